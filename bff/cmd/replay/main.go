@@ -0,0 +1,79 @@
+// Command replay sends requests captured by the BFF capture mode to a
+// target environment (usually staging) and reports results that differ
+// from the original outcome.
+//
+//	go run ./cmd/replay -file captures/captures-20260101.jsonl -target http://bff.staging:8080 -token "$TOKEN"
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"net/http"
+	"os"
+	"time"
+
+	"github.com/daisuke8000/example-ec-platform/bff/internal/capture"
+)
+
+func main() {
+	if err := run(); err != nil {
+		fmt.Fprintln(os.Stderr, "replay failed:", err)
+		os.Exit(1)
+	}
+}
+
+func run() error {
+	file := flag.String("file", "", "capture file to replay")
+	target := flag.String("target", "", "base URL of the target BFF")
+	token := flag.String("token", "", "bearer token for the target environment")
+	procedure := flag.String("procedure", "", "only replay this procedure")
+	id := flag.String("id", "", "only replay the record with this ID")
+	timeout := flag.Duration("timeout", 10*time.Second, "per-request timeout")
+	flag.Parse()
+
+	if *file == "" || *target == "" {
+		flag.Usage()
+		return fmt.Errorf("-file and -target are required")
+	}
+
+	records, err := capture.ReadFile(*file)
+	if err != nil {
+		return err
+	}
+
+	replayer := capture.NewReplayer(&http.Client{Timeout: *timeout}, *target, *token)
+	ctx := context.Background()
+
+	var replayed, mismatched int
+	for _, rec := range records {
+		if (*procedure != "" && rec.Procedure != *procedure) || (*id != "" && rec.ID != *id) {
+			continue
+		}
+		replayed++
+
+		result, err := replayer.Replay(ctx, rec)
+		if err != nil {
+			mismatched++
+			fmt.Printf("ERROR %s %s: %v\n", rec.ID, rec.Procedure, err)
+			continue
+		}
+		if !result.Matches() {
+			mismatched++
+			fmt.Printf("DIFF  %s %s: captured %q, replayed %q\n",
+				rec.ID, rec.Procedure, outcome(rec.ErrorCode), outcome(result.ErrorCode))
+			continue
+		}
+		fmt.Printf("OK    %s %s\n", rec.ID, rec.Procedure)
+	}
+
+	fmt.Printf("%d replayed, %d differed\n", replayed, mismatched)
+	return nil
+}
+
+func outcome(code string) string {
+	if code == "" {
+		return "ok"
+	}
+	return code
+}
@@ -12,6 +12,10 @@ import (
 
 	"github.com/daisuke8000/example-ec-platform/bff/internal/config"
 	"github.com/daisuke8000/example-ec-platform/bff/internal/server"
+	pkgmw "github.com/daisuke8000/example-ec-platform/pkg/connect/middleware"
+	pkgobs "github.com/daisuke8000/example-ec-platform/pkg/observability"
+
+	"go.opentelemetry.io/otel/metric"
 )
 
 func main() {
@@ -36,14 +40,22 @@ func run() error {
 	}
 
 	// Setup logger
-	setupLogger(cfg.Observability.LogLevel)
+	setupLogger(cfg.Observability.LogLevel, cfg.Server.Region)
 	slog.Info("starting BFF server",
 		"port", cfg.Server.Port,
 		"issuer", cfg.JWT.IssuerURL,
 	)
 
+	// Metrics are served on the metrics port (optional)
+	var metricsExporter *pkgobs.Exporter
+	var meter metric.Meter
+	if cfg.Observability.MetricsEnabled {
+		metricsExporter = pkgobs.NewExporter()
+		meter = metricsExporter.Meter("bff")
+	}
+
 	// Initialize dependencies
-	deps, err := server.NewDependencies(ctx, cfg, nil)
+	deps, err := server.NewDependencies(ctx, cfg, meter)
 	if err != nil {
 		return fmt.Errorf("failed to initialize dependencies: %w", err)
 	}
@@ -58,22 +70,20 @@ func run() error {
 		w.Write([]byte("OK"))
 	})
 
-	// Ready check endpoint (checks JWKS health)
-	mux.HandleFunc("/ready", func(w http.ResponseWriter, r *http.Request) {
-		if deps.JWKSManager.IsHealthy() {
-			w.WriteHeader(http.StatusOK)
-			w.Write([]byte("Ready"))
-		} else {
-			w.WriteHeader(http.StatusServiceUnavailable)
-			w.Write([]byte("JWKS not healthy"))
-		}
-	})
+	// Ready check endpoint (JWKS health plus optional backend probes)
+	mux.Handle("/ready", deps.ReadinessChecker)
 
 	// Register Connect-go service handlers
-	deps.RegisterHandlers(mux)
+	if err := deps.RegisterHandlers(mux); err != nil {
+		return err
+	}
 
 	// Apply middleware chain
 	handler := server.BuildHTTPHandler(cfg, mux)
+	if deps.LoadShedder != nil {
+		// Outermost so shed requests cost as little as possible.
+		handler = deps.LoadShedder.Middleware(handler)
+	}
 
 	// Create HTTP server
 	srv := &http.Server{
@@ -84,6 +94,12 @@ func run() error {
 		IdleTimeout:  60 * time.Second,
 	}
 
+	// Start memory and goroutine watchdog (optional). Its restart request
+	// is a SIGTERM, handled by the graceful shutdown below.
+	if deps.Watchdog != nil {
+		go deps.Watchdog.Start(ctx)
+	}
+
 	// Start server in goroutine
 	errCh := make(chan error, 1)
 	go func() {
@@ -93,6 +109,29 @@ func run() error {
 		}
 	}()
 
+	if cfg.Server.DebugPort != 0 {
+		// Optional; a failure is logged but does not stop the server
+		debugServer := pkgobs.NewDebugServer(cfg.Server.DebugAddr, cfg.Server.DebugPort)
+		defer debugServer.Close()
+		go func() {
+			slog.Warn("debug server listening", "addr", debugServer.Addr)
+			if err := debugServer.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+				slog.Error("debug server error", "error", err)
+			}
+		}()
+	}
+
+	var metricsServer *http.Server
+	if metricsExporter != nil {
+		metricsServer = pkgobs.NewMetricsServer(cfg.Server.MetricsPort, metricsExporter)
+		go func() {
+			slog.Info("metrics server listening", "addr", metricsServer.Addr)
+			if err := metricsServer.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+				errCh <- fmt.Errorf("metrics server: %w", err)
+			}
+		}()
+	}
+
 	// Wait for shutdown signal or error
 	select {
 	case sig := <-sigCh:
@@ -109,12 +148,16 @@ func run() error {
 	if err := srv.Shutdown(shutdownCtx); err != nil {
 		return fmt.Errorf("shutdown error: %w", err)
 	}
+	if metricsServer != nil {
+		metricsServer.Shutdown(shutdownCtx)
+		metricsExporter.Shutdown(shutdownCtx)
+	}
 
 	slog.Info("server stopped gracefully")
 	return nil
 }
 
-func setupLogger(level string) {
+func setupLogger(level, region string) {
 	var logLevel slog.Level
 	switch level {
 	case "debug":
@@ -130,5 +173,10 @@ func setupLogger(level string) {
 	handler := slog.NewJSONHandler(os.Stdout, &slog.HandlerOptions{
 		Level: logLevel,
 	})
-	slog.SetDefault(slog.New(handler))
+	// Records logged with a request's context carry its request ID
+	logger := slog.New(pkgmw.NewContextLogHandler(handler))
+	if region != "" {
+		logger = logger.With("region", region)
+	}
+	slog.SetDefault(logger)
 }
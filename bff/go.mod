@@ -6,20 +6,34 @@ require (
 	connectrpc.com/connect v1.18.1
 	github.com/daisuke8000/example-ec-platform/gen v0.0.0-00010101000000-000000000000
 	github.com/daisuke8000/example-ec-platform/pkg/connect v0.0.0-00010101000000-000000000000
+	github.com/daisuke8000/example-ec-platform/pkg/observability v0.0.0-00010101000000-000000000000
+	github.com/daisuke8000/example-ec-platform/pkg/watchdog v0.0.0-00010101000000-000000000000
+	github.com/daisuke8000/example-ec-platform/pkg/webhook v0.0.0-00010101000000-000000000000
+	github.com/google/uuid v1.6.0
 	github.com/lestrrat-go/jwx/v2 v2.1.6
+	github.com/redis/go-redis/v9 v9.17.2
 	github.com/sethvargo/go-envconfig v1.0.3
 	go.opentelemetry.io/otel v1.32.0
 	go.opentelemetry.io/otel/metric v1.32.0
 	go.opentelemetry.io/otel/sdk/metric v1.32.0
 	golang.org/x/net v0.29.0
+	golang.org/x/text v0.21.0
+	google.golang.org/genproto/googleapis/rpc v0.0.0-20240318140521-94a12d6c2237
+	google.golang.org/protobuf v1.35.2
 )
 
 require (
+	github.com/cespare/xxhash/v2 v2.3.0 // indirect
+	github.com/daisuke8000/example-ec-platform/pkg/listing v0.0.0-00010101000000-000000000000 // indirect
 	github.com/decred/dcrd/dcrec/secp256k1/v4 v4.4.0 // indirect
+	github.com/dgryski/go-rendezvous v0.0.0-20200823014737-9f7001d12a5f // indirect
 	github.com/go-logr/logr v1.4.2 // indirect
 	github.com/go-logr/stdr v1.2.2 // indirect
 	github.com/goccy/go-json v0.10.3 // indirect
-	github.com/google/uuid v1.6.0 // indirect
+	github.com/jackc/pgpassfile v1.0.0 // indirect
+	github.com/jackc/pgservicefile v0.0.0-20221227161230-091c0ba34f0a // indirect
+	github.com/jackc/pgx/v5 v5.6.0 // indirect
+	github.com/jackc/puddle/v2 v2.2.1 // indirect
 	github.com/lestrrat-go/blackmagic v1.0.3 // indirect
 	github.com/lestrrat-go/httpcc v1.0.1 // indirect
 	github.com/lestrrat-go/httprc v1.0.6 // indirect
@@ -29,13 +43,19 @@ require (
 	go.opentelemetry.io/otel/sdk v1.32.0 // indirect
 	go.opentelemetry.io/otel/trace v1.32.0 // indirect
 	golang.org/x/crypto v0.32.0 // indirect
+	golang.org/x/sync v0.10.0 // indirect
 	golang.org/x/sys v0.31.0 // indirect
-	golang.org/x/text v0.21.0 // indirect
-	google.golang.org/genproto/googleapis/rpc v0.0.0-20240318140521-94a12d6c2237 // indirect
 	google.golang.org/grpc v1.64.0 // indirect
-	google.golang.org/protobuf v1.35.2 // indirect
 )
 
 replace github.com/daisuke8000/example-ec-platform/gen => ../gen
 
 replace github.com/daisuke8000/example-ec-platform/pkg/connect => ../pkg/connect
+
+replace github.com/daisuke8000/example-ec-platform/pkg/observability => ../pkg/observability
+
+replace github.com/daisuke8000/example-ec-platform/pkg/watchdog => ../pkg/watchdog
+
+replace github.com/daisuke8000/example-ec-platform/pkg/webhook => ../pkg/webhook
+
+replace github.com/daisuke8000/example-ec-platform/pkg/listing => ../pkg/listing
@@ -7,14 +7,25 @@ import (
 
 	"connectrpc.com/connect"
 
+	"github.com/daisuke8000/example-ec-platform/gen/user/v1/userv1connect"
 	pkgmw "github.com/daisuke8000/example-ec-platform/pkg/connect/middleware"
 )
 
+// Permissions granted through roles in the user service.
 const (
-	ScopeAdmin      = "admin"
-	ScopeUserRead   = "user:read"
-	ScopeUserWrite  = "user:write"
-	ScopeUserDelete = "user:delete"
+	PermUsersList   = "users:list"
+	PermUsersRead   = "users:read"
+	PermUsersWrite  = "users:write"
+	PermUsersDelete = "users:delete"
+	PermUsersBulk   = "users:bulk"
+	PermUsersGrant  = "users:grant"
+
+	// PermProductCacheFlush allows flushing the BFF's product cache.
+	PermProductCacheFlush = "product-cache:flush"
+
+	// PermOpsRunbook allows running the incident actions under
+	// /admin/runbook/.
+	PermOpsRunbook = "ops:runbook"
 )
 
 var (
@@ -22,46 +33,104 @@ var (
 	ErrPermissionDenied = connect.NewError(connect.CodePermissionDenied, errors.New("access denied"))
 )
 
-type Authorizer struct{}
+// Policy maps a full procedure name to the permission it requires.
+// For owner-scoped methods the permission is only needed to act on other users.
+type Policy map[string]string
+
+// DefaultPolicy returns the built-in permission requirements for the user service.
+func DefaultPolicy() Policy {
+	return Policy{
+		userv1connect.UserServiceGetUserProcedure:       PermUsersRead,
+		userv1connect.UserServiceGetUserRolesProcedure:  PermUsersRead,
+		userv1connect.UserServiceUpdateUserProcedure:    PermUsersWrite,
+		userv1connect.UserServiceDeleteUserProcedure:    PermUsersDelete,
+		userv1connect.UserServiceUnlockUserProcedure:    PermUsersWrite,
+		userv1connect.UserServiceListUsersProcedure:     PermUsersList,
+		userv1connect.UserServiceListConsentsProcedure:  PermUsersRead,
+		userv1connect.UserServiceRevokeConsentProcedure: PermUsersWrite,
+
+		userv1connect.UserServiceBatchDeactivateUsersProcedure: PermUsersBulk,
+		userv1connect.UserServiceBatchAssignSegmentProcedure:   PermUsersBulk,
+		userv1connect.UserServiceGetBatchJobProcedure:          PermUsersBulk,
+		userv1connect.UserServiceGetBatchJobReportProcedure:    PermUsersBulk,
+
+		userv1connect.UserServiceCreateAccessGrantProcedure: PermUsersGrant,
+		userv1connect.UserServiceRevokeAccessGrantProcedure: PermUsersGrant,
+		userv1connect.UserServiceListAccessGrantsProcedure:  PermUsersGrant,
+	}
+}
+
+type Authorizer struct {
+	policy Policy
+}
 
-func NewAuthorizer() *Authorizer {
-	return &Authorizer{}
+// NewAuthorizer creates an authorizer evaluating the given per-method policy.
+func NewAuthorizer(policy Policy) *Authorizer {
+	return &Authorizer{policy: policy}
 }
 
 // CanAccessUser checks if the current user can access the target user's data.
+// Owners always have access; other callers need the permission the policy
+// requires for procedure.
 // Returns nil if access is allowed, error otherwise.
-func (a *Authorizer) CanAccessUser(ctx context.Context, targetUserID string) error {
+func (a *Authorizer) CanAccessUser(ctx context.Context, procedure, targetUserID string) error {
 	currentUserID := pkgmw.GetUserID(ctx)
 	if currentUserID == "" {
 		return ErrUnauthenticated
 	}
 
-	// Admin can access any user
-	if a.HasScope(ctx, ScopeAdmin) {
+	// Users can always access their own data
+	if currentUserID == targetUserID {
 		return nil
 	}
 
-	// Users can only access their own data (BOLA prevention)
-	if currentUserID != targetUserID {
+	// Cross-user access requires an explicit permission (BOLA prevention)
+	perm, ok := a.policy[procedure]
+	if !ok || !a.HasPermission(ctx, perm) {
 		return ErrPermissionDenied
 	}
 
 	return nil
 }
 
-// HasScope checks if the current user has the specified scope.
-func (a *Authorizer) HasScope(ctx context.Context, scope string) bool {
-	scopes := pkgmw.GetScopes(ctx)
-	if scopes == "" {
-		return false
+// RequireSelf checks that the current user is the target user. Unlike
+// CanAccessUser no permission grants access to other users, for data only
+// the owner may see or act on, such as their login sessions.
+func (a *Authorizer) RequireSelf(ctx context.Context, targetUserID string) error {
+	currentUserID := pkgmw.GetUserID(ctx)
+	if currentUserID == "" {
+		return ErrUnauthenticated
 	}
+	if currentUserID != targetUserID {
+		return ErrPermissionDenied
+	}
+	return nil
+}
 
-	for _, s := range strings.Split(scopes, " ") {
-		if s == scope {
-			return true
-		}
+// Authorize checks that the current user holds the permission the policy
+// requires for procedure. Procedures without a policy entry are denied, so
+// a method whose entry was forgotten or removed from the configuration
+// fails closed instead of opening to every signed-in user.
+func (a *Authorizer) Authorize(ctx context.Context, procedure string) error {
+	if err := a.RequireAuthenticated(ctx); err != nil {
+		return err
 	}
-	return false
+
+	perm, ok := a.policy[procedure]
+	if !ok || !a.HasPermission(ctx, perm) {
+		return ErrPermissionDenied
+	}
+	return nil
+}
+
+// HasPermission checks if the current user has the specified permission.
+func (a *Authorizer) HasPermission(ctx context.Context, permission string) bool {
+	return containsField(pkgmw.GetPermissions(ctx), permission)
+}
+
+// HasRole checks if the current user has the specified role.
+func (a *Authorizer) HasRole(ctx context.Context, role string) bool {
+	return containsField(pkgmw.GetRoles(ctx), role)
 }
 
 // RequireAuthenticated checks if the user is authenticated.
@@ -71,3 +140,13 @@ func (a *Authorizer) RequireAuthenticated(ctx context.Context) error {
 	}
 	return nil
 }
+
+// containsField reports whether the space-separated list contains value.
+func containsField(list, value string) bool {
+	for _, s := range strings.Fields(list) {
+		if s == value {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,101 @@
+package authz
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	userv1 "github.com/daisuke8000/example-ec-platform/gen/user/v1"
+	"github.com/daisuke8000/example-ec-platform/gen/user/v1/userv1connect"
+	pkgmw "github.com/daisuke8000/example-ec-platform/pkg/connect/middleware"
+)
+
+func TestAuthorizer_Authorize(t *testing.T) {
+	authorizer := NewAuthorizer(Policy{
+		userv1connect.UserServiceListUsersProcedure: PermUsersList,
+	})
+	admin := pkgmw.WithPermissions(pkgmw.WithUserID(context.Background(), "admin-1"), PermUsersList+" "+PermUsersWrite)
+
+	tests := []struct {
+		name      string
+		ctx       context.Context
+		procedure string
+		wantErr   error
+	}{
+		{
+			name:      "permission held",
+			ctx:       admin,
+			procedure: userv1connect.UserServiceListUsersProcedure,
+		},
+		{
+			name:      "permission missing",
+			ctx:       pkgmw.WithUserID(context.Background(), "user-1"),
+			procedure: userv1connect.UserServiceListUsersProcedure,
+			wantErr:   ErrPermissionDenied,
+		},
+		{
+			name:      "procedure without a policy entry is denied",
+			ctx:       admin,
+			procedure: userv1connect.UserServiceUnlockUserProcedure,
+			wantErr:   ErrPermissionDenied,
+		},
+		{
+			name:      "unauthenticated",
+			ctx:       context.Background(),
+			procedure: userv1connect.UserServiceListUsersProcedure,
+			wantErr:   ErrUnauthenticated,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := authorizer.Authorize(tt.ctx, tt.procedure); err != tt.wantErr {
+				t.Errorf("Authorize() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+// unpolicedUserProcedures are the user service procedures that the BFF
+// deliberately authorizes without a permission.
+var unpolicedUserProcedures = map[string]string{
+	userv1connect.UserServiceCreateUserProcedure:     "public registration",
+	userv1connect.UserServiceVerifyEmailProcedure:    "public; the token is the credential",
+	userv1connect.UserServiceVerifyPasswordProcedure: "blocked at the BFF",
+	userv1connect.UserServiceGetServerInfoProcedure:  "called by the BFF itself, not proxied",
+
+	userv1connect.UserServiceResendVerificationEmailProcedure:   "self only",
+	userv1connect.UserServiceListConnectedAppsProcedure:         "self only",
+	userv1connect.UserServiceListSessionsProcedure:              "self only",
+	userv1connect.UserServiceRevokeSessionProcedure:             "self only",
+	userv1connect.UserServiceGetLoginHistoryProcedure:           "self only",
+	userv1connect.UserServiceAddAddressProcedure:                "self only",
+	userv1connect.UserServiceListAddressesProcedure:             "self only",
+	userv1connect.UserServiceSetDefaultShippingAddressProcedure: "self only",
+	userv1connect.UserServiceDeleteAddressProcedure:             "self only",
+	userv1connect.UserServiceGetTwoFactorStatusProcedure:        "self only",
+	userv1connect.UserServiceEnrollTOTPProcedure:                "self only",
+	userv1connect.UserServiceConfirmTOTPProcedure:               "self only",
+	userv1connect.UserServiceDisableTOTPProcedure:               "self only",
+	userv1connect.UserServiceChangePasswordProcedure:            "self only",
+}
+
+// TestDefaultPolicy_CoversUserService makes adding a user service RPC a
+// decision: it either gets a permission in DefaultPolicy or is listed
+// above with the reason it needs none.
+func TestDefaultPolicy_CoversUserService(t *testing.T) {
+	policy := DefaultPolicy()
+	service := userv1.File_user_v1_user_service_proto.Services().ByName("UserService")
+	methods := service.Methods()
+	for i := 0; i < methods.Len(); i++ {
+		procedure := fmt.Sprintf("/%s/%s", service.FullName(), methods.Get(i).Name())
+		_, policed := policy[procedure]
+		_, unpoliced := unpolicedUserProcedures[procedure]
+		switch {
+		case policed && unpoliced:
+			t.Errorf("%s has a policy entry and is listed as needing none", procedure)
+		case !policed && !unpoliced:
+			t.Errorf("%s has no policy entry; add one to DefaultPolicy or list why it needs none", procedure)
+		}
+	}
+}
@@ -0,0 +1,180 @@
+package authz
+
+import (
+	"context"
+	"log/slog"
+	"strings"
+	"sync"
+	"time"
+
+	"connectrpc.com/connect"
+
+	userv1 "github.com/daisuke8000/example-ec-platform/gen/user/v1"
+	"github.com/daisuke8000/example-ec-platform/gen/user/v1/userv1connect"
+	pkgmw "github.com/daisuke8000/example-ec-platform/pkg/connect/middleware"
+)
+
+// maxCachedGrantUsers bounds the grant cache; expired entries are swept
+// when it is reached.
+const maxCachedGrantUsers = 10000
+
+// Grant is an access grant in effect for the caller.
+type Grant struct {
+	ID         string
+	Permission string
+	ExpiresAt  time.Time
+}
+
+// GrantFetcher returns a user's active access grants.
+type GrantFetcher interface {
+	ActiveGrants(ctx context.Context, userID string) ([]Grant, error)
+}
+
+// UserServiceGrantFetcher reads access grants from the user service.
+type UserServiceGrantFetcher struct {
+	client userv1connect.UserServiceClient
+}
+
+func NewUserServiceGrantFetcher(client userv1connect.UserServiceClient) *UserServiceGrantFetcher {
+	return &UserServiceGrantFetcher{client: client}
+}
+
+func (f *UserServiceGrantFetcher) ActiveGrants(ctx context.Context, userID string) ([]Grant, error) {
+	resp, err := f.client.ListAccessGrants(ctx, connect.NewRequest(&userv1.ListAccessGrantsRequest{
+		UserId:     userID,
+		ActiveOnly: true,
+	}))
+	if err != nil {
+		// Backends without access grants have none to apply.
+		if connect.CodeOf(err) == connect.CodeUnimplemented {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	grants := make([]Grant, 0, len(resp.Msg.GetGrants()))
+	for _, g := range resp.Msg.GetGrants() {
+		grants = append(grants, Grant{
+			ID:         g.GetId(),
+			Permission: g.GetPermission(),
+			ExpiresAt:  g.GetExpiresAt().AsTime(),
+		})
+	}
+	return grants, nil
+}
+
+type grantsKey struct{}
+
+// GrantsFromContext returns the access grants that added permissions to the
+// current request.
+func GrantsFromContext(ctx context.Context) []Grant {
+	grants, _ := ctx.Value(grantsKey{}).([]Grant)
+	return grants
+}
+
+type cachedGrants struct {
+	grants    []Grant
+	fetchedAt time.Time
+}
+
+// Grants adds the permissions of a caller's active access grants to those
+// carried by their token. Grants are cached per user for cacheTTL, so a
+// revocation takes up to cacheTTL to reach every BFF replica; expiry is
+// checked on every request.
+type Grants struct {
+	fetcher  GrantFetcher
+	cacheTTL time.Duration
+	logger   *slog.Logger
+	now      func() time.Time
+
+	mu    sync.Mutex
+	cache map[string]cachedGrants
+}
+
+func NewGrants(fetcher GrantFetcher, cacheTTL time.Duration, logger *slog.Logger) *Grants {
+	return &Grants{
+		fetcher:  fetcher,
+		cacheTTL: cacheTTL,
+		logger:   logger,
+		now:      time.Now,
+		cache:    make(map[string]cachedGrants),
+	}
+}
+
+// Interceptor returns a server-side interceptor applying access grants. It
+// must run after authentication and before authorization and the security
+// audit. When grants cannot be loaded the request proceeds with the token's
+// permissions only.
+func (g *Grants) Interceptor() connect.UnaryInterceptorFunc {
+	return func(next connect.UnaryFunc) connect.UnaryFunc {
+		return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+			userID := pkgmw.GetUserID(ctx)
+			if req.Spec().IsClient || userID == "" {
+				return next(ctx, req)
+			}
+
+			grants, err := g.lookup(ctx, userID)
+			if err != nil {
+				g.logger.WarnContext(ctx, "failed to load access grants",
+					slog.String("user_id", userID),
+					slog.String("error", err.Error()),
+				)
+				return next(ctx, req)
+			}
+
+			permissions, applied := g.apply(pkgmw.GetPermissions(ctx), grants)
+			if len(applied) == 0 {
+				return next(ctx, req)
+			}
+			ctx = pkgmw.WithPermissions(ctx, permissions)
+			ctx = context.WithValue(ctx, grantsKey{}, applied)
+			return next(ctx, req)
+		}
+	}
+}
+
+// apply merges the permissions of unexpired grants into permissions and
+// returns the grants that added one.
+func (g *Grants) apply(permissions string, grants []Grant) (string, []Grant) {
+	now := g.now()
+	var applied []Grant
+	for _, grant := range grants {
+		if !now.Before(grant.ExpiresAt) || containsField(permissions, grant.Permission) {
+			continue
+		}
+		permissions = strings.TrimSpace(permissions + " " + grant.Permission)
+		applied = append(applied, grant)
+	}
+	return permissions, applied
+}
+
+func (g *Grants) lookup(ctx context.Context, userID string) ([]Grant, error) {
+	now := g.now()
+
+	g.mu.Lock()
+	entry, ok := g.cache[userID]
+	g.mu.Unlock()
+	if ok && now.Sub(entry.fetchedAt) < g.cacheTTL {
+		return entry.grants, nil
+	}
+
+	grants, err := g.fetcher.ActiveGrants(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	if len(g.cache) >= maxCachedGrantUsers {
+		for id, e := range g.cache {
+			if now.Sub(e.fetchedAt) >= g.cacheTTL {
+				delete(g.cache, id)
+			}
+		}
+		if len(g.cache) >= maxCachedGrantUsers {
+			clear(g.cache)
+		}
+	}
+	g.cache[userID] = cachedGrants{grants: grants, fetchedAt: now}
+	return grants, nil
+}
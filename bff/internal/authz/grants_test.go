@@ -0,0 +1,128 @@
+package authz
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"os"
+	"testing"
+	"time"
+)
+
+// fakeGrantFetcher returns fixed grants and counts its calls.
+type fakeGrantFetcher struct {
+	grants []Grant
+	err    error
+	calls  int
+}
+
+func (f *fakeGrantFetcher) ActiveGrants(ctx context.Context, userID string) ([]Grant, error) {
+	f.calls++
+	return f.grants, f.err
+}
+
+func newTestGrants(fetcher GrantFetcher, now *time.Time) *Grants {
+	logger := slog.New(slog.NewTextHandler(os.Stdout, &slog.HandlerOptions{Level: slog.LevelError}))
+	g := NewGrants(fetcher, 30*time.Second, logger)
+	g.now = func() time.Time { return *now }
+	return g
+}
+
+func TestGrants_Apply(t *testing.T) {
+	now := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	g := newTestGrants(&fakeGrantFetcher{}, &now)
+
+	tests := []struct {
+		name        string
+		permissions string
+		grants      []Grant
+		want        string
+		wantApplied []string
+	}{
+		{
+			name:        "adds granted permission",
+			permissions: "users:read",
+			grants:      []Grant{{ID: "g1", Permission: "users:write", ExpiresAt: now.Add(time.Hour)}},
+			want:        "users:read users:write",
+			wantApplied: []string{"g1"},
+		},
+		{
+			name:        "grant to caller without permissions",
+			permissions: "",
+			grants:      []Grant{{ID: "g1", Permission: "users:read", ExpiresAt: now.Add(time.Hour)}},
+			want:        "users:read",
+			wantApplied: []string{"g1"},
+		},
+		{
+			name:        "expired grant is ignored",
+			permissions: "users:read",
+			grants:      []Grant{{ID: "g1", Permission: "users:write", ExpiresAt: now}},
+			want:        "users:read",
+		},
+		{
+			name:        "permission already held is not attributed to the grant",
+			permissions: "users:read",
+			grants:      []Grant{{ID: "g1", Permission: "users:read", ExpiresAt: now.Add(time.Hour)}},
+			want:        "users:read",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, applied := g.apply(tt.permissions, tt.grants)
+			if got != tt.want {
+				t.Errorf("permissions = %q, want %q", got, tt.want)
+			}
+			if len(applied) != len(tt.wantApplied) {
+				t.Fatalf("applied %d grants, want %d", len(applied), len(tt.wantApplied))
+			}
+			for i, id := range tt.wantApplied {
+				if applied[i].ID != id {
+					t.Errorf("applied[%d] = %q, want %q", i, applied[i].ID, id)
+				}
+			}
+		})
+	}
+}
+
+func TestGrants_LookupCachesPerTTL(t *testing.T) {
+	now := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	fetcher := &fakeGrantFetcher{grants: []Grant{{ID: "g1", Permission: "users:write", ExpiresAt: now.Add(time.Hour)}}}
+	g := newTestGrants(fetcher, &now)
+	ctx := context.Background()
+
+	for i := 0; i < 2; i++ {
+		if _, err := g.lookup(ctx, "agent"); err != nil {
+			t.Fatalf("lookup: %v", err)
+		}
+	}
+	if fetcher.calls != 1 {
+		t.Errorf("fetcher called %d times, want 1 within the TTL", fetcher.calls)
+	}
+
+	now = now.Add(31 * time.Second)
+	if _, err := g.lookup(ctx, "agent"); err != nil {
+		t.Fatalf("lookup: %v", err)
+	}
+	if fetcher.calls != 2 {
+		t.Errorf("fetcher called %d times, want 2 after the TTL", fetcher.calls)
+	}
+}
+
+func TestGrants_LookupErrorIsNotCached(t *testing.T) {
+	now := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	fetcher := &fakeGrantFetcher{err: errors.New("unavailable")}
+	g := newTestGrants(fetcher, &now)
+	ctx := context.Background()
+
+	if _, err := g.lookup(ctx, "agent"); err == nil {
+		t.Fatal("expected error")
+	}
+	fetcher.err = nil
+	if _, err := g.lookup(ctx, "agent"); err != nil {
+		t.Fatalf("lookup: %v", err)
+	}
+	if fetcher.calls != 2 {
+		t.Errorf("fetcher called %d times, want 2", fetcher.calls)
+	}
+}
@@ -0,0 +1,220 @@
+// Package capability tracks what backend versions support so the BFF can
+// degrade gracefully during mixed-version rollouts.
+package capability
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+	"sync"
+	"time"
+
+	"connectrpc.com/connect"
+)
+
+// Info describes the capabilities reported by a backend.
+type Info struct {
+	Version    string
+	Procedures map[string]struct{}
+	Features   map[string]struct{}
+}
+
+// Fetcher retrieves the current capabilities of a backend.
+// It returns (nil, nil) when the backend predates capability negotiation.
+type Fetcher func(ctx context.Context) (*Info, error)
+
+// FieldGate requires a feature when a request uses an optional field.
+type FieldGate struct {
+	Procedure string
+	Feature   string
+
+	// Uses reports whether the request message sets the gated field.
+	Uses func(msg any) bool
+}
+
+// Config holds configuration for a Registry.
+type Config struct {
+	// Service is the fully-qualified service name (e.g. "user.v1.UserService").
+	// Only procedures of this service are gated.
+	Service string
+
+	RefreshInterval time.Duration
+	FetchTimeout    time.Duration
+	FieldGates      []FieldGate
+}
+
+// Registry caches the capabilities of one backend service and refreshes them
+// in the background.
+//
+// Until capabilities are known, or when the backend does not implement
+// capability negotiation, every procedure and feature is assumed supported,
+// which is the behaviour before negotiation existed.
+type Registry struct {
+	fetch      Fetcher
+	prefix     string
+	interval   time.Duration
+	timeout    time.Duration
+	fieldGates map[string][]FieldGate
+	logger     *slog.Logger
+
+	info *Info
+	mu   sync.RWMutex
+
+	stopCh chan struct{}
+	doneCh chan struct{}
+}
+
+// NewRegistry creates a registry. Call Start to begin refreshing.
+func NewRegistry(fetch Fetcher, cfg Config, logger *slog.Logger) *Registry {
+	gates := make(map[string][]FieldGate)
+	for _, g := range cfg.FieldGates {
+		gates[g.Procedure] = append(gates[g.Procedure], g)
+	}
+
+	return &Registry{
+		fetch:      fetch,
+		prefix:     "/" + cfg.Service + "/",
+		interval:   cfg.RefreshInterval,
+		timeout:    cfg.FetchTimeout,
+		fieldGates: gates,
+		logger:     logger,
+		stopCh:     make(chan struct{}),
+		doneCh:     make(chan struct{}),
+	}
+}
+
+// Start fetches capabilities immediately and then every RefreshInterval
+// until Close is called. Fetch failures keep the last known capabilities.
+func (r *Registry) Start(ctx context.Context) {
+	ctx = context.WithoutCancel(ctx)
+	go func() {
+		defer close(r.doneCh)
+
+		ticker := time.NewTicker(r.interval)
+		defer ticker.Stop()
+
+		for {
+			if err := r.Refresh(ctx); err != nil {
+				r.logger.Warn("failed to refresh backend capabilities",
+					slog.String("service", strings.Trim(r.prefix, "/")),
+					slog.String("error", err.Error()),
+				)
+			}
+			select {
+			case <-r.stopCh:
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+}
+
+// Close stops background refreshing.
+func (r *Registry) Close() {
+	select {
+	case <-r.stopCh:
+		return
+	default:
+		close(r.stopCh)
+	}
+	<-r.doneCh
+}
+
+// Refresh fetches capabilities once.
+func (r *Registry) Refresh(ctx context.Context) error {
+	if r.timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, r.timeout)
+		defer cancel()
+	}
+
+	info, err := r.fetch(ctx)
+	if err != nil {
+		return err
+	}
+
+	r.mu.Lock()
+	prev := r.info
+	r.info = info
+	r.mu.Unlock()
+
+	if info != nil && (prev == nil || prev.Version != info.Version) {
+		r.logger.Info("backend capabilities updated",
+			slog.String("service", strings.Trim(r.prefix, "/")),
+			slog.String("version", info.Version),
+			slog.Int("procedures", len(info.Procedures)),
+			slog.Int("features", len(info.Features)),
+		)
+	}
+	return nil
+}
+
+// Version returns the last reported backend version, or "" if unknown.
+func (r *Registry) Version() string {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	if r.info == nil {
+		return ""
+	}
+	return r.info.Version
+}
+
+// Supports reports whether the backend implements procedure.
+func (r *Registry) Supports(procedure string) bool {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	if r.info == nil {
+		return true
+	}
+	_, ok := r.info.Procedures[procedure]
+	return ok
+}
+
+// HasFeature reports whether the backend supports an optional feature.
+func (r *Registry) HasFeature(feature string) bool {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	if r.info == nil {
+		return true
+	}
+	_, ok := r.info.Features[feature]
+	return ok
+}
+
+// Check returns an Unavailable error if the backend cannot serve the request.
+// Unavailable is used rather than Unimplemented because the condition is
+// temporary: it clears once the rollout reaches the backend.
+func (r *Registry) Check(procedure string, msg any) error {
+	if !strings.HasPrefix(procedure, r.prefix) {
+		return nil
+	}
+	if !r.Supports(procedure) {
+		return connect.NewError(connect.CodeUnavailable,
+			fmt.Errorf("%s is not available yet, please retry later", strings.TrimPrefix(procedure, r.prefix)))
+	}
+	for _, gate := range r.fieldGates[procedure] {
+		if gate.Uses(msg) && !r.HasFeature(gate.Feature) {
+			return connect.NewError(connect.CodeUnavailable,
+				errors.New("requested option is not available yet, please retry later"))
+		}
+	}
+	return nil
+}
+
+// Interceptor returns a server-side interceptor that rejects requests the
+// backend cannot serve before they are proxied.
+func (r *Registry) Interceptor() connect.UnaryInterceptorFunc {
+	return func(next connect.UnaryFunc) connect.UnaryFunc {
+		return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+			if req.Spec().IsClient {
+				return next(ctx, req)
+			}
+			if err := r.Check(req.Spec().Procedure, req.Any()); err != nil {
+				return nil, err
+			}
+			return next(ctx, req)
+		}
+	}
+}
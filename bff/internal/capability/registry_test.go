@@ -0,0 +1,148 @@
+package capability_test
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"os"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+
+	userv1 "github.com/daisuke8000/example-ec-platform/gen/user/v1"
+	"github.com/daisuke8000/example-ec-platform/gen/user/v1/userv1connect"
+
+	"github.com/daisuke8000/example-ec-platform/bff/internal/capability"
+)
+
+func newTestLogger() *slog.Logger {
+	return slog.New(slog.NewTextHandler(os.Stdout, &slog.HandlerOptions{Level: slog.LevelError}))
+}
+
+func newTestRegistry(fetch capability.Fetcher) *capability.Registry {
+	return capability.NewRegistry(fetch, capability.Config{
+		Service:         userv1connect.UserServiceName,
+		RefreshInterval: time.Minute,
+		FetchTimeout:    time.Second,
+		FieldGates:      capability.UserServiceFieldGates(),
+	}, newTestLogger())
+}
+
+func staticFetcher(info *capability.Info, err error) capability.Fetcher {
+	return func(ctx context.Context) (*capability.Info, error) {
+		return info, err
+	}
+}
+
+func set(values ...string) map[string]struct{} {
+	m := make(map[string]struct{}, len(values))
+	for _, v := range values {
+		m[v] = struct{}{}
+	}
+	return m
+}
+
+func TestRegistry_UnknownAllowsEverything(t *testing.T) {
+	registry := newTestRegistry(staticFetcher(nil, nil))
+	if err := registry.Refresh(t.Context()); err != nil {
+		t.Fatalf("Refresh() error = %v", err)
+	}
+
+	if err := registry.Check(userv1connect.UserServiceRevokeConsentProcedure, &userv1.RevokeConsentRequest{}); err != nil {
+		t.Errorf("Check() error = %v, want nil for backend without negotiation", err)
+	}
+	if !registry.HasFeature("list_consents.include_revoked") {
+		t.Error("HasFeature() = false, want true when capabilities are unknown")
+	}
+}
+
+func TestRegistry_GatesProcedures(t *testing.T) {
+	registry := newTestRegistry(staticFetcher(&capability.Info{
+		Version:    "v1.0.0",
+		Procedures: set(userv1connect.UserServiceGetUserProcedure),
+		Features:   set(),
+	}, nil))
+	if err := registry.Refresh(t.Context()); err != nil {
+		t.Fatalf("Refresh() error = %v", err)
+	}
+
+	if err := registry.Check(userv1connect.UserServiceGetUserProcedure, &userv1.GetUserRequest{}); err != nil {
+		t.Errorf("Check(GetUser) error = %v, want nil", err)
+	}
+	err := registry.Check(userv1connect.UserServiceRevokeConsentProcedure, &userv1.RevokeConsentRequest{})
+	if connect.CodeOf(err) != connect.CodeUnavailable {
+		t.Errorf("Check(RevokeConsent) code = %v, want Unavailable", connect.CodeOf(err))
+	}
+	// Procedures of other services are not gated.
+	if err := registry.Check("/product.v1.ProductService/GetProduct", nil); err != nil {
+		t.Errorf("Check(other service) error = %v, want nil", err)
+	}
+	if registry.Version() != "v1.0.0" {
+		t.Errorf("Version() = %q, want v1.0.0", registry.Version())
+	}
+}
+
+func TestRegistry_GatesOptionalFields(t *testing.T) {
+	registry := newTestRegistry(staticFetcher(&capability.Info{
+		Version:    "v1.0.0",
+		Procedures: set(userv1connect.UserServiceListConsentsProcedure),
+		Features:   set(),
+	}, nil))
+	if err := registry.Refresh(t.Context()); err != nil {
+		t.Fatalf("Refresh() error = %v", err)
+	}
+
+	if err := registry.Check(userv1connect.UserServiceListConsentsProcedure, &userv1.ListConsentsRequest{UserId: "u"}); err != nil {
+		t.Errorf("Check() without option error = %v, want nil", err)
+	}
+	err := registry.Check(userv1connect.UserServiceListConsentsProcedure, &userv1.ListConsentsRequest{UserId: "u", IncludeRevoked: true})
+	if connect.CodeOf(err) != connect.CodeUnavailable {
+		t.Errorf("Check() with unsupported option code = %v, want Unavailable", connect.CodeOf(err))
+	}
+}
+
+func TestRegistry_RefreshFailureKeepsLastKnown(t *testing.T) {
+	info := &capability.Info{
+		Version:    "v1.0.0",
+		Procedures: set(userv1connect.UserServiceGetUserProcedure),
+	}
+	var fail bool
+	registry := newTestRegistry(func(ctx context.Context) (*capability.Info, error) {
+		if fail {
+			return nil, errors.New("connection refused")
+		}
+		return info, nil
+	})
+
+	if err := registry.Refresh(t.Context()); err != nil {
+		t.Fatalf("Refresh() error = %v", err)
+	}
+	fail = true
+	if err := registry.Refresh(t.Context()); err == nil {
+		t.Fatal("Refresh() error = nil, want fetch error")
+	}
+	if registry.Supports(userv1connect.UserServiceRevokeConsentProcedure) {
+		t.Error("Supports() = true after failed refresh, want last known capabilities kept")
+	}
+}
+
+func TestRegistry_StartAndClose(t *testing.T) {
+	fetched := make(chan struct{}, 1)
+	registry := newTestRegistry(func(ctx context.Context) (*capability.Info, error) {
+		select {
+		case fetched <- struct{}{}:
+		default:
+		}
+		return &capability.Info{Version: "v2.0.0"}, nil
+	})
+
+	registry.Start(t.Context())
+	select {
+	case <-fetched:
+	case <-time.After(time.Second):
+		t.Fatal("expected initial fetch on Start")
+	}
+	registry.Close()
+	registry.Close()
+}
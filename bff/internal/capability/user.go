@@ -0,0 +1,59 @@
+package capability
+
+import (
+	"context"
+
+	"connectrpc.com/connect"
+
+	userv1 "github.com/daisuke8000/example-ec-platform/gen/user/v1"
+	"github.com/daisuke8000/example-ec-platform/gen/user/v1/userv1connect"
+)
+
+// UserServiceFetcher returns a Fetcher backed by UserService.GetServerInfo.
+func UserServiceFetcher(client userv1connect.UserServiceClient) Fetcher {
+	return func(ctx context.Context) (*Info, error) {
+		resp, err := client.GetServerInfo(ctx, connect.NewRequest(&userv1.GetServerInfoRequest{}))
+		if err != nil {
+			if connect.CodeOf(err) == connect.CodeUnimplemented {
+				return nil, nil
+			}
+			return nil, err
+		}
+		return &Info{
+			Version:    resp.Msg.GetVersion(),
+			Procedures: toSet(resp.Msg.GetProcedures()),
+			Features:   toSet(resp.Msg.GetFeatures()),
+		}, nil
+	}
+}
+
+// UserServiceFieldGates returns the optional User Service request fields that
+// require a backend feature.
+func UserServiceFieldGates() []FieldGate {
+	return []FieldGate{
+		{
+			Procedure: userv1connect.UserServiceListUsersProcedure,
+			Feature:   "list_users.include_deleted",
+			Uses: func(msg any) bool {
+				req, ok := msg.(*userv1.ListUsersRequest)
+				return ok && req.GetIncludeDeleted()
+			},
+		},
+		{
+			Procedure: userv1connect.UserServiceListConsentsProcedure,
+			Feature:   "list_consents.include_revoked",
+			Uses: func(msg any) bool {
+				req, ok := msg.(*userv1.ListConsentsRequest)
+				return ok && req.GetIncludeRevoked()
+			},
+		},
+	}
+}
+
+func toSet(values []string) map[string]struct{} {
+	set := make(map[string]struct{}, len(values))
+	for _, v := range values {
+		set[v] = struct{}{}
+	}
+	return set
+}
@@ -0,0 +1,195 @@
+// Package capture records sanitized request/response pairs for selected
+// users or procedures so production issues can be replayed against staging.
+package capture
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"log/slog"
+	"strings"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+
+	pkgmw "github.com/daisuke8000/example-ec-platform/pkg/connect/middleware"
+)
+
+// ConsentHeader must be "true" on a request for it to be captured when
+// consent is required.
+const ConsentHeader = "X-Capture-Consent"
+
+// redacted replaces sensitive string values.
+const redacted = "REDACTED"
+
+// Record is a captured request/response pair. Request and Response hold the
+// sanitized messages in Connect JSON form so they can be replayed as-is.
+type Record struct {
+	ID           string          `json:"id"`
+	CapturedAt   time.Time       `json:"captured_at"`
+	Procedure    string          `json:"procedure"`
+	UserID       string          `json:"user_id"`
+	Duration     time.Duration   `json:"duration"`
+	Request      json.RawMessage `json:"request,omitempty"`
+	Response     json.RawMessage `json:"response,omitempty"`
+	ErrorCode    string          `json:"error_code,omitempty"`
+	ErrorMessage string          `json:"error_message,omitempty"`
+}
+
+// Store persists captured records.
+type Store interface {
+	Save(ctx context.Context, rec Record) error
+}
+
+// Config selects which requests are captured. A request is captured when its
+// caller is in UserIDs or its procedure is in Procedures.
+type Config struct {
+	UserIDs    []string
+	Procedures []string
+
+	// RequireConsent limits capture to requests carrying ConsentHeader.
+	RequireConsent bool
+}
+
+// Recorder captures matching requests into a Store.
+type Recorder struct {
+	store          Store
+	userIDs        map[string]struct{}
+	procedures     map[string]struct{}
+	requireConsent bool
+	logger         *slog.Logger
+	now            func() time.Time
+}
+
+// NewRecorder creates a recorder.
+func NewRecorder(store Store, cfg Config, logger *slog.Logger) *Recorder {
+	r := &Recorder{
+		store:          store,
+		userIDs:        make(map[string]struct{}, len(cfg.UserIDs)),
+		procedures:     make(map[string]struct{}, len(cfg.Procedures)),
+		requireConsent: cfg.RequireConsent,
+		logger:         logger,
+		now:            time.Now,
+	}
+	for _, id := range cfg.UserIDs {
+		r.userIDs[id] = struct{}{}
+	}
+	for _, p := range cfg.Procedures {
+		r.procedures[p] = struct{}{}
+	}
+	return r
+}
+
+func (r *Recorder) matches(req connect.AnyRequest, userID string) bool {
+	if r.requireConsent && req.Header().Get(ConsentHeader) != "true" {
+		return false
+	}
+	if _, ok := r.procedures[req.Spec().Procedure]; ok {
+		return true
+	}
+	if userID == "" {
+		return false
+	}
+	_, ok := r.userIDs[userID]
+	return ok
+}
+
+// Interceptor returns a server-side interceptor that captures matching
+// requests. It must run after authentication so callers can be matched by
+// user ID. Capture failures are logged and never affect the response.
+func (r *Recorder) Interceptor() connect.UnaryInterceptorFunc {
+	return func(next connect.UnaryFunc) connect.UnaryFunc {
+		return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+			userID := pkgmw.GetUserID(ctx)
+			if req.Spec().IsClient || !r.matches(req, userID) {
+				return next(ctx, req)
+			}
+
+			start := r.now()
+			resp, err := next(ctx, req)
+
+			rec := Record{
+				ID:         uuid.NewString(),
+				CapturedAt: start.UTC(),
+				Procedure:  req.Spec().Procedure,
+				UserID:     userID,
+				Duration:   r.now().Sub(start),
+				Request:    sanitize(req.Any()),
+			}
+			if err != nil {
+				rec.ErrorCode = connect.CodeOf(err).String()
+				if connectErr, ok := err.(*connect.Error); ok {
+					rec.ErrorMessage = connectErr.Message()
+				}
+			} else if resp != nil {
+				rec.Response = sanitize(resp.Any())
+			}
+
+			if saveErr := r.store.Save(ctx, rec); saveErr != nil {
+				r.logger.WarnContext(ctx, "failed to save capture",
+					slog.String("procedure", rec.Procedure),
+					slog.String("error", saveErr.Error()),
+				)
+			}
+			return resp, err
+		}
+	}
+}
+
+// sanitize encodes msg as Connect JSON with credentials and personal data
+// replaced. Emails are replaced with a stable placeholder address so replayed
+// requests stay valid and records for the same address can be correlated.
+func sanitize(msg any) json.RawMessage {
+	m, ok := msg.(proto.Message)
+	if !ok {
+		return nil
+	}
+	raw, err := protojson.Marshal(m)
+	if err != nil {
+		return nil
+	}
+	var v any
+	if err := json.Unmarshal(raw, &v); err != nil {
+		return nil
+	}
+	out, err := json.Marshal(redact(v, ""))
+	if err != nil {
+		return nil
+	}
+	return out
+}
+
+func redact(v any, key string) any {
+	switch val := v.(type) {
+	case map[string]any:
+		for k, field := range val {
+			val[k] = redact(field, k)
+		}
+		return val
+	case []any:
+		for i, item := range val {
+			val[i] = redact(item, key)
+		}
+		return val
+	case string:
+		k := strings.ToLower(key)
+		switch {
+		case strings.HasSuffix(k, "pagetoken"):
+			// Pagination cursors are opaque but not credentials.
+			return val
+		case strings.Contains(k, "email"):
+			sum := sha256.Sum256([]byte(strings.ToLower(val)))
+			return "redacted+" + hex.EncodeToString(sum[:6]) + "@example.invalid"
+		case strings.Contains(k, "password"), strings.Contains(k, "token"),
+			strings.Contains(k, "secret"), strings.Contains(k, "phone"):
+			return redacted
+		}
+		return val
+	default:
+		return val
+	}
+}
@@ -0,0 +1,98 @@
+package capture
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"os"
+	"strings"
+	"testing"
+
+	"connectrpc.com/connect"
+
+	userv1 "github.com/daisuke8000/example-ec-platform/gen/user/v1"
+	pkgmw "github.com/daisuke8000/example-ec-platform/pkg/connect/middleware"
+)
+
+type memoryStore struct {
+	records []Record
+}
+
+func (s *memoryStore) Save(ctx context.Context, rec Record) error {
+	s.records = append(s.records, rec)
+	return nil
+}
+
+func newTestRecorder(store Store, cfg Config) *Recorder {
+	logger := slog.New(slog.NewTextHandler(os.Stdout, &slog.HandlerOptions{Level: slog.LevelError}))
+	return NewRecorder(store, cfg, logger)
+}
+
+func TestRecorder_CapturesConfiguredUser(t *testing.T) {
+	store := &memoryStore{}
+	r := newTestRecorder(store, Config{UserIDs: []string{"user-123"}})
+
+	handler := r.Interceptor()(func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+		return connect.NewResponse(&userv1.VerifyPasswordResponse{UserId: "user-123"}), nil
+	})
+
+	req := connect.NewRequest(&userv1.VerifyPasswordRequest{Email: "Alice@Example.com", Password: "hunter2"})
+	if _, err := handler(pkgmw.WithUserID(context.Background(), "user-123"), req); err != nil {
+		t.Fatalf("handler() error = %v", err)
+	}
+	if _, err := handler(pkgmw.WithUserID(context.Background(), "someone-else"), req); err != nil {
+		t.Fatalf("handler() error = %v", err)
+	}
+
+	if len(store.records) != 1 {
+		t.Fatalf("captured %d records, want 1", len(store.records))
+	}
+	rec := store.records[0]
+	if rec.UserID != "user-123" {
+		t.Errorf("UserID = %q, want user-123", rec.UserID)
+	}
+
+	body := string(rec.Request)
+	if strings.Contains(body, "hunter2") || strings.Contains(strings.ToLower(body), "alice@example.com") {
+		t.Errorf("Request = %s, want credentials and email redacted", body)
+	}
+	var fields map[string]string
+	if err := json.Unmarshal(rec.Request, &fields); err != nil {
+		t.Fatalf("Request is not JSON: %v", err)
+	}
+	if fields["password"] != redacted {
+		t.Errorf("password = %q, want %q", fields["password"], redacted)
+	}
+	if !strings.HasSuffix(fields["email"], "@example.invalid") {
+		t.Errorf("email = %q, want placeholder address", fields["email"])
+	}
+	if !strings.Contains(string(rec.Response), "user-123") {
+		t.Errorf("Response = %s, want user ID kept", rec.Response)
+	}
+}
+
+func TestRecorder_RequiresConsent(t *testing.T) {
+	store := &memoryStore{}
+	r := newTestRecorder(store, Config{UserIDs: []string{"user-123"}, RequireConsent: true})
+
+	handler := r.Interceptor()(func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+		return nil, connect.NewError(connect.CodeNotFound, errors.New("user not found"))
+	})
+	ctx := pkgmw.WithUserID(context.Background(), "user-123")
+
+	handler(ctx, connect.NewRequest(&userv1.GetUserRequest{Id: "missing"}))
+	if len(store.records) != 0 {
+		t.Fatalf("captured %d records without consent, want 0", len(store.records))
+	}
+
+	req := connect.NewRequest(&userv1.GetUserRequest{Id: "missing"})
+	req.Header().Set(ConsentHeader, "true")
+	handler(ctx, req)
+	if len(store.records) != 1 {
+		t.Fatalf("captured %d records with consent, want 1", len(store.records))
+	}
+	if store.records[0].ErrorCode != connect.CodeNotFound.String() {
+		t.Errorf("ErrorCode = %q, want %q", store.records[0].ErrorCode, connect.CodeNotFound.String())
+	}
+}
@@ -0,0 +1,92 @@
+package capture
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+)
+
+// Result is the outcome of replaying a record.
+type Result struct {
+	Record Record
+
+	// ErrorCode is the Connect error code returned by the target, or empty
+	// on success.
+	ErrorCode string
+
+	// Response is the response body on success.
+	Response json.RawMessage
+}
+
+// Matches reports whether the replay ended with the same error code as the
+// captured request.
+func (r Result) Matches() bool {
+	return r.ErrorCode == r.Record.ErrorCode
+}
+
+// Replayer sends captured requests to a target environment using the
+// Connect unary JSON protocol, so no generated types are needed.
+type Replayer struct {
+	client  *http.Client
+	baseURL string
+	token   string
+}
+
+// NewReplayer creates a replayer. token, if set, is sent as a bearer token;
+// captured requests never include the original caller's credentials.
+func NewReplayer(client *http.Client, baseURL, token string) *Replayer {
+	return &Replayer{
+		client:  client,
+		baseURL: strings.TrimRight(baseURL, "/"),
+		token:   token,
+	}
+}
+
+// Replay sends rec's request to the target.
+func (r *Replayer) Replay(ctx context.Context, rec Record) (*Result, error) {
+	body := rec.Request
+	if len(body) == 0 {
+		body = json.RawMessage("{}")
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, r.baseURL+rec.Procedure, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Connect-Protocol-Version", "1")
+	if r.token != "" {
+		req.Header.Set("Authorization", "Bearer "+r.token)
+	}
+
+	resp, err := r.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("replay %s: %w", rec.ID, err)
+	}
+	defer resp.Body.Close()
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("replay %s: %w", rec.ID, err)
+	}
+
+	result := &Result{Record: rec}
+	if resp.StatusCode == http.StatusOK {
+		result.Response = respBody
+		return result, nil
+	}
+
+	// Connect unary errors are JSON objects with a "code" field.
+	var connectErr struct {
+		Code string `json:"code"`
+	}
+	if err := json.Unmarshal(respBody, &connectErr); err != nil || connectErr.Code == "" {
+		return nil, fmt.Errorf("replay %s: unexpected HTTP status %d", rec.ID, resp.StatusCode)
+	}
+	result.ErrorCode = connectErr.Code
+	return result, nil
+}
@@ -0,0 +1,204 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"connectrpc.com/connect"
+)
+
+// BreakerState is the state of a circuit breaker.
+type BreakerState int
+
+const (
+	// BreakerClosed lets every request through.
+	BreakerClosed BreakerState = iota
+	// BreakerHalfOpen lets a limited number of probe requests through.
+	BreakerHalfOpen
+	// BreakerOpen rejects requests without contacting the backend.
+	BreakerOpen
+)
+
+func (s BreakerState) String() string {
+	switch s {
+	case BreakerClosed:
+		return "closed"
+	case BreakerHalfOpen:
+		return "half_open"
+	case BreakerOpen:
+		return "open"
+	default:
+		return "unknown"
+	}
+}
+
+// BreakerConfig holds circuit breaker configuration.
+type BreakerConfig struct {
+	// Name identifies the backend in errors and state change callbacks.
+	Name string
+
+	// FailureThreshold is the number of consecutive failures that opens the breaker.
+	FailureThreshold int
+
+	// OpenTimeout is how long the breaker stays open before probing.
+	OpenTimeout time.Duration
+
+	// HalfOpenProbes is the number of successful probes required to close
+	// the breaker. It also bounds concurrent probes.
+	HalfOpenProbes int
+
+	// OnStateChange, if set, is called after every transition.
+	OnStateChange func(name string, from, to BreakerState)
+}
+
+// CircuitBreaker stops sending requests to a backend after consecutive
+// failures, so callers fail fast instead of waiting on timeouts.
+//
+// Only failures that indicate an unhealthy backend are counted: transport
+// errors, timeouts and Unavailable/Internal/Unknown responses. Client errors
+// such as NotFound or InvalidArgument count as successes.
+type CircuitBreaker struct {
+	cfg BreakerConfig
+	now func() time.Time
+
+	mu             sync.Mutex
+	state          BreakerState
+	failures       int
+	openedAt       time.Time
+	probes         int
+	probeSuccesses int
+}
+
+// NewCircuitBreaker creates a closed circuit breaker.
+func NewCircuitBreaker(cfg BreakerConfig) *CircuitBreaker {
+	if cfg.FailureThreshold < 1 {
+		cfg.FailureThreshold = 1
+	}
+	if cfg.HalfOpenProbes < 1 {
+		cfg.HalfOpenProbes = 1
+	}
+	return &CircuitBreaker{
+		cfg: cfg,
+		now: time.Now,
+	}
+}
+
+// State returns the current state.
+func (b *CircuitBreaker) State() BreakerState {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.maybeHalfOpen()
+	return b.state
+}
+
+// Allow reports whether a request may be sent. Every allowed request must be
+// followed by a call to Record.
+func (b *CircuitBreaker) Allow() error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.maybeHalfOpen()
+	switch b.state {
+	case BreakerOpen:
+		return connect.NewError(connect.CodeUnavailable,
+			fmt.Errorf("%s is temporarily unavailable", b.cfg.Name))
+	case BreakerHalfOpen:
+		if b.probes >= b.cfg.HalfOpenProbes {
+			return connect.NewError(connect.CodeUnavailable,
+				fmt.Errorf("%s is temporarily unavailable", b.cfg.Name))
+		}
+		b.probes++
+	}
+	return nil
+}
+
+// Record reports the outcome of an allowed request.
+func (b *CircuitBreaker) Record(err error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	failed := isBackendFailure(err)
+	switch b.state {
+	case BreakerClosed:
+		if !failed {
+			b.failures = 0
+			return
+		}
+		b.failures++
+		if b.failures >= b.cfg.FailureThreshold {
+			b.transition(BreakerOpen)
+		}
+	case BreakerHalfOpen:
+		if failed {
+			b.transition(BreakerOpen)
+			return
+		}
+		b.probeSuccesses++
+		if b.probeSuccesses >= b.cfg.HalfOpenProbes {
+			b.transition(BreakerClosed)
+		}
+	}
+}
+
+// Interceptor returns a client-side interceptor guarding unary calls.
+func (b *CircuitBreaker) Interceptor() connect.UnaryInterceptorFunc {
+	return func(next connect.UnaryFunc) connect.UnaryFunc {
+		return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+			if !req.Spec().IsClient {
+				return next(ctx, req)
+			}
+			if err := b.Allow(); err != nil {
+				return nil, err
+			}
+			resp, err := next(ctx, req)
+			if ctx.Err() == context.Canceled {
+				// The caller gave up; this says nothing about the backend.
+				b.Record(nil)
+			} else {
+				b.Record(err)
+			}
+			return resp, err
+		}
+	}
+}
+
+// maybeHalfOpen must be called with mu held.
+func (b *CircuitBreaker) maybeHalfOpen() {
+	if b.state == BreakerOpen && b.now().Sub(b.openedAt) >= b.cfg.OpenTimeout {
+		b.transition(BreakerHalfOpen)
+	}
+}
+
+// transition must be called with mu held.
+func (b *CircuitBreaker) transition(to BreakerState) {
+	from := b.state
+	b.state = to
+	b.failures = 0
+	b.probes = 0
+	b.probeSuccesses = 0
+	if to == BreakerOpen {
+		b.openedAt = b.now()
+	}
+	if b.cfg.OnStateChange != nil {
+		b.cfg.OnStateChange(b.cfg.Name, from, to)
+	}
+}
+
+func isBackendFailure(err error) bool {
+	if err == nil {
+		return false
+	}
+	if errors.Is(err, context.DeadlineExceeded) {
+		return true
+	}
+	switch connect.CodeOf(err) {
+	case connect.CodeUnavailable, connect.CodeDeadlineExceeded,
+		connect.CodeInternal, connect.CodeUnknown:
+		return true
+	default:
+		return false
+	}
+}
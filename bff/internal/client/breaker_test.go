@@ -0,0 +1,114 @@
+package client
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+)
+
+func newTestBreaker(now *time.Time, transitions *[]string) *CircuitBreaker {
+	b := NewCircuitBreaker(BreakerConfig{
+		Name:             "user-service",
+		FailureThreshold: 3,
+		OpenTimeout:      10 * time.Second,
+		HalfOpenProbes:   1,
+		OnStateChange: func(name string, from, to BreakerState) {
+			*transitions = append(*transitions, from.String()+"->"+to.String())
+		},
+	})
+	b.now = func() time.Time { return *now }
+	return b
+}
+
+func TestCircuitBreaker_OpensAfterConsecutiveFailures(t *testing.T) {
+	now := time.Now()
+	var transitions []string
+	b := newTestBreaker(&now, &transitions)
+	unavailable := connect.NewError(connect.CodeUnavailable, errors.New("connection refused"))
+
+	for i := 0; i < 2; i++ {
+		if err := b.Allow(); err != nil {
+			t.Fatalf("request %d rejected while closed: %v", i+1, err)
+		}
+		b.Record(unavailable)
+	}
+	// A success resets the failure count.
+	b.Allow()
+	b.Record(nil)
+	for i := 0; i < 3; i++ {
+		b.Allow()
+		b.Record(unavailable)
+	}
+
+	if b.State() != BreakerOpen {
+		t.Fatalf("State() = %v, want open", b.State())
+	}
+	if err := b.Allow(); connect.CodeOf(err) != connect.CodeUnavailable {
+		t.Errorf("Allow() while open code = %v, want Unavailable", connect.CodeOf(err))
+	}
+	if len(transitions) != 1 || transitions[0] != "closed->open" {
+		t.Errorf("transitions = %v, want [closed->open]", transitions)
+	}
+}
+
+func TestCircuitBreaker_IgnoresClientErrors(t *testing.T) {
+	now := time.Now()
+	var transitions []string
+	b := newTestBreaker(&now, &transitions)
+
+	for i := 0; i < 5; i++ {
+		b.Allow()
+		b.Record(connect.NewError(connect.CodeNotFound, errors.New("user not found")))
+	}
+	if b.State() != BreakerClosed {
+		t.Errorf("State() = %v, want closed", b.State())
+	}
+}
+
+func TestCircuitBreaker_HalfOpenProbe(t *testing.T) {
+	now := time.Now()
+	var transitions []string
+	b := newTestBreaker(&now, &transitions)
+	timeout := connect.NewError(connect.CodeDeadlineExceeded, errors.New("timeout"))
+
+	for i := 0; i < 3; i++ {
+		b.Allow()
+		b.Record(timeout)
+	}
+
+	now = now.Add(10 * time.Second)
+	if b.State() != BreakerHalfOpen {
+		t.Fatalf("State() after open timeout = %v, want half_open", b.State())
+	}
+	if err := b.Allow(); err != nil {
+		t.Fatalf("probe rejected: %v", err)
+	}
+	if err := b.Allow(); err == nil {
+		t.Error("second concurrent probe allowed, want rejection")
+	}
+
+	// A failed probe re-opens the breaker.
+	b.Record(timeout)
+	if b.State() != BreakerOpen {
+		t.Fatalf("State() after failed probe = %v, want open", b.State())
+	}
+
+	now = now.Add(10 * time.Second)
+	b.Allow()
+	b.Record(nil)
+	if b.State() != BreakerClosed {
+		t.Errorf("State() after successful probe = %v, want closed", b.State())
+	}
+
+	want := []string{"closed->open", "open->half_open", "half_open->open", "open->half_open", "half_open->closed"}
+	if len(transitions) != len(want) {
+		t.Fatalf("transitions = %v, want %v", transitions, want)
+	}
+	for i := range want {
+		if transitions[i] != want[i] {
+			t.Errorf("transitions[%d] = %q, want %q", i, transitions[i], want[i])
+		}
+	}
+}
@@ -0,0 +1,150 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"hash/fnv"
+	"math/rand/v2"
+	"net/http"
+	"net/url"
+	"time"
+
+	"connectrpc.com/connect"
+
+	pkgmw "github.com/daisuke8000/example-ec-platform/pkg/connect/middleware"
+)
+
+// Target is the backend release a call is routed to.
+type Target string
+
+const (
+	TargetStable Target = "stable"
+	TargetCanary Target = "canary"
+)
+
+// ParseTarget parses "stable" or "canary".
+func ParseTarget(s string) (Target, error) {
+	switch t := Target(s); t {
+	case TargetStable, TargetCanary:
+		return t, nil
+	}
+	return "", fmt.Errorf("unknown backend target %q", s)
+}
+
+type targetKey struct{}
+
+// WithTarget pins backend calls made with ctx to t, overriding the canary
+// weight. Used for internal testers.
+func WithTarget(ctx context.Context, t Target) context.Context {
+	return context.WithValue(ctx, targetKey{}, t)
+}
+
+func targetFromContext(ctx context.Context) (Target, bool) {
+	t, ok := ctx.Value(targetKey{}).(Target)
+	return t, ok
+}
+
+// CanaryConfig holds canary routing configuration for one backend.
+type CanaryConfig struct {
+	// Name identifies the backend in metrics, e.g. "user-service".
+	Name string
+
+	// URL is the base URL of the canary release.
+	URL string
+
+	// Weight is the percentage (0-100) of callers routed to the canary.
+	Weight int
+
+	// OnCall, if set, is called after every call with its target, Connect
+	// error code ("ok" on success) and duration.
+	OnCall func(ctx context.Context, name string, target Target, code string, duration time.Duration)
+}
+
+// CanaryRouter splits calls to a backend between its stable and canary
+// releases. Authenticated callers are assigned by a hash of their user ID,
+// so each user consistently sees one release; anonymous calls are assigned
+// at random.
+type CanaryRouter struct {
+	cfg    CanaryConfig
+	target *url.URL
+}
+
+func NewCanaryRouter(cfg CanaryConfig) (*CanaryRouter, error) {
+	if cfg.Weight < 0 || cfg.Weight > 100 {
+		return nil, errors.New("canary weight must be between 0 and 100")
+	}
+	target, err := url.Parse(cfg.URL)
+	if err != nil || target.Host == "" {
+		return nil, fmt.Errorf("invalid canary URL %q", cfg.URL)
+	}
+	return &CanaryRouter{cfg: cfg, target: target}, nil
+}
+
+// choose returns the target of a call made with ctx.
+func (r *CanaryRouter) choose(ctx context.Context) Target {
+	if t, ok := targetFromContext(ctx); ok {
+		return t
+	}
+
+	var bucket int
+	if userID := pkgmw.GetUserID(ctx); userID != "" {
+		h := fnv.New32a()
+		h.Write([]byte(r.cfg.Name))
+		h.Write([]byte(userID))
+		bucket = int(h.Sum32() % 100)
+	} else {
+		bucket = rand.IntN(100)
+	}
+	if bucket < r.cfg.Weight {
+		return TargetCanary
+	}
+	return TargetStable
+}
+
+// Interceptor returns a client interceptor that chooses the target of each
+// call and reports its outcome. It must run outside retries so that all
+// attempts of a call go to the same release.
+func (r *CanaryRouter) Interceptor() connect.UnaryInterceptorFunc {
+	return func(next connect.UnaryFunc) connect.UnaryFunc {
+		return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+			target := r.choose(ctx)
+			ctx = WithTarget(ctx, target)
+
+			start := time.Now()
+			resp, err := next(ctx, req)
+			if r.cfg.OnCall != nil {
+				code := "ok"
+				if err != nil {
+					code = connect.CodeOf(err).String()
+				}
+				r.cfg.OnCall(ctx, r.cfg.Name, target, code, time.Since(start))
+			}
+			return resp, err
+		}
+	}
+}
+
+// Transport returns a transport sending calls routed to the canary through
+// canary, with the host rewritten to the canary URL, and all other calls
+// through stable.
+func (r *CanaryRouter) Transport(stable, canary http.RoundTripper) http.RoundTripper {
+	return &canaryTransport{router: r, stable: stable, canary: canary}
+}
+
+type canaryTransport struct {
+	router *CanaryRouter
+	stable http.RoundTripper
+	canary http.RoundTripper
+}
+
+func (t *canaryTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if target, _ := targetFromContext(req.Context()); target != TargetCanary {
+		return t.stable.RoundTrip(req)
+	}
+	out := req.Clone(req.Context())
+	out.URL.Scheme = t.router.target.Scheme
+	out.URL.Host = t.router.target.Host
+	out.Host = t.router.target.Host
+	return t.canary.RoundTrip(out)
+}
@@ -0,0 +1,154 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+
+	userv1 "github.com/daisuke8000/example-ec-platform/gen/user/v1"
+	pkgmw "github.com/daisuke8000/example-ec-platform/pkg/connect/middleware"
+)
+
+func newTestCanaryRouter(t *testing.T, weight int) *CanaryRouter {
+	t.Helper()
+	router, err := NewCanaryRouter(CanaryConfig{Name: "user-service", URL: "http://canary.invalid", Weight: weight})
+	if err != nil {
+		t.Fatalf("NewCanaryRouter() error = %v", err)
+	}
+	return router
+}
+
+func TestNewCanaryRouter_InvalidConfig(t *testing.T) {
+	for _, cfg := range []CanaryConfig{
+		{URL: "http://canary:50051", Weight: -1},
+		{URL: "http://canary:50051", Weight: 101},
+		{URL: "canary", Weight: 10},
+	} {
+		if _, err := NewCanaryRouter(cfg); err == nil {
+			t.Errorf("NewCanaryRouter(%+v) error = nil, want error", cfg)
+		}
+	}
+}
+
+func TestCanaryRouter_Weight(t *testing.T) {
+	tests := []struct {
+		weight int
+		want   Target
+	}{
+		{weight: 0, want: TargetStable},
+		{weight: 100, want: TargetCanary},
+	}
+
+	for _, tt := range tests {
+		t.Run(fmt.Sprintf("weight_%d", tt.weight), func(t *testing.T) {
+			router := newTestCanaryRouter(t, tt.weight)
+			for i := range 50 {
+				ctx := pkgmw.WithUserID(context.Background(), fmt.Sprintf("user-%d", i))
+				if got := router.choose(ctx); got != tt.want {
+					t.Fatalf("choose() = %s, want %s", got, tt.want)
+				}
+			}
+			if got := router.choose(context.Background()); got != tt.want {
+				t.Errorf("choose() for anonymous caller = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCanaryRouter_StickyPerUser(t *testing.T) {
+	router := newTestCanaryRouter(t, 50)
+
+	canary := 0
+	for i := range 200 {
+		ctx := pkgmw.WithUserID(context.Background(), fmt.Sprintf("user-%d", i))
+		first := router.choose(ctx)
+		for range 5 {
+			if got := router.choose(ctx); got != first {
+				t.Fatalf("user-%d: choose() = %s, previously %s", i, got, first)
+			}
+		}
+		if first == TargetCanary {
+			canary++
+		}
+	}
+	if canary < 50 || canary > 150 {
+		t.Errorf("%d of 200 users routed to the canary, want about half", canary)
+	}
+}
+
+func TestCanaryRouter_PinnedTarget(t *testing.T) {
+	router := newTestCanaryRouter(t, 0)
+	ctx := WithTarget(context.Background(), TargetCanary)
+	if got := router.choose(ctx); got != TargetCanary {
+		t.Errorf("choose() = %s, want pinned %s", got, TargetCanary)
+	}
+}
+
+func TestCanaryRouter_InterceptorReportsOutcome(t *testing.T) {
+	type call struct {
+		target Target
+		code   string
+	}
+	var calls []call
+	router, err := NewCanaryRouter(CanaryConfig{
+		Name:   "user-service",
+		URL:    "http://canary.invalid",
+		Weight: 100,
+		OnCall: func(_ context.Context, _ string, target Target, code string, _ time.Duration) {
+			calls = append(calls, call{target, code})
+		},
+	})
+	if err != nil {
+		t.Fatalf("NewCanaryRouter() error = %v", err)
+	}
+
+	var seen Target
+	next := connect.UnaryFunc(func(ctx context.Context, _ connect.AnyRequest) (connect.AnyResponse, error) {
+		seen, _ = targetFromContext(ctx)
+		return nil, connect.NewError(connect.CodeUnavailable, errors.New("down"))
+	})
+	req := connect.NewRequest(&userv1.GetUserRequest{})
+	if _, err := router.Interceptor()(next)(context.Background(), req); err == nil {
+		t.Fatal("expected error")
+	}
+
+	if seen != TargetCanary {
+		t.Errorf("target in context = %q, want %s", seen, TargetCanary)
+	}
+	if len(calls) != 1 || calls[0] != (call{TargetCanary, "unavailable"}) {
+		t.Errorf("OnCall calls = %+v, want one canary call with code unavailable", calls)
+	}
+}
+
+func TestCanaryTransport_RewritesCanaryHost(t *testing.T) {
+	stable := newRegionServer(t, "stable")
+	canary := newRegionServer(t, "canary")
+
+	router, err := NewCanaryRouter(CanaryConfig{Name: "user-service", URL: canary.URL, Weight: 0})
+	if err != nil {
+		t.Fatalf("NewCanaryRouter() error = %v", err)
+	}
+	transport := router.Transport(http.DefaultTransport, http.DefaultTransport)
+
+	for _, target := range []Target{TargetStable, TargetCanary} {
+		ctx := WithTarget(context.Background(), target)
+		req, _ := http.NewRequestWithContext(ctx, http.MethodPost, stable.URL+"/user.v1.UserService/GetUser", strings.NewReader("hello"))
+		resp, err := transport.RoundTrip(req)
+		if err != nil {
+			t.Fatalf("RoundTrip() error = %v", err)
+		}
+		body, _ := io.ReadAll(resp.Body)
+		resp.Body.Close()
+
+		if want := string(target) + ":hello"; string(body) != want {
+			t.Errorf("response = %q, want %q", body, want)
+		}
+	}
+}
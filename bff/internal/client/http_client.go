@@ -24,3 +24,11 @@ func NewH2CClient(timeout time.Duration) *http.Client {
 		},
 	}
 }
+
+// NewTLSClient creates an HTTP/2 client for services served over TLS,
+// presenting the client certificate in tlsConfig for mutual TLS.
+func NewTLSClient(tlsConfig *tls.Config) *http.Client {
+	return &http.Client{
+		Transport: &http2.Transport{TLSClientConfig: tlsConfig},
+	}
+}
@@ -0,0 +1,31 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+)
+
+type remoteAddrKey struct{}
+
+// WithRemoteAddr sets the client address seen by handlers called through
+// InProcessTransport, so per-client limits apply to the original caller.
+func WithRemoteAddr(ctx context.Context, addr string) context.Context {
+	return context.WithValue(ctx, remoteAddrKey{}, addr)
+}
+
+// InProcessTransport serves requests by calling an http.Handler directly.
+// Responses are buffered, so only unary calls are supported.
+type InProcessTransport struct {
+	Handler http.Handler
+}
+
+func (t InProcessTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if addr, ok := req.Context().Value(remoteAddrKey{}).(string); ok {
+		req = req.Clone(req.Context())
+		req.RemoteAddr = addr
+	}
+	rec := httptest.NewRecorder()
+	t.Handler.ServeHTTP(rec, req)
+	return rec.Result(), nil
+}
@@ -0,0 +1,64 @@
+package client
+
+import (
+	"crypto/tls"
+	"log/slog"
+	"time"
+
+	"connectrpc.com/connect"
+
+	"github.com/daisuke8000/example-ec-platform/gen/product/v1/productv1connect"
+	pkgmw "github.com/daisuke8000/example-ec-platform/pkg/connect/middleware"
+)
+
+type ProductClientConfig struct {
+	BaseURL string
+
+	// Timeout bounds each call; TimeoutOverrides sets it per procedure.
+	Timeout          time.Duration
+	TimeoutOverrides map[string]time.Duration
+	Logger           *slog.Logger
+
+	// Breaker, if set, fails calls fast while the Product Service is unhealthy.
+	Breaker *CircuitBreaker
+
+	// Retry, if set, retries transient failures of calls safe to repeat.
+	Retry *pkgmw.RetryConfig
+
+	// Canary, if set, routes a share of calls to a canary release.
+	Canary *CanaryRouter
+
+	// TLS, if set, calls the service over TLS instead of h2c.
+	TLS *tls.Config
+
+	// ServiceToken, if set, authenticates calls with the BFF's client
+	// credentials access token.
+	ServiceToken *pkgmw.ClientCredentials
+
+	// Propagator sends the user context; nil propagates it unsigned.
+	Propagator *pkgmw.ContextPropagator
+}
+
+// ProductServiceClients are the clients for the APIs served by the Product
+// Service. They share one connection pool, breaker and retry policy.
+type ProductServiceClients struct {
+	Products  productv1connect.ProductServiceClient
+	Inventory productv1connect.InventoryServiceClient
+	Favorites productv1connect.FavoriteServiceClient
+	Workers   productv1connect.WorkerServiceClient
+}
+
+func NewProductServiceClients(cfg ProductClientConfig) ProductServiceClients {
+	httpClient := newBackendClient(cfg.TLS)
+	timeouts := Timeouts{Default: cfg.Timeout, Overrides: cfg.TimeoutOverrides}
+	if cfg.Canary != nil {
+		httpClient.Transport = cfg.Canary.Transport(httpClient.Transport, httpClient.Transport)
+	}
+	opts := connect.WithInterceptors(clientInterceptors(cfg.Canary, cfg.Breaker, cfg.Retry, timeouts, cfg.ServiceToken, cfg.Propagator, cfg.Logger)...)
+	return ProductServiceClients{
+		Products:  productv1connect.NewProductServiceClient(httpClient, cfg.BaseURL, opts),
+		Inventory: productv1connect.NewInventoryServiceClient(httpClient, cfg.BaseURL, opts),
+		Favorites: productv1connect.NewFavoriteServiceClient(httpClient, cfg.BaseURL, opts),
+		Workers:   productv1connect.NewWorkerServiceClient(httpClient, cfg.BaseURL, opts),
+	}
+}
@@ -0,0 +1,143 @@
+package client
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"log/slog"
+	"net"
+	"net/http"
+	"net/url"
+	"sync"
+	"time"
+)
+
+// Endpoint is a backend base URL served from a region.
+type Endpoint struct {
+	Region string
+	URL    string
+}
+
+type endpointState struct {
+	region    string
+	target    *url.URL
+	downUntil time.Time
+}
+
+// RegionalTransport routes requests to the endpoints of a backend, preferring
+// those in the local region. When an endpoint cannot be dialed, the request
+// fails over to the next endpoint and the failed one is skipped until the
+// cooldown elapses. Only dial failures fail over, because the request is
+// known not to have reached the backend; mutations are never replayed after
+// being sent.
+//
+// Request bodies are buffered so they can be resent, which limits this
+// transport to unary and server-streaming calls.
+type RegionalTransport struct {
+	base      http.RoundTripper
+	endpoints []*endpointState
+	cooldown  time.Duration
+	logger    *slog.Logger
+	mu        sync.Mutex
+}
+
+// NewRegionalTransport creates a transport over endpoints, ordered so that
+// endpoints in region come first. Relative order is otherwise preserved.
+func NewRegionalTransport(base http.RoundTripper, region string, endpoints []Endpoint, cooldown time.Duration, logger *slog.Logger) (*RegionalTransport, error) {
+	if len(endpoints) == 0 {
+		return nil, errors.New("at least one endpoint is required")
+	}
+
+	var local, remote []*endpointState
+	for _, ep := range endpoints {
+		target, err := url.Parse(ep.URL)
+		if err != nil {
+			return nil, err
+		}
+		state := &endpointState{region: ep.Region, target: target}
+		if region != "" && ep.Region == region {
+			local = append(local, state)
+		} else {
+			remote = append(remote, state)
+		}
+	}
+
+	return &RegionalTransport{
+		base:      base,
+		endpoints: append(local, remote...),
+		cooldown:  cooldown,
+		logger:    logger,
+	}, nil
+}
+
+// RoundTrip sends req to the first available endpoint.
+func (t *RegionalTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	var body []byte
+	if req.Body != nil && req.Body != http.NoBody {
+		var err error
+		body, err = io.ReadAll(req.Body)
+		req.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var lastErr error
+	for _, ep := range t.candidates() {
+		out := req.Clone(req.Context())
+		out.URL.Scheme = ep.target.Scheme
+		out.URL.Host = ep.target.Host
+		out.Host = ep.target.Host
+		if body != nil {
+			out.Body = io.NopCloser(bytes.NewReader(body))
+			out.ContentLength = int64(len(body))
+		}
+
+		resp, err := t.base.RoundTrip(out)
+		if err == nil {
+			return resp, nil
+		}
+
+		lastErr = err
+		if !isDialError(err) {
+			return nil, err
+		}
+		t.markDown(ep)
+		t.logger.Warn("backend endpoint unreachable, failing over",
+			slog.String("endpoint", ep.target.Host),
+			slog.String("endpoint_region", ep.region),
+			slog.String("error", err.Error()),
+		)
+	}
+	return nil, lastErr
+}
+
+// candidates returns endpoints in preference order, skipping those in
+// cooldown. If every endpoint is in cooldown, all are tried.
+func (t *RegionalTransport) candidates() []*endpointState {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	now := time.Now()
+	available := make([]*endpointState, 0, len(t.endpoints))
+	for _, ep := range t.endpoints {
+		if now.After(ep.downUntil) {
+			available = append(available, ep)
+		}
+	}
+	if len(available) == 0 {
+		return t.endpoints
+	}
+	return available
+}
+
+func (t *RegionalTransport) markDown(ep *endpointState) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	ep.downUntil = time.Now().Add(t.cooldown)
+}
+
+func isDialError(err error) bool {
+	var opErr *net.OpError
+	return errors.As(err, &opErr) && opErr.Op == "dial"
+}
@@ -0,0 +1,107 @@
+package client
+
+import (
+	"io"
+	"log/slog"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+	"time"
+)
+
+func newTestLogger() *slog.Logger {
+	return slog.New(slog.NewTextHandler(os.Stdout, &slog.HandlerOptions{Level: slog.LevelError}))
+}
+
+func newRegionServer(t *testing.T, region string) *httptest.Server {
+	t.Helper()
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		w.Write([]byte(region + ":" + string(body)))
+	}))
+	t.Cleanup(srv.Close)
+	return srv
+}
+
+// closedURL returns a URL nothing is listening on.
+func closedURL(t *testing.T) string {
+	t.Helper()
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("failed to listen: %v", err)
+	}
+	addr := l.Addr().String()
+	l.Close()
+	return "http://" + addr
+}
+
+func doPost(t *testing.T, transport http.RoundTripper, body string) string {
+	t.Helper()
+	client := &http.Client{Transport: transport}
+	resp, err := client.Post("http://backend.invalid/user.v1.UserService/GetUser", "application/json", strings.NewReader(body))
+	if err != nil {
+		t.Fatalf("request failed: %v", err)
+	}
+	defer resp.Body.Close()
+	got, _ := io.ReadAll(resp.Body)
+	return string(got)
+}
+
+func TestRegionalTransport_PrefersLocalRegion(t *testing.T) {
+	east := newRegionServer(t, "us-east-1")
+	west := newRegionServer(t, "us-west-2")
+
+	transport, err := NewRegionalTransport(http.DefaultTransport, "us-west-2", []Endpoint{
+		{Region: "us-east-1", URL: east.URL},
+		{Region: "us-west-2", URL: west.URL},
+	}, time.Minute, newTestLogger())
+	if err != nil {
+		t.Fatalf("NewRegionalTransport() error = %v", err)
+	}
+
+	if got := doPost(t, transport, "hello"); got != "us-west-2:hello" {
+		t.Errorf("response = %q, want served by us-west-2", got)
+	}
+}
+
+func TestRegionalTransport_FailsOverOnDialError(t *testing.T) {
+	east := newRegionServer(t, "us-east-1")
+
+	transport, err := NewRegionalTransport(http.DefaultTransport, "us-west-2", []Endpoint{
+		{Region: "us-west-2", URL: closedURL(t)},
+		{Region: "us-east-1", URL: east.URL},
+	}, time.Minute, newTestLogger())
+	if err != nil {
+		t.Fatalf("NewRegionalTransport() error = %v", err)
+	}
+
+	if got := doPost(t, transport, "payload"); got != "us-east-1:payload" {
+		t.Errorf("response = %q, want failover to us-east-1 with body intact", got)
+	}
+
+	// The failed endpoint is in cooldown and skipped.
+	if candidates := transport.candidates(); len(candidates) != 1 || candidates[0].region != "us-east-1" {
+		t.Errorf("candidates after failover = %d, want only us-east-1", len(candidates))
+	}
+}
+
+func TestRegionalTransport_AllEndpointsDown(t *testing.T) {
+	transport, err := NewRegionalTransport(http.DefaultTransport, "us-west-2", []Endpoint{
+		{Region: "us-west-2", URL: closedURL(t)},
+	}, time.Minute, newTestLogger())
+	if err != nil {
+		t.Fatalf("NewRegionalTransport() error = %v", err)
+	}
+
+	client := &http.Client{Transport: transport}
+	if _, err := client.Get("http://backend.invalid/"); err == nil {
+		t.Error("expected error when every endpoint is unreachable")
+	}
+	// With every endpoint in cooldown, all are still tried.
+	if len(transport.candidates()) != 1 {
+		t.Error("expected endpoints in cooldown to be retried when none are available")
+	}
+}
@@ -0,0 +1,86 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"connectrpc.com/connect"
+)
+
+// TimeoutError reports a backend call that did not complete before its
+// deadline. It is wrapped in a CodeDeadlineExceeded error; aggregating
+// handlers can use errors.As to report which calls timed out.
+type TimeoutError struct {
+	Procedure string
+	Timeout   time.Duration
+}
+
+func (e *TimeoutError) Error() string {
+	if e.Timeout <= 0 {
+		return fmt.Sprintf("%s: deadline exceeded", e.Procedure)
+	}
+	return fmt.Sprintf("%s: no response within %s", e.Procedure, e.Timeout)
+}
+
+// Timeouts are the deadlines applied to outgoing backend calls.
+type Timeouts struct {
+	// Default applies to procedures without an override. Zero means the
+	// caller's deadline, if any, is the only limit.
+	Default time.Duration
+
+	// Overrides maps a procedure (e.g. "/user.v1.UserService/ListUsers")
+	// to its timeout.
+	Overrides map[string]time.Duration
+}
+
+// For returns the timeout for procedure.
+func (t Timeouts) For(procedure string) time.Duration {
+	if d, ok := t.Overrides[procedure]; ok {
+		return d
+	}
+	return t.Default
+}
+
+// Interceptor returns a client interceptor that bounds each call with its
+// timeout. The deadline is derived from the incoming context, so a shorter
+// deadline set by the caller still wins, and Connect forwards the remaining
+// time to the backend. Any failure after the deadline passes is reported as
+// CodeDeadlineExceeded, regardless of how the transport surfaced it.
+func (t Timeouts) Interceptor() connect.UnaryInterceptorFunc {
+	return func(next connect.UnaryFunc) connect.UnaryFunc {
+		return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+			if !req.Spec().IsClient {
+				return next(ctx, req)
+			}
+
+			procedure := req.Spec().Procedure
+			timeout := t.For(procedure)
+			callCtx := ctx
+			if timeout > 0 {
+				var cancel context.CancelFunc
+				callCtx, cancel = context.WithTimeout(ctx, timeout)
+				defer cancel()
+			}
+
+			resp, err := next(callCtx, req)
+			deadline, hasDeadline := callCtx.Deadline()
+			if err == nil || !hasDeadline {
+				return resp, err
+			}
+			// Connect forwards the deadline, so the backend may give up and
+			// answer just before the local deadline fires.
+			if errors.Is(callCtx.Err(), context.DeadlineExceeded) ||
+				connect.CodeOf(err) == connect.CodeDeadlineExceeded {
+				timeoutErr := &TimeoutError{Procedure: procedure, Timeout: timeout}
+				if callerDeadline, ok := ctx.Deadline(); ok && !callerDeadline.After(deadline) {
+					// The caller's own deadline is the one that expired.
+					timeoutErr.Timeout = 0
+				}
+				return nil, connect.NewError(connect.CodeDeadlineExceeded, timeoutErr)
+			}
+			return resp, err
+		}
+	}
+}
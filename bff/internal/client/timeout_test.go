@@ -0,0 +1,84 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+
+	userv1 "github.com/daisuke8000/example-ec-platform/gen/user/v1"
+	"github.com/daisuke8000/example-ec-platform/gen/user/v1/userv1connect"
+)
+
+// slowUserService answers GetUser after delay, or fails once the request's
+// deadline passes.
+type slowUserService struct {
+	userv1connect.UnimplementedUserServiceHandler
+	delay time.Duration
+}
+
+func (s *slowUserService) GetUser(ctx context.Context, req *connect.Request[userv1.GetUserRequest]) (*connect.Response[userv1.GetUserResponse], error) {
+	select {
+	case <-time.After(s.delay):
+		return connect.NewResponse(&userv1.GetUserResponse{}), nil
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+func newTimeoutTestClient(t *testing.T, delay time.Duration, timeouts Timeouts) userv1connect.UserServiceClient {
+	t.Helper()
+	_, handler := userv1connect.NewUserServiceHandler(&slowUserService{delay: delay})
+	srv := httptest.NewServer(handler)
+	t.Cleanup(srv.Close)
+	return userv1connect.NewUserServiceClient(http.DefaultClient, srv.URL,
+		connect.WithInterceptors(timeouts.Interceptor()))
+}
+
+func TestTimeouts_DeadlineExceeded(t *testing.T) {
+	c := newTimeoutTestClient(t, time.Second, Timeouts{Default: 50 * time.Millisecond})
+
+	_, err := c.GetUser(context.Background(), connect.NewRequest(&userv1.GetUserRequest{}))
+	if connect.CodeOf(err) != connect.CodeDeadlineExceeded {
+		t.Fatalf("GetUser() code = %v, want DeadlineExceeded (err: %v)", connect.CodeOf(err), err)
+	}
+	var timeoutErr *TimeoutError
+	if !errors.As(err, &timeoutErr) {
+		t.Fatalf("GetUser() error %v does not wrap *TimeoutError", err)
+	}
+	if timeoutErr.Procedure != userv1connect.UserServiceGetUserProcedure || timeoutErr.Timeout != 50*time.Millisecond {
+		t.Errorf("TimeoutError = %+v, want GetUser with 50ms", timeoutErr)
+	}
+}
+
+func TestTimeouts_OverrideExtendsDefault(t *testing.T) {
+	c := newTimeoutTestClient(t, 100*time.Millisecond, Timeouts{
+		Default: 20 * time.Millisecond,
+		Overrides: map[string]time.Duration{
+			userv1connect.UserServiceGetUserProcedure: time.Second,
+		},
+	})
+
+	if _, err := c.GetUser(context.Background(), connect.NewRequest(&userv1.GetUserRequest{})); err != nil {
+		t.Fatalf("GetUser() unexpected error: %v", err)
+	}
+}
+
+func TestTimeouts_CallerDeadlineWins(t *testing.T) {
+	c := newTimeoutTestClient(t, time.Second, Timeouts{Default: 10 * time.Second})
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	_, err := c.GetUser(ctx, connect.NewRequest(&userv1.GetUserRequest{}))
+	if connect.CodeOf(err) != connect.CodeDeadlineExceeded {
+		t.Fatalf("GetUser() code = %v, want DeadlineExceeded (err: %v)", connect.CodeOf(err), err)
+	}
+	var timeoutErr *TimeoutError
+	if !errors.As(err, &timeoutErr) || timeoutErr.Timeout != 0 {
+		t.Errorf("GetUser() error = %v, want TimeoutError without a configured timeout", err)
+	}
+}
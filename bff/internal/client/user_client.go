@@ -1,6 +1,8 @@
 package client
 
 import (
+	"crypto/tls"
+	"log/slog"
 	"net/http"
 	"time"
 
@@ -12,20 +14,108 @@ import (
 
 type UserClientConfig struct {
 	BaseURL string
-	Timeout time.Duration
+
+	// Timeout bounds each call; TimeoutOverrides sets it per procedure.
+	Timeout          time.Duration
+	TimeoutOverrides map[string]time.Duration
+
+	// Endpoints, when set, replace BaseURL with region-aware routing.
+	// Endpoints in Region are preferred; others are used for failover.
+	Endpoints        []Endpoint
+	Region           string
+	FailoverCooldown time.Duration
+	Logger           *slog.Logger
+
+	// Breaker, if set, fails calls fast while the User Service is unhealthy.
+	Breaker *CircuitBreaker
+
+	// Retry, if set, retries transient failures of calls safe to repeat.
+	Retry *pkgmw.RetryConfig
+
+	// Canary, if set, routes a share of calls to a canary release.
+	Canary *CanaryRouter
+
+	// TLS, if set, calls the service over TLS instead of h2c.
+	TLS *tls.Config
+
+	// ServiceToken, if set, authenticates calls with the BFF's client
+	// credentials access token.
+	ServiceToken *pkgmw.ClientCredentials
+
+	// Propagator sends the user context; nil propagates it unsigned.
+	Propagator *pkgmw.ContextPropagator
+}
+
+func NewUserServiceClient(cfg UserClientConfig) (userv1connect.UserServiceClient, error) {
+	// Deadlines are applied per call by the timeout interceptor, so
+	// overrides may exceed the default timeout.
+	httpClient := newBackendClient(cfg.TLS)
+	timeouts := Timeouts{Default: cfg.Timeout, Overrides: cfg.TimeoutOverrides}
+	interceptors := clientInterceptors(cfg.Canary, cfg.Breaker, cfg.Retry, timeouts, cfg.ServiceToken, cfg.Propagator, cfg.Logger)
+	baseTransport := httpClient.Transport
+	baseURL := cfg.BaseURL
+	if len(cfg.Endpoints) > 0 {
+		transport, err := NewRegionalTransport(baseTransport, cfg.Region, cfg.Endpoints, cfg.FailoverCooldown, cfg.Logger)
+		if err != nil {
+			return nil, err
+		}
+		httpClient.Transport = transport
+		// The host is rewritten per request by the regional transport.
+		baseURL = cfg.Endpoints[0].URL
+	}
+	if cfg.Canary != nil {
+		// The canary is a single endpoint and bypasses regional routing.
+		httpClient.Transport = cfg.Canary.Transport(httpClient.Transport, baseTransport)
+	}
+	return newUserServiceClientWithHTTP(httpClient, baseURL, interceptors), nil
+}
+
+// newBackendClient returns the HTTP client for a backend service: h2c, or
+// HTTP/2 over TLS when tlsConfig is set.
+func newBackendClient(tlsConfig *tls.Config) *http.Client {
+	if tlsConfig != nil {
+		return NewTLSClient(tlsConfig)
+	}
+	return NewH2CClient(0)
 }
 
-func NewUserServiceClient(cfg UserClientConfig) userv1connect.UserServiceClient {
-	httpClient := NewH2CClient(cfg.Timeout)
-	return newUserServiceClientWithHTTP(httpClient, cfg.BaseURL)
+// clientInterceptors returns the client interceptors, outermost first.
+// canary, breaker, retry, serviceToken and propagator are optional.
+func clientInterceptors(canary *CanaryRouter, breaker *CircuitBreaker, retry *pkgmw.RetryConfig, timeouts Timeouts, serviceToken *pkgmw.ClientCredentials, propagator *pkgmw.ContextPropagator, logger *slog.Logger) []connect.Interceptor {
+	var interceptors []connect.Interceptor
+	if canary != nil {
+		// Outermost so that every attempt of a call goes to the same target
+		// and breaker rejections count against it.
+		interceptors = append(interceptors, canary.Interceptor())
+	}
+	if breaker != nil {
+		// Outside retries so the breaker sees one outcome per call and
+		// rejected calls are not retried.
+		interceptors = append(interceptors, breaker.Interceptor())
+	}
+	if retry != nil {
+		if logger == nil {
+			logger = slog.Default()
+		}
+		interceptors = append(interceptors, pkgmw.RetryInterceptor(*retry, logger))
+	}
+	// Inside retries so each attempt gets its own deadline.
+	interceptors = append(interceptors, timeouts.Interceptor())
+	if serviceToken != nil {
+		// Per attempt, so a retry after the token expired gets a new one.
+		interceptors = append(interceptors, pkgmw.ServiceTokenInterceptor(serviceToken))
+	}
+	if propagator == nil {
+		propagator = pkgmw.NewContextPropagator()
+	}
+	// Innermost and per attempt, so every attempt is signed when it is sent.
+	return append(interceptors, propagator.ClientPropagatorInterceptor())
 }
 
-func newUserServiceClientWithHTTP(httpClient *http.Client, baseURL string) userv1connect.UserServiceClient {
+func newUserServiceClientWithHTTP(httpClient *http.Client, baseURL string, interceptors []connect.Interceptor) userv1connect.UserServiceClient {
 	return userv1connect.NewUserServiceClient(
 		httpClient,
 		baseURL,
-		connect.WithInterceptors(
-			pkgmw.ClientPropagatorInterceptor(),
-		),
+		connect.WithInterceptors(interceptors...),
 	)
 }
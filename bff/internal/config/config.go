@@ -4,6 +4,9 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"net/url"
+	"slices"
+	"strconv"
 	"strings"
 	"time"
 
@@ -30,15 +33,106 @@ type Config struct {
 	// Public endpoints configuration
 	PublicEndpoints PublicEndpointsConfig
 
+	// Role-based access control configuration
+	RBAC RBACConfig
+
+	// Idempotency-Key handling configuration
+	Idempotency IdempotencyConfig
+
+	// Readiness endpoint configuration
+	Readiness ReadinessConfig
+
 	// Observability configuration
 	Observability ObservabilityConfig
+
+	// Per-procedure service level objectives
+	SLO SLOConfig
+
+	// Backend capability negotiation
+	Capability CapabilityConfig
+
+	// Per-user, per-procedure request quotas
+	Quota QuotaConfig
+
+	// Circuit breakers for backend service clients
+	CircuitBreaker CircuitBreakerConfig
+
+	// Request/response capture for replaying production issues
+	Capture CaptureConfig
+
+	// Optional GraphQL gateway over the storefront API
+	GraphQL GraphQLConfig
+
+	// Optional REST/JSON gateway for third-party integrators
+	REST RESTConfig
+
+	// Optional canary routing between backend releases
+	Canary CanaryConfig
+
+	// Security event forwarding to a SIEM
+	SIEM SIEMConfig
+
+	// Sales channel and market product reads are filtered for
+	Channel ChannelConfig
+
+	// Expiring access grants delegated through the user service
+	AccessGrant AccessGrantConfig
+
+	// How storefront pages display stock quantities
+	StockDisplay StockDisplayConfig
+
+	// Adaptive concurrency limit with load shedding
+	LoadShed LoadShedConfig
+
+	// Memory and goroutine watchdog
+	Watchdog WatchdogConfig
+
+	// Cache of storefront product reads invalidated by product events
+	ProductCache ProductCacheConfig
+
+	// Denylist of access tokens revoked by the User Service
+	TokenDenylist TokenDenylistConfig
+
+	// Authentication of the BFF to the backend services
+	ServiceAuth ServiceAuthConfig
 }
 
 type BackendConfig struct {
-	UserServiceURL    string        `env:"USER_SERVICE_URL,required"`
+	UserServiceURL    string        `env:"USER_SERVICE_URL"`
 	ProductServiceURL string        `env:"PRODUCT_SERVICE_URL"`
 	OrderServiceURL   string        `env:"ORDER_SERVICE_URL"`
 	RequestTimeout    time.Duration `env:"BACKEND_REQUEST_TIMEOUT,default=10s"`
+
+	// TimeoutOverrides is a comma-separated list of "procedure=duration"
+	// entries that replace BACKEND_REQUEST_TIMEOUT for specific backend RPCs.
+	// Example: "/user.v1.UserService/ListUsers=3s,/user.v1.UserService/GetBatchJobReport=30s"
+	TimeoutOverrides string `env:"BACKEND_TIMEOUT_OVERRIDES,default="`
+
+	// UserServiceEndpoints is a comma-separated list of "region=url" entries.
+	// When set, User Service RPCs prefer endpoints in REGION and fail over to
+	// the others; USER_SERVICE_URL is then only used for readiness probes.
+	// Example: "ap-northeast-1=http://user-apne1:50051,us-west-2=http://user-usw2:50051"
+	UserServiceEndpoints string `env:"USER_SERVICE_ENDPOINTS,default="`
+
+	// FailoverCooldown is how long an unreachable endpoint is skipped.
+	FailoverCooldown time.Duration `env:"BACKEND_FAILOVER_COOLDOWN,default=30s"`
+
+	// RetryMaxAttempts is the maximum number of attempts for RPCs that are
+	// safe to repeat (read-only or carrying an Idempotency-Key). 1 disables retries.
+	RetryMaxAttempts int `env:"BACKEND_RETRY_MAX_ATTEMPTS,default=3"`
+
+	// RetryInitialBackoff and RetryMaxBackoff bound the jittered exponential backoff.
+	RetryInitialBackoff time.Duration `env:"BACKEND_RETRY_INITIAL_BACKOFF,default=50ms"`
+	RetryMaxBackoff     time.Duration `env:"BACKEND_RETRY_MAX_BACKOFF,default=1s"`
+
+	// RetryBudget is the total time a request may spend across attempts.
+	RetryBudget time.Duration `env:"BACKEND_RETRY_BUDGET,default=3s"`
+}
+
+// RegionalEndpoint is a backend base URL served from a region.
+type RegionalEndpoint struct {
+	Region string
+	URL    string
 }
 
 // ServerConfig holds server-related configuration.
@@ -49,6 +143,23 @@ type ServerConfig struct {
 	// MetricsPort is the port for Prometheus metrics endpoint.
 	MetricsPort int `env:"BFF_METRICS_PORT,default=8081"`
 
+	// DebugPort serves pprof, expvar and GC statistics for performance
+	// investigations; disabled when 0. Never expose it publicly.
+	DebugPort int `env:"DEBUG_PORT,default=0"`
+
+	// DebugAddr is the host the debug server binds to. Loopback by default;
+	// set it to the pod IP or 0.0.0.0 only for in-cluster access.
+	DebugAddr string `env:"DEBUG_ADDR,default=127.0.0.1"`
+
+	// Region is the deployment region of this instance (e.g. "ap-northeast-1").
+	// It is attached to logs and metrics and used to prefer same-region backends.
+	Region string `env:"REGION,default="`
+
+	// MockMode serves deterministic fake backend data in process and accepts
+	// fixed mock tokens instead of Hydra JWTs, so the BFF runs with no
+	// backends. For local frontend development only.
+	MockMode bool `env:"BFF_MOCK_MODE,default=false"`
+
 	// TrustedProxyHeader is the header to use for client IP extraction.
 	// Options: "X-Real-IP", "X-Forwarded-For", or empty for RemoteAddr.
 	TrustedProxyHeader string `env:"TRUSTED_PROXY_HEADER,default=X-Real-IP"`
@@ -57,12 +168,12 @@ type ServerConfig struct {
 // JWTConfig holds JWT verification configuration.
 type JWTConfig struct {
 	// IssuerURL is the expected JWT issuer (iss claim).
-	// Required.
-	IssuerURL string `env:"HYDRA_ISSUER_URL,required"`
+	// Required unless BFF_MOCK_MODE is enabled.
+	IssuerURL string `env:"HYDRA_ISSUER_URL"`
 
 	// Audience is the expected JWT audience (aud claim).
-	// Required.
-	Audience string `env:"JWT_AUDIENCE,required"`
+	// Required unless BFF_MOCK_MODE is enabled.
+	Audience string `env:"JWT_AUDIENCE"`
 
 	// ClockSkew is the tolerance for exp/nbf claim validation.
 	ClockSkew time.Duration `env:"JWT_CLOCK_SKEW,default=30s"`
@@ -71,8 +182,8 @@ type JWTConfig struct {
 // JWKSConfig holds JWKS cache configuration.
 type JWKSConfig struct {
 	// URL is the JWKS endpoint URL.
-	// Required.
-	URL string `env:"HYDRA_JWKS_URL,required"`
+	// Required unless BFF_MOCK_MODE is enabled.
+	URL string `env:"HYDRA_JWKS_URL"`
 
 	// RefreshInterval is the interval for background JWKS refresh.
 	RefreshInterval time.Duration `env:"JWKS_REFRESH_INTERVAL,default=1h"`
@@ -105,6 +216,320 @@ type PublicEndpointsConfig struct {
 	Endpoints string `env:"PUBLIC_ENDPOINTS,default="`
 }
 
+// RBACConfig holds per-method permission requirements.
+type RBACConfig struct {
+	// Policy is a comma-separated list of "procedure=permission" pairs.
+	// Entries override the built-in defaults for the same procedure.
+	// Example: "/user.v1.UserService/ListUsers=users:list"
+	Policy string `env:"RBAC_POLICY,default="`
+}
+
+// IdempotencyConfig holds Idempotency-Key replay configuration.
+type IdempotencyConfig struct {
+	// RedisURL is the Redis instance storing replayable responses.
+	// If empty, Idempotency-Key headers are ignored.
+	RedisURL string `env:"REDIS_URL"`
+
+	// KeyTTL is how long a response is kept for replay.
+	KeyTTL time.Duration `env:"IDEMPOTENCY_KEY_TTL,default=24h"`
+}
+
+// ReadinessConfig holds /ready endpoint configuration.
+type ReadinessConfig struct {
+	// ProbeBackends enables probing backend health endpoints from /ready.
+	// When disabled, /ready only reflects JWKS health.
+	ProbeBackends bool `env:"READY_PROBE_BACKENDS,default=false"`
+
+	// BackendPath is the health endpoint path probed on each backend.
+	BackendPath string `env:"READY_BACKEND_PATH,default=/readyz"`
+
+	// ProbeTimeout bounds each backend probe.
+	ProbeTimeout time.Duration `env:"READY_PROBE_TIMEOUT,default=2s"`
+
+	// CacheTTL is how long backend probe results are reused.
+	CacheTTL time.Duration `env:"READY_CACHE_TTL,default=5s"`
+}
+
+// CapabilityConfig holds backend capability negotiation configuration.
+// When enabled, the BFF polls GetServerInfo and rejects procedures or request
+// options the backend does not support yet with Unavailable instead of
+// forwarding them to fail with Unimplemented.
+type CapabilityConfig struct {
+	Enabled bool `env:"CAPABILITY_NEGOTIATION_ENABLED,default=true"`
+
+	// RefreshInterval is how often backend capabilities are re-fetched.
+	RefreshInterval time.Duration `env:"CAPABILITY_REFRESH_INTERVAL,default=30s"`
+}
+
+// QuotaConfig holds per-user request quotas. Counters live in Redis
+// (REDIS_URL) so budgets are shared across BFF replicas.
+type QuotaConfig struct {
+	// Limits is a comma-separated list of "procedure=requests/window" entries.
+	// Example: "/order.v1.OrderService/CreateOrder=5/1m"
+	Limits string `env:"QUOTA_LIMITS,default="`
+
+	// FailOpen allows requests when Redis is unavailable instead of
+	// rejecting them with Unavailable.
+	FailOpen bool `env:"QUOTA_FAIL_OPEN,default=true"`
+
+	// HeaderThreshold is the remaining fraction of a quota at or below which
+	// successful responses carry RateLimit-* headers, so clients can back off
+	// before being throttled. 0 sends them only on rejection; 1 always.
+	HeaderThreshold float64 `env:"QUOTA_HEADER_THRESHOLD,default=0.2"`
+}
+
+// CircuitBreakerConfig holds circuit breaker configuration for backend
+// clients. Each backend has its own breaker, which opens after consecutive
+// failures and rejects calls with Unavailable until a probe succeeds.
+type CircuitBreakerConfig struct {
+	Enabled bool `env:"CIRCUIT_BREAKER_ENABLED,default=true"`
+
+	// FailureThreshold is the number of consecutive failures or timeouts
+	// that opens the breaker.
+	FailureThreshold int `env:"CIRCUIT_BREAKER_FAILURE_THRESHOLD,default=5"`
+
+	// OpenTimeout is how long the breaker stays open before probing.
+	OpenTimeout time.Duration `env:"CIRCUIT_BREAKER_OPEN_TIMEOUT,default=30s"`
+
+	// HalfOpenProbes is the number of successful probes required to close
+	// the breaker again.
+	HalfOpenProbes int `env:"CIRCUIT_BREAKER_HALF_OPEN_PROBES,default=1"`
+}
+
+// CaptureConfig holds request/response capture configuration. Captured
+// pairs are sanitized and written to Dir for replay with cmd/replay.
+// Enable only while debugging a specific issue.
+type CaptureConfig struct {
+	Enabled bool `env:"CAPTURE_ENABLED,default=false"`
+
+	// UserIDs is a comma-separated list of user IDs whose requests are captured.
+	UserIDs string `env:"CAPTURE_USER_IDS,default="`
+
+	// Procedures is a comma-separated list of procedures captured for all callers.
+	Procedures string `env:"CAPTURE_PROCEDURES,default="`
+
+	// RequireConsent captures only requests sent with "X-Capture-Consent: true".
+	RequireConsent bool `env:"CAPTURE_REQUIRE_CONSENT,default=true"`
+
+	// Dir is the directory capture files are written to.
+	Dir string `env:"CAPTURE_DIR,default=./captures"`
+}
+
+// GraphQLConfig controls the /graphql endpoint. Queries are resolved by
+// calling the StorefrontService handlers in process, so they pass through
+// the same authentication, quotas and metrics as Connect requests.
+// Requires PRODUCT_SERVICE_URL; not available in mock mode.
+type GraphQLConfig struct {
+	Enabled bool `env:"GRAPHQL_ENABLED,default=false"`
+
+	// MaxDepth is the maximum nesting depth of a query's selection sets.
+	MaxDepth int `env:"GRAPHQL_MAX_DEPTH,default=10"`
+}
+
+// RESTConfig controls the REST/JSON gateway under /api/v1 and its OpenAPI
+// document at /openapi.json. Routes are translated to the BFF's own Connect
+// procedures, so PUBLIC_ENDPOINTS and RBAC apply unchanged. Storefront
+// routes require PRODUCT_SERVICE_URL.
+type RESTConfig struct {
+	Enabled bool `env:"REST_GATEWAY_ENABLED,default=false"`
+}
+
+// CanaryConfig routes a share of backend calls to a canary release of a
+// service. Authenticated users are assigned by a hash of their user ID so
+// each consistently sees one release. Callers with TesterRole may pin a
+// release with the X-Backend-Target header ("stable" or "canary").
+type CanaryConfig struct {
+	UserServiceURL    string `env:"CANARY_USER_SERVICE_URL,default="`
+	ProductServiceURL string `env:"CANARY_PRODUCT_SERVICE_URL,default="`
+
+	// Weights are the percentage (0-100) of calls routed to each canary.
+	UserServiceWeight    int `env:"CANARY_USER_SERVICE_WEIGHT,default=0"`
+	ProductServiceWeight int `env:"CANARY_PRODUCT_SERVICE_WEIGHT,default=0"`
+
+	// TesterRole is the role allowed to pin a release. Empty disables pinning.
+	TesterRole string `env:"CANARY_TESTER_ROLE,default="`
+}
+
+// ChannelConfig sets the sales channel and market used when neither the
+// caller's token nor the X-Channel/X-Market headers name one. The Product
+// Service hides products that are not on sale for them from public reads.
+type ChannelConfig struct {
+	// DefaultChannel is "web", "app" or "marketplace". Empty shows products
+	// on every channel.
+	DefaultChannel string `env:"DEFAULT_CHANNEL,default=web"`
+
+	// DefaultMarket is an ISO 3166-1 alpha-2 country code. Empty shows
+	// products regardless of their market restrictions.
+	DefaultMarket string `env:"DEFAULT_MARKET,default="`
+}
+
+// AccessGrantConfig holds delegated access configuration. When enabled,
+// the permissions of a caller's active access grants are added to those in
+// their token. Grants are cached per user, so a revocation can take up to
+// CacheTTL to take effect.
+type AccessGrantConfig struct {
+	Enabled bool `env:"ACCESS_GRANTS_ENABLED,default=false"`
+
+	// CacheTTL is how long a user's grants are reused before re-fetching.
+	CacheTTL time.Duration `env:"ACCESS_GRANTS_CACHE_TTL,default=30s"`
+}
+
+// StockDisplayConfig controls how much of a SKU's available quantity the
+// storefront reveals. Quantities up to MaxQuantity are shown exactly; larger
+// stock is shown as "MaxQuantity+".
+type StockDisplayConfig struct {
+	// LowStockThreshold is the available quantity at or below which a SKU
+	// is shown as low stock ("only N left"). 0 disables the low level.
+	LowStockThreshold int `env:"STOCK_DISPLAY_LOW_THRESHOLD,default=5"`
+
+	// MaxQuantity caps the displayed quantity. 0 shows stock levels only.
+	MaxQuantity int `env:"STOCK_DISPLAY_MAX_QUANTITY,default=10"`
+
+	// HiddenCategories is a comma-separated list of category IDs whose
+	// products show stock levels only, never exact counts. Subcategories
+	// must be listed separately.
+	HiddenCategories string `env:"STOCK_DISPLAY_HIDDEN_CATEGORIES,default="`
+}
+
+// LoadShedConfig controls the adaptive concurrency limit. Requests beyond
+// the limit are rejected at once with 503 and Retry-After instead of
+// queueing; the limit shrinks when request latency rises above its
+// long-term average and grows while it holds steady. /health and /ready
+// are never shed.
+type LoadShedConfig struct {
+	Enabled bool `env:"LOAD_SHEDDING_ENABLED,default=false"`
+
+	InitialLimit int `env:"LOAD_SHEDDING_INITIAL_LIMIT,default=200"`
+	MinLimit     int `env:"LOAD_SHEDDING_MIN_LIMIT,default=20"`
+	MaxLimit     int `env:"LOAD_SHEDDING_MAX_LIMIT,default=2000"`
+
+	// Window is how often the limit is recomputed.
+	Window time.Duration `env:"LOAD_SHEDDING_WINDOW,default=1s"`
+
+	// RetryAfter is sent to shed clients; rounded up to whole seconds.
+	RetryAfter time.Duration `env:"LOAD_SHEDDING_RETRY_AFTER,default=1s"`
+}
+
+// WatchdogConfig samples the heap size and goroutine count. A heap above
+// MaxHeapMB forces a garbage collection; goroutines above MaxGoroutines log
+// the most common stacks. After RestartAfter consecutive breaches the BFF
+// shuts down gracefully so the orchestrator restarts it. The watchdog runs
+// only when a limit is set; 0 disables a limit and RestartAfter.
+type WatchdogConfig struct {
+	Interval      time.Duration `env:"WATCHDOG_INTERVAL,default=30s"`
+	MaxHeapMB     int           `env:"WATCHDOG_MAX_HEAP_MB,default=0"`
+	MaxGoroutines int           `env:"WATCHDOG_MAX_GOROUTINES,default=0"`
+	RestartAfter  int           `env:"WATCHDOG_RESTART_AFTER,default=0"`
+}
+
+// Enabled reports whether any watchdog limit is set.
+func (c WatchdogConfig) Enabled() bool {
+	return c.MaxHeapMB > 0 || c.MaxGoroutines > 0
+}
+
+// ProductCacheConfig caches the GetProduct and GetInventory reads behind
+// storefront pages. The Product Service delivers its product, inventory and
+// price events to /webhooks/product-events, signed with WebhookSecret (the
+// secret returned when the endpoint is registered with its WebhookService),
+// and each event drops the affected entries. The TTLs bound staleness for
+// changes that are not published, such as reservations, and for lost
+// events.
+type ProductCacheConfig struct {
+	Enabled bool `env:"PRODUCT_CACHE_ENABLED,default=false"`
+
+	ProductTTL   time.Duration `env:"PRODUCT_CACHE_TTL,default=5m"`
+	InventoryTTL time.Duration `env:"PRODUCT_CACHE_INVENTORY_TTL,default=30s"`
+
+	// MaxEntries bounds the cached products and inventories each. When
+	// full, new reads are not cached until entries expire.
+	MaxEntries int `env:"PRODUCT_CACHE_MAX_ENTRIES,default=10000"`
+
+	WebhookSecret string `env:"PRODUCT_CACHE_WEBHOOK_SECRET"`
+
+	// WebhookTolerance bounds the age of a delivery's signature.
+	WebhookTolerance time.Duration `env:"PRODUCT_CACHE_WEBHOOK_TOLERANCE,default=5m"`
+}
+
+// TokenDenylistConfig rejects access tokens whose grant was revoked in
+// Hydra before they expired. The User Service delivers token revocations
+// to /webhooks/user-events, signed with WebhookSecret (its
+// TOKEN_REVOCATION_WEBHOOK_SECRET). The denylist is in memory, so every
+// replica must be registered in TOKEN_REVOCATION_WEBHOOK_URLS. TTL should
+// be at least the access token lifespan: revocations are forgotten after
+// it.
+type TokenDenylistConfig struct {
+	Enabled bool `env:"TOKEN_DENYLIST_ENABLED,default=false"`
+
+	TTL time.Duration `env:"TOKEN_DENYLIST_TTL,default=1h"`
+
+	WebhookSecret string `env:"TOKEN_DENYLIST_WEBHOOK_SECRET"`
+
+	// WebhookTolerance bounds the age of a delivery's signature.
+	WebhookTolerance time.Duration `env:"TOKEN_DENYLIST_WEBHOOK_TOLERANCE,default=5m"`
+}
+
+// ServiceAuthConfig authenticates the BFF to backend services that require
+// a service identity (their SERVICE_AUTH_ENABLED). With a client ID, every
+// backend call carries an access token of the BFF's OAuth2 client, obtained
+// from Hydra with the client credentials grant. With a certificate, the
+// backends are called over mutual TLS; their URLs must then use https.
+type ServiceAuthConfig struct {
+	TokenURL     string `env:"SERVICE_AUTH_TOKEN_URL"`
+	ClientID     string `env:"SERVICE_AUTH_CLIENT_ID"`
+	ClientSecret string `env:"SERVICE_AUTH_CLIENT_SECRET"`
+
+	// Audience is requested for the tokens (the backends' SERVICE_AUTH_AUDIENCE).
+	Audience string `env:"SERVICE_AUTH_AUDIENCE"`
+
+	// TLSCertFile and TLSKeyFile are the client certificate, and TLSCAFile
+	// verifies the backends' certificates. Setting any of them calls the
+	// backends over TLS.
+	TLSCertFile string `env:"BACKEND_TLS_CERT_FILE"`
+	TLSKeyFile  string `env:"BACKEND_TLS_KEY_FILE"`
+	TLSCAFile   string `env:"BACKEND_TLS_CA_FILE"`
+
+	// ContextSigningKey signs the propagated user context (x-user-id,
+	// x-scopes, ...) for backends that verify it (their CONTEXT_SIGNING_KEYS).
+	ContextSigningKey string `env:"CONTEXT_SIGNING_KEY"`
+}
+
+// BackendTLS reports whether the backends are called over TLS.
+func (c ServiceAuthConfig) BackendTLS() bool {
+	return c.TLSCertFile != "" || c.TLSCAFile != ""
+}
+
+// SIEMConfig forwards security events (authentication failures, access
+// denials and requests by callers holding permissions) to a SIEM. Events
+// are buffered in memory and shipped in the background; when the sink is
+// down and the buffer fills, new events are dropped rather than slowing
+// requests down.
+type SIEMConfig struct {
+	Enabled bool `env:"SIEM_ENABLED,default=false"`
+
+	// Sink is "syslog" or "http".
+	Sink string `env:"SIEM_SINK,default=syslog"`
+
+	// SyslogNetwork is "tcp", "tls" or "udp".
+	SyslogNetwork string `env:"SIEM_SYSLOG_NETWORK,default=tcp"`
+	SyslogAddr    string `env:"SIEM_SYSLOG_ADDR,default="`
+
+	// HTTPURL receives batches as newline-delimited JSON.
+	HTTPURL   string `env:"SIEM_HTTP_URL,default="`
+	HTTPToken string `env:"SIEM_HTTP_TOKEN,default="`
+
+	BufferSize    int           `env:"SIEM_BUFFER_SIZE,default=10000"`
+	BatchSize     int           `env:"SIEM_BATCH_SIZE,default=100"`
+	FlushInterval time.Duration `env:"SIEM_FLUSH_INTERVAL,default=1s"`
+	Timeout       time.Duration `env:"SIEM_TIMEOUT,default=5s"`
+	MaxBackoff    time.Duration `env:"SIEM_MAX_BACKOFF,default=30s"`
+}
+
+// QuotaLimit is the number of requests allowed per user within Window.
+type QuotaLimit struct {
+	Requests int
+	Window   time.Duration
+}
+
 // ObservabilityConfig holds logging and metrics configuration.
 // Uses OpenTelemetry for metrics with Prometheus exporter.
 type ObservabilityConfig struct {
@@ -131,6 +556,31 @@ type ObservabilityConfig struct {
 	OTLPEndpoint string `env:"OTEL_EXPORTER_OTLP_ENDPOINT"`
 }
 
+// SLOConfig holds service level objectives used by the SLO metrics.
+type SLOConfig struct {
+	// DefaultAvailability is the target ratio of requests that must not fail
+	// with a server-side error (e.g. 0.995 for 99.5%).
+	DefaultAvailability float64 `env:"SLO_DEFAULT_AVAILABILITY,default=0.995"`
+
+	// DefaultLatency is the duration within which requests must complete
+	// to count as good for the latency objective.
+	DefaultLatency time.Duration `env:"SLO_DEFAULT_LATENCY,default=500ms"`
+
+	// LatencyTarget is the target ratio of requests meeting their latency objective.
+	LatencyTarget float64 `env:"SLO_LATENCY_TARGET,default=0.99"`
+
+	// Objectives is a comma-separated list of "procedure=availability:latency"
+	// entries overriding the defaults for specific procedures.
+	// Example: "/user.v1.UserService/GetUser=0.999:200ms"
+	Objectives string `env:"SLO_OBJECTIVES,default="`
+}
+
+// SLOObjective is the availability and latency objective of a procedure.
+type SLOObjective struct {
+	Availability float64
+	Latency      time.Duration
+}
+
 // Load loads configuration from environment variables.
 func Load(ctx context.Context) (*Config, error) {
 	var cfg Config
@@ -150,10 +600,10 @@ func (c *Config) Validate() error {
 	var errs []error
 
 	// Validate JWT config
-	if c.JWT.IssuerURL == "" {
+	if c.JWT.IssuerURL == "" && !c.Server.MockMode {
 		errs = append(errs, errors.New("HYDRA_ISSUER_URL is required"))
 	}
-	if c.JWT.Audience == "" {
+	if c.JWT.Audience == "" && !c.Server.MockMode {
 		errs = append(errs, errors.New("JWT_AUDIENCE is required"))
 	}
 	if c.JWT.ClockSkew < 0 {
@@ -161,7 +611,7 @@ func (c *Config) Validate() error {
 	}
 
 	// Validate JWKS config
-	if c.JWKS.URL == "" {
+	if c.JWKS.URL == "" && !c.Server.MockMode {
 		errs = append(errs, errors.New("HYDRA_JWKS_URL is required"))
 	}
 	if c.JWKS.RefreshInterval < time.Minute {
@@ -189,6 +639,9 @@ func (c *Config) Validate() error {
 	if c.Server.MetricsPort < 1 || c.Server.MetricsPort > 65535 {
 		errs = append(errs, errors.New("BFF_METRICS_PORT must be between 1 and 65535"))
 	}
+	if c.Server.DebugPort < 0 || c.Server.DebugPort > 65535 {
+		errs = append(errs, errors.New("DEBUG_PORT must be between 0 and 65535"))
+	}
 
 	// Validate observability config
 	if c.Observability.PrometheusPort < 1 || c.Observability.PrometheusPort > 65535 {
@@ -199,12 +652,255 @@ func (c *Config) Validate() error {
 	}
 
 	// Validate backend config
-	if c.Backend.UserServiceURL == "" {
+	if c.Backend.UserServiceURL == "" && !c.Server.MockMode {
 		errs = append(errs, errors.New("USER_SERVICE_URL is required"))
 	}
 	if c.Backend.RequestTimeout < time.Second {
 		errs = append(errs, errors.New("BACKEND_REQUEST_TIMEOUT must be at least 1 second"))
 	}
+	if _, err := c.GetBackendTimeoutOverrides(); err != nil {
+		errs = append(errs, err)
+	}
+	if endpoints, err := c.GetUserServiceEndpoints(); err != nil {
+		errs = append(errs, err)
+	} else if len(endpoints) > 0 && c.Backend.FailoverCooldown <= 0 {
+		errs = append(errs, errors.New("BACKEND_FAILOVER_COOLDOWN must be positive"))
+	}
+
+	// Validate idempotency config
+	if c.Idempotency.RedisURL != "" && c.Idempotency.KeyTTL < time.Minute {
+		errs = append(errs, errors.New("IDEMPOTENCY_KEY_TTL must be at least 1 minute"))
+	}
+
+	// Validate readiness config
+	if c.Readiness.ProbeBackends {
+		if c.Readiness.ProbeTimeout <= 0 {
+			errs = append(errs, errors.New("READY_PROBE_TIMEOUT must be positive"))
+		}
+		if c.Readiness.CacheTTL < 0 {
+			errs = append(errs, errors.New("READY_CACHE_TTL must be non-negative"))
+		}
+		if !strings.HasPrefix(c.Readiness.BackendPath, "/") {
+			errs = append(errs, errors.New("READY_BACKEND_PATH must start with /"))
+		}
+	}
+
+	// Validate backend retry config
+	if c.Backend.RetryMaxAttempts > 1 {
+		if c.Backend.RetryInitialBackoff <= 0 || c.Backend.RetryMaxBackoff < c.Backend.RetryInitialBackoff {
+			errs = append(errs, errors.New("BACKEND_RETRY_INITIAL_BACKOFF must be positive and at most BACKEND_RETRY_MAX_BACKOFF"))
+		}
+		if c.Backend.RetryBudget < 0 {
+			errs = append(errs, errors.New("BACKEND_RETRY_BUDGET must be non-negative"))
+		}
+	}
+
+	// Validate capability config
+	if c.Capability.Enabled && c.Capability.RefreshInterval < time.Second {
+		errs = append(errs, errors.New("CAPABILITY_REFRESH_INTERVAL must be at least 1 second"))
+	}
+
+	// Validate quota config
+	if quotaLimits, err := c.GetQuotaLimits(); err != nil {
+		errs = append(errs, err)
+	} else if len(quotaLimits) > 0 && c.Idempotency.RedisURL == "" {
+		errs = append(errs, errors.New("QUOTA_LIMITS requires REDIS_URL"))
+	}
+	if c.Quota.HeaderThreshold < 0 || c.Quota.HeaderThreshold > 1 {
+		errs = append(errs, errors.New("QUOTA_HEADER_THRESHOLD must be between 0 and 1"))
+	}
+
+	// Validate circuit breaker config
+	if c.CircuitBreaker.Enabled {
+		if c.CircuitBreaker.FailureThreshold < 1 {
+			errs = append(errs, errors.New("CIRCUIT_BREAKER_FAILURE_THRESHOLD must be at least 1"))
+		}
+		if c.CircuitBreaker.OpenTimeout <= 0 {
+			errs = append(errs, errors.New("CIRCUIT_BREAKER_OPEN_TIMEOUT must be positive"))
+		}
+		if c.CircuitBreaker.HalfOpenProbes < 1 {
+			errs = append(errs, errors.New("CIRCUIT_BREAKER_HALF_OPEN_PROBES must be at least 1"))
+		}
+	}
+
+	// Validate capture config
+	if c.Capture.Enabled {
+		if len(c.GetCaptureUserIDs()) == 0 && len(c.GetCaptureProcedures()) == 0 {
+			errs = append(errs, errors.New("CAPTURE_ENABLED requires CAPTURE_USER_IDS or CAPTURE_PROCEDURES"))
+		}
+		if c.Capture.Dir == "" {
+			errs = append(errs, errors.New("CAPTURE_DIR is required when capture is enabled"))
+		}
+	}
+
+	// Validate GraphQL config
+	if c.GraphQL.Enabled {
+		if c.GraphQL.MaxDepth < 1 {
+			errs = append(errs, errors.New("GRAPHQL_MAX_DEPTH must be at least 1"))
+		}
+		if c.Backend.ProductServiceURL == "" || c.Server.MockMode {
+			errs = append(errs, errors.New("GRAPHQL_ENABLED requires PRODUCT_SERVICE_URL and is not available in mock mode"))
+		}
+	}
+
+	// Validate canary config
+	for _, canary := range []struct {
+		name   string
+		url    string
+		weight int
+	}{
+		{"CANARY_USER_SERVICE", c.Canary.UserServiceURL, c.Canary.UserServiceWeight},
+		{"CANARY_PRODUCT_SERVICE", c.Canary.ProductServiceURL, c.Canary.ProductServiceWeight},
+	} {
+		if canary.weight < 0 || canary.weight > 100 {
+			errs = append(errs, fmt.Errorf("%s_WEIGHT must be between 0 and 100", canary.name))
+		}
+		if canary.url != "" && c.Server.MockMode {
+			errs = append(errs, fmt.Errorf("%s_URL is not available in mock mode", canary.name))
+		}
+		if canary.url == "" && canary.weight > 0 {
+			errs = append(errs, fmt.Errorf("%s_WEIGHT requires %s_URL", canary.name, canary.name))
+		}
+	}
+	if c.Canary.ProductServiceURL != "" && c.Backend.ProductServiceURL == "" {
+		errs = append(errs, errors.New("CANARY_PRODUCT_SERVICE_URL requires PRODUCT_SERVICE_URL"))
+	}
+
+	// Validate channel config
+	if ch := c.Channel.DefaultChannel; ch != "" && !slices.Contains([]string{"web", "app", "marketplace"}, ch) {
+		errs = append(errs, errors.New("DEFAULT_CHANNEL must be web, app or marketplace"))
+	}
+	if m := c.Channel.DefaultMarket; m != "" && len(m) != 2 {
+		errs = append(errs, errors.New("DEFAULT_MARKET must be a two-letter country code"))
+	}
+
+	// Validate access grant config
+	if c.AccessGrant.Enabled && (c.AccessGrant.CacheTTL <= 0 || c.AccessGrant.CacheTTL > 5*time.Minute) {
+		errs = append(errs, errors.New("ACCESS_GRANTS_CACHE_TTL must be positive and at most 5 minutes"))
+	}
+
+	// Validate stock display config
+	if c.StockDisplay.MaxQuantity < 0 || c.StockDisplay.MaxQuantity > 1000 {
+		errs = append(errs, errors.New("STOCK_DISPLAY_MAX_QUANTITY must be between 0 and 1000"))
+	}
+	if c.StockDisplay.LowStockThreshold < 0 || c.StockDisplay.LowStockThreshold > c.StockDisplay.MaxQuantity {
+		errs = append(errs, errors.New("STOCK_DISPLAY_LOW_THRESHOLD must be between 0 and STOCK_DISPLAY_MAX_QUANTITY"))
+	}
+
+	// Validate load shedding config
+	if c.LoadShed.Enabled {
+		if c.LoadShed.MinLimit < 1 || c.LoadShed.MaxLimit < c.LoadShed.MinLimit {
+			errs = append(errs, errors.New("LOAD_SHEDDING_MIN_LIMIT must be at least 1 and at most LOAD_SHEDDING_MAX_LIMIT"))
+		}
+		if c.LoadShed.InitialLimit < c.LoadShed.MinLimit || c.LoadShed.InitialLimit > c.LoadShed.MaxLimit {
+			errs = append(errs, errors.New("LOAD_SHEDDING_INITIAL_LIMIT must be between LOAD_SHEDDING_MIN_LIMIT and LOAD_SHEDDING_MAX_LIMIT"))
+		}
+		if c.LoadShed.Window <= 0 || c.LoadShed.RetryAfter <= 0 {
+			errs = append(errs, errors.New("LOAD_SHEDDING_WINDOW and LOAD_SHEDDING_RETRY_AFTER must be positive"))
+		}
+	}
+
+	// Validate watchdog config
+	if c.Watchdog.MaxHeapMB < 0 || c.Watchdog.MaxGoroutines < 0 || c.Watchdog.RestartAfter < 0 {
+		errs = append(errs, errors.New("WATCHDOG_MAX_HEAP_MB, WATCHDOG_MAX_GOROUTINES and WATCHDOG_RESTART_AFTER must not be negative"))
+	}
+	if c.Watchdog.Enabled() && (c.Watchdog.Interval < time.Second || c.Watchdog.Interval > 10*time.Minute) {
+		errs = append(errs, errors.New("WATCHDOG_INTERVAL must be between 1s and 10m"))
+	}
+
+	// Validate product cache config
+	if c.ProductCache.Enabled {
+		if c.ProductCache.ProductTTL < time.Second || c.ProductCache.ProductTTL > time.Hour {
+			errs = append(errs, errors.New("PRODUCT_CACHE_TTL must be between 1s and 1h"))
+		}
+		if c.ProductCache.InventoryTTL < time.Second || c.ProductCache.InventoryTTL > c.ProductCache.ProductTTL {
+			errs = append(errs, errors.New("PRODUCT_CACHE_INVENTORY_TTL must be between 1s and PRODUCT_CACHE_TTL"))
+		}
+		if c.ProductCache.MaxEntries < 1 {
+			errs = append(errs, errors.New("PRODUCT_CACHE_MAX_ENTRIES must be at least 1"))
+		}
+		if c.ProductCache.WebhookSecret == "" {
+			errs = append(errs, errors.New("PRODUCT_CACHE_WEBHOOK_SECRET is required when PRODUCT_CACHE_ENABLED is true"))
+		}
+		if c.ProductCache.WebhookTolerance < 30*time.Second || c.ProductCache.WebhookTolerance > time.Hour {
+			errs = append(errs, errors.New("PRODUCT_CACHE_WEBHOOK_TOLERANCE must be between 30s and 1h"))
+		}
+	}
+
+	// Validate token denylist config
+	if c.TokenDenylist.Enabled {
+		if c.TokenDenylist.TTL < time.Minute || c.TokenDenylist.TTL > 24*time.Hour {
+			errs = append(errs, errors.New("TOKEN_DENYLIST_TTL must be between 1m and 24h"))
+		}
+		if c.TokenDenylist.WebhookSecret == "" {
+			errs = append(errs, errors.New("TOKEN_DENYLIST_WEBHOOK_SECRET is required when TOKEN_DENYLIST_ENABLED is true"))
+		}
+		if c.TokenDenylist.WebhookTolerance < 30*time.Second || c.TokenDenylist.WebhookTolerance > time.Hour {
+			errs = append(errs, errors.New("TOKEN_DENYLIST_WEBHOOK_TOLERANCE must be between 30s and 1h"))
+		}
+	}
+
+	// Validate service auth config
+	if c.ServiceAuth.ClientID != "" && (c.ServiceAuth.TokenURL == "" || c.ServiceAuth.ClientSecret == "") {
+		errs = append(errs, errors.New("SERVICE_AUTH_TOKEN_URL and SERVICE_AUTH_CLIENT_SECRET are required when SERVICE_AUTH_CLIENT_ID is set"))
+	}
+	if (c.ServiceAuth.TLSCertFile == "") != (c.ServiceAuth.TLSKeyFile == "") {
+		errs = append(errs, errors.New("BACKEND_TLS_CERT_FILE and BACKEND_TLS_KEY_FILE must be set together"))
+	}
+	if c.ServiceAuth.ContextSigningKey != "" && len(c.ServiceAuth.ContextSigningKey) < 32 {
+		errs = append(errs, errors.New("CONTEXT_SIGNING_KEY must be at least 32 characters"))
+	}
+	if c.ServiceAuth.BackendTLS() {
+		for _, u := range []string{c.Backend.UserServiceURL, c.Backend.ProductServiceURL} {
+			if u != "" && !strings.HasPrefix(u, "https://") {
+				errs = append(errs, fmt.Errorf("backend URL %s must use https when backend TLS is configured", u))
+			}
+		}
+	}
+
+	// Validate SIEM config
+	if c.SIEM.Enabled {
+		switch c.SIEM.Sink {
+		case "syslog":
+			if c.SIEM.SyslogAddr == "" {
+				errs = append(errs, errors.New("SIEM_SYSLOG_ADDR is required when SIEM_SINK is syslog"))
+			}
+			if !slices.Contains([]string{"tcp", "tls", "udp"}, c.SIEM.SyslogNetwork) {
+				errs = append(errs, errors.New("SIEM_SYSLOG_NETWORK must be tcp, tls or udp"))
+			}
+		case "http":
+			if c.SIEM.HTTPURL == "" {
+				errs = append(errs, errors.New("SIEM_HTTP_URL is required when SIEM_SINK is http"))
+			}
+		default:
+			errs = append(errs, errors.New("SIEM_SINK must be syslog or http"))
+		}
+		if c.SIEM.BufferSize < 1 || c.SIEM.BatchSize < 1 {
+			errs = append(errs, errors.New("SIEM_BUFFER_SIZE and SIEM_BATCH_SIZE must be at least 1"))
+		}
+		if c.SIEM.FlushInterval <= 0 || c.SIEM.Timeout <= 0 || c.SIEM.MaxBackoff <= 0 {
+			errs = append(errs, errors.New("SIEM_FLUSH_INTERVAL, SIEM_TIMEOUT and SIEM_MAX_BACKOFF must be positive"))
+		}
+	}
+
+	// Validate SLO config
+	if c.SLO.DefaultAvailability < 0 || c.SLO.DefaultAvailability >= 1 {
+		errs = append(errs, errors.New("SLO_DEFAULT_AVAILABILITY must be between 0 and 1 (exclusive)"))
+	}
+	if c.SLO.LatencyTarget < 0 || c.SLO.LatencyTarget >= 1 {
+		errs = append(errs, errors.New("SLO_LATENCY_TARGET must be between 0 and 1 (exclusive)"))
+	}
+	if c.SLO.DefaultLatency < 0 {
+		errs = append(errs, errors.New("SLO_DEFAULT_LATENCY must be non-negative"))
+	}
+	if _, err := c.GetSLOObjectives(); err != nil {
+		errs = append(errs, err)
+	}
+
+	// Validate RBAC config
+	if _, err := c.GetMethodPermissions(); err != nil {
+		errs = append(errs, err)
+	}
 
 	if len(errs) > 0 {
 		return errors.Join(errs...)
@@ -230,6 +926,165 @@ func (c *Config) GetPublicEndpoints() []string {
 	return result
 }
 
+// GetCaptureUserIDs parses CAPTURE_USER_IDS into a slice.
+func (c *Config) GetCaptureUserIDs() []string {
+	return splitList(c.Capture.UserIDs)
+}
+
+// GetCaptureProcedures parses CAPTURE_PROCEDURES into a slice.
+func (c *Config) GetCaptureProcedures() []string {
+	return splitList(c.Capture.Procedures)
+}
+
+// GetStockDisplayHiddenCategories parses STOCK_DISPLAY_HIDDEN_CATEGORIES into a slice.
+func (c *Config) GetStockDisplayHiddenCategories() []string {
+	return splitList(c.StockDisplay.HiddenCategories)
+}
+
+// GetMethodPermissions parses RBAC_POLICY into a procedure-to-permission map.
+func (c *Config) GetMethodPermissions() (map[string]string, error) {
+	result := make(map[string]string)
+	if c.RBAC.Policy == "" {
+		return result, nil
+	}
+
+	for _, entry := range strings.Split(c.RBAC.Policy, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+		procedure, permission, ok := strings.Cut(entry, "=")
+		procedure = strings.TrimSpace(procedure)
+		permission = strings.TrimSpace(permission)
+		if !ok || !strings.HasPrefix(procedure, "/") || permission == "" {
+			return nil, fmt.Errorf("RBAC_POLICY entry %q must be of the form /package.Service/Method=permission", entry)
+		}
+		result[procedure] = permission
+	}
+	return result, nil
+}
+
+// GetSLOObjectives parses SLO_OBJECTIVES into a procedure-to-objective map.
+func (c *Config) GetSLOObjectives() (map[string]SLOObjective, error) {
+	result := make(map[string]SLOObjective)
+	if c.SLO.Objectives == "" {
+		return result, nil
+	}
+
+	for _, entry := range strings.Split(c.SLO.Objectives, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+		procedure, objective, ok := strings.Cut(entry, "=")
+		procedure = strings.TrimSpace(procedure)
+		availabilityStr, latencyStr, hasLatency := strings.Cut(strings.TrimSpace(objective), ":")
+		if !ok || !hasLatency || !strings.HasPrefix(procedure, "/") {
+			return nil, fmt.Errorf("SLO_OBJECTIVES entry %q must be of the form /package.Service/Method=availability:latency", entry)
+		}
+
+		availability, err := strconv.ParseFloat(strings.TrimSpace(availabilityStr), 64)
+		if err != nil || availability <= 0 || availability >= 1 {
+			return nil, fmt.Errorf("SLO_OBJECTIVES entry %q: availability must be between 0 and 1 (exclusive)", entry)
+		}
+		latency, err := time.ParseDuration(strings.TrimSpace(latencyStr))
+		if err != nil || latency <= 0 {
+			return nil, fmt.Errorf("SLO_OBJECTIVES entry %q: latency must be a positive duration", entry)
+		}
+
+		result[procedure] = SLOObjective{Availability: availability, Latency: latency}
+	}
+	return result, nil
+}
+
+// GetQuotaLimits parses QUOTA_LIMITS into a procedure-to-limit map.
+func (c *Config) GetQuotaLimits() (map[string]QuotaLimit, error) {
+	result := make(map[string]QuotaLimit)
+	if c.Quota.Limits == "" {
+		return result, nil
+	}
+
+	for _, entry := range strings.Split(c.Quota.Limits, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+		procedure, limit, ok := strings.Cut(entry, "=")
+		procedure = strings.TrimSpace(procedure)
+		requestsStr, windowStr, hasWindow := strings.Cut(strings.TrimSpace(limit), "/")
+		if !ok || !hasWindow || !strings.HasPrefix(procedure, "/") {
+			return nil, fmt.Errorf("QUOTA_LIMITS entry %q must be of the form /package.Service/Method=requests/window", entry)
+		}
+
+		requests, err := strconv.Atoi(strings.TrimSpace(requestsStr))
+		if err != nil || requests < 1 {
+			return nil, fmt.Errorf("QUOTA_LIMITS entry %q: requests must be a positive integer", entry)
+		}
+		window, err := time.ParseDuration(strings.TrimSpace(windowStr))
+		if err != nil || window < time.Second {
+			return nil, fmt.Errorf("QUOTA_LIMITS entry %q: window must be at least 1s", entry)
+		}
+
+		result[procedure] = QuotaLimit{Requests: requests, Window: window}
+	}
+	return result, nil
+}
+
+// GetBackendTimeoutOverrides parses BACKEND_TIMEOUT_OVERRIDES into a
+// procedure-to-timeout map.
+func (c *Config) GetBackendTimeoutOverrides() (map[string]time.Duration, error) {
+	result := make(map[string]time.Duration)
+	if c.Backend.TimeoutOverrides == "" {
+		return result, nil
+	}
+
+	for _, entry := range strings.Split(c.Backend.TimeoutOverrides, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+		procedure, timeoutStr, ok := strings.Cut(entry, "=")
+		procedure = strings.TrimSpace(procedure)
+		if !ok || !strings.HasPrefix(procedure, "/") {
+			return nil, fmt.Errorf("BACKEND_TIMEOUT_OVERRIDES entry %q must be of the form /package.Service/Method=duration", entry)
+		}
+		timeout, err := time.ParseDuration(strings.TrimSpace(timeoutStr))
+		if err != nil || timeout <= 0 {
+			return nil, fmt.Errorf("BACKEND_TIMEOUT_OVERRIDES entry %q: timeout must be a positive duration", entry)
+		}
+		result[procedure] = timeout
+	}
+	return result, nil
+}
+
+// GetUserServiceEndpoints parses USER_SERVICE_ENDPOINTS.
+// Endpoints are returned in configuration order.
+func (c *Config) GetUserServiceEndpoints() ([]RegionalEndpoint, error) {
+	if c.Backend.UserServiceEndpoints == "" {
+		return nil, nil
+	}
+
+	var result []RegionalEndpoint
+	for _, entry := range strings.Split(c.Backend.UserServiceEndpoints, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+		region, rawURL, ok := strings.Cut(entry, "=")
+		region = strings.TrimSpace(region)
+		rawURL = strings.TrimSpace(rawURL)
+		if !ok || region == "" {
+			return nil, fmt.Errorf("USER_SERVICE_ENDPOINTS entry %q must be of the form region=url", entry)
+		}
+		u, err := url.Parse(rawURL)
+		if err != nil || u.Scheme == "" || u.Host == "" || (u.Path != "" && u.Path != "/") {
+			return nil, fmt.Errorf("USER_SERVICE_ENDPOINTS entry %q must have a URL of the form scheme://host[:port]", entry)
+		}
+		result = append(result, RegionalEndpoint{Region: region, URL: rawURL})
+	}
+	return result, nil
+}
+
 // HeadersToSanitize returns the list of internal headers to remove from incoming requests.
 func (c *Config) HeadersToSanitize() []string {
 	return []string{
@@ -239,3 +1094,14 @@ func (c *Config) HeadersToSanitize() []string {
 		"x-tenant-id",
 	}
 }
+
+// splitList splits a comma-separated list, dropping empty entries.
+func splitList(s string) []string {
+	var result []string
+	for _, item := range strings.Split(s, ",") {
+		if trimmed := strings.TrimSpace(item); trimmed != "" {
+			result = append(result, trimmed)
+		}
+	}
+	return result
+}
@@ -38,6 +38,7 @@ func clearAllEnv(t *testing.T) func() {
 	envVars := []string{
 		"BFF_PORT",
 		"BFF_METRICS_PORT",
+		"BFF_MOCK_MODE",
 		"TRUSTED_PROXY_HEADER",
 		"HYDRA_ISSUER_URL",
 		"JWT_AUDIENCE",
@@ -56,6 +57,7 @@ func clearAllEnv(t *testing.T) func() {
 		"OTEL_SERVICE_VERSION",
 		"OTEL_PROMETHEUS_PORT",
 		"OTEL_EXPORTER_OTLP_ENDPOINT",
+		"USER_SERVICE_URL",
 	}
 
 	originalValues := make(map[string]string)
@@ -93,6 +95,7 @@ func TestConfig_Load_RequiredFields(t *testing.T) {
 			"HYDRA_ISSUER_URL": "http://localhost:4444/",
 			"HYDRA_JWKS_URL":   "http://localhost:4444/.well-known/jwks.json",
 			"JWT_AUDIENCE":     "ec-platform-bff",
+			"USER_SERVICE_URL": "http://localhost:50051",
 		})
 		defer cleanup()
 
@@ -113,6 +116,24 @@ func TestConfig_Load_RequiredFields(t *testing.T) {
 	})
 }
 
+func TestConfig_Load_MockMode(t *testing.T) {
+	cleanup := clearAllEnv(t)
+	defer cleanup()
+
+	envCleanup := setEnv(t, map[string]string{
+		"BFF_MOCK_MODE": "true",
+	})
+	defer envCleanup()
+
+	cfg, err := config.Load(context.Background())
+	if err != nil {
+		t.Fatalf("mock mode should not require Hydra or backend settings: %v", err)
+	}
+	if !cfg.Server.MockMode {
+		t.Error("expected MockMode to be enabled")
+	}
+}
+
 func TestConfig_Load_DefaultValues(t *testing.T) {
 	cleanup := clearAllEnv(t)
 	defer cleanup()
@@ -122,6 +143,7 @@ func TestConfig_Load_DefaultValues(t *testing.T) {
 		"HYDRA_ISSUER_URL": "http://localhost:4444/",
 		"HYDRA_JWKS_URL":   "http://localhost:4444/.well-known/jwks.json",
 		"JWT_AUDIENCE":     "ec-platform-bff",
+		"USER_SERVICE_URL": "http://localhost:50051",
 	})
 	defer envCleanup()
 
@@ -225,6 +247,10 @@ func TestConfig_Validate(t *testing.T) {
 					ServiceName:    "bff",
 					PrometheusPort: 9090,
 				},
+				Backend: config.BackendConfig{
+					UserServiceURL: "http://localhost:50051",
+					RequestTimeout: 10 * time.Second,
+				},
 			},
 			wantErr: false,
 		},
@@ -326,6 +352,21 @@ func TestConfig_Validate(t *testing.T) {
 			},
 			wantErr: true,
 		},
+		{
+			name: "invalid_debug_port",
+			cfg: config.Config{
+				Server: config.ServerConfig{Port: 8080, MetricsPort: 8081, DebugPort: 70000},
+				JWT:    config.JWTConfig{IssuerURL: "http://test", Audience: "test", ClockSkew: 30 * time.Second},
+				JWKS:   config.JWKSConfig{URL: "http://test", RefreshInterval: time.Hour, MinRefreshInterval: 10 * time.Second},
+				RateLimit: config.RateLimitConfig{
+					FailureThreshold: 10,
+					Window:           time.Minute,
+					Cooldown:         5 * time.Minute,
+				},
+				Observability: config.ObservabilityConfig{ServiceName: "bff", PrometheusPort: 9090},
+			},
+			wantErr: true,
+		},
 		{
 			name: "rate_limit_failure_threshold_zero",
 			cfg: config.Config{
@@ -369,6 +410,218 @@ func TestConfig_Validate(t *testing.T) {
 			},
 			wantErr: true,
 		},
+		{
+			name: "retry_max_backoff_below_initial",
+			cfg: config.Config{
+				Server:        config.ServerConfig{Port: 8080, MetricsPort: 8081},
+				JWT:           config.JWTConfig{IssuerURL: "http://test", Audience: "test", ClockSkew: 30 * time.Second},
+				JWKS:          config.JWKSConfig{URL: "http://test", RefreshInterval: time.Hour, MinRefreshInterval: 10 * time.Second},
+				RateLimit:     config.RateLimitConfig{FailureThreshold: 10, Window: time.Minute, Cooldown: 5 * time.Minute},
+				Observability: config.ObservabilityConfig{ServiceName: "bff", PrometheusPort: 9090},
+				Backend: config.BackendConfig{
+					RetryMaxAttempts:    3,
+					RetryInitialBackoff: time.Second,
+					RetryMaxBackoff:     100 * time.Millisecond,
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "circuit_breaker_failure_threshold_zero",
+			cfg: config.Config{
+				Server:        config.ServerConfig{Port: 8080, MetricsPort: 8081},
+				JWT:           config.JWTConfig{IssuerURL: "http://test", Audience: "test", ClockSkew: 30 * time.Second},
+				JWKS:          config.JWKSConfig{URL: "http://test", RefreshInterval: time.Hour, MinRefreshInterval: 10 * time.Second},
+				RateLimit:     config.RateLimitConfig{FailureThreshold: 10, Window: time.Minute, Cooldown: 5 * time.Minute},
+				Observability: config.ObservabilityConfig{ServiceName: "bff", PrometheusPort: 9090},
+				CircuitBreaker: config.CircuitBreakerConfig{
+					Enabled:          true,
+					FailureThreshold: 0,
+					OpenTimeout:      30 * time.Second,
+					HalfOpenProbes:   1,
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "graphql_without_product_service",
+			cfg: config.Config{
+				Server:        config.ServerConfig{Port: 8080, MetricsPort: 8081},
+				JWT:           config.JWTConfig{IssuerURL: "http://test", Audience: "test", ClockSkew: 30 * time.Second},
+				JWKS:          config.JWKSConfig{URL: "http://test", RefreshInterval: time.Hour, MinRefreshInterval: 10 * time.Second},
+				RateLimit:     config.RateLimitConfig{FailureThreshold: 10, Window: time.Minute, Cooldown: 5 * time.Minute},
+				Observability: config.ObservabilityConfig{ServiceName: "bff", PrometheusPort: 9090},
+				GraphQL:       config.GraphQLConfig{Enabled: true, MaxDepth: 10},
+			},
+			wantErr: true,
+		},
+		{
+			name: "canary_weight_without_url",
+			cfg: config.Config{
+				Server:        config.ServerConfig{Port: 8080, MetricsPort: 8081},
+				JWT:           config.JWTConfig{IssuerURL: "http://test", Audience: "test", ClockSkew: 30 * time.Second},
+				JWKS:          config.JWKSConfig{URL: "http://test", RefreshInterval: time.Hour, MinRefreshInterval: 10 * time.Second},
+				RateLimit:     config.RateLimitConfig{FailureThreshold: 10, Window: time.Minute, Cooldown: 5 * time.Minute},
+				Observability: config.ObservabilityConfig{ServiceName: "bff", PrometheusPort: 9090},
+				Backend:       config.BackendConfig{UserServiceURL: "http://user:50051", RequestTimeout: 10 * time.Second},
+				Canary:        config.CanaryConfig{UserServiceWeight: 10},
+			},
+			wantErr: true,
+		},
+		{
+			name: "unknown_default_channel",
+			cfg: config.Config{
+				Server:        config.ServerConfig{Port: 8080, MetricsPort: 8081},
+				JWT:           config.JWTConfig{IssuerURL: "http://test", Audience: "test", ClockSkew: 30 * time.Second},
+				JWKS:          config.JWKSConfig{URL: "http://test", RefreshInterval: time.Hour, MinRefreshInterval: 10 * time.Second},
+				RateLimit:     config.RateLimitConfig{FailureThreshold: 10, Window: time.Minute, Cooldown: 5 * time.Minute},
+				Observability: config.ObservabilityConfig{ServiceName: "bff", PrometheusPort: 9090},
+				Backend:       config.BackendConfig{UserServiceURL: "http://user:50051", RequestTimeout: 10 * time.Second},
+				Channel:       config.ChannelConfig{DefaultChannel: "kiosk"},
+			},
+			wantErr: true,
+		},
+		{
+			name: "access_grants_cache_ttl_too_long",
+			cfg: config.Config{
+				Server:        config.ServerConfig{Port: 8080, MetricsPort: 8081},
+				JWT:           config.JWTConfig{IssuerURL: "http://test", Audience: "test", ClockSkew: 30 * time.Second},
+				JWKS:          config.JWKSConfig{URL: "http://test", RefreshInterval: time.Hour, MinRefreshInterval: 10 * time.Second},
+				RateLimit:     config.RateLimitConfig{FailureThreshold: 10, Window: time.Minute, Cooldown: 5 * time.Minute},
+				Observability: config.ObservabilityConfig{ServiceName: "bff", PrometheusPort: 9090},
+				Backend:       config.BackendConfig{UserServiceURL: "http://user:50051", RequestTimeout: 10 * time.Second},
+				AccessGrant:   config.AccessGrantConfig{Enabled: true, CacheTTL: time.Hour},
+			},
+			wantErr: true,
+		},
+		{
+			name: "stock_display_threshold_above_max_quantity",
+			cfg: config.Config{
+				Server:        config.ServerConfig{Port: 8080, MetricsPort: 8081},
+				JWT:           config.JWTConfig{IssuerURL: "http://test", Audience: "test", ClockSkew: 30 * time.Second},
+				JWKS:          config.JWKSConfig{URL: "http://test", RefreshInterval: time.Hour, MinRefreshInterval: 10 * time.Second},
+				RateLimit:     config.RateLimitConfig{FailureThreshold: 10, Window: time.Minute, Cooldown: 5 * time.Minute},
+				Observability: config.ObservabilityConfig{ServiceName: "bff", PrometheusPort: 9090},
+				Backend:       config.BackendConfig{UserServiceURL: "http://user:50051", RequestTimeout: 10 * time.Second},
+				StockDisplay:  config.StockDisplayConfig{LowStockThreshold: 20, MaxQuantity: 10},
+			},
+			wantErr: true,
+		},
+		{
+			name: "load_shedding_initial_limit_below_min",
+			cfg: config.Config{
+				Server:        config.ServerConfig{Port: 8080, MetricsPort: 8081},
+				JWT:           config.JWTConfig{IssuerURL: "http://test", Audience: "test", ClockSkew: 30 * time.Second},
+				JWKS:          config.JWKSConfig{URL: "http://test", RefreshInterval: time.Hour, MinRefreshInterval: 10 * time.Second},
+				RateLimit:     config.RateLimitConfig{FailureThreshold: 10, Window: time.Minute, Cooldown: 5 * time.Minute},
+				Observability: config.ObservabilityConfig{ServiceName: "bff", PrometheusPort: 9090},
+				Backend:       config.BackendConfig{UserServiceURL: "http://user:50051", RequestTimeout: 10 * time.Second},
+				LoadShed: config.LoadShedConfig{
+					Enabled: true, InitialLimit: 5, MinLimit: 20, MaxLimit: 2000,
+					Window: time.Second, RetryAfter: time.Second,
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "watchdog_interval_too_short",
+			cfg: config.Config{
+				Server:        config.ServerConfig{Port: 8080, MetricsPort: 8081},
+				JWT:           config.JWTConfig{IssuerURL: "http://test", Audience: "test", ClockSkew: 30 * time.Second},
+				JWKS:          config.JWKSConfig{URL: "http://test", RefreshInterval: time.Hour, MinRefreshInterval: 10 * time.Second},
+				RateLimit:     config.RateLimitConfig{FailureThreshold: 10, Window: time.Minute, Cooldown: 5 * time.Minute},
+				Observability: config.ObservabilityConfig{ServiceName: "bff", PrometheusPort: 9090},
+				Backend:       config.BackendConfig{UserServiceURL: "http://user:50051", RequestTimeout: 10 * time.Second},
+				Watchdog:      config.WatchdogConfig{Interval: 100 * time.Millisecond, MaxHeapMB: 512},
+			},
+			wantErr: true,
+		},
+		{
+			name: "product_cache_without_webhook_secret",
+			cfg: config.Config{
+				Server:        config.ServerConfig{Port: 8080, MetricsPort: 8081},
+				JWT:           config.JWTConfig{IssuerURL: "http://test", Audience: "test", ClockSkew: 30 * time.Second},
+				JWKS:          config.JWKSConfig{URL: "http://test", RefreshInterval: time.Hour, MinRefreshInterval: 10 * time.Second},
+				RateLimit:     config.RateLimitConfig{FailureThreshold: 10, Window: time.Minute, Cooldown: 5 * time.Minute},
+				Observability: config.ObservabilityConfig{ServiceName: "bff", PrometheusPort: 9090},
+				Backend:       config.BackendConfig{UserServiceURL: "http://user:50051", RequestTimeout: 10 * time.Second},
+				ProductCache: config.ProductCacheConfig{
+					Enabled: true, ProductTTL: 5 * time.Minute, InventoryTTL: 30 * time.Second,
+					MaxEntries: 10000, WebhookTolerance: 5 * time.Minute,
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "token_denylist_without_webhook_secret",
+			cfg: config.Config{
+				Server:        config.ServerConfig{Port: 8080, MetricsPort: 8081},
+				JWT:           config.JWTConfig{IssuerURL: "http://test", Audience: "test", ClockSkew: 30 * time.Second},
+				JWKS:          config.JWKSConfig{URL: "http://test", RefreshInterval: time.Hour, MinRefreshInterval: 10 * time.Second},
+				RateLimit:     config.RateLimitConfig{FailureThreshold: 10, Window: time.Minute, Cooldown: 5 * time.Minute},
+				Observability: config.ObservabilityConfig{ServiceName: "bff", PrometheusPort: 9090},
+				Backend:       config.BackendConfig{UserServiceURL: "http://user:50051", RequestTimeout: 10 * time.Second},
+				TokenDenylist: config.TokenDenylistConfig{Enabled: true, TTL: time.Hour, WebhookTolerance: 5 * time.Minute},
+			},
+			wantErr: true,
+		},
+		{
+			name: "token_denylist_ttl_too_short",
+			cfg: config.Config{
+				Server:        config.ServerConfig{Port: 8080, MetricsPort: 8081},
+				JWT:           config.JWTConfig{IssuerURL: "http://test", Audience: "test", ClockSkew: 30 * time.Second},
+				JWKS:          config.JWKSConfig{URL: "http://test", RefreshInterval: time.Hour, MinRefreshInterval: 10 * time.Second},
+				RateLimit:     config.RateLimitConfig{FailureThreshold: 10, Window: time.Minute, Cooldown: 5 * time.Minute},
+				Observability: config.ObservabilityConfig{ServiceName: "bff", PrometheusPort: 9090},
+				Backend:       config.BackendConfig{UserServiceURL: "http://user:50051", RequestTimeout: 10 * time.Second},
+				TokenDenylist: config.TokenDenylistConfig{
+					Enabled: true, TTL: time.Second, WebhookSecret: "whsec_test", WebhookTolerance: 5 * time.Minute,
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "siem_syslog_without_addr",
+			cfg: config.Config{
+				Server:        config.ServerConfig{Port: 8080, MetricsPort: 8081},
+				JWT:           config.JWTConfig{IssuerURL: "http://test", Audience: "test", ClockSkew: 30 * time.Second},
+				JWKS:          config.JWKSConfig{URL: "http://test", RefreshInterval: time.Hour, MinRefreshInterval: 10 * time.Second},
+				RateLimit:     config.RateLimitConfig{FailureThreshold: 10, Window: time.Minute, Cooldown: 5 * time.Minute},
+				Observability: config.ObservabilityConfig{ServiceName: "bff", PrometheusPort: 9090},
+				Backend:       config.BackendConfig{UserServiceURL: "http://user:50051", RequestTimeout: 10 * time.Second},
+				SIEM: config.SIEMConfig{
+					Enabled: true, Sink: "syslog", SyslogNetwork: "tcp",
+					BufferSize: 100, BatchSize: 10, FlushInterval: time.Second, Timeout: time.Second, MaxBackoff: time.Second,
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "service_auth_client_without_secret",
+			cfg: config.Config{
+				Server:        config.ServerConfig{Port: 8080, MetricsPort: 8081},
+				JWT:           config.JWTConfig{IssuerURL: "http://test", Audience: "test", ClockSkew: 30 * time.Second},
+				JWKS:          config.JWKSConfig{URL: "http://test", RefreshInterval: time.Hour, MinRefreshInterval: 10 * time.Second},
+				RateLimit:     config.RateLimitConfig{FailureThreshold: 10, Window: time.Minute, Cooldown: 5 * time.Minute},
+				Observability: config.ObservabilityConfig{ServiceName: "bff", PrometheusPort: 9090},
+				Backend:       config.BackendConfig{UserServiceURL: "http://user:50051", RequestTimeout: 10 * time.Second},
+				ServiceAuth:   config.ServiceAuthConfig{TokenURL: "http://hydra:4444/oauth2/token", ClientID: "bff"},
+			},
+			wantErr: true,
+		},
+		{
+			name: "backend_tls_with_cleartext_url",
+			cfg: config.Config{
+				Server:        config.ServerConfig{Port: 8080, MetricsPort: 8081},
+				JWT:           config.JWTConfig{IssuerURL: "http://test", Audience: "test", ClockSkew: 30 * time.Second},
+				JWKS:          config.JWKSConfig{URL: "http://test", RefreshInterval: time.Hour, MinRefreshInterval: 10 * time.Second},
+				RateLimit:     config.RateLimitConfig{FailureThreshold: 10, Window: time.Minute, Cooldown: 5 * time.Minute},
+				Observability: config.ObservabilityConfig{ServiceName: "bff", PrometheusPort: 9090},
+				Backend:       config.BackendConfig{UserServiceURL: "http://user:50051", RequestTimeout: 10 * time.Second},
+				ServiceAuth:   config.ServiceAuthConfig{TLSCertFile: "bff.pem", TLSKeyFile: "bff-key.pem"},
+			},
+			wantErr: true,
+		},
 	}
 
 	for _, tt := range tests {
@@ -444,6 +697,339 @@ func TestConfig_GetPublicEndpoints(t *testing.T) {
 	}
 }
 
+func TestConfig_GetMethodPermissions(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected map[string]string
+		wantErr  bool
+	}{
+		{
+			name:     "empty_string",
+			input:    "",
+			expected: map[string]string{},
+		},
+		{
+			name:  "multiple_entries",
+			input: "/user.v1.UserService/ListUsers=users:list, /user.v1.UserService/GetUser = users:read",
+			expected: map[string]string{
+				"/user.v1.UserService/ListUsers": "users:list",
+				"/user.v1.UserService/GetUser":   "users:read",
+			},
+		},
+		{
+			name:    "missing_permission",
+			input:   "/user.v1.UserService/ListUsers=",
+			wantErr: true,
+		},
+		{
+			name:    "missing_separator",
+			input:   "/user.v1.UserService/ListUsers",
+			wantErr: true,
+		},
+		{
+			name:    "relative_procedure",
+			input:   "user.v1.UserService/ListUsers=users:list",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := &config.Config{
+				RBAC: config.RBACConfig{
+					Policy: tt.input,
+				},
+			}
+
+			got, err := cfg.GetMethodPermissions()
+			if tt.wantErr {
+				if err == nil {
+					t.Error("GetMethodPermissions() expected error, got nil")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("GetMethodPermissions() unexpected error: %v", err)
+			}
+
+			if len(got) != len(tt.expected) {
+				t.Errorf("GetMethodPermissions() returned %d entries, expected %d", len(got), len(tt.expected))
+				return
+			}
+
+			for procedure, permission := range tt.expected {
+				if got[procedure] != permission {
+					t.Errorf("GetMethodPermissions()[%s] = %s, expected %s", procedure, got[procedure], permission)
+				}
+			}
+		})
+	}
+}
+
+func TestConfig_GetSLOObjectives(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected map[string]config.SLOObjective
+		wantErr  bool
+	}{
+		{
+			name:     "empty_string",
+			input:    "",
+			expected: map[string]config.SLOObjective{},
+		},
+		{
+			name:  "multiple_entries",
+			input: "/user.v1.UserService/GetUser=0.999:200ms, /user.v1.UserService/ListUsers = 0.99:1s",
+			expected: map[string]config.SLOObjective{
+				"/user.v1.UserService/GetUser":   {Availability: 0.999, Latency: 200 * time.Millisecond},
+				"/user.v1.UserService/ListUsers": {Availability: 0.99, Latency: time.Second},
+			},
+		},
+		{
+			name:    "missing_latency",
+			input:   "/user.v1.UserService/GetUser=0.999",
+			wantErr: true,
+		},
+		{
+			name:    "availability_out_of_range",
+			input:   "/user.v1.UserService/GetUser=99.9:200ms",
+			wantErr: true,
+		},
+		{
+			name:    "invalid_latency",
+			input:   "/user.v1.UserService/GetUser=0.999:fast",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := &config.Config{
+				SLO: config.SLOConfig{
+					Objectives: tt.input,
+				},
+			}
+
+			got, err := cfg.GetSLOObjectives()
+			if tt.wantErr {
+				if err == nil {
+					t.Error("GetSLOObjectives() expected error, got nil")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("GetSLOObjectives() unexpected error: %v", err)
+			}
+			if len(got) != len(tt.expected) {
+				t.Fatalf("GetSLOObjectives() returned %d entries, want %d", len(got), len(tt.expected))
+			}
+			for procedure, want := range tt.expected {
+				if got[procedure] != want {
+					t.Errorf("GetSLOObjectives()[%s] = %+v, want %+v", procedure, got[procedure], want)
+				}
+			}
+		})
+	}
+}
+
+func TestConfig_GetQuotaLimits(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected map[string]config.QuotaLimit
+		wantErr  bool
+	}{
+		{
+			name:     "empty_string",
+			input:    "",
+			expected: map[string]config.QuotaLimit{},
+		},
+		{
+			name:  "multiple_entries",
+			input: "/order.v1.OrderService/CreateOrder=5/1m, /user.v1.UserService/UpdateUser = 10/1h",
+			expected: map[string]config.QuotaLimit{
+				"/order.v1.OrderService/CreateOrder": {Requests: 5, Window: time.Minute},
+				"/user.v1.UserService/UpdateUser":    {Requests: 10, Window: time.Hour},
+			},
+		},
+		{
+			name:    "missing_window",
+			input:   "/order.v1.OrderService/CreateOrder=5",
+			wantErr: true,
+		},
+		{
+			name:    "zero_requests",
+			input:   "/order.v1.OrderService/CreateOrder=0/1m",
+			wantErr: true,
+		},
+		{
+			name:    "window_too_short",
+			input:   "/order.v1.OrderService/CreateOrder=5/100ms",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := &config.Config{
+				Quota: config.QuotaConfig{
+					Limits: tt.input,
+				},
+			}
+
+			got, err := cfg.GetQuotaLimits()
+			if tt.wantErr {
+				if err == nil {
+					t.Error("GetQuotaLimits() expected error, got nil")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("GetQuotaLimits() unexpected error: %v", err)
+			}
+			if len(got) != len(tt.expected) {
+				t.Fatalf("GetQuotaLimits() returned %d entries, want %d", len(got), len(tt.expected))
+			}
+			for procedure, want := range tt.expected {
+				if got[procedure] != want {
+					t.Errorf("GetQuotaLimits()[%s] = %+v, want %+v", procedure, got[procedure], want)
+				}
+			}
+		})
+	}
+}
+
+func TestConfig_GetBackendTimeoutOverrides(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected map[string]time.Duration
+		wantErr  bool
+	}{
+		{
+			name:     "empty_string",
+			input:    "",
+			expected: map[string]time.Duration{},
+		},
+		{
+			name:  "multiple_entries",
+			input: "/user.v1.UserService/ListUsers=3s, /user.v1.UserService/GetBatchJobReport = 30s",
+			expected: map[string]time.Duration{
+				"/user.v1.UserService/ListUsers":         3 * time.Second,
+				"/user.v1.UserService/GetBatchJobReport": 30 * time.Second,
+			},
+		},
+		{
+			name:    "missing_slash",
+			input:   "user.v1.UserService/ListUsers=3s",
+			wantErr: true,
+		},
+		{
+			name:    "zero_timeout",
+			input:   "/user.v1.UserService/ListUsers=0s",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := &config.Config{
+				Backend: config.BackendConfig{
+					TimeoutOverrides: tt.input,
+				},
+			}
+
+			got, err := cfg.GetBackendTimeoutOverrides()
+			if tt.wantErr {
+				if err == nil {
+					t.Error("GetBackendTimeoutOverrides() expected error, got nil")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("GetBackendTimeoutOverrides() unexpected error: %v", err)
+			}
+			if len(got) != len(tt.expected) {
+				t.Fatalf("GetBackendTimeoutOverrides() returned %d entries, want %d", len(got), len(tt.expected))
+			}
+			for procedure, want := range tt.expected {
+				if got[procedure] != want {
+					t.Errorf("GetBackendTimeoutOverrides()[%s] = %v, want %v", procedure, got[procedure], want)
+				}
+			}
+		})
+	}
+}
+
+func TestConfig_GetUserServiceEndpoints(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected []config.RegionalEndpoint
+		wantErr  bool
+	}{
+		{
+			name:     "empty_string",
+			input:    "",
+			expected: nil,
+		},
+		{
+			name:  "multiple_entries",
+			input: "ap-northeast-1=http://user-apne1:50051, us-west-2 = http://user-usw2:50051",
+			expected: []config.RegionalEndpoint{
+				{Region: "ap-northeast-1", URL: "http://user-apne1:50051"},
+				{Region: "us-west-2", URL: "http://user-usw2:50051"},
+			},
+		},
+		{
+			name:    "missing_region",
+			input:   "=http://user-apne1:50051",
+			wantErr: true,
+		},
+		{
+			name:    "url_with_path",
+			input:   "ap-northeast-1=http://user-apne1:50051/api",
+			wantErr: true,
+		},
+		{
+			name:    "url_without_scheme",
+			input:   "ap-northeast-1=user-apne1:50051",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := &config.Config{
+				Backend: config.BackendConfig{
+					UserServiceEndpoints: tt.input,
+				},
+			}
+
+			got, err := cfg.GetUserServiceEndpoints()
+			if tt.wantErr {
+				if err == nil {
+					t.Error("GetUserServiceEndpoints() expected error, got nil")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("GetUserServiceEndpoints() unexpected error: %v", err)
+			}
+			if len(got) != len(tt.expected) {
+				t.Fatalf("GetUserServiceEndpoints() returned %d entries, want %d", len(got), len(tt.expected))
+			}
+			for i := range tt.expected {
+				if got[i] != tt.expected[i] {
+					t.Errorf("GetUserServiceEndpoints()[%d] = %+v, want %+v", i, got[i], tt.expected[i])
+				}
+			}
+		})
+	}
+}
+
 func TestConfig_HeadersToSanitize(t *testing.T) {
 	cfg := &config.Config{}
 
@@ -0,0 +1,86 @@
+// Package denylist rejects access tokens revoked at the authorization
+// server before they expire. Tokens are validated locally from their
+// signature, so a consent or login session revoked in Hydra would
+// otherwise keep working until the token expires. The User Service relays
+// Hydra's revocation events to EventHandler, and every token of the user
+// (and client, if the revocation names one) issued before the revocation
+// is rejected.
+package denylist
+
+import (
+	"sync"
+	"time"
+)
+
+// List holds revocations for as long as tokens issued before them can be
+// valid.
+type List struct {
+	// ttl is the longest lifetime of an access token.
+	ttl time.Duration
+	now func() time.Time
+
+	mu sync.Mutex
+	// revoked maps a user ID, or user and client ID, to the time tokens
+	// issued up to were revoked.
+	revoked map[key]time.Time
+}
+
+type key struct {
+	userID   string
+	clientID string
+}
+
+// New creates a list that keeps revocations for ttl, which must be at
+// least the access token lifespan configured in Hydra.
+func New(ttl time.Duration) *List {
+	return &List{
+		ttl:     ttl,
+		now:     time.Now,
+		revoked: make(map[key]time.Time),
+	}
+}
+
+// Revoke rejects the tokens of userID issued up to at: of clientID, or of
+// every client if clientID is empty.
+func (l *List) Revoke(userID, clientID string, at time.Time) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.pruneLocked()
+	k := key{userID: userID, clientID: clientID}
+	if at.After(l.revoked[k]) {
+		l.revoked[k] = at
+	}
+}
+
+// IsRevoked reports whether a token of userID and clientID issued at
+// issuedAt was revoked. Token times have second precision, so a token
+// issued in the second of a revocation counts as revoked.
+func (l *List) IsRevoked(userID, clientID string, issuedAt time.Time) bool {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	for _, k := range []key{{userID: userID}, {userID: userID, clientID: clientID}} {
+		if at, ok := l.revoked[k]; ok && !issuedAt.After(at.Truncate(time.Second)) {
+			return true
+		}
+	}
+	return false
+}
+
+// Len returns the number of revocations held.
+func (l *List) Len() int {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return len(l.revoked)
+}
+
+// pruneLocked drops revocations older than any valid token.
+func (l *List) pruneLocked() {
+	cutoff := l.now().Add(-l.ttl)
+	for k, at := range l.revoked {
+		if at.Before(cutoff) {
+			delete(l.revoked, k)
+		}
+	}
+}
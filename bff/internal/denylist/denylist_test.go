@@ -0,0 +1,135 @@
+package denylist
+
+import (
+	"bytes"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/daisuke8000/example-ec-platform/pkg/webhook"
+)
+
+const testSecret = "whsec_test"
+
+func TestList_IsRevoked(t *testing.T) {
+	revokedAt := time.Date(2025, 1, 2, 3, 4, 5, 500_000_000, time.UTC)
+	l := New(time.Hour)
+	l.now = func() time.Time { return revokedAt }
+	l.Revoke("user-1", "web", revokedAt)
+	l.Revoke("user-2", "", revokedAt)
+
+	tests := []struct {
+		name     string
+		userID   string
+		clientID string
+		issuedAt time.Time
+		want     bool
+	}{
+		{"client token issued before", "user-1", "web", revokedAt.Add(-time.Minute), true},
+		{"client token issued in the same second", "user-1", "web", revokedAt.Truncate(time.Second), true},
+		{"client token issued after", "user-1", "web", revokedAt.Add(time.Second), false},
+		{"other client", "user-1", "mobile", revokedAt.Add(-time.Minute), false},
+		{"every client", "user-2", "mobile", revokedAt.Add(-time.Minute), true},
+		{"other user", "user-3", "web", revokedAt.Add(-time.Minute), false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := l.IsRevoked(tt.userID, tt.clientID, tt.issuedAt); got != tt.want {
+				t.Errorf("IsRevoked() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestList_KeepsLatestRevocation(t *testing.T) {
+	now := time.Now()
+	l := New(time.Hour)
+	l.Revoke("user-1", "", now)
+	l.Revoke("user-1", "", now.Add(-time.Minute))
+
+	if !l.IsRevoked("user-1", "web", now.Add(-time.Second)) {
+		t.Error("an earlier revocation replaced a later one")
+	}
+}
+
+func TestList_DropsExpiredRevocations(t *testing.T) {
+	now := time.Now()
+	l := New(time.Hour)
+	l.now = func() time.Time { return now }
+	l.Revoke("user-1", "", now.Add(-2*time.Hour))
+	l.Revoke("user-2", "", now)
+
+	if n := l.Len(); n != 1 {
+		t.Errorf("Len() = %d, want 1", n)
+	}
+}
+
+func deliver(t *testing.T, h http.Handler, secret, body string) *httptest.ResponseRecorder {
+	t.Helper()
+	now := time.Now()
+	req := httptest.NewRequest(http.MethodPost, "/webhooks/user-events", bytes.NewBufferString(body))
+	req.Header.Set(webhook.HeaderTimestamp, strconv.FormatInt(now.Unix(), 10))
+	req.Header.Set(webhook.HeaderSignature, webhook.Sign(secret, now, []byte(body)))
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+	return rec
+}
+
+func TestList_EventHandler(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	now := time.Now().UTC()
+	revokedAt := now.Format(time.RFC3339Nano)
+
+	tests := []struct {
+		name       string
+		secret     string
+		body       string
+		wantStatus int
+		wantLen    int
+	}{
+		{
+			name:       "tokens_revoked",
+			secret:     testSecret,
+			body:       `{"id":"e1","type":"user.tokens_revoked","occurred_at":"` + revokedAt + `","data":{"user_id":"user-1","client_id":"web","revoked_at":"` + revokedAt + `"}}`,
+			wantStatus: http.StatusNoContent,
+			wantLen:    1,
+		},
+		{
+			name:       "other_type_ignored",
+			secret:     testSecret,
+			body:       `{"id":"e2","type":"user.created","occurred_at":"` + revokedAt + `","data":{}}`,
+			wantStatus: http.StatusNoContent,
+		},
+		{
+			name:       "invalid_signature",
+			secret:     "other",
+			body:       `{"id":"e3","type":"user.tokens_revoked","occurred_at":"` + revokedAt + `","data":{"user_id":"user-1","revoked_at":"` + revokedAt + `"}}`,
+			wantStatus: http.StatusUnauthorized,
+		},
+		{
+			name:       "missing_user",
+			secret:     testSecret,
+			body:       `{"id":"e4","type":"user.tokens_revoked","occurred_at":"` + revokedAt + `","data":{"revoked_at":"` + revokedAt + `"}}`,
+			wantStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			l := New(time.Hour)
+			h := l.EventHandler(EventsConfig{Secret: testSecret, Tolerance: time.Minute}, logger)
+
+			rec := deliver(t, h, tt.secret, tt.body)
+			if rec.Code != tt.wantStatus {
+				t.Fatalf("status = %d, want %d", rec.Code, tt.wantStatus)
+			}
+			if n := l.Len(); n != tt.wantLen {
+				t.Errorf("Len() = %d, want %d", n, tt.wantLen)
+			}
+		})
+	}
+}
@@ -0,0 +1,88 @@
+package denylist
+
+import (
+	"encoding/json"
+	"io"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/daisuke8000/example-ec-platform/pkg/webhook"
+)
+
+// maxEventBytes bounds the body of a webhook delivery.
+const maxEventBytes = 64 << 10
+
+// eventTokensRevoked is the User Service event that revokes tokens.
+const eventTokensRevoked = "user.tokens_revoked"
+
+// EventsConfig configures the webhook receiver.
+type EventsConfig struct {
+	// Secret is the signing secret shared with the User Service
+	// (TOKEN_REVOCATION_WEBHOOK_SECRET).
+	Secret string
+	// Tolerance bounds the age of a delivery's signature.
+	Tolerance time.Duration
+}
+
+// envelope is the body of a User Service webhook delivery.
+type envelope struct {
+	ID         string          `json:"id"`
+	Type       string          `json:"type"`
+	OccurredAt time.Time       `json:"occurred_at"`
+	Data       json.RawMessage `json:"data"`
+}
+
+type revokedData struct {
+	UserID    string    `json:"user_id"`
+	ClientID  string    `json:"client_id"`
+	RevokedAt time.Time `json:"revoked_at"`
+}
+
+// EventHandler returns the endpoint the User Service delivers token
+// revocations to. Deliveries must be signed with cfg.Secret; events of
+// other types are acknowledged and ignored. Revoking is idempotent, so
+// redelivered events are harmless.
+func (l *List) EventHandler(cfg EventsConfig, logger *slog.Logger) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			w.Header().Set("Allow", http.MethodPost)
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+
+		body, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxEventBytes))
+		if err != nil {
+			http.Error(w, "request body too large", http.StatusRequestEntityTooLarge)
+			return
+		}
+		if err := webhook.Verify(cfg.Secret, r.Header.Get(webhook.HeaderTimestamp), r.Header.Get(webhook.HeaderSignature), body, cfg.Tolerance); err != nil {
+			logger.Warn("rejected user event", slog.String("error", err.Error()))
+			http.Error(w, "invalid signature", http.StatusUnauthorized)
+			return
+		}
+
+		var event envelope
+		if err := json.Unmarshal(body, &event); err != nil {
+			http.Error(w, "malformed event", http.StatusBadRequest)
+			return
+		}
+		if event.Type != eventTokensRevoked {
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+		var data revokedData
+		if err := json.Unmarshal(event.Data, &data); err != nil || data.UserID == "" || data.RevokedAt.IsZero() {
+			http.Error(w, "malformed event", http.StatusBadRequest)
+			return
+		}
+
+		l.Revoke(data.UserID, data.ClientID, data.RevokedAt)
+		logger.Info("tokens revoked",
+			slog.String("user_id", data.UserID),
+			slog.String("client_id", data.ClientID),
+			slog.Time("revoked_at", data.RevokedAt),
+		)
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
@@ -0,0 +1,140 @@
+// Package errmsg localizes the errors end users see. Backends attach a
+// stable code (see pkg/connect/errcode) to such errors; the BFF looks the
+// code up in the catalog of the caller's Accept-Language and adds the
+// message as a google.rpc.LocalizedMessage detail next to the code, so
+// frontends can show it as is.
+package errmsg
+
+import (
+	"context"
+	"embed"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"path"
+	"strings"
+
+	"connectrpc.com/connect"
+	"golang.org/x/text/language"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+
+	"github.com/daisuke8000/example-ec-platform/pkg/connect/errcode"
+)
+
+// DefaultLocale is used when Accept-Language matches no catalog, and for
+// codes missing from the matched catalog.
+const DefaultLocale = "en"
+
+//go:embed catalogs/*.json
+var catalogFS embed.FS
+
+// Catalog holds the messages of every supported locale, keyed by code.
+type Catalog struct {
+	locales  []string
+	messages map[string]map[string]string
+	matcher  language.Matcher
+}
+
+// Load parses the embedded catalogs, one JSON file per locale.
+func Load() (*Catalog, error) {
+	entries, err := catalogFS.ReadDir("catalogs")
+	if err != nil {
+		return nil, err
+	}
+
+	c := &Catalog{messages: make(map[string]map[string]string)}
+	for _, entry := range entries {
+		data, err := catalogFS.ReadFile(path.Join("catalogs", entry.Name()))
+		if err != nil {
+			return nil, err
+		}
+		var messages map[string]string
+		if err := json.Unmarshal(data, &messages); err != nil {
+			return nil, fmt.Errorf("invalid catalog %s: %w", entry.Name(), err)
+		}
+		c.messages[strings.TrimSuffix(entry.Name(), ".json")] = messages
+	}
+	if _, ok := c.messages[DefaultLocale]; !ok {
+		return nil, fmt.Errorf("catalog for default locale %q is missing", DefaultLocale)
+	}
+
+	// The matcher falls back to its first tag.
+	c.locales = []string{DefaultLocale}
+	for locale := range c.messages {
+		if locale != DefaultLocale {
+			c.locales = append(c.locales, locale)
+		}
+	}
+	tags := make([]language.Tag, len(c.locales))
+	for i, locale := range c.locales {
+		tags[i] = language.Make(locale)
+	}
+	c.matcher = language.NewMatcher(tags)
+	return c, nil
+}
+
+// Locale returns the supported locale that best matches an Accept-Language
+// header.
+func (c *Catalog) Locale(acceptLanguage string) string {
+	tags, _, err := language.ParseAcceptLanguage(acceptLanguage)
+	if err != nil || len(tags) == 0 {
+		return DefaultLocale
+	}
+	_, i, _ := c.matcher.Match(tags...)
+	return c.locales[i]
+}
+
+// Message returns the message for code in locale, with {key} placeholders
+// replaced by metadata. It falls back to DefaultLocale and reports false for
+// unknown codes.
+func (c *Catalog) Message(locale, code string, metadata map[string]string) (string, string, bool) {
+	msg, ok := c.messages[locale][code]
+	if !ok {
+		locale = DefaultLocale
+		msg, ok = c.messages[locale][code]
+		if !ok {
+			return "", "", false
+		}
+	}
+	for key, value := range metadata {
+		msg = strings.ReplaceAll(msg, "{"+key+"}", value)
+	}
+	return locale, msg, true
+}
+
+// Interceptor returns an interceptor that adds a LocalizedMessage detail to
+// errors carrying an error code. It should run before the other
+// interceptors so their errors are localized too.
+func (c *Catalog) Interceptor() connect.UnaryInterceptorFunc {
+	return func(next connect.UnaryFunc) connect.UnaryFunc {
+		return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+			resp, err := next(ctx, req)
+			if err != nil {
+				c.localize(req.Header().Get("Accept-Language"), err)
+			}
+			return resp, err
+		}
+	}
+}
+
+func (c *Catalog) localize(acceptLanguage string, err error) {
+	info, ok := errcode.Info(err)
+	if !ok {
+		return
+	}
+	locale, msg, ok := c.Message(c.Locale(acceptLanguage), info.GetReason(), info.GetMetadata())
+	if !ok {
+		return
+	}
+	detail, detailErr := connect.NewErrorDetail(&errdetails.LocalizedMessage{
+		Locale:  locale,
+		Message: msg,
+	})
+	if detailErr != nil {
+		return
+	}
+	var connectErr *connect.Error
+	if errors.As(err, &connectErr) {
+		connectErr.AddDetail(detail)
+	}
+}
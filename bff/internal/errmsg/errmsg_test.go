@@ -0,0 +1,135 @@
+package errmsg
+
+import (
+	"context"
+	"errors"
+	"regexp"
+	"slices"
+	"testing"
+
+	"connectrpc.com/connect"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+
+	"github.com/daisuke8000/example-ec-platform/pkg/connect/errcode"
+)
+
+var placeholder = regexp.MustCompile(`\{[a-z_]+\}`)
+
+func TestCatalogs_Complete(t *testing.T) {
+	c, err := Load()
+	if err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+
+	codes := []string{errcode.OutOfStock, errcode.CouponExpired, errcode.PasswordEmpty, errcode.PasswordTooShort, errcode.AccountLocked,
+		errcode.PasswordTooWeak, errcode.PasswordBreached}
+	for locale, messages := range c.messages {
+		for _, code := range codes {
+			msg, ok := messages[code]
+			if !ok {
+				t.Errorf("%s: missing message for %s", locale, code)
+				continue
+			}
+			want := placeholder.FindAllString(c.messages[DefaultLocale][code], -1)
+			got := placeholder.FindAllString(msg, -1)
+			slices.Sort(want)
+			slices.Sort(got)
+			if !slices.Equal(got, want) {
+				t.Errorf("%s: %s has placeholders %v, want %v", locale, code, got, want)
+			}
+		}
+		for code := range messages {
+			if !slices.Contains(codes, code) {
+				t.Errorf("%s: message for unknown code %s", locale, code)
+			}
+		}
+	}
+}
+
+func TestCatalog_Locale(t *testing.T) {
+	c, err := Load()
+	if err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+
+	tests := []struct {
+		acceptLanguage string
+		want           string
+	}{
+		{"", "en"},
+		{"ja", "ja"},
+		{"ja-JP,ja;q=0.9,en;q=0.8", "ja"},
+		{"en-US,en;q=0.9,ja;q=0.5", "en"},
+		{"fr-FR,ja;q=0.5", "ja"},
+		{"de", "en"},
+		{"not a language;;", "en"},
+	}
+	for _, tt := range tests {
+		if got := c.Locale(tt.acceptLanguage); got != tt.want {
+			t.Errorf("Locale(%q) = %q, want %q", tt.acceptLanguage, got, tt.want)
+		}
+	}
+}
+
+func TestCatalog_Interceptor(t *testing.T) {
+	c, err := Load()
+	if err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+
+	tests := []struct {
+		name        string
+		err         error
+		wantLocale  string
+		wantMessage string
+	}{
+		{
+			name: "coded_error",
+			err: errcode.New(connect.CodeInvalidArgument, errors.New("password must be at least 8 characters"),
+				errcode.PasswordTooShort, map[string]string{"min_length": "8"}),
+			wantLocale:  "ja",
+			wantMessage: "パスワードは 8 文字以上で入力してください。",
+		},
+		{
+			name: "error_without_code",
+			err:  connect.NewError(connect.CodeNotFound, errors.New("user not found")),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			next := connect.UnaryFunc(func(context.Context, connect.AnyRequest) (connect.AnyResponse, error) {
+				return nil, tt.err
+			})
+			req := connect.NewRequest(&errdetails.ErrorInfo{})
+			req.Header().Set("Accept-Language", "ja-JP,en;q=0.5")
+
+			_, err := c.Interceptor()(next)(context.Background(), req)
+
+			var connectErr *connect.Error
+			if !errors.As(err, &connectErr) {
+				t.Fatalf("error is not a Connect error: %v", err)
+			}
+			var localized *errdetails.LocalizedMessage
+			for _, detail := range connectErr.Details() {
+				value, _ := detail.Value()
+				if msg, ok := value.(*errdetails.LocalizedMessage); ok {
+					localized = msg
+				}
+			}
+			if tt.wantMessage == "" {
+				if localized != nil {
+					t.Errorf("unexpected localized message %v", localized)
+				}
+				return
+			}
+			if localized == nil {
+				t.Fatal("no localized message")
+			}
+			if localized.GetLocale() != tt.wantLocale || localized.GetMessage() != tt.wantMessage {
+				t.Errorf("localized = (%q, %q), want (%q, %q)",
+					localized.GetLocale(), localized.GetMessage(), tt.wantLocale, tt.wantMessage)
+			}
+		})
+	}
+}
@@ -0,0 +1,353 @@
+// Package graphql serves a small GraphQL subset over Connect services.
+//
+// Only queries are supported. Root fields are resolved by calling Connect
+// procedures, and the protojson form of the response is projected onto the
+// requested selection, so the schema follows the proto messages: field
+// names are lowerCamelCase and 64-bit integers are strings.
+package graphql
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"slices"
+	"sync"
+
+	"connectrpc.com/connect"
+)
+
+// Resolver resolves a root field. It returns a JSON value as decoded by
+// encoding/json: map[string]any, []any, string, float64, bool or nil.
+type Resolver func(ctx context.Context, args map[string]any) (any, error)
+
+// RootField is a field of the Query type.
+type RootField struct {
+	// Args lists the accepted argument names.
+	Args    []string
+	Resolve Resolver
+}
+
+// Schema maps Query field names to their definitions.
+type Schema map[string]RootField
+
+// Request is a GraphQL request as sent over HTTP.
+type Request struct {
+	Query         string         `json:"query"`
+	OperationName string         `json:"operationName,omitempty"`
+	Variables     map[string]any `json:"variables,omitempty"`
+}
+
+// Response is a GraphQL response. Data is omitted when the request failed
+// before execution.
+type Response struct {
+	Data   any      `json:"data,omitempty"`
+	Errors []*Error `json:"errors,omitempty"`
+}
+
+type Location struct {
+	Line   int `json:"line"`
+	Column int `json:"column"`
+}
+
+// Error is a GraphQL error. Extensions["code"] holds the Connect error code
+// of failed resolvers.
+type Error struct {
+	Message    string         `json:"message"`
+	Locations  []Location     `json:"locations,omitempty"`
+	Path       []any          `json:"path,omitempty"`
+	Extensions map[string]any `json:"extensions,omitempty"`
+}
+
+// Executor executes requests against a schema.
+type Executor struct {
+	schema   Schema
+	maxDepth int
+	logger   *slog.Logger
+}
+
+// NewExecutor creates an executor rejecting queries nested deeper than
+// maxDepth levels.
+func NewExecutor(schema Schema, maxDepth int, logger *slog.Logger) *Executor {
+	return &Executor{schema: schema, maxDepth: maxDepth, logger: logger}
+}
+
+// Execute parses, validates and executes req. Root fields are resolved
+// concurrently; a failed field is null and reported in Errors.
+func (e *Executor) Execute(ctx context.Context, req *Request) *Response {
+	doc, err := Parse(req.Query)
+	if err != nil {
+		var syntaxErr *SyntaxError
+		if errors.As(err, &syntaxErr) {
+			return requestError(syntaxErr.Message, Location{Line: syntaxErr.Line, Column: syntaxErr.Column})
+		}
+		return requestError(err.Error())
+	}
+
+	op, err := selectOperation(doc, req.OperationName)
+	if err != nil {
+		return requestError(err.Error())
+	}
+	if errs := e.validate(op); len(errs) > 0 {
+		return &Response{Errors: errs}
+	}
+	vars, err := coerceVariables(op, req.Variables)
+	if err != nil {
+		return requestError(err.Error())
+	}
+
+	var (
+		wg      sync.WaitGroup
+		results = make([]any, len(op.Selection))
+		errs    = make([][]*Error, len(op.Selection))
+	)
+	for i, f := range op.Selection {
+		if f.Name == "__typename" {
+			results[i] = "Query"
+			continue
+		}
+		wg.Go(func() {
+			results[i], errs[i] = e.resolveRoot(ctx, f, vars)
+		})
+	}
+	wg.Wait()
+
+	resp := &Response{}
+	data := make(object, len(op.Selection))
+	for i, f := range op.Selection {
+		data[i] = member{key: f.ResponseKey(), value: results[i]}
+		resp.Errors = append(resp.Errors, errs[i]...)
+	}
+	resp.Data = data
+	return resp
+}
+
+func (e *Executor) resolveRoot(ctx context.Context, f *Field, vars map[string]any) (any, []*Error) {
+	path := []any{f.ResponseKey()}
+	args := make(map[string]any, len(f.Arguments))
+	for _, arg := range f.Arguments {
+		args[arg.Name] = substitute(arg.Value, vars)
+	}
+
+	value, err := e.schema[f.Name].Resolve(ctx, args)
+	if err != nil {
+		return nil, []*Error{e.resolverError(ctx, f, path, err)}
+	}
+	return project(value, f, path)
+}
+
+func (e *Executor) resolverError(ctx context.Context, f *Field, path []any, err error) *Error {
+	gqlErr := &Error{
+		Locations: []Location{{Line: f.Line, Column: f.Column}},
+		Path:      path,
+	}
+	var connectErr *connect.Error
+	if errors.As(err, &connectErr) && connectErr.Code() != connect.CodeInternal && connectErr.Code() != connect.CodeUnknown {
+		gqlErr.Message = connectErr.Message()
+		gqlErr.Extensions = map[string]any{"code": connectErr.Code().String()}
+		return gqlErr
+	}
+	e.logger.ErrorContext(ctx, "graphql resolver failed",
+		slog.String("field", f.Name),
+		slog.String("error", err.Error()),
+	)
+	gqlErr.Message = "internal server error"
+	gqlErr.Extensions = map[string]any{"code": connect.CodeInternal.String()}
+	return gqlErr
+}
+
+func requestError(message string, locations ...Location) *Response {
+	return &Response{Errors: []*Error{{Message: message, Locations: locations}}}
+}
+
+func selectOperation(doc *Document, name string) (*Operation, error) {
+	if name == "" {
+		if len(doc.Operations) > 1 {
+			return nil, errors.New("operationName is required for documents with multiple operations")
+		}
+		return doc.Operations[0], nil
+	}
+	for _, op := range doc.Operations {
+		if op.Name == name {
+			return op, nil
+		}
+	}
+	return nil, fmt.Errorf("unknown operation %q", name)
+}
+
+// validate checks root fields, arguments, depth and response key conflicts.
+// Nested fields are checked against the resolved data during execution.
+func (e *Executor) validate(op *Operation) []*Error {
+	var errs []*Error
+	fail := func(f *Field, format string, args ...any) {
+		errs = append(errs, &Error{
+			Message:   fmt.Sprintf(format, args...),
+			Locations: []Location{{Line: f.Line, Column: f.Column}},
+		})
+	}
+
+	for _, f := range op.Selection {
+		if f.Name == "__typename" {
+			continue
+		}
+		def, ok := e.schema[f.Name]
+		if !ok {
+			fail(f, "Cannot query field %q on type \"Query\"", f.Name)
+			continue
+		}
+		for _, arg := range f.Arguments {
+			if !slices.Contains(def.Args, arg.Name) {
+				fail(f, "Unknown argument %q on field \"Query.%s\"", arg.Name, f.Name)
+			}
+		}
+	}
+
+	var walk func(fields []*Field, depth int)
+	walk = func(fields []*Field, depth int) {
+		seen := make(map[string]bool, len(fields))
+		for _, f := range fields {
+			if seen[f.ResponseKey()] {
+				fail(f, "Field %q conflicts with another field; use an alias", f.ResponseKey())
+			}
+			seen[f.ResponseKey()] = true
+			if depth > e.maxDepth {
+				fail(f, "Query exceeds the maximum depth of %d", e.maxDepth)
+				return
+			}
+			if depth > 1 && len(f.Arguments) > 0 {
+				fail(f, "Field %q does not accept arguments", f.Name)
+			}
+			walk(f.Selection, depth+1)
+		}
+	}
+	walk(op.Selection, 1)
+	return errs
+}
+
+func coerceVariables(op *Operation, values map[string]any) (map[string]any, error) {
+	vars := make(map[string]any, len(op.Variables))
+	for _, def := range op.Variables {
+		if v, ok := values[def.Name]; ok {
+			vars[def.Name] = v
+		} else {
+			vars[def.Name] = def.Default
+		}
+	}
+	for name := range values {
+		if _, ok := vars[name]; !ok {
+			return nil, fmt.Errorf("variable %q is not defined by the operation", name)
+		}
+	}
+	return vars, nil
+}
+
+// substitute replaces variable references in an argument value.
+// Undefined variables are null.
+func substitute(value any, vars map[string]any) any {
+	switch v := value.(type) {
+	case Variable:
+		return vars[string(v)]
+	case []any:
+		out := make([]any, len(v))
+		for i, item := range v {
+			out[i] = substitute(item, vars)
+		}
+		return out
+	case map[string]any:
+		out := make(map[string]any, len(v))
+		for k, item := range v {
+			out[k] = substitute(item, vars)
+		}
+		return out
+	}
+	return value
+}
+
+// project returns the parts of value selected by f.
+func project(value any, f *Field, path []any) (any, []*Error) {
+	fieldError := func(format string, args ...any) []*Error {
+		return []*Error{{
+			Message:   fmt.Sprintf(format, args...),
+			Locations: []Location{{Line: f.Line, Column: f.Column}},
+			Path:      path,
+		}}
+	}
+
+	switch v := value.(type) {
+	case nil:
+		return nil, nil
+	case []any:
+		out := make([]any, len(v))
+		var errs []*Error
+		for i, item := range v {
+			var itemErrs []*Error
+			out[i], itemErrs = project(item, f, append(slices.Clone(path), i))
+			errs = append(errs, itemErrs...)
+		}
+		return out, errs
+	case map[string]any:
+		if len(f.Selection) == 0 {
+			return nil, fieldError("Field %q of object type must have a selection of subfields", f.Name)
+		}
+		out := make(object, 0, len(f.Selection))
+		var errs []*Error
+		for _, sub := range f.Selection {
+			key := sub.ResponseKey()
+			if sub.Name == "__typename" {
+				// Object types are not named in this schema.
+				out = append(out, member{key: key, value: nil})
+				continue
+			}
+			subValue, ok := v[sub.Name]
+			if !ok {
+				return nil, []*Error{{
+					Message:   fmt.Sprintf("Cannot query field %q on %q", sub.Name, f.Name),
+					Locations: []Location{{Line: sub.Line, Column: sub.Column}},
+					Path:      path,
+				}}
+			}
+			projected, subErrs := project(subValue, sub, append(slices.Clone(path), key))
+			out = append(out, member{key: key, value: projected})
+			errs = append(errs, subErrs...)
+		}
+		return out, errs
+	default:
+		if len(f.Selection) > 0 {
+			return nil, fieldError("Field %q must not have a selection since it is a scalar", f.Name)
+		}
+		return v, nil
+	}
+}
+
+// object is a JSON object that keeps the order of the query's selection.
+type object []member
+
+type member struct {
+	key   string
+	value any
+}
+
+func (o object) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	buf.WriteByte('{')
+	for i, m := range o {
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+		key, err := json.Marshal(m.key)
+		if err != nil {
+			return nil, err
+		}
+		value, err := json.Marshal(m.value)
+		if err != nil {
+			return nil, err
+		}
+		buf.Write(key)
+		buf.WriteByte(':')
+		buf.Write(value)
+	}
+	buf.WriteByte('}')
+	return buf.Bytes(), nil
+}
@@ -0,0 +1,207 @@
+package graphql_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+
+	"connectrpc.com/connect"
+
+	storefrontv1 "github.com/daisuke8000/example-ec-platform/gen/storefront/v1"
+	"github.com/daisuke8000/example-ec-platform/gen/storefront/v1/storefrontv1connect"
+	userv1 "github.com/daisuke8000/example-ec-platform/gen/user/v1"
+
+	"github.com/daisuke8000/example-ec-platform/bff/internal/client"
+	"github.com/daisuke8000/example-ec-platform/bff/internal/graphql"
+)
+
+func newTestLogger() *slog.Logger {
+	return slog.New(slog.NewTextHandler(os.Stdout, &slog.HandlerOptions{Level: slog.LevelError}))
+}
+
+func testSchema() graphql.Schema {
+	return graphql.Schema{
+		"product": {
+			Args: []string{"id"},
+			Resolve: func(_ context.Context, args map[string]any) (any, error) {
+				if args["id"] != "p1" {
+					return nil, connect.NewError(connect.CodeNotFound, errors.New("product not found"))
+				}
+				return map[string]any{
+					"id":   "p1",
+					"name": "Tee",
+					"skus": []any{
+						map[string]any{"id": "s1", "price": "1200"},
+						map[string]any{"id": "s2", "price": "1500"},
+					},
+				}, nil
+			},
+		},
+		"broken": {
+			Resolve: func(context.Context, map[string]any) (any, error) {
+				return nil, errors.New("dial tcp: connection refused")
+			},
+		},
+	}
+}
+
+func execute(t *testing.T, req *graphql.Request) string {
+	t.Helper()
+	executor := graphql.NewExecutor(testSchema(), 3, newTestLogger())
+	out, err := json.Marshal(executor.Execute(context.Background(), req))
+	if err != nil {
+		t.Fatalf("marshal response: %v", err)
+	}
+	return string(out)
+}
+
+func TestExecutor_Execute(t *testing.T) {
+	tests := []struct {
+		name string
+		req  graphql.Request
+		want string
+	}{
+		{
+			name: "selection order and aliases",
+			req: graphql.Request{
+				Query:     `query Page($id: ID!) { item: product(id: $id) { skus { price id } name } }`,
+				Variables: map[string]any{"id": "p1"},
+			},
+			want: `{"data":{"item":{"skus":[{"price":"1200","id":"s1"},{"price":"1500","id":"s2"}],"name":"Tee"}}}`,
+		},
+		{
+			name: "variable default",
+			req:  graphql.Request{Query: `query ($id: ID = "p1") { product(id: $id) { id } }`},
+			want: `{"data":{"product":{"id":"p1"}}}`,
+		},
+		{
+			name: "resolver error keeps other fields",
+			req:  graphql.Request{Query: `{ a: product(id: "p1") { id } b: product(id: "nope") { id } }`},
+			want: `{"data":{"a":{"id":"p1"},"b":null},"errors":[{"message":"product not found","locations":[{"line":1,"column":31}],"path":["b"],"extensions":{"code":"not_found"}}]}`,
+		},
+		{
+			name: "internal errors are not exposed",
+			req:  graphql.Request{Query: `{ broken }`},
+			want: `{"data":{"broken":null},"errors":[{"message":"internal server error","locations":[{"line":1,"column":3}],"path":["broken"],"extensions":{"code":"internal"}}]}`,
+		},
+		{
+			name: "unknown nested field",
+			req:  graphql.Request{Query: `{ product(id: "p1") { id color } }`},
+			want: `{"data":{"product":null},"errors":[{"message":"Cannot query field \"color\" on \"product\"","locations":[{"line":1,"column":26}],"path":["product"]}]}`,
+		},
+		{
+			name: "unknown root field",
+			req:  graphql.Request{Query: `{ cart { id } }`},
+			want: `{"errors":[{"message":"Cannot query field \"cart\" on type \"Query\"","locations":[{"line":1,"column":3}]}]}`,
+		},
+		{
+			name: "object without selection",
+			req:  graphql.Request{Query: `{ product(id: "p1") }`},
+			want: `{"data":{"product":null},"errors":[{"message":"Field \"product\" of object type must have a selection of subfields","locations":[{"line":1,"column":3}],"path":["product"]}]}`,
+		},
+		{
+			name: "depth limit",
+			req:  graphql.Request{Query: `{ product(id: "p1") { skus { id { x } } } }`},
+			want: `{"errors":[{"message":"Query exceeds the maximum depth of 3","locations":[{"line":1,"column":35}]}]}`,
+		},
+		{
+			name: "fragments rejected",
+			req:  graphql.Request{Query: `{ product(id: "p1") { ...F } }`},
+			want: `{"errors":[{"message":"fragments are not supported","locations":[{"line":1,"column":23}]}]}`,
+		},
+		{
+			name: "mutations rejected",
+			req:  graphql.Request{Query: `mutation { product(id: "p1") { id } }`},
+			want: `{"errors":[{"message":"mutation operations are not supported","locations":[{"line":1,"column":1}]}]}`,
+		},
+		{
+			name: "operation name required",
+			req:  graphql.Request{Query: `query A { broken } query B { broken }`},
+			want: `{"errors":[{"message":"operationName is required for documents with multiple operations"}]}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := execute(t, &tt.req); got != tt.want {
+				t.Errorf("response mismatch\n got: %s\nwant: %s", got, tt.want)
+			}
+		})
+	}
+}
+
+type stubStorefrontHandler struct {
+	storefrontv1connect.UnimplementedStorefrontServiceHandler
+}
+
+func (stubStorefrontHandler) GetMe(_ context.Context, req *connect.Request[storefrontv1.GetMeRequest]) (*connect.Response[storefrontv1.GetMeResponse], error) {
+	if req.Header().Get("Authorization") != "Bearer token" {
+		return nil, connect.NewError(connect.CodeUnauthenticated, errors.New("authentication required"))
+	}
+	return connect.NewResponse(&storefrontv1.GetMeResponse{
+		User: &userv1.User{Id: "u1", Email: "user@example.com"},
+	}), nil
+}
+
+func TestHandler_ForwardsCredentials(t *testing.T) {
+	_, storefront := storefrontv1connect.NewStorefrontServiceHandler(stubStorefrontHandler{})
+	storefrontClient := storefrontv1connect.NewStorefrontServiceClient(
+		&http.Client{Transport: client.InProcessTransport{Handler: storefront}},
+		"http://bff.internal",
+		connect.WithInterceptors(graphql.ForwardHeaders("Authorization")),
+	)
+	executor := graphql.NewExecutor(graphql.StorefrontSchema(storefrontClient), 10, newTestLogger())
+	h := graphql.NewHandler(executor, newTestLogger())
+
+	tests := []struct {
+		name          string
+		authorization string
+		want          string
+	}{
+		{
+			name:          "with token",
+			authorization: "Bearer token",
+			want:          `{"data":{"me":{"id":"u1","email":"user@example.com"}}}`,
+		},
+		{
+			name: "without token",
+			want: `{"data":{"me":null},"errors":[{"message":"authentication required","locations":[{"line":1,"column":3}],"path":["me"],"extensions":{"code":"unauthenticated"}}]}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodPost, "/graphql", strings.NewReader(`{"query":"{ me { id email } }"}`))
+			req.Header.Set("Content-Type", "application/json")
+			if tt.authorization != "" {
+				req.Header.Set("Authorization", tt.authorization)
+			}
+			rec := httptest.NewRecorder()
+			h.ServeHTTP(rec, req)
+
+			if rec.Code != http.StatusOK {
+				t.Fatalf("status = %d, want %d", rec.Code, http.StatusOK)
+			}
+			if got := strings.TrimSpace(rec.Body.String()); got != tt.want {
+				t.Errorf("response mismatch\n got: %s\nwant: %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestHandler_RejectsNonPost(t *testing.T) {
+	h := graphql.NewHandler(graphql.NewExecutor(testSchema(), 10, newTestLogger()), newTestLogger())
+
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/graphql?query={broken}", nil))
+
+	if rec.Code != http.StatusMethodNotAllowed {
+		t.Errorf("status = %d, want %d", rec.Code, http.StatusMethodNotAllowed)
+	}
+}
@@ -0,0 +1,92 @@
+package graphql
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"mime"
+	"net/http"
+
+	"connectrpc.com/connect"
+
+	"github.com/daisuke8000/example-ec-platform/bff/internal/client"
+)
+
+// maxRequestBytes bounds the size of a GraphQL request body.
+const maxRequestBytes = 1 << 20
+
+type inboundHeaderKey struct{}
+
+// Handler serves GraphQL queries over HTTP POST with JSON bodies.
+type Handler struct {
+	executor *Executor
+	logger   *slog.Logger
+}
+
+func NewHandler(executor *Executor, logger *slog.Logger) *Handler {
+	return &Handler{executor: executor, logger: logger}
+}
+
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		w.Header().Set("Allow", http.MethodPost)
+		h.writeResponse(w, http.StatusMethodNotAllowed, requestError("GraphQL requests must use POST"))
+		return
+	}
+	if mediaType, _, _ := mime.ParseMediaType(r.Header.Get("Content-Type")); mediaType != "application/json" {
+		h.writeResponse(w, http.StatusUnsupportedMediaType, requestError("Content-Type must be application/json"))
+		return
+	}
+
+	var req Request
+	if err := json.NewDecoder(http.MaxBytesReader(w, r.Body, maxRequestBytes)).Decode(&req); err != nil {
+		var maxBytesErr *http.MaxBytesError
+		if errors.As(err, &maxBytesErr) {
+			h.writeResponse(w, http.StatusRequestEntityTooLarge, requestError("request body too large"))
+			return
+		}
+		h.writeResponse(w, http.StatusBadRequest, requestError("invalid JSON request body"))
+		return
+	}
+	if req.Query == "" {
+		h.writeResponse(w, http.StatusBadRequest, requestError("query is required"))
+		return
+	}
+
+	// Resolvers call the BFF's own Connect handlers, which authenticate and
+	// rate-limit the original caller.
+	ctx := context.WithValue(r.Context(), inboundHeaderKey{}, r.Header)
+	ctx = client.WithRemoteAddr(ctx, r.RemoteAddr)
+
+	h.writeResponse(w, http.StatusOK, h.executor.Execute(ctx, &req))
+}
+
+func (h *Handler) writeResponse(w http.ResponseWriter, status int, resp *Response) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	if err := json.NewEncoder(w).Encode(resp); err != nil {
+		h.logger.Error("failed to write graphql response", slog.String("error", err.Error()))
+	}
+}
+
+// ForwardHeaders copies the named headers of the GraphQL HTTP request to
+// outgoing Connect calls, so resolvers act with the caller's credentials.
+// Empty names are ignored.
+func ForwardHeaders(names ...string) connect.UnaryInterceptorFunc {
+	return func(next connect.UnaryFunc) connect.UnaryFunc {
+		return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+			if inbound, ok := ctx.Value(inboundHeaderKey{}).(http.Header); ok {
+				for _, name := range names {
+					if name == "" {
+						continue
+					}
+					if v := inbound.Get(name); v != "" {
+						req.Header().Set(name, v)
+					}
+				}
+			}
+			return next(ctx, req)
+		}
+	}
+}
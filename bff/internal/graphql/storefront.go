@@ -0,0 +1,164 @@
+package graphql
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math"
+
+	"connectrpc.com/connect"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+
+	storefrontv1 "github.com/daisuke8000/example-ec-platform/gen/storefront/v1"
+	"github.com/daisuke8000/example-ec-platform/gen/storefront/v1/storefrontv1connect"
+)
+
+// StorefrontSchema exposes storefront.v1.StorefrontService as Query fields:
+//
+//	productPage(id: ID!): GetProductPageResponse
+//	products(pageSize: Int, pageToken: String, categoryId: ID,
+//	         searchQuery: String, orderBy: String): ListProductsResponse
+//	categories: [Category]
+//	me: User
+//
+// The cart is not exposed yet: it belongs to the Order Service.
+func StorefrontSchema(storefront storefrontv1connect.StorefrontServiceClient) Schema {
+	return Schema{
+		"productPage": {
+			Args: []string{"id"},
+			Resolve: func(ctx context.Context, args map[string]any) (any, error) {
+				id, err := stringArg(args, "id")
+				if err != nil {
+					return nil, err
+				}
+				if id == nil {
+					return nil, invalidArgument("argument \"id\" is required")
+				}
+				resp, err := storefront.GetProductPage(ctx, connect.NewRequest(&storefrontv1.GetProductPageRequest{ProductId: *id}))
+				if err != nil {
+					return nil, err
+				}
+				return toJSON(resp.Msg)
+			},
+		},
+		"products": {
+			Args: []string{"pageSize", "pageToken", "categoryId", "searchQuery", "orderBy"},
+			Resolve: func(ctx context.Context, args map[string]any) (any, error) {
+				pageSize, err := intArg(args, "pageSize")
+				if err != nil {
+					return nil, err
+				}
+				pageToken, err := stringArg(args, "pageToken")
+				if err != nil {
+					return nil, err
+				}
+				categoryID, err := stringArg(args, "categoryId")
+				if err != nil {
+					return nil, err
+				}
+				searchQuery, err := stringArg(args, "searchQuery")
+				if err != nil {
+					return nil, err
+				}
+				orderBy, err := stringArg(args, "orderBy")
+				if err != nil {
+					return nil, err
+				}
+
+				req := &storefrontv1.ListProductsRequest{
+					PageSize:    pageSize,
+					CategoryId:  categoryID,
+					SearchQuery: searchQuery,
+				}
+				if pageToken != nil {
+					req.PageToken = *pageToken
+				}
+				if orderBy != nil {
+					req.OrderBy = *orderBy
+				}
+
+				resp, err := storefront.ListProducts(ctx, connect.NewRequest(req))
+				if err != nil {
+					return nil, err
+				}
+				return toJSON(resp.Msg)
+			},
+		},
+		"categories": {
+			Resolve: func(ctx context.Context, _ map[string]any) (any, error) {
+				resp, err := storefront.ListCategories(ctx, connect.NewRequest(&storefrontv1.ListCategoriesRequest{}))
+				if err != nil {
+					return nil, err
+				}
+				page, err := toJSON(resp.Msg)
+				if err != nil {
+					return nil, err
+				}
+				return page.(map[string]any)["categories"], nil
+			},
+		},
+		"me": {
+			Resolve: func(ctx context.Context, _ map[string]any) (any, error) {
+				resp, err := storefront.GetMe(ctx, connect.NewRequest(&storefrontv1.GetMeRequest{}))
+				if err != nil {
+					return nil, err
+				}
+				if resp.Msg.GetUser() == nil {
+					return nil, nil
+				}
+				return toJSON(resp.Msg.GetUser())
+			},
+		},
+	}
+}
+
+// toJSON converts msg to its protojson form with every field present, so
+// that any field of the message can be selected.
+func toJSON(msg proto.Message) (any, error) {
+	raw, err := protojson.MarshalOptions{EmitUnpopulated: true}.Marshal(msg)
+	if err != nil {
+		return nil, err
+	}
+	var v any
+	if err := json.Unmarshal(raw, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+func invalidArgument(format string, args ...any) error {
+	return connect.NewError(connect.CodeInvalidArgument, fmt.Errorf(format, args...))
+}
+
+// stringArg returns the named argument, or nil if it is absent or null.
+func stringArg(args map[string]any, name string) (*string, error) {
+	switch v := args[name].(type) {
+	case nil:
+		return nil, nil
+	case string:
+		return &v, nil
+	default:
+		return nil, invalidArgument("argument %q must be a string", name)
+	}
+}
+
+// intArg returns the named argument, or 0 if it is absent or null.
+// Literals parse as int64 and JSON variables decode as float64.
+func intArg(args map[string]any, name string) (int32, error) {
+	var n float64
+	switch v := args[name].(type) {
+	case nil:
+		return 0, nil
+	case int64:
+		n = float64(v)
+	case float64:
+		n = v
+	default:
+		return 0, invalidArgument("argument %q must be an integer", name)
+	}
+	if n != math.Trunc(n) || n < math.MinInt32 || n > math.MaxInt32 {
+		return 0, invalidArgument("argument %q must be a 32-bit integer", name)
+	}
+	return int32(n), nil
+}
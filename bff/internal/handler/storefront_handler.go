@@ -0,0 +1,387 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"sync"
+
+	"connectrpc.com/connect"
+	"google.golang.org/protobuf/proto"
+
+	productv1 "github.com/daisuke8000/example-ec-platform/gen/product/v1"
+	"github.com/daisuke8000/example-ec-platform/gen/product/v1/productv1connect"
+	storefrontv1 "github.com/daisuke8000/example-ec-platform/gen/storefront/v1"
+	"github.com/daisuke8000/example-ec-platform/gen/storefront/v1/storefrontv1connect"
+	userv1 "github.com/daisuke8000/example-ec-platform/gen/user/v1"
+	"github.com/daisuke8000/example-ec-platform/gen/user/v1/userv1connect"
+	pkgmw "github.com/daisuke8000/example-ec-platform/pkg/connect/middleware"
+
+	"github.com/daisuke8000/example-ec-platform/bff/internal/authz"
+	"github.com/daisuke8000/example-ec-platform/bff/internal/stockdisplay"
+)
+
+var _ storefrontv1connect.StorefrontServiceHandler = (*StorefrontHandler)(nil)
+
+// maxInventoryLookups bounds the concurrent GetInventory calls per page.
+const maxInventoryLookups = 8
+
+// maxWatchedSKUs is the Product Service's limit of SKUs per WatchInventory.
+const maxWatchedSKUs = 100
+
+// StorefrontHandler composes storefront pages from the backend services.
+type StorefrontHandler struct {
+	storefrontv1connect.UnimplementedStorefrontServiceHandler
+	products   productv1connect.ProductServiceClient
+	inventory  productv1connect.InventoryServiceClient
+	favorites  productv1connect.FavoriteServiceClient
+	users      userv1connect.UserServiceClient
+	authorizer *authz.Authorizer
+	display    *stockdisplay.Policy
+	logger     *slog.Logger
+}
+
+func NewStorefrontHandler(
+	products productv1connect.ProductServiceClient,
+	inventory productv1connect.InventoryServiceClient,
+	favorites productv1connect.FavoriteServiceClient,
+	users userv1connect.UserServiceClient,
+	authorizer *authz.Authorizer,
+	display *stockdisplay.Policy,
+	logger *slog.Logger,
+) *StorefrontHandler {
+	return &StorefrontHandler{
+		products:   products,
+		inventory:  inventory,
+		favorites:  favorites,
+		users:      users,
+		authorizer: authorizer,
+		display:    display,
+		logger:     logger,
+	}
+}
+
+// GetProductPage fetches the product, then its category, favorite count and
+// the stock of each SKU in parallel. Only the product is required; failed
+// lookups are reported as partial failures. Stock is shown according to the
+// stock display policy.
+func (h *StorefrontHandler) GetProductPage(
+	ctx context.Context,
+	req *connect.Request[storefrontv1.GetProductPageRequest],
+) (*connect.Response[storefrontv1.GetProductPageResponse], error) {
+	productID := req.Msg.GetProductId()
+	if productID == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("product_id is required"))
+	}
+
+	productResp, err := h.products.GetProduct(ctx, connect.NewRequest(&productv1.GetProductRequest{Id: productID}))
+	if err != nil {
+		return nil, h.handleError(ctx, "GetProduct", err)
+	}
+	product := productResp.Msg.GetProduct()
+	skus := product.GetSkus()
+
+	var (
+		wg          sync.WaitGroup
+		category    *productv1.Category
+		categoryErr error
+		inventories = make([]*productv1.Inventory, len(skus))
+		stockErrs   = make([]error, len(skus))
+		favorites   int64
+		favoriteErr error
+	)
+
+	if categoryID := product.GetCategoryId(); categoryID != "" {
+		wg.Go(func() {
+			resp, err := h.products.GetCategory(ctx, connect.NewRequest(&productv1.GetCategoryRequest{Id: categoryID}))
+			if err != nil {
+				categoryErr = err
+				return
+			}
+			category = resp.Msg.GetCategory()
+		})
+	}
+
+	wg.Go(func() {
+		resp, err := h.favorites.GetFavoriteCounts(ctx, connect.NewRequest(&productv1.GetFavoriteCountsRequest{ProductIds: []string{productID}}))
+		if err != nil {
+			favoriteErr = err
+			return
+		}
+		favorites = resp.Msg.GetCounts()[productID]
+	})
+
+	sem := make(chan struct{}, maxInventoryLookups)
+	for i, sku := range skus {
+		wg.Go(func() {
+			sem <- struct{}{}
+			defer func() { <-sem }()
+
+			resp, err := h.inventory.GetInventory(ctx, connect.NewRequest(&productv1.GetInventoryRequest{SkuId: sku.GetId()}))
+			switch {
+			case err == nil:
+				inventories[i] = resp.Msg.GetInventory()
+			case connect.CodeOf(err) == connect.CodeNotFound:
+				// No inventory record yet: known to be out of stock.
+			default:
+				stockErrs[i] = err
+			}
+		})
+	}
+	wg.Wait()
+
+	page := &storefrontv1.GetProductPageResponse{
+		Product:       product,
+		Category:      category,
+		FavoriteCount: favorites,
+	}
+	if categoryErr != nil {
+		page.PartialFailures = append(page.PartialFailures,
+			h.partialFailure(ctx, productv1connect.ProductServiceGetCategoryProcedure, product.GetCategoryId(), categoryErr))
+	}
+	if favoriteErr != nil {
+		page.PartialFailures = append(page.PartialFailures,
+			h.partialFailure(ctx, productv1connect.FavoriteServiceGetFavoriteCountsProcedure, productID, favoriteErr))
+	}
+	for i, sku := range skus {
+		availability := &storefrontv1.SKUAvailability{SkuId: sku.GetId()}
+		if stockErrs[i] != nil {
+			page.PartialFailures = append(page.PartialFailures,
+				h.partialFailure(ctx, productv1connect.InventoryServiceGetInventoryProcedure, sku.GetId(), stockErrs[i]))
+		} else {
+			if !h.display.HidesCounts(product.GetCategoryId()) {
+				sku.Inventory = inventories[i]
+			}
+			availability.Known = true
+			h.display.Apply(availability, inventories[i].GetAvailable(), product.GetCategoryId())
+		}
+		page.InStock = page.InStock || availability.InStock
+		page.Availability = append(page.Availability, availability)
+	}
+
+	return connect.NewResponse(page), nil
+}
+
+// WatchProductAvailability watches the stock of the product's SKUs and
+// sends a SKU's availability whenever its display changes. Changes the stock
+// display policy hides, such as 40 to 39 above the display cap, are not
+// sent, so the stream reveals no more than the product page.
+func (h *StorefrontHandler) WatchProductAvailability(
+	ctx context.Context,
+	req *connect.Request[storefrontv1.WatchProductAvailabilityRequest],
+	stream *connect.ServerStream[storefrontv1.WatchProductAvailabilityResponse],
+) error {
+	productID := req.Msg.GetProductId()
+	if productID == "" {
+		return connect.NewError(connect.CodeInvalidArgument, errors.New("product_id is required"))
+	}
+
+	productResp, err := h.products.GetProduct(ctx, connect.NewRequest(&productv1.GetProductRequest{Id: productID}))
+	if err != nil {
+		return h.handleError(ctx, "GetProduct", err)
+	}
+	product := productResp.Msg.GetProduct()
+	skuIDs := make([]string, 0, len(product.GetSkus()))
+	for _, sku := range product.GetSkus() {
+		skuIDs = append(skuIDs, sku.GetId())
+	}
+	switch {
+	case len(skuIDs) == 0:
+		// Nothing can come into stock.
+		return nil
+	case len(skuIDs) > maxWatchedSKUs:
+		return connect.NewError(connect.CodeFailedPrecondition,
+			fmt.Errorf("products with more than %d SKUs cannot be watched", maxWatchedSKUs))
+	}
+
+	changes, err := h.inventory.WatchInventory(ctx, connect.NewRequest(&productv1.WatchInventoryRequest{SkuIds: skuIDs}))
+	if err != nil {
+		return h.handleError(ctx, "WatchInventory", err)
+	}
+	defer changes.Close()
+
+	sent := make(map[string]*storefrontv1.SKUAvailability, len(skuIDs))
+	for changes.Receive() {
+		inventory := changes.Msg().GetInventory()
+		availability := &storefrontv1.SKUAvailability{SkuId: inventory.GetSkuId(), Known: true}
+		h.display.Apply(availability, inventory.GetAvailable(), product.GetCategoryId())
+		if last, ok := sent[availability.SkuId]; ok && proto.Equal(last, availability) {
+			continue
+		}
+		if err := stream.Send(&storefrontv1.WatchProductAvailabilityResponse{Availability: availability}); err != nil {
+			return err
+		}
+		sent[availability.SkuId] = availability
+	}
+	if err := changes.Err(); err != nil && ctx.Err() == nil {
+		return h.handleError(ctx, "WatchInventory", err)
+	}
+	// The client went away
+	return nil
+}
+
+// ListProducts lists products visible to customers. The Product Service
+// restricts callers without the admin role to published products.
+func (h *StorefrontHandler) ListProducts(
+	ctx context.Context,
+	req *connect.Request[storefrontv1.ListProductsRequest],
+) (*connect.Response[storefrontv1.ListProductsResponse], error) {
+	resp, err := h.products.ListProducts(ctx, connect.NewRequest(&productv1.ListProductsRequest{
+		PageSize:    req.Msg.GetPageSize(),
+		PageToken:   req.Msg.GetPageToken(),
+		CategoryId:  req.Msg.CategoryId,
+		SearchQuery: req.Msg.SearchQuery,
+		OrderBy:     req.Msg.GetOrderBy(),
+	}))
+	if err != nil {
+		return nil, h.handleError(ctx, "ListProducts", err)
+	}
+	return connect.NewResponse(&storefrontv1.ListProductsResponse{
+		Products:      resp.Msg.GetProducts(),
+		NextPageToken: resp.Msg.GetNextPageToken(),
+	}), nil
+}
+
+func (h *StorefrontHandler) ListCategories(
+	ctx context.Context,
+	_ *connect.Request[storefrontv1.ListCategoriesRequest],
+) (*connect.Response[storefrontv1.ListCategoriesResponse], error) {
+	resp, err := h.products.ListCategories(ctx, connect.NewRequest(&productv1.ListCategoriesRequest{}))
+	if err != nil {
+		return nil, h.handleError(ctx, "ListCategories", err)
+	}
+	return connect.NewResponse(&storefrontv1.ListCategoriesResponse{Categories: resp.Msg.GetCategories()}), nil
+}
+
+// GetMe returns the user identified by the access token.
+func (h *StorefrontHandler) GetMe(
+	ctx context.Context,
+	_ *connect.Request[storefrontv1.GetMeRequest],
+) (*connect.Response[storefrontv1.GetMeResponse], error) {
+	userID := pkgmw.GetUserID(ctx)
+	if userID == "" {
+		return nil, authz.ErrUnauthenticated
+	}
+
+	resp, err := h.users.GetUser(ctx, connect.NewRequest(&userv1.GetUserRequest{Id: userID}))
+	if err != nil {
+		return nil, h.handleError(ctx, "GetMe", err)
+	}
+	return connect.NewResponse(&storefrontv1.GetMeResponse{User: resp.Msg.GetUser()}), nil
+}
+
+// AddFavorite lets users add to their own wishlist only.
+func (h *StorefrontHandler) AddFavorite(
+	ctx context.Context,
+	req *connect.Request[storefrontv1.AddFavoriteRequest],
+) (*connect.Response[storefrontv1.AddFavoriteResponse], error) {
+	if err := h.authorizer.RequireSelf(ctx, req.Msg.GetUserId()); err != nil {
+		h.logAuthzError(ctx, "AddFavorite", req.Msg.GetUserId(), err)
+		return nil, err
+	}
+
+	_, err := h.favorites.AddFavorite(ctx, connect.NewRequest(&productv1.AddFavoriteRequest{
+		UserId:    req.Msg.GetUserId(),
+		ProductId: req.Msg.GetProductId(),
+	}))
+	if err != nil {
+		return nil, h.handleError(ctx, "AddFavorite", err)
+	}
+	return connect.NewResponse(&storefrontv1.AddFavoriteResponse{}), nil
+}
+
+// RemoveFavorite lets users remove from their own wishlist only.
+func (h *StorefrontHandler) RemoveFavorite(
+	ctx context.Context,
+	req *connect.Request[storefrontv1.RemoveFavoriteRequest],
+) (*connect.Response[storefrontv1.RemoveFavoriteResponse], error) {
+	if err := h.authorizer.RequireSelf(ctx, req.Msg.GetUserId()); err != nil {
+		h.logAuthzError(ctx, "RemoveFavorite", req.Msg.GetUserId(), err)
+		return nil, err
+	}
+
+	_, err := h.favorites.RemoveFavorite(ctx, connect.NewRequest(&productv1.RemoveFavoriteRequest{
+		UserId:    req.Msg.GetUserId(),
+		ProductId: req.Msg.GetProductId(),
+	}))
+	if err != nil {
+		return nil, h.handleError(ctx, "RemoveFavorite", err)
+	}
+	return connect.NewResponse(&storefrontv1.RemoveFavoriteResponse{}), nil
+}
+
+// ListFavorites lets users list their own wishlist only. Wishlists are
+// private even to admins, like login sessions.
+func (h *StorefrontHandler) ListFavorites(
+	ctx context.Context,
+	req *connect.Request[storefrontv1.ListFavoritesRequest],
+) (*connect.Response[storefrontv1.ListFavoritesResponse], error) {
+	if err := h.authorizer.RequireSelf(ctx, req.Msg.GetUserId()); err != nil {
+		h.logAuthzError(ctx, "ListFavorites", req.Msg.GetUserId(), err)
+		return nil, err
+	}
+
+	resp, err := h.favorites.ListFavorites(ctx, connect.NewRequest(&productv1.ListFavoritesRequest{
+		UserId:    req.Msg.GetUserId(),
+		PageSize:  req.Msg.GetPageSize(),
+		PageToken: req.Msg.GetPageToken(),
+	}))
+	if err != nil {
+		return nil, h.handleError(ctx, "ListFavorites", err)
+	}
+	return connect.NewResponse(&storefrontv1.ListFavoritesResponse{
+		Favorites:     resp.Msg.GetFavorites(),
+		NextPageToken: resp.Msg.GetNextPageToken(),
+	}), nil
+}
+
+func (h *StorefrontHandler) partialFailure(ctx context.Context, procedure, resourceID string, err error) *storefrontv1.PartialFailure {
+	code := connect.CodeOf(err)
+	if errors.Is(err, context.DeadlineExceeded) {
+		code = connect.CodeDeadlineExceeded
+	}
+	h.logger.WarnContext(ctx, "storefront lookup failed",
+		slog.String("procedure", procedure),
+		slog.String("resource_id", resourceID),
+		slog.String("error", err.Error()),
+	)
+	return &storefrontv1.PartialFailure{
+		Procedure:  procedure,
+		ResourceId: resourceID,
+		Code:       code.String(),
+	}
+}
+
+func (h *StorefrontHandler) logAuthzError(ctx context.Context, method, targetUserID string, err error) {
+	h.logger.WarnContext(ctx, "authorization denied",
+		slog.String("method", method),
+		slog.String("current_user_id", pkgmw.GetUserID(ctx)),
+		slog.String("target_user_id", targetUserID),
+		slog.String("reason", err.Error()),
+	)
+}
+
+func (h *StorefrontHandler) handleError(ctx context.Context, method string, err error) error {
+	var connectErr *connect.Error
+	if errors.As(err, &connectErr) {
+		if connectErr.Code() == connect.CodeInternal {
+			h.logger.ErrorContext(ctx, "internal error from backend service",
+				slog.String("method", method),
+				slog.String("error", err.Error()),
+			)
+			return connect.NewError(connect.CodeInternal, errors.New("internal server error"))
+		}
+		return connectErr
+	}
+
+	if errors.Is(err, context.DeadlineExceeded) {
+		return connect.NewError(connect.CodeDeadlineExceeded, errors.New("request timeout"))
+	}
+
+	h.logger.ErrorContext(ctx, "unexpected error from backend service",
+		slog.String("method", method),
+		slog.String("error", err.Error()),
+	)
+	return connect.NewError(connect.CodeInternal, errors.New("internal server error"))
+}
@@ -0,0 +1,432 @@
+package handler_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"testing"
+
+	"connectrpc.com/connect"
+	"google.golang.org/protobuf/proto"
+
+	productv1 "github.com/daisuke8000/example-ec-platform/gen/product/v1"
+	"github.com/daisuke8000/example-ec-platform/gen/product/v1/productv1connect"
+	storefrontv1 "github.com/daisuke8000/example-ec-platform/gen/storefront/v1"
+	"github.com/daisuke8000/example-ec-platform/gen/storefront/v1/storefrontv1connect"
+	pkgmw "github.com/daisuke8000/example-ec-platform/pkg/connect/middleware"
+
+	"github.com/daisuke8000/example-ec-platform/bff/internal/authz"
+	"github.com/daisuke8000/example-ec-platform/bff/internal/handler"
+	"github.com/daisuke8000/example-ec-platform/bff/internal/stockdisplay"
+)
+
+type mockProductServiceClient struct {
+	productv1connect.ProductServiceClient
+	products   map[string]*productv1.Product
+	categories map[string]*productv1.Category
+}
+
+func (m *mockProductServiceClient) GetProduct(_ context.Context, req *connect.Request[productv1.GetProductRequest]) (*connect.Response[productv1.GetProductResponse], error) {
+	product, ok := m.products[req.Msg.GetId()]
+	if !ok {
+		return nil, connect.NewError(connect.CodeNotFound, errors.New("product not found"))
+	}
+	return connect.NewResponse(&productv1.GetProductResponse{Product: product}), nil
+}
+
+func (m *mockProductServiceClient) GetCategory(_ context.Context, req *connect.Request[productv1.GetCategoryRequest]) (*connect.Response[productv1.GetCategoryResponse], error) {
+	category, ok := m.categories[req.Msg.GetId()]
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnavailable, errors.New("connection refused"))
+	}
+	return connect.NewResponse(&productv1.GetCategoryResponse{Category: category}), nil
+}
+
+type mockInventoryServiceClient struct {
+	productv1connect.InventoryServiceClient
+	available map[string]int64
+	errs      map[string]error
+}
+
+func (m *mockInventoryServiceClient) GetInventory(_ context.Context, req *connect.Request[productv1.GetInventoryRequest]) (*connect.Response[productv1.GetInventoryResponse], error) {
+	skuID := req.Msg.GetSkuId()
+	if err, ok := m.errs[skuID]; ok {
+		return nil, err
+	}
+	available, ok := m.available[skuID]
+	if !ok {
+		return nil, connect.NewError(connect.CodeNotFound, errors.New("inventory not found"))
+	}
+	return connect.NewResponse(&productv1.GetInventoryResponse{
+		Inventory: &productv1.Inventory{SkuId: skuID, Quantity: available, Available: available},
+	}), nil
+}
+
+type mockFavoriteServiceClient struct {
+	productv1connect.FavoriteServiceClient
+	counts   map[string]int64
+	countErr error
+	added    []*productv1.AddFavoriteRequest
+}
+
+func (m *mockFavoriteServiceClient) GetFavoriteCounts(_ context.Context, req *connect.Request[productv1.GetFavoriteCountsRequest]) (*connect.Response[productv1.GetFavoriteCountsResponse], error) {
+	if m.countErr != nil {
+		return nil, m.countErr
+	}
+	counts := make(map[string]int64)
+	for _, id := range req.Msg.GetProductIds() {
+		if count, ok := m.counts[id]; ok {
+			counts[id] = count
+		}
+	}
+	return connect.NewResponse(&productv1.GetFavoriteCountsResponse{Counts: counts}), nil
+}
+
+func (m *mockFavoriteServiceClient) AddFavorite(_ context.Context, req *connect.Request[productv1.AddFavoriteRequest]) (*connect.Response[productv1.AddFavoriteResponse], error) {
+	m.added = append(m.added, req.Msg)
+	return connect.NewResponse(&productv1.AddFavoriteResponse{}), nil
+}
+
+func (m *mockFavoriteServiceClient) ListFavorites(_ context.Context, req *connect.Request[productv1.ListFavoritesRequest]) (*connect.Response[productv1.ListFavoritesResponse], error) {
+	return connect.NewResponse(&productv1.ListFavoritesResponse{
+		Favorites: []*productv1.Favorite{{Product: &productv1.Product{Id: "product-1"}}},
+	}), nil
+}
+
+func newStorefrontHandler(inventory *mockInventoryServiceClient) *handler.StorefrontHandler {
+	return newStorefrontHandlerWithPolicy(inventory, stockdisplay.NewPolicy(3, 10, nil))
+}
+
+func newStorefrontHandlerWithPolicy(inventory productv1connect.InventoryServiceClient, display *stockdisplay.Policy) *handler.StorefrontHandler {
+	return newStorefrontHandlerWithFavorites(inventory, &mockFavoriteServiceClient{counts: map[string]int64{"product-1": 7}}, display)
+}
+
+func newStorefrontHandlerWithFavorites(inventory productv1connect.InventoryServiceClient, favorites *mockFavoriteServiceClient, display *stockdisplay.Policy) *handler.StorefrontHandler {
+	products := &mockProductServiceClient{
+		products: map[string]*productv1.Product{
+			"product-1": {
+				Id:         "product-1",
+				CategoryId: "category-1",
+				Skus: []*productv1.SKU{
+					{Id: "sku-1"},
+					{Id: "sku-2"},
+					{Id: "sku-3"},
+				},
+			},
+		},
+		categories: map[string]*productv1.Category{
+			"category-1": {Id: "category-1", Name: "Shirts"},
+		},
+	}
+	return handler.NewStorefrontHandler(
+		products,
+		inventory,
+		favorites,
+		&mockUserServiceClient{},
+		authz.NewAuthorizer(authz.DefaultPolicy()),
+		display,
+		newTestLogger(),
+	)
+}
+
+func TestStorefrontHandler_GetProductPage(t *testing.T) {
+	h := newStorefrontHandler(&mockInventoryServiceClient{
+		available: map[string]int64{"sku-1": 0, "sku-2": 5},
+	})
+
+	resp, err := h.GetProductPage(context.Background(), connect.NewRequest(&storefrontv1.GetProductPageRequest{ProductId: "product-1"}))
+	if err != nil {
+		t.Fatalf("GetProductPage() unexpected error: %v", err)
+	}
+
+	page := resp.Msg
+	if page.GetCategory().GetName() != "Shirts" {
+		t.Errorf("category = %v, want Shirts", page.GetCategory())
+	}
+	if !page.GetInStock() {
+		t.Error("in_stock = false, want true")
+	}
+	if len(page.GetPartialFailures()) != 0 {
+		t.Errorf("partial_failures = %v, want none", page.GetPartialFailures())
+	}
+
+	want := map[string]bool{"sku-1": false, "sku-2": true, "sku-3": false}
+	if len(page.GetAvailability()) != len(want) {
+		t.Fatalf("availability has %d entries, want %d", len(page.GetAvailability()), len(want))
+	}
+	for _, a := range page.GetAvailability() {
+		if !a.GetKnown() || a.GetInStock() != want[a.GetSkuId()] {
+			t.Errorf("availability[%s] = %+v, want known with in_stock=%v", a.GetSkuId(), a, want[a.GetSkuId()])
+		}
+	}
+	if got := page.GetProduct().GetSkus()[1].GetInventory().GetAvailable(); got != 5 {
+		t.Errorf("sku-2 inventory available = %d, want 5", got)
+	}
+	if page.GetFavoriteCount() != 7 {
+		t.Errorf("favorite_count = %d, want 7", page.GetFavoriteCount())
+	}
+}
+
+func TestStorefrontHandler_GetProductPage_StockDisplay(t *testing.T) {
+	inventory := &mockInventoryServiceClient{
+		available: map[string]int64{"sku-1": 2, "sku-2": 40},
+	}
+
+	resp, err := newStorefrontHandler(inventory).GetProductPage(context.Background(),
+		connect.NewRequest(&storefrontv1.GetProductPageRequest{ProductId: "product-1"}))
+	if err != nil {
+		t.Fatalf("GetProductPage() unexpected error: %v", err)
+	}
+	low, plenty := resp.Msg.GetAvailability()[0], resp.Msg.GetAvailability()[1]
+	if low.GetLevel() != storefrontv1.StockLevel_STOCK_LEVEL_LOW || low.GetDisplayQuantity() != 2 {
+		t.Errorf("availability[sku-1] = %+v, want low with 2 displayed", low)
+	}
+	if plenty.GetDisplayQuantity() != 10 || !plenty.GetMoreAvailable() {
+		t.Errorf("availability[sku-2] = %+v, want 10 displayed with more available", plenty)
+	}
+
+	// Counts are hidden for the category: levels only, no raw inventory.
+	hidden := newStorefrontHandlerWithPolicy(inventory, stockdisplay.NewPolicy(3, 10, []string{"category-1"}))
+	resp, err = hidden.GetProductPage(context.Background(),
+		connect.NewRequest(&storefrontv1.GetProductPageRequest{ProductId: "product-1"}))
+	if err != nil {
+		t.Fatalf("GetProductPage() unexpected error: %v", err)
+	}
+	for i, a := range resp.Msg.GetAvailability()[:2] {
+		if a.GetDisplayQuantity() != 0 || a.GetMoreAvailable() || !a.GetInStock() {
+			t.Errorf("availability[%s] = %+v, want in stock without a quantity", a.GetSkuId(), a)
+		}
+		if inv := resp.Msg.GetProduct().GetSkus()[i].GetInventory(); inv != nil {
+			t.Errorf("sku %s inventory = %+v, want unset", a.GetSkuId(), inv)
+		}
+	}
+}
+
+func TestStorefrontHandler_GetProductPage_PartialFailure(t *testing.T) {
+	h := newStorefrontHandler(&mockInventoryServiceClient{
+		available: map[string]int64{"sku-1": 3, "sku-3": 1},
+		errs: map[string]error{
+			"sku-2": connect.NewError(connect.CodeDeadlineExceeded, errors.New("no response within 1s")),
+		},
+	})
+
+	resp, err := h.GetProductPage(context.Background(), connect.NewRequest(&storefrontv1.GetProductPageRequest{ProductId: "product-1"}))
+	if err != nil {
+		t.Fatalf("GetProductPage() unexpected error: %v", err)
+	}
+
+	failures := resp.Msg.GetPartialFailures()
+	if len(failures) != 1 {
+		t.Fatalf("partial_failures = %v, want 1 entry", failures)
+	}
+	if failures[0].GetResourceId() != "sku-2" || failures[0].GetCode() != connect.CodeDeadlineExceeded.String() {
+		t.Errorf("partial_failures[0] = %+v, want sku-2 deadline_exceeded", failures[0])
+	}
+	if a := resp.Msg.GetAvailability()[1]; a.GetKnown() || a.GetInStock() {
+		t.Errorf("availability[sku-2] = %+v, want unknown", a)
+	}
+	if !resp.Msg.GetInStock() {
+		t.Error("in_stock = false, want true")
+	}
+}
+
+func TestStorefrontHandler_GetProductPage_NotFound(t *testing.T) {
+	h := newStorefrontHandler(&mockInventoryServiceClient{})
+
+	_, err := h.GetProductPage(context.Background(), connect.NewRequest(&storefrontv1.GetProductPageRequest{ProductId: "missing"}))
+	if connect.CodeOf(err) != connect.CodeNotFound {
+		t.Errorf("GetProductPage() code = %v, want NotFound", connect.CodeOf(err))
+	}
+
+	_, err = h.GetProductPage(context.Background(), connect.NewRequest(&storefrontv1.GetProductPageRequest{}))
+	if connect.CodeOf(err) != connect.CodeInvalidArgument {
+		t.Errorf("GetProductPage() without product_id code = %v, want InvalidArgument", connect.CodeOf(err))
+	}
+}
+
+func TestStorefrontHandler_GetProductPage_FavoriteCountFailure(t *testing.T) {
+	favorites := &mockFavoriteServiceClient{countErr: connect.NewError(connect.CodeUnavailable, errors.New("connection refused"))}
+	h := newStorefrontHandlerWithFavorites(&mockInventoryServiceClient{
+		available: map[string]int64{"sku-1": 1, "sku-2": 1, "sku-3": 1},
+	}, favorites, stockdisplay.NewPolicy(3, 10, nil))
+
+	resp, err := h.GetProductPage(context.Background(), connect.NewRequest(&storefrontv1.GetProductPageRequest{ProductId: "product-1"}))
+	if err != nil {
+		t.Fatalf("GetProductPage() unexpected error: %v", err)
+	}
+
+	failures := resp.Msg.GetPartialFailures()
+	if len(failures) != 1 || failures[0].GetProcedure() != productv1connect.FavoriteServiceGetFavoriteCountsProcedure {
+		t.Fatalf("partial_failures = %v, want the favorite count lookup", failures)
+	}
+	if resp.Msg.GetFavoriteCount() != 0 {
+		t.Errorf("favorite_count = %d, want 0", resp.Msg.GetFavoriteCount())
+	}
+}
+
+func TestStorefrontHandler_Favorites_SelfOnly(t *testing.T) {
+	favorites := &mockFavoriteServiceClient{}
+	h := newStorefrontHandlerWithFavorites(&mockInventoryServiceClient{}, favorites, stockdisplay.NewPolicy(3, 10, nil))
+
+	ctx := pkgmw.WithUserID(context.Background(), "user-123")
+	_, err := h.AddFavorite(ctx, connect.NewRequest(&storefrontv1.AddFavoriteRequest{UserId: "user-123", ProductId: "product-1"}))
+	if err != nil {
+		t.Fatalf("AddFavorite() unexpected error: %v", err)
+	}
+	if len(favorites.added) != 1 || favorites.added[0].GetUserId() != "user-123" {
+		t.Errorf("added = %v, want the favorite of user-123", favorites.added)
+	}
+
+	resp, err := h.ListFavorites(ctx, connect.NewRequest(&storefrontv1.ListFavoritesRequest{UserId: "user-123"}))
+	if err != nil {
+		t.Fatalf("ListFavorites() unexpected error: %v", err)
+	}
+	if len(resp.Msg.GetFavorites()) != 1 {
+		t.Errorf("favorites = %v, want 1 entry", resp.Msg.GetFavorites())
+	}
+
+	// Not even an admin may reach another user's wishlist.
+	admin := pkgmw.WithPermissions(pkgmw.WithUserID(context.Background(), "admin-user"), "users:list users:read")
+	_, err = h.ListFavorites(admin, connect.NewRequest(&storefrontv1.ListFavoritesRequest{UserId: "user-123"}))
+	if connect.CodeOf(err) != connect.CodePermissionDenied {
+		t.Errorf("ListFavorites() of another user code = %v, want PermissionDenied", connect.CodeOf(err))
+	}
+	_, err = h.AddFavorite(admin, connect.NewRequest(&storefrontv1.AddFavoriteRequest{UserId: "user-123", ProductId: "product-1"}))
+	if connect.CodeOf(err) != connect.CodePermissionDenied {
+		t.Errorf("AddFavorite() for another user code = %v, want PermissionDenied", connect.CodeOf(err))
+	}
+	if len(favorites.added) != 1 {
+		t.Errorf("added %d favorites, want 1", len(favorites.added))
+	}
+
+	_, err = h.RemoveFavorite(context.Background(), connect.NewRequest(&storefrontv1.RemoveFavoriteRequest{UserId: "user-123", ProductId: "product-1"}))
+	if connect.CodeOf(err) != connect.CodeUnauthenticated {
+		t.Errorf("RemoveFavorite() unauthenticated code = %v, want Unauthenticated", connect.CodeOf(err))
+	}
+}
+
+// watchInventoryServer streams changes to WatchInventory, then ends the
+// stream with err.
+type watchInventoryServer struct {
+	productv1connect.UnimplementedInventoryServiceHandler
+	changes []*productv1.Inventory
+	err     error
+	watched []string
+}
+
+func (s *watchInventoryServer) WatchInventory(_ context.Context, req *connect.Request[productv1.WatchInventoryRequest], stream *connect.ServerStream[productv1.WatchInventoryResponse]) error {
+	s.watched = req.Msg.GetSkuIds()
+	for _, inv := range s.changes {
+		if err := stream.Send(&productv1.WatchInventoryResponse{Inventory: inv}); err != nil {
+			return err
+		}
+	}
+	return s.err
+}
+
+// watchAvailability serves h and collects the availability it streams.
+func watchAvailability(t *testing.T, h *handler.StorefrontHandler, productID string) ([]*storefrontv1.SKUAvailability, error) {
+	t.Helper()
+	mux := http.NewServeMux()
+	mux.Handle(storefrontv1connect.NewStorefrontServiceHandler(h))
+	srv := httptest.NewServer(mux)
+	defer srv.Close()
+
+	client := storefrontv1connect.NewStorefrontServiceClient(srv.Client(), srv.URL)
+	stream, err := client.WatchProductAvailability(context.Background(),
+		connect.NewRequest(&storefrontv1.WatchProductAvailabilityRequest{ProductId: productID}))
+	if err != nil {
+		return nil, err
+	}
+	defer stream.Close()
+	var got []*storefrontv1.SKUAvailability
+	for stream.Receive() {
+		got = append(got, stream.Msg().GetAvailability())
+	}
+	return got, stream.Err()
+}
+
+func newWatchInventoryClient(t *testing.T, s *watchInventoryServer) productv1connect.InventoryServiceClient {
+	t.Helper()
+	mux := http.NewServeMux()
+	mux.Handle(productv1connect.NewInventoryServiceHandler(s))
+	srv := httptest.NewServer(mux)
+	t.Cleanup(srv.Close)
+	return productv1connect.NewInventoryServiceClient(srv.Client(), srv.URL)
+}
+
+func TestStorefrontHandler_WatchProductAvailability(t *testing.T) {
+	inventory := &watchInventoryServer{
+		changes: []*productv1.Inventory{
+			{SkuId: "sku-1", Available: 40},
+			{SkuId: "sku-2", Available: 0},
+			{SkuId: "sku-1", Available: 39}, // Still shown as 10+
+			{SkuId: "sku-1", Available: 2},
+			{SkuId: "sku-2", Available: 0},
+		},
+		err: connect.NewError(connect.CodeUnavailable, errors.New("inventory watch interrupted")),
+	}
+	h := newStorefrontHandlerWithPolicy(newWatchInventoryClient(t, inventory), stockdisplay.NewPolicy(3, 10, nil))
+
+	got, err := watchAvailability(t, h, "product-1")
+	if connect.CodeOf(err) != connect.CodeUnavailable {
+		t.Errorf("stream code = %v, want Unavailable", connect.CodeOf(err))
+	}
+	if want := []string{"sku-1", "sku-2", "sku-3"}; !slices.Equal(inventory.watched, want) {
+		t.Errorf("watched SKUs = %v, want %v", inventory.watched, want)
+	}
+
+	want := []*storefrontv1.SKUAvailability{
+		{SkuId: "sku-1", Known: true, InStock: true, Level: storefrontv1.StockLevel_STOCK_LEVEL_IN_STOCK, DisplayQuantity: 10, MoreAvailable: true},
+		{SkuId: "sku-2", Known: true, Level: storefrontv1.StockLevel_STOCK_LEVEL_OUT_OF_STOCK},
+		{SkuId: "sku-1", Known: true, InStock: true, Level: storefrontv1.StockLevel_STOCK_LEVEL_LOW, DisplayQuantity: 2},
+	}
+	if len(got) != len(want) {
+		t.Fatalf("streamed %d availabilities (%v), want %d", len(got), got, len(want))
+	}
+	for i := range want {
+		if !proto.Equal(got[i], want[i]) {
+			t.Errorf("availability[%d] = %v, want %v", i, got[i], want[i])
+		}
+	}
+}
+
+func TestStorefrontHandler_WatchProductAvailability_HiddenCounts(t *testing.T) {
+	inventory := &watchInventoryServer{
+		changes: []*productv1.Inventory{
+			{SkuId: "sku-1", Available: 40},
+			{SkuId: "sku-1", Available: 5}, // Still in stock; counts are hidden
+			{SkuId: "sku-1", Available: 1},
+		},
+	}
+	h := newStorefrontHandlerWithPolicy(newWatchInventoryClient(t, inventory), stockdisplay.NewPolicy(3, 10, []string{"category-1"}))
+
+	got, err := watchAvailability(t, h, "product-1")
+	if err != nil {
+		t.Fatalf("stream error: %v", err)
+	}
+	if len(got) != 2 {
+		t.Fatalf("streamed %v, want in stock then low", got)
+	}
+	for _, a := range got {
+		if a.GetDisplayQuantity() != 0 || a.GetMoreAvailable() {
+			t.Errorf("availability = %v, want no quantity", a)
+		}
+	}
+	if got[1].GetLevel() != storefrontv1.StockLevel_STOCK_LEVEL_LOW {
+		t.Errorf("level = %v, want low", got[1].GetLevel())
+	}
+}
+
+func TestStorefrontHandler_WatchProductAvailability_NotFound(t *testing.T) {
+	h := newStorefrontHandlerWithPolicy(newWatchInventoryClient(t, &watchInventoryServer{}), stockdisplay.NewPolicy(3, 10, nil))
+
+	if _, err := watchAvailability(t, h, "missing"); connect.CodeOf(err) != connect.CodeNotFound {
+		t.Errorf("stream code = %v, want NotFound", connect.CodeOf(err))
+	}
+	if _, err := watchAvailability(t, h, ""); connect.CodeOf(err) != connect.CodeInvalidArgument {
+		t.Errorf("stream without product_id code = %v, want InvalidArgument", connect.CodeOf(err))
+	}
+}
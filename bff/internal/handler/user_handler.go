@@ -51,7 +51,7 @@ func (p *UserServiceProxy) GetUser(
 	ctx context.Context,
 	req *connect.Request[userv1.GetUserRequest],
 ) (*connect.Response[userv1.GetUserResponse], error) {
-	if err := p.authorizer.CanAccessUser(ctx, req.Msg.GetId()); err != nil {
+	if err := p.authorizer.CanAccessUser(ctx, userv1connect.UserServiceGetUserProcedure, req.Msg.GetId()); err != nil {
 		p.logAuthzError(ctx, "GetUser", req.Msg.GetId(), err)
 		return nil, err
 	}
@@ -67,7 +67,7 @@ func (p *UserServiceProxy) UpdateUser(
 	ctx context.Context,
 	req *connect.Request[userv1.UpdateUserRequest],
 ) (*connect.Response[userv1.UpdateUserResponse], error) {
-	if err := p.authorizer.CanAccessUser(ctx, req.Msg.GetId()); err != nil {
+	if err := p.authorizer.CanAccessUser(ctx, userv1connect.UserServiceUpdateUserProcedure, req.Msg.GetId()); err != nil {
 		p.logAuthzError(ctx, "UpdateUser", req.Msg.GetId(), err)
 		return nil, err
 	}
@@ -83,7 +83,7 @@ func (p *UserServiceProxy) DeleteUser(
 	ctx context.Context,
 	req *connect.Request[userv1.DeleteUserRequest],
 ) (*connect.Response[userv1.DeleteUserResponse], error) {
-	if err := p.authorizer.CanAccessUser(ctx, req.Msg.GetId()); err != nil {
+	if err := p.authorizer.CanAccessUser(ctx, userv1connect.UserServiceDeleteUserProcedure, req.Msg.GetId()); err != nil {
 		p.logAuthzError(ctx, "DeleteUser", req.Msg.GetId(), err)
 		return nil, err
 	}
@@ -95,6 +95,469 @@ func (p *UserServiceProxy) DeleteUser(
 	return resp, nil
 }
 
+// UnlockUser requires users:write by default, even on the caller's own
+// account.
+func (p *UserServiceProxy) UnlockUser(
+	ctx context.Context,
+	req *connect.Request[userv1.UnlockUserRequest],
+) (*connect.Response[userv1.UnlockUserResponse], error) {
+	if err := p.authorizer.Authorize(ctx, userv1connect.UserServiceUnlockUserProcedure); err != nil {
+		p.logAuthzError(ctx, "UnlockUser", req.Msg.GetId(), err)
+		return nil, err
+	}
+
+	resp, err := p.client.UnlockUser(ctx, req)
+	if err != nil {
+		return nil, p.handleError(ctx, "UnlockUser", err)
+	}
+	return resp, nil
+}
+
+// ListUsers requires the permission configured for the procedure (users:list by default).
+func (p *UserServiceProxy) ListUsers(
+	ctx context.Context,
+	req *connect.Request[userv1.ListUsersRequest],
+) (*connect.Response[userv1.ListUsersResponse], error) {
+	if err := p.authorizer.Authorize(ctx, userv1connect.UserServiceListUsersProcedure); err != nil {
+		p.logAuthzError(ctx, "ListUsers", "", err)
+		return nil, err
+	}
+
+	resp, err := p.client.ListUsers(ctx, req)
+	if err != nil {
+		return nil, p.handleError(ctx, "ListUsers", err)
+	}
+	return resp, nil
+}
+
+// GetUserRoles lets users read their own roles; others need users:read by default.
+func (p *UserServiceProxy) GetUserRoles(
+	ctx context.Context,
+	req *connect.Request[userv1.GetUserRolesRequest],
+) (*connect.Response[userv1.GetUserRolesResponse], error) {
+	if err := p.authorizer.CanAccessUser(ctx, userv1connect.UserServiceGetUserRolesProcedure, req.Msg.GetUserId()); err != nil {
+		p.logAuthzError(ctx, "GetUserRoles", req.Msg.GetUserId(), err)
+		return nil, err
+	}
+
+	resp, err := p.client.GetUserRoles(ctx, req)
+	if err != nil {
+		return nil, p.handleError(ctx, "GetUserRoles", err)
+	}
+	return resp, nil
+}
+
+// BatchDeactivateUsers starts an asynchronous bulk deactivation (users:bulk by default).
+func (p *UserServiceProxy) BatchDeactivateUsers(
+	ctx context.Context,
+	req *connect.Request[userv1.BatchDeactivateUsersRequest],
+) (*connect.Response[userv1.BatchDeactivateUsersResponse], error) {
+	if err := p.authorizer.Authorize(ctx, userv1connect.UserServiceBatchDeactivateUsersProcedure); err != nil {
+		p.logAuthzError(ctx, "BatchDeactivateUsers", "", err)
+		return nil, err
+	}
+
+	resp, err := p.client.BatchDeactivateUsers(ctx, req)
+	if err != nil {
+		return nil, p.handleError(ctx, "BatchDeactivateUsers", err)
+	}
+	return resp, nil
+}
+
+// BatchAssignSegment starts an asynchronous bulk segment assignment (users:bulk by default).
+func (p *UserServiceProxy) BatchAssignSegment(
+	ctx context.Context,
+	req *connect.Request[userv1.BatchAssignSegmentRequest],
+) (*connect.Response[userv1.BatchAssignSegmentResponse], error) {
+	if err := p.authorizer.Authorize(ctx, userv1connect.UserServiceBatchAssignSegmentProcedure); err != nil {
+		p.logAuthzError(ctx, "BatchAssignSegment", "", err)
+		return nil, err
+	}
+
+	resp, err := p.client.BatchAssignSegment(ctx, req)
+	if err != nil {
+		return nil, p.handleError(ctx, "BatchAssignSegment", err)
+	}
+	return resp, nil
+}
+
+// GetBatchJob returns the progress of a bulk job.
+func (p *UserServiceProxy) GetBatchJob(
+	ctx context.Context,
+	req *connect.Request[userv1.GetBatchJobRequest],
+) (*connect.Response[userv1.GetBatchJobResponse], error) {
+	if err := p.authorizer.Authorize(ctx, userv1connect.UserServiceGetBatchJobProcedure); err != nil {
+		p.logAuthzError(ctx, "GetBatchJob", "", err)
+		return nil, err
+	}
+
+	resp, err := p.client.GetBatchJob(ctx, req)
+	if err != nil {
+		return nil, p.handleError(ctx, "GetBatchJob", err)
+	}
+	return resp, nil
+}
+
+// GetBatchJobReport returns the per-user result report of a finished bulk job.
+func (p *UserServiceProxy) GetBatchJobReport(
+	ctx context.Context,
+	req *connect.Request[userv1.GetBatchJobReportRequest],
+) (*connect.Response[userv1.GetBatchJobReportResponse], error) {
+	if err := p.authorizer.Authorize(ctx, userv1connect.UserServiceGetBatchJobReportProcedure); err != nil {
+		p.logAuthzError(ctx, "GetBatchJobReport", "", err)
+		return nil, err
+	}
+
+	resp, err := p.client.GetBatchJobReport(ctx, req)
+	if err != nil {
+		return nil, p.handleError(ctx, "GetBatchJobReport", err)
+	}
+	return resp, nil
+}
+
+// ListConsents lets users list their own consent receipts; others need users:read by default.
+func (p *UserServiceProxy) ListConsents(
+	ctx context.Context,
+	req *connect.Request[userv1.ListConsentsRequest],
+) (*connect.Response[userv1.ListConsentsResponse], error) {
+	if err := p.authorizer.CanAccessUser(ctx, userv1connect.UserServiceListConsentsProcedure, req.Msg.GetUserId()); err != nil {
+		p.logAuthzError(ctx, "ListConsents", req.Msg.GetUserId(), err)
+		return nil, err
+	}
+
+	resp, err := p.client.ListConsents(ctx, req)
+	if err != nil {
+		return nil, p.handleError(ctx, "ListConsents", err)
+	}
+	return resp, nil
+}
+
+// RevokeConsent lets users revoke their own app access; others need users:write by default.
+func (p *UserServiceProxy) RevokeConsent(
+	ctx context.Context,
+	req *connect.Request[userv1.RevokeConsentRequest],
+) (*connect.Response[userv1.RevokeConsentResponse], error) {
+	if err := p.authorizer.CanAccessUser(ctx, userv1connect.UserServiceRevokeConsentProcedure, req.Msg.GetUserId()); err != nil {
+		p.logAuthzError(ctx, "RevokeConsent", req.Msg.GetUserId(), err)
+		return nil, err
+	}
+
+	resp, err := p.client.RevokeConsent(ctx, req)
+	if err != nil {
+		return nil, p.handleError(ctx, "RevokeConsent", err)
+	}
+	return resp, nil
+}
+
+// ListConnectedApps lets users list the apps they granted access to only.
+func (p *UserServiceProxy) ListConnectedApps(
+	ctx context.Context,
+	req *connect.Request[userv1.ListConnectedAppsRequest],
+) (*connect.Response[userv1.ListConnectedAppsResponse], error) {
+	if err := p.authorizer.RequireSelf(ctx, req.Msg.GetUserId()); err != nil {
+		p.logAuthzError(ctx, "ListConnectedApps", req.Msg.GetUserId(), err)
+		return nil, err
+	}
+
+	resp, err := p.client.ListConnectedApps(ctx, req)
+	if err != nil {
+		return nil, p.handleError(ctx, "ListConnectedApps", err)
+	}
+	return resp, nil
+}
+
+// ListSessions lets users list their own login sessions only.
+func (p *UserServiceProxy) ListSessions(
+	ctx context.Context,
+	req *connect.Request[userv1.ListSessionsRequest],
+) (*connect.Response[userv1.ListSessionsResponse], error) {
+	if err := p.authorizer.RequireSelf(ctx, req.Msg.GetUserId()); err != nil {
+		p.logAuthzError(ctx, "ListSessions", req.Msg.GetUserId(), err)
+		return nil, err
+	}
+
+	resp, err := p.client.ListSessions(ctx, req)
+	if err != nil {
+		return nil, p.handleError(ctx, "ListSessions", err)
+	}
+	return resp, nil
+}
+
+// RevokeSession lets users log out their own sessions only.
+func (p *UserServiceProxy) RevokeSession(
+	ctx context.Context,
+	req *connect.Request[userv1.RevokeSessionRequest],
+) (*connect.Response[userv1.RevokeSessionResponse], error) {
+	if err := p.authorizer.RequireSelf(ctx, req.Msg.GetUserId()); err != nil {
+		p.logAuthzError(ctx, "RevokeSession", req.Msg.GetUserId(), err)
+		return nil, err
+	}
+
+	resp, err := p.client.RevokeSession(ctx, req)
+	if err != nil {
+		return nil, p.handleError(ctx, "RevokeSession", err)
+	}
+	return resp, nil
+}
+
+// GetLoginHistory lets users read their own sign-in attempts only.
+func (p *UserServiceProxy) GetLoginHistory(
+	ctx context.Context,
+	req *connect.Request[userv1.GetLoginHistoryRequest],
+) (*connect.Response[userv1.GetLoginHistoryResponse], error) {
+	if err := p.authorizer.RequireSelf(ctx, req.Msg.GetUserId()); err != nil {
+		p.logAuthzError(ctx, "GetLoginHistory", req.Msg.GetUserId(), err)
+		return nil, err
+	}
+
+	resp, err := p.client.GetLoginHistory(ctx, req)
+	if err != nil {
+		return nil, p.handleError(ctx, "GetLoginHistory", err)
+	}
+	return resp, nil
+}
+
+// AddAddress lets users add addresses to their own address book only.
+func (p *UserServiceProxy) AddAddress(
+	ctx context.Context,
+	req *connect.Request[userv1.AddAddressRequest],
+) (*connect.Response[userv1.AddAddressResponse], error) {
+	if err := p.authorizer.RequireSelf(ctx, req.Msg.GetUserId()); err != nil {
+		p.logAuthzError(ctx, "AddAddress", req.Msg.GetUserId(), err)
+		return nil, err
+	}
+
+	resp, err := p.client.AddAddress(ctx, req)
+	if err != nil {
+		return nil, p.handleError(ctx, "AddAddress", err)
+	}
+	return resp, nil
+}
+
+// ListAddresses lets users list their own addresses only.
+func (p *UserServiceProxy) ListAddresses(
+	ctx context.Context,
+	req *connect.Request[userv1.ListAddressesRequest],
+) (*connect.Response[userv1.ListAddressesResponse], error) {
+	if err := p.authorizer.RequireSelf(ctx, req.Msg.GetUserId()); err != nil {
+		p.logAuthzError(ctx, "ListAddresses", req.Msg.GetUserId(), err)
+		return nil, err
+	}
+
+	resp, err := p.client.ListAddresses(ctx, req)
+	if err != nil {
+		return nil, p.handleError(ctx, "ListAddresses", err)
+	}
+	return resp, nil
+}
+
+// SetDefaultShippingAddress lets users change their own default shipping address only.
+func (p *UserServiceProxy) SetDefaultShippingAddress(
+	ctx context.Context,
+	req *connect.Request[userv1.SetDefaultShippingAddressRequest],
+) (*connect.Response[userv1.SetDefaultShippingAddressResponse], error) {
+	if err := p.authorizer.RequireSelf(ctx, req.Msg.GetUserId()); err != nil {
+		p.logAuthzError(ctx, "SetDefaultShippingAddress", req.Msg.GetUserId(), err)
+		return nil, err
+	}
+
+	resp, err := p.client.SetDefaultShippingAddress(ctx, req)
+	if err != nil {
+		return nil, p.handleError(ctx, "SetDefaultShippingAddress", err)
+	}
+	return resp, nil
+}
+
+// DeleteAddress lets users delete their own addresses only.
+func (p *UserServiceProxy) DeleteAddress(
+	ctx context.Context,
+	req *connect.Request[userv1.DeleteAddressRequest],
+) (*connect.Response[userv1.DeleteAddressResponse], error) {
+	if err := p.authorizer.RequireSelf(ctx, req.Msg.GetUserId()); err != nil {
+		p.logAuthzError(ctx, "DeleteAddress", req.Msg.GetUserId(), err)
+		return nil, err
+	}
+
+	resp, err := p.client.DeleteAddress(ctx, req)
+	if err != nil {
+		return nil, p.handleError(ctx, "DeleteAddress", err)
+	}
+	return resp, nil
+}
+
+// GetTwoFactorStatus lets users read their own two-factor status only.
+func (p *UserServiceProxy) GetTwoFactorStatus(
+	ctx context.Context,
+	req *connect.Request[userv1.GetTwoFactorStatusRequest],
+) (*connect.Response[userv1.GetTwoFactorStatusResponse], error) {
+	if err := p.authorizer.RequireSelf(ctx, req.Msg.GetUserId()); err != nil {
+		p.logAuthzError(ctx, "GetTwoFactorStatus", req.Msg.GetUserId(), err)
+		return nil, err
+	}
+
+	resp, err := p.client.GetTwoFactorStatus(ctx, req)
+	if err != nil {
+		return nil, p.handleError(ctx, "GetTwoFactorStatus", err)
+	}
+	return resp, nil
+}
+
+// EnrollTOTP lets users enroll their own authenticator app only.
+func (p *UserServiceProxy) EnrollTOTP(
+	ctx context.Context,
+	req *connect.Request[userv1.EnrollTOTPRequest],
+) (*connect.Response[userv1.EnrollTOTPResponse], error) {
+	if err := p.authorizer.RequireSelf(ctx, req.Msg.GetUserId()); err != nil {
+		p.logAuthzError(ctx, "EnrollTOTP", req.Msg.GetUserId(), err)
+		return nil, err
+	}
+
+	resp, err := p.client.EnrollTOTP(ctx, req)
+	if err != nil {
+		return nil, p.handleError(ctx, "EnrollTOTP", err)
+	}
+	return resp, nil
+}
+
+// ConfirmTOTP lets users enable their own two-factor authentication only.
+func (p *UserServiceProxy) ConfirmTOTP(
+	ctx context.Context,
+	req *connect.Request[userv1.ConfirmTOTPRequest],
+) (*connect.Response[userv1.ConfirmTOTPResponse], error) {
+	if err := p.authorizer.RequireSelf(ctx, req.Msg.GetUserId()); err != nil {
+		p.logAuthzError(ctx, "ConfirmTOTP", req.Msg.GetUserId(), err)
+		return nil, err
+	}
+
+	resp, err := p.client.ConfirmTOTP(ctx, req)
+	if err != nil {
+		return nil, p.handleError(ctx, "ConfirmTOTP", err)
+	}
+	return resp, nil
+}
+
+// DisableTOTP lets users disable their own two-factor authentication only.
+func (p *UserServiceProxy) DisableTOTP(
+	ctx context.Context,
+	req *connect.Request[userv1.DisableTOTPRequest],
+) (*connect.Response[userv1.DisableTOTPResponse], error) {
+	if err := p.authorizer.RequireSelf(ctx, req.Msg.GetUserId()); err != nil {
+		p.logAuthzError(ctx, "DisableTOTP", req.Msg.GetUserId(), err)
+		return nil, err
+	}
+
+	resp, err := p.client.DisableTOTP(ctx, req)
+	if err != nil {
+		return nil, p.handleError(ctx, "DisableTOTP", err)
+	}
+	return resp, nil
+}
+
+// ChangePassword lets users change their own password only. Admins cannot
+// set another user's password, since the current one is required.
+func (p *UserServiceProxy) ChangePassword(
+	ctx context.Context,
+	req *connect.Request[userv1.ChangePasswordRequest],
+) (*connect.Response[userv1.ChangePasswordResponse], error) {
+	if err := p.authorizer.RequireSelf(ctx, req.Msg.GetUserId()); err != nil {
+		p.logAuthzError(ctx, "ChangePassword", req.Msg.GetUserId(), err)
+		return nil, err
+	}
+
+	resp, err := p.client.ChangePassword(ctx, req)
+	if err != nil {
+		return nil, p.handleError(ctx, "ChangePassword", err)
+	}
+	return resp, nil
+}
+
+// CreateAccessGrant requires users:grant by default. Callers can only
+// delegate permissions their own roles give them, not ones granted to them.
+func (p *UserServiceProxy) CreateAccessGrant(
+	ctx context.Context,
+	req *connect.Request[userv1.CreateAccessGrantRequest],
+) (*connect.Response[userv1.CreateAccessGrantResponse], error) {
+	if err := p.authorizer.Authorize(ctx, userv1connect.UserServiceCreateAccessGrantProcedure); err != nil {
+		p.logAuthzError(ctx, "CreateAccessGrant", req.Msg.GetUserId(), err)
+		return nil, err
+	}
+	if !p.authorizer.HasPermission(ctx, req.Msg.GetPermission()) || isGranted(ctx, req.Msg.GetPermission()) {
+		p.logAuthzError(ctx, "CreateAccessGrant", req.Msg.GetUserId(), authz.ErrPermissionDenied)
+		return nil, authz.ErrPermissionDenied
+	}
+
+	resp, err := p.client.CreateAccessGrant(ctx, req)
+	if err != nil {
+		return nil, p.handleError(ctx, "CreateAccessGrant", err)
+	}
+	return resp, nil
+}
+
+// RevokeAccessGrant requires users:grant by default.
+func (p *UserServiceProxy) RevokeAccessGrant(
+	ctx context.Context,
+	req *connect.Request[userv1.RevokeAccessGrantRequest],
+) (*connect.Response[userv1.RevokeAccessGrantResponse], error) {
+	if err := p.authorizer.Authorize(ctx, userv1connect.UserServiceRevokeAccessGrantProcedure); err != nil {
+		p.logAuthzError(ctx, "RevokeAccessGrant", "", err)
+		return nil, err
+	}
+
+	resp, err := p.client.RevokeAccessGrant(ctx, req)
+	if err != nil {
+		return nil, p.handleError(ctx, "RevokeAccessGrant", err)
+	}
+	return resp, nil
+}
+
+// ListAccessGrants lets users list the grants given to them; others need
+// users:grant by default.
+func (p *UserServiceProxy) ListAccessGrants(
+	ctx context.Context,
+	req *connect.Request[userv1.ListAccessGrantsRequest],
+) (*connect.Response[userv1.ListAccessGrantsResponse], error) {
+	if err := p.authorizer.CanAccessUser(ctx, userv1connect.UserServiceListAccessGrantsProcedure, req.Msg.GetUserId()); err != nil {
+		p.logAuthzError(ctx, "ListAccessGrants", req.Msg.GetUserId(), err)
+		return nil, err
+	}
+
+	resp, err := p.client.ListAccessGrants(ctx, req)
+	if err != nil {
+		return nil, p.handleError(ctx, "ListAccessGrants", err)
+	}
+	return resp, nil
+}
+
+// VerifyEmail is a public endpoint; possession of the token is the authorization.
+func (p *UserServiceProxy) VerifyEmail(
+	ctx context.Context,
+	req *connect.Request[userv1.VerifyEmailRequest],
+) (*connect.Response[userv1.VerifyEmailResponse], error) {
+	resp, err := p.client.VerifyEmail(ctx, req)
+	if err != nil {
+		return nil, p.handleError(ctx, "VerifyEmail", err)
+	}
+	return resp, nil
+}
+
+// ResendVerificationEmail lets users request a new verification email for
+// their own account only.
+func (p *UserServiceProxy) ResendVerificationEmail(
+	ctx context.Context,
+	req *connect.Request[userv1.ResendVerificationEmailRequest],
+) (*connect.Response[userv1.ResendVerificationEmailResponse], error) {
+	if err := p.authorizer.RequireSelf(ctx, req.Msg.GetUserId()); err != nil {
+		p.logAuthzError(ctx, "ResendVerificationEmail", req.Msg.GetUserId(), err)
+		return nil, err
+	}
+
+	resp, err := p.client.ResendVerificationEmail(ctx, req)
+	if err != nil {
+		return nil, p.handleError(ctx, "ResendVerificationEmail", err)
+	}
+	return resp, nil
+}
+
 // VerifyPassword is blocked at BFF level (internal use only via Hydra Login Provider).
 func (p *UserServiceProxy) VerifyPassword(
 	ctx context.Context,
@@ -136,3 +599,14 @@ func (p *UserServiceProxy) logAuthzError(ctx context.Context, method, targetUser
 		slog.String("reason", err.Error()),
 	)
 }
+
+// isGranted reports whether permission comes from an access grant rather
+// than the caller's roles.
+func isGranted(ctx context.Context, permission string) bool {
+	for _, g := range authz.GrantsFromContext(ctx) {
+		if g.Permission == permission {
+			return true
+		}
+	}
+	return false
+}
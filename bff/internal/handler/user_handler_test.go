@@ -19,11 +19,25 @@ import (
 
 type mockUserServiceClient struct {
 	userv1connect.UserServiceClient
-	createUserFn     func(context.Context, *connect.Request[userv1.CreateUserRequest]) (*connect.Response[userv1.CreateUserResponse], error)
-	getUserFn        func(context.Context, *connect.Request[userv1.GetUserRequest]) (*connect.Response[userv1.GetUserResponse], error)
-	updateUserFn     func(context.Context, *connect.Request[userv1.UpdateUserRequest]) (*connect.Response[userv1.UpdateUserResponse], error)
-	deleteUserFn     func(context.Context, *connect.Request[userv1.DeleteUserRequest]) (*connect.Response[userv1.DeleteUserResponse], error)
-	verifyPasswordFn func(context.Context, *connect.Request[userv1.VerifyPasswordRequest]) (*connect.Response[userv1.VerifyPasswordResponse], error)
+	createUserFn      func(context.Context, *connect.Request[userv1.CreateUserRequest]) (*connect.Response[userv1.CreateUserResponse], error)
+	getUserFn         func(context.Context, *connect.Request[userv1.GetUserRequest]) (*connect.Response[userv1.GetUserResponse], error)
+	updateUserFn      func(context.Context, *connect.Request[userv1.UpdateUserRequest]) (*connect.Response[userv1.UpdateUserResponse], error)
+	deleteUserFn      func(context.Context, *connect.Request[userv1.DeleteUserRequest]) (*connect.Response[userv1.DeleteUserResponse], error)
+	verifyPasswordFn  func(context.Context, *connect.Request[userv1.VerifyPasswordRequest]) (*connect.Response[userv1.VerifyPasswordResponse], error)
+	verifyEmailFn     func(context.Context, *connect.Request[userv1.VerifyEmailRequest]) (*connect.Response[userv1.VerifyEmailResponse], error)
+	listUsersFn       func(context.Context, *connect.Request[userv1.ListUsersRequest]) (*connect.Response[userv1.ListUsersResponse], error)
+	getUserRolesFn    func(context.Context, *connect.Request[userv1.GetUserRolesRequest]) (*connect.Response[userv1.GetUserRolesResponse], error)
+	batchDeactivateFn func(context.Context, *connect.Request[userv1.BatchDeactivateUsersRequest]) (*connect.Response[userv1.BatchDeactivateUsersResponse], error)
+	revokeConsentFn   func(context.Context, *connect.Request[userv1.RevokeConsentRequest]) (*connect.Response[userv1.RevokeConsentResponse], error)
+	createGrantFn     func(context.Context, *connect.Request[userv1.CreateAccessGrantRequest]) (*connect.Response[userv1.CreateAccessGrantResponse], error)
+	revokeSessionFn   func(context.Context, *connect.Request[userv1.RevokeSessionRequest]) (*connect.Response[userv1.RevokeSessionResponse], error)
+	disableTOTPFn     func(context.Context, *connect.Request[userv1.DisableTOTPRequest]) (*connect.Response[userv1.DisableTOTPResponse], error)
+	changePasswordFn  func(context.Context, *connect.Request[userv1.ChangePasswordRequest]) (*connect.Response[userv1.ChangePasswordResponse], error)
+	resendFn          func(context.Context, *connect.Request[userv1.ResendVerificationEmailRequest]) (*connect.Response[userv1.ResendVerificationEmailResponse], error)
+	unlockUserFn      func(context.Context, *connect.Request[userv1.UnlockUserRequest]) (*connect.Response[userv1.UnlockUserResponse], error)
+	getLoginHistoryFn func(context.Context, *connect.Request[userv1.GetLoginHistoryRequest]) (*connect.Response[userv1.GetLoginHistoryResponse], error)
+	addAddressFn      func(context.Context, *connect.Request[userv1.AddAddressRequest]) (*connect.Response[userv1.AddAddressResponse], error)
+	listAppsFn        func(context.Context, *connect.Request[userv1.ListConnectedAppsRequest]) (*connect.Response[userv1.ListConnectedAppsResponse], error)
 }
 
 func (m *mockUserServiceClient) CreateUser(ctx context.Context, req *connect.Request[userv1.CreateUserRequest]) (*connect.Response[userv1.CreateUserResponse], error) {
@@ -61,6 +75,120 @@ func (m *mockUserServiceClient) VerifyPassword(ctx context.Context, req *connect
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("not implemented"))
 }
 
+func (m *mockUserServiceClient) VerifyEmail(ctx context.Context, req *connect.Request[userv1.VerifyEmailRequest]) (*connect.Response[userv1.VerifyEmailResponse], error) {
+	if m.verifyEmailFn != nil {
+		return m.verifyEmailFn(ctx, req)
+	}
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("not implemented"))
+}
+
+func (m *mockUserServiceClient) ListUsers(ctx context.Context, req *connect.Request[userv1.ListUsersRequest]) (*connect.Response[userv1.ListUsersResponse], error) {
+	if m.listUsersFn != nil {
+		return m.listUsersFn(ctx, req)
+	}
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("not implemented"))
+}
+
+func (m *mockUserServiceClient) GetUserRoles(ctx context.Context, req *connect.Request[userv1.GetUserRolesRequest]) (*connect.Response[userv1.GetUserRolesResponse], error) {
+	if m.getUserRolesFn != nil {
+		return m.getUserRolesFn(ctx, req)
+	}
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("not implemented"))
+}
+
+func (m *mockUserServiceClient) BatchDeactivateUsers(ctx context.Context, req *connect.Request[userv1.BatchDeactivateUsersRequest]) (*connect.Response[userv1.BatchDeactivateUsersResponse], error) {
+	if m.batchDeactivateFn != nil {
+		return m.batchDeactivateFn(ctx, req)
+	}
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("not implemented"))
+}
+
+func (m *mockUserServiceClient) BatchAssignSegment(_ context.Context, _ *connect.Request[userv1.BatchAssignSegmentRequest]) (*connect.Response[userv1.BatchAssignSegmentResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("not implemented"))
+}
+
+func (m *mockUserServiceClient) GetBatchJob(_ context.Context, _ *connect.Request[userv1.GetBatchJobRequest]) (*connect.Response[userv1.GetBatchJobResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("not implemented"))
+}
+
+func (m *mockUserServiceClient) GetBatchJobReport(_ context.Context, _ *connect.Request[userv1.GetBatchJobReportRequest]) (*connect.Response[userv1.GetBatchJobReportResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("not implemented"))
+}
+
+func (m *mockUserServiceClient) ListConsents(_ context.Context, _ *connect.Request[userv1.ListConsentsRequest]) (*connect.Response[userv1.ListConsentsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("not implemented"))
+}
+
+func (m *mockUserServiceClient) RevokeConsent(ctx context.Context, req *connect.Request[userv1.RevokeConsentRequest]) (*connect.Response[userv1.RevokeConsentResponse], error) {
+	if m.revokeConsentFn != nil {
+		return m.revokeConsentFn(ctx, req)
+	}
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("not implemented"))
+}
+
+func (m *mockUserServiceClient) RevokeSession(ctx context.Context, req *connect.Request[userv1.RevokeSessionRequest]) (*connect.Response[userv1.RevokeSessionResponse], error) {
+	if m.revokeSessionFn != nil {
+		return m.revokeSessionFn(ctx, req)
+	}
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("not implemented"))
+}
+
+func (m *mockUserServiceClient) DisableTOTP(ctx context.Context, req *connect.Request[userv1.DisableTOTPRequest]) (*connect.Response[userv1.DisableTOTPResponse], error) {
+	if m.disableTOTPFn != nil {
+		return m.disableTOTPFn(ctx, req)
+	}
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("not implemented"))
+}
+
+func (m *mockUserServiceClient) GetLoginHistory(ctx context.Context, req *connect.Request[userv1.GetLoginHistoryRequest]) (*connect.Response[userv1.GetLoginHistoryResponse], error) {
+	if m.getLoginHistoryFn != nil {
+		return m.getLoginHistoryFn(ctx, req)
+	}
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("not implemented"))
+}
+
+func (m *mockUserServiceClient) ListConnectedApps(ctx context.Context, req *connect.Request[userv1.ListConnectedAppsRequest]) (*connect.Response[userv1.ListConnectedAppsResponse], error) {
+	if m.listAppsFn != nil {
+		return m.listAppsFn(ctx, req)
+	}
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("not implemented"))
+}
+
+func (m *mockUserServiceClient) AddAddress(ctx context.Context, req *connect.Request[userv1.AddAddressRequest]) (*connect.Response[userv1.AddAddressResponse], error) {
+	if m.addAddressFn != nil {
+		return m.addAddressFn(ctx, req)
+	}
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("not implemented"))
+}
+
+func (m *mockUserServiceClient) ChangePassword(ctx context.Context, req *connect.Request[userv1.ChangePasswordRequest]) (*connect.Response[userv1.ChangePasswordResponse], error) {
+	if m.changePasswordFn != nil {
+		return m.changePasswordFn(ctx, req)
+	}
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("not implemented"))
+}
+
+func (m *mockUserServiceClient) ResendVerificationEmail(ctx context.Context, req *connect.Request[userv1.ResendVerificationEmailRequest]) (*connect.Response[userv1.ResendVerificationEmailResponse], error) {
+	if m.resendFn != nil {
+		return m.resendFn(ctx, req)
+	}
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("not implemented"))
+}
+
+func (m *mockUserServiceClient) UnlockUser(ctx context.Context, req *connect.Request[userv1.UnlockUserRequest]) (*connect.Response[userv1.UnlockUserResponse], error) {
+	if m.unlockUserFn != nil {
+		return m.unlockUserFn(ctx, req)
+	}
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("not implemented"))
+}
+
+func (m *mockUserServiceClient) CreateAccessGrant(ctx context.Context, req *connect.Request[userv1.CreateAccessGrantRequest]) (*connect.Response[userv1.CreateAccessGrantResponse], error) {
+	if m.createGrantFn != nil {
+		return m.createGrantFn(ctx, req)
+	}
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("not implemented"))
+}
+
 func newTestLogger() *slog.Logger {
 	return slog.New(slog.NewTextHandler(os.Stdout, &slog.HandlerOptions{Level: slog.LevelError}))
 }
@@ -77,7 +205,7 @@ func TestUserServiceProxy_CreateUser(t *testing.T) {
 		},
 	}
 
-	proxy := handler.NewUserServiceProxy(mockClient, authz.NewAuthorizer(), newTestLogger())
+	proxy := handler.NewUserServiceProxy(mockClient, authz.NewAuthorizer(authz.DefaultPolicy()), newTestLogger())
 
 	req := connect.NewRequest(&userv1.CreateUserRequest{
 		Email:    "test@example.com",
@@ -94,6 +222,33 @@ func TestUserServiceProxy_CreateUser(t *testing.T) {
 	}
 }
 
+func TestUserServiceProxy_VerifyEmail_Public(t *testing.T) {
+	mockClient := &mockUserServiceClient{
+		verifyEmailFn: func(_ context.Context, _ *connect.Request[userv1.VerifyEmailRequest]) (*connect.Response[userv1.VerifyEmailResponse], error) {
+			return connect.NewResponse(&userv1.VerifyEmailResponse{
+				User: &userv1.User{
+					Id:            "user-123",
+					EmailVerified: true,
+				},
+			}), nil
+		},
+	}
+
+	proxy := handler.NewUserServiceProxy(mockClient, authz.NewAuthorizer(authz.DefaultPolicy()), newTestLogger())
+
+	// No authenticated user in context
+	req := connect.NewRequest(&userv1.VerifyEmailRequest{Token: "token"})
+
+	resp, err := proxy.VerifyEmail(context.Background(), req)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if !resp.Msg.GetUser().GetEmailVerified() {
+		t.Error("expected email_verified to be true")
+	}
+}
+
 func TestUserServiceProxy_GetUser_Authorized(t *testing.T) {
 	userID := "user-123"
 
@@ -108,7 +263,7 @@ func TestUserServiceProxy_GetUser_Authorized(t *testing.T) {
 		},
 	}
 
-	proxy := handler.NewUserServiceProxy(mockClient, authz.NewAuthorizer(), newTestLogger())
+	proxy := handler.NewUserServiceProxy(mockClient, authz.NewAuthorizer(authz.DefaultPolicy()), newTestLogger())
 
 	// User accessing their own data
 	ctx := pkgmw.WithUserID(context.Background(), userID)
@@ -126,7 +281,7 @@ func TestUserServiceProxy_GetUser_Authorized(t *testing.T) {
 
 func TestUserServiceProxy_GetUser_Unauthorized(t *testing.T) {
 	mockClient := &mockUserServiceClient{}
-	proxy := handler.NewUserServiceProxy(mockClient, authz.NewAuthorizer(), newTestLogger())
+	proxy := handler.NewUserServiceProxy(mockClient, authz.NewAuthorizer(authz.DefaultPolicy()), newTestLogger())
 
 	// User trying to access another user's data
 	ctx := pkgmw.WithUserID(context.Background(), "user-123")
@@ -149,7 +304,7 @@ func TestUserServiceProxy_GetUser_Unauthorized(t *testing.T) {
 
 func TestUserServiceProxy_GetUser_Unauthenticated(t *testing.T) {
 	mockClient := &mockUserServiceClient{}
-	proxy := handler.NewUserServiceProxy(mockClient, authz.NewAuthorizer(), newTestLogger())
+	proxy := handler.NewUserServiceProxy(mockClient, authz.NewAuthorizer(authz.DefaultPolicy()), newTestLogger())
 
 	// No user in context
 	req := connect.NewRequest(&userv1.GetUserRequest{Id: "user-123"})
@@ -169,7 +324,7 @@ func TestUserServiceProxy_GetUser_Unauthenticated(t *testing.T) {
 	}
 }
 
-func TestUserServiceProxy_GetUser_AdminBypass(t *testing.T) {
+func TestUserServiceProxy_GetUser_WithPermission(t *testing.T) {
 	mockClient := &mockUserServiceClient{
 		getUserFn: func(_ context.Context, _ *connect.Request[userv1.GetUserRequest]) (*connect.Response[userv1.GetUserResponse], error) {
 			return connect.NewResponse(&userv1.GetUserResponse{
@@ -181,11 +336,11 @@ func TestUserServiceProxy_GetUser_AdminBypass(t *testing.T) {
 		},
 	}
 
-	proxy := handler.NewUserServiceProxy(mockClient, authz.NewAuthorizer(), newTestLogger())
+	proxy := handler.NewUserServiceProxy(mockClient, authz.NewAuthorizer(authz.DefaultPolicy()), newTestLogger())
 
-	// Admin accessing another user's data
-	ctx := pkgmw.WithUserID(context.Background(), "admin-user")
-	ctx = pkgmw.WithScopes(ctx, "admin")
+	// Support staff accessing another user's data
+	ctx := pkgmw.WithUserID(context.Background(), "support-user")
+	ctx = pkgmw.WithPermissions(ctx, "users:list users:read")
 	req := connect.NewRequest(&userv1.GetUserRequest{Id: "other-user"})
 
 	resp, err := proxy.GetUser(ctx, req)
@@ -198,9 +353,131 @@ func TestUserServiceProxy_GetUser_AdminBypass(t *testing.T) {
 	}
 }
 
+func TestUserServiceProxy_ListUsers_Admin(t *testing.T) {
+	mockClient := &mockUserServiceClient{
+		listUsersFn: func(_ context.Context, _ *connect.Request[userv1.ListUsersRequest]) (*connect.Response[userv1.ListUsersResponse], error) {
+			return connect.NewResponse(&userv1.ListUsersResponse{
+				Users: []*userv1.User{{Id: "user-1"}, {Id: "user-2"}},
+			}), nil
+		},
+	}
+
+	proxy := handler.NewUserServiceProxy(mockClient, authz.NewAuthorizer(authz.DefaultPolicy()), newTestLogger())
+
+	ctx := pkgmw.WithUserID(context.Background(), "admin-user")
+	ctx = pkgmw.WithPermissions(ctx, "users:list users:read")
+	req := connect.NewRequest(&userv1.ListUsersRequest{PageSize: 10})
+
+	resp, err := proxy.ListUsers(ctx, req)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(resp.Msg.GetUsers()) != 2 {
+		t.Errorf("expected 2 users, got %d", len(resp.Msg.GetUsers()))
+	}
+}
+
+func TestUserServiceProxy_ListUsers_NonAdmin(t *testing.T) {
+	tests := []struct {
+		name     string
+		ctx      context.Context
+		wantCode connect.Code
+	}{
+		{
+			name:     "unauthenticated",
+			ctx:      context.Background(),
+			wantCode: connect.CodeUnauthenticated,
+		},
+		{
+			name:     "authenticated without users:list permission",
+			ctx:      pkgmw.WithPermissions(pkgmw.WithUserID(context.Background(), "user-123"), "users:read"),
+			wantCode: connect.CodePermissionDenied,
+		},
+		{
+			name:     "legacy admin scope is not sufficient",
+			ctx:      pkgmw.WithScopes(pkgmw.WithUserID(context.Background(), "user-123"), "openid admin"),
+			wantCode: connect.CodePermissionDenied,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockClient := &mockUserServiceClient{}
+			proxy := handler.NewUserServiceProxy(mockClient, authz.NewAuthorizer(authz.DefaultPolicy()), newTestLogger())
+
+			_, err := proxy.ListUsers(tt.ctx, connect.NewRequest(&userv1.ListUsersRequest{}))
+			if err == nil {
+				t.Fatal("expected error, got nil")
+			}
+
+			var connectErr *connect.Error
+			if !errors.As(err, &connectErr) {
+				t.Fatalf("expected connect.Error, got %T", err)
+			}
+
+			if connectErr.Code() != tt.wantCode {
+				t.Errorf("expected %v, got %v", tt.wantCode, connectErr.Code())
+			}
+		})
+	}
+}
+
+func TestUserServiceProxy_ListUsers_CustomPolicy(t *testing.T) {
+	mockClient := &mockUserServiceClient{
+		listUsersFn: func(_ context.Context, _ *connect.Request[userv1.ListUsersRequest]) (*connect.Response[userv1.ListUsersResponse], error) {
+			return connect.NewResponse(&userv1.ListUsersResponse{}), nil
+		},
+	}
+
+	policy := authz.Policy{userv1connect.UserServiceListUsersProcedure: "users:export"}
+	proxy := handler.NewUserServiceProxy(mockClient, authz.NewAuthorizer(policy), newTestLogger())
+
+	ctx := pkgmw.WithUserID(context.Background(), "ops-user")
+	ctx = pkgmw.WithPermissions(ctx, "users:list")
+	if _, err := proxy.ListUsers(ctx, connect.NewRequest(&userv1.ListUsersRequest{})); connect.CodeOf(err) != connect.CodePermissionDenied {
+		t.Errorf("expected CodePermissionDenied, got %v", connect.CodeOf(err))
+	}
+
+	ctx = pkgmw.WithPermissions(ctx, "users:export")
+	if _, err := proxy.ListUsers(ctx, connect.NewRequest(&userv1.ListUsersRequest{})); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
+
+func TestUserServiceProxy_GetUserRoles(t *testing.T) {
+	mockClient := &mockUserServiceClient{
+		getUserRolesFn: func(_ context.Context, _ *connect.Request[userv1.GetUserRolesRequest]) (*connect.Response[userv1.GetUserRolesResponse], error) {
+			return connect.NewResponse(&userv1.GetUserRolesResponse{
+				Roles: []*userv1.Role{{Name: "support"}},
+			}), nil
+		},
+	}
+	proxy := handler.NewUserServiceProxy(mockClient, authz.NewAuthorizer(authz.DefaultPolicy()), newTestLogger())
+
+	t.Run("own roles", func(t *testing.T) {
+		ctx := pkgmw.WithUserID(context.Background(), "user-123")
+		resp, err := proxy.GetUserRoles(ctx, connect.NewRequest(&userv1.GetUserRolesRequest{UserId: "user-123"}))
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if len(resp.Msg.GetRoles()) != 1 {
+			t.Errorf("expected 1 role, got %d", len(resp.Msg.GetRoles()))
+		}
+	})
+
+	t.Run("other user's roles without permission", func(t *testing.T) {
+		ctx := pkgmw.WithUserID(context.Background(), "user-123")
+		_, err := proxy.GetUserRoles(ctx, connect.NewRequest(&userv1.GetUserRolesRequest{UserId: "user-456"}))
+		if connect.CodeOf(err) != connect.CodePermissionDenied {
+			t.Errorf("expected CodePermissionDenied, got %v", connect.CodeOf(err))
+		}
+	})
+}
+
 func TestUserServiceProxy_VerifyPassword_Blocked(t *testing.T) {
 	mockClient := &mockUserServiceClient{}
-	proxy := handler.NewUserServiceProxy(mockClient, authz.NewAuthorizer(), newTestLogger())
+	proxy := handler.NewUserServiceProxy(mockClient, authz.NewAuthorizer(authz.DefaultPolicy()), newTestLogger())
 
 	req := connect.NewRequest(&userv1.VerifyPasswordRequest{
 		Email:    "test@example.com",
@@ -236,7 +513,7 @@ func TestUserServiceProxy_UpdateUser_Authorized(t *testing.T) {
 		},
 	}
 
-	proxy := handler.NewUserServiceProxy(mockClient, authz.NewAuthorizer(), newTestLogger())
+	proxy := handler.NewUserServiceProxy(mockClient, authz.NewAuthorizer(authz.DefaultPolicy()), newTestLogger())
 
 	ctx := pkgmw.WithUserID(context.Background(), userID)
 	email := "updated@example.com"
@@ -264,7 +541,7 @@ func TestUserServiceProxy_DeleteUser_Authorized(t *testing.T) {
 		},
 	}
 
-	proxy := handler.NewUserServiceProxy(mockClient, authz.NewAuthorizer(), newTestLogger())
+	proxy := handler.NewUserServiceProxy(mockClient, authz.NewAuthorizer(authz.DefaultPolicy()), newTestLogger())
 
 	ctx := pkgmw.WithUserID(context.Background(), userID)
 	req := connect.NewRequest(&userv1.DeleteUserRequest{Id: userID})
@@ -282,7 +559,7 @@ func TestUserServiceProxy_HandleError_Internal(t *testing.T) {
 		},
 	}
 
-	proxy := handler.NewUserServiceProxy(mockClient, authz.NewAuthorizer(), newTestLogger())
+	proxy := handler.NewUserServiceProxy(mockClient, authz.NewAuthorizer(authz.DefaultPolicy()), newTestLogger())
 
 	userID := "user-123"
 	ctx := pkgmw.WithUserID(context.Background(), userID)
@@ -315,7 +592,7 @@ func TestUserServiceProxy_HandleError_NotFound(t *testing.T) {
 		},
 	}
 
-	proxy := handler.NewUserServiceProxy(mockClient, authz.NewAuthorizer(), newTestLogger())
+	proxy := handler.NewUserServiceProxy(mockClient, authz.NewAuthorizer(authz.DefaultPolicy()), newTestLogger())
 
 	userID := "user-123"
 	ctx := pkgmw.WithUserID(context.Background(), userID)
@@ -336,3 +613,554 @@ func TestUserServiceProxy_HandleError_NotFound(t *testing.T) {
 		t.Errorf("expected CodeNotFound, got %v", connectErr.Code())
 	}
 }
+
+func TestUserServiceProxy_BatchDeactivateUsers(t *testing.T) {
+	mockClient := &mockUserServiceClient{
+		batchDeactivateFn: func(_ context.Context, req *connect.Request[userv1.BatchDeactivateUsersRequest]) (*connect.Response[userv1.BatchDeactivateUsersResponse], error) {
+			return connect.NewResponse(&userv1.BatchDeactivateUsersResponse{
+				Job: &userv1.BatchJob{
+					Id:     "job-1",
+					Status: userv1.BatchJobStatus_BATCH_JOB_STATUS_PENDING,
+					Total:  int32(len(req.Msg.GetTarget().GetUserIds().GetIds())),
+				},
+			}), nil
+		},
+	}
+
+	proxy := handler.NewUserServiceProxy(mockClient, authz.NewAuthorizer(authz.DefaultPolicy()), newTestLogger())
+	req := connect.NewRequest(&userv1.BatchDeactivateUsersRequest{
+		Target: &userv1.BatchTarget{
+			Target: &userv1.BatchTarget_UserIds{UserIds: &userv1.UserIdList{Ids: []string{"user-1", "user-2"}}},
+		},
+	})
+
+	// Support staff can list users but not run bulk actions
+	ctx := pkgmw.WithUserID(context.Background(), "support-user")
+	ctx = pkgmw.WithPermissions(ctx, "users:list users:read")
+	if _, err := proxy.BatchDeactivateUsers(ctx, req); connect.CodeOf(err) != connect.CodePermissionDenied {
+		t.Errorf("expected CodePermissionDenied, got %v", connect.CodeOf(err))
+	}
+
+	ctx = pkgmw.WithPermissions(ctx, "users:list users:read users:bulk")
+	resp, err := proxy.BatchDeactivateUsers(ctx, req)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if resp.Msg.GetJob().GetTotal() != 2 {
+		t.Errorf("expected total 2, got %d", resp.Msg.GetJob().GetTotal())
+	}
+}
+
+func TestUserServiceProxy_RevokeConsent(t *testing.T) {
+	mockClient := &mockUserServiceClient{
+		revokeConsentFn: func(_ context.Context, _ *connect.Request[userv1.RevokeConsentRequest]) (*connect.Response[userv1.RevokeConsentResponse], error) {
+			return connect.NewResponse(&userv1.RevokeConsentResponse{RevokedCount: 1}), nil
+		},
+	}
+	proxy := handler.NewUserServiceProxy(mockClient, authz.NewAuthorizer(authz.DefaultPolicy()), newTestLogger())
+
+	tests := []struct {
+		name     string
+		ctx      context.Context
+		userID   string
+		wantCode connect.Code
+	}{
+		{
+			name:   "owner can revoke",
+			ctx:    pkgmw.WithUserID(context.Background(), "user-123"),
+			userID: "user-123",
+		},
+		{
+			name:     "other user is denied",
+			ctx:      pkgmw.WithUserID(context.Background(), "user-456"),
+			userID:   "user-123",
+			wantCode: connect.CodePermissionDenied,
+		},
+		{
+			name:   "admin with users:write can revoke",
+			ctx:    pkgmw.WithPermissions(pkgmw.WithUserID(context.Background(), "admin-user"), "users:write"),
+			userID: "user-123",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := proxy.RevokeConsent(tt.ctx, connect.NewRequest(&userv1.RevokeConsentRequest{
+				UserId:   tt.userID,
+				ClientId: "spa",
+			}))
+			if tt.wantCode != 0 {
+				if connect.CodeOf(err) != tt.wantCode {
+					t.Errorf("expected %v, got %v", tt.wantCode, connect.CodeOf(err))
+				}
+				return
+			}
+			if err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+		})
+	}
+}
+
+func TestUserServiceProxy_CreateAccessGrant(t *testing.T) {
+	mockClient := &mockUserServiceClient{
+		createGrantFn: func(_ context.Context, req *connect.Request[userv1.CreateAccessGrantRequest]) (*connect.Response[userv1.CreateAccessGrantResponse], error) {
+			return connect.NewResponse(&userv1.CreateAccessGrantResponse{
+				Grant: &userv1.AccessGrant{Id: "grant-1", UserId: req.Msg.GetUserId(), Permission: req.Msg.GetPermission()},
+			}), nil
+		},
+	}
+	proxy := handler.NewUserServiceProxy(mockClient, authz.NewAuthorizer(authz.DefaultPolicy()), newTestLogger())
+	adminCtx := func(permissions string) context.Context {
+		return pkgmw.WithPermissions(pkgmw.WithUserID(context.Background(), "admin-user"), permissions)
+	}
+
+	tests := []struct {
+		name       string
+		ctx        context.Context
+		permission string
+		wantCode   connect.Code
+	}{
+		{
+			name:       "admin can delegate a permission they hold",
+			ctx:        adminCtx("users:grant users:write"),
+			permission: "users:write",
+		},
+		{
+			name:       "cannot delegate a permission not held",
+			ctx:        adminCtx("users:grant"),
+			permission: "users:delete",
+			wantCode:   connect.CodePermissionDenied,
+		},
+		{
+			name:       "users:grant is required",
+			ctx:        adminCtx("users:write"),
+			permission: "users:write",
+			wantCode:   connect.CodePermissionDenied,
+		},
+		{
+			name:       "unauthenticated",
+			ctx:        context.Background(),
+			permission: "users:write",
+			wantCode:   connect.CodeUnauthenticated,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := proxy.CreateAccessGrant(tt.ctx, connect.NewRequest(&userv1.CreateAccessGrantRequest{
+				UserId:        "agent-1",
+				Permission:    tt.permission,
+				Reason:        "ticket #42",
+				DurationHours: 4,
+			}))
+			if tt.wantCode != 0 {
+				if connect.CodeOf(err) != tt.wantCode {
+					t.Errorf("expected %v, got %v", tt.wantCode, connect.CodeOf(err))
+				}
+				return
+			}
+			if err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+		})
+	}
+}
+
+func TestUserServiceProxy_RevokeSession(t *testing.T) {
+	mockClient := &mockUserServiceClient{
+		revokeSessionFn: func(_ context.Context, _ *connect.Request[userv1.RevokeSessionRequest]) (*connect.Response[userv1.RevokeSessionResponse], error) {
+			return connect.NewResponse(&userv1.RevokeSessionResponse{RevokedClientIds: []string{"spa"}}), nil
+		},
+	}
+	proxy := handler.NewUserServiceProxy(mockClient, authz.NewAuthorizer(authz.DefaultPolicy()), newTestLogger())
+
+	tests := []struct {
+		name     string
+		ctx      context.Context
+		wantCode connect.Code
+	}{
+		{
+			name: "owner can revoke",
+			ctx:  pkgmw.WithUserID(context.Background(), "user-123"),
+		},
+		{
+			name:     "other user is denied",
+			ctx:      pkgmw.WithUserID(context.Background(), "user-456"),
+			wantCode: connect.CodePermissionDenied,
+		},
+		{
+			name:     "admin is denied",
+			ctx:      pkgmw.WithPermissions(pkgmw.WithUserID(context.Background(), "admin-user"), "users:read users:write users:delete"),
+			wantCode: connect.CodePermissionDenied,
+		},
+		{
+			name:     "unauthenticated",
+			ctx:      context.Background(),
+			wantCode: connect.CodeUnauthenticated,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := proxy.RevokeSession(tt.ctx, connect.NewRequest(&userv1.RevokeSessionRequest{
+				UserId:    "user-123",
+				SessionId: "session-1",
+			}))
+			if tt.wantCode != 0 {
+				if connect.CodeOf(err) != tt.wantCode {
+					t.Errorf("expected %v, got %v", tt.wantCode, connect.CodeOf(err))
+				}
+				return
+			}
+			if err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+		})
+	}
+}
+
+func TestUserServiceProxy_DisableTOTP(t *testing.T) {
+	mockClient := &mockUserServiceClient{
+		disableTOTPFn: func(_ context.Context, _ *connect.Request[userv1.DisableTOTPRequest]) (*connect.Response[userv1.DisableTOTPResponse], error) {
+			return connect.NewResponse(&userv1.DisableTOTPResponse{}), nil
+		},
+	}
+	proxy := handler.NewUserServiceProxy(mockClient, authz.NewAuthorizer(authz.DefaultPolicy()), newTestLogger())
+
+	tests := []struct {
+		name     string
+		ctx      context.Context
+		wantCode connect.Code
+	}{
+		{
+			name: "owner can disable",
+			ctx:  pkgmw.WithUserID(context.Background(), "user-123"),
+		},
+		{
+			name:     "admin is denied",
+			ctx:      pkgmw.WithPermissions(pkgmw.WithUserID(context.Background(), "admin-user"), "users:read users:write users:delete"),
+			wantCode: connect.CodePermissionDenied,
+		},
+		{
+			name:     "unauthenticated",
+			ctx:      context.Background(),
+			wantCode: connect.CodeUnauthenticated,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := proxy.DisableTOTP(tt.ctx, connect.NewRequest(&userv1.DisableTOTPRequest{
+				UserId: "user-123",
+				Code:   "123456",
+			}))
+			if tt.wantCode != 0 {
+				if connect.CodeOf(err) != tt.wantCode {
+					t.Errorf("expected %v, got %v", tt.wantCode, connect.CodeOf(err))
+				}
+				return
+			}
+			if err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+		})
+	}
+}
+
+func TestUserServiceProxy_ChangePassword(t *testing.T) {
+	mockClient := &mockUserServiceClient{
+		changePasswordFn: func(_ context.Context, _ *connect.Request[userv1.ChangePasswordRequest]) (*connect.Response[userv1.ChangePasswordResponse], error) {
+			return connect.NewResponse(&userv1.ChangePasswordResponse{}), nil
+		},
+	}
+	proxy := handler.NewUserServiceProxy(mockClient, authz.NewAuthorizer(authz.DefaultPolicy()), newTestLogger())
+
+	tests := []struct {
+		name     string
+		ctx      context.Context
+		wantCode connect.Code
+	}{
+		{
+			name: "owner can change",
+			ctx:  pkgmw.WithUserID(context.Background(), "user-123"),
+		},
+		{
+			name:     "admin is denied",
+			ctx:      pkgmw.WithPermissions(pkgmw.WithUserID(context.Background(), "admin-user"), "users:read users:write users:delete"),
+			wantCode: connect.CodePermissionDenied,
+		},
+		{
+			name:     "unauthenticated",
+			ctx:      context.Background(),
+			wantCode: connect.CodeUnauthenticated,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := proxy.ChangePassword(tt.ctx, connect.NewRequest(&userv1.ChangePasswordRequest{
+				UserId:          "user-123",
+				CurrentPassword: "password123",
+				NewPassword:     "new-password-456",
+			}))
+			if tt.wantCode != 0 {
+				if connect.CodeOf(err) != tt.wantCode {
+					t.Errorf("expected %v, got %v", tt.wantCode, connect.CodeOf(err))
+				}
+				return
+			}
+			if err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+		})
+	}
+}
+
+func TestUserServiceProxy_ResendVerificationEmail(t *testing.T) {
+	mockClient := &mockUserServiceClient{
+		resendFn: func(_ context.Context, _ *connect.Request[userv1.ResendVerificationEmailRequest]) (*connect.Response[userv1.ResendVerificationEmailResponse], error) {
+			return connect.NewResponse(&userv1.ResendVerificationEmailResponse{}), nil
+		},
+	}
+	proxy := handler.NewUserServiceProxy(mockClient, authz.NewAuthorizer(authz.DefaultPolicy()), newTestLogger())
+
+	tests := []struct {
+		name     string
+		ctx      context.Context
+		wantCode connect.Code
+	}{
+		{
+			name: "owner can resend",
+			ctx:  pkgmw.WithUserID(context.Background(), "user-123"),
+		},
+		{
+			name:     "another user is denied",
+			ctx:      pkgmw.WithUserID(context.Background(), "user-456"),
+			wantCode: connect.CodePermissionDenied,
+		},
+		{
+			name:     "unauthenticated",
+			ctx:      context.Background(),
+			wantCode: connect.CodeUnauthenticated,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := proxy.ResendVerificationEmail(tt.ctx, connect.NewRequest(&userv1.ResendVerificationEmailRequest{
+				UserId: "user-123",
+			}))
+			if tt.wantCode != 0 {
+				if connect.CodeOf(err) != tt.wantCode {
+					t.Errorf("expected %v, got %v", tt.wantCode, connect.CodeOf(err))
+				}
+				return
+			}
+			if err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+		})
+	}
+}
+
+func TestUserServiceProxy_GetLoginHistory(t *testing.T) {
+	mockClient := &mockUserServiceClient{
+		getLoginHistoryFn: func(_ context.Context, _ *connect.Request[userv1.GetLoginHistoryRequest]) (*connect.Response[userv1.GetLoginHistoryResponse], error) {
+			return connect.NewResponse(&userv1.GetLoginHistoryResponse{
+				Events: []*userv1.LoginEvent{{Id: "event-1", Succeeded: true}},
+			}), nil
+		},
+	}
+	proxy := handler.NewUserServiceProxy(mockClient, authz.NewAuthorizer(authz.DefaultPolicy()), newTestLogger())
+
+	tests := []struct {
+		name     string
+		ctx      context.Context
+		wantCode connect.Code
+	}{
+		{
+			name: "owner can read",
+			ctx:  pkgmw.WithUserID(context.Background(), "user-123"),
+		},
+		{
+			name:     "admin is denied",
+			ctx:      pkgmw.WithPermissions(pkgmw.WithUserID(context.Background(), "admin-user"), "users:read users:write users:delete"),
+			wantCode: connect.CodePermissionDenied,
+		},
+		{
+			name:     "unauthenticated",
+			ctx:      context.Background(),
+			wantCode: connect.CodeUnauthenticated,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp, err := proxy.GetLoginHistory(tt.ctx, connect.NewRequest(&userv1.GetLoginHistoryRequest{UserId: "user-123"}))
+			if tt.wantCode != 0 {
+				if connect.CodeOf(err) != tt.wantCode {
+					t.Errorf("expected %v, got %v", tt.wantCode, connect.CodeOf(err))
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if len(resp.Msg.GetEvents()) != 1 {
+				t.Errorf("expected 1 event, got %d", len(resp.Msg.GetEvents()))
+			}
+		})
+	}
+}
+
+func TestUserServiceProxy_ListConnectedApps(t *testing.T) {
+	mockClient := &mockUserServiceClient{
+		listAppsFn: func(_ context.Context, _ *connect.Request[userv1.ListConnectedAppsRequest]) (*connect.Response[userv1.ListConnectedAppsResponse], error) {
+			return connect.NewResponse(&userv1.ListConnectedAppsResponse{
+				Apps: []*userv1.ConnectedApp{{ClientId: "spa"}},
+			}), nil
+		},
+	}
+	proxy := handler.NewUserServiceProxy(mockClient, authz.NewAuthorizer(authz.DefaultPolicy()), newTestLogger())
+
+	resp, err := proxy.ListConnectedApps(pkgmw.WithUserID(context.Background(), "user-123"),
+		connect.NewRequest(&userv1.ListConnectedAppsRequest{UserId: "user-123"}))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(resp.Msg.GetApps()) != 1 {
+		t.Errorf("expected 1 app, got %d", len(resp.Msg.GetApps()))
+	}
+
+	admin := pkgmw.WithPermissions(pkgmw.WithUserID(context.Background(), "admin-user"), "users:read users:write users:delete")
+	_, err = proxy.ListConnectedApps(admin, connect.NewRequest(&userv1.ListConnectedAppsRequest{UserId: "user-123"}))
+	if connect.CodeOf(err) != connect.CodePermissionDenied {
+		t.Errorf("admin listing another user's apps: expected %v, got %v", connect.CodePermissionDenied, connect.CodeOf(err))
+	}
+}
+
+func TestUserServiceProxy_AddAddress(t *testing.T) {
+	mockClient := &mockUserServiceClient{
+		addAddressFn: func(_ context.Context, _ *connect.Request[userv1.AddAddressRequest]) (*connect.Response[userv1.AddAddressResponse], error) {
+			return connect.NewResponse(&userv1.AddAddressResponse{Address: &userv1.Address{Id: "address-1"}}), nil
+		},
+	}
+	proxy := handler.NewUserServiceProxy(mockClient, authz.NewAuthorizer(authz.DefaultPolicy()), newTestLogger())
+
+	tests := []struct {
+		name     string
+		ctx      context.Context
+		wantCode connect.Code
+	}{
+		{
+			name: "owner can add",
+			ctx:  pkgmw.WithUserID(context.Background(), "user-123"),
+		},
+		{
+			name:     "admin is denied",
+			ctx:      pkgmw.WithPermissions(pkgmw.WithUserID(context.Background(), "admin-user"), "users:read users:write users:delete"),
+			wantCode: connect.CodePermissionDenied,
+		},
+		{
+			name:     "unauthenticated",
+			ctx:      context.Background(),
+			wantCode: connect.CodeUnauthenticated,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := proxy.AddAddress(tt.ctx, connect.NewRequest(&userv1.AddAddressRequest{
+				UserId:      "user-123",
+				Recipient:   "Taro Yamada",
+				PhoneNumber: "+819012345678",
+				Country:     "JP",
+				PostalCode:  "100-0001",
+				City:        "Chiyoda-ku",
+				Line1:       "1-1 Chiyoda",
+			}))
+			if tt.wantCode != 0 {
+				if connect.CodeOf(err) != tt.wantCode {
+					t.Errorf("expected %v, got %v", tt.wantCode, connect.CodeOf(err))
+				}
+				return
+			}
+			if err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+		})
+	}
+}
+
+func TestUserServiceProxy_AddressesOfOthersDenied(t *testing.T) {
+	proxy := handler.NewUserServiceProxy(&mockUserServiceClient{}, authz.NewAuthorizer(authz.DefaultPolicy()), newTestLogger())
+	ctx := pkgmw.WithUserID(context.Background(), "user-456")
+
+	calls := map[string]func() error{
+		"ListAddresses": func() error {
+			_, err := proxy.ListAddresses(ctx, connect.NewRequest(&userv1.ListAddressesRequest{UserId: "user-123"}))
+			return err
+		},
+		"SetDefaultShippingAddress": func() error {
+			_, err := proxy.SetDefaultShippingAddress(ctx, connect.NewRequest(&userv1.SetDefaultShippingAddressRequest{UserId: "user-123", AddressId: "address-1"}))
+			return err
+		},
+		"DeleteAddress": func() error {
+			_, err := proxy.DeleteAddress(ctx, connect.NewRequest(&userv1.DeleteAddressRequest{UserId: "user-123", AddressId: "address-1"}))
+			return err
+		},
+	}
+	for name, call := range calls {
+		t.Run(name, func(t *testing.T) {
+			if code := connect.CodeOf(call()); code != connect.CodePermissionDenied {
+				t.Errorf("expected %v, got %v", connect.CodePermissionDenied, code)
+			}
+		})
+	}
+}
+
+func TestUserServiceProxy_UnlockUser(t *testing.T) {
+	mockClient := &mockUserServiceClient{
+		unlockUserFn: func(_ context.Context, _ *connect.Request[userv1.UnlockUserRequest]) (*connect.Response[userv1.UnlockUserResponse], error) {
+			return connect.NewResponse(&userv1.UnlockUserResponse{}), nil
+		},
+	}
+	proxy := handler.NewUserServiceProxy(mockClient, authz.NewAuthorizer(authz.DefaultPolicy()), newTestLogger())
+
+	tests := []struct {
+		name     string
+		ctx      context.Context
+		wantCode connect.Code
+	}{
+		{
+			name: "admin can unlock",
+			ctx:  pkgmw.WithPermissions(pkgmw.WithUserID(context.Background(), "admin-user"), "users:read users:write"),
+		},
+		{
+			name:     "owner without users:write is denied",
+			ctx:      pkgmw.WithUserID(context.Background(), "user-123"),
+			wantCode: connect.CodePermissionDenied,
+		},
+		{
+			name:     "unauthenticated",
+			ctx:      context.Background(),
+			wantCode: connect.CodeUnauthenticated,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := proxy.UnlockUser(tt.ctx, connect.NewRequest(&userv1.UnlockUserRequest{Id: "user-123"}))
+			if tt.wantCode != 0 {
+				if connect.CodeOf(err) != tt.wantCode {
+					t.Errorf("expected %v, got %v", tt.wantCode, connect.CodeOf(err))
+				}
+				return
+			}
+			if err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,189 @@
+package health
+
+import (
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+)
+
+const (
+	StatusOK          = "ok"
+	StatusUnavailable = "unavailable"
+)
+
+// Backend is a dependency whose health endpoint is probed over HTTP.
+type Backend struct {
+	Name string
+	URL  string
+}
+
+// ReadinessConfig holds configuration for the readiness checker.
+type ReadinessConfig struct {
+	// Backends are probed with GET; any 2xx response counts as healthy.
+	Backends []Backend
+
+	// Timeout bounds each backend probe.
+	Timeout time.Duration
+
+	// CacheTTL is how long probe results are reused, so frequent
+	// readiness polls don't fan out to every backend.
+	CacheTTL time.Duration
+
+	// TLS, if set, is used for probing backends served over TLS.
+	TLS *tls.Config
+}
+
+// DependencyStatus is the readiness of a single dependency.
+type DependencyStatus struct {
+	Status    string `json:"status"`
+	Error     string `json:"error,omitempty"`
+	LatencyMs int64  `json:"latency_ms"`
+}
+
+// Report is the aggregated readiness of the BFF and its dependencies.
+type Report struct {
+	Status       string                      `json:"status"`
+	Dependencies map[string]DependencyStatus `json:"dependencies"`
+	CheckedAt    time.Time                   `json:"checked_at"`
+}
+
+// ReadinessChecker aggregates local checks and backend probes into a single report.
+type ReadinessChecker struct {
+	local    map[string]func() bool
+	backends []Backend
+	client   *http.Client
+	cacheTTL time.Duration
+
+	mu       sync.Mutex
+	cached   map[string]DependencyStatus
+	cachedAt time.Time
+}
+
+// NewReadinessChecker creates a readiness checker.
+// local checks are evaluated on every request; backend probes are cached.
+func NewReadinessChecker(cfg ReadinessConfig, local map[string]func() bool) *ReadinessChecker {
+	client := &http.Client{Timeout: cfg.Timeout}
+	if cfg.TLS != nil {
+		client.Transport = &http.Transport{TLSClientConfig: cfg.TLS}
+	}
+	return &ReadinessChecker{
+		local:    local,
+		backends: cfg.Backends,
+		client:   client,
+		cacheTTL: cfg.CacheTTL,
+	}
+}
+
+// Check returns the current readiness report.
+// The overall status is unavailable if any dependency is unavailable.
+func (c *ReadinessChecker) Check(ctx context.Context) Report {
+	report := Report{
+		Status:       StatusOK,
+		Dependencies: make(map[string]DependencyStatus, len(c.local)+len(c.backends)),
+		CheckedAt:    time.Now().UTC(),
+	}
+
+	for name, check := range c.local {
+		status := DependencyStatus{Status: StatusOK}
+		if !check() {
+			status = DependencyStatus{Status: StatusUnavailable, Error: "not healthy"}
+		}
+		report.Dependencies[name] = status
+	}
+
+	for name, status := range c.probeBackends(ctx) {
+		report.Dependencies[name] = status
+	}
+
+	for _, status := range report.Dependencies {
+		if status.Status != StatusOK {
+			report.Status = StatusUnavailable
+			break
+		}
+	}
+
+	return report
+}
+
+// ServeHTTP writes the readiness report as JSON.
+// Responds 200 when ready and 503 otherwise.
+func (c *ReadinessChecker) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	report := c.Check(r.Context())
+
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "no-store")
+	if report.Status == StatusOK {
+		w.WriteHeader(http.StatusOK)
+	} else {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}
+	json.NewEncoder(w).Encode(report)
+}
+
+func (c *ReadinessChecker) probeBackends(ctx context.Context) map[string]DependencyStatus {
+	if len(c.backends) == 0 {
+		return nil
+	}
+
+	// Holding the lock while probing collapses concurrent polls into one round.
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.cached != nil && time.Since(c.cachedAt) < c.cacheTTL {
+		return c.cached
+	}
+
+	// Probes must not be cut short by the caller going away, or a
+	// disconnected poller would cache a spurious failure.
+	ctx = context.WithoutCancel(ctx)
+
+	results := make(map[string]DependencyStatus, len(c.backends))
+	var resultsMu sync.Mutex
+	var wg sync.WaitGroup
+	for _, backend := range c.backends {
+		wg.Add(1)
+		go func(b Backend) {
+			defer wg.Done()
+			status := c.probe(ctx, b)
+			resultsMu.Lock()
+			results[b.Name] = status
+			resultsMu.Unlock()
+		}(backend)
+	}
+	wg.Wait()
+
+	c.cached = results
+	c.cachedAt = time.Now()
+	return results
+}
+
+func (c *ReadinessChecker) probe(ctx context.Context, b Backend) DependencyStatus {
+	start := time.Now()
+	status := DependencyStatus{Status: StatusOK}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, b.URL, nil)
+	if err != nil {
+		status.Status = StatusUnavailable
+		status.Error = err.Error()
+		return status
+	}
+
+	resp, err := c.client.Do(req)
+	status.LatencyMs = time.Since(start).Milliseconds()
+	if err != nil {
+		status.Status = StatusUnavailable
+		status.Error = err.Error()
+		return status
+	}
+	resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		status.Status = StatusUnavailable
+		status.Error = fmt.Sprintf("unexpected status %d", resp.StatusCode)
+	}
+	return status
+}
@@ -0,0 +1,137 @@
+package health_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/daisuke8000/example-ec-platform/bff/internal/health"
+)
+
+func newBackend(t *testing.T, status int, hits *atomic.Int32) *httptest.Server {
+	t.Helper()
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if hits != nil {
+			hits.Add(1)
+		}
+		w.WriteHeader(status)
+	}))
+	t.Cleanup(srv.Close)
+	return srv
+}
+
+func TestReadinessChecker_AllHealthy(t *testing.T) {
+	user := newBackend(t, http.StatusOK, nil)
+
+	checker := health.NewReadinessChecker(health.ReadinessConfig{
+		Backends: []health.Backend{{Name: "user-service", URL: user.URL}},
+		Timeout:  time.Second,
+		CacheTTL: time.Minute,
+	}, map[string]func() bool{
+		"jwks": func() bool { return true },
+	})
+
+	rec := httptest.NewRecorder()
+	checker.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/ready", nil))
+
+	if rec.Code != http.StatusOK {
+		t.Fatalf("expected 200, got %d", rec.Code)
+	}
+
+	var report health.Report
+	if err := json.NewDecoder(rec.Body).Decode(&report); err != nil {
+		t.Fatalf("failed to decode report: %v", err)
+	}
+	if report.Status != health.StatusOK {
+		t.Errorf("expected status ok, got %s", report.Status)
+	}
+	for _, name := range []string{"jwks", "user-service"} {
+		if report.Dependencies[name].Status != health.StatusOK {
+			t.Errorf("expected %s ok, got %+v", name, report.Dependencies[name])
+		}
+	}
+}
+
+func TestReadinessChecker_BackendDown(t *testing.T) {
+	user := newBackend(t, http.StatusOK, nil)
+	product := newBackend(t, http.StatusServiceUnavailable, nil)
+
+	checker := health.NewReadinessChecker(health.ReadinessConfig{
+		Backends: []health.Backend{
+			{Name: "user-service", URL: user.URL},
+			{Name: "product-service", URL: product.URL},
+		},
+		Timeout:  time.Second,
+		CacheTTL: time.Minute,
+	}, nil)
+
+	rec := httptest.NewRecorder()
+	checker.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/ready", nil))
+
+	if rec.Code != http.StatusServiceUnavailable {
+		t.Fatalf("expected 503, got %d", rec.Code)
+	}
+
+	var report health.Report
+	if err := json.NewDecoder(rec.Body).Decode(&report); err != nil {
+		t.Fatalf("failed to decode report: %v", err)
+	}
+	if report.Dependencies["user-service"].Status != health.StatusOK {
+		t.Errorf("expected user-service ok, got %+v", report.Dependencies["user-service"])
+	}
+	if got := report.Dependencies["product-service"]; got.Status != health.StatusUnavailable || got.Error == "" {
+		t.Errorf("expected product-service unavailable with error, got %+v", got)
+	}
+}
+
+func TestReadinessChecker_LocalCheckFails(t *testing.T) {
+	checker := health.NewReadinessChecker(health.ReadinessConfig{}, map[string]func() bool{
+		"jwks": func() bool { return false },
+	})
+
+	report := checker.Check(t.Context())
+	if report.Status != health.StatusUnavailable {
+		t.Errorf("expected status unavailable, got %s", report.Status)
+	}
+}
+
+func TestReadinessChecker_CachesProbes(t *testing.T) {
+	var hits atomic.Int32
+	user := newBackend(t, http.StatusOK, &hits)
+
+	checker := health.NewReadinessChecker(health.ReadinessConfig{
+		Backends: []health.Backend{{Name: "user-service", URL: user.URL}},
+		Timeout:  time.Second,
+		CacheTTL: time.Minute,
+	}, nil)
+
+	for i := 0; i < 3; i++ {
+		checker.Check(t.Context())
+	}
+
+	if hits.Load() != 1 {
+		t.Errorf("expected 1 probe within cache TTL, got %d", hits.Load())
+	}
+}
+
+func TestReadinessChecker_ProbeTimeout(t *testing.T) {
+	slow := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		time.Sleep(200 * time.Millisecond)
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer slow.Close()
+
+	checker := health.NewReadinessChecker(health.ReadinessConfig{
+		Backends: []health.Backend{{Name: "user-service", URL: slow.URL}},
+		Timeout:  20 * time.Millisecond,
+		CacheTTL: time.Minute,
+	}, nil)
+
+	report := checker.Check(t.Context())
+	if report.Dependencies["user-service"].Status != health.StatusUnavailable {
+		t.Errorf("expected timed out backend to be unavailable, got %+v", report.Dependencies["user-service"])
+	}
+}
@@ -124,6 +124,22 @@ func (m *JWKSManager) Refresh(ctx context.Context) error {
 	return nil
 }
 
+// RefreshNow fetches the JWKS regardless of MinRefreshInterval, for
+// operators who know the keys were rotated.
+func (m *JWKSManager) RefreshNow(ctx context.Context) error {
+	m.refreshMu.Lock()
+	defer m.refreshMu.Unlock()
+
+	if _, err := m.cache.Refresh(ctx, m.url); err != nil {
+		m.setHealthy(false)
+		return fmt.Errorf("failed to refresh JWKS: %w", err)
+	}
+
+	m.lastRefresh = time.Now()
+	m.setHealthy(true)
+	return nil
+}
+
 // IsHealthy returns true if the last JWKS operation was successful.
 func (m *JWKSManager) IsHealthy() bool {
 	m.healthMu.RLock()
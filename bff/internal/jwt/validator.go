@@ -22,8 +22,16 @@ type ValidatorConfig struct {
 
 // ValidatedClaims contains extracted claims from a validated JWT.
 type ValidatedClaims struct {
-	Subject   string
-	Scopes    []string
+	Subject     string
+	Scopes      []string
+	Roles       []string
+	Permissions []string
+	// Channel and Market restrict public product reads to what is on sale
+	// for the client the token was issued to. Empty when not set at consent.
+	Channel string
+	Market  string
+	// ClientID is the OAuth2 client the token was issued to.
+	ClientID  string
 	ExpiresAt time.Time
 	IssuedAt  time.Time
 }
@@ -160,10 +168,15 @@ func (v *Validator) Validate(ctx context.Context, tokenString string) (*Validate
 
 	// Extract claims
 	claims := &ValidatedClaims{
-		Subject:   token.Subject(),
-		Scopes:    extractScopes(token),
-		ExpiresAt: token.Expiration(),
-		IssuedAt:  token.IssuedAt(),
+		Subject:     token.Subject(),
+		Scopes:      extractScopes(token),
+		Roles:       extractStringList(token, "roles"),
+		Permissions: extractStringList(token, "permissions"),
+		Channel:     extractString(token, "channel"),
+		Market:      extractString(token, "market"),
+		ClientID:    extractString(token, "client_id"),
+		ExpiresAt:   token.Expiration(),
+		IssuedAt:    token.IssuedAt(),
 	}
 
 	return claims, nil
@@ -239,3 +252,56 @@ func extractScopes(token jwt.Token) []string {
 
 	return strings.Split(scopeStr, " ")
 }
+
+// extractStringList reads a string array claim set at consent time.
+// Hydra places consent session claims under "ext" unless they are allowed
+// as top-level claims, so both locations are checked.
+func extractStringList(token jwt.Token, name string) []string {
+	if v, ok := token.Get(name); ok {
+		return toStringList(v)
+	}
+
+	ext, ok := token.Get("ext")
+	if !ok {
+		return []string{}
+	}
+	extMap, ok := ext.(map[string]interface{})
+	if !ok {
+		return []string{}
+	}
+	return toStringList(extMap[name])
+}
+
+// extractString reads a string claim set at consent time, checking the same
+// locations as extractStringList.
+func extractString(token jwt.Token, name string) string {
+	v, ok := token.Get(name)
+	if !ok {
+		ext, ok := token.Get("ext")
+		if !ok {
+			return ""
+		}
+		extMap, ok := ext.(map[string]interface{})
+		if !ok {
+			return ""
+		}
+		v = extMap[name]
+	}
+	s, _ := v.(string)
+	return s
+}
+
+func toStringList(v interface{}) []string {
+	items, ok := v.([]interface{})
+	if !ok {
+		return []string{}
+	}
+
+	result := make([]string, 0, len(items))
+	for _, item := range items {
+		if s, ok := item.(string); ok && s != "" {
+			result = append(result, s)
+		}
+	}
+	return result
+}
@@ -0,0 +1,63 @@
+package jwt
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"strings"
+	"testing"
+	"unicode/utf8"
+)
+
+func FuzzExtractKidFromJWT(f *testing.F) {
+	header := base64.RawURLEncoding.EncodeToString([]byte(`{"alg":"RS256","kid":"key-1"}`))
+	for _, token := range []string{
+		header + ".e30.sig",
+		header + ".e30",
+		"..",
+		"e30..",
+		"not-base64!.e30.sig",
+		base64.RawURLEncoding.EncodeToString([]byte(`{"kid":1}`)) + ".e30.sig",
+		base64.RawURLEncoding.EncodeToString([]byte(`[]`)) + ".e30.sig",
+	} {
+		f.Add(token)
+	}
+
+	f.Fuzz(func(t *testing.T, token string) {
+		kid, err := extractKidFromJWT(token)
+		if err != nil {
+			return
+		}
+		if strings.Count(token, ".") != 2 {
+			t.Fatalf("extractKidFromJWT(%q) = %q, want an error for a token without three parts", token, kid)
+		}
+		if !utf8.ValidString(kid) {
+			t.Fatalf("extractKidFromJWT(%q) = %q, not valid UTF-8", token, kid)
+		}
+	})
+}
+
+func FuzzExtractKidFromJWT_RoundTrip(f *testing.F) {
+	f.Add("key-1")
+	f.Add("")
+	f.Add("kid with \"quotes\" and \\")
+	f.Add("鍵")
+
+	f.Fuzz(func(t *testing.T, kid string) {
+		if !utf8.ValidString(kid) {
+			t.Skip("json.Marshal replaces invalid UTF-8")
+		}
+		header, err := json.Marshal(map[string]string{"alg": "RS256", "kid": kid})
+		if err != nil {
+			t.Fatal(err)
+		}
+		token := base64.RawURLEncoding.EncodeToString(header) + ".e30.sig"
+
+		got, err := extractKidFromJWT(token)
+		if err != nil {
+			t.Fatalf("extractKidFromJWT() error = %v", err)
+		}
+		if got != kid {
+			t.Fatalf("extractKidFromJWT() = %q, want %q", got, kid)
+		}
+	})
+}
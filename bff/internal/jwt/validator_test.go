@@ -7,6 +7,7 @@ import (
 	"encoding/json"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
 	"time"
 
@@ -387,3 +388,86 @@ func TestJWTValidator_ExtractScopes(t *testing.T) {
 		})
 	}
 }
+
+func TestJWTValidator_ExtractRoleClaims(t *testing.T) {
+	kp := setupTestKeyPair(t, "test-kid")
+	defer kp.jwksServer.Close()
+
+	cfg := jwtpkg.ValidatorConfig{
+		Issuer:    "https://hydra.example.com/",
+		Audience:  "test-audience",
+		ClockSkew: 30 * time.Second,
+	}
+
+	jwksCfg := jwtpkg.JWKSConfig{
+		URL:                kp.jwksServer.URL,
+		RefreshInterval:    time.Hour,
+		MinRefreshInterval: 10 * time.Second,
+	}
+
+	ctx := context.Background()
+	jwksManager, _ := jwtpkg.NewJWKSManager(ctx, jwksCfg)
+	defer jwksManager.Close()
+
+	validator := jwtpkg.NewValidator(cfg, jwksManager)
+
+	tests := []struct {
+		name      string
+		extra     map[string]interface{}
+		wantRoles []string
+		wantPerms []string
+	}{
+		{
+			name: "top_level",
+			extra: map[string]interface{}{
+				"roles":       []string{"admin"},
+				"permissions": []string{"users:list", "users:read"},
+			},
+			wantRoles: []string{"admin"},
+			wantPerms: []string{"users:list", "users:read"},
+		},
+		{
+			name: "nested_in_ext",
+			extra: map[string]interface{}{
+				"ext": map[string]interface{}{
+					"roles":       []string{"support"},
+					"permissions": []string{"users:read"},
+				},
+			},
+			wantRoles: []string{"support"},
+			wantPerms: []string{"users:read"},
+		},
+		{
+			name:      "absent",
+			extra:     map[string]interface{}{},
+			wantRoles: []string{},
+			wantPerms: []string{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			claims := map[string]interface{}{
+				"iss": "https://hydra.example.com/",
+				"aud": []string{"test-audience"},
+				"sub": "user-123",
+				"exp": time.Now().Add(time.Hour).Unix(),
+			}
+			for k, v := range tt.extra {
+				claims[k] = v
+			}
+
+			validated, err := validator.Validate(ctx, kp.signToken(t, claims))
+			if err != nil {
+				t.Fatalf("Validate() error = %v", err)
+			}
+
+			if strings.Join(validated.Roles, " ") != strings.Join(tt.wantRoles, " ") {
+				t.Errorf("Roles = %v, want %v", validated.Roles, tt.wantRoles)
+			}
+			if strings.Join(validated.Permissions, " ") != strings.Join(tt.wantPerms, " ") {
+				t.Errorf("Permissions = %v, want %v", validated.Permissions, tt.wantPerms)
+			}
+		})
+	}
+}
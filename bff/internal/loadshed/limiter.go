@@ -0,0 +1,176 @@
+// Package loadshed rejects requests beyond an adaptive concurrency limit so
+// the BFF sheds excess load with a fast 503 instead of queueing it until
+// every request times out.
+//
+// The limit follows a gradient of request latency: while the latency of
+// recent requests stays close to the long-term average the limit grows;
+// when requests slow down, which means they are queueing somewhere, the
+// limit shrinks in proportion.
+package loadshed
+
+import (
+	"log/slog"
+	"math"
+	"net/http"
+	"slices"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// Config bounds the adaptive concurrency limit.
+type Config struct {
+	InitialLimit int
+	MinLimit     int
+	MaxLimit     int
+
+	// Window is how often the limit is recomputed from the latencies
+	// observed since the last update.
+	Window time.Duration
+
+	// RetryAfter is sent in the Retry-After header of shed requests.
+	RetryAfter time.Duration
+
+	// ExemptPaths are never shed, e.g. health probes.
+	ExemptPaths []string
+
+	// OnShed, if set, is called for every shed request.
+	OnShed func()
+}
+
+const (
+	// longRTTWeight is the weight of a window's average latency in the
+	// long-term average (an EWMA over roughly 20 windows).
+	longRTTWeight = 0.05
+	// smoothing damps each limit change.
+	smoothing = 0.2
+	// minGradient bounds how fast the limit shrinks in one window.
+	minGradient = 0.5
+)
+
+// Limiter is an adaptive concurrency limiter for HTTP handlers.
+type Limiter struct {
+	cfg    Config
+	logger *slog.Logger
+	now    func() time.Time
+
+	mu          sync.Mutex
+	limit       float64
+	inflight    int
+	maxInflight int // Peak inflight in the current window
+	windowStart time.Time
+	windowSum   time.Duration
+	windowCount int
+	longRTT     float64 // Seconds; 0 until the first window
+}
+
+func NewLimiter(cfg Config, logger *slog.Logger) *Limiter {
+	return &Limiter{
+		cfg:         cfg,
+		logger:      logger,
+		now:         time.Now,
+		limit:       float64(cfg.InitialLimit),
+		windowStart: time.Now(),
+	}
+}
+
+// Limit returns the current concurrency limit.
+func (l *Limiter) Limit() int {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return int(l.limit)
+}
+
+// Inflight returns the number of requests being served.
+func (l *Limiter) Inflight() int {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.inflight
+}
+
+// Middleware serves requests while fewer than the limit are in flight and
+// rejects the others with 503 Service Unavailable and Retry-After.
+func (l *Limiter) Middleware(next http.Handler) http.Handler {
+	retryAfter := strconv.Itoa(int(math.Ceil(l.cfg.RetryAfter.Seconds())))
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if slices.Contains(l.cfg.ExemptPaths, r.URL.Path) {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		if !l.acquire() {
+			if l.cfg.OnShed != nil {
+				l.cfg.OnShed()
+			}
+			w.Header().Set("Retry-After", retryAfter)
+			http.Error(w, "server overloaded", http.StatusServiceUnavailable)
+			return
+		}
+
+		start := l.now()
+		defer func() { l.release(l.now().Sub(start)) }()
+		next.ServeHTTP(w, r)
+	})
+}
+
+func (l *Limiter) acquire() bool {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.inflight >= int(l.limit) {
+		return false
+	}
+	l.inflight++
+	l.maxInflight = max(l.maxInflight, l.inflight)
+	return true
+}
+
+func (l *Limiter) release(rtt time.Duration) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.inflight--
+	l.windowSum += rtt
+	l.windowCount++
+
+	if now := l.now(); now.Sub(l.windowStart) >= l.cfg.Window {
+		l.update()
+		l.windowStart = now
+		l.windowSum, l.windowCount = 0, 0
+		l.maxInflight = l.inflight
+	}
+}
+
+// update recomputes the limit from the current window. Callers hold l.mu.
+func (l *Limiter) update() {
+	shortRTT := (l.windowSum / time.Duration(l.windowCount)).Seconds()
+	if shortRTT <= 0 {
+		return
+	}
+	if l.longRTT == 0 {
+		l.longRTT = shortRTT
+		return
+	}
+	l.longRTT = l.longRTT*(1-longRTTWeight) + shortRTT*longRTTWeight
+
+	// Under an unused limit latency says nothing about capacity; growing
+	// the limit then would only let a later burst through unchecked.
+	if float64(l.maxInflight) < l.limit/2 {
+		return
+	}
+
+	gradient := max(minGradient, min(1, l.longRTT/shortRTT))
+	// sqrt(limit) of headroom lets the limit probe upwards while latency
+	// holds steady.
+	target := l.limit*gradient + math.Sqrt(l.limit)
+	limit := l.limit*(1-smoothing) + target*smoothing
+	limit = max(float64(l.cfg.MinLimit), min(float64(l.cfg.MaxLimit), limit))
+
+	if int(limit) != int(l.limit) {
+		l.logger.Debug("concurrency limit changed",
+			slog.Int("from", int(l.limit)),
+			slog.Int("to", int(limit)),
+			slog.Duration("short_rtt", time.Duration(shortRTT*float64(time.Second))),
+			slog.Duration("long_rtt", time.Duration(l.longRTT*float64(time.Second))),
+		)
+	}
+	l.limit = limit
+}
@@ -0,0 +1,134 @@
+package loadshed
+
+import (
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"sync"
+	"testing"
+	"time"
+)
+
+func newTestLimiter(cfg Config) (*Limiter, *time.Time) {
+	logger := slog.New(slog.NewTextHandler(os.Stdout, &slog.HandlerOptions{Level: slog.LevelError}))
+	l := NewLimiter(cfg, logger)
+	clock := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	l.now = func() time.Time { return clock }
+	l.windowStart = clock
+	return l, &clock
+}
+
+// runWindow serves n concurrent requests taking rtt each and ends the window.
+func runWindow(l *Limiter, clock *time.Time, n int, rtt time.Duration) {
+	for range n {
+		l.acquire()
+	}
+	for range n - 1 {
+		l.release(rtt)
+	}
+	*clock = clock.Add(l.cfg.Window)
+	l.release(rtt)
+}
+
+func TestLimiter_Middleware_Sheds(t *testing.T) {
+	var shed int
+	l, _ := newTestLimiter(Config{
+		InitialLimit: 2, MinLimit: 1, MaxLimit: 10,
+		Window:      time.Second,
+		RetryAfter:  1500 * time.Millisecond,
+		ExemptPaths: []string{"/health"},
+		OnShed:      func() { shed++ },
+	})
+
+	release := make(chan struct{})
+	var started, done sync.WaitGroup
+	handler := l.Middleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/slow" {
+			started.Done()
+			<-release
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+
+	for range 2 {
+		started.Add(1)
+		done.Add(1)
+		go func() {
+			defer done.Done()
+			handler.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodPost, "/slow", nil))
+		}()
+	}
+	started.Wait()
+
+	rr := httptest.NewRecorder()
+	handler.ServeHTTP(rr, httptest.NewRequest(http.MethodPost, "/user.v1.UserService/GetUser", nil))
+	if rr.Code != http.StatusServiceUnavailable {
+		t.Errorf("status = %d, want 503 at the limit", rr.Code)
+	}
+	if got := rr.Header().Get("Retry-After"); got != "2" {
+		t.Errorf("Retry-After = %q, want 2", got)
+	}
+	if shed != 1 {
+		t.Errorf("OnShed calls = %d, want 1", shed)
+	}
+
+	rr = httptest.NewRecorder()
+	handler.ServeHTTP(rr, httptest.NewRequest(http.MethodGet, "/health", nil))
+	if rr.Code != http.StatusOK {
+		t.Errorf("exempt path status = %d, want 200", rr.Code)
+	}
+
+	close(release)
+	done.Wait()
+	if l.Inflight() != 0 {
+		t.Errorf("inflight = %d, want 0 after requests finished", l.Inflight())
+	}
+}
+
+func TestLimiter_AdaptsToLatency(t *testing.T) {
+	l, clock := newTestLimiter(Config{InitialLimit: 100, MinLimit: 10, MaxLimit: 1000, Window: time.Second})
+
+	// Steady latency at the limit: the limit probes upwards.
+	for range 3 {
+		runWindow(l, clock, l.Limit(), 10*time.Millisecond)
+	}
+	grown := l.Limit()
+	if grown <= 100 {
+		t.Fatalf("limit = %d after steady windows, want above 100", grown)
+	}
+
+	// Requests slow down: the limit shrinks.
+	for range 5 {
+		runWindow(l, clock, l.Limit(), 50*time.Millisecond)
+	}
+	if l.Limit() >= grown {
+		t.Errorf("limit = %d after slow windows, want below %d", l.Limit(), grown)
+	}
+}
+
+func TestLimiter_RespectsMinLimit(t *testing.T) {
+	l, clock := newTestLimiter(Config{InitialLimit: 20, MinLimit: 15, MaxLimit: 100, Window: time.Second})
+
+	runWindow(l, clock, l.Limit(), 10*time.Millisecond)
+	for range 8 {
+		runWindow(l, clock, l.Limit(), time.Second)
+		if l.Limit() < 15 {
+			t.Fatalf("limit = %d, want at least MinLimit 15", l.Limit())
+		}
+	}
+	if l.Limit() != 15 {
+		t.Errorf("limit = %d, want MinLimit 15 under sustained slowness", l.Limit())
+	}
+}
+
+func TestLimiter_UnusedLimitDoesNotGrow(t *testing.T) {
+	l, clock := newTestLimiter(Config{InitialLimit: 100, MinLimit: 10, MaxLimit: 1000, Window: time.Second})
+
+	for range 5 {
+		runWindow(l, clock, 10, 10*time.Millisecond)
+	}
+	if l.Limit() != 100 {
+		t.Errorf("limit = %d, want 100 while mostly unused", l.Limit())
+	}
+}
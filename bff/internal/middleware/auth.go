@@ -2,8 +2,13 @@ package middleware
 
 import (
 	"context"
+	"errors"
 	"log/slog"
+	"math"
+	"net/http"
+	"strconv"
 	"strings"
+	"time"
 
 	"connectrpc.com/connect"
 
@@ -18,13 +23,31 @@ type ProcedureKey struct{}
 type AuthInterceptorConfig struct {
 	// TrustedProxyHeader is the header to extract client IP from (e.g., X-Real-IP, X-Forwarded-For).
 	TrustedProxyHeader string
+
+	// Events, if set, receives an event for every rejected authentication.
+	Events SecurityEventSink
+
+	// Denylist, if set, rejects tokens revoked before they expired.
+	Denylist TokenDenylist
+}
+
+// TokenValidator validates bearer tokens and returns their claims.
+// *jwt.Validator is the production implementation.
+type TokenValidator interface {
+	Validate(ctx context.Context, token string) (*jwt.ValidatedClaims, error)
+}
+
+// TokenDenylist reports tokens revoked before they expired.
+// *denylist.List is the production implementation.
+type TokenDenylist interface {
+	IsRevoked(userID, clientID string, issuedAt time.Time) bool
 }
 
 // NewAuthInterceptor creates a Connect-go unary interceptor for JWT authentication.
 // It validates Bearer tokens, checks rate limits, and propagates user context.
 func NewAuthInterceptor(
 	cfg AuthInterceptorConfig,
-	validator *jwt.Validator,
+	validator TokenValidator,
 	rateLimiter *RateLimiter,
 	publicMatcher *PublicEndpointMatcher,
 ) connect.UnaryInterceptorFunc {
@@ -42,35 +65,61 @@ func NewAuthInterceptor(
 			clientIP := extractClientIP(req, cfg.TrustedProxyHeader)
 
 			// Check rate limit before processing
-			if rateLimiter.IsRateLimited(clientIP) {
+			if retryAfter := rateLimiter.CooldownRemaining(clientIP); retryAfter > 0 {
 				slog.Warn("rate limited",
 					"client_ip", clientIP,
 					"procedure", procedure,
 				)
-				return nil, connect.NewError(
+				emitAuthFailure(cfg.Events, req, clientIP, procedure, "rate_limited")
+				connectErr := connect.NewError(
 					connect.CodeResourceExhausted,
 					nil,
 				)
+				seconds := strconv.Itoa(int(math.Ceil(retryAfter.Seconds())))
+				connectErr.Meta().Set("Retry-After", seconds)
+				connectErr.Meta().Set("RateLimit-Remaining", "0")
+				connectErr.Meta().Set("RateLimit-Reset", seconds)
+				return nil, connectErr
 			}
 
 			// Extract Bearer token
 			token, err := extractBearerToken(req)
 			if err != nil {
 				recordFailureAndLog(rateLimiter, clientIP, procedure, "missing_token")
+				emitAuthFailure(cfg.Events, req, clientIP, procedure, "missing_token")
 				return nil, newUnauthenticatedError()
 			}
 
-			// Validate JWT
-			claims, err := validator.Validate(ctx, token)
+			claims, err := authenticate(ctx, validator, cfg.Denylist, token)
+			if errors.Is(err, errTokenRevoked) {
+				// A revoked token is still validly signed, so it does not
+				// count toward the client's rate limit.
+				slog.Warn("authentication failed",
+					"reason", "token_revoked",
+					"user_id", claims.Subject,
+					"procedure", procedure,
+				)
+				emitAuthFailure(cfg.Events, req, clientIP, procedure, "token_revoked")
+				return nil, newUnauthenticatedError()
+			}
 			if err != nil {
 				reason := categorizeValidationError(err)
 				recordFailureAndLog(rateLimiter, clientIP, procedure, reason)
+				emitAuthFailure(cfg.Events, req, clientIP, procedure, reason)
 				return nil, newUnauthenticatedError()
 			}
 
 			// Inject user context using shared package for consistent context keys
 			ctx = pkgmw.WithUserID(ctx, claims.Subject)
 			ctx = pkgmw.WithScopes(ctx, strings.Join(claims.Scopes, " "))
+			ctx = pkgmw.WithRoles(ctx, strings.Join(claims.Roles, " "))
+			ctx = pkgmw.WithPermissions(ctx, strings.Join(claims.Permissions, " "))
+			if claims.Channel != "" {
+				ctx = pkgmw.WithChannel(ctx, claims.Channel)
+			}
+			if claims.Market != "" {
+				ctx = pkgmw.WithMarket(ctx, claims.Market)
+			}
 
 			slog.Debug("authentication successful",
 				"user_id", claims.Subject,
@@ -82,6 +131,37 @@ func NewAuthInterceptor(
 	}
 }
 
+var errTokenRevoked = errors.New("token revoked")
+
+// authenticate validates a bearer token and rejects it if denylist, which
+// may be nil, lists it as revoked. The claims of a revoked token are
+// returned along with errTokenRevoked.
+func authenticate(ctx context.Context, validator TokenValidator, denylist TokenDenylist, token string) (*jwt.ValidatedClaims, error) {
+	claims, err := validator.Validate(ctx, token)
+	if err != nil {
+		return nil, err
+	}
+	if denylist != nil && denylist.IsRevoked(claims.Subject, claims.ClientID, claims.IssuedAt) {
+		return claims, errTokenRevoked
+	}
+	return claims, nil
+}
+
+// AuthenticateHTTP authenticates the bearer token of a plain HTTP request
+// the way the auth interceptor does a Connect request, for endpoints that
+// are not served through it. denylist may be nil.
+func AuthenticateHTTP(r *http.Request, validator TokenValidator, denylist TokenDenylist) (*jwt.ValidatedClaims, bool) {
+	token, ok := bearerToken(r.Header)
+	if !ok {
+		return nil, false
+	}
+	claims, err := authenticate(r.Context(), validator, denylist, token)
+	if err != nil {
+		return nil, false
+	}
+	return claims, true
+}
+
 // getProcedure extracts procedure name from context or request.
 func getProcedure(ctx context.Context, req connect.AnyRequest) string {
 	// First, check context (used in tests)
@@ -102,32 +182,37 @@ func getProcedure(ctx context.Context, req connect.AnyRequest) string {
 // extractBearerToken extracts the token from Authorization header.
 // Supports case-insensitive Bearer scheme (Bearer, bearer, BEARER).
 func extractBearerToken(req connect.AnyRequest) (string, error) {
-	authHeader := req.Header().Get("Authorization")
-	if authHeader == "" {
+	token, ok := bearerToken(req.Header())
+	if !ok {
 		return "", connect.NewError(connect.CodeUnauthenticated, nil)
 	}
+	return token, nil
+}
+
+func bearerToken(header http.Header) (string, bool) {
+	authHeader := header.Get("Authorization")
 
 	// Case-insensitive Bearer check
 	if len(authHeader) < 7 {
-		return "", connect.NewError(connect.CodeUnauthenticated, nil)
+		return "", false
 	}
 
 	prefix := strings.ToLower(authHeader[:6])
 	if prefix != "bearer" {
-		return "", connect.NewError(connect.CodeUnauthenticated, nil)
+		return "", false
 	}
 
 	// Check for space after Bearer
 	if authHeader[6] != ' ' {
-		return "", connect.NewError(connect.CodeUnauthenticated, nil)
+		return "", false
 	}
 
 	token := strings.TrimSpace(authHeader[7:])
 	if token == "" {
-		return "", connect.NewError(connect.CodeUnauthenticated, nil)
+		return "", false
 	}
 
-	return token, nil
+	return token, true
 }
 
 // extractClientIP extracts client IP from request headers.
@@ -0,0 +1,49 @@
+package middleware
+
+import (
+	"strings"
+	"testing"
+
+	"connectrpc.com/connect"
+)
+
+func FuzzExtractBearerToken(f *testing.F) {
+	for _, header := range []string{
+		"",
+		"Bearer token",
+		"bearer token",
+		"BEARER  token  ",
+		"Bearer",
+		"Bearer ",
+		"Bearertoken",
+		"Basic dXNlcjpwYXNz",
+		"Bearer\ttoken",
+		"Béarer token",
+	} {
+		f.Add(header)
+	}
+
+	f.Fuzz(func(t *testing.T, header string) {
+		req := connect.NewRequest(&struct{}{})
+		req.Header().Set("Authorization", header)
+
+		token, err := extractBearerToken(req)
+		if err != nil {
+			if connect.CodeOf(err) != connect.CodeUnauthenticated {
+				t.Fatalf("extractBearerToken(%q) code = %v, want %v", header, connect.CodeOf(err), connect.CodeUnauthenticated)
+			}
+			return
+		}
+
+		got := req.Header().Get("Authorization")
+		if !strings.EqualFold(got[:7], "bearer ") {
+			t.Fatalf("extractBearerToken(%q) accepted a non-Bearer scheme", header)
+		}
+		if token == "" || token != strings.TrimSpace(token) {
+			t.Fatalf("extractBearerToken(%q) = %q, want a trimmed non-empty token", header, token)
+		}
+		if !strings.Contains(got, token) {
+			t.Fatalf("extractBearerToken(%q) = %q, not part of the header", header, token)
+		}
+	})
+}
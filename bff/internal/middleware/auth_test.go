@@ -259,3 +259,112 @@ func TestAuthInterceptor_BearerCaseInsensitive(t *testing.T) {
 		})
 	}
 }
+
+type staticValidator struct {
+	claims *jwtpkg.ValidatedClaims
+}
+
+func (v staticValidator) Validate(context.Context, string) (*jwtpkg.ValidatedClaims, error) {
+	return v.claims, nil
+}
+
+type revokedClients map[string]time.Time
+
+func (d revokedClients) IsRevoked(userID, clientID string, issuedAt time.Time) bool {
+	at, ok := d[userID+"/"+clientID]
+	return ok && !issuedAt.After(at)
+}
+
+func TestAuthInterceptor_RevokedToken(t *testing.T) {
+	revokedAt := time.Now()
+	denylist := revokedClients{"user-123/web": revokedAt}
+
+	tests := []struct {
+		name     string
+		clientID string
+		issuedAt time.Time
+		wantErr  bool
+	}{
+		{"issued before revocation", "web", revokedAt.Add(-time.Minute), true},
+		{"issued after revocation", "web", revokedAt.Add(time.Minute), false},
+		{"other client", "mobile", revokedAt.Add(-time.Minute), false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			validator := staticValidator{claims: &jwtpkg.ValidatedClaims{
+				Subject:  "user-123",
+				ClientID: tt.clientID,
+				IssuedAt: tt.issuedAt,
+			}}
+			rateLimiter := middleware.NewRateLimiter(middleware.RateLimitConfig{
+				FailureThreshold: 10,
+				Window:           time.Minute,
+				Cooldown:         5 * time.Minute,
+			})
+			interceptor := middleware.NewAuthInterceptor(
+				middleware.AuthInterceptorConfig{Denylist: denylist},
+				validator,
+				rateLimiter,
+				middleware.NewPublicEndpointMatcher(nil),
+			)
+
+			req := connect.NewRequest(&struct{}{})
+			req.Header().Set("Authorization", "Bearer token")
+			handler := func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+				return connect.NewResponse(&struct{}{}), nil
+			}
+
+			_, err := interceptor(handler)(context.Background(), req)
+			if !tt.wantErr {
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				return
+			}
+			if connect.CodeOf(err) != connect.CodeUnauthenticated {
+				t.Errorf("expected CodeUnauthenticated, got %v", err)
+			}
+		})
+	}
+}
+
+func TestAuthenticateHTTP(t *testing.T) {
+	revokedAt := time.Now()
+	validator := staticValidator{claims: &jwtpkg.ValidatedClaims{
+		Subject:  "user-123",
+		ClientID: "web",
+		IssuedAt: revokedAt.Add(-time.Minute),
+	}}
+
+	tests := []struct {
+		name     string
+		header   string
+		denylist middleware.TokenDenylist
+		wantOK   bool
+	}{
+		{"valid token", "Bearer token", nil, true},
+		{"lowercase scheme", "bearer token", nil, true},
+		{"missing token", "", nil, false},
+		{"other scheme", "Basic dXNlcjpwYXNz", nil, false},
+		{"revoked token", "Bearer token", revokedClients{"user-123/web": revokedAt}, false},
+		{"token of another revoked client", "Bearer token", revokedClients{"user-123/mobile": revokedAt}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := httptest.NewRequest(http.MethodPost, "/ops/runbook", nil)
+			if tt.header != "" {
+				r.Header.Set("Authorization", tt.header)
+			}
+
+			claims, ok := middleware.AuthenticateHTTP(r, validator, tt.denylist)
+			if ok != tt.wantOK {
+				t.Fatalf("AuthenticateHTTP() ok = %v, want %v", ok, tt.wantOK)
+			}
+			if ok && claims.Subject != "user-123" {
+				t.Errorf("subject = %q, want user-123", claims.Subject)
+			}
+		})
+	}
+}
@@ -0,0 +1,39 @@
+package middleware
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"connectrpc.com/connect"
+
+	"github.com/daisuke8000/example-ec-platform/bff/internal/client"
+	pkgmw "github.com/daisuke8000/example-ec-platform/pkg/connect/middleware"
+)
+
+// BackendTargetHeader pins the backend release ("stable" or "canary") that
+// serves a request.
+const BackendTargetHeader = "X-Backend-Target"
+
+// NewCanaryPinInterceptor returns an interceptor that honors
+// BackendTargetHeader for callers with testerRole, letting internal testers
+// exercise a canary release regardless of its weight. The header is ignored
+// for everyone else. Must run after the auth interceptor.
+func NewCanaryPinInterceptor(testerRole string) connect.UnaryInterceptorFunc {
+	return func(next connect.UnaryFunc) connect.UnaryFunc {
+		return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+			value := req.Header().Get(BackendTargetHeader)
+			if value == "" || !slices.Contains(strings.Fields(pkgmw.GetRoles(ctx)), testerRole) {
+				return next(ctx, req)
+			}
+
+			target, err := client.ParseTarget(value)
+			if err != nil {
+				return nil, connect.NewError(connect.CodeInvalidArgument,
+					fmt.Errorf("%s must be %q or %q", BackendTargetHeader, client.TargetStable, client.TargetCanary))
+			}
+			return next(client.WithTarget(ctx, target), req)
+		}
+	}
+}
@@ -0,0 +1,84 @@
+package middleware
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+
+	"github.com/daisuke8000/example-ec-platform/bff/internal/client"
+	userv1 "github.com/daisuke8000/example-ec-platform/gen/user/v1"
+	pkgmw "github.com/daisuke8000/example-ec-platform/pkg/connect/middleware"
+)
+
+func TestCanaryPinInterceptor(t *testing.T) {
+	tests := []struct {
+		name       string
+		roles      string
+		header     string
+		wantTarget client.Target
+		wantCode   connect.Code
+	}{
+		{name: "tester_pins_canary", roles: "customer canary-tester", header: "canary", wantTarget: client.TargetCanary},
+		{name: "tester_pins_stable", roles: "canary-tester", header: "stable", wantTarget: client.TargetStable},
+		{name: "tester_without_header", roles: "canary-tester"},
+		{name: "non_tester_ignored", roles: "customer", header: "canary"},
+		{name: "tester_invalid_target", roles: "canary-tester", header: "beta", wantCode: connect.CodeInvalidArgument},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got client.Target
+			next := connect.UnaryFunc(func(ctx context.Context, _ connect.AnyRequest) (connect.AnyResponse, error) {
+				got = pinnedTarget(t, ctx)
+				return nil, nil
+			})
+
+			req := connect.NewRequest(&userv1.GetUserRequest{})
+			if tt.header != "" {
+				req.Header().Set(BackendTargetHeader, tt.header)
+			}
+			ctx := pkgmw.WithRoles(context.Background(), tt.roles)
+
+			_, err := NewCanaryPinInterceptor("canary-tester")(next)(ctx, req)
+			if tt.wantCode != 0 {
+				if connect.CodeOf(err) != tt.wantCode {
+					t.Fatalf("error code = %v, want %v", connect.CodeOf(err), tt.wantCode)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			want := tt.wantTarget
+			if want == "" {
+				want = client.TargetStable
+			}
+			if got != want {
+				t.Errorf("target = %s, want %s", got, want)
+			}
+		})
+	}
+}
+
+// pinnedTarget returns the target a weight-0 canary router chooses for ctx:
+// the pinned target, or stable when nothing is pinned.
+func pinnedTarget(t *testing.T, ctx context.Context) client.Target {
+	t.Helper()
+	var target client.Target
+	router, err := client.NewCanaryRouter(client.CanaryConfig{
+		Name: "test",
+		URL:  "http://canary.invalid",
+		OnCall: func(_ context.Context, _ string, got client.Target, _ string, _ time.Duration) {
+			target = got
+		},
+	})
+	if err != nil {
+		t.Fatalf("NewCanaryRouter() error = %v", err)
+	}
+	noop := func(context.Context, connect.AnyRequest) (connect.AnyResponse, error) { return nil, nil }
+	router.Interceptor()(noop)(ctx, connect.NewRequest(&userv1.GetUserRequest{}))
+	return target
+}
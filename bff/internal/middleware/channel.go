@@ -0,0 +1,92 @@
+package middleware
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+
+	"connectrpc.com/connect"
+
+	pkgmw "github.com/daisuke8000/example-ec-platform/pkg/connect/middleware"
+)
+
+const (
+	// ChannelHeader selects the sales channel public product reads are
+	// filtered for.
+	ChannelHeader = "X-Channel"
+
+	// MarketHeader selects the market (ISO 3166-1 alpha-2 country code)
+	// public product reads are filtered for.
+	MarketHeader = "X-Market"
+)
+
+// Channels are the sales channels the Product Service knows about.
+var Channels = []string{"web", "app", "marketplace"}
+
+// NewChannelInterceptor returns an interceptor that puts the sales channel
+// and market of the request into the context, from which they are propagated
+// to the backends. Values from the caller's token take precedence over
+// ChannelHeader and MarketHeader, which in turn override the defaults. Must
+// run after the auth interceptor. Streams are not authenticated, so they
+// use the headers or the defaults.
+func NewChannelInterceptor(defaultChannel, defaultMarket string) connect.Interceptor {
+	return &channelInterceptor{defaultChannel: defaultChannel, defaultMarket: defaultMarket}
+}
+
+type channelInterceptor struct {
+	defaultChannel string
+	defaultMarket  string
+}
+
+func (i *channelInterceptor) WrapUnary(next connect.UnaryFunc) connect.UnaryFunc {
+	return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+		ctx, err := i.withChannel(ctx, req.Header())
+		if err != nil {
+			return nil, err
+		}
+		return next(ctx, req)
+	}
+}
+
+func (i *channelInterceptor) WrapStreamingClient(next connect.StreamingClientFunc) connect.StreamingClientFunc {
+	return next
+}
+
+func (i *channelInterceptor) WrapStreamingHandler(next connect.StreamingHandlerFunc) connect.StreamingHandlerFunc {
+	return func(ctx context.Context, conn connect.StreamingHandlerConn) error {
+		ctx, err := i.withChannel(ctx, conn.RequestHeader())
+		if err != nil {
+			return err
+		}
+		return next(ctx, conn)
+	}
+}
+
+func (i *channelInterceptor) withChannel(ctx context.Context, header http.Header) (context.Context, error) {
+	channel := firstNonEmpty(pkgmw.GetChannel(ctx), header.Get(ChannelHeader), i.defaultChannel)
+	market := firstNonEmpty(pkgmw.GetMarket(ctx), header.Get(MarketHeader), i.defaultMarket)
+
+	if channel != "" {
+		channel = strings.ToLower(channel)
+		if !slices.Contains(Channels, channel) {
+			return nil, connect.NewError(connect.CodeInvalidArgument,
+				fmt.Errorf("%s must be one of %s", ChannelHeader, strings.Join(Channels, ", ")))
+		}
+		ctx = pkgmw.WithChannel(ctx, channel)
+	}
+	if market != "" {
+		ctx = pkgmw.WithMarket(ctx, strings.ToUpper(market))
+	}
+	return ctx, nil
+}
+
+func firstNonEmpty(values ...string) string {
+	for _, v := range values {
+		if v != "" {
+			return v
+		}
+	}
+	return ""
+}
@@ -0,0 +1,108 @@
+package middleware
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"connectrpc.com/connect"
+
+	userv1 "github.com/daisuke8000/example-ec-platform/gen/user/v1"
+	pkgmw "github.com/daisuke8000/example-ec-platform/pkg/connect/middleware"
+)
+
+func TestChannelInterceptor(t *testing.T) {
+	tests := []struct {
+		name          string
+		claimChannel  string
+		claimMarket   string
+		headerChannel string
+		headerMarket  string
+		wantChannel   string
+		wantMarket    string
+		wantCode      connect.Code
+	}{
+		{name: "defaults", wantChannel: "web"},
+		{name: "headers_override_defaults", headerChannel: "App", headerMarket: "jp", wantChannel: "app", wantMarket: "JP"},
+		{name: "claims_override_headers", claimChannel: "marketplace", claimMarket: "US", headerChannel: "web", headerMarket: "JP", wantChannel: "marketplace", wantMarket: "US"},
+		{name: "claim_channel_with_header_market", claimChannel: "app", headerMarket: "jp", wantChannel: "app", wantMarket: "JP"},
+		{name: "unknown_channel", headerChannel: "kiosk", wantCode: connect.CodeInvalidArgument},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var gotChannel, gotMarket string
+			next := connect.UnaryFunc(func(ctx context.Context, _ connect.AnyRequest) (connect.AnyResponse, error) {
+				gotChannel = pkgmw.GetChannel(ctx)
+				gotMarket = pkgmw.GetMarket(ctx)
+				return nil, nil
+			})
+
+			req := connect.NewRequest(&userv1.GetUserRequest{})
+			if tt.headerChannel != "" {
+				req.Header().Set(ChannelHeader, tt.headerChannel)
+			}
+			if tt.headerMarket != "" {
+				req.Header().Set(MarketHeader, tt.headerMarket)
+			}
+			ctx := context.Background()
+			if tt.claimChannel != "" {
+				ctx = pkgmw.WithChannel(ctx, tt.claimChannel)
+			}
+			if tt.claimMarket != "" {
+				ctx = pkgmw.WithMarket(ctx, tt.claimMarket)
+			}
+
+			_, err := NewChannelInterceptor("web", "").WrapUnary(next)(ctx, req)
+			if tt.wantCode != 0 {
+				if connect.CodeOf(err) != tt.wantCode {
+					t.Fatalf("error code = %v, want %v", connect.CodeOf(err), tt.wantCode)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if gotChannel != tt.wantChannel {
+				t.Errorf("channel = %q, want %q", gotChannel, tt.wantChannel)
+			}
+			if gotMarket != tt.wantMarket {
+				t.Errorf("market = %q, want %q", gotMarket, tt.wantMarket)
+			}
+		})
+	}
+}
+
+// headerConn is a stream with only request headers.
+type headerConn struct {
+	connect.StreamingHandlerConn
+	header http.Header
+}
+
+func (c *headerConn) RequestHeader() http.Header { return c.header }
+
+func TestChannelInterceptor_Stream(t *testing.T) {
+	var gotChannel, gotMarket string
+	next := connect.StreamingHandlerFunc(func(ctx context.Context, _ connect.StreamingHandlerConn) error {
+		gotChannel = pkgmw.GetChannel(ctx)
+		gotMarket = pkgmw.GetMarket(ctx)
+		return nil
+	})
+	interceptor := NewChannelInterceptor("web", "")
+
+	header := http.Header{}
+	header.Set(ChannelHeader, "App")
+	header.Set(MarketHeader, "jp")
+	if err := interceptor.WrapStreamingHandler(next)(context.Background(), &headerConn{header: header}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if gotChannel != "app" || gotMarket != "JP" {
+		t.Errorf("channel, market = %q, %q, want app, JP", gotChannel, gotMarket)
+	}
+
+	header.Set(ChannelHeader, "kiosk")
+	err := interceptor.WrapStreamingHandler(next)(context.Background(), &headerConn{header: header})
+	if connect.CodeOf(err) != connect.CodeInvalidArgument {
+		t.Errorf("error code = %v, want %v", connect.CodeOf(err), connect.CodeInvalidArgument)
+	}
+}
@@ -84,6 +84,19 @@ func (r *RateLimiter) IsRateLimited(ip string) bool {
 	return false
 }
 
+// CooldownRemaining returns how long an IP remains rate limited, or zero
+// if it is not.
+func (r *RateLimiter) CooldownRemaining(ip string) time.Duration {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	state, exists := r.state[ip]
+	if !exists || state.cooldownUntil.IsZero() {
+		return 0
+	}
+	return max(time.Until(state.cooldownUntil), 0)
+}
+
 // RecordFailure records an authentication failure for an IP.
 func (r *RateLimiter) RecordFailure(ip string) bool {
 	r.mu.Lock()
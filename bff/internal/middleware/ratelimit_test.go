@@ -189,3 +189,30 @@ func TestRateLimiter_Reset(t *testing.T) {
 		t.Error("expected IP to not be rate limited after reset")
 	}
 }
+
+func TestRateLimiter_CooldownRemaining(t *testing.T) {
+	cfg := middleware.RateLimitConfig{
+		FailureThreshold: 2,
+		Window:           time.Minute,
+		Cooldown:         5 * time.Minute,
+	}
+
+	rl := middleware.NewRateLimiter(cfg)
+	defer rl.Close()
+
+	ip := "192.168.1.10"
+
+	rl.RecordFailure(ip)
+	if got := rl.CooldownRemaining(ip); got != 0 {
+		t.Errorf("expected no cooldown below threshold, got %v", got)
+	}
+
+	rl.RecordFailure(ip)
+	if got := rl.CooldownRemaining(ip); got <= 4*time.Minute || got > 5*time.Minute {
+		t.Errorf("expected cooldown of about 5m, got %v", got)
+	}
+
+	if got := rl.CooldownRemaining("192.168.1.11"); got != 0 {
+		t.Errorf("expected no cooldown for unknown IP, got %v", got)
+	}
+}
@@ -0,0 +1,70 @@
+package middleware
+
+import (
+	"context"
+	"errors"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+
+	pkgmw "github.com/daisuke8000/example-ec-platform/pkg/connect/middleware"
+)
+
+// RequestIDHeader carries the request ID from and back to clients.
+const RequestIDHeader = "X-Request-Id"
+
+// maxRequestIDLength bounds request IDs chosen by clients.
+const maxRequestIDLength = 128
+
+// NewRequestIDInterceptor returns an interceptor that puts the request ID
+// into the context, from which it is propagated to the backends and added to
+// log records. A client's RequestIDHeader is kept if it is a plain token;
+// otherwise a UUIDv7 is generated, so IDs sort by time. The ID is returned in
+// RequestIDHeader, also on errors. Must run outermost so every log record of
+// the request carries the ID.
+func NewRequestIDInterceptor() connect.UnaryInterceptorFunc {
+	return func(next connect.UnaryFunc) connect.UnaryFunc {
+		return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+			requestID := req.Header().Get(RequestIDHeader)
+			if !validRequestID(requestID) {
+				id, err := uuid.NewV7()
+				if err != nil {
+					id = uuid.New()
+				}
+				requestID = id.String()
+			}
+
+			resp, err := next(pkgmw.WithRequestID(ctx, requestID), req)
+			if err != nil {
+				var connectErr *connect.Error
+				if !errors.As(err, &connectErr) {
+					connectErr = connect.NewError(connect.CodeOf(err), err)
+					err = connectErr
+				}
+				connectErr.Meta().Set(RequestIDHeader, requestID)
+				return nil, err
+			}
+			if resp != nil {
+				resp.Header().Set(RequestIDHeader, requestID)
+			}
+			return resp, nil
+		}
+	}
+}
+
+// validRequestID accepts IDs that are safe to log and forward: letters,
+// digits and "-", "_", ".", ":".
+func validRequestID(id string) bool {
+	if id == "" || len(id) > maxRequestIDLength {
+		return false
+	}
+	for _, c := range id {
+		switch {
+		case c >= 'a' && c <= 'z', c >= 'A' && c <= 'Z', c >= '0' && c <= '9':
+		case c == '-' || c == '_' || c == '.' || c == ':':
+		default:
+			return false
+		}
+	}
+	return true
+}
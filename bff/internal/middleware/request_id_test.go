@@ -0,0 +1,74 @@
+package middleware
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+
+	userv1 "github.com/daisuke8000/example-ec-platform/gen/user/v1"
+	pkgmw "github.com/daisuke8000/example-ec-platform/pkg/connect/middleware"
+)
+
+func TestRequestIDInterceptor(t *testing.T) {
+	tests := []struct {
+		name     string
+		header   string
+		keepSent bool
+	}{
+		{name: "generated_when_absent"},
+		{name: "client_id_kept", header: "req-123_abc.def:1", keepSent: true},
+		{name: "unsafe_id_replaced", header: "abc\ninjected"},
+		{name: "too_long_id_replaced", header: strings.Repeat("a", maxRequestIDLength+1)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var gotID string
+			next := connect.UnaryFunc(func(ctx context.Context, _ connect.AnyRequest) (connect.AnyResponse, error) {
+				gotID = pkgmw.GetRequestID(ctx)
+				return connect.NewResponse(&userv1.GetUserResponse{}), nil
+			})
+
+			req := connect.NewRequest(&userv1.GetUserRequest{})
+			if tt.header != "" {
+				req.Header().Set(RequestIDHeader, tt.header)
+			}
+			resp, err := NewRequestIDInterceptor()(next)(context.Background(), req)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if tt.keepSent {
+				if gotID != tt.header {
+					t.Errorf("request ID = %q, want %q", gotID, tt.header)
+				}
+			} else if id, err := uuid.Parse(gotID); err != nil || id.Version() != 7 {
+				t.Errorf("request ID = %q, want a UUIDv7", gotID)
+			}
+			if got := resp.Header().Get(RequestIDHeader); got != gotID {
+				t.Errorf("response header = %q, want %q", got, gotID)
+			}
+		})
+	}
+}
+
+func TestRequestIDInterceptor_Error(t *testing.T) {
+	next := connect.UnaryFunc(func(ctx context.Context, _ connect.AnyRequest) (connect.AnyResponse, error) {
+		return nil, errors.New("boom")
+	})
+	req := connect.NewRequest(&userv1.GetUserRequest{})
+	req.Header().Set(RequestIDHeader, "req-1")
+
+	_, err := NewRequestIDInterceptor()(next)(context.Background(), req)
+	var connectErr *connect.Error
+	if !errors.As(err, &connectErr) {
+		t.Fatalf("expected a connect error, got %v", err)
+	}
+	if got := connectErr.Meta().Get(RequestIDHeader); got != "req-1" {
+		t.Errorf("error metadata = %q, want %q", got, "req-1")
+	}
+}
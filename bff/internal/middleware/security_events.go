@@ -0,0 +1,108 @@
+package middleware
+
+import (
+	"context"
+	"strings"
+
+	"connectrpc.com/connect"
+
+	"github.com/daisuke8000/example-ec-platform/bff/internal/authz"
+	"github.com/daisuke8000/example-ec-platform/bff/internal/siem"
+	pkgmw "github.com/daisuke8000/example-ec-platform/pkg/connect/middleware"
+)
+
+// SecurityEventSink receives security-relevant events.
+// *siem.Shipper is the production implementation.
+type SecurityEventSink interface {
+	Emit(e siem.Event)
+}
+
+// NewSecurityAuditInterceptor reports authorization denials and every
+// request made by a caller holding permissions (staff and admin accounts)
+// to events. It must run after the auth and access grant interceptors;
+// events for requests made under a grant carry the grant IDs.
+func NewSecurityAuditInterceptor(events SecurityEventSink, trustedProxyHeader string) connect.UnaryInterceptorFunc {
+	return func(next connect.UnaryFunc) connect.UnaryFunc {
+		return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+			resp, err := next(ctx, req)
+
+			userID := pkgmw.GetUserID(ctx)
+			if userID == "" {
+				return resp, err
+			}
+
+			e := siem.Event{
+				Actor:     actorOf(req, userID, trustedProxyHeader),
+				Procedure: getProcedure(ctx, req),
+				Target:    targetOf(req.Any()),
+				Outcome:   siem.OutcomeSuccess,
+			}
+			switch {
+			case connect.CodeOf(err) == connect.CodePermissionDenied:
+				e.Type = siem.EventAccessDenied
+				e.Severity = siem.SeverityWarning
+				e.Outcome = siem.OutcomeFailure
+			case pkgmw.GetPermissions(ctx) != "":
+				e.Type = siem.EventAdminAction
+				e.Severity = siem.SeverityNotice
+				if err != nil {
+					e.Outcome = siem.OutcomeFailure
+					e.Reason = connect.CodeOf(err).String()
+				}
+			default:
+				return resp, err
+			}
+			if grants := authz.GrantsFromContext(ctx); len(grants) > 0 {
+				e.Attributes = map[string]string{"access_grant_ids": grantIDs(grants)}
+			}
+			events.Emit(e)
+
+			return resp, err
+		}
+	}
+}
+
+// grantIDs returns the IDs of grants as a comma-separated list.
+func grantIDs(grants []authz.Grant) string {
+	ids := make([]string, len(grants))
+	for i, g := range grants {
+		ids[i] = g.ID
+	}
+	return strings.Join(ids, ",")
+}
+
+// emitAuthFailure reports a rejected authentication attempt.
+func emitAuthFailure(events SecurityEventSink, req connect.AnyRequest, clientIP, procedure, reason string) {
+	if events == nil {
+		return
+	}
+	events.Emit(siem.Event{
+		Type:      siem.EventAuthFailure,
+		Severity:  siem.SeverityWarning,
+		Outcome:   siem.OutcomeFailure,
+		Actor:     siem.Actor{IP: clientIP, UserAgent: req.Header().Get("User-Agent")},
+		Procedure: procedure,
+		Reason:    reason,
+	})
+}
+
+func actorOf(req connect.AnyRequest, userID, trustedProxyHeader string) siem.Actor {
+	return siem.Actor{
+		UserID:    userID,
+		IP:        extractClientIP(req, trustedProxyHeader),
+		UserAgent: req.Header().Get("User-Agent"),
+	}
+}
+
+// targetOf returns the ID of the resource a request acts on, for messages
+// with an id or user_id field.
+func targetOf(msg any) string {
+	switch m := msg.(type) {
+	case interface{ GetId() string }:
+		return m.GetId()
+	case interface{ GetUserId() string }:
+		return m.GetUserId()
+	default:
+		return ""
+	}
+}
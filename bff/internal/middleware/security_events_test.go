@@ -0,0 +1,93 @@
+package middleware
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"connectrpc.com/connect"
+
+	"github.com/daisuke8000/example-ec-platform/bff/internal/siem"
+	userv1 "github.com/daisuke8000/example-ec-platform/gen/user/v1"
+	pkgmw "github.com/daisuke8000/example-ec-platform/pkg/connect/middleware"
+)
+
+type recordingSink struct {
+	events []siem.Event
+}
+
+func (s *recordingSink) Emit(e siem.Event) {
+	s.events = append(s.events, e)
+}
+
+func TestSecurityAuditInterceptor(t *testing.T) {
+	tests := []struct {
+		name        string
+		userID      string
+		permissions string
+		err         error
+		wantType    siem.EventType
+		wantOutcome string
+	}{
+		{name: "anonymous_ignored"},
+		{name: "customer_ignored", userID: "user-1"},
+		{
+			name:        "customer_denied",
+			userID:      "user-1",
+			err:         connect.NewError(connect.CodePermissionDenied, errors.New("access denied")),
+			wantType:    siem.EventAccessDenied,
+			wantOutcome: siem.OutcomeFailure,
+		},
+		{
+			name:        "admin_action",
+			userID:      "admin-1",
+			permissions: "users:read users:delete",
+			wantType:    siem.EventAdminAction,
+			wantOutcome: siem.OutcomeSuccess,
+		},
+		{
+			name:        "admin_action_failed",
+			userID:      "admin-1",
+			permissions: "users:delete",
+			err:         connect.NewError(connect.CodeNotFound, errors.New("not found")),
+			wantType:    siem.EventAdminAction,
+			wantOutcome: siem.OutcomeFailure,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sink := &recordingSink{}
+			next := connect.UnaryFunc(func(context.Context, connect.AnyRequest) (connect.AnyResponse, error) {
+				return nil, tt.err
+			})
+
+			req := connect.NewRequest(&userv1.DeleteUserRequest{Id: "target-1"})
+			ctx := context.WithValue(context.Background(), ProcedureKey{}, "/user.v1.UserService/DeleteUser")
+			ctx = pkgmw.WithUserID(ctx, tt.userID)
+			ctx = pkgmw.WithPermissions(ctx, tt.permissions)
+
+			_, err := NewSecurityAuditInterceptor(sink, "")(next)(ctx, req)
+			if !errors.Is(err, tt.err) {
+				t.Fatalf("error = %v, want %v", err, tt.err)
+			}
+
+			if tt.wantType == "" {
+				if len(sink.events) != 0 {
+					t.Fatalf("events = %+v, want none", sink.events)
+				}
+				return
+			}
+			if len(sink.events) != 1 {
+				t.Fatalf("got %d events, want 1", len(sink.events))
+			}
+			e := sink.events[0]
+			if e.Type != tt.wantType || e.Outcome != tt.wantOutcome {
+				t.Errorf("event = %s/%s, want %s/%s", e.Type, e.Outcome, tt.wantType, tt.wantOutcome)
+			}
+			if e.Actor.UserID != tt.userID || e.Target != "target-1" || e.Procedure != "/user.v1.UserService/DeleteUser" {
+				t.Errorf("event = %+v", e)
+			}
+		})
+	}
+}
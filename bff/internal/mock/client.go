@@ -0,0 +1,25 @@
+package mock
+
+import (
+	"net/http"
+
+	"github.com/daisuke8000/example-ec-platform/gen/user/v1/userv1connect"
+
+	"github.com/daisuke8000/example-ec-platform/bff/internal/client"
+)
+
+// baseURL is never dialed; requests are served in process.
+const baseURL = "http://mock.invalid"
+
+// NewUserServiceClient returns a UserService client backed by svc in process.
+// The client goes through the Connect protocol, so serialization and error
+// codes behave as with a real backend.
+func NewUserServiceClient(svc userv1connect.UserServiceHandler) userv1connect.UserServiceClient {
+	mux := http.NewServeMux()
+	mux.Handle(userv1connect.NewUserServiceHandler(svc))
+
+	return userv1connect.NewUserServiceClient(
+		&http.Client{Transport: client.InProcessTransport{Handler: mux}},
+		baseURL,
+	)
+}
@@ -0,0 +1,424 @@
+// Package mock provides in-process fake backends so the BFF can run without
+// Hydra or any backend service. It is intended for local frontend development
+// only and must never be enabled in deployed environments.
+package mock
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/mail"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"connectrpc.com/connect"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	userv1 "github.com/daisuke8000/example-ec-platform/gen/user/v1"
+	"github.com/daisuke8000/example-ec-platform/gen/user/v1/userv1connect"
+)
+
+// Fixed identities of the seeded users.
+const (
+	AdminUserID    = "00000000-0000-4000-8000-000000000001"
+	CustomerUserID = "00000000-0000-4000-8000-000000000002"
+
+	// Password accepted by VerifyPassword for every seeded user.
+	SeedPassword = "password123"
+)
+
+// seedTime is the base creation time of seeded data, so responses are
+// identical across restarts.
+var seedTime = time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+// implementedProcedures are reported by GetServerInfo; other procedures
+// return Unimplemented.
+var implementedProcedures = []string{
+	userv1connect.UserServiceCreateUserProcedure,
+	userv1connect.UserServiceGetUserProcedure,
+	userv1connect.UserServiceUpdateUserProcedure,
+	userv1connect.UserServiceDeleteUserProcedure,
+	userv1connect.UserServiceVerifyPasswordProcedure,
+	userv1connect.UserServiceListUsersProcedure,
+	userv1connect.UserServiceGetUserRolesProcedure,
+	userv1connect.UserServiceListConsentsProcedure,
+	userv1connect.UserServiceRevokeConsentProcedure,
+	userv1connect.UserServiceListConnectedAppsProcedure,
+	userv1connect.UserServiceGetServerInfoProcedure,
+}
+
+type mockUser struct {
+	user     *userv1.User
+	password string
+	roles    []*userv1.Role
+	consents []*userv1.ConsentReceipt
+}
+
+// UserService is an in-memory UserService seeded with deterministic data.
+// Changes are kept for the lifetime of the process.
+type UserService struct {
+	userv1connect.UnimplementedUserServiceHandler
+
+	mu     sync.Mutex
+	users  map[string]*mockUser
+	nextID int
+}
+
+// NewUserService creates a UserService with seeded users.
+func NewUserService() *UserService {
+	s := &UserService{users: make(map[string]*mockUser)}
+
+	adminRole := &userv1.Role{
+		Name:        "admin",
+		Permissions: []string{"users:list", "users:read", "users:write", "users:delete", "users:bulk"},
+	}
+	seeds := []struct {
+		email string
+		name  string
+		roles []*userv1.Role
+	}{
+		{"admin@example.com", "Mock Admin", []*userv1.Role{adminRole}},
+		{"customer@example.com", "Mock Customer", nil},
+		{"hanako@example.com", "Hanako Yamada", nil},
+		{"taro@example.com", "Taro Suzuki", nil},
+		{"unverified@example.com", "Unverified User", nil},
+	}
+	for i, seed := range seeds {
+		created := timestamppb.New(seedTime.Add(time.Duration(i) * 24 * time.Hour))
+		u := s.addUser(seed.email, seed.name, SeedPassword, created)
+		u.user.EmailVerified = seed.email != "unverified@example.com"
+		u.roles = seed.roles
+	}
+
+	s.users[CustomerUserID].consents = []*userv1.ConsentReceipt{{
+		Id:         "00000000-0000-4000-9000-000000000001",
+		ClientId:   "storefront-spa",
+		ClientName: "Storefront",
+		Scopes:     []string{"openid", "offline_access"},
+		Remember:   true,
+		GrantedAt:  timestamppb.New(seedTime.Add(48 * time.Hour)),
+	}}
+
+	return s
+}
+
+// addUser must be called with mu held (or before the service is shared).
+func (s *UserService) addUser(email, name, password string, created *timestamppb.Timestamp) *mockUser {
+	s.nextID++
+	u := &mockUser{
+		user: &userv1.User{
+			Id:        fmt.Sprintf("00000000-0000-4000-8000-%012d", s.nextID),
+			Email:     email,
+			Name:      &name,
+			CreatedAt: created,
+			UpdatedAt: created,
+		},
+		password: password,
+	}
+	s.users[u.user.Id] = u
+	return u
+}
+
+func (s *UserService) CreateUser(
+	ctx context.Context,
+	req *connect.Request[userv1.CreateUserRequest],
+) (*connect.Response[userv1.CreateUserResponse], error) {
+	if _, err := mail.ParseAddress(req.Msg.GetEmail()); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("invalid email format"))
+	}
+	if len(req.Msg.GetPassword()) < 8 {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("password must be at least 8 characters"))
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.findByEmail(req.Msg.GetEmail()) != nil {
+		return nil, connect.NewError(connect.CodeAlreadyExists, errors.New("email already exists"))
+	}
+	u := s.addUser(req.Msg.GetEmail(), req.Msg.GetName(), req.Msg.GetPassword(), timestamppb.Now())
+	if req.Msg.Name == nil {
+		u.user.Name = nil
+	}
+
+	return connect.NewResponse(&userv1.CreateUserResponse{User: cloneUser(u.user)}), nil
+}
+
+func (s *UserService) GetUser(
+	ctx context.Context,
+	req *connect.Request[userv1.GetUserRequest],
+) (*connect.Response[userv1.GetUserResponse], error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	u, err := s.activeUser(req.Msg.GetId())
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&userv1.GetUserResponse{User: cloneUser(u.user)}), nil
+}
+
+func (s *UserService) UpdateUser(
+	ctx context.Context,
+	req *connect.Request[userv1.UpdateUserRequest],
+) (*connect.Response[userv1.UpdateUserResponse], error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	u, err := s.activeUser(req.Msg.GetId())
+	if err != nil {
+		return nil, err
+	}
+	if req.Msg.Email != nil {
+		if _, err := mail.ParseAddress(req.Msg.GetEmail()); err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("invalid email format"))
+		}
+		if other := s.findByEmail(req.Msg.GetEmail()); other != nil && other != u {
+			return nil, connect.NewError(connect.CodeAlreadyExists, errors.New("email already exists"))
+		}
+		u.user.Email = req.Msg.GetEmail()
+	}
+	if req.Msg.Name != nil {
+		name := req.Msg.GetName()
+		u.user.Name = &name
+	}
+	u.user.UpdatedAt = timestamppb.Now()
+
+	return connect.NewResponse(&userv1.UpdateUserResponse{User: cloneUser(u.user)}), nil
+}
+
+func (s *UserService) DeleteUser(
+	ctx context.Context,
+	req *connect.Request[userv1.DeleteUserRequest],
+) (*connect.Response[userv1.DeleteUserResponse], error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	u, err := s.activeUser(req.Msg.GetId())
+	if err != nil {
+		return nil, err
+	}
+	u.user.DeletedAt = timestamppb.Now()
+
+	return connect.NewResponse(&userv1.DeleteUserResponse{}), nil
+}
+
+func (s *UserService) VerifyPassword(
+	ctx context.Context,
+	req *connect.Request[userv1.VerifyPasswordRequest],
+) (*connect.Response[userv1.VerifyPasswordResponse], error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	u := s.findByEmail(req.Msg.GetEmail())
+	if u == nil || u.user.DeletedAt != nil || u.password != req.Msg.GetPassword() {
+		return nil, connect.NewError(connect.CodeUnauthenticated, errors.New("invalid email or password"))
+	}
+	return connect.NewResponse(&userv1.VerifyPasswordResponse{UserId: u.user.Id}), nil
+}
+
+// ListUsers pages through users newest first. Page tokens are offsets.
+func (s *UserService) ListUsers(
+	ctx context.Context,
+	req *connect.Request[userv1.ListUsersRequest],
+) (*connect.Response[userv1.ListUsersResponse], error) {
+	pageSize := int(req.Msg.GetPageSize())
+	if pageSize <= 0 {
+		pageSize = 20
+	}
+	if pageSize > 100 {
+		pageSize = 100
+	}
+	offset := 0
+	if token := req.Msg.GetPageToken(); token != "" {
+		n, err := strconv.Atoi(token)
+		if err != nil || n < 0 {
+			return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("invalid page token"))
+		}
+		offset = n
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var matched []*userv1.User
+	for _, u := range s.users {
+		if u.user.DeletedAt != nil && !req.Msg.GetIncludeDeleted() {
+			continue
+		}
+		if req.Msg.EmailContains != nil &&
+			!strings.Contains(strings.ToLower(u.user.Email), strings.ToLower(req.Msg.GetEmailContains())) {
+			continue
+		}
+		if after := req.Msg.GetCreatedAfter(); after != nil && u.user.CreatedAt.AsTime().Before(after.AsTime()) {
+			continue
+		}
+		if before := req.Msg.GetCreatedBefore(); before != nil && !u.user.CreatedAt.AsTime().Before(before.AsTime()) {
+			continue
+		}
+		matched = append(matched, cloneUser(u.user))
+	}
+	sort.Slice(matched, func(i, j int) bool {
+		ti, tj := matched[i].CreatedAt.AsTime(), matched[j].CreatedAt.AsTime()
+		if ti.Equal(tj) {
+			return matched[i].Id > matched[j].Id
+		}
+		return ti.After(tj)
+	})
+
+	resp := &userv1.ListUsersResponse{}
+	if offset < len(matched) {
+		end := min(offset+pageSize, len(matched))
+		resp.Users = matched[offset:end]
+		if end < len(matched) {
+			resp.NextPageToken = strconv.Itoa(end)
+		}
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (s *UserService) GetUserRoles(
+	ctx context.Context,
+	req *connect.Request[userv1.GetUserRolesRequest],
+) (*connect.Response[userv1.GetUserRolesResponse], error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	u, err := s.activeUser(req.Msg.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&userv1.GetUserRolesResponse{Roles: u.roles}), nil
+}
+
+func (s *UserService) ListConsents(
+	ctx context.Context,
+	req *connect.Request[userv1.ListConsentsRequest],
+) (*connect.Response[userv1.ListConsentsResponse], error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	u, err := s.activeUser(req.Msg.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+	var consents []*userv1.ConsentReceipt
+	for _, c := range u.consents {
+		if c.RevokedAt != nil && !req.Msg.GetIncludeRevoked() {
+			continue
+		}
+		consents = append(consents, proto.Clone(c).(*userv1.ConsentReceipt))
+	}
+	return connect.NewResponse(&userv1.ListConsentsResponse{Consents: consents}), nil
+}
+
+func (s *UserService) RevokeConsent(
+	ctx context.Context,
+	req *connect.Request[userv1.RevokeConsentRequest],
+) (*connect.Response[userv1.RevokeConsentResponse], error) {
+	if req.Msg.GetClientId() == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("client_id is required"))
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	u, err := s.activeUser(req.Msg.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+	var revoked int32
+	for _, c := range u.consents {
+		if c.ClientId == req.Msg.GetClientId() && c.RevokedAt == nil {
+			c.RevokedAt = timestamppb.Now()
+			revoked++
+		}
+	}
+	return connect.NewResponse(&userv1.RevokeConsentResponse{RevokedCount: revoked}), nil
+}
+
+// ListConnectedApps derives the apps from the active consent receipts;
+// there are no login sessions without Hydra.
+func (s *UserService) ListConnectedApps(
+	ctx context.Context,
+	req *connect.Request[userv1.ListConnectedAppsRequest],
+) (*connect.Response[userv1.ListConnectedAppsResponse], error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	u, err := s.activeUser(req.Msg.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+	byClient := make(map[string]*userv1.ConnectedApp)
+	var apps []*userv1.ConnectedApp
+	for _, c := range u.consents {
+		if c.RevokedAt != nil {
+			continue
+		}
+		app, ok := byClient[c.ClientId]
+		if !ok {
+			app = &userv1.ConnectedApp{
+				ClientId:       c.ClientId,
+				ClientName:     c.ClientName,
+				FirstGrantedAt: c.GrantedAt,
+				LastGrantedAt:  c.GrantedAt,
+			}
+			byClient[c.ClientId] = app
+			apps = append(apps, app)
+		}
+		if c.GrantedAt.AsTime().After(app.LastGrantedAt.AsTime()) {
+			app.LastGrantedAt = c.GrantedAt
+		}
+		for _, scope := range c.Scopes {
+			if !slices.Contains(app.Scopes, scope) {
+				app.Scopes = append(app.Scopes, scope)
+			}
+		}
+	}
+	sort.SliceStable(apps, func(i, j int) bool {
+		return apps[i].LastGrantedAt.AsTime().After(apps[j].LastGrantedAt.AsTime())
+	})
+	return connect.NewResponse(proto.Clone(&userv1.ListConnectedAppsResponse{Apps: apps}).(*userv1.ListConnectedAppsResponse)), nil
+}
+
+func (s *UserService) GetServerInfo(
+	ctx context.Context,
+	req *connect.Request[userv1.GetServerInfoRequest],
+) (*connect.Response[userv1.GetServerInfoResponse], error) {
+	return connect.NewResponse(&userv1.GetServerInfoResponse{
+		Version:    "mock",
+		Procedures: implementedProcedures,
+		Features:   []string{"list_users.include_deleted", "list_consents.include_revoked"},
+	}), nil
+}
+
+// cloneUser copies a stored user so responses are not mutated by later
+// requests while being serialized.
+func cloneUser(u *userv1.User) *userv1.User {
+	return proto.Clone(u).(*userv1.User)
+}
+
+// activeUser must be called with mu held.
+func (s *UserService) activeUser(id string) (*mockUser, error) {
+	u, ok := s.users[id]
+	if !ok || u.user.DeletedAt != nil {
+		return nil, connect.NewError(connect.CodeNotFound, errors.New("user not found"))
+	}
+	return u, nil
+}
+
+// findByEmail must be called with mu held.
+func (s *UserService) findByEmail(email string) *mockUser {
+	for _, u := range s.users {
+		if strings.EqualFold(u.user.Email, email) {
+			return u
+		}
+	}
+	return nil
+}
@@ -0,0 +1,121 @@
+package mock_test
+
+import (
+	"context"
+	"testing"
+
+	"connectrpc.com/connect"
+
+	userv1 "github.com/daisuke8000/example-ec-platform/gen/user/v1"
+
+	"github.com/daisuke8000/example-ec-platform/bff/internal/mock"
+)
+
+func TestUserService_SeededData(t *testing.T) {
+	client := mock.NewUserServiceClient(mock.NewUserService())
+	ctx := context.Background()
+
+	resp, err := client.GetUser(ctx, connect.NewRequest(&userv1.GetUserRequest{Id: mock.CustomerUserID}))
+	if err != nil {
+		t.Fatalf("GetUser() error = %v", err)
+	}
+	if resp.Msg.GetUser().GetEmail() != "customer@example.com" {
+		t.Errorf("GetUser() email = %q, want customer@example.com", resp.Msg.GetUser().GetEmail())
+	}
+
+	_, err = client.GetUser(ctx, connect.NewRequest(&userv1.GetUserRequest{Id: "missing"}))
+	if connect.CodeOf(err) != connect.CodeNotFound {
+		t.Errorf("GetUser(missing) code = %v, want NotFound", connect.CodeOf(err))
+	}
+}
+
+func TestUserService_Deterministic(t *testing.T) {
+	ctx := context.Background()
+	list := func() []*userv1.User {
+		client := mock.NewUserServiceClient(mock.NewUserService())
+		resp, err := client.ListUsers(ctx, connect.NewRequest(&userv1.ListUsersRequest{PageSize: 2}))
+		if err != nil {
+			t.Fatalf("ListUsers() error = %v", err)
+		}
+		if resp.Msg.GetNextPageToken() == "" {
+			t.Error("ListUsers() next_page_token is empty, want more pages")
+		}
+		return resp.Msg.GetUsers()
+	}
+
+	first, second := list(), list()
+	if len(first) != 2 || len(second) != 2 {
+		t.Fatalf("ListUsers() returned %d and %d users, want 2", len(first), len(second))
+	}
+	for i := range first {
+		if first[i].GetId() != second[i].GetId() || !first[i].GetCreatedAt().AsTime().Equal(second[i].GetCreatedAt().AsTime()) {
+			t.Errorf("user %d differs across instances: %v vs %v", i, first[i], second[i])
+		}
+	}
+}
+
+func TestUserService_CreateAndVerifyPassword(t *testing.T) {
+	client := mock.NewUserServiceClient(mock.NewUserService())
+	ctx := context.Background()
+
+	created, err := client.CreateUser(ctx, connect.NewRequest(&userv1.CreateUserRequest{
+		Email:    "new@example.com",
+		Password: "supersecret",
+	}))
+	if err != nil {
+		t.Fatalf("CreateUser() error = %v", err)
+	}
+
+	_, err = client.CreateUser(ctx, connect.NewRequest(&userv1.CreateUserRequest{
+		Email:    "new@example.com",
+		Password: "supersecret",
+	}))
+	if connect.CodeOf(err) != connect.CodeAlreadyExists {
+		t.Errorf("CreateUser(duplicate) code = %v, want AlreadyExists", connect.CodeOf(err))
+	}
+
+	verified, err := client.VerifyPassword(ctx, connect.NewRequest(&userv1.VerifyPasswordRequest{
+		Email:    "new@example.com",
+		Password: "supersecret",
+	}))
+	if err != nil {
+		t.Fatalf("VerifyPassword() error = %v", err)
+	}
+	if verified.Msg.GetUserId() != created.Msg.GetUser().GetId() {
+		t.Errorf("VerifyPassword() user_id = %q, want %q", verified.Msg.GetUserId(), created.Msg.GetUser().GetId())
+	}
+}
+
+func TestUserService_UnsupportedProcedure(t *testing.T) {
+	client := mock.NewUserServiceClient(mock.NewUserService())
+
+	_, err := client.GetBatchJob(context.Background(), connect.NewRequest(&userv1.GetBatchJobRequest{JobId: "job"}))
+	if connect.CodeOf(err) != connect.CodeUnimplemented {
+		t.Errorf("GetBatchJob() code = %v, want Unimplemented", connect.CodeOf(err))
+	}
+}
+
+func TestValidator(t *testing.T) {
+	v := mock.NewValidator()
+	ctx := context.Background()
+
+	admin, err := v.Validate(ctx, mock.AdminToken)
+	if err != nil {
+		t.Fatalf("Validate(admin) error = %v", err)
+	}
+	if admin.Subject != mock.AdminUserID || len(admin.Permissions) == 0 {
+		t.Errorf("Validate(admin) = %+v, want admin identity with permissions", admin)
+	}
+
+	customer, err := v.Validate(ctx, mock.CustomerToken)
+	if err != nil {
+		t.Fatalf("Validate(customer) error = %v", err)
+	}
+	if customer.Subject != mock.CustomerUserID || len(customer.Permissions) != 0 {
+		t.Errorf("Validate(customer) = %+v, want customer identity without permissions", customer)
+	}
+
+	if _, err := v.Validate(ctx, "eyJhbGciOi..."); err == nil {
+		t.Error("Validate(unknown) error = nil, want rejection")
+	}
+}
@@ -0,0 +1,48 @@
+package mock
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/daisuke8000/example-ec-platform/bff/internal/jwt"
+)
+
+// Bearer tokens accepted in mock mode.
+const (
+	AdminToken    = "mock-admin"
+	CustomerToken = "mock-customer"
+)
+
+var errUnknownToken = errors.New("unknown mock token")
+
+// Validator accepts the fixed mock tokens in place of Hydra-issued JWTs.
+type Validator struct{}
+
+// NewValidator creates a mock token validator.
+func NewValidator() *Validator {
+	return &Validator{}
+}
+
+// Validate maps AdminToken and CustomerToken to the seeded admin and
+// customer users. Any other token is rejected.
+func (v *Validator) Validate(ctx context.Context, token string) (*jwt.ValidatedClaims, error) {
+	now := time.Now()
+	claims := &jwt.ValidatedClaims{
+		Scopes:    []string{"openid", "offline_access"},
+		IssuedAt:  now,
+		ExpiresAt: now.Add(time.Hour),
+	}
+
+	switch token {
+	case AdminToken:
+		claims.Subject = AdminUserID
+		claims.Roles = []string{"admin"}
+		claims.Permissions = []string{"users:list", "users:read", "users:write", "users:delete", "users:bulk"}
+	case CustomerToken:
+		claims.Subject = CustomerUserID
+	default:
+		return nil, errUnknownToken
+	}
+	return claims, nil
+}
@@ -0,0 +1,71 @@
+package observability
+
+import (
+	"context"
+	"sync"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+)
+
+// BreakerMetrics exports circuit breaker state per backend.
+type BreakerMetrics struct {
+	state       metric.Int64ObservableGauge
+	transitions metric.Int64Counter
+
+	// backends maps backend names to a function returning the current state
+	// (0 closed, 1 half-open, 2 open).
+	backends   map[string]func() int64
+	backendsMu sync.RWMutex
+}
+
+// NewBreakerMetrics creates circuit breaker metrics.
+func NewBreakerMetrics(meter metric.Meter) (*BreakerMetrics, error) {
+	m := &BreakerMetrics{
+		backends: make(map[string]func() int64),
+	}
+
+	var err error
+
+	m.state, err = meter.Int64ObservableGauge(
+		"circuit_breaker_state",
+		metric.WithDescription("Circuit breaker state per backend (0=closed, 1=half-open, 2=open)"),
+		metric.WithInt64Callback(func(_ context.Context, o metric.Int64Observer) error {
+			m.backendsMu.RLock()
+			defer m.backendsMu.RUnlock()
+			for name, state := range m.backends {
+				o.Observe(state(), metric.WithAttributes(attribute.String("backend", name)))
+			}
+			return nil
+		}),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	m.transitions, err = meter.Int64Counter(
+		"circuit_breaker_transitions_total",
+		metric.WithDescription("Total number of circuit breaker state transitions"),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return m, nil
+}
+
+// Track registers a backend whose state is observed on every collection.
+func (m *BreakerMetrics) Track(name string, state func() int64) {
+	m.backendsMu.Lock()
+	defer m.backendsMu.Unlock()
+	m.backends[name] = state
+}
+
+// RecordTransition counts a state transition.
+func (m *BreakerMetrics) RecordTransition(ctx context.Context, name, from, to string) {
+	m.transitions.Add(ctx, 1, metric.WithAttributes(
+		attribute.String("backend", name),
+		attribute.String("from", from),
+		attribute.String("to", to),
+	))
+}
@@ -0,0 +1,52 @@
+package observability
+
+import (
+	"context"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+)
+
+// CanaryMetrics exports backend call outcomes per release target so the
+// canary can be compared against stable.
+type CanaryMetrics struct {
+	requests metric.Int64Counter
+	duration metric.Float64Histogram
+}
+
+// NewCanaryMetrics creates canary routing metrics.
+func NewCanaryMetrics(meter metric.Meter) (*CanaryMetrics, error) {
+	m := &CanaryMetrics{}
+
+	var err error
+
+	m.requests, err = meter.Int64Counter(
+		"backend_target_requests_total",
+		metric.WithDescription("Total number of backend calls per release target and status code"),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	m.duration, err = meter.Float64Histogram(
+		"backend_target_request_duration_seconds",
+		metric.WithDescription("Backend call duration per release target in seconds"),
+		metric.WithUnit("s"),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return m, nil
+}
+
+// RecordCall records the outcome of one backend call.
+func (m *CanaryMetrics) RecordCall(ctx context.Context, backend, target, code string, duration time.Duration) {
+	attrs := metric.WithAttributes(
+		attribute.String("backend", backend),
+		attribute.String("target", target),
+	)
+	m.requests.Add(ctx, 1, attrs, metric.WithAttributes(attribute.String("code", code)))
+	m.duration.Record(ctx, duration.Seconds(), attrs)
+}
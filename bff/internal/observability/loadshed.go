@@ -0,0 +1,62 @@
+package observability
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel/metric"
+)
+
+// LoadShedMetrics exports the adaptive concurrency limit and shed requests.
+type LoadShedMetrics struct {
+	shed     metric.Int64Counter
+	limit    metric.Int64ObservableGauge
+	inflight metric.Int64ObservableGauge
+}
+
+// NewLoadShedMetrics creates load shedding metrics. limit and inflight are
+// observed on every collection.
+func NewLoadShedMetrics(meter metric.Meter, limit, inflight func() int64) (*LoadShedMetrics, error) {
+	m := &LoadShedMetrics{}
+
+	var err error
+
+	m.shed, err = meter.Int64Counter(
+		"http_requests_shed_total",
+		metric.WithDescription("Total number of requests rejected with 503 because the concurrency limit was reached"),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	m.limit, err = meter.Int64ObservableGauge(
+		"http_concurrency_limit",
+		metric.WithDescription("Current adaptive limit on concurrent requests"),
+		metric.WithInt64Callback(func(_ context.Context, o metric.Int64Observer) error {
+			o.Observe(limit())
+			return nil
+		}),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	m.inflight, err = meter.Int64ObservableGauge(
+		"http_requests_inflight",
+		metric.WithDescription("Number of requests being served under the concurrency limit"),
+		metric.WithInt64Callback(func(_ context.Context, o metric.Int64Observer) error {
+			o.Observe(inflight())
+			return nil
+		}),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return m, nil
+}
+
+// RecordShed counts a shed request. Requests are not labelled by path:
+// shed requests are not routed, so their paths are unbounded.
+func (m *LoadShedMetrics) RecordShed(ctx context.Context) {
+	m.shed.Add(ctx, 1)
+}
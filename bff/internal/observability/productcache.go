@@ -0,0 +1,100 @@
+package observability
+
+import (
+	"context"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+)
+
+// ProductCacheMetrics exports the product cache's hit ratio, size and how
+// quickly Product Service events invalidate it.
+type ProductCacheMetrics struct {
+	lookups       metric.Int64Counter
+	entries       metric.Int64ObservableGauge
+	invalidations metric.Int64Counter
+	lag           metric.Float64Histogram
+	flushes       metric.Int64Counter
+}
+
+// NewProductCacheMetrics creates product cache metrics. entries is observed
+// on every collection.
+func NewProductCacheMetrics(meter metric.Meter, entries func() int64) (*ProductCacheMetrics, error) {
+	m := &ProductCacheMetrics{}
+
+	var err error
+
+	m.lookups, err = meter.Int64Counter(
+		"product_cache_lookups_total",
+		metric.WithDescription("Total number of cacheable product reads by kind and result (hit, miss)"),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	m.entries, err = meter.Int64ObservableGauge(
+		"product_cache_entries",
+		metric.WithDescription("Number of cached product and inventory responses"),
+		metric.WithInt64Callback(func(_ context.Context, o metric.Int64Observer) error {
+			o.Observe(entries())
+			return nil
+		}),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	m.invalidations, err = meter.Int64Counter(
+		"product_cache_invalidations_total",
+		metric.WithDescription("Total number of Product Service events that invalidated cache entries, by event type"),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	m.lag, err = meter.Float64Histogram(
+		"product_cache_invalidation_lag_seconds",
+		metric.WithDescription("Time from a Product Service change to the invalidation of its cache entries"),
+		metric.WithUnit("s"),
+		metric.WithExplicitBucketBoundaries(0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10, 30, 60),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	m.flushes, err = meter.Int64Counter(
+		"product_cache_flushes_total",
+		metric.WithDescription("Total number of full cache flushes through the admin endpoint"),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return m, nil
+}
+
+// RecordLookup counts a cacheable read.
+func (m *ProductCacheMetrics) RecordLookup(ctx context.Context, kind string, hit bool) {
+	result := "miss"
+	if hit {
+		result = "hit"
+	}
+	m.lookups.Add(ctx, 1, metric.WithAttributes(
+		attribute.String("kind", kind),
+		attribute.String("result", result),
+	))
+}
+
+// RecordInvalidation counts an invalidating event and its lag. The lag
+// includes webhook delivery retries, so it also shows a backlog of events.
+func (m *ProductCacheMetrics) RecordInvalidation(ctx context.Context, eventType string, lag time.Duration) {
+	attrs := metric.WithAttributes(attribute.String("event", eventType))
+	m.invalidations.Add(ctx, 1, attrs)
+	m.lag.Record(ctx, lag.Seconds(), attrs)
+}
+
+// RecordFlush counts a full flush.
+func (m *ProductCacheMetrics) RecordFlush(ctx context.Context) {
+	m.flushes.Add(ctx, 1)
+}
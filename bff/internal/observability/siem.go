@@ -0,0 +1,49 @@
+package observability
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+)
+
+// SIEMMetrics exports the outcome of security events forwarded to the SIEM.
+type SIEMMetrics struct {
+	events metric.Int64Counter
+	queued metric.Int64ObservableGauge
+}
+
+// NewSIEMMetrics creates SIEM shipping metrics. queueDepth is observed on
+// every collection.
+func NewSIEMMetrics(meter metric.Meter, queueDepth func() int64) (*SIEMMetrics, error) {
+	m := &SIEMMetrics{}
+
+	var err error
+
+	m.events, err = meter.Int64Counter(
+		"siem_events_total",
+		metric.WithDescription("Total number of security events by shipping result (shipped, dropped, failed)"),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	m.queued, err = meter.Int64ObservableGauge(
+		"siem_queue_depth",
+		metric.WithDescription("Number of security events waiting to be shipped"),
+		metric.WithInt64Callback(func(_ context.Context, o metric.Int64Observer) error {
+			o.Observe(queueDepth())
+			return nil
+		}),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return m, nil
+}
+
+// RecordEvents counts n events with the given result.
+func (m *SIEMMetrics) RecordEvents(ctx context.Context, result string, n int) {
+	m.events.Add(ctx, int64(n), metric.WithAttributes(attribute.String("result", result)))
+}
@@ -0,0 +1,223 @@
+package observability
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"connectrpc.com/connect"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+)
+
+const (
+	sloAvailability = "availability"
+	sloLatency      = "latency"
+)
+
+// SLOObjective is the availability and latency objective of a procedure.
+type SLOObjective struct {
+	// Availability is the target ratio of requests without server-side errors.
+	Availability float64
+
+	// Latency is the threshold a request must complete within to count as good.
+	Latency time.Duration
+}
+
+// SLOConfig configures SLO metrics.
+type SLOConfig struct {
+	// Defaults apply to procedures without an override.
+	Defaults SLOObjective
+
+	// LatencyTarget is the target ratio of requests meeting their latency objective.
+	LatencyTarget float64
+
+	// Overrides replace Defaults for specific procedures.
+	Overrides map[string]SLOObjective
+
+	// Region, if set, is attached to every series as the "region" attribute.
+	Region string
+}
+
+// SLOMetrics classifies requests as good or bad against per-procedure
+// objectives and exports the objectives alongside the counters, so burn
+// rate alerts can be written as
+//
+//	(bad / (good + bad)) / (1 - slo_objective_ratio)
+//
+// without duplicating targets in alerting rules.
+type SLOMetrics struct {
+	requests  metric.Int64Counter
+	objective metric.Float64ObservableGauge
+	threshold metric.Float64ObservableGauge
+
+	defaults      SLOObjective
+	latencyTarget float64
+	overrides     map[string]SLOObjective
+	baseAttrs     []attribute.KeyValue
+
+	// seen tracks procedures that received traffic so objectives are
+	// exported for every procedure that has counters.
+	seen   map[string]struct{}
+	seenMu sync.RWMutex
+}
+
+// NewSLOMetrics creates SLO metrics.
+func NewSLOMetrics(meter metric.Meter, cfg SLOConfig) (*SLOMetrics, error) {
+	m := &SLOMetrics{
+		defaults:      cfg.Defaults,
+		latencyTarget: cfg.LatencyTarget,
+		overrides:     cfg.Overrides,
+		seen:          make(map[string]struct{}),
+	}
+	if cfg.Region != "" {
+		m.baseAttrs = []attribute.KeyValue{attribute.String("region", cfg.Region)}
+	}
+
+	var err error
+
+	m.requests, err = meter.Int64Counter(
+		"slo_requests_total",
+		metric.WithDescription("Requests classified against SLOs by procedure, slo and result (good/bad)"),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	m.objective, err = meter.Float64ObservableGauge(
+		"slo_objective_ratio",
+		metric.WithDescription("Target good-request ratio by procedure and slo"),
+		metric.WithFloat64Callback(func(_ context.Context, o metric.Float64Observer) error {
+			for _, procedure := range m.seenProcedures() {
+				obj := m.Objective(procedure)
+				o.Observe(obj.Availability, m.attrs(
+					attribute.String("procedure", procedure),
+					attribute.String("slo", sloAvailability),
+				))
+				o.Observe(m.latencyTarget, m.attrs(
+					attribute.String("procedure", procedure),
+					attribute.String("slo", sloLatency),
+				))
+			}
+			return nil
+		}),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	m.threshold, err = meter.Float64ObservableGauge(
+		"slo_latency_threshold_seconds",
+		metric.WithDescription("Latency objective threshold by procedure"),
+		metric.WithUnit("s"),
+		metric.WithFloat64Callback(func(_ context.Context, o metric.Float64Observer) error {
+			for _, procedure := range m.seenProcedures() {
+				o.Observe(m.Objective(procedure).Latency.Seconds(),
+					m.attrs(attribute.String("procedure", procedure)),
+				)
+			}
+			return nil
+		}),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return m, nil
+}
+
+// Objective returns the objective that applies to a procedure.
+func (m *SLOMetrics) Objective(procedure string) SLOObjective {
+	if obj, ok := m.overrides[procedure]; ok {
+		return obj
+	}
+	return m.defaults
+}
+
+// Record classifies a completed request.
+// Only server-side failures count against availability; requests that
+// failed availability are not counted for latency.
+func (m *SLOMetrics) Record(ctx context.Context, procedure string, duration time.Duration, err error) {
+	m.markSeen(procedure)
+
+	available := !isServerError(err)
+	m.requests.Add(ctx, 1, m.attrs(
+		attribute.String("procedure", procedure),
+		attribute.String("slo", sloAvailability),
+		attribute.String("result", goodOrBad(available)),
+	))
+	if !available {
+		return
+	}
+
+	m.requests.Add(ctx, 1, m.attrs(
+		attribute.String("procedure", procedure),
+		attribute.String("slo", sloLatency),
+		attribute.String("result", goodOrBad(duration <= m.Objective(procedure).Latency)),
+	))
+}
+
+// Interceptor returns a server-side interceptor recording every unary call.
+func (m *SLOMetrics) Interceptor() connect.UnaryInterceptorFunc {
+	return func(next connect.UnaryFunc) connect.UnaryFunc {
+		return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+			if req.Spec().IsClient {
+				return next(ctx, req)
+			}
+			start := time.Now()
+			resp, err := next(ctx, req)
+			m.Record(ctx, req.Spec().Procedure, time.Since(start), err)
+			return resp, err
+		}
+	}
+}
+
+// attrs returns a measurement option with the base attributes appended.
+func (m *SLOMetrics) attrs(kv ...attribute.KeyValue) metric.MeasurementOption {
+	return metric.WithAttributes(append(kv, m.baseAttrs...)...)
+}
+
+func (m *SLOMetrics) markSeen(procedure string) {
+	m.seenMu.RLock()
+	_, ok := m.seen[procedure]
+	m.seenMu.RUnlock()
+	if ok {
+		return
+	}
+
+	m.seenMu.Lock()
+	m.seen[procedure] = struct{}{}
+	m.seenMu.Unlock()
+}
+
+func (m *SLOMetrics) seenProcedures() []string {
+	m.seenMu.RLock()
+	defer m.seenMu.RUnlock()
+	procedures := make([]string, 0, len(m.seen))
+	for p := range m.seen {
+		procedures = append(procedures, p)
+	}
+	return procedures
+}
+
+// isServerError reports whether err is a failure attributable to the service
+// rather than the caller.
+func isServerError(err error) bool {
+	if err == nil {
+		return false
+	}
+	switch connect.CodeOf(err) {
+	case connect.CodeUnknown, connect.CodeInternal, connect.CodeUnavailable,
+		connect.CodeDataLoss, connect.CodeDeadlineExceeded, connect.CodeUnimplemented:
+		return true
+	default:
+		return false
+	}
+}
+
+func goodOrBad(good bool) string {
+	if good {
+		return "good"
+	}
+	return "bad"
+}
@@ -0,0 +1,103 @@
+package observability
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"go.opentelemetry.io/otel/attribute"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+)
+
+const testProcedure = "/user.v1.UserService/GetUser"
+
+func newTestSLOMetrics(t *testing.T) (*SLOMetrics, *sdkmetric.ManualReader) {
+	t.Helper()
+	reader := sdkmetric.NewManualReader()
+	mp := sdkmetric.NewMeterProvider(sdkmetric.WithReader(reader))
+	t.Cleanup(func() { _ = mp.Shutdown(context.Background()) })
+
+	metrics, err := NewSLOMetrics(mp.Meter("test"), SLOConfig{
+		Defaults:      SLOObjective{Availability: 0.995, Latency: 500 * time.Millisecond},
+		LatencyTarget: 0.99,
+		Overrides: map[string]SLOObjective{
+			testProcedure: {Availability: 0.999, Latency: 100 * time.Millisecond},
+		},
+		Region: "ap-northeast-1",
+	})
+	if err != nil {
+		t.Fatalf("failed to create metrics: %v", err)
+	}
+	return metrics, reader
+}
+
+func sloCount(rm metricdata.ResourceMetrics, slo, result string) int64 {
+	m := findMetric(rm, "slo_requests_total")
+	if m == nil {
+		return 0
+	}
+	sum, ok := m.Data.(metricdata.Sum[int64])
+	if !ok {
+		return 0
+	}
+	var total int64
+	for _, dp := range sum.DataPoints {
+		s, _ := dp.Attributes.Value(attribute.Key("slo"))
+		r, _ := dp.Attributes.Value(attribute.Key("result"))
+		if s.AsString() == slo && r.AsString() == result {
+			total += dp.Value
+		}
+	}
+	return total
+}
+
+func TestSLOMetrics_Record(t *testing.T) {
+	metrics, reader := newTestSLOMetrics(t)
+	ctx := context.Background()
+
+	metrics.Record(ctx, testProcedure, 50*time.Millisecond, nil)
+	metrics.Record(ctx, testProcedure, 200*time.Millisecond, nil)
+	metrics.Record(ctx, testProcedure, 10*time.Millisecond, connect.NewError(connect.CodeNotFound, errors.New("not found")))
+	metrics.Record(ctx, testProcedure, 10*time.Millisecond, connect.NewError(connect.CodeUnavailable, errors.New("down")))
+
+	rm := metricdata.ResourceMetrics{}
+	if err := reader.Collect(ctx, &rm); err != nil {
+		t.Fatalf("failed to collect metrics: %v", err)
+	}
+
+	tests := []struct {
+		slo, result string
+		want        int64
+	}{
+		{"availability", "good", 3},
+		{"availability", "bad", 1},
+		{"latency", "good", 2},
+		{"latency", "bad", 1},
+	}
+	for _, tt := range tests {
+		if got := sloCount(rm, tt.slo, tt.result); got != tt.want {
+			t.Errorf("%s/%s = %d, want %d", tt.slo, tt.result, got, tt.want)
+		}
+	}
+
+	if findMetric(rm, "slo_objective_ratio") == nil {
+		t.Error("slo_objective_ratio metric not found")
+	}
+	if findMetric(rm, "slo_latency_threshold_seconds") == nil {
+		t.Error("slo_latency_threshold_seconds metric not found")
+	}
+}
+
+func TestSLOMetrics_Objective(t *testing.T) {
+	metrics, _ := newTestSLOMetrics(t)
+
+	if got := metrics.Objective(testProcedure); got.Availability != 0.999 || got.Latency != 100*time.Millisecond {
+		t.Errorf("override objective = %+v", got)
+	}
+	if got := metrics.Objective("/user.v1.UserService/ListUsers"); got.Availability != 0.995 || got.Latency != 500*time.Millisecond {
+		t.Errorf("default objective = %+v", got)
+	}
+}
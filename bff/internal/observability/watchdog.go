@@ -0,0 +1,77 @@
+package observability
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel/metric"
+)
+
+// WatchdogMetrics exports the memory and goroutine watchdog's samples and
+// the actions it took.
+type WatchdogMetrics struct {
+	heapBytes  metric.Int64ObservableGauge
+	goroutines metric.Int64ObservableGauge
+	forcedGC   metric.Int64Counter
+	restarts   metric.Int64Counter
+}
+
+// NewWatchdogMetrics creates watchdog metrics. heapBytes and goroutines are
+// observed on every collection.
+func NewWatchdogMetrics(meter metric.Meter, heapBytes, goroutines func() int64) (*WatchdogMetrics, error) {
+	m := &WatchdogMetrics{}
+
+	var err error
+
+	m.heapBytes, err = meter.Int64ObservableGauge(
+		"watchdog_heap_bytes",
+		metric.WithDescription("Live heap size at the last watchdog sample"),
+		metric.WithUnit("By"),
+		metric.WithInt64Callback(func(_ context.Context, o metric.Int64Observer) error {
+			o.Observe(heapBytes())
+			return nil
+		}),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	m.goroutines, err = meter.Int64ObservableGauge(
+		"watchdog_goroutines",
+		metric.WithDescription("Number of goroutines at the last watchdog sample"),
+		metric.WithInt64Callback(func(_ context.Context, o metric.Int64Observer) error {
+			o.Observe(goroutines())
+			return nil
+		}),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	m.forcedGC, err = meter.Int64Counter(
+		"watchdog_forced_gc_total",
+		metric.WithDescription("Total number of garbage collections forced because the heap exceeded its limit"),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	m.restarts, err = meter.Int64Counter(
+		"watchdog_restart_requests_total",
+		metric.WithDescription("Total number of restarts requested because resource limits stayed exceeded"),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return m, nil
+}
+
+// RecordForcedGC counts a forced garbage collection.
+func (m *WatchdogMetrics) RecordForcedGC(ctx context.Context) {
+	m.forcedGC.Add(ctx, 1)
+}
+
+// RecordRestart counts a restart request.
+func (m *WatchdogMetrics) RecordRestart(ctx context.Context) {
+	m.restarts.Add(ctx, 1)
+}
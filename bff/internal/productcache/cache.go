@@ -0,0 +1,276 @@
+// Package productcache caches the Product Service reads behind storefront
+// pages, GetProduct and GetInventory, and drops entries as soon as the
+// Product Service reports a change through its webhook events. Pages then
+// reflect stock and price changes within seconds while most reads skip the
+// backend; the TTLs bound staleness for changes that are not published,
+// such as reservations, and for lost events.
+package productcache
+
+import (
+	"context"
+	"slices"
+	"sync"
+	"time"
+
+	"connectrpc.com/connect"
+	"google.golang.org/protobuf/proto"
+
+	productv1 "github.com/daisuke8000/example-ec-platform/gen/product/v1"
+	"github.com/daisuke8000/example-ec-platform/gen/product/v1/productv1connect"
+	pkgmw "github.com/daisuke8000/example-ec-platform/pkg/connect/middleware"
+)
+
+// Kinds of cached reads.
+const (
+	KindProduct   = "product"
+	KindInventory = "inventory"
+)
+
+type Config struct {
+	// ProductTTL and InventoryTTL bound how long an entry is served when no
+	// event invalidates it.
+	ProductTTL   time.Duration
+	InventoryTTL time.Duration
+
+	// MaxEntries bounds the number of cached products and inventories each.
+	MaxEntries int
+
+	// OnLookup, if set, is called for every cacheable read with its kind
+	// and whether it was served from the cache.
+	OnLookup func(kind string, hit bool)
+}
+
+type entry struct {
+	msg     proto.Message
+	expires time.Time
+	// productID and skuIDs are set for products, to find the entries an
+	// event affects.
+	productID string
+	skuIDs    []string
+}
+
+// Cache holds GetProduct responses per product and audience (channel and
+// market, which decide the product's visibility) and GetInventory
+// responses per SKU.
+type Cache struct {
+	cfg Config
+	now func() time.Time
+
+	mu          sync.Mutex
+	products    map[string]*entry
+	inventories map[string]*entry
+	// generation is bumped by every invalidation. A read started before an
+	// invalidation does not store its possibly stale response.
+	generation uint64
+}
+
+func New(cfg Config) *Cache {
+	return &Cache{
+		cfg:         cfg,
+		now:         time.Now,
+		products:    make(map[string]*entry),
+		inventories: make(map[string]*entry),
+	}
+}
+
+// Products returns client with GetProduct served from the cache.
+func (c *Cache) Products(client productv1connect.ProductServiceClient) productv1connect.ProductServiceClient {
+	return &productClient{ProductServiceClient: client, cache: c}
+}
+
+// Inventory returns client with GetInventory served from the cache.
+func (c *Cache) Inventory(client productv1connect.InventoryServiceClient) productv1connect.InventoryServiceClient {
+	return &inventoryClient{InventoryServiceClient: client, cache: c}
+}
+
+// Len returns the number of cached entries.
+func (c *Cache) Len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return len(c.products) + len(c.inventories)
+}
+
+// InvalidateProduct drops every cached response of the product.
+func (c *Cache) InvalidateProduct(productID string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.generation++
+	for key, e := range c.products {
+		if e.productID == productID {
+			delete(c.products, key)
+		}
+	}
+}
+
+// InvalidateSKU drops the SKU's inventory and every cached product
+// response that includes the SKU.
+func (c *Cache) InvalidateSKU(skuID string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.generation++
+	delete(c.inventories, skuID)
+	for key, e := range c.products {
+		if slices.Contains(e.skuIDs, skuID) {
+			delete(c.products, key)
+		}
+	}
+}
+
+// InvalidateInventory drops the SKU's cached inventory.
+func (c *Cache) InvalidateInventory(skuID string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.generation++
+	delete(c.inventories, skuID)
+}
+
+// Flush drops every entry and returns how many there were.
+func (c *Cache) Flush() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.generation++
+	n := len(c.products) + len(c.inventories)
+	c.products = make(map[string]*entry)
+	c.inventories = make(map[string]*entry)
+	return n
+}
+
+func (c *Cache) lookup(kind string, hit bool) {
+	if c.cfg.OnLookup != nil {
+		c.cfg.OnLookup(kind, hit)
+	}
+}
+
+// currentGeneration returns the generation to pass to a store after the
+// backend read.
+func (c *Cache) currentGeneration() uint64 {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.generation
+}
+
+func (c *Cache) getProduct(key string) (*productv1.GetProductResponse, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	e, ok := c.products[key]
+	if !ok || c.now().After(e.expires) {
+		return nil, false
+	}
+	// Callers may modify the response, e.g. to attach inventory.
+	return proto.Clone(e.msg).(*productv1.GetProductResponse), true
+}
+
+func (c *Cache) storeProduct(key string, generation uint64, msg *productv1.GetProductResponse) {
+	var skuIDs []string
+	for _, sku := range msg.GetProduct().GetSkus() {
+		skuIDs = append(skuIDs, sku.GetId())
+	}
+	e := &entry{
+		msg:       proto.Clone(msg),
+		expires:   c.now().Add(c.cfg.ProductTTL),
+		productID: msg.GetProduct().GetId(),
+		skuIDs:    skuIDs,
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if generation != c.generation {
+		return
+	}
+	if _, ok := c.products[key]; !ok && !makeRoom(c.products, c.cfg.MaxEntries, c.now()) {
+		return
+	}
+	c.products[key] = e
+}
+
+func (c *Cache) getInventory(skuID string) (*productv1.GetInventoryResponse, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	e, ok := c.inventories[skuID]
+	if !ok || c.now().After(e.expires) {
+		return nil, false
+	}
+	return proto.Clone(e.msg).(*productv1.GetInventoryResponse), true
+}
+
+func (c *Cache) storeInventory(skuID string, generation uint64, msg *productv1.GetInventoryResponse) {
+	e := &entry{
+		msg:     proto.Clone(msg),
+		expires: c.now().Add(c.cfg.InventoryTTL),
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if generation != c.generation {
+		return
+	}
+	if _, ok := c.inventories[skuID]; !ok && !makeRoom(c.inventories, c.cfg.MaxEntries, c.now()) {
+		return
+	}
+	c.inventories[skuID] = e
+}
+
+// makeRoom drops expired entries when m is full and reports whether there
+// is room for another entry. Live entries are not evicted: when the cache
+// is full of them, new reads are simply not cached until some expire.
+func makeRoom(m map[string]*entry, maxEntries int, now time.Time) bool {
+	if len(m) < maxEntries {
+		return true
+	}
+	for key, e := range m {
+		if now.After(e.expires) {
+			delete(m, key)
+		}
+	}
+	return len(m) < maxEntries
+}
+
+type productClient struct {
+	productv1connect.ProductServiceClient
+	cache *Cache
+}
+
+func (p *productClient) GetProduct(
+	ctx context.Context,
+	req *connect.Request[productv1.GetProductRequest],
+) (*connect.Response[productv1.GetProductResponse], error) {
+	key := req.Msg.GetId() + "|" + pkgmw.GetChannel(ctx) + "|" + pkgmw.GetMarket(ctx)
+	if msg, ok := p.cache.getProduct(key); ok {
+		p.cache.lookup(KindProduct, true)
+		return connect.NewResponse(msg), nil
+	}
+	p.cache.lookup(KindProduct, false)
+
+	generation := p.cache.currentGeneration()
+	resp, err := p.ProductServiceClient.GetProduct(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	p.cache.storeProduct(key, generation, resp.Msg)
+	return resp, nil
+}
+
+type inventoryClient struct {
+	productv1connect.InventoryServiceClient
+	cache *Cache
+}
+
+func (i *inventoryClient) GetInventory(
+	ctx context.Context,
+	req *connect.Request[productv1.GetInventoryRequest],
+) (*connect.Response[productv1.GetInventoryResponse], error) {
+	skuID := req.Msg.GetSkuId()
+	if msg, ok := i.cache.getInventory(skuID); ok {
+		i.cache.lookup(KindInventory, true)
+		return connect.NewResponse(msg), nil
+	}
+	i.cache.lookup(KindInventory, false)
+
+	generation := i.cache.currentGeneration()
+	resp, err := i.InventoryServiceClient.GetInventory(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	i.cache.storeInventory(skuID, generation, resp.Msg)
+	return resp, nil
+}
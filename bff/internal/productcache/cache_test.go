@@ -0,0 +1,261 @@
+package productcache
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+
+	productv1 "github.com/daisuke8000/example-ec-platform/gen/product/v1"
+	"github.com/daisuke8000/example-ec-platform/gen/product/v1/productv1connect"
+	"github.com/daisuke8000/example-ec-platform/pkg/webhook"
+)
+
+const testSecret = "whsec_test"
+
+type fakeProducts struct {
+	productv1connect.ProductServiceClient
+	calls int
+}
+
+func (f *fakeProducts) GetProduct(_ context.Context, req *connect.Request[productv1.GetProductRequest]) (*connect.Response[productv1.GetProductResponse], error) {
+	f.calls++
+	return connect.NewResponse(&productv1.GetProductResponse{
+		Product: &productv1.Product{
+			Id:   req.Msg.GetId(),
+			Skus: []*productv1.SKU{{Id: "sku-1"}},
+		},
+	}), nil
+}
+
+type fakeInventory struct {
+	productv1connect.InventoryServiceClient
+	calls int
+}
+
+func (f *fakeInventory) GetInventory(_ context.Context, req *connect.Request[productv1.GetInventoryRequest]) (*connect.Response[productv1.GetInventoryResponse], error) {
+	f.calls++
+	return connect.NewResponse(&productv1.GetInventoryResponse{
+		Inventory: &productv1.Inventory{SkuId: req.Msg.GetSkuId(), Available: 5},
+	}), nil
+}
+
+func newTestCache() *Cache {
+	return New(Config{ProductTTL: time.Minute, InventoryTTL: 10 * time.Second, MaxEntries: 10})
+}
+
+func getProduct(t *testing.T, client productv1connect.ProductServiceClient, id string) *productv1.GetProductResponse {
+	t.Helper()
+	resp, err := client.GetProduct(context.Background(), connect.NewRequest(&productv1.GetProductRequest{Id: id}))
+	if err != nil {
+		t.Fatalf("GetProduct() error = %v", err)
+	}
+	return resp.Msg
+}
+
+func getInventory(t *testing.T, client productv1connect.InventoryServiceClient, skuID string) {
+	t.Helper()
+	if _, err := client.GetInventory(context.Background(), connect.NewRequest(&productv1.GetInventoryRequest{SkuId: skuID})); err != nil {
+		t.Fatalf("GetInventory() error = %v", err)
+	}
+}
+
+func TestCache_Products_ServesAndClones(t *testing.T) {
+	backend := &fakeProducts{}
+	cache := newTestCache()
+	client := cache.Products(backend)
+
+	first := getProduct(t, client, "p1")
+	first.Product.Name = "modified by caller"
+	second := getProduct(t, client, "p1")
+
+	if backend.calls != 1 {
+		t.Errorf("backend calls = %d, want 1", backend.calls)
+	}
+	if second.Product.Name != "" {
+		t.Errorf("cached response was modified by a caller: %q", second.Product.Name)
+	}
+}
+
+func TestCache_Expiry(t *testing.T) {
+	backend := &fakeInventory{}
+	cache := newTestCache()
+	clock := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	cache.now = func() time.Time { return clock }
+	client := cache.Inventory(backend)
+
+	getInventory(t, client, "sku-1")
+	clock = clock.Add(5 * time.Second)
+	getInventory(t, client, "sku-1")
+	clock = clock.Add(10 * time.Second)
+	getInventory(t, client, "sku-1")
+
+	if backend.calls != 2 {
+		t.Errorf("backend calls = %d, want 2", backend.calls)
+	}
+}
+
+func TestCache_InvalidateSKU_DropsProductsContainingIt(t *testing.T) {
+	products := &fakeProducts{}
+	inventory := &fakeInventory{}
+	cache := newTestCache()
+
+	getProduct(t, cache.Products(products), "p1")
+	getInventory(t, cache.Inventory(inventory), "sku-1")
+	cache.InvalidateSKU("sku-1")
+
+	if n := cache.Len(); n != 0 {
+		t.Errorf("Len() = %d after InvalidateSKU, want 0", n)
+	}
+}
+
+func TestCache_MaxEntries(t *testing.T) {
+	backend := &fakeProducts{}
+	cache := New(Config{ProductTTL: time.Minute, InventoryTTL: time.Second, MaxEntries: 1})
+	client := cache.Products(backend)
+
+	getProduct(t, client, "p1")
+	getProduct(t, client, "p2")
+	getProduct(t, client, "p2")
+
+	if backend.calls != 3 {
+		t.Errorf("backend calls = %d, want 3 (p2 must not be cached)", backend.calls)
+	}
+}
+
+func deliver(t *testing.T, h http.Handler, secret, body string) *httptest.ResponseRecorder {
+	t.Helper()
+	now := time.Now()
+	req := httptest.NewRequest(http.MethodPost, "/webhooks/product-events", bytes.NewBufferString(body))
+	req.Header.Set(webhook.HeaderTimestamp, strconv.FormatInt(now.Unix(), 10))
+	req.Header.Set(webhook.HeaderSignature, webhook.Sign(secret, now, []byte(body)))
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+	return rec
+}
+
+func TestCache_EventHandler(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	occurred := time.Now().Add(-2 * time.Second).UTC().Format(time.RFC3339Nano)
+
+	tests := []struct {
+		name        string
+		secret      string
+		body        string
+		wantStatus  int
+		wantLen     int
+		wantInvalid string
+	}{
+		{
+			name:        "product_updated",
+			secret:      testSecret,
+			body:        `{"id":"e1","type":"product.updated","occurred_at":"` + occurred + `","data":{"id":"p1"}}`,
+			wantStatus:  http.StatusNoContent,
+			wantLen:     1,
+			wantInvalid: "product.updated",
+		},
+		{
+			name:        "inventory_updated",
+			secret:      testSecret,
+			body:        `{"id":"e2","type":"inventory.updated","occurred_at":"` + occurred + `","data":{"sku_id":"sku-1","available_quantity":3}}`,
+			wantStatus:  http.StatusNoContent,
+			wantLen:     1,
+			wantInvalid: "inventory.updated",
+		},
+		{
+			name:        "price_changed",
+			secret:      testSecret,
+			body:        `{"id":"e3","type":"sku.price_changed","occurred_at":"` + occurred + `","data":{"sku_id":"sku-1"}}`,
+			wantStatus:  http.StatusNoContent,
+			wantLen:     0,
+			wantInvalid: "sku.price_changed",
+		},
+		{
+			name:       "other_type_ignored",
+			secret:     testSecret,
+			body:       `{"id":"e4","type":"category.updated","occurred_at":"` + occurred + `","data":{"id":"c1"}}`,
+			wantStatus: http.StatusNoContent,
+			wantLen:    2,
+		},
+		{
+			name:       "invalid_signature",
+			secret:     "other",
+			body:       `{"id":"e5","type":"product.deleted","occurred_at":"` + occurred + `","data":{"id":"p1"}}`,
+			wantStatus: http.StatusUnauthorized,
+			wantLen:    2,
+		},
+		{
+			name:       "missing_id",
+			secret:     testSecret,
+			body:       `{"id":"e6","type":"product.deleted","occurred_at":"` + occurred + `","data":{}}`,
+			wantStatus: http.StatusBadRequest,
+			wantLen:    2,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cache := newTestCache()
+			getProduct(t, cache.Products(&fakeProducts{}), "p1")
+			getInventory(t, cache.Inventory(&fakeInventory{}), "sku-1")
+
+			var invalidated string
+			var lag time.Duration
+			h := cache.EventHandler(EventsConfig{
+				Secret:    testSecret,
+				Tolerance: time.Minute,
+				OnInvalidate: func(eventType string, l time.Duration) {
+					invalidated, lag = eventType, l
+				},
+			}, logger)
+
+			rec := deliver(t, h, tt.secret, tt.body)
+			if rec.Code != tt.wantStatus {
+				t.Fatalf("status = %d, want %d", rec.Code, tt.wantStatus)
+			}
+			if n := cache.Len(); n != tt.wantLen {
+				t.Errorf("Len() = %d, want %d", n, tt.wantLen)
+			}
+			if invalidated != tt.wantInvalid {
+				t.Errorf("invalidated = %q, want %q", invalidated, tt.wantInvalid)
+			}
+			if tt.wantInvalid != "" && lag < 2*time.Second {
+				t.Errorf("lag = %v, want at least 2s", lag)
+			}
+		})
+	}
+}
+
+func TestCache_FlushHandler(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	cache := newTestCache()
+	getProduct(t, cache.Products(&fakeProducts{}), "p1")
+
+	denied := cache.FlushHandler(func(*http.Request) bool { return false }, nil, logger)
+	rec := httptest.NewRecorder()
+	denied.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/admin/product-cache/flush", nil))
+	if rec.Code != http.StatusForbidden {
+		t.Fatalf("status = %d, want %d", rec.Code, http.StatusForbidden)
+	}
+
+	var flushed int
+	allowed := cache.FlushHandler(func(*http.Request) bool { return true }, func(n int) { flushed = n }, logger)
+	rec = httptest.NewRecorder()
+	allowed.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/admin/product-cache/flush", nil))
+	if rec.Code != http.StatusOK {
+		t.Fatalf("status = %d, want %d", rec.Code, http.StatusOK)
+	}
+	if flushed != 1 || cache.Len() != 0 {
+		t.Errorf("flushed = %d, Len() = %d, want 1 and 0", flushed, cache.Len())
+	}
+	if got := rec.Body.String(); got != "{\"flushed\":1}\n" {
+		t.Errorf("body = %q", got)
+	}
+}
@@ -0,0 +1,149 @@
+package productcache
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/daisuke8000/example-ec-platform/pkg/webhook"
+)
+
+// maxEventBytes bounds the body of a webhook delivery.
+const maxEventBytes = 1 << 20
+
+// Product Service event types that invalidate entries.
+const (
+	eventProductCreated   = "product.created"
+	eventProductUpdated   = "product.updated"
+	eventProductDeleted   = "product.deleted"
+	eventInventoryUpdated = "inventory.updated"
+	eventSKUPriceChanged  = "sku.price_changed"
+)
+
+// EventsConfig configures the webhook receiver.
+type EventsConfig struct {
+	// Secret is the signing secret of the webhook endpoint registered with
+	// the Product Service's WebhookService.
+	Secret string
+	// Tolerance bounds the age of a delivery's signature.
+	Tolerance time.Duration
+	// OnInvalidate, if set, is called for every event that invalidated
+	// entries, with the time since the change was published.
+	OnInvalidate func(eventType string, lag time.Duration)
+}
+
+// envelope is the body of a Product Service webhook delivery.
+type envelope struct {
+	ID         string          `json:"id"`
+	Type       string          `json:"type"`
+	OccurredAt time.Time       `json:"occurred_at"`
+	Data       json.RawMessage `json:"data"`
+}
+
+type eventData struct {
+	ID    string `json:"id"`     // Product events
+	SKUID string `json:"sku_id"` // Inventory and price events
+}
+
+// EventHandler returns the endpoint the Product Service delivers its
+// webhook events to. Deliveries must be signed with cfg.Secret; events of
+// other types are acknowledged and ignored. Invalidation is idempotent, so
+// redelivered events are harmless.
+func (c *Cache) EventHandler(cfg EventsConfig, logger *slog.Logger) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			w.Header().Set("Allow", http.MethodPost)
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+
+		body, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxEventBytes))
+		if err != nil {
+			http.Error(w, "request body too large", http.StatusRequestEntityTooLarge)
+			return
+		}
+		if err := webhook.Verify(cfg.Secret, r.Header.Get(webhook.HeaderTimestamp), r.Header.Get(webhook.HeaderSignature), body, cfg.Tolerance); err != nil {
+			logger.Warn("rejected product event", slog.String("error", err.Error()))
+			http.Error(w, "invalid signature", http.StatusUnauthorized)
+			return
+		}
+
+		var event envelope
+		var data eventData
+		if err := json.Unmarshal(body, &event); err != nil {
+			http.Error(w, "malformed event", http.StatusBadRequest)
+			return
+		}
+		if len(event.Data) > 0 {
+			if err := json.Unmarshal(event.Data, &data); err != nil {
+				http.Error(w, "malformed event", http.StatusBadRequest)
+				return
+			}
+		}
+
+		if err := c.apply(event.Type, data); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		if cfg.OnInvalidate != nil && handled(event.Type) {
+			cfg.OnInvalidate(event.Type, time.Since(event.OccurredAt))
+		}
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
+
+func handled(eventType string) bool {
+	switch eventType {
+	case eventProductCreated, eventProductUpdated, eventProductDeleted, eventInventoryUpdated, eventSKUPriceChanged:
+		return true
+	}
+	return false
+}
+
+func (c *Cache) apply(eventType string, data eventData) error {
+	switch eventType {
+	case eventProductCreated, eventProductUpdated, eventProductDeleted:
+		if data.ID == "" {
+			return errors.New("product event without id")
+		}
+		c.InvalidateProduct(data.ID)
+	case eventInventoryUpdated:
+		if data.SKUID == "" {
+			return errors.New("inventory event without sku_id")
+		}
+		c.InvalidateInventory(data.SKUID)
+	case eventSKUPriceChanged:
+		if data.SKUID == "" {
+			return errors.New("price event without sku_id")
+		}
+		c.InvalidateSKU(data.SKUID)
+	}
+	return nil
+}
+
+// FlushHandler returns the admin endpoint that drops every entry, e.g.
+// after events were lost. authorize decides whether the caller may flush.
+func (c *Cache) FlushHandler(authorize func(r *http.Request) bool, onFlush func(n int), logger *slog.Logger) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			w.Header().Set("Allow", http.MethodPost)
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+		if !authorize(r) {
+			http.Error(w, "access denied", http.StatusForbidden)
+			return
+		}
+
+		n := c.Flush()
+		logger.Info("product cache flushed", slog.Int("entries", n))
+		if onFlush != nil {
+			onFlush(n)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]int{"flushed": n})
+	})
+}
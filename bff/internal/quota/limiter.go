@@ -0,0 +1,216 @@
+// Package quota enforces per-user request budgets for individual procedures
+// (e.g. 5 order creations per minute), shared across BFF replicas.
+package quota
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"math"
+	"net/http"
+	"strconv"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/redis/go-redis/v9"
+
+	pkgmw "github.com/daisuke8000/example-ec-platform/pkg/connect/middleware"
+)
+
+// Limit is the number of requests a user may make within a window.
+type Limit struct {
+	Requests int
+	Window   time.Duration
+}
+
+// Counter increments a counter that expires after window.
+type Counter interface {
+	Increment(ctx context.Context, key string, window time.Duration) (int64, error)
+}
+
+// RedisCounter implements Counter on top of Redis.
+type RedisCounter struct {
+	client *redis.Client
+	prefix string
+}
+
+func NewRedisCounter(client *redis.Client, prefix string) *RedisCounter {
+	if prefix == "" {
+		prefix = "bff:quota:"
+	}
+	return &RedisCounter{
+		client: client,
+		prefix: prefix,
+	}
+}
+
+func (c *RedisCounter) Increment(ctx context.Context, key string, window time.Duration) (int64, error) {
+	pipe := c.client.TxPipeline()
+	incr := pipe.Incr(ctx, c.prefix+key)
+	pipe.PExpire(ctx, c.prefix+key, window)
+	if _, err := pipe.Exec(ctx); err != nil {
+		return 0, err
+	}
+	return incr.Val(), nil
+}
+
+// Standard rate limit headers (draft-ietf-httpapi-ratelimit-headers).
+const (
+	HeaderLimit     = "RateLimit-Limit"
+	HeaderRemaining = "RateLimit-Remaining"
+	HeaderReset     = "RateLimit-Reset"
+	HeaderPolicy    = "RateLimit-Policy"
+)
+
+// Usage is a caller's quota state after counting a request.
+type Usage struct {
+	Limit     Limit
+	Remaining int
+	// Reset is the time until the current window ends.
+	Reset    time.Duration
+	Exceeded bool
+}
+
+// SetHeaders writes the RateLimit headers describing u, plus Retry-After
+// when the quota is exceeded.
+func (u Usage) SetHeaders(h http.Header) {
+	resetSeconds := strconv.Itoa(int(math.Ceil(u.Reset.Seconds())))
+	h.Set(HeaderLimit, strconv.Itoa(u.Limit.Requests))
+	h.Set(HeaderRemaining, strconv.Itoa(u.Remaining))
+	h.Set(HeaderReset, resetSeconds)
+	h.Set(HeaderPolicy, fmt.Sprintf("%d;w=%d", u.Limit.Requests, int(u.Limit.Window.Seconds())))
+	if u.Exceeded {
+		h.Set("Retry-After", resetSeconds)
+	}
+}
+
+// Limiter applies fixed-window quotas keyed by procedure and user ID.
+type Limiter struct {
+	counter         Counter
+	limits          map[string]Limit
+	failOpen        bool
+	headerThreshold float64
+	logger          *slog.Logger
+	now             func() time.Time
+}
+
+// NewLimiter creates a limiter. Procedures without a limit are not counted.
+// When failOpen is set, requests are allowed if the counter is unavailable.
+// Successful responses carry RateLimit headers once the remaining fraction
+// of the quota is at most headerThreshold (0 disables them, 1 always sends
+// them); rejected requests always carry them.
+func NewLimiter(counter Counter, limits map[string]Limit, failOpen bool, headerThreshold float64, logger *slog.Logger) *Limiter {
+	return &Limiter{
+		counter:         counter,
+		limits:          limits,
+		failOpen:        failOpen,
+		headerThreshold: headerThreshold,
+		logger:          logger,
+		now:             time.Now,
+	}
+}
+
+// Count counts a request against the caller's quota. ok is false for
+// procedures without a limit, which are not counted.
+func (l *Limiter) Count(ctx context.Context, procedure, userID string) (usage Usage, ok bool, err error) {
+	limit, ok := l.limits[procedure]
+	if !ok {
+		return Usage{}, false, nil
+	}
+
+	now := l.now()
+	window := now.UnixNano() / int64(limit.Window)
+	key := procedure + ":" + userID + ":" + strconv.FormatInt(window, 10)
+
+	count, err := l.counter.Increment(ctx, key, limit.Window)
+	if err != nil {
+		return Usage{}, true, err
+	}
+
+	windowEnd := time.Unix(0, (window+1)*int64(limit.Window))
+	return Usage{
+		Limit:     limit,
+		Remaining: max(limit.Requests-int(count), 0),
+		Reset:     windowEnd.Sub(now),
+		Exceeded:  count > int64(limit.Requests),
+	}, true, nil
+}
+
+// Allow counts a request and reports whether it is within quota. When it is
+// not, retryAfter is the time until the current window ends.
+func (l *Limiter) Allow(ctx context.Context, procedure, userID string) (allowed bool, retryAfter time.Duration, err error) {
+	usage, ok, err := l.Count(ctx, procedure, userID)
+	if err != nil {
+		return false, 0, err
+	}
+	if !ok || !usage.Exceeded {
+		return true, 0, nil
+	}
+	return false, usage.Reset, nil
+}
+
+// nearLimit reports whether a successful response should advertise u.
+func (l *Limiter) nearLimit(u Usage) bool {
+	if l.headerThreshold <= 0 || u.Limit.Requests <= 0 {
+		return false
+	}
+	return float64(u.Remaining) <= l.headerThreshold*float64(u.Limit.Requests)
+}
+
+// Interceptor returns a server-side interceptor enforcing quotas for
+// authenticated callers. It must run after authentication; anonymous
+// requests are not counted.
+func (l *Limiter) Interceptor() connect.UnaryInterceptorFunc {
+	return func(next connect.UnaryFunc) connect.UnaryFunc {
+		return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+			userID := pkgmw.GetUserID(ctx)
+			if req.Spec().IsClient || userID == "" {
+				return next(ctx, req)
+			}
+
+			procedure := req.Spec().Procedure
+			usage, ok, err := l.Count(ctx, procedure, userID)
+			if err != nil {
+				if l.failOpen {
+					l.logger.WarnContext(ctx, "quota store unavailable, allowing request",
+						slog.String("procedure", procedure),
+						slog.String("error", err.Error()),
+					)
+					return next(ctx, req)
+				}
+				l.logger.ErrorContext(ctx, "quota store unavailable",
+					slog.String("procedure", procedure),
+					slog.String("error", err.Error()),
+				)
+				return nil, connect.NewError(connect.CodeUnavailable, errors.New("quota store unavailable"))
+			}
+			if !ok {
+				return next(ctx, req)
+			}
+			if usage.Exceeded {
+				l.logger.InfoContext(ctx, "quota exceeded",
+					slog.String("procedure", procedure),
+					slog.String("user_id", userID),
+				)
+				connectErr := connect.NewError(connect.CodeResourceExhausted,
+					fmt.Errorf("quota exceeded, retry in %s", usage.Reset.Round(time.Second)))
+				usage.SetHeaders(connectErr.Meta())
+				return nil, connectErr
+			}
+
+			resp, err := next(ctx, req)
+			if l.nearLimit(usage) {
+				// Let well-behaved clients slow down before being rejected.
+				var connectErr *connect.Error
+				switch {
+				case err == nil:
+					usage.SetHeaders(resp.Header())
+				case errors.As(err, &connectErr):
+					usage.SetHeaders(connectErr.Meta())
+				}
+			}
+			return resp, err
+		}
+	}
+}
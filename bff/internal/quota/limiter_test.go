@@ -0,0 +1,177 @@
+package quota
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"net/http"
+	"os"
+	"testing"
+	"time"
+)
+
+const createOrder = "/order.v1.OrderService/CreateOrder"
+
+// memoryCounter is an in-memory Counter.
+type memoryCounter struct {
+	counts map[string]int64
+	err    error
+}
+
+func (c *memoryCounter) Increment(ctx context.Context, key string, window time.Duration) (int64, error) {
+	if c.err != nil {
+		return 0, c.err
+	}
+	c.counts[key]++
+	return c.counts[key], nil
+}
+
+func newTestLimiter(counter Counter, failOpen bool, now time.Time) *Limiter {
+	logger := slog.New(slog.NewTextHandler(os.Stdout, &slog.HandlerOptions{Level: slog.LevelError}))
+	l := NewLimiter(counter, map[string]Limit{
+		createOrder: {Requests: 2, Window: time.Minute},
+	}, failOpen, 0.5, logger)
+	l.now = func() time.Time { return now }
+	return l
+}
+
+func TestLimiter_Allow(t *testing.T) {
+	now := time.Date(2026, 1, 1, 12, 0, 15, 0, time.UTC)
+	l := newTestLimiter(&memoryCounter{counts: make(map[string]int64)}, true, now)
+	ctx := context.Background()
+
+	for i := 0; i < 2; i++ {
+		if allowed, _, err := l.Allow(ctx, createOrder, "alice"); err != nil || !allowed {
+			t.Fatalf("request %d: allowed = %v, err = %v, want allowed", i+1, allowed, err)
+		}
+	}
+
+	allowed, retryAfter, err := l.Allow(ctx, createOrder, "alice")
+	if err != nil || allowed {
+		t.Fatalf("third request: allowed = %v, err = %v, want rejected", allowed, err)
+	}
+	if retryAfter != 45*time.Second {
+		t.Errorf("retryAfter = %v, want 45s until the window ends", retryAfter)
+	}
+
+	// Quotas are per user.
+	if allowed, _, _ := l.Allow(ctx, createOrder, "bob"); !allowed {
+		t.Error("other user should have their own quota")
+	}
+	// Procedures without a limit are not counted.
+	if allowed, _, _ := l.Allow(ctx, "/user.v1.UserService/GetUser", "alice"); !allowed {
+		t.Error("procedure without a limit should be allowed")
+	}
+}
+
+func TestLimiter_NewWindowResets(t *testing.T) {
+	counter := &memoryCounter{counts: make(map[string]int64)}
+	now := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	l := newTestLimiter(counter, true, now)
+	ctx := context.Background()
+
+	for i := 0; i < 3; i++ {
+		l.Allow(ctx, createOrder, "alice")
+	}
+
+	l.now = func() time.Time { return now.Add(time.Minute) }
+	if allowed, _, _ := l.Allow(ctx, createOrder, "alice"); !allowed {
+		t.Error("quota should reset in the next window")
+	}
+}
+
+func TestLimiter_CounterError(t *testing.T) {
+	counter := &memoryCounter{err: errors.New("connection refused")}
+	l := newTestLimiter(counter, false, time.Now())
+
+	if _, _, err := l.Allow(context.Background(), createOrder, "alice"); err == nil {
+		t.Error("expected counter error to be returned")
+	}
+}
+
+func TestLimiter_Count(t *testing.T) {
+	now := time.Date(2026, 1, 1, 12, 0, 15, 0, time.UTC)
+	l := newTestLimiter(&memoryCounter{counts: make(map[string]int64)}, true, now)
+	ctx := context.Background()
+
+	tests := []struct {
+		wantRemaining int
+		wantExceeded  bool
+		wantNearLimit bool
+	}{
+		{wantRemaining: 1, wantNearLimit: true},
+		{wantRemaining: 0, wantNearLimit: true},
+		{wantRemaining: 0, wantExceeded: true, wantNearLimit: true},
+	}
+	for i, tt := range tests {
+		usage, ok, err := l.Count(ctx, createOrder, "alice")
+		if err != nil || !ok {
+			t.Fatalf("request %d: ok = %v, err = %v", i+1, ok, err)
+		}
+		if usage.Remaining != tt.wantRemaining || usage.Exceeded != tt.wantExceeded {
+			t.Errorf("request %d: remaining = %d, exceeded = %v, want %d, %v",
+				i+1, usage.Remaining, usage.Exceeded, tt.wantRemaining, tt.wantExceeded)
+		}
+		if usage.Reset != 45*time.Second {
+			t.Errorf("request %d: reset = %v, want 45s", i+1, usage.Reset)
+		}
+		if got := l.nearLimit(usage); got != tt.wantNearLimit {
+			t.Errorf("request %d: nearLimit = %v, want %v", i+1, got, tt.wantNearLimit)
+		}
+	}
+
+	if _, ok, _ := l.Count(ctx, "/user.v1.UserService/GetUser", "alice"); ok {
+		t.Error("procedure without a limit should not be counted")
+	}
+}
+
+func TestLimiter_NearLimitThreshold(t *testing.T) {
+	limit := Limit{Requests: 10, Window: time.Minute}
+	tests := []struct {
+		threshold float64
+		remaining int
+		want      bool
+	}{
+		{threshold: 0.2, remaining: 5, want: false},
+		{threshold: 0.2, remaining: 2, want: true},
+		{threshold: 0, remaining: 0, want: false},
+		{threshold: 1, remaining: 10, want: true},
+	}
+	for _, tt := range tests {
+		l := &Limiter{headerThreshold: tt.threshold}
+		if got := l.nearLimit(Usage{Limit: limit, Remaining: tt.remaining}); got != tt.want {
+			t.Errorf("threshold %v, remaining %d: nearLimit = %v, want %v", tt.threshold, tt.remaining, got, tt.want)
+		}
+	}
+}
+
+func TestUsage_SetHeaders(t *testing.T) {
+	usage := Usage{
+		Limit:     Limit{Requests: 5, Window: time.Minute},
+		Remaining: 0,
+		Reset:     1500 * time.Millisecond,
+		Exceeded:  true,
+	}
+	h := http.Header{}
+	usage.SetHeaders(h)
+
+	want := map[string]string{
+		HeaderLimit:     "5",
+		HeaderRemaining: "0",
+		HeaderReset:     "2",
+		HeaderPolicy:    "5;w=60",
+		"Retry-After":   "2",
+	}
+	for name, value := range want {
+		if got := h.Get(name); got != value {
+			t.Errorf("%s = %q, want %q", name, got, value)
+		}
+	}
+
+	h = http.Header{}
+	usage.Exceeded = false
+	usage.SetHeaders(h)
+	if h.Get("Retry-After") != "" {
+		t.Error("Retry-After should only be set when the quota is exceeded")
+	}
+}
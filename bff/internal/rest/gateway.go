@@ -0,0 +1,368 @@
+// Package rest translates REST/JSON requests into unary Connect calls for
+// integrators that cannot use Connect or gRPC, and describes the resulting
+// API as an OpenAPI document derived from the proto descriptors.
+package rest
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+
+	"connectrpc.com/connect"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/dynamicpb"
+)
+
+// maxRequestBytes bounds the size of a REST request body.
+const maxRequestBytes = 1 << 20
+
+// OpenAPIPath is where the gateway serves its OpenAPI document.
+const OpenAPIPath = "/openapi.json"
+
+var wildcardPattern = regexp.MustCompile(`\{([A-Za-z_][A-Za-z0-9_]*)\}`)
+
+// methodsWithBody are the HTTP methods for which Route.Body may be set.
+var methodsWithBody = map[string]bool{
+	http.MethodPost:  true,
+	http.MethodPut:   true,
+	http.MethodPatch: true,
+}
+
+// Route maps an HTTP endpoint to a unary Connect procedure.
+type Route struct {
+	// Method is the HTTP method, e.g. http.MethodGet.
+	Method string
+	// Path is a path pattern such as "/api/v1/users/{id}". Each {name}
+	// wildcard binds the request field of that proto name.
+	Path string
+	// Procedure is the Connect procedure, e.g. "/user.v1.UserService/GetUser".
+	Procedure string
+	// Body binds the JSON request body to the request message. Routes
+	// without a body bind query parameters to top-level scalar fields.
+	Body bool
+	// Summary is a short description for the OpenAPI document.
+	Summary string
+}
+
+type route struct {
+	Route
+	method     protoreflect.MethodDescriptor
+	pathFields []protoreflect.FieldDescriptor
+}
+
+// Gateway serves REST routes by calling Connect handlers in process, so
+// requests pass through the same interceptors as Connect clients.
+type Gateway struct {
+	mux            *http.ServeMux
+	backend        http.Handler
+	forwardHeaders []string
+	openAPI        []byte
+	logger         *slog.Logger
+}
+
+// NewGateway creates a gateway for routes served by backend, which must
+// handle the routes' Connect procedures. forwardHeaders lists the request
+// headers passed on to backend, such as Authorization; empty names are
+// ignored. It fails if a route refers to an unknown procedure or field.
+func NewGateway(routes []Route, backend http.Handler, forwardHeaders []string, logger *slog.Logger) (*Gateway, error) {
+	g := &Gateway{
+		mux:     http.NewServeMux(),
+		backend: backend,
+		logger:  logger,
+	}
+	for _, name := range forwardHeaders {
+		if name != "" {
+			g.forwardHeaders = append(g.forwardHeaders, name)
+		}
+	}
+
+	resolved := make([]*route, 0, len(routes))
+	for _, r := range routes {
+		rt, err := resolveRoute(r)
+		if err != nil {
+			return nil, err
+		}
+		resolved = append(resolved, rt)
+		g.mux.HandleFunc(r.Method+" "+r.Path, func(w http.ResponseWriter, req *http.Request) {
+			g.serve(rt, w, req)
+		})
+	}
+
+	doc, err := json.Marshal(buildOpenAPI(resolved))
+	if err != nil {
+		return nil, fmt.Errorf("build openapi document: %w", err)
+	}
+	g.openAPI = doc
+	g.mux.HandleFunc("GET "+OpenAPIPath, func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write(g.openAPI)
+	})
+	return g, nil
+}
+
+func resolveRoute(r Route) (*route, error) {
+	name := strings.ReplaceAll(strings.TrimPrefix(r.Procedure, "/"), "/", ".")
+	desc, err := protoregistry.GlobalFiles.FindDescriptorByName(protoreflect.FullName(name))
+	if err != nil {
+		return nil, fmt.Errorf("route %s %s: unknown procedure %s", r.Method, r.Path, r.Procedure)
+	}
+	method, ok := desc.(protoreflect.MethodDescriptor)
+	if !ok || method.IsStreamingClient() || method.IsStreamingServer() {
+		return nil, fmt.Errorf("route %s %s: %s is not a unary method", r.Method, r.Path, r.Procedure)
+	}
+
+	if r.Body && !methodsWithBody[r.Method] {
+		return nil, fmt.Errorf("route %s %s: %s requests have no body", r.Method, r.Path, r.Method)
+	}
+
+	rt := &route{Route: r, method: method}
+	for _, m := range wildcardPattern.FindAllStringSubmatch(r.Path, -1) {
+		fd := method.Input().Fields().ByName(protoreflect.Name(m[1]))
+		if fd == nil || fd.IsList() || fd.IsMap() || fd.Kind() == protoreflect.MessageKind {
+			return nil, fmt.Errorf("route %s %s: %s has no scalar field %q", r.Method, r.Path, method.Input().FullName(), m[1])
+		}
+		rt.pathFields = append(rt.pathFields, fd)
+	}
+	return rt, nil
+}
+
+func (g *Gateway) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	g.mux.ServeHTTP(w, r)
+}
+
+func (g *Gateway) serve(rt *route, w http.ResponseWriter, r *http.Request) {
+	msg := dynamicpb.NewMessage(rt.method.Input())
+
+	if rt.Body {
+		body, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxRequestBytes))
+		if err != nil {
+			writeError(w, connect.CodeInvalidArgument, "request body too large or unreadable")
+			return
+		}
+		if len(bytes.TrimSpace(body)) > 0 {
+			if err := protojson.Unmarshal(body, msg); err != nil {
+				writeError(w, connect.CodeInvalidArgument, "invalid JSON request body: "+err.Error())
+				return
+			}
+		}
+	} else if err := bindQuery(msg, r.URL.Query(), rt.pathFields); err != nil {
+		writeError(w, connect.CodeInvalidArgument, err.Error())
+		return
+	}
+
+	// Path parameters take precedence over the body.
+	for _, fd := range rt.pathFields {
+		v, err := parseValue(msg, fd, r.PathValue(string(fd.Name())))
+		if err != nil {
+			writeError(w, connect.CodeInvalidArgument, err.Error())
+			return
+		}
+		msg.Set(fd, v)
+	}
+
+	payload, err := protojson.Marshal(msg)
+	if err != nil {
+		g.logger.ErrorContext(r.Context(), "failed to encode rest request",
+			slog.String("procedure", rt.Procedure),
+			slog.String("error", err.Error()),
+		)
+		writeError(w, connect.CodeInternal, "internal server error")
+		return
+	}
+
+	// Call the Connect handler with the unary JSON protocol. Its responses
+	// are already REST-shaped: the message as JSON on success, and an error
+	// object with a matching HTTP status otherwise.
+	req, err := http.NewRequestWithContext(r.Context(), http.MethodPost, rt.Procedure, bytes.NewReader(payload))
+	if err != nil {
+		writeError(w, connect.CodeInternal, "internal server error")
+		return
+	}
+	req.RemoteAddr = r.RemoteAddr
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Connect-Protocol-Version", "1")
+	for _, name := range g.forwardHeaders {
+		if v := r.Header.Get(name); v != "" {
+			req.Header.Set(name, v)
+		}
+	}
+
+	rec := httptest.NewRecorder()
+	g.backend.ServeHTTP(rec, req)
+
+	for name, values := range rec.Header() {
+		if name == "Content-Length" {
+			continue
+		}
+		w.Header()[name] = values
+	}
+	w.WriteHeader(rec.Code)
+	w.Write(rec.Body.Bytes())
+}
+
+// bindQuery sets top-level fields of msg from query parameters, matched by
+// JSON or proto name. Repeated fields take every value of the parameter.
+func bindQuery(msg *dynamicpb.Message, query map[string][]string, pathFields []protoreflect.FieldDescriptor) error {
+	fields := msg.Descriptor().Fields()
+	for key, values := range query {
+		fd := fields.ByJSONName(key)
+		if fd == nil {
+			fd = fields.ByName(protoreflect.Name(key))
+		}
+		if fd == nil || !queryBindable(fd) || slices.Contains(pathFields, fd) {
+			return fmt.Errorf("unknown query parameter %q", key)
+		}
+
+		if fd.IsList() {
+			list := msg.Mutable(fd).List()
+			for _, s := range values {
+				v, err := parseValue(msg, fd, s)
+				if err != nil {
+					return err
+				}
+				list.Append(v)
+			}
+			continue
+		}
+		if len(values) > 1 {
+			return fmt.Errorf("query parameter %q must not be repeated", key)
+		}
+		v, err := parseValue(msg, fd, values[0])
+		if err != nil {
+			return err
+		}
+		msg.Set(fd, v)
+	}
+	return nil
+}
+
+// queryBindable reports whether fd can be set from query parameters:
+// scalars, enums, well-known types with a string form, and lists of these.
+func queryBindable(fd protoreflect.FieldDescriptor) bool {
+	if fd.IsMap() {
+		return false
+	}
+	if fd.Kind() == protoreflect.MessageKind || fd.Kind() == protoreflect.GroupKind {
+		_, ok := scalarWellKnownTypes[fd.Message().FullName()]
+		return ok
+	}
+	return true
+}
+
+// parseValue parses s as a value of field fd of msg.
+func parseValue(msg *dynamicpb.Message, fd protoreflect.FieldDescriptor, s string) (protoreflect.Value, error) {
+	invalid := func() (protoreflect.Value, error) {
+		return protoreflect.Value{}, fmt.Errorf("invalid value %q for %s", s, fd.JSONName())
+	}
+
+	switch fd.Kind() {
+	case protoreflect.StringKind:
+		return protoreflect.ValueOfString(s), nil
+	case protoreflect.BoolKind:
+		b, err := strconv.ParseBool(s)
+		if err != nil {
+			return invalid()
+		}
+		return protoreflect.ValueOfBool(b), nil
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind:
+		n, err := strconv.ParseInt(s, 10, 32)
+		if err != nil {
+			return invalid()
+		}
+		return protoreflect.ValueOfInt32(int32(n)), nil
+	case protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind:
+		n, err := strconv.ParseInt(s, 10, 64)
+		if err != nil {
+			return invalid()
+		}
+		return protoreflect.ValueOfInt64(n), nil
+	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind:
+		n, err := strconv.ParseUint(s, 10, 32)
+		if err != nil {
+			return invalid()
+		}
+		return protoreflect.ValueOfUint32(uint32(n)), nil
+	case protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		n, err := strconv.ParseUint(s, 10, 64)
+		if err != nil {
+			return invalid()
+		}
+		return protoreflect.ValueOfUint64(n), nil
+	case protoreflect.FloatKind:
+		f, err := strconv.ParseFloat(s, 32)
+		if err != nil {
+			return invalid()
+		}
+		return protoreflect.ValueOfFloat32(float32(f)), nil
+	case protoreflect.DoubleKind:
+		f, err := strconv.ParseFloat(s, 64)
+		if err != nil {
+			return invalid()
+		}
+		return protoreflect.ValueOfFloat64(f), nil
+	case protoreflect.BytesKind:
+		b, err := base64.StdEncoding.DecodeString(s)
+		if err != nil {
+			if b, err = base64.URLEncoding.DecodeString(s); err != nil {
+				return invalid()
+			}
+		}
+		return protoreflect.ValueOfBytes(b), nil
+	case protoreflect.EnumKind:
+		if v := fd.Enum().Values().ByName(protoreflect.Name(s)); v != nil {
+			return protoreflect.ValueOfEnum(v.Number()), nil
+		}
+		n, err := strconv.ParseInt(s, 10, 32)
+		if err != nil || fd.Enum().Values().ByNumber(protoreflect.EnumNumber(n)) == nil {
+			return invalid()
+		}
+		return protoreflect.ValueOfEnum(protoreflect.EnumNumber(n)), nil
+	case protoreflect.MessageKind, protoreflect.GroupKind:
+		// Well-known types such as Timestamp parse from their JSON string form.
+		var v protoreflect.Value
+		if fd.IsList() {
+			v = msg.Mutable(fd).List().NewElement()
+		} else {
+			v = msg.NewField(fd)
+		}
+		raw, err := json.Marshal(s)
+		if err != nil {
+			return invalid()
+		}
+		if err := protojson.Unmarshal(raw, v.Message().Interface()); err != nil {
+			return invalid()
+		}
+		return v, nil
+	}
+	return invalid()
+}
+
+// writeError writes an error in the Connect JSON error format used by the
+// backend handlers, so clients see a single error shape.
+func writeError(w http.ResponseWriter, code connect.Code, message string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(httpStatus(code))
+	json.NewEncoder(w).Encode(map[string]string{
+		"code":    code.String(),
+		"message": message,
+	})
+}
+
+func httpStatus(code connect.Code) int {
+	switch code {
+	case connect.CodeInvalidArgument:
+		return http.StatusBadRequest
+	}
+	return http.StatusInternalServerError
+}
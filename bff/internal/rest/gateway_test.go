@@ -0,0 +1,191 @@
+package rest_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+
+	"connectrpc.com/connect"
+
+	userv1 "github.com/daisuke8000/example-ec-platform/gen/user/v1"
+	"github.com/daisuke8000/example-ec-platform/gen/user/v1/userv1connect"
+
+	"github.com/daisuke8000/example-ec-platform/bff/internal/rest"
+)
+
+type stubUserService struct {
+	userv1connect.UnimplementedUserServiceHandler
+	lastList   *userv1.ListUsersRequest
+	lastCreate *userv1.CreateUserRequest
+}
+
+func (s *stubUserService) GetUser(_ context.Context, req *connect.Request[userv1.GetUserRequest]) (*connect.Response[userv1.GetUserResponse], error) {
+	if req.Header().Get("Authorization") != "Bearer token" {
+		return nil, connect.NewError(connect.CodeUnauthenticated, errors.New("authentication required"))
+	}
+	if req.Msg.GetId() != "u1" {
+		return nil, connect.NewError(connect.CodeNotFound, errors.New("user not found"))
+	}
+	return connect.NewResponse(&userv1.GetUserResponse{User: &userv1.User{Id: "u1", Email: "user@example.com"}}), nil
+}
+
+func (s *stubUserService) ListUsers(_ context.Context, req *connect.Request[userv1.ListUsersRequest]) (*connect.Response[userv1.ListUsersResponse], error) {
+	s.lastList = req.Msg
+	return connect.NewResponse(&userv1.ListUsersResponse{}), nil
+}
+
+func (s *stubUserService) CreateUser(_ context.Context, req *connect.Request[userv1.CreateUserRequest]) (*connect.Response[userv1.CreateUserResponse], error) {
+	s.lastCreate = req.Msg
+	return connect.NewResponse(&userv1.CreateUserResponse{User: &userv1.User{Id: "u2", Email: req.Msg.GetEmail()}}), nil
+}
+
+func newGateway(t *testing.T, svc *stubUserService) *rest.Gateway {
+	t.Helper()
+	mux := http.NewServeMux()
+	mux.Handle(userv1connect.NewUserServiceHandler(svc))
+
+	logger := slog.New(slog.NewTextHandler(os.Stdout, &slog.HandlerOptions{Level: slog.LevelError}))
+	gateway, err := rest.NewGateway(rest.UserRoutes, mux, []string{"Authorization", ""}, logger)
+	if err != nil {
+		t.Fatalf("NewGateway() error = %v", err)
+	}
+	return gateway
+}
+
+func TestGateway_PathParameters(t *testing.T) {
+	gateway := newGateway(t, &stubUserService{})
+
+	tests := []struct {
+		name       string
+		path       string
+		token      string
+		wantStatus int
+		wantBody   string
+	}{
+		{name: "found", path: "/api/v1/users/u1", token: "Bearer token", wantStatus: http.StatusOK, wantBody: `"email":"user@example.com"`},
+		{name: "not_found", path: "/api/v1/users/u9", token: "Bearer token", wantStatus: http.StatusNotFound, wantBody: `"code":"not_found"`},
+		{name: "unauthenticated", path: "/api/v1/users/u1", wantStatus: http.StatusUnauthorized, wantBody: `"code":"unauthenticated"`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, tt.path, nil)
+			if tt.token != "" {
+				req.Header.Set("Authorization", tt.token)
+			}
+			rec := httptest.NewRecorder()
+			gateway.ServeHTTP(rec, req)
+
+			if rec.Code != tt.wantStatus {
+				t.Errorf("status = %d, want %d", rec.Code, tt.wantStatus)
+			}
+			if !strings.Contains(rec.Body.String(), tt.wantBody) {
+				t.Errorf("body = %s, want it to contain %s", rec.Body.String(), tt.wantBody)
+			}
+		})
+	}
+}
+
+func TestGateway_QueryParameters(t *testing.T) {
+	svc := &stubUserService{}
+	gateway := newGateway(t, svc)
+
+	rec := httptest.NewRecorder()
+	gateway.ServeHTTP(rec, httptest.NewRequest(http.MethodGet,
+		"/api/v1/users?pageSize=5&email_contains=example&createdAfter=2024-01-01T00:00:00Z", nil))
+
+	if rec.Code != http.StatusOK {
+		t.Fatalf("status = %d, body = %s", rec.Code, rec.Body.String())
+	}
+	if svc.lastList.GetPageSize() != 5 {
+		t.Errorf("page_size = %d, want 5", svc.lastList.GetPageSize())
+	}
+	if svc.lastList.GetEmailContains() != "example" {
+		t.Errorf("email_contains = %q, want %q", svc.lastList.GetEmailContains(), "example")
+	}
+	if svc.lastList.GetCreatedAfter().AsTime().Year() != 2024 {
+		t.Errorf("created_after = %v, want 2024-01-01", svc.lastList.GetCreatedAfter().AsTime())
+	}
+
+	for _, query := range []string{"?unknown=1", "?pageSize=abc", "?pageSize=1&pageSize=2"} {
+		rec := httptest.NewRecorder()
+		gateway.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/api/v1/users"+query, nil))
+		if rec.Code != http.StatusBadRequest {
+			t.Errorf("%s: status = %d, want %d", query, rec.Code, http.StatusBadRequest)
+		}
+	}
+}
+
+func TestGateway_Body(t *testing.T) {
+	svc := &stubUserService{}
+	gateway := newGateway(t, svc)
+
+	req := httptest.NewRequest(http.MethodPost, "/api/v1/users",
+		strings.NewReader(`{"email":"new@example.com","password":"password123","name":"New"}`))
+	rec := httptest.NewRecorder()
+	gateway.ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusOK {
+		t.Fatalf("status = %d, body = %s", rec.Code, rec.Body.String())
+	}
+	if svc.lastCreate.GetEmail() != "new@example.com" || svc.lastCreate.GetName() != "New" {
+		t.Errorf("request = %v, want email and name from the body", svc.lastCreate)
+	}
+
+	rec = httptest.NewRecorder()
+	gateway.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/api/v1/users", strings.NewReader(`{"emial":"x"}`)))
+	if rec.Code != http.StatusBadRequest {
+		t.Errorf("unknown body field: status = %d, want %d", rec.Code, http.StatusBadRequest)
+	}
+}
+
+func TestGateway_OpenAPI(t *testing.T) {
+	gateway := newGateway(t, &stubUserService{})
+
+	rec := httptest.NewRecorder()
+	gateway.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, rest.OpenAPIPath, nil))
+
+	if rec.Code != http.StatusOK {
+		t.Fatalf("status = %d, want %d", rec.Code, http.StatusOK)
+	}
+
+	var doc struct {
+		Paths      map[string]map[string]any `json:"paths"`
+		Components struct {
+			Schemas map[string]any `json:"schemas"`
+		} `json:"components"`
+	}
+	if err := json.Unmarshal(rec.Body.Bytes(), &doc); err != nil {
+		t.Fatalf("invalid document: %v", err)
+	}
+	for path, methods := range map[string][]string{
+		"/api/v1/users":      {"get", "post"},
+		"/api/v1/users/{id}": {"get", "patch", "delete"},
+	} {
+		for _, method := range methods {
+			if _, ok := doc.Paths[path][method]; !ok {
+				t.Errorf("missing operation %s %s", method, path)
+			}
+		}
+	}
+	for _, schema := range []string{"user.v1.User", "user.v1.CreateUserRequest", "Error"} {
+		if _, ok := doc.Components.Schemas[schema]; !ok {
+			t.Errorf("missing schema %s", schema)
+		}
+	}
+}
+
+func TestNewGateway_InvalidRoute(t *testing.T) {
+	routes := []rest.Route{
+		{Method: http.MethodGet, Path: "/api/v1/users/{user_id}", Procedure: userv1connect.UserServiceGetUserProcedure},
+	}
+	if _, err := rest.NewGateway(routes, http.NotFoundHandler(), nil, slog.Default()); err == nil {
+		t.Error("NewGateway() error = nil, want error for unknown path field")
+	}
+}
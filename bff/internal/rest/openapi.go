@@ -0,0 +1,212 @@
+package rest
+
+import (
+	"slices"
+	"strings"
+
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// scalarWellKnownTypes maps well-known types whose JSON form is a single
+// value to their OpenAPI schema.
+var scalarWellKnownTypes = map[protoreflect.FullName]map[string]any{
+	"google.protobuf.Timestamp":   {"type": "string", "format": "date-time"},
+	"google.protobuf.Duration":    {"type": "string", "example": "1.5s"},
+	"google.protobuf.FieldMask":   {"type": "string"},
+	"google.protobuf.StringValue": {"type": "string"},
+	"google.protobuf.BytesValue":  {"type": "string", "format": "byte"},
+	"google.protobuf.BoolValue":   {"type": "boolean"},
+	"google.protobuf.Int32Value":  {"type": "integer", "format": "int32"},
+	"google.protobuf.UInt32Value": {"type": "integer", "format": "int64"},
+	"google.protobuf.Int64Value":  {"type": "string", "format": "int64"},
+	"google.protobuf.UInt64Value": {"type": "string", "format": "uint64"},
+	"google.protobuf.FloatValue":  {"type": "number", "format": "float"},
+	"google.protobuf.DoubleValue": {"type": "number", "format": "double"},
+}
+
+// jsonWellKnownTypes maps well-known types with free-form JSON values.
+var jsonWellKnownTypes = map[protoreflect.FullName]map[string]any{
+	"google.protobuf.Struct":    {"type": "object"},
+	"google.protobuf.Value":     {},
+	"google.protobuf.ListValue": {"type": "array", "items": map[string]any{}},
+	"google.protobuf.Any":       {"type": "object"},
+	"google.protobuf.Empty":     {"type": "object"},
+}
+
+// errorSchema is the Connect JSON error format.
+var errorSchema = map[string]any{
+	"type": "object",
+	"properties": map[string]any{
+		"code":    map[string]any{"type": "string", "example": "not_found"},
+		"message": map[string]any{"type": "string"},
+		"details": map[string]any{"type": "array", "items": map[string]any{"type": "object"}},
+	},
+}
+
+// buildOpenAPI describes routes as an OpenAPI 3.0 document. Schemas follow
+// the proto3 JSON mapping of the request and response messages.
+func buildOpenAPI(routes []*route) map[string]any {
+	schemas := map[string]any{"Error": errorSchema}
+	paths := map[string]any{}
+
+	for _, rt := range routes {
+		op := map[string]any{
+			"operationId": string(rt.method.Parent().Name()) + "_" + string(rt.method.Name()),
+			"tags":        []string{string(rt.method.Parent().Name())},
+			"responses": map[string]any{
+				"200": map[string]any{
+					"description": "OK",
+					"content":     jsonContent(messageRef(rt.method.Output(), schemas)),
+				},
+				"default": map[string]any{
+					"description": "Error",
+					"content":     jsonContent(map[string]any{"$ref": "#/components/schemas/Error"}),
+				},
+			},
+		}
+		if rt.Summary != "" {
+			op["summary"] = rt.Summary
+		}
+
+		var params []any
+		for _, fd := range rt.pathFields {
+			params = append(params, map[string]any{
+				"name":     string(fd.Name()),
+				"in":       "path",
+				"required": true,
+				"schema":   fieldSchema(fd, schemas),
+			})
+		}
+		if rt.Body {
+			op["requestBody"] = map[string]any{
+				"required": true,
+				"content":  jsonContent(messageRef(rt.method.Input(), schemas)),
+			}
+		} else {
+			fields := rt.method.Input().Fields()
+			for i := range fields.Len() {
+				fd := fields.Get(i)
+				if !queryBindable(fd) || slices.Contains(rt.pathFields, fd) {
+					continue
+				}
+				param := map[string]any{
+					"name":   fd.JSONName(),
+					"in":     "query",
+					"schema": fieldSchema(fd, schemas),
+				}
+				if fd.IsList() {
+					param["explode"] = true
+				}
+				params = append(params, param)
+			}
+		}
+		if len(params) > 0 {
+			op["parameters"] = params
+		}
+
+		item, ok := paths[rt.Path].(map[string]any)
+		if !ok {
+			item = map[string]any{}
+			paths[rt.Path] = item
+		}
+		item[strings.ToLower(rt.Method)] = op
+	}
+
+	return map[string]any{
+		"openapi": "3.0.3",
+		"info": map[string]any{
+			"title":   "EC Platform API",
+			"version": "v1",
+			"description": "REST mapping of the BFF's Connect API. Request and response " +
+				"bodies use the proto3 JSON mapping; 64-bit integers are strings.",
+		},
+		"paths": paths,
+		"components": map[string]any{
+			"schemas": schemas,
+			"securitySchemes": map[string]any{
+				"bearerAuth": map[string]any{"type": "http", "scheme": "bearer", "bearerFormat": "JWT"},
+			},
+		},
+		"security": []any{map[string]any{"bearerAuth": []string{}}},
+	}
+}
+
+func jsonContent(schema map[string]any) map[string]any {
+	return map[string]any{"application/json": map[string]any{"schema": schema}}
+}
+
+// messageRef returns a reference to the schema of md, adding it and the
+// messages it uses to schemas.
+func messageRef(md protoreflect.MessageDescriptor, schemas map[string]any) map[string]any {
+	if s, ok := scalarWellKnownTypes[md.FullName()]; ok {
+		return s
+	}
+	if s, ok := jsonWellKnownTypes[md.FullName()]; ok {
+		return s
+	}
+
+	name := string(md.FullName())
+	ref := map[string]any{"$ref": "#/components/schemas/" + name}
+	if _, ok := schemas[name]; ok {
+		return ref
+	}
+	// Register before recursing so self-referencing messages terminate.
+	schema := map[string]any{"type": "object"}
+	schemas[name] = schema
+
+	props := map[string]any{}
+	fields := md.Fields()
+	for i := range fields.Len() {
+		fd := fields.Get(i)
+		props[fd.JSONName()] = fieldSchema(fd, schemas)
+	}
+	if len(props) > 0 {
+		schema["properties"] = props
+	}
+	return ref
+}
+
+func fieldSchema(fd protoreflect.FieldDescriptor, schemas map[string]any) map[string]any {
+	if fd.IsMap() {
+		return map[string]any{
+			"type":                 "object",
+			"additionalProperties": valueSchema(fd.MapValue(), schemas),
+		}
+	}
+	if fd.IsList() {
+		return map[string]any{"type": "array", "items": valueSchema(fd, schemas)}
+	}
+	return valueSchema(fd, schemas)
+}
+
+// valueSchema returns the schema of a single value of fd.
+func valueSchema(fd protoreflect.FieldDescriptor, schemas map[string]any) map[string]any {
+	switch fd.Kind() {
+	case protoreflect.BoolKind:
+		return map[string]any{"type": "boolean"}
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind:
+		return map[string]any{"type": "integer", "format": "int32"}
+	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind:
+		return map[string]any{"type": "integer", "format": "int64", "minimum": 0}
+	case protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind:
+		return map[string]any{"type": "string", "format": "int64"}
+	case protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		return map[string]any{"type": "string", "format": "uint64"}
+	case protoreflect.FloatKind:
+		return map[string]any{"type": "number", "format": "float"}
+	case protoreflect.DoubleKind:
+		return map[string]any{"type": "number", "format": "double"}
+	case protoreflect.BytesKind:
+		return map[string]any{"type": "string", "format": "byte"}
+	case protoreflect.EnumKind:
+		values := fd.Enum().Values()
+		names := make([]string, values.Len())
+		for i := range values.Len() {
+			names[i] = string(values.Get(i).Name())
+		}
+		return map[string]any{"type": "string", "enum": names}
+	case protoreflect.MessageKind, protoreflect.GroupKind:
+		return messageRef(fd.Message(), schemas)
+	}
+	return map[string]any{"type": "string"}
+}
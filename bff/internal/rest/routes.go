@@ -0,0 +1,45 @@
+package rest
+
+import (
+	"net/http"
+
+	"github.com/daisuke8000/example-ec-platform/gen/storefront/v1/storefrontv1connect"
+	"github.com/daisuke8000/example-ec-platform/gen/user/v1/userv1connect"
+)
+
+// UserRoutes maps /api/v1/users to user.v1.UserService.
+var UserRoutes = []Route{
+	{Method: http.MethodPost, Path: "/api/v1/users", Procedure: userv1connect.UserServiceCreateUserProcedure, Body: true, Summary: "Register a user"},
+	{Method: http.MethodGet, Path: "/api/v1/users", Procedure: userv1connect.UserServiceListUsersProcedure, Summary: "List users (admin)"},
+	{Method: http.MethodGet, Path: "/api/v1/users/{id}", Procedure: userv1connect.UserServiceGetUserProcedure, Summary: "Get a user"},
+	{Method: http.MethodPatch, Path: "/api/v1/users/{id}", Procedure: userv1connect.UserServiceUpdateUserProcedure, Body: true, Summary: "Update a user's profile"},
+	{Method: http.MethodDelete, Path: "/api/v1/users/{id}", Procedure: userv1connect.UserServiceDeleteUserProcedure, Summary: "Delete a user"},
+	{Method: http.MethodPost, Path: "/api/v1/users/{id}/unlock", Procedure: userv1connect.UserServiceUnlockUserProcedure, Summary: "Lift a user's login lockout (admin)"},
+	{Method: http.MethodGet, Path: "/api/v1/users/{user_id}/connected-apps", Procedure: userv1connect.UserServiceListConnectedAppsProcedure, Summary: "List the apps the user granted access to (self only)"},
+	{Method: http.MethodDelete, Path: "/api/v1/users/{user_id}/connected-apps/{client_id}", Procedure: userv1connect.UserServiceRevokeConsentProcedure, Summary: "Revoke an app's access and tokens"},
+	{Method: http.MethodGet, Path: "/api/v1/users/{user_id}/sessions", Procedure: userv1connect.UserServiceListSessionsProcedure, Summary: "List the user's login sessions (self only)"},
+	{Method: http.MethodDelete, Path: "/api/v1/users/{user_id}/sessions/{session_id}", Procedure: userv1connect.UserServiceRevokeSessionProcedure, Summary: "Log out one of the user's sessions (self only)"},
+	{Method: http.MethodGet, Path: "/api/v1/users/{user_id}/login-history", Procedure: userv1connect.UserServiceGetLoginHistoryProcedure, Summary: "List the user's recent sign-in attempts (self only)"},
+	{Method: http.MethodGet, Path: "/api/v1/users/{user_id}/addresses", Procedure: userv1connect.UserServiceListAddressesProcedure, Summary: "List the user's addresses (self only)"},
+	{Method: http.MethodPost, Path: "/api/v1/users/{user_id}/addresses", Procedure: userv1connect.UserServiceAddAddressProcedure, Body: true, Summary: "Add an address (self only)"},
+	{Method: http.MethodPost, Path: "/api/v1/users/{user_id}/addresses/{address_id}/default-shipping", Procedure: userv1connect.UserServiceSetDefaultShippingAddressProcedure, Summary: "Make an address the default shipping address (self only)"},
+	{Method: http.MethodDelete, Path: "/api/v1/users/{user_id}/addresses/{address_id}", Procedure: userv1connect.UserServiceDeleteAddressProcedure, Summary: "Delete an address (self only)"},
+	{Method: http.MethodGet, Path: "/api/v1/users/{user_id}/two-factor", Procedure: userv1connect.UserServiceGetTwoFactorStatusProcedure, Summary: "Get the user's two-factor status (self only)"},
+	{Method: http.MethodPost, Path: "/api/v1/users/{user_id}/two-factor/totp", Procedure: userv1connect.UserServiceEnrollTOTPProcedure, Summary: "Start a TOTP enrollment (self only)"},
+	{Method: http.MethodPost, Path: "/api/v1/users/{user_id}/two-factor/totp/confirm", Procedure: userv1connect.UserServiceConfirmTOTPProcedure, Body: true, Summary: "Enable two-factor authentication with a first code (self only)"},
+	{Method: http.MethodPost, Path: "/api/v1/users/{user_id}/two-factor/totp/disable", Procedure: userv1connect.UserServiceDisableTOTPProcedure, Body: true, Summary: "Disable two-factor authentication (self only)"},
+	{Method: http.MethodPost, Path: "/api/v1/users/{user_id}/password", Procedure: userv1connect.UserServiceChangePasswordProcedure, Body: true, Summary: "Change password (self only)"},
+	{Method: http.MethodPost, Path: "/api/v1/users/{user_id}/verification-email", Procedure: userv1connect.UserServiceResendVerificationEmailProcedure, Summary: "Resend the email verification link (self only)"},
+}
+
+// StorefrontRoutes maps the catalog, /api/v1/me and wishlists to
+// storefront.v1.StorefrontService.
+var StorefrontRoutes = []Route{
+	{Method: http.MethodGet, Path: "/api/v1/products", Procedure: storefrontv1connect.StorefrontServiceListProductsProcedure, Summary: "List published products"},
+	{Method: http.MethodGet, Path: "/api/v1/products/{product_id}", Procedure: storefrontv1connect.StorefrontServiceGetProductPageProcedure, Summary: "Get a product with its category and stock"},
+	{Method: http.MethodGet, Path: "/api/v1/categories", Procedure: storefrontv1connect.StorefrontServiceListCategoriesProcedure, Summary: "List the category tree"},
+	{Method: http.MethodGet, Path: "/api/v1/me", Procedure: storefrontv1connect.StorefrontServiceGetMeProcedure, Summary: "Get the authenticated user"},
+	{Method: http.MethodGet, Path: "/api/v1/users/{user_id}/favorites", Procedure: storefrontv1connect.StorefrontServiceListFavoritesProcedure, Summary: "List the user's wishlist (self only)"},
+	{Method: http.MethodPost, Path: "/api/v1/users/{user_id}/favorites", Procedure: storefrontv1connect.StorefrontServiceAddFavoriteProcedure, Body: true, Summary: "Add a product to the wishlist (self only)"},
+	{Method: http.MethodDelete, Path: "/api/v1/users/{user_id}/favorites/{product_id}", Procedure: storefrontv1connect.StorefrontServiceRemoveFavoriteProcedure, Summary: "Remove a product from the wishlist (self only)"},
+}
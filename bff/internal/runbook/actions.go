@@ -0,0 +1,147 @@
+package runbook
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+
+	"connectrpc.com/connect"
+
+	productv1 "github.com/daisuke8000/example-ec-platform/gen/product/v1"
+	"github.com/daisuke8000/example-ec-platform/gen/product/v1/productv1connect"
+)
+
+// Names of the built-in actions.
+const (
+	ActionFlushCaches       = "flush-caches"
+	ActionRotateJWKS        = "rotate-jwks"
+	ActionListWorkers       = "list-workers"
+	ActionPauseWorker       = "pause-worker"
+	ActionResumeWorker      = "resume-worker"
+	ActionDrainReservations = "drain-reservations"
+)
+
+// FlushCaches empties the BFF's caches, keyed by name, and reports the
+// entries dropped from each.
+func FlushCaches(caches map[string]func() int) Action {
+	return func(context.Context, json.RawMessage) (map[string]any, error) {
+		flushed := make(map[string]any, len(caches))
+		for name, flush := range caches {
+			flushed[name] = flush()
+		}
+		return map[string]any{"flushed": flushed}, nil
+	}
+}
+
+// KeySet is the JWKS used to validate tokens.
+// *jwt.JWKSManager is the production implementation.
+type KeySet interface {
+	RefreshNow(ctx context.Context) error
+	GetKeyCount() int
+}
+
+// RotateJWKS fetches the JWKS now, e.g. after the issuer rotated its
+// signing keys, instead of waiting for the next scheduled refresh.
+func RotateJWKS(keys KeySet) Action {
+	return func(ctx context.Context, _ json.RawMessage) (map[string]any, error) {
+		if err := keys.RefreshNow(ctx); err != nil {
+			return nil, err
+		}
+		return map[string]any{"keys": keys.GetKeyCount()}, nil
+	}
+}
+
+// ListWorkers reports the Product Service's background workers.
+func ListWorkers(workers productv1connect.WorkerServiceClient) Action {
+	return func(ctx context.Context, _ json.RawMessage) (map[string]any, error) {
+		resp, err := workers.ListWorkers(ctx, connect.NewRequest(&productv1.ListWorkersRequest{}))
+		if err != nil {
+			return nil, err
+		}
+		list := make([]map[string]any, len(resp.Msg.GetWorkers()))
+		for i, w := range resp.Msg.GetWorkers() {
+			list[i] = workerDetails(w)
+		}
+		return map[string]any{"workers": list}, nil
+	}
+}
+
+// PauseWorker pauses a Product Service worker on every instance. The body
+// is {"name": ..., "reason": ...}.
+func PauseWorker(workers productv1connect.WorkerServiceClient) Action {
+	return func(ctx context.Context, params json.RawMessage) (map[string]any, error) {
+		var p struct {
+			Name   string `json:"name"`
+			Reason string `json:"reason"`
+		}
+		if err := decodeParams(params, &p); err != nil {
+			return nil, err
+		}
+		resp, err := workers.PauseWorker(ctx, connect.NewRequest(&productv1.PauseWorkerRequest{
+			Name:   p.Name,
+			Reason: p.Reason,
+		}))
+		if err != nil {
+			return nil, err
+		}
+		return map[string]any{"worker": workerDetails(resp.Msg.GetWorker())}, nil
+	}
+}
+
+// ResumeWorker resumes a paused Product Service worker. The body is
+// {"name": ...}.
+func ResumeWorker(workers productv1connect.WorkerServiceClient) Action {
+	return func(ctx context.Context, params json.RawMessage) (map[string]any, error) {
+		var p struct {
+			Name string `json:"name"`
+		}
+		if err := decodeParams(params, &p); err != nil {
+			return nil, err
+		}
+		resp, err := workers.ResumeWorker(ctx, connect.NewRequest(&productv1.ResumeWorkerRequest{Name: p.Name}))
+		if err != nil {
+			return nil, err
+		}
+		return map[string]any{"worker": workerDetails(resp.Msg.GetWorker())}, nil
+	}
+}
+
+// DrainReservations force-releases the pending reservations of a SKU. The
+// body is {"sku_id": ..., "reason": ...}; has_more in the result asks for
+// another run.
+func DrainReservations(inventory productv1connect.InventoryServiceClient) Action {
+	return func(ctx context.Context, params json.RawMessage) (map[string]any, error) {
+		var p struct {
+			SKUID  string `json:"sku_id"`
+			Reason string `json:"reason"`
+		}
+		if err := decodeParams(params, &p); err != nil {
+			return nil, err
+		}
+		if p.SKUID == "" {
+			return nil, errors.Join(errInvalidParams, errors.New("sku_id is required"))
+		}
+		resp, err := inventory.DrainSKUReservations(ctx, connect.NewRequest(&productv1.DrainSKUReservationsRequest{
+			SkuId:  p.SKUID,
+			Reason: p.Reason,
+		}))
+		if err != nil {
+			return nil, err
+		}
+		return map[string]any{
+			"released": resp.Msg.GetReleasedReservationIds(),
+			"skipped":  resp.Msg.GetSkipped(),
+			"has_more": resp.Msg.GetHasMore(),
+		}, nil
+	}
+}
+
+func workerDetails(w *productv1.Worker) map[string]any {
+	details := map[string]any{"name": w.GetName(), "paused": w.GetPaused()}
+	if w.GetPaused() {
+		details["pause_reason"] = w.GetPauseReason()
+		details["paused_by"] = w.GetPausedBy()
+		details["paused_at"] = w.GetPausedAt().AsTime()
+	}
+	return details
+}
@@ -0,0 +1,192 @@
+// Package runbook serves the admin endpoints on-call uses for common
+// incident actions, such as flushing caches or draining a SKU's
+// reservations, so they need no shell access to the pods. Every action is
+// run with POST {Prefix}{action}, requires the caller to be authorized, is
+// reported to the security audit trail as an admin.action event and
+// answers with a JSON Result.
+package runbook
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"log/slog"
+	"net"
+	"net/http"
+	"strings"
+
+	"connectrpc.com/connect"
+
+	"github.com/daisuke8000/example-ec-platform/bff/internal/siem"
+	pkgmw "github.com/daisuke8000/example-ec-platform/pkg/connect/middleware"
+)
+
+// Prefix is the path under which actions are served.
+const Prefix = "/admin/runbook/"
+
+// maxParamsBytes bounds the request body of an action.
+const maxParamsBytes = 64 << 10
+
+// errInvalidParams is returned by actions for a malformed request body.
+var errInvalidParams = errors.New("invalid parameters")
+
+// Action performs one runbook step. params is the request body, empty if
+// none was sent. The returned details are reported in the Result.
+type Action func(ctx context.Context, params json.RawMessage) (map[string]any, error)
+
+// EventSink receives the audit events of actions.
+// *siem.Shipper is the production implementation.
+type EventSink interface {
+	Emit(e siem.Event)
+}
+
+type Config struct {
+	// Authorize returns the caller's user ID if they may run actions.
+	Authorize func(r *http.Request) (userID string, ok bool)
+	// Events, if set, receives an admin.action event for every action run.
+	Events EventSink
+	// TrustedProxyHeader, if set, carries the client address.
+	TrustedProxyHeader string
+}
+
+// Result is the response of an action.
+type Result struct {
+	Action string `json:"action"`
+	// Outcome is "success" or "failure".
+	Outcome string         `json:"outcome"`
+	Details map[string]any `json:"details,omitempty"`
+	Error   string         `json:"error,omitempty"`
+}
+
+// Handler runs the registered actions.
+type Handler struct {
+	cfg     Config
+	actions map[string]Action
+	logger  *slog.Logger
+}
+
+func NewHandler(cfg Config, logger *slog.Logger) *Handler {
+	return &Handler{
+		cfg:     cfg,
+		actions: make(map[string]Action),
+		logger:  logger,
+	}
+}
+
+// Register serves action under name, e.g. "flush-caches".
+func (h *Handler) Register(name string, action Action) {
+	h.actions[name] = action
+}
+
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		w.Header().Set("Allow", http.MethodPost)
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	name := strings.TrimPrefix(r.URL.Path, Prefix)
+	action, ok := h.actions[name]
+	if !ok {
+		http.Error(w, "unknown action", http.StatusNotFound)
+		return
+	}
+	userID, ok := h.cfg.Authorize(r)
+	if !ok {
+		http.Error(w, "access denied", http.StatusForbidden)
+		return
+	}
+
+	params, err := io.ReadAll(io.LimitReader(r.Body, maxParamsBytes+1))
+	if err == nil && len(params) > maxParamsBytes {
+		err = errors.New("request body too large")
+	}
+	if err != nil {
+		h.respond(w, r, userID, name, nil, errors.Join(errInvalidParams, err))
+		return
+	}
+
+	// The caller is propagated so downstream services record them as the
+	// actor of the changes.
+	ctx := pkgmw.WithUserID(r.Context(), userID)
+	details, err := action(ctx, params)
+	h.respond(w, r, userID, name, details, err)
+}
+
+func (h *Handler) respond(w http.ResponseWriter, r *http.Request, userID, name string, details map[string]any, err error) {
+	result := Result{Action: name, Outcome: siem.OutcomeSuccess, Details: details}
+	status := http.StatusOK
+	e := siem.Event{
+		Type:     siem.EventAdminAction,
+		Severity: siem.SeverityNotice,
+		Outcome:  siem.OutcomeSuccess,
+		Actor: siem.Actor{
+			UserID:    userID,
+			IP:        clientIP(r, h.cfg.TrustedProxyHeader),
+			UserAgent: r.UserAgent(),
+		},
+		Target:     Prefix + name,
+		Attributes: map[string]string{"runbook_action": name},
+	}
+	if err != nil {
+		status = httpStatus(err)
+		result.Outcome = siem.OutcomeFailure
+		result.Error = err.Error()
+		e.Outcome = siem.OutcomeFailure
+		e.Reason = http.StatusText(status)
+		h.logger.Warn("runbook action failed", "action", name, "user_id", userID, "error", err)
+	} else {
+		h.logger.Info("runbook action run", "action", name, "user_id", userID)
+	}
+	if h.cfg.Events != nil {
+		h.cfg.Events.Emit(e)
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(result)
+}
+
+// httpStatus maps an action error to a response status. Errors of
+// downstream services keep their meaning; others are reported as 500.
+func httpStatus(err error) int {
+	if errors.Is(err, errInvalidParams) {
+		return http.StatusBadRequest
+	}
+	switch connect.CodeOf(err) {
+	case connect.CodeInvalidArgument:
+		return http.StatusBadRequest
+	case connect.CodeNotFound:
+		return http.StatusNotFound
+	case connect.CodeFailedPrecondition:
+		return http.StatusConflict
+	case connect.CodeUnavailable, connect.CodeDeadlineExceeded:
+		return http.StatusBadGateway
+	default:
+		return http.StatusInternalServerError
+	}
+}
+
+// decodeParams decodes the request body of an action into v.
+func decodeParams(params json.RawMessage, v any) error {
+	if len(params) == 0 {
+		return errors.Join(errInvalidParams, errors.New("request body is required"))
+	}
+	if err := json.Unmarshal(params, v); err != nil {
+		return errors.Join(errInvalidParams, err)
+	}
+	return nil
+}
+
+func clientIP(r *http.Request, trustedHeader string) string {
+	if trustedHeader != "" {
+		if ip := r.Header.Get(trustedHeader); ip != "" {
+			first, _, _ := strings.Cut(ip, ",")
+			return strings.TrimSpace(first)
+		}
+	}
+	if host, _, err := net.SplitHostPort(r.RemoteAddr); err == nil {
+		return host
+	}
+	return r.RemoteAddr
+}
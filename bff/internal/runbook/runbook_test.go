@@ -0,0 +1,177 @@
+package runbook
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"connectrpc.com/connect"
+
+	"github.com/daisuke8000/example-ec-platform/bff/internal/siem"
+	productv1 "github.com/daisuke8000/example-ec-platform/gen/product/v1"
+	"github.com/daisuke8000/example-ec-platform/gen/product/v1/productv1connect"
+	pkgmw "github.com/daisuke8000/example-ec-platform/pkg/connect/middleware"
+)
+
+type recordingSink struct {
+	events []siem.Event
+}
+
+func (s *recordingSink) Emit(e siem.Event) {
+	s.events = append(s.events, e)
+}
+
+type fakeInventory struct {
+	productv1connect.InventoryServiceClient
+	req    *productv1.DrainSKUReservationsRequest
+	userID string
+	err    error
+}
+
+func (f *fakeInventory) DrainSKUReservations(ctx context.Context, req *connect.Request[productv1.DrainSKUReservationsRequest]) (*connect.Response[productv1.DrainSKUReservationsResponse], error) {
+	f.req = req.Msg
+	f.userID = pkgmw.GetUserID(ctx)
+	if f.err != nil {
+		return nil, f.err
+	}
+	return connect.NewResponse(&productv1.DrainSKUReservationsResponse{
+		ReleasedReservationIds: []string{"r1", "r2"},
+		Skipped:                1,
+	}), nil
+}
+
+func newTestHandler(allow bool, events EventSink) *Handler {
+	return NewHandler(Config{
+		Authorize: func(*http.Request) (string, bool) { return "staff-1", allow },
+		Events:    events,
+	}, slog.New(slog.NewTextHandler(io.Discard, nil)))
+}
+
+func run(h http.Handler, method, action, body string) (*httptest.ResponseRecorder, Result) {
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, httptest.NewRequest(method, Prefix+action, strings.NewReader(body)))
+	var result Result
+	_ = json.Unmarshal(rec.Body.Bytes(), &result)
+	return rec, result
+}
+
+func TestHandler_Rejects(t *testing.T) {
+	tests := []struct {
+		name   string
+		allow  bool
+		method string
+		action string
+		want   int
+	}{
+		{"wrong method", true, http.MethodGet, ActionFlushCaches, http.StatusMethodNotAllowed},
+		{"unknown action", true, http.MethodPost, "reboot", http.StatusNotFound},
+		{"not authorized", false, http.MethodPost, ActionFlushCaches, http.StatusForbidden},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sink := &recordingSink{}
+			h := newTestHandler(tt.allow, sink)
+			h.Register(ActionFlushCaches, func(context.Context, json.RawMessage) (map[string]any, error) {
+				t.Fatal("action run")
+				return nil, nil
+			})
+
+			rec, _ := run(h, tt.method, tt.action, "")
+			if rec.Code != tt.want {
+				t.Errorf("status = %d, want %d", rec.Code, tt.want)
+			}
+			if len(sink.events) != 0 {
+				t.Errorf("events = %d, want 0", len(sink.events))
+			}
+		})
+	}
+}
+
+func TestHandler_FlushCaches(t *testing.T) {
+	sink := &recordingSink{}
+	h := newTestHandler(true, sink)
+	h.Register(ActionFlushCaches, FlushCaches(map[string]func() int{"product": func() int { return 3 }}))
+
+	rec, result := run(h, http.MethodPost, ActionFlushCaches, "")
+	if rec.Code != http.StatusOK {
+		t.Fatalf("status = %d, want %d", rec.Code, http.StatusOK)
+	}
+	if result.Outcome != siem.OutcomeSuccess || result.Action != ActionFlushCaches {
+		t.Errorf("result = %+v", result)
+	}
+	flushed, _ := result.Details["flushed"].(map[string]any)
+	if flushed["product"] != float64(3) {
+		t.Errorf("flushed = %v, want product: 3", result.Details["flushed"])
+	}
+
+	if len(sink.events) != 1 {
+		t.Fatalf("events = %d, want 1", len(sink.events))
+	}
+	e := sink.events[0]
+	if e.Type != siem.EventAdminAction || e.Actor.UserID != "staff-1" || e.Outcome != siem.OutcomeSuccess {
+		t.Errorf("event = %+v", e)
+	}
+	if e.Attributes["runbook_action"] != ActionFlushCaches {
+		t.Errorf("attributes = %v", e.Attributes)
+	}
+}
+
+func TestHandler_DrainReservations(t *testing.T) {
+	inventory := &fakeInventory{}
+	h := newTestHandler(true, nil)
+	h.Register(ActionDrainReservations, DrainReservations(inventory))
+
+	rec, result := run(h, http.MethodPost, ActionDrainReservations, `{"sku_id":"sku-1","reason":"INC-42"}`)
+	if rec.Code != http.StatusOK {
+		t.Fatalf("status = %d, want %d: %s", rec.Code, http.StatusOK, rec.Body)
+	}
+	if inventory.req.GetSkuId() != "sku-1" || inventory.req.GetReason() != "INC-42" {
+		t.Errorf("request = %v", inventory.req)
+	}
+	if inventory.userID != "staff-1" {
+		t.Errorf("propagated user ID = %q, want staff-1", inventory.userID)
+	}
+	if result.Details["skipped"] != float64(1) || result.Details["has_more"] != false {
+		t.Errorf("details = %v", result.Details)
+	}
+}
+
+func TestHandler_ActionErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		body string
+		err  error
+		want int
+	}{
+		{"missing body", "", nil, http.StatusBadRequest},
+		{"malformed body", "{", nil, http.StatusBadRequest},
+		{"missing sku", `{"reason":"INC-42"}`, nil, http.StatusBadRequest},
+		{"invalid argument", `{"sku_id":"x"}`, connect.NewError(connect.CodeInvalidArgument, errors.New("bad sku")), http.StatusBadRequest},
+		{"not found", `{"sku_id":"x","reason":"r"}`, connect.NewError(connect.CodeNotFound, errors.New("no sku")), http.StatusNotFound},
+		{"unavailable", `{"sku_id":"x","reason":"r"}`, connect.NewError(connect.CodeUnavailable, errors.New("down")), http.StatusBadGateway},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sink := &recordingSink{}
+			h := newTestHandler(true, sink)
+			h.Register(ActionDrainReservations, DrainReservations(&fakeInventory{err: tt.err}))
+
+			rec, result := run(h, http.MethodPost, ActionDrainReservations, tt.body)
+			if rec.Code != tt.want {
+				t.Errorf("status = %d, want %d", rec.Code, tt.want)
+			}
+			if result.Outcome != siem.OutcomeFailure || result.Error == "" {
+				t.Errorf("result = %+v", result)
+			}
+			if len(sink.events) != 1 || sink.events[0].Outcome != siem.OutcomeFailure {
+				t.Errorf("events = %+v, want one failure", sink.events)
+			}
+		})
+	}
+}
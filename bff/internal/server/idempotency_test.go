@@ -0,0 +1,176 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"sync"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+
+	"github.com/daisuke8000/example-ec-platform/bff/internal/idempotency"
+	userv1 "github.com/daisuke8000/example-ec-platform/gen/user/v1"
+	"github.com/daisuke8000/example-ec-platform/gen/user/v1/userv1connect"
+	pkgmw "github.com/daisuke8000/example-ec-platform/pkg/connect/middleware"
+)
+
+// memoryIdempotencyStore is an in-memory IdempotencyStore for tests.
+type memoryIdempotencyStore struct {
+	mu     sync.Mutex
+	values map[string]string
+}
+
+func (s *memoryIdempotencyStore) Get(_ context.Context, key string) (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	v, ok := s.values[key]
+	if !ok {
+		return "", idempotency.ErrKeyNotFound
+	}
+	return v, nil
+}
+
+func (s *memoryIdempotencyStore) SetNX(_ context.Context, key, value string, _ time.Duration) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.values[key]; ok {
+		return false, nil
+	}
+	s.values[key] = value
+	return true, nil
+}
+
+func (s *memoryIdempotencyStore) Set(_ context.Context, key, value string, _ time.Duration) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.values[key] = value
+	return nil
+}
+
+func (s *memoryIdempotencyStore) Del(_ context.Context, key string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.values, key)
+	return nil
+}
+
+// failingSetStore is a store that cannot save responses.
+type failingSetStore struct {
+	*memoryIdempotencyStore
+}
+
+func (s failingSetStore) Set(context.Context, string, string, time.Duration) error {
+	return errors.New("connection reset")
+}
+
+// countingUserService counts CreateUser executions.
+type countingUserService struct {
+	userv1connect.UnimplementedUserServiceHandler
+	mu    sync.Mutex
+	calls int
+}
+
+func (s *countingUserService) CreateUser(_ context.Context, req *connect.Request[userv1.CreateUserRequest]) (*connect.Response[userv1.CreateUserResponse], error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.calls++
+	return connect.NewResponse(&userv1.CreateUserResponse{
+		User: &userv1.User{Id: "user-1", Email: req.Msg.GetEmail()},
+	}), nil
+}
+
+func TestIdempotencyInterceptor_ReplaysResponse(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(os.Stdout, &slog.HandlerOptions{Level: slog.LevelError}))
+	store := &memoryIdempotencyStore{values: make(map[string]string)}
+	svc := &countingUserService{}
+
+	mux := http.NewServeMux()
+	mux.Handle(userv1connect.NewUserServiceHandler(svc,
+		connect.WithInterceptors(pkgmw.IdempotencyInterceptor(store, time.Hour, pkgmw.HandlerResponseTypes(svc), logger)),
+	))
+	srv := httptest.NewServer(mux)
+	defer srv.Close()
+
+	client := userv1connect.NewUserServiceClient(srv.Client(), srv.URL)
+
+	newRequest := func(key, email string) *connect.Request[userv1.CreateUserRequest] {
+		req := connect.NewRequest(&userv1.CreateUserRequest{Email: email, Password: "password123"})
+		if key != "" {
+			req.Header().Set(pkgmw.IdempotencyKeyHeader, key)
+		}
+		return req
+	}
+
+	first, err := client.CreateUser(context.Background(), newRequest("key-1", "a@example.com"))
+	if err != nil {
+		t.Fatalf("first call: unexpected error: %v", err)
+	}
+	if first.Header().Get(pkgmw.IdempotentReplayedHeader) != "" {
+		t.Error("first call should not be marked as replayed")
+	}
+
+	t.Run("retry with same key is replayed", func(t *testing.T) {
+		resp, err := client.CreateUser(context.Background(), newRequest("key-1", "a@example.com"))
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if resp.Header().Get(pkgmw.IdempotentReplayedHeader) != "true" {
+			t.Error("expected replayed response header")
+		}
+		if resp.Msg.GetUser().GetEmail() != "a@example.com" {
+			t.Errorf("expected replayed email a@example.com, got %s", resp.Msg.GetUser().GetEmail())
+		}
+		if svc.calls != 1 {
+			t.Errorf("expected handler to run once, ran %d times", svc.calls)
+		}
+	})
+
+	t.Run("same key with different request is rejected", func(t *testing.T) {
+		_, err := client.CreateUser(context.Background(), newRequest("key-1", "b@example.com"))
+		if connect.CodeOf(err) != connect.CodeInvalidArgument {
+			t.Errorf("expected CodeInvalidArgument, got %v", connect.CodeOf(err))
+		}
+	})
+
+	t.Run("requests without a key are not deduplicated", func(t *testing.T) {
+		before := svc.calls
+		for i := 0; i < 2; i++ {
+			if _, err := client.CreateUser(context.Background(), newRequest("", "c@example.com")); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+		}
+		if svc.calls != before+2 {
+			t.Errorf("expected 2 handler calls, got %d", svc.calls-before)
+		}
+	})
+}
+
+func TestIdempotencyInterceptor_ReleasesKeyWhenSaveFails(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(os.Stdout, &slog.HandlerOptions{Level: slog.LevelError}))
+	store := failingSetStore{&memoryIdempotencyStore{values: make(map[string]string)}}
+	svc := &countingUserService{}
+
+	mux := http.NewServeMux()
+	mux.Handle(userv1connect.NewUserServiceHandler(svc,
+		connect.WithInterceptors(pkgmw.IdempotencyInterceptor(store, time.Hour, pkgmw.HandlerResponseTypes(svc), logger)),
+	))
+	srv := httptest.NewServer(mux)
+	defer srv.Close()
+
+	client := userv1connect.NewUserServiceClient(srv.Client(), srv.URL)
+	for i := 0; i < 2; i++ {
+		req := connect.NewRequest(&userv1.CreateUserRequest{Email: "a@example.com", Password: "password123"})
+		req.Header().Set(pkgmw.IdempotencyKeyHeader, "key-1")
+		if _, err := client.CreateUser(context.Background(), req); err != nil {
+			t.Fatalf("call %d: unexpected error: %v", i+1, err)
+		}
+	}
+	if svc.calls != 2 {
+		t.Errorf("expected the retry to run the handler again, ran %d times", svc.calls)
+	}
+}
@@ -2,21 +2,46 @@ package server
 
 import (
 	"context"
+	"crypto/tls"
 	"errors"
 	"fmt"
 	"log/slog"
 	"net/http"
+	"os"
+	"slices"
+	"strings"
+	"time"
 
 	"connectrpc.com/connect"
+	"github.com/redis/go-redis/v9"
 
 	"github.com/daisuke8000/example-ec-platform/bff/internal/authz"
+	"github.com/daisuke8000/example-ec-platform/bff/internal/capability"
+	"github.com/daisuke8000/example-ec-platform/bff/internal/capture"
 	"github.com/daisuke8000/example-ec-platform/bff/internal/client"
 	"github.com/daisuke8000/example-ec-platform/bff/internal/config"
+	"github.com/daisuke8000/example-ec-platform/bff/internal/denylist"
+	"github.com/daisuke8000/example-ec-platform/bff/internal/errmsg"
+	"github.com/daisuke8000/example-ec-platform/bff/internal/graphql"
 	"github.com/daisuke8000/example-ec-platform/bff/internal/handler"
+	"github.com/daisuke8000/example-ec-platform/bff/internal/health"
+	"github.com/daisuke8000/example-ec-platform/bff/internal/idempotency"
 	"github.com/daisuke8000/example-ec-platform/bff/internal/jwt"
+	"github.com/daisuke8000/example-ec-platform/bff/internal/loadshed"
 	"github.com/daisuke8000/example-ec-platform/bff/internal/middleware"
+	"github.com/daisuke8000/example-ec-platform/bff/internal/mock"
 	"github.com/daisuke8000/example-ec-platform/bff/internal/observability"
+	"github.com/daisuke8000/example-ec-platform/bff/internal/productcache"
+	"github.com/daisuke8000/example-ec-platform/bff/internal/quota"
+	"github.com/daisuke8000/example-ec-platform/bff/internal/rest"
+	"github.com/daisuke8000/example-ec-platform/bff/internal/runbook"
+	"github.com/daisuke8000/example-ec-platform/bff/internal/siem"
+	"github.com/daisuke8000/example-ec-platform/bff/internal/stockdisplay"
+	"github.com/daisuke8000/example-ec-platform/gen/storefront/v1/storefrontv1connect"
 	"github.com/daisuke8000/example-ec-platform/gen/user/v1/userv1connect"
+	pkgmw "github.com/daisuke8000/example-ec-platform/pkg/connect/middleware"
+	pkgobs "github.com/daisuke8000/example-ec-platform/pkg/observability"
+	"github.com/daisuke8000/example-ec-platform/pkg/watchdog"
 
 	"go.opentelemetry.io/otel/metric"
 )
@@ -24,43 +49,104 @@ import (
 type Dependencies struct {
 	Config        *config.Config
 	JWKSManager   *jwt.JWKSManager
-	Validator     *jwt.Validator
+	Validator     middleware.TokenValidator
 	RateLimiter   *middleware.RateLimiter
 	PublicMatcher *middleware.PublicEndpointMatcher
 	Metrics       *observability.AuthMetrics
+	SLOMetrics    *observability.SLOMetrics
+	RPCMetrics    *pkgobs.RPCMetrics
 
 	// Backend service clients
 	UserServiceClient userv1connect.UserServiceClient
 
+	// User Service capabilities (nil when negotiation is disabled)
+	UserCapabilities *capability.Registry
+
 	// Authorization
 	Authorizer *authz.Authorizer
 
+	// Localized messages for coded backend errors
+	ErrorMessages *errmsg.Catalog
+
+	// Expiring access grants (nil when disabled)
+	AccessGrants *authz.Grants
+
+	// Per-user quotas (nil when no limits are configured)
+	QuotaLimiter *quota.Limiter
+
+	// Request capture for replay (nil when disabled)
+	CaptureRecorder *capture.Recorder
+
+	// Security event forwarding (nil when disabled)
+	SIEMShipper *siem.Shipper
+
+	// Adaptive concurrency limit (nil when load shedding is disabled)
+	LoadShedder *loadshed.Limiter
+
+	// Memory and goroutine watchdog (nil when no limit is set); started by
+	// the caller once shutdown signals are handled
+	Watchdog *watchdog.Watchdog
+
+	// Idempotency-Key replay store (nil when disabled)
+	IdempotencyStore pkgmw.IdempotencyStore
+	redisClient      *redis.Client
+
+	// Aggregated /ready check
+	ReadinessChecker *health.ReadinessChecker
+
 	// Handlers
 	UserHandler *handler.UserServiceProxy
+
+	// StorefrontHandler is nil when PRODUCT_SERVICE_URL is unset or in mock mode.
+	StorefrontHandler *handler.StorefrontHandler
+
+	// Storefront product read cache (nil when disabled or without the
+	// Product Service)
+	ProductCache        *productcache.Cache
+	productCacheMetrics *observability.ProductCacheMetrics
+
+	// Access tokens revoked by the User Service (nil when disabled)
+	Denylist *denylist.List
+
+	// Product Service clients used by the runbook actions (nil without the
+	// Product Service)
+	productClients *client.ProductServiceClients
 }
 
 func NewDependencies(ctx context.Context, cfg *config.Config, meter metric.Meter) (*Dependencies, error) {
-	if cfg.JWT.IssuerURL == "" || cfg.JWT.Audience == "" {
-		return nil, errors.New("missing required JWT configuration")
-	}
-	if cfg.JWKS.URL == "" {
-		return nil, errors.New("missing required JWKS URL")
+	if cfg.Server.MockMode {
+		slog.Warn("mock mode enabled: serving fake backend data and accepting mock tokens")
+	} else {
+		if cfg.JWT.IssuerURL == "" || cfg.JWT.Audience == "" {
+			return nil, errors.New("missing required JWT configuration")
+		}
+		if cfg.JWKS.URL == "" {
+			return nil, errors.New("missing required JWKS URL")
+		}
 	}
 
-	jwksManager, err := jwt.NewJWKSManager(ctx, jwt.JWKSConfig{
-		URL:                cfg.JWKS.URL,
-		RefreshInterval:    cfg.JWKS.RefreshInterval,
-		MinRefreshInterval: cfg.JWKS.MinRefreshInterval,
-	})
-	if err != nil {
-		return nil, fmt.Errorf("failed to initialize JWKS manager: %w", err)
-	}
+	// In mock mode Hydra is not contacted and jwksManager stays nil.
+	var jwksManager *jwt.JWKSManager
+	var validator middleware.TokenValidator
+	if cfg.Server.MockMode {
+		validator = mock.NewValidator()
+	} else {
+		var err error
+		jwksManager, err = jwt.NewJWKSManager(ctx, jwt.JWKSConfig{
+			URL:                cfg.JWKS.URL,
+			RefreshInterval:    cfg.JWKS.RefreshInterval,
+			MinRefreshInterval: cfg.JWKS.MinRefreshInterval,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to initialize JWKS manager: %w", err)
+		}
 
-	validator := jwt.NewValidator(jwt.ValidatorConfig{
-		Issuer:    cfg.JWT.IssuerURL,
-		Audience:  cfg.JWT.Audience,
-		ClockSkew: cfg.JWT.ClockSkew,
-	}, jwksManager)
+		validator = jwt.NewValidator(jwt.ValidatorConfig{
+			Issuer:    cfg.JWT.IssuerURL,
+			Audience:  cfg.JWT.Audience,
+			ClockSkew: cfg.JWT.ClockSkew,
+		}, jwksManager)
+	}
 
 	rateLimiter := middleware.NewRateLimiter(middleware.RateLimitConfig{
 		FailureThreshold: cfg.RateLimit.FailureThreshold,
@@ -71,34 +157,302 @@ func NewDependencies(ctx context.Context, cfg *config.Config, meter metric.Meter
 	var success bool
 	defer func() {
 		if !success {
-			jwksManager.Close()
+			if jwksManager != nil {
+				jwksManager.Close()
+			}
 			rateLimiter.Close()
 		}
 	}()
 
 	publicMatcher := middleware.NewPublicEndpointMatcher(cfg.GetPublicEndpoints())
 
+	var err error
 	var metrics *observability.AuthMetrics
 	if meter != nil {
 		metrics, err = observability.NewAuthMetrics(meter)
 		if err != nil {
 			return nil, fmt.Errorf("failed to initialize metrics: %w", err)
 		}
-		metrics.SetDependencyStatus("hydra", jwksManager.IsHealthy())
+		if jwksManager != nil {
+			metrics.SetDependencyStatus("hydra", jwksManager.IsHealthy())
+		}
+	}
+
+	var sloMetrics *observability.SLOMetrics
+	if meter != nil {
+		sloObjectives, err := cfg.GetSLOObjectives()
+		if err != nil {
+			return nil, fmt.Errorf("invalid SLO objectives: %w", err)
+		}
+		overrides := make(map[string]observability.SLOObjective, len(sloObjectives))
+		for procedure, obj := range sloObjectives {
+			overrides[procedure] = observability.SLOObjective{
+				Availability: obj.Availability,
+				Latency:      obj.Latency,
+			}
+		}
+		sloMetrics, err = observability.NewSLOMetrics(meter, observability.SLOConfig{
+			Defaults: observability.SLOObjective{
+				Availability: cfg.SLO.DefaultAvailability,
+				Latency:      cfg.SLO.DefaultLatency,
+			},
+			LatencyTarget: cfg.SLO.LatencyTarget,
+			Overrides:     overrides,
+			Region:        cfg.Server.Region,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to initialize SLO metrics: %w", err)
+		}
+	}
+
+	var rpcMetrics *pkgobs.RPCMetrics
+	if meter != nil {
+		rpcMetrics, err = pkgobs.NewRPCMetrics(meter)
+		if err != nil {
+			return nil, fmt.Errorf("failed to initialize RPC metrics: %w", err)
+		}
+	}
+
+	// Initialize backend circuit breakers (optional)
+	var userBreaker, productBreaker *client.CircuitBreaker
+	if cfg.CircuitBreaker.Enabled && !cfg.Server.MockMode {
+		var breakerMetrics *observability.BreakerMetrics
+		if meter != nil {
+			breakerMetrics, err = observability.NewBreakerMetrics(meter)
+			if err != nil {
+				return nil, fmt.Errorf("failed to initialize circuit breaker metrics: %w", err)
+			}
+		}
+		userBreaker = newCircuitBreaker(cfg, "user-service", breakerMetrics)
+		if cfg.Backend.ProductServiceURL != "" {
+			productBreaker = newCircuitBreaker(cfg, "product-service", breakerMetrics)
+		}
+	}
+
+	// Initialize canary routing (optional)
+	var userCanary, productCanary *client.CanaryRouter
+	if cfg.Canary.UserServiceURL != "" || cfg.Canary.ProductServiceURL != "" {
+		var canaryMetrics *observability.CanaryMetrics
+		if meter != nil {
+			canaryMetrics, err = observability.NewCanaryMetrics(meter)
+			if err != nil {
+				return nil, fmt.Errorf("failed to initialize canary metrics: %w", err)
+			}
+		}
+		if cfg.Canary.UserServiceURL != "" {
+			userCanary, err = newCanaryRouter("user-service", cfg.Canary.UserServiceURL, cfg.Canary.UserServiceWeight, canaryMetrics)
+			if err != nil {
+				return nil, err
+			}
+		}
+		if cfg.Canary.ProductServiceURL != "" {
+			productCanary, err = newCanaryRouter("product-service", cfg.Canary.ProductServiceURL, cfg.Canary.ProductServiceWeight, canaryMetrics)
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	// Initialize backend service authentication (optional)
+	backendAuth, err := newBackendAuth(cfg)
+	if err != nil {
+		return nil, err
 	}
 
 	// Initialize backend service clients
-	userServiceClient := client.NewUserServiceClient(client.UserClientConfig{
-		BaseURL: cfg.Backend.UserServiceURL,
-		Timeout: cfg.Backend.RequestTimeout,
-	})
+	userServiceClient, err := newUserServiceClient(cfg, userBreaker, userCanary, backendAuth)
+	if err != nil {
+		return nil, err
+	}
+	productClients, err := newProductServiceClients(cfg, productBreaker, productCanary, backendAuth)
+	if err != nil {
+		return nil, err
+	}
+
+	// Initialize capability negotiation (optional)
+	var userCapabilities *capability.Registry
+	if cfg.Capability.Enabled {
+		userCapabilities = capability.NewRegistry(
+			capability.UserServiceFetcher(userServiceClient),
+			capability.Config{
+				Service:         userv1connect.UserServiceName,
+				RefreshInterval: cfg.Capability.RefreshInterval,
+				FetchTimeout:    cfg.Backend.RequestTimeout,
+				FieldGates:      capability.UserServiceFieldGates(),
+			},
+			slog.Default().With("component", "user-capabilities"),
+		)
+		userCapabilities.Start(ctx)
+		defer func() {
+			if !success {
+				userCapabilities.Close()
+			}
+		}()
+	}
 
-	// Initialize authorization
-	authorizer := authz.NewAuthorizer()
+	// Initialize authorization (config entries override the defaults)
+	methodPermissions, err := cfg.GetMethodPermissions()
+	if err != nil {
+		return nil, fmt.Errorf("invalid RBAC policy: %w", err)
+	}
+	policy := authz.DefaultPolicy()
+	for procedure, permission := range methodPermissions {
+		policy[procedure] = permission
+	}
+	authorizer := authz.NewAuthorizer(policy)
+
+	errorMessages, err := errmsg.Load()
+	if err != nil {
+		return nil, fmt.Errorf("failed to load error message catalogs: %w", err)
+	}
+
+	var accessGrants *authz.Grants
+	if cfg.AccessGrant.Enabled {
+		accessGrants = authz.NewGrants(
+			authz.NewUserServiceGrantFetcher(userServiceClient),
+			cfg.AccessGrant.CacheTTL,
+			slog.Default().With("component", "access-grants"),
+		)
+	}
 
 	// Initialize handlers
 	logger := slog.Default()
+
+	// Initialize Idempotency-Key replay (optional)
+	var redisClient *redis.Client
+	var idempotencyStore pkgmw.IdempotencyStore
+	if cfg.Idempotency.RedisURL != "" {
+		redisOpts, err := redis.ParseURL(cfg.Idempotency.RedisURL)
+		if err != nil {
+			return nil, fmt.Errorf("invalid REDIS_URL: %w", err)
+		}
+		redisClient = redis.NewClient(redisOpts)
+		if err := redisClient.Ping(ctx).Err(); err != nil {
+			logger.Warn("failed to connect to Redis, idempotency disabled", slog.String("error", err.Error()))
+			redisClient.Close()
+			redisClient = nil
+		} else {
+			idempotencyStore = idempotency.NewRedisStore(redisClient, "bff:idempotency:")
+		}
+	}
+
+	// Initialize per-user quotas (optional, shares the Redis client)
+	var quotaLimiter *quota.Limiter
+	quotaLimits, err := cfg.GetQuotaLimits()
+	if err != nil {
+		return nil, fmt.Errorf("invalid quota limits: %w", err)
+	}
+	if len(quotaLimits) > 0 {
+		if redisClient == nil {
+			if !cfg.Quota.FailOpen {
+				return nil, errors.New("quota limits require Redis, which is unavailable")
+			}
+			logger.Warn("Redis unavailable, quota limits disabled")
+		} else {
+			limits := make(map[string]quota.Limit, len(quotaLimits))
+			for procedure, limit := range quotaLimits {
+				limits[procedure] = quota.Limit{Requests: limit.Requests, Window: limit.Window}
+			}
+			quotaLimiter = quota.NewLimiter(
+				quota.NewRedisCounter(redisClient, "bff:quota:"),
+				limits,
+				cfg.Quota.FailOpen,
+				cfg.Quota.HeaderThreshold,
+				logger.With("component", "quota"),
+			)
+		}
+	}
+
+	// Initialize request capture (optional)
+	var captureRecorder *capture.Recorder
+	if cfg.Capture.Enabled {
+		captureStore, err := capture.NewFileStore(cfg.Capture.Dir)
+		if err != nil {
+			return nil, err
+		}
+		captureRecorder = capture.NewRecorder(captureStore, capture.Config{
+			UserIDs:        cfg.GetCaptureUserIDs(),
+			Procedures:     cfg.GetCaptureProcedures(),
+			RequireConsent: cfg.Capture.RequireConsent,
+		}, logger.With("component", "capture"))
+		logger.Warn("request capture enabled",
+			slog.String("dir", cfg.Capture.Dir),
+			slog.Bool("require_consent", cfg.Capture.RequireConsent),
+		)
+	}
+
+	// Initialize security event forwarding (optional)
+	var siemShipper *siem.Shipper
+	if cfg.SIEM.Enabled {
+		siemShipper, err = newSIEMShipper(cfg, meter)
+		if err != nil {
+			return nil, err
+		}
+		siemShipper.Start()
+	}
+
+	// Initialize load shedding (optional)
+	var loadShedder *loadshed.Limiter
+	if cfg.LoadShed.Enabled {
+		loadShedder, err = newLoadShedder(cfg, meter)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// Initialize memory and goroutine watchdog (optional)
+	var wd *watchdog.Watchdog
+	if cfg.Watchdog.Enabled() {
+		wd, err = newWatchdog(cfg, meter)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	userHandler := handler.NewUserServiceProxy(userServiceClient, authorizer, logger)
+	var storefrontHandler *handler.StorefrontHandler
+	var productCache *productcache.Cache
+	var productCacheMetrics *observability.ProductCacheMetrics
+	if productClients != nil {
+		if cfg.ProductCache.Enabled {
+			productCache, productCacheMetrics, err = newProductCache(cfg, meter)
+			if err != nil {
+				return nil, err
+			}
+			productClients.Products = productCache.Products(productClients.Products)
+			productClients.Inventory = productCache.Inventory(productClients.Inventory)
+		}
+		stockDisplay := stockdisplay.NewPolicy(
+			int64(cfg.StockDisplay.LowStockThreshold),
+			int64(cfg.StockDisplay.MaxQuantity),
+			cfg.GetStockDisplayHiddenCategories(),
+		)
+		storefrontHandler = handler.NewStorefrontHandler(
+			productClients.Products,
+			productClients.Inventory,
+			productClients.Favorites,
+			userServiceClient,
+			authorizer,
+			stockDisplay,
+			logger,
+		)
+	}
+
+	var tokenDenylist *denylist.List
+	if cfg.TokenDenylist.Enabled {
+		tokenDenylist = denylist.New(cfg.TokenDenylist.TTL)
+	}
+
+	localChecks := map[string]func() bool{}
+	if jwksManager != nil {
+		localChecks["jwks"] = jwksManager.IsHealthy
+	}
+	readinessChecker := health.NewReadinessChecker(health.ReadinessConfig{
+		Backends: readinessBackends(cfg),
+		Timeout:  cfg.Readiness.ProbeTimeout,
+		CacheTTL: cfg.Readiness.CacheTTL,
+		TLS:      backendAuth.tls,
+	}, localChecks)
 
 	success = true
 	return &Dependencies{
@@ -108,12 +462,339 @@ func NewDependencies(ctx context.Context, cfg *config.Config, meter metric.Meter
 		RateLimiter:       rateLimiter,
 		PublicMatcher:     publicMatcher,
 		Metrics:           metrics,
+		SLOMetrics:        sloMetrics,
+		RPCMetrics:        rpcMetrics,
 		UserServiceClient: userServiceClient,
+		UserCapabilities:  userCapabilities,
 		Authorizer:        authorizer,
+		ErrorMessages:     errorMessages,
+		AccessGrants:      accessGrants,
+		IdempotencyStore:  idempotencyStore,
+		QuotaLimiter:      quotaLimiter,
+		CaptureRecorder:   captureRecorder,
+		SIEMShipper:       siemShipper,
+		LoadShedder:       loadShedder,
+		Watchdog:          wd,
+		redisClient:       redisClient,
 		UserHandler:       userHandler,
+		StorefrontHandler: storefrontHandler,
+		ProductCache:      productCache,
+		Denylist:          tokenDenylist,
+		ReadinessChecker:  readinessChecker,
+
+		productCacheMetrics: productCacheMetrics,
+		productClients:      productClients,
 	}, nil
 }
 
+// newCircuitBreaker returns a circuit breaker for the named backend that logs
+// transitions and, when metrics is non-nil, exports its state.
+func newCircuitBreaker(cfg *config.Config, name string, metrics *observability.BreakerMetrics) *client.CircuitBreaker {
+	logger := slog.Default().With("component", "circuit-breaker")
+	breaker := client.NewCircuitBreaker(client.BreakerConfig{
+		Name:             name,
+		FailureThreshold: cfg.CircuitBreaker.FailureThreshold,
+		OpenTimeout:      cfg.CircuitBreaker.OpenTimeout,
+		HalfOpenProbes:   cfg.CircuitBreaker.HalfOpenProbes,
+		OnStateChange: func(name string, from, to client.BreakerState) {
+			logger.Warn("circuit breaker state changed",
+				slog.String("backend", name),
+				slog.String("from", from.String()),
+				slog.String("to", to.String()),
+			)
+			if metrics != nil {
+				metrics.RecordTransition(context.Background(), name, from.String(), to.String())
+			}
+		},
+	})
+	if metrics != nil {
+		metrics.Track(name, func() int64 { return int64(breaker.State()) })
+	}
+	return breaker
+}
+
+// newCanaryRouter returns a canary router for the named backend that, when
+// metrics is non-nil, exports per-target call outcomes.
+func newCanaryRouter(name, rawURL string, weight int, metrics *observability.CanaryMetrics) (*client.CanaryRouter, error) {
+	canaryCfg := client.CanaryConfig{Name: name, URL: rawURL, Weight: weight}
+	if metrics != nil {
+		canaryCfg.OnCall = func(ctx context.Context, name string, target client.Target, code string, duration time.Duration) {
+			metrics.RecordCall(ctx, name, string(target), code, duration)
+		}
+	}
+	router, err := client.NewCanaryRouter(canaryCfg)
+	if err != nil {
+		return nil, fmt.Errorf("failed to initialize %s canary routing: %w", name, err)
+	}
+	return router, nil
+}
+
+// newSIEMShipper returns a shipper for the configured SIEM sink that, when
+// meter is non-nil, exports shipping results and queue depth.
+func newSIEMShipper(cfg *config.Config, meter metric.Meter) (*siem.Shipper, error) {
+	var sink siem.Sink
+	if cfg.SIEM.Sink == "http" {
+		sink = siem.NewHTTPSink(cfg.SIEM.HTTPURL, cfg.SIEM.HTTPToken)
+	} else {
+		syslogSink, err := siem.NewSyslogSink(cfg.SIEM.SyslogNetwork, cfg.SIEM.SyslogAddr, cfg.Observability.ServiceName)
+		if err != nil {
+			return nil, fmt.Errorf("failed to initialize SIEM sink: %w", err)
+		}
+		sink = syslogSink
+	}
+
+	hostname, _ := os.Hostname()
+	shipperCfg := siem.ShipperConfig{
+		Source: siem.Source{
+			Service:  cfg.Observability.ServiceName,
+			Region:   cfg.Server.Region,
+			Hostname: hostname,
+		},
+		BufferSize:    cfg.SIEM.BufferSize,
+		BatchSize:     cfg.SIEM.BatchSize,
+		FlushInterval: cfg.SIEM.FlushInterval,
+		Timeout:       cfg.SIEM.Timeout,
+		MaxBackoff:    cfg.SIEM.MaxBackoff,
+	}
+
+	var shipper *siem.Shipper
+	if meter != nil {
+		metrics, err := observability.NewSIEMMetrics(meter, func() int64 { return int64(shipper.Queued()) })
+		if err != nil {
+			return nil, fmt.Errorf("failed to initialize SIEM metrics: %w", err)
+		}
+		shipperCfg.OnResult = func(result string, n int) {
+			metrics.RecordEvents(context.Background(), result, n)
+		}
+	}
+	shipper = siem.NewShipper(sink, shipperCfg, slog.Default().With("component", "siem"))
+	return shipper, nil
+}
+
+// newLoadShedder returns the adaptive concurrency limiter that, when meter
+// is non-nil, exports its limit and shed requests.
+func newLoadShedder(cfg *config.Config, meter metric.Meter) (*loadshed.Limiter, error) {
+	limiterCfg := loadshed.Config{
+		InitialLimit: cfg.LoadShed.InitialLimit,
+		MinLimit:     cfg.LoadShed.MinLimit,
+		MaxLimit:     cfg.LoadShed.MaxLimit,
+		Window:       cfg.LoadShed.Window,
+		RetryAfter:   cfg.LoadShed.RetryAfter,
+		ExemptPaths:  []string{"/health", "/ready"},
+	}
+
+	var limiter *loadshed.Limiter
+	if meter != nil {
+		metrics, err := observability.NewLoadShedMetrics(meter,
+			func() int64 { return int64(limiter.Limit()) },
+			func() int64 { return int64(limiter.Inflight()) },
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to initialize load shedding metrics: %w", err)
+		}
+		limiterCfg.OnShed = func() {
+			metrics.RecordShed(context.Background())
+		}
+	}
+	limiter = loadshed.NewLimiter(limiterCfg, slog.Default().With("component", "loadshed"))
+	return limiter, nil
+}
+
+// newWatchdog returns the memory and goroutine watchdog that, when meter is
+// non-nil, exports its samples, forced collections and restart requests.
+func newWatchdog(cfg *config.Config, meter metric.Meter) (*watchdog.Watchdog, error) {
+	wdCfg := watchdog.Config{
+		Interval:      cfg.Watchdog.Interval,
+		MaxHeapBytes:  uint64(cfg.Watchdog.MaxHeapMB) << 20,
+		MaxGoroutines: cfg.Watchdog.MaxGoroutines,
+		RestartAfter:  cfg.Watchdog.RestartAfter,
+	}
+
+	var wd *watchdog.Watchdog
+	if meter != nil {
+		metrics, err := observability.NewWatchdogMetrics(meter,
+			func() int64 { return int64(wd.Last().HeapBytes) },
+			func() int64 { return int64(wd.Last().Goroutines) },
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to initialize watchdog metrics: %w", err)
+		}
+		wdCfg.OnSample = func(s watchdog.Sample) {
+			if s.ForcedGC {
+				metrics.RecordForcedGC(context.Background())
+			}
+			if s.RestartRequested {
+				metrics.RecordRestart(context.Background())
+			}
+		}
+	}
+	wd = watchdog.New(wdCfg, slog.Default().With("component", "watchdog"))
+	return wd, nil
+}
+
+// newProductCache returns the storefront product read cache and, when
+// meter is non-nil, its metrics.
+func newProductCache(cfg *config.Config, meter metric.Meter) (*productcache.Cache, *observability.ProductCacheMetrics, error) {
+	cacheCfg := productcache.Config{
+		ProductTTL:   cfg.ProductCache.ProductTTL,
+		InventoryTTL: cfg.ProductCache.InventoryTTL,
+		MaxEntries:   cfg.ProductCache.MaxEntries,
+	}
+
+	var cache *productcache.Cache
+	var metrics *observability.ProductCacheMetrics
+	if meter != nil {
+		var err error
+		metrics, err = observability.NewProductCacheMetrics(meter, func() int64 { return int64(cache.Len()) })
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to initialize product cache metrics: %w", err)
+		}
+		cacheCfg.OnLookup = func(kind string, hit bool) {
+			metrics.RecordLookup(context.Background(), kind, hit)
+		}
+	}
+	cache = productcache.New(cacheCfg)
+	return cache, metrics, nil
+}
+
+// backendAuth is how the BFF authenticates itself and the propagated user
+// context to the backend services. tls and serviceToken are optional.
+type backendAuth struct {
+	tls          *tls.Config
+	serviceToken *pkgmw.ClientCredentials
+	propagator   *pkgmw.ContextPropagator
+}
+
+func newBackendAuth(cfg *config.Config) (backendAuth, error) {
+	auth := backendAuth{propagator: pkgmw.NewContextPropagator()}
+	if cfg.ServiceAuth.BackendTLS() {
+		var err error
+		auth.tls, err = pkgmw.ClientTLSConfig(cfg.ServiceAuth.TLSCertFile, cfg.ServiceAuth.TLSKeyFile, cfg.ServiceAuth.TLSCAFile)
+		if err != nil {
+			return backendAuth{}, fmt.Errorf("failed to load backend TLS configuration: %w", err)
+		}
+	}
+	if cfg.ServiceAuth.ClientID != "" {
+		auth.serviceToken = pkgmw.NewClientCredentials(pkgmw.ClientCredentialsConfig{
+			TokenURL:     cfg.ServiceAuth.TokenURL,
+			ClientID:     cfg.ServiceAuth.ClientID,
+			ClientSecret: cfg.ServiceAuth.ClientSecret,
+			Audience:     cfg.ServiceAuth.Audience,
+		})
+	}
+	if cfg.ServiceAuth.ContextSigningKey != "" {
+		auth.propagator = pkgmw.NewSignedContextPropagator(pkgmw.PropagatorConfig{
+			SigningKeys: []string{cfg.ServiceAuth.ContextSigningKey},
+		})
+	}
+	return auth, nil
+}
+
+// newUserServiceClient returns the User Service client, served in process
+// when mock mode is enabled.
+func newUserServiceClient(cfg *config.Config, breaker *client.CircuitBreaker, canary *client.CanaryRouter, auth backendAuth) (userv1connect.UserServiceClient, error) {
+	if cfg.Server.MockMode {
+		return mock.NewUserServiceClient(mock.NewUserService()), nil
+	}
+
+	userEndpoints, err := cfg.GetUserServiceEndpoints()
+	if err != nil {
+		return nil, fmt.Errorf("invalid user service endpoints: %w", err)
+	}
+	clientEndpoints := make([]client.Endpoint, len(userEndpoints))
+	for i, ep := range userEndpoints {
+		clientEndpoints[i] = client.Endpoint{Region: ep.Region, URL: ep.URL}
+	}
+	timeoutOverrides, err := cfg.GetBackendTimeoutOverrides()
+	if err != nil {
+		return nil, fmt.Errorf("invalid backend timeout overrides: %w", err)
+	}
+	userServiceClient, err := client.NewUserServiceClient(client.UserClientConfig{
+		BaseURL:          cfg.Backend.UserServiceURL,
+		Timeout:          cfg.Backend.RequestTimeout,
+		TimeoutOverrides: timeoutOverrides,
+		Endpoints:        clientEndpoints,
+		Region:           cfg.Server.Region,
+		FailoverCooldown: cfg.Backend.FailoverCooldown,
+		Logger:           slog.Default().With("component", "user-client"),
+		Breaker:          breaker,
+		Canary:           canary,
+		Retry: &pkgmw.RetryConfig{
+			MaxAttempts:    cfg.Backend.RetryMaxAttempts,
+			InitialBackoff: cfg.Backend.RetryInitialBackoff,
+			MaxBackoff:     cfg.Backend.RetryMaxBackoff,
+			Budget:         cfg.Backend.RetryBudget,
+		},
+		TLS:          auth.tls,
+		ServiceToken: auth.serviceToken,
+		Propagator:   auth.propagator,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to initialize user service client: %w", err)
+	}
+	return userServiceClient, nil
+}
+
+// newProductServiceClients returns the Product Service clients, or nil when
+// PRODUCT_SERVICE_URL is unset or in mock mode.
+func newProductServiceClients(cfg *config.Config, breaker *client.CircuitBreaker, canary *client.CanaryRouter, auth backendAuth) (*client.ProductServiceClients, error) {
+	if cfg.Server.MockMode || cfg.Backend.ProductServiceURL == "" {
+		return nil, nil
+	}
+
+	timeoutOverrides, err := cfg.GetBackendTimeoutOverrides()
+	if err != nil {
+		return nil, fmt.Errorf("invalid backend timeout overrides: %w", err)
+	}
+	clients := client.NewProductServiceClients(client.ProductClientConfig{
+		BaseURL:          cfg.Backend.ProductServiceURL,
+		Timeout:          cfg.Backend.RequestTimeout,
+		TimeoutOverrides: timeoutOverrides,
+		Logger:           slog.Default().With("component", "product-client"),
+		Breaker:          breaker,
+		Canary:           canary,
+		Retry: &pkgmw.RetryConfig{
+			MaxAttempts:    cfg.Backend.RetryMaxAttempts,
+			InitialBackoff: cfg.Backend.RetryInitialBackoff,
+			MaxBackoff:     cfg.Backend.RetryMaxBackoff,
+			Budget:         cfg.Backend.RetryBudget,
+		},
+		TLS:          auth.tls,
+		ServiceToken: auth.serviceToken,
+		Propagator:   auth.propagator,
+	})
+	return &clients, nil
+}
+
+// readinessBackends returns the backends probed by /ready.
+// Returns nil when backend probing is disabled or in mock mode.
+func readinessBackends(cfg *config.Config) []health.Backend {
+	if !cfg.Readiness.ProbeBackends || cfg.Server.MockMode {
+		return nil
+	}
+
+	services := []struct {
+		name    string
+		baseURL string
+	}{
+		{"user-service", cfg.Backend.UserServiceURL},
+		{"product-service", cfg.Backend.ProductServiceURL},
+		{"order-service", cfg.Backend.OrderServiceURL},
+	}
+
+	var backends []health.Backend
+	for _, svc := range services {
+		if svc.baseURL == "" {
+			continue
+		}
+		backends = append(backends, health.Backend{
+			Name: svc.name,
+			URL:  strings.TrimRight(svc.baseURL, "/") + cfg.Readiness.BackendPath,
+		})
+	}
+	return backends
+}
+
 func (d *Dependencies) Close() {
 	if d.RateLimiter != nil {
 		d.RateLimiter.Close()
@@ -121,21 +802,118 @@ func (d *Dependencies) Close() {
 	if d.JWKSManager != nil {
 		d.JWKSManager.Close()
 	}
+	if d.redisClient != nil {
+		d.redisClient.Close()
+	}
+	if d.UserCapabilities != nil {
+		d.UserCapabilities.Close()
+	}
+	// Last, so events from the shutdown above are flushed.
+	if d.SIEMShipper != nil {
+		d.SIEMShipper.Close()
+	}
 }
 
 func BuildInterceptorChain(deps *Dependencies) connect.Option {
+	authConfig := middleware.AuthInterceptorConfig{
+		TrustedProxyHeader: deps.Config.Server.TrustedProxyHeader,
+	}
+	if deps.SIEMShipper != nil {
+		authConfig.Events = deps.SIEMShipper
+	}
+	if deps.Denylist != nil {
+		authConfig.Denylist = deps.Denylist
+	}
 	authInterceptor := middleware.NewAuthInterceptor(
-		middleware.AuthInterceptorConfig{
-			TrustedProxyHeader: deps.Config.Server.TrustedProxyHeader,
-		},
+		authConfig,
 		deps.Validator,
 		deps.RateLimiter,
 		deps.PublicMatcher,
 	)
 
-	return connect.WithInterceptors(authInterceptor)
+	// Outermost so every log record and error of the request carries the
+	// request ID.
+	interceptors := []connect.Interceptor{middleware.NewRequestIDInterceptor()}
+
+	// Before auth so auth rejections are counted.
+	if deps.RPCMetrics != nil {
+		interceptors = append(interceptors, deps.RPCMetrics.Interceptor())
+	}
+
+	// Before auth so auth rejections and slow auth count toward the SLOs.
+	if deps.SLOMetrics != nil {
+		interceptors = append(interceptors, deps.SLOMetrics.Interceptor())
+	}
+
+	if deps.ErrorMessages != nil {
+		// Before auth so errors of every interceptor below are localized.
+		interceptors = append(interceptors, deps.ErrorMessages.Interceptor())
+	}
+
+	interceptors = append(interceptors, authInterceptor)
+
+	if deps.AccessGrants != nil {
+		// Runs after auth so it can extend the token's permissions, and
+		// before the security audit so grant use is recorded.
+		interceptors = append(interceptors, deps.AccessGrants.Interceptor())
+	}
+
+	if deps.SIEMShipper != nil {
+		// Runs right after auth so it reports the final outcome of every
+		// authenticated request.
+		interceptors = append(interceptors, middleware.NewSecurityAuditInterceptor(
+			deps.SIEMShipper,
+			deps.Config.Server.TrustedProxyHeader,
+		))
+	}
+
+	// Runs after auth so channel and market claims in the token win over
+	// the request headers.
+	interceptors = append(interceptors, middleware.NewChannelInterceptor(
+		deps.Config.Channel.DefaultChannel,
+		deps.Config.Channel.DefaultMarket,
+	))
+
+	if deps.Config.Canary.TesterRole != "" {
+		// Runs after auth so only callers with the tester role can pin a
+		// backend release.
+		interceptors = append(interceptors, middleware.NewCanaryPinInterceptor(deps.Config.Canary.TesterRole))
+	}
+
+	if deps.UserCapabilities != nil {
+		// Runs after auth so callers without access learn nothing about
+		// backend versions.
+		interceptors = append(interceptors, deps.UserCapabilities.Interceptor())
+	}
+
+	if deps.IdempotencyStore != nil {
+		// Runs after auth so replayed responses are scoped to the caller.
+		interceptors = append(interceptors, pkgmw.IdempotencyInterceptor(
+			deps.IdempotencyStore,
+			deps.Config.Idempotency.KeyTTL,
+			pkgmw.HandlerResponseTypes(deps.UserHandler, deps.StorefrontHandler),
+			slog.Default(),
+		))
+	}
+
+	if deps.QuotaLimiter != nil {
+		// Runs after idempotency so replayed responses do not consume quota.
+		interceptors = append(interceptors, deps.QuotaLimiter.Interceptor())
+	}
+
+	if deps.CaptureRecorder != nil {
+		// Innermost so captures reflect what the handler actually received
+		// and returned.
+		interceptors = append(interceptors, deps.CaptureRecorder.Interceptor())
+	}
+
+	return connect.WithInterceptors(interceptors...)
 }
 
+// storefrontStreamTimeout bounds a storefront stream, matching the Product
+// Service's default route timeout for WatchInventory.
+const storefrontStreamTimeout = 30 * time.Minute
+
 func BuildHTTPHandler(cfg *config.Config, connectHandler http.Handler) http.Handler {
 	sanitizer := middleware.NewHeaderSanitizer(cfg.HeadersToSanitize())
 
@@ -145,14 +923,161 @@ func BuildHTTPHandler(cfg *config.Config, connectHandler http.Handler) http.Hand
 		})
 	}
 
-	return sanitizer.Middleware(connectHandler)
+	// Availability streams outlive the server-wide write timeout, like the
+	// Product Service's WatchInventory streams they relay.
+	streams := pkgmw.RouteTimeouts(map[string]time.Duration{
+		storefrontv1connect.StorefrontServiceWatchProductAvailabilityProcedure: storefrontStreamTimeout,
+	})
+	return sanitizer.Middleware(streams(connectHandler))
 }
 
 // RegisterHandlers registers all Connect-go service handlers to the mux.
-func (d *Dependencies) RegisterHandlers(mux *http.ServeMux) {
+func (d *Dependencies) RegisterHandlers(mux *http.ServeMux) error {
 	interceptors := BuildInterceptorChain(d)
 
 	// Register User Service handler
 	path, handler := userv1connect.NewUserServiceHandler(d.UserHandler, interceptors)
 	mux.Handle(path, handler)
+
+	// Register Storefront Service handler (requires the Product Service)
+	if d.StorefrontHandler != nil {
+		path, handler = storefrontv1connect.NewStorefrontServiceHandler(d.StorefrontHandler, interceptors)
+		mux.Handle(path, handler)
+
+		if d.Config.GraphQL.Enabled {
+			mux.Handle("/graphql", newGraphQLHandler(d.Config, handler))
+		}
+	}
+
+	// Register the product cache endpoints. They are plain HTTP, outside the
+	// interceptor chain: events are authenticated by their signature and
+	// flushes by the caller's token.
+	if d.ProductCache != nil {
+		logger := slog.Default().With("component", "product-cache")
+		eventsCfg := productcache.EventsConfig{
+			Secret:    d.Config.ProductCache.WebhookSecret,
+			Tolerance: d.Config.ProductCache.WebhookTolerance,
+		}
+		var onFlush func(int)
+		if d.productCacheMetrics != nil {
+			eventsCfg.OnInvalidate = func(eventType string, lag time.Duration) {
+				d.productCacheMetrics.RecordInvalidation(context.Background(), eventType, lag)
+			}
+			onFlush = func(int) {
+				d.productCacheMetrics.RecordFlush(context.Background())
+			}
+		}
+		mux.Handle("/webhooks/product-events", d.ProductCache.EventHandler(eventsCfg, logger))
+		mux.Handle("/admin/product-cache/flush", d.ProductCache.FlushHandler(
+			d.hasPermission(authz.PermProductCacheFlush), onFlush, logger,
+		))
+	}
+
+	// Register the token revocation endpoint. Like the product events it is
+	// authenticated by its signature.
+	if d.Denylist != nil {
+		mux.Handle("/webhooks/user-events", d.Denylist.EventHandler(denylist.EventsConfig{
+			Secret:    d.Config.TokenDenylist.WebhookSecret,
+			Tolerance: d.Config.TokenDenylist.WebhookTolerance,
+		}, slog.Default().With("component", "token-denylist")))
+	}
+
+	// Register the runbook actions for on-call. Like the cache flush they
+	// are plain HTTP, authorized by the caller's token.
+	mux.Handle(runbook.Prefix, d.newRunbookHandler())
+
+	// Register the REST gateway over the Connect handlers above
+	if d.Config.REST.Enabled {
+		routes := rest.UserRoutes
+		if d.StorefrontHandler != nil {
+			routes = append(slices.Clone(routes), rest.StorefrontRoutes...)
+		}
+		gateway, err := rest.NewGateway(
+			routes,
+			mux,
+			[]string{"Authorization", "Accept-Language", pkgmw.IdempotencyKeyHeader, d.Config.Server.TrustedProxyHeader},
+			slog.Default(),
+		)
+		if err != nil {
+			return fmt.Errorf("failed to create REST gateway: %w", err)
+		}
+		mux.Handle("/api/v1/", gateway)
+		mux.Handle(rest.OpenAPIPath, gateway)
+	}
+	return nil
+}
+
+// newGraphQLHandler serves GraphQL queries by calling the storefront
+// handler in process, forwarding the caller's credentials and address so
+// the full interceptor chain applies to each resolver call.
+func newGraphQLHandler(cfg *config.Config, storefront http.Handler) http.Handler {
+	storefrontClient := storefrontv1connect.NewStorefrontServiceClient(
+		&http.Client{Transport: client.InProcessTransport{Handler: storefront}},
+		"http://bff.internal",
+		connect.WithInterceptors(graphql.ForwardHeaders("Authorization", cfg.Server.TrustedProxyHeader)),
+	)
+	executor := graphql.NewExecutor(graphql.StorefrontSchema(storefrontClient), cfg.GraphQL.MaxDepth, slog.Default())
+	return graphql.NewHandler(executor, slog.Default())
+}
+
+// hasPermission returns a check for plain HTTP endpoints that reports
+// whether the request carries a valid, unrevoked bearer token granting
+// permission.
+func (d *Dependencies) hasPermission(permission string) func(r *http.Request) bool {
+	caller := d.callerWithPermission(permission)
+	return func(r *http.Request) bool {
+		_, ok := caller(r)
+		return ok
+	}
+}
+
+// callerWithPermission returns the user ID of the bearer of r's token if
+// the token is valid, not revoked, and grants permission.
+func (d *Dependencies) callerWithPermission(permission string) func(r *http.Request) (string, bool) {
+	var tokenDenylist middleware.TokenDenylist
+	if d.Denylist != nil {
+		tokenDenylist = d.Denylist
+	}
+	return func(r *http.Request) (string, bool) {
+		claims, ok := middleware.AuthenticateHTTP(r, d.Validator, tokenDenylist)
+		if !ok || !slices.Contains(claims.Permissions, permission) {
+			return "", false
+		}
+		return claims.Subject, true
+	}
+}
+
+// newRunbookHandler returns the on-call actions available with the
+// configured dependencies.
+func (d *Dependencies) newRunbookHandler() *runbook.Handler {
+	cfg := runbook.Config{
+		Authorize:          d.callerWithPermission(authz.PermOpsRunbook),
+		TrustedProxyHeader: d.Config.Server.TrustedProxyHeader,
+	}
+	if d.SIEMShipper != nil {
+		cfg.Events = d.SIEMShipper
+	}
+	h := runbook.NewHandler(cfg, slog.Default().With("component", "runbook"))
+
+	caches := map[string]func() int{}
+	if d.ProductCache != nil {
+		caches["product"] = func() int {
+			n := d.ProductCache.Flush()
+			if d.productCacheMetrics != nil {
+				d.productCacheMetrics.RecordFlush(context.Background())
+			}
+			return n
+		}
+	}
+	h.Register(runbook.ActionFlushCaches, runbook.FlushCaches(caches))
+	if d.JWKSManager != nil {
+		h.Register(runbook.ActionRotateJWKS, runbook.RotateJWKS(d.JWKSManager))
+	}
+	if d.productClients != nil {
+		h.Register(runbook.ActionListWorkers, runbook.ListWorkers(d.productClients.Workers))
+		h.Register(runbook.ActionPauseWorker, runbook.PauseWorker(d.productClients.Workers))
+		h.Register(runbook.ActionResumeWorker, runbook.ResumeWorker(d.productClients.Workers))
+		h.Register(runbook.ActionDrainReservations, runbook.DrainReservations(d.productClients.Inventory))
+	}
+	return h
 }
@@ -8,7 +8,9 @@ import (
 	"time"
 
 	"connectrpc.com/connect"
+	"github.com/daisuke8000/example-ec-platform/bff/internal/authz"
 	"github.com/daisuke8000/example-ec-platform/bff/internal/config"
+	"github.com/daisuke8000/example-ec-platform/bff/internal/denylist"
 	"github.com/daisuke8000/example-ec-platform/bff/internal/jwt"
 	"github.com/daisuke8000/example-ec-platform/bff/internal/middleware"
 )
@@ -101,3 +103,44 @@ func TestDependencies_Initialize(t *testing.T) {
 
 var _ connect.Interceptor = (connect.UnaryInterceptorFunc)(nil)
 var _ *jwt.Validator = (*jwt.Validator)(nil)
+
+type staticValidator struct {
+	claims *jwt.ValidatedClaims
+}
+
+func (v staticValidator) Validate(context.Context, string) (*jwt.ValidatedClaims, error) {
+	return v.claims, nil
+}
+
+func TestDependencies_CallerWithPermission(t *testing.T) {
+	issuedAt := time.Now().Add(-time.Minute)
+	deps := &Dependencies{
+		Validator: staticValidator{claims: &jwt.ValidatedClaims{
+			Subject:     "admin-1",
+			ClientID:    "admin-console",
+			IssuedAt:    issuedAt,
+			Permissions: []string{authz.PermOpsRunbook},
+		}},
+		Denylist: denylist.New(time.Hour),
+	}
+	newRequest := func() *http.Request {
+		r := httptest.NewRequest(http.MethodPost, "/ops/runbook", nil)
+		r.Header.Set("Authorization", "Bearer token")
+		return r
+	}
+
+	if caller, ok := deps.callerWithPermission(authz.PermOpsRunbook)(newRequest()); !ok || caller != "admin-1" {
+		t.Errorf("callerWithPermission() = %q, %v, want admin-1, true", caller, ok)
+	}
+	if deps.hasPermission(authz.PermProductCacheFlush)(newRequest()) {
+		t.Error("hasPermission() granted a permission the token does not carry")
+	}
+
+	deps.Denylist.Revoke("admin-1", "admin-console", issuedAt.Add(time.Second))
+	if _, ok := deps.callerWithPermission(authz.PermOpsRunbook)(newRequest()); ok {
+		t.Error("callerWithPermission() accepted a revoked token")
+	}
+	if deps.hasPermission(authz.PermOpsRunbook)(newRequest()) {
+		t.Error("hasPermission() accepted a revoked token")
+	}
+}
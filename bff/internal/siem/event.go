@@ -0,0 +1,94 @@
+// Package siem forwards security-relevant events to a SIEM over syslog or
+// HTTP. Events are queued in memory and shipped in batches by a background
+// goroutine, so a slow or unavailable sink never adds latency to requests;
+// when the queue is full new events are dropped and counted.
+package siem
+
+import (
+	"time"
+)
+
+// SchemaVersion identifies the layout of Event. It is bumped on
+// incompatible changes so SIEM parsers can handle both versions.
+const SchemaVersion = "1"
+
+// EventType classifies a security event.
+type EventType string
+
+const (
+	// EventAuthFailure is a request rejected for missing or invalid credentials.
+	EventAuthFailure EventType = "auth.failure"
+	// EventAccessDenied is an authenticated request rejected by authorization.
+	EventAccessDenied EventType = "authz.denied"
+	// EventAdminAction is a request made by a caller holding permissions.
+	EventAdminAction EventType = "admin.action"
+	// EventImpersonation is a request made on behalf of another user.
+	EventImpersonation EventType = "impersonation"
+	// EventHoneypotHit is a request to an endpoint that legitimate clients
+	// never call.
+	EventHoneypotHit EventType = "honeypot.hit"
+)
+
+// Severity follows the syslog severity names.
+type Severity string
+
+const (
+	SeverityInfo     Severity = "info"
+	SeverityNotice   Severity = "notice"
+	SeverityWarning  Severity = "warning"
+	SeverityCritical Severity = "critical"
+)
+
+// syslogSeverity returns the RFC 5424 numeric severity.
+func (s Severity) syslogSeverity() int {
+	switch s {
+	case SeverityCritical:
+		return 2
+	case SeverityWarning:
+		return 4
+	case SeverityNotice:
+		return 5
+	default:
+		return 6
+	}
+}
+
+// Outcome of the action an event describes.
+const (
+	OutcomeSuccess = "success"
+	OutcomeFailure = "failure"
+)
+
+// Event is the structured record shipped to the SIEM.
+type Event struct {
+	SchemaVersion string    `json:"schema_version"`
+	ID            string    `json:"id"`
+	Time          time.Time `json:"time"`
+	Type          EventType `json:"type"`
+	Severity      Severity  `json:"severity"`
+	Outcome       string    `json:"outcome"`
+	Actor         Actor     `json:"actor"`
+	// Procedure is the RPC the event relates to.
+	Procedure string `json:"procedure,omitempty"`
+	// Target identifies the resource acted on, if known.
+	Target string `json:"target,omitempty"`
+	// Reason is a machine-readable cause, e.g. "token_expired".
+	Reason string `json:"reason,omitempty"`
+	Source Source `json:"source"`
+	// Attributes holds event-type specific details.
+	Attributes map[string]string `json:"attributes,omitempty"`
+}
+
+// Actor is the caller that triggered an event.
+type Actor struct {
+	UserID    string `json:"user_id,omitempty"`
+	IP        string `json:"ip,omitempty"`
+	UserAgent string `json:"user_agent,omitempty"`
+}
+
+// Source is the instance that observed an event. It is set by the Shipper.
+type Source struct {
+	Service  string `json:"service"`
+	Region   string `json:"region,omitempty"`
+	Hostname string `json:"hostname,omitempty"`
+}
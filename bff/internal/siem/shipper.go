@@ -0,0 +1,248 @@
+package siem
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"sync/atomic"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ErrRejected is wrapped by sinks when the SIEM refuses a batch in a way
+// that retrying cannot fix. Rejected batches are dropped.
+var ErrRejected = errors.New("siem rejected events")
+
+// Results reported through ShipperConfig.OnResult.
+const (
+	ResultShipped = "shipped"
+	ResultDropped = "dropped"
+	ResultFailed  = "failed"
+)
+
+// Sink delivers batches of events to a SIEM. Send is only called from the
+// shipper goroutine.
+type Sink interface {
+	Send(ctx context.Context, events []Event) error
+	Close() error
+}
+
+type ShipperConfig struct {
+	// Source is stamped on every event.
+	Source Source
+
+	// BufferSize is the number of events queued in memory. Events emitted
+	// while the queue is full are dropped.
+	BufferSize int
+
+	// BatchSize is the maximum number of events per Send.
+	BatchSize int
+
+	// FlushInterval is how long a partial batch waits before it is sent.
+	FlushInterval time.Duration
+
+	// Timeout bounds each Send and the final flush on Close.
+	Timeout time.Duration
+
+	// MaxBackoff bounds the delay between retries of a failed batch.
+	MaxBackoff time.Duration
+
+	// OnResult, if set, is called with the number of events shipped,
+	// dropped because the queue was full, or failed.
+	OnResult func(result string, n int)
+}
+
+// Shipper queues events and forwards them to a Sink in the background.
+// A batch that fails is retried with backoff while new events wait in the
+// queue; once the queue is full further events are dropped, which bounds
+// memory and keeps Emit non-blocking.
+type Shipper struct {
+	sink   Sink
+	cfg    ShipperConfig
+	logger *slog.Logger
+
+	queue   chan Event
+	dropped atomic.Int64
+
+	stopCh chan struct{}
+	doneCh chan struct{}
+}
+
+// NewShipper creates a shipper. Call Start to begin shipping.
+func NewShipper(sink Sink, cfg ShipperConfig, logger *slog.Logger) *Shipper {
+	return &Shipper{
+		sink:   sink,
+		cfg:    cfg,
+		logger: logger,
+		queue:  make(chan Event, cfg.BufferSize),
+		stopCh: make(chan struct{}),
+		doneCh: make(chan struct{}),
+	}
+}
+
+// Emit queues an event without blocking. ID, Time, SchemaVersion and
+// Source are filled in when unset.
+func (s *Shipper) Emit(e Event) {
+	if e.ID == "" {
+		e.ID = uuid.NewString()
+	}
+	if e.Time.IsZero() {
+		e.Time = time.Now().UTC()
+	}
+	e.SchemaVersion = SchemaVersion
+	e.Source = s.cfg.Source
+
+	select {
+	case s.queue <- e:
+	default:
+		s.dropped.Add(1)
+		s.report(ResultDropped, 1)
+	}
+}
+
+// Queued returns the number of events waiting to be shipped.
+func (s *Shipper) Queued() int {
+	return len(s.queue)
+}
+
+// Start ships queued events until Close is called.
+func (s *Shipper) Start() {
+	go func() {
+		defer close(s.doneCh)
+
+		ticker := time.NewTicker(s.cfg.FlushInterval)
+		defer ticker.Stop()
+
+		batch := make([]Event, 0, s.cfg.BatchSize)
+		for {
+			select {
+			case <-s.stopCh:
+				s.flush(batch)
+				return
+			case e := <-s.queue:
+				batch = append(batch, e)
+				if len(batch) < s.cfg.BatchSize {
+					continue
+				}
+			case <-ticker.C:
+				s.logDropped()
+				if len(batch) == 0 {
+					continue
+				}
+			}
+
+			if !s.ship(batch) {
+				s.flush(batch)
+				return
+			}
+			batch = batch[:0]
+		}
+	}()
+}
+
+// Close stops the shipper after a final attempt, bounded by Timeout, to
+// send the queued events, then closes the sink.
+func (s *Shipper) Close() {
+	select {
+	case <-s.stopCh:
+		return
+	default:
+		close(s.stopCh)
+	}
+	<-s.doneCh
+
+	if err := s.sink.Close(); err != nil {
+		s.logger.Warn("failed to close siem sink", slog.String("error", err.Error()))
+	}
+}
+
+// ship sends batch, retrying with backoff until it is accepted or rejected.
+// It returns false if the shipper was closed before the batch was sent.
+func (s *Shipper) ship(batch []Event) bool {
+	backoff := min(s.cfg.FlushInterval, s.cfg.MaxBackoff)
+	for {
+		err := s.send(context.Background(), batch)
+		if err == nil {
+			s.report(ResultShipped, len(batch))
+			return true
+		}
+		if errors.Is(err, ErrRejected) {
+			s.logger.Error("siem rejected events, dropping batch",
+				slog.Int("events", len(batch)),
+				slog.String("error", err.Error()),
+			)
+			s.report(ResultFailed, len(batch))
+			return true
+		}
+
+		s.logger.Warn("failed to ship siem events, will retry",
+			slog.Int("events", len(batch)),
+			slog.Int("queued", len(s.queue)),
+			slog.Duration("backoff", backoff),
+			slog.String("error", err.Error()),
+		)
+		select {
+		case <-s.stopCh:
+			return false
+		case <-time.After(backoff):
+		}
+		backoff = min(backoff*2, s.cfg.MaxBackoff)
+		s.logDropped()
+	}
+}
+
+// flush sends batch and everything still queued once, giving up when
+// Timeout elapses.
+func (s *Shipper) flush(batch []Event) {
+	ctx, cancel := context.WithTimeout(context.Background(), s.cfg.Timeout)
+	defer cancel()
+
+	for {
+		for len(batch) < s.cfg.BatchSize {
+			select {
+			case e := <-s.queue:
+				batch = append(batch, e)
+				continue
+			default:
+			}
+			break
+		}
+		if len(batch) == 0 {
+			break
+		}
+
+		if err := s.send(ctx, batch); err != nil {
+			lost := len(batch) + len(s.queue)
+			s.logger.Error("failed to flush siem events on shutdown",
+				slog.Int("events", lost),
+				slog.String("error", err.Error()),
+			)
+			s.report(ResultFailed, lost)
+			break
+		}
+		s.report(ResultShipped, len(batch))
+		batch = batch[:0]
+	}
+	s.logDropped()
+}
+
+func (s *Shipper) send(ctx context.Context, batch []Event) error {
+	ctx, cancel := context.WithTimeout(ctx, s.cfg.Timeout)
+	defer cancel()
+	return s.sink.Send(ctx, batch)
+}
+
+// logDropped logs the events dropped since the last call. Drops are logged
+// in aggregate so a full queue doesn't flood the logs.
+func (s *Shipper) logDropped() {
+	if n := s.dropped.Swap(0); n > 0 {
+		s.logger.Warn("siem queue full, events dropped", slog.Int64("events", n))
+	}
+}
+
+func (s *Shipper) report(result string, n int) {
+	if s.cfg.OnResult != nil {
+		s.cfg.OnResult(result, n)
+	}
+}
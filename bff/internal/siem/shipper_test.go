@@ -0,0 +1,161 @@
+package siem
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"sync"
+	"testing"
+	"time"
+)
+
+type fakeSink struct {
+	mu      sync.Mutex
+	batches [][]Event
+	err     error
+}
+
+func (s *fakeSink) Send(_ context.Context, events []Event) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.err != nil {
+		return s.err
+	}
+	s.batches = append(s.batches, append([]Event(nil), events...))
+	return nil
+}
+
+func (s *fakeSink) Close() error { return nil }
+
+func (s *fakeSink) sent() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	n := 0
+	for _, b := range s.batches {
+		n += len(b)
+	}
+	return n
+}
+
+func newTestShipper(sink Sink, bufferSize int, results map[string]int, mu *sync.Mutex) *Shipper {
+	return NewShipper(sink, ShipperConfig{
+		Source:        Source{Service: "bff"},
+		BufferSize:    bufferSize,
+		BatchSize:     2,
+		FlushInterval: 10 * time.Millisecond,
+		Timeout:       time.Second,
+		MaxBackoff:    20 * time.Millisecond,
+		OnResult: func(result string, n int) {
+			mu.Lock()
+			defer mu.Unlock()
+			results[result] += n
+		},
+	}, slog.New(slog.NewTextHandler(io.Discard, nil)))
+}
+
+func TestShipper_ShipsInBatches(t *testing.T) {
+	sink := &fakeSink{}
+	var mu sync.Mutex
+	results := map[string]int{}
+	s := newTestShipper(sink, 10, results, &mu)
+	s.Start()
+
+	for range 5 {
+		s.Emit(Event{Type: EventAuthFailure})
+	}
+	s.Close()
+
+	if got := sink.sent(); got != 5 {
+		t.Fatalf("sent = %d, want 5", got)
+	}
+	for _, b := range sink.batches {
+		if len(b) > 2 {
+			t.Errorf("batch size = %d, want at most 2", len(b))
+		}
+		for _, e := range b {
+			if e.ID == "" || e.Time.IsZero() || e.SchemaVersion != SchemaVersion || e.Source.Service != "bff" {
+				t.Errorf("event not stamped: %+v", e)
+			}
+		}
+	}
+	if results[ResultShipped] != 5 {
+		t.Errorf("shipped = %d, want 5", results[ResultShipped])
+	}
+}
+
+func TestShipper_DropsWhenQueueFull(t *testing.T) {
+	sink := &fakeSink{}
+	var mu sync.Mutex
+	results := map[string]int{}
+	s := newTestShipper(sink, 2, results, &mu)
+
+	// Not started, so nothing leaves the queue and Emit must not block.
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for range 5 {
+			s.Emit(Event{Type: EventAuthFailure})
+		}
+	}()
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("Emit blocked on a full queue")
+	}
+
+	mu.Lock()
+	dropped := results[ResultDropped]
+	mu.Unlock()
+	if dropped != 3 {
+		t.Errorf("dropped = %d, want 3", dropped)
+	}
+	if s.Queued() != 2 {
+		t.Errorf("queued = %d, want 2", s.Queued())
+	}
+}
+
+func TestShipper_RetriesFailedBatch(t *testing.T) {
+	sink := &fakeSink{err: errors.New("connection refused")}
+	var mu sync.Mutex
+	results := map[string]int{}
+	s := newTestShipper(sink, 10, results, &mu)
+	s.Start()
+
+	s.Emit(Event{Type: EventAdminAction})
+	s.Emit(Event{Type: EventAdminAction})
+	time.Sleep(50 * time.Millisecond)
+
+	sink.mu.Lock()
+	sink.err = nil
+	sink.mu.Unlock()
+
+	deadline := time.Now().Add(time.Second)
+	for sink.sent() < 2 && time.Now().Before(deadline) {
+		time.Sleep(5 * time.Millisecond)
+	}
+	s.Close()
+
+	if got := sink.sent(); got != 2 {
+		t.Fatalf("sent = %d, want 2 after the sink recovered", got)
+	}
+}
+
+func TestShipper_DropsRejectedBatch(t *testing.T) {
+	sink := &fakeSink{err: ErrRejected}
+	var mu sync.Mutex
+	results := map[string]int{}
+	s := newTestShipper(sink, 10, results, &mu)
+	s.Start()
+
+	s.Emit(Event{Type: EventAuthFailure})
+	s.Emit(Event{Type: EventAuthFailure})
+	s.Emit(Event{Type: EventAuthFailure})
+	s.Close()
+
+	mu.Lock()
+	defer mu.Unlock()
+	if results[ResultFailed] != 3 {
+		t.Errorf("failed = %d, want 3", results[ResultFailed])
+	}
+}
@@ -0,0 +1,111 @@
+package siem
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"io"
+	"net"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestFormatSyslog(t *testing.T) {
+	e := Event{
+		ID:       "evt-1",
+		Time:     time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC),
+		Type:     EventAuthFailure,
+		Severity: SeverityWarning,
+		Source:   Source{Service: "bff", Hostname: "bff-1"},
+	}
+
+	msg, err := formatSyslog(e, "bff")
+	if err != nil {
+		t.Fatalf("formatSyslog: %v", err)
+	}
+
+	// authpriv (10) * 8 + warning (4) = 84
+	wantPrefix := "<84>1 2025-01-02T03:04:05Z bff-1 bff - auth.failure - "
+	if !strings.HasPrefix(string(msg), wantPrefix) {
+		t.Fatalf("message = %q, want prefix %q", msg, wantPrefix)
+	}
+
+	var decoded Event
+	if err := json.Unmarshal(msg[len(wantPrefix):], &decoded); err != nil {
+		t.Fatalf("body is not JSON: %v", err)
+	}
+	if decoded.ID != "evt-1" || decoded.Type != EventAuthFailure {
+		t.Errorf("decoded = %+v", decoded)
+	}
+}
+
+func TestSyslogSink_OctetCountingOverTCP(t *testing.T) {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("listen: %v", err)
+	}
+	defer ln.Close()
+
+	received := make(chan []string, 1)
+	go func() {
+		conn, err := ln.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		r := bufio.NewReader(conn)
+		var msgs []string
+		for range 2 {
+			lenStr, err := r.ReadString(' ')
+			if err != nil {
+				return
+			}
+			n, err := strconv.Atoi(strings.TrimSpace(lenStr))
+			if err != nil {
+				return
+			}
+			buf := make([]byte, n)
+			if _, err := io.ReadFull(r, buf); err != nil {
+				return
+			}
+			msgs = append(msgs, string(buf))
+		}
+		received <- msgs
+	}()
+
+	sink, err := NewSyslogSink("tcp", ln.Addr().String(), "bff")
+	if err != nil {
+		t.Fatalf("NewSyslogSink: %v", err)
+	}
+	defer sink.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	events := []Event{
+		{ID: "a", Type: EventAdminAction, Severity: SeverityNotice},
+		{ID: "b", Type: EventAccessDenied, Severity: SeverityWarning},
+	}
+	if err := sink.Send(ctx, events); err != nil {
+		t.Fatalf("Send: %v", err)
+	}
+
+	select {
+	case msgs := <-received:
+		if len(msgs) != 2 {
+			t.Fatalf("received %d messages, want 2", len(msgs))
+		}
+		if !strings.Contains(msgs[0], `"id":"a"`) || !strings.Contains(msgs[1], `"id":"b"`) {
+			t.Errorf("messages = %q", msgs)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("no messages received")
+	}
+}
+
+func TestNewSyslogSink_RejectsUnknownNetwork(t *testing.T) {
+	if _, err := NewSyslogSink("unix", "/dev/log", "bff"); err == nil {
+		t.Error("expected error for unsupported network")
+	}
+}
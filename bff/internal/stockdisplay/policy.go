@@ -0,0 +1,66 @@
+// Package stockdisplay turns raw inventory counts into the stock state shown
+// to customers, so storefronts do not reveal more about stock levels than
+// the business wants to.
+package stockdisplay
+
+import (
+	storefrontv1 "github.com/daisuke8000/example-ec-platform/gen/storefront/v1"
+)
+
+// Policy decides how much of a SKU's available quantity is displayed.
+type Policy struct {
+	// LowStockThreshold is the available quantity at or below which a SKU
+	// is shown as low stock ("only N left"). 0 disables the low level.
+	LowStockThreshold int64
+
+	// MaxQuantity caps the displayed quantity; larger stock is shown as
+	// "MaxQuantity+". 0 displays no quantities at all.
+	MaxQuantity int64
+
+	// hiddenCategories are categories whose exact counts are never shown,
+	// only the stock level.
+	hiddenCategories map[string]bool
+}
+
+func NewPolicy(lowStockThreshold, maxQuantity int64, hiddenCategories []string) *Policy {
+	hidden := make(map[string]bool, len(hiddenCategories))
+	for _, id := range hiddenCategories {
+		hidden[id] = true
+	}
+	return &Policy{
+		LowStockThreshold: lowStockThreshold,
+		MaxQuantity:       maxQuantity,
+		hiddenCategories:  hidden,
+	}
+}
+
+// HidesCounts reports whether exact counts are hidden for a category.
+// Only the product's own category is matched, not its ancestors.
+func (p *Policy) HidesCounts(categoryID string) bool {
+	return p.hiddenCategories[categoryID]
+}
+
+// Apply sets the display fields of availability from the available
+// quantity of a SKU in the given category.
+func (p *Policy) Apply(availability *storefrontv1.SKUAvailability, available int64, categoryID string) {
+	availability.InStock = available > 0
+	switch {
+	case available <= 0:
+		availability.Level = storefrontv1.StockLevel_STOCK_LEVEL_OUT_OF_STOCK
+		return
+	case available <= p.LowStockThreshold:
+		availability.Level = storefrontv1.StockLevel_STOCK_LEVEL_LOW
+	default:
+		availability.Level = storefrontv1.StockLevel_STOCK_LEVEL_IN_STOCK
+	}
+
+	if p.HidesCounts(categoryID) || p.MaxQuantity <= 0 {
+		return
+	}
+	if available > p.MaxQuantity {
+		availability.DisplayQuantity = int32(p.MaxQuantity)
+		availability.MoreAvailable = true
+		return
+	}
+	availability.DisplayQuantity = int32(available)
+}
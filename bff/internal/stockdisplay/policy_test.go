@@ -0,0 +1,57 @@
+package stockdisplay
+
+import (
+	"testing"
+
+	storefrontv1 "github.com/daisuke8000/example-ec-platform/gen/storefront/v1"
+)
+
+func TestPolicy_Apply(t *testing.T) {
+	policy := NewPolicy(5, 10, []string{"limited-editions"})
+
+	tests := []struct {
+		name         string
+		available    int64
+		categoryID   string
+		wantLevel    storefrontv1.StockLevel
+		wantQuantity int32
+		wantMore     bool
+	}{
+		{"out of stock", 0, "shirts", storefrontv1.StockLevel_STOCK_LEVEL_OUT_OF_STOCK, 0, false},
+		{"oversold", -2, "shirts", storefrontv1.StockLevel_STOCK_LEVEL_OUT_OF_STOCK, 0, false},
+		{"only one left", 1, "shirts", storefrontv1.StockLevel_STOCK_LEVEL_LOW, 1, false},
+		{"at threshold", 5, "shirts", storefrontv1.StockLevel_STOCK_LEVEL_LOW, 5, false},
+		{"above threshold", 6, "shirts", storefrontv1.StockLevel_STOCK_LEVEL_IN_STOCK, 6, false},
+		{"at cap", 10, "shirts", storefrontv1.StockLevel_STOCK_LEVEL_IN_STOCK, 10, false},
+		{"above cap", 250, "shirts", storefrontv1.StockLevel_STOCK_LEVEL_IN_STOCK, 10, true},
+		{"hidden category low", 2, "limited-editions", storefrontv1.StockLevel_STOCK_LEVEL_LOW, 0, false},
+		{"hidden category plenty", 250, "limited-editions", storefrontv1.StockLevel_STOCK_LEVEL_IN_STOCK, 0, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a := &storefrontv1.SKUAvailability{}
+			policy.Apply(a, tt.available, tt.categoryID)
+
+			if a.GetInStock() != (tt.available > 0) {
+				t.Errorf("in_stock = %v, want %v", a.GetInStock(), tt.available > 0)
+			}
+			if a.GetLevel() != tt.wantLevel {
+				t.Errorf("level = %v, want %v", a.GetLevel(), tt.wantLevel)
+			}
+			if a.GetDisplayQuantity() != tt.wantQuantity || a.GetMoreAvailable() != tt.wantMore {
+				t.Errorf("display = %d (more %v), want %d (more %v)",
+					a.GetDisplayQuantity(), a.GetMoreAvailable(), tt.wantQuantity, tt.wantMore)
+			}
+		})
+	}
+}
+
+func TestPolicy_Apply_NoQuantities(t *testing.T) {
+	a := &storefrontv1.SKUAvailability{}
+	NewPolicy(0, 0, nil).Apply(a, 1, "shirts")
+
+	if a.GetLevel() != storefrontv1.StockLevel_STOCK_LEVEL_IN_STOCK || a.GetDisplayQuantity() != 0 {
+		t.Errorf("availability = %+v, want in stock without a quantity", a)
+	}
+}
@@ -17,8 +17,12 @@ CREATE TABLE IF NOT EXISTS user_service.users (
     email VARCHAR(255) UNIQUE NOT NULL,
     password_hash VARCHAR(255) NOT NULL,
     name VARCHAR(255),
+    email_verified BOOLEAN NOT NULL DEFAULT FALSE,
+    email_verified_at TIMESTAMP WITH TIME ZONE,
     is_deleted BOOLEAN DEFAULT FALSE,
     deleted_at TIMESTAMP WITH TIME ZONE,
+    -- Set when personal data was erased by the purge worker
+    purged_at TIMESTAMP WITH TIME ZONE,
     created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
     updated_at TIMESTAMP WITH TIME ZONE DEFAULT NOW()
 );
@@ -32,6 +36,251 @@ CREATE INDEX IF NOT EXISTS idx_users_is_deleted
     ON user_service.users(is_deleted)
     WHERE is_deleted = FALSE;
 
+-- Index for the purge worker (soft-deleted users awaiting purge)
+CREATE INDEX IF NOT EXISTS idx_users_purgeable
+    ON user_service.users(deleted_at)
+    WHERE is_deleted = TRUE AND purged_at IS NULL;
+
+-- Index for admin listing (keyset pagination, newest first)
+CREATE INDEX IF NOT EXISTS idx_users_created_at_id
+    ON user_service.users(created_at DESC, id DESC);
+
+-- Email verification tokens (only the SHA-256 hash of the token is stored)
+CREATE TABLE IF NOT EXISTS user_service.email_verification_tokens (
+    token_hash VARCHAR(64) PRIMARY KEY,
+    user_id UUID NOT NULL REFERENCES user_service.users(id) ON DELETE CASCADE,
+    -- Address the token was sent to; it no longer verifies the user once their email changes
+    email VARCHAR(255) NOT NULL,
+    expires_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    used_at TIMESTAMP WITH TIME ZONE,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW()
+);
+
+-- Index for user token lookup
+CREATE INDEX IF NOT EXISTS idx_email_verification_tokens_user_id
+    ON user_service.email_verification_tokens(user_id);
+
+-- Roles (RBAC); permissions are evaluated per method by the BFF
+CREATE TABLE IF NOT EXISTS user_service.roles (
+    name VARCHAR(64) PRIMARY KEY,
+    description TEXT,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW()
+);
+
+-- Permissions granted by each role (e.g. "users:list")
+CREATE TABLE IF NOT EXISTS user_service.role_permissions (
+    role_name VARCHAR(64) NOT NULL REFERENCES user_service.roles(name) ON DELETE CASCADE,
+    permission VARCHAR(128) NOT NULL,
+    PRIMARY KEY (role_name, permission)
+);
+
+-- Role assignments
+CREATE TABLE IF NOT EXISTS user_service.user_roles (
+    user_id UUID NOT NULL REFERENCES user_service.users(id) ON DELETE CASCADE,
+    role_name VARCHAR(64) NOT NULL REFERENCES user_service.roles(name) ON DELETE CASCADE,
+    granted_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+    PRIMARY KEY (user_id, role_name)
+);
+
+-- Built-in roles
+INSERT INTO user_service.roles (name, description) VALUES
+    ('admin', 'Full administrative access'),
+    ('support', 'Read-only access to customer accounts')
+ON CONFLICT (name) DO NOTHING;
+
+INSERT INTO user_service.role_permissions (role_name, permission) VALUES
+    ('admin', 'users:list'),
+    ('admin', 'users:read'),
+    ('admin', 'users:write'),
+    ('admin', 'users:delete'),
+    ('admin', 'users:bulk'),
+    ('admin', 'users:grant'),
+    ('support', 'users:list'),
+    ('support', 'users:read')
+ON CONFLICT DO NOTHING;
+
+-- Time-boxed permissions delegated to a user (e.g. a support agent) on top
+-- of their roles; the BFF honors active grants when authorizing requests
+CREATE TABLE IF NOT EXISTS user_service.access_grants (
+    id UUID PRIMARY KEY,
+    grantee_id UUID NOT NULL REFERENCES user_service.users(id) ON DELETE CASCADE,
+    permission VARCHAR(128) NOT NULL,
+    reason VARCHAR(500) NOT NULL,
+    granted_by VARCHAR(255) NOT NULL,
+    granted_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    expires_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    revoked_at TIMESTAMP WITH TIME ZONE,
+    revoked_by VARCHAR(255) NOT NULL DEFAULT ''
+);
+
+CREATE INDEX IF NOT EXISTS idx_access_grants_grantee_granted
+    ON user_service.access_grants(grantee_id, granted_at DESC);
+
+-- Marketing segments (one segment per user)
+CREATE TABLE IF NOT EXISTS user_service.user_segments (
+    user_id UUID PRIMARY KEY REFERENCES user_service.users(id) ON DELETE CASCADE,
+    segment VARCHAR(64) NOT NULL,
+    assigned_at TIMESTAMP WITH TIME ZONE DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_user_segments_segment
+    ON user_service.user_segments(segment);
+
+-- Asynchronous admin bulk actions (BatchDeactivateUsers, BatchAssignSegment)
+CREATE TABLE IF NOT EXISTS user_service.batch_jobs (
+    id UUID PRIMARY KEY,
+    kind VARCHAR(32) NOT NULL,
+    status VARCHAR(16) NOT NULL,
+    segment VARCHAR(64),
+    requested_by VARCHAR(255) NOT NULL DEFAULT '',
+    total INTEGER NOT NULL,
+    processed INTEGER NOT NULL DEFAULT 0,
+    succeeded INTEGER NOT NULL DEFAULT 0,
+    failed INTEGER NOT NULL DEFAULT 0,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+    completed_at TIMESTAMP WITH TIME ZONE
+);
+
+-- Per-user outcomes for the downloadable job report
+CREATE TABLE IF NOT EXISTS user_service.batch_job_results (
+    job_id UUID NOT NULL REFERENCES user_service.batch_jobs(id) ON DELETE CASCADE,
+    seq INTEGER NOT NULL,
+    user_id UUID NOT NULL,
+    status VARCHAR(16) NOT NULL,
+    error TEXT NOT NULL DEFAULT '',
+    PRIMARY KEY (job_id, seq)
+);
+
+-- Long-running operations served by OperationsService (see pkg/operations)
+CREATE TABLE IF NOT EXISTS user_service.operations (
+    id UUID PRIMARY KEY,
+    kind VARCHAR(64) NOT NULL,
+    status VARCHAR(16) NOT NULL,
+    progress SMALLINT NOT NULL DEFAULT 0,
+    result JSONB,
+    error_code VARCHAR(32),
+    error_message TEXT,
+    error_details JSONB,
+    created_by VARCHAR(255) NOT NULL DEFAULT '',
+    cancel_requested BOOLEAN NOT NULL DEFAULT FALSE,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    done_at TIMESTAMP WITH TIME ZONE
+);
+
+CREATE INDEX IF NOT EXISTS idx_operations_created_at_id
+    ON user_service.operations(created_at DESC, id DESC);
+
+-- Administrative mutations served by AuditService (see pkg/audit)
+CREATE TABLE IF NOT EXISTS user_service.audit_log (
+    id UUID PRIMARY KEY,
+    actor VARCHAR(255) NOT NULL DEFAULT '',
+    method VARCHAR(255) NOT NULL,
+    entity_type VARCHAR(64) NOT NULL,
+    entity_ids TEXT[] NOT NULL DEFAULT '{}',
+    changes JSONB NOT NULL DEFAULT '[]',
+    request JSONB,
+    request_id VARCHAR(255) NOT NULL DEFAULT '',
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_audit_log_created_at_id
+    ON user_service.audit_log(created_at DESC, id DESC);
+CREATE INDEX IF NOT EXISTS idx_audit_log_entity_ids
+    ON user_service.audit_log USING GIN (entity_ids);
+CREATE INDEX IF NOT EXISTS idx_audit_log_actor_created_at
+    ON user_service.audit_log(actor, created_at DESC);
+
+-- Consent receipts: one row per consent grant recorded by the consent flow
+CREATE TABLE IF NOT EXISTS user_service.consent_receipts (
+    id UUID PRIMARY KEY,
+    user_id UUID NOT NULL REFERENCES user_service.users(id) ON DELETE CASCADE,
+    client_id VARCHAR(255) NOT NULL,
+    client_name VARCHAR(255) NOT NULL DEFAULT '',
+    scopes TEXT[] NOT NULL DEFAULT '{}',
+    remember BOOLEAN NOT NULL DEFAULT FALSE,
+    granted_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    revoked_at TIMESTAMP WITH TIME ZONE
+);
+
+CREATE INDEX IF NOT EXISTS idx_consent_receipts_user_granted
+    ON user_service.consent_receipts(user_id, granted_at DESC);
+
+-- TOTP two-factor enrollments. The secret is encrypted with TWO_FACTOR_SECRET_KEY
+-- and only SHA-256 hashes of the unused recovery codes are stored.
+CREATE TABLE IF NOT EXISTS user_service.two_factor (
+    user_id UUID PRIMARY KEY REFERENCES user_service.users(id) ON DELETE CASCADE,
+    secret_ciphertext BYTEA NOT NULL,
+    enabled_at TIMESTAMP WITH TIME ZONE,  -- NULL while the enrollment awaits its first code
+    last_used_step BIGINT NOT NULL DEFAULT 0,  -- rejects replayed codes
+    recovery_code_hashes TEXT[] NOT NULL DEFAULT '{}',
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+-- Consecutive failed password logins per email address (SHA-256 hash), kept for
+-- addresses without an account too so that lockouts don't reveal registrations.
+CREATE TABLE IF NOT EXISTS user_service.login_lockouts (
+    email_hash TEXT PRIMARY KEY,
+    failed_attempts INTEGER NOT NULL DEFAULT 0,
+    locked_until TIMESTAMP WITH TIME ZONE,
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+-- Sign-in attempts on accounts, shown to their owner by GetLoginHistory.
+-- Rows older than LOGIN_HISTORY_RETENTION are deleted on the user's next login.
+CREATE TABLE IF NOT EXISTS user_service.login_events (
+    id UUID PRIMARY KEY,
+    user_id UUID NOT NULL REFERENCES user_service.users(id) ON DELETE CASCADE,
+    ip_address TEXT NOT NULL DEFAULT '',
+    user_agent VARCHAR(512) NOT NULL DEFAULT '',
+    succeeded BOOLEAN NOT NULL,
+    failure_reason TEXT NOT NULL DEFAULT '',
+    new_device BOOLEAN NOT NULL DEFAULT FALSE,
+    occurred_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_login_events_user_occurred
+    ON user_service.login_events(user_id, occurred_at DESC);
+
+-- Address books. The partial unique index allows one default shipping
+-- address per user.
+CREATE TABLE IF NOT EXISTS user_service.addresses (
+    id UUID PRIMARY KEY,
+    user_id UUID NOT NULL REFERENCES user_service.users(id) ON DELETE CASCADE,
+    recipient VARCHAR(100) NOT NULL,
+    phone_number VARCHAR(16) NOT NULL,
+    country CHAR(2) NOT NULL,
+    postal_code VARCHAR(10) NOT NULL,
+    region VARCHAR(100) NOT NULL DEFAULT '',
+    city VARCHAR(100) NOT NULL,
+    line1 VARCHAR(200) NOT NULL,
+    line2 VARCHAR(200) NOT NULL DEFAULT '',
+    default_shipping BOOLEAN NOT NULL DEFAULT FALSE,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_addresses_user_created
+    ON user_service.addresses(user_id, created_at DESC);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_addresses_default_shipping
+    ON user_service.addresses(user_id) WHERE default_shipping;
+
+-- Social login links of users to upstream identity provider accounts
+-- (provider's subject). A user has at most one link per provider.
+CREATE TABLE IF NOT EXISTS user_service.external_identities (
+    provider VARCHAR(32) NOT NULL,
+    subject VARCHAR(255) NOT NULL,
+    user_id UUID NOT NULL REFERENCES user_service.users(id) ON DELETE CASCADE,
+    email VARCHAR(255) NOT NULL DEFAULT '',
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (provider, subject)
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_external_identities_user_provider
+    ON user_service.external_identities(user_id, provider);
+
 -- ------------------------------------------------------------------------------
 -- Product Service Schema
 -- ------------------------------------------------------------------------------
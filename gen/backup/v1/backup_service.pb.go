@@ -0,0 +1,343 @@
+// ==============================================================================
+// Backup Service API
+// Logical exports of a service schema to object storage
+// ==============================================================================
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: backup/v1/backup_service.proto
+
+package backupv1
+
+import (
+	v1 "github.com/daisuke8000/example-ec-platform/gen/operations/v1"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Backup is a logical export stored in object storage.
+type Backup struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Key           string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"` // Object key, e.g. "user_service/user_service-20260101T000000Z.dump"
+	Schema        string                 `protobuf:"bytes,2,opt,name=schema,proto3" json:"schema,omitempty"`
+	SizeBytes     int64                  `protobuf:"varint,3,opt,name=size_bytes,json=sizeBytes,proto3" json:"size_bytes,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Backup) Reset() {
+	*x = Backup{}
+	mi := &file_backup_v1_backup_service_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Backup) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Backup) ProtoMessage() {}
+
+func (x *Backup) ProtoReflect() protoreflect.Message {
+	mi := &file_backup_v1_backup_service_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Backup.ProtoReflect.Descriptor instead.
+func (*Backup) Descriptor() ([]byte, []int) {
+	return file_backup_v1_backup_service_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Backup) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *Backup) GetSchema() string {
+	if x != nil {
+		return x.Schema
+	}
+	return ""
+}
+
+func (x *Backup) GetSizeBytes() int64 {
+	if x != nil {
+		return x.SizeBytes
+	}
+	return 0
+}
+
+func (x *Backup) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type CreateBackupRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateBackupRequest) Reset() {
+	*x = CreateBackupRequest{}
+	mi := &file_backup_v1_backup_service_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateBackupRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateBackupRequest) ProtoMessage() {}
+
+func (x *CreateBackupRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_backup_v1_backup_service_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateBackupRequest.ProtoReflect.Descriptor instead.
+func (*CreateBackupRequest) Descriptor() ([]byte, []int) {
+	return file_backup_v1_backup_service_proto_rawDescGZIP(), []int{1}
+}
+
+type CreateBackupResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Operation     *v1.Operation          `protobuf:"bytes,1,opt,name=operation,proto3" json:"operation,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateBackupResponse) Reset() {
+	*x = CreateBackupResponse{}
+	mi := &file_backup_v1_backup_service_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateBackupResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateBackupResponse) ProtoMessage() {}
+
+func (x *CreateBackupResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_backup_v1_backup_service_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateBackupResponse.ProtoReflect.Descriptor instead.
+func (*CreateBackupResponse) Descriptor() ([]byte, []int) {
+	return file_backup_v1_backup_service_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *CreateBackupResponse) GetOperation() *v1.Operation {
+	if x != nil {
+		return x.Operation
+	}
+	return nil
+}
+
+type ListBackupsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListBackupsRequest) Reset() {
+	*x = ListBackupsRequest{}
+	mi := &file_backup_v1_backup_service_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListBackupsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListBackupsRequest) ProtoMessage() {}
+
+func (x *ListBackupsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_backup_v1_backup_service_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListBackupsRequest.ProtoReflect.Descriptor instead.
+func (*ListBackupsRequest) Descriptor() ([]byte, []int) {
+	return file_backup_v1_backup_service_proto_rawDescGZIP(), []int{3}
+}
+
+type ListBackupsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Backups       []*Backup              `protobuf:"bytes,1,rep,name=backups,proto3" json:"backups,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListBackupsResponse) Reset() {
+	*x = ListBackupsResponse{}
+	mi := &file_backup_v1_backup_service_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListBackupsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListBackupsResponse) ProtoMessage() {}
+
+func (x *ListBackupsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_backup_v1_backup_service_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListBackupsResponse.ProtoReflect.Descriptor instead.
+func (*ListBackupsResponse) Descriptor() ([]byte, []int) {
+	return file_backup_v1_backup_service_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ListBackupsResponse) GetBackups() []*Backup {
+	if x != nil {
+		return x.Backups
+	}
+	return nil
+}
+
+var File_backup_v1_backup_service_proto protoreflect.FileDescriptor
+
+const file_backup_v1_backup_service_proto_rawDesc = "" +
+	"\n" +
+	"\x1ebackup/v1/backup_service.proto\x12\tbackup.v1\x1a\x1fgoogle/protobuf/timestamp.proto\x1a&operations/v1/operations_service.proto\"\x8c\x01\n" +
+	"\x06Backup\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x16\n" +
+	"\x06schema\x18\x02 \x01(\tR\x06schema\x12\x1d\n" +
+	"\n" +
+	"size_bytes\x18\x03 \x01(\x03R\tsizeBytes\x129\n" +
+	"\n" +
+	"created_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\x15\n" +
+	"\x13CreateBackupRequest\"N\n" +
+	"\x14CreateBackupResponse\x126\n" +
+	"\toperation\x18\x01 \x01(\v2\x18.operations.v1.OperationR\toperation\"\x14\n" +
+	"\x12ListBackupsRequest\"B\n" +
+	"\x13ListBackupsResponse\x12+\n" +
+	"\abackups\x18\x01 \x03(\v2\x11.backup.v1.BackupR\abackups2\xb3\x01\n" +
+	"\rBackupService\x12O\n" +
+	"\fCreateBackup\x12\x1e.backup.v1.CreateBackupRequest\x1a\x1f.backup.v1.CreateBackupResponse\x12Q\n" +
+	"\vListBackups\x12\x1d.backup.v1.ListBackupsRequest\x1a\x1e.backup.v1.ListBackupsResponse\"\x03\x90\x02\x01B\xab\x01\n" +
+	"\rcom.backup.v1B\x12BackupServiceProtoP\x01ZAgithub.com/daisuke8000/example-ec-platform/gen/backup/v1;backupv1\xa2\x02\x03BXX\xaa\x02\tBackup.V1\xca\x02\tBackup\\V1\xe2\x02\x15Backup\\V1\\GPBMetadata\xea\x02\n" +
+	"Backup::V1b\x06proto3"
+
+var (
+	file_backup_v1_backup_service_proto_rawDescOnce sync.Once
+	file_backup_v1_backup_service_proto_rawDescData []byte
+)
+
+func file_backup_v1_backup_service_proto_rawDescGZIP() []byte {
+	file_backup_v1_backup_service_proto_rawDescOnce.Do(func() {
+		file_backup_v1_backup_service_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_backup_v1_backup_service_proto_rawDesc), len(file_backup_v1_backup_service_proto_rawDesc)))
+	})
+	return file_backup_v1_backup_service_proto_rawDescData
+}
+
+var file_backup_v1_backup_service_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_backup_v1_backup_service_proto_goTypes = []any{
+	(*Backup)(nil),                // 0: backup.v1.Backup
+	(*CreateBackupRequest)(nil),   // 1: backup.v1.CreateBackupRequest
+	(*CreateBackupResponse)(nil),  // 2: backup.v1.CreateBackupResponse
+	(*ListBackupsRequest)(nil),    // 3: backup.v1.ListBackupsRequest
+	(*ListBackupsResponse)(nil),   // 4: backup.v1.ListBackupsResponse
+	(*timestamppb.Timestamp)(nil), // 5: google.protobuf.Timestamp
+	(*v1.Operation)(nil),          // 6: operations.v1.Operation
+}
+var file_backup_v1_backup_service_proto_depIdxs = []int32{
+	5, // 0: backup.v1.Backup.created_at:type_name -> google.protobuf.Timestamp
+	6, // 1: backup.v1.CreateBackupResponse.operation:type_name -> operations.v1.Operation
+	0, // 2: backup.v1.ListBackupsResponse.backups:type_name -> backup.v1.Backup
+	1, // 3: backup.v1.BackupService.CreateBackup:input_type -> backup.v1.CreateBackupRequest
+	3, // 4: backup.v1.BackupService.ListBackups:input_type -> backup.v1.ListBackupsRequest
+	2, // 5: backup.v1.BackupService.CreateBackup:output_type -> backup.v1.CreateBackupResponse
+	4, // 6: backup.v1.BackupService.ListBackups:output_type -> backup.v1.ListBackupsResponse
+	5, // [5:7] is the sub-list for method output_type
+	3, // [3:5] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
+}
+
+func init() { file_backup_v1_backup_service_proto_init() }
+func file_backup_v1_backup_service_proto_init() {
+	if File_backup_v1_backup_service_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_backup_v1_backup_service_proto_rawDesc), len(file_backup_v1_backup_service_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   5,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_backup_v1_backup_service_proto_goTypes,
+		DependencyIndexes: file_backup_v1_backup_service_proto_depIdxs,
+		MessageInfos:      file_backup_v1_backup_service_proto_msgTypes,
+	}.Build()
+	File_backup_v1_backup_service_proto = out.File
+	file_backup_v1_backup_service_proto_goTypes = nil
+	file_backup_v1_backup_service_proto_depIdxs = nil
+}
@@ -0,0 +1,153 @@
+// ==============================================================================
+// Backup Service API
+// Logical exports of a service schema to object storage
+// ==============================================================================
+
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: backup/v1/backup_service.proto
+
+package backupv1connect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	v1 "github.com/daisuke8000/example-ec-platform/gen/backup/v1"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// BackupServiceName is the fully-qualified name of the BackupService service.
+	BackupServiceName = "backup.v1.BackupService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// BackupServiceCreateBackupProcedure is the fully-qualified name of the BackupService's
+	// CreateBackup RPC.
+	BackupServiceCreateBackupProcedure = "/backup.v1.BackupService/CreateBackup"
+	// BackupServiceListBackupsProcedure is the fully-qualified name of the BackupService's ListBackups
+	// RPC.
+	BackupServiceListBackupsProcedure = "/backup.v1.BackupService/ListBackups"
+)
+
+// BackupServiceClient is a client for the backup.v1.BackupService service.
+type BackupServiceClient interface {
+	// CreateBackup starts an export. The returned operation's result holds the
+	// backup once it succeeds; poll OperationsService.GetOperation. Backups
+	// outside the retention policy are deleted after the export.
+	CreateBackup(context.Context, *connect.Request[v1.CreateBackupRequest]) (*connect.Response[v1.CreateBackupResponse], error)
+	// ListBackups returns the stored backups of the schema, newest first.
+	ListBackups(context.Context, *connect.Request[v1.ListBackupsRequest]) (*connect.Response[v1.ListBackupsResponse], error)
+}
+
+// NewBackupServiceClient constructs a client for the backup.v1.BackupService service. By default,
+// it uses the Connect protocol with the binary Protobuf Codec, asks for gzipped responses, and
+// sends uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the connect.WithGRPC()
+// or connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewBackupServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) BackupServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	backupServiceMethods := v1.File_backup_v1_backup_service_proto.Services().ByName("BackupService").Methods()
+	return &backupServiceClient{
+		createBackup: connect.NewClient[v1.CreateBackupRequest, v1.CreateBackupResponse](
+			httpClient,
+			baseURL+BackupServiceCreateBackupProcedure,
+			connect.WithSchema(backupServiceMethods.ByName("CreateBackup")),
+			connect.WithClientOptions(opts...),
+		),
+		listBackups: connect.NewClient[v1.ListBackupsRequest, v1.ListBackupsResponse](
+			httpClient,
+			baseURL+BackupServiceListBackupsProcedure,
+			connect.WithSchema(backupServiceMethods.ByName("ListBackups")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// backupServiceClient implements BackupServiceClient.
+type backupServiceClient struct {
+	createBackup *connect.Client[v1.CreateBackupRequest, v1.CreateBackupResponse]
+	listBackups  *connect.Client[v1.ListBackupsRequest, v1.ListBackupsResponse]
+}
+
+// CreateBackup calls backup.v1.BackupService.CreateBackup.
+func (c *backupServiceClient) CreateBackup(ctx context.Context, req *connect.Request[v1.CreateBackupRequest]) (*connect.Response[v1.CreateBackupResponse], error) {
+	return c.createBackup.CallUnary(ctx, req)
+}
+
+// ListBackups calls backup.v1.BackupService.ListBackups.
+func (c *backupServiceClient) ListBackups(ctx context.Context, req *connect.Request[v1.ListBackupsRequest]) (*connect.Response[v1.ListBackupsResponse], error) {
+	return c.listBackups.CallUnary(ctx, req)
+}
+
+// BackupServiceHandler is an implementation of the backup.v1.BackupService service.
+type BackupServiceHandler interface {
+	// CreateBackup starts an export. The returned operation's result holds the
+	// backup once it succeeds; poll OperationsService.GetOperation. Backups
+	// outside the retention policy are deleted after the export.
+	CreateBackup(context.Context, *connect.Request[v1.CreateBackupRequest]) (*connect.Response[v1.CreateBackupResponse], error)
+	// ListBackups returns the stored backups of the schema, newest first.
+	ListBackups(context.Context, *connect.Request[v1.ListBackupsRequest]) (*connect.Response[v1.ListBackupsResponse], error)
+}
+
+// NewBackupServiceHandler builds an HTTP handler from the service implementation. It returns the
+// path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewBackupServiceHandler(svc BackupServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	backupServiceMethods := v1.File_backup_v1_backup_service_proto.Services().ByName("BackupService").Methods()
+	backupServiceCreateBackupHandler := connect.NewUnaryHandler(
+		BackupServiceCreateBackupProcedure,
+		svc.CreateBackup,
+		connect.WithSchema(backupServiceMethods.ByName("CreateBackup")),
+		connect.WithHandlerOptions(opts...),
+	)
+	backupServiceListBackupsHandler := connect.NewUnaryHandler(
+		BackupServiceListBackupsProcedure,
+		svc.ListBackups,
+		connect.WithSchema(backupServiceMethods.ByName("ListBackups")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/backup.v1.BackupService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case BackupServiceCreateBackupProcedure:
+			backupServiceCreateBackupHandler.ServeHTTP(w, r)
+		case BackupServiceListBackupsProcedure:
+			backupServiceListBackupsHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedBackupServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedBackupServiceHandler struct{}
+
+func (UnimplementedBackupServiceHandler) CreateBackup(context.Context, *connect.Request[v1.CreateBackupRequest]) (*connect.Response[v1.CreateBackupResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("backup.v1.BackupService.CreateBackup is not implemented"))
+}
+
+func (UnimplementedBackupServiceHandler) ListBackups(context.Context, *connect.Request[v1.ListBackupsRequest]) (*connect.Response[v1.ListBackupsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("backup.v1.BackupService.ListBackups is not implemented"))
+}
@@ -0,0 +1,1000 @@
+// ==============================================================================
+// Digital Goods Service API
+// Fulfillment of SKUs delivered as license keys or downloads instead of shipped
+// ==============================================================================
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: product/v1/digital_goods_service.proto
+
+package productv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type DigitalKind int32
+
+const (
+	DigitalKind_DIGITAL_KIND_UNSPECIFIED DigitalKind = 0
+	DigitalKind_DIGITAL_KIND_LICENSE_KEY DigitalKind = 1 // One key from the SKU's pool per unit
+	DigitalKind_DIGITAL_KIND_DOWNLOAD    DigitalKind = 2 // A limited number of downloads of a file
+)
+
+// Enum value maps for DigitalKind.
+var (
+	DigitalKind_name = map[int32]string{
+		0: "DIGITAL_KIND_UNSPECIFIED",
+		1: "DIGITAL_KIND_LICENSE_KEY",
+		2: "DIGITAL_KIND_DOWNLOAD",
+	}
+	DigitalKind_value = map[string]int32{
+		"DIGITAL_KIND_UNSPECIFIED": 0,
+		"DIGITAL_KIND_LICENSE_KEY": 1,
+		"DIGITAL_KIND_DOWNLOAD":    2,
+	}
+)
+
+func (x DigitalKind) Enum() *DigitalKind {
+	p := new(DigitalKind)
+	*p = x
+	return p
+}
+
+func (x DigitalKind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (DigitalKind) Descriptor() protoreflect.EnumDescriptor {
+	return file_product_v1_digital_goods_service_proto_enumTypes[0].Descriptor()
+}
+
+func (DigitalKind) Type() protoreflect.EnumType {
+	return &file_product_v1_digital_goods_service_proto_enumTypes[0]
+}
+
+func (x DigitalKind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use DigitalKind.Descriptor instead.
+func (DigitalKind) EnumDescriptor() ([]byte, []int) {
+	return file_product_v1_digital_goods_service_proto_rawDescGZIP(), []int{0}
+}
+
+type DigitalSKU struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	SkuId string                 `protobuf:"bytes,1,opt,name=sku_id,json=skuId,proto3" json:"sku_id,omitempty"`
+	Kind  DigitalKind            `protobuf:"varint,2,opt,name=kind,proto3,enum=product.v1.DigitalKind" json:"kind,omitempty"`
+	// Object key of the file in download storage (downloads only)
+	ObjectKey string `protobuf:"bytes,3,opt,name=object_key,json=objectKey,proto3" json:"object_key,omitempty"`
+	// Downloads per buyer (downloads only)
+	MaxDownloads int32 `protobuf:"varint,4,opt,name=max_downloads,json=maxDownloads,proto3" json:"max_downloads,omitempty"`
+	// Unassigned keys in the pool (license keys only)
+	AvailableKeys int64 `protobuf:"varint,5,opt,name=available_keys,json=availableKeys,proto3" json:"available_keys,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DigitalSKU) Reset() {
+	*x = DigitalSKU{}
+	mi := &file_product_v1_digital_goods_service_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DigitalSKU) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DigitalSKU) ProtoMessage() {}
+
+func (x *DigitalSKU) ProtoReflect() protoreflect.Message {
+	mi := &file_product_v1_digital_goods_service_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DigitalSKU.ProtoReflect.Descriptor instead.
+func (*DigitalSKU) Descriptor() ([]byte, []int) {
+	return file_product_v1_digital_goods_service_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *DigitalSKU) GetSkuId() string {
+	if x != nil {
+		return x.SkuId
+	}
+	return ""
+}
+
+func (x *DigitalSKU) GetKind() DigitalKind {
+	if x != nil {
+		return x.Kind
+	}
+	return DigitalKind_DIGITAL_KIND_UNSPECIFIED
+}
+
+func (x *DigitalSKU) GetObjectKey() string {
+	if x != nil {
+		return x.ObjectKey
+	}
+	return ""
+}
+
+func (x *DigitalSKU) GetMaxDownloads() int32 {
+	if x != nil {
+		return x.MaxDownloads
+	}
+	return 0
+}
+
+func (x *DigitalSKU) GetAvailableKeys() int64 {
+	if x != nil {
+		return x.AvailableKeys
+	}
+	return 0
+}
+
+// DigitalGood is one good a buyer owns: an assigned license key or the
+// downloads of a file.
+type DigitalGood struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Id      string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	OrderId string                 `protobuf:"bytes,2,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	SkuId   string                 `protobuf:"bytes,3,opt,name=sku_id,json=skuId,proto3" json:"sku_id,omitempty"`
+	Kind    DigitalKind            `protobuf:"varint,4,opt,name=kind,proto3,enum=product.v1.DigitalKind" json:"kind,omitempty"`
+	// Assigned key; only returned by RetrieveDigitalGoods
+	LicenseKey string `protobuf:"bytes,5,opt,name=license_key,json=licenseKey,proto3" json:"license_key,omitempty"`
+	// Presigned URL of the file; set when a download was requested and one
+	// was left
+	DownloadUrl          string                 `protobuf:"bytes,6,opt,name=download_url,json=downloadUrl,proto3" json:"download_url,omitempty"`
+	DownloadUrlExpiresAt *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=download_url_expires_at,json=downloadUrlExpiresAt,proto3" json:"download_url_expires_at,omitempty"`
+	DownloadsRemaining   int32                  `protobuf:"varint,8,opt,name=downloads_remaining,json=downloadsRemaining,proto3" json:"downloads_remaining,omitempty"`
+	CreatedAt            *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *DigitalGood) Reset() {
+	*x = DigitalGood{}
+	mi := &file_product_v1_digital_goods_service_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DigitalGood) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DigitalGood) ProtoMessage() {}
+
+func (x *DigitalGood) ProtoReflect() protoreflect.Message {
+	mi := &file_product_v1_digital_goods_service_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DigitalGood.ProtoReflect.Descriptor instead.
+func (*DigitalGood) Descriptor() ([]byte, []int) {
+	return file_product_v1_digital_goods_service_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *DigitalGood) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *DigitalGood) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+func (x *DigitalGood) GetSkuId() string {
+	if x != nil {
+		return x.SkuId
+	}
+	return ""
+}
+
+func (x *DigitalGood) GetKind() DigitalKind {
+	if x != nil {
+		return x.Kind
+	}
+	return DigitalKind_DIGITAL_KIND_UNSPECIFIED
+}
+
+func (x *DigitalGood) GetLicenseKey() string {
+	if x != nil {
+		return x.LicenseKey
+	}
+	return ""
+}
+
+func (x *DigitalGood) GetDownloadUrl() string {
+	if x != nil {
+		return x.DownloadUrl
+	}
+	return ""
+}
+
+func (x *DigitalGood) GetDownloadUrlExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DownloadUrlExpiresAt
+	}
+	return nil
+}
+
+func (x *DigitalGood) GetDownloadsRemaining() int32 {
+	if x != nil {
+		return x.DownloadsRemaining
+	}
+	return 0
+}
+
+func (x *DigitalGood) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type SetDigitalFulfillmentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SkuId         string                 `protobuf:"bytes,1,opt,name=sku_id,json=skuId,proto3" json:"sku_id,omitempty"`
+	Kind          DigitalKind            `protobuf:"varint,2,opt,name=kind,proto3,enum=product.v1.DigitalKind" json:"kind,omitempty"`
+	ObjectKey     string                 `protobuf:"bytes,3,opt,name=object_key,json=objectKey,proto3" json:"object_key,omitempty"`
+	MaxDownloads  int32                  `protobuf:"varint,4,opt,name=max_downloads,json=maxDownloads,proto3" json:"max_downloads,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetDigitalFulfillmentRequest) Reset() {
+	*x = SetDigitalFulfillmentRequest{}
+	mi := &file_product_v1_digital_goods_service_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetDigitalFulfillmentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetDigitalFulfillmentRequest) ProtoMessage() {}
+
+func (x *SetDigitalFulfillmentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_v1_digital_goods_service_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetDigitalFulfillmentRequest.ProtoReflect.Descriptor instead.
+func (*SetDigitalFulfillmentRequest) Descriptor() ([]byte, []int) {
+	return file_product_v1_digital_goods_service_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *SetDigitalFulfillmentRequest) GetSkuId() string {
+	if x != nil {
+		return x.SkuId
+	}
+	return ""
+}
+
+func (x *SetDigitalFulfillmentRequest) GetKind() DigitalKind {
+	if x != nil {
+		return x.Kind
+	}
+	return DigitalKind_DIGITAL_KIND_UNSPECIFIED
+}
+
+func (x *SetDigitalFulfillmentRequest) GetObjectKey() string {
+	if x != nil {
+		return x.ObjectKey
+	}
+	return ""
+}
+
+func (x *SetDigitalFulfillmentRequest) GetMaxDownloads() int32 {
+	if x != nil {
+		return x.MaxDownloads
+	}
+	return 0
+}
+
+type SetDigitalFulfillmentResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DigitalSku    *DigitalSKU            `protobuf:"bytes,1,opt,name=digital_sku,json=digitalSku,proto3" json:"digital_sku,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetDigitalFulfillmentResponse) Reset() {
+	*x = SetDigitalFulfillmentResponse{}
+	mi := &file_product_v1_digital_goods_service_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetDigitalFulfillmentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetDigitalFulfillmentResponse) ProtoMessage() {}
+
+func (x *SetDigitalFulfillmentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_v1_digital_goods_service_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetDigitalFulfillmentResponse.ProtoReflect.Descriptor instead.
+func (*SetDigitalFulfillmentResponse) Descriptor() ([]byte, []int) {
+	return file_product_v1_digital_goods_service_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *SetDigitalFulfillmentResponse) GetDigitalSku() *DigitalSKU {
+	if x != nil {
+		return x.DigitalSku
+	}
+	return nil
+}
+
+type GetDigitalSKUsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SkuIds        []string               `protobuf:"bytes,1,rep,name=sku_ids,json=skuIds,proto3" json:"sku_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetDigitalSKUsRequest) Reset() {
+	*x = GetDigitalSKUsRequest{}
+	mi := &file_product_v1_digital_goods_service_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDigitalSKUsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDigitalSKUsRequest) ProtoMessage() {}
+
+func (x *GetDigitalSKUsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_v1_digital_goods_service_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDigitalSKUsRequest.ProtoReflect.Descriptor instead.
+func (*GetDigitalSKUsRequest) Descriptor() ([]byte, []int) {
+	return file_product_v1_digital_goods_service_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GetDigitalSKUsRequest) GetSkuIds() []string {
+	if x != nil {
+		return x.SkuIds
+	}
+	return nil
+}
+
+type GetDigitalSKUsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DigitalSkus   []*DigitalSKU          `protobuf:"bytes,1,rep,name=digital_skus,json=digitalSkus,proto3" json:"digital_skus,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetDigitalSKUsResponse) Reset() {
+	*x = GetDigitalSKUsResponse{}
+	mi := &file_product_v1_digital_goods_service_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDigitalSKUsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDigitalSKUsResponse) ProtoMessage() {}
+
+func (x *GetDigitalSKUsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_v1_digital_goods_service_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDigitalSKUsResponse.ProtoReflect.Descriptor instead.
+func (*GetDigitalSKUsResponse) Descriptor() ([]byte, []int) {
+	return file_product_v1_digital_goods_service_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *GetDigitalSKUsResponse) GetDigitalSkus() []*DigitalSKU {
+	if x != nil {
+		return x.DigitalSkus
+	}
+	return nil
+}
+
+type AddLicenseKeysRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SkuId         string                 `protobuf:"bytes,1,opt,name=sku_id,json=skuId,proto3" json:"sku_id,omitempty"`
+	Keys          []string               `protobuf:"bytes,2,rep,name=keys,proto3" json:"keys,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddLicenseKeysRequest) Reset() {
+	*x = AddLicenseKeysRequest{}
+	mi := &file_product_v1_digital_goods_service_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddLicenseKeysRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddLicenseKeysRequest) ProtoMessage() {}
+
+func (x *AddLicenseKeysRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_v1_digital_goods_service_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddLicenseKeysRequest.ProtoReflect.Descriptor instead.
+func (*AddLicenseKeysRequest) Descriptor() ([]byte, []int) {
+	return file_product_v1_digital_goods_service_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *AddLicenseKeysRequest) GetSkuId() string {
+	if x != nil {
+		return x.SkuId
+	}
+	return ""
+}
+
+func (x *AddLicenseKeysRequest) GetKeys() []string {
+	if x != nil {
+		return x.Keys
+	}
+	return nil
+}
+
+type AddLicenseKeysResponse struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	AddedCount int32                  `protobuf:"varint,1,opt,name=added_count,json=addedCount,proto3" json:"added_count,omitempty"`
+	// Keys that were already in the pool
+	DuplicateCount int32 `protobuf:"varint,2,opt,name=duplicate_count,json=duplicateCount,proto3" json:"duplicate_count,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *AddLicenseKeysResponse) Reset() {
+	*x = AddLicenseKeysResponse{}
+	mi := &file_product_v1_digital_goods_service_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddLicenseKeysResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddLicenseKeysResponse) ProtoMessage() {}
+
+func (x *AddLicenseKeysResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_v1_digital_goods_service_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddLicenseKeysResponse.ProtoReflect.Descriptor instead.
+func (*AddLicenseKeysResponse) Descriptor() ([]byte, []int) {
+	return file_product_v1_digital_goods_service_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *AddLicenseKeysResponse) GetAddedCount() int32 {
+	if x != nil {
+		return x.AddedCount
+	}
+	return 0
+}
+
+func (x *AddLicenseKeysResponse) GetDuplicateCount() int32 {
+	if x != nil {
+		return x.DuplicateCount
+	}
+	return 0
+}
+
+type FulfillDigitalOrderRequest struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	OrderId string                 `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	// Buyer who may retrieve the goods
+	UserId string `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// Paid order lines (max 100)
+	Items         []*DigitalOrderItem `protobuf:"bytes,3,rep,name=items,proto3" json:"items,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FulfillDigitalOrderRequest) Reset() {
+	*x = FulfillDigitalOrderRequest{}
+	mi := &file_product_v1_digital_goods_service_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FulfillDigitalOrderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FulfillDigitalOrderRequest) ProtoMessage() {}
+
+func (x *FulfillDigitalOrderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_v1_digital_goods_service_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FulfillDigitalOrderRequest.ProtoReflect.Descriptor instead.
+func (*FulfillDigitalOrderRequest) Descriptor() ([]byte, []int) {
+	return file_product_v1_digital_goods_service_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *FulfillDigitalOrderRequest) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+func (x *FulfillDigitalOrderRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *FulfillDigitalOrderRequest) GetItems() []*DigitalOrderItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+type DigitalOrderItem struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SkuId         string                 `protobuf:"bytes,1,opt,name=sku_id,json=skuId,proto3" json:"sku_id,omitempty"`
+	Quantity      int64                  `protobuf:"varint,2,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DigitalOrderItem) Reset() {
+	*x = DigitalOrderItem{}
+	mi := &file_product_v1_digital_goods_service_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DigitalOrderItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DigitalOrderItem) ProtoMessage() {}
+
+func (x *DigitalOrderItem) ProtoReflect() protoreflect.Message {
+	mi := &file_product_v1_digital_goods_service_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DigitalOrderItem.ProtoReflect.Descriptor instead.
+func (*DigitalOrderItem) Descriptor() ([]byte, []int) {
+	return file_product_v1_digital_goods_service_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *DigitalOrderItem) GetSkuId() string {
+	if x != nil {
+		return x.SkuId
+	}
+	return ""
+}
+
+func (x *DigitalOrderItem) GetQuantity() int64 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+type FulfillDigitalOrderResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Goods granted, without license keys
+	Goods []*DigitalGood `protobuf:"bytes,1,rep,name=goods,proto3" json:"goods,omitempty"`
+	// True if the order was fulfilled before; nothing was assigned
+	Replayed      bool `protobuf:"varint,2,opt,name=replayed,proto3" json:"replayed,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FulfillDigitalOrderResponse) Reset() {
+	*x = FulfillDigitalOrderResponse{}
+	mi := &file_product_v1_digital_goods_service_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FulfillDigitalOrderResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FulfillDigitalOrderResponse) ProtoMessage() {}
+
+func (x *FulfillDigitalOrderResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_v1_digital_goods_service_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FulfillDigitalOrderResponse.ProtoReflect.Descriptor instead.
+func (*FulfillDigitalOrderResponse) Descriptor() ([]byte, []int) {
+	return file_product_v1_digital_goods_service_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *FulfillDigitalOrderResponse) GetGoods() []*DigitalGood {
+	if x != nil {
+		return x.Goods
+	}
+	return nil
+}
+
+func (x *FulfillDigitalOrderResponse) GetReplayed() bool {
+	if x != nil {
+		return x.Replayed
+	}
+	return false
+}
+
+type RetrieveDigitalGoodsRequest struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	OrderId string                 `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	// Limits the response to one good and issues its download URL
+	EntitlementId *string `protobuf:"bytes,2,opt,name=entitlement_id,json=entitlementId,proto3,oneof" json:"entitlement_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RetrieveDigitalGoodsRequest) Reset() {
+	*x = RetrieveDigitalGoodsRequest{}
+	mi := &file_product_v1_digital_goods_service_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RetrieveDigitalGoodsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RetrieveDigitalGoodsRequest) ProtoMessage() {}
+
+func (x *RetrieveDigitalGoodsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_v1_digital_goods_service_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RetrieveDigitalGoodsRequest.ProtoReflect.Descriptor instead.
+func (*RetrieveDigitalGoodsRequest) Descriptor() ([]byte, []int) {
+	return file_product_v1_digital_goods_service_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *RetrieveDigitalGoodsRequest) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+func (x *RetrieveDigitalGoodsRequest) GetEntitlementId() string {
+	if x != nil && x.EntitlementId != nil {
+		return *x.EntitlementId
+	}
+	return ""
+}
+
+type RetrieveDigitalGoodsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Goods         []*DigitalGood         `protobuf:"bytes,1,rep,name=goods,proto3" json:"goods,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RetrieveDigitalGoodsResponse) Reset() {
+	*x = RetrieveDigitalGoodsResponse{}
+	mi := &file_product_v1_digital_goods_service_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RetrieveDigitalGoodsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RetrieveDigitalGoodsResponse) ProtoMessage() {}
+
+func (x *RetrieveDigitalGoodsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_v1_digital_goods_service_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RetrieveDigitalGoodsResponse.ProtoReflect.Descriptor instead.
+func (*RetrieveDigitalGoodsResponse) Descriptor() ([]byte, []int) {
+	return file_product_v1_digital_goods_service_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *RetrieveDigitalGoodsResponse) GetGoods() []*DigitalGood {
+	if x != nil {
+		return x.Goods
+	}
+	return nil
+}
+
+var File_product_v1_digital_goods_service_proto protoreflect.FileDescriptor
+
+const file_product_v1_digital_goods_service_proto_rawDesc = "" +
+	"\n" +
+	"&product/v1/digital_goods_service.proto\x12\n" +
+	"product.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\xbb\x01\n" +
+	"\n" +
+	"DigitalSKU\x12\x15\n" +
+	"\x06sku_id\x18\x01 \x01(\tR\x05skuId\x12+\n" +
+	"\x04kind\x18\x02 \x01(\x0e2\x17.product.v1.DigitalKindR\x04kind\x12\x1d\n" +
+	"\n" +
+	"object_key\x18\x03 \x01(\tR\tobjectKey\x12#\n" +
+	"\rmax_downloads\x18\x04 \x01(\x05R\fmaxDownloads\x12%\n" +
+	"\x0eavailable_keys\x18\x05 \x01(\x03R\ravailableKeys\"\x89\x03\n" +
+	"\vDigitalGood\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x19\n" +
+	"\border_id\x18\x02 \x01(\tR\aorderId\x12\x15\n" +
+	"\x06sku_id\x18\x03 \x01(\tR\x05skuId\x12+\n" +
+	"\x04kind\x18\x04 \x01(\x0e2\x17.product.v1.DigitalKindR\x04kind\x12$\n" +
+	"\vlicense_key\x18\x05 \x01(\tB\x03\x80\x01\x01R\n" +
+	"licenseKey\x12&\n" +
+	"\fdownload_url\x18\x06 \x01(\tB\x03\x80\x01\x01R\vdownloadUrl\x12Q\n" +
+	"\x17download_url_expires_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\x14downloadUrlExpiresAt\x12/\n" +
+	"\x13downloads_remaining\x18\b \x01(\x05R\x12downloadsRemaining\x129\n" +
+	"\n" +
+	"created_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\xa6\x01\n" +
+	"\x1cSetDigitalFulfillmentRequest\x12\x15\n" +
+	"\x06sku_id\x18\x01 \x01(\tR\x05skuId\x12+\n" +
+	"\x04kind\x18\x02 \x01(\x0e2\x17.product.v1.DigitalKindR\x04kind\x12\x1d\n" +
+	"\n" +
+	"object_key\x18\x03 \x01(\tR\tobjectKey\x12#\n" +
+	"\rmax_downloads\x18\x04 \x01(\x05R\fmaxDownloads\"X\n" +
+	"\x1dSetDigitalFulfillmentResponse\x127\n" +
+	"\vdigital_sku\x18\x01 \x01(\v2\x16.product.v1.DigitalSKUR\n" +
+	"digitalSku\"0\n" +
+	"\x15GetDigitalSKUsRequest\x12\x17\n" +
+	"\asku_ids\x18\x01 \x03(\tR\x06skuIds\"S\n" +
+	"\x16GetDigitalSKUsResponse\x129\n" +
+	"\fdigital_skus\x18\x01 \x03(\v2\x16.product.v1.DigitalSKUR\vdigitalSkus\"G\n" +
+	"\x15AddLicenseKeysRequest\x12\x15\n" +
+	"\x06sku_id\x18\x01 \x01(\tR\x05skuId\x12\x17\n" +
+	"\x04keys\x18\x02 \x03(\tB\x03\x80\x01\x01R\x04keys\"b\n" +
+	"\x16AddLicenseKeysResponse\x12\x1f\n" +
+	"\vadded_count\x18\x01 \x01(\x05R\n" +
+	"addedCount\x12'\n" +
+	"\x0fduplicate_count\x18\x02 \x01(\x05R\x0eduplicateCount\"\x84\x01\n" +
+	"\x1aFulfillDigitalOrderRequest\x12\x19\n" +
+	"\border_id\x18\x01 \x01(\tR\aorderId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x122\n" +
+	"\x05items\x18\x03 \x03(\v2\x1c.product.v1.DigitalOrderItemR\x05items\"E\n" +
+	"\x10DigitalOrderItem\x12\x15\n" +
+	"\x06sku_id\x18\x01 \x01(\tR\x05skuId\x12\x1a\n" +
+	"\bquantity\x18\x02 \x01(\x03R\bquantity\"h\n" +
+	"\x1bFulfillDigitalOrderResponse\x12-\n" +
+	"\x05goods\x18\x01 \x03(\v2\x17.product.v1.DigitalGoodR\x05goods\x12\x1a\n" +
+	"\breplayed\x18\x02 \x01(\bR\breplayed\"w\n" +
+	"\x1bRetrieveDigitalGoodsRequest\x12\x19\n" +
+	"\border_id\x18\x01 \x01(\tR\aorderId\x12*\n" +
+	"\x0eentitlement_id\x18\x02 \x01(\tH\x00R\rentitlementId\x88\x01\x01B\x11\n" +
+	"\x0f_entitlement_id\"M\n" +
+	"\x1cRetrieveDigitalGoodsResponse\x12-\n" +
+	"\x05goods\x18\x01 \x03(\v2\x17.product.v1.DigitalGoodR\x05goods*d\n" +
+	"\vDigitalKind\x12\x1c\n" +
+	"\x18DIGITAL_KIND_UNSPECIFIED\x10\x00\x12\x1c\n" +
+	"\x18DIGITAL_KIND_LICENSE_KEY\x10\x01\x12\x19\n" +
+	"\x15DIGITAL_KIND_DOWNLOAD\x10\x022\x88\x04\n" +
+	"\x13DigitalGoodsService\x12l\n" +
+	"\x15SetDigitalFulfillment\x12(.product.v1.SetDigitalFulfillmentRequest\x1a).product.v1.SetDigitalFulfillmentResponse\x12W\n" +
+	"\x0eGetDigitalSKUs\x12!.product.v1.GetDigitalSKUsRequest\x1a\".product.v1.GetDigitalSKUsResponse\x12W\n" +
+	"\x0eAddLicenseKeys\x12!.product.v1.AddLicenseKeysRequest\x1a\".product.v1.AddLicenseKeysResponse\x12f\n" +
+	"\x13FulfillDigitalOrder\x12&.product.v1.FulfillDigitalOrderRequest\x1a'.product.v1.FulfillDigitalOrderResponse\x12i\n" +
+	"\x14RetrieveDigitalGoods\x12'.product.v1.RetrieveDigitalGoodsRequest\x1a(.product.v1.RetrieveDigitalGoodsResponseB\xb8\x01\n" +
+	"\x0ecom.product.v1B\x18DigitalGoodsServiceProtoP\x01ZCgithub.com/daisuke8000/example-ec-platform/gen/product/v1;productv1\xa2\x02\x03PXX\xaa\x02\n" +
+	"Product.V1\xca\x02\n" +
+	"Product\\V1\xe2\x02\x16Product\\V1\\GPBMetadata\xea\x02\vProduct::V1b\x06proto3"
+
+var (
+	file_product_v1_digital_goods_service_proto_rawDescOnce sync.Once
+	file_product_v1_digital_goods_service_proto_rawDescData []byte
+)
+
+func file_product_v1_digital_goods_service_proto_rawDescGZIP() []byte {
+	file_product_v1_digital_goods_service_proto_rawDescOnce.Do(func() {
+		file_product_v1_digital_goods_service_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_product_v1_digital_goods_service_proto_rawDesc), len(file_product_v1_digital_goods_service_proto_rawDesc)))
+	})
+	return file_product_v1_digital_goods_service_proto_rawDescData
+}
+
+var file_product_v1_digital_goods_service_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_product_v1_digital_goods_service_proto_msgTypes = make([]protoimpl.MessageInfo, 13)
+var file_product_v1_digital_goods_service_proto_goTypes = []any{
+	(DigitalKind)(0),                      // 0: product.v1.DigitalKind
+	(*DigitalSKU)(nil),                    // 1: product.v1.DigitalSKU
+	(*DigitalGood)(nil),                   // 2: product.v1.DigitalGood
+	(*SetDigitalFulfillmentRequest)(nil),  // 3: product.v1.SetDigitalFulfillmentRequest
+	(*SetDigitalFulfillmentResponse)(nil), // 4: product.v1.SetDigitalFulfillmentResponse
+	(*GetDigitalSKUsRequest)(nil),         // 5: product.v1.GetDigitalSKUsRequest
+	(*GetDigitalSKUsResponse)(nil),        // 6: product.v1.GetDigitalSKUsResponse
+	(*AddLicenseKeysRequest)(nil),         // 7: product.v1.AddLicenseKeysRequest
+	(*AddLicenseKeysResponse)(nil),        // 8: product.v1.AddLicenseKeysResponse
+	(*FulfillDigitalOrderRequest)(nil),    // 9: product.v1.FulfillDigitalOrderRequest
+	(*DigitalOrderItem)(nil),              // 10: product.v1.DigitalOrderItem
+	(*FulfillDigitalOrderResponse)(nil),   // 11: product.v1.FulfillDigitalOrderResponse
+	(*RetrieveDigitalGoodsRequest)(nil),   // 12: product.v1.RetrieveDigitalGoodsRequest
+	(*RetrieveDigitalGoodsResponse)(nil),  // 13: product.v1.RetrieveDigitalGoodsResponse
+	(*timestamppb.Timestamp)(nil),         // 14: google.protobuf.Timestamp
+}
+var file_product_v1_digital_goods_service_proto_depIdxs = []int32{
+	0,  // 0: product.v1.DigitalSKU.kind:type_name -> product.v1.DigitalKind
+	0,  // 1: product.v1.DigitalGood.kind:type_name -> product.v1.DigitalKind
+	14, // 2: product.v1.DigitalGood.download_url_expires_at:type_name -> google.protobuf.Timestamp
+	14, // 3: product.v1.DigitalGood.created_at:type_name -> google.protobuf.Timestamp
+	0,  // 4: product.v1.SetDigitalFulfillmentRequest.kind:type_name -> product.v1.DigitalKind
+	1,  // 5: product.v1.SetDigitalFulfillmentResponse.digital_sku:type_name -> product.v1.DigitalSKU
+	1,  // 6: product.v1.GetDigitalSKUsResponse.digital_skus:type_name -> product.v1.DigitalSKU
+	10, // 7: product.v1.FulfillDigitalOrderRequest.items:type_name -> product.v1.DigitalOrderItem
+	2,  // 8: product.v1.FulfillDigitalOrderResponse.goods:type_name -> product.v1.DigitalGood
+	2,  // 9: product.v1.RetrieveDigitalGoodsResponse.goods:type_name -> product.v1.DigitalGood
+	3,  // 10: product.v1.DigitalGoodsService.SetDigitalFulfillment:input_type -> product.v1.SetDigitalFulfillmentRequest
+	5,  // 11: product.v1.DigitalGoodsService.GetDigitalSKUs:input_type -> product.v1.GetDigitalSKUsRequest
+	7,  // 12: product.v1.DigitalGoodsService.AddLicenseKeys:input_type -> product.v1.AddLicenseKeysRequest
+	9,  // 13: product.v1.DigitalGoodsService.FulfillDigitalOrder:input_type -> product.v1.FulfillDigitalOrderRequest
+	12, // 14: product.v1.DigitalGoodsService.RetrieveDigitalGoods:input_type -> product.v1.RetrieveDigitalGoodsRequest
+	4,  // 15: product.v1.DigitalGoodsService.SetDigitalFulfillment:output_type -> product.v1.SetDigitalFulfillmentResponse
+	6,  // 16: product.v1.DigitalGoodsService.GetDigitalSKUs:output_type -> product.v1.GetDigitalSKUsResponse
+	8,  // 17: product.v1.DigitalGoodsService.AddLicenseKeys:output_type -> product.v1.AddLicenseKeysResponse
+	11, // 18: product.v1.DigitalGoodsService.FulfillDigitalOrder:output_type -> product.v1.FulfillDigitalOrderResponse
+	13, // 19: product.v1.DigitalGoodsService.RetrieveDigitalGoods:output_type -> product.v1.RetrieveDigitalGoodsResponse
+	15, // [15:20] is the sub-list for method output_type
+	10, // [10:15] is the sub-list for method input_type
+	10, // [10:10] is the sub-list for extension type_name
+	10, // [10:10] is the sub-list for extension extendee
+	0,  // [0:10] is the sub-list for field type_name
+}
+
+func init() { file_product_v1_digital_goods_service_proto_init() }
+func file_product_v1_digital_goods_service_proto_init() {
+	if File_product_v1_digital_goods_service_proto != nil {
+		return
+	}
+	file_product_v1_digital_goods_service_proto_msgTypes[11].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_product_v1_digital_goods_service_proto_rawDesc), len(file_product_v1_digital_goods_service_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   13,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_product_v1_digital_goods_service_proto_goTypes,
+		DependencyIndexes: file_product_v1_digital_goods_service_proto_depIdxs,
+		EnumInfos:         file_product_v1_digital_goods_service_proto_enumTypes,
+		MessageInfos:      file_product_v1_digital_goods_service_proto_msgTypes,
+	}.Build()
+	File_product_v1_digital_goods_service_proto = out.File
+	file_product_v1_digital_goods_service_proto_goTypes = nil
+	file_product_v1_digital_goods_service_proto_depIdxs = nil
+}
@@ -0,0 +1,574 @@
+// ==============================================================================
+// Favorite Service API
+// Per-user wishlists of products
+// ==============================================================================
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: product/v1/favorite_service.proto
+
+package productv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type Favorite struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Product       *Product               `protobuf:"bytes,1,opt,name=product,proto3" json:"product,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"` // When the product was added
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Favorite) Reset() {
+	*x = Favorite{}
+	mi := &file_product_v1_favorite_service_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Favorite) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Favorite) ProtoMessage() {}
+
+func (x *Favorite) ProtoReflect() protoreflect.Message {
+	mi := &file_product_v1_favorite_service_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Favorite.ProtoReflect.Descriptor instead.
+func (*Favorite) Descriptor() ([]byte, []int) {
+	return file_product_v1_favorite_service_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Favorite) GetProduct() *Product {
+	if x != nil {
+		return x.Product
+	}
+	return nil
+}
+
+func (x *Favorite) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type AddFavoriteRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	ProductId     string                 `protobuf:"bytes,2,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddFavoriteRequest) Reset() {
+	*x = AddFavoriteRequest{}
+	mi := &file_product_v1_favorite_service_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddFavoriteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddFavoriteRequest) ProtoMessage() {}
+
+func (x *AddFavoriteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_v1_favorite_service_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddFavoriteRequest.ProtoReflect.Descriptor instead.
+func (*AddFavoriteRequest) Descriptor() ([]byte, []int) {
+	return file_product_v1_favorite_service_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *AddFavoriteRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *AddFavoriteRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+type AddFavoriteResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddFavoriteResponse) Reset() {
+	*x = AddFavoriteResponse{}
+	mi := &file_product_v1_favorite_service_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddFavoriteResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddFavoriteResponse) ProtoMessage() {}
+
+func (x *AddFavoriteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_v1_favorite_service_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddFavoriteResponse.ProtoReflect.Descriptor instead.
+func (*AddFavoriteResponse) Descriptor() ([]byte, []int) {
+	return file_product_v1_favorite_service_proto_rawDescGZIP(), []int{2}
+}
+
+type RemoveFavoriteRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	ProductId     string                 `protobuf:"bytes,2,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveFavoriteRequest) Reset() {
+	*x = RemoveFavoriteRequest{}
+	mi := &file_product_v1_favorite_service_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveFavoriteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveFavoriteRequest) ProtoMessage() {}
+
+func (x *RemoveFavoriteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_v1_favorite_service_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveFavoriteRequest.ProtoReflect.Descriptor instead.
+func (*RemoveFavoriteRequest) Descriptor() ([]byte, []int) {
+	return file_product_v1_favorite_service_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *RemoveFavoriteRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *RemoveFavoriteRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+type RemoveFavoriteResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveFavoriteResponse) Reset() {
+	*x = RemoveFavoriteResponse{}
+	mi := &file_product_v1_favorite_service_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveFavoriteResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveFavoriteResponse) ProtoMessage() {}
+
+func (x *RemoveFavoriteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_v1_favorite_service_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveFavoriteResponse.ProtoReflect.Descriptor instead.
+func (*RemoveFavoriteResponse) Descriptor() ([]byte, []int) {
+	return file_product_v1_favorite_service_proto_rawDescGZIP(), []int{4}
+}
+
+type ListFavoritesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	PageSize      int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"` // Default 20, max 100
+	PageToken     string                 `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListFavoritesRequest) Reset() {
+	*x = ListFavoritesRequest{}
+	mi := &file_product_v1_favorite_service_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListFavoritesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListFavoritesRequest) ProtoMessage() {}
+
+func (x *ListFavoritesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_v1_favorite_service_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListFavoritesRequest.ProtoReflect.Descriptor instead.
+func (*ListFavoritesRequest) Descriptor() ([]byte, []int) {
+	return file_product_v1_favorite_service_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ListFavoritesRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ListFavoritesRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListFavoritesRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type ListFavoritesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Favorites     []*Favorite            `protobuf:"bytes,1,rep,name=favorites,proto3" json:"favorites,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListFavoritesResponse) Reset() {
+	*x = ListFavoritesResponse{}
+	mi := &file_product_v1_favorite_service_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListFavoritesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListFavoritesResponse) ProtoMessage() {}
+
+func (x *ListFavoritesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_v1_favorite_service_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListFavoritesResponse.ProtoReflect.Descriptor instead.
+func (*ListFavoritesResponse) Descriptor() ([]byte, []int) {
+	return file_product_v1_favorite_service_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ListFavoritesResponse) GetFavorites() []*Favorite {
+	if x != nil {
+		return x.Favorites
+	}
+	return nil
+}
+
+func (x *ListFavoritesResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type GetFavoriteCountsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductIds    []string               `protobuf:"bytes,1,rep,name=product_ids,json=productIds,proto3" json:"product_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetFavoriteCountsRequest) Reset() {
+	*x = GetFavoriteCountsRequest{}
+	mi := &file_product_v1_favorite_service_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetFavoriteCountsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFavoriteCountsRequest) ProtoMessage() {}
+
+func (x *GetFavoriteCountsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_v1_favorite_service_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFavoriteCountsRequest.ProtoReflect.Descriptor instead.
+func (*GetFavoriteCountsRequest) Descriptor() ([]byte, []int) {
+	return file_product_v1_favorite_service_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *GetFavoriteCountsRequest) GetProductIds() []string {
+	if x != nil {
+		return x.ProductIds
+	}
+	return nil
+}
+
+type GetFavoriteCountsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Keyed by product ID; products nobody saved are absent
+	Counts        map[string]int64 `protobuf:"bytes,1,rep,name=counts,proto3" json:"counts,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetFavoriteCountsResponse) Reset() {
+	*x = GetFavoriteCountsResponse{}
+	mi := &file_product_v1_favorite_service_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetFavoriteCountsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFavoriteCountsResponse) ProtoMessage() {}
+
+func (x *GetFavoriteCountsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_v1_favorite_service_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFavoriteCountsResponse.ProtoReflect.Descriptor instead.
+func (*GetFavoriteCountsResponse) Descriptor() ([]byte, []int) {
+	return file_product_v1_favorite_service_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *GetFavoriteCountsResponse) GetCounts() map[string]int64 {
+	if x != nil {
+		return x.Counts
+	}
+	return nil
+}
+
+var File_product_v1_favorite_service_proto protoreflect.FileDescriptor
+
+const file_product_v1_favorite_service_proto_rawDesc = "" +
+	"\n" +
+	"!product/v1/favorite_service.proto\x12\n" +
+	"product.v1\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x16product/v1/types.proto\"t\n" +
+	"\bFavorite\x12-\n" +
+	"\aproduct\x18\x01 \x01(\v2\x13.product.v1.ProductR\aproduct\x129\n" +
+	"\n" +
+	"created_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"L\n" +
+	"\x12AddFavoriteRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x02 \x01(\tR\tproductId\"\x15\n" +
+	"\x13AddFavoriteResponse\"O\n" +
+	"\x15RemoveFavoriteRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x02 \x01(\tR\tproductId\"\x18\n" +
+	"\x16RemoveFavoriteResponse\"k\n" +
+	"\x14ListFavoritesRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1b\n" +
+	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x03 \x01(\tR\tpageToken\"s\n" +
+	"\x15ListFavoritesResponse\x122\n" +
+	"\tfavorites\x18\x01 \x03(\v2\x14.product.v1.FavoriteR\tfavorites\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\";\n" +
+	"\x18GetFavoriteCountsRequest\x12\x1f\n" +
+	"\vproduct_ids\x18\x01 \x03(\tR\n" +
+	"productIds\"\xa1\x01\n" +
+	"\x19GetFavoriteCountsResponse\x12I\n" +
+	"\x06counts\x18\x01 \x03(\v21.product.v1.GetFavoriteCountsResponse.CountsEntryR\x06counts\x1a9\n" +
+	"\vCountsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x03R\x05value:\x028\x012\xf2\x02\n" +
+	"\x0fFavoriteService\x12N\n" +
+	"\vAddFavorite\x12\x1e.product.v1.AddFavoriteRequest\x1a\x1f.product.v1.AddFavoriteResponse\x12W\n" +
+	"\x0eRemoveFavorite\x12!.product.v1.RemoveFavoriteRequest\x1a\".product.v1.RemoveFavoriteResponse\x12T\n" +
+	"\rListFavorites\x12 .product.v1.ListFavoritesRequest\x1a!.product.v1.ListFavoritesResponse\x12`\n" +
+	"\x11GetFavoriteCounts\x12$.product.v1.GetFavoriteCountsRequest\x1a%.product.v1.GetFavoriteCountsResponseB\xb4\x01\n" +
+	"\x0ecom.product.v1B\x14FavoriteServiceProtoP\x01ZCgithub.com/daisuke8000/example-ec-platform/gen/product/v1;productv1\xa2\x02\x03PXX\xaa\x02\n" +
+	"Product.V1\xca\x02\n" +
+	"Product\\V1\xe2\x02\x16Product\\V1\\GPBMetadata\xea\x02\vProduct::V1b\x06proto3"
+
+var (
+	file_product_v1_favorite_service_proto_rawDescOnce sync.Once
+	file_product_v1_favorite_service_proto_rawDescData []byte
+)
+
+func file_product_v1_favorite_service_proto_rawDescGZIP() []byte {
+	file_product_v1_favorite_service_proto_rawDescOnce.Do(func() {
+		file_product_v1_favorite_service_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_product_v1_favorite_service_proto_rawDesc), len(file_product_v1_favorite_service_proto_rawDesc)))
+	})
+	return file_product_v1_favorite_service_proto_rawDescData
+}
+
+var file_product_v1_favorite_service_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
+var file_product_v1_favorite_service_proto_goTypes = []any{
+	(*Favorite)(nil),                  // 0: product.v1.Favorite
+	(*AddFavoriteRequest)(nil),        // 1: product.v1.AddFavoriteRequest
+	(*AddFavoriteResponse)(nil),       // 2: product.v1.AddFavoriteResponse
+	(*RemoveFavoriteRequest)(nil),     // 3: product.v1.RemoveFavoriteRequest
+	(*RemoveFavoriteResponse)(nil),    // 4: product.v1.RemoveFavoriteResponse
+	(*ListFavoritesRequest)(nil),      // 5: product.v1.ListFavoritesRequest
+	(*ListFavoritesResponse)(nil),     // 6: product.v1.ListFavoritesResponse
+	(*GetFavoriteCountsRequest)(nil),  // 7: product.v1.GetFavoriteCountsRequest
+	(*GetFavoriteCountsResponse)(nil), // 8: product.v1.GetFavoriteCountsResponse
+	nil,                               // 9: product.v1.GetFavoriteCountsResponse.CountsEntry
+	(*Product)(nil),                   // 10: product.v1.Product
+	(*timestamppb.Timestamp)(nil),     // 11: google.protobuf.Timestamp
+}
+var file_product_v1_favorite_service_proto_depIdxs = []int32{
+	10, // 0: product.v1.Favorite.product:type_name -> product.v1.Product
+	11, // 1: product.v1.Favorite.created_at:type_name -> google.protobuf.Timestamp
+	0,  // 2: product.v1.ListFavoritesResponse.favorites:type_name -> product.v1.Favorite
+	9,  // 3: product.v1.GetFavoriteCountsResponse.counts:type_name -> product.v1.GetFavoriteCountsResponse.CountsEntry
+	1,  // 4: product.v1.FavoriteService.AddFavorite:input_type -> product.v1.AddFavoriteRequest
+	3,  // 5: product.v1.FavoriteService.RemoveFavorite:input_type -> product.v1.RemoveFavoriteRequest
+	5,  // 6: product.v1.FavoriteService.ListFavorites:input_type -> product.v1.ListFavoritesRequest
+	7,  // 7: product.v1.FavoriteService.GetFavoriteCounts:input_type -> product.v1.GetFavoriteCountsRequest
+	2,  // 8: product.v1.FavoriteService.AddFavorite:output_type -> product.v1.AddFavoriteResponse
+	4,  // 9: product.v1.FavoriteService.RemoveFavorite:output_type -> product.v1.RemoveFavoriteResponse
+	6,  // 10: product.v1.FavoriteService.ListFavorites:output_type -> product.v1.ListFavoritesResponse
+	8,  // 11: product.v1.FavoriteService.GetFavoriteCounts:output_type -> product.v1.GetFavoriteCountsResponse
+	8,  // [8:12] is the sub-list for method output_type
+	4,  // [4:8] is the sub-list for method input_type
+	4,  // [4:4] is the sub-list for extension type_name
+	4,  // [4:4] is the sub-list for extension extendee
+	0,  // [0:4] is the sub-list for field type_name
+}
+
+func init() { file_product_v1_favorite_service_proto_init() }
+func file_product_v1_favorite_service_proto_init() {
+	if File_product_v1_favorite_service_proto != nil {
+		return
+	}
+	file_product_v1_types_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_product_v1_favorite_service_proto_rawDesc), len(file_product_v1_favorite_service_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   10,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_product_v1_favorite_service_proto_goTypes,
+		DependencyIndexes: file_product_v1_favorite_service_proto_depIdxs,
+		MessageInfos:      file_product_v1_favorite_service_proto_msgTypes,
+	}.Build()
+	File_product_v1_favorite_service_proto = out.File
+	file_product_v1_favorite_service_proto_goTypes = nil
+	file_product_v1_favorite_service_proto_depIdxs = nil
+}
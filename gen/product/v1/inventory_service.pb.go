@@ -14,6 +14,7 @@ package productv1
 import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
 	unsafe "unsafe"
@@ -26,6 +27,62 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// ReservationLocking selects the concurrency control of a reservation.
+type ReservationLocking int32
+
+const (
+	ReservationLocking_RESERVATION_LOCKING_UNSPECIFIED ReservationLocking = 0
+	// Conditional updates without waiting up front; a reservation that loses a
+	// race with a concurrent one is rolled back and retried. Cheapest when
+	// SKUs are rarely reserved at the same time.
+	ReservationLocking_RESERVATION_LOCKING_OPTIMISTIC ReservationLocking = 1
+	// Locks the SKUs' inventory rows (SELECT ... FOR UPDATE, in SKU ID order)
+	// before checking stock, so concurrent reservations queue instead of
+	// retrying. Suited to hot SKUs, e.g. during flash sales.
+	ReservationLocking_RESERVATION_LOCKING_PESSIMISTIC ReservationLocking = 2
+)
+
+// Enum value maps for ReservationLocking.
+var (
+	ReservationLocking_name = map[int32]string{
+		0: "RESERVATION_LOCKING_UNSPECIFIED",
+		1: "RESERVATION_LOCKING_OPTIMISTIC",
+		2: "RESERVATION_LOCKING_PESSIMISTIC",
+	}
+	ReservationLocking_value = map[string]int32{
+		"RESERVATION_LOCKING_UNSPECIFIED": 0,
+		"RESERVATION_LOCKING_OPTIMISTIC":  1,
+		"RESERVATION_LOCKING_PESSIMISTIC": 2,
+	}
+)
+
+func (x ReservationLocking) Enum() *ReservationLocking {
+	p := new(ReservationLocking)
+	*p = x
+	return p
+}
+
+func (x ReservationLocking) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ReservationLocking) Descriptor() protoreflect.EnumDescriptor {
+	return file_product_v1_inventory_service_proto_enumTypes[0].Descriptor()
+}
+
+func (ReservationLocking) Type() protoreflect.EnumType {
+	return &file_product_v1_inventory_service_proto_enumTypes[0]
+}
+
+func (x ReservationLocking) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ReservationLocking.Descriptor instead.
+func (ReservationLocking) EnumDescriptor() ([]byte, []int) {
+	return file_product_v1_inventory_service_proto_rawDescGZIP(), []int{0}
+}
+
 type GetInventoryRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	SkuId         string                 `protobuf:"bytes,1,opt,name=sku_id,json=skuId,proto3" json:"sku_id,omitempty"`
@@ -114,29 +171,27 @@ func (x *GetInventoryResponse) GetInventory() *Inventory {
 	return nil
 }
 
-type UpdateInventoryRequest struct {
+type WatchInventoryRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	SkuId         string                 `protobuf:"bytes,1,opt,name=sku_id,json=skuId,proto3" json:"sku_id,omitempty"`
-	Quantity      int64                  `protobuf:"varint,2,opt,name=quantity,proto3" json:"quantity,omitempty"` // New absolute quantity (not delta)
-	Version       int64                  `protobuf:"varint,3,opt,name=version,proto3" json:"version,omitempty"`   // For optimistic locking; must match current version
+	SkuIds        []string               `protobuf:"bytes,1,rep,name=sku_ids,json=skuIds,proto3" json:"sku_ids,omitempty"` // Max 100
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateInventoryRequest) Reset() {
-	*x = UpdateInventoryRequest{}
+func (x *WatchInventoryRequest) Reset() {
+	*x = WatchInventoryRequest{}
 	mi := &file_product_v1_inventory_service_proto_msgTypes[2]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateInventoryRequest) String() string {
+func (x *WatchInventoryRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateInventoryRequest) ProtoMessage() {}
+func (*WatchInventoryRequest) ProtoMessage() {}
 
-func (x *UpdateInventoryRequest) ProtoReflect() protoreflect.Message {
+func (x *WatchInventoryRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_product_v1_inventory_service_proto_msgTypes[2]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -148,53 +203,39 @@ func (x *UpdateInventoryRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateInventoryRequest.ProtoReflect.Descriptor instead.
-func (*UpdateInventoryRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use WatchInventoryRequest.ProtoReflect.Descriptor instead.
+func (*WatchInventoryRequest) Descriptor() ([]byte, []int) {
 	return file_product_v1_inventory_service_proto_rawDescGZIP(), []int{2}
 }
 
-func (x *UpdateInventoryRequest) GetSkuId() string {
-	if x != nil {
-		return x.SkuId
-	}
-	return ""
-}
-
-func (x *UpdateInventoryRequest) GetQuantity() int64 {
-	if x != nil {
-		return x.Quantity
-	}
-	return 0
-}
-
-func (x *UpdateInventoryRequest) GetVersion() int64 {
+func (x *WatchInventoryRequest) GetSkuIds() []string {
 	if x != nil {
-		return x.Version
+		return x.SkuIds
 	}
-	return 0
+	return nil
 }
 
-type UpdateInventoryResponse struct {
+type WatchInventoryResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Inventory     *Inventory             `protobuf:"bytes,1,opt,name=inventory,proto3" json:"inventory,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateInventoryResponse) Reset() {
-	*x = UpdateInventoryResponse{}
+func (x *WatchInventoryResponse) Reset() {
+	*x = WatchInventoryResponse{}
 	mi := &file_product_v1_inventory_service_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateInventoryResponse) String() string {
+func (x *WatchInventoryResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateInventoryResponse) ProtoMessage() {}
+func (*WatchInventoryResponse) ProtoMessage() {}
 
-func (x *UpdateInventoryResponse) ProtoReflect() protoreflect.Message {
+func (x *WatchInventoryResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_product_v1_inventory_service_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -206,43 +247,41 @@ func (x *UpdateInventoryResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateInventoryResponse.ProtoReflect.Descriptor instead.
-func (*UpdateInventoryResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use WatchInventoryResponse.ProtoReflect.Descriptor instead.
+func (*WatchInventoryResponse) Descriptor() ([]byte, []int) {
 	return file_product_v1_inventory_service_proto_rawDescGZIP(), []int{3}
 }
 
-func (x *UpdateInventoryResponse) GetInventory() *Inventory {
+func (x *WatchInventoryResponse) GetInventory() *Inventory {
 	if x != nil {
 		return x.Inventory
 	}
 	return nil
 }
 
-type BatchReserveInventoryRequest struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// Items to reserve (max 50)
-	Items []*ReservationItem `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
-	// Idempotency key for exactly-once semantics (required, max 256 chars)
-	// Recommended format: "{order-id}-reserve" or UUID
-	IdempotencyKey string `protobuf:"bytes,2,opt,name=idempotency_key,json=idempotencyKey,proto3" json:"idempotency_key,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+type UpdateInventoryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SkuId         string                 `protobuf:"bytes,1,opt,name=sku_id,json=skuId,proto3" json:"sku_id,omitempty"`
+	Quantity      int64                  `protobuf:"varint,2,opt,name=quantity,proto3" json:"quantity,omitempty"` // New absolute quantity (not delta)
+	Version       int64                  `protobuf:"varint,3,opt,name=version,proto3" json:"version,omitempty"`   // For optimistic locking; must match current version
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *BatchReserveInventoryRequest) Reset() {
-	*x = BatchReserveInventoryRequest{}
+func (x *UpdateInventoryRequest) Reset() {
+	*x = UpdateInventoryRequest{}
 	mi := &file_product_v1_inventory_service_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *BatchReserveInventoryRequest) String() string {
+func (x *UpdateInventoryRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BatchReserveInventoryRequest) ProtoMessage() {}
+func (*UpdateInventoryRequest) ProtoMessage() {}
 
-func (x *BatchReserveInventoryRequest) ProtoReflect() protoreflect.Message {
+func (x *UpdateInventoryRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_product_v1_inventory_service_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -254,46 +293,53 @@ func (x *BatchReserveInventoryRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BatchReserveInventoryRequest.ProtoReflect.Descriptor instead.
-func (*BatchReserveInventoryRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use UpdateInventoryRequest.ProtoReflect.Descriptor instead.
+func (*UpdateInventoryRequest) Descriptor() ([]byte, []int) {
 	return file_product_v1_inventory_service_proto_rawDescGZIP(), []int{4}
 }
 
-func (x *BatchReserveInventoryRequest) GetItems() []*ReservationItem {
+func (x *UpdateInventoryRequest) GetSkuId() string {
 	if x != nil {
-		return x.Items
+		return x.SkuId
 	}
-	return nil
+	return ""
 }
 
-func (x *BatchReserveInventoryRequest) GetIdempotencyKey() string {
+func (x *UpdateInventoryRequest) GetQuantity() int64 {
 	if x != nil {
-		return x.IdempotencyKey
+		return x.Quantity
 	}
-	return ""
+	return 0
 }
 
-type BatchReserveInventoryResponse struct {
+func (x *UpdateInventoryRequest) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+type UpdateInventoryResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Reservation   *Reservation           `protobuf:"bytes,1,opt,name=reservation,proto3" json:"reservation,omitempty"`
+	Inventory     *Inventory             `protobuf:"bytes,1,opt,name=inventory,proto3" json:"inventory,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *BatchReserveInventoryResponse) Reset() {
-	*x = BatchReserveInventoryResponse{}
+func (x *UpdateInventoryResponse) Reset() {
+	*x = UpdateInventoryResponse{}
 	mi := &file_product_v1_inventory_service_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *BatchReserveInventoryResponse) String() string {
+func (x *UpdateInventoryResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BatchReserveInventoryResponse) ProtoMessage() {}
+func (*UpdateInventoryResponse) ProtoMessage() {}
 
-func (x *BatchReserveInventoryResponse) ProtoReflect() protoreflect.Message {
+func (x *UpdateInventoryResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_product_v1_inventory_service_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -305,42 +351,40 @@ func (x *BatchReserveInventoryResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BatchReserveInventoryResponse.ProtoReflect.Descriptor instead.
-func (*BatchReserveInventoryResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use UpdateInventoryResponse.ProtoReflect.Descriptor instead.
+func (*UpdateInventoryResponse) Descriptor() ([]byte, []int) {
 	return file_product_v1_inventory_service_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *BatchReserveInventoryResponse) GetReservation() *Reservation {
+func (x *UpdateInventoryResponse) GetInventory() *Inventory {
 	if x != nil {
-		return x.Reservation
+		return x.Inventory
 	}
 	return nil
 }
 
-type ConfirmReservationRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	ReservationId string                 `protobuf:"bytes,1,opt,name=reservation_id,json=reservationId,proto3" json:"reservation_id,omitempty"`
-	// Idempotency key for exactly-once semantics
-	// Recommended format: "{order-id}-confirm"
-	IdempotencyKey string `protobuf:"bytes,2,opt,name=idempotency_key,json=idempotencyKey,proto3" json:"idempotency_key,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+type BatchUpdateInventoryRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Items to update (max 5000)
+	Items         []*InventoryQuantity `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ConfirmReservationRequest) Reset() {
-	*x = ConfirmReservationRequest{}
+func (x *BatchUpdateInventoryRequest) Reset() {
+	*x = BatchUpdateInventoryRequest{}
 	mi := &file_product_v1_inventory_service_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ConfirmReservationRequest) String() string {
+func (x *BatchUpdateInventoryRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ConfirmReservationRequest) ProtoMessage() {}
+func (*BatchUpdateInventoryRequest) ProtoMessage() {}
 
-func (x *ConfirmReservationRequest) ProtoReflect() protoreflect.Message {
+func (x *BatchUpdateInventoryRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_product_v1_inventory_service_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -352,46 +396,40 @@ func (x *ConfirmReservationRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ConfirmReservationRequest.ProtoReflect.Descriptor instead.
-func (*ConfirmReservationRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use BatchUpdateInventoryRequest.ProtoReflect.Descriptor instead.
+func (*BatchUpdateInventoryRequest) Descriptor() ([]byte, []int) {
 	return file_product_v1_inventory_service_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *ConfirmReservationRequest) GetReservationId() string {
-	if x != nil {
-		return x.ReservationId
-	}
-	return ""
-}
-
-func (x *ConfirmReservationRequest) GetIdempotencyKey() string {
+func (x *BatchUpdateInventoryRequest) GetItems() []*InventoryQuantity {
 	if x != nil {
-		return x.IdempotencyKey
+		return x.Items
 	}
-	return ""
+	return nil
 }
 
-type ConfirmReservationResponse struct {
+type InventoryQuantity struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Reservation   *Reservation           `protobuf:"bytes,1,opt,name=reservation,proto3" json:"reservation,omitempty"`
+	SkuId         string                 `protobuf:"bytes,1,opt,name=sku_id,json=skuId,proto3" json:"sku_id,omitempty"`
+	Quantity      int64                  `protobuf:"varint,2,opt,name=quantity,proto3" json:"quantity,omitempty"` // New absolute quantity (not delta)
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ConfirmReservationResponse) Reset() {
-	*x = ConfirmReservationResponse{}
+func (x *InventoryQuantity) Reset() {
+	*x = InventoryQuantity{}
 	mi := &file_product_v1_inventory_service_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ConfirmReservationResponse) String() string {
+func (x *InventoryQuantity) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ConfirmReservationResponse) ProtoMessage() {}
+func (*InventoryQuantity) ProtoMessage() {}
 
-func (x *ConfirmReservationResponse) ProtoReflect() protoreflect.Message {
+func (x *InventoryQuantity) ProtoReflect() protoreflect.Message {
 	mi := &file_product_v1_inventory_service_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -403,42 +441,49 @@ func (x *ConfirmReservationResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ConfirmReservationResponse.ProtoReflect.Descriptor instead.
-func (*ConfirmReservationResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use InventoryQuantity.ProtoReflect.Descriptor instead.
+func (*InventoryQuantity) Descriptor() ([]byte, []int) {
 	return file_product_v1_inventory_service_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *ConfirmReservationResponse) GetReservation() *Reservation {
+func (x *InventoryQuantity) GetSkuId() string {
 	if x != nil {
-		return x.Reservation
+		return x.SkuId
 	}
-	return nil
+	return ""
 }
 
-type ReleaseInventoryRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	ReservationId string                 `protobuf:"bytes,1,opt,name=reservation_id,json=reservationId,proto3" json:"reservation_id,omitempty"`
-	// Idempotency key for exactly-once semantics
-	// Recommended format: "{order-id}-release"
-	IdempotencyKey string `protobuf:"bytes,2,opt,name=idempotency_key,json=idempotencyKey,proto3" json:"idempotency_key,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+func (x *InventoryQuantity) GetQuantity() int64 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
 }
 
-func (x *ReleaseInventoryRequest) Reset() {
-	*x = ReleaseInventoryRequest{}
+type BatchUpdateInventoryResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// One entry per requested item, in request order
+	Results       []*InventoryUpdateResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	UpdatedCount  int32                    `protobuf:"varint,2,opt,name=updated_count,json=updatedCount,proto3" json:"updated_count,omitempty"`
+	FailedCount   int32                    `protobuf:"varint,3,opt,name=failed_count,json=failedCount,proto3" json:"failed_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchUpdateInventoryResponse) Reset() {
+	*x = BatchUpdateInventoryResponse{}
 	mi := &file_product_v1_inventory_service_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ReleaseInventoryRequest) String() string {
+func (x *BatchUpdateInventoryResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ReleaseInventoryRequest) ProtoMessage() {}
+func (*BatchUpdateInventoryResponse) ProtoMessage() {}
 
-func (x *ReleaseInventoryRequest) ProtoReflect() protoreflect.Message {
+func (x *BatchUpdateInventoryResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_product_v1_inventory_service_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -450,46 +495,58 @@ func (x *ReleaseInventoryRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ReleaseInventoryRequest.ProtoReflect.Descriptor instead.
-func (*ReleaseInventoryRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use BatchUpdateInventoryResponse.ProtoReflect.Descriptor instead.
+func (*BatchUpdateInventoryResponse) Descriptor() ([]byte, []int) {
 	return file_product_v1_inventory_service_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *ReleaseInventoryRequest) GetReservationId() string {
+func (x *BatchUpdateInventoryResponse) GetResults() []*InventoryUpdateResult {
 	if x != nil {
-		return x.ReservationId
+		return x.Results
 	}
-	return ""
+	return nil
 }
 
-func (x *ReleaseInventoryRequest) GetIdempotencyKey() string {
+func (x *BatchUpdateInventoryResponse) GetUpdatedCount() int32 {
 	if x != nil {
-		return x.IdempotencyKey
+		return x.UpdatedCount
 	}
-	return ""
+	return 0
 }
 
-type ReleaseInventoryResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Reservation   *Reservation           `protobuf:"bytes,1,opt,name=reservation,proto3" json:"reservation,omitempty"`
+func (x *BatchUpdateInventoryResponse) GetFailedCount() int32 {
+	if x != nil {
+		return x.FailedCount
+	}
+	return 0
+}
+
+type InventoryUpdateResult struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	SkuId string                 `protobuf:"bytes,1,opt,name=sku_id,json=skuId,proto3" json:"sku_id,omitempty"`
+	// Set when the update was applied
+	Inventory *Inventory `protobuf:"bytes,2,opt,name=inventory,proto3" json:"inventory,omitempty"`
+	// Connect error code (e.g. "not_found") when the update was not applied
+	ErrorCode     string `protobuf:"bytes,3,opt,name=error_code,json=errorCode,proto3" json:"error_code,omitempty"`
+	ErrorMessage  string `protobuf:"bytes,4,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ReleaseInventoryResponse) Reset() {
-	*x = ReleaseInventoryResponse{}
+func (x *InventoryUpdateResult) Reset() {
+	*x = InventoryUpdateResult{}
 	mi := &file_product_v1_inventory_service_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ReleaseInventoryResponse) String() string {
+func (x *InventoryUpdateResult) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ReleaseInventoryResponse) ProtoMessage() {}
+func (*InventoryUpdateResult) ProtoMessage() {}
 
-func (x *ReleaseInventoryResponse) ProtoReflect() protoreflect.Message {
+func (x *InventoryUpdateResult) ProtoReflect() protoreflect.Message {
 	mi := &file_product_v1_inventory_service_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -501,46 +558,934 @@ func (x *ReleaseInventoryResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ReleaseInventoryResponse.ProtoReflect.Descriptor instead.
-func (*ReleaseInventoryResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use InventoryUpdateResult.ProtoReflect.Descriptor instead.
+func (*InventoryUpdateResult) Descriptor() ([]byte, []int) {
 	return file_product_v1_inventory_service_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *ReleaseInventoryResponse) GetReservation() *Reservation {
+func (x *InventoryUpdateResult) GetSkuId() string {
 	if x != nil {
-		return x.Reservation
+		return x.SkuId
+	}
+	return ""
+}
+
+func (x *InventoryUpdateResult) GetInventory() *Inventory {
+	if x != nil {
+		return x.Inventory
 	}
 	return nil
 }
 
-type UpdateReservationRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	ReservationId string                 `protobuf:"bytes,1,opt,name=reservation_id,json=reservationId,proto3" json:"reservation_id,omitempty"`
-	// New quantities for specific SKUs
-	// Only include items that need quantity changes
-	Items []*ReservationItem `protobuf:"bytes,2,rep,name=items,proto3" json:"items,omitempty"`
-	// Idempotency key for exactly-once semantics
-	// Recommended format: "{order-id}-update-{version}"
-	IdempotencyKey string `protobuf:"bytes,3,opt,name=idempotency_key,json=idempotencyKey,proto3" json:"idempotency_key,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+func (x *InventoryUpdateResult) GetErrorCode() string {
+	if x != nil {
+		return x.ErrorCode
+	}
+	return ""
 }
 
-func (x *UpdateReservationRequest) Reset() {
-	*x = UpdateReservationRequest{}
-	mi := &file_product_v1_inventory_service_proto_msgTypes[10]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *InventoryUpdateResult) GetErrorMessage() string {
+	if x != nil {
+		return x.ErrorMessage
+	}
+	return ""
+}
+
+type BatchReserveInventoryRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Items to reserve (max 50)
+	Items []*ReservationItem `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	// Idempotency key for exactly-once semantics (required, max 256 chars)
+	// Recommended format: "{order-id}-reserve" or UUID
+	IdempotencyKey string `protobuf:"bytes,2,opt,name=idempotency_key,json=idempotencyKey,proto3" json:"idempotency_key,omitempty"`
+	// How to handle concurrent reservations of the same SKUs. Unspecified
+	// uses the server default (RESERVATION_LOCKING).
+	Locking ReservationLocking `protobuf:"varint,3,opt,name=locking,proto3,enum=product.v1.ReservationLocking" json:"locking,omitempty"`
+	// How long the stock is held before the reservation expires. 0 uses the
+	// server default (RESERVATION_TTL). Callers may request between
+	// RESERVATION_TTL_MIN and RESERVATION_TTL_MAX; callers holding a scope
+	// configured in RESERVATION_TTL_SCOPE_MAX may request up to its maximum.
+	TtlSeconds    int64 `protobuf:"varint,4,opt,name=ttl_seconds,json=ttlSeconds,proto3" json:"ttl_seconds,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchReserveInventoryRequest) Reset() {
+	*x = BatchReserveInventoryRequest{}
+	mi := &file_product_v1_inventory_service_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchReserveInventoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchReserveInventoryRequest) ProtoMessage() {}
+
+func (x *BatchReserveInventoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_v1_inventory_service_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchReserveInventoryRequest.ProtoReflect.Descriptor instead.
+func (*BatchReserveInventoryRequest) Descriptor() ([]byte, []int) {
+	return file_product_v1_inventory_service_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *BatchReserveInventoryRequest) GetItems() []*ReservationItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *BatchReserveInventoryRequest) GetIdempotencyKey() string {
+	if x != nil {
+		return x.IdempotencyKey
+	}
+	return ""
+}
+
+func (x *BatchReserveInventoryRequest) GetLocking() ReservationLocking {
+	if x != nil {
+		return x.Locking
+	}
+	return ReservationLocking_RESERVATION_LOCKING_UNSPECIFIED
+}
+
+func (x *BatchReserveInventoryRequest) GetTtlSeconds() int64 {
+	if x != nil {
+		return x.TtlSeconds
+	}
+	return 0
+}
+
+type BatchReserveInventoryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Reservation   *Reservation           `protobuf:"bytes,1,opt,name=reservation,proto3" json:"reservation,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchReserveInventoryResponse) Reset() {
+	*x = BatchReserveInventoryResponse{}
+	mi := &file_product_v1_inventory_service_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchReserveInventoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchReserveInventoryResponse) ProtoMessage() {}
+
+func (x *BatchReserveInventoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_v1_inventory_service_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchReserveInventoryResponse.ProtoReflect.Descriptor instead.
+func (*BatchReserveInventoryResponse) Descriptor() ([]byte, []int) {
+	return file_product_v1_inventory_service_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *BatchReserveInventoryResponse) GetReservation() *Reservation {
+	if x != nil {
+		return x.Reservation
+	}
+	return nil
+}
+
+type ConfirmReservationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ReservationId string                 `protobuf:"bytes,1,opt,name=reservation_id,json=reservationId,proto3" json:"reservation_id,omitempty"`
+	// Idempotency key for exactly-once semantics
+	// Recommended format: "{order-id}-confirm"
+	IdempotencyKey string `protobuf:"bytes,2,opt,name=idempotency_key,json=idempotencyKey,proto3" json:"idempotency_key,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ConfirmReservationRequest) Reset() {
+	*x = ConfirmReservationRequest{}
+	mi := &file_product_v1_inventory_service_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConfirmReservationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfirmReservationRequest) ProtoMessage() {}
+
+func (x *ConfirmReservationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_v1_inventory_service_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfirmReservationRequest.ProtoReflect.Descriptor instead.
+func (*ConfirmReservationRequest) Descriptor() ([]byte, []int) {
+	return file_product_v1_inventory_service_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *ConfirmReservationRequest) GetReservationId() string {
+	if x != nil {
+		return x.ReservationId
+	}
+	return ""
+}
+
+func (x *ConfirmReservationRequest) GetIdempotencyKey() string {
+	if x != nil {
+		return x.IdempotencyKey
+	}
+	return ""
+}
+
+type ConfirmReservationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Reservation   *Reservation           `protobuf:"bytes,1,opt,name=reservation,proto3" json:"reservation,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConfirmReservationResponse) Reset() {
+	*x = ConfirmReservationResponse{}
+	mi := &file_product_v1_inventory_service_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConfirmReservationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfirmReservationResponse) ProtoMessage() {}
+
+func (x *ConfirmReservationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_v1_inventory_service_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfirmReservationResponse.ProtoReflect.Descriptor instead.
+func (*ConfirmReservationResponse) Descriptor() ([]byte, []int) {
+	return file_product_v1_inventory_service_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *ConfirmReservationResponse) GetReservation() *Reservation {
+	if x != nil {
+		return x.Reservation
+	}
+	return nil
+}
+
+type ReleaseInventoryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ReservationId string                 `protobuf:"bytes,1,opt,name=reservation_id,json=reservationId,proto3" json:"reservation_id,omitempty"`
+	// Idempotency key for exactly-once semantics
+	// Recommended format: "{order-id}-release"
+	IdempotencyKey string `protobuf:"bytes,2,opt,name=idempotency_key,json=idempotencyKey,proto3" json:"idempotency_key,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ReleaseInventoryRequest) Reset() {
+	*x = ReleaseInventoryRequest{}
+	mi := &file_product_v1_inventory_service_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReleaseInventoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReleaseInventoryRequest) ProtoMessage() {}
+
+func (x *ReleaseInventoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_v1_inventory_service_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReleaseInventoryRequest.ProtoReflect.Descriptor instead.
+func (*ReleaseInventoryRequest) Descriptor() ([]byte, []int) {
+	return file_product_v1_inventory_service_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *ReleaseInventoryRequest) GetReservationId() string {
+	if x != nil {
+		return x.ReservationId
+	}
+	return ""
+}
+
+func (x *ReleaseInventoryRequest) GetIdempotencyKey() string {
+	if x != nil {
+		return x.IdempotencyKey
+	}
+	return ""
+}
+
+type ReleaseInventoryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Reservation   *Reservation           `protobuf:"bytes,1,opt,name=reservation,proto3" json:"reservation,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReleaseInventoryResponse) Reset() {
+	*x = ReleaseInventoryResponse{}
+	mi := &file_product_v1_inventory_service_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReleaseInventoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReleaseInventoryResponse) ProtoMessage() {}
+
+func (x *ReleaseInventoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_v1_inventory_service_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReleaseInventoryResponse.ProtoReflect.Descriptor instead.
+func (*ReleaseInventoryResponse) Descriptor() ([]byte, []int) {
+	return file_product_v1_inventory_service_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *ReleaseInventoryResponse) GetReservation() *Reservation {
+	if x != nil {
+		return x.Reservation
+	}
+	return nil
+}
+
+type UpdateReservationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ReservationId string                 `protobuf:"bytes,1,opt,name=reservation_id,json=reservationId,proto3" json:"reservation_id,omitempty"`
+	// New quantities for specific SKUs
+	// Only include items that need quantity changes
+	Items []*ReservationItem `protobuf:"bytes,2,rep,name=items,proto3" json:"items,omitempty"`
+	// Idempotency key for exactly-once semantics
+	// Recommended format: "{order-id}-update-{version}"
+	IdempotencyKey string `protobuf:"bytes,3,opt,name=idempotency_key,json=idempotencyKey,proto3" json:"idempotency_key,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *UpdateReservationRequest) Reset() {
+	*x = UpdateReservationRequest{}
+	mi := &file_product_v1_inventory_service_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *UpdateReservationRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateReservationRequest) ProtoMessage() {}
+func (*UpdateReservationRequest) ProtoMessage() {}
+
+func (x *UpdateReservationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_v1_inventory_service_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateReservationRequest.ProtoReflect.Descriptor instead.
+func (*UpdateReservationRequest) Descriptor() ([]byte, []int) {
+	return file_product_v1_inventory_service_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *UpdateReservationRequest) GetReservationId() string {
+	if x != nil {
+		return x.ReservationId
+	}
+	return ""
+}
+
+func (x *UpdateReservationRequest) GetItems() []*ReservationItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *UpdateReservationRequest) GetIdempotencyKey() string {
+	if x != nil {
+		return x.IdempotencyKey
+	}
+	return ""
+}
+
+type UpdateReservationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Reservation   *Reservation           `protobuf:"bytes,1,opt,name=reservation,proto3" json:"reservation,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateReservationResponse) Reset() {
+	*x = UpdateReservationResponse{}
+	mi := &file_product_v1_inventory_service_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateReservationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateReservationResponse) ProtoMessage() {}
+
+func (x *UpdateReservationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_v1_inventory_service_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateReservationResponse.ProtoReflect.Descriptor instead.
+func (*UpdateReservationResponse) Descriptor() ([]byte, []int) {
+	return file_product_v1_inventory_service_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *UpdateReservationResponse) GetReservation() *Reservation {
+	if x != nil {
+		return x.Reservation
+	}
+	return nil
+}
+
+type GetReservationStatusRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ReservationId string                 `protobuf:"bytes,1,opt,name=reservation_id,json=reservationId,proto3" json:"reservation_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetReservationStatusRequest) Reset() {
+	*x = GetReservationStatusRequest{}
+	mi := &file_product_v1_inventory_service_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetReservationStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetReservationStatusRequest) ProtoMessage() {}
+
+func (x *GetReservationStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_v1_inventory_service_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetReservationStatusRequest.ProtoReflect.Descriptor instead.
+func (*GetReservationStatusRequest) Descriptor() ([]byte, []int) {
+	return file_product_v1_inventory_service_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *GetReservationStatusRequest) GetReservationId() string {
+	if x != nil {
+		return x.ReservationId
+	}
+	return ""
+}
+
+type GetReservationStatusResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Reservation   *Reservation           `protobuf:"bytes,1,opt,name=reservation,proto3" json:"reservation,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetReservationStatusResponse) Reset() {
+	*x = GetReservationStatusResponse{}
+	mi := &file_product_v1_inventory_service_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetReservationStatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetReservationStatusResponse) ProtoMessage() {}
+
+func (x *GetReservationStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_v1_inventory_service_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetReservationStatusResponse.ProtoReflect.Descriptor instead.
+func (*GetReservationStatusResponse) Descriptor() ([]byte, []int) {
+	return file_product_v1_inventory_service_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *GetReservationStatusResponse) GetReservation() *Reservation {
+	if x != nil {
+		return x.Reservation
+	}
+	return nil
+}
+
+type GetSKUVelocityRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// SKUs to aggregate (max 50)
+	SkuIds []string `protobuf:"bytes,1,rep,name=sku_ids,json=skuIds,proto3" json:"sku_ids,omitempty"`
+	// Window lengths in days (e.g., 7, 30, 90)
+	// Defaults to the server-configured windows when empty
+	WindowDays    []int32 `protobuf:"varint,2,rep,packed,name=window_days,json=windowDays,proto3" json:"window_days,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSKUVelocityRequest) Reset() {
+	*x = GetSKUVelocityRequest{}
+	mi := &file_product_v1_inventory_service_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSKUVelocityRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSKUVelocityRequest) ProtoMessage() {}
+
+func (x *GetSKUVelocityRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_v1_inventory_service_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSKUVelocityRequest.ProtoReflect.Descriptor instead.
+func (*GetSKUVelocityRequest) Descriptor() ([]byte, []int) {
+	return file_product_v1_inventory_service_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *GetSKUVelocityRequest) GetSkuIds() []string {
+	if x != nil {
+		return x.SkuIds
+	}
+	return nil
+}
+
+func (x *GetSKUVelocityRequest) GetWindowDays() []int32 {
+	if x != nil {
+		return x.WindowDays
+	}
+	return nil
+}
+
+type GetSKUVelocityResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// One entry per requested SKU, in request order
+	Velocities    []*SKUVelocity `protobuf:"bytes,1,rep,name=velocities,proto3" json:"velocities,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSKUVelocityResponse) Reset() {
+	*x = GetSKUVelocityResponse{}
+	mi := &file_product_v1_inventory_service_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSKUVelocityResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSKUVelocityResponse) ProtoMessage() {}
+
+func (x *GetSKUVelocityResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_v1_inventory_service_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSKUVelocityResponse.ProtoReflect.Descriptor instead.
+func (*GetSKUVelocityResponse) Descriptor() ([]byte, []int) {
+	return file_product_v1_inventory_service_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *GetSKUVelocityResponse) GetVelocities() []*SKUVelocity {
+	if x != nil {
+		return x.Velocities
+	}
+	return nil
+}
+
+type ListInventoryMovementsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	SkuId string                 `protobuf:"bytes,1,opt,name=sku_id,json=skuId,proto3" json:"sku_id,omitempty"`
+	// Defaults to 50, max 200
+	PageSize int32 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// next_page_token from a previous response
+	PageToken     string `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListInventoryMovementsRequest) Reset() {
+	*x = ListInventoryMovementsRequest{}
+	mi := &file_product_v1_inventory_service_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListInventoryMovementsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListInventoryMovementsRequest) ProtoMessage() {}
+
+func (x *ListInventoryMovementsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_v1_inventory_service_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListInventoryMovementsRequest.ProtoReflect.Descriptor instead.
+func (*ListInventoryMovementsRequest) Descriptor() ([]byte, []int) {
+	return file_product_v1_inventory_service_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *ListInventoryMovementsRequest) GetSkuId() string {
+	if x != nil {
+		return x.SkuId
+	}
+	return ""
+}
+
+func (x *ListInventoryMovementsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListInventoryMovementsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type ListInventoryMovementsResponse struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Movements []*InventoryMovement   `protobuf:"bytes,1,rep,name=movements,proto3" json:"movements,omitempty"`
+	// Empty when there are no more movements
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListInventoryMovementsResponse) Reset() {
+	*x = ListInventoryMovementsResponse{}
+	mi := &file_product_v1_inventory_service_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListInventoryMovementsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListInventoryMovementsResponse) ProtoMessage() {}
+
+func (x *ListInventoryMovementsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_v1_inventory_service_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListInventoryMovementsResponse.ProtoReflect.Descriptor instead.
+func (*ListInventoryMovementsResponse) Descriptor() ([]byte, []int) {
+	return file_product_v1_inventory_service_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *ListInventoryMovementsResponse) GetMovements() []*InventoryMovement {
+	if x != nil {
+		return x.Movements
+	}
+	return nil
+}
+
+func (x *ListInventoryMovementsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type SetLowStockThresholdRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	SkuId string                 `protobuf:"bytes,1,opt,name=sku_id,json=skuId,proto3" json:"sku_id,omitempty"`
+	// Unset reverts the SKU to the default threshold
+	Threshold     *int64 `protobuf:"varint,2,opt,name=threshold,proto3,oneof" json:"threshold,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetLowStockThresholdRequest) Reset() {
+	*x = SetLowStockThresholdRequest{}
+	mi := &file_product_v1_inventory_service_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetLowStockThresholdRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetLowStockThresholdRequest) ProtoMessage() {}
+
+func (x *SetLowStockThresholdRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_v1_inventory_service_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetLowStockThresholdRequest.ProtoReflect.Descriptor instead.
+func (*SetLowStockThresholdRequest) Descriptor() ([]byte, []int) {
+	return file_product_v1_inventory_service_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *SetLowStockThresholdRequest) GetSkuId() string {
+	if x != nil {
+		return x.SkuId
+	}
+	return ""
+}
+
+func (x *SetLowStockThresholdRequest) GetThreshold() int64 {
+	if x != nil && x.Threshold != nil {
+		return *x.Threshold
+	}
+	return 0
+}
+
+type SetLowStockThresholdResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetLowStockThresholdResponse) Reset() {
+	*x = SetLowStockThresholdResponse{}
+	mi := &file_product_v1_inventory_service_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetLowStockThresholdResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetLowStockThresholdResponse) ProtoMessage() {}
+
+func (x *SetLowStockThresholdResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_v1_inventory_service_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetLowStockThresholdResponse.ProtoReflect.Descriptor instead.
+func (*SetLowStockThresholdResponse) Descriptor() ([]byte, []int) {
+	return file_product_v1_inventory_service_proto_rawDescGZIP(), []int{25}
+}
+
+type ListLowStockSKUsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Defaults to 100, max 1000
+	PageSize int32 `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// next_page_token from a previous response
+	PageToken     string `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListLowStockSKUsRequest) Reset() {
+	*x = ListLowStockSKUsRequest{}
+	mi := &file_product_v1_inventory_service_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListLowStockSKUsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListLowStockSKUsRequest) ProtoMessage() {}
+
+func (x *ListLowStockSKUsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_v1_inventory_service_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListLowStockSKUsRequest.ProtoReflect.Descriptor instead.
+func (*ListLowStockSKUsRequest) Descriptor() ([]byte, []int) {
+	return file_product_v1_inventory_service_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *ListLowStockSKUsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListLowStockSKUsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type ListLowStockSKUsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Skus  []*LowStockSKU         `protobuf:"bytes,1,rep,name=skus,proto3" json:"skus,omitempty"`
+	// Empty when there are no more SKUs
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListLowStockSKUsResponse) Reset() {
+	*x = ListLowStockSKUsResponse{}
+	mi := &file_product_v1_inventory_service_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListLowStockSKUsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListLowStockSKUsResponse) ProtoMessage() {}
 
-func (x *UpdateReservationRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_product_v1_inventory_service_proto_msgTypes[10]
+func (x *ListLowStockSKUsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_v1_inventory_service_proto_msgTypes[27]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -551,54 +1496,225 @@ func (x *UpdateReservationRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateReservationRequest.ProtoReflect.Descriptor instead.
-func (*UpdateReservationRequest) Descriptor() ([]byte, []int) {
-	return file_product_v1_inventory_service_proto_rawDescGZIP(), []int{10}
+// Deprecated: Use ListLowStockSKUsResponse.ProtoReflect.Descriptor instead.
+func (*ListLowStockSKUsResponse) Descriptor() ([]byte, []int) {
+	return file_product_v1_inventory_service_proto_rawDescGZIP(), []int{27}
 }
 
-func (x *UpdateReservationRequest) GetReservationId() string {
+func (x *ListLowStockSKUsResponse) GetSkus() []*LowStockSKU {
 	if x != nil {
-		return x.ReservationId
+		return x.Skus
+	}
+	return nil
+}
+
+func (x *ListLowStockSKUsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
 	}
 	return ""
 }
 
-func (x *UpdateReservationRequest) GetItems() []*ReservationItem {
+// LowStockSKU is a SKU whose available quantity is below its threshold.
+type LowStockSKU struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	SkuId     string                 `protobuf:"bytes,1,opt,name=sku_id,json=skuId,proto3" json:"sku_id,omitempty"`
+	Quantity  int64                  `protobuf:"varint,2,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	Reserved  int64                  `protobuf:"varint,3,opt,name=reserved,proto3" json:"reserved,omitempty"`
+	Available int64                  `protobuf:"varint,4,opt,name=available,proto3" json:"available,omitempty"` // quantity - reserved
+	Threshold int64                  `protobuf:"varint,5,opt,name=threshold,proto3" json:"threshold,omitempty"` // The SKU's threshold, or the default if it has none
+	// When the low-stock event was published; unset until the worker runs
+	AlertedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=alerted_at,json=alertedAt,proto3" json:"alerted_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LowStockSKU) Reset() {
+	*x = LowStockSKU{}
+	mi := &file_product_v1_inventory_service_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LowStockSKU) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LowStockSKU) ProtoMessage() {}
+
+func (x *LowStockSKU) ProtoReflect() protoreflect.Message {
+	mi := &file_product_v1_inventory_service_proto_msgTypes[28]
 	if x != nil {
-		return x.Items
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LowStockSKU.ProtoReflect.Descriptor instead.
+func (*LowStockSKU) Descriptor() ([]byte, []int) {
+	return file_product_v1_inventory_service_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *LowStockSKU) GetSkuId() string {
+	if x != nil {
+		return x.SkuId
+	}
+	return ""
+}
+
+func (x *LowStockSKU) GetQuantity() int64 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *LowStockSKU) GetReserved() int64 {
+	if x != nil {
+		return x.Reserved
+	}
+	return 0
+}
+
+func (x *LowStockSKU) GetAvailable() int64 {
+	if x != nil {
+		return x.Available
+	}
+	return 0
+}
+
+func (x *LowStockSKU) GetThreshold() int64 {
+	if x != nil {
+		return x.Threshold
+	}
+	return 0
+}
+
+func (x *LowStockSKU) GetAlertedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.AlertedAt
 	}
 	return nil
 }
 
-func (x *UpdateReservationRequest) GetIdempotencyKey() string {
+type ListReservationsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Unspecified lists every status
+	Status ReservationStatus `protobuf:"varint,1,opt,name=status,proto3,enum=product.v1.ReservationStatus" json:"status,omitempty"`
+	// Only reservations containing this SKU
+	SkuId string `protobuf:"bytes,2,opt,name=sku_id,json=skuId,proto3" json:"sku_id,omitempty"`
+	// Only reservations created at or after created_after and before
+	// created_before
+	CreatedAfter  *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=created_after,json=createdAfter,proto3" json:"created_after,omitempty"`
+	CreatedBefore *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=created_before,json=createdBefore,proto3" json:"created_before,omitempty"`
+	// Defaults to 50, max 200
+	PageSize int32 `protobuf:"varint,5,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// next_page_token from a previous response
+	PageToken     string `protobuf:"bytes,6,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListReservationsRequest) Reset() {
+	*x = ListReservationsRequest{}
+	mi := &file_product_v1_inventory_service_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListReservationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListReservationsRequest) ProtoMessage() {}
+
+func (x *ListReservationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_v1_inventory_service_proto_msgTypes[29]
 	if x != nil {
-		return x.IdempotencyKey
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListReservationsRequest.ProtoReflect.Descriptor instead.
+func (*ListReservationsRequest) Descriptor() ([]byte, []int) {
+	return file_product_v1_inventory_service_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *ListReservationsRequest) GetStatus() ReservationStatus {
+	if x != nil {
+		return x.Status
+	}
+	return ReservationStatus_RESERVATION_STATUS_UNSPECIFIED
+}
+
+func (x *ListReservationsRequest) GetSkuId() string {
+	if x != nil {
+		return x.SkuId
 	}
 	return ""
 }
 
-type UpdateReservationResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Reservation   *Reservation           `protobuf:"bytes,1,opt,name=reservation,proto3" json:"reservation,omitempty"`
+func (x *ListReservationsRequest) GetCreatedAfter() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAfter
+	}
+	return nil
+}
+
+func (x *ListReservationsRequest) GetCreatedBefore() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedBefore
+	}
+	return nil
+}
+
+func (x *ListReservationsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListReservationsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type ListReservationsResponse struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	Reservations []*Reservation         `protobuf:"bytes,1,rep,name=reservations,proto3" json:"reservations,omitempty"`
+	// Empty when there are no more reservations
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateReservationResponse) Reset() {
-	*x = UpdateReservationResponse{}
-	mi := &file_product_v1_inventory_service_proto_msgTypes[11]
+func (x *ListReservationsResponse) Reset() {
+	*x = ListReservationsResponse{}
+	mi := &file_product_v1_inventory_service_proto_msgTypes[30]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateReservationResponse) String() string {
+func (x *ListReservationsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateReservationResponse) ProtoMessage() {}
+func (*ListReservationsResponse) ProtoMessage() {}
 
-func (x *UpdateReservationResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_product_v1_inventory_service_proto_msgTypes[11]
+func (x *ListReservationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_v1_inventory_service_proto_msgTypes[30]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -609,40 +1725,49 @@ func (x *UpdateReservationResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateReservationResponse.ProtoReflect.Descriptor instead.
-func (*UpdateReservationResponse) Descriptor() ([]byte, []int) {
-	return file_product_v1_inventory_service_proto_rawDescGZIP(), []int{11}
+// Deprecated: Use ListReservationsResponse.ProtoReflect.Descriptor instead.
+func (*ListReservationsResponse) Descriptor() ([]byte, []int) {
+	return file_product_v1_inventory_service_proto_rawDescGZIP(), []int{30}
 }
 
-func (x *UpdateReservationResponse) GetReservation() *Reservation {
+func (x *ListReservationsResponse) GetReservations() []*Reservation {
 	if x != nil {
-		return x.Reservation
+		return x.Reservations
 	}
 	return nil
 }
 
-type GetReservationStatusRequest struct {
+func (x *ListReservationsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type ForceReleaseReservationRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	ReservationId string                 `protobuf:"bytes,1,opt,name=reservation_id,json=reservationId,proto3" json:"reservation_id,omitempty"`
+	// Why the reservation is released, e.g. a support ticket (max 500 chars)
+	Reason        string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetReservationStatusRequest) Reset() {
-	*x = GetReservationStatusRequest{}
-	mi := &file_product_v1_inventory_service_proto_msgTypes[12]
+func (x *ForceReleaseReservationRequest) Reset() {
+	*x = ForceReleaseReservationRequest{}
+	mi := &file_product_v1_inventory_service_proto_msgTypes[31]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetReservationStatusRequest) String() string {
+func (x *ForceReleaseReservationRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetReservationStatusRequest) ProtoMessage() {}
+func (*ForceReleaseReservationRequest) ProtoMessage() {}
 
-func (x *GetReservationStatusRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_product_v1_inventory_service_proto_msgTypes[12]
+func (x *ForceReleaseReservationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_v1_inventory_service_proto_msgTypes[31]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -653,40 +1778,47 @@ func (x *GetReservationStatusRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetReservationStatusRequest.ProtoReflect.Descriptor instead.
-func (*GetReservationStatusRequest) Descriptor() ([]byte, []int) {
-	return file_product_v1_inventory_service_proto_rawDescGZIP(), []int{12}
+// Deprecated: Use ForceReleaseReservationRequest.ProtoReflect.Descriptor instead.
+func (*ForceReleaseReservationRequest) Descriptor() ([]byte, []int) {
+	return file_product_v1_inventory_service_proto_rawDescGZIP(), []int{31}
 }
 
-func (x *GetReservationStatusRequest) GetReservationId() string {
+func (x *ForceReleaseReservationRequest) GetReservationId() string {
 	if x != nil {
 		return x.ReservationId
 	}
 	return ""
 }
 
-type GetReservationStatusResponse struct {
+func (x *ForceReleaseReservationRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type ForceReleaseReservationResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Reservation   *Reservation           `protobuf:"bytes,1,opt,name=reservation,proto3" json:"reservation,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetReservationStatusResponse) Reset() {
-	*x = GetReservationStatusResponse{}
-	mi := &file_product_v1_inventory_service_proto_msgTypes[13]
+func (x *ForceReleaseReservationResponse) Reset() {
+	*x = ForceReleaseReservationResponse{}
+	mi := &file_product_v1_inventory_service_proto_msgTypes[32]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetReservationStatusResponse) String() string {
+func (x *ForceReleaseReservationResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetReservationStatusResponse) ProtoMessage() {}
+func (*ForceReleaseReservationResponse) ProtoMessage() {}
 
-func (x *GetReservationStatusResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_product_v1_inventory_service_proto_msgTypes[13]
+func (x *ForceReleaseReservationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_v1_inventory_service_proto_msgTypes[32]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -697,37 +1829,175 @@ func (x *GetReservationStatusResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetReservationStatusResponse.ProtoReflect.Descriptor instead.
-func (*GetReservationStatusResponse) Descriptor() ([]byte, []int) {
-	return file_product_v1_inventory_service_proto_rawDescGZIP(), []int{13}
+// Deprecated: Use ForceReleaseReservationResponse.ProtoReflect.Descriptor instead.
+func (*ForceReleaseReservationResponse) Descriptor() ([]byte, []int) {
+	return file_product_v1_inventory_service_proto_rawDescGZIP(), []int{32}
 }
 
-func (x *GetReservationStatusResponse) GetReservation() *Reservation {
+func (x *ForceReleaseReservationResponse) GetReservation() *Reservation {
 	if x != nil {
 		return x.Reservation
 	}
 	return nil
 }
 
+type DrainSKUReservationsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	SkuId string                 `protobuf:"bytes,1,opt,name=sku_id,json=skuId,proto3" json:"sku_id,omitempty"`
+	// Why the reservations are released, e.g. an incident (max 500 chars)
+	Reason        string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DrainSKUReservationsRequest) Reset() {
+	*x = DrainSKUReservationsRequest{}
+	mi := &file_product_v1_inventory_service_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DrainSKUReservationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DrainSKUReservationsRequest) ProtoMessage() {}
+
+func (x *DrainSKUReservationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_v1_inventory_service_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DrainSKUReservationsRequest.ProtoReflect.Descriptor instead.
+func (*DrainSKUReservationsRequest) Descriptor() ([]byte, []int) {
+	return file_product_v1_inventory_service_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *DrainSKUReservationsRequest) GetSkuId() string {
+	if x != nil {
+		return x.SkuId
+	}
+	return ""
+}
+
+func (x *DrainSKUReservationsRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type DrainSKUReservationsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// IDs of the reservations released by this call
+	ReleasedReservationIds []string `protobuf:"bytes,1,rep,name=released_reservation_ids,json=releasedReservationIds,proto3" json:"released_reservation_ids,omitempty"`
+	// Reservations no longer pending when they were released
+	Skipped int32 `protobuf:"varint,2,opt,name=skipped,proto3" json:"skipped,omitempty"`
+	// True if pending reservations are left; call again to drain them
+	HasMore       bool `protobuf:"varint,3,opt,name=has_more,json=hasMore,proto3" json:"has_more,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DrainSKUReservationsResponse) Reset() {
+	*x = DrainSKUReservationsResponse{}
+	mi := &file_product_v1_inventory_service_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DrainSKUReservationsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DrainSKUReservationsResponse) ProtoMessage() {}
+
+func (x *DrainSKUReservationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_v1_inventory_service_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DrainSKUReservationsResponse.ProtoReflect.Descriptor instead.
+func (*DrainSKUReservationsResponse) Descriptor() ([]byte, []int) {
+	return file_product_v1_inventory_service_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *DrainSKUReservationsResponse) GetReleasedReservationIds() []string {
+	if x != nil {
+		return x.ReleasedReservationIds
+	}
+	return nil
+}
+
+func (x *DrainSKUReservationsResponse) GetSkipped() int32 {
+	if x != nil {
+		return x.Skipped
+	}
+	return 0
+}
+
+func (x *DrainSKUReservationsResponse) GetHasMore() bool {
+	if x != nil {
+		return x.HasMore
+	}
+	return false
+}
+
 var File_product_v1_inventory_service_proto protoreflect.FileDescriptor
 
 const file_product_v1_inventory_service_proto_rawDesc = "" +
 	"\n" +
 	"\"product/v1/inventory_service.proto\x12\n" +
-	"product.v1\x1a\x16product/v1/types.proto\",\n" +
+	"product.v1\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x16product/v1/types.proto\",\n" +
 	"\x13GetInventoryRequest\x12\x15\n" +
 	"\x06sku_id\x18\x01 \x01(\tR\x05skuId\"K\n" +
 	"\x14GetInventoryResponse\x123\n" +
+	"\tinventory\x18\x01 \x01(\v2\x15.product.v1.InventoryR\tinventory\"0\n" +
+	"\x15WatchInventoryRequest\x12\x17\n" +
+	"\asku_ids\x18\x01 \x03(\tR\x06skuIds\"M\n" +
+	"\x16WatchInventoryResponse\x123\n" +
 	"\tinventory\x18\x01 \x01(\v2\x15.product.v1.InventoryR\tinventory\"e\n" +
 	"\x16UpdateInventoryRequest\x12\x15\n" +
 	"\x06sku_id\x18\x01 \x01(\tR\x05skuId\x12\x1a\n" +
 	"\bquantity\x18\x02 \x01(\x03R\bquantity\x12\x18\n" +
 	"\aversion\x18\x03 \x01(\x03R\aversion\"N\n" +
 	"\x17UpdateInventoryResponse\x123\n" +
-	"\tinventory\x18\x01 \x01(\v2\x15.product.v1.InventoryR\tinventory\"z\n" +
+	"\tinventory\x18\x01 \x01(\v2\x15.product.v1.InventoryR\tinventory\"R\n" +
+	"\x1bBatchUpdateInventoryRequest\x123\n" +
+	"\x05items\x18\x01 \x03(\v2\x1d.product.v1.InventoryQuantityR\x05items\"F\n" +
+	"\x11InventoryQuantity\x12\x15\n" +
+	"\x06sku_id\x18\x01 \x01(\tR\x05skuId\x12\x1a\n" +
+	"\bquantity\x18\x02 \x01(\x03R\bquantity\"\xa3\x01\n" +
+	"\x1cBatchUpdateInventoryResponse\x12;\n" +
+	"\aresults\x18\x01 \x03(\v2!.product.v1.InventoryUpdateResultR\aresults\x12#\n" +
+	"\rupdated_count\x18\x02 \x01(\x05R\fupdatedCount\x12!\n" +
+	"\ffailed_count\x18\x03 \x01(\x05R\vfailedCount\"\xa7\x01\n" +
+	"\x15InventoryUpdateResult\x12\x15\n" +
+	"\x06sku_id\x18\x01 \x01(\tR\x05skuId\x123\n" +
+	"\tinventory\x18\x02 \x01(\v2\x15.product.v1.InventoryR\tinventory\x12\x1d\n" +
+	"\n" +
+	"error_code\x18\x03 \x01(\tR\terrorCode\x12#\n" +
+	"\rerror_message\x18\x04 \x01(\tR\ferrorMessage\"\xd5\x01\n" +
 	"\x1cBatchReserveInventoryRequest\x121\n" +
 	"\x05items\x18\x01 \x03(\v2\x1b.product.v1.ReservationItemR\x05items\x12'\n" +
-	"\x0fidempotency_key\x18\x02 \x01(\tR\x0eidempotencyKey\"Z\n" +
+	"\x0fidempotency_key\x18\x02 \x01(\tR\x0eidempotencyKey\x128\n" +
+	"\alocking\x18\x03 \x01(\x0e2\x1e.product.v1.ReservationLockingR\alocking\x12\x1f\n" +
+	"\vttl_seconds\x18\x04 \x01(\x03R\n" +
+	"ttlSeconds\"Z\n" +
 	"\x1dBatchReserveInventoryResponse\x129\n" +
 	"\vreservation\x18\x01 \x01(\v2\x17.product.v1.ReservationR\vreservation\"k\n" +
 	"\x19ConfirmReservationRequest\x12%\n" +
@@ -749,15 +2019,88 @@ const file_product_v1_inventory_service_proto_rawDesc = "" +
 	"\x1bGetReservationStatusRequest\x12%\n" +
 	"\x0ereservation_id\x18\x01 \x01(\tR\rreservationId\"Y\n" +
 	"\x1cGetReservationStatusResponse\x129\n" +
-	"\vreservation\x18\x01 \x01(\v2\x17.product.v1.ReservationR\vreservation2\xc0\x05\n" +
+	"\vreservation\x18\x01 \x01(\v2\x17.product.v1.ReservationR\vreservation\"Q\n" +
+	"\x15GetSKUVelocityRequest\x12\x17\n" +
+	"\asku_ids\x18\x01 \x03(\tR\x06skuIds\x12\x1f\n" +
+	"\vwindow_days\x18\x02 \x03(\x05R\n" +
+	"windowDays\"Q\n" +
+	"\x16GetSKUVelocityResponse\x127\n" +
+	"\n" +
+	"velocities\x18\x01 \x03(\v2\x17.product.v1.SKUVelocityR\n" +
+	"velocities\"r\n" +
+	"\x1dListInventoryMovementsRequest\x12\x15\n" +
+	"\x06sku_id\x18\x01 \x01(\tR\x05skuId\x12\x1b\n" +
+	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x03 \x01(\tR\tpageToken\"\x85\x01\n" +
+	"\x1eListInventoryMovementsResponse\x12;\n" +
+	"\tmovements\x18\x01 \x03(\v2\x1d.product.v1.InventoryMovementR\tmovements\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"e\n" +
+	"\x1bSetLowStockThresholdRequest\x12\x15\n" +
+	"\x06sku_id\x18\x01 \x01(\tR\x05skuId\x12!\n" +
+	"\tthreshold\x18\x02 \x01(\x03H\x00R\tthreshold\x88\x01\x01B\f\n" +
+	"\n" +
+	"_threshold\"\x1e\n" +
+	"\x1cSetLowStockThresholdResponse\"U\n" +
+	"\x17ListLowStockSKUsRequest\x12\x1b\n" +
+	"\tpage_size\x18\x01 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x02 \x01(\tR\tpageToken\"o\n" +
+	"\x18ListLowStockSKUsResponse\x12+\n" +
+	"\x04skus\x18\x01 \x03(\v2\x17.product.v1.LowStockSKUR\x04skus\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"\xd3\x01\n" +
+	"\vLowStockSKU\x12\x15\n" +
+	"\x06sku_id\x18\x01 \x01(\tR\x05skuId\x12\x1a\n" +
+	"\bquantity\x18\x02 \x01(\x03R\bquantity\x12\x1a\n" +
+	"\breserved\x18\x03 \x01(\x03R\breserved\x12\x1c\n" +
+	"\tavailable\x18\x04 \x01(\x03R\tavailable\x12\x1c\n" +
+	"\tthreshold\x18\x05 \x01(\x03R\tthreshold\x129\n" +
+	"\n" +
+	"alerted_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\talertedAt\"\xa7\x02\n" +
+	"\x17ListReservationsRequest\x125\n" +
+	"\x06status\x18\x01 \x01(\x0e2\x1d.product.v1.ReservationStatusR\x06status\x12\x15\n" +
+	"\x06sku_id\x18\x02 \x01(\tR\x05skuId\x12?\n" +
+	"\rcreated_after\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\fcreatedAfter\x12A\n" +
+	"\x0ecreated_before\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\rcreatedBefore\x12\x1b\n" +
+	"\tpage_size\x18\x05 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x06 \x01(\tR\tpageToken\"\x7f\n" +
+	"\x18ListReservationsResponse\x12;\n" +
+	"\freservations\x18\x01 \x03(\v2\x17.product.v1.ReservationR\freservations\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"_\n" +
+	"\x1eForceReleaseReservationRequest\x12%\n" +
+	"\x0ereservation_id\x18\x01 \x01(\tR\rreservationId\x12\x16\n" +
+	"\x06reason\x18\x02 \x01(\tR\x06reason\"\\\n" +
+	"\x1fForceReleaseReservationResponse\x129\n" +
+	"\vreservation\x18\x01 \x01(\v2\x17.product.v1.ReservationR\vreservation\"L\n" +
+	"\x1bDrainSKUReservationsRequest\x12\x15\n" +
+	"\x06sku_id\x18\x01 \x01(\tR\x05skuId\x12\x16\n" +
+	"\x06reason\x18\x02 \x01(\tR\x06reason\"\x8d\x01\n" +
+	"\x1cDrainSKUReservationsResponse\x128\n" +
+	"\x18released_reservation_ids\x18\x01 \x03(\tR\x16releasedReservationIds\x12\x18\n" +
+	"\askipped\x18\x02 \x01(\x05R\askipped\x12\x19\n" +
+	"\bhas_more\x18\x03 \x01(\bR\ahasMore*\x82\x01\n" +
+	"\x12ReservationLocking\x12#\n" +
+	"\x1fRESERVATION_LOCKING_UNSPECIFIED\x10\x00\x12\"\n" +
+	"\x1eRESERVATION_LOCKING_OPTIMISTIC\x10\x01\x12#\n" +
+	"\x1fRESERVATION_LOCKING_PESSIMISTIC\x10\x022\xd8\f\n" +
 	"\x10InventoryService\x12Q\n" +
-	"\fGetInventory\x12\x1f.product.v1.GetInventoryRequest\x1a .product.v1.GetInventoryResponse\x12Z\n" +
-	"\x0fUpdateInventory\x12\".product.v1.UpdateInventoryRequest\x1a#.product.v1.UpdateInventoryResponse\x12l\n" +
+	"\fGetInventory\x12\x1f.product.v1.GetInventoryRequest\x1a .product.v1.GetInventoryResponse\x12Y\n" +
+	"\x0eWatchInventory\x12!.product.v1.WatchInventoryRequest\x1a\".product.v1.WatchInventoryResponse0\x01\x12Z\n" +
+	"\x0fUpdateInventory\x12\".product.v1.UpdateInventoryRequest\x1a#.product.v1.UpdateInventoryResponse\x12i\n" +
+	"\x14BatchUpdateInventory\x12'.product.v1.BatchUpdateInventoryRequest\x1a(.product.v1.BatchUpdateInventoryResponse\x12l\n" +
 	"\x15BatchReserveInventory\x12(.product.v1.BatchReserveInventoryRequest\x1a).product.v1.BatchReserveInventoryResponse\x12c\n" +
 	"\x12ConfirmReservation\x12%.product.v1.ConfirmReservationRequest\x1a&.product.v1.ConfirmReservationResponse\x12]\n" +
 	"\x10ReleaseInventory\x12#.product.v1.ReleaseInventoryRequest\x1a$.product.v1.ReleaseInventoryResponse\x12`\n" +
 	"\x11UpdateReservation\x12$.product.v1.UpdateReservationRequest\x1a%.product.v1.UpdateReservationResponse\x12i\n" +
-	"\x14GetReservationStatus\x12'.product.v1.GetReservationStatusRequest\x1a(.product.v1.GetReservationStatusResponseB\xb5\x01\n" +
+	"\x14GetReservationStatus\x12'.product.v1.GetReservationStatusRequest\x1a(.product.v1.GetReservationStatusResponse\x12W\n" +
+	"\x0eGetSKUVelocity\x12!.product.v1.GetSKUVelocityRequest\x1a\".product.v1.GetSKUVelocityResponse\x12o\n" +
+	"\x16ListInventoryMovements\x12).product.v1.ListInventoryMovementsRequest\x1a*.product.v1.ListInventoryMovementsResponse\x12i\n" +
+	"\x14SetLowStockThreshold\x12'.product.v1.SetLowStockThresholdRequest\x1a(.product.v1.SetLowStockThresholdResponse\x12]\n" +
+	"\x10ListLowStockSKUs\x12#.product.v1.ListLowStockSKUsRequest\x1a$.product.v1.ListLowStockSKUsResponse\x12]\n" +
+	"\x10ListReservations\x12#.product.v1.ListReservationsRequest\x1a$.product.v1.ListReservationsResponse\x12r\n" +
+	"\x17ForceReleaseReservation\x12*.product.v1.ForceReleaseReservationRequest\x1a+.product.v1.ForceReleaseReservationResponse\x12i\n" +
+	"\x14DrainSKUReservations\x12'.product.v1.DrainSKUReservationsRequest\x1a(.product.v1.DrainSKUReservationsResponseB\xb5\x01\n" +
 	"\x0ecom.product.v1B\x15InventoryServiceProtoP\x01ZCgithub.com/daisuke8000/example-ec-platform/gen/product/v1;productv1\xa2\x02\x03PXX\xaa\x02\n" +
 	"Product.V1\xca\x02\n" +
 	"Product\\V1\xe2\x02\x16Product\\V1\\GPBMetadata\xea\x02\vProduct::V1b\x06proto3"
@@ -774,55 +2117,114 @@ func file_product_v1_inventory_service_proto_rawDescGZIP() []byte {
 	return file_product_v1_inventory_service_proto_rawDescData
 }
 
-var file_product_v1_inventory_service_proto_msgTypes = make([]protoimpl.MessageInfo, 14)
+var file_product_v1_inventory_service_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_product_v1_inventory_service_proto_msgTypes = make([]protoimpl.MessageInfo, 35)
 var file_product_v1_inventory_service_proto_goTypes = []any{
-	(*GetInventoryRequest)(nil),           // 0: product.v1.GetInventoryRequest
-	(*GetInventoryResponse)(nil),          // 1: product.v1.GetInventoryResponse
-	(*UpdateInventoryRequest)(nil),        // 2: product.v1.UpdateInventoryRequest
-	(*UpdateInventoryResponse)(nil),       // 3: product.v1.UpdateInventoryResponse
-	(*BatchReserveInventoryRequest)(nil),  // 4: product.v1.BatchReserveInventoryRequest
-	(*BatchReserveInventoryResponse)(nil), // 5: product.v1.BatchReserveInventoryResponse
-	(*ConfirmReservationRequest)(nil),     // 6: product.v1.ConfirmReservationRequest
-	(*ConfirmReservationResponse)(nil),    // 7: product.v1.ConfirmReservationResponse
-	(*ReleaseInventoryRequest)(nil),       // 8: product.v1.ReleaseInventoryRequest
-	(*ReleaseInventoryResponse)(nil),      // 9: product.v1.ReleaseInventoryResponse
-	(*UpdateReservationRequest)(nil),      // 10: product.v1.UpdateReservationRequest
-	(*UpdateReservationResponse)(nil),     // 11: product.v1.UpdateReservationResponse
-	(*GetReservationStatusRequest)(nil),   // 12: product.v1.GetReservationStatusRequest
-	(*GetReservationStatusResponse)(nil),  // 13: product.v1.GetReservationStatusResponse
-	(*Inventory)(nil),                     // 14: product.v1.Inventory
-	(*ReservationItem)(nil),               // 15: product.v1.ReservationItem
-	(*Reservation)(nil),                   // 16: product.v1.Reservation
+	(ReservationLocking)(0),                 // 0: product.v1.ReservationLocking
+	(*GetInventoryRequest)(nil),             // 1: product.v1.GetInventoryRequest
+	(*GetInventoryResponse)(nil),            // 2: product.v1.GetInventoryResponse
+	(*WatchInventoryRequest)(nil),           // 3: product.v1.WatchInventoryRequest
+	(*WatchInventoryResponse)(nil),          // 4: product.v1.WatchInventoryResponse
+	(*UpdateInventoryRequest)(nil),          // 5: product.v1.UpdateInventoryRequest
+	(*UpdateInventoryResponse)(nil),         // 6: product.v1.UpdateInventoryResponse
+	(*BatchUpdateInventoryRequest)(nil),     // 7: product.v1.BatchUpdateInventoryRequest
+	(*InventoryQuantity)(nil),               // 8: product.v1.InventoryQuantity
+	(*BatchUpdateInventoryResponse)(nil),    // 9: product.v1.BatchUpdateInventoryResponse
+	(*InventoryUpdateResult)(nil),           // 10: product.v1.InventoryUpdateResult
+	(*BatchReserveInventoryRequest)(nil),    // 11: product.v1.BatchReserveInventoryRequest
+	(*BatchReserveInventoryResponse)(nil),   // 12: product.v1.BatchReserveInventoryResponse
+	(*ConfirmReservationRequest)(nil),       // 13: product.v1.ConfirmReservationRequest
+	(*ConfirmReservationResponse)(nil),      // 14: product.v1.ConfirmReservationResponse
+	(*ReleaseInventoryRequest)(nil),         // 15: product.v1.ReleaseInventoryRequest
+	(*ReleaseInventoryResponse)(nil),        // 16: product.v1.ReleaseInventoryResponse
+	(*UpdateReservationRequest)(nil),        // 17: product.v1.UpdateReservationRequest
+	(*UpdateReservationResponse)(nil),       // 18: product.v1.UpdateReservationResponse
+	(*GetReservationStatusRequest)(nil),     // 19: product.v1.GetReservationStatusRequest
+	(*GetReservationStatusResponse)(nil),    // 20: product.v1.GetReservationStatusResponse
+	(*GetSKUVelocityRequest)(nil),           // 21: product.v1.GetSKUVelocityRequest
+	(*GetSKUVelocityResponse)(nil),          // 22: product.v1.GetSKUVelocityResponse
+	(*ListInventoryMovementsRequest)(nil),   // 23: product.v1.ListInventoryMovementsRequest
+	(*ListInventoryMovementsResponse)(nil),  // 24: product.v1.ListInventoryMovementsResponse
+	(*SetLowStockThresholdRequest)(nil),     // 25: product.v1.SetLowStockThresholdRequest
+	(*SetLowStockThresholdResponse)(nil),    // 26: product.v1.SetLowStockThresholdResponse
+	(*ListLowStockSKUsRequest)(nil),         // 27: product.v1.ListLowStockSKUsRequest
+	(*ListLowStockSKUsResponse)(nil),        // 28: product.v1.ListLowStockSKUsResponse
+	(*LowStockSKU)(nil),                     // 29: product.v1.LowStockSKU
+	(*ListReservationsRequest)(nil),         // 30: product.v1.ListReservationsRequest
+	(*ListReservationsResponse)(nil),        // 31: product.v1.ListReservationsResponse
+	(*ForceReleaseReservationRequest)(nil),  // 32: product.v1.ForceReleaseReservationRequest
+	(*ForceReleaseReservationResponse)(nil), // 33: product.v1.ForceReleaseReservationResponse
+	(*DrainSKUReservationsRequest)(nil),     // 34: product.v1.DrainSKUReservationsRequest
+	(*DrainSKUReservationsResponse)(nil),    // 35: product.v1.DrainSKUReservationsResponse
+	(*Inventory)(nil),                       // 36: product.v1.Inventory
+	(*ReservationItem)(nil),                 // 37: product.v1.ReservationItem
+	(*Reservation)(nil),                     // 38: product.v1.Reservation
+	(*SKUVelocity)(nil),                     // 39: product.v1.SKUVelocity
+	(*InventoryMovement)(nil),               // 40: product.v1.InventoryMovement
+	(*timestamppb.Timestamp)(nil),           // 41: google.protobuf.Timestamp
+	(ReservationStatus)(0),                  // 42: product.v1.ReservationStatus
 }
 var file_product_v1_inventory_service_proto_depIdxs = []int32{
-	14, // 0: product.v1.GetInventoryResponse.inventory:type_name -> product.v1.Inventory
-	14, // 1: product.v1.UpdateInventoryResponse.inventory:type_name -> product.v1.Inventory
-	15, // 2: product.v1.BatchReserveInventoryRequest.items:type_name -> product.v1.ReservationItem
-	16, // 3: product.v1.BatchReserveInventoryResponse.reservation:type_name -> product.v1.Reservation
-	16, // 4: product.v1.ConfirmReservationResponse.reservation:type_name -> product.v1.Reservation
-	16, // 5: product.v1.ReleaseInventoryResponse.reservation:type_name -> product.v1.Reservation
-	15, // 6: product.v1.UpdateReservationRequest.items:type_name -> product.v1.ReservationItem
-	16, // 7: product.v1.UpdateReservationResponse.reservation:type_name -> product.v1.Reservation
-	16, // 8: product.v1.GetReservationStatusResponse.reservation:type_name -> product.v1.Reservation
-	0,  // 9: product.v1.InventoryService.GetInventory:input_type -> product.v1.GetInventoryRequest
-	2,  // 10: product.v1.InventoryService.UpdateInventory:input_type -> product.v1.UpdateInventoryRequest
-	4,  // 11: product.v1.InventoryService.BatchReserveInventory:input_type -> product.v1.BatchReserveInventoryRequest
-	6,  // 12: product.v1.InventoryService.ConfirmReservation:input_type -> product.v1.ConfirmReservationRequest
-	8,  // 13: product.v1.InventoryService.ReleaseInventory:input_type -> product.v1.ReleaseInventoryRequest
-	10, // 14: product.v1.InventoryService.UpdateReservation:input_type -> product.v1.UpdateReservationRequest
-	12, // 15: product.v1.InventoryService.GetReservationStatus:input_type -> product.v1.GetReservationStatusRequest
-	1,  // 16: product.v1.InventoryService.GetInventory:output_type -> product.v1.GetInventoryResponse
-	3,  // 17: product.v1.InventoryService.UpdateInventory:output_type -> product.v1.UpdateInventoryResponse
-	5,  // 18: product.v1.InventoryService.BatchReserveInventory:output_type -> product.v1.BatchReserveInventoryResponse
-	7,  // 19: product.v1.InventoryService.ConfirmReservation:output_type -> product.v1.ConfirmReservationResponse
-	9,  // 20: product.v1.InventoryService.ReleaseInventory:output_type -> product.v1.ReleaseInventoryResponse
-	11, // 21: product.v1.InventoryService.UpdateReservation:output_type -> product.v1.UpdateReservationResponse
-	13, // 22: product.v1.InventoryService.GetReservationStatus:output_type -> product.v1.GetReservationStatusResponse
-	16, // [16:23] is the sub-list for method output_type
-	9,  // [9:16] is the sub-list for method input_type
-	9,  // [9:9] is the sub-list for extension type_name
-	9,  // [9:9] is the sub-list for extension extendee
-	0,  // [0:9] is the sub-list for field type_name
+	36, // 0: product.v1.GetInventoryResponse.inventory:type_name -> product.v1.Inventory
+	36, // 1: product.v1.WatchInventoryResponse.inventory:type_name -> product.v1.Inventory
+	36, // 2: product.v1.UpdateInventoryResponse.inventory:type_name -> product.v1.Inventory
+	8,  // 3: product.v1.BatchUpdateInventoryRequest.items:type_name -> product.v1.InventoryQuantity
+	10, // 4: product.v1.BatchUpdateInventoryResponse.results:type_name -> product.v1.InventoryUpdateResult
+	36, // 5: product.v1.InventoryUpdateResult.inventory:type_name -> product.v1.Inventory
+	37, // 6: product.v1.BatchReserveInventoryRequest.items:type_name -> product.v1.ReservationItem
+	0,  // 7: product.v1.BatchReserveInventoryRequest.locking:type_name -> product.v1.ReservationLocking
+	38, // 8: product.v1.BatchReserveInventoryResponse.reservation:type_name -> product.v1.Reservation
+	38, // 9: product.v1.ConfirmReservationResponse.reservation:type_name -> product.v1.Reservation
+	38, // 10: product.v1.ReleaseInventoryResponse.reservation:type_name -> product.v1.Reservation
+	37, // 11: product.v1.UpdateReservationRequest.items:type_name -> product.v1.ReservationItem
+	38, // 12: product.v1.UpdateReservationResponse.reservation:type_name -> product.v1.Reservation
+	38, // 13: product.v1.GetReservationStatusResponse.reservation:type_name -> product.v1.Reservation
+	39, // 14: product.v1.GetSKUVelocityResponse.velocities:type_name -> product.v1.SKUVelocity
+	40, // 15: product.v1.ListInventoryMovementsResponse.movements:type_name -> product.v1.InventoryMovement
+	29, // 16: product.v1.ListLowStockSKUsResponse.skus:type_name -> product.v1.LowStockSKU
+	41, // 17: product.v1.LowStockSKU.alerted_at:type_name -> google.protobuf.Timestamp
+	42, // 18: product.v1.ListReservationsRequest.status:type_name -> product.v1.ReservationStatus
+	41, // 19: product.v1.ListReservationsRequest.created_after:type_name -> google.protobuf.Timestamp
+	41, // 20: product.v1.ListReservationsRequest.created_before:type_name -> google.protobuf.Timestamp
+	38, // 21: product.v1.ListReservationsResponse.reservations:type_name -> product.v1.Reservation
+	38, // 22: product.v1.ForceReleaseReservationResponse.reservation:type_name -> product.v1.Reservation
+	1,  // 23: product.v1.InventoryService.GetInventory:input_type -> product.v1.GetInventoryRequest
+	3,  // 24: product.v1.InventoryService.WatchInventory:input_type -> product.v1.WatchInventoryRequest
+	5,  // 25: product.v1.InventoryService.UpdateInventory:input_type -> product.v1.UpdateInventoryRequest
+	7,  // 26: product.v1.InventoryService.BatchUpdateInventory:input_type -> product.v1.BatchUpdateInventoryRequest
+	11, // 27: product.v1.InventoryService.BatchReserveInventory:input_type -> product.v1.BatchReserveInventoryRequest
+	13, // 28: product.v1.InventoryService.ConfirmReservation:input_type -> product.v1.ConfirmReservationRequest
+	15, // 29: product.v1.InventoryService.ReleaseInventory:input_type -> product.v1.ReleaseInventoryRequest
+	17, // 30: product.v1.InventoryService.UpdateReservation:input_type -> product.v1.UpdateReservationRequest
+	19, // 31: product.v1.InventoryService.GetReservationStatus:input_type -> product.v1.GetReservationStatusRequest
+	21, // 32: product.v1.InventoryService.GetSKUVelocity:input_type -> product.v1.GetSKUVelocityRequest
+	23, // 33: product.v1.InventoryService.ListInventoryMovements:input_type -> product.v1.ListInventoryMovementsRequest
+	25, // 34: product.v1.InventoryService.SetLowStockThreshold:input_type -> product.v1.SetLowStockThresholdRequest
+	27, // 35: product.v1.InventoryService.ListLowStockSKUs:input_type -> product.v1.ListLowStockSKUsRequest
+	30, // 36: product.v1.InventoryService.ListReservations:input_type -> product.v1.ListReservationsRequest
+	32, // 37: product.v1.InventoryService.ForceReleaseReservation:input_type -> product.v1.ForceReleaseReservationRequest
+	34, // 38: product.v1.InventoryService.DrainSKUReservations:input_type -> product.v1.DrainSKUReservationsRequest
+	2,  // 39: product.v1.InventoryService.GetInventory:output_type -> product.v1.GetInventoryResponse
+	4,  // 40: product.v1.InventoryService.WatchInventory:output_type -> product.v1.WatchInventoryResponse
+	6,  // 41: product.v1.InventoryService.UpdateInventory:output_type -> product.v1.UpdateInventoryResponse
+	9,  // 42: product.v1.InventoryService.BatchUpdateInventory:output_type -> product.v1.BatchUpdateInventoryResponse
+	12, // 43: product.v1.InventoryService.BatchReserveInventory:output_type -> product.v1.BatchReserveInventoryResponse
+	14, // 44: product.v1.InventoryService.ConfirmReservation:output_type -> product.v1.ConfirmReservationResponse
+	16, // 45: product.v1.InventoryService.ReleaseInventory:output_type -> product.v1.ReleaseInventoryResponse
+	18, // 46: product.v1.InventoryService.UpdateReservation:output_type -> product.v1.UpdateReservationResponse
+	20, // 47: product.v1.InventoryService.GetReservationStatus:output_type -> product.v1.GetReservationStatusResponse
+	22, // 48: product.v1.InventoryService.GetSKUVelocity:output_type -> product.v1.GetSKUVelocityResponse
+	24, // 49: product.v1.InventoryService.ListInventoryMovements:output_type -> product.v1.ListInventoryMovementsResponse
+	26, // 50: product.v1.InventoryService.SetLowStockThreshold:output_type -> product.v1.SetLowStockThresholdResponse
+	28, // 51: product.v1.InventoryService.ListLowStockSKUs:output_type -> product.v1.ListLowStockSKUsResponse
+	31, // 52: product.v1.InventoryService.ListReservations:output_type -> product.v1.ListReservationsResponse
+	33, // 53: product.v1.InventoryService.ForceReleaseReservation:output_type -> product.v1.ForceReleaseReservationResponse
+	35, // 54: product.v1.InventoryService.DrainSKUReservations:output_type -> product.v1.DrainSKUReservationsResponse
+	39, // [39:55] is the sub-list for method output_type
+	23, // [23:39] is the sub-list for method input_type
+	23, // [23:23] is the sub-list for extension type_name
+	23, // [23:23] is the sub-list for extension extendee
+	0,  // [0:23] is the sub-list for field type_name
 }
 
 func init() { file_product_v1_inventory_service_proto_init() }
@@ -831,18 +2233,20 @@ func file_product_v1_inventory_service_proto_init() {
 		return
 	}
 	file_product_v1_types_proto_init()
+	file_product_v1_inventory_service_proto_msgTypes[24].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_product_v1_inventory_service_proto_rawDesc), len(file_product_v1_inventory_service_proto_rawDesc)),
-			NumEnums:      0,
-			NumMessages:   14,
+			NumEnums:      1,
+			NumMessages:   35,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_product_v1_inventory_service_proto_goTypes,
 		DependencyIndexes: file_product_v1_inventory_service_proto_depIdxs,
+		EnumInfos:         file_product_v1_inventory_service_proto_enumTypes,
 		MessageInfos:      file_product_v1_inventory_service_proto_msgTypes,
 	}.Build()
 	File_product_v1_inventory_service_proto = out.File
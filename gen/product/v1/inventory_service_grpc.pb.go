@@ -24,13 +24,22 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	InventoryService_GetInventory_FullMethodName          = "/product.v1.InventoryService/GetInventory"
-	InventoryService_UpdateInventory_FullMethodName       = "/product.v1.InventoryService/UpdateInventory"
-	InventoryService_BatchReserveInventory_FullMethodName = "/product.v1.InventoryService/BatchReserveInventory"
-	InventoryService_ConfirmReservation_FullMethodName    = "/product.v1.InventoryService/ConfirmReservation"
-	InventoryService_ReleaseInventory_FullMethodName      = "/product.v1.InventoryService/ReleaseInventory"
-	InventoryService_UpdateReservation_FullMethodName     = "/product.v1.InventoryService/UpdateReservation"
-	InventoryService_GetReservationStatus_FullMethodName  = "/product.v1.InventoryService/GetReservationStatus"
+	InventoryService_GetInventory_FullMethodName            = "/product.v1.InventoryService/GetInventory"
+	InventoryService_WatchInventory_FullMethodName          = "/product.v1.InventoryService/WatchInventory"
+	InventoryService_UpdateInventory_FullMethodName         = "/product.v1.InventoryService/UpdateInventory"
+	InventoryService_BatchUpdateInventory_FullMethodName    = "/product.v1.InventoryService/BatchUpdateInventory"
+	InventoryService_BatchReserveInventory_FullMethodName   = "/product.v1.InventoryService/BatchReserveInventory"
+	InventoryService_ConfirmReservation_FullMethodName      = "/product.v1.InventoryService/ConfirmReservation"
+	InventoryService_ReleaseInventory_FullMethodName        = "/product.v1.InventoryService/ReleaseInventory"
+	InventoryService_UpdateReservation_FullMethodName       = "/product.v1.InventoryService/UpdateReservation"
+	InventoryService_GetReservationStatus_FullMethodName    = "/product.v1.InventoryService/GetReservationStatus"
+	InventoryService_GetSKUVelocity_FullMethodName          = "/product.v1.InventoryService/GetSKUVelocity"
+	InventoryService_ListInventoryMovements_FullMethodName  = "/product.v1.InventoryService/ListInventoryMovements"
+	InventoryService_SetLowStockThreshold_FullMethodName    = "/product.v1.InventoryService/SetLowStockThreshold"
+	InventoryService_ListLowStockSKUs_FullMethodName        = "/product.v1.InventoryService/ListLowStockSKUs"
+	InventoryService_ListReservations_FullMethodName        = "/product.v1.InventoryService/ListReservations"
+	InventoryService_ForceReleaseReservation_FullMethodName = "/product.v1.InventoryService/ForceReleaseReservation"
+	InventoryService_DrainSKUReservations_FullMethodName    = "/product.v1.InventoryService/DrainSKUReservations"
 )
 
 // InventoryServiceClient is the client API for InventoryService service.
@@ -44,22 +53,51 @@ type InventoryServiceClient interface {
 	// GetInventory retrieves current stock levels for a SKU.
 	// Returns NOT_FOUND if SKU doesn't exist.
 	GetInventory(ctx context.Context, in *GetInventoryRequest, opts ...grpc.CallOption) (*GetInventoryResponse, error)
+	// WatchInventory streams the stock levels of SKUs: first their current
+	// inventory, then their inventory whenever the available quantity changes.
+	// Unknown SKU IDs are ignored. The stream stays open until the client
+	// cancels it or the server's route timeout elapses.
+	// Returns INVALID_ARGUMENT if sku_ids is empty or exceeds 100 SKUs.
+	// Returns UNAVAILABLE if changes may have been missed (the client fell
+	// behind or the server lost its database listener); watch again.
+	WatchInventory(ctx context.Context, in *WatchInventoryRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[WatchInventoryResponse], error)
 	// UpdateInventory modifies the stock quantity for a SKU.
 	// Returns NOT_FOUND if SKU doesn't exist.
 	// Returns ABORTED if version conflict (optimistic locking).
 	// Returns INVALID_ARGUMENT if update would result in negative available quantity.
 	// Returns PERMISSION_DENIED if caller lacks admin role.
 	UpdateInventory(ctx context.Context, in *UpdateInventoryRequest, opts ...grpc.CallOption) (*UpdateInventoryResponse, error)
+	// BatchUpdateInventory sets absolute quantities for many SKUs, e.g. from a
+	// warehouse sync. Items are applied in chunks of 500 SKUs, each in its own
+	// transaction, and every change is recorded as an inventory movement.
+	//
+	// Behavior:
+	// - Partial success: An item that cannot be applied does not affect the others
+	// - Per-item errors: NOT_FOUND for unknown SKUs, RESOURCE_EXHAUSTED if the
+	//   quantity is below the reserved quantity, INVALID_ARGUMENT for malformed
+	//   IDs, negative quantities and SKUs listed more than once
+	// - If a chunk fails as a whole, earlier chunks stay applied and the
+	//   remaining items report the error
+	//
+	// Returns INVALID_ARGUMENT if items is empty or exceeds the batch limit (5000).
+	// Returns PERMISSION_DENIED if caller lacks admin role.
+	BatchUpdateInventory(ctx context.Context, in *BatchUpdateInventoryRequest, opts ...grpc.CallOption) (*BatchUpdateInventoryResponse, error)
 	// BatchReserveInventory atomically reserves inventory for multiple SKUs.
 	// This is the "Try" phase of the TCC pattern.
 	//
 	// Behavior:
 	// - All-or-Nothing: Either all items are reserved or none are
 	// - Idempotent: Same idempotency_key returns same response
-	// - TTL: Reservations expire after 15 minutes (configurable)
+	// - TTL: Reservations expire after 15 minutes (configurable) unless
+	//   ttl_seconds requests another TTL within the allowed bounds
 	//
 	// Returns RESOURCE_EXHAUSTED with InsufficientStockDetail if any SKU lacks stock.
 	// Returns INVALID_ARGUMENT if batch size exceeds limit (50 SKUs).
+	// Returns INVALID_ARGUMENT if ttl_seconds is outside the bounds allowed for the caller.
+	// Returns ABORTED if contention with concurrent reservations persists
+	// after the server's retries (LOCK_RETRY_MAX_ATTEMPTS), or if a
+	// pessimistic reservation waits longer than RESERVATION_LOCK_TIMEOUT for
+	// its rows; safe to retry.
 	BatchReserveInventory(ctx context.Context, in *BatchReserveInventoryRequest, opts ...grpc.CallOption) (*BatchReserveInventoryResponse, error)
 	// ConfirmReservation permanently commits the reservation.
 	// This is the "Confirm" phase of the TCC pattern.
@@ -97,6 +135,60 @@ type InventoryServiceClient interface {
 	// GetReservationStatus retrieves the current state of a reservation.
 	// Returns status NOT_FOUND (in response, not error) if reservation doesn't exist.
 	GetReservationStatus(ctx context.Context, in *GetReservationStatusRequest, opts ...grpc.CallOption) (*GetReservationStatusResponse, error)
+	// GetSKUVelocity returns aggregated sales velocity (units/day) per SKU.
+	// Sales are counted from CONFIRMED reservations over each requested window.
+	// Intended for purchasing and low-stock tooling to derive reorder points.
+	//
+	// Returns INVALID_ARGUMENT if sku_ids is empty or exceeds the batch limit (50).
+	// Returns INVALID_ARGUMENT if any window is outside 1..365 days.
+	GetSKUVelocity(ctx context.Context, in *GetSKUVelocityRequest, opts ...grpc.CallOption) (*GetSKUVelocityResponse, error)
+	// ListInventoryMovements returns the audit trail of a SKU's inventory, newest first.
+	// Every UpdateInventory, reserve, confirm, release and TTL expiry writes one movement
+	// per SKU in the same statement as the inventory change.
+	//
+	// Returns INVALID_ARGUMENT if sku_id or page_token is malformed.
+	ListInventoryMovements(ctx context.Context, in *ListInventoryMovementsRequest, opts ...grpc.CallOption) (*ListInventoryMovementsResponse, error)
+	// SetLowStockThreshold sets the available quantity below which a SKU is low
+	// on stock. Without a threshold of its own a SKU uses the service-wide
+	// default (LOW_STOCK_DEFAULT_THRESHOLD); 0 disables low-stock alerts for it.
+	//
+	// Returns NOT_FOUND if the SKU has no inventory.
+	// Returns INVALID_ARGUMENT if threshold is negative.
+	SetLowStockThreshold(ctx context.Context, in *SetLowStockThresholdRequest, opts ...grpc.CallOption) (*SetLowStockThresholdResponse, error)
+	// ListLowStockSKUs returns SKUs whose available quantity is below their
+	// low-stock threshold, ordered by SKU ID.
+	//
+	// A background worker also publishes an "inventory.low_stock" webhook event
+	// once each time a SKU drops below its threshold.
+	//
+	// Returns INVALID_ARGUMENT if page_token is malformed.
+	ListLowStockSKUs(ctx context.Context, in *ListLowStockSKUsRequest, opts ...grpc.CallOption) (*ListLowStockSKUsResponse, error)
+	// ListReservations returns reservations newest first, for support staff
+	// inspecting checkouts. Filters are combined with AND.
+	//
+	// Returns INVALID_ARGUMENT if sku_id or page_token is malformed, or if
+	// created_after is not before created_before.
+	ListReservations(ctx context.Context, in *ListReservationsRequest, opts ...grpc.CallOption) (*ListReservationsResponse, error)
+	// ForceReleaseReservation releases a PENDING reservation on behalf of
+	// support staff, e.g. one left behind by a failed checkout, and returns its
+	// stock. The reason is stored with the reservation and the stock movements
+	// are recorded as FORCE_RELEASE with the caller as actor.
+	//
+	// Returns NOT_FOUND if the reservation doesn't exist.
+	// Returns FAILED_PRECONDITION if it is not PENDING (already confirmed,
+	// released or expired).
+	// Returns INVALID_ARGUMENT if reason is empty or longer than 500 characters.
+	ForceReleaseReservation(ctx context.Context, in *ForceReleaseReservationRequest, opts ...grpc.CallOption) (*ForceReleaseReservationResponse, error)
+	// DrainSKUReservations force-releases every PENDING reservation holding a
+	// SKU, e.g. when the SKU was oversold or pulled during an incident. Each
+	// reservation is released as by ForceReleaseReservation in its own
+	// transaction; reservations confirmed or expired in the meantime are
+	// skipped. At most 1000 reservations are released per call and has_more
+	// is set when some are left.
+	//
+	// Returns INVALID_ARGUMENT if sku_id is malformed, or if reason is empty
+	// or longer than 500 characters.
+	DrainSKUReservations(ctx context.Context, in *DrainSKUReservationsRequest, opts ...grpc.CallOption) (*DrainSKUReservationsResponse, error)
 }
 
 type inventoryServiceClient struct {
@@ -117,6 +209,25 @@ func (c *inventoryServiceClient) GetInventory(ctx context.Context, in *GetInvent
 	return out, nil
 }
 
+func (c *inventoryServiceClient) WatchInventory(ctx context.Context, in *WatchInventoryRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[WatchInventoryResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &InventoryService_ServiceDesc.Streams[0], InventoryService_WatchInventory_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[WatchInventoryRequest, WatchInventoryResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type InventoryService_WatchInventoryClient = grpc.ServerStreamingClient[WatchInventoryResponse]
+
 func (c *inventoryServiceClient) UpdateInventory(ctx context.Context, in *UpdateInventoryRequest, opts ...grpc.CallOption) (*UpdateInventoryResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(UpdateInventoryResponse)
@@ -127,6 +238,16 @@ func (c *inventoryServiceClient) UpdateInventory(ctx context.Context, in *Update
 	return out, nil
 }
 
+func (c *inventoryServiceClient) BatchUpdateInventory(ctx context.Context, in *BatchUpdateInventoryRequest, opts ...grpc.CallOption) (*BatchUpdateInventoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BatchUpdateInventoryResponse)
+	err := c.cc.Invoke(ctx, InventoryService_BatchUpdateInventory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *inventoryServiceClient) BatchReserveInventory(ctx context.Context, in *BatchReserveInventoryRequest, opts ...grpc.CallOption) (*BatchReserveInventoryResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(BatchReserveInventoryResponse)
@@ -177,6 +298,76 @@ func (c *inventoryServiceClient) GetReservationStatus(ctx context.Context, in *G
 	return out, nil
 }
 
+func (c *inventoryServiceClient) GetSKUVelocity(ctx context.Context, in *GetSKUVelocityRequest, opts ...grpc.CallOption) (*GetSKUVelocityResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetSKUVelocityResponse)
+	err := c.cc.Invoke(ctx, InventoryService_GetSKUVelocity_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) ListInventoryMovements(ctx context.Context, in *ListInventoryMovementsRequest, opts ...grpc.CallOption) (*ListInventoryMovementsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListInventoryMovementsResponse)
+	err := c.cc.Invoke(ctx, InventoryService_ListInventoryMovements_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) SetLowStockThreshold(ctx context.Context, in *SetLowStockThresholdRequest, opts ...grpc.CallOption) (*SetLowStockThresholdResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetLowStockThresholdResponse)
+	err := c.cc.Invoke(ctx, InventoryService_SetLowStockThreshold_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) ListLowStockSKUs(ctx context.Context, in *ListLowStockSKUsRequest, opts ...grpc.CallOption) (*ListLowStockSKUsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListLowStockSKUsResponse)
+	err := c.cc.Invoke(ctx, InventoryService_ListLowStockSKUs_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) ListReservations(ctx context.Context, in *ListReservationsRequest, opts ...grpc.CallOption) (*ListReservationsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListReservationsResponse)
+	err := c.cc.Invoke(ctx, InventoryService_ListReservations_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) ForceReleaseReservation(ctx context.Context, in *ForceReleaseReservationRequest, opts ...grpc.CallOption) (*ForceReleaseReservationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ForceReleaseReservationResponse)
+	err := c.cc.Invoke(ctx, InventoryService_ForceReleaseReservation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) DrainSKUReservations(ctx context.Context, in *DrainSKUReservationsRequest, opts ...grpc.CallOption) (*DrainSKUReservationsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DrainSKUReservationsResponse)
+	err := c.cc.Invoke(ctx, InventoryService_DrainSKUReservations_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // InventoryServiceServer is the server API for InventoryService service.
 // All implementations must embed UnimplementedInventoryServiceServer
 // for forward compatibility.
@@ -188,22 +379,51 @@ type InventoryServiceServer interface {
 	// GetInventory retrieves current stock levels for a SKU.
 	// Returns NOT_FOUND if SKU doesn't exist.
 	GetInventory(context.Context, *GetInventoryRequest) (*GetInventoryResponse, error)
+	// WatchInventory streams the stock levels of SKUs: first their current
+	// inventory, then their inventory whenever the available quantity changes.
+	// Unknown SKU IDs are ignored. The stream stays open until the client
+	// cancels it or the server's route timeout elapses.
+	// Returns INVALID_ARGUMENT if sku_ids is empty or exceeds 100 SKUs.
+	// Returns UNAVAILABLE if changes may have been missed (the client fell
+	// behind or the server lost its database listener); watch again.
+	WatchInventory(*WatchInventoryRequest, grpc.ServerStreamingServer[WatchInventoryResponse]) error
 	// UpdateInventory modifies the stock quantity for a SKU.
 	// Returns NOT_FOUND if SKU doesn't exist.
 	// Returns ABORTED if version conflict (optimistic locking).
 	// Returns INVALID_ARGUMENT if update would result in negative available quantity.
 	// Returns PERMISSION_DENIED if caller lacks admin role.
 	UpdateInventory(context.Context, *UpdateInventoryRequest) (*UpdateInventoryResponse, error)
+	// BatchUpdateInventory sets absolute quantities for many SKUs, e.g. from a
+	// warehouse sync. Items are applied in chunks of 500 SKUs, each in its own
+	// transaction, and every change is recorded as an inventory movement.
+	//
+	// Behavior:
+	// - Partial success: An item that cannot be applied does not affect the others
+	// - Per-item errors: NOT_FOUND for unknown SKUs, RESOURCE_EXHAUSTED if the
+	//   quantity is below the reserved quantity, INVALID_ARGUMENT for malformed
+	//   IDs, negative quantities and SKUs listed more than once
+	// - If a chunk fails as a whole, earlier chunks stay applied and the
+	//   remaining items report the error
+	//
+	// Returns INVALID_ARGUMENT if items is empty or exceeds the batch limit (5000).
+	// Returns PERMISSION_DENIED if caller lacks admin role.
+	BatchUpdateInventory(context.Context, *BatchUpdateInventoryRequest) (*BatchUpdateInventoryResponse, error)
 	// BatchReserveInventory atomically reserves inventory for multiple SKUs.
 	// This is the "Try" phase of the TCC pattern.
 	//
 	// Behavior:
 	// - All-or-Nothing: Either all items are reserved or none are
 	// - Idempotent: Same idempotency_key returns same response
-	// - TTL: Reservations expire after 15 minutes (configurable)
+	// - TTL: Reservations expire after 15 minutes (configurable) unless
+	//   ttl_seconds requests another TTL within the allowed bounds
 	//
 	// Returns RESOURCE_EXHAUSTED with InsufficientStockDetail if any SKU lacks stock.
 	// Returns INVALID_ARGUMENT if batch size exceeds limit (50 SKUs).
+	// Returns INVALID_ARGUMENT if ttl_seconds is outside the bounds allowed for the caller.
+	// Returns ABORTED if contention with concurrent reservations persists
+	// after the server's retries (LOCK_RETRY_MAX_ATTEMPTS), or if a
+	// pessimistic reservation waits longer than RESERVATION_LOCK_TIMEOUT for
+	// its rows; safe to retry.
 	BatchReserveInventory(context.Context, *BatchReserveInventoryRequest) (*BatchReserveInventoryResponse, error)
 	// ConfirmReservation permanently commits the reservation.
 	// This is the "Confirm" phase of the TCC pattern.
@@ -241,6 +461,60 @@ type InventoryServiceServer interface {
 	// GetReservationStatus retrieves the current state of a reservation.
 	// Returns status NOT_FOUND (in response, not error) if reservation doesn't exist.
 	GetReservationStatus(context.Context, *GetReservationStatusRequest) (*GetReservationStatusResponse, error)
+	// GetSKUVelocity returns aggregated sales velocity (units/day) per SKU.
+	// Sales are counted from CONFIRMED reservations over each requested window.
+	// Intended for purchasing and low-stock tooling to derive reorder points.
+	//
+	// Returns INVALID_ARGUMENT if sku_ids is empty or exceeds the batch limit (50).
+	// Returns INVALID_ARGUMENT if any window is outside 1..365 days.
+	GetSKUVelocity(context.Context, *GetSKUVelocityRequest) (*GetSKUVelocityResponse, error)
+	// ListInventoryMovements returns the audit trail of a SKU's inventory, newest first.
+	// Every UpdateInventory, reserve, confirm, release and TTL expiry writes one movement
+	// per SKU in the same statement as the inventory change.
+	//
+	// Returns INVALID_ARGUMENT if sku_id or page_token is malformed.
+	ListInventoryMovements(context.Context, *ListInventoryMovementsRequest) (*ListInventoryMovementsResponse, error)
+	// SetLowStockThreshold sets the available quantity below which a SKU is low
+	// on stock. Without a threshold of its own a SKU uses the service-wide
+	// default (LOW_STOCK_DEFAULT_THRESHOLD); 0 disables low-stock alerts for it.
+	//
+	// Returns NOT_FOUND if the SKU has no inventory.
+	// Returns INVALID_ARGUMENT if threshold is negative.
+	SetLowStockThreshold(context.Context, *SetLowStockThresholdRequest) (*SetLowStockThresholdResponse, error)
+	// ListLowStockSKUs returns SKUs whose available quantity is below their
+	// low-stock threshold, ordered by SKU ID.
+	//
+	// A background worker also publishes an "inventory.low_stock" webhook event
+	// once each time a SKU drops below its threshold.
+	//
+	// Returns INVALID_ARGUMENT if page_token is malformed.
+	ListLowStockSKUs(context.Context, *ListLowStockSKUsRequest) (*ListLowStockSKUsResponse, error)
+	// ListReservations returns reservations newest first, for support staff
+	// inspecting checkouts. Filters are combined with AND.
+	//
+	// Returns INVALID_ARGUMENT if sku_id or page_token is malformed, or if
+	// created_after is not before created_before.
+	ListReservations(context.Context, *ListReservationsRequest) (*ListReservationsResponse, error)
+	// ForceReleaseReservation releases a PENDING reservation on behalf of
+	// support staff, e.g. one left behind by a failed checkout, and returns its
+	// stock. The reason is stored with the reservation and the stock movements
+	// are recorded as FORCE_RELEASE with the caller as actor.
+	//
+	// Returns NOT_FOUND if the reservation doesn't exist.
+	// Returns FAILED_PRECONDITION if it is not PENDING (already confirmed,
+	// released or expired).
+	// Returns INVALID_ARGUMENT if reason is empty or longer than 500 characters.
+	ForceReleaseReservation(context.Context, *ForceReleaseReservationRequest) (*ForceReleaseReservationResponse, error)
+	// DrainSKUReservations force-releases every PENDING reservation holding a
+	// SKU, e.g. when the SKU was oversold or pulled during an incident. Each
+	// reservation is released as by ForceReleaseReservation in its own
+	// transaction; reservations confirmed or expired in the meantime are
+	// skipped. At most 1000 reservations are released per call and has_more
+	// is set when some are left.
+	//
+	// Returns INVALID_ARGUMENT if sku_id is malformed, or if reason is empty
+	// or longer than 500 characters.
+	DrainSKUReservations(context.Context, *DrainSKUReservationsRequest) (*DrainSKUReservationsResponse, error)
 	mustEmbedUnimplementedInventoryServiceServer()
 }
 
@@ -254,9 +528,15 @@ type UnimplementedInventoryServiceServer struct{}
 func (UnimplementedInventoryServiceServer) GetInventory(context.Context, *GetInventoryRequest) (*GetInventoryResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method GetInventory not implemented")
 }
+func (UnimplementedInventoryServiceServer) WatchInventory(*WatchInventoryRequest, grpc.ServerStreamingServer[WatchInventoryResponse]) error {
+	return status.Error(codes.Unimplemented, "method WatchInventory not implemented")
+}
 func (UnimplementedInventoryServiceServer) UpdateInventory(context.Context, *UpdateInventoryRequest) (*UpdateInventoryResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method UpdateInventory not implemented")
 }
+func (UnimplementedInventoryServiceServer) BatchUpdateInventory(context.Context, *BatchUpdateInventoryRequest) (*BatchUpdateInventoryResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method BatchUpdateInventory not implemented")
+}
 func (UnimplementedInventoryServiceServer) BatchReserveInventory(context.Context, *BatchReserveInventoryRequest) (*BatchReserveInventoryResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method BatchReserveInventory not implemented")
 }
@@ -272,6 +552,27 @@ func (UnimplementedInventoryServiceServer) UpdateReservation(context.Context, *U
 func (UnimplementedInventoryServiceServer) GetReservationStatus(context.Context, *GetReservationStatusRequest) (*GetReservationStatusResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method GetReservationStatus not implemented")
 }
+func (UnimplementedInventoryServiceServer) GetSKUVelocity(context.Context, *GetSKUVelocityRequest) (*GetSKUVelocityResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetSKUVelocity not implemented")
+}
+func (UnimplementedInventoryServiceServer) ListInventoryMovements(context.Context, *ListInventoryMovementsRequest) (*ListInventoryMovementsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListInventoryMovements not implemented")
+}
+func (UnimplementedInventoryServiceServer) SetLowStockThreshold(context.Context, *SetLowStockThresholdRequest) (*SetLowStockThresholdResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetLowStockThreshold not implemented")
+}
+func (UnimplementedInventoryServiceServer) ListLowStockSKUs(context.Context, *ListLowStockSKUsRequest) (*ListLowStockSKUsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListLowStockSKUs not implemented")
+}
+func (UnimplementedInventoryServiceServer) ListReservations(context.Context, *ListReservationsRequest) (*ListReservationsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListReservations not implemented")
+}
+func (UnimplementedInventoryServiceServer) ForceReleaseReservation(context.Context, *ForceReleaseReservationRequest) (*ForceReleaseReservationResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ForceReleaseReservation not implemented")
+}
+func (UnimplementedInventoryServiceServer) DrainSKUReservations(context.Context, *DrainSKUReservationsRequest) (*DrainSKUReservationsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DrainSKUReservations not implemented")
+}
 func (UnimplementedInventoryServiceServer) mustEmbedUnimplementedInventoryServiceServer() {}
 func (UnimplementedInventoryServiceServer) testEmbeddedByValue()                          {}
 
@@ -311,6 +612,17 @@ func _InventoryService_GetInventory_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _InventoryService_WatchInventory_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchInventoryRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(InventoryServiceServer).WatchInventory(m, &grpc.GenericServerStream[WatchInventoryRequest, WatchInventoryResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type InventoryService_WatchInventoryServer = grpc.ServerStreamingServer[WatchInventoryResponse]
+
 func _InventoryService_UpdateInventory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(UpdateInventoryRequest)
 	if err := dec(in); err != nil {
@@ -329,6 +641,24 @@ func _InventoryService_UpdateInventory_Handler(srv interface{}, ctx context.Cont
 	return interceptor(ctx, in, info, handler)
 }
 
+func _InventoryService_BatchUpdateInventory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchUpdateInventoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).BatchUpdateInventory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_BatchUpdateInventory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).BatchUpdateInventory(ctx, req.(*BatchUpdateInventoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _InventoryService_BatchReserveInventory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(BatchReserveInventoryRequest)
 	if err := dec(in); err != nil {
@@ -419,6 +749,132 @@ func _InventoryService_GetReservationStatus_Handler(srv interface{}, ctx context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _InventoryService_GetSKUVelocity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSKUVelocityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).GetSKUVelocity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_GetSKUVelocity_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).GetSKUVelocity(ctx, req.(*GetSKUVelocityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_ListInventoryMovements_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListInventoryMovementsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).ListInventoryMovements(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_ListInventoryMovements_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).ListInventoryMovements(ctx, req.(*ListInventoryMovementsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_SetLowStockThreshold_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetLowStockThresholdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).SetLowStockThreshold(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_SetLowStockThreshold_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).SetLowStockThreshold(ctx, req.(*SetLowStockThresholdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_ListLowStockSKUs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListLowStockSKUsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).ListLowStockSKUs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_ListLowStockSKUs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).ListLowStockSKUs(ctx, req.(*ListLowStockSKUsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_ListReservations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListReservationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).ListReservations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_ListReservations_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).ListReservations(ctx, req.(*ListReservationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_ForceReleaseReservation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ForceReleaseReservationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).ForceReleaseReservation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_ForceReleaseReservation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).ForceReleaseReservation(ctx, req.(*ForceReleaseReservationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_DrainSKUReservations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DrainSKUReservationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).DrainSKUReservations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_DrainSKUReservations_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).DrainSKUReservations(ctx, req.(*DrainSKUReservationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // InventoryService_ServiceDesc is the grpc.ServiceDesc for InventoryService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -434,6 +890,10 @@ var InventoryService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateInventory",
 			Handler:    _InventoryService_UpdateInventory_Handler,
 		},
+		{
+			MethodName: "BatchUpdateInventory",
+			Handler:    _InventoryService_BatchUpdateInventory_Handler,
+		},
 		{
 			MethodName: "BatchReserveInventory",
 			Handler:    _InventoryService_BatchReserveInventory_Handler,
@@ -454,7 +914,41 @@ var InventoryService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetReservationStatus",
 			Handler:    _InventoryService_GetReservationStatus_Handler,
 		},
+		{
+			MethodName: "GetSKUVelocity",
+			Handler:    _InventoryService_GetSKUVelocity_Handler,
+		},
+		{
+			MethodName: "ListInventoryMovements",
+			Handler:    _InventoryService_ListInventoryMovements_Handler,
+		},
+		{
+			MethodName: "SetLowStockThreshold",
+			Handler:    _InventoryService_SetLowStockThreshold_Handler,
+		},
+		{
+			MethodName: "ListLowStockSKUs",
+			Handler:    _InventoryService_ListLowStockSKUs_Handler,
+		},
+		{
+			MethodName: "ListReservations",
+			Handler:    _InventoryService_ListReservations_Handler,
+		},
+		{
+			MethodName: "ForceReleaseReservation",
+			Handler:    _InventoryService_ForceReleaseReservation_Handler,
+		},
+		{
+			MethodName: "DrainSKUReservations",
+			Handler:    _InventoryService_DrainSKUReservations_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchInventory",
+			Handler:       _InventoryService_WatchInventory_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "product/v1/inventory_service.proto",
 }
@@ -0,0 +1,1884 @@
+// ==============================================================================
+// Pickup Service API
+// Store pickup (click & collect): locations, their stock and pickup slots
+// ==============================================================================
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: product/v1/pickup_service.proto
+
+package productv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type PickupStatus int32
+
+const (
+	PickupStatus_PICKUP_STATUS_UNSPECIFIED PickupStatus = 0
+	PickupStatus_PICKUP_STATUS_RESERVED    PickupStatus = 1
+	PickupStatus_PICKUP_STATUS_READY       PickupStatus = 2 // Packed at the location, buyer notified
+	PickupStatus_PICKUP_STATUS_COLLECTED   PickupStatus = 3
+	PickupStatus_PICKUP_STATUS_CANCELLED   PickupStatus = 4 // Slot and items returned to the location
+)
+
+// Enum value maps for PickupStatus.
+var (
+	PickupStatus_name = map[int32]string{
+		0: "PICKUP_STATUS_UNSPECIFIED",
+		1: "PICKUP_STATUS_RESERVED",
+		2: "PICKUP_STATUS_READY",
+		3: "PICKUP_STATUS_COLLECTED",
+		4: "PICKUP_STATUS_CANCELLED",
+	}
+	PickupStatus_value = map[string]int32{
+		"PICKUP_STATUS_UNSPECIFIED": 0,
+		"PICKUP_STATUS_RESERVED":    1,
+		"PICKUP_STATUS_READY":       2,
+		"PICKUP_STATUS_COLLECTED":   3,
+		"PICKUP_STATUS_CANCELLED":   4,
+	}
+)
+
+func (x PickupStatus) Enum() *PickupStatus {
+	p := new(PickupStatus)
+	*p = x
+	return p
+}
+
+func (x PickupStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PickupStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_product_v1_pickup_service_proto_enumTypes[0].Descriptor()
+}
+
+func (PickupStatus) Type() protoreflect.EnumType {
+	return &file_product_v1_pickup_service_proto_enumTypes[0]
+}
+
+func (x PickupStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PickupStatus.Descriptor instead.
+func (PickupStatus) EnumDescriptor() ([]byte, []int) {
+	return file_product_v1_pickup_service_proto_rawDescGZIP(), []int{0}
+}
+
+type PickupLocation struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Id      string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name    string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Address string                 `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty"`
+	// Inactive locations take no new reservations
+	Active        bool                   `protobuf:"varint,4,opt,name=active,proto3" json:"active,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PickupLocation) Reset() {
+	*x = PickupLocation{}
+	mi := &file_product_v1_pickup_service_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PickupLocation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PickupLocation) ProtoMessage() {}
+
+func (x *PickupLocation) ProtoReflect() protoreflect.Message {
+	mi := &file_product_v1_pickup_service_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PickupLocation.ProtoReflect.Descriptor instead.
+func (*PickupLocation) Descriptor() ([]byte, []int) {
+	return file_product_v1_pickup_service_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *PickupLocation) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *PickupLocation) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *PickupLocation) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *PickupLocation) GetActive() bool {
+	if x != nil {
+		return x.Active
+	}
+	return false
+}
+
+func (x *PickupLocation) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *PickupLocation) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type PickupSlot struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	LocationId    string                 `protobuf:"bytes,2,opt,name=location_id,json=locationId,proto3" json:"location_id,omitempty"`
+	StartsAt      *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=starts_at,json=startsAt,proto3" json:"starts_at,omitempty"`
+	EndsAt        *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=ends_at,json=endsAt,proto3" json:"ends_at,omitempty"`
+	Capacity      int32                  `protobuf:"varint,5,opt,name=capacity,proto3" json:"capacity,omitempty"`
+	Reserved      int32                  `protobuf:"varint,6,opt,name=reserved,proto3" json:"reserved,omitempty"`
+	Remaining     int32                  `protobuf:"varint,7,opt,name=remaining,proto3" json:"remaining,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PickupSlot) Reset() {
+	*x = PickupSlot{}
+	mi := &file_product_v1_pickup_service_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PickupSlot) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PickupSlot) ProtoMessage() {}
+
+func (x *PickupSlot) ProtoReflect() protoreflect.Message {
+	mi := &file_product_v1_pickup_service_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PickupSlot.ProtoReflect.Descriptor instead.
+func (*PickupSlot) Descriptor() ([]byte, []int) {
+	return file_product_v1_pickup_service_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *PickupSlot) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *PickupSlot) GetLocationId() string {
+	if x != nil {
+		return x.LocationId
+	}
+	return ""
+}
+
+func (x *PickupSlot) GetStartsAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartsAt
+	}
+	return nil
+}
+
+func (x *PickupSlot) GetEndsAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndsAt
+	}
+	return nil
+}
+
+func (x *PickupSlot) GetCapacity() int32 {
+	if x != nil {
+		return x.Capacity
+	}
+	return 0
+}
+
+func (x *PickupSlot) GetReserved() int32 {
+	if x != nil {
+		return x.Reserved
+	}
+	return 0
+}
+
+func (x *PickupSlot) GetRemaining() int32 {
+	if x != nil {
+		return x.Remaining
+	}
+	return 0
+}
+
+type PickupItem struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SkuId         string                 `protobuf:"bytes,1,opt,name=sku_id,json=skuId,proto3" json:"sku_id,omitempty"`
+	Quantity      int64                  `protobuf:"varint,2,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PickupItem) Reset() {
+	*x = PickupItem{}
+	mi := &file_product_v1_pickup_service_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PickupItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PickupItem) ProtoMessage() {}
+
+func (x *PickupItem) ProtoReflect() protoreflect.Message {
+	mi := &file_product_v1_pickup_service_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PickupItem.ProtoReflect.Descriptor instead.
+func (*PickupItem) Descriptor() ([]byte, []int) {
+	return file_product_v1_pickup_service_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *PickupItem) GetSkuId() string {
+	if x != nil {
+		return x.SkuId
+	}
+	return ""
+}
+
+func (x *PickupItem) GetQuantity() int64 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+type PickupReservation struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OrderId       string                 `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	LocationId    string                 `protobuf:"bytes,2,opt,name=location_id,json=locationId,proto3" json:"location_id,omitempty"`
+	SlotId        string                 `protobuf:"bytes,3,opt,name=slot_id,json=slotId,proto3" json:"slot_id,omitempty"`
+	Status        PickupStatus           `protobuf:"varint,4,opt,name=status,proto3,enum=product.v1.PickupStatus" json:"status,omitempty"`
+	Items         []*PickupItem          `protobuf:"bytes,5,rep,name=items,proto3" json:"items,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	ReadyAt       *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=ready_at,json=readyAt,proto3" json:"ready_at,omitempty"`
+	CollectedAt   *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=collected_at,json=collectedAt,proto3" json:"collected_at,omitempty"`
+	CancelledAt   *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=cancelled_at,json=cancelledAt,proto3" json:"cancelled_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PickupReservation) Reset() {
+	*x = PickupReservation{}
+	mi := &file_product_v1_pickup_service_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PickupReservation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PickupReservation) ProtoMessage() {}
+
+func (x *PickupReservation) ProtoReflect() protoreflect.Message {
+	mi := &file_product_v1_pickup_service_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PickupReservation.ProtoReflect.Descriptor instead.
+func (*PickupReservation) Descriptor() ([]byte, []int) {
+	return file_product_v1_pickup_service_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *PickupReservation) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+func (x *PickupReservation) GetLocationId() string {
+	if x != nil {
+		return x.LocationId
+	}
+	return ""
+}
+
+func (x *PickupReservation) GetSlotId() string {
+	if x != nil {
+		return x.SlotId
+	}
+	return ""
+}
+
+func (x *PickupReservation) GetStatus() PickupStatus {
+	if x != nil {
+		return x.Status
+	}
+	return PickupStatus_PICKUP_STATUS_UNSPECIFIED
+}
+
+func (x *PickupReservation) GetItems() []*PickupItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *PickupReservation) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *PickupReservation) GetReadyAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ReadyAt
+	}
+	return nil
+}
+
+func (x *PickupReservation) GetCollectedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CollectedAt
+	}
+	return nil
+}
+
+func (x *PickupReservation) GetCancelledAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CancelledAt
+	}
+	return nil
+}
+
+type CreatePickupLocationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Address       string                 `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreatePickupLocationRequest) Reset() {
+	*x = CreatePickupLocationRequest{}
+	mi := &file_product_v1_pickup_service_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreatePickupLocationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePickupLocationRequest) ProtoMessage() {}
+
+func (x *CreatePickupLocationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_v1_pickup_service_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatePickupLocationRequest.ProtoReflect.Descriptor instead.
+func (*CreatePickupLocationRequest) Descriptor() ([]byte, []int) {
+	return file_product_v1_pickup_service_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *CreatePickupLocationRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreatePickupLocationRequest) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+type CreatePickupLocationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Location      *PickupLocation        `protobuf:"bytes,1,opt,name=location,proto3" json:"location,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreatePickupLocationResponse) Reset() {
+	*x = CreatePickupLocationResponse{}
+	mi := &file_product_v1_pickup_service_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreatePickupLocationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePickupLocationResponse) ProtoMessage() {}
+
+func (x *CreatePickupLocationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_v1_pickup_service_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatePickupLocationResponse.ProtoReflect.Descriptor instead.
+func (*CreatePickupLocationResponse) Descriptor() ([]byte, []int) {
+	return file_product_v1_pickup_service_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *CreatePickupLocationResponse) GetLocation() *PickupLocation {
+	if x != nil {
+		return x.Location
+	}
+	return nil
+}
+
+type SetPickupLocationActiveRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	LocationId    string                 `protobuf:"bytes,1,opt,name=location_id,json=locationId,proto3" json:"location_id,omitempty"`
+	Active        bool                   `protobuf:"varint,2,opt,name=active,proto3" json:"active,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetPickupLocationActiveRequest) Reset() {
+	*x = SetPickupLocationActiveRequest{}
+	mi := &file_product_v1_pickup_service_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetPickupLocationActiveRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetPickupLocationActiveRequest) ProtoMessage() {}
+
+func (x *SetPickupLocationActiveRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_v1_pickup_service_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetPickupLocationActiveRequest.ProtoReflect.Descriptor instead.
+func (*SetPickupLocationActiveRequest) Descriptor() ([]byte, []int) {
+	return file_product_v1_pickup_service_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *SetPickupLocationActiveRequest) GetLocationId() string {
+	if x != nil {
+		return x.LocationId
+	}
+	return ""
+}
+
+func (x *SetPickupLocationActiveRequest) GetActive() bool {
+	if x != nil {
+		return x.Active
+	}
+	return false
+}
+
+type SetPickupLocationActiveResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Location      *PickupLocation        `protobuf:"bytes,1,opt,name=location,proto3" json:"location,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetPickupLocationActiveResponse) Reset() {
+	*x = SetPickupLocationActiveResponse{}
+	mi := &file_product_v1_pickup_service_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetPickupLocationActiveResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetPickupLocationActiveResponse) ProtoMessage() {}
+
+func (x *SetPickupLocationActiveResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_v1_pickup_service_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetPickupLocationActiveResponse.ProtoReflect.Descriptor instead.
+func (*SetPickupLocationActiveResponse) Descriptor() ([]byte, []int) {
+	return file_product_v1_pickup_service_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *SetPickupLocationActiveResponse) GetLocation() *PickupLocation {
+	if x != nil {
+		return x.Location
+	}
+	return nil
+}
+
+type ListPickupLocationsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Include inactive locations
+	IncludeInactive bool `protobuf:"varint,1,opt,name=include_inactive,json=includeInactive,proto3" json:"include_inactive,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ListPickupLocationsRequest) Reset() {
+	*x = ListPickupLocationsRequest{}
+	mi := &file_product_v1_pickup_service_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPickupLocationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPickupLocationsRequest) ProtoMessage() {}
+
+func (x *ListPickupLocationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_v1_pickup_service_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPickupLocationsRequest.ProtoReflect.Descriptor instead.
+func (*ListPickupLocationsRequest) Descriptor() ([]byte, []int) {
+	return file_product_v1_pickup_service_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ListPickupLocationsRequest) GetIncludeInactive() bool {
+	if x != nil {
+		return x.IncludeInactive
+	}
+	return false
+}
+
+type ListPickupLocationsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Locations     []*PickupLocation      `protobuf:"bytes,1,rep,name=locations,proto3" json:"locations,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPickupLocationsResponse) Reset() {
+	*x = ListPickupLocationsResponse{}
+	mi := &file_product_v1_pickup_service_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPickupLocationsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPickupLocationsResponse) ProtoMessage() {}
+
+func (x *ListPickupLocationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_v1_pickup_service_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPickupLocationsResponse.ProtoReflect.Descriptor instead.
+func (*ListPickupLocationsResponse) Descriptor() ([]byte, []int) {
+	return file_product_v1_pickup_service_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ListPickupLocationsResponse) GetLocations() []*PickupLocation {
+	if x != nil {
+		return x.Locations
+	}
+	return nil
+}
+
+type SetPickupStockRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	LocationId    string                 `protobuf:"bytes,1,opt,name=location_id,json=locationId,proto3" json:"location_id,omitempty"`
+	SkuId         string                 `protobuf:"bytes,2,opt,name=sku_id,json=skuId,proto3" json:"sku_id,omitempty"`
+	Quantity      int64                  `protobuf:"varint,3,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetPickupStockRequest) Reset() {
+	*x = SetPickupStockRequest{}
+	mi := &file_product_v1_pickup_service_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetPickupStockRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetPickupStockRequest) ProtoMessage() {}
+
+func (x *SetPickupStockRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_v1_pickup_service_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetPickupStockRequest.ProtoReflect.Descriptor instead.
+func (*SetPickupStockRequest) Descriptor() ([]byte, []int) {
+	return file_product_v1_pickup_service_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *SetPickupStockRequest) GetLocationId() string {
+	if x != nil {
+		return x.LocationId
+	}
+	return ""
+}
+
+func (x *SetPickupStockRequest) GetSkuId() string {
+	if x != nil {
+		return x.SkuId
+	}
+	return ""
+}
+
+func (x *SetPickupStockRequest) GetQuantity() int64 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+type SetPickupStockResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetPickupStockResponse) Reset() {
+	*x = SetPickupStockResponse{}
+	mi := &file_product_v1_pickup_service_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetPickupStockResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetPickupStockResponse) ProtoMessage() {}
+
+func (x *SetPickupStockResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_v1_pickup_service_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetPickupStockResponse.ProtoReflect.Descriptor instead.
+func (*SetPickupStockResponse) Descriptor() ([]byte, []int) {
+	return file_product_v1_pickup_service_proto_rawDescGZIP(), []int{11}
+}
+
+type CheckPickupAvailabilityRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Lines of the order (max 100)
+	Items         []*PickupItem `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CheckPickupAvailabilityRequest) Reset() {
+	*x = CheckPickupAvailabilityRequest{}
+	mi := &file_product_v1_pickup_service_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckPickupAvailabilityRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckPickupAvailabilityRequest) ProtoMessage() {}
+
+func (x *CheckPickupAvailabilityRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_v1_pickup_service_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckPickupAvailabilityRequest.ProtoReflect.Descriptor instead.
+func (*CheckPickupAvailabilityRequest) Descriptor() ([]byte, []int) {
+	return file_product_v1_pickup_service_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *CheckPickupAvailabilityRequest) GetItems() []*PickupItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+type CheckPickupAvailabilityResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Locations     []*PickupAvailability  `protobuf:"bytes,1,rep,name=locations,proto3" json:"locations,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CheckPickupAvailabilityResponse) Reset() {
+	*x = CheckPickupAvailabilityResponse{}
+	mi := &file_product_v1_pickup_service_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckPickupAvailabilityResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckPickupAvailabilityResponse) ProtoMessage() {}
+
+func (x *CheckPickupAvailabilityResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_v1_pickup_service_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckPickupAvailabilityResponse.ProtoReflect.Descriptor instead.
+func (*CheckPickupAvailabilityResponse) Descriptor() ([]byte, []int) {
+	return file_product_v1_pickup_service_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *CheckPickupAvailabilityResponse) GetLocations() []*PickupAvailability {
+	if x != nil {
+		return x.Locations
+	}
+	return nil
+}
+
+type PickupAvailability struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Location *PickupLocation        `protobuf:"bytes,1,opt,name=location,proto3" json:"location,omitempty"`
+	// True if the location has every item on hand
+	Available     bool `protobuf:"varint,2,opt,name=available,proto3" json:"available,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PickupAvailability) Reset() {
+	*x = PickupAvailability{}
+	mi := &file_product_v1_pickup_service_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PickupAvailability) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PickupAvailability) ProtoMessage() {}
+
+func (x *PickupAvailability) ProtoReflect() protoreflect.Message {
+	mi := &file_product_v1_pickup_service_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PickupAvailability.ProtoReflect.Descriptor instead.
+func (*PickupAvailability) Descriptor() ([]byte, []int) {
+	return file_product_v1_pickup_service_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *PickupAvailability) GetLocation() *PickupLocation {
+	if x != nil {
+		return x.Location
+	}
+	return nil
+}
+
+func (x *PickupAvailability) GetAvailable() bool {
+	if x != nil {
+		return x.Available
+	}
+	return false
+}
+
+type CreatePickupSlotRequest struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	LocationId string                 `protobuf:"bytes,1,opt,name=location_id,json=locationId,proto3" json:"location_id,omitempty"`
+	StartsAt   *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=starts_at,json=startsAt,proto3" json:"starts_at,omitempty"`
+	EndsAt     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=ends_at,json=endsAt,proto3" json:"ends_at,omitempty"`
+	// Orders the location can hand over in the slot
+	Capacity      int32 `protobuf:"varint,4,opt,name=capacity,proto3" json:"capacity,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreatePickupSlotRequest) Reset() {
+	*x = CreatePickupSlotRequest{}
+	mi := &file_product_v1_pickup_service_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreatePickupSlotRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePickupSlotRequest) ProtoMessage() {}
+
+func (x *CreatePickupSlotRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_v1_pickup_service_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatePickupSlotRequest.ProtoReflect.Descriptor instead.
+func (*CreatePickupSlotRequest) Descriptor() ([]byte, []int) {
+	return file_product_v1_pickup_service_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *CreatePickupSlotRequest) GetLocationId() string {
+	if x != nil {
+		return x.LocationId
+	}
+	return ""
+}
+
+func (x *CreatePickupSlotRequest) GetStartsAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartsAt
+	}
+	return nil
+}
+
+func (x *CreatePickupSlotRequest) GetEndsAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndsAt
+	}
+	return nil
+}
+
+func (x *CreatePickupSlotRequest) GetCapacity() int32 {
+	if x != nil {
+		return x.Capacity
+	}
+	return 0
+}
+
+type CreatePickupSlotResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Slot          *PickupSlot            `protobuf:"bytes,1,opt,name=slot,proto3" json:"slot,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreatePickupSlotResponse) Reset() {
+	*x = CreatePickupSlotResponse{}
+	mi := &file_product_v1_pickup_service_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreatePickupSlotResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePickupSlotResponse) ProtoMessage() {}
+
+func (x *CreatePickupSlotResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_v1_pickup_service_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatePickupSlotResponse.ProtoReflect.Descriptor instead.
+func (*CreatePickupSlotResponse) Descriptor() ([]byte, []int) {
+	return file_product_v1_pickup_service_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *CreatePickupSlotResponse) GetSlot() *PickupSlot {
+	if x != nil {
+		return x.Slot
+	}
+	return nil
+}
+
+type ListPickupSlotsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	LocationId    string                 `protobuf:"bytes,1,opt,name=location_id,json=locationId,proto3" json:"location_id,omitempty"`
+	From          *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`
+	To            *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=to,proto3" json:"to,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPickupSlotsRequest) Reset() {
+	*x = ListPickupSlotsRequest{}
+	mi := &file_product_v1_pickup_service_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPickupSlotsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPickupSlotsRequest) ProtoMessage() {}
+
+func (x *ListPickupSlotsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_v1_pickup_service_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPickupSlotsRequest.ProtoReflect.Descriptor instead.
+func (*ListPickupSlotsRequest) Descriptor() ([]byte, []int) {
+	return file_product_v1_pickup_service_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *ListPickupSlotsRequest) GetLocationId() string {
+	if x != nil {
+		return x.LocationId
+	}
+	return ""
+}
+
+func (x *ListPickupSlotsRequest) GetFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *ListPickupSlotsRequest) GetTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+type ListPickupSlotsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Slots         []*PickupSlot          `protobuf:"bytes,1,rep,name=slots,proto3" json:"slots,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPickupSlotsResponse) Reset() {
+	*x = ListPickupSlotsResponse{}
+	mi := &file_product_v1_pickup_service_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPickupSlotsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPickupSlotsResponse) ProtoMessage() {}
+
+func (x *ListPickupSlotsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_v1_pickup_service_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPickupSlotsResponse.ProtoReflect.Descriptor instead.
+func (*ListPickupSlotsResponse) Descriptor() ([]byte, []int) {
+	return file_product_v1_pickup_service_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *ListPickupSlotsResponse) GetSlots() []*PickupSlot {
+	if x != nil {
+		return x.Slots
+	}
+	return nil
+}
+
+type ReservePickupRequest struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	OrderId string                 `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	SlotId  string                 `protobuf:"bytes,2,opt,name=slot_id,json=slotId,proto3" json:"slot_id,omitempty"`
+	// Lines of the order (max 100)
+	Items         []*PickupItem `protobuf:"bytes,3,rep,name=items,proto3" json:"items,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReservePickupRequest) Reset() {
+	*x = ReservePickupRequest{}
+	mi := &file_product_v1_pickup_service_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReservePickupRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReservePickupRequest) ProtoMessage() {}
+
+func (x *ReservePickupRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_v1_pickup_service_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReservePickupRequest.ProtoReflect.Descriptor instead.
+func (*ReservePickupRequest) Descriptor() ([]byte, []int) {
+	return file_product_v1_pickup_service_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *ReservePickupRequest) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+func (x *ReservePickupRequest) GetSlotId() string {
+	if x != nil {
+		return x.SlotId
+	}
+	return ""
+}
+
+func (x *ReservePickupRequest) GetItems() []*PickupItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+type ReservePickupResponse struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Reservation *PickupReservation     `protobuf:"bytes,1,opt,name=reservation,proto3" json:"reservation,omitempty"`
+	// True if the order was reserved before; nothing was reserved
+	Replayed      bool `protobuf:"varint,2,opt,name=replayed,proto3" json:"replayed,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReservePickupResponse) Reset() {
+	*x = ReservePickupResponse{}
+	mi := &file_product_v1_pickup_service_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReservePickupResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReservePickupResponse) ProtoMessage() {}
+
+func (x *ReservePickupResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_v1_pickup_service_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReservePickupResponse.ProtoReflect.Descriptor instead.
+func (*ReservePickupResponse) Descriptor() ([]byte, []int) {
+	return file_product_v1_pickup_service_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *ReservePickupResponse) GetReservation() *PickupReservation {
+	if x != nil {
+		return x.Reservation
+	}
+	return nil
+}
+
+func (x *ReservePickupResponse) GetReplayed() bool {
+	if x != nil {
+		return x.Replayed
+	}
+	return false
+}
+
+type GetPickupReservationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OrderId       string                 `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPickupReservationRequest) Reset() {
+	*x = GetPickupReservationRequest{}
+	mi := &file_product_v1_pickup_service_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPickupReservationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPickupReservationRequest) ProtoMessage() {}
+
+func (x *GetPickupReservationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_v1_pickup_service_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPickupReservationRequest.ProtoReflect.Descriptor instead.
+func (*GetPickupReservationRequest) Descriptor() ([]byte, []int) {
+	return file_product_v1_pickup_service_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *GetPickupReservationRequest) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+type GetPickupReservationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Reservation   *PickupReservation     `protobuf:"bytes,1,opt,name=reservation,proto3" json:"reservation,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPickupReservationResponse) Reset() {
+	*x = GetPickupReservationResponse{}
+	mi := &file_product_v1_pickup_service_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPickupReservationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPickupReservationResponse) ProtoMessage() {}
+
+func (x *GetPickupReservationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_v1_pickup_service_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPickupReservationResponse.ProtoReflect.Descriptor instead.
+func (*GetPickupReservationResponse) Descriptor() ([]byte, []int) {
+	return file_product_v1_pickup_service_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *GetPickupReservationResponse) GetReservation() *PickupReservation {
+	if x != nil {
+		return x.Reservation
+	}
+	return nil
+}
+
+type MarkPickupReadyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OrderId       string                 `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MarkPickupReadyRequest) Reset() {
+	*x = MarkPickupReadyRequest{}
+	mi := &file_product_v1_pickup_service_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MarkPickupReadyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarkPickupReadyRequest) ProtoMessage() {}
+
+func (x *MarkPickupReadyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_v1_pickup_service_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarkPickupReadyRequest.ProtoReflect.Descriptor instead.
+func (*MarkPickupReadyRequest) Descriptor() ([]byte, []int) {
+	return file_product_v1_pickup_service_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *MarkPickupReadyRequest) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+type MarkPickupReadyResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Reservation   *PickupReservation     `protobuf:"bytes,1,opt,name=reservation,proto3" json:"reservation,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MarkPickupReadyResponse) Reset() {
+	*x = MarkPickupReadyResponse{}
+	mi := &file_product_v1_pickup_service_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MarkPickupReadyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarkPickupReadyResponse) ProtoMessage() {}
+
+func (x *MarkPickupReadyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_v1_pickup_service_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarkPickupReadyResponse.ProtoReflect.Descriptor instead.
+func (*MarkPickupReadyResponse) Descriptor() ([]byte, []int) {
+	return file_product_v1_pickup_service_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *MarkPickupReadyResponse) GetReservation() *PickupReservation {
+	if x != nil {
+		return x.Reservation
+	}
+	return nil
+}
+
+type MarkPickupCollectedRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OrderId       string                 `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MarkPickupCollectedRequest) Reset() {
+	*x = MarkPickupCollectedRequest{}
+	mi := &file_product_v1_pickup_service_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MarkPickupCollectedRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarkPickupCollectedRequest) ProtoMessage() {}
+
+func (x *MarkPickupCollectedRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_v1_pickup_service_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarkPickupCollectedRequest.ProtoReflect.Descriptor instead.
+func (*MarkPickupCollectedRequest) Descriptor() ([]byte, []int) {
+	return file_product_v1_pickup_service_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *MarkPickupCollectedRequest) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+type MarkPickupCollectedResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Reservation   *PickupReservation     `protobuf:"bytes,1,opt,name=reservation,proto3" json:"reservation,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MarkPickupCollectedResponse) Reset() {
+	*x = MarkPickupCollectedResponse{}
+	mi := &file_product_v1_pickup_service_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MarkPickupCollectedResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarkPickupCollectedResponse) ProtoMessage() {}
+
+func (x *MarkPickupCollectedResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_v1_pickup_service_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarkPickupCollectedResponse.ProtoReflect.Descriptor instead.
+func (*MarkPickupCollectedResponse) Descriptor() ([]byte, []int) {
+	return file_product_v1_pickup_service_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *MarkPickupCollectedResponse) GetReservation() *PickupReservation {
+	if x != nil {
+		return x.Reservation
+	}
+	return nil
+}
+
+type CancelPickupRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OrderId       string                 `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelPickupRequest) Reset() {
+	*x = CancelPickupRequest{}
+	mi := &file_product_v1_pickup_service_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelPickupRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelPickupRequest) ProtoMessage() {}
+
+func (x *CancelPickupRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_v1_pickup_service_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelPickupRequest.ProtoReflect.Descriptor instead.
+func (*CancelPickupRequest) Descriptor() ([]byte, []int) {
+	return file_product_v1_pickup_service_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *CancelPickupRequest) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+type CancelPickupResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Reservation   *PickupReservation     `protobuf:"bytes,1,opt,name=reservation,proto3" json:"reservation,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelPickupResponse) Reset() {
+	*x = CancelPickupResponse{}
+	mi := &file_product_v1_pickup_service_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelPickupResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelPickupResponse) ProtoMessage() {}
+
+func (x *CancelPickupResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_v1_pickup_service_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelPickupResponse.ProtoReflect.Descriptor instead.
+func (*CancelPickupResponse) Descriptor() ([]byte, []int) {
+	return file_product_v1_pickup_service_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *CancelPickupResponse) GetReservation() *PickupReservation {
+	if x != nil {
+		return x.Reservation
+	}
+	return nil
+}
+
+var File_product_v1_pickup_service_proto protoreflect.FileDescriptor
+
+const file_product_v1_pickup_service_proto_rawDesc = "" +
+	"\n" +
+	"\x1fproduct/v1/pickup_service.proto\x12\n" +
+	"product.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\xdc\x01\n" +
+	"\x0ePickupLocation\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x18\n" +
+	"\aaddress\x18\x03 \x01(\tR\aaddress\x12\x16\n" +
+	"\x06active\x18\x04 \x01(\bR\x06active\x129\n" +
+	"\n" +
+	"created_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\x81\x02\n" +
+	"\n" +
+	"PickupSlot\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1f\n" +
+	"\vlocation_id\x18\x02 \x01(\tR\n" +
+	"locationId\x127\n" +
+	"\tstarts_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\bstartsAt\x123\n" +
+	"\aends_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\x06endsAt\x12\x1a\n" +
+	"\bcapacity\x18\x05 \x01(\x05R\bcapacity\x12\x1a\n" +
+	"\breserved\x18\x06 \x01(\x05R\breserved\x12\x1c\n" +
+	"\tremaining\x18\a \x01(\x05R\tremaining\"?\n" +
+	"\n" +
+	"PickupItem\x12\x15\n" +
+	"\x06sku_id\x18\x01 \x01(\tR\x05skuId\x12\x1a\n" +
+	"\bquantity\x18\x02 \x01(\x03R\bquantity\"\xb8\x03\n" +
+	"\x11PickupReservation\x12\x19\n" +
+	"\border_id\x18\x01 \x01(\tR\aorderId\x12\x1f\n" +
+	"\vlocation_id\x18\x02 \x01(\tR\n" +
+	"locationId\x12\x17\n" +
+	"\aslot_id\x18\x03 \x01(\tR\x06slotId\x120\n" +
+	"\x06status\x18\x04 \x01(\x0e2\x18.product.v1.PickupStatusR\x06status\x12,\n" +
+	"\x05items\x18\x05 \x03(\v2\x16.product.v1.PickupItemR\x05items\x129\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x125\n" +
+	"\bready_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\areadyAt\x12=\n" +
+	"\fcollected_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\vcollectedAt\x12=\n" +
+	"\fcancelled_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\vcancelledAt\"K\n" +
+	"\x1bCreatePickupLocationRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x18\n" +
+	"\aaddress\x18\x02 \x01(\tR\aaddress\"V\n" +
+	"\x1cCreatePickupLocationResponse\x126\n" +
+	"\blocation\x18\x01 \x01(\v2\x1a.product.v1.PickupLocationR\blocation\"Y\n" +
+	"\x1eSetPickupLocationActiveRequest\x12\x1f\n" +
+	"\vlocation_id\x18\x01 \x01(\tR\n" +
+	"locationId\x12\x16\n" +
+	"\x06active\x18\x02 \x01(\bR\x06active\"Y\n" +
+	"\x1fSetPickupLocationActiveResponse\x126\n" +
+	"\blocation\x18\x01 \x01(\v2\x1a.product.v1.PickupLocationR\blocation\"G\n" +
+	"\x1aListPickupLocationsRequest\x12)\n" +
+	"\x10include_inactive\x18\x01 \x01(\bR\x0fincludeInactive\"W\n" +
+	"\x1bListPickupLocationsResponse\x128\n" +
+	"\tlocations\x18\x01 \x03(\v2\x1a.product.v1.PickupLocationR\tlocations\"k\n" +
+	"\x15SetPickupStockRequest\x12\x1f\n" +
+	"\vlocation_id\x18\x01 \x01(\tR\n" +
+	"locationId\x12\x15\n" +
+	"\x06sku_id\x18\x02 \x01(\tR\x05skuId\x12\x1a\n" +
+	"\bquantity\x18\x03 \x01(\x03R\bquantity\"\x18\n" +
+	"\x16SetPickupStockResponse\"N\n" +
+	"\x1eCheckPickupAvailabilityRequest\x12,\n" +
+	"\x05items\x18\x01 \x03(\v2\x16.product.v1.PickupItemR\x05items\"_\n" +
+	"\x1fCheckPickupAvailabilityResponse\x12<\n" +
+	"\tlocations\x18\x01 \x03(\v2\x1e.product.v1.PickupAvailabilityR\tlocations\"j\n" +
+	"\x12PickupAvailability\x126\n" +
+	"\blocation\x18\x01 \x01(\v2\x1a.product.v1.PickupLocationR\blocation\x12\x1c\n" +
+	"\tavailable\x18\x02 \x01(\bR\tavailable\"\xc4\x01\n" +
+	"\x17CreatePickupSlotRequest\x12\x1f\n" +
+	"\vlocation_id\x18\x01 \x01(\tR\n" +
+	"locationId\x127\n" +
+	"\tstarts_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\bstartsAt\x123\n" +
+	"\aends_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x06endsAt\x12\x1a\n" +
+	"\bcapacity\x18\x04 \x01(\x05R\bcapacity\"F\n" +
+	"\x18CreatePickupSlotResponse\x12*\n" +
+	"\x04slot\x18\x01 \x01(\v2\x16.product.v1.PickupSlotR\x04slot\"\x95\x01\n" +
+	"\x16ListPickupSlotsRequest\x12\x1f\n" +
+	"\vlocation_id\x18\x01 \x01(\tR\n" +
+	"locationId\x12.\n" +
+	"\x04from\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x04from\x12*\n" +
+	"\x02to\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x02to\"G\n" +
+	"\x17ListPickupSlotsResponse\x12,\n" +
+	"\x05slots\x18\x01 \x03(\v2\x16.product.v1.PickupSlotR\x05slots\"x\n" +
+	"\x14ReservePickupRequest\x12\x19\n" +
+	"\border_id\x18\x01 \x01(\tR\aorderId\x12\x17\n" +
+	"\aslot_id\x18\x02 \x01(\tR\x06slotId\x12,\n" +
+	"\x05items\x18\x03 \x03(\v2\x16.product.v1.PickupItemR\x05items\"t\n" +
+	"\x15ReservePickupResponse\x12?\n" +
+	"\vreservation\x18\x01 \x01(\v2\x1d.product.v1.PickupReservationR\vreservation\x12\x1a\n" +
+	"\breplayed\x18\x02 \x01(\bR\breplayed\"8\n" +
+	"\x1bGetPickupReservationRequest\x12\x19\n" +
+	"\border_id\x18\x01 \x01(\tR\aorderId\"_\n" +
+	"\x1cGetPickupReservationResponse\x12?\n" +
+	"\vreservation\x18\x01 \x01(\v2\x1d.product.v1.PickupReservationR\vreservation\"3\n" +
+	"\x16MarkPickupReadyRequest\x12\x19\n" +
+	"\border_id\x18\x01 \x01(\tR\aorderId\"Z\n" +
+	"\x17MarkPickupReadyResponse\x12?\n" +
+	"\vreservation\x18\x01 \x01(\v2\x1d.product.v1.PickupReservationR\vreservation\"7\n" +
+	"\x1aMarkPickupCollectedRequest\x12\x19\n" +
+	"\border_id\x18\x01 \x01(\tR\aorderId\"^\n" +
+	"\x1bMarkPickupCollectedResponse\x12?\n" +
+	"\vreservation\x18\x01 \x01(\v2\x1d.product.v1.PickupReservationR\vreservation\"0\n" +
+	"\x13CancelPickupRequest\x12\x19\n" +
+	"\border_id\x18\x01 \x01(\tR\aorderId\"W\n" +
+	"\x14CancelPickupResponse\x12?\n" +
+	"\vreservation\x18\x01 \x01(\v2\x1d.product.v1.PickupReservationR\vreservation*\x9c\x01\n" +
+	"\fPickupStatus\x12\x1d\n" +
+	"\x19PICKUP_STATUS_UNSPECIFIED\x10\x00\x12\x1a\n" +
+	"\x16PICKUP_STATUS_RESERVED\x10\x01\x12\x17\n" +
+	"\x13PICKUP_STATUS_READY\x10\x02\x12\x1b\n" +
+	"\x17PICKUP_STATUS_COLLECTED\x10\x03\x12\x1b\n" +
+	"\x17PICKUP_STATUS_CANCELLED\x10\x042\xb6\t\n" +
+	"\rPickupService\x12i\n" +
+	"\x14CreatePickupLocation\x12'.product.v1.CreatePickupLocationRequest\x1a(.product.v1.CreatePickupLocationResponse\x12r\n" +
+	"\x17SetPickupLocationActive\x12*.product.v1.SetPickupLocationActiveRequest\x1a+.product.v1.SetPickupLocationActiveResponse\x12f\n" +
+	"\x13ListPickupLocations\x12&.product.v1.ListPickupLocationsRequest\x1a'.product.v1.ListPickupLocationsResponse\x12W\n" +
+	"\x0eSetPickupStock\x12!.product.v1.SetPickupStockRequest\x1a\".product.v1.SetPickupStockResponse\x12r\n" +
+	"\x17CheckPickupAvailability\x12*.product.v1.CheckPickupAvailabilityRequest\x1a+.product.v1.CheckPickupAvailabilityResponse\x12]\n" +
+	"\x10CreatePickupSlot\x12#.product.v1.CreatePickupSlotRequest\x1a$.product.v1.CreatePickupSlotResponse\x12Z\n" +
+	"\x0fListPickupSlots\x12\".product.v1.ListPickupSlotsRequest\x1a#.product.v1.ListPickupSlotsResponse\x12T\n" +
+	"\rReservePickup\x12 .product.v1.ReservePickupRequest\x1a!.product.v1.ReservePickupResponse\x12i\n" +
+	"\x14GetPickupReservation\x12'.product.v1.GetPickupReservationRequest\x1a(.product.v1.GetPickupReservationResponse\x12Z\n" +
+	"\x0fMarkPickupReady\x12\".product.v1.MarkPickupReadyRequest\x1a#.product.v1.MarkPickupReadyResponse\x12f\n" +
+	"\x13MarkPickupCollected\x12&.product.v1.MarkPickupCollectedRequest\x1a'.product.v1.MarkPickupCollectedResponse\x12Q\n" +
+	"\fCancelPickup\x12\x1f.product.v1.CancelPickupRequest\x1a .product.v1.CancelPickupResponseB\xb2\x01\n" +
+	"\x0ecom.product.v1B\x12PickupServiceProtoP\x01ZCgithub.com/daisuke8000/example-ec-platform/gen/product/v1;productv1\xa2\x02\x03PXX\xaa\x02\n" +
+	"Product.V1\xca\x02\n" +
+	"Product\\V1\xe2\x02\x16Product\\V1\\GPBMetadata\xea\x02\vProduct::V1b\x06proto3"
+
+var (
+	file_product_v1_pickup_service_proto_rawDescOnce sync.Once
+	file_product_v1_pickup_service_proto_rawDescData []byte
+)
+
+func file_product_v1_pickup_service_proto_rawDescGZIP() []byte {
+	file_product_v1_pickup_service_proto_rawDescOnce.Do(func() {
+		file_product_v1_pickup_service_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_product_v1_pickup_service_proto_rawDesc), len(file_product_v1_pickup_service_proto_rawDesc)))
+	})
+	return file_product_v1_pickup_service_proto_rawDescData
+}
+
+var file_product_v1_pickup_service_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_product_v1_pickup_service_proto_msgTypes = make([]protoimpl.MessageInfo, 29)
+var file_product_v1_pickup_service_proto_goTypes = []any{
+	(PickupStatus)(0),                       // 0: product.v1.PickupStatus
+	(*PickupLocation)(nil),                  // 1: product.v1.PickupLocation
+	(*PickupSlot)(nil),                      // 2: product.v1.PickupSlot
+	(*PickupItem)(nil),                      // 3: product.v1.PickupItem
+	(*PickupReservation)(nil),               // 4: product.v1.PickupReservation
+	(*CreatePickupLocationRequest)(nil),     // 5: product.v1.CreatePickupLocationRequest
+	(*CreatePickupLocationResponse)(nil),    // 6: product.v1.CreatePickupLocationResponse
+	(*SetPickupLocationActiveRequest)(nil),  // 7: product.v1.SetPickupLocationActiveRequest
+	(*SetPickupLocationActiveResponse)(nil), // 8: product.v1.SetPickupLocationActiveResponse
+	(*ListPickupLocationsRequest)(nil),      // 9: product.v1.ListPickupLocationsRequest
+	(*ListPickupLocationsResponse)(nil),     // 10: product.v1.ListPickupLocationsResponse
+	(*SetPickupStockRequest)(nil),           // 11: product.v1.SetPickupStockRequest
+	(*SetPickupStockResponse)(nil),          // 12: product.v1.SetPickupStockResponse
+	(*CheckPickupAvailabilityRequest)(nil),  // 13: product.v1.CheckPickupAvailabilityRequest
+	(*CheckPickupAvailabilityResponse)(nil), // 14: product.v1.CheckPickupAvailabilityResponse
+	(*PickupAvailability)(nil),              // 15: product.v1.PickupAvailability
+	(*CreatePickupSlotRequest)(nil),         // 16: product.v1.CreatePickupSlotRequest
+	(*CreatePickupSlotResponse)(nil),        // 17: product.v1.CreatePickupSlotResponse
+	(*ListPickupSlotsRequest)(nil),          // 18: product.v1.ListPickupSlotsRequest
+	(*ListPickupSlotsResponse)(nil),         // 19: product.v1.ListPickupSlotsResponse
+	(*ReservePickupRequest)(nil),            // 20: product.v1.ReservePickupRequest
+	(*ReservePickupResponse)(nil),           // 21: product.v1.ReservePickupResponse
+	(*GetPickupReservationRequest)(nil),     // 22: product.v1.GetPickupReservationRequest
+	(*GetPickupReservationResponse)(nil),    // 23: product.v1.GetPickupReservationResponse
+	(*MarkPickupReadyRequest)(nil),          // 24: product.v1.MarkPickupReadyRequest
+	(*MarkPickupReadyResponse)(nil),         // 25: product.v1.MarkPickupReadyResponse
+	(*MarkPickupCollectedRequest)(nil),      // 26: product.v1.MarkPickupCollectedRequest
+	(*MarkPickupCollectedResponse)(nil),     // 27: product.v1.MarkPickupCollectedResponse
+	(*CancelPickupRequest)(nil),             // 28: product.v1.CancelPickupRequest
+	(*CancelPickupResponse)(nil),            // 29: product.v1.CancelPickupResponse
+	(*timestamppb.Timestamp)(nil),           // 30: google.protobuf.Timestamp
+}
+var file_product_v1_pickup_service_proto_depIdxs = []int32{
+	30, // 0: product.v1.PickupLocation.created_at:type_name -> google.protobuf.Timestamp
+	30, // 1: product.v1.PickupLocation.updated_at:type_name -> google.protobuf.Timestamp
+	30, // 2: product.v1.PickupSlot.starts_at:type_name -> google.protobuf.Timestamp
+	30, // 3: product.v1.PickupSlot.ends_at:type_name -> google.protobuf.Timestamp
+	0,  // 4: product.v1.PickupReservation.status:type_name -> product.v1.PickupStatus
+	3,  // 5: product.v1.PickupReservation.items:type_name -> product.v1.PickupItem
+	30, // 6: product.v1.PickupReservation.created_at:type_name -> google.protobuf.Timestamp
+	30, // 7: product.v1.PickupReservation.ready_at:type_name -> google.protobuf.Timestamp
+	30, // 8: product.v1.PickupReservation.collected_at:type_name -> google.protobuf.Timestamp
+	30, // 9: product.v1.PickupReservation.cancelled_at:type_name -> google.protobuf.Timestamp
+	1,  // 10: product.v1.CreatePickupLocationResponse.location:type_name -> product.v1.PickupLocation
+	1,  // 11: product.v1.SetPickupLocationActiveResponse.location:type_name -> product.v1.PickupLocation
+	1,  // 12: product.v1.ListPickupLocationsResponse.locations:type_name -> product.v1.PickupLocation
+	3,  // 13: product.v1.CheckPickupAvailabilityRequest.items:type_name -> product.v1.PickupItem
+	15, // 14: product.v1.CheckPickupAvailabilityResponse.locations:type_name -> product.v1.PickupAvailability
+	1,  // 15: product.v1.PickupAvailability.location:type_name -> product.v1.PickupLocation
+	30, // 16: product.v1.CreatePickupSlotRequest.starts_at:type_name -> google.protobuf.Timestamp
+	30, // 17: product.v1.CreatePickupSlotRequest.ends_at:type_name -> google.protobuf.Timestamp
+	2,  // 18: product.v1.CreatePickupSlotResponse.slot:type_name -> product.v1.PickupSlot
+	30, // 19: product.v1.ListPickupSlotsRequest.from:type_name -> google.protobuf.Timestamp
+	30, // 20: product.v1.ListPickupSlotsRequest.to:type_name -> google.protobuf.Timestamp
+	2,  // 21: product.v1.ListPickupSlotsResponse.slots:type_name -> product.v1.PickupSlot
+	3,  // 22: product.v1.ReservePickupRequest.items:type_name -> product.v1.PickupItem
+	4,  // 23: product.v1.ReservePickupResponse.reservation:type_name -> product.v1.PickupReservation
+	4,  // 24: product.v1.GetPickupReservationResponse.reservation:type_name -> product.v1.PickupReservation
+	4,  // 25: product.v1.MarkPickupReadyResponse.reservation:type_name -> product.v1.PickupReservation
+	4,  // 26: product.v1.MarkPickupCollectedResponse.reservation:type_name -> product.v1.PickupReservation
+	4,  // 27: product.v1.CancelPickupResponse.reservation:type_name -> product.v1.PickupReservation
+	5,  // 28: product.v1.PickupService.CreatePickupLocation:input_type -> product.v1.CreatePickupLocationRequest
+	7,  // 29: product.v1.PickupService.SetPickupLocationActive:input_type -> product.v1.SetPickupLocationActiveRequest
+	9,  // 30: product.v1.PickupService.ListPickupLocations:input_type -> product.v1.ListPickupLocationsRequest
+	11, // 31: product.v1.PickupService.SetPickupStock:input_type -> product.v1.SetPickupStockRequest
+	13, // 32: product.v1.PickupService.CheckPickupAvailability:input_type -> product.v1.CheckPickupAvailabilityRequest
+	16, // 33: product.v1.PickupService.CreatePickupSlot:input_type -> product.v1.CreatePickupSlotRequest
+	18, // 34: product.v1.PickupService.ListPickupSlots:input_type -> product.v1.ListPickupSlotsRequest
+	20, // 35: product.v1.PickupService.ReservePickup:input_type -> product.v1.ReservePickupRequest
+	22, // 36: product.v1.PickupService.GetPickupReservation:input_type -> product.v1.GetPickupReservationRequest
+	24, // 37: product.v1.PickupService.MarkPickupReady:input_type -> product.v1.MarkPickupReadyRequest
+	26, // 38: product.v1.PickupService.MarkPickupCollected:input_type -> product.v1.MarkPickupCollectedRequest
+	28, // 39: product.v1.PickupService.CancelPickup:input_type -> product.v1.CancelPickupRequest
+	6,  // 40: product.v1.PickupService.CreatePickupLocation:output_type -> product.v1.CreatePickupLocationResponse
+	8,  // 41: product.v1.PickupService.SetPickupLocationActive:output_type -> product.v1.SetPickupLocationActiveResponse
+	10, // 42: product.v1.PickupService.ListPickupLocations:output_type -> product.v1.ListPickupLocationsResponse
+	12, // 43: product.v1.PickupService.SetPickupStock:output_type -> product.v1.SetPickupStockResponse
+	14, // 44: product.v1.PickupService.CheckPickupAvailability:output_type -> product.v1.CheckPickupAvailabilityResponse
+	17, // 45: product.v1.PickupService.CreatePickupSlot:output_type -> product.v1.CreatePickupSlotResponse
+	19, // 46: product.v1.PickupService.ListPickupSlots:output_type -> product.v1.ListPickupSlotsResponse
+	21, // 47: product.v1.PickupService.ReservePickup:output_type -> product.v1.ReservePickupResponse
+	23, // 48: product.v1.PickupService.GetPickupReservation:output_type -> product.v1.GetPickupReservationResponse
+	25, // 49: product.v1.PickupService.MarkPickupReady:output_type -> product.v1.MarkPickupReadyResponse
+	27, // 50: product.v1.PickupService.MarkPickupCollected:output_type -> product.v1.MarkPickupCollectedResponse
+	29, // 51: product.v1.PickupService.CancelPickup:output_type -> product.v1.CancelPickupResponse
+	40, // [40:52] is the sub-list for method output_type
+	28, // [28:40] is the sub-list for method input_type
+	28, // [28:28] is the sub-list for extension type_name
+	28, // [28:28] is the sub-list for extension extendee
+	0,  // [0:28] is the sub-list for field type_name
+}
+
+func init() { file_product_v1_pickup_service_proto_init() }
+func file_product_v1_pickup_service_proto_init() {
+	if File_product_v1_pickup_service_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_product_v1_pickup_service_proto_rawDesc), len(file_product_v1_pickup_service_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   29,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_product_v1_pickup_service_proto_goTypes,
+		DependencyIndexes: file_product_v1_pickup_service_proto_depIdxs,
+		EnumInfos:         file_product_v1_pickup_service_proto_enumTypes,
+		MessageInfos:      file_product_v1_pickup_service_proto_msgTypes,
+	}.Build()
+	File_product_v1_pickup_service_proto = out.File
+	file_product_v1_pickup_service_proto_goTypes = nil
+	file_product_v1_pickup_service_proto_depIdxs = nil
+}
@@ -0,0 +1,866 @@
+// ==============================================================================
+// Preorder Service API
+// Campaigns that sell SKUs before they are in stock, up to an allocation cap
+// ==============================================================================
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: product/v1/preorder_service.proto
+
+package productv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type PreorderAllocationStatus int32
+
+const (
+	PreorderAllocationStatus_PREORDER_ALLOCATION_STATUS_UNSPECIFIED PreorderAllocationStatus = 0
+	PreorderAllocationStatus_PREORDER_ALLOCATION_STATUS_ALLOCATED   PreorderAllocationStatus = 1
+	PreorderAllocationStatus_PREORDER_ALLOCATION_STATUS_RELEASED    PreorderAllocationStatus = 2 // No longer counts against the cap
+)
+
+// Enum value maps for PreorderAllocationStatus.
+var (
+	PreorderAllocationStatus_name = map[int32]string{
+		0: "PREORDER_ALLOCATION_STATUS_UNSPECIFIED",
+		1: "PREORDER_ALLOCATION_STATUS_ALLOCATED",
+		2: "PREORDER_ALLOCATION_STATUS_RELEASED",
+	}
+	PreorderAllocationStatus_value = map[string]int32{
+		"PREORDER_ALLOCATION_STATUS_UNSPECIFIED": 0,
+		"PREORDER_ALLOCATION_STATUS_ALLOCATED":   1,
+		"PREORDER_ALLOCATION_STATUS_RELEASED":    2,
+	}
+)
+
+func (x PreorderAllocationStatus) Enum() *PreorderAllocationStatus {
+	p := new(PreorderAllocationStatus)
+	*p = x
+	return p
+}
+
+func (x PreorderAllocationStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PreorderAllocationStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_product_v1_preorder_service_proto_enumTypes[0].Descriptor()
+}
+
+func (PreorderAllocationStatus) Type() protoreflect.EnumType {
+	return &file_product_v1_preorder_service_proto_enumTypes[0]
+}
+
+func (x PreorderAllocationStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PreorderAllocationStatus.Descriptor instead.
+func (PreorderAllocationStatus) EnumDescriptor() ([]byte, []int) {
+	return file_product_v1_preorder_service_proto_rawDescGZIP(), []int{0}
+}
+
+type PreorderCampaign struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	SkuId string                 `protobuf:"bytes,1,opt,name=sku_id,json=skuId,proto3" json:"sku_id,omitempty"`
+	// Orders are allocated from starts_at until ends_at
+	StartsAt *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=starts_at,json=startsAt,proto3" json:"starts_at,omitempty"`
+	EndsAt   *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=ends_at,json=endsAt,proto3" json:"ends_at,omitempty"`
+	// Estimated ship date announced to buyers
+	ShipDate      *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=ship_date,json=shipDate,proto3" json:"ship_date,omitempty"`
+	AllocationCap int64                  `protobuf:"varint,5,opt,name=allocation_cap,json=allocationCap,proto3" json:"allocation_cap,omitempty"`
+	Allocated     int64                  `protobuf:"varint,6,opt,name=allocated,proto3" json:"allocated,omitempty"`
+	Remaining     int64                  `protobuf:"varint,7,opt,name=remaining,proto3" json:"remaining,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PreorderCampaign) Reset() {
+	*x = PreorderCampaign{}
+	mi := &file_product_v1_preorder_service_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PreorderCampaign) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PreorderCampaign) ProtoMessage() {}
+
+func (x *PreorderCampaign) ProtoReflect() protoreflect.Message {
+	mi := &file_product_v1_preorder_service_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PreorderCampaign.ProtoReflect.Descriptor instead.
+func (*PreorderCampaign) Descriptor() ([]byte, []int) {
+	return file_product_v1_preorder_service_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *PreorderCampaign) GetSkuId() string {
+	if x != nil {
+		return x.SkuId
+	}
+	return ""
+}
+
+func (x *PreorderCampaign) GetStartsAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartsAt
+	}
+	return nil
+}
+
+func (x *PreorderCampaign) GetEndsAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndsAt
+	}
+	return nil
+}
+
+func (x *PreorderCampaign) GetShipDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ShipDate
+	}
+	return nil
+}
+
+func (x *PreorderCampaign) GetAllocationCap() int64 {
+	if x != nil {
+		return x.AllocationCap
+	}
+	return 0
+}
+
+func (x *PreorderCampaign) GetAllocated() int64 {
+	if x != nil {
+		return x.Allocated
+	}
+	return 0
+}
+
+func (x *PreorderCampaign) GetRemaining() int64 {
+	if x != nil {
+		return x.Remaining
+	}
+	return 0
+}
+
+func (x *PreorderCampaign) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type PreorderAllocation struct {
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	OrderId       string                   `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	SkuId         string                   `protobuf:"bytes,2,opt,name=sku_id,json=skuId,proto3" json:"sku_id,omitempty"`
+	Quantity      int64                    `protobuf:"varint,3,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	Status        PreorderAllocationStatus `protobuf:"varint,4,opt,name=status,proto3,enum=product.v1.PreorderAllocationStatus" json:"status,omitempty"`
+	CreatedAt     *timestamppb.Timestamp   `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	ReleasedAt    *timestamppb.Timestamp   `protobuf:"bytes,6,opt,name=released_at,json=releasedAt,proto3" json:"released_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PreorderAllocation) Reset() {
+	*x = PreorderAllocation{}
+	mi := &file_product_v1_preorder_service_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PreorderAllocation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PreorderAllocation) ProtoMessage() {}
+
+func (x *PreorderAllocation) ProtoReflect() protoreflect.Message {
+	mi := &file_product_v1_preorder_service_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PreorderAllocation.ProtoReflect.Descriptor instead.
+func (*PreorderAllocation) Descriptor() ([]byte, []int) {
+	return file_product_v1_preorder_service_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *PreorderAllocation) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+func (x *PreorderAllocation) GetSkuId() string {
+	if x != nil {
+		return x.SkuId
+	}
+	return ""
+}
+
+func (x *PreorderAllocation) GetQuantity() int64 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *PreorderAllocation) GetStatus() PreorderAllocationStatus {
+	if x != nil {
+		return x.Status
+	}
+	return PreorderAllocationStatus_PREORDER_ALLOCATION_STATUS_UNSPECIFIED
+}
+
+func (x *PreorderAllocation) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *PreorderAllocation) GetReleasedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ReleasedAt
+	}
+	return nil
+}
+
+type SetPreorderCampaignRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SkuId         string                 `protobuf:"bytes,1,opt,name=sku_id,json=skuId,proto3" json:"sku_id,omitempty"`
+	StartsAt      *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=starts_at,json=startsAt,proto3" json:"starts_at,omitempty"`
+	EndsAt        *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=ends_at,json=endsAt,proto3" json:"ends_at,omitempty"`
+	ShipDate      *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=ship_date,json=shipDate,proto3" json:"ship_date,omitempty"`
+	AllocationCap int64                  `protobuf:"varint,5,opt,name=allocation_cap,json=allocationCap,proto3" json:"allocation_cap,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetPreorderCampaignRequest) Reset() {
+	*x = SetPreorderCampaignRequest{}
+	mi := &file_product_v1_preorder_service_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetPreorderCampaignRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetPreorderCampaignRequest) ProtoMessage() {}
+
+func (x *SetPreorderCampaignRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_v1_preorder_service_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetPreorderCampaignRequest.ProtoReflect.Descriptor instead.
+func (*SetPreorderCampaignRequest) Descriptor() ([]byte, []int) {
+	return file_product_v1_preorder_service_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *SetPreorderCampaignRequest) GetSkuId() string {
+	if x != nil {
+		return x.SkuId
+	}
+	return ""
+}
+
+func (x *SetPreorderCampaignRequest) GetStartsAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartsAt
+	}
+	return nil
+}
+
+func (x *SetPreorderCampaignRequest) GetEndsAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndsAt
+	}
+	return nil
+}
+
+func (x *SetPreorderCampaignRequest) GetShipDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ShipDate
+	}
+	return nil
+}
+
+func (x *SetPreorderCampaignRequest) GetAllocationCap() int64 {
+	if x != nil {
+		return x.AllocationCap
+	}
+	return 0
+}
+
+type SetPreorderCampaignResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Campaign      *PreorderCampaign      `protobuf:"bytes,1,opt,name=campaign,proto3" json:"campaign,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetPreorderCampaignResponse) Reset() {
+	*x = SetPreorderCampaignResponse{}
+	mi := &file_product_v1_preorder_service_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetPreorderCampaignResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetPreorderCampaignResponse) ProtoMessage() {}
+
+func (x *SetPreorderCampaignResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_v1_preorder_service_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetPreorderCampaignResponse.ProtoReflect.Descriptor instead.
+func (*SetPreorderCampaignResponse) Descriptor() ([]byte, []int) {
+	return file_product_v1_preorder_service_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *SetPreorderCampaignResponse) GetCampaign() *PreorderCampaign {
+	if x != nil {
+		return x.Campaign
+	}
+	return nil
+}
+
+type GetPreorderCampaignsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SkuIds        []string               `protobuf:"bytes,1,rep,name=sku_ids,json=skuIds,proto3" json:"sku_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPreorderCampaignsRequest) Reset() {
+	*x = GetPreorderCampaignsRequest{}
+	mi := &file_product_v1_preorder_service_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPreorderCampaignsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPreorderCampaignsRequest) ProtoMessage() {}
+
+func (x *GetPreorderCampaignsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_v1_preorder_service_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPreorderCampaignsRequest.ProtoReflect.Descriptor instead.
+func (*GetPreorderCampaignsRequest) Descriptor() ([]byte, []int) {
+	return file_product_v1_preorder_service_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GetPreorderCampaignsRequest) GetSkuIds() []string {
+	if x != nil {
+		return x.SkuIds
+	}
+	return nil
+}
+
+type GetPreorderCampaignsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Campaigns     []*PreorderCampaign    `protobuf:"bytes,1,rep,name=campaigns,proto3" json:"campaigns,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPreorderCampaignsResponse) Reset() {
+	*x = GetPreorderCampaignsResponse{}
+	mi := &file_product_v1_preorder_service_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPreorderCampaignsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPreorderCampaignsResponse) ProtoMessage() {}
+
+func (x *GetPreorderCampaignsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_v1_preorder_service_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPreorderCampaignsResponse.ProtoReflect.Descriptor instead.
+func (*GetPreorderCampaignsResponse) Descriptor() ([]byte, []int) {
+	return file_product_v1_preorder_service_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *GetPreorderCampaignsResponse) GetCampaigns() []*PreorderCampaign {
+	if x != nil {
+		return x.Campaigns
+	}
+	return nil
+}
+
+type AllocatePreorderRequest struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	OrderId string                 `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	// Preorder lines of the order (max 100)
+	Items         []*PreorderItem `protobuf:"bytes,2,rep,name=items,proto3" json:"items,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AllocatePreorderRequest) Reset() {
+	*x = AllocatePreorderRequest{}
+	mi := &file_product_v1_preorder_service_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AllocatePreorderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AllocatePreorderRequest) ProtoMessage() {}
+
+func (x *AllocatePreorderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_v1_preorder_service_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AllocatePreorderRequest.ProtoReflect.Descriptor instead.
+func (*AllocatePreorderRequest) Descriptor() ([]byte, []int) {
+	return file_product_v1_preorder_service_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *AllocatePreorderRequest) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+func (x *AllocatePreorderRequest) GetItems() []*PreorderItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+type PreorderItem struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SkuId         string                 `protobuf:"bytes,1,opt,name=sku_id,json=skuId,proto3" json:"sku_id,omitempty"`
+	Quantity      int64                  `protobuf:"varint,2,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PreorderItem) Reset() {
+	*x = PreorderItem{}
+	mi := &file_product_v1_preorder_service_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PreorderItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PreorderItem) ProtoMessage() {}
+
+func (x *PreorderItem) ProtoReflect() protoreflect.Message {
+	mi := &file_product_v1_preorder_service_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PreorderItem.ProtoReflect.Descriptor instead.
+func (*PreorderItem) Descriptor() ([]byte, []int) {
+	return file_product_v1_preorder_service_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *PreorderItem) GetSkuId() string {
+	if x != nil {
+		return x.SkuId
+	}
+	return ""
+}
+
+func (x *PreorderItem) GetQuantity() int64 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+type AllocatePreorderResponse struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Allocations []*PreorderAllocation  `protobuf:"bytes,1,rep,name=allocations,proto3" json:"allocations,omitempty"`
+	// True if the order was allocated before; nothing was allocated
+	Replayed      bool `protobuf:"varint,2,opt,name=replayed,proto3" json:"replayed,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AllocatePreorderResponse) Reset() {
+	*x = AllocatePreorderResponse{}
+	mi := &file_product_v1_preorder_service_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AllocatePreorderResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AllocatePreorderResponse) ProtoMessage() {}
+
+func (x *AllocatePreorderResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_v1_preorder_service_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AllocatePreorderResponse.ProtoReflect.Descriptor instead.
+func (*AllocatePreorderResponse) Descriptor() ([]byte, []int) {
+	return file_product_v1_preorder_service_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *AllocatePreorderResponse) GetAllocations() []*PreorderAllocation {
+	if x != nil {
+		return x.Allocations
+	}
+	return nil
+}
+
+func (x *AllocatePreorderResponse) GetReplayed() bool {
+	if x != nil {
+		return x.Replayed
+	}
+	return false
+}
+
+type ReleasePreorderRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OrderId       string                 `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReleasePreorderRequest) Reset() {
+	*x = ReleasePreorderRequest{}
+	mi := &file_product_v1_preorder_service_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReleasePreorderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReleasePreorderRequest) ProtoMessage() {}
+
+func (x *ReleasePreorderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_v1_preorder_service_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReleasePreorderRequest.ProtoReflect.Descriptor instead.
+func (*ReleasePreorderRequest) Descriptor() ([]byte, []int) {
+	return file_product_v1_preorder_service_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ReleasePreorderRequest) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+type ReleasePreorderResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Lines released by this call
+	Allocations   []*PreorderAllocation `protobuf:"bytes,1,rep,name=allocations,proto3" json:"allocations,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReleasePreorderResponse) Reset() {
+	*x = ReleasePreorderResponse{}
+	mi := &file_product_v1_preorder_service_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReleasePreorderResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReleasePreorderResponse) ProtoMessage() {}
+
+func (x *ReleasePreorderResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_v1_preorder_service_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReleasePreorderResponse.ProtoReflect.Descriptor instead.
+func (*ReleasePreorderResponse) Descriptor() ([]byte, []int) {
+	return file_product_v1_preorder_service_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ReleasePreorderResponse) GetAllocations() []*PreorderAllocation {
+	if x != nil {
+		return x.Allocations
+	}
+	return nil
+}
+
+var File_product_v1_preorder_service_proto protoreflect.FileDescriptor
+
+const file_product_v1_preorder_service_proto_rawDesc = "" +
+	"\n" +
+	"!product/v1/preorder_service.proto\x12\n" +
+	"product.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\xee\x02\n" +
+	"\x10PreorderCampaign\x12\x15\n" +
+	"\x06sku_id\x18\x01 \x01(\tR\x05skuId\x127\n" +
+	"\tstarts_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\bstartsAt\x123\n" +
+	"\aends_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x06endsAt\x127\n" +
+	"\tship_date\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\bshipDate\x12%\n" +
+	"\x0eallocation_cap\x18\x05 \x01(\x03R\rallocationCap\x12\x1c\n" +
+	"\tallocated\x18\x06 \x01(\x03R\tallocated\x12\x1c\n" +
+	"\tremaining\x18\a \x01(\x03R\tremaining\x129\n" +
+	"\n" +
+	"updated_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\x98\x02\n" +
+	"\x12PreorderAllocation\x12\x19\n" +
+	"\border_id\x18\x01 \x01(\tR\aorderId\x12\x15\n" +
+	"\x06sku_id\x18\x02 \x01(\tR\x05skuId\x12\x1a\n" +
+	"\bquantity\x18\x03 \x01(\x03R\bquantity\x12<\n" +
+	"\x06status\x18\x04 \x01(\x0e2$.product.v1.PreorderAllocationStatusR\x06status\x129\n" +
+	"\n" +
+	"created_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12;\n" +
+	"\vreleased_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"releasedAt\"\x81\x02\n" +
+	"\x1aSetPreorderCampaignRequest\x12\x15\n" +
+	"\x06sku_id\x18\x01 \x01(\tR\x05skuId\x127\n" +
+	"\tstarts_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\bstartsAt\x123\n" +
+	"\aends_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x06endsAt\x127\n" +
+	"\tship_date\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\bshipDate\x12%\n" +
+	"\x0eallocation_cap\x18\x05 \x01(\x03R\rallocationCap\"W\n" +
+	"\x1bSetPreorderCampaignResponse\x128\n" +
+	"\bcampaign\x18\x01 \x01(\v2\x1c.product.v1.PreorderCampaignR\bcampaign\"6\n" +
+	"\x1bGetPreorderCampaignsRequest\x12\x17\n" +
+	"\asku_ids\x18\x01 \x03(\tR\x06skuIds\"Z\n" +
+	"\x1cGetPreorderCampaignsResponse\x12:\n" +
+	"\tcampaigns\x18\x01 \x03(\v2\x1c.product.v1.PreorderCampaignR\tcampaigns\"d\n" +
+	"\x17AllocatePreorderRequest\x12\x19\n" +
+	"\border_id\x18\x01 \x01(\tR\aorderId\x12.\n" +
+	"\x05items\x18\x02 \x03(\v2\x18.product.v1.PreorderItemR\x05items\"A\n" +
+	"\fPreorderItem\x12\x15\n" +
+	"\x06sku_id\x18\x01 \x01(\tR\x05skuId\x12\x1a\n" +
+	"\bquantity\x18\x02 \x01(\x03R\bquantity\"x\n" +
+	"\x18AllocatePreorderResponse\x12@\n" +
+	"\vallocations\x18\x01 \x03(\v2\x1e.product.v1.PreorderAllocationR\vallocations\x12\x1a\n" +
+	"\breplayed\x18\x02 \x01(\bR\breplayed\"3\n" +
+	"\x16ReleasePreorderRequest\x12\x19\n" +
+	"\border_id\x18\x01 \x01(\tR\aorderId\"[\n" +
+	"\x17ReleasePreorderResponse\x12@\n" +
+	"\vallocations\x18\x01 \x03(\v2\x1e.product.v1.PreorderAllocationR\vallocations*\x99\x01\n" +
+	"\x18PreorderAllocationStatus\x12*\n" +
+	"&PREORDER_ALLOCATION_STATUS_UNSPECIFIED\x10\x00\x12(\n" +
+	"$PREORDER_ALLOCATION_STATUS_ALLOCATED\x10\x01\x12'\n" +
+	"#PREORDER_ALLOCATION_STATUS_RELEASED\x10\x022\x9f\x03\n" +
+	"\x0fPreorderService\x12f\n" +
+	"\x13SetPreorderCampaign\x12&.product.v1.SetPreorderCampaignRequest\x1a'.product.v1.SetPreorderCampaignResponse\x12i\n" +
+	"\x14GetPreorderCampaigns\x12'.product.v1.GetPreorderCampaignsRequest\x1a(.product.v1.GetPreorderCampaignsResponse\x12]\n" +
+	"\x10AllocatePreorder\x12#.product.v1.AllocatePreorderRequest\x1a$.product.v1.AllocatePreorderResponse\x12Z\n" +
+	"\x0fReleasePreorder\x12\".product.v1.ReleasePreorderRequest\x1a#.product.v1.ReleasePreorderResponseB\xb4\x01\n" +
+	"\x0ecom.product.v1B\x14PreorderServiceProtoP\x01ZCgithub.com/daisuke8000/example-ec-platform/gen/product/v1;productv1\xa2\x02\x03PXX\xaa\x02\n" +
+	"Product.V1\xca\x02\n" +
+	"Product\\V1\xe2\x02\x16Product\\V1\\GPBMetadata\xea\x02\vProduct::V1b\x06proto3"
+
+var (
+	file_product_v1_preorder_service_proto_rawDescOnce sync.Once
+	file_product_v1_preorder_service_proto_rawDescData []byte
+)
+
+func file_product_v1_preorder_service_proto_rawDescGZIP() []byte {
+	file_product_v1_preorder_service_proto_rawDescOnce.Do(func() {
+		file_product_v1_preorder_service_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_product_v1_preorder_service_proto_rawDesc), len(file_product_v1_preorder_service_proto_rawDesc)))
+	})
+	return file_product_v1_preorder_service_proto_rawDescData
+}
+
+var file_product_v1_preorder_service_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_product_v1_preorder_service_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
+var file_product_v1_preorder_service_proto_goTypes = []any{
+	(PreorderAllocationStatus)(0),        // 0: product.v1.PreorderAllocationStatus
+	(*PreorderCampaign)(nil),             // 1: product.v1.PreorderCampaign
+	(*PreorderAllocation)(nil),           // 2: product.v1.PreorderAllocation
+	(*SetPreorderCampaignRequest)(nil),   // 3: product.v1.SetPreorderCampaignRequest
+	(*SetPreorderCampaignResponse)(nil),  // 4: product.v1.SetPreorderCampaignResponse
+	(*GetPreorderCampaignsRequest)(nil),  // 5: product.v1.GetPreorderCampaignsRequest
+	(*GetPreorderCampaignsResponse)(nil), // 6: product.v1.GetPreorderCampaignsResponse
+	(*AllocatePreorderRequest)(nil),      // 7: product.v1.AllocatePreorderRequest
+	(*PreorderItem)(nil),                 // 8: product.v1.PreorderItem
+	(*AllocatePreorderResponse)(nil),     // 9: product.v1.AllocatePreorderResponse
+	(*ReleasePreorderRequest)(nil),       // 10: product.v1.ReleasePreorderRequest
+	(*ReleasePreorderResponse)(nil),      // 11: product.v1.ReleasePreorderResponse
+	(*timestamppb.Timestamp)(nil),        // 12: google.protobuf.Timestamp
+}
+var file_product_v1_preorder_service_proto_depIdxs = []int32{
+	12, // 0: product.v1.PreorderCampaign.starts_at:type_name -> google.protobuf.Timestamp
+	12, // 1: product.v1.PreorderCampaign.ends_at:type_name -> google.protobuf.Timestamp
+	12, // 2: product.v1.PreorderCampaign.ship_date:type_name -> google.protobuf.Timestamp
+	12, // 3: product.v1.PreorderCampaign.updated_at:type_name -> google.protobuf.Timestamp
+	0,  // 4: product.v1.PreorderAllocation.status:type_name -> product.v1.PreorderAllocationStatus
+	12, // 5: product.v1.PreorderAllocation.created_at:type_name -> google.protobuf.Timestamp
+	12, // 6: product.v1.PreorderAllocation.released_at:type_name -> google.protobuf.Timestamp
+	12, // 7: product.v1.SetPreorderCampaignRequest.starts_at:type_name -> google.protobuf.Timestamp
+	12, // 8: product.v1.SetPreorderCampaignRequest.ends_at:type_name -> google.protobuf.Timestamp
+	12, // 9: product.v1.SetPreorderCampaignRequest.ship_date:type_name -> google.protobuf.Timestamp
+	1,  // 10: product.v1.SetPreorderCampaignResponse.campaign:type_name -> product.v1.PreorderCampaign
+	1,  // 11: product.v1.GetPreorderCampaignsResponse.campaigns:type_name -> product.v1.PreorderCampaign
+	8,  // 12: product.v1.AllocatePreorderRequest.items:type_name -> product.v1.PreorderItem
+	2,  // 13: product.v1.AllocatePreorderResponse.allocations:type_name -> product.v1.PreorderAllocation
+	2,  // 14: product.v1.ReleasePreorderResponse.allocations:type_name -> product.v1.PreorderAllocation
+	3,  // 15: product.v1.PreorderService.SetPreorderCampaign:input_type -> product.v1.SetPreorderCampaignRequest
+	5,  // 16: product.v1.PreorderService.GetPreorderCampaigns:input_type -> product.v1.GetPreorderCampaignsRequest
+	7,  // 17: product.v1.PreorderService.AllocatePreorder:input_type -> product.v1.AllocatePreorderRequest
+	10, // 18: product.v1.PreorderService.ReleasePreorder:input_type -> product.v1.ReleasePreorderRequest
+	4,  // 19: product.v1.PreorderService.SetPreorderCampaign:output_type -> product.v1.SetPreorderCampaignResponse
+	6,  // 20: product.v1.PreorderService.GetPreorderCampaigns:output_type -> product.v1.GetPreorderCampaignsResponse
+	9,  // 21: product.v1.PreorderService.AllocatePreorder:output_type -> product.v1.AllocatePreorderResponse
+	11, // 22: product.v1.PreorderService.ReleasePreorder:output_type -> product.v1.ReleasePreorderResponse
+	19, // [19:23] is the sub-list for method output_type
+	15, // [15:19] is the sub-list for method input_type
+	15, // [15:15] is the sub-list for extension type_name
+	15, // [15:15] is the sub-list for extension extendee
+	0,  // [0:15] is the sub-list for field type_name
+}
+
+func init() { file_product_v1_preorder_service_proto_init() }
+func file_product_v1_preorder_service_proto_init() {
+	if File_product_v1_preorder_service_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_product_v1_preorder_service_proto_rawDesc), len(file_product_v1_preorder_service_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   11,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_product_v1_preorder_service_proto_goTypes,
+		DependencyIndexes: file_product_v1_preorder_service_proto_depIdxs,
+		EnumInfos:         file_product_v1_preorder_service_proto_enumTypes,
+		MessageInfos:      file_product_v1_preorder_service_proto_msgTypes,
+	}.Build()
+	File_product_v1_preorder_service_proto = out.File
+	file_product_v1_preorder_service_proto_goTypes = nil
+	file_product_v1_preorder_service_proto_depIdxs = nil
+}
@@ -12,8 +12,10 @@
 package productv1
 
 import (
+	v1 "github.com/daisuke8000/example-ec-platform/gen/operations/v1"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
 	unsafe "unsafe"
@@ -26,11 +28,70 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type ImportFormat int32
+
+const (
+	ImportFormat_IMPORT_FORMAT_UNSPECIFIED ImportFormat = 0
+	// Header row, then one row per SKU. Columns: product_ref, name,
+	// description, category_id, status, sku_code, price_amount,
+	// price_currency, quantity, attributes ("key=value;key=value"). Rows
+	// sharing a product_ref form one product.
+	ImportFormat_IMPORT_FORMAT_CSV ImportFormat = 1
+	// One product per line: {"name", "description", "category_id", "status",
+	// "skus": [{"sku_code", "price_amount", "price_currency", "quantity",
+	// "attributes"}]}.
+	ImportFormat_IMPORT_FORMAT_NDJSON ImportFormat = 2
+)
+
+// Enum value maps for ImportFormat.
+var (
+	ImportFormat_name = map[int32]string{
+		0: "IMPORT_FORMAT_UNSPECIFIED",
+		1: "IMPORT_FORMAT_CSV",
+		2: "IMPORT_FORMAT_NDJSON",
+	}
+	ImportFormat_value = map[string]int32{
+		"IMPORT_FORMAT_UNSPECIFIED": 0,
+		"IMPORT_FORMAT_CSV":         1,
+		"IMPORT_FORMAT_NDJSON":      2,
+	}
+)
+
+func (x ImportFormat) Enum() *ImportFormat {
+	p := new(ImportFormat)
+	*p = x
+	return p
+}
+
+func (x ImportFormat) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ImportFormat) Descriptor() protoreflect.EnumDescriptor {
+	return file_product_v1_product_service_proto_enumTypes[0].Descriptor()
+}
+
+func (ImportFormat) Type() protoreflect.EnumType {
+	return &file_product_v1_product_service_proto_enumTypes[0]
+}
+
+func (x ImportFormat) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ImportFormat.Descriptor instead.
+func (ImportFormat) EnumDescriptor() ([]byte, []int) {
+	return file_product_v1_product_service_proto_rawDescGZIP(), []int{0}
+}
+
 type CreateProductRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Description   string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
-	CategoryId    *string                `protobuf:"bytes,3,opt,name=category_id,json=categoryId,proto3,oneof" json:"category_id,omitempty"`
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Name        string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Description string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	CategoryId  *string                `protobuf:"bytes,3,opt,name=category_id,json=categoryId,proto3,oneof" json:"category_id,omitempty"`
+	// Run all validation without creating the product; the response holds
+	// the product that would have been created.
+	ValidateOnly  bool `protobuf:"varint,4,opt,name=validate_only,json=validateOnly,proto3" json:"validate_only,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -86,6 +147,13 @@ func (x *CreateProductRequest) GetCategoryId() string {
 	return ""
 }
 
+func (x *CreateProductRequest) GetValidateOnly() bool {
+	if x != nil {
+		return x.ValidateOnly
+	}
+	return false
+}
+
 type CreateProductResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Product       *Product               `protobuf:"bytes,1,opt,name=product,proto3" json:"product,omitempty"`
@@ -218,6 +286,155 @@ func (x *GetProductResponse) GetProduct() *Product {
 	return nil
 }
 
+type GetProductsByIDsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Ids           []string               `protobuf:"bytes,1,rep,name=ids,proto3" json:"ids,omitempty"` // Max 100
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetProductsByIDsRequest) Reset() {
+	*x = GetProductsByIDsRequest{}
+	mi := &file_product_v1_product_service_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetProductsByIDsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetProductsByIDsRequest) ProtoMessage() {}
+
+func (x *GetProductsByIDsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_v1_product_service_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetProductsByIDsRequest.ProtoReflect.Descriptor instead.
+func (*GetProductsByIDsRequest) Descriptor() ([]byte, []int) {
+	return file_product_v1_product_service_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GetProductsByIDsRequest) GetIds() []string {
+	if x != nil {
+		return x.Ids
+	}
+	return nil
+}
+
+type GetProductsByIDsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// One entry per requested ID, in request order
+	Results       []*ProductLookup `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetProductsByIDsResponse) Reset() {
+	*x = GetProductsByIDsResponse{}
+	mi := &file_product_v1_product_service_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetProductsByIDsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetProductsByIDsResponse) ProtoMessage() {}
+
+func (x *GetProductsByIDsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_v1_product_service_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetProductsByIDsResponse.ProtoReflect.Descriptor instead.
+func (*GetProductsByIDsResponse) Descriptor() ([]byte, []int) {
+	return file_product_v1_product_service_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *GetProductsByIDsResponse) GetResults() []*ProductLookup {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+type ProductLookup struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Found         bool                   `protobuf:"varint,2,opt,name=found,proto3" json:"found,omitempty"`
+	Product       *Product               `protobuf:"bytes,3,opt,name=product,proto3" json:"product,omitempty"` // Unset when not found
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProductLookup) Reset() {
+	*x = ProductLookup{}
+	mi := &file_product_v1_product_service_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProductLookup) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProductLookup) ProtoMessage() {}
+
+func (x *ProductLookup) ProtoReflect() protoreflect.Message {
+	mi := &file_product_v1_product_service_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProductLookup.ProtoReflect.Descriptor instead.
+func (*ProductLookup) Descriptor() ([]byte, []int) {
+	return file_product_v1_product_service_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ProductLookup) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ProductLookup) GetFound() bool {
+	if x != nil {
+		return x.Found
+	}
+	return false
+}
+
+func (x *ProductLookup) GetProduct() *Product {
+	if x != nil {
+		return x.Product
+	}
+	return nil
+}
+
 type UpdateProductRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -230,7 +447,7 @@ type UpdateProductRequest struct {
 
 func (x *UpdateProductRequest) Reset() {
 	*x = UpdateProductRequest{}
-	mi := &file_product_v1_product_service_proto_msgTypes[4]
+	mi := &file_product_v1_product_service_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -242,7 +459,7 @@ func (x *UpdateProductRequest) String() string {
 func (*UpdateProductRequest) ProtoMessage() {}
 
 func (x *UpdateProductRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_product_v1_product_service_proto_msgTypes[4]
+	mi := &file_product_v1_product_service_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -255,7 +472,7 @@ func (x *UpdateProductRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateProductRequest.ProtoReflect.Descriptor instead.
 func (*UpdateProductRequest) Descriptor() ([]byte, []int) {
-	return file_product_v1_product_service_proto_rawDescGZIP(), []int{4}
+	return file_product_v1_product_service_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *UpdateProductRequest) GetId() string {
@@ -295,7 +512,7 @@ type UpdateProductResponse struct {
 
 func (x *UpdateProductResponse) Reset() {
 	*x = UpdateProductResponse{}
-	mi := &file_product_v1_product_service_proto_msgTypes[5]
+	mi := &file_product_v1_product_service_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -307,7 +524,7 @@ func (x *UpdateProductResponse) String() string {
 func (*UpdateProductResponse) ProtoMessage() {}
 
 func (x *UpdateProductResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_product_v1_product_service_proto_msgTypes[5]
+	mi := &file_product_v1_product_service_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -320,7 +537,7 @@ func (x *UpdateProductResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateProductResponse.ProtoReflect.Descriptor instead.
 func (*UpdateProductResponse) Descriptor() ([]byte, []int) {
-	return file_product_v1_product_service_proto_rawDescGZIP(), []int{5}
+	return file_product_v1_product_service_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *UpdateProductResponse) GetProduct() *Product {
@@ -339,7 +556,7 @@ type DeleteProductRequest struct {
 
 func (x *DeleteProductRequest) Reset() {
 	*x = DeleteProductRequest{}
-	mi := &file_product_v1_product_service_proto_msgTypes[6]
+	mi := &file_product_v1_product_service_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -351,7 +568,7 @@ func (x *DeleteProductRequest) String() string {
 func (*DeleteProductRequest) ProtoMessage() {}
 
 func (x *DeleteProductRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_product_v1_product_service_proto_msgTypes[6]
+	mi := &file_product_v1_product_service_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -364,7 +581,7 @@ func (x *DeleteProductRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteProductRequest.ProtoReflect.Descriptor instead.
 func (*DeleteProductRequest) Descriptor() ([]byte, []int) {
-	return file_product_v1_product_service_proto_rawDescGZIP(), []int{6}
+	return file_product_v1_product_service_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *DeleteProductRequest) GetId() string {
@@ -382,7 +599,7 @@ type DeleteProductResponse struct {
 
 func (x *DeleteProductResponse) Reset() {
 	*x = DeleteProductResponse{}
-	mi := &file_product_v1_product_service_proto_msgTypes[7]
+	mi := &file_product_v1_product_service_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -394,7 +611,7 @@ func (x *DeleteProductResponse) String() string {
 func (*DeleteProductResponse) ProtoMessage() {}
 
 func (x *DeleteProductResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_product_v1_product_service_proto_msgTypes[7]
+	mi := &file_product_v1_product_service_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -407,7 +624,7 @@ func (x *DeleteProductResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteProductResponse.ProtoReflect.Descriptor instead.
 func (*DeleteProductResponse) Descriptor() ([]byte, []int) {
-	return file_product_v1_product_service_proto_rawDescGZIP(), []int{7}
+	return file_product_v1_product_service_proto_rawDescGZIP(), []int{10}
 }
 
 type ListProductsRequest struct {
@@ -416,18 +633,25 @@ type ListProductsRequest struct {
 	PageSize  int32  `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`   // Default: 20, Max: 100
 	PageToken string `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"` // Cursor for next page
 	// Filters
-	CategoryId    *string        `protobuf:"bytes,3,opt,name=category_id,json=categoryId,proto3,oneof" json:"category_id,omitempty"`
-	SearchQuery   *string        `protobuf:"bytes,4,opt,name=search_query,json=searchQuery,proto3,oneof" json:"search_query,omitempty"`   // Full-text search on name and description
-	MinPrice      *int64         `protobuf:"varint,5,opt,name=min_price,json=minPrice,proto3,oneof" json:"min_price,omitempty"`           // In smallest currency unit
-	MaxPrice      *int64         `protobuf:"varint,6,opt,name=max_price,json=maxPrice,proto3,oneof" json:"max_price,omitempty"`           // In smallest currency unit
-	Status        *ProductStatus `protobuf:"varint,7,opt,name=status,proto3,enum=product.v1.ProductStatus,oneof" json:"status,omitempty"` // Admin only; public queries always get PUBLISHED
+	CategoryId  *string        `protobuf:"bytes,3,opt,name=category_id,json=categoryId,proto3,oneof" json:"category_id,omitempty"`
+	SearchQuery *string        `protobuf:"bytes,4,opt,name=search_query,json=searchQuery,proto3,oneof" json:"search_query,omitempty"`   // Full-text search on name and description
+	MinPrice    *int64         `protobuf:"varint,5,opt,name=min_price,json=minPrice,proto3,oneof" json:"min_price,omitempty"`           // In smallest currency unit
+	MaxPrice    *int64         `protobuf:"varint,6,opt,name=max_price,json=maxPrice,proto3,oneof" json:"max_price,omitempty"`           // In smallest currency unit
+	Status      *ProductStatus `protobuf:"varint,7,opt,name=status,proto3,enum=product.v1.ProductStatus,oneof" json:"status,omitempty"` // Admin only; public queries always get PUBLISHED
+	// Comma-separated "field [asc|desc]" entries. Fields: created_at,
+	// updated_at, name. Default: "created_at desc".
+	OrderBy string `protobuf:"bytes,8,opt,name=order_by,json=orderBy,proto3" json:"order_by,omitempty"`
+	// Conditions joined by AND, e.g. `status = PUBLISHED AND created_at >= "2026-01-01T00:00:00Z"`.
+	// Fields: status (=), category_id (=), created_at (>=, <).
+	// Must not repeat a field also set above.
+	Filter        string `protobuf:"bytes,9,opt,name=filter,proto3" json:"filter,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ListProductsRequest) Reset() {
 	*x = ListProductsRequest{}
-	mi := &file_product_v1_product_service_proto_msgTypes[8]
+	mi := &file_product_v1_product_service_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -439,7 +663,7 @@ func (x *ListProductsRequest) String() string {
 func (*ListProductsRequest) ProtoMessage() {}
 
 func (x *ListProductsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_product_v1_product_service_proto_msgTypes[8]
+	mi := &file_product_v1_product_service_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -452,7 +676,7 @@ func (x *ListProductsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListProductsRequest.ProtoReflect.Descriptor instead.
 func (*ListProductsRequest) Descriptor() ([]byte, []int) {
-	return file_product_v1_product_service_proto_rawDescGZIP(), []int{8}
+	return file_product_v1_product_service_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *ListProductsRequest) GetPageSize() int32 {
@@ -504,6 +728,20 @@ func (x *ListProductsRequest) GetStatus() ProductStatus {
 	return ProductStatus_PRODUCT_STATUS_UNSPECIFIED
 }
 
+func (x *ListProductsRequest) GetOrderBy() string {
+	if x != nil {
+		return x.OrderBy
+	}
+	return ""
+}
+
+func (x *ListProductsRequest) GetFilter() string {
+	if x != nil {
+		return x.Filter
+	}
+	return ""
+}
+
 type ListProductsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Products      []*Product             `protobuf:"bytes,1,rep,name=products,proto3" json:"products,omitempty"`
@@ -517,7 +755,7 @@ type ListProductsResponse struct {
 
 func (x *ListProductsResponse) Reset() {
 	*x = ListProductsResponse{}
-	mi := &file_product_v1_product_service_proto_msgTypes[9]
+	mi := &file_product_v1_product_service_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -529,7 +767,7 @@ func (x *ListProductsResponse) String() string {
 func (*ListProductsResponse) ProtoMessage() {}
 
 func (x *ListProductsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_product_v1_product_service_proto_msgTypes[9]
+	mi := &file_product_v1_product_service_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -542,7 +780,7 @@ func (x *ListProductsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListProductsResponse.ProtoReflect.Descriptor instead.
 func (*ListProductsResponse) Descriptor() ([]byte, []int) {
-	return file_product_v1_product_service_proto_rawDescGZIP(), []int{9}
+	return file_product_v1_product_service_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *ListProductsResponse) GetProducts() []*Product {
@@ -575,7 +813,7 @@ type PublishProductRequest struct {
 
 func (x *PublishProductRequest) Reset() {
 	*x = PublishProductRequest{}
-	mi := &file_product_v1_product_service_proto_msgTypes[10]
+	mi := &file_product_v1_product_service_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -587,7 +825,7 @@ func (x *PublishProductRequest) String() string {
 func (*PublishProductRequest) ProtoMessage() {}
 
 func (x *PublishProductRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_product_v1_product_service_proto_msgTypes[10]
+	mi := &file_product_v1_product_service_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -600,7 +838,7 @@ func (x *PublishProductRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PublishProductRequest.ProtoReflect.Descriptor instead.
 func (*PublishProductRequest) Descriptor() ([]byte, []int) {
-	return file_product_v1_product_service_proto_rawDescGZIP(), []int{10}
+	return file_product_v1_product_service_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *PublishProductRequest) GetId() string {
@@ -619,7 +857,7 @@ type PublishProductResponse struct {
 
 func (x *PublishProductResponse) Reset() {
 	*x = PublishProductResponse{}
-	mi := &file_product_v1_product_service_proto_msgTypes[11]
+	mi := &file_product_v1_product_service_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -631,7 +869,7 @@ func (x *PublishProductResponse) String() string {
 func (*PublishProductResponse) ProtoMessage() {}
 
 func (x *PublishProductResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_product_v1_product_service_proto_msgTypes[11]
+	mi := &file_product_v1_product_service_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -644,7 +882,7 @@ func (x *PublishProductResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PublishProductResponse.ProtoReflect.Descriptor instead.
 func (*PublishProductResponse) Descriptor() ([]byte, []int) {
-	return file_product_v1_product_service_proto_rawDescGZIP(), []int{11}
+	return file_product_v1_product_service_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *PublishProductResponse) GetProduct() *Product {
@@ -663,7 +901,7 @@ type HideProductRequest struct {
 
 func (x *HideProductRequest) Reset() {
 	*x = HideProductRequest{}
-	mi := &file_product_v1_product_service_proto_msgTypes[12]
+	mi := &file_product_v1_product_service_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -675,7 +913,7 @@ func (x *HideProductRequest) String() string {
 func (*HideProductRequest) ProtoMessage() {}
 
 func (x *HideProductRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_product_v1_product_service_proto_msgTypes[12]
+	mi := &file_product_v1_product_service_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -688,7 +926,7 @@ func (x *HideProductRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use HideProductRequest.ProtoReflect.Descriptor instead.
 func (*HideProductRequest) Descriptor() ([]byte, []int) {
-	return file_product_v1_product_service_proto_rawDescGZIP(), []int{12}
+	return file_product_v1_product_service_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *HideProductRequest) GetId() string {
@@ -707,7 +945,7 @@ type HideProductResponse struct {
 
 func (x *HideProductResponse) Reset() {
 	*x = HideProductResponse{}
-	mi := &file_product_v1_product_service_proto_msgTypes[13]
+	mi := &file_product_v1_product_service_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -719,7 +957,7 @@ func (x *HideProductResponse) String() string {
 func (*HideProductResponse) ProtoMessage() {}
 
 func (x *HideProductResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_product_v1_product_service_proto_msgTypes[13]
+	mi := &file_product_v1_product_service_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -732,7 +970,7 @@ func (x *HideProductResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use HideProductResponse.ProtoReflect.Descriptor instead.
 func (*HideProductResponse) Descriptor() ([]byte, []int) {
-	return file_product_v1_product_service_proto_rawDescGZIP(), []int{13}
+	return file_product_v1_product_service_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *HideProductResponse) GetProduct() *Product {
@@ -751,7 +989,7 @@ type UnpublishProductRequest struct {
 
 func (x *UnpublishProductRequest) Reset() {
 	*x = UnpublishProductRequest{}
-	mi := &file_product_v1_product_service_proto_msgTypes[14]
+	mi := &file_product_v1_product_service_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -763,7 +1001,7 @@ func (x *UnpublishProductRequest) String() string {
 func (*UnpublishProductRequest) ProtoMessage() {}
 
 func (x *UnpublishProductRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_product_v1_product_service_proto_msgTypes[14]
+	mi := &file_product_v1_product_service_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -776,7 +1014,7 @@ func (x *UnpublishProductRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UnpublishProductRequest.ProtoReflect.Descriptor instead.
 func (*UnpublishProductRequest) Descriptor() ([]byte, []int) {
-	return file_product_v1_product_service_proto_rawDescGZIP(), []int{14}
+	return file_product_v1_product_service_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *UnpublishProductRequest) GetId() string {
@@ -795,7 +1033,7 @@ type UnpublishProductResponse struct {
 
 func (x *UnpublishProductResponse) Reset() {
 	*x = UnpublishProductResponse{}
-	mi := &file_product_v1_product_service_proto_msgTypes[15]
+	mi := &file_product_v1_product_service_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -807,7 +1045,7 @@ func (x *UnpublishProductResponse) String() string {
 func (*UnpublishProductResponse) ProtoMessage() {}
 
 func (x *UnpublishProductResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_product_v1_product_service_proto_msgTypes[15]
+	mi := &file_product_v1_product_service_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -820,7 +1058,7 @@ func (x *UnpublishProductResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UnpublishProductResponse.ProtoReflect.Descriptor instead.
 func (*UnpublishProductResponse) Descriptor() ([]byte, []int) {
-	return file_product_v1_product_service_proto_rawDescGZIP(), []int{15}
+	return file_product_v1_product_service_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *UnpublishProductResponse) GetProduct() *Product {
@@ -830,32 +1068,30 @@ func (x *UnpublishProductResponse) GetProduct() *Product {
 	return nil
 }
 
-type CreateSKURequest struct {
-	state           protoimpl.MessageState `protogen:"open.v1"`
-	ProductId       string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
-	SkuCode         string                 `protobuf:"bytes,2,opt,name=sku_code,json=skuCode,proto3" json:"sku_code,omitempty"`
-	Price           *Money                 `protobuf:"bytes,3,opt,name=price,proto3" json:"price,omitempty"`
-	Attributes      map[string]string      `protobuf:"bytes,4,rep,name=attributes,proto3" json:"attributes,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
-	InitialQuantity int64                  `protobuf:"varint,5,opt,name=initial_quantity,json=initialQuantity,proto3" json:"initial_quantity,omitempty"` // Initial inventory quantity
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+type UpdateProductVisibilityRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Channels      []string               `protobuf:"bytes,2,rep,name=channels,proto3" json:"channels,omitempty"` // web, app, marketplace
+	Markets       []string               `protobuf:"bytes,3,rep,name=markets,proto3" json:"markets,omitempty"`   // ISO 3166-1 alpha-2, e.g. "JP"
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateSKURequest) Reset() {
-	*x = CreateSKURequest{}
-	mi := &file_product_v1_product_service_proto_msgTypes[16]
+func (x *UpdateProductVisibilityRequest) Reset() {
+	*x = UpdateProductVisibilityRequest{}
+	mi := &file_product_v1_product_service_proto_msgTypes[19]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateSKURequest) String() string {
+func (x *UpdateProductVisibilityRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateSKURequest) ProtoMessage() {}
+func (*UpdateProductVisibilityRequest) ProtoMessage() {}
 
-func (x *CreateSKURequest) ProtoReflect() protoreflect.Message {
-	mi := &file_product_v1_product_service_proto_msgTypes[16]
+func (x *UpdateProductVisibilityRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_v1_product_service_proto_msgTypes[19]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -866,68 +1102,2054 @@ func (x *CreateSKURequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateSKURequest.ProtoReflect.Descriptor instead.
-func (*CreateSKURequest) Descriptor() ([]byte, []int) {
-	return file_product_v1_product_service_proto_rawDescGZIP(), []int{16}
+// Deprecated: Use UpdateProductVisibilityRequest.ProtoReflect.Descriptor instead.
+func (*UpdateProductVisibilityRequest) Descriptor() ([]byte, []int) {
+	return file_product_v1_product_service_proto_rawDescGZIP(), []int{19}
 }
 
-func (x *CreateSKURequest) GetProductId() string {
+func (x *UpdateProductVisibilityRequest) GetId() string {
 	if x != nil {
-		return x.ProductId
+		return x.Id
 	}
 	return ""
 }
 
-func (x *CreateSKURequest) GetSkuCode() string {
+func (x *UpdateProductVisibilityRequest) GetChannels() []string {
 	if x != nil {
-		return x.SkuCode
+		return x.Channels
 	}
-	return ""
+	return nil
+}
+
+func (x *UpdateProductVisibilityRequest) GetMarkets() []string {
+	if x != nil {
+		return x.Markets
+	}
+	return nil
+}
+
+type UpdateProductVisibilityResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Product       *Product               `protobuf:"bytes,1,opt,name=product,proto3" json:"product,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateProductVisibilityResponse) Reset() {
+	*x = UpdateProductVisibilityResponse{}
+	mi := &file_product_v1_product_service_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateProductVisibilityResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateProductVisibilityResponse) ProtoMessage() {}
+
+func (x *UpdateProductVisibilityResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_v1_product_service_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateProductVisibilityResponse.ProtoReflect.Descriptor instead.
+func (*UpdateProductVisibilityResponse) Descriptor() ([]byte, []int) {
+	return file_product_v1_product_service_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *UpdateProductVisibilityResponse) GetProduct() *Product {
+	if x != nil {
+		return x.Product
+	}
+	return nil
+}
+
+type ImportProductsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Format        ImportFormat           `protobuf:"varint,1,opt,name=format,proto3,enum=product.v1.ImportFormat" json:"format,omitempty"`
+	Data          []byte                 `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	ValidateOnly  bool                   `protobuf:"varint,3,opt,name=validate_only,json=validateOnly,proto3" json:"validate_only,omitempty"` // Report per-row results without writing
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportProductsRequest) Reset() {
+	*x = ImportProductsRequest{}
+	mi := &file_product_v1_product_service_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportProductsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportProductsRequest) ProtoMessage() {}
+
+func (x *ImportProductsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_v1_product_service_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportProductsRequest.ProtoReflect.Descriptor instead.
+func (*ImportProductsRequest) Descriptor() ([]byte, []int) {
+	return file_product_v1_product_service_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *ImportProductsRequest) GetFormat() ImportFormat {
+	if x != nil {
+		return x.Format
+	}
+	return ImportFormat_IMPORT_FORMAT_UNSPECIFIED
+}
+
+func (x *ImportProductsRequest) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *ImportProductsRequest) GetValidateOnly() bool {
+	if x != nil {
+		return x.ValidateOnly
+	}
+	return false
+}
+
+type ImportProductsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Operation     *v1.Operation          `protobuf:"bytes,1,opt,name=operation,proto3" json:"operation,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportProductsResponse) Reset() {
+	*x = ImportProductsResponse{}
+	mi := &file_product_v1_product_service_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportProductsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportProductsResponse) ProtoMessage() {}
+
+func (x *ImportProductsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_v1_product_service_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportProductsResponse.ProtoReflect.Descriptor instead.
+func (*ImportProductsResponse) Descriptor() ([]byte, []int) {
+	return file_product_v1_product_service_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *ImportProductsResponse) GetOperation() *v1.Operation {
+	if x != nil {
+		return x.Operation
+	}
+	return nil
+}
+
+type GetProductImportRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OperationId   string                 `protobuf:"bytes,1,opt,name=operation_id,json=operationId,proto3" json:"operation_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetProductImportRequest) Reset() {
+	*x = GetProductImportRequest{}
+	mi := &file_product_v1_product_service_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetProductImportRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetProductImportRequest) ProtoMessage() {}
+
+func (x *GetProductImportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_v1_product_service_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetProductImportRequest.ProtoReflect.Descriptor instead.
+func (*GetProductImportRequest) Descriptor() ([]byte, []int) {
+	return file_product_v1_product_service_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *GetProductImportRequest) GetOperationId() string {
+	if x != nil {
+		return x.OperationId
+	}
+	return ""
+}
+
+type GetProductImportResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Operation     *v1.Operation          `protobuf:"bytes,1,opt,name=operation,proto3" json:"operation,omitempty"`
+	Report        *ProductImportReport   `protobuf:"bytes,2,opt,name=report,proto3" json:"report,omitempty"` // Set when the import has succeeded
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetProductImportResponse) Reset() {
+	*x = GetProductImportResponse{}
+	mi := &file_product_v1_product_service_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetProductImportResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetProductImportResponse) ProtoMessage() {}
+
+func (x *GetProductImportResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_v1_product_service_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetProductImportResponse.ProtoReflect.Descriptor instead.
+func (*GetProductImportResponse) Descriptor() ([]byte, []int) {
+	return file_product_v1_product_service_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *GetProductImportResponse) GetOperation() *v1.Operation {
+	if x != nil {
+		return x.Operation
+	}
+	return nil
+}
+
+func (x *GetProductImportResponse) GetReport() *ProductImportReport {
+	if x != nil {
+		return x.Report
+	}
+	return nil
+}
+
+// ProductImportReport summarizes a finished import.
+type ProductImportReport struct {
+	state            protoimpl.MessageState   `protogen:"open.v1"`
+	ValidateOnly     bool                     `protobuf:"varint,1,opt,name=validate_only,json=validateOnly,proto3" json:"validate_only,omitempty"`
+	TotalProducts    int32                    `protobuf:"varint,2,opt,name=total_products,json=totalProducts,proto3" json:"total_products,omitempty"`
+	ImportedProducts int32                    `protobuf:"varint,3,opt,name=imported_products,json=importedProducts,proto3" json:"imported_products,omitempty"` // Would have been imported, for validate_only
+	FailedProducts   int32                    `protobuf:"varint,4,opt,name=failed_products,json=failedProducts,proto3" json:"failed_products,omitempty"`
+	Errors           []*ProductImportRowError `protobuf:"bytes,5,rep,name=errors,proto3" json:"errors,omitempty"` // At most 1000
+	ErrorsTruncated  bool                     `protobuf:"varint,6,opt,name=errors_truncated,json=errorsTruncated,proto3" json:"errors_truncated,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *ProductImportReport) Reset() {
+	*x = ProductImportReport{}
+	mi := &file_product_v1_product_service_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProductImportReport) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProductImportReport) ProtoMessage() {}
+
+func (x *ProductImportReport) ProtoReflect() protoreflect.Message {
+	mi := &file_product_v1_product_service_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProductImportReport.ProtoReflect.Descriptor instead.
+func (*ProductImportReport) Descriptor() ([]byte, []int) {
+	return file_product_v1_product_service_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *ProductImportReport) GetValidateOnly() bool {
+	if x != nil {
+		return x.ValidateOnly
+	}
+	return false
+}
+
+func (x *ProductImportReport) GetTotalProducts() int32 {
+	if x != nil {
+		return x.TotalProducts
+	}
+	return 0
+}
+
+func (x *ProductImportReport) GetImportedProducts() int32 {
+	if x != nil {
+		return x.ImportedProducts
+	}
+	return 0
+}
+
+func (x *ProductImportReport) GetFailedProducts() int32 {
+	if x != nil {
+		return x.FailedProducts
+	}
+	return 0
+}
+
+func (x *ProductImportReport) GetErrors() []*ProductImportRowError {
+	if x != nil {
+		return x.Errors
+	}
+	return nil
+}
+
+func (x *ProductImportReport) GetErrorsTruncated() bool {
+	if x != nil {
+		return x.ErrorsTruncated
+	}
+	return false
+}
+
+// ProductImportRowError reports why a product was not imported.
+type ProductImportRowError struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Line          int32                  `protobuf:"varint,1,opt,name=line,proto3" json:"line,omitempty"`                     // 1-based line the product starts on
+	SkuCode       string                 `protobuf:"bytes,2,opt,name=sku_code,json=skuCode,proto3" json:"sku_code,omitempty"` // Set when the error concerns one SKU
+	Message       string                 `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProductImportRowError) Reset() {
+	*x = ProductImportRowError{}
+	mi := &file_product_v1_product_service_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProductImportRowError) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProductImportRowError) ProtoMessage() {}
+
+func (x *ProductImportRowError) ProtoReflect() protoreflect.Message {
+	mi := &file_product_v1_product_service_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProductImportRowError.ProtoReflect.Descriptor instead.
+func (*ProductImportRowError) Descriptor() ([]byte, []int) {
+	return file_product_v1_product_service_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *ProductImportRowError) GetLine() int32 {
+	if x != nil {
+		return x.Line
+	}
+	return 0
+}
+
+func (x *ProductImportRowError) GetSkuCode() string {
+	if x != nil {
+		return x.SkuCode
+	}
+	return ""
+}
+
+func (x *ProductImportRowError) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type CreateSKURequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	ProductId       string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	SkuCode         string                 `protobuf:"bytes,2,opt,name=sku_code,json=skuCode,proto3" json:"sku_code,omitempty"`
+	Price           *Money                 `protobuf:"bytes,3,opt,name=price,proto3" json:"price,omitempty"`
+	Attributes      map[string]string      `protobuf:"bytes,4,rep,name=attributes,proto3" json:"attributes,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	InitialQuantity int64                  `protobuf:"varint,5,opt,name=initial_quantity,json=initialQuantity,proto3" json:"initial_quantity,omitempty"` // Initial inventory quantity
+	// Run all validation, including the sku_code uniqueness check, without
+	// creating the SKU; the response holds the SKU that would have been created.
+	ValidateOnly bool `protobuf:"varint,6,opt,name=validate_only,json=validateOnly,proto3" json:"validate_only,omitempty"`
+	// Prices in currencies other than price's, at most one per currency
+	AdditionalPrices []*Money `protobuf:"bytes,7,rep,name=additional_prices,json=additionalPrices,proto3" json:"additional_prices,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *CreateSKURequest) Reset() {
+	*x = CreateSKURequest{}
+	mi := &file_product_v1_product_service_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateSKURequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateSKURequest) ProtoMessage() {}
+
+func (x *CreateSKURequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_v1_product_service_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateSKURequest.ProtoReflect.Descriptor instead.
+func (*CreateSKURequest) Descriptor() ([]byte, []int) {
+	return file_product_v1_product_service_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *CreateSKURequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *CreateSKURequest) GetSkuCode() string {
+	if x != nil {
+		return x.SkuCode
+	}
+	return ""
 }
 
 func (x *CreateSKURequest) GetPrice() *Money {
 	if x != nil {
-		return x.Price
+		return x.Price
+	}
+	return nil
+}
+
+func (x *CreateSKURequest) GetAttributes() map[string]string {
+	if x != nil {
+		return x.Attributes
+	}
+	return nil
+}
+
+func (x *CreateSKURequest) GetInitialQuantity() int64 {
+	if x != nil {
+		return x.InitialQuantity
+	}
+	return 0
+}
+
+func (x *CreateSKURequest) GetValidateOnly() bool {
+	if x != nil {
+		return x.ValidateOnly
+	}
+	return false
+}
+
+func (x *CreateSKURequest) GetAdditionalPrices() []*Money {
+	if x != nil {
+		return x.AdditionalPrices
+	}
+	return nil
+}
+
+type CreateSKUResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Sku           *SKU                   `protobuf:"bytes,1,opt,name=sku,proto3" json:"sku,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateSKUResponse) Reset() {
+	*x = CreateSKUResponse{}
+	mi := &file_product_v1_product_service_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateSKUResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateSKUResponse) ProtoMessage() {}
+
+func (x *CreateSKUResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_v1_product_service_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateSKUResponse.ProtoReflect.Descriptor instead.
+func (*CreateSKUResponse) Descriptor() ([]byte, []int) {
+	return file_product_v1_product_service_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *CreateSKUResponse) GetSku() *SKU {
+	if x != nil {
+		return x.Sku
+	}
+	return nil
+}
+
+type GenerateSKUsRequest struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	ProductId  string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Dimensions []*SKUDimension        `protobuf:"bytes,2,rep,name=dimensions,proto3" json:"dimensions,omitempty"` // 1-3 dimensions
+	BasePrice  *Money                 `protobuf:"bytes,3,opt,name=base_price,json=basePrice,proto3" json:"base_price,omitempty"`
+	PriceRules []*SKUPriceRule        `protobuf:"bytes,4,rep,name=price_rules,json=priceRules,proto3" json:"price_rules,omitempty"`
+	// SKU codes are this prefix as given followed by each value in dimension
+	// order, uppercased, and joined by hyphens (e.g. "SHIRT-NAVY-BLUE-M").
+	SkuCodePrefix   string `protobuf:"bytes,5,opt,name=sku_code_prefix,json=skuCodePrefix,proto3" json:"sku_code_prefix,omitempty"`
+	InitialQuantity int64  `protobuf:"varint,6,opt,name=initial_quantity,json=initialQuantity,proto3" json:"initial_quantity,omitempty"` // Initial inventory quantity of every SKU
+	// Run all validation, including the SKU code uniqueness check, without
+	// creating the SKUs; the response holds the SKUs that would have been
+	// created.
+	ValidateOnly  bool `protobuf:"varint,7,opt,name=validate_only,json=validateOnly,proto3" json:"validate_only,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GenerateSKUsRequest) Reset() {
+	*x = GenerateSKUsRequest{}
+	mi := &file_product_v1_product_service_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GenerateSKUsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GenerateSKUsRequest) ProtoMessage() {}
+
+func (x *GenerateSKUsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_v1_product_service_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GenerateSKUsRequest.ProtoReflect.Descriptor instead.
+func (*GenerateSKUsRequest) Descriptor() ([]byte, []int) {
+	return file_product_v1_product_service_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *GenerateSKUsRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *GenerateSKUsRequest) GetDimensions() []*SKUDimension {
+	if x != nil {
+		return x.Dimensions
+	}
+	return nil
+}
+
+func (x *GenerateSKUsRequest) GetBasePrice() *Money {
+	if x != nil {
+		return x.BasePrice
+	}
+	return nil
+}
+
+func (x *GenerateSKUsRequest) GetPriceRules() []*SKUPriceRule {
+	if x != nil {
+		return x.PriceRules
+	}
+	return nil
+}
+
+func (x *GenerateSKUsRequest) GetSkuCodePrefix() string {
+	if x != nil {
+		return x.SkuCodePrefix
+	}
+	return ""
+}
+
+func (x *GenerateSKUsRequest) GetInitialQuantity() int64 {
+	if x != nil {
+		return x.InitialQuantity
+	}
+	return 0
+}
+
+func (x *GenerateSKUsRequest) GetValidateOnly() bool {
+	if x != nil {
+		return x.ValidateOnly
+	}
+	return false
+}
+
+// SKUDimension is an attribute and the values to combine.
+type SKUDimension struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Values        []string               `protobuf:"bytes,2,rep,name=values,proto3" json:"values,omitempty"` // 1-50 distinct values
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SKUDimension) Reset() {
+	*x = SKUDimension{}
+	mi := &file_product_v1_product_service_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SKUDimension) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SKUDimension) ProtoMessage() {}
+
+func (x *SKUDimension) ProtoReflect() protoreflect.Message {
+	mi := &file_product_v1_product_service_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SKUDimension.ProtoReflect.Descriptor instead.
+func (*SKUDimension) Descriptor() ([]byte, []int) {
+	return file_product_v1_product_service_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *SKUDimension) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SKUDimension) GetValues() []string {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+// SKUPriceRule adds amount_delta, which may be negative, to the base price
+// of the SKUs whose attribute has value. Rules matching a SKU add up.
+type SKUPriceRule struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Attribute     string                 `protobuf:"bytes,1,opt,name=attribute,proto3" json:"attribute,omitempty"`
+	Value         string                 `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	AmountDelta   int64                  `protobuf:"varint,3,opt,name=amount_delta,json=amountDelta,proto3" json:"amount_delta,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SKUPriceRule) Reset() {
+	*x = SKUPriceRule{}
+	mi := &file_product_v1_product_service_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SKUPriceRule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SKUPriceRule) ProtoMessage() {}
+
+func (x *SKUPriceRule) ProtoReflect() protoreflect.Message {
+	mi := &file_product_v1_product_service_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SKUPriceRule.ProtoReflect.Descriptor instead.
+func (*SKUPriceRule) Descriptor() ([]byte, []int) {
+	return file_product_v1_product_service_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *SKUPriceRule) GetAttribute() string {
+	if x != nil {
+		return x.Attribute
+	}
+	return ""
+}
+
+func (x *SKUPriceRule) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+func (x *SKUPriceRule) GetAmountDelta() int64 {
+	if x != nil {
+		return x.AmountDelta
+	}
+	return 0
+}
+
+type GenerateSKUsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Skus          []*SKU                 `protobuf:"bytes,1,rep,name=skus,proto3" json:"skus,omitempty"`
+	SkippedCount  int32                  `protobuf:"varint,2,opt,name=skipped_count,json=skippedCount,proto3" json:"skipped_count,omitempty"` // Combinations an existing SKU already has
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GenerateSKUsResponse) Reset() {
+	*x = GenerateSKUsResponse{}
+	mi := &file_product_v1_product_service_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GenerateSKUsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GenerateSKUsResponse) ProtoMessage() {}
+
+func (x *GenerateSKUsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_v1_product_service_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GenerateSKUsResponse.ProtoReflect.Descriptor instead.
+func (*GenerateSKUsResponse) Descriptor() ([]byte, []int) {
+	return file_product_v1_product_service_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *GenerateSKUsResponse) GetSkus() []*SKU {
+	if x != nil {
+		return x.Skus
+	}
+	return nil
+}
+
+func (x *GenerateSKUsResponse) GetSkippedCount() int32 {
+	if x != nil {
+		return x.SkippedCount
+	}
+	return 0
+}
+
+type GetSKURequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// ISO 4217 code. When the SKU has a price in this currency it is returned
+	// as price and the base price moves to additional_prices.
+	PreferredCurrency string `protobuf:"bytes,2,opt,name=preferred_currency,json=preferredCurrency,proto3" json:"preferred_currency,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *GetSKURequest) Reset() {
+	*x = GetSKURequest{}
+	mi := &file_product_v1_product_service_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSKURequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSKURequest) ProtoMessage() {}
+
+func (x *GetSKURequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_v1_product_service_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSKURequest.ProtoReflect.Descriptor instead.
+func (*GetSKURequest) Descriptor() ([]byte, []int) {
+	return file_product_v1_product_service_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *GetSKURequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *GetSKURequest) GetPreferredCurrency() string {
+	if x != nil {
+		return x.PreferredCurrency
+	}
+	return ""
+}
+
+type GetSKUResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Sku           *SKU                   `protobuf:"bytes,1,opt,name=sku,proto3" json:"sku,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSKUResponse) Reset() {
+	*x = GetSKUResponse{}
+	mi := &file_product_v1_product_service_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSKUResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSKUResponse) ProtoMessage() {}
+
+func (x *GetSKUResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_v1_product_service_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSKUResponse.ProtoReflect.Descriptor instead.
+func (*GetSKUResponse) Descriptor() ([]byte, []int) {
+	return file_product_v1_product_service_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *GetSKUResponse) GetSku() *SKU {
+	if x != nil {
+		return x.Sku
+	}
+	return nil
+}
+
+type GetSKUsByIDsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Ids           []string               `protobuf:"bytes,1,rep,name=ids,proto3" json:"ids,omitempty"` // Max 100
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSKUsByIDsRequest) Reset() {
+	*x = GetSKUsByIDsRequest{}
+	mi := &file_product_v1_product_service_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSKUsByIDsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSKUsByIDsRequest) ProtoMessage() {}
+
+func (x *GetSKUsByIDsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_v1_product_service_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSKUsByIDsRequest.ProtoReflect.Descriptor instead.
+func (*GetSKUsByIDsRequest) Descriptor() ([]byte, []int) {
+	return file_product_v1_product_service_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *GetSKUsByIDsRequest) GetIds() []string {
+	if x != nil {
+		return x.Ids
+	}
+	return nil
+}
+
+type GetSKUsByIDsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// One entry per requested ID, in request order
+	Results       []*SKULookup `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSKUsByIDsResponse) Reset() {
+	*x = GetSKUsByIDsResponse{}
+	mi := &file_product_v1_product_service_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSKUsByIDsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSKUsByIDsResponse) ProtoMessage() {}
+
+func (x *GetSKUsByIDsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_v1_product_service_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSKUsByIDsResponse.ProtoReflect.Descriptor instead.
+func (*GetSKUsByIDsResponse) Descriptor() ([]byte, []int) {
+	return file_product_v1_product_service_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *GetSKUsByIDsResponse) GetResults() []*SKULookup {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+type SKULookup struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Found         bool                   `protobuf:"varint,2,opt,name=found,proto3" json:"found,omitempty"`
+	Sku           *SKU                   `protobuf:"bytes,3,opt,name=sku,proto3" json:"sku,omitempty"` // Unset when not found; includes inventory
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SKULookup) Reset() {
+	*x = SKULookup{}
+	mi := &file_product_v1_product_service_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SKULookup) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SKULookup) ProtoMessage() {}
+
+func (x *SKULookup) ProtoReflect() protoreflect.Message {
+	mi := &file_product_v1_product_service_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SKULookup.ProtoReflect.Descriptor instead.
+func (*SKULookup) Descriptor() ([]byte, []int) {
+	return file_product_v1_product_service_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *SKULookup) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *SKULookup) GetFound() bool {
+	if x != nil {
+		return x.Found
+	}
+	return false
+}
+
+func (x *SKULookup) GetSku() *SKU {
+	if x != nil {
+		return x.Sku
+	}
+	return nil
+}
+
+type UpdateSKURequest struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	Id         string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	SkuCode    *string                `protobuf:"bytes,2,opt,name=sku_code,json=skuCode,proto3,oneof" json:"sku_code,omitempty"`
+	Price      *Money                 `protobuf:"bytes,3,opt,name=price,proto3,oneof" json:"price,omitempty"`
+	Attributes map[string]string      `protobuf:"bytes,4,rep,name=attributes,proto3" json:"attributes,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// Run all validation without saving; the response holds the SKU as it
+	// would be after the update.
+	ValidateOnly bool `protobuf:"varint,5,opt,name=validate_only,json=validateOnly,proto3" json:"validate_only,omitempty"`
+	// Replaces the additional currency prices when set; an empty list removes
+	// them all
+	AdditionalPrices *MoneyList `protobuf:"bytes,6,opt,name=additional_prices,json=additionalPrices,proto3" json:"additional_prices,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *UpdateSKURequest) Reset() {
+	*x = UpdateSKURequest{}
+	mi := &file_product_v1_product_service_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateSKURequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateSKURequest) ProtoMessage() {}
+
+func (x *UpdateSKURequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_v1_product_service_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateSKURequest.ProtoReflect.Descriptor instead.
+func (*UpdateSKURequest) Descriptor() ([]byte, []int) {
+	return file_product_v1_product_service_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *UpdateSKURequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateSKURequest) GetSkuCode() string {
+	if x != nil && x.SkuCode != nil {
+		return *x.SkuCode
+	}
+	return ""
+}
+
+func (x *UpdateSKURequest) GetPrice() *Money {
+	if x != nil {
+		return x.Price
+	}
+	return nil
+}
+
+func (x *UpdateSKURequest) GetAttributes() map[string]string {
+	if x != nil {
+		return x.Attributes
+	}
+	return nil
+}
+
+func (x *UpdateSKURequest) GetValidateOnly() bool {
+	if x != nil {
+		return x.ValidateOnly
+	}
+	return false
+}
+
+func (x *UpdateSKURequest) GetAdditionalPrices() *MoneyList {
+	if x != nil {
+		return x.AdditionalPrices
+	}
+	return nil
+}
+
+type UpdateSKUResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Sku           *SKU                   `protobuf:"bytes,1,opt,name=sku,proto3" json:"sku,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateSKUResponse) Reset() {
+	*x = UpdateSKUResponse{}
+	mi := &file_product_v1_product_service_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateSKUResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateSKUResponse) ProtoMessage() {}
+
+func (x *UpdateSKUResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_v1_product_service_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateSKUResponse.ProtoReflect.Descriptor instead.
+func (*UpdateSKUResponse) Descriptor() ([]byte, []int) {
+	return file_product_v1_product_service_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *UpdateSKUResponse) GetSku() *SKU {
+	if x != nil {
+		return x.Sku
+	}
+	return nil
+}
+
+type DeleteSKURequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteSKURequest) Reset() {
+	*x = DeleteSKURequest{}
+	mi := &file_product_v1_product_service_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteSKURequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteSKURequest) ProtoMessage() {}
+
+func (x *DeleteSKURequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_v1_product_service_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteSKURequest.ProtoReflect.Descriptor instead.
+func (*DeleteSKURequest) Descriptor() ([]byte, []int) {
+	return file_product_v1_product_service_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *DeleteSKURequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type DeleteSKUResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteSKUResponse) Reset() {
+	*x = DeleteSKUResponse{}
+	mi := &file_product_v1_product_service_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteSKUResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteSKUResponse) ProtoMessage() {}
+
+func (x *DeleteSKUResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_v1_product_service_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteSKUResponse.ProtoReflect.Descriptor instead.
+func (*DeleteSKUResponse) Descriptor() ([]byte, []int) {
+	return file_product_v1_product_service_proto_rawDescGZIP(), []int{41}
+}
+
+type SchedulePriceChangeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SkuId         string                 `protobuf:"bytes,1,opt,name=sku_id,json=skuId,proto3" json:"sku_id,omitempty"`
+	Price         *Money                 `protobuf:"bytes,2,opt,name=price,proto3" json:"price,omitempty"`
+	EffectiveFrom *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=effective_from,json=effectiveFrom,proto3" json:"effective_from,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SchedulePriceChangeRequest) Reset() {
+	*x = SchedulePriceChangeRequest{}
+	mi := &file_product_v1_product_service_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SchedulePriceChangeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SchedulePriceChangeRequest) ProtoMessage() {}
+
+func (x *SchedulePriceChangeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_v1_product_service_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SchedulePriceChangeRequest.ProtoReflect.Descriptor instead.
+func (*SchedulePriceChangeRequest) Descriptor() ([]byte, []int) {
+	return file_product_v1_product_service_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *SchedulePriceChangeRequest) GetSkuId() string {
+	if x != nil {
+		return x.SkuId
+	}
+	return ""
+}
+
+func (x *SchedulePriceChangeRequest) GetPrice() *Money {
+	if x != nil {
+		return x.Price
+	}
+	return nil
+}
+
+func (x *SchedulePriceChangeRequest) GetEffectiveFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EffectiveFrom
+	}
+	return nil
+}
+
+type SchedulePriceChangeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PriceChange   *PriceChange           `protobuf:"bytes,1,opt,name=price_change,json=priceChange,proto3" json:"price_change,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SchedulePriceChangeResponse) Reset() {
+	*x = SchedulePriceChangeResponse{}
+	mi := &file_product_v1_product_service_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SchedulePriceChangeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SchedulePriceChangeResponse) ProtoMessage() {}
+
+func (x *SchedulePriceChangeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_v1_product_service_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SchedulePriceChangeResponse.ProtoReflect.Descriptor instead.
+func (*SchedulePriceChangeResponse) Descriptor() ([]byte, []int) {
+	return file_product_v1_product_service_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *SchedulePriceChangeResponse) GetPriceChange() *PriceChange {
+	if x != nil {
+		return x.PriceChange
+	}
+	return nil
+}
+
+type GetPriceHistoryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SkuId         string                 `protobuf:"bytes,1,opt,name=sku_id,json=skuId,proto3" json:"sku_id,omitempty"`
+	PageSize      int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"` // Default 20, max 100
+	PageToken     string                 `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPriceHistoryRequest) Reset() {
+	*x = GetPriceHistoryRequest{}
+	mi := &file_product_v1_product_service_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPriceHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPriceHistoryRequest) ProtoMessage() {}
+
+func (x *GetPriceHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_v1_product_service_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPriceHistoryRequest.ProtoReflect.Descriptor instead.
+func (*GetPriceHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_product_v1_product_service_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *GetPriceHistoryRequest) GetSkuId() string {
+	if x != nil {
+		return x.SkuId
+	}
+	return ""
+}
+
+func (x *GetPriceHistoryRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *GetPriceHistoryRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type GetPriceHistoryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PriceChanges  []*PriceChange         `protobuf:"bytes,1,rep,name=price_changes,json=priceChanges,proto3" json:"price_changes,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPriceHistoryResponse) Reset() {
+	*x = GetPriceHistoryResponse{}
+	mi := &file_product_v1_product_service_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPriceHistoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPriceHistoryResponse) ProtoMessage() {}
+
+func (x *GetPriceHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_v1_product_service_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPriceHistoryResponse.ProtoReflect.Descriptor instead.
+func (*GetPriceHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_product_v1_product_service_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *GetPriceHistoryResponse) GetPriceChanges() []*PriceChange {
+	if x != nil {
+		return x.PriceChanges
+	}
+	return nil
+}
+
+func (x *GetPriceHistoryResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type CreateProductImageUploadRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	ContentType   string                 `protobuf:"bytes,2,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	AltText       string                 `protobuf:"bytes,3,opt,name=alt_text,json=altText,proto3" json:"alt_text,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateProductImageUploadRequest) Reset() {
+	*x = CreateProductImageUploadRequest{}
+	mi := &file_product_v1_product_service_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateProductImageUploadRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateProductImageUploadRequest) ProtoMessage() {}
+
+func (x *CreateProductImageUploadRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_v1_product_service_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateProductImageUploadRequest.ProtoReflect.Descriptor instead.
+func (*CreateProductImageUploadRequest) Descriptor() ([]byte, []int) {
+	return file_product_v1_product_service_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *CreateProductImageUploadRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *CreateProductImageUploadRequest) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *CreateProductImageUploadRequest) GetAltText() string {
+	if x != nil {
+		return x.AltText
+	}
+	return ""
+}
+
+type CreateProductImageUploadResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Image           *ProductImage          `protobuf:"bytes,1,opt,name=image,proto3" json:"image,omitempty"`
+	UploadUrl       string                 `protobuf:"bytes,2,opt,name=upload_url,json=uploadUrl,proto3" json:"upload_url,omitempty"` // PUT the file here with Content-Type set to content_type
+	UploadExpiresAt *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=upload_expires_at,json=uploadExpiresAt,proto3" json:"upload_expires_at,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *CreateProductImageUploadResponse) Reset() {
+	*x = CreateProductImageUploadResponse{}
+	mi := &file_product_v1_product_service_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateProductImageUploadResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateProductImageUploadResponse) ProtoMessage() {}
+
+func (x *CreateProductImageUploadResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_v1_product_service_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateProductImageUploadResponse.ProtoReflect.Descriptor instead.
+func (*CreateProductImageUploadResponse) Descriptor() ([]byte, []int) {
+	return file_product_v1_product_service_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *CreateProductImageUploadResponse) GetImage() *ProductImage {
+	if x != nil {
+		return x.Image
+	}
+	return nil
+}
+
+func (x *CreateProductImageUploadResponse) GetUploadUrl() string {
+	if x != nil {
+		return x.UploadUrl
+	}
+	return ""
+}
+
+func (x *CreateProductImageUploadResponse) GetUploadExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UploadExpiresAt
+	}
+	return nil
+}
+
+type CompleteProductImageUploadRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CompleteProductImageUploadRequest) Reset() {
+	*x = CompleteProductImageUploadRequest{}
+	mi := &file_product_v1_product_service_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CompleteProductImageUploadRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompleteProductImageUploadRequest) ProtoMessage() {}
+
+func (x *CompleteProductImageUploadRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_v1_product_service_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompleteProductImageUploadRequest.ProtoReflect.Descriptor instead.
+func (*CompleteProductImageUploadRequest) Descriptor() ([]byte, []int) {
+	return file_product_v1_product_service_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *CompleteProductImageUploadRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type CompleteProductImageUploadResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Image         *ProductImage          `protobuf:"bytes,1,opt,name=image,proto3" json:"image,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CompleteProductImageUploadResponse) Reset() {
+	*x = CompleteProductImageUploadResponse{}
+	mi := &file_product_v1_product_service_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CompleteProductImageUploadResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompleteProductImageUploadResponse) ProtoMessage() {}
+
+func (x *CompleteProductImageUploadResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_v1_product_service_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompleteProductImageUploadResponse.ProtoReflect.Descriptor instead.
+func (*CompleteProductImageUploadResponse) Descriptor() ([]byte, []int) {
+	return file_product_v1_product_service_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *CompleteProductImageUploadResponse) GetImage() *ProductImage {
+	if x != nil {
+		return x.Image
+	}
+	return nil
+}
+
+type UpdateProductImageRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	AltText       *string                `protobuf:"bytes,2,opt,name=alt_text,json=altText,proto3,oneof" json:"alt_text,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateProductImageRequest) Reset() {
+	*x = UpdateProductImageRequest{}
+	mi := &file_product_v1_product_service_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateProductImageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateProductImageRequest) ProtoMessage() {}
+
+func (x *UpdateProductImageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_v1_product_service_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateProductImageRequest.ProtoReflect.Descriptor instead.
+func (*UpdateProductImageRequest) Descriptor() ([]byte, []int) {
+	return file_product_v1_product_service_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *UpdateProductImageRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateProductImageRequest) GetAltText() string {
+	if x != nil && x.AltText != nil {
+		return *x.AltText
+	}
+	return ""
+}
+
+type UpdateProductImageResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Image         *ProductImage          `protobuf:"bytes,1,opt,name=image,proto3" json:"image,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateProductImageResponse) Reset() {
+	*x = UpdateProductImageResponse{}
+	mi := &file_product_v1_product_service_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateProductImageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateProductImageResponse) ProtoMessage() {}
+
+func (x *UpdateProductImageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_v1_product_service_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateProductImageResponse.ProtoReflect.Descriptor instead.
+func (*UpdateProductImageResponse) Descriptor() ([]byte, []int) {
+	return file_product_v1_product_service_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *UpdateProductImageResponse) GetImage() *ProductImage {
+	if x != nil {
+		return x.Image
+	}
+	return nil
+}
+
+type ReorderProductImagesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	ImageIds      []string               `protobuf:"bytes,2,rep,name=image_ids,json=imageIds,proto3" json:"image_ids,omitempty"` // Every image of the product, in display order
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReorderProductImagesRequest) Reset() {
+	*x = ReorderProductImagesRequest{}
+	mi := &file_product_v1_product_service_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReorderProductImagesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReorderProductImagesRequest) ProtoMessage() {}
+
+func (x *ReorderProductImagesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_v1_product_service_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReorderProductImagesRequest.ProtoReflect.Descriptor instead.
+func (*ReorderProductImagesRequest) Descriptor() ([]byte, []int) {
+	return file_product_v1_product_service_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *ReorderProductImagesRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *ReorderProductImagesRequest) GetImageIds() []string {
+	if x != nil {
+		return x.ImageIds
+	}
+	return nil
+}
+
+type ReorderProductImagesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Images        []*ProductImage        `protobuf:"bytes,1,rep,name=images,proto3" json:"images,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReorderProductImagesResponse) Reset() {
+	*x = ReorderProductImagesResponse{}
+	mi := &file_product_v1_product_service_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReorderProductImagesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReorderProductImagesResponse) ProtoMessage() {}
+
+func (x *ReorderProductImagesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_v1_product_service_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReorderProductImagesResponse.ProtoReflect.Descriptor instead.
+func (*ReorderProductImagesResponse) Descriptor() ([]byte, []int) {
+	return file_product_v1_product_service_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *ReorderProductImagesResponse) GetImages() []*ProductImage {
+	if x != nil {
+		return x.Images
+	}
+	return nil
+}
+
+type DeleteProductImageRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteProductImageRequest) Reset() {
+	*x = DeleteProductImageRequest{}
+	mi := &file_product_v1_product_service_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteProductImageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteProductImageRequest) ProtoMessage() {}
+
+func (x *DeleteProductImageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_v1_product_service_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteProductImageRequest.ProtoReflect.Descriptor instead.
+func (*DeleteProductImageRequest) Descriptor() ([]byte, []int) {
+	return file_product_v1_product_service_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *DeleteProductImageRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type DeleteProductImageResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteProductImageResponse) Reset() {
+	*x = DeleteProductImageResponse{}
+	mi := &file_product_v1_product_service_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteProductImageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteProductImageResponse) ProtoMessage() {}
+
+func (x *DeleteProductImageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_v1_product_service_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteProductImageResponse.ProtoReflect.Descriptor instead.
+func (*DeleteProductImageResponse) Descriptor() ([]byte, []int) {
+	return file_product_v1_product_service_proto_rawDescGZIP(), []int{55}
+}
+
+type CreateCategoryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	ParentId      *string                `protobuf:"bytes,2,opt,name=parent_id,json=parentId,proto3,oneof" json:"parent_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateCategoryRequest) Reset() {
+	*x = CreateCategoryRequest{}
+	mi := &file_product_v1_product_service_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateCategoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateCategoryRequest) ProtoMessage() {}
+
+func (x *CreateCategoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_v1_product_service_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *CreateSKURequest) GetAttributes() map[string]string {
+// Deprecated: Use CreateCategoryRequest.ProtoReflect.Descriptor instead.
+func (*CreateCategoryRequest) Descriptor() ([]byte, []int) {
+	return file_product_v1_product_service_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *CreateCategoryRequest) GetName() string {
 	if x != nil {
-		return x.Attributes
+		return x.Name
 	}
-	return nil
+	return ""
 }
 
-func (x *CreateSKURequest) GetInitialQuantity() int64 {
-	if x != nil {
-		return x.InitialQuantity
+func (x *CreateCategoryRequest) GetParentId() string {
+	if x != nil && x.ParentId != nil {
+		return *x.ParentId
 	}
-	return 0
+	return ""
 }
 
-type CreateSKUResponse struct {
+type CreateCategoryResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Sku           *SKU                   `protobuf:"bytes,1,opt,name=sku,proto3" json:"sku,omitempty"`
+	Category      *Category              `protobuf:"bytes,1,opt,name=category,proto3" json:"category,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateSKUResponse) Reset() {
-	*x = CreateSKUResponse{}
-	mi := &file_product_v1_product_service_proto_msgTypes[17]
+func (x *CreateCategoryResponse) Reset() {
+	*x = CreateCategoryResponse{}
+	mi := &file_product_v1_product_service_proto_msgTypes[57]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateSKUResponse) String() string {
+func (x *CreateCategoryResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateSKUResponse) ProtoMessage() {}
+func (*CreateCategoryResponse) ProtoMessage() {}
 
-func (x *CreateSKUResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_product_v1_product_service_proto_msgTypes[17]
+func (x *CreateCategoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_v1_product_service_proto_msgTypes[57]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -938,40 +3160,40 @@ func (x *CreateSKUResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateSKUResponse.ProtoReflect.Descriptor instead.
-func (*CreateSKUResponse) Descriptor() ([]byte, []int) {
-	return file_product_v1_product_service_proto_rawDescGZIP(), []int{17}
+// Deprecated: Use CreateCategoryResponse.ProtoReflect.Descriptor instead.
+func (*CreateCategoryResponse) Descriptor() ([]byte, []int) {
+	return file_product_v1_product_service_proto_rawDescGZIP(), []int{57}
 }
 
-func (x *CreateSKUResponse) GetSku() *SKU {
+func (x *CreateCategoryResponse) GetCategory() *Category {
 	if x != nil {
-		return x.Sku
+		return x.Category
 	}
 	return nil
 }
 
-type GetSKURequest struct {
+type GetCategoryRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetSKURequest) Reset() {
-	*x = GetSKURequest{}
-	mi := &file_product_v1_product_service_proto_msgTypes[18]
+func (x *GetCategoryRequest) Reset() {
+	*x = GetCategoryRequest{}
+	mi := &file_product_v1_product_service_proto_msgTypes[58]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetSKURequest) String() string {
+func (x *GetCategoryRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetSKURequest) ProtoMessage() {}
+func (*GetCategoryRequest) ProtoMessage() {}
 
-func (x *GetSKURequest) ProtoReflect() protoreflect.Message {
-	mi := &file_product_v1_product_service_proto_msgTypes[18]
+func (x *GetCategoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_v1_product_service_proto_msgTypes[58]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -982,40 +3204,40 @@ func (x *GetSKURequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetSKURequest.ProtoReflect.Descriptor instead.
-func (*GetSKURequest) Descriptor() ([]byte, []int) {
-	return file_product_v1_product_service_proto_rawDescGZIP(), []int{18}
+// Deprecated: Use GetCategoryRequest.ProtoReflect.Descriptor instead.
+func (*GetCategoryRequest) Descriptor() ([]byte, []int) {
+	return file_product_v1_product_service_proto_rawDescGZIP(), []int{58}
 }
 
-func (x *GetSKURequest) GetId() string {
+func (x *GetCategoryRequest) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-type GetSKUResponse struct {
+type GetCategoryResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Sku           *SKU                   `protobuf:"bytes,1,opt,name=sku,proto3" json:"sku,omitempty"`
+	Category      *Category              `protobuf:"bytes,1,opt,name=category,proto3" json:"category,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetSKUResponse) Reset() {
-	*x = GetSKUResponse{}
-	mi := &file_product_v1_product_service_proto_msgTypes[19]
+func (x *GetCategoryResponse) Reset() {
+	*x = GetCategoryResponse{}
+	mi := &file_product_v1_product_service_proto_msgTypes[59]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetSKUResponse) String() string {
+func (x *GetCategoryResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetSKUResponse) ProtoMessage() {}
+func (*GetCategoryResponse) ProtoMessage() {}
 
-func (x *GetSKUResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_product_v1_product_service_proto_msgTypes[19]
+func (x *GetCategoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_v1_product_service_proto_msgTypes[59]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1026,43 +3248,41 @@ func (x *GetSKUResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetSKUResponse.ProtoReflect.Descriptor instead.
-func (*GetSKUResponse) Descriptor() ([]byte, []int) {
-	return file_product_v1_product_service_proto_rawDescGZIP(), []int{19}
+// Deprecated: Use GetCategoryResponse.ProtoReflect.Descriptor instead.
+func (*GetCategoryResponse) Descriptor() ([]byte, []int) {
+	return file_product_v1_product_service_proto_rawDescGZIP(), []int{59}
 }
 
-func (x *GetSKUResponse) GetSku() *SKU {
+func (x *GetCategoryResponse) GetCategory() *Category {
 	if x != nil {
-		return x.Sku
+		return x.Category
 	}
 	return nil
 }
 
-type UpdateSKURequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	SkuCode       *string                `protobuf:"bytes,2,opt,name=sku_code,json=skuCode,proto3,oneof" json:"sku_code,omitempty"`
-	Price         *Money                 `protobuf:"bytes,3,opt,name=price,proto3,oneof" json:"price,omitempty"`
-	Attributes    map[string]string      `protobuf:"bytes,4,rep,name=attributes,proto3" json:"attributes,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+type ListCategoriesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// If true, return flat list instead of tree structure
+	Flat          bool `protobuf:"varint,1,opt,name=flat,proto3" json:"flat,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateSKURequest) Reset() {
-	*x = UpdateSKURequest{}
-	mi := &file_product_v1_product_service_proto_msgTypes[20]
+func (x *ListCategoriesRequest) Reset() {
+	*x = ListCategoriesRequest{}
+	mi := &file_product_v1_product_service_proto_msgTypes[60]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateSKURequest) String() string {
+func (x *ListCategoriesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateSKURequest) ProtoMessage() {}
+func (*ListCategoriesRequest) ProtoMessage() {}
 
-func (x *UpdateSKURequest) ProtoReflect() protoreflect.Message {
-	mi := &file_product_v1_product_service_proto_msgTypes[20]
+func (x *ListCategoriesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_v1_product_service_proto_msgTypes[60]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1073,61 +3293,136 @@ func (x *UpdateSKURequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateSKURequest.ProtoReflect.Descriptor instead.
-func (*UpdateSKURequest) Descriptor() ([]byte, []int) {
-	return file_product_v1_product_service_proto_rawDescGZIP(), []int{20}
+// Deprecated: Use ListCategoriesRequest.ProtoReflect.Descriptor instead.
+func (*ListCategoriesRequest) Descriptor() ([]byte, []int) {
+	return file_product_v1_product_service_proto_rawDescGZIP(), []int{60}
 }
 
-func (x *UpdateSKURequest) GetId() string {
+func (x *ListCategoriesRequest) GetFlat() bool {
 	if x != nil {
-		return x.Id
+		return x.Flat
 	}
-	return ""
+	return false
 }
 
-func (x *UpdateSKURequest) GetSkuCode() string {
-	if x != nil && x.SkuCode != nil {
-		return *x.SkuCode
+type ListCategoriesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Categories    []*Category            `protobuf:"bytes,1,rep,name=categories,proto3" json:"categories,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCategoriesResponse) Reset() {
+	*x = ListCategoriesResponse{}
+	mi := &file_product_v1_product_service_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCategoriesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCategoriesResponse) ProtoMessage() {}
+
+func (x *ListCategoriesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_v1_product_service_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *UpdateSKURequest) GetPrice() *Money {
+// Deprecated: Use ListCategoriesResponse.ProtoReflect.Descriptor instead.
+func (*ListCategoriesResponse) Descriptor() ([]byte, []int) {
+	return file_product_v1_product_service_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *ListCategoriesResponse) GetCategories() []*Category {
 	if x != nil {
-		return x.Price
+		return x.Categories
 	}
 	return nil
 }
 
-func (x *UpdateSKURequest) GetAttributes() map[string]string {
+type GetCategoryTreeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RootId        *string                `protobuf:"bytes,1,opt,name=root_id,json=rootId,proto3,oneof" json:"root_id,omitempty"`        // Unset returns every top-level category
+	MaxDepth      *int32                 `protobuf:"varint,2,opt,name=max_depth,json=maxDepth,proto3,oneof" json:"max_depth,omitempty"` // Levels below the top nodes; unset returns all
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCategoryTreeRequest) Reset() {
+	*x = GetCategoryTreeRequest{}
+	mi := &file_product_v1_product_service_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCategoryTreeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCategoryTreeRequest) ProtoMessage() {}
+
+func (x *GetCategoryTreeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_v1_product_service_proto_msgTypes[62]
 	if x != nil {
-		return x.Attributes
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-type UpdateSKUResponse struct {
+// Deprecated: Use GetCategoryTreeRequest.ProtoReflect.Descriptor instead.
+func (*GetCategoryTreeRequest) Descriptor() ([]byte, []int) {
+	return file_product_v1_product_service_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *GetCategoryTreeRequest) GetRootId() string {
+	if x != nil && x.RootId != nil {
+		return *x.RootId
+	}
+	return ""
+}
+
+func (x *GetCategoryTreeRequest) GetMaxDepth() int32 {
+	if x != nil && x.MaxDepth != nil {
+		return *x.MaxDepth
+	}
+	return 0
+}
+
+type GetCategoryTreeResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Sku           *SKU                   `protobuf:"bytes,1,opt,name=sku,proto3" json:"sku,omitempty"`
+	Nodes         []*CategoryTreeNode    `protobuf:"bytes,1,rep,name=nodes,proto3" json:"nodes,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateSKUResponse) Reset() {
-	*x = UpdateSKUResponse{}
-	mi := &file_product_v1_product_service_proto_msgTypes[21]
+func (x *GetCategoryTreeResponse) Reset() {
+	*x = GetCategoryTreeResponse{}
+	mi := &file_product_v1_product_service_proto_msgTypes[63]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateSKUResponse) String() string {
+func (x *GetCategoryTreeResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateSKUResponse) ProtoMessage() {}
+func (*GetCategoryTreeResponse) ProtoMessage() {}
 
-func (x *UpdateSKUResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_product_v1_product_service_proto_msgTypes[21]
+func (x *GetCategoryTreeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_v1_product_service_proto_msgTypes[63]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1138,40 +3433,42 @@ func (x *UpdateSKUResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateSKUResponse.ProtoReflect.Descriptor instead.
-func (*UpdateSKUResponse) Descriptor() ([]byte, []int) {
-	return file_product_v1_product_service_proto_rawDescGZIP(), []int{21}
+// Deprecated: Use GetCategoryTreeResponse.ProtoReflect.Descriptor instead.
+func (*GetCategoryTreeResponse) Descriptor() ([]byte, []int) {
+	return file_product_v1_product_service_proto_rawDescGZIP(), []int{63}
 }
 
-func (x *UpdateSKUResponse) GetSku() *SKU {
+func (x *GetCategoryTreeResponse) GetNodes() []*CategoryTreeNode {
 	if x != nil {
-		return x.Sku
+		return x.Nodes
 	}
 	return nil
 }
 
-type DeleteSKURequest struct {
+type UpdateCategoryRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          *string                `protobuf:"bytes,2,opt,name=name,proto3,oneof" json:"name,omitempty"`
+	ParentId      *string                `protobuf:"bytes,3,opt,name=parent_id,json=parentId,proto3,oneof" json:"parent_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteSKURequest) Reset() {
-	*x = DeleteSKURequest{}
-	mi := &file_product_v1_product_service_proto_msgTypes[22]
+func (x *UpdateCategoryRequest) Reset() {
+	*x = UpdateCategoryRequest{}
+	mi := &file_product_v1_product_service_proto_msgTypes[64]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteSKURequest) String() string {
+func (x *UpdateCategoryRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteSKURequest) ProtoMessage() {}
+func (*UpdateCategoryRequest) ProtoMessage() {}
 
-func (x *DeleteSKURequest) ProtoReflect() protoreflect.Message {
-	mi := &file_product_v1_product_service_proto_msgTypes[22]
+func (x *UpdateCategoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_v1_product_service_proto_msgTypes[64]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1182,39 +3479,54 @@ func (x *DeleteSKURequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteSKURequest.ProtoReflect.Descriptor instead.
-func (*DeleteSKURequest) Descriptor() ([]byte, []int) {
-	return file_product_v1_product_service_proto_rawDescGZIP(), []int{22}
+// Deprecated: Use UpdateCategoryRequest.ProtoReflect.Descriptor instead.
+func (*UpdateCategoryRequest) Descriptor() ([]byte, []int) {
+	return file_product_v1_product_service_proto_rawDescGZIP(), []int{64}
 }
 
-func (x *DeleteSKURequest) GetId() string {
+func (x *UpdateCategoryRequest) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-type DeleteSKUResponse struct {
+func (x *UpdateCategoryRequest) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+func (x *UpdateCategoryRequest) GetParentId() string {
+	if x != nil && x.ParentId != nil {
+		return *x.ParentId
+	}
+	return ""
+}
+
+type UpdateCategoryResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
+	Category      *Category              `protobuf:"bytes,1,opt,name=category,proto3" json:"category,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteSKUResponse) Reset() {
-	*x = DeleteSKUResponse{}
-	mi := &file_product_v1_product_service_proto_msgTypes[23]
+func (x *UpdateCategoryResponse) Reset() {
+	*x = UpdateCategoryResponse{}
+	mi := &file_product_v1_product_service_proto_msgTypes[65]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteSKUResponse) String() string {
+func (x *UpdateCategoryResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteSKUResponse) ProtoMessage() {}
+func (*UpdateCategoryResponse) ProtoMessage() {}
 
-func (x *DeleteSKUResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_product_v1_product_service_proto_msgTypes[23]
+func (x *UpdateCategoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_v1_product_service_proto_msgTypes[65]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1225,34 +3537,40 @@ func (x *DeleteSKUResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteSKUResponse.ProtoReflect.Descriptor instead.
-func (*DeleteSKUResponse) Descriptor() ([]byte, []int) {
-	return file_product_v1_product_service_proto_rawDescGZIP(), []int{23}
+// Deprecated: Use UpdateCategoryResponse.ProtoReflect.Descriptor instead.
+func (*UpdateCategoryResponse) Descriptor() ([]byte, []int) {
+	return file_product_v1_product_service_proto_rawDescGZIP(), []int{65}
 }
 
-type CreateCategoryRequest struct {
+func (x *UpdateCategoryResponse) GetCategory() *Category {
+	if x != nil {
+		return x.Category
+	}
+	return nil
+}
+
+type DeleteCategoryRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	ParentId      *string                `protobuf:"bytes,2,opt,name=parent_id,json=parentId,proto3,oneof" json:"parent_id,omitempty"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateCategoryRequest) Reset() {
-	*x = CreateCategoryRequest{}
-	mi := &file_product_v1_product_service_proto_msgTypes[24]
+func (x *DeleteCategoryRequest) Reset() {
+	*x = DeleteCategoryRequest{}
+	mi := &file_product_v1_product_service_proto_msgTypes[66]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateCategoryRequest) String() string {
+func (x *DeleteCategoryRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateCategoryRequest) ProtoMessage() {}
+func (*DeleteCategoryRequest) ProtoMessage() {}
 
-func (x *CreateCategoryRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_product_v1_product_service_proto_msgTypes[24]
+func (x *DeleteCategoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_v1_product_service_proto_msgTypes[66]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1263,47 +3581,39 @@ func (x *CreateCategoryRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateCategoryRequest.ProtoReflect.Descriptor instead.
-func (*CreateCategoryRequest) Descriptor() ([]byte, []int) {
-	return file_product_v1_product_service_proto_rawDescGZIP(), []int{24}
+// Deprecated: Use DeleteCategoryRequest.ProtoReflect.Descriptor instead.
+func (*DeleteCategoryRequest) Descriptor() ([]byte, []int) {
+	return file_product_v1_product_service_proto_rawDescGZIP(), []int{66}
 }
 
-func (x *CreateCategoryRequest) GetName() string {
+func (x *DeleteCategoryRequest) GetId() string {
 	if x != nil {
-		return x.Name
-	}
-	return ""
-}
-
-func (x *CreateCategoryRequest) GetParentId() string {
-	if x != nil && x.ParentId != nil {
-		return *x.ParentId
+		return x.Id
 	}
 	return ""
 }
 
-type CreateCategoryResponse struct {
+type DeleteCategoryResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Category      *Category              `protobuf:"bytes,1,opt,name=category,proto3" json:"category,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateCategoryResponse) Reset() {
-	*x = CreateCategoryResponse{}
-	mi := &file_product_v1_product_service_proto_msgTypes[25]
+func (x *DeleteCategoryResponse) Reset() {
+	*x = DeleteCategoryResponse{}
+	mi := &file_product_v1_product_service_proto_msgTypes[67]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateCategoryResponse) String() string {
+func (x *DeleteCategoryResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateCategoryResponse) ProtoMessage() {}
+func (*DeleteCategoryResponse) ProtoMessage() {}
 
-func (x *CreateCategoryResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_product_v1_product_service_proto_msgTypes[25]
+func (x *DeleteCategoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_v1_product_service_proto_msgTypes[67]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1314,40 +3624,37 @@ func (x *CreateCategoryResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateCategoryResponse.ProtoReflect.Descriptor instead.
-func (*CreateCategoryResponse) Descriptor() ([]byte, []int) {
-	return file_product_v1_product_service_proto_rawDescGZIP(), []int{25}
-}
-
-func (x *CreateCategoryResponse) GetCategory() *Category {
-	if x != nil {
-		return x.Category
-	}
-	return nil
+// Deprecated: Use DeleteCategoryResponse.ProtoReflect.Descriptor instead.
+func (*DeleteCategoryResponse) Descriptor() ([]byte, []int) {
+	return file_product_v1_product_service_proto_rawDescGZIP(), []int{67}
 }
 
-type GetCategoryRequest struct {
+type CreateAttributeDefinitionRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	CategoryId    string                 `protobuf:"bytes,1,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"` // 1-64 lowercase letters, digits or underscores, starting with a letter
+	Type          AttributeType          `protobuf:"varint,3,opt,name=type,proto3,enum=product.v1.AttributeType" json:"type,omitempty"`
+	AllowedValues []string               `protobuf:"bytes,4,rep,name=allowed_values,json=allowedValues,proto3" json:"allowed_values,omitempty"` // Max 100, each a valid value of type
+	Required      bool                   `protobuf:"varint,5,opt,name=required,proto3" json:"required,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetCategoryRequest) Reset() {
-	*x = GetCategoryRequest{}
-	mi := &file_product_v1_product_service_proto_msgTypes[26]
+func (x *CreateAttributeDefinitionRequest) Reset() {
+	*x = CreateAttributeDefinitionRequest{}
+	mi := &file_product_v1_product_service_proto_msgTypes[68]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetCategoryRequest) String() string {
+func (x *CreateAttributeDefinitionRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetCategoryRequest) ProtoMessage() {}
+func (*CreateAttributeDefinitionRequest) ProtoMessage() {}
 
-func (x *GetCategoryRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_product_v1_product_service_proto_msgTypes[26]
+func (x *CreateAttributeDefinitionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_v1_product_service_proto_msgTypes[68]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1358,40 +3665,68 @@ func (x *GetCategoryRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetCategoryRequest.ProtoReflect.Descriptor instead.
-func (*GetCategoryRequest) Descriptor() ([]byte, []int) {
-	return file_product_v1_product_service_proto_rawDescGZIP(), []int{26}
+// Deprecated: Use CreateAttributeDefinitionRequest.ProtoReflect.Descriptor instead.
+func (*CreateAttributeDefinitionRequest) Descriptor() ([]byte, []int) {
+	return file_product_v1_product_service_proto_rawDescGZIP(), []int{68}
 }
 
-func (x *GetCategoryRequest) GetId() string {
+func (x *CreateAttributeDefinitionRequest) GetCategoryId() string {
 	if x != nil {
-		return x.Id
+		return x.CategoryId
 	}
 	return ""
 }
 
-type GetCategoryResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Category      *Category              `protobuf:"bytes,1,opt,name=category,proto3" json:"category,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *CreateAttributeDefinitionRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
 }
 
-func (x *GetCategoryResponse) Reset() {
-	*x = GetCategoryResponse{}
-	mi := &file_product_v1_product_service_proto_msgTypes[27]
+func (x *CreateAttributeDefinitionRequest) GetType() AttributeType {
+	if x != nil {
+		return x.Type
+	}
+	return AttributeType_ATTRIBUTE_TYPE_UNSPECIFIED
+}
+
+func (x *CreateAttributeDefinitionRequest) GetAllowedValues() []string {
+	if x != nil {
+		return x.AllowedValues
+	}
+	return nil
+}
+
+func (x *CreateAttributeDefinitionRequest) GetRequired() bool {
+	if x != nil {
+		return x.Required
+	}
+	return false
+}
+
+type CreateAttributeDefinitionResponse struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	AttributeDefinition *AttributeDefinition   `protobuf:"bytes,1,opt,name=attribute_definition,json=attributeDefinition,proto3" json:"attribute_definition,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *CreateAttributeDefinitionResponse) Reset() {
+	*x = CreateAttributeDefinitionResponse{}
+	mi := &file_product_v1_product_service_proto_msgTypes[69]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetCategoryResponse) String() string {
+func (x *CreateAttributeDefinitionResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetCategoryResponse) ProtoMessage() {}
+func (*CreateAttributeDefinitionResponse) ProtoMessage() {}
 
-func (x *GetCategoryResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_product_v1_product_service_proto_msgTypes[27]
+func (x *CreateAttributeDefinitionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_v1_product_service_proto_msgTypes[69]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1402,41 +3737,40 @@ func (x *GetCategoryResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetCategoryResponse.ProtoReflect.Descriptor instead.
-func (*GetCategoryResponse) Descriptor() ([]byte, []int) {
-	return file_product_v1_product_service_proto_rawDescGZIP(), []int{27}
+// Deprecated: Use CreateAttributeDefinitionResponse.ProtoReflect.Descriptor instead.
+func (*CreateAttributeDefinitionResponse) Descriptor() ([]byte, []int) {
+	return file_product_v1_product_service_proto_rawDescGZIP(), []int{69}
 }
 
-func (x *GetCategoryResponse) GetCategory() *Category {
+func (x *CreateAttributeDefinitionResponse) GetAttributeDefinition() *AttributeDefinition {
 	if x != nil {
-		return x.Category
+		return x.AttributeDefinition
 	}
 	return nil
 }
 
-type ListCategoriesRequest struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// If true, return flat list instead of tree structure
-	Flat          bool `protobuf:"varint,1,opt,name=flat,proto3" json:"flat,omitempty"`
+type ListAttributeDefinitionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CategoryId    string                 `protobuf:"bytes,1,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListCategoriesRequest) Reset() {
-	*x = ListCategoriesRequest{}
-	mi := &file_product_v1_product_service_proto_msgTypes[28]
+func (x *ListAttributeDefinitionsRequest) Reset() {
+	*x = ListAttributeDefinitionsRequest{}
+	mi := &file_product_v1_product_service_proto_msgTypes[70]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListCategoriesRequest) String() string {
+func (x *ListAttributeDefinitionsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListCategoriesRequest) ProtoMessage() {}
+func (*ListAttributeDefinitionsRequest) ProtoMessage() {}
 
-func (x *ListCategoriesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_product_v1_product_service_proto_msgTypes[28]
+func (x *ListAttributeDefinitionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_v1_product_service_proto_msgTypes[70]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1447,40 +3781,40 @@ func (x *ListCategoriesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListCategoriesRequest.ProtoReflect.Descriptor instead.
-func (*ListCategoriesRequest) Descriptor() ([]byte, []int) {
-	return file_product_v1_product_service_proto_rawDescGZIP(), []int{28}
+// Deprecated: Use ListAttributeDefinitionsRequest.ProtoReflect.Descriptor instead.
+func (*ListAttributeDefinitionsRequest) Descriptor() ([]byte, []int) {
+	return file_product_v1_product_service_proto_rawDescGZIP(), []int{70}
 }
 
-func (x *ListCategoriesRequest) GetFlat() bool {
+func (x *ListAttributeDefinitionsRequest) GetCategoryId() string {
 	if x != nil {
-		return x.Flat
+		return x.CategoryId
 	}
-	return false
+	return ""
 }
 
-type ListCategoriesResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Categories    []*Category            `protobuf:"bytes,1,rep,name=categories,proto3" json:"categories,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type ListAttributeDefinitionsResponse struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	AttributeDefinitions []*AttributeDefinition `protobuf:"bytes,1,rep,name=attribute_definitions,json=attributeDefinitions,proto3" json:"attribute_definitions,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
 }
 
-func (x *ListCategoriesResponse) Reset() {
-	*x = ListCategoriesResponse{}
-	mi := &file_product_v1_product_service_proto_msgTypes[29]
+func (x *ListAttributeDefinitionsResponse) Reset() {
+	*x = ListAttributeDefinitionsResponse{}
+	mi := &file_product_v1_product_service_proto_msgTypes[71]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListCategoriesResponse) String() string {
+func (x *ListAttributeDefinitionsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListCategoriesResponse) ProtoMessage() {}
+func (*ListAttributeDefinitionsResponse) ProtoMessage() {}
 
-func (x *ListCategoriesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_product_v1_product_service_proto_msgTypes[29]
+func (x *ListAttributeDefinitionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_v1_product_service_proto_msgTypes[71]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1491,42 +3825,43 @@ func (x *ListCategoriesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListCategoriesResponse.ProtoReflect.Descriptor instead.
-func (*ListCategoriesResponse) Descriptor() ([]byte, []int) {
-	return file_product_v1_product_service_proto_rawDescGZIP(), []int{29}
+// Deprecated: Use ListAttributeDefinitionsResponse.ProtoReflect.Descriptor instead.
+func (*ListAttributeDefinitionsResponse) Descriptor() ([]byte, []int) {
+	return file_product_v1_product_service_proto_rawDescGZIP(), []int{71}
 }
 
-func (x *ListCategoriesResponse) GetCategories() []*Category {
+func (x *ListAttributeDefinitionsResponse) GetAttributeDefinitions() []*AttributeDefinition {
 	if x != nil {
-		return x.Categories
+		return x.AttributeDefinitions
 	}
 	return nil
 }
 
-type UpdateCategoryRequest struct {
+type UpdateAttributeDefinitionRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name          *string                `protobuf:"bytes,2,opt,name=name,proto3,oneof" json:"name,omitempty"`
-	ParentId      *string                `protobuf:"bytes,3,opt,name=parent_id,json=parentId,proto3,oneof" json:"parent_id,omitempty"`
+	Type          AttributeType          `protobuf:"varint,2,opt,name=type,proto3,enum=product.v1.AttributeType" json:"type,omitempty"`
+	AllowedValues []string               `protobuf:"bytes,3,rep,name=allowed_values,json=allowedValues,proto3" json:"allowed_values,omitempty"`
+	Required      bool                   `protobuf:"varint,4,opt,name=required,proto3" json:"required,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateCategoryRequest) Reset() {
-	*x = UpdateCategoryRequest{}
-	mi := &file_product_v1_product_service_proto_msgTypes[30]
+func (x *UpdateAttributeDefinitionRequest) Reset() {
+	*x = UpdateAttributeDefinitionRequest{}
+	mi := &file_product_v1_product_service_proto_msgTypes[72]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateCategoryRequest) String() string {
+func (x *UpdateAttributeDefinitionRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateCategoryRequest) ProtoMessage() {}
+func (*UpdateAttributeDefinitionRequest) ProtoMessage() {}
 
-func (x *UpdateCategoryRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_product_v1_product_service_proto_msgTypes[30]
+func (x *UpdateAttributeDefinitionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_v1_product_service_proto_msgTypes[72]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1537,54 +3872,61 @@ func (x *UpdateCategoryRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateCategoryRequest.ProtoReflect.Descriptor instead.
-func (*UpdateCategoryRequest) Descriptor() ([]byte, []int) {
-	return file_product_v1_product_service_proto_rawDescGZIP(), []int{30}
+// Deprecated: Use UpdateAttributeDefinitionRequest.ProtoReflect.Descriptor instead.
+func (*UpdateAttributeDefinitionRequest) Descriptor() ([]byte, []int) {
+	return file_product_v1_product_service_proto_rawDescGZIP(), []int{72}
 }
 
-func (x *UpdateCategoryRequest) GetId() string {
+func (x *UpdateAttributeDefinitionRequest) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-func (x *UpdateCategoryRequest) GetName() string {
-	if x != nil && x.Name != nil {
-		return *x.Name
+func (x *UpdateAttributeDefinitionRequest) GetType() AttributeType {
+	if x != nil {
+		return x.Type
 	}
-	return ""
+	return AttributeType_ATTRIBUTE_TYPE_UNSPECIFIED
 }
 
-func (x *UpdateCategoryRequest) GetParentId() string {
-	if x != nil && x.ParentId != nil {
-		return *x.ParentId
+func (x *UpdateAttributeDefinitionRequest) GetAllowedValues() []string {
+	if x != nil {
+		return x.AllowedValues
 	}
-	return ""
+	return nil
 }
 
-type UpdateCategoryResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Category      *Category              `protobuf:"bytes,1,opt,name=category,proto3" json:"category,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *UpdateAttributeDefinitionRequest) GetRequired() bool {
+	if x != nil {
+		return x.Required
+	}
+	return false
 }
 
-func (x *UpdateCategoryResponse) Reset() {
-	*x = UpdateCategoryResponse{}
-	mi := &file_product_v1_product_service_proto_msgTypes[31]
+type UpdateAttributeDefinitionResponse struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	AttributeDefinition *AttributeDefinition   `protobuf:"bytes,1,opt,name=attribute_definition,json=attributeDefinition,proto3" json:"attribute_definition,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *UpdateAttributeDefinitionResponse) Reset() {
+	*x = UpdateAttributeDefinitionResponse{}
+	mi := &file_product_v1_product_service_proto_msgTypes[73]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateCategoryResponse) String() string {
+func (x *UpdateAttributeDefinitionResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateCategoryResponse) ProtoMessage() {}
+func (*UpdateAttributeDefinitionResponse) ProtoMessage() {}
 
-func (x *UpdateCategoryResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_product_v1_product_service_proto_msgTypes[31]
+func (x *UpdateAttributeDefinitionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_v1_product_service_proto_msgTypes[73]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1595,40 +3937,40 @@ func (x *UpdateCategoryResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateCategoryResponse.ProtoReflect.Descriptor instead.
-func (*UpdateCategoryResponse) Descriptor() ([]byte, []int) {
-	return file_product_v1_product_service_proto_rawDescGZIP(), []int{31}
+// Deprecated: Use UpdateAttributeDefinitionResponse.ProtoReflect.Descriptor instead.
+func (*UpdateAttributeDefinitionResponse) Descriptor() ([]byte, []int) {
+	return file_product_v1_product_service_proto_rawDescGZIP(), []int{73}
 }
 
-func (x *UpdateCategoryResponse) GetCategory() *Category {
+func (x *UpdateAttributeDefinitionResponse) GetAttributeDefinition() *AttributeDefinition {
 	if x != nil {
-		return x.Category
+		return x.AttributeDefinition
 	}
 	return nil
 }
 
-type DeleteCategoryRequest struct {
+type DeleteAttributeDefinitionRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteCategoryRequest) Reset() {
-	*x = DeleteCategoryRequest{}
-	mi := &file_product_v1_product_service_proto_msgTypes[32]
+func (x *DeleteAttributeDefinitionRequest) Reset() {
+	*x = DeleteAttributeDefinitionRequest{}
+	mi := &file_product_v1_product_service_proto_msgTypes[74]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteCategoryRequest) String() string {
+func (x *DeleteAttributeDefinitionRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteCategoryRequest) ProtoMessage() {}
+func (*DeleteAttributeDefinitionRequest) ProtoMessage() {}
 
-func (x *DeleteCategoryRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_product_v1_product_service_proto_msgTypes[32]
+func (x *DeleteAttributeDefinitionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_v1_product_service_proto_msgTypes[74]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1639,39 +3981,39 @@ func (x *DeleteCategoryRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteCategoryRequest.ProtoReflect.Descriptor instead.
-func (*DeleteCategoryRequest) Descriptor() ([]byte, []int) {
-	return file_product_v1_product_service_proto_rawDescGZIP(), []int{32}
+// Deprecated: Use DeleteAttributeDefinitionRequest.ProtoReflect.Descriptor instead.
+func (*DeleteAttributeDefinitionRequest) Descriptor() ([]byte, []int) {
+	return file_product_v1_product_service_proto_rawDescGZIP(), []int{74}
 }
 
-func (x *DeleteCategoryRequest) GetId() string {
+func (x *DeleteAttributeDefinitionRequest) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-type DeleteCategoryResponse struct {
+type DeleteAttributeDefinitionResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteCategoryResponse) Reset() {
-	*x = DeleteCategoryResponse{}
-	mi := &file_product_v1_product_service_proto_msgTypes[33]
+func (x *DeleteAttributeDefinitionResponse) Reset() {
+	*x = DeleteAttributeDefinitionResponse{}
+	mi := &file_product_v1_product_service_proto_msgTypes[75]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteCategoryResponse) String() string {
+func (x *DeleteAttributeDefinitionResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteCategoryResponse) ProtoMessage() {}
+func (*DeleteAttributeDefinitionResponse) ProtoMessage() {}
 
-func (x *DeleteCategoryResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_product_v1_product_service_proto_msgTypes[33]
+func (x *DeleteAttributeDefinitionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_v1_product_service_proto_msgTypes[75]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1682,9 +4024,9 @@ func (x *DeleteCategoryResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteCategoryResponse.ProtoReflect.Descriptor instead.
-func (*DeleteCategoryResponse) Descriptor() ([]byte, []int) {
-	return file_product_v1_product_service_proto_rawDescGZIP(), []int{33}
+// Deprecated: Use DeleteAttributeDefinitionResponse.ProtoReflect.Descriptor instead.
+func (*DeleteAttributeDefinitionResponse) Descriptor() ([]byte, []int) {
+	return file_product_v1_product_service_proto_rawDescGZIP(), []int{75}
 }
 
 var File_product_v1_product_service_proto protoreflect.FileDescriptor
@@ -1692,19 +4034,28 @@ var File_product_v1_product_service_proto protoreflect.FileDescriptor
 const file_product_v1_product_service_proto_rawDesc = "" +
 	"\n" +
 	" product/v1/product_service.proto\x12\n" +
-	"product.v1\x1a\x16product/v1/types.proto\"\x82\x01\n" +
+	"product.v1\x1a\x1fgoogle/protobuf/timestamp.proto\x1a&operations/v1/operations_service.proto\x1a\x16product/v1/types.proto\"\xa7\x01\n" +
 	"\x14CreateProductRequest\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12 \n" +
 	"\vdescription\x18\x02 \x01(\tR\vdescription\x12$\n" +
 	"\vcategory_id\x18\x03 \x01(\tH\x00R\n" +
-	"categoryId\x88\x01\x01B\x0e\n" +
+	"categoryId\x88\x01\x01\x12#\n" +
+	"\rvalidate_only\x18\x04 \x01(\bR\fvalidateOnlyB\x0e\n" +
 	"\f_category_id\"F\n" +
 	"\x15CreateProductResponse\x12-\n" +
 	"\aproduct\x18\x01 \x01(\v2\x13.product.v1.ProductR\aproduct\"#\n" +
 	"\x11GetProductRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\"C\n" +
 	"\x12GetProductResponse\x12-\n" +
-	"\aproduct\x18\x01 \x01(\v2\x13.product.v1.ProductR\aproduct\"\xb5\x01\n" +
+	"\aproduct\x18\x01 \x01(\v2\x13.product.v1.ProductR\aproduct\"+\n" +
+	"\x17GetProductsByIDsRequest\x12\x10\n" +
+	"\x03ids\x18\x01 \x03(\tR\x03ids\"O\n" +
+	"\x18GetProductsByIDsResponse\x123\n" +
+	"\aresults\x18\x01 \x03(\v2\x19.product.v1.ProductLookupR\aresults\"d\n" +
+	"\rProductLookup\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
+	"\x05found\x18\x02 \x01(\bR\x05found\x12-\n" +
+	"\aproduct\x18\x03 \x01(\v2\x13.product.v1.ProductR\aproduct\"\xb5\x01\n" +
 	"\x14UpdateProductRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
 	"\x04name\x18\x02 \x01(\tH\x00R\x04name\x88\x01\x01\x12%\n" +
@@ -1718,7 +4069,7 @@ const file_product_v1_product_service_proto_rawDesc = "" +
 	"\aproduct\x18\x01 \x01(\v2\x13.product.v1.ProductR\aproduct\"&\n" +
 	"\x14DeleteProductRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\"\x17\n" +
-	"\x15DeleteProductResponse\"\xe3\x02\n" +
+	"\x15DeleteProductResponse\"\x96\x03\n" +
 	"\x13ListProductsRequest\x12\x1b\n" +
 	"\tpage_size\x18\x01 \x01(\x05R\bpageSize\x12\x1d\n" +
 	"\n" +
@@ -1728,7 +4079,9 @@ const file_product_v1_product_service_proto_rawDesc = "" +
 	"\fsearch_query\x18\x04 \x01(\tH\x01R\vsearchQuery\x88\x01\x01\x12 \n" +
 	"\tmin_price\x18\x05 \x01(\x03H\x02R\bminPrice\x88\x01\x01\x12 \n" +
 	"\tmax_price\x18\x06 \x01(\x03H\x03R\bmaxPrice\x88\x01\x01\x126\n" +
-	"\x06status\x18\a \x01(\x0e2\x19.product.v1.ProductStatusH\x04R\x06status\x88\x01\x01B\x0e\n" +
+	"\x06status\x18\a \x01(\x0e2\x19.product.v1.ProductStatusH\x04R\x06status\x88\x01\x01\x12\x19\n" +
+	"\border_by\x18\b \x01(\tR\aorderBy\x12\x16\n" +
+	"\x06filter\x18\t \x01(\tR\x06filterB\x0e\n" +
 	"\f_category_idB\x0f\n" +
 	"\r_search_queryB\f\n" +
 	"\n" +
@@ -1752,7 +4105,35 @@ const file_product_v1_product_service_proto_rawDesc = "" +
 	"\x17UnpublishProductRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\"I\n" +
 	"\x18UnpublishProductResponse\x12-\n" +
-	"\aproduct\x18\x01 \x01(\v2\x13.product.v1.ProductR\aproduct\"\xad\x02\n" +
+	"\aproduct\x18\x01 \x01(\v2\x13.product.v1.ProductR\aproduct\"f\n" +
+	"\x1eUpdateProductVisibilityRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1a\n" +
+	"\bchannels\x18\x02 \x03(\tR\bchannels\x12\x18\n" +
+	"\amarkets\x18\x03 \x03(\tR\amarkets\"P\n" +
+	"\x1fUpdateProductVisibilityResponse\x12-\n" +
+	"\aproduct\x18\x01 \x01(\v2\x13.product.v1.ProductR\aproduct\"\x82\x01\n" +
+	"\x15ImportProductsRequest\x120\n" +
+	"\x06format\x18\x01 \x01(\x0e2\x18.product.v1.ImportFormatR\x06format\x12\x12\n" +
+	"\x04data\x18\x02 \x01(\fR\x04data\x12#\n" +
+	"\rvalidate_only\x18\x03 \x01(\bR\fvalidateOnly\"P\n" +
+	"\x16ImportProductsResponse\x126\n" +
+	"\toperation\x18\x01 \x01(\v2\x18.operations.v1.OperationR\toperation\"<\n" +
+	"\x17GetProductImportRequest\x12!\n" +
+	"\foperation_id\x18\x01 \x01(\tR\voperationId\"\x8b\x01\n" +
+	"\x18GetProductImportResponse\x126\n" +
+	"\toperation\x18\x01 \x01(\v2\x18.operations.v1.OperationR\toperation\x127\n" +
+	"\x06report\x18\x02 \x01(\v2\x1f.product.v1.ProductImportReportR\x06report\"\x9d\x02\n" +
+	"\x13ProductImportReport\x12#\n" +
+	"\rvalidate_only\x18\x01 \x01(\bR\fvalidateOnly\x12%\n" +
+	"\x0etotal_products\x18\x02 \x01(\x05R\rtotalProducts\x12+\n" +
+	"\x11imported_products\x18\x03 \x01(\x05R\x10importedProducts\x12'\n" +
+	"\x0ffailed_products\x18\x04 \x01(\x05R\x0efailedProducts\x129\n" +
+	"\x06errors\x18\x05 \x03(\v2!.product.v1.ProductImportRowErrorR\x06errors\x12)\n" +
+	"\x10errors_truncated\x18\x06 \x01(\bR\x0ferrorsTruncated\"`\n" +
+	"\x15ProductImportRowError\x12\x12\n" +
+	"\x04line\x18\x01 \x01(\x05R\x04line\x12\x19\n" +
+	"\bsku_code\x18\x02 \x01(\tR\askuCode\x12\x18\n" +
+	"\amessage\x18\x03 \x01(\tR\amessage\"\x92\x03\n" +
 	"\x10CreateSKURequest\x12\x1d\n" +
 	"\n" +
 	"product_id\x18\x01 \x01(\tR\tproductId\x12\x19\n" +
@@ -1761,23 +4142,59 @@ const file_product_v1_product_service_proto_rawDesc = "" +
 	"\n" +
 	"attributes\x18\x04 \x03(\v2,.product.v1.CreateSKURequest.AttributesEntryR\n" +
 	"attributes\x12)\n" +
-	"\x10initial_quantity\x18\x05 \x01(\x03R\x0finitialQuantity\x1a=\n" +
+	"\x10initial_quantity\x18\x05 \x01(\x03R\x0finitialQuantity\x12#\n" +
+	"\rvalidate_only\x18\x06 \x01(\bR\fvalidateOnly\x12>\n" +
+	"\x11additional_prices\x18\a \x03(\v2\x11.product.v1.MoneyR\x10additionalPrices\x1a=\n" +
 	"\x0fAttributesEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
 	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"6\n" +
 	"\x11CreateSKUResponse\x12!\n" +
-	"\x03sku\x18\x01 \x01(\v2\x0f.product.v1.SKUR\x03sku\"\x1f\n" +
+	"\x03sku\x18\x01 \x01(\v2\x0f.product.v1.SKUR\x03sku\"\xd3\x02\n" +
+	"\x13GenerateSKUsRequest\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\x128\n" +
+	"\n" +
+	"dimensions\x18\x02 \x03(\v2\x18.product.v1.SKUDimensionR\n" +
+	"dimensions\x120\n" +
+	"\n" +
+	"base_price\x18\x03 \x01(\v2\x11.product.v1.MoneyR\tbasePrice\x129\n" +
+	"\vprice_rules\x18\x04 \x03(\v2\x18.product.v1.SKUPriceRuleR\n" +
+	"priceRules\x12&\n" +
+	"\x0fsku_code_prefix\x18\x05 \x01(\tR\rskuCodePrefix\x12)\n" +
+	"\x10initial_quantity\x18\x06 \x01(\x03R\x0finitialQuantity\x12#\n" +
+	"\rvalidate_only\x18\a \x01(\bR\fvalidateOnly\":\n" +
+	"\fSKUDimension\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x16\n" +
+	"\x06values\x18\x02 \x03(\tR\x06values\"e\n" +
+	"\fSKUPriceRule\x12\x1c\n" +
+	"\tattribute\x18\x01 \x01(\tR\tattribute\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value\x12!\n" +
+	"\famount_delta\x18\x03 \x01(\x03R\vamountDelta\"`\n" +
+	"\x14GenerateSKUsResponse\x12#\n" +
+	"\x04skus\x18\x01 \x03(\v2\x0f.product.v1.SKUR\x04skus\x12#\n" +
+	"\rskipped_count\x18\x02 \x01(\x05R\fskippedCount\"N\n" +
 	"\rGetSKURequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\"3\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12-\n" +
+	"\x12preferred_currency\x18\x02 \x01(\tR\x11preferredCurrency\"3\n" +
 	"\x0eGetSKUResponse\x12!\n" +
-	"\x03sku\x18\x01 \x01(\v2\x0f.product.v1.SKUR\x03sku\"\x94\x02\n" +
+	"\x03sku\x18\x01 \x01(\v2\x0f.product.v1.SKUR\x03sku\"'\n" +
+	"\x13GetSKUsByIDsRequest\x12\x10\n" +
+	"\x03ids\x18\x01 \x03(\tR\x03ids\"G\n" +
+	"\x14GetSKUsByIDsResponse\x12/\n" +
+	"\aresults\x18\x01 \x03(\v2\x15.product.v1.SKULookupR\aresults\"T\n" +
+	"\tSKULookup\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
+	"\x05found\x18\x02 \x01(\bR\x05found\x12!\n" +
+	"\x03sku\x18\x03 \x01(\v2\x0f.product.v1.SKUR\x03sku\"\xfd\x02\n" +
 	"\x10UpdateSKURequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1e\n" +
 	"\bsku_code\x18\x02 \x01(\tH\x00R\askuCode\x88\x01\x01\x12,\n" +
 	"\x05price\x18\x03 \x01(\v2\x11.product.v1.MoneyH\x01R\x05price\x88\x01\x01\x12L\n" +
 	"\n" +
 	"attributes\x18\x04 \x03(\v2,.product.v1.UpdateSKURequest.AttributesEntryR\n" +
-	"attributes\x1a=\n" +
+	"attributes\x12#\n" +
+	"\rvalidate_only\x18\x05 \x01(\bR\fvalidateOnly\x12B\n" +
+	"\x11additional_prices\x18\x06 \x01(\v2\x15.product.v1.MoneyListR\x10additionalPrices\x1a=\n" +
 	"\x0fAttributesEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
 	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01B\v\n" +
@@ -1787,7 +4204,50 @@ const file_product_v1_product_service_proto_rawDesc = "" +
 	"\x03sku\x18\x01 \x01(\v2\x0f.product.v1.SKUR\x03sku\"\"\n" +
 	"\x10DeleteSKURequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\"\x13\n" +
-	"\x11DeleteSKUResponse\"[\n" +
+	"\x11DeleteSKUResponse\"\x9f\x01\n" +
+	"\x1aSchedulePriceChangeRequest\x12\x15\n" +
+	"\x06sku_id\x18\x01 \x01(\tR\x05skuId\x12'\n" +
+	"\x05price\x18\x02 \x01(\v2\x11.product.v1.MoneyR\x05price\x12A\n" +
+	"\x0eeffective_from\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\reffectiveFrom\"Y\n" +
+	"\x1bSchedulePriceChangeResponse\x12:\n" +
+	"\fprice_change\x18\x01 \x01(\v2\x17.product.v1.PriceChangeR\vpriceChange\"k\n" +
+	"\x16GetPriceHistoryRequest\x12\x15\n" +
+	"\x06sku_id\x18\x01 \x01(\tR\x05skuId\x12\x1b\n" +
+	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x03 \x01(\tR\tpageToken\"\x7f\n" +
+	"\x17GetPriceHistoryResponse\x12<\n" +
+	"\rprice_changes\x18\x01 \x03(\v2\x17.product.v1.PriceChangeR\fpriceChanges\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"~\n" +
+	"\x1fCreateProductImageUploadRequest\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\x12!\n" +
+	"\fcontent_type\x18\x02 \x01(\tR\vcontentType\x12\x19\n" +
+	"\balt_text\x18\x03 \x01(\tR\aaltText\"\xbe\x01\n" +
+	" CreateProductImageUploadResponse\x12.\n" +
+	"\x05image\x18\x01 \x01(\v2\x18.product.v1.ProductImageR\x05image\x12\"\n" +
+	"\n" +
+	"upload_url\x18\x02 \x01(\tB\x03\x80\x01\x01R\tuploadUrl\x12F\n" +
+	"\x11upload_expires_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x0fuploadExpiresAt\"3\n" +
+	"!CompleteProductImageUploadRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"T\n" +
+	"\"CompleteProductImageUploadResponse\x12.\n" +
+	"\x05image\x18\x01 \x01(\v2\x18.product.v1.ProductImageR\x05image\"X\n" +
+	"\x19UpdateProductImageRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1e\n" +
+	"\balt_text\x18\x02 \x01(\tH\x00R\aaltText\x88\x01\x01B\v\n" +
+	"\t_alt_text\"L\n" +
+	"\x1aUpdateProductImageResponse\x12.\n" +
+	"\x05image\x18\x01 \x01(\v2\x18.product.v1.ProductImageR\x05image\"Y\n" +
+	"\x1bReorderProductImagesRequest\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\x12\x1b\n" +
+	"\timage_ids\x18\x02 \x03(\tR\bimageIds\"P\n" +
+	"\x1cReorderProductImagesResponse\x120\n" +
+	"\x06images\x18\x01 \x03(\v2\x18.product.v1.ProductImageR\x06images\"+\n" +
+	"\x19DeleteProductImageRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\x1c\n" +
+	"\x1aDeleteProductImageResponse\"[\n" +
 	"\x15CreateCategoryRequest\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12 \n" +
 	"\tparent_id\x18\x02 \x01(\tH\x00R\bparentId\x88\x01\x01B\f\n" +
@@ -1804,7 +4264,16 @@ const file_product_v1_product_service_proto_rawDesc = "" +
 	"\x16ListCategoriesResponse\x124\n" +
 	"\n" +
 	"categories\x18\x01 \x03(\v2\x14.product.v1.CategoryR\n" +
-	"categories\"y\n" +
+	"categories\"r\n" +
+	"\x16GetCategoryTreeRequest\x12\x1c\n" +
+	"\aroot_id\x18\x01 \x01(\tH\x00R\x06rootId\x88\x01\x01\x12 \n" +
+	"\tmax_depth\x18\x02 \x01(\x05H\x01R\bmaxDepth\x88\x01\x01B\n" +
+	"\n" +
+	"\b_root_idB\f\n" +
+	"\n" +
+	"_max_depth\"M\n" +
+	"\x17GetCategoryTreeResponse\x122\n" +
+	"\x05nodes\x18\x01 \x03(\v2\x1c.product.v1.CategoryTreeNodeR\x05nodes\"y\n" +
 	"\x15UpdateCategoryRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
 	"\x04name\x18\x02 \x01(\tH\x00R\x04name\x88\x01\x01\x12 \n" +
@@ -1816,26 +4285,72 @@ const file_product_v1_product_service_proto_rawDesc = "" +
 	"\bcategory\x18\x01 \x01(\v2\x14.product.v1.CategoryR\bcategory\"'\n" +
 	"\x15DeleteCategoryRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\"\x18\n" +
-	"\x16DeleteCategoryResponse2\x8d\v\n" +
+	"\x16DeleteCategoryResponse\"\xc9\x01\n" +
+	" CreateAttributeDefinitionRequest\x12\x1f\n" +
+	"\vcategory_id\x18\x01 \x01(\tR\n" +
+	"categoryId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12-\n" +
+	"\x04type\x18\x03 \x01(\x0e2\x19.product.v1.AttributeTypeR\x04type\x12%\n" +
+	"\x0eallowed_values\x18\x04 \x03(\tR\rallowedValues\x12\x1a\n" +
+	"\brequired\x18\x05 \x01(\bR\brequired\"w\n" +
+	"!CreateAttributeDefinitionResponse\x12R\n" +
+	"\x14attribute_definition\x18\x01 \x01(\v2\x1f.product.v1.AttributeDefinitionR\x13attributeDefinition\"B\n" +
+	"\x1fListAttributeDefinitionsRequest\x12\x1f\n" +
+	"\vcategory_id\x18\x01 \x01(\tR\n" +
+	"categoryId\"x\n" +
+	" ListAttributeDefinitionsResponse\x12T\n" +
+	"\x15attribute_definitions\x18\x01 \x03(\v2\x1f.product.v1.AttributeDefinitionR\x14attributeDefinitions\"\xa4\x01\n" +
+	" UpdateAttributeDefinitionRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12-\n" +
+	"\x04type\x18\x02 \x01(\x0e2\x19.product.v1.AttributeTypeR\x04type\x12%\n" +
+	"\x0eallowed_values\x18\x03 \x03(\tR\rallowedValues\x12\x1a\n" +
+	"\brequired\x18\x04 \x01(\bR\brequired\"w\n" +
+	"!UpdateAttributeDefinitionResponse\x12R\n" +
+	"\x14attribute_definition\x18\x01 \x01(\v2\x1f.product.v1.AttributeDefinitionR\x13attributeDefinition\"2\n" +
+	" DeleteAttributeDefinitionRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"#\n" +
+	"!DeleteAttributeDefinitionResponse*^\n" +
+	"\fImportFormat\x12\x1d\n" +
+	"\x19IMPORT_FORMAT_UNSPECIFIED\x10\x00\x12\x15\n" +
+	"\x11IMPORT_FORMAT_CSV\x10\x01\x12\x18\n" +
+	"\x14IMPORT_FORMAT_NDJSON\x10\x022\xec\x19\n" +
 	"\x0eProductService\x12T\n" +
 	"\rCreateProduct\x12 .product.v1.CreateProductRequest\x1a!.product.v1.CreateProductResponse\x12K\n" +
 	"\n" +
-	"GetProduct\x12\x1d.product.v1.GetProductRequest\x1a\x1e.product.v1.GetProductResponse\x12T\n" +
+	"GetProduct\x12\x1d.product.v1.GetProductRequest\x1a\x1e.product.v1.GetProductResponse\x12]\n" +
+	"\x10GetProductsByIDs\x12#.product.v1.GetProductsByIDsRequest\x1a$.product.v1.GetProductsByIDsResponse\x12T\n" +
 	"\rUpdateProduct\x12 .product.v1.UpdateProductRequest\x1a!.product.v1.UpdateProductResponse\x12T\n" +
 	"\rDeleteProduct\x12 .product.v1.DeleteProductRequest\x1a!.product.v1.DeleteProductResponse\x12Q\n" +
 	"\fListProducts\x12\x1f.product.v1.ListProductsRequest\x1a .product.v1.ListProductsResponse\x12W\n" +
 	"\x0ePublishProduct\x12!.product.v1.PublishProductRequest\x1a\".product.v1.PublishProductResponse\x12N\n" +
 	"\vHideProduct\x12\x1e.product.v1.HideProductRequest\x1a\x1f.product.v1.HideProductResponse\x12]\n" +
-	"\x10UnpublishProduct\x12#.product.v1.UnpublishProductRequest\x1a$.product.v1.UnpublishProductResponse\x12H\n" +
-	"\tCreateSKU\x12\x1c.product.v1.CreateSKURequest\x1a\x1d.product.v1.CreateSKUResponse\x12?\n" +
-	"\x06GetSKU\x12\x19.product.v1.GetSKURequest\x1a\x1a.product.v1.GetSKUResponse\x12H\n" +
+	"\x10UnpublishProduct\x12#.product.v1.UnpublishProductRequest\x1a$.product.v1.UnpublishProductResponse\x12r\n" +
+	"\x17UpdateProductVisibility\x12*.product.v1.UpdateProductVisibilityRequest\x1a+.product.v1.UpdateProductVisibilityResponse\x12W\n" +
+	"\x0eImportProducts\x12!.product.v1.ImportProductsRequest\x1a\".product.v1.ImportProductsResponse\x12]\n" +
+	"\x10GetProductImport\x12#.product.v1.GetProductImportRequest\x1a$.product.v1.GetProductImportResponse\x12H\n" +
+	"\tCreateSKU\x12\x1c.product.v1.CreateSKURequest\x1a\x1d.product.v1.CreateSKUResponse\x12Q\n" +
+	"\fGenerateSKUs\x12\x1f.product.v1.GenerateSKUsRequest\x1a .product.v1.GenerateSKUsResponse\x12?\n" +
+	"\x06GetSKU\x12\x19.product.v1.GetSKURequest\x1a\x1a.product.v1.GetSKUResponse\x12Q\n" +
+	"\fGetSKUsByIDs\x12\x1f.product.v1.GetSKUsByIDsRequest\x1a .product.v1.GetSKUsByIDsResponse\x12H\n" +
 	"\tUpdateSKU\x12\x1c.product.v1.UpdateSKURequest\x1a\x1d.product.v1.UpdateSKUResponse\x12H\n" +
-	"\tDeleteSKU\x12\x1c.product.v1.DeleteSKURequest\x1a\x1d.product.v1.DeleteSKUResponse\x12W\n" +
+	"\tDeleteSKU\x12\x1c.product.v1.DeleteSKURequest\x1a\x1d.product.v1.DeleteSKUResponse\x12f\n" +
+	"\x13SchedulePriceChange\x12&.product.v1.SchedulePriceChangeRequest\x1a'.product.v1.SchedulePriceChangeResponse\x12Z\n" +
+	"\x0fGetPriceHistory\x12\".product.v1.GetPriceHistoryRequest\x1a#.product.v1.GetPriceHistoryResponse\x12u\n" +
+	"\x18CreateProductImageUpload\x12+.product.v1.CreateProductImageUploadRequest\x1a,.product.v1.CreateProductImageUploadResponse\x12{\n" +
+	"\x1aCompleteProductImageUpload\x12-.product.v1.CompleteProductImageUploadRequest\x1a..product.v1.CompleteProductImageUploadResponse\x12c\n" +
+	"\x12UpdateProductImage\x12%.product.v1.UpdateProductImageRequest\x1a&.product.v1.UpdateProductImageResponse\x12i\n" +
+	"\x14ReorderProductImages\x12'.product.v1.ReorderProductImagesRequest\x1a(.product.v1.ReorderProductImagesResponse\x12c\n" +
+	"\x12DeleteProductImage\x12%.product.v1.DeleteProductImageRequest\x1a&.product.v1.DeleteProductImageResponse\x12W\n" +
 	"\x0eCreateCategory\x12!.product.v1.CreateCategoryRequest\x1a\".product.v1.CreateCategoryResponse\x12N\n" +
 	"\vGetCategory\x12\x1e.product.v1.GetCategoryRequest\x1a\x1f.product.v1.GetCategoryResponse\x12W\n" +
-	"\x0eListCategories\x12!.product.v1.ListCategoriesRequest\x1a\".product.v1.ListCategoriesResponse\x12W\n" +
+	"\x0eListCategories\x12!.product.v1.ListCategoriesRequest\x1a\".product.v1.ListCategoriesResponse\x12Z\n" +
+	"\x0fGetCategoryTree\x12\".product.v1.GetCategoryTreeRequest\x1a#.product.v1.GetCategoryTreeResponse\x12W\n" +
 	"\x0eUpdateCategory\x12!.product.v1.UpdateCategoryRequest\x1a\".product.v1.UpdateCategoryResponse\x12W\n" +
-	"\x0eDeleteCategory\x12!.product.v1.DeleteCategoryRequest\x1a\".product.v1.DeleteCategoryResponseB\xb3\x01\n" +
+	"\x0eDeleteCategory\x12!.product.v1.DeleteCategoryRequest\x1a\".product.v1.DeleteCategoryResponse\x12x\n" +
+	"\x19CreateAttributeDefinition\x12,.product.v1.CreateAttributeDefinitionRequest\x1a-.product.v1.CreateAttributeDefinitionResponse\x12u\n" +
+	"\x18ListAttributeDefinitions\x12+.product.v1.ListAttributeDefinitionsRequest\x1a,.product.v1.ListAttributeDefinitionsResponse\x12x\n" +
+	"\x19UpdateAttributeDefinition\x12,.product.v1.UpdateAttributeDefinitionRequest\x1a-.product.v1.UpdateAttributeDefinitionResponse\x12x\n" +
+	"\x19DeleteAttributeDefinition\x12,.product.v1.DeleteAttributeDefinitionRequest\x1a-.product.v1.DeleteAttributeDefinitionResponseB\xb3\x01\n" +
 	"\x0ecom.product.v1B\x13ProductServiceProtoP\x01ZCgithub.com/daisuke8000/example-ec-platform/gen/product/v1;productv1\xa2\x02\x03PXX\xaa\x02\n" +
 	"Product.V1\xca\x02\n" +
 	"Product\\V1\xe2\x02\x16Product\\V1\\GPBMetadata\xea\x02\vProduct::V1b\x06proto3"
@@ -1852,109 +4367,228 @@ func file_product_v1_product_service_proto_rawDescGZIP() []byte {
 	return file_product_v1_product_service_proto_rawDescData
 }
 
-var file_product_v1_product_service_proto_msgTypes = make([]protoimpl.MessageInfo, 36)
+var file_product_v1_product_service_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_product_v1_product_service_proto_msgTypes = make([]protoimpl.MessageInfo, 78)
 var file_product_v1_product_service_proto_goTypes = []any{
-	(*CreateProductRequest)(nil),     // 0: product.v1.CreateProductRequest
-	(*CreateProductResponse)(nil),    // 1: product.v1.CreateProductResponse
-	(*GetProductRequest)(nil),        // 2: product.v1.GetProductRequest
-	(*GetProductResponse)(nil),       // 3: product.v1.GetProductResponse
-	(*UpdateProductRequest)(nil),     // 4: product.v1.UpdateProductRequest
-	(*UpdateProductResponse)(nil),    // 5: product.v1.UpdateProductResponse
-	(*DeleteProductRequest)(nil),     // 6: product.v1.DeleteProductRequest
-	(*DeleteProductResponse)(nil),    // 7: product.v1.DeleteProductResponse
-	(*ListProductsRequest)(nil),      // 8: product.v1.ListProductsRequest
-	(*ListProductsResponse)(nil),     // 9: product.v1.ListProductsResponse
-	(*PublishProductRequest)(nil),    // 10: product.v1.PublishProductRequest
-	(*PublishProductResponse)(nil),   // 11: product.v1.PublishProductResponse
-	(*HideProductRequest)(nil),       // 12: product.v1.HideProductRequest
-	(*HideProductResponse)(nil),      // 13: product.v1.HideProductResponse
-	(*UnpublishProductRequest)(nil),  // 14: product.v1.UnpublishProductRequest
-	(*UnpublishProductResponse)(nil), // 15: product.v1.UnpublishProductResponse
-	(*CreateSKURequest)(nil),         // 16: product.v1.CreateSKURequest
-	(*CreateSKUResponse)(nil),        // 17: product.v1.CreateSKUResponse
-	(*GetSKURequest)(nil),            // 18: product.v1.GetSKURequest
-	(*GetSKUResponse)(nil),           // 19: product.v1.GetSKUResponse
-	(*UpdateSKURequest)(nil),         // 20: product.v1.UpdateSKURequest
-	(*UpdateSKUResponse)(nil),        // 21: product.v1.UpdateSKUResponse
-	(*DeleteSKURequest)(nil),         // 22: product.v1.DeleteSKURequest
-	(*DeleteSKUResponse)(nil),        // 23: product.v1.DeleteSKUResponse
-	(*CreateCategoryRequest)(nil),    // 24: product.v1.CreateCategoryRequest
-	(*CreateCategoryResponse)(nil),   // 25: product.v1.CreateCategoryResponse
-	(*GetCategoryRequest)(nil),       // 26: product.v1.GetCategoryRequest
-	(*GetCategoryResponse)(nil),      // 27: product.v1.GetCategoryResponse
-	(*ListCategoriesRequest)(nil),    // 28: product.v1.ListCategoriesRequest
-	(*ListCategoriesResponse)(nil),   // 29: product.v1.ListCategoriesResponse
-	(*UpdateCategoryRequest)(nil),    // 30: product.v1.UpdateCategoryRequest
-	(*UpdateCategoryResponse)(nil),   // 31: product.v1.UpdateCategoryResponse
-	(*DeleteCategoryRequest)(nil),    // 32: product.v1.DeleteCategoryRequest
-	(*DeleteCategoryResponse)(nil),   // 33: product.v1.DeleteCategoryResponse
-	nil,                              // 34: product.v1.CreateSKURequest.AttributesEntry
-	nil,                              // 35: product.v1.UpdateSKURequest.AttributesEntry
-	(*Product)(nil),                  // 36: product.v1.Product
-	(ProductStatus)(0),               // 37: product.v1.ProductStatus
-	(*Money)(nil),                    // 38: product.v1.Money
-	(*SKU)(nil),                      // 39: product.v1.SKU
-	(*Category)(nil),                 // 40: product.v1.Category
+	(ImportFormat)(0),                          // 0: product.v1.ImportFormat
+	(*CreateProductRequest)(nil),               // 1: product.v1.CreateProductRequest
+	(*CreateProductResponse)(nil),              // 2: product.v1.CreateProductResponse
+	(*GetProductRequest)(nil),                  // 3: product.v1.GetProductRequest
+	(*GetProductResponse)(nil),                 // 4: product.v1.GetProductResponse
+	(*GetProductsByIDsRequest)(nil),            // 5: product.v1.GetProductsByIDsRequest
+	(*GetProductsByIDsResponse)(nil),           // 6: product.v1.GetProductsByIDsResponse
+	(*ProductLookup)(nil),                      // 7: product.v1.ProductLookup
+	(*UpdateProductRequest)(nil),               // 8: product.v1.UpdateProductRequest
+	(*UpdateProductResponse)(nil),              // 9: product.v1.UpdateProductResponse
+	(*DeleteProductRequest)(nil),               // 10: product.v1.DeleteProductRequest
+	(*DeleteProductResponse)(nil),              // 11: product.v1.DeleteProductResponse
+	(*ListProductsRequest)(nil),                // 12: product.v1.ListProductsRequest
+	(*ListProductsResponse)(nil),               // 13: product.v1.ListProductsResponse
+	(*PublishProductRequest)(nil),              // 14: product.v1.PublishProductRequest
+	(*PublishProductResponse)(nil),             // 15: product.v1.PublishProductResponse
+	(*HideProductRequest)(nil),                 // 16: product.v1.HideProductRequest
+	(*HideProductResponse)(nil),                // 17: product.v1.HideProductResponse
+	(*UnpublishProductRequest)(nil),            // 18: product.v1.UnpublishProductRequest
+	(*UnpublishProductResponse)(nil),           // 19: product.v1.UnpublishProductResponse
+	(*UpdateProductVisibilityRequest)(nil),     // 20: product.v1.UpdateProductVisibilityRequest
+	(*UpdateProductVisibilityResponse)(nil),    // 21: product.v1.UpdateProductVisibilityResponse
+	(*ImportProductsRequest)(nil),              // 22: product.v1.ImportProductsRequest
+	(*ImportProductsResponse)(nil),             // 23: product.v1.ImportProductsResponse
+	(*GetProductImportRequest)(nil),            // 24: product.v1.GetProductImportRequest
+	(*GetProductImportResponse)(nil),           // 25: product.v1.GetProductImportResponse
+	(*ProductImportReport)(nil),                // 26: product.v1.ProductImportReport
+	(*ProductImportRowError)(nil),              // 27: product.v1.ProductImportRowError
+	(*CreateSKURequest)(nil),                   // 28: product.v1.CreateSKURequest
+	(*CreateSKUResponse)(nil),                  // 29: product.v1.CreateSKUResponse
+	(*GenerateSKUsRequest)(nil),                // 30: product.v1.GenerateSKUsRequest
+	(*SKUDimension)(nil),                       // 31: product.v1.SKUDimension
+	(*SKUPriceRule)(nil),                       // 32: product.v1.SKUPriceRule
+	(*GenerateSKUsResponse)(nil),               // 33: product.v1.GenerateSKUsResponse
+	(*GetSKURequest)(nil),                      // 34: product.v1.GetSKURequest
+	(*GetSKUResponse)(nil),                     // 35: product.v1.GetSKUResponse
+	(*GetSKUsByIDsRequest)(nil),                // 36: product.v1.GetSKUsByIDsRequest
+	(*GetSKUsByIDsResponse)(nil),               // 37: product.v1.GetSKUsByIDsResponse
+	(*SKULookup)(nil),                          // 38: product.v1.SKULookup
+	(*UpdateSKURequest)(nil),                   // 39: product.v1.UpdateSKURequest
+	(*UpdateSKUResponse)(nil),                  // 40: product.v1.UpdateSKUResponse
+	(*DeleteSKURequest)(nil),                   // 41: product.v1.DeleteSKURequest
+	(*DeleteSKUResponse)(nil),                  // 42: product.v1.DeleteSKUResponse
+	(*SchedulePriceChangeRequest)(nil),         // 43: product.v1.SchedulePriceChangeRequest
+	(*SchedulePriceChangeResponse)(nil),        // 44: product.v1.SchedulePriceChangeResponse
+	(*GetPriceHistoryRequest)(nil),             // 45: product.v1.GetPriceHistoryRequest
+	(*GetPriceHistoryResponse)(nil),            // 46: product.v1.GetPriceHistoryResponse
+	(*CreateProductImageUploadRequest)(nil),    // 47: product.v1.CreateProductImageUploadRequest
+	(*CreateProductImageUploadResponse)(nil),   // 48: product.v1.CreateProductImageUploadResponse
+	(*CompleteProductImageUploadRequest)(nil),  // 49: product.v1.CompleteProductImageUploadRequest
+	(*CompleteProductImageUploadResponse)(nil), // 50: product.v1.CompleteProductImageUploadResponse
+	(*UpdateProductImageRequest)(nil),          // 51: product.v1.UpdateProductImageRequest
+	(*UpdateProductImageResponse)(nil),         // 52: product.v1.UpdateProductImageResponse
+	(*ReorderProductImagesRequest)(nil),        // 53: product.v1.ReorderProductImagesRequest
+	(*ReorderProductImagesResponse)(nil),       // 54: product.v1.ReorderProductImagesResponse
+	(*DeleteProductImageRequest)(nil),          // 55: product.v1.DeleteProductImageRequest
+	(*DeleteProductImageResponse)(nil),         // 56: product.v1.DeleteProductImageResponse
+	(*CreateCategoryRequest)(nil),              // 57: product.v1.CreateCategoryRequest
+	(*CreateCategoryResponse)(nil),             // 58: product.v1.CreateCategoryResponse
+	(*GetCategoryRequest)(nil),                 // 59: product.v1.GetCategoryRequest
+	(*GetCategoryResponse)(nil),                // 60: product.v1.GetCategoryResponse
+	(*ListCategoriesRequest)(nil),              // 61: product.v1.ListCategoriesRequest
+	(*ListCategoriesResponse)(nil),             // 62: product.v1.ListCategoriesResponse
+	(*GetCategoryTreeRequest)(nil),             // 63: product.v1.GetCategoryTreeRequest
+	(*GetCategoryTreeResponse)(nil),            // 64: product.v1.GetCategoryTreeResponse
+	(*UpdateCategoryRequest)(nil),              // 65: product.v1.UpdateCategoryRequest
+	(*UpdateCategoryResponse)(nil),             // 66: product.v1.UpdateCategoryResponse
+	(*DeleteCategoryRequest)(nil),              // 67: product.v1.DeleteCategoryRequest
+	(*DeleteCategoryResponse)(nil),             // 68: product.v1.DeleteCategoryResponse
+	(*CreateAttributeDefinitionRequest)(nil),   // 69: product.v1.CreateAttributeDefinitionRequest
+	(*CreateAttributeDefinitionResponse)(nil),  // 70: product.v1.CreateAttributeDefinitionResponse
+	(*ListAttributeDefinitionsRequest)(nil),    // 71: product.v1.ListAttributeDefinitionsRequest
+	(*ListAttributeDefinitionsResponse)(nil),   // 72: product.v1.ListAttributeDefinitionsResponse
+	(*UpdateAttributeDefinitionRequest)(nil),   // 73: product.v1.UpdateAttributeDefinitionRequest
+	(*UpdateAttributeDefinitionResponse)(nil),  // 74: product.v1.UpdateAttributeDefinitionResponse
+	(*DeleteAttributeDefinitionRequest)(nil),   // 75: product.v1.DeleteAttributeDefinitionRequest
+	(*DeleteAttributeDefinitionResponse)(nil),  // 76: product.v1.DeleteAttributeDefinitionResponse
+	nil,                           // 77: product.v1.CreateSKURequest.AttributesEntry
+	nil,                           // 78: product.v1.UpdateSKURequest.AttributesEntry
+	(*Product)(nil),               // 79: product.v1.Product
+	(ProductStatus)(0),            // 80: product.v1.ProductStatus
+	(*v1.Operation)(nil),          // 81: operations.v1.Operation
+	(*Money)(nil),                 // 82: product.v1.Money
+	(*SKU)(nil),                   // 83: product.v1.SKU
+	(*MoneyList)(nil),             // 84: product.v1.MoneyList
+	(*timestamppb.Timestamp)(nil), // 85: google.protobuf.Timestamp
+	(*PriceChange)(nil),           // 86: product.v1.PriceChange
+	(*ProductImage)(nil),          // 87: product.v1.ProductImage
+	(*Category)(nil),              // 88: product.v1.Category
+	(*CategoryTreeNode)(nil),      // 89: product.v1.CategoryTreeNode
+	(AttributeType)(0),            // 90: product.v1.AttributeType
+	(*AttributeDefinition)(nil),   // 91: product.v1.AttributeDefinition
 }
 var file_product_v1_product_service_proto_depIdxs = []int32{
-	36, // 0: product.v1.CreateProductResponse.product:type_name -> product.v1.Product
-	36, // 1: product.v1.GetProductResponse.product:type_name -> product.v1.Product
-	36, // 2: product.v1.UpdateProductResponse.product:type_name -> product.v1.Product
-	37, // 3: product.v1.ListProductsRequest.status:type_name -> product.v1.ProductStatus
-	36, // 4: product.v1.ListProductsResponse.products:type_name -> product.v1.Product
-	36, // 5: product.v1.PublishProductResponse.product:type_name -> product.v1.Product
-	36, // 6: product.v1.HideProductResponse.product:type_name -> product.v1.Product
-	36, // 7: product.v1.UnpublishProductResponse.product:type_name -> product.v1.Product
-	38, // 8: product.v1.CreateSKURequest.price:type_name -> product.v1.Money
-	34, // 9: product.v1.CreateSKURequest.attributes:type_name -> product.v1.CreateSKURequest.AttributesEntry
-	39, // 10: product.v1.CreateSKUResponse.sku:type_name -> product.v1.SKU
-	39, // 11: product.v1.GetSKUResponse.sku:type_name -> product.v1.SKU
-	38, // 12: product.v1.UpdateSKURequest.price:type_name -> product.v1.Money
-	35, // 13: product.v1.UpdateSKURequest.attributes:type_name -> product.v1.UpdateSKURequest.AttributesEntry
-	39, // 14: product.v1.UpdateSKUResponse.sku:type_name -> product.v1.SKU
-	40, // 15: product.v1.CreateCategoryResponse.category:type_name -> product.v1.Category
-	40, // 16: product.v1.GetCategoryResponse.category:type_name -> product.v1.Category
-	40, // 17: product.v1.ListCategoriesResponse.categories:type_name -> product.v1.Category
-	40, // 18: product.v1.UpdateCategoryResponse.category:type_name -> product.v1.Category
-	0,  // 19: product.v1.ProductService.CreateProduct:input_type -> product.v1.CreateProductRequest
-	2,  // 20: product.v1.ProductService.GetProduct:input_type -> product.v1.GetProductRequest
-	4,  // 21: product.v1.ProductService.UpdateProduct:input_type -> product.v1.UpdateProductRequest
-	6,  // 22: product.v1.ProductService.DeleteProduct:input_type -> product.v1.DeleteProductRequest
-	8,  // 23: product.v1.ProductService.ListProducts:input_type -> product.v1.ListProductsRequest
-	10, // 24: product.v1.ProductService.PublishProduct:input_type -> product.v1.PublishProductRequest
-	12, // 25: product.v1.ProductService.HideProduct:input_type -> product.v1.HideProductRequest
-	14, // 26: product.v1.ProductService.UnpublishProduct:input_type -> product.v1.UnpublishProductRequest
-	16, // 27: product.v1.ProductService.CreateSKU:input_type -> product.v1.CreateSKURequest
-	18, // 28: product.v1.ProductService.GetSKU:input_type -> product.v1.GetSKURequest
-	20, // 29: product.v1.ProductService.UpdateSKU:input_type -> product.v1.UpdateSKURequest
-	22, // 30: product.v1.ProductService.DeleteSKU:input_type -> product.v1.DeleteSKURequest
-	24, // 31: product.v1.ProductService.CreateCategory:input_type -> product.v1.CreateCategoryRequest
-	26, // 32: product.v1.ProductService.GetCategory:input_type -> product.v1.GetCategoryRequest
-	28, // 33: product.v1.ProductService.ListCategories:input_type -> product.v1.ListCategoriesRequest
-	30, // 34: product.v1.ProductService.UpdateCategory:input_type -> product.v1.UpdateCategoryRequest
-	32, // 35: product.v1.ProductService.DeleteCategory:input_type -> product.v1.DeleteCategoryRequest
-	1,  // 36: product.v1.ProductService.CreateProduct:output_type -> product.v1.CreateProductResponse
-	3,  // 37: product.v1.ProductService.GetProduct:output_type -> product.v1.GetProductResponse
-	5,  // 38: product.v1.ProductService.UpdateProduct:output_type -> product.v1.UpdateProductResponse
-	7,  // 39: product.v1.ProductService.DeleteProduct:output_type -> product.v1.DeleteProductResponse
-	9,  // 40: product.v1.ProductService.ListProducts:output_type -> product.v1.ListProductsResponse
-	11, // 41: product.v1.ProductService.PublishProduct:output_type -> product.v1.PublishProductResponse
-	13, // 42: product.v1.ProductService.HideProduct:output_type -> product.v1.HideProductResponse
-	15, // 43: product.v1.ProductService.UnpublishProduct:output_type -> product.v1.UnpublishProductResponse
-	17, // 44: product.v1.ProductService.CreateSKU:output_type -> product.v1.CreateSKUResponse
-	19, // 45: product.v1.ProductService.GetSKU:output_type -> product.v1.GetSKUResponse
-	21, // 46: product.v1.ProductService.UpdateSKU:output_type -> product.v1.UpdateSKUResponse
-	23, // 47: product.v1.ProductService.DeleteSKU:output_type -> product.v1.DeleteSKUResponse
-	25, // 48: product.v1.ProductService.CreateCategory:output_type -> product.v1.CreateCategoryResponse
-	27, // 49: product.v1.ProductService.GetCategory:output_type -> product.v1.GetCategoryResponse
-	29, // 50: product.v1.ProductService.ListCategories:output_type -> product.v1.ListCategoriesResponse
-	31, // 51: product.v1.ProductService.UpdateCategory:output_type -> product.v1.UpdateCategoryResponse
-	33, // 52: product.v1.ProductService.DeleteCategory:output_type -> product.v1.DeleteCategoryResponse
-	36, // [36:53] is the sub-list for method output_type
-	19, // [19:36] is the sub-list for method input_type
-	19, // [19:19] is the sub-list for extension type_name
-	19, // [19:19] is the sub-list for extension extendee
-	0,  // [0:19] is the sub-list for field type_name
+	79, // 0: product.v1.CreateProductResponse.product:type_name -> product.v1.Product
+	79, // 1: product.v1.GetProductResponse.product:type_name -> product.v1.Product
+	7,  // 2: product.v1.GetProductsByIDsResponse.results:type_name -> product.v1.ProductLookup
+	79, // 3: product.v1.ProductLookup.product:type_name -> product.v1.Product
+	79, // 4: product.v1.UpdateProductResponse.product:type_name -> product.v1.Product
+	80, // 5: product.v1.ListProductsRequest.status:type_name -> product.v1.ProductStatus
+	79, // 6: product.v1.ListProductsResponse.products:type_name -> product.v1.Product
+	79, // 7: product.v1.PublishProductResponse.product:type_name -> product.v1.Product
+	79, // 8: product.v1.HideProductResponse.product:type_name -> product.v1.Product
+	79, // 9: product.v1.UnpublishProductResponse.product:type_name -> product.v1.Product
+	79, // 10: product.v1.UpdateProductVisibilityResponse.product:type_name -> product.v1.Product
+	0,  // 11: product.v1.ImportProductsRequest.format:type_name -> product.v1.ImportFormat
+	81, // 12: product.v1.ImportProductsResponse.operation:type_name -> operations.v1.Operation
+	81, // 13: product.v1.GetProductImportResponse.operation:type_name -> operations.v1.Operation
+	26, // 14: product.v1.GetProductImportResponse.report:type_name -> product.v1.ProductImportReport
+	27, // 15: product.v1.ProductImportReport.errors:type_name -> product.v1.ProductImportRowError
+	82, // 16: product.v1.CreateSKURequest.price:type_name -> product.v1.Money
+	77, // 17: product.v1.CreateSKURequest.attributes:type_name -> product.v1.CreateSKURequest.AttributesEntry
+	82, // 18: product.v1.CreateSKURequest.additional_prices:type_name -> product.v1.Money
+	83, // 19: product.v1.CreateSKUResponse.sku:type_name -> product.v1.SKU
+	31, // 20: product.v1.GenerateSKUsRequest.dimensions:type_name -> product.v1.SKUDimension
+	82, // 21: product.v1.GenerateSKUsRequest.base_price:type_name -> product.v1.Money
+	32, // 22: product.v1.GenerateSKUsRequest.price_rules:type_name -> product.v1.SKUPriceRule
+	83, // 23: product.v1.GenerateSKUsResponse.skus:type_name -> product.v1.SKU
+	83, // 24: product.v1.GetSKUResponse.sku:type_name -> product.v1.SKU
+	38, // 25: product.v1.GetSKUsByIDsResponse.results:type_name -> product.v1.SKULookup
+	83, // 26: product.v1.SKULookup.sku:type_name -> product.v1.SKU
+	82, // 27: product.v1.UpdateSKURequest.price:type_name -> product.v1.Money
+	78, // 28: product.v1.UpdateSKURequest.attributes:type_name -> product.v1.UpdateSKURequest.AttributesEntry
+	84, // 29: product.v1.UpdateSKURequest.additional_prices:type_name -> product.v1.MoneyList
+	83, // 30: product.v1.UpdateSKUResponse.sku:type_name -> product.v1.SKU
+	82, // 31: product.v1.SchedulePriceChangeRequest.price:type_name -> product.v1.Money
+	85, // 32: product.v1.SchedulePriceChangeRequest.effective_from:type_name -> google.protobuf.Timestamp
+	86, // 33: product.v1.SchedulePriceChangeResponse.price_change:type_name -> product.v1.PriceChange
+	86, // 34: product.v1.GetPriceHistoryResponse.price_changes:type_name -> product.v1.PriceChange
+	87, // 35: product.v1.CreateProductImageUploadResponse.image:type_name -> product.v1.ProductImage
+	85, // 36: product.v1.CreateProductImageUploadResponse.upload_expires_at:type_name -> google.protobuf.Timestamp
+	87, // 37: product.v1.CompleteProductImageUploadResponse.image:type_name -> product.v1.ProductImage
+	87, // 38: product.v1.UpdateProductImageResponse.image:type_name -> product.v1.ProductImage
+	87, // 39: product.v1.ReorderProductImagesResponse.images:type_name -> product.v1.ProductImage
+	88, // 40: product.v1.CreateCategoryResponse.category:type_name -> product.v1.Category
+	88, // 41: product.v1.GetCategoryResponse.category:type_name -> product.v1.Category
+	88, // 42: product.v1.ListCategoriesResponse.categories:type_name -> product.v1.Category
+	89, // 43: product.v1.GetCategoryTreeResponse.nodes:type_name -> product.v1.CategoryTreeNode
+	88, // 44: product.v1.UpdateCategoryResponse.category:type_name -> product.v1.Category
+	90, // 45: product.v1.CreateAttributeDefinitionRequest.type:type_name -> product.v1.AttributeType
+	91, // 46: product.v1.CreateAttributeDefinitionResponse.attribute_definition:type_name -> product.v1.AttributeDefinition
+	91, // 47: product.v1.ListAttributeDefinitionsResponse.attribute_definitions:type_name -> product.v1.AttributeDefinition
+	90, // 48: product.v1.UpdateAttributeDefinitionRequest.type:type_name -> product.v1.AttributeType
+	91, // 49: product.v1.UpdateAttributeDefinitionResponse.attribute_definition:type_name -> product.v1.AttributeDefinition
+	1,  // 50: product.v1.ProductService.CreateProduct:input_type -> product.v1.CreateProductRequest
+	3,  // 51: product.v1.ProductService.GetProduct:input_type -> product.v1.GetProductRequest
+	5,  // 52: product.v1.ProductService.GetProductsByIDs:input_type -> product.v1.GetProductsByIDsRequest
+	8,  // 53: product.v1.ProductService.UpdateProduct:input_type -> product.v1.UpdateProductRequest
+	10, // 54: product.v1.ProductService.DeleteProduct:input_type -> product.v1.DeleteProductRequest
+	12, // 55: product.v1.ProductService.ListProducts:input_type -> product.v1.ListProductsRequest
+	14, // 56: product.v1.ProductService.PublishProduct:input_type -> product.v1.PublishProductRequest
+	16, // 57: product.v1.ProductService.HideProduct:input_type -> product.v1.HideProductRequest
+	18, // 58: product.v1.ProductService.UnpublishProduct:input_type -> product.v1.UnpublishProductRequest
+	20, // 59: product.v1.ProductService.UpdateProductVisibility:input_type -> product.v1.UpdateProductVisibilityRequest
+	22, // 60: product.v1.ProductService.ImportProducts:input_type -> product.v1.ImportProductsRequest
+	24, // 61: product.v1.ProductService.GetProductImport:input_type -> product.v1.GetProductImportRequest
+	28, // 62: product.v1.ProductService.CreateSKU:input_type -> product.v1.CreateSKURequest
+	30, // 63: product.v1.ProductService.GenerateSKUs:input_type -> product.v1.GenerateSKUsRequest
+	34, // 64: product.v1.ProductService.GetSKU:input_type -> product.v1.GetSKURequest
+	36, // 65: product.v1.ProductService.GetSKUsByIDs:input_type -> product.v1.GetSKUsByIDsRequest
+	39, // 66: product.v1.ProductService.UpdateSKU:input_type -> product.v1.UpdateSKURequest
+	41, // 67: product.v1.ProductService.DeleteSKU:input_type -> product.v1.DeleteSKURequest
+	43, // 68: product.v1.ProductService.SchedulePriceChange:input_type -> product.v1.SchedulePriceChangeRequest
+	45, // 69: product.v1.ProductService.GetPriceHistory:input_type -> product.v1.GetPriceHistoryRequest
+	47, // 70: product.v1.ProductService.CreateProductImageUpload:input_type -> product.v1.CreateProductImageUploadRequest
+	49, // 71: product.v1.ProductService.CompleteProductImageUpload:input_type -> product.v1.CompleteProductImageUploadRequest
+	51, // 72: product.v1.ProductService.UpdateProductImage:input_type -> product.v1.UpdateProductImageRequest
+	53, // 73: product.v1.ProductService.ReorderProductImages:input_type -> product.v1.ReorderProductImagesRequest
+	55, // 74: product.v1.ProductService.DeleteProductImage:input_type -> product.v1.DeleteProductImageRequest
+	57, // 75: product.v1.ProductService.CreateCategory:input_type -> product.v1.CreateCategoryRequest
+	59, // 76: product.v1.ProductService.GetCategory:input_type -> product.v1.GetCategoryRequest
+	61, // 77: product.v1.ProductService.ListCategories:input_type -> product.v1.ListCategoriesRequest
+	63, // 78: product.v1.ProductService.GetCategoryTree:input_type -> product.v1.GetCategoryTreeRequest
+	65, // 79: product.v1.ProductService.UpdateCategory:input_type -> product.v1.UpdateCategoryRequest
+	67, // 80: product.v1.ProductService.DeleteCategory:input_type -> product.v1.DeleteCategoryRequest
+	69, // 81: product.v1.ProductService.CreateAttributeDefinition:input_type -> product.v1.CreateAttributeDefinitionRequest
+	71, // 82: product.v1.ProductService.ListAttributeDefinitions:input_type -> product.v1.ListAttributeDefinitionsRequest
+	73, // 83: product.v1.ProductService.UpdateAttributeDefinition:input_type -> product.v1.UpdateAttributeDefinitionRequest
+	75, // 84: product.v1.ProductService.DeleteAttributeDefinition:input_type -> product.v1.DeleteAttributeDefinitionRequest
+	2,  // 85: product.v1.ProductService.CreateProduct:output_type -> product.v1.CreateProductResponse
+	4,  // 86: product.v1.ProductService.GetProduct:output_type -> product.v1.GetProductResponse
+	6,  // 87: product.v1.ProductService.GetProductsByIDs:output_type -> product.v1.GetProductsByIDsResponse
+	9,  // 88: product.v1.ProductService.UpdateProduct:output_type -> product.v1.UpdateProductResponse
+	11, // 89: product.v1.ProductService.DeleteProduct:output_type -> product.v1.DeleteProductResponse
+	13, // 90: product.v1.ProductService.ListProducts:output_type -> product.v1.ListProductsResponse
+	15, // 91: product.v1.ProductService.PublishProduct:output_type -> product.v1.PublishProductResponse
+	17, // 92: product.v1.ProductService.HideProduct:output_type -> product.v1.HideProductResponse
+	19, // 93: product.v1.ProductService.UnpublishProduct:output_type -> product.v1.UnpublishProductResponse
+	21, // 94: product.v1.ProductService.UpdateProductVisibility:output_type -> product.v1.UpdateProductVisibilityResponse
+	23, // 95: product.v1.ProductService.ImportProducts:output_type -> product.v1.ImportProductsResponse
+	25, // 96: product.v1.ProductService.GetProductImport:output_type -> product.v1.GetProductImportResponse
+	29, // 97: product.v1.ProductService.CreateSKU:output_type -> product.v1.CreateSKUResponse
+	33, // 98: product.v1.ProductService.GenerateSKUs:output_type -> product.v1.GenerateSKUsResponse
+	35, // 99: product.v1.ProductService.GetSKU:output_type -> product.v1.GetSKUResponse
+	37, // 100: product.v1.ProductService.GetSKUsByIDs:output_type -> product.v1.GetSKUsByIDsResponse
+	40, // 101: product.v1.ProductService.UpdateSKU:output_type -> product.v1.UpdateSKUResponse
+	42, // 102: product.v1.ProductService.DeleteSKU:output_type -> product.v1.DeleteSKUResponse
+	44, // 103: product.v1.ProductService.SchedulePriceChange:output_type -> product.v1.SchedulePriceChangeResponse
+	46, // 104: product.v1.ProductService.GetPriceHistory:output_type -> product.v1.GetPriceHistoryResponse
+	48, // 105: product.v1.ProductService.CreateProductImageUpload:output_type -> product.v1.CreateProductImageUploadResponse
+	50, // 106: product.v1.ProductService.CompleteProductImageUpload:output_type -> product.v1.CompleteProductImageUploadResponse
+	52, // 107: product.v1.ProductService.UpdateProductImage:output_type -> product.v1.UpdateProductImageResponse
+	54, // 108: product.v1.ProductService.ReorderProductImages:output_type -> product.v1.ReorderProductImagesResponse
+	56, // 109: product.v1.ProductService.DeleteProductImage:output_type -> product.v1.DeleteProductImageResponse
+	58, // 110: product.v1.ProductService.CreateCategory:output_type -> product.v1.CreateCategoryResponse
+	60, // 111: product.v1.ProductService.GetCategory:output_type -> product.v1.GetCategoryResponse
+	62, // 112: product.v1.ProductService.ListCategories:output_type -> product.v1.ListCategoriesResponse
+	64, // 113: product.v1.ProductService.GetCategoryTree:output_type -> product.v1.GetCategoryTreeResponse
+	66, // 114: product.v1.ProductService.UpdateCategory:output_type -> product.v1.UpdateCategoryResponse
+	68, // 115: product.v1.ProductService.DeleteCategory:output_type -> product.v1.DeleteCategoryResponse
+	70, // 116: product.v1.ProductService.CreateAttributeDefinition:output_type -> product.v1.CreateAttributeDefinitionResponse
+	72, // 117: product.v1.ProductService.ListAttributeDefinitions:output_type -> product.v1.ListAttributeDefinitionsResponse
+	74, // 118: product.v1.ProductService.UpdateAttributeDefinition:output_type -> product.v1.UpdateAttributeDefinitionResponse
+	76, // 119: product.v1.ProductService.DeleteAttributeDefinition:output_type -> product.v1.DeleteAttributeDefinitionResponse
+	85, // [85:120] is the sub-list for method output_type
+	50, // [50:85] is the sub-list for method input_type
+	50, // [50:50] is the sub-list for extension type_name
+	50, // [50:50] is the sub-list for extension extendee
+	0,  // [0:50] is the sub-list for field type_name
 }
 
 func init() { file_product_v1_product_service_proto_init() }
@@ -1964,23 +4598,26 @@ func file_product_v1_product_service_proto_init() {
 	}
 	file_product_v1_types_proto_init()
 	file_product_v1_product_service_proto_msgTypes[0].OneofWrappers = []any{}
-	file_product_v1_product_service_proto_msgTypes[4].OneofWrappers = []any{}
-	file_product_v1_product_service_proto_msgTypes[8].OneofWrappers = []any{}
-	file_product_v1_product_service_proto_msgTypes[20].OneofWrappers = []any{}
-	file_product_v1_product_service_proto_msgTypes[24].OneofWrappers = []any{}
-	file_product_v1_product_service_proto_msgTypes[30].OneofWrappers = []any{}
+	file_product_v1_product_service_proto_msgTypes[7].OneofWrappers = []any{}
+	file_product_v1_product_service_proto_msgTypes[11].OneofWrappers = []any{}
+	file_product_v1_product_service_proto_msgTypes[38].OneofWrappers = []any{}
+	file_product_v1_product_service_proto_msgTypes[50].OneofWrappers = []any{}
+	file_product_v1_product_service_proto_msgTypes[56].OneofWrappers = []any{}
+	file_product_v1_product_service_proto_msgTypes[62].OneofWrappers = []any{}
+	file_product_v1_product_service_proto_msgTypes[64].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_product_v1_product_service_proto_rawDesc), len(file_product_v1_product_service_proto_rawDesc)),
-			NumEnums:      0,
-			NumMessages:   36,
+			NumEnums:      1,
+			NumMessages:   78,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_product_v1_product_service_proto_goTypes,
 		DependencyIndexes: file_product_v1_product_service_proto_depIdxs,
+		EnumInfos:         file_product_v1_product_service_proto_enumTypes,
 		MessageInfos:      file_product_v1_product_service_proto_msgTypes,
 	}.Build()
 	File_product_v1_product_service_proto = out.File
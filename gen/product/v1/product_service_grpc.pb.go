@@ -24,23 +24,41 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	ProductService_CreateProduct_FullMethodName    = "/product.v1.ProductService/CreateProduct"
-	ProductService_GetProduct_FullMethodName       = "/product.v1.ProductService/GetProduct"
-	ProductService_UpdateProduct_FullMethodName    = "/product.v1.ProductService/UpdateProduct"
-	ProductService_DeleteProduct_FullMethodName    = "/product.v1.ProductService/DeleteProduct"
-	ProductService_ListProducts_FullMethodName     = "/product.v1.ProductService/ListProducts"
-	ProductService_PublishProduct_FullMethodName   = "/product.v1.ProductService/PublishProduct"
-	ProductService_HideProduct_FullMethodName      = "/product.v1.ProductService/HideProduct"
-	ProductService_UnpublishProduct_FullMethodName = "/product.v1.ProductService/UnpublishProduct"
-	ProductService_CreateSKU_FullMethodName        = "/product.v1.ProductService/CreateSKU"
-	ProductService_GetSKU_FullMethodName           = "/product.v1.ProductService/GetSKU"
-	ProductService_UpdateSKU_FullMethodName        = "/product.v1.ProductService/UpdateSKU"
-	ProductService_DeleteSKU_FullMethodName        = "/product.v1.ProductService/DeleteSKU"
-	ProductService_CreateCategory_FullMethodName   = "/product.v1.ProductService/CreateCategory"
-	ProductService_GetCategory_FullMethodName      = "/product.v1.ProductService/GetCategory"
-	ProductService_ListCategories_FullMethodName   = "/product.v1.ProductService/ListCategories"
-	ProductService_UpdateCategory_FullMethodName   = "/product.v1.ProductService/UpdateCategory"
-	ProductService_DeleteCategory_FullMethodName   = "/product.v1.ProductService/DeleteCategory"
+	ProductService_CreateProduct_FullMethodName              = "/product.v1.ProductService/CreateProduct"
+	ProductService_GetProduct_FullMethodName                 = "/product.v1.ProductService/GetProduct"
+	ProductService_GetProductsByIDs_FullMethodName           = "/product.v1.ProductService/GetProductsByIDs"
+	ProductService_UpdateProduct_FullMethodName              = "/product.v1.ProductService/UpdateProduct"
+	ProductService_DeleteProduct_FullMethodName              = "/product.v1.ProductService/DeleteProduct"
+	ProductService_ListProducts_FullMethodName               = "/product.v1.ProductService/ListProducts"
+	ProductService_PublishProduct_FullMethodName             = "/product.v1.ProductService/PublishProduct"
+	ProductService_HideProduct_FullMethodName                = "/product.v1.ProductService/HideProduct"
+	ProductService_UnpublishProduct_FullMethodName           = "/product.v1.ProductService/UnpublishProduct"
+	ProductService_UpdateProductVisibility_FullMethodName    = "/product.v1.ProductService/UpdateProductVisibility"
+	ProductService_ImportProducts_FullMethodName             = "/product.v1.ProductService/ImportProducts"
+	ProductService_GetProductImport_FullMethodName           = "/product.v1.ProductService/GetProductImport"
+	ProductService_CreateSKU_FullMethodName                  = "/product.v1.ProductService/CreateSKU"
+	ProductService_GenerateSKUs_FullMethodName               = "/product.v1.ProductService/GenerateSKUs"
+	ProductService_GetSKU_FullMethodName                     = "/product.v1.ProductService/GetSKU"
+	ProductService_GetSKUsByIDs_FullMethodName               = "/product.v1.ProductService/GetSKUsByIDs"
+	ProductService_UpdateSKU_FullMethodName                  = "/product.v1.ProductService/UpdateSKU"
+	ProductService_DeleteSKU_FullMethodName                  = "/product.v1.ProductService/DeleteSKU"
+	ProductService_SchedulePriceChange_FullMethodName        = "/product.v1.ProductService/SchedulePriceChange"
+	ProductService_GetPriceHistory_FullMethodName            = "/product.v1.ProductService/GetPriceHistory"
+	ProductService_CreateProductImageUpload_FullMethodName   = "/product.v1.ProductService/CreateProductImageUpload"
+	ProductService_CompleteProductImageUpload_FullMethodName = "/product.v1.ProductService/CompleteProductImageUpload"
+	ProductService_UpdateProductImage_FullMethodName         = "/product.v1.ProductService/UpdateProductImage"
+	ProductService_ReorderProductImages_FullMethodName       = "/product.v1.ProductService/ReorderProductImages"
+	ProductService_DeleteProductImage_FullMethodName         = "/product.v1.ProductService/DeleteProductImage"
+	ProductService_CreateCategory_FullMethodName             = "/product.v1.ProductService/CreateCategory"
+	ProductService_GetCategory_FullMethodName                = "/product.v1.ProductService/GetCategory"
+	ProductService_ListCategories_FullMethodName             = "/product.v1.ProductService/ListCategories"
+	ProductService_GetCategoryTree_FullMethodName            = "/product.v1.ProductService/GetCategoryTree"
+	ProductService_UpdateCategory_FullMethodName             = "/product.v1.ProductService/UpdateCategory"
+	ProductService_DeleteCategory_FullMethodName             = "/product.v1.ProductService/DeleteCategory"
+	ProductService_CreateAttributeDefinition_FullMethodName  = "/product.v1.ProductService/CreateAttributeDefinition"
+	ProductService_ListAttributeDefinitions_FullMethodName   = "/product.v1.ProductService/ListAttributeDefinitions"
+	ProductService_UpdateAttributeDefinition_FullMethodName  = "/product.v1.ProductService/UpdateAttributeDefinition"
+	ProductService_DeleteAttributeDefinition_FullMethodName  = "/product.v1.ProductService/DeleteAttributeDefinition"
 )
 
 // ProductServiceClient is the client API for ProductService service.
@@ -55,8 +73,14 @@ type ProductServiceClient interface {
 	// Returns PERMISSION_DENIED if caller lacks admin role.
 	CreateProduct(ctx context.Context, in *CreateProductRequest, opts ...grpc.CallOption) (*CreateProductResponse, error)
 	// GetProduct retrieves a product by ID.
-	// Returns NOT_FOUND if product doesn't exist or is soft-deleted.
+	// Returns NOT_FOUND if product doesn't exist, is soft-deleted, or is not
+	// visible in the caller's channel and market.
 	GetProduct(ctx context.Context, in *GetProductRequest, opts ...grpc.CallOption) (*GetProductResponse, error)
+	// GetProductsByIDs retrieves up to 100 products in one call (without SKUs).
+	// Missing or soft-deleted products are reported per ID with found = false
+	// instead of failing the whole request.
+	// Returns INVALID_ARGUMENT if ids is empty, exceeds the limit, or contains a malformed ID.
+	GetProductsByIDs(ctx context.Context, in *GetProductsByIDsRequest, opts ...grpc.CallOption) (*GetProductsByIDsResponse, error)
 	// UpdateProduct modifies an existing product.
 	// Returns NOT_FOUND if product doesn't exist.
 	// Returns PERMISSION_DENIED if caller lacks admin role.
@@ -67,7 +91,8 @@ type ProductServiceClient interface {
 	// Returns PERMISSION_DENIED if caller lacks admin role.
 	DeleteProduct(ctx context.Context, in *DeleteProductRequest, opts ...grpc.CallOption) (*DeleteProductResponse, error)
 	// ListProducts returns a paginated list of products with optional filtering.
-	// Only returns PUBLISHED products for public queries.
+	// Only returns PUBLISHED products for public queries, and only products
+	// visible in the caller's channel and market (x-channel / x-market).
 	ListProducts(ctx context.Context, in *ListProductsRequest, opts ...grpc.CallOption) (*ListProductsResponse, error)
 	// PublishProduct changes status from DRAFT or HIDDEN to PUBLISHED.
 	// Returns FAILED_PRECONDITION if current status doesn't allow transition.
@@ -78,20 +103,93 @@ type ProductServiceClient interface {
 	// UnpublishProduct changes status back to DRAFT.
 	// Returns FAILED_PRECONDITION if current status doesn't allow transition.
 	UnpublishProduct(ctx context.Context, in *UnpublishProductRequest, opts ...grpc.CallOption) (*UnpublishProductResponse, error)
+	// UpdateProductVisibility restricts the sales channels and markets in which
+	// public reads return the product. Empty lists remove the restriction.
+	// Returns NOT_FOUND if product doesn't exist.
+	// Returns INVALID_ARGUMENT for an unknown channel or malformed market code.
+	UpdateProductVisibility(ctx context.Context, in *UpdateProductVisibilityRequest, opts ...grpc.CallOption) (*UpdateProductVisibilityResponse, error)
+	// ImportProducts creates products with their SKUs and initial inventory
+	// from a CSV or NDJSON payload of up to 32 MiB. The payload is parsed
+	// immediately; products are then validated and written in the background
+	// in transactions of 100, skipping invalid products. Poll
+	// GetProductImport (or OperationsService.GetOperation) for progress and
+	// the per-row results.
+	// Returns INVALID_ARGUMENT if the format is unknown, the payload is too
+	// large or empty, or the CSV header or syntax is broken.
+	ImportProducts(ctx context.Context, in *ImportProductsRequest, opts ...grpc.CallOption) (*ImportProductsResponse, error)
+	// GetProductImport returns the state of an import and, once it has
+	// succeeded, its per-row results.
+	// Returns NOT_FOUND if the operation doesn't exist or is not an import.
+	GetProductImport(ctx context.Context, in *GetProductImportRequest, opts ...grpc.CallOption) (*GetProductImportResponse, error)
 	// CreateSKU adds a new variant to an existing product.
 	// Returns NOT_FOUND if parent product doesn't exist.
 	// Returns ALREADY_EXISTS if SKU code is already in use.
+	// Returns INVALID_ARGUMENT if attributes don't match the attribute
+	// definitions of the product's category.
 	CreateSKU(ctx context.Context, in *CreateSKURequest, opts ...grpc.CallOption) (*CreateSKUResponse, error)
+	// GenerateSKUs creates a SKU for every combination of the values of the
+	// given attribute dimensions (e.g. color × size) in one transaction.
+	// Combinations that an existing SKU of the product already has are
+	// skipped, so a dimension can be extended and generated again.
+	// Returns NOT_FOUND if parent product doesn't exist.
+	// Returns ALREADY_EXISTS if a generated SKU code is already in use; no
+	// SKU is created.
+	// Returns INVALID_ARGUMENT if the dimensions or price rules are invalid,
+	// there are more than 500 combinations, or a combination doesn't match
+	// the attribute definitions of the product's category.
+	GenerateSKUs(ctx context.Context, in *GenerateSKUsRequest, opts ...grpc.CallOption) (*GenerateSKUsResponse, error)
 	// GetSKU retrieves a SKU by ID including inventory information.
 	// Returns NOT_FOUND if SKU doesn't exist or is soft-deleted.
 	GetSKU(ctx context.Context, in *GetSKURequest, opts ...grpc.CallOption) (*GetSKUResponse, error)
+	// GetSKUsByIDs retrieves up to 100 SKUs with inventory in one call.
+	// Missing or soft-deleted SKUs are reported per ID with found = false
+	// instead of failing the whole request.
+	// Returns INVALID_ARGUMENT if ids is empty, exceeds the limit, or contains a malformed ID.
+	GetSKUsByIDs(ctx context.Context, in *GetSKUsByIDsRequest, opts ...grpc.CallOption) (*GetSKUsByIDsResponse, error)
 	// UpdateSKU modifies an existing SKU.
 	// Returns NOT_FOUND if SKU doesn't exist.
+	// Returns INVALID_ARGUMENT if attributes are set and don't match the
+	// attribute definitions of the product's category.
 	UpdateSKU(ctx context.Context, in *UpdateSKURequest, opts ...grpc.CallOption) (*UpdateSKUResponse, error)
 	// DeleteSKU performs soft deletion of a SKU.
 	// Returns NOT_FOUND if SKU doesn't exist.
 	// Returns FAILED_PRECONDITION if SKU has pending reservations.
 	DeleteSKU(ctx context.Context, in *DeleteSKURequest, opts ...grpc.CallOption) (*DeleteSKUResponse, error)
+	// SchedulePriceChange sets a SKU's price in one currency at a future time.
+	// A price in the base currency replaces the base price; any other currency
+	// adds or replaces an additional price.
+	// Returns NOT_FOUND if SKU doesn't exist.
+	// Returns INVALID_ARGUMENT if effective_from is not in the future.
+	SchedulePriceChange(ctx context.Context, in *SchedulePriceChangeRequest, opts ...grpc.CallOption) (*SchedulePriceChangeResponse, error)
+	// GetPriceHistory lists a SKU's applied and scheduled price changes,
+	// newest effective_from first.
+	// Returns NOT_FOUND if SKU doesn't exist.
+	GetPriceHistory(ctx context.Context, in *GetPriceHistoryRequest, opts ...grpc.CallOption) (*GetPriceHistoryResponse, error)
+	// CreateProductImageUpload registers a pending image and returns a
+	// presigned URL to PUT the file to, with the given content type, before
+	// upload_expires_at. The image is shown once CompleteProductImageUpload
+	// has been called.
+	// Returns NOT_FOUND if product doesn't exist.
+	// Returns INVALID_ARGUMENT if content_type is not a supported image type.
+	// Returns FAILED_PRECONDITION if the product already has 20 images.
+	// Returns UNIMPLEMENTED if image storage is not configured.
+	CreateProductImageUpload(ctx context.Context, in *CreateProductImageUploadRequest, opts ...grpc.CallOption) (*CreateProductImageUploadResponse, error)
+	// CompleteProductImageUpload verifies the uploaded file and adds the image
+	// after the product's other images.
+	// Returns NOT_FOUND if image doesn't exist.
+	// Returns FAILED_PRECONDITION if the file has not been uploaded or the
+	// upload is already complete.
+	// Returns INVALID_ARGUMENT if the file exceeds 10 MiB; the image is deleted.
+	CompleteProductImageUpload(ctx context.Context, in *CompleteProductImageUploadRequest, opts ...grpc.CallOption) (*CompleteProductImageUploadResponse, error)
+	// UpdateProductImage modifies an image's alt text.
+	// Returns NOT_FOUND if image doesn't exist.
+	UpdateProductImage(ctx context.Context, in *UpdateProductImageRequest, opts ...grpc.CallOption) (*UpdateProductImageResponse, error)
+	// ReorderProductImages sets the display order of a product's images.
+	// Returns INVALID_ARGUMENT unless image_ids lists every image exactly once.
+	ReorderProductImages(ctx context.Context, in *ReorderProductImagesRequest, opts ...grpc.CallOption) (*ReorderProductImagesResponse, error)
+	// DeleteProductImage deletes an image and its file.
+	// Returns NOT_FOUND if image doesn't exist.
+	DeleteProductImage(ctx context.Context, in *DeleteProductImageRequest, opts ...grpc.CallOption) (*DeleteProductImageResponse, error)
 	// CreateCategory creates a new category.
 	// Returns ALREADY_EXISTS if category name already exists under same parent.
 	CreateCategory(ctx context.Context, in *CreateCategoryRequest, opts ...grpc.CallOption) (*CreateCategoryResponse, error)
@@ -100,6 +198,10 @@ type ProductServiceClient interface {
 	GetCategory(ctx context.Context, in *GetCategoryRequest, opts ...grpc.CallOption) (*GetCategoryResponse, error)
 	// ListCategories returns the full category tree structure.
 	ListCategories(ctx context.Context, in *ListCategoriesRequest, opts ...grpc.CallOption) (*ListCategoriesResponse, error)
+	// GetCategoryTree returns categories nested to max_depth with the number
+	// of published products per category and per subtree.
+	// Returns NOT_FOUND if root_id is set and the category doesn't exist.
+	GetCategoryTree(ctx context.Context, in *GetCategoryTreeRequest, opts ...grpc.CallOption) (*GetCategoryTreeResponse, error)
 	// UpdateCategory modifies an existing category.
 	// Returns NOT_FOUND if category doesn't exist.
 	// Returns FAILED_PRECONDITION if update would create a cycle.
@@ -107,6 +209,24 @@ type ProductServiceClient interface {
 	// DeleteCategory performs soft deletion of a category.
 	// Returns FAILED_PRECONDITION if category contains products.
 	DeleteCategory(ctx context.Context, in *DeleteCategoryRequest, opts ...grpc.CallOption) (*DeleteCategoryResponse, error)
+	// CreateAttributeDefinition defines a SKU attribute for the products of a
+	// category. Existing SKUs are not revalidated.
+	// Returns NOT_FOUND if category doesn't exist.
+	// Returns ALREADY_EXISTS if the category already defines the name.
+	// Returns INVALID_ARGUMENT if name, type or allowed_values are invalid.
+	CreateAttributeDefinition(ctx context.Context, in *CreateAttributeDefinitionRequest, opts ...grpc.CallOption) (*CreateAttributeDefinitionResponse, error)
+	// ListAttributeDefinitions returns the attribute definitions of a category
+	// ordered by name.
+	// Returns NOT_FOUND if category doesn't exist.
+	ListAttributeDefinitions(ctx context.Context, in *ListAttributeDefinitionsRequest, opts ...grpc.CallOption) (*ListAttributeDefinitionsResponse, error)
+	// UpdateAttributeDefinition replaces the type, allowed values and required
+	// flag of a definition. Existing SKUs are not revalidated.
+	// Returns NOT_FOUND if definition doesn't exist.
+	UpdateAttributeDefinition(ctx context.Context, in *UpdateAttributeDefinitionRequest, opts ...grpc.CallOption) (*UpdateAttributeDefinitionResponse, error)
+	// DeleteAttributeDefinition removes a definition; SKU attributes of that
+	// name become free-form again.
+	// Returns NOT_FOUND if definition doesn't exist.
+	DeleteAttributeDefinition(ctx context.Context, in *DeleteAttributeDefinitionRequest, opts ...grpc.CallOption) (*DeleteAttributeDefinitionResponse, error)
 }
 
 type productServiceClient struct {
@@ -137,6 +257,16 @@ func (c *productServiceClient) GetProduct(ctx context.Context, in *GetProductReq
 	return out, nil
 }
 
+func (c *productServiceClient) GetProductsByIDs(ctx context.Context, in *GetProductsByIDsRequest, opts ...grpc.CallOption) (*GetProductsByIDsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetProductsByIDsResponse)
+	err := c.cc.Invoke(ctx, ProductService_GetProductsByIDs_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *productServiceClient) UpdateProduct(ctx context.Context, in *UpdateProductRequest, opts ...grpc.CallOption) (*UpdateProductResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(UpdateProductResponse)
@@ -197,6 +327,36 @@ func (c *productServiceClient) UnpublishProduct(ctx context.Context, in *Unpubli
 	return out, nil
 }
 
+func (c *productServiceClient) UpdateProductVisibility(ctx context.Context, in *UpdateProductVisibilityRequest, opts ...grpc.CallOption) (*UpdateProductVisibilityResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateProductVisibilityResponse)
+	err := c.cc.Invoke(ctx, ProductService_UpdateProductVisibility_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) ImportProducts(ctx context.Context, in *ImportProductsRequest, opts ...grpc.CallOption) (*ImportProductsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ImportProductsResponse)
+	err := c.cc.Invoke(ctx, ProductService_ImportProducts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) GetProductImport(ctx context.Context, in *GetProductImportRequest, opts ...grpc.CallOption) (*GetProductImportResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetProductImportResponse)
+	err := c.cc.Invoke(ctx, ProductService_GetProductImport_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *productServiceClient) CreateSKU(ctx context.Context, in *CreateSKURequest, opts ...grpc.CallOption) (*CreateSKUResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(CreateSKUResponse)
@@ -207,6 +367,16 @@ func (c *productServiceClient) CreateSKU(ctx context.Context, in *CreateSKUReque
 	return out, nil
 }
 
+func (c *productServiceClient) GenerateSKUs(ctx context.Context, in *GenerateSKUsRequest, opts ...grpc.CallOption) (*GenerateSKUsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GenerateSKUsResponse)
+	err := c.cc.Invoke(ctx, ProductService_GenerateSKUs_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *productServiceClient) GetSKU(ctx context.Context, in *GetSKURequest, opts ...grpc.CallOption) (*GetSKUResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(GetSKUResponse)
@@ -217,6 +387,16 @@ func (c *productServiceClient) GetSKU(ctx context.Context, in *GetSKURequest, op
 	return out, nil
 }
 
+func (c *productServiceClient) GetSKUsByIDs(ctx context.Context, in *GetSKUsByIDsRequest, opts ...grpc.CallOption) (*GetSKUsByIDsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetSKUsByIDsResponse)
+	err := c.cc.Invoke(ctx, ProductService_GetSKUsByIDs_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *productServiceClient) UpdateSKU(ctx context.Context, in *UpdateSKURequest, opts ...grpc.CallOption) (*UpdateSKUResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(UpdateSKUResponse)
@@ -237,6 +417,76 @@ func (c *productServiceClient) DeleteSKU(ctx context.Context, in *DeleteSKUReque
 	return out, nil
 }
 
+func (c *productServiceClient) SchedulePriceChange(ctx context.Context, in *SchedulePriceChangeRequest, opts ...grpc.CallOption) (*SchedulePriceChangeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SchedulePriceChangeResponse)
+	err := c.cc.Invoke(ctx, ProductService_SchedulePriceChange_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) GetPriceHistory(ctx context.Context, in *GetPriceHistoryRequest, opts ...grpc.CallOption) (*GetPriceHistoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetPriceHistoryResponse)
+	err := c.cc.Invoke(ctx, ProductService_GetPriceHistory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) CreateProductImageUpload(ctx context.Context, in *CreateProductImageUploadRequest, opts ...grpc.CallOption) (*CreateProductImageUploadResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateProductImageUploadResponse)
+	err := c.cc.Invoke(ctx, ProductService_CreateProductImageUpload_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) CompleteProductImageUpload(ctx context.Context, in *CompleteProductImageUploadRequest, opts ...grpc.CallOption) (*CompleteProductImageUploadResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CompleteProductImageUploadResponse)
+	err := c.cc.Invoke(ctx, ProductService_CompleteProductImageUpload_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) UpdateProductImage(ctx context.Context, in *UpdateProductImageRequest, opts ...grpc.CallOption) (*UpdateProductImageResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateProductImageResponse)
+	err := c.cc.Invoke(ctx, ProductService_UpdateProductImage_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) ReorderProductImages(ctx context.Context, in *ReorderProductImagesRequest, opts ...grpc.CallOption) (*ReorderProductImagesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReorderProductImagesResponse)
+	err := c.cc.Invoke(ctx, ProductService_ReorderProductImages_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) DeleteProductImage(ctx context.Context, in *DeleteProductImageRequest, opts ...grpc.CallOption) (*DeleteProductImageResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteProductImageResponse)
+	err := c.cc.Invoke(ctx, ProductService_DeleteProductImage_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *productServiceClient) CreateCategory(ctx context.Context, in *CreateCategoryRequest, opts ...grpc.CallOption) (*CreateCategoryResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(CreateCategoryResponse)
@@ -267,6 +517,16 @@ func (c *productServiceClient) ListCategories(ctx context.Context, in *ListCateg
 	return out, nil
 }
 
+func (c *productServiceClient) GetCategoryTree(ctx context.Context, in *GetCategoryTreeRequest, opts ...grpc.CallOption) (*GetCategoryTreeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetCategoryTreeResponse)
+	err := c.cc.Invoke(ctx, ProductService_GetCategoryTree_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *productServiceClient) UpdateCategory(ctx context.Context, in *UpdateCategoryRequest, opts ...grpc.CallOption) (*UpdateCategoryResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(UpdateCategoryResponse)
@@ -287,6 +547,46 @@ func (c *productServiceClient) DeleteCategory(ctx context.Context, in *DeleteCat
 	return out, nil
 }
 
+func (c *productServiceClient) CreateAttributeDefinition(ctx context.Context, in *CreateAttributeDefinitionRequest, opts ...grpc.CallOption) (*CreateAttributeDefinitionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateAttributeDefinitionResponse)
+	err := c.cc.Invoke(ctx, ProductService_CreateAttributeDefinition_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) ListAttributeDefinitions(ctx context.Context, in *ListAttributeDefinitionsRequest, opts ...grpc.CallOption) (*ListAttributeDefinitionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListAttributeDefinitionsResponse)
+	err := c.cc.Invoke(ctx, ProductService_ListAttributeDefinitions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) UpdateAttributeDefinition(ctx context.Context, in *UpdateAttributeDefinitionRequest, opts ...grpc.CallOption) (*UpdateAttributeDefinitionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateAttributeDefinitionResponse)
+	err := c.cc.Invoke(ctx, ProductService_UpdateAttributeDefinition_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) DeleteAttributeDefinition(ctx context.Context, in *DeleteAttributeDefinitionRequest, opts ...grpc.CallOption) (*DeleteAttributeDefinitionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteAttributeDefinitionResponse)
+	err := c.cc.Invoke(ctx, ProductService_DeleteAttributeDefinition_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ProductServiceServer is the server API for ProductService service.
 // All implementations must embed UnimplementedProductServiceServer
 // for forward compatibility.
@@ -299,8 +599,14 @@ type ProductServiceServer interface {
 	// Returns PERMISSION_DENIED if caller lacks admin role.
 	CreateProduct(context.Context, *CreateProductRequest) (*CreateProductResponse, error)
 	// GetProduct retrieves a product by ID.
-	// Returns NOT_FOUND if product doesn't exist or is soft-deleted.
+	// Returns NOT_FOUND if product doesn't exist, is soft-deleted, or is not
+	// visible in the caller's channel and market.
 	GetProduct(context.Context, *GetProductRequest) (*GetProductResponse, error)
+	// GetProductsByIDs retrieves up to 100 products in one call (without SKUs).
+	// Missing or soft-deleted products are reported per ID with found = false
+	// instead of failing the whole request.
+	// Returns INVALID_ARGUMENT if ids is empty, exceeds the limit, or contains a malformed ID.
+	GetProductsByIDs(context.Context, *GetProductsByIDsRequest) (*GetProductsByIDsResponse, error)
 	// UpdateProduct modifies an existing product.
 	// Returns NOT_FOUND if product doesn't exist.
 	// Returns PERMISSION_DENIED if caller lacks admin role.
@@ -311,7 +617,8 @@ type ProductServiceServer interface {
 	// Returns PERMISSION_DENIED if caller lacks admin role.
 	DeleteProduct(context.Context, *DeleteProductRequest) (*DeleteProductResponse, error)
 	// ListProducts returns a paginated list of products with optional filtering.
-	// Only returns PUBLISHED products for public queries.
+	// Only returns PUBLISHED products for public queries, and only products
+	// visible in the caller's channel and market (x-channel / x-market).
 	ListProducts(context.Context, *ListProductsRequest) (*ListProductsResponse, error)
 	// PublishProduct changes status from DRAFT or HIDDEN to PUBLISHED.
 	// Returns FAILED_PRECONDITION if current status doesn't allow transition.
@@ -322,20 +629,93 @@ type ProductServiceServer interface {
 	// UnpublishProduct changes status back to DRAFT.
 	// Returns FAILED_PRECONDITION if current status doesn't allow transition.
 	UnpublishProduct(context.Context, *UnpublishProductRequest) (*UnpublishProductResponse, error)
+	// UpdateProductVisibility restricts the sales channels and markets in which
+	// public reads return the product. Empty lists remove the restriction.
+	// Returns NOT_FOUND if product doesn't exist.
+	// Returns INVALID_ARGUMENT for an unknown channel or malformed market code.
+	UpdateProductVisibility(context.Context, *UpdateProductVisibilityRequest) (*UpdateProductVisibilityResponse, error)
+	// ImportProducts creates products with their SKUs and initial inventory
+	// from a CSV or NDJSON payload of up to 32 MiB. The payload is parsed
+	// immediately; products are then validated and written in the background
+	// in transactions of 100, skipping invalid products. Poll
+	// GetProductImport (or OperationsService.GetOperation) for progress and
+	// the per-row results.
+	// Returns INVALID_ARGUMENT if the format is unknown, the payload is too
+	// large or empty, or the CSV header or syntax is broken.
+	ImportProducts(context.Context, *ImportProductsRequest) (*ImportProductsResponse, error)
+	// GetProductImport returns the state of an import and, once it has
+	// succeeded, its per-row results.
+	// Returns NOT_FOUND if the operation doesn't exist or is not an import.
+	GetProductImport(context.Context, *GetProductImportRequest) (*GetProductImportResponse, error)
 	// CreateSKU adds a new variant to an existing product.
 	// Returns NOT_FOUND if parent product doesn't exist.
 	// Returns ALREADY_EXISTS if SKU code is already in use.
+	// Returns INVALID_ARGUMENT if attributes don't match the attribute
+	// definitions of the product's category.
 	CreateSKU(context.Context, *CreateSKURequest) (*CreateSKUResponse, error)
+	// GenerateSKUs creates a SKU for every combination of the values of the
+	// given attribute dimensions (e.g. color × size) in one transaction.
+	// Combinations that an existing SKU of the product already has are
+	// skipped, so a dimension can be extended and generated again.
+	// Returns NOT_FOUND if parent product doesn't exist.
+	// Returns ALREADY_EXISTS if a generated SKU code is already in use; no
+	// SKU is created.
+	// Returns INVALID_ARGUMENT if the dimensions or price rules are invalid,
+	// there are more than 500 combinations, or a combination doesn't match
+	// the attribute definitions of the product's category.
+	GenerateSKUs(context.Context, *GenerateSKUsRequest) (*GenerateSKUsResponse, error)
 	// GetSKU retrieves a SKU by ID including inventory information.
 	// Returns NOT_FOUND if SKU doesn't exist or is soft-deleted.
 	GetSKU(context.Context, *GetSKURequest) (*GetSKUResponse, error)
+	// GetSKUsByIDs retrieves up to 100 SKUs with inventory in one call.
+	// Missing or soft-deleted SKUs are reported per ID with found = false
+	// instead of failing the whole request.
+	// Returns INVALID_ARGUMENT if ids is empty, exceeds the limit, or contains a malformed ID.
+	GetSKUsByIDs(context.Context, *GetSKUsByIDsRequest) (*GetSKUsByIDsResponse, error)
 	// UpdateSKU modifies an existing SKU.
 	// Returns NOT_FOUND if SKU doesn't exist.
+	// Returns INVALID_ARGUMENT if attributes are set and don't match the
+	// attribute definitions of the product's category.
 	UpdateSKU(context.Context, *UpdateSKURequest) (*UpdateSKUResponse, error)
 	// DeleteSKU performs soft deletion of a SKU.
 	// Returns NOT_FOUND if SKU doesn't exist.
 	// Returns FAILED_PRECONDITION if SKU has pending reservations.
 	DeleteSKU(context.Context, *DeleteSKURequest) (*DeleteSKUResponse, error)
+	// SchedulePriceChange sets a SKU's price in one currency at a future time.
+	// A price in the base currency replaces the base price; any other currency
+	// adds or replaces an additional price.
+	// Returns NOT_FOUND if SKU doesn't exist.
+	// Returns INVALID_ARGUMENT if effective_from is not in the future.
+	SchedulePriceChange(context.Context, *SchedulePriceChangeRequest) (*SchedulePriceChangeResponse, error)
+	// GetPriceHistory lists a SKU's applied and scheduled price changes,
+	// newest effective_from first.
+	// Returns NOT_FOUND if SKU doesn't exist.
+	GetPriceHistory(context.Context, *GetPriceHistoryRequest) (*GetPriceHistoryResponse, error)
+	// CreateProductImageUpload registers a pending image and returns a
+	// presigned URL to PUT the file to, with the given content type, before
+	// upload_expires_at. The image is shown once CompleteProductImageUpload
+	// has been called.
+	// Returns NOT_FOUND if product doesn't exist.
+	// Returns INVALID_ARGUMENT if content_type is not a supported image type.
+	// Returns FAILED_PRECONDITION if the product already has 20 images.
+	// Returns UNIMPLEMENTED if image storage is not configured.
+	CreateProductImageUpload(context.Context, *CreateProductImageUploadRequest) (*CreateProductImageUploadResponse, error)
+	// CompleteProductImageUpload verifies the uploaded file and adds the image
+	// after the product's other images.
+	// Returns NOT_FOUND if image doesn't exist.
+	// Returns FAILED_PRECONDITION if the file has not been uploaded or the
+	// upload is already complete.
+	// Returns INVALID_ARGUMENT if the file exceeds 10 MiB; the image is deleted.
+	CompleteProductImageUpload(context.Context, *CompleteProductImageUploadRequest) (*CompleteProductImageUploadResponse, error)
+	// UpdateProductImage modifies an image's alt text.
+	// Returns NOT_FOUND if image doesn't exist.
+	UpdateProductImage(context.Context, *UpdateProductImageRequest) (*UpdateProductImageResponse, error)
+	// ReorderProductImages sets the display order of a product's images.
+	// Returns INVALID_ARGUMENT unless image_ids lists every image exactly once.
+	ReorderProductImages(context.Context, *ReorderProductImagesRequest) (*ReorderProductImagesResponse, error)
+	// DeleteProductImage deletes an image and its file.
+	// Returns NOT_FOUND if image doesn't exist.
+	DeleteProductImage(context.Context, *DeleteProductImageRequest) (*DeleteProductImageResponse, error)
 	// CreateCategory creates a new category.
 	// Returns ALREADY_EXISTS if category name already exists under same parent.
 	CreateCategory(context.Context, *CreateCategoryRequest) (*CreateCategoryResponse, error)
@@ -344,6 +724,10 @@ type ProductServiceServer interface {
 	GetCategory(context.Context, *GetCategoryRequest) (*GetCategoryResponse, error)
 	// ListCategories returns the full category tree structure.
 	ListCategories(context.Context, *ListCategoriesRequest) (*ListCategoriesResponse, error)
+	// GetCategoryTree returns categories nested to max_depth with the number
+	// of published products per category and per subtree.
+	// Returns NOT_FOUND if root_id is set and the category doesn't exist.
+	GetCategoryTree(context.Context, *GetCategoryTreeRequest) (*GetCategoryTreeResponse, error)
 	// UpdateCategory modifies an existing category.
 	// Returns NOT_FOUND if category doesn't exist.
 	// Returns FAILED_PRECONDITION if update would create a cycle.
@@ -351,6 +735,24 @@ type ProductServiceServer interface {
 	// DeleteCategory performs soft deletion of a category.
 	// Returns FAILED_PRECONDITION if category contains products.
 	DeleteCategory(context.Context, *DeleteCategoryRequest) (*DeleteCategoryResponse, error)
+	// CreateAttributeDefinition defines a SKU attribute for the products of a
+	// category. Existing SKUs are not revalidated.
+	// Returns NOT_FOUND if category doesn't exist.
+	// Returns ALREADY_EXISTS if the category already defines the name.
+	// Returns INVALID_ARGUMENT if name, type or allowed_values are invalid.
+	CreateAttributeDefinition(context.Context, *CreateAttributeDefinitionRequest) (*CreateAttributeDefinitionResponse, error)
+	// ListAttributeDefinitions returns the attribute definitions of a category
+	// ordered by name.
+	// Returns NOT_FOUND if category doesn't exist.
+	ListAttributeDefinitions(context.Context, *ListAttributeDefinitionsRequest) (*ListAttributeDefinitionsResponse, error)
+	// UpdateAttributeDefinition replaces the type, allowed values and required
+	// flag of a definition. Existing SKUs are not revalidated.
+	// Returns NOT_FOUND if definition doesn't exist.
+	UpdateAttributeDefinition(context.Context, *UpdateAttributeDefinitionRequest) (*UpdateAttributeDefinitionResponse, error)
+	// DeleteAttributeDefinition removes a definition; SKU attributes of that
+	// name become free-form again.
+	// Returns NOT_FOUND if definition doesn't exist.
+	DeleteAttributeDefinition(context.Context, *DeleteAttributeDefinitionRequest) (*DeleteAttributeDefinitionResponse, error)
 	mustEmbedUnimplementedProductServiceServer()
 }
 
@@ -367,6 +769,9 @@ func (UnimplementedProductServiceServer) CreateProduct(context.Context, *CreateP
 func (UnimplementedProductServiceServer) GetProduct(context.Context, *GetProductRequest) (*GetProductResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method GetProduct not implemented")
 }
+func (UnimplementedProductServiceServer) GetProductsByIDs(context.Context, *GetProductsByIDsRequest) (*GetProductsByIDsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetProductsByIDs not implemented")
+}
 func (UnimplementedProductServiceServer) UpdateProduct(context.Context, *UpdateProductRequest) (*UpdateProductResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method UpdateProduct not implemented")
 }
@@ -385,18 +790,54 @@ func (UnimplementedProductServiceServer) HideProduct(context.Context, *HideProdu
 func (UnimplementedProductServiceServer) UnpublishProduct(context.Context, *UnpublishProductRequest) (*UnpublishProductResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method UnpublishProduct not implemented")
 }
+func (UnimplementedProductServiceServer) UpdateProductVisibility(context.Context, *UpdateProductVisibilityRequest) (*UpdateProductVisibilityResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateProductVisibility not implemented")
+}
+func (UnimplementedProductServiceServer) ImportProducts(context.Context, *ImportProductsRequest) (*ImportProductsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ImportProducts not implemented")
+}
+func (UnimplementedProductServiceServer) GetProductImport(context.Context, *GetProductImportRequest) (*GetProductImportResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetProductImport not implemented")
+}
 func (UnimplementedProductServiceServer) CreateSKU(context.Context, *CreateSKURequest) (*CreateSKUResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method CreateSKU not implemented")
 }
+func (UnimplementedProductServiceServer) GenerateSKUs(context.Context, *GenerateSKUsRequest) (*GenerateSKUsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GenerateSKUs not implemented")
+}
 func (UnimplementedProductServiceServer) GetSKU(context.Context, *GetSKURequest) (*GetSKUResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method GetSKU not implemented")
 }
+func (UnimplementedProductServiceServer) GetSKUsByIDs(context.Context, *GetSKUsByIDsRequest) (*GetSKUsByIDsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetSKUsByIDs not implemented")
+}
 func (UnimplementedProductServiceServer) UpdateSKU(context.Context, *UpdateSKURequest) (*UpdateSKUResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method UpdateSKU not implemented")
 }
 func (UnimplementedProductServiceServer) DeleteSKU(context.Context, *DeleteSKURequest) (*DeleteSKUResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method DeleteSKU not implemented")
 }
+func (UnimplementedProductServiceServer) SchedulePriceChange(context.Context, *SchedulePriceChangeRequest) (*SchedulePriceChangeResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SchedulePriceChange not implemented")
+}
+func (UnimplementedProductServiceServer) GetPriceHistory(context.Context, *GetPriceHistoryRequest) (*GetPriceHistoryResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetPriceHistory not implemented")
+}
+func (UnimplementedProductServiceServer) CreateProductImageUpload(context.Context, *CreateProductImageUploadRequest) (*CreateProductImageUploadResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateProductImageUpload not implemented")
+}
+func (UnimplementedProductServiceServer) CompleteProductImageUpload(context.Context, *CompleteProductImageUploadRequest) (*CompleteProductImageUploadResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CompleteProductImageUpload not implemented")
+}
+func (UnimplementedProductServiceServer) UpdateProductImage(context.Context, *UpdateProductImageRequest) (*UpdateProductImageResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateProductImage not implemented")
+}
+func (UnimplementedProductServiceServer) ReorderProductImages(context.Context, *ReorderProductImagesRequest) (*ReorderProductImagesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ReorderProductImages not implemented")
+}
+func (UnimplementedProductServiceServer) DeleteProductImage(context.Context, *DeleteProductImageRequest) (*DeleteProductImageResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteProductImage not implemented")
+}
 func (UnimplementedProductServiceServer) CreateCategory(context.Context, *CreateCategoryRequest) (*CreateCategoryResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method CreateCategory not implemented")
 }
@@ -406,12 +847,27 @@ func (UnimplementedProductServiceServer) GetCategory(context.Context, *GetCatego
 func (UnimplementedProductServiceServer) ListCategories(context.Context, *ListCategoriesRequest) (*ListCategoriesResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method ListCategories not implemented")
 }
+func (UnimplementedProductServiceServer) GetCategoryTree(context.Context, *GetCategoryTreeRequest) (*GetCategoryTreeResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetCategoryTree not implemented")
+}
 func (UnimplementedProductServiceServer) UpdateCategory(context.Context, *UpdateCategoryRequest) (*UpdateCategoryResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method UpdateCategory not implemented")
 }
 func (UnimplementedProductServiceServer) DeleteCategory(context.Context, *DeleteCategoryRequest) (*DeleteCategoryResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method DeleteCategory not implemented")
 }
+func (UnimplementedProductServiceServer) CreateAttributeDefinition(context.Context, *CreateAttributeDefinitionRequest) (*CreateAttributeDefinitionResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateAttributeDefinition not implemented")
+}
+func (UnimplementedProductServiceServer) ListAttributeDefinitions(context.Context, *ListAttributeDefinitionsRequest) (*ListAttributeDefinitionsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListAttributeDefinitions not implemented")
+}
+func (UnimplementedProductServiceServer) UpdateAttributeDefinition(context.Context, *UpdateAttributeDefinitionRequest) (*UpdateAttributeDefinitionResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateAttributeDefinition not implemented")
+}
+func (UnimplementedProductServiceServer) DeleteAttributeDefinition(context.Context, *DeleteAttributeDefinitionRequest) (*DeleteAttributeDefinitionResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteAttributeDefinition not implemented")
+}
 func (UnimplementedProductServiceServer) mustEmbedUnimplementedProductServiceServer() {}
 func (UnimplementedProductServiceServer) testEmbeddedByValue()                        {}
 
@@ -469,6 +925,24 @@ func _ProductService_GetProduct_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ProductService_GetProductsByIDs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetProductsByIDsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).GetProductsByIDs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_GetProductsByIDs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).GetProductsByIDs(ctx, req.(*GetProductsByIDsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ProductService_UpdateProduct_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(UpdateProductRequest)
 	if err := dec(in); err != nil {
@@ -577,6 +1051,60 @@ func _ProductService_UnpublishProduct_Handler(srv interface{}, ctx context.Conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ProductService_UpdateProductVisibility_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateProductVisibilityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).UpdateProductVisibility(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_UpdateProductVisibility_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).UpdateProductVisibility(ctx, req.(*UpdateProductVisibilityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_ImportProducts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportProductsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).ImportProducts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_ImportProducts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).ImportProducts(ctx, req.(*ImportProductsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_GetProductImport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetProductImportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).GetProductImport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_GetProductImport_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).GetProductImport(ctx, req.(*GetProductImportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ProductService_CreateSKU_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CreateSKURequest)
 	if err := dec(in); err != nil {
@@ -595,6 +1123,24 @@ func _ProductService_CreateSKU_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ProductService_GenerateSKUs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GenerateSKUsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).GenerateSKUs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_GenerateSKUs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).GenerateSKUs(ctx, req.(*GenerateSKUsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ProductService_GetSKU_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetSKURequest)
 	if err := dec(in); err != nil {
@@ -613,6 +1159,24 @@ func _ProductService_GetSKU_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ProductService_GetSKUsByIDs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSKUsByIDsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).GetSKUsByIDs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_GetSKUsByIDs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).GetSKUsByIDs(ctx, req.(*GetSKUsByIDsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ProductService_UpdateSKU_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(UpdateSKURequest)
 	if err := dec(in); err != nil {
@@ -649,6 +1213,132 @@ func _ProductService_DeleteSKU_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ProductService_SchedulePriceChange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SchedulePriceChangeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).SchedulePriceChange(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_SchedulePriceChange_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).SchedulePriceChange(ctx, req.(*SchedulePriceChangeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_GetPriceHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPriceHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).GetPriceHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_GetPriceHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).GetPriceHistory(ctx, req.(*GetPriceHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_CreateProductImageUpload_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateProductImageUploadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).CreateProductImageUpload(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_CreateProductImageUpload_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).CreateProductImageUpload(ctx, req.(*CreateProductImageUploadRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_CompleteProductImageUpload_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CompleteProductImageUploadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).CompleteProductImageUpload(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_CompleteProductImageUpload_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).CompleteProductImageUpload(ctx, req.(*CompleteProductImageUploadRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_UpdateProductImage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateProductImageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).UpdateProductImage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_UpdateProductImage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).UpdateProductImage(ctx, req.(*UpdateProductImageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_ReorderProductImages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReorderProductImagesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).ReorderProductImages(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_ReorderProductImages_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).ReorderProductImages(ctx, req.(*ReorderProductImagesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_DeleteProductImage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteProductImageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).DeleteProductImage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_DeleteProductImage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).DeleteProductImage(ctx, req.(*DeleteProductImageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ProductService_CreateCategory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CreateCategoryRequest)
 	if err := dec(in); err != nil {
@@ -703,6 +1393,24 @@ func _ProductService_ListCategories_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ProductService_GetCategoryTree_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCategoryTreeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).GetCategoryTree(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_GetCategoryTree_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).GetCategoryTree(ctx, req.(*GetCategoryTreeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ProductService_UpdateCategory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(UpdateCategoryRequest)
 	if err := dec(in); err != nil {
@@ -739,6 +1447,78 @@ func _ProductService_DeleteCategory_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ProductService_CreateAttributeDefinition_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateAttributeDefinitionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).CreateAttributeDefinition(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_CreateAttributeDefinition_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).CreateAttributeDefinition(ctx, req.(*CreateAttributeDefinitionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_ListAttributeDefinitions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAttributeDefinitionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).ListAttributeDefinitions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_ListAttributeDefinitions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).ListAttributeDefinitions(ctx, req.(*ListAttributeDefinitionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_UpdateAttributeDefinition_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateAttributeDefinitionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).UpdateAttributeDefinition(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_UpdateAttributeDefinition_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).UpdateAttributeDefinition(ctx, req.(*UpdateAttributeDefinitionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_DeleteAttributeDefinition_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteAttributeDefinitionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).DeleteAttributeDefinition(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_DeleteAttributeDefinition_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).DeleteAttributeDefinition(ctx, req.(*DeleteAttributeDefinitionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // ProductService_ServiceDesc is the grpc.ServiceDesc for ProductService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -754,6 +1534,10 @@ var ProductService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetProduct",
 			Handler:    _ProductService_GetProduct_Handler,
 		},
+		{
+			MethodName: "GetProductsByIDs",
+			Handler:    _ProductService_GetProductsByIDs_Handler,
+		},
 		{
 			MethodName: "UpdateProduct",
 			Handler:    _ProductService_UpdateProduct_Handler,
@@ -778,14 +1562,34 @@ var ProductService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UnpublishProduct",
 			Handler:    _ProductService_UnpublishProduct_Handler,
 		},
+		{
+			MethodName: "UpdateProductVisibility",
+			Handler:    _ProductService_UpdateProductVisibility_Handler,
+		},
+		{
+			MethodName: "ImportProducts",
+			Handler:    _ProductService_ImportProducts_Handler,
+		},
+		{
+			MethodName: "GetProductImport",
+			Handler:    _ProductService_GetProductImport_Handler,
+		},
 		{
 			MethodName: "CreateSKU",
 			Handler:    _ProductService_CreateSKU_Handler,
 		},
+		{
+			MethodName: "GenerateSKUs",
+			Handler:    _ProductService_GenerateSKUs_Handler,
+		},
 		{
 			MethodName: "GetSKU",
 			Handler:    _ProductService_GetSKU_Handler,
 		},
+		{
+			MethodName: "GetSKUsByIDs",
+			Handler:    _ProductService_GetSKUsByIDs_Handler,
+		},
 		{
 			MethodName: "UpdateSKU",
 			Handler:    _ProductService_UpdateSKU_Handler,
@@ -794,6 +1598,34 @@ var ProductService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteSKU",
 			Handler:    _ProductService_DeleteSKU_Handler,
 		},
+		{
+			MethodName: "SchedulePriceChange",
+			Handler:    _ProductService_SchedulePriceChange_Handler,
+		},
+		{
+			MethodName: "GetPriceHistory",
+			Handler:    _ProductService_GetPriceHistory_Handler,
+		},
+		{
+			MethodName: "CreateProductImageUpload",
+			Handler:    _ProductService_CreateProductImageUpload_Handler,
+		},
+		{
+			MethodName: "CompleteProductImageUpload",
+			Handler:    _ProductService_CompleteProductImageUpload_Handler,
+		},
+		{
+			MethodName: "UpdateProductImage",
+			Handler:    _ProductService_UpdateProductImage_Handler,
+		},
+		{
+			MethodName: "ReorderProductImages",
+			Handler:    _ProductService_ReorderProductImages_Handler,
+		},
+		{
+			MethodName: "DeleteProductImage",
+			Handler:    _ProductService_DeleteProductImage_Handler,
+		},
 		{
 			MethodName: "CreateCategory",
 			Handler:    _ProductService_CreateCategory_Handler,
@@ -806,6 +1638,10 @@ var ProductService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListCategories",
 			Handler:    _ProductService_ListCategories_Handler,
 		},
+		{
+			MethodName: "GetCategoryTree",
+			Handler:    _ProductService_GetCategoryTree_Handler,
+		},
 		{
 			MethodName: "UpdateCategory",
 			Handler:    _ProductService_UpdateCategory_Handler,
@@ -814,6 +1650,22 @@ var ProductService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteCategory",
 			Handler:    _ProductService_DeleteCategory_Handler,
 		},
+		{
+			MethodName: "CreateAttributeDefinition",
+			Handler:    _ProductService_CreateAttributeDefinition_Handler,
+		},
+		{
+			MethodName: "ListAttributeDefinitions",
+			Handler:    _ProductService_ListAttributeDefinitions_Handler,
+		},
+		{
+			MethodName: "UpdateAttributeDefinition",
+			Handler:    _ProductService_UpdateAttributeDefinition_Handler,
+		},
+		{
+			MethodName: "DeleteAttributeDefinition",
+			Handler:    _ProductService_DeleteAttributeDefinition_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "product/v1/product_service.proto",
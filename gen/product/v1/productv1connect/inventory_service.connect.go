@@ -41,9 +41,15 @@ const (
 	// InventoryServiceGetInventoryProcedure is the fully-qualified name of the InventoryService's
 	// GetInventory RPC.
 	InventoryServiceGetInventoryProcedure = "/product.v1.InventoryService/GetInventory"
+	// InventoryServiceWatchInventoryProcedure is the fully-qualified name of the InventoryService's
+	// WatchInventory RPC.
+	InventoryServiceWatchInventoryProcedure = "/product.v1.InventoryService/WatchInventory"
 	// InventoryServiceUpdateInventoryProcedure is the fully-qualified name of the InventoryService's
 	// UpdateInventory RPC.
 	InventoryServiceUpdateInventoryProcedure = "/product.v1.InventoryService/UpdateInventory"
+	// InventoryServiceBatchUpdateInventoryProcedure is the fully-qualified name of the
+	// InventoryService's BatchUpdateInventory RPC.
+	InventoryServiceBatchUpdateInventoryProcedure = "/product.v1.InventoryService/BatchUpdateInventory"
 	// InventoryServiceBatchReserveInventoryProcedure is the fully-qualified name of the
 	// InventoryService's BatchReserveInventory RPC.
 	InventoryServiceBatchReserveInventoryProcedure = "/product.v1.InventoryService/BatchReserveInventory"
@@ -59,6 +65,27 @@ const (
 	// InventoryServiceGetReservationStatusProcedure is the fully-qualified name of the
 	// InventoryService's GetReservationStatus RPC.
 	InventoryServiceGetReservationStatusProcedure = "/product.v1.InventoryService/GetReservationStatus"
+	// InventoryServiceGetSKUVelocityProcedure is the fully-qualified name of the InventoryService's
+	// GetSKUVelocity RPC.
+	InventoryServiceGetSKUVelocityProcedure = "/product.v1.InventoryService/GetSKUVelocity"
+	// InventoryServiceListInventoryMovementsProcedure is the fully-qualified name of the
+	// InventoryService's ListInventoryMovements RPC.
+	InventoryServiceListInventoryMovementsProcedure = "/product.v1.InventoryService/ListInventoryMovements"
+	// InventoryServiceSetLowStockThresholdProcedure is the fully-qualified name of the
+	// InventoryService's SetLowStockThreshold RPC.
+	InventoryServiceSetLowStockThresholdProcedure = "/product.v1.InventoryService/SetLowStockThreshold"
+	// InventoryServiceListLowStockSKUsProcedure is the fully-qualified name of the InventoryService's
+	// ListLowStockSKUs RPC.
+	InventoryServiceListLowStockSKUsProcedure = "/product.v1.InventoryService/ListLowStockSKUs"
+	// InventoryServiceListReservationsProcedure is the fully-qualified name of the InventoryService's
+	// ListReservations RPC.
+	InventoryServiceListReservationsProcedure = "/product.v1.InventoryService/ListReservations"
+	// InventoryServiceForceReleaseReservationProcedure is the fully-qualified name of the
+	// InventoryService's ForceReleaseReservation RPC.
+	InventoryServiceForceReleaseReservationProcedure = "/product.v1.InventoryService/ForceReleaseReservation"
+	// InventoryServiceDrainSKUReservationsProcedure is the fully-qualified name of the
+	// InventoryService's DrainSKUReservations RPC.
+	InventoryServiceDrainSKUReservationsProcedure = "/product.v1.InventoryService/DrainSKUReservations"
 )
 
 // InventoryServiceClient is a client for the product.v1.InventoryService service.
@@ -66,22 +93,51 @@ type InventoryServiceClient interface {
 	// GetInventory retrieves current stock levels for a SKU.
 	// Returns NOT_FOUND if SKU doesn't exist.
 	GetInventory(context.Context, *connect.Request[v1.GetInventoryRequest]) (*connect.Response[v1.GetInventoryResponse], error)
+	// WatchInventory streams the stock levels of SKUs: first their current
+	// inventory, then their inventory whenever the available quantity changes.
+	// Unknown SKU IDs are ignored. The stream stays open until the client
+	// cancels it or the server's route timeout elapses.
+	// Returns INVALID_ARGUMENT if sku_ids is empty or exceeds 100 SKUs.
+	// Returns UNAVAILABLE if changes may have been missed (the client fell
+	// behind or the server lost its database listener); watch again.
+	WatchInventory(context.Context, *connect.Request[v1.WatchInventoryRequest]) (*connect.ServerStreamForClient[v1.WatchInventoryResponse], error)
 	// UpdateInventory modifies the stock quantity for a SKU.
 	// Returns NOT_FOUND if SKU doesn't exist.
 	// Returns ABORTED if version conflict (optimistic locking).
 	// Returns INVALID_ARGUMENT if update would result in negative available quantity.
 	// Returns PERMISSION_DENIED if caller lacks admin role.
 	UpdateInventory(context.Context, *connect.Request[v1.UpdateInventoryRequest]) (*connect.Response[v1.UpdateInventoryResponse], error)
+	// BatchUpdateInventory sets absolute quantities for many SKUs, e.g. from a
+	// warehouse sync. Items are applied in chunks of 500 SKUs, each in its own
+	// transaction, and every change is recorded as an inventory movement.
+	//
+	// Behavior:
+	// - Partial success: An item that cannot be applied does not affect the others
+	// - Per-item errors: NOT_FOUND for unknown SKUs, RESOURCE_EXHAUSTED if the
+	//   quantity is below the reserved quantity, INVALID_ARGUMENT for malformed
+	//   IDs, negative quantities and SKUs listed more than once
+	// - If a chunk fails as a whole, earlier chunks stay applied and the
+	//   remaining items report the error
+	//
+	// Returns INVALID_ARGUMENT if items is empty or exceeds the batch limit (5000).
+	// Returns PERMISSION_DENIED if caller lacks admin role.
+	BatchUpdateInventory(context.Context, *connect.Request[v1.BatchUpdateInventoryRequest]) (*connect.Response[v1.BatchUpdateInventoryResponse], error)
 	// BatchReserveInventory atomically reserves inventory for multiple SKUs.
 	// This is the "Try" phase of the TCC pattern.
 	//
 	// Behavior:
 	// - All-or-Nothing: Either all items are reserved or none are
 	// - Idempotent: Same idempotency_key returns same response
-	// - TTL: Reservations expire after 15 minutes (configurable)
+	// - TTL: Reservations expire after 15 minutes (configurable) unless
+	//   ttl_seconds requests another TTL within the allowed bounds
 	//
 	// Returns RESOURCE_EXHAUSTED with InsufficientStockDetail if any SKU lacks stock.
 	// Returns INVALID_ARGUMENT if batch size exceeds limit (50 SKUs).
+	// Returns INVALID_ARGUMENT if ttl_seconds is outside the bounds allowed for the caller.
+	// Returns ABORTED if contention with concurrent reservations persists
+	// after the server's retries (LOCK_RETRY_MAX_ATTEMPTS), or if a
+	// pessimistic reservation waits longer than RESERVATION_LOCK_TIMEOUT for
+	// its rows; safe to retry.
 	BatchReserveInventory(context.Context, *connect.Request[v1.BatchReserveInventoryRequest]) (*connect.Response[v1.BatchReserveInventoryResponse], error)
 	// ConfirmReservation permanently commits the reservation.
 	// This is the "Confirm" phase of the TCC pattern.
@@ -119,6 +175,60 @@ type InventoryServiceClient interface {
 	// GetReservationStatus retrieves the current state of a reservation.
 	// Returns status NOT_FOUND (in response, not error) if reservation doesn't exist.
 	GetReservationStatus(context.Context, *connect.Request[v1.GetReservationStatusRequest]) (*connect.Response[v1.GetReservationStatusResponse], error)
+	// GetSKUVelocity returns aggregated sales velocity (units/day) per SKU.
+	// Sales are counted from CONFIRMED reservations over each requested window.
+	// Intended for purchasing and low-stock tooling to derive reorder points.
+	//
+	// Returns INVALID_ARGUMENT if sku_ids is empty or exceeds the batch limit (50).
+	// Returns INVALID_ARGUMENT if any window is outside 1..365 days.
+	GetSKUVelocity(context.Context, *connect.Request[v1.GetSKUVelocityRequest]) (*connect.Response[v1.GetSKUVelocityResponse], error)
+	// ListInventoryMovements returns the audit trail of a SKU's inventory, newest first.
+	// Every UpdateInventory, reserve, confirm, release and TTL expiry writes one movement
+	// per SKU in the same statement as the inventory change.
+	//
+	// Returns INVALID_ARGUMENT if sku_id or page_token is malformed.
+	ListInventoryMovements(context.Context, *connect.Request[v1.ListInventoryMovementsRequest]) (*connect.Response[v1.ListInventoryMovementsResponse], error)
+	// SetLowStockThreshold sets the available quantity below which a SKU is low
+	// on stock. Without a threshold of its own a SKU uses the service-wide
+	// default (LOW_STOCK_DEFAULT_THRESHOLD); 0 disables low-stock alerts for it.
+	//
+	// Returns NOT_FOUND if the SKU has no inventory.
+	// Returns INVALID_ARGUMENT if threshold is negative.
+	SetLowStockThreshold(context.Context, *connect.Request[v1.SetLowStockThresholdRequest]) (*connect.Response[v1.SetLowStockThresholdResponse], error)
+	// ListLowStockSKUs returns SKUs whose available quantity is below their
+	// low-stock threshold, ordered by SKU ID.
+	//
+	// A background worker also publishes an "inventory.low_stock" webhook event
+	// once each time a SKU drops below its threshold.
+	//
+	// Returns INVALID_ARGUMENT if page_token is malformed.
+	ListLowStockSKUs(context.Context, *connect.Request[v1.ListLowStockSKUsRequest]) (*connect.Response[v1.ListLowStockSKUsResponse], error)
+	// ListReservations returns reservations newest first, for support staff
+	// inspecting checkouts. Filters are combined with AND.
+	//
+	// Returns INVALID_ARGUMENT if sku_id or page_token is malformed, or if
+	// created_after is not before created_before.
+	ListReservations(context.Context, *connect.Request[v1.ListReservationsRequest]) (*connect.Response[v1.ListReservationsResponse], error)
+	// ForceReleaseReservation releases a PENDING reservation on behalf of
+	// support staff, e.g. one left behind by a failed checkout, and returns its
+	// stock. The reason is stored with the reservation and the stock movements
+	// are recorded as FORCE_RELEASE with the caller as actor.
+	//
+	// Returns NOT_FOUND if the reservation doesn't exist.
+	// Returns FAILED_PRECONDITION if it is not PENDING (already confirmed,
+	// released or expired).
+	// Returns INVALID_ARGUMENT if reason is empty or longer than 500 characters.
+	ForceReleaseReservation(context.Context, *connect.Request[v1.ForceReleaseReservationRequest]) (*connect.Response[v1.ForceReleaseReservationResponse], error)
+	// DrainSKUReservations force-releases every PENDING reservation holding a
+	// SKU, e.g. when the SKU was oversold or pulled during an incident. Each
+	// reservation is released as by ForceReleaseReservation in its own
+	// transaction; reservations confirmed or expired in the meantime are
+	// skipped. At most 1000 reservations are released per call and has_more
+	// is set when some are left.
+	//
+	// Returns INVALID_ARGUMENT if sku_id is malformed, or if reason is empty
+	// or longer than 500 characters.
+	DrainSKUReservations(context.Context, *connect.Request[v1.DrainSKUReservationsRequest]) (*connect.Response[v1.DrainSKUReservationsResponse], error)
 }
 
 // NewInventoryServiceClient constructs a client for the product.v1.InventoryService service. By
@@ -138,12 +248,24 @@ func NewInventoryServiceClient(httpClient connect.HTTPClient, baseURL string, op
 			connect.WithSchema(inventoryServiceMethods.ByName("GetInventory")),
 			connect.WithClientOptions(opts...),
 		),
+		watchInventory: connect.NewClient[v1.WatchInventoryRequest, v1.WatchInventoryResponse](
+			httpClient,
+			baseURL+InventoryServiceWatchInventoryProcedure,
+			connect.WithSchema(inventoryServiceMethods.ByName("WatchInventory")),
+			connect.WithClientOptions(opts...),
+		),
 		updateInventory: connect.NewClient[v1.UpdateInventoryRequest, v1.UpdateInventoryResponse](
 			httpClient,
 			baseURL+InventoryServiceUpdateInventoryProcedure,
 			connect.WithSchema(inventoryServiceMethods.ByName("UpdateInventory")),
 			connect.WithClientOptions(opts...),
 		),
+		batchUpdateInventory: connect.NewClient[v1.BatchUpdateInventoryRequest, v1.BatchUpdateInventoryResponse](
+			httpClient,
+			baseURL+InventoryServiceBatchUpdateInventoryProcedure,
+			connect.WithSchema(inventoryServiceMethods.ByName("BatchUpdateInventory")),
+			connect.WithClientOptions(opts...),
+		),
 		batchReserveInventory: connect.NewClient[v1.BatchReserveInventoryRequest, v1.BatchReserveInventoryResponse](
 			httpClient,
 			baseURL+InventoryServiceBatchReserveInventoryProcedure,
@@ -174,18 +296,69 @@ func NewInventoryServiceClient(httpClient connect.HTTPClient, baseURL string, op
 			connect.WithSchema(inventoryServiceMethods.ByName("GetReservationStatus")),
 			connect.WithClientOptions(opts...),
 		),
+		getSKUVelocity: connect.NewClient[v1.GetSKUVelocityRequest, v1.GetSKUVelocityResponse](
+			httpClient,
+			baseURL+InventoryServiceGetSKUVelocityProcedure,
+			connect.WithSchema(inventoryServiceMethods.ByName("GetSKUVelocity")),
+			connect.WithClientOptions(opts...),
+		),
+		listInventoryMovements: connect.NewClient[v1.ListInventoryMovementsRequest, v1.ListInventoryMovementsResponse](
+			httpClient,
+			baseURL+InventoryServiceListInventoryMovementsProcedure,
+			connect.WithSchema(inventoryServiceMethods.ByName("ListInventoryMovements")),
+			connect.WithClientOptions(opts...),
+		),
+		setLowStockThreshold: connect.NewClient[v1.SetLowStockThresholdRequest, v1.SetLowStockThresholdResponse](
+			httpClient,
+			baseURL+InventoryServiceSetLowStockThresholdProcedure,
+			connect.WithSchema(inventoryServiceMethods.ByName("SetLowStockThreshold")),
+			connect.WithClientOptions(opts...),
+		),
+		listLowStockSKUs: connect.NewClient[v1.ListLowStockSKUsRequest, v1.ListLowStockSKUsResponse](
+			httpClient,
+			baseURL+InventoryServiceListLowStockSKUsProcedure,
+			connect.WithSchema(inventoryServiceMethods.ByName("ListLowStockSKUs")),
+			connect.WithClientOptions(opts...),
+		),
+		listReservations: connect.NewClient[v1.ListReservationsRequest, v1.ListReservationsResponse](
+			httpClient,
+			baseURL+InventoryServiceListReservationsProcedure,
+			connect.WithSchema(inventoryServiceMethods.ByName("ListReservations")),
+			connect.WithClientOptions(opts...),
+		),
+		forceReleaseReservation: connect.NewClient[v1.ForceReleaseReservationRequest, v1.ForceReleaseReservationResponse](
+			httpClient,
+			baseURL+InventoryServiceForceReleaseReservationProcedure,
+			connect.WithSchema(inventoryServiceMethods.ByName("ForceReleaseReservation")),
+			connect.WithClientOptions(opts...),
+		),
+		drainSKUReservations: connect.NewClient[v1.DrainSKUReservationsRequest, v1.DrainSKUReservationsResponse](
+			httpClient,
+			baseURL+InventoryServiceDrainSKUReservationsProcedure,
+			connect.WithSchema(inventoryServiceMethods.ByName("DrainSKUReservations")),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
 // inventoryServiceClient implements InventoryServiceClient.
 type inventoryServiceClient struct {
-	getInventory          *connect.Client[v1.GetInventoryRequest, v1.GetInventoryResponse]
-	updateInventory       *connect.Client[v1.UpdateInventoryRequest, v1.UpdateInventoryResponse]
-	batchReserveInventory *connect.Client[v1.BatchReserveInventoryRequest, v1.BatchReserveInventoryResponse]
-	confirmReservation    *connect.Client[v1.ConfirmReservationRequest, v1.ConfirmReservationResponse]
-	releaseInventory      *connect.Client[v1.ReleaseInventoryRequest, v1.ReleaseInventoryResponse]
-	updateReservation     *connect.Client[v1.UpdateReservationRequest, v1.UpdateReservationResponse]
-	getReservationStatus  *connect.Client[v1.GetReservationStatusRequest, v1.GetReservationStatusResponse]
+	getInventory            *connect.Client[v1.GetInventoryRequest, v1.GetInventoryResponse]
+	watchInventory          *connect.Client[v1.WatchInventoryRequest, v1.WatchInventoryResponse]
+	updateInventory         *connect.Client[v1.UpdateInventoryRequest, v1.UpdateInventoryResponse]
+	batchUpdateInventory    *connect.Client[v1.BatchUpdateInventoryRequest, v1.BatchUpdateInventoryResponse]
+	batchReserveInventory   *connect.Client[v1.BatchReserveInventoryRequest, v1.BatchReserveInventoryResponse]
+	confirmReservation      *connect.Client[v1.ConfirmReservationRequest, v1.ConfirmReservationResponse]
+	releaseInventory        *connect.Client[v1.ReleaseInventoryRequest, v1.ReleaseInventoryResponse]
+	updateReservation       *connect.Client[v1.UpdateReservationRequest, v1.UpdateReservationResponse]
+	getReservationStatus    *connect.Client[v1.GetReservationStatusRequest, v1.GetReservationStatusResponse]
+	getSKUVelocity          *connect.Client[v1.GetSKUVelocityRequest, v1.GetSKUVelocityResponse]
+	listInventoryMovements  *connect.Client[v1.ListInventoryMovementsRequest, v1.ListInventoryMovementsResponse]
+	setLowStockThreshold    *connect.Client[v1.SetLowStockThresholdRequest, v1.SetLowStockThresholdResponse]
+	listLowStockSKUs        *connect.Client[v1.ListLowStockSKUsRequest, v1.ListLowStockSKUsResponse]
+	listReservations        *connect.Client[v1.ListReservationsRequest, v1.ListReservationsResponse]
+	forceReleaseReservation *connect.Client[v1.ForceReleaseReservationRequest, v1.ForceReleaseReservationResponse]
+	drainSKUReservations    *connect.Client[v1.DrainSKUReservationsRequest, v1.DrainSKUReservationsResponse]
 }
 
 // GetInventory calls product.v1.InventoryService.GetInventory.
@@ -193,11 +366,21 @@ func (c *inventoryServiceClient) GetInventory(ctx context.Context, req *connect.
 	return c.getInventory.CallUnary(ctx, req)
 }
 
+// WatchInventory calls product.v1.InventoryService.WatchInventory.
+func (c *inventoryServiceClient) WatchInventory(ctx context.Context, req *connect.Request[v1.WatchInventoryRequest]) (*connect.ServerStreamForClient[v1.WatchInventoryResponse], error) {
+	return c.watchInventory.CallServerStream(ctx, req)
+}
+
 // UpdateInventory calls product.v1.InventoryService.UpdateInventory.
 func (c *inventoryServiceClient) UpdateInventory(ctx context.Context, req *connect.Request[v1.UpdateInventoryRequest]) (*connect.Response[v1.UpdateInventoryResponse], error) {
 	return c.updateInventory.CallUnary(ctx, req)
 }
 
+// BatchUpdateInventory calls product.v1.InventoryService.BatchUpdateInventory.
+func (c *inventoryServiceClient) BatchUpdateInventory(ctx context.Context, req *connect.Request[v1.BatchUpdateInventoryRequest]) (*connect.Response[v1.BatchUpdateInventoryResponse], error) {
+	return c.batchUpdateInventory.CallUnary(ctx, req)
+}
+
 // BatchReserveInventory calls product.v1.InventoryService.BatchReserveInventory.
 func (c *inventoryServiceClient) BatchReserveInventory(ctx context.Context, req *connect.Request[v1.BatchReserveInventoryRequest]) (*connect.Response[v1.BatchReserveInventoryResponse], error) {
 	return c.batchReserveInventory.CallUnary(ctx, req)
@@ -223,27 +406,91 @@ func (c *inventoryServiceClient) GetReservationStatus(ctx context.Context, req *
 	return c.getReservationStatus.CallUnary(ctx, req)
 }
 
+// GetSKUVelocity calls product.v1.InventoryService.GetSKUVelocity.
+func (c *inventoryServiceClient) GetSKUVelocity(ctx context.Context, req *connect.Request[v1.GetSKUVelocityRequest]) (*connect.Response[v1.GetSKUVelocityResponse], error) {
+	return c.getSKUVelocity.CallUnary(ctx, req)
+}
+
+// ListInventoryMovements calls product.v1.InventoryService.ListInventoryMovements.
+func (c *inventoryServiceClient) ListInventoryMovements(ctx context.Context, req *connect.Request[v1.ListInventoryMovementsRequest]) (*connect.Response[v1.ListInventoryMovementsResponse], error) {
+	return c.listInventoryMovements.CallUnary(ctx, req)
+}
+
+// SetLowStockThreshold calls product.v1.InventoryService.SetLowStockThreshold.
+func (c *inventoryServiceClient) SetLowStockThreshold(ctx context.Context, req *connect.Request[v1.SetLowStockThresholdRequest]) (*connect.Response[v1.SetLowStockThresholdResponse], error) {
+	return c.setLowStockThreshold.CallUnary(ctx, req)
+}
+
+// ListLowStockSKUs calls product.v1.InventoryService.ListLowStockSKUs.
+func (c *inventoryServiceClient) ListLowStockSKUs(ctx context.Context, req *connect.Request[v1.ListLowStockSKUsRequest]) (*connect.Response[v1.ListLowStockSKUsResponse], error) {
+	return c.listLowStockSKUs.CallUnary(ctx, req)
+}
+
+// ListReservations calls product.v1.InventoryService.ListReservations.
+func (c *inventoryServiceClient) ListReservations(ctx context.Context, req *connect.Request[v1.ListReservationsRequest]) (*connect.Response[v1.ListReservationsResponse], error) {
+	return c.listReservations.CallUnary(ctx, req)
+}
+
+// ForceReleaseReservation calls product.v1.InventoryService.ForceReleaseReservation.
+func (c *inventoryServiceClient) ForceReleaseReservation(ctx context.Context, req *connect.Request[v1.ForceReleaseReservationRequest]) (*connect.Response[v1.ForceReleaseReservationResponse], error) {
+	return c.forceReleaseReservation.CallUnary(ctx, req)
+}
+
+// DrainSKUReservations calls product.v1.InventoryService.DrainSKUReservations.
+func (c *inventoryServiceClient) DrainSKUReservations(ctx context.Context, req *connect.Request[v1.DrainSKUReservationsRequest]) (*connect.Response[v1.DrainSKUReservationsResponse], error) {
+	return c.drainSKUReservations.CallUnary(ctx, req)
+}
+
 // InventoryServiceHandler is an implementation of the product.v1.InventoryService service.
 type InventoryServiceHandler interface {
 	// GetInventory retrieves current stock levels for a SKU.
 	// Returns NOT_FOUND if SKU doesn't exist.
 	GetInventory(context.Context, *connect.Request[v1.GetInventoryRequest]) (*connect.Response[v1.GetInventoryResponse], error)
+	// WatchInventory streams the stock levels of SKUs: first their current
+	// inventory, then their inventory whenever the available quantity changes.
+	// Unknown SKU IDs are ignored. The stream stays open until the client
+	// cancels it or the server's route timeout elapses.
+	// Returns INVALID_ARGUMENT if sku_ids is empty or exceeds 100 SKUs.
+	// Returns UNAVAILABLE if changes may have been missed (the client fell
+	// behind or the server lost its database listener); watch again.
+	WatchInventory(context.Context, *connect.Request[v1.WatchInventoryRequest], *connect.ServerStream[v1.WatchInventoryResponse]) error
 	// UpdateInventory modifies the stock quantity for a SKU.
 	// Returns NOT_FOUND if SKU doesn't exist.
 	// Returns ABORTED if version conflict (optimistic locking).
 	// Returns INVALID_ARGUMENT if update would result in negative available quantity.
 	// Returns PERMISSION_DENIED if caller lacks admin role.
 	UpdateInventory(context.Context, *connect.Request[v1.UpdateInventoryRequest]) (*connect.Response[v1.UpdateInventoryResponse], error)
+	// BatchUpdateInventory sets absolute quantities for many SKUs, e.g. from a
+	// warehouse sync. Items are applied in chunks of 500 SKUs, each in its own
+	// transaction, and every change is recorded as an inventory movement.
+	//
+	// Behavior:
+	// - Partial success: An item that cannot be applied does not affect the others
+	// - Per-item errors: NOT_FOUND for unknown SKUs, RESOURCE_EXHAUSTED if the
+	//   quantity is below the reserved quantity, INVALID_ARGUMENT for malformed
+	//   IDs, negative quantities and SKUs listed more than once
+	// - If a chunk fails as a whole, earlier chunks stay applied and the
+	//   remaining items report the error
+	//
+	// Returns INVALID_ARGUMENT if items is empty or exceeds the batch limit (5000).
+	// Returns PERMISSION_DENIED if caller lacks admin role.
+	BatchUpdateInventory(context.Context, *connect.Request[v1.BatchUpdateInventoryRequest]) (*connect.Response[v1.BatchUpdateInventoryResponse], error)
 	// BatchReserveInventory atomically reserves inventory for multiple SKUs.
 	// This is the "Try" phase of the TCC pattern.
 	//
 	// Behavior:
 	// - All-or-Nothing: Either all items are reserved or none are
 	// - Idempotent: Same idempotency_key returns same response
-	// - TTL: Reservations expire after 15 minutes (configurable)
+	// - TTL: Reservations expire after 15 minutes (configurable) unless
+	//   ttl_seconds requests another TTL within the allowed bounds
 	//
 	// Returns RESOURCE_EXHAUSTED with InsufficientStockDetail if any SKU lacks stock.
 	// Returns INVALID_ARGUMENT if batch size exceeds limit (50 SKUs).
+	// Returns INVALID_ARGUMENT if ttl_seconds is outside the bounds allowed for the caller.
+	// Returns ABORTED if contention with concurrent reservations persists
+	// after the server's retries (LOCK_RETRY_MAX_ATTEMPTS), or if a
+	// pessimistic reservation waits longer than RESERVATION_LOCK_TIMEOUT for
+	// its rows; safe to retry.
 	BatchReserveInventory(context.Context, *connect.Request[v1.BatchReserveInventoryRequest]) (*connect.Response[v1.BatchReserveInventoryResponse], error)
 	// ConfirmReservation permanently commits the reservation.
 	// This is the "Confirm" phase of the TCC pattern.
@@ -281,6 +528,60 @@ type InventoryServiceHandler interface {
 	// GetReservationStatus retrieves the current state of a reservation.
 	// Returns status NOT_FOUND (in response, not error) if reservation doesn't exist.
 	GetReservationStatus(context.Context, *connect.Request[v1.GetReservationStatusRequest]) (*connect.Response[v1.GetReservationStatusResponse], error)
+	// GetSKUVelocity returns aggregated sales velocity (units/day) per SKU.
+	// Sales are counted from CONFIRMED reservations over each requested window.
+	// Intended for purchasing and low-stock tooling to derive reorder points.
+	//
+	// Returns INVALID_ARGUMENT if sku_ids is empty or exceeds the batch limit (50).
+	// Returns INVALID_ARGUMENT if any window is outside 1..365 days.
+	GetSKUVelocity(context.Context, *connect.Request[v1.GetSKUVelocityRequest]) (*connect.Response[v1.GetSKUVelocityResponse], error)
+	// ListInventoryMovements returns the audit trail of a SKU's inventory, newest first.
+	// Every UpdateInventory, reserve, confirm, release and TTL expiry writes one movement
+	// per SKU in the same statement as the inventory change.
+	//
+	// Returns INVALID_ARGUMENT if sku_id or page_token is malformed.
+	ListInventoryMovements(context.Context, *connect.Request[v1.ListInventoryMovementsRequest]) (*connect.Response[v1.ListInventoryMovementsResponse], error)
+	// SetLowStockThreshold sets the available quantity below which a SKU is low
+	// on stock. Without a threshold of its own a SKU uses the service-wide
+	// default (LOW_STOCK_DEFAULT_THRESHOLD); 0 disables low-stock alerts for it.
+	//
+	// Returns NOT_FOUND if the SKU has no inventory.
+	// Returns INVALID_ARGUMENT if threshold is negative.
+	SetLowStockThreshold(context.Context, *connect.Request[v1.SetLowStockThresholdRequest]) (*connect.Response[v1.SetLowStockThresholdResponse], error)
+	// ListLowStockSKUs returns SKUs whose available quantity is below their
+	// low-stock threshold, ordered by SKU ID.
+	//
+	// A background worker also publishes an "inventory.low_stock" webhook event
+	// once each time a SKU drops below its threshold.
+	//
+	// Returns INVALID_ARGUMENT if page_token is malformed.
+	ListLowStockSKUs(context.Context, *connect.Request[v1.ListLowStockSKUsRequest]) (*connect.Response[v1.ListLowStockSKUsResponse], error)
+	// ListReservations returns reservations newest first, for support staff
+	// inspecting checkouts. Filters are combined with AND.
+	//
+	// Returns INVALID_ARGUMENT if sku_id or page_token is malformed, or if
+	// created_after is not before created_before.
+	ListReservations(context.Context, *connect.Request[v1.ListReservationsRequest]) (*connect.Response[v1.ListReservationsResponse], error)
+	// ForceReleaseReservation releases a PENDING reservation on behalf of
+	// support staff, e.g. one left behind by a failed checkout, and returns its
+	// stock. The reason is stored with the reservation and the stock movements
+	// are recorded as FORCE_RELEASE with the caller as actor.
+	//
+	// Returns NOT_FOUND if the reservation doesn't exist.
+	// Returns FAILED_PRECONDITION if it is not PENDING (already confirmed,
+	// released or expired).
+	// Returns INVALID_ARGUMENT if reason is empty or longer than 500 characters.
+	ForceReleaseReservation(context.Context, *connect.Request[v1.ForceReleaseReservationRequest]) (*connect.Response[v1.ForceReleaseReservationResponse], error)
+	// DrainSKUReservations force-releases every PENDING reservation holding a
+	// SKU, e.g. when the SKU was oversold or pulled during an incident. Each
+	// reservation is released as by ForceReleaseReservation in its own
+	// transaction; reservations confirmed or expired in the meantime are
+	// skipped. At most 1000 reservations are released per call and has_more
+	// is set when some are left.
+	//
+	// Returns INVALID_ARGUMENT if sku_id is malformed, or if reason is empty
+	// or longer than 500 characters.
+	DrainSKUReservations(context.Context, *connect.Request[v1.DrainSKUReservationsRequest]) (*connect.Response[v1.DrainSKUReservationsResponse], error)
 }
 
 // NewInventoryServiceHandler builds an HTTP handler from the service implementation. It returns the
@@ -296,12 +597,24 @@ func NewInventoryServiceHandler(svc InventoryServiceHandler, opts ...connect.Han
 		connect.WithSchema(inventoryServiceMethods.ByName("GetInventory")),
 		connect.WithHandlerOptions(opts...),
 	)
+	inventoryServiceWatchInventoryHandler := connect.NewServerStreamHandler(
+		InventoryServiceWatchInventoryProcedure,
+		svc.WatchInventory,
+		connect.WithSchema(inventoryServiceMethods.ByName("WatchInventory")),
+		connect.WithHandlerOptions(opts...),
+	)
 	inventoryServiceUpdateInventoryHandler := connect.NewUnaryHandler(
 		InventoryServiceUpdateInventoryProcedure,
 		svc.UpdateInventory,
 		connect.WithSchema(inventoryServiceMethods.ByName("UpdateInventory")),
 		connect.WithHandlerOptions(opts...),
 	)
+	inventoryServiceBatchUpdateInventoryHandler := connect.NewUnaryHandler(
+		InventoryServiceBatchUpdateInventoryProcedure,
+		svc.BatchUpdateInventory,
+		connect.WithSchema(inventoryServiceMethods.ByName("BatchUpdateInventory")),
+		connect.WithHandlerOptions(opts...),
+	)
 	inventoryServiceBatchReserveInventoryHandler := connect.NewUnaryHandler(
 		InventoryServiceBatchReserveInventoryProcedure,
 		svc.BatchReserveInventory,
@@ -332,12 +645,58 @@ func NewInventoryServiceHandler(svc InventoryServiceHandler, opts ...connect.Han
 		connect.WithSchema(inventoryServiceMethods.ByName("GetReservationStatus")),
 		connect.WithHandlerOptions(opts...),
 	)
+	inventoryServiceGetSKUVelocityHandler := connect.NewUnaryHandler(
+		InventoryServiceGetSKUVelocityProcedure,
+		svc.GetSKUVelocity,
+		connect.WithSchema(inventoryServiceMethods.ByName("GetSKUVelocity")),
+		connect.WithHandlerOptions(opts...),
+	)
+	inventoryServiceListInventoryMovementsHandler := connect.NewUnaryHandler(
+		InventoryServiceListInventoryMovementsProcedure,
+		svc.ListInventoryMovements,
+		connect.WithSchema(inventoryServiceMethods.ByName("ListInventoryMovements")),
+		connect.WithHandlerOptions(opts...),
+	)
+	inventoryServiceSetLowStockThresholdHandler := connect.NewUnaryHandler(
+		InventoryServiceSetLowStockThresholdProcedure,
+		svc.SetLowStockThreshold,
+		connect.WithSchema(inventoryServiceMethods.ByName("SetLowStockThreshold")),
+		connect.WithHandlerOptions(opts...),
+	)
+	inventoryServiceListLowStockSKUsHandler := connect.NewUnaryHandler(
+		InventoryServiceListLowStockSKUsProcedure,
+		svc.ListLowStockSKUs,
+		connect.WithSchema(inventoryServiceMethods.ByName("ListLowStockSKUs")),
+		connect.WithHandlerOptions(opts...),
+	)
+	inventoryServiceListReservationsHandler := connect.NewUnaryHandler(
+		InventoryServiceListReservationsProcedure,
+		svc.ListReservations,
+		connect.WithSchema(inventoryServiceMethods.ByName("ListReservations")),
+		connect.WithHandlerOptions(opts...),
+	)
+	inventoryServiceForceReleaseReservationHandler := connect.NewUnaryHandler(
+		InventoryServiceForceReleaseReservationProcedure,
+		svc.ForceReleaseReservation,
+		connect.WithSchema(inventoryServiceMethods.ByName("ForceReleaseReservation")),
+		connect.WithHandlerOptions(opts...),
+	)
+	inventoryServiceDrainSKUReservationsHandler := connect.NewUnaryHandler(
+		InventoryServiceDrainSKUReservationsProcedure,
+		svc.DrainSKUReservations,
+		connect.WithSchema(inventoryServiceMethods.ByName("DrainSKUReservations")),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/product.v1.InventoryService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case InventoryServiceGetInventoryProcedure:
 			inventoryServiceGetInventoryHandler.ServeHTTP(w, r)
+		case InventoryServiceWatchInventoryProcedure:
+			inventoryServiceWatchInventoryHandler.ServeHTTP(w, r)
 		case InventoryServiceUpdateInventoryProcedure:
 			inventoryServiceUpdateInventoryHandler.ServeHTTP(w, r)
+		case InventoryServiceBatchUpdateInventoryProcedure:
+			inventoryServiceBatchUpdateInventoryHandler.ServeHTTP(w, r)
 		case InventoryServiceBatchReserveInventoryProcedure:
 			inventoryServiceBatchReserveInventoryHandler.ServeHTTP(w, r)
 		case InventoryServiceConfirmReservationProcedure:
@@ -348,6 +707,20 @@ func NewInventoryServiceHandler(svc InventoryServiceHandler, opts ...connect.Han
 			inventoryServiceUpdateReservationHandler.ServeHTTP(w, r)
 		case InventoryServiceGetReservationStatusProcedure:
 			inventoryServiceGetReservationStatusHandler.ServeHTTP(w, r)
+		case InventoryServiceGetSKUVelocityProcedure:
+			inventoryServiceGetSKUVelocityHandler.ServeHTTP(w, r)
+		case InventoryServiceListInventoryMovementsProcedure:
+			inventoryServiceListInventoryMovementsHandler.ServeHTTP(w, r)
+		case InventoryServiceSetLowStockThresholdProcedure:
+			inventoryServiceSetLowStockThresholdHandler.ServeHTTP(w, r)
+		case InventoryServiceListLowStockSKUsProcedure:
+			inventoryServiceListLowStockSKUsHandler.ServeHTTP(w, r)
+		case InventoryServiceListReservationsProcedure:
+			inventoryServiceListReservationsHandler.ServeHTTP(w, r)
+		case InventoryServiceForceReleaseReservationProcedure:
+			inventoryServiceForceReleaseReservationHandler.ServeHTTP(w, r)
+		case InventoryServiceDrainSKUReservationsProcedure:
+			inventoryServiceDrainSKUReservationsHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -361,10 +734,18 @@ func (UnimplementedInventoryServiceHandler) GetInventory(context.Context, *conne
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("product.v1.InventoryService.GetInventory is not implemented"))
 }
 
+func (UnimplementedInventoryServiceHandler) WatchInventory(context.Context, *connect.Request[v1.WatchInventoryRequest], *connect.ServerStream[v1.WatchInventoryResponse]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("product.v1.InventoryService.WatchInventory is not implemented"))
+}
+
 func (UnimplementedInventoryServiceHandler) UpdateInventory(context.Context, *connect.Request[v1.UpdateInventoryRequest]) (*connect.Response[v1.UpdateInventoryResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("product.v1.InventoryService.UpdateInventory is not implemented"))
 }
 
+func (UnimplementedInventoryServiceHandler) BatchUpdateInventory(context.Context, *connect.Request[v1.BatchUpdateInventoryRequest]) (*connect.Response[v1.BatchUpdateInventoryResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("product.v1.InventoryService.BatchUpdateInventory is not implemented"))
+}
+
 func (UnimplementedInventoryServiceHandler) BatchReserveInventory(context.Context, *connect.Request[v1.BatchReserveInventoryRequest]) (*connect.Response[v1.BatchReserveInventoryResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("product.v1.InventoryService.BatchReserveInventory is not implemented"))
 }
@@ -384,3 +765,31 @@ func (UnimplementedInventoryServiceHandler) UpdateReservation(context.Context, *
 func (UnimplementedInventoryServiceHandler) GetReservationStatus(context.Context, *connect.Request[v1.GetReservationStatusRequest]) (*connect.Response[v1.GetReservationStatusResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("product.v1.InventoryService.GetReservationStatus is not implemented"))
 }
+
+func (UnimplementedInventoryServiceHandler) GetSKUVelocity(context.Context, *connect.Request[v1.GetSKUVelocityRequest]) (*connect.Response[v1.GetSKUVelocityResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("product.v1.InventoryService.GetSKUVelocity is not implemented"))
+}
+
+func (UnimplementedInventoryServiceHandler) ListInventoryMovements(context.Context, *connect.Request[v1.ListInventoryMovementsRequest]) (*connect.Response[v1.ListInventoryMovementsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("product.v1.InventoryService.ListInventoryMovements is not implemented"))
+}
+
+func (UnimplementedInventoryServiceHandler) SetLowStockThreshold(context.Context, *connect.Request[v1.SetLowStockThresholdRequest]) (*connect.Response[v1.SetLowStockThresholdResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("product.v1.InventoryService.SetLowStockThreshold is not implemented"))
+}
+
+func (UnimplementedInventoryServiceHandler) ListLowStockSKUs(context.Context, *connect.Request[v1.ListLowStockSKUsRequest]) (*connect.Response[v1.ListLowStockSKUsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("product.v1.InventoryService.ListLowStockSKUs is not implemented"))
+}
+
+func (UnimplementedInventoryServiceHandler) ListReservations(context.Context, *connect.Request[v1.ListReservationsRequest]) (*connect.Response[v1.ListReservationsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("product.v1.InventoryService.ListReservations is not implemented"))
+}
+
+func (UnimplementedInventoryServiceHandler) ForceReleaseReservation(context.Context, *connect.Request[v1.ForceReleaseReservationRequest]) (*connect.Response[v1.ForceReleaseReservationResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("product.v1.InventoryService.ForceReleaseReservation is not implemented"))
+}
+
+func (UnimplementedInventoryServiceHandler) DrainSKUReservations(context.Context, *connect.Request[v1.DrainSKUReservationsRequest]) (*connect.Response[v1.DrainSKUReservationsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("product.v1.InventoryService.DrainSKUReservations is not implemented"))
+}
@@ -44,6 +44,9 @@ const (
 	// ProductServiceGetProductProcedure is the fully-qualified name of the ProductService's GetProduct
 	// RPC.
 	ProductServiceGetProductProcedure = "/product.v1.ProductService/GetProduct"
+	// ProductServiceGetProductsByIDsProcedure is the fully-qualified name of the ProductService's
+	// GetProductsByIDs RPC.
+	ProductServiceGetProductsByIDsProcedure = "/product.v1.ProductService/GetProductsByIDs"
 	// ProductServiceUpdateProductProcedure is the fully-qualified name of the ProductService's
 	// UpdateProduct RPC.
 	ProductServiceUpdateProductProcedure = "/product.v1.ProductService/UpdateProduct"
@@ -62,17 +65,53 @@ const (
 	// ProductServiceUnpublishProductProcedure is the fully-qualified name of the ProductService's
 	// UnpublishProduct RPC.
 	ProductServiceUnpublishProductProcedure = "/product.v1.ProductService/UnpublishProduct"
+	// ProductServiceUpdateProductVisibilityProcedure is the fully-qualified name of the
+	// ProductService's UpdateProductVisibility RPC.
+	ProductServiceUpdateProductVisibilityProcedure = "/product.v1.ProductService/UpdateProductVisibility"
+	// ProductServiceImportProductsProcedure is the fully-qualified name of the ProductService's
+	// ImportProducts RPC.
+	ProductServiceImportProductsProcedure = "/product.v1.ProductService/ImportProducts"
+	// ProductServiceGetProductImportProcedure is the fully-qualified name of the ProductService's
+	// GetProductImport RPC.
+	ProductServiceGetProductImportProcedure = "/product.v1.ProductService/GetProductImport"
 	// ProductServiceCreateSKUProcedure is the fully-qualified name of the ProductService's CreateSKU
 	// RPC.
 	ProductServiceCreateSKUProcedure = "/product.v1.ProductService/CreateSKU"
+	// ProductServiceGenerateSKUsProcedure is the fully-qualified name of the ProductService's
+	// GenerateSKUs RPC.
+	ProductServiceGenerateSKUsProcedure = "/product.v1.ProductService/GenerateSKUs"
 	// ProductServiceGetSKUProcedure is the fully-qualified name of the ProductService's GetSKU RPC.
 	ProductServiceGetSKUProcedure = "/product.v1.ProductService/GetSKU"
+	// ProductServiceGetSKUsByIDsProcedure is the fully-qualified name of the ProductService's
+	// GetSKUsByIDs RPC.
+	ProductServiceGetSKUsByIDsProcedure = "/product.v1.ProductService/GetSKUsByIDs"
 	// ProductServiceUpdateSKUProcedure is the fully-qualified name of the ProductService's UpdateSKU
 	// RPC.
 	ProductServiceUpdateSKUProcedure = "/product.v1.ProductService/UpdateSKU"
 	// ProductServiceDeleteSKUProcedure is the fully-qualified name of the ProductService's DeleteSKU
 	// RPC.
 	ProductServiceDeleteSKUProcedure = "/product.v1.ProductService/DeleteSKU"
+	// ProductServiceSchedulePriceChangeProcedure is the fully-qualified name of the ProductService's
+	// SchedulePriceChange RPC.
+	ProductServiceSchedulePriceChangeProcedure = "/product.v1.ProductService/SchedulePriceChange"
+	// ProductServiceGetPriceHistoryProcedure is the fully-qualified name of the ProductService's
+	// GetPriceHistory RPC.
+	ProductServiceGetPriceHistoryProcedure = "/product.v1.ProductService/GetPriceHistory"
+	// ProductServiceCreateProductImageUploadProcedure is the fully-qualified name of the
+	// ProductService's CreateProductImageUpload RPC.
+	ProductServiceCreateProductImageUploadProcedure = "/product.v1.ProductService/CreateProductImageUpload"
+	// ProductServiceCompleteProductImageUploadProcedure is the fully-qualified name of the
+	// ProductService's CompleteProductImageUpload RPC.
+	ProductServiceCompleteProductImageUploadProcedure = "/product.v1.ProductService/CompleteProductImageUpload"
+	// ProductServiceUpdateProductImageProcedure is the fully-qualified name of the ProductService's
+	// UpdateProductImage RPC.
+	ProductServiceUpdateProductImageProcedure = "/product.v1.ProductService/UpdateProductImage"
+	// ProductServiceReorderProductImagesProcedure is the fully-qualified name of the ProductService's
+	// ReorderProductImages RPC.
+	ProductServiceReorderProductImagesProcedure = "/product.v1.ProductService/ReorderProductImages"
+	// ProductServiceDeleteProductImageProcedure is the fully-qualified name of the ProductService's
+	// DeleteProductImage RPC.
+	ProductServiceDeleteProductImageProcedure = "/product.v1.ProductService/DeleteProductImage"
 	// ProductServiceCreateCategoryProcedure is the fully-qualified name of the ProductService's
 	// CreateCategory RPC.
 	ProductServiceCreateCategoryProcedure = "/product.v1.ProductService/CreateCategory"
@@ -82,12 +121,27 @@ const (
 	// ProductServiceListCategoriesProcedure is the fully-qualified name of the ProductService's
 	// ListCategories RPC.
 	ProductServiceListCategoriesProcedure = "/product.v1.ProductService/ListCategories"
+	// ProductServiceGetCategoryTreeProcedure is the fully-qualified name of the ProductService's
+	// GetCategoryTree RPC.
+	ProductServiceGetCategoryTreeProcedure = "/product.v1.ProductService/GetCategoryTree"
 	// ProductServiceUpdateCategoryProcedure is the fully-qualified name of the ProductService's
 	// UpdateCategory RPC.
 	ProductServiceUpdateCategoryProcedure = "/product.v1.ProductService/UpdateCategory"
 	// ProductServiceDeleteCategoryProcedure is the fully-qualified name of the ProductService's
 	// DeleteCategory RPC.
 	ProductServiceDeleteCategoryProcedure = "/product.v1.ProductService/DeleteCategory"
+	// ProductServiceCreateAttributeDefinitionProcedure is the fully-qualified name of the
+	// ProductService's CreateAttributeDefinition RPC.
+	ProductServiceCreateAttributeDefinitionProcedure = "/product.v1.ProductService/CreateAttributeDefinition"
+	// ProductServiceListAttributeDefinitionsProcedure is the fully-qualified name of the
+	// ProductService's ListAttributeDefinitions RPC.
+	ProductServiceListAttributeDefinitionsProcedure = "/product.v1.ProductService/ListAttributeDefinitions"
+	// ProductServiceUpdateAttributeDefinitionProcedure is the fully-qualified name of the
+	// ProductService's UpdateAttributeDefinition RPC.
+	ProductServiceUpdateAttributeDefinitionProcedure = "/product.v1.ProductService/UpdateAttributeDefinition"
+	// ProductServiceDeleteAttributeDefinitionProcedure is the fully-qualified name of the
+	// ProductService's DeleteAttributeDefinition RPC.
+	ProductServiceDeleteAttributeDefinitionProcedure = "/product.v1.ProductService/DeleteAttributeDefinition"
 )
 
 // ProductServiceClient is a client for the product.v1.ProductService service.
@@ -97,8 +151,14 @@ type ProductServiceClient interface {
 	// Returns PERMISSION_DENIED if caller lacks admin role.
 	CreateProduct(context.Context, *connect.Request[v1.CreateProductRequest]) (*connect.Response[v1.CreateProductResponse], error)
 	// GetProduct retrieves a product by ID.
-	// Returns NOT_FOUND if product doesn't exist or is soft-deleted.
+	// Returns NOT_FOUND if product doesn't exist, is soft-deleted, or is not
+	// visible in the caller's channel and market.
 	GetProduct(context.Context, *connect.Request[v1.GetProductRequest]) (*connect.Response[v1.GetProductResponse], error)
+	// GetProductsByIDs retrieves up to 100 products in one call (without SKUs).
+	// Missing or soft-deleted products are reported per ID with found = false
+	// instead of failing the whole request.
+	// Returns INVALID_ARGUMENT if ids is empty, exceeds the limit, or contains a malformed ID.
+	GetProductsByIDs(context.Context, *connect.Request[v1.GetProductsByIDsRequest]) (*connect.Response[v1.GetProductsByIDsResponse], error)
 	// UpdateProduct modifies an existing product.
 	// Returns NOT_FOUND if product doesn't exist.
 	// Returns PERMISSION_DENIED if caller lacks admin role.
@@ -109,7 +169,8 @@ type ProductServiceClient interface {
 	// Returns PERMISSION_DENIED if caller lacks admin role.
 	DeleteProduct(context.Context, *connect.Request[v1.DeleteProductRequest]) (*connect.Response[v1.DeleteProductResponse], error)
 	// ListProducts returns a paginated list of products with optional filtering.
-	// Only returns PUBLISHED products for public queries.
+	// Only returns PUBLISHED products for public queries, and only products
+	// visible in the caller's channel and market (x-channel / x-market).
 	ListProducts(context.Context, *connect.Request[v1.ListProductsRequest]) (*connect.Response[v1.ListProductsResponse], error)
 	// PublishProduct changes status from DRAFT or HIDDEN to PUBLISHED.
 	// Returns FAILED_PRECONDITION if current status doesn't allow transition.
@@ -120,20 +181,93 @@ type ProductServiceClient interface {
 	// UnpublishProduct changes status back to DRAFT.
 	// Returns FAILED_PRECONDITION if current status doesn't allow transition.
 	UnpublishProduct(context.Context, *connect.Request[v1.UnpublishProductRequest]) (*connect.Response[v1.UnpublishProductResponse], error)
+	// UpdateProductVisibility restricts the sales channels and markets in which
+	// public reads return the product. Empty lists remove the restriction.
+	// Returns NOT_FOUND if product doesn't exist.
+	// Returns INVALID_ARGUMENT for an unknown channel or malformed market code.
+	UpdateProductVisibility(context.Context, *connect.Request[v1.UpdateProductVisibilityRequest]) (*connect.Response[v1.UpdateProductVisibilityResponse], error)
+	// ImportProducts creates products with their SKUs and initial inventory
+	// from a CSV or NDJSON payload of up to 32 MiB. The payload is parsed
+	// immediately; products are then validated and written in the background
+	// in transactions of 100, skipping invalid products. Poll
+	// GetProductImport (or OperationsService.GetOperation) for progress and
+	// the per-row results.
+	// Returns INVALID_ARGUMENT if the format is unknown, the payload is too
+	// large or empty, or the CSV header or syntax is broken.
+	ImportProducts(context.Context, *connect.Request[v1.ImportProductsRequest]) (*connect.Response[v1.ImportProductsResponse], error)
+	// GetProductImport returns the state of an import and, once it has
+	// succeeded, its per-row results.
+	// Returns NOT_FOUND if the operation doesn't exist or is not an import.
+	GetProductImport(context.Context, *connect.Request[v1.GetProductImportRequest]) (*connect.Response[v1.GetProductImportResponse], error)
 	// CreateSKU adds a new variant to an existing product.
 	// Returns NOT_FOUND if parent product doesn't exist.
 	// Returns ALREADY_EXISTS if SKU code is already in use.
+	// Returns INVALID_ARGUMENT if attributes don't match the attribute
+	// definitions of the product's category.
 	CreateSKU(context.Context, *connect.Request[v1.CreateSKURequest]) (*connect.Response[v1.CreateSKUResponse], error)
+	// GenerateSKUs creates a SKU for every combination of the values of the
+	// given attribute dimensions (e.g. color × size) in one transaction.
+	// Combinations that an existing SKU of the product already has are
+	// skipped, so a dimension can be extended and generated again.
+	// Returns NOT_FOUND if parent product doesn't exist.
+	// Returns ALREADY_EXISTS if a generated SKU code is already in use; no
+	// SKU is created.
+	// Returns INVALID_ARGUMENT if the dimensions or price rules are invalid,
+	// there are more than 500 combinations, or a combination doesn't match
+	// the attribute definitions of the product's category.
+	GenerateSKUs(context.Context, *connect.Request[v1.GenerateSKUsRequest]) (*connect.Response[v1.GenerateSKUsResponse], error)
 	// GetSKU retrieves a SKU by ID including inventory information.
 	// Returns NOT_FOUND if SKU doesn't exist or is soft-deleted.
 	GetSKU(context.Context, *connect.Request[v1.GetSKURequest]) (*connect.Response[v1.GetSKUResponse], error)
+	// GetSKUsByIDs retrieves up to 100 SKUs with inventory in one call.
+	// Missing or soft-deleted SKUs are reported per ID with found = false
+	// instead of failing the whole request.
+	// Returns INVALID_ARGUMENT if ids is empty, exceeds the limit, or contains a malformed ID.
+	GetSKUsByIDs(context.Context, *connect.Request[v1.GetSKUsByIDsRequest]) (*connect.Response[v1.GetSKUsByIDsResponse], error)
 	// UpdateSKU modifies an existing SKU.
 	// Returns NOT_FOUND if SKU doesn't exist.
+	// Returns INVALID_ARGUMENT if attributes are set and don't match the
+	// attribute definitions of the product's category.
 	UpdateSKU(context.Context, *connect.Request[v1.UpdateSKURequest]) (*connect.Response[v1.UpdateSKUResponse], error)
 	// DeleteSKU performs soft deletion of a SKU.
 	// Returns NOT_FOUND if SKU doesn't exist.
 	// Returns FAILED_PRECONDITION if SKU has pending reservations.
 	DeleteSKU(context.Context, *connect.Request[v1.DeleteSKURequest]) (*connect.Response[v1.DeleteSKUResponse], error)
+	// SchedulePriceChange sets a SKU's price in one currency at a future time.
+	// A price in the base currency replaces the base price; any other currency
+	// adds or replaces an additional price.
+	// Returns NOT_FOUND if SKU doesn't exist.
+	// Returns INVALID_ARGUMENT if effective_from is not in the future.
+	SchedulePriceChange(context.Context, *connect.Request[v1.SchedulePriceChangeRequest]) (*connect.Response[v1.SchedulePriceChangeResponse], error)
+	// GetPriceHistory lists a SKU's applied and scheduled price changes,
+	// newest effective_from first.
+	// Returns NOT_FOUND if SKU doesn't exist.
+	GetPriceHistory(context.Context, *connect.Request[v1.GetPriceHistoryRequest]) (*connect.Response[v1.GetPriceHistoryResponse], error)
+	// CreateProductImageUpload registers a pending image and returns a
+	// presigned URL to PUT the file to, with the given content type, before
+	// upload_expires_at. The image is shown once CompleteProductImageUpload
+	// has been called.
+	// Returns NOT_FOUND if product doesn't exist.
+	// Returns INVALID_ARGUMENT if content_type is not a supported image type.
+	// Returns FAILED_PRECONDITION if the product already has 20 images.
+	// Returns UNIMPLEMENTED if image storage is not configured.
+	CreateProductImageUpload(context.Context, *connect.Request[v1.CreateProductImageUploadRequest]) (*connect.Response[v1.CreateProductImageUploadResponse], error)
+	// CompleteProductImageUpload verifies the uploaded file and adds the image
+	// after the product's other images.
+	// Returns NOT_FOUND if image doesn't exist.
+	// Returns FAILED_PRECONDITION if the file has not been uploaded or the
+	// upload is already complete.
+	// Returns INVALID_ARGUMENT if the file exceeds 10 MiB; the image is deleted.
+	CompleteProductImageUpload(context.Context, *connect.Request[v1.CompleteProductImageUploadRequest]) (*connect.Response[v1.CompleteProductImageUploadResponse], error)
+	// UpdateProductImage modifies an image's alt text.
+	// Returns NOT_FOUND if image doesn't exist.
+	UpdateProductImage(context.Context, *connect.Request[v1.UpdateProductImageRequest]) (*connect.Response[v1.UpdateProductImageResponse], error)
+	// ReorderProductImages sets the display order of a product's images.
+	// Returns INVALID_ARGUMENT unless image_ids lists every image exactly once.
+	ReorderProductImages(context.Context, *connect.Request[v1.ReorderProductImagesRequest]) (*connect.Response[v1.ReorderProductImagesResponse], error)
+	// DeleteProductImage deletes an image and its file.
+	// Returns NOT_FOUND if image doesn't exist.
+	DeleteProductImage(context.Context, *connect.Request[v1.DeleteProductImageRequest]) (*connect.Response[v1.DeleteProductImageResponse], error)
 	// CreateCategory creates a new category.
 	// Returns ALREADY_EXISTS if category name already exists under same parent.
 	CreateCategory(context.Context, *connect.Request[v1.CreateCategoryRequest]) (*connect.Response[v1.CreateCategoryResponse], error)
@@ -142,6 +276,10 @@ type ProductServiceClient interface {
 	GetCategory(context.Context, *connect.Request[v1.GetCategoryRequest]) (*connect.Response[v1.GetCategoryResponse], error)
 	// ListCategories returns the full category tree structure.
 	ListCategories(context.Context, *connect.Request[v1.ListCategoriesRequest]) (*connect.Response[v1.ListCategoriesResponse], error)
+	// GetCategoryTree returns categories nested to max_depth with the number
+	// of published products per category and per subtree.
+	// Returns NOT_FOUND if root_id is set and the category doesn't exist.
+	GetCategoryTree(context.Context, *connect.Request[v1.GetCategoryTreeRequest]) (*connect.Response[v1.GetCategoryTreeResponse], error)
 	// UpdateCategory modifies an existing category.
 	// Returns NOT_FOUND if category doesn't exist.
 	// Returns FAILED_PRECONDITION if update would create a cycle.
@@ -149,6 +287,24 @@ type ProductServiceClient interface {
 	// DeleteCategory performs soft deletion of a category.
 	// Returns FAILED_PRECONDITION if category contains products.
 	DeleteCategory(context.Context, *connect.Request[v1.DeleteCategoryRequest]) (*connect.Response[v1.DeleteCategoryResponse], error)
+	// CreateAttributeDefinition defines a SKU attribute for the products of a
+	// category. Existing SKUs are not revalidated.
+	// Returns NOT_FOUND if category doesn't exist.
+	// Returns ALREADY_EXISTS if the category already defines the name.
+	// Returns INVALID_ARGUMENT if name, type or allowed_values are invalid.
+	CreateAttributeDefinition(context.Context, *connect.Request[v1.CreateAttributeDefinitionRequest]) (*connect.Response[v1.CreateAttributeDefinitionResponse], error)
+	// ListAttributeDefinitions returns the attribute definitions of a category
+	// ordered by name.
+	// Returns NOT_FOUND if category doesn't exist.
+	ListAttributeDefinitions(context.Context, *connect.Request[v1.ListAttributeDefinitionsRequest]) (*connect.Response[v1.ListAttributeDefinitionsResponse], error)
+	// UpdateAttributeDefinition replaces the type, allowed values and required
+	// flag of a definition. Existing SKUs are not revalidated.
+	// Returns NOT_FOUND if definition doesn't exist.
+	UpdateAttributeDefinition(context.Context, *connect.Request[v1.UpdateAttributeDefinitionRequest]) (*connect.Response[v1.UpdateAttributeDefinitionResponse], error)
+	// DeleteAttributeDefinition removes a definition; SKU attributes of that
+	// name become free-form again.
+	// Returns NOT_FOUND if definition doesn't exist.
+	DeleteAttributeDefinition(context.Context, *connect.Request[v1.DeleteAttributeDefinitionRequest]) (*connect.Response[v1.DeleteAttributeDefinitionResponse], error)
 }
 
 // NewProductServiceClient constructs a client for the product.v1.ProductService service. By
@@ -174,6 +330,12 @@ func NewProductServiceClient(httpClient connect.HTTPClient, baseURL string, opts
 			connect.WithSchema(productServiceMethods.ByName("GetProduct")),
 			connect.WithClientOptions(opts...),
 		),
+		getProductsByIDs: connect.NewClient[v1.GetProductsByIDsRequest, v1.GetProductsByIDsResponse](
+			httpClient,
+			baseURL+ProductServiceGetProductsByIDsProcedure,
+			connect.WithSchema(productServiceMethods.ByName("GetProductsByIDs")),
+			connect.WithClientOptions(opts...),
+		),
 		updateProduct: connect.NewClient[v1.UpdateProductRequest, v1.UpdateProductResponse](
 			httpClient,
 			baseURL+ProductServiceUpdateProductProcedure,
@@ -210,18 +372,48 @@ func NewProductServiceClient(httpClient connect.HTTPClient, baseURL string, opts
 			connect.WithSchema(productServiceMethods.ByName("UnpublishProduct")),
 			connect.WithClientOptions(opts...),
 		),
+		updateProductVisibility: connect.NewClient[v1.UpdateProductVisibilityRequest, v1.UpdateProductVisibilityResponse](
+			httpClient,
+			baseURL+ProductServiceUpdateProductVisibilityProcedure,
+			connect.WithSchema(productServiceMethods.ByName("UpdateProductVisibility")),
+			connect.WithClientOptions(opts...),
+		),
+		importProducts: connect.NewClient[v1.ImportProductsRequest, v1.ImportProductsResponse](
+			httpClient,
+			baseURL+ProductServiceImportProductsProcedure,
+			connect.WithSchema(productServiceMethods.ByName("ImportProducts")),
+			connect.WithClientOptions(opts...),
+		),
+		getProductImport: connect.NewClient[v1.GetProductImportRequest, v1.GetProductImportResponse](
+			httpClient,
+			baseURL+ProductServiceGetProductImportProcedure,
+			connect.WithSchema(productServiceMethods.ByName("GetProductImport")),
+			connect.WithClientOptions(opts...),
+		),
 		createSKU: connect.NewClient[v1.CreateSKURequest, v1.CreateSKUResponse](
 			httpClient,
 			baseURL+ProductServiceCreateSKUProcedure,
 			connect.WithSchema(productServiceMethods.ByName("CreateSKU")),
 			connect.WithClientOptions(opts...),
 		),
+		generateSKUs: connect.NewClient[v1.GenerateSKUsRequest, v1.GenerateSKUsResponse](
+			httpClient,
+			baseURL+ProductServiceGenerateSKUsProcedure,
+			connect.WithSchema(productServiceMethods.ByName("GenerateSKUs")),
+			connect.WithClientOptions(opts...),
+		),
 		getSKU: connect.NewClient[v1.GetSKURequest, v1.GetSKUResponse](
 			httpClient,
 			baseURL+ProductServiceGetSKUProcedure,
 			connect.WithSchema(productServiceMethods.ByName("GetSKU")),
 			connect.WithClientOptions(opts...),
 		),
+		getSKUsByIDs: connect.NewClient[v1.GetSKUsByIDsRequest, v1.GetSKUsByIDsResponse](
+			httpClient,
+			baseURL+ProductServiceGetSKUsByIDsProcedure,
+			connect.WithSchema(productServiceMethods.ByName("GetSKUsByIDs")),
+			connect.WithClientOptions(opts...),
+		),
 		updateSKU: connect.NewClient[v1.UpdateSKURequest, v1.UpdateSKUResponse](
 			httpClient,
 			baseURL+ProductServiceUpdateSKUProcedure,
@@ -234,6 +426,48 @@ func NewProductServiceClient(httpClient connect.HTTPClient, baseURL string, opts
 			connect.WithSchema(productServiceMethods.ByName("DeleteSKU")),
 			connect.WithClientOptions(opts...),
 		),
+		schedulePriceChange: connect.NewClient[v1.SchedulePriceChangeRequest, v1.SchedulePriceChangeResponse](
+			httpClient,
+			baseURL+ProductServiceSchedulePriceChangeProcedure,
+			connect.WithSchema(productServiceMethods.ByName("SchedulePriceChange")),
+			connect.WithClientOptions(opts...),
+		),
+		getPriceHistory: connect.NewClient[v1.GetPriceHistoryRequest, v1.GetPriceHistoryResponse](
+			httpClient,
+			baseURL+ProductServiceGetPriceHistoryProcedure,
+			connect.WithSchema(productServiceMethods.ByName("GetPriceHistory")),
+			connect.WithClientOptions(opts...),
+		),
+		createProductImageUpload: connect.NewClient[v1.CreateProductImageUploadRequest, v1.CreateProductImageUploadResponse](
+			httpClient,
+			baseURL+ProductServiceCreateProductImageUploadProcedure,
+			connect.WithSchema(productServiceMethods.ByName("CreateProductImageUpload")),
+			connect.WithClientOptions(opts...),
+		),
+		completeProductImageUpload: connect.NewClient[v1.CompleteProductImageUploadRequest, v1.CompleteProductImageUploadResponse](
+			httpClient,
+			baseURL+ProductServiceCompleteProductImageUploadProcedure,
+			connect.WithSchema(productServiceMethods.ByName("CompleteProductImageUpload")),
+			connect.WithClientOptions(opts...),
+		),
+		updateProductImage: connect.NewClient[v1.UpdateProductImageRequest, v1.UpdateProductImageResponse](
+			httpClient,
+			baseURL+ProductServiceUpdateProductImageProcedure,
+			connect.WithSchema(productServiceMethods.ByName("UpdateProductImage")),
+			connect.WithClientOptions(opts...),
+		),
+		reorderProductImages: connect.NewClient[v1.ReorderProductImagesRequest, v1.ReorderProductImagesResponse](
+			httpClient,
+			baseURL+ProductServiceReorderProductImagesProcedure,
+			connect.WithSchema(productServiceMethods.ByName("ReorderProductImages")),
+			connect.WithClientOptions(opts...),
+		),
+		deleteProductImage: connect.NewClient[v1.DeleteProductImageRequest, v1.DeleteProductImageResponse](
+			httpClient,
+			baseURL+ProductServiceDeleteProductImageProcedure,
+			connect.WithSchema(productServiceMethods.ByName("DeleteProductImage")),
+			connect.WithClientOptions(opts...),
+		),
 		createCategory: connect.NewClient[v1.CreateCategoryRequest, v1.CreateCategoryResponse](
 			httpClient,
 			baseURL+ProductServiceCreateCategoryProcedure,
@@ -252,6 +486,12 @@ func NewProductServiceClient(httpClient connect.HTTPClient, baseURL string, opts
 			connect.WithSchema(productServiceMethods.ByName("ListCategories")),
 			connect.WithClientOptions(opts...),
 		),
+		getCategoryTree: connect.NewClient[v1.GetCategoryTreeRequest, v1.GetCategoryTreeResponse](
+			httpClient,
+			baseURL+ProductServiceGetCategoryTreeProcedure,
+			connect.WithSchema(productServiceMethods.ByName("GetCategoryTree")),
+			connect.WithClientOptions(opts...),
+		),
 		updateCategory: connect.NewClient[v1.UpdateCategoryRequest, v1.UpdateCategoryResponse](
 			httpClient,
 			baseURL+ProductServiceUpdateCategoryProcedure,
@@ -264,28 +504,70 @@ func NewProductServiceClient(httpClient connect.HTTPClient, baseURL string, opts
 			connect.WithSchema(productServiceMethods.ByName("DeleteCategory")),
 			connect.WithClientOptions(opts...),
 		),
+		createAttributeDefinition: connect.NewClient[v1.CreateAttributeDefinitionRequest, v1.CreateAttributeDefinitionResponse](
+			httpClient,
+			baseURL+ProductServiceCreateAttributeDefinitionProcedure,
+			connect.WithSchema(productServiceMethods.ByName("CreateAttributeDefinition")),
+			connect.WithClientOptions(opts...),
+		),
+		listAttributeDefinitions: connect.NewClient[v1.ListAttributeDefinitionsRequest, v1.ListAttributeDefinitionsResponse](
+			httpClient,
+			baseURL+ProductServiceListAttributeDefinitionsProcedure,
+			connect.WithSchema(productServiceMethods.ByName("ListAttributeDefinitions")),
+			connect.WithClientOptions(opts...),
+		),
+		updateAttributeDefinition: connect.NewClient[v1.UpdateAttributeDefinitionRequest, v1.UpdateAttributeDefinitionResponse](
+			httpClient,
+			baseURL+ProductServiceUpdateAttributeDefinitionProcedure,
+			connect.WithSchema(productServiceMethods.ByName("UpdateAttributeDefinition")),
+			connect.WithClientOptions(opts...),
+		),
+		deleteAttributeDefinition: connect.NewClient[v1.DeleteAttributeDefinitionRequest, v1.DeleteAttributeDefinitionResponse](
+			httpClient,
+			baseURL+ProductServiceDeleteAttributeDefinitionProcedure,
+			connect.WithSchema(productServiceMethods.ByName("DeleteAttributeDefinition")),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
 // productServiceClient implements ProductServiceClient.
 type productServiceClient struct {
-	createProduct    *connect.Client[v1.CreateProductRequest, v1.CreateProductResponse]
-	getProduct       *connect.Client[v1.GetProductRequest, v1.GetProductResponse]
-	updateProduct    *connect.Client[v1.UpdateProductRequest, v1.UpdateProductResponse]
-	deleteProduct    *connect.Client[v1.DeleteProductRequest, v1.DeleteProductResponse]
-	listProducts     *connect.Client[v1.ListProductsRequest, v1.ListProductsResponse]
-	publishProduct   *connect.Client[v1.PublishProductRequest, v1.PublishProductResponse]
-	hideProduct      *connect.Client[v1.HideProductRequest, v1.HideProductResponse]
-	unpublishProduct *connect.Client[v1.UnpublishProductRequest, v1.UnpublishProductResponse]
-	createSKU        *connect.Client[v1.CreateSKURequest, v1.CreateSKUResponse]
-	getSKU           *connect.Client[v1.GetSKURequest, v1.GetSKUResponse]
-	updateSKU        *connect.Client[v1.UpdateSKURequest, v1.UpdateSKUResponse]
-	deleteSKU        *connect.Client[v1.DeleteSKURequest, v1.DeleteSKUResponse]
-	createCategory   *connect.Client[v1.CreateCategoryRequest, v1.CreateCategoryResponse]
-	getCategory      *connect.Client[v1.GetCategoryRequest, v1.GetCategoryResponse]
-	listCategories   *connect.Client[v1.ListCategoriesRequest, v1.ListCategoriesResponse]
-	updateCategory   *connect.Client[v1.UpdateCategoryRequest, v1.UpdateCategoryResponse]
-	deleteCategory   *connect.Client[v1.DeleteCategoryRequest, v1.DeleteCategoryResponse]
+	createProduct              *connect.Client[v1.CreateProductRequest, v1.CreateProductResponse]
+	getProduct                 *connect.Client[v1.GetProductRequest, v1.GetProductResponse]
+	getProductsByIDs           *connect.Client[v1.GetProductsByIDsRequest, v1.GetProductsByIDsResponse]
+	updateProduct              *connect.Client[v1.UpdateProductRequest, v1.UpdateProductResponse]
+	deleteProduct              *connect.Client[v1.DeleteProductRequest, v1.DeleteProductResponse]
+	listProducts               *connect.Client[v1.ListProductsRequest, v1.ListProductsResponse]
+	publishProduct             *connect.Client[v1.PublishProductRequest, v1.PublishProductResponse]
+	hideProduct                *connect.Client[v1.HideProductRequest, v1.HideProductResponse]
+	unpublishProduct           *connect.Client[v1.UnpublishProductRequest, v1.UnpublishProductResponse]
+	updateProductVisibility    *connect.Client[v1.UpdateProductVisibilityRequest, v1.UpdateProductVisibilityResponse]
+	importProducts             *connect.Client[v1.ImportProductsRequest, v1.ImportProductsResponse]
+	getProductImport           *connect.Client[v1.GetProductImportRequest, v1.GetProductImportResponse]
+	createSKU                  *connect.Client[v1.CreateSKURequest, v1.CreateSKUResponse]
+	generateSKUs               *connect.Client[v1.GenerateSKUsRequest, v1.GenerateSKUsResponse]
+	getSKU                     *connect.Client[v1.GetSKURequest, v1.GetSKUResponse]
+	getSKUsByIDs               *connect.Client[v1.GetSKUsByIDsRequest, v1.GetSKUsByIDsResponse]
+	updateSKU                  *connect.Client[v1.UpdateSKURequest, v1.UpdateSKUResponse]
+	deleteSKU                  *connect.Client[v1.DeleteSKURequest, v1.DeleteSKUResponse]
+	schedulePriceChange        *connect.Client[v1.SchedulePriceChangeRequest, v1.SchedulePriceChangeResponse]
+	getPriceHistory            *connect.Client[v1.GetPriceHistoryRequest, v1.GetPriceHistoryResponse]
+	createProductImageUpload   *connect.Client[v1.CreateProductImageUploadRequest, v1.CreateProductImageUploadResponse]
+	completeProductImageUpload *connect.Client[v1.CompleteProductImageUploadRequest, v1.CompleteProductImageUploadResponse]
+	updateProductImage         *connect.Client[v1.UpdateProductImageRequest, v1.UpdateProductImageResponse]
+	reorderProductImages       *connect.Client[v1.ReorderProductImagesRequest, v1.ReorderProductImagesResponse]
+	deleteProductImage         *connect.Client[v1.DeleteProductImageRequest, v1.DeleteProductImageResponse]
+	createCategory             *connect.Client[v1.CreateCategoryRequest, v1.CreateCategoryResponse]
+	getCategory                *connect.Client[v1.GetCategoryRequest, v1.GetCategoryResponse]
+	listCategories             *connect.Client[v1.ListCategoriesRequest, v1.ListCategoriesResponse]
+	getCategoryTree            *connect.Client[v1.GetCategoryTreeRequest, v1.GetCategoryTreeResponse]
+	updateCategory             *connect.Client[v1.UpdateCategoryRequest, v1.UpdateCategoryResponse]
+	deleteCategory             *connect.Client[v1.DeleteCategoryRequest, v1.DeleteCategoryResponse]
+	createAttributeDefinition  *connect.Client[v1.CreateAttributeDefinitionRequest, v1.CreateAttributeDefinitionResponse]
+	listAttributeDefinitions   *connect.Client[v1.ListAttributeDefinitionsRequest, v1.ListAttributeDefinitionsResponse]
+	updateAttributeDefinition  *connect.Client[v1.UpdateAttributeDefinitionRequest, v1.UpdateAttributeDefinitionResponse]
+	deleteAttributeDefinition  *connect.Client[v1.DeleteAttributeDefinitionRequest, v1.DeleteAttributeDefinitionResponse]
 }
 
 // CreateProduct calls product.v1.ProductService.CreateProduct.
@@ -298,6 +580,11 @@ func (c *productServiceClient) GetProduct(ctx context.Context, req *connect.Requ
 	return c.getProduct.CallUnary(ctx, req)
 }
 
+// GetProductsByIDs calls product.v1.ProductService.GetProductsByIDs.
+func (c *productServiceClient) GetProductsByIDs(ctx context.Context, req *connect.Request[v1.GetProductsByIDsRequest]) (*connect.Response[v1.GetProductsByIDsResponse], error) {
+	return c.getProductsByIDs.CallUnary(ctx, req)
+}
+
 // UpdateProduct calls product.v1.ProductService.UpdateProduct.
 func (c *productServiceClient) UpdateProduct(ctx context.Context, req *connect.Request[v1.UpdateProductRequest]) (*connect.Response[v1.UpdateProductResponse], error) {
 	return c.updateProduct.CallUnary(ctx, req)
@@ -328,16 +615,41 @@ func (c *productServiceClient) UnpublishProduct(ctx context.Context, req *connec
 	return c.unpublishProduct.CallUnary(ctx, req)
 }
 
+// UpdateProductVisibility calls product.v1.ProductService.UpdateProductVisibility.
+func (c *productServiceClient) UpdateProductVisibility(ctx context.Context, req *connect.Request[v1.UpdateProductVisibilityRequest]) (*connect.Response[v1.UpdateProductVisibilityResponse], error) {
+	return c.updateProductVisibility.CallUnary(ctx, req)
+}
+
+// ImportProducts calls product.v1.ProductService.ImportProducts.
+func (c *productServiceClient) ImportProducts(ctx context.Context, req *connect.Request[v1.ImportProductsRequest]) (*connect.Response[v1.ImportProductsResponse], error) {
+	return c.importProducts.CallUnary(ctx, req)
+}
+
+// GetProductImport calls product.v1.ProductService.GetProductImport.
+func (c *productServiceClient) GetProductImport(ctx context.Context, req *connect.Request[v1.GetProductImportRequest]) (*connect.Response[v1.GetProductImportResponse], error) {
+	return c.getProductImport.CallUnary(ctx, req)
+}
+
 // CreateSKU calls product.v1.ProductService.CreateSKU.
 func (c *productServiceClient) CreateSKU(ctx context.Context, req *connect.Request[v1.CreateSKURequest]) (*connect.Response[v1.CreateSKUResponse], error) {
 	return c.createSKU.CallUnary(ctx, req)
 }
 
+// GenerateSKUs calls product.v1.ProductService.GenerateSKUs.
+func (c *productServiceClient) GenerateSKUs(ctx context.Context, req *connect.Request[v1.GenerateSKUsRequest]) (*connect.Response[v1.GenerateSKUsResponse], error) {
+	return c.generateSKUs.CallUnary(ctx, req)
+}
+
 // GetSKU calls product.v1.ProductService.GetSKU.
 func (c *productServiceClient) GetSKU(ctx context.Context, req *connect.Request[v1.GetSKURequest]) (*connect.Response[v1.GetSKUResponse], error) {
 	return c.getSKU.CallUnary(ctx, req)
 }
 
+// GetSKUsByIDs calls product.v1.ProductService.GetSKUsByIDs.
+func (c *productServiceClient) GetSKUsByIDs(ctx context.Context, req *connect.Request[v1.GetSKUsByIDsRequest]) (*connect.Response[v1.GetSKUsByIDsResponse], error) {
+	return c.getSKUsByIDs.CallUnary(ctx, req)
+}
+
 // UpdateSKU calls product.v1.ProductService.UpdateSKU.
 func (c *productServiceClient) UpdateSKU(ctx context.Context, req *connect.Request[v1.UpdateSKURequest]) (*connect.Response[v1.UpdateSKUResponse], error) {
 	return c.updateSKU.CallUnary(ctx, req)
@@ -348,6 +660,41 @@ func (c *productServiceClient) DeleteSKU(ctx context.Context, req *connect.Reque
 	return c.deleteSKU.CallUnary(ctx, req)
 }
 
+// SchedulePriceChange calls product.v1.ProductService.SchedulePriceChange.
+func (c *productServiceClient) SchedulePriceChange(ctx context.Context, req *connect.Request[v1.SchedulePriceChangeRequest]) (*connect.Response[v1.SchedulePriceChangeResponse], error) {
+	return c.schedulePriceChange.CallUnary(ctx, req)
+}
+
+// GetPriceHistory calls product.v1.ProductService.GetPriceHistory.
+func (c *productServiceClient) GetPriceHistory(ctx context.Context, req *connect.Request[v1.GetPriceHistoryRequest]) (*connect.Response[v1.GetPriceHistoryResponse], error) {
+	return c.getPriceHistory.CallUnary(ctx, req)
+}
+
+// CreateProductImageUpload calls product.v1.ProductService.CreateProductImageUpload.
+func (c *productServiceClient) CreateProductImageUpload(ctx context.Context, req *connect.Request[v1.CreateProductImageUploadRequest]) (*connect.Response[v1.CreateProductImageUploadResponse], error) {
+	return c.createProductImageUpload.CallUnary(ctx, req)
+}
+
+// CompleteProductImageUpload calls product.v1.ProductService.CompleteProductImageUpload.
+func (c *productServiceClient) CompleteProductImageUpload(ctx context.Context, req *connect.Request[v1.CompleteProductImageUploadRequest]) (*connect.Response[v1.CompleteProductImageUploadResponse], error) {
+	return c.completeProductImageUpload.CallUnary(ctx, req)
+}
+
+// UpdateProductImage calls product.v1.ProductService.UpdateProductImage.
+func (c *productServiceClient) UpdateProductImage(ctx context.Context, req *connect.Request[v1.UpdateProductImageRequest]) (*connect.Response[v1.UpdateProductImageResponse], error) {
+	return c.updateProductImage.CallUnary(ctx, req)
+}
+
+// ReorderProductImages calls product.v1.ProductService.ReorderProductImages.
+func (c *productServiceClient) ReorderProductImages(ctx context.Context, req *connect.Request[v1.ReorderProductImagesRequest]) (*connect.Response[v1.ReorderProductImagesResponse], error) {
+	return c.reorderProductImages.CallUnary(ctx, req)
+}
+
+// DeleteProductImage calls product.v1.ProductService.DeleteProductImage.
+func (c *productServiceClient) DeleteProductImage(ctx context.Context, req *connect.Request[v1.DeleteProductImageRequest]) (*connect.Response[v1.DeleteProductImageResponse], error) {
+	return c.deleteProductImage.CallUnary(ctx, req)
+}
+
 // CreateCategory calls product.v1.ProductService.CreateCategory.
 func (c *productServiceClient) CreateCategory(ctx context.Context, req *connect.Request[v1.CreateCategoryRequest]) (*connect.Response[v1.CreateCategoryResponse], error) {
 	return c.createCategory.CallUnary(ctx, req)
@@ -363,6 +710,11 @@ func (c *productServiceClient) ListCategories(ctx context.Context, req *connect.
 	return c.listCategories.CallUnary(ctx, req)
 }
 
+// GetCategoryTree calls product.v1.ProductService.GetCategoryTree.
+func (c *productServiceClient) GetCategoryTree(ctx context.Context, req *connect.Request[v1.GetCategoryTreeRequest]) (*connect.Response[v1.GetCategoryTreeResponse], error) {
+	return c.getCategoryTree.CallUnary(ctx, req)
+}
+
 // UpdateCategory calls product.v1.ProductService.UpdateCategory.
 func (c *productServiceClient) UpdateCategory(ctx context.Context, req *connect.Request[v1.UpdateCategoryRequest]) (*connect.Response[v1.UpdateCategoryResponse], error) {
 	return c.updateCategory.CallUnary(ctx, req)
@@ -373,6 +725,26 @@ func (c *productServiceClient) DeleteCategory(ctx context.Context, req *connect.
 	return c.deleteCategory.CallUnary(ctx, req)
 }
 
+// CreateAttributeDefinition calls product.v1.ProductService.CreateAttributeDefinition.
+func (c *productServiceClient) CreateAttributeDefinition(ctx context.Context, req *connect.Request[v1.CreateAttributeDefinitionRequest]) (*connect.Response[v1.CreateAttributeDefinitionResponse], error) {
+	return c.createAttributeDefinition.CallUnary(ctx, req)
+}
+
+// ListAttributeDefinitions calls product.v1.ProductService.ListAttributeDefinitions.
+func (c *productServiceClient) ListAttributeDefinitions(ctx context.Context, req *connect.Request[v1.ListAttributeDefinitionsRequest]) (*connect.Response[v1.ListAttributeDefinitionsResponse], error) {
+	return c.listAttributeDefinitions.CallUnary(ctx, req)
+}
+
+// UpdateAttributeDefinition calls product.v1.ProductService.UpdateAttributeDefinition.
+func (c *productServiceClient) UpdateAttributeDefinition(ctx context.Context, req *connect.Request[v1.UpdateAttributeDefinitionRequest]) (*connect.Response[v1.UpdateAttributeDefinitionResponse], error) {
+	return c.updateAttributeDefinition.CallUnary(ctx, req)
+}
+
+// DeleteAttributeDefinition calls product.v1.ProductService.DeleteAttributeDefinition.
+func (c *productServiceClient) DeleteAttributeDefinition(ctx context.Context, req *connect.Request[v1.DeleteAttributeDefinitionRequest]) (*connect.Response[v1.DeleteAttributeDefinitionResponse], error) {
+	return c.deleteAttributeDefinition.CallUnary(ctx, req)
+}
+
 // ProductServiceHandler is an implementation of the product.v1.ProductService service.
 type ProductServiceHandler interface {
 	// CreateProduct creates a new product in the catalog.
@@ -380,8 +752,14 @@ type ProductServiceHandler interface {
 	// Returns PERMISSION_DENIED if caller lacks admin role.
 	CreateProduct(context.Context, *connect.Request[v1.CreateProductRequest]) (*connect.Response[v1.CreateProductResponse], error)
 	// GetProduct retrieves a product by ID.
-	// Returns NOT_FOUND if product doesn't exist or is soft-deleted.
+	// Returns NOT_FOUND if product doesn't exist, is soft-deleted, or is not
+	// visible in the caller's channel and market.
 	GetProduct(context.Context, *connect.Request[v1.GetProductRequest]) (*connect.Response[v1.GetProductResponse], error)
+	// GetProductsByIDs retrieves up to 100 products in one call (without SKUs).
+	// Missing or soft-deleted products are reported per ID with found = false
+	// instead of failing the whole request.
+	// Returns INVALID_ARGUMENT if ids is empty, exceeds the limit, or contains a malformed ID.
+	GetProductsByIDs(context.Context, *connect.Request[v1.GetProductsByIDsRequest]) (*connect.Response[v1.GetProductsByIDsResponse], error)
 	// UpdateProduct modifies an existing product.
 	// Returns NOT_FOUND if product doesn't exist.
 	// Returns PERMISSION_DENIED if caller lacks admin role.
@@ -392,7 +770,8 @@ type ProductServiceHandler interface {
 	// Returns PERMISSION_DENIED if caller lacks admin role.
 	DeleteProduct(context.Context, *connect.Request[v1.DeleteProductRequest]) (*connect.Response[v1.DeleteProductResponse], error)
 	// ListProducts returns a paginated list of products with optional filtering.
-	// Only returns PUBLISHED products for public queries.
+	// Only returns PUBLISHED products for public queries, and only products
+	// visible in the caller's channel and market (x-channel / x-market).
 	ListProducts(context.Context, *connect.Request[v1.ListProductsRequest]) (*connect.Response[v1.ListProductsResponse], error)
 	// PublishProduct changes status from DRAFT or HIDDEN to PUBLISHED.
 	// Returns FAILED_PRECONDITION if current status doesn't allow transition.
@@ -403,20 +782,93 @@ type ProductServiceHandler interface {
 	// UnpublishProduct changes status back to DRAFT.
 	// Returns FAILED_PRECONDITION if current status doesn't allow transition.
 	UnpublishProduct(context.Context, *connect.Request[v1.UnpublishProductRequest]) (*connect.Response[v1.UnpublishProductResponse], error)
+	// UpdateProductVisibility restricts the sales channels and markets in which
+	// public reads return the product. Empty lists remove the restriction.
+	// Returns NOT_FOUND if product doesn't exist.
+	// Returns INVALID_ARGUMENT for an unknown channel or malformed market code.
+	UpdateProductVisibility(context.Context, *connect.Request[v1.UpdateProductVisibilityRequest]) (*connect.Response[v1.UpdateProductVisibilityResponse], error)
+	// ImportProducts creates products with their SKUs and initial inventory
+	// from a CSV or NDJSON payload of up to 32 MiB. The payload is parsed
+	// immediately; products are then validated and written in the background
+	// in transactions of 100, skipping invalid products. Poll
+	// GetProductImport (or OperationsService.GetOperation) for progress and
+	// the per-row results.
+	// Returns INVALID_ARGUMENT if the format is unknown, the payload is too
+	// large or empty, or the CSV header or syntax is broken.
+	ImportProducts(context.Context, *connect.Request[v1.ImportProductsRequest]) (*connect.Response[v1.ImportProductsResponse], error)
+	// GetProductImport returns the state of an import and, once it has
+	// succeeded, its per-row results.
+	// Returns NOT_FOUND if the operation doesn't exist or is not an import.
+	GetProductImport(context.Context, *connect.Request[v1.GetProductImportRequest]) (*connect.Response[v1.GetProductImportResponse], error)
 	// CreateSKU adds a new variant to an existing product.
 	// Returns NOT_FOUND if parent product doesn't exist.
 	// Returns ALREADY_EXISTS if SKU code is already in use.
+	// Returns INVALID_ARGUMENT if attributes don't match the attribute
+	// definitions of the product's category.
 	CreateSKU(context.Context, *connect.Request[v1.CreateSKURequest]) (*connect.Response[v1.CreateSKUResponse], error)
+	// GenerateSKUs creates a SKU for every combination of the values of the
+	// given attribute dimensions (e.g. color × size) in one transaction.
+	// Combinations that an existing SKU of the product already has are
+	// skipped, so a dimension can be extended and generated again.
+	// Returns NOT_FOUND if parent product doesn't exist.
+	// Returns ALREADY_EXISTS if a generated SKU code is already in use; no
+	// SKU is created.
+	// Returns INVALID_ARGUMENT if the dimensions or price rules are invalid,
+	// there are more than 500 combinations, or a combination doesn't match
+	// the attribute definitions of the product's category.
+	GenerateSKUs(context.Context, *connect.Request[v1.GenerateSKUsRequest]) (*connect.Response[v1.GenerateSKUsResponse], error)
 	// GetSKU retrieves a SKU by ID including inventory information.
 	// Returns NOT_FOUND if SKU doesn't exist or is soft-deleted.
 	GetSKU(context.Context, *connect.Request[v1.GetSKURequest]) (*connect.Response[v1.GetSKUResponse], error)
+	// GetSKUsByIDs retrieves up to 100 SKUs with inventory in one call.
+	// Missing or soft-deleted SKUs are reported per ID with found = false
+	// instead of failing the whole request.
+	// Returns INVALID_ARGUMENT if ids is empty, exceeds the limit, or contains a malformed ID.
+	GetSKUsByIDs(context.Context, *connect.Request[v1.GetSKUsByIDsRequest]) (*connect.Response[v1.GetSKUsByIDsResponse], error)
 	// UpdateSKU modifies an existing SKU.
 	// Returns NOT_FOUND if SKU doesn't exist.
+	// Returns INVALID_ARGUMENT if attributes are set and don't match the
+	// attribute definitions of the product's category.
 	UpdateSKU(context.Context, *connect.Request[v1.UpdateSKURequest]) (*connect.Response[v1.UpdateSKUResponse], error)
 	// DeleteSKU performs soft deletion of a SKU.
 	// Returns NOT_FOUND if SKU doesn't exist.
 	// Returns FAILED_PRECONDITION if SKU has pending reservations.
 	DeleteSKU(context.Context, *connect.Request[v1.DeleteSKURequest]) (*connect.Response[v1.DeleteSKUResponse], error)
+	// SchedulePriceChange sets a SKU's price in one currency at a future time.
+	// A price in the base currency replaces the base price; any other currency
+	// adds or replaces an additional price.
+	// Returns NOT_FOUND if SKU doesn't exist.
+	// Returns INVALID_ARGUMENT if effective_from is not in the future.
+	SchedulePriceChange(context.Context, *connect.Request[v1.SchedulePriceChangeRequest]) (*connect.Response[v1.SchedulePriceChangeResponse], error)
+	// GetPriceHistory lists a SKU's applied and scheduled price changes,
+	// newest effective_from first.
+	// Returns NOT_FOUND if SKU doesn't exist.
+	GetPriceHistory(context.Context, *connect.Request[v1.GetPriceHistoryRequest]) (*connect.Response[v1.GetPriceHistoryResponse], error)
+	// CreateProductImageUpload registers a pending image and returns a
+	// presigned URL to PUT the file to, with the given content type, before
+	// upload_expires_at. The image is shown once CompleteProductImageUpload
+	// has been called.
+	// Returns NOT_FOUND if product doesn't exist.
+	// Returns INVALID_ARGUMENT if content_type is not a supported image type.
+	// Returns FAILED_PRECONDITION if the product already has 20 images.
+	// Returns UNIMPLEMENTED if image storage is not configured.
+	CreateProductImageUpload(context.Context, *connect.Request[v1.CreateProductImageUploadRequest]) (*connect.Response[v1.CreateProductImageUploadResponse], error)
+	// CompleteProductImageUpload verifies the uploaded file and adds the image
+	// after the product's other images.
+	// Returns NOT_FOUND if image doesn't exist.
+	// Returns FAILED_PRECONDITION if the file has not been uploaded or the
+	// upload is already complete.
+	// Returns INVALID_ARGUMENT if the file exceeds 10 MiB; the image is deleted.
+	CompleteProductImageUpload(context.Context, *connect.Request[v1.CompleteProductImageUploadRequest]) (*connect.Response[v1.CompleteProductImageUploadResponse], error)
+	// UpdateProductImage modifies an image's alt text.
+	// Returns NOT_FOUND if image doesn't exist.
+	UpdateProductImage(context.Context, *connect.Request[v1.UpdateProductImageRequest]) (*connect.Response[v1.UpdateProductImageResponse], error)
+	// ReorderProductImages sets the display order of a product's images.
+	// Returns INVALID_ARGUMENT unless image_ids lists every image exactly once.
+	ReorderProductImages(context.Context, *connect.Request[v1.ReorderProductImagesRequest]) (*connect.Response[v1.ReorderProductImagesResponse], error)
+	// DeleteProductImage deletes an image and its file.
+	// Returns NOT_FOUND if image doesn't exist.
+	DeleteProductImage(context.Context, *connect.Request[v1.DeleteProductImageRequest]) (*connect.Response[v1.DeleteProductImageResponse], error)
 	// CreateCategory creates a new category.
 	// Returns ALREADY_EXISTS if category name already exists under same parent.
 	CreateCategory(context.Context, *connect.Request[v1.CreateCategoryRequest]) (*connect.Response[v1.CreateCategoryResponse], error)
@@ -425,6 +877,10 @@ type ProductServiceHandler interface {
 	GetCategory(context.Context, *connect.Request[v1.GetCategoryRequest]) (*connect.Response[v1.GetCategoryResponse], error)
 	// ListCategories returns the full category tree structure.
 	ListCategories(context.Context, *connect.Request[v1.ListCategoriesRequest]) (*connect.Response[v1.ListCategoriesResponse], error)
+	// GetCategoryTree returns categories nested to max_depth with the number
+	// of published products per category and per subtree.
+	// Returns NOT_FOUND if root_id is set and the category doesn't exist.
+	GetCategoryTree(context.Context, *connect.Request[v1.GetCategoryTreeRequest]) (*connect.Response[v1.GetCategoryTreeResponse], error)
 	// UpdateCategory modifies an existing category.
 	// Returns NOT_FOUND if category doesn't exist.
 	// Returns FAILED_PRECONDITION if update would create a cycle.
@@ -432,6 +888,24 @@ type ProductServiceHandler interface {
 	// DeleteCategory performs soft deletion of a category.
 	// Returns FAILED_PRECONDITION if category contains products.
 	DeleteCategory(context.Context, *connect.Request[v1.DeleteCategoryRequest]) (*connect.Response[v1.DeleteCategoryResponse], error)
+	// CreateAttributeDefinition defines a SKU attribute for the products of a
+	// category. Existing SKUs are not revalidated.
+	// Returns NOT_FOUND if category doesn't exist.
+	// Returns ALREADY_EXISTS if the category already defines the name.
+	// Returns INVALID_ARGUMENT if name, type or allowed_values are invalid.
+	CreateAttributeDefinition(context.Context, *connect.Request[v1.CreateAttributeDefinitionRequest]) (*connect.Response[v1.CreateAttributeDefinitionResponse], error)
+	// ListAttributeDefinitions returns the attribute definitions of a category
+	// ordered by name.
+	// Returns NOT_FOUND if category doesn't exist.
+	ListAttributeDefinitions(context.Context, *connect.Request[v1.ListAttributeDefinitionsRequest]) (*connect.Response[v1.ListAttributeDefinitionsResponse], error)
+	// UpdateAttributeDefinition replaces the type, allowed values and required
+	// flag of a definition. Existing SKUs are not revalidated.
+	// Returns NOT_FOUND if definition doesn't exist.
+	UpdateAttributeDefinition(context.Context, *connect.Request[v1.UpdateAttributeDefinitionRequest]) (*connect.Response[v1.UpdateAttributeDefinitionResponse], error)
+	// DeleteAttributeDefinition removes a definition; SKU attributes of that
+	// name become free-form again.
+	// Returns NOT_FOUND if definition doesn't exist.
+	DeleteAttributeDefinition(context.Context, *connect.Request[v1.DeleteAttributeDefinitionRequest]) (*connect.Response[v1.DeleteAttributeDefinitionResponse], error)
 }
 
 // NewProductServiceHandler builds an HTTP handler from the service implementation. It returns the
@@ -453,6 +927,12 @@ func NewProductServiceHandler(svc ProductServiceHandler, opts ...connect.Handler
 		connect.WithSchema(productServiceMethods.ByName("GetProduct")),
 		connect.WithHandlerOptions(opts...),
 	)
+	productServiceGetProductsByIDsHandler := connect.NewUnaryHandler(
+		ProductServiceGetProductsByIDsProcedure,
+		svc.GetProductsByIDs,
+		connect.WithSchema(productServiceMethods.ByName("GetProductsByIDs")),
+		connect.WithHandlerOptions(opts...),
+	)
 	productServiceUpdateProductHandler := connect.NewUnaryHandler(
 		ProductServiceUpdateProductProcedure,
 		svc.UpdateProduct,
@@ -489,18 +969,48 @@ func NewProductServiceHandler(svc ProductServiceHandler, opts ...connect.Handler
 		connect.WithSchema(productServiceMethods.ByName("UnpublishProduct")),
 		connect.WithHandlerOptions(opts...),
 	)
+	productServiceUpdateProductVisibilityHandler := connect.NewUnaryHandler(
+		ProductServiceUpdateProductVisibilityProcedure,
+		svc.UpdateProductVisibility,
+		connect.WithSchema(productServiceMethods.ByName("UpdateProductVisibility")),
+		connect.WithHandlerOptions(opts...),
+	)
+	productServiceImportProductsHandler := connect.NewUnaryHandler(
+		ProductServiceImportProductsProcedure,
+		svc.ImportProducts,
+		connect.WithSchema(productServiceMethods.ByName("ImportProducts")),
+		connect.WithHandlerOptions(opts...),
+	)
+	productServiceGetProductImportHandler := connect.NewUnaryHandler(
+		ProductServiceGetProductImportProcedure,
+		svc.GetProductImport,
+		connect.WithSchema(productServiceMethods.ByName("GetProductImport")),
+		connect.WithHandlerOptions(opts...),
+	)
 	productServiceCreateSKUHandler := connect.NewUnaryHandler(
 		ProductServiceCreateSKUProcedure,
 		svc.CreateSKU,
 		connect.WithSchema(productServiceMethods.ByName("CreateSKU")),
 		connect.WithHandlerOptions(opts...),
 	)
+	productServiceGenerateSKUsHandler := connect.NewUnaryHandler(
+		ProductServiceGenerateSKUsProcedure,
+		svc.GenerateSKUs,
+		connect.WithSchema(productServiceMethods.ByName("GenerateSKUs")),
+		connect.WithHandlerOptions(opts...),
+	)
 	productServiceGetSKUHandler := connect.NewUnaryHandler(
 		ProductServiceGetSKUProcedure,
 		svc.GetSKU,
 		connect.WithSchema(productServiceMethods.ByName("GetSKU")),
 		connect.WithHandlerOptions(opts...),
 	)
+	productServiceGetSKUsByIDsHandler := connect.NewUnaryHandler(
+		ProductServiceGetSKUsByIDsProcedure,
+		svc.GetSKUsByIDs,
+		connect.WithSchema(productServiceMethods.ByName("GetSKUsByIDs")),
+		connect.WithHandlerOptions(opts...),
+	)
 	productServiceUpdateSKUHandler := connect.NewUnaryHandler(
 		ProductServiceUpdateSKUProcedure,
 		svc.UpdateSKU,
@@ -513,6 +1023,48 @@ func NewProductServiceHandler(svc ProductServiceHandler, opts ...connect.Handler
 		connect.WithSchema(productServiceMethods.ByName("DeleteSKU")),
 		connect.WithHandlerOptions(opts...),
 	)
+	productServiceSchedulePriceChangeHandler := connect.NewUnaryHandler(
+		ProductServiceSchedulePriceChangeProcedure,
+		svc.SchedulePriceChange,
+		connect.WithSchema(productServiceMethods.ByName("SchedulePriceChange")),
+		connect.WithHandlerOptions(opts...),
+	)
+	productServiceGetPriceHistoryHandler := connect.NewUnaryHandler(
+		ProductServiceGetPriceHistoryProcedure,
+		svc.GetPriceHistory,
+		connect.WithSchema(productServiceMethods.ByName("GetPriceHistory")),
+		connect.WithHandlerOptions(opts...),
+	)
+	productServiceCreateProductImageUploadHandler := connect.NewUnaryHandler(
+		ProductServiceCreateProductImageUploadProcedure,
+		svc.CreateProductImageUpload,
+		connect.WithSchema(productServiceMethods.ByName("CreateProductImageUpload")),
+		connect.WithHandlerOptions(opts...),
+	)
+	productServiceCompleteProductImageUploadHandler := connect.NewUnaryHandler(
+		ProductServiceCompleteProductImageUploadProcedure,
+		svc.CompleteProductImageUpload,
+		connect.WithSchema(productServiceMethods.ByName("CompleteProductImageUpload")),
+		connect.WithHandlerOptions(opts...),
+	)
+	productServiceUpdateProductImageHandler := connect.NewUnaryHandler(
+		ProductServiceUpdateProductImageProcedure,
+		svc.UpdateProductImage,
+		connect.WithSchema(productServiceMethods.ByName("UpdateProductImage")),
+		connect.WithHandlerOptions(opts...),
+	)
+	productServiceReorderProductImagesHandler := connect.NewUnaryHandler(
+		ProductServiceReorderProductImagesProcedure,
+		svc.ReorderProductImages,
+		connect.WithSchema(productServiceMethods.ByName("ReorderProductImages")),
+		connect.WithHandlerOptions(opts...),
+	)
+	productServiceDeleteProductImageHandler := connect.NewUnaryHandler(
+		ProductServiceDeleteProductImageProcedure,
+		svc.DeleteProductImage,
+		connect.WithSchema(productServiceMethods.ByName("DeleteProductImage")),
+		connect.WithHandlerOptions(opts...),
+	)
 	productServiceCreateCategoryHandler := connect.NewUnaryHandler(
 		ProductServiceCreateCategoryProcedure,
 		svc.CreateCategory,
@@ -531,6 +1083,12 @@ func NewProductServiceHandler(svc ProductServiceHandler, opts ...connect.Handler
 		connect.WithSchema(productServiceMethods.ByName("ListCategories")),
 		connect.WithHandlerOptions(opts...),
 	)
+	productServiceGetCategoryTreeHandler := connect.NewUnaryHandler(
+		ProductServiceGetCategoryTreeProcedure,
+		svc.GetCategoryTree,
+		connect.WithSchema(productServiceMethods.ByName("GetCategoryTree")),
+		connect.WithHandlerOptions(opts...),
+	)
 	productServiceUpdateCategoryHandler := connect.NewUnaryHandler(
 		ProductServiceUpdateCategoryProcedure,
 		svc.UpdateCategory,
@@ -543,12 +1101,38 @@ func NewProductServiceHandler(svc ProductServiceHandler, opts ...connect.Handler
 		connect.WithSchema(productServiceMethods.ByName("DeleteCategory")),
 		connect.WithHandlerOptions(opts...),
 	)
+	productServiceCreateAttributeDefinitionHandler := connect.NewUnaryHandler(
+		ProductServiceCreateAttributeDefinitionProcedure,
+		svc.CreateAttributeDefinition,
+		connect.WithSchema(productServiceMethods.ByName("CreateAttributeDefinition")),
+		connect.WithHandlerOptions(opts...),
+	)
+	productServiceListAttributeDefinitionsHandler := connect.NewUnaryHandler(
+		ProductServiceListAttributeDefinitionsProcedure,
+		svc.ListAttributeDefinitions,
+		connect.WithSchema(productServiceMethods.ByName("ListAttributeDefinitions")),
+		connect.WithHandlerOptions(opts...),
+	)
+	productServiceUpdateAttributeDefinitionHandler := connect.NewUnaryHandler(
+		ProductServiceUpdateAttributeDefinitionProcedure,
+		svc.UpdateAttributeDefinition,
+		connect.WithSchema(productServiceMethods.ByName("UpdateAttributeDefinition")),
+		connect.WithHandlerOptions(opts...),
+	)
+	productServiceDeleteAttributeDefinitionHandler := connect.NewUnaryHandler(
+		ProductServiceDeleteAttributeDefinitionProcedure,
+		svc.DeleteAttributeDefinition,
+		connect.WithSchema(productServiceMethods.ByName("DeleteAttributeDefinition")),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/product.v1.ProductService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case ProductServiceCreateProductProcedure:
 			productServiceCreateProductHandler.ServeHTTP(w, r)
 		case ProductServiceGetProductProcedure:
 			productServiceGetProductHandler.ServeHTTP(w, r)
+		case ProductServiceGetProductsByIDsProcedure:
+			productServiceGetProductsByIDsHandler.ServeHTTP(w, r)
 		case ProductServiceUpdateProductProcedure:
 			productServiceUpdateProductHandler.ServeHTTP(w, r)
 		case ProductServiceDeleteProductProcedure:
@@ -561,24 +1145,58 @@ func NewProductServiceHandler(svc ProductServiceHandler, opts ...connect.Handler
 			productServiceHideProductHandler.ServeHTTP(w, r)
 		case ProductServiceUnpublishProductProcedure:
 			productServiceUnpublishProductHandler.ServeHTTP(w, r)
+		case ProductServiceUpdateProductVisibilityProcedure:
+			productServiceUpdateProductVisibilityHandler.ServeHTTP(w, r)
+		case ProductServiceImportProductsProcedure:
+			productServiceImportProductsHandler.ServeHTTP(w, r)
+		case ProductServiceGetProductImportProcedure:
+			productServiceGetProductImportHandler.ServeHTTP(w, r)
 		case ProductServiceCreateSKUProcedure:
 			productServiceCreateSKUHandler.ServeHTTP(w, r)
+		case ProductServiceGenerateSKUsProcedure:
+			productServiceGenerateSKUsHandler.ServeHTTP(w, r)
 		case ProductServiceGetSKUProcedure:
 			productServiceGetSKUHandler.ServeHTTP(w, r)
+		case ProductServiceGetSKUsByIDsProcedure:
+			productServiceGetSKUsByIDsHandler.ServeHTTP(w, r)
 		case ProductServiceUpdateSKUProcedure:
 			productServiceUpdateSKUHandler.ServeHTTP(w, r)
 		case ProductServiceDeleteSKUProcedure:
 			productServiceDeleteSKUHandler.ServeHTTP(w, r)
+		case ProductServiceSchedulePriceChangeProcedure:
+			productServiceSchedulePriceChangeHandler.ServeHTTP(w, r)
+		case ProductServiceGetPriceHistoryProcedure:
+			productServiceGetPriceHistoryHandler.ServeHTTP(w, r)
+		case ProductServiceCreateProductImageUploadProcedure:
+			productServiceCreateProductImageUploadHandler.ServeHTTP(w, r)
+		case ProductServiceCompleteProductImageUploadProcedure:
+			productServiceCompleteProductImageUploadHandler.ServeHTTP(w, r)
+		case ProductServiceUpdateProductImageProcedure:
+			productServiceUpdateProductImageHandler.ServeHTTP(w, r)
+		case ProductServiceReorderProductImagesProcedure:
+			productServiceReorderProductImagesHandler.ServeHTTP(w, r)
+		case ProductServiceDeleteProductImageProcedure:
+			productServiceDeleteProductImageHandler.ServeHTTP(w, r)
 		case ProductServiceCreateCategoryProcedure:
 			productServiceCreateCategoryHandler.ServeHTTP(w, r)
 		case ProductServiceGetCategoryProcedure:
 			productServiceGetCategoryHandler.ServeHTTP(w, r)
 		case ProductServiceListCategoriesProcedure:
 			productServiceListCategoriesHandler.ServeHTTP(w, r)
+		case ProductServiceGetCategoryTreeProcedure:
+			productServiceGetCategoryTreeHandler.ServeHTTP(w, r)
 		case ProductServiceUpdateCategoryProcedure:
 			productServiceUpdateCategoryHandler.ServeHTTP(w, r)
 		case ProductServiceDeleteCategoryProcedure:
 			productServiceDeleteCategoryHandler.ServeHTTP(w, r)
+		case ProductServiceCreateAttributeDefinitionProcedure:
+			productServiceCreateAttributeDefinitionHandler.ServeHTTP(w, r)
+		case ProductServiceListAttributeDefinitionsProcedure:
+			productServiceListAttributeDefinitionsHandler.ServeHTTP(w, r)
+		case ProductServiceUpdateAttributeDefinitionProcedure:
+			productServiceUpdateAttributeDefinitionHandler.ServeHTTP(w, r)
+		case ProductServiceDeleteAttributeDefinitionProcedure:
+			productServiceDeleteAttributeDefinitionHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -596,6 +1214,10 @@ func (UnimplementedProductServiceHandler) GetProduct(context.Context, *connect.R
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("product.v1.ProductService.GetProduct is not implemented"))
 }
 
+func (UnimplementedProductServiceHandler) GetProductsByIDs(context.Context, *connect.Request[v1.GetProductsByIDsRequest]) (*connect.Response[v1.GetProductsByIDsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("product.v1.ProductService.GetProductsByIDs is not implemented"))
+}
+
 func (UnimplementedProductServiceHandler) UpdateProduct(context.Context, *connect.Request[v1.UpdateProductRequest]) (*connect.Response[v1.UpdateProductResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("product.v1.ProductService.UpdateProduct is not implemented"))
 }
@@ -620,14 +1242,34 @@ func (UnimplementedProductServiceHandler) UnpublishProduct(context.Context, *con
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("product.v1.ProductService.UnpublishProduct is not implemented"))
 }
 
+func (UnimplementedProductServiceHandler) UpdateProductVisibility(context.Context, *connect.Request[v1.UpdateProductVisibilityRequest]) (*connect.Response[v1.UpdateProductVisibilityResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("product.v1.ProductService.UpdateProductVisibility is not implemented"))
+}
+
+func (UnimplementedProductServiceHandler) ImportProducts(context.Context, *connect.Request[v1.ImportProductsRequest]) (*connect.Response[v1.ImportProductsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("product.v1.ProductService.ImportProducts is not implemented"))
+}
+
+func (UnimplementedProductServiceHandler) GetProductImport(context.Context, *connect.Request[v1.GetProductImportRequest]) (*connect.Response[v1.GetProductImportResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("product.v1.ProductService.GetProductImport is not implemented"))
+}
+
 func (UnimplementedProductServiceHandler) CreateSKU(context.Context, *connect.Request[v1.CreateSKURequest]) (*connect.Response[v1.CreateSKUResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("product.v1.ProductService.CreateSKU is not implemented"))
 }
 
+func (UnimplementedProductServiceHandler) GenerateSKUs(context.Context, *connect.Request[v1.GenerateSKUsRequest]) (*connect.Response[v1.GenerateSKUsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("product.v1.ProductService.GenerateSKUs is not implemented"))
+}
+
 func (UnimplementedProductServiceHandler) GetSKU(context.Context, *connect.Request[v1.GetSKURequest]) (*connect.Response[v1.GetSKUResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("product.v1.ProductService.GetSKU is not implemented"))
 }
 
+func (UnimplementedProductServiceHandler) GetSKUsByIDs(context.Context, *connect.Request[v1.GetSKUsByIDsRequest]) (*connect.Response[v1.GetSKUsByIDsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("product.v1.ProductService.GetSKUsByIDs is not implemented"))
+}
+
 func (UnimplementedProductServiceHandler) UpdateSKU(context.Context, *connect.Request[v1.UpdateSKURequest]) (*connect.Response[v1.UpdateSKUResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("product.v1.ProductService.UpdateSKU is not implemented"))
 }
@@ -636,6 +1278,34 @@ func (UnimplementedProductServiceHandler) DeleteSKU(context.Context, *connect.Re
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("product.v1.ProductService.DeleteSKU is not implemented"))
 }
 
+func (UnimplementedProductServiceHandler) SchedulePriceChange(context.Context, *connect.Request[v1.SchedulePriceChangeRequest]) (*connect.Response[v1.SchedulePriceChangeResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("product.v1.ProductService.SchedulePriceChange is not implemented"))
+}
+
+func (UnimplementedProductServiceHandler) GetPriceHistory(context.Context, *connect.Request[v1.GetPriceHistoryRequest]) (*connect.Response[v1.GetPriceHistoryResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("product.v1.ProductService.GetPriceHistory is not implemented"))
+}
+
+func (UnimplementedProductServiceHandler) CreateProductImageUpload(context.Context, *connect.Request[v1.CreateProductImageUploadRequest]) (*connect.Response[v1.CreateProductImageUploadResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("product.v1.ProductService.CreateProductImageUpload is not implemented"))
+}
+
+func (UnimplementedProductServiceHandler) CompleteProductImageUpload(context.Context, *connect.Request[v1.CompleteProductImageUploadRequest]) (*connect.Response[v1.CompleteProductImageUploadResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("product.v1.ProductService.CompleteProductImageUpload is not implemented"))
+}
+
+func (UnimplementedProductServiceHandler) UpdateProductImage(context.Context, *connect.Request[v1.UpdateProductImageRequest]) (*connect.Response[v1.UpdateProductImageResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("product.v1.ProductService.UpdateProductImage is not implemented"))
+}
+
+func (UnimplementedProductServiceHandler) ReorderProductImages(context.Context, *connect.Request[v1.ReorderProductImagesRequest]) (*connect.Response[v1.ReorderProductImagesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("product.v1.ProductService.ReorderProductImages is not implemented"))
+}
+
+func (UnimplementedProductServiceHandler) DeleteProductImage(context.Context, *connect.Request[v1.DeleteProductImageRequest]) (*connect.Response[v1.DeleteProductImageResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("product.v1.ProductService.DeleteProductImage is not implemented"))
+}
+
 func (UnimplementedProductServiceHandler) CreateCategory(context.Context, *connect.Request[v1.CreateCategoryRequest]) (*connect.Response[v1.CreateCategoryResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("product.v1.ProductService.CreateCategory is not implemented"))
 }
@@ -648,6 +1318,10 @@ func (UnimplementedProductServiceHandler) ListCategories(context.Context, *conne
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("product.v1.ProductService.ListCategories is not implemented"))
 }
 
+func (UnimplementedProductServiceHandler) GetCategoryTree(context.Context, *connect.Request[v1.GetCategoryTreeRequest]) (*connect.Response[v1.GetCategoryTreeResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("product.v1.ProductService.GetCategoryTree is not implemented"))
+}
+
 func (UnimplementedProductServiceHandler) UpdateCategory(context.Context, *connect.Request[v1.UpdateCategoryRequest]) (*connect.Response[v1.UpdateCategoryResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("product.v1.ProductService.UpdateCategory is not implemented"))
 }
@@ -655,3 +1329,19 @@ func (UnimplementedProductServiceHandler) UpdateCategory(context.Context, *conne
 func (UnimplementedProductServiceHandler) DeleteCategory(context.Context, *connect.Request[v1.DeleteCategoryRequest]) (*connect.Response[v1.DeleteCategoryResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("product.v1.ProductService.DeleteCategory is not implemented"))
 }
+
+func (UnimplementedProductServiceHandler) CreateAttributeDefinition(context.Context, *connect.Request[v1.CreateAttributeDefinitionRequest]) (*connect.Response[v1.CreateAttributeDefinitionResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("product.v1.ProductService.CreateAttributeDefinition is not implemented"))
+}
+
+func (UnimplementedProductServiceHandler) ListAttributeDefinitions(context.Context, *connect.Request[v1.ListAttributeDefinitionsRequest]) (*connect.Response[v1.ListAttributeDefinitionsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("product.v1.ProductService.ListAttributeDefinitions is not implemented"))
+}
+
+func (UnimplementedProductServiceHandler) UpdateAttributeDefinition(context.Context, *connect.Request[v1.UpdateAttributeDefinitionRequest]) (*connect.Response[v1.UpdateAttributeDefinitionResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("product.v1.ProductService.UpdateAttributeDefinition is not implemented"))
+}
+
+func (UnimplementedProductServiceHandler) DeleteAttributeDefinition(context.Context, *connect.Request[v1.DeleteAttributeDefinitionRequest]) (*connect.Response[v1.DeleteAttributeDefinitionResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("product.v1.ProductService.DeleteAttributeDefinition is not implemented"))
+}
@@ -0,0 +1,196 @@
+// ==============================================================================
+// Worker Service API
+// Runbook actions on the Product Service's background workers
+// ==============================================================================
+
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: product/v1/worker_service.proto
+
+package productv1connect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	v1 "github.com/daisuke8000/example-ec-platform/gen/product/v1"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// WorkerServiceName is the fully-qualified name of the WorkerService service.
+	WorkerServiceName = "product.v1.WorkerService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// WorkerServiceListWorkersProcedure is the fully-qualified name of the WorkerService's ListWorkers
+	// RPC.
+	WorkerServiceListWorkersProcedure = "/product.v1.WorkerService/ListWorkers"
+	// WorkerServicePauseWorkerProcedure is the fully-qualified name of the WorkerService's PauseWorker
+	// RPC.
+	WorkerServicePauseWorkerProcedure = "/product.v1.WorkerService/PauseWorker"
+	// WorkerServiceResumeWorkerProcedure is the fully-qualified name of the WorkerService's
+	// ResumeWorker RPC.
+	WorkerServiceResumeWorkerProcedure = "/product.v1.WorkerService/ResumeWorker"
+)
+
+// WorkerServiceClient is a client for the product.v1.WorkerService service.
+type WorkerServiceClient interface {
+	// ListWorkers returns the background workers by name and whether they
+	// are paused.
+	ListWorkers(context.Context, *connect.Request[v1.ListWorkersRequest]) (*connect.Response[v1.ListWorkersResponse], error)
+	// PauseWorker pauses a worker. Pausing a paused worker keeps the
+	// original pause.
+	//
+	// Returns NOT_FOUND if there is no worker with the name.
+	// Returns INVALID_ARGUMENT if reason is empty or longer than 500
+	// characters.
+	PauseWorker(context.Context, *connect.Request[v1.PauseWorkerRequest]) (*connect.Response[v1.PauseWorkerResponse], error)
+	// ResumeWorker resumes a paused worker. Resuming a running worker does
+	// nothing.
+	//
+	// Returns NOT_FOUND if there is no worker with the name.
+	ResumeWorker(context.Context, *connect.Request[v1.ResumeWorkerRequest]) (*connect.Response[v1.ResumeWorkerResponse], error)
+}
+
+// NewWorkerServiceClient constructs a client for the product.v1.WorkerService service. By default,
+// it uses the Connect protocol with the binary Protobuf Codec, asks for gzipped responses, and
+// sends uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the connect.WithGRPC()
+// or connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewWorkerServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) WorkerServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	workerServiceMethods := v1.File_product_v1_worker_service_proto.Services().ByName("WorkerService").Methods()
+	return &workerServiceClient{
+		listWorkers: connect.NewClient[v1.ListWorkersRequest, v1.ListWorkersResponse](
+			httpClient,
+			baseURL+WorkerServiceListWorkersProcedure,
+			connect.WithSchema(workerServiceMethods.ByName("ListWorkers")),
+			connect.WithClientOptions(opts...),
+		),
+		pauseWorker: connect.NewClient[v1.PauseWorkerRequest, v1.PauseWorkerResponse](
+			httpClient,
+			baseURL+WorkerServicePauseWorkerProcedure,
+			connect.WithSchema(workerServiceMethods.ByName("PauseWorker")),
+			connect.WithClientOptions(opts...),
+		),
+		resumeWorker: connect.NewClient[v1.ResumeWorkerRequest, v1.ResumeWorkerResponse](
+			httpClient,
+			baseURL+WorkerServiceResumeWorkerProcedure,
+			connect.WithSchema(workerServiceMethods.ByName("ResumeWorker")),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// workerServiceClient implements WorkerServiceClient.
+type workerServiceClient struct {
+	listWorkers  *connect.Client[v1.ListWorkersRequest, v1.ListWorkersResponse]
+	pauseWorker  *connect.Client[v1.PauseWorkerRequest, v1.PauseWorkerResponse]
+	resumeWorker *connect.Client[v1.ResumeWorkerRequest, v1.ResumeWorkerResponse]
+}
+
+// ListWorkers calls product.v1.WorkerService.ListWorkers.
+func (c *workerServiceClient) ListWorkers(ctx context.Context, req *connect.Request[v1.ListWorkersRequest]) (*connect.Response[v1.ListWorkersResponse], error) {
+	return c.listWorkers.CallUnary(ctx, req)
+}
+
+// PauseWorker calls product.v1.WorkerService.PauseWorker.
+func (c *workerServiceClient) PauseWorker(ctx context.Context, req *connect.Request[v1.PauseWorkerRequest]) (*connect.Response[v1.PauseWorkerResponse], error) {
+	return c.pauseWorker.CallUnary(ctx, req)
+}
+
+// ResumeWorker calls product.v1.WorkerService.ResumeWorker.
+func (c *workerServiceClient) ResumeWorker(ctx context.Context, req *connect.Request[v1.ResumeWorkerRequest]) (*connect.Response[v1.ResumeWorkerResponse], error) {
+	return c.resumeWorker.CallUnary(ctx, req)
+}
+
+// WorkerServiceHandler is an implementation of the product.v1.WorkerService service.
+type WorkerServiceHandler interface {
+	// ListWorkers returns the background workers by name and whether they
+	// are paused.
+	ListWorkers(context.Context, *connect.Request[v1.ListWorkersRequest]) (*connect.Response[v1.ListWorkersResponse], error)
+	// PauseWorker pauses a worker. Pausing a paused worker keeps the
+	// original pause.
+	//
+	// Returns NOT_FOUND if there is no worker with the name.
+	// Returns INVALID_ARGUMENT if reason is empty or longer than 500
+	// characters.
+	PauseWorker(context.Context, *connect.Request[v1.PauseWorkerRequest]) (*connect.Response[v1.PauseWorkerResponse], error)
+	// ResumeWorker resumes a paused worker. Resuming a running worker does
+	// nothing.
+	//
+	// Returns NOT_FOUND if there is no worker with the name.
+	ResumeWorker(context.Context, *connect.Request[v1.ResumeWorkerRequest]) (*connect.Response[v1.ResumeWorkerResponse], error)
+}
+
+// NewWorkerServiceHandler builds an HTTP handler from the service implementation. It returns the
+// path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewWorkerServiceHandler(svc WorkerServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	workerServiceMethods := v1.File_product_v1_worker_service_proto.Services().ByName("WorkerService").Methods()
+	workerServiceListWorkersHandler := connect.NewUnaryHandler(
+		WorkerServiceListWorkersProcedure,
+		svc.ListWorkers,
+		connect.WithSchema(workerServiceMethods.ByName("ListWorkers")),
+		connect.WithHandlerOptions(opts...),
+	)
+	workerServicePauseWorkerHandler := connect.NewUnaryHandler(
+		WorkerServicePauseWorkerProcedure,
+		svc.PauseWorker,
+		connect.WithSchema(workerServiceMethods.ByName("PauseWorker")),
+		connect.WithHandlerOptions(opts...),
+	)
+	workerServiceResumeWorkerHandler := connect.NewUnaryHandler(
+		WorkerServiceResumeWorkerProcedure,
+		svc.ResumeWorker,
+		connect.WithSchema(workerServiceMethods.ByName("ResumeWorker")),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/product.v1.WorkerService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case WorkerServiceListWorkersProcedure:
+			workerServiceListWorkersHandler.ServeHTTP(w, r)
+		case WorkerServicePauseWorkerProcedure:
+			workerServicePauseWorkerHandler.ServeHTTP(w, r)
+		case WorkerServiceResumeWorkerProcedure:
+			workerServiceResumeWorkerHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedWorkerServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedWorkerServiceHandler struct{}
+
+func (UnimplementedWorkerServiceHandler) ListWorkers(context.Context, *connect.Request[v1.ListWorkersRequest]) (*connect.Response[v1.ListWorkersResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("product.v1.WorkerService.ListWorkers is not implemented"))
+}
+
+func (UnimplementedWorkerServiceHandler) PauseWorker(context.Context, *connect.Request[v1.PauseWorkerRequest]) (*connect.Response[v1.PauseWorkerResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("product.v1.WorkerService.PauseWorker is not implemented"))
+}
+
+func (UnimplementedWorkerServiceHandler) ResumeWorker(context.Context, *connect.Request[v1.ResumeWorkerRequest]) (*connect.Response[v1.ResumeWorkerResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("product.v1.WorkerService.ResumeWorker is not implemented"))
+}
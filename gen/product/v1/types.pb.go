@@ -137,6 +137,225 @@ func (ReservationStatus) EnumDescriptor() ([]byte, []int) {
 	return file_product_v1_types_proto_rawDescGZIP(), []int{1}
 }
 
+// InventoryMovementReason describes what caused an inventory change.
+type InventoryMovementReason int32
+
+const (
+	InventoryMovementReason_INVENTORY_MOVEMENT_REASON_UNSPECIFIED   InventoryMovementReason = 0
+	InventoryMovementReason_INVENTORY_MOVEMENT_REASON_ADJUSTMENT    InventoryMovementReason = 1 // Quantity set via UpdateInventory or a warehouse sync
+	InventoryMovementReason_INVENTORY_MOVEMENT_REASON_RESERVE       InventoryMovementReason = 2 // Stock reserved by BatchReserveInventory
+	InventoryMovementReason_INVENTORY_MOVEMENT_REASON_CONFIRM       InventoryMovementReason = 3 // Reservation confirmed, stock consumed
+	InventoryMovementReason_INVENTORY_MOVEMENT_REASON_RELEASE       InventoryMovementReason = 4 // Reservation released by the caller
+	InventoryMovementReason_INVENTORY_MOVEMENT_REASON_EXPIRE        InventoryMovementReason = 5 // Reservation released by TTL expiry
+	InventoryMovementReason_INVENTORY_MOVEMENT_REASON_FORCE_RELEASE InventoryMovementReason = 6 // Reservation released by ForceReleaseReservation
+)
+
+// Enum value maps for InventoryMovementReason.
+var (
+	InventoryMovementReason_name = map[int32]string{
+		0: "INVENTORY_MOVEMENT_REASON_UNSPECIFIED",
+		1: "INVENTORY_MOVEMENT_REASON_ADJUSTMENT",
+		2: "INVENTORY_MOVEMENT_REASON_RESERVE",
+		3: "INVENTORY_MOVEMENT_REASON_CONFIRM",
+		4: "INVENTORY_MOVEMENT_REASON_RELEASE",
+		5: "INVENTORY_MOVEMENT_REASON_EXPIRE",
+		6: "INVENTORY_MOVEMENT_REASON_FORCE_RELEASE",
+	}
+	InventoryMovementReason_value = map[string]int32{
+		"INVENTORY_MOVEMENT_REASON_UNSPECIFIED":   0,
+		"INVENTORY_MOVEMENT_REASON_ADJUSTMENT":    1,
+		"INVENTORY_MOVEMENT_REASON_RESERVE":       2,
+		"INVENTORY_MOVEMENT_REASON_CONFIRM":       3,
+		"INVENTORY_MOVEMENT_REASON_RELEASE":       4,
+		"INVENTORY_MOVEMENT_REASON_EXPIRE":        5,
+		"INVENTORY_MOVEMENT_REASON_FORCE_RELEASE": 6,
+	}
+)
+
+func (x InventoryMovementReason) Enum() *InventoryMovementReason {
+	p := new(InventoryMovementReason)
+	*p = x
+	return p
+}
+
+func (x InventoryMovementReason) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (InventoryMovementReason) Descriptor() protoreflect.EnumDescriptor {
+	return file_product_v1_types_proto_enumTypes[2].Descriptor()
+}
+
+func (InventoryMovementReason) Type() protoreflect.EnumType {
+	return &file_product_v1_types_proto_enumTypes[2]
+}
+
+func (x InventoryMovementReason) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use InventoryMovementReason.Descriptor instead.
+func (InventoryMovementReason) EnumDescriptor() ([]byte, []int) {
+	return file_product_v1_types_proto_rawDescGZIP(), []int{2}
+}
+
+// PriceChangeStatus represents the state of a SKU price change.
+type PriceChangeStatus int32
+
+const (
+	PriceChangeStatus_PRICE_CHANGE_STATUS_UNSPECIFIED PriceChangeStatus = 0
+	PriceChangeStatus_PRICE_CHANGE_STATUS_SCHEDULED   PriceChangeStatus = 1 // Waiting for effective_from
+	PriceChangeStatus_PRICE_CHANGE_STATUS_APPLIED     PriceChangeStatus = 2 // Price in effect (or replaced by a later change)
+	PriceChangeStatus_PRICE_CHANGE_STATUS_CANCELLED   PriceChangeStatus = 3 // SKU deleted before effective_from
+)
+
+// Enum value maps for PriceChangeStatus.
+var (
+	PriceChangeStatus_name = map[int32]string{
+		0: "PRICE_CHANGE_STATUS_UNSPECIFIED",
+		1: "PRICE_CHANGE_STATUS_SCHEDULED",
+		2: "PRICE_CHANGE_STATUS_APPLIED",
+		3: "PRICE_CHANGE_STATUS_CANCELLED",
+	}
+	PriceChangeStatus_value = map[string]int32{
+		"PRICE_CHANGE_STATUS_UNSPECIFIED": 0,
+		"PRICE_CHANGE_STATUS_SCHEDULED":   1,
+		"PRICE_CHANGE_STATUS_APPLIED":     2,
+		"PRICE_CHANGE_STATUS_CANCELLED":   3,
+	}
+)
+
+func (x PriceChangeStatus) Enum() *PriceChangeStatus {
+	p := new(PriceChangeStatus)
+	*p = x
+	return p
+}
+
+func (x PriceChangeStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PriceChangeStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_product_v1_types_proto_enumTypes[3].Descriptor()
+}
+
+func (PriceChangeStatus) Type() protoreflect.EnumType {
+	return &file_product_v1_types_proto_enumTypes[3]
+}
+
+func (x PriceChangeStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PriceChangeStatus.Descriptor instead.
+func (PriceChangeStatus) EnumDescriptor() ([]byte, []int) {
+	return file_product_v1_types_proto_rawDescGZIP(), []int{3}
+}
+
+// ProductImageStatus represents the upload state of a product image.
+type ProductImageStatus int32
+
+const (
+	ProductImageStatus_PRODUCT_IMAGE_STATUS_UNSPECIFIED ProductImageStatus = 0
+	ProductImageStatus_PRODUCT_IMAGE_STATUS_PENDING     ProductImageStatus = 1 // Waiting for the upload to the presigned URL
+	ProductImageStatus_PRODUCT_IMAGE_STATUS_READY       ProductImageStatus = 2 // Uploaded and shown with the product
+)
+
+// Enum value maps for ProductImageStatus.
+var (
+	ProductImageStatus_name = map[int32]string{
+		0: "PRODUCT_IMAGE_STATUS_UNSPECIFIED",
+		1: "PRODUCT_IMAGE_STATUS_PENDING",
+		2: "PRODUCT_IMAGE_STATUS_READY",
+	}
+	ProductImageStatus_value = map[string]int32{
+		"PRODUCT_IMAGE_STATUS_UNSPECIFIED": 0,
+		"PRODUCT_IMAGE_STATUS_PENDING":     1,
+		"PRODUCT_IMAGE_STATUS_READY":       2,
+	}
+)
+
+func (x ProductImageStatus) Enum() *ProductImageStatus {
+	p := new(ProductImageStatus)
+	*p = x
+	return p
+}
+
+func (x ProductImageStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ProductImageStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_product_v1_types_proto_enumTypes[4].Descriptor()
+}
+
+func (ProductImageStatus) Type() protoreflect.EnumType {
+	return &file_product_v1_types_proto_enumTypes[4]
+}
+
+func (x ProductImageStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ProductImageStatus.Descriptor instead.
+func (ProductImageStatus) EnumDescriptor() ([]byte, []int) {
+	return file_product_v1_types_proto_rawDescGZIP(), []int{4}
+}
+
+// AttributeType is the type of the values of a SKU attribute. Values are
+// strings on the wire in every case.
+type AttributeType int32
+
+const (
+	AttributeType_ATTRIBUTE_TYPE_UNSPECIFIED AttributeType = 0
+	AttributeType_ATTRIBUTE_TYPE_STRING      AttributeType = 1
+	AttributeType_ATTRIBUTE_TYPE_NUMBER      AttributeType = 2 // Decimal numbers such as "42" or "27.5"
+	AttributeType_ATTRIBUTE_TYPE_BOOLEAN     AttributeType = 3 // "true" or "false"
+)
+
+// Enum value maps for AttributeType.
+var (
+	AttributeType_name = map[int32]string{
+		0: "ATTRIBUTE_TYPE_UNSPECIFIED",
+		1: "ATTRIBUTE_TYPE_STRING",
+		2: "ATTRIBUTE_TYPE_NUMBER",
+		3: "ATTRIBUTE_TYPE_BOOLEAN",
+	}
+	AttributeType_value = map[string]int32{
+		"ATTRIBUTE_TYPE_UNSPECIFIED": 0,
+		"ATTRIBUTE_TYPE_STRING":      1,
+		"ATTRIBUTE_TYPE_NUMBER":      2,
+		"ATTRIBUTE_TYPE_BOOLEAN":     3,
+	}
+)
+
+func (x AttributeType) Enum() *AttributeType {
+	p := new(AttributeType)
+	*p = x
+	return p
+}
+
+func (x AttributeType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AttributeType) Descriptor() protoreflect.EnumDescriptor {
+	return file_product_v1_types_proto_enumTypes[5].Descriptor()
+}
+
+func (AttributeType) Type() protoreflect.EnumType {
+	return &file_product_v1_types_proto_enumTypes[5]
+}
+
+func (x AttributeType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AttributeType.Descriptor instead.
+func (AttributeType) EnumDescriptor() ([]byte, []int) {
+	return file_product_v1_types_proto_rawDescGZIP(), []int{5}
+}
+
 // Money represents a monetary value with currency.
 // Amount is in the smallest currency unit (e.g., cents for USD, yen for JPY).
 type Money struct {
@@ -204,6 +423,9 @@ type Product struct {
 	MaxPrice      *Money                 `protobuf:"bytes,8,opt,name=max_price,json=maxPrice,proto3" json:"max_price,omitempty"` // Maximum price across all SKUs
 	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	Images        []*ProductImage        `protobuf:"bytes,11,rep,name=images,proto3" json:"images,omitempty"`     // Ready images in display order (GetProduct only)
+	Channels      []string               `protobuf:"bytes,12,rep,name=channels,proto3" json:"channels,omitempty"` // Sales channels the product is visible in; empty for all
+	Markets       []string               `protobuf:"bytes,13,rep,name=markets,proto3" json:"markets,omitempty"`   // ISO 3166-1 alpha-2 markets the product is visible in; empty for all
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -308,6 +530,144 @@ func (x *Product) GetUpdatedAt() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *Product) GetImages() []*ProductImage {
+	if x != nil {
+		return x.Images
+	}
+	return nil
+}
+
+func (x *Product) GetChannels() []string {
+	if x != nil {
+		return x.Channels
+	}
+	return nil
+}
+
+func (x *Product) GetMarkets() []string {
+	if x != nil {
+		return x.Markets
+	}
+	return nil
+}
+
+// ProductImage is an image of a product kept in object storage.
+type ProductImage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ProductId     string                 `protobuf:"bytes,2,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Url           string                 `protobuf:"bytes,3,opt,name=url,proto3" json:"url,omitempty"`                                    // Public URL of the image
+	AltText       string                 `protobuf:"bytes,4,opt,name=alt_text,json=altText,proto3" json:"alt_text,omitempty"`             // Max 500 characters
+	Position      int32                  `protobuf:"varint,5,opt,name=position,proto3" json:"position,omitempty"`                         // Display order, lowest first
+	ContentType   string                 `protobuf:"bytes,6,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"` // image/jpeg, image/png, image/webp or image/avif
+	SizeBytes     int64                  `protobuf:"varint,7,opt,name=size_bytes,json=sizeBytes,proto3" json:"size_bytes,omitempty"`      // Max 10 MiB; 0 while pending
+	Status        ProductImageStatus     `protobuf:"varint,8,opt,name=status,proto3,enum=product.v1.ProductImageStatus" json:"status,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProductImage) Reset() {
+	*x = ProductImage{}
+	mi := &file_product_v1_types_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProductImage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProductImage) ProtoMessage() {}
+
+func (x *ProductImage) ProtoReflect() protoreflect.Message {
+	mi := &file_product_v1_types_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProductImage.ProtoReflect.Descriptor instead.
+func (*ProductImage) Descriptor() ([]byte, []int) {
+	return file_product_v1_types_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ProductImage) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ProductImage) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *ProductImage) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *ProductImage) GetAltText() string {
+	if x != nil {
+		return x.AltText
+	}
+	return ""
+}
+
+func (x *ProductImage) GetPosition() int32 {
+	if x != nil {
+		return x.Position
+	}
+	return 0
+}
+
+func (x *ProductImage) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *ProductImage) GetSizeBytes() int64 {
+	if x != nil {
+		return x.SizeBytes
+	}
+	return 0
+}
+
+func (x *ProductImage) GetStatus() ProductImageStatus {
+	if x != nil {
+		return x.Status
+	}
+	return ProductImageStatus_PRODUCT_IMAGE_STATUS_UNSPECIFIED
+}
+
+func (x *ProductImage) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *ProductImage) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
 // SKU represents a product variant (Stock Keeping Unit).
 type SKU struct {
 	state      protoimpl.MessageState `protogen:"open.v1"`
@@ -319,16 +679,19 @@ type SKU struct {
 	// Inventory is optional and only populated when explicitly requested.
 	// Use InventoryService.GetInventory for real-time stock data.
 	// In ListProducts, this is NOT populated by default to avoid N+1 queries.
-	Inventory     *Inventory             `protobuf:"bytes,6,opt,name=inventory,proto3,oneof" json:"inventory,omitempty"`
-	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	Inventory *Inventory             `protobuf:"bytes,6,opt,name=inventory,proto3,oneof" json:"inventory,omitempty"`
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	// Prices in currencies other than price's, at most one per currency.
+	// Only populated by GetSKU, CreateSKU and UpdateSKU.
+	AdditionalPrices []*Money `protobuf:"bytes,9,rep,name=additional_prices,json=additionalPrices,proto3" json:"additional_prices,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
 }
 
 func (x *SKU) Reset() {
 	*x = SKU{}
-	mi := &file_product_v1_types_proto_msgTypes[2]
+	mi := &file_product_v1_types_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -340,7 +703,7 @@ func (x *SKU) String() string {
 func (*SKU) ProtoMessage() {}
 
 func (x *SKU) ProtoReflect() protoreflect.Message {
-	mi := &file_product_v1_types_proto_msgTypes[2]
+	mi := &file_product_v1_types_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -353,7 +716,7 @@ func (x *SKU) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SKU.ProtoReflect.Descriptor instead.
 func (*SKU) Descriptor() ([]byte, []int) {
-	return file_product_v1_types_proto_rawDescGZIP(), []int{2}
+	return file_product_v1_types_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *SKU) GetId() string {
@@ -391,55 +754,556 @@ func (x *SKU) GetAttributes() map[string]string {
 	return nil
 }
 
-func (x *SKU) GetInventory() *Inventory {
+func (x *SKU) GetInventory() *Inventory {
+	if x != nil {
+		return x.Inventory
+	}
+	return nil
+}
+
+func (x *SKU) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *SKU) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *SKU) GetAdditionalPrices() []*Money {
+	if x != nil {
+		return x.AdditionalPrices
+	}
+	return nil
+}
+
+// MoneyList wraps repeated prices so that an empty list can be told apart
+// from an unset field.
+type MoneyList struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Values        []*Money               `protobuf:"bytes,1,rep,name=values,proto3" json:"values,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MoneyList) Reset() {
+	*x = MoneyList{}
+	mi := &file_product_v1_types_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MoneyList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MoneyList) ProtoMessage() {}
+
+func (x *MoneyList) ProtoReflect() protoreflect.Message {
+	mi := &file_product_v1_types_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MoneyList.ProtoReflect.Descriptor instead.
+func (*MoneyList) Descriptor() ([]byte, []int) {
+	return file_product_v1_types_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *MoneyList) GetValues() []*Money {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+// Category represents a product category with hierarchical structure.
+type Category struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	ParentId      *string                `protobuf:"bytes,3,opt,name=parent_id,json=parentId,proto3,oneof" json:"parent_id,omitempty"`
+	Children      []*Category            `protobuf:"bytes,4,rep,name=children,proto3" json:"children,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Category) Reset() {
+	*x = Category{}
+	mi := &file_product_v1_types_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Category) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Category) ProtoMessage() {}
+
+func (x *Category) ProtoReflect() protoreflect.Message {
+	mi := &file_product_v1_types_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Category.ProtoReflect.Descriptor instead.
+func (*Category) Descriptor() ([]byte, []int) {
+	return file_product_v1_types_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *Category) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Category) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Category) GetParentId() string {
+	if x != nil && x.ParentId != nil {
+		return *x.ParentId
+	}
+	return ""
+}
+
+func (x *Category) GetChildren() []*Category {
+	if x != nil {
+		return x.Children
+	}
+	return nil
+}
+
+func (x *Category) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Category) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+// AttributeDefinition is the schema of a SKU attribute for the products of a
+// category. CreateSKU and UpdateSKU reject attributes that do not match the
+// definitions of the product's category; attributes without a definition are
+// accepted as they are.
+type AttributeDefinition struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	CategoryId    string                 `protobuf:"bytes,2,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"` // Attribute key, e.g. "color"
+	Type          AttributeType          `protobuf:"varint,4,opt,name=type,proto3,enum=product.v1.AttributeType" json:"type,omitempty"`
+	AllowedValues []string               `protobuf:"bytes,5,rep,name=allowed_values,json=allowedValues,proto3" json:"allowed_values,omitempty"` // Empty allows any value of the type
+	Required      bool                   `protobuf:"varint,6,opt,name=required,proto3" json:"required,omitempty"`                               // SKUs must have the attribute
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AttributeDefinition) Reset() {
+	*x = AttributeDefinition{}
+	mi := &file_product_v1_types_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AttributeDefinition) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AttributeDefinition) ProtoMessage() {}
+
+func (x *AttributeDefinition) ProtoReflect() protoreflect.Message {
+	mi := &file_product_v1_types_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AttributeDefinition.ProtoReflect.Descriptor instead.
+func (*AttributeDefinition) Descriptor() ([]byte, []int) {
+	return file_product_v1_types_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *AttributeDefinition) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *AttributeDefinition) GetCategoryId() string {
+	if x != nil {
+		return x.CategoryId
+	}
+	return ""
+}
+
+func (x *AttributeDefinition) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *AttributeDefinition) GetType() AttributeType {
+	if x != nil {
+		return x.Type
+	}
+	return AttributeType_ATTRIBUTE_TYPE_UNSPECIFIED
+}
+
+func (x *AttributeDefinition) GetAllowedValues() []string {
+	if x != nil {
+		return x.AllowedValues
+	}
+	return nil
+}
+
+func (x *AttributeDefinition) GetRequired() bool {
+	if x != nil {
+		return x.Required
+	}
+	return false
+}
+
+func (x *AttributeDefinition) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *AttributeDefinition) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+// CategoryTreeNode is a category with its subtree and product counts.
+type CategoryTreeNode struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Category          *Category              `protobuf:"bytes,1,opt,name=category,proto3" json:"category,omitempty"`                                               // children is left empty; see children below
+	Depth             int32                  `protobuf:"varint,2,opt,name=depth,proto3" json:"depth,omitempty"`                                                    // 0 for the top nodes of the response
+	ProductCount      int64                  `protobuf:"varint,3,opt,name=product_count,json=productCount,proto3" json:"product_count,omitempty"`                  // Published products directly in this category
+	TotalProductCount int64                  `protobuf:"varint,4,opt,name=total_product_count,json=totalProductCount,proto3" json:"total_product_count,omitempty"` // Published products in this category and all descendants
+	Children          []*CategoryTreeNode    `protobuf:"bytes,5,rep,name=children,proto3" json:"children,omitempty"`                                               // Sorted by name
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *CategoryTreeNode) Reset() {
+	*x = CategoryTreeNode{}
+	mi := &file_product_v1_types_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CategoryTreeNode) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CategoryTreeNode) ProtoMessage() {}
+
+func (x *CategoryTreeNode) ProtoReflect() protoreflect.Message {
+	mi := &file_product_v1_types_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CategoryTreeNode.ProtoReflect.Descriptor instead.
+func (*CategoryTreeNode) Descriptor() ([]byte, []int) {
+	return file_product_v1_types_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *CategoryTreeNode) GetCategory() *Category {
+	if x != nil {
+		return x.Category
+	}
+	return nil
+}
+
+func (x *CategoryTreeNode) GetDepth() int32 {
+	if x != nil {
+		return x.Depth
+	}
+	return 0
+}
+
+func (x *CategoryTreeNode) GetProductCount() int64 {
+	if x != nil {
+		return x.ProductCount
+	}
+	return 0
+}
+
+func (x *CategoryTreeNode) GetTotalProductCount() int64 {
+	if x != nil {
+		return x.TotalProductCount
+	}
+	return 0
+}
+
+func (x *CategoryTreeNode) GetChildren() []*CategoryTreeNode {
+	if x != nil {
+		return x.Children
+	}
+	return nil
+}
+
+// Inventory represents the stock level for a SKU.
+type Inventory struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SkuId         string                 `protobuf:"bytes,1,opt,name=sku_id,json=skuId,proto3" json:"sku_id,omitempty"`
+	Quantity      int64                  `protobuf:"varint,2,opt,name=quantity,proto3" json:"quantity,omitempty"`   // Total stock quantity
+	Reserved      int64                  `protobuf:"varint,3,opt,name=reserved,proto3" json:"reserved,omitempty"`   // Quantity reserved by pending orders
+	Available     int64                  `protobuf:"varint,4,opt,name=available,proto3" json:"available,omitempty"` // quantity - reserved
+	Version       int64                  `protobuf:"varint,5,opt,name=version,proto3" json:"version,omitempty"`     // For optimistic locking
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Inventory) Reset() {
+	*x = Inventory{}
+	mi := &file_product_v1_types_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Inventory) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Inventory) ProtoMessage() {}
+
+func (x *Inventory) ProtoReflect() protoreflect.Message {
+	mi := &file_product_v1_types_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Inventory.ProtoReflect.Descriptor instead.
+func (*Inventory) Descriptor() ([]byte, []int) {
+	return file_product_v1_types_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *Inventory) GetSkuId() string {
+	if x != nil {
+		return x.SkuId
+	}
+	return ""
+}
+
+func (x *Inventory) GetQuantity() int64 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *Inventory) GetReserved() int64 {
+	if x != nil {
+		return x.Reserved
+	}
+	return 0
+}
+
+func (x *Inventory) GetAvailable() int64 {
+	if x != nil {
+		return x.Available
+	}
+	return 0
+}
+
+func (x *Inventory) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *Inventory) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+// Reservation represents an inventory reservation for order processing.
+type Reservation struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	Id                  string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"` // UUID v7
+	Status              ReservationStatus      `protobuf:"varint,2,opt,name=status,proto3,enum=product.v1.ReservationStatus" json:"status,omitempty"`
+	Items               []*ReservationItem     `protobuf:"bytes,3,rep,name=items,proto3" json:"items,omitempty"`
+	CreatedAt           *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	ExpiresAt           *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	RemainingTtlSeconds int64                  `protobuf:"varint,6,opt,name=remaining_ttl_seconds,json=remainingTtlSeconds,proto3" json:"remaining_ttl_seconds,omitempty"` // Seconds until expiration (for pending only)
+	UpdatedAt           *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`                                  // Time of the last status change
+	ReleaseReason       string                 `protobuf:"bytes,8,opt,name=release_reason,json=releaseReason,proto3" json:"release_reason,omitempty"`                      // Set when released by ForceReleaseReservation
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *Reservation) Reset() {
+	*x = Reservation{}
+	mi := &file_product_v1_types_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Reservation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Reservation) ProtoMessage() {}
+
+func (x *Reservation) ProtoReflect() protoreflect.Message {
+	mi := &file_product_v1_types_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Reservation.ProtoReflect.Descriptor instead.
+func (*Reservation) Descriptor() ([]byte, []int) {
+	return file_product_v1_types_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *Reservation) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Reservation) GetStatus() ReservationStatus {
+	if x != nil {
+		return x.Status
+	}
+	return ReservationStatus_RESERVATION_STATUS_UNSPECIFIED
+}
+
+func (x *Reservation) GetItems() []*ReservationItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *Reservation) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Reservation) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+func (x *Reservation) GetRemainingTtlSeconds() int64 {
 	if x != nil {
-		return x.Inventory
+		return x.RemainingTtlSeconds
 	}
-	return nil
+	return 0
 }
 
-func (x *SKU) GetCreatedAt() *timestamppb.Timestamp {
+func (x *Reservation) GetUpdatedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.CreatedAt
+		return x.UpdatedAt
 	}
 	return nil
 }
 
-func (x *SKU) GetUpdatedAt() *timestamppb.Timestamp {
+func (x *Reservation) GetReleaseReason() string {
 	if x != nil {
-		return x.UpdatedAt
+		return x.ReleaseReason
 	}
-	return nil
+	return ""
 }
 
-// Category represents a product category with hierarchical structure.
-type Category struct {
+// ReservationItem represents a single SKU reservation within a batch.
+type ReservationItem struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	ParentId      *string                `protobuf:"bytes,3,opt,name=parent_id,json=parentId,proto3,oneof" json:"parent_id,omitempty"`
-	Children      []*Category            `protobuf:"bytes,4,rep,name=children,proto3" json:"children,omitempty"`
-	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	SkuId         string                 `protobuf:"bytes,1,opt,name=sku_id,json=skuId,proto3" json:"sku_id,omitempty"`
+	Quantity      int64                  `protobuf:"varint,2,opt,name=quantity,proto3" json:"quantity,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Category) Reset() {
-	*x = Category{}
-	mi := &file_product_v1_types_proto_msgTypes[3]
+func (x *ReservationItem) Reset() {
+	*x = ReservationItem{}
+	mi := &file_product_v1_types_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Category) String() string {
+func (x *ReservationItem) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Category) ProtoMessage() {}
+func (*ReservationItem) ProtoMessage() {}
 
-func (x *Category) ProtoReflect() protoreflect.Message {
-	mi := &file_product_v1_types_proto_msgTypes[3]
+func (x *ReservationItem) ProtoReflect() protoreflect.Message {
+	mi := &file_product_v1_types_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -450,81 +1314,109 @@ func (x *Category) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Category.ProtoReflect.Descriptor instead.
-func (*Category) Descriptor() ([]byte, []int) {
-	return file_product_v1_types_proto_rawDescGZIP(), []int{3}
+// Deprecated: Use ReservationItem.ProtoReflect.Descriptor instead.
+func (*ReservationItem) Descriptor() ([]byte, []int) {
+	return file_product_v1_types_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *Category) GetId() string {
+func (x *ReservationItem) GetSkuId() string {
 	if x != nil {
-		return x.Id
+		return x.SkuId
 	}
 	return ""
 }
 
-func (x *Category) GetName() string {
+func (x *ReservationItem) GetQuantity() int64 {
 	if x != nil {
-		return x.Name
+		return x.Quantity
 	}
-	return ""
+	return 0
 }
 
-func (x *Category) GetParentId() string {
-	if x != nil && x.ParentId != nil {
-		return *x.ParentId
-	}
-	return ""
+// SKUVelocity holds aggregated sales velocity for a SKU.
+type SKUVelocity struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SkuId         string                 `protobuf:"bytes,1,opt,name=sku_id,json=skuId,proto3" json:"sku_id,omitempty"`
+	Windows       []*VelocityWindow      `protobuf:"bytes,2,rep,name=windows,proto3" json:"windows,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Category) GetChildren() []*Category {
+func (x *SKUVelocity) Reset() {
+	*x = SKUVelocity{}
+	mi := &file_product_v1_types_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SKUVelocity) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SKUVelocity) ProtoMessage() {}
+
+func (x *SKUVelocity) ProtoReflect() protoreflect.Message {
+	mi := &file_product_v1_types_proto_msgTypes[11]
 	if x != nil {
-		return x.Children
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *Category) GetCreatedAt() *timestamppb.Timestamp {
+// Deprecated: Use SKUVelocity.ProtoReflect.Descriptor instead.
+func (*SKUVelocity) Descriptor() ([]byte, []int) {
+	return file_product_v1_types_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *SKUVelocity) GetSkuId() string {
 	if x != nil {
-		return x.CreatedAt
+		return x.SkuId
 	}
-	return nil
+	return ""
 }
 
-func (x *Category) GetUpdatedAt() *timestamppb.Timestamp {
+func (x *SKUVelocity) GetWindows() []*VelocityWindow {
 	if x != nil {
-		return x.UpdatedAt
+		return x.Windows
 	}
 	return nil
 }
 
-// Inventory represents the stock level for a SKU.
-type Inventory struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	SkuId         string                 `protobuf:"bytes,1,opt,name=sku_id,json=skuId,proto3" json:"sku_id,omitempty"`
-	Quantity      int64                  `protobuf:"varint,2,opt,name=quantity,proto3" json:"quantity,omitempty"`   // Total stock quantity
-	Reserved      int64                  `protobuf:"varint,3,opt,name=reserved,proto3" json:"reserved,omitempty"`   // Quantity reserved by pending orders
-	Available     int64                  `protobuf:"varint,4,opt,name=available,proto3" json:"available,omitempty"` // quantity - reserved
-	Version       int64                  `protobuf:"varint,5,opt,name=version,proto3" json:"version,omitempty"`     // For optimistic locking
-	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *Inventory) Reset() {
-	*x = Inventory{}
-	mi := &file_product_v1_types_proto_msgTypes[4]
+// PriceChange records a change of a SKU's price in one currency.
+type PriceChange struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	SkuId          string                 `protobuf:"bytes,2,opt,name=sku_id,json=skuId,proto3" json:"sku_id,omitempty"`
+	Price          *Money                 `protobuf:"bytes,3,opt,name=price,proto3" json:"price,omitempty"`
+	PreviousAmount *int64                 `protobuf:"varint,4,opt,name=previous_amount,json=previousAmount,proto3,oneof" json:"previous_amount,omitempty"` // Unset for a new currency or before the change is applied
+	EffectiveFrom  *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=effective_from,json=effectiveFrom,proto3" json:"effective_from,omitempty"`
+	Status         PriceChangeStatus      `protobuf:"varint,6,opt,name=status,proto3,enum=product.v1.PriceChangeStatus" json:"status,omitempty"`
+	Actor          string                 `protobuf:"bytes,7,opt,name=actor,proto3" json:"actor,omitempty"` // x-user-id of the caller
+	CreatedAt      *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	AppliedAt      *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=applied_at,json=appliedAt,proto3" json:"applied_at,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *PriceChange) Reset() {
+	*x = PriceChange{}
+	mi := &file_product_v1_types_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Inventory) String() string {
+func (x *PriceChange) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Inventory) ProtoMessage() {}
+func (*PriceChange) ProtoMessage() {}
 
-func (x *Inventory) ProtoReflect() protoreflect.Message {
-	mi := &file_product_v1_types_proto_msgTypes[4]
+func (x *PriceChange) ProtoReflect() protoreflect.Message {
+	mi := &file_product_v1_types_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -535,81 +1427,106 @@ func (x *Inventory) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Inventory.ProtoReflect.Descriptor instead.
-func (*Inventory) Descriptor() ([]byte, []int) {
-	return file_product_v1_types_proto_rawDescGZIP(), []int{4}
+// Deprecated: Use PriceChange.ProtoReflect.Descriptor instead.
+func (*PriceChange) Descriptor() ([]byte, []int) {
+	return file_product_v1_types_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *Inventory) GetSkuId() string {
+func (x *PriceChange) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *PriceChange) GetSkuId() string {
 	if x != nil {
 		return x.SkuId
 	}
 	return ""
 }
 
-func (x *Inventory) GetQuantity() int64 {
+func (x *PriceChange) GetPrice() *Money {
 	if x != nil {
-		return x.Quantity
+		return x.Price
+	}
+	return nil
+}
+
+func (x *PriceChange) GetPreviousAmount() int64 {
+	if x != nil && x.PreviousAmount != nil {
+		return *x.PreviousAmount
 	}
 	return 0
 }
 
-func (x *Inventory) GetReserved() int64 {
+func (x *PriceChange) GetEffectiveFrom() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Reserved
+		return x.EffectiveFrom
 	}
-	return 0
+	return nil
 }
 
-func (x *Inventory) GetAvailable() int64 {
+func (x *PriceChange) GetStatus() PriceChangeStatus {
 	if x != nil {
-		return x.Available
+		return x.Status
 	}
-	return 0
+	return PriceChangeStatus_PRICE_CHANGE_STATUS_UNSPECIFIED
 }
 
-func (x *Inventory) GetVersion() int64 {
+func (x *PriceChange) GetActor() string {
 	if x != nil {
-		return x.Version
+		return x.Actor
 	}
-	return 0
+	return ""
 }
 
-func (x *Inventory) GetUpdatedAt() *timestamppb.Timestamp {
+func (x *PriceChange) GetCreatedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.UpdatedAt
+		return x.CreatedAt
 	}
 	return nil
 }
 
-// Reservation represents an inventory reservation for order processing.
-type Reservation struct {
-	state               protoimpl.MessageState `protogen:"open.v1"`
-	Id                  string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"` // UUID v7
-	Status              ReservationStatus      `protobuf:"varint,2,opt,name=status,proto3,enum=product.v1.ReservationStatus" json:"status,omitempty"`
-	Items               []*ReservationItem     `protobuf:"bytes,3,rep,name=items,proto3" json:"items,omitempty"`
-	CreatedAt           *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	ExpiresAt           *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
-	RemainingTtlSeconds int64                  `protobuf:"varint,6,opt,name=remaining_ttl_seconds,json=remainingTtlSeconds,proto3" json:"remaining_ttl_seconds,omitempty"` // Seconds until expiration (for pending only)
-	unknownFields       protoimpl.UnknownFields
-	sizeCache           protoimpl.SizeCache
+func (x *PriceChange) GetAppliedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.AppliedAt
+	}
+	return nil
 }
 
-func (x *Reservation) Reset() {
-	*x = Reservation{}
-	mi := &file_product_v1_types_proto_msgTypes[5]
+// InventoryMovement is an audit record of a single inventory change.
+type InventoryMovement struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Id            int64                   `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"` // Monotonically increasing per database
+	SkuId         string                  `protobuf:"bytes,2,opt,name=sku_id,json=skuId,proto3" json:"sku_id,omitempty"`
+	Reason        InventoryMovementReason `protobuf:"varint,3,opt,name=reason,proto3,enum=product.v1.InventoryMovementReason" json:"reason,omitempty"`
+	Actor         string                  `protobuf:"bytes,4,opt,name=actor,proto3" json:"actor,omitempty"`                                      // x-user-id of the caller, or "system:<component>" for workers
+	ReservationId string                  `protobuf:"bytes,5,opt,name=reservation_id,json=reservationId,proto3" json:"reservation_id,omitempty"` // Empty for adjustments
+	QuantityDelta int64                   `protobuf:"varint,6,opt,name=quantity_delta,json=quantityDelta,proto3" json:"quantity_delta,omitempty"`
+	ReservedDelta int64                   `protobuf:"varint,7,opt,name=reserved_delta,json=reservedDelta,proto3" json:"reserved_delta,omitempty"`
+	QuantityAfter int64                   `protobuf:"varint,8,opt,name=quantity_after,json=quantityAfter,proto3" json:"quantity_after,omitempty"` // Resulting quantity
+	ReservedAfter int64                   `protobuf:"varint,9,opt,name=reserved_after,json=reservedAfter,proto3" json:"reserved_after,omitempty"` // Resulting reserved quantity
+	CreatedAt     *timestamppb.Timestamp  `protobuf:"bytes,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InventoryMovement) Reset() {
+	*x = InventoryMovement{}
+	mi := &file_product_v1_types_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Reservation) String() string {
+func (x *InventoryMovement) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Reservation) ProtoMessage() {}
+func (*InventoryMovement) ProtoMessage() {}
 
-func (x *Reservation) ProtoReflect() protoreflect.Message {
-	mi := &file_product_v1_types_proto_msgTypes[5]
+func (x *InventoryMovement) ProtoReflect() protoreflect.Message {
+	mi := &file_product_v1_types_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -620,77 +1537,106 @@ func (x *Reservation) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Reservation.ProtoReflect.Descriptor instead.
-func (*Reservation) Descriptor() ([]byte, []int) {
-	return file_product_v1_types_proto_rawDescGZIP(), []int{5}
+// Deprecated: Use InventoryMovement.ProtoReflect.Descriptor instead.
+func (*InventoryMovement) Descriptor() ([]byte, []int) {
+	return file_product_v1_types_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *Reservation) GetId() string {
+func (x *InventoryMovement) GetId() int64 {
 	if x != nil {
 		return x.Id
 	}
+	return 0
+}
+
+func (x *InventoryMovement) GetSkuId() string {
+	if x != nil {
+		return x.SkuId
+	}
 	return ""
 }
 
-func (x *Reservation) GetStatus() ReservationStatus {
+func (x *InventoryMovement) GetReason() InventoryMovementReason {
 	if x != nil {
-		return x.Status
+		return x.Reason
 	}
-	return ReservationStatus_RESERVATION_STATUS_UNSPECIFIED
+	return InventoryMovementReason_INVENTORY_MOVEMENT_REASON_UNSPECIFIED
 }
 
-func (x *Reservation) GetItems() []*ReservationItem {
+func (x *InventoryMovement) GetActor() string {
 	if x != nil {
-		return x.Items
+		return x.Actor
 	}
-	return nil
+	return ""
 }
 
-func (x *Reservation) GetCreatedAt() *timestamppb.Timestamp {
+func (x *InventoryMovement) GetReservationId() string {
 	if x != nil {
-		return x.CreatedAt
+		return x.ReservationId
 	}
-	return nil
+	return ""
 }
 
-func (x *Reservation) GetExpiresAt() *timestamppb.Timestamp {
+func (x *InventoryMovement) GetQuantityDelta() int64 {
 	if x != nil {
-		return x.ExpiresAt
+		return x.QuantityDelta
 	}
-	return nil
+	return 0
 }
 
-func (x *Reservation) GetRemainingTtlSeconds() int64 {
+func (x *InventoryMovement) GetReservedDelta() int64 {
 	if x != nil {
-		return x.RemainingTtlSeconds
+		return x.ReservedDelta
 	}
 	return 0
 }
 
-// ReservationItem represents a single SKU reservation within a batch.
-type ReservationItem struct {
+func (x *InventoryMovement) GetQuantityAfter() int64 {
+	if x != nil {
+		return x.QuantityAfter
+	}
+	return 0
+}
+
+func (x *InventoryMovement) GetReservedAfter() int64 {
+	if x != nil {
+		return x.ReservedAfter
+	}
+	return 0
+}
+
+func (x *InventoryMovement) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+// VelocityWindow is the sales volume of a SKU over a trailing window.
+type VelocityWindow struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	SkuId         string                 `protobuf:"bytes,1,opt,name=sku_id,json=skuId,proto3" json:"sku_id,omitempty"`
-	Quantity      int64                  `protobuf:"varint,2,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	WindowDays    int32                  `protobuf:"varint,1,opt,name=window_days,json=windowDays,proto3" json:"window_days,omitempty"`
+	UnitsSold     int64                  `protobuf:"varint,2,opt,name=units_sold,json=unitsSold,proto3" json:"units_sold,omitempty"`          // Units in CONFIRMED reservations within the window
+	UnitsPerDay   float64                `protobuf:"fixed64,3,opt,name=units_per_day,json=unitsPerDay,proto3" json:"units_per_day,omitempty"` // units_sold / window_days
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ReservationItem) Reset() {
-	*x = ReservationItem{}
-	mi := &file_product_v1_types_proto_msgTypes[6]
+func (x *VelocityWindow) Reset() {
+	*x = VelocityWindow{}
+	mi := &file_product_v1_types_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ReservationItem) String() string {
+func (x *VelocityWindow) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ReservationItem) ProtoMessage() {}
+func (*VelocityWindow) ProtoMessage() {}
 
-func (x *ReservationItem) ProtoReflect() protoreflect.Message {
-	mi := &file_product_v1_types_proto_msgTypes[6]
+func (x *VelocityWindow) ProtoReflect() protoreflect.Message {
+	mi := &file_product_v1_types_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -701,21 +1647,28 @@ func (x *ReservationItem) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ReservationItem.ProtoReflect.Descriptor instead.
-func (*ReservationItem) Descriptor() ([]byte, []int) {
-	return file_product_v1_types_proto_rawDescGZIP(), []int{6}
+// Deprecated: Use VelocityWindow.ProtoReflect.Descriptor instead.
+func (*VelocityWindow) Descriptor() ([]byte, []int) {
+	return file_product_v1_types_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *ReservationItem) GetSkuId() string {
+func (x *VelocityWindow) GetWindowDays() int32 {
 	if x != nil {
-		return x.SkuId
+		return x.WindowDays
 	}
-	return ""
+	return 0
 }
 
-func (x *ReservationItem) GetQuantity() int64 {
+func (x *VelocityWindow) GetUnitsSold() int64 {
 	if x != nil {
-		return x.Quantity
+		return x.UnitsSold
+	}
+	return 0
+}
+
+func (x *VelocityWindow) GetUnitsPerDay() float64 {
+	if x != nil {
+		return x.UnitsPerDay
 	}
 	return 0
 }
@@ -731,7 +1684,7 @@ type InsufficientStockDetail struct {
 
 func (x *InsufficientStockDetail) Reset() {
 	*x = InsufficientStockDetail{}
-	mi := &file_product_v1_types_proto_msgTypes[7]
+	mi := &file_product_v1_types_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -743,7 +1696,7 @@ func (x *InsufficientStockDetail) String() string {
 func (*InsufficientStockDetail) ProtoMessage() {}
 
 func (x *InsufficientStockDetail) ProtoReflect() protoreflect.Message {
-	mi := &file_product_v1_types_proto_msgTypes[7]
+	mi := &file_product_v1_types_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -756,7 +1709,7 @@ func (x *InsufficientStockDetail) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use InsufficientStockDetail.ProtoReflect.Descriptor instead.
 func (*InsufficientStockDetail) Descriptor() ([]byte, []int) {
-	return file_product_v1_types_proto_rawDescGZIP(), []int{7}
+	return file_product_v1_types_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *InsufficientStockDetail) GetItems() []*InsufficientItem {
@@ -778,7 +1731,7 @@ type InsufficientItem struct {
 
 func (x *InsufficientItem) Reset() {
 	*x = InsufficientItem{}
-	mi := &file_product_v1_types_proto_msgTypes[8]
+	mi := &file_product_v1_types_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -790,7 +1743,7 @@ func (x *InsufficientItem) String() string {
 func (*InsufficientItem) ProtoMessage() {}
 
 func (x *InsufficientItem) ProtoReflect() protoreflect.Message {
-	mi := &file_product_v1_types_proto_msgTypes[8]
+	mi := &file_product_v1_types_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -803,7 +1756,7 @@ func (x *InsufficientItem) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use InsufficientItem.ProtoReflect.Descriptor instead.
 func (*InsufficientItem) Descriptor() ([]byte, []int) {
-	return file_product_v1_types_proto_rawDescGZIP(), []int{8}
+	return file_product_v1_types_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *InsufficientItem) GetSkuId() string {
@@ -839,7 +1792,7 @@ type BatchValidationError struct {
 
 func (x *BatchValidationError) Reset() {
 	*x = BatchValidationError{}
-	mi := &file_product_v1_types_proto_msgTypes[9]
+	mi := &file_product_v1_types_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -851,7 +1804,7 @@ func (x *BatchValidationError) String() string {
 func (*BatchValidationError) ProtoMessage() {}
 
 func (x *BatchValidationError) ProtoReflect() protoreflect.Message {
-	mi := &file_product_v1_types_proto_msgTypes[9]
+	mi := &file_product_v1_types_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -864,7 +1817,7 @@ func (x *BatchValidationError) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BatchValidationError.ProtoReflect.Descriptor instead.
 func (*BatchValidationError) Descriptor() ([]byte, []int) {
-	return file_product_v1_types_proto_rawDescGZIP(), []int{9}
+	return file_product_v1_types_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *BatchValidationError) GetField() string {
@@ -896,7 +1849,7 @@ const file_product_v1_types_proto_rawDesc = "" +
 	"product.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"D\n" +
 	"\x05Money\x12\x16\n" +
 	"\x06amount\x18\x01 \x01(\x03R\x06amount\x12#\n" +
-	"\rcurrency_code\x18\x02 \x01(\tR\fcurrencyCode\"\x9e\x03\n" +
+	"\rcurrency_code\x18\x02 \x01(\tR\fcurrencyCode\"\x86\x04\n" +
 	"\aProduct\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
@@ -911,7 +1864,26 @@ const file_product_v1_types_proto_rawDesc = "" +
 	"created_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
 	"\n" +
 	"updated_at\x18\n" +
-	" \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\xb6\x03\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x120\n" +
+	"\x06images\x18\v \x03(\v2\x18.product.v1.ProductImageR\x06images\x12\x1a\n" +
+	"\bchannels\x18\f \x03(\tR\bchannels\x12\x18\n" +
+	"\amarkets\x18\r \x03(\tR\amarkets\"\xf6\x02\n" +
+	"\fProductImage\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x02 \x01(\tR\tproductId\x12\x10\n" +
+	"\x03url\x18\x03 \x01(\tR\x03url\x12\x19\n" +
+	"\balt_text\x18\x04 \x01(\tR\aaltText\x12\x1a\n" +
+	"\bposition\x18\x05 \x01(\x05R\bposition\x12!\n" +
+	"\fcontent_type\x18\x06 \x01(\tR\vcontentType\x12\x1d\n" +
+	"\n" +
+	"size_bytes\x18\a \x01(\x03R\tsizeBytes\x126\n" +
+	"\x06status\x18\b \x01(\x0e2\x1e.product.v1.ProductImageStatusR\x06status\x129\n" +
+	"\n" +
+	"created_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\xf6\x03\n" +
 	"\x03SKU\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1d\n" +
 	"\n" +
@@ -925,12 +1897,15 @@ const file_product_v1_types_proto_rawDesc = "" +
 	"\n" +
 	"created_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
 	"\n" +
-	"updated_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x1a=\n" +
+	"updated_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12>\n" +
+	"\x11additional_prices\x18\t \x03(\v2\x11.product.v1.MoneyR\x10additionalPrices\x1a=\n" +
 	"\x0fAttributesEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
 	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01B\f\n" +
 	"\n" +
-	"_inventory\"\x86\x02\n" +
+	"_inventory\"6\n" +
+	"\tMoneyList\x12)\n" +
+	"\x06values\x18\x01 \x03(\v2\x11.product.v1.MoneyR\x06values\"\x86\x02\n" +
 	"\bCategory\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
@@ -941,7 +1916,25 @@ const file_product_v1_types_proto_rawDesc = "" +
 	"\n" +
 	"updated_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAtB\f\n" +
 	"\n" +
-	"_parent_id\"\xcd\x01\n" +
+	"_parent_id\"\xc2\x02\n" +
+	"\x13AttributeDefinition\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1f\n" +
+	"\vcategory_id\x18\x02 \x01(\tR\n" +
+	"categoryId\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12-\n" +
+	"\x04type\x18\x04 \x01(\x0e2\x19.product.v1.AttributeTypeR\x04type\x12%\n" +
+	"\x0eallowed_values\x18\x05 \x03(\tR\rallowedValues\x12\x1a\n" +
+	"\brequired\x18\x06 \x01(\bR\brequired\x129\n" +
+	"\n" +
+	"created_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\xe9\x01\n" +
+	"\x10CategoryTreeNode\x120\n" +
+	"\bcategory\x18\x01 \x01(\v2\x14.product.v1.CategoryR\bcategory\x12\x14\n" +
+	"\x05depth\x18\x02 \x01(\x05R\x05depth\x12#\n" +
+	"\rproduct_count\x18\x03 \x01(\x03R\fproductCount\x12.\n" +
+	"\x13total_product_count\x18\x04 \x01(\x03R\x11totalProductCount\x128\n" +
+	"\bchildren\x18\x05 \x03(\v2\x1c.product.v1.CategoryTreeNodeR\bchildren\"\xcd\x01\n" +
 	"\tInventory\x12\x15\n" +
 	"\x06sku_id\x18\x01 \x01(\tR\x05skuId\x12\x1a\n" +
 	"\bquantity\x18\x02 \x01(\x03R\bquantity\x12\x1a\n" +
@@ -949,7 +1942,7 @@ const file_product_v1_types_proto_rawDesc = "" +
 	"\tavailable\x18\x04 \x01(\x03R\tavailable\x12\x18\n" +
 	"\aversion\x18\x05 \x01(\x03R\aversion\x129\n" +
 	"\n" +
-	"updated_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\xb1\x02\n" +
+	"updated_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\x93\x03\n" +
 	"\vReservation\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x125\n" +
 	"\x06status\x18\x02 \x01(\x0e2\x1d.product.v1.ReservationStatusR\x06status\x121\n" +
@@ -958,10 +1951,48 @@ const file_product_v1_types_proto_rawDesc = "" +
 	"created_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
 	"\n" +
 	"expires_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\x122\n" +
-	"\x15remaining_ttl_seconds\x18\x06 \x01(\x03R\x13remainingTtlSeconds\"D\n" +
+	"\x15remaining_ttl_seconds\x18\x06 \x01(\x03R\x13remainingTtlSeconds\x129\n" +
+	"\n" +
+	"updated_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12%\n" +
+	"\x0erelease_reason\x18\b \x01(\tR\rreleaseReason\"D\n" +
 	"\x0fReservationItem\x12\x15\n" +
 	"\x06sku_id\x18\x01 \x01(\tR\x05skuId\x12\x1a\n" +
-	"\bquantity\x18\x02 \x01(\x03R\bquantity\"M\n" +
+	"\bquantity\x18\x02 \x01(\x03R\bquantity\"Z\n" +
+	"\vSKUVelocity\x12\x15\n" +
+	"\x06sku_id\x18\x01 \x01(\tR\x05skuId\x124\n" +
+	"\awindows\x18\x02 \x03(\v2\x1a.product.v1.VelocityWindowR\awindows\"\xa5\x03\n" +
+	"\vPriceChange\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x15\n" +
+	"\x06sku_id\x18\x02 \x01(\tR\x05skuId\x12'\n" +
+	"\x05price\x18\x03 \x01(\v2\x11.product.v1.MoneyR\x05price\x12,\n" +
+	"\x0fprevious_amount\x18\x04 \x01(\x03H\x00R\x0epreviousAmount\x88\x01\x01\x12A\n" +
+	"\x0eeffective_from\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\reffectiveFrom\x125\n" +
+	"\x06status\x18\x06 \x01(\x0e2\x1d.product.v1.PriceChangeStatusR\x06status\x12\x14\n" +
+	"\x05actor\x18\a \x01(\tR\x05actor\x129\n" +
+	"\n" +
+	"created_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"applied_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tappliedAtB\x12\n" +
+	"\x10_previous_amount\"\x8b\x03\n" +
+	"\x11InventoryMovement\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x15\n" +
+	"\x06sku_id\x18\x02 \x01(\tR\x05skuId\x12;\n" +
+	"\x06reason\x18\x03 \x01(\x0e2#.product.v1.InventoryMovementReasonR\x06reason\x12\x14\n" +
+	"\x05actor\x18\x04 \x01(\tR\x05actor\x12%\n" +
+	"\x0ereservation_id\x18\x05 \x01(\tR\rreservationId\x12%\n" +
+	"\x0equantity_delta\x18\x06 \x01(\x03R\rquantityDelta\x12%\n" +
+	"\x0ereserved_delta\x18\a \x01(\x03R\rreservedDelta\x12%\n" +
+	"\x0equantity_after\x18\b \x01(\x03R\rquantityAfter\x12%\n" +
+	"\x0ereserved_after\x18\t \x01(\x03R\rreservedAfter\x129\n" +
+	"\n" +
+	"created_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"t\n" +
+	"\x0eVelocityWindow\x12\x1f\n" +
+	"\vwindow_days\x18\x01 \x01(\x05R\n" +
+	"windowDays\x12\x1d\n" +
+	"\n" +
+	"units_sold\x18\x02 \x01(\x03R\tunitsSold\x12\"\n" +
+	"\runits_per_day\x18\x03 \x01(\x01R\vunitsPerDay\"M\n" +
 	"\x17InsufficientStockDetail\x122\n" +
 	"\x05items\x18\x01 \x03(\v2\x1c.product.v1.InsufficientItemR\x05items\"e\n" +
 	"\x10InsufficientItem\x12\x15\n" +
@@ -984,7 +2015,29 @@ const file_product_v1_types_proto_rawDesc = "" +
 	"\x1aRESERVATION_STATUS_PENDING\x10\x01\x12 \n" +
 	"\x1cRESERVATION_STATUS_CONFIRMED\x10\x02\x12\x1f\n" +
 	"\x1bRESERVATION_STATUS_RELEASED\x10\x03\x12\x1e\n" +
-	"\x1aRESERVATION_STATUS_EXPIRED\x10\x04B\xaa\x01\n" +
+	"\x1aRESERVATION_STATUS_EXPIRED\x10\x04*\xb6\x02\n" +
+	"\x17InventoryMovementReason\x12)\n" +
+	"%INVENTORY_MOVEMENT_REASON_UNSPECIFIED\x10\x00\x12(\n" +
+	"$INVENTORY_MOVEMENT_REASON_ADJUSTMENT\x10\x01\x12%\n" +
+	"!INVENTORY_MOVEMENT_REASON_RESERVE\x10\x02\x12%\n" +
+	"!INVENTORY_MOVEMENT_REASON_CONFIRM\x10\x03\x12%\n" +
+	"!INVENTORY_MOVEMENT_REASON_RELEASE\x10\x04\x12$\n" +
+	" INVENTORY_MOVEMENT_REASON_EXPIRE\x10\x05\x12+\n" +
+	"'INVENTORY_MOVEMENT_REASON_FORCE_RELEASE\x10\x06*\x9f\x01\n" +
+	"\x11PriceChangeStatus\x12#\n" +
+	"\x1fPRICE_CHANGE_STATUS_UNSPECIFIED\x10\x00\x12!\n" +
+	"\x1dPRICE_CHANGE_STATUS_SCHEDULED\x10\x01\x12\x1f\n" +
+	"\x1bPRICE_CHANGE_STATUS_APPLIED\x10\x02\x12!\n" +
+	"\x1dPRICE_CHANGE_STATUS_CANCELLED\x10\x03*|\n" +
+	"\x12ProductImageStatus\x12$\n" +
+	" PRODUCT_IMAGE_STATUS_UNSPECIFIED\x10\x00\x12 \n" +
+	"\x1cPRODUCT_IMAGE_STATUS_PENDING\x10\x01\x12\x1e\n" +
+	"\x1aPRODUCT_IMAGE_STATUS_READY\x10\x02*\x81\x01\n" +
+	"\rAttributeType\x12\x1e\n" +
+	"\x1aATTRIBUTE_TYPE_UNSPECIFIED\x10\x00\x12\x19\n" +
+	"\x15ATTRIBUTE_TYPE_STRING\x10\x01\x12\x19\n" +
+	"\x15ATTRIBUTE_TYPE_NUMBER\x10\x02\x12\x1a\n" +
+	"\x16ATTRIBUTE_TYPE_BOOLEAN\x10\x03B\xaa\x01\n" +
 	"\x0ecom.product.v1B\n" +
 	"TypesProtoP\x01ZCgithub.com/daisuke8000/example-ec-platform/gen/product/v1;productv1\xa2\x02\x03PXX\xaa\x02\n" +
 	"Product.V1\xca\x02\n" +
@@ -1002,50 +2055,82 @@ func file_product_v1_types_proto_rawDescGZIP() []byte {
 	return file_product_v1_types_proto_rawDescData
 }
 
-var file_product_v1_types_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
-var file_product_v1_types_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
+var file_product_v1_types_proto_enumTypes = make([]protoimpl.EnumInfo, 6)
+var file_product_v1_types_proto_msgTypes = make([]protoimpl.MessageInfo, 19)
 var file_product_v1_types_proto_goTypes = []any{
 	(ProductStatus)(0),              // 0: product.v1.ProductStatus
 	(ReservationStatus)(0),          // 1: product.v1.ReservationStatus
-	(*Money)(nil),                   // 2: product.v1.Money
-	(*Product)(nil),                 // 3: product.v1.Product
-	(*SKU)(nil),                     // 4: product.v1.SKU
-	(*Category)(nil),                // 5: product.v1.Category
-	(*Inventory)(nil),               // 6: product.v1.Inventory
-	(*Reservation)(nil),             // 7: product.v1.Reservation
-	(*ReservationItem)(nil),         // 8: product.v1.ReservationItem
-	(*InsufficientStockDetail)(nil), // 9: product.v1.InsufficientStockDetail
-	(*InsufficientItem)(nil),        // 10: product.v1.InsufficientItem
-	(*BatchValidationError)(nil),    // 11: product.v1.BatchValidationError
-	nil,                             // 12: product.v1.SKU.AttributesEntry
-	(*timestamppb.Timestamp)(nil),   // 13: google.protobuf.Timestamp
+	(InventoryMovementReason)(0),    // 2: product.v1.InventoryMovementReason
+	(PriceChangeStatus)(0),          // 3: product.v1.PriceChangeStatus
+	(ProductImageStatus)(0),         // 4: product.v1.ProductImageStatus
+	(AttributeType)(0),              // 5: product.v1.AttributeType
+	(*Money)(nil),                   // 6: product.v1.Money
+	(*Product)(nil),                 // 7: product.v1.Product
+	(*ProductImage)(nil),            // 8: product.v1.ProductImage
+	(*SKU)(nil),                     // 9: product.v1.SKU
+	(*MoneyList)(nil),               // 10: product.v1.MoneyList
+	(*Category)(nil),                // 11: product.v1.Category
+	(*AttributeDefinition)(nil),     // 12: product.v1.AttributeDefinition
+	(*CategoryTreeNode)(nil),        // 13: product.v1.CategoryTreeNode
+	(*Inventory)(nil),               // 14: product.v1.Inventory
+	(*Reservation)(nil),             // 15: product.v1.Reservation
+	(*ReservationItem)(nil),         // 16: product.v1.ReservationItem
+	(*SKUVelocity)(nil),             // 17: product.v1.SKUVelocity
+	(*PriceChange)(nil),             // 18: product.v1.PriceChange
+	(*InventoryMovement)(nil),       // 19: product.v1.InventoryMovement
+	(*VelocityWindow)(nil),          // 20: product.v1.VelocityWindow
+	(*InsufficientStockDetail)(nil), // 21: product.v1.InsufficientStockDetail
+	(*InsufficientItem)(nil),        // 22: product.v1.InsufficientItem
+	(*BatchValidationError)(nil),    // 23: product.v1.BatchValidationError
+	nil,                             // 24: product.v1.SKU.AttributesEntry
+	(*timestamppb.Timestamp)(nil),   // 25: google.protobuf.Timestamp
 }
 var file_product_v1_types_proto_depIdxs = []int32{
 	0,  // 0: product.v1.Product.status:type_name -> product.v1.ProductStatus
-	4,  // 1: product.v1.Product.skus:type_name -> product.v1.SKU
-	2,  // 2: product.v1.Product.min_price:type_name -> product.v1.Money
-	2,  // 3: product.v1.Product.max_price:type_name -> product.v1.Money
-	13, // 4: product.v1.Product.created_at:type_name -> google.protobuf.Timestamp
-	13, // 5: product.v1.Product.updated_at:type_name -> google.protobuf.Timestamp
-	2,  // 6: product.v1.SKU.price:type_name -> product.v1.Money
-	12, // 7: product.v1.SKU.attributes:type_name -> product.v1.SKU.AttributesEntry
-	6,  // 8: product.v1.SKU.inventory:type_name -> product.v1.Inventory
-	13, // 9: product.v1.SKU.created_at:type_name -> google.protobuf.Timestamp
-	13, // 10: product.v1.SKU.updated_at:type_name -> google.protobuf.Timestamp
-	5,  // 11: product.v1.Category.children:type_name -> product.v1.Category
-	13, // 12: product.v1.Category.created_at:type_name -> google.protobuf.Timestamp
-	13, // 13: product.v1.Category.updated_at:type_name -> google.protobuf.Timestamp
-	13, // 14: product.v1.Inventory.updated_at:type_name -> google.protobuf.Timestamp
-	1,  // 15: product.v1.Reservation.status:type_name -> product.v1.ReservationStatus
-	8,  // 16: product.v1.Reservation.items:type_name -> product.v1.ReservationItem
-	13, // 17: product.v1.Reservation.created_at:type_name -> google.protobuf.Timestamp
-	13, // 18: product.v1.Reservation.expires_at:type_name -> google.protobuf.Timestamp
-	10, // 19: product.v1.InsufficientStockDetail.items:type_name -> product.v1.InsufficientItem
-	20, // [20:20] is the sub-list for method output_type
-	20, // [20:20] is the sub-list for method input_type
-	20, // [20:20] is the sub-list for extension type_name
-	20, // [20:20] is the sub-list for extension extendee
-	0,  // [0:20] is the sub-list for field type_name
+	9,  // 1: product.v1.Product.skus:type_name -> product.v1.SKU
+	6,  // 2: product.v1.Product.min_price:type_name -> product.v1.Money
+	6,  // 3: product.v1.Product.max_price:type_name -> product.v1.Money
+	25, // 4: product.v1.Product.created_at:type_name -> google.protobuf.Timestamp
+	25, // 5: product.v1.Product.updated_at:type_name -> google.protobuf.Timestamp
+	8,  // 6: product.v1.Product.images:type_name -> product.v1.ProductImage
+	4,  // 7: product.v1.ProductImage.status:type_name -> product.v1.ProductImageStatus
+	25, // 8: product.v1.ProductImage.created_at:type_name -> google.protobuf.Timestamp
+	25, // 9: product.v1.ProductImage.updated_at:type_name -> google.protobuf.Timestamp
+	6,  // 10: product.v1.SKU.price:type_name -> product.v1.Money
+	24, // 11: product.v1.SKU.attributes:type_name -> product.v1.SKU.AttributesEntry
+	14, // 12: product.v1.SKU.inventory:type_name -> product.v1.Inventory
+	25, // 13: product.v1.SKU.created_at:type_name -> google.protobuf.Timestamp
+	25, // 14: product.v1.SKU.updated_at:type_name -> google.protobuf.Timestamp
+	6,  // 15: product.v1.SKU.additional_prices:type_name -> product.v1.Money
+	6,  // 16: product.v1.MoneyList.values:type_name -> product.v1.Money
+	11, // 17: product.v1.Category.children:type_name -> product.v1.Category
+	25, // 18: product.v1.Category.created_at:type_name -> google.protobuf.Timestamp
+	25, // 19: product.v1.Category.updated_at:type_name -> google.protobuf.Timestamp
+	5,  // 20: product.v1.AttributeDefinition.type:type_name -> product.v1.AttributeType
+	25, // 21: product.v1.AttributeDefinition.created_at:type_name -> google.protobuf.Timestamp
+	25, // 22: product.v1.AttributeDefinition.updated_at:type_name -> google.protobuf.Timestamp
+	11, // 23: product.v1.CategoryTreeNode.category:type_name -> product.v1.Category
+	13, // 24: product.v1.CategoryTreeNode.children:type_name -> product.v1.CategoryTreeNode
+	25, // 25: product.v1.Inventory.updated_at:type_name -> google.protobuf.Timestamp
+	1,  // 26: product.v1.Reservation.status:type_name -> product.v1.ReservationStatus
+	16, // 27: product.v1.Reservation.items:type_name -> product.v1.ReservationItem
+	25, // 28: product.v1.Reservation.created_at:type_name -> google.protobuf.Timestamp
+	25, // 29: product.v1.Reservation.expires_at:type_name -> google.protobuf.Timestamp
+	25, // 30: product.v1.Reservation.updated_at:type_name -> google.protobuf.Timestamp
+	20, // 31: product.v1.SKUVelocity.windows:type_name -> product.v1.VelocityWindow
+	6,  // 32: product.v1.PriceChange.price:type_name -> product.v1.Money
+	25, // 33: product.v1.PriceChange.effective_from:type_name -> google.protobuf.Timestamp
+	3,  // 34: product.v1.PriceChange.status:type_name -> product.v1.PriceChangeStatus
+	25, // 35: product.v1.PriceChange.created_at:type_name -> google.protobuf.Timestamp
+	25, // 36: product.v1.PriceChange.applied_at:type_name -> google.protobuf.Timestamp
+	2,  // 37: product.v1.InventoryMovement.reason:type_name -> product.v1.InventoryMovementReason
+	25, // 38: product.v1.InventoryMovement.created_at:type_name -> google.protobuf.Timestamp
+	22, // 39: product.v1.InsufficientStockDetail.items:type_name -> product.v1.InsufficientItem
+	40, // [40:40] is the sub-list for method output_type
+	40, // [40:40] is the sub-list for method input_type
+	40, // [40:40] is the sub-list for extension type_name
+	40, // [40:40] is the sub-list for extension extendee
+	0,  // [0:40] is the sub-list for field type_name
 }
 
 func init() { file_product_v1_types_proto_init() }
@@ -1053,15 +2138,16 @@ func file_product_v1_types_proto_init() {
 	if File_product_v1_types_proto != nil {
 		return
 	}
-	file_product_v1_types_proto_msgTypes[2].OneofWrappers = []any{}
 	file_product_v1_types_proto_msgTypes[3].OneofWrappers = []any{}
+	file_product_v1_types_proto_msgTypes[5].OneofWrappers = []any{}
+	file_product_v1_types_proto_msgTypes[12].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_product_v1_types_proto_rawDesc), len(file_product_v1_types_proto_rawDesc)),
-			NumEnums:      2,
-			NumMessages:   11,
+			NumEnums:      6,
+			NumMessages:   19,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
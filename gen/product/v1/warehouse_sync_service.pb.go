@@ -0,0 +1,859 @@
+// ==============================================================================
+// Warehouse Sync Service API
+// Integration surface for third-party logistics (3PL) providers
+// ==============================================================================
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: product/v1/warehouse_sync_service.proto
+
+package productv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type ExternalSKUMapping struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Provider      string                 `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
+	ExternalSku   string                 `protobuf:"bytes,2,opt,name=external_sku,json=externalSku,proto3" json:"external_sku,omitempty"`
+	SkuId         string                 `protobuf:"bytes,3,opt,name=sku_id,json=skuId,proto3" json:"sku_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExternalSKUMapping) Reset() {
+	*x = ExternalSKUMapping{}
+	mi := &file_product_v1_warehouse_sync_service_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExternalSKUMapping) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExternalSKUMapping) ProtoMessage() {}
+
+func (x *ExternalSKUMapping) ProtoReflect() protoreflect.Message {
+	mi := &file_product_v1_warehouse_sync_service_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExternalSKUMapping.ProtoReflect.Descriptor instead.
+func (*ExternalSKUMapping) Descriptor() ([]byte, []int) {
+	return file_product_v1_warehouse_sync_service_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ExternalSKUMapping) GetProvider() string {
+	if x != nil {
+		return x.Provider
+	}
+	return ""
+}
+
+func (x *ExternalSKUMapping) GetExternalSku() string {
+	if x != nil {
+		return x.ExternalSku
+	}
+	return ""
+}
+
+func (x *ExternalSKUMapping) GetSkuId() string {
+	if x != nil {
+		return x.SkuId
+	}
+	return ""
+}
+
+type SetExternalSKUMappingsRequest struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Provider string                 `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
+	// Mappings to create or repoint (max 500); provider is taken from the request
+	Mappings      []*ExternalSKUMapping `protobuf:"bytes,2,rep,name=mappings,proto3" json:"mappings,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetExternalSKUMappingsRequest) Reset() {
+	*x = SetExternalSKUMappingsRequest{}
+	mi := &file_product_v1_warehouse_sync_service_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetExternalSKUMappingsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetExternalSKUMappingsRequest) ProtoMessage() {}
+
+func (x *SetExternalSKUMappingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_v1_warehouse_sync_service_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetExternalSKUMappingsRequest.ProtoReflect.Descriptor instead.
+func (*SetExternalSKUMappingsRequest) Descriptor() ([]byte, []int) {
+	return file_product_v1_warehouse_sync_service_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *SetExternalSKUMappingsRequest) GetProvider() string {
+	if x != nil {
+		return x.Provider
+	}
+	return ""
+}
+
+func (x *SetExternalSKUMappingsRequest) GetMappings() []*ExternalSKUMapping {
+	if x != nil {
+		return x.Mappings
+	}
+	return nil
+}
+
+type SetExternalSKUMappingsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Mappings      []*ExternalSKUMapping  `protobuf:"bytes,1,rep,name=mappings,proto3" json:"mappings,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetExternalSKUMappingsResponse) Reset() {
+	*x = SetExternalSKUMappingsResponse{}
+	mi := &file_product_v1_warehouse_sync_service_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetExternalSKUMappingsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetExternalSKUMappingsResponse) ProtoMessage() {}
+
+func (x *SetExternalSKUMappingsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_v1_warehouse_sync_service_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetExternalSKUMappingsResponse.ProtoReflect.Descriptor instead.
+func (*SetExternalSKUMappingsResponse) Descriptor() ([]byte, []int) {
+	return file_product_v1_warehouse_sync_service_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *SetExternalSKUMappingsResponse) GetMappings() []*ExternalSKUMapping {
+	if x != nil {
+		return x.Mappings
+	}
+	return nil
+}
+
+type ListExternalSKUMappingsRequest struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Provider string                 `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
+	// Defaults to 100, max 1000
+	PageSize int32 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// next_page_token from a previous response
+	PageToken     string `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListExternalSKUMappingsRequest) Reset() {
+	*x = ListExternalSKUMappingsRequest{}
+	mi := &file_product_v1_warehouse_sync_service_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListExternalSKUMappingsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListExternalSKUMappingsRequest) ProtoMessage() {}
+
+func (x *ListExternalSKUMappingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_v1_warehouse_sync_service_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListExternalSKUMappingsRequest.ProtoReflect.Descriptor instead.
+func (*ListExternalSKUMappingsRequest) Descriptor() ([]byte, []int) {
+	return file_product_v1_warehouse_sync_service_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ListExternalSKUMappingsRequest) GetProvider() string {
+	if x != nil {
+		return x.Provider
+	}
+	return ""
+}
+
+func (x *ListExternalSKUMappingsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListExternalSKUMappingsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type ListExternalSKUMappingsResponse struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Mappings []*ExternalSKUMapping  `protobuf:"bytes,1,rep,name=mappings,proto3" json:"mappings,omitempty"`
+	// Empty when there are no more mappings
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListExternalSKUMappingsResponse) Reset() {
+	*x = ListExternalSKUMappingsResponse{}
+	mi := &file_product_v1_warehouse_sync_service_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListExternalSKUMappingsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListExternalSKUMappingsResponse) ProtoMessage() {}
+
+func (x *ListExternalSKUMappingsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_v1_warehouse_sync_service_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListExternalSKUMappingsResponse.ProtoReflect.Descriptor instead.
+func (*ListExternalSKUMappingsResponse) Descriptor() ([]byte, []int) {
+	return file_product_v1_warehouse_sync_service_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ListExternalSKUMappingsResponse) GetMappings() []*ExternalSKUMapping {
+	if x != nil {
+		return x.Mappings
+	}
+	return nil
+}
+
+func (x *ListExternalSKUMappingsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type DeleteExternalSKUMappingRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Provider      string                 `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
+	ExternalSku   string                 `protobuf:"bytes,2,opt,name=external_sku,json=externalSku,proto3" json:"external_sku,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteExternalSKUMappingRequest) Reset() {
+	*x = DeleteExternalSKUMappingRequest{}
+	mi := &file_product_v1_warehouse_sync_service_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteExternalSKUMappingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteExternalSKUMappingRequest) ProtoMessage() {}
+
+func (x *DeleteExternalSKUMappingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_v1_warehouse_sync_service_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteExternalSKUMappingRequest.ProtoReflect.Descriptor instead.
+func (*DeleteExternalSKUMappingRequest) Descriptor() ([]byte, []int) {
+	return file_product_v1_warehouse_sync_service_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *DeleteExternalSKUMappingRequest) GetProvider() string {
+	if x != nil {
+		return x.Provider
+	}
+	return ""
+}
+
+func (x *DeleteExternalSKUMappingRequest) GetExternalSku() string {
+	if x != nil {
+		return x.ExternalSku
+	}
+	return ""
+}
+
+type DeleteExternalSKUMappingResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteExternalSKUMappingResponse) Reset() {
+	*x = DeleteExternalSKUMappingResponse{}
+	mi := &file_product_v1_warehouse_sync_service_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteExternalSKUMappingResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteExternalSKUMappingResponse) ProtoMessage() {}
+
+func (x *DeleteExternalSKUMappingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_v1_warehouse_sync_service_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteExternalSKUMappingResponse.ProtoReflect.Descriptor instead.
+func (*DeleteExternalSKUMappingResponse) Descriptor() ([]byte, []int) {
+	return file_product_v1_warehouse_sync_service_proto_rawDescGZIP(), []int{6}
+}
+
+type ListStockChangesRequest struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Provider string                 `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
+	// next_cursor from a previous response; empty starts from the oldest movement
+	Cursor string `protobuf:"bytes,2,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	// Defaults to 100, max 1000
+	PageSize      int32 `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListStockChangesRequest) Reset() {
+	*x = ListStockChangesRequest{}
+	mi := &file_product_v1_warehouse_sync_service_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListStockChangesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListStockChangesRequest) ProtoMessage() {}
+
+func (x *ListStockChangesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_v1_warehouse_sync_service_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListStockChangesRequest.ProtoReflect.Descriptor instead.
+func (*ListStockChangesRequest) Descriptor() ([]byte, []int) {
+	return file_product_v1_warehouse_sync_service_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ListStockChangesRequest) GetProvider() string {
+	if x != nil {
+		return x.Provider
+	}
+	return ""
+}
+
+func (x *ListStockChangesRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+func (x *ListStockChangesRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type ListStockChangesResponse struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Changes []*StockChange         `protobuf:"bytes,1,rep,name=changes,proto3" json:"changes,omitempty"`
+	// Cursor to resume from; unchanged when there are no new changes
+	NextCursor    string `protobuf:"bytes,2,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListStockChangesResponse) Reset() {
+	*x = ListStockChangesResponse{}
+	mi := &file_product_v1_warehouse_sync_service_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListStockChangesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListStockChangesResponse) ProtoMessage() {}
+
+func (x *ListStockChangesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_v1_warehouse_sync_service_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListStockChangesResponse.ProtoReflect.Descriptor instead.
+func (*ListStockChangesResponse) Descriptor() ([]byte, []int) {
+	return file_product_v1_warehouse_sync_service_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ListStockChangesResponse) GetChanges() []*StockChange {
+	if x != nil {
+		return x.Changes
+	}
+	return nil
+}
+
+func (x *ListStockChangesResponse) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+type StockChange struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Movement      *InventoryMovement     `protobuf:"bytes,1,opt,name=movement,proto3" json:"movement,omitempty"`
+	ExternalSku   string                 `protobuf:"bytes,2,opt,name=external_sku,json=externalSku,proto3" json:"external_sku,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StockChange) Reset() {
+	*x = StockChange{}
+	mi := &file_product_v1_warehouse_sync_service_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StockChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StockChange) ProtoMessage() {}
+
+func (x *StockChange) ProtoReflect() protoreflect.Message {
+	mi := &file_product_v1_warehouse_sync_service_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StockChange.ProtoReflect.Descriptor instead.
+func (*StockChange) Descriptor() ([]byte, []int) {
+	return file_product_v1_warehouse_sync_service_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *StockChange) GetMovement() *InventoryMovement {
+	if x != nil {
+		return x.Movement
+	}
+	return nil
+}
+
+func (x *StockChange) GetExternalSku() string {
+	if x != nil {
+		return x.ExternalSku
+	}
+	return ""
+}
+
+type PushWarehouseAdjustmentsRequest struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Provider string                 `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
+	// Idempotency key for exactly-once semantics (required, max 256 chars),
+	// scoped to the provider. Recommended: the warehouse's own document ID.
+	IdempotencyKey string `protobuf:"bytes,2,opt,name=idempotency_key,json=idempotencyKey,proto3" json:"idempotency_key,omitempty"`
+	// Adjustments to apply (max 500)
+	Adjustments   []*WarehouseAdjustment `protobuf:"bytes,3,rep,name=adjustments,proto3" json:"adjustments,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PushWarehouseAdjustmentsRequest) Reset() {
+	*x = PushWarehouseAdjustmentsRequest{}
+	mi := &file_product_v1_warehouse_sync_service_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PushWarehouseAdjustmentsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PushWarehouseAdjustmentsRequest) ProtoMessage() {}
+
+func (x *PushWarehouseAdjustmentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_v1_warehouse_sync_service_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PushWarehouseAdjustmentsRequest.ProtoReflect.Descriptor instead.
+func (*PushWarehouseAdjustmentsRequest) Descriptor() ([]byte, []int) {
+	return file_product_v1_warehouse_sync_service_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *PushWarehouseAdjustmentsRequest) GetProvider() string {
+	if x != nil {
+		return x.Provider
+	}
+	return ""
+}
+
+func (x *PushWarehouseAdjustmentsRequest) GetIdempotencyKey() string {
+	if x != nil {
+		return x.IdempotencyKey
+	}
+	return ""
+}
+
+func (x *PushWarehouseAdjustmentsRequest) GetAdjustments() []*WarehouseAdjustment {
+	if x != nil {
+		return x.Adjustments
+	}
+	return nil
+}
+
+type WarehouseAdjustment struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ExternalSku   string                 `protobuf:"bytes,1,opt,name=external_sku,json=externalSku,proto3" json:"external_sku,omitempty"`
+	QuantityDelta int64                  `protobuf:"varint,2,opt,name=quantity_delta,json=quantityDelta,proto3" json:"quantity_delta,omitempty"` // Non-zero change in on-hand quantity
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WarehouseAdjustment) Reset() {
+	*x = WarehouseAdjustment{}
+	mi := &file_product_v1_warehouse_sync_service_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WarehouseAdjustment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WarehouseAdjustment) ProtoMessage() {}
+
+func (x *WarehouseAdjustment) ProtoReflect() protoreflect.Message {
+	mi := &file_product_v1_warehouse_sync_service_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WarehouseAdjustment.ProtoReflect.Descriptor instead.
+func (*WarehouseAdjustment) Descriptor() ([]byte, []int) {
+	return file_product_v1_warehouse_sync_service_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *WarehouseAdjustment) GetExternalSku() string {
+	if x != nil {
+		return x.ExternalSku
+	}
+	return ""
+}
+
+func (x *WarehouseAdjustment) GetQuantityDelta() int64 {
+	if x != nil {
+		return x.QuantityDelta
+	}
+	return 0
+}
+
+type PushWarehouseAdjustmentsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// True if the idempotency key was already applied; nothing was changed
+	Replayed bool `protobuf:"varint,1,opt,name=replayed,proto3" json:"replayed,omitempty"`
+	// Number of adjustments in the applied push
+	AppliedCount  int32 `protobuf:"varint,2,opt,name=applied_count,json=appliedCount,proto3" json:"applied_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PushWarehouseAdjustmentsResponse) Reset() {
+	*x = PushWarehouseAdjustmentsResponse{}
+	mi := &file_product_v1_warehouse_sync_service_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PushWarehouseAdjustmentsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PushWarehouseAdjustmentsResponse) ProtoMessage() {}
+
+func (x *PushWarehouseAdjustmentsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_v1_warehouse_sync_service_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PushWarehouseAdjustmentsResponse.ProtoReflect.Descriptor instead.
+func (*PushWarehouseAdjustmentsResponse) Descriptor() ([]byte, []int) {
+	return file_product_v1_warehouse_sync_service_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *PushWarehouseAdjustmentsResponse) GetReplayed() bool {
+	if x != nil {
+		return x.Replayed
+	}
+	return false
+}
+
+func (x *PushWarehouseAdjustmentsResponse) GetAppliedCount() int32 {
+	if x != nil {
+		return x.AppliedCount
+	}
+	return 0
+}
+
+var File_product_v1_warehouse_sync_service_proto protoreflect.FileDescriptor
+
+const file_product_v1_warehouse_sync_service_proto_rawDesc = "" +
+	"\n" +
+	"'product/v1/warehouse_sync_service.proto\x12\n" +
+	"product.v1\x1a\x16product/v1/types.proto\"j\n" +
+	"\x12ExternalSKUMapping\x12\x1a\n" +
+	"\bprovider\x18\x01 \x01(\tR\bprovider\x12!\n" +
+	"\fexternal_sku\x18\x02 \x01(\tR\vexternalSku\x12\x15\n" +
+	"\x06sku_id\x18\x03 \x01(\tR\x05skuId\"w\n" +
+	"\x1dSetExternalSKUMappingsRequest\x12\x1a\n" +
+	"\bprovider\x18\x01 \x01(\tR\bprovider\x12:\n" +
+	"\bmappings\x18\x02 \x03(\v2\x1e.product.v1.ExternalSKUMappingR\bmappings\"\\\n" +
+	"\x1eSetExternalSKUMappingsResponse\x12:\n" +
+	"\bmappings\x18\x01 \x03(\v2\x1e.product.v1.ExternalSKUMappingR\bmappings\"x\n" +
+	"\x1eListExternalSKUMappingsRequest\x12\x1a\n" +
+	"\bprovider\x18\x01 \x01(\tR\bprovider\x12\x1b\n" +
+	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x03 \x01(\tR\tpageToken\"\x85\x01\n" +
+	"\x1fListExternalSKUMappingsResponse\x12:\n" +
+	"\bmappings\x18\x01 \x03(\v2\x1e.product.v1.ExternalSKUMappingR\bmappings\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"`\n" +
+	"\x1fDeleteExternalSKUMappingRequest\x12\x1a\n" +
+	"\bprovider\x18\x01 \x01(\tR\bprovider\x12!\n" +
+	"\fexternal_sku\x18\x02 \x01(\tR\vexternalSku\"\"\n" +
+	" DeleteExternalSKUMappingResponse\"j\n" +
+	"\x17ListStockChangesRequest\x12\x1a\n" +
+	"\bprovider\x18\x01 \x01(\tR\bprovider\x12\x16\n" +
+	"\x06cursor\x18\x02 \x01(\tR\x06cursor\x12\x1b\n" +
+	"\tpage_size\x18\x03 \x01(\x05R\bpageSize\"n\n" +
+	"\x18ListStockChangesResponse\x121\n" +
+	"\achanges\x18\x01 \x03(\v2\x17.product.v1.StockChangeR\achanges\x12\x1f\n" +
+	"\vnext_cursor\x18\x02 \x01(\tR\n" +
+	"nextCursor\"k\n" +
+	"\vStockChange\x129\n" +
+	"\bmovement\x18\x01 \x01(\v2\x1d.product.v1.InventoryMovementR\bmovement\x12!\n" +
+	"\fexternal_sku\x18\x02 \x01(\tR\vexternalSku\"\xa9\x01\n" +
+	"\x1fPushWarehouseAdjustmentsRequest\x12\x1a\n" +
+	"\bprovider\x18\x01 \x01(\tR\bprovider\x12'\n" +
+	"\x0fidempotency_key\x18\x02 \x01(\tR\x0eidempotencyKey\x12A\n" +
+	"\vadjustments\x18\x03 \x03(\v2\x1f.product.v1.WarehouseAdjustmentR\vadjustments\"_\n" +
+	"\x13WarehouseAdjustment\x12!\n" +
+	"\fexternal_sku\x18\x01 \x01(\tR\vexternalSku\x12%\n" +
+	"\x0equantity_delta\x18\x02 \x01(\x03R\rquantityDelta\"c\n" +
+	" PushWarehouseAdjustmentsResponse\x12\x1a\n" +
+	"\breplayed\x18\x01 \x01(\bR\breplayed\x12#\n" +
+	"\rapplied_count\x18\x02 \x01(\x05R\fappliedCount2\xc8\x04\n" +
+	"\x14WarehouseSyncService\x12o\n" +
+	"\x16SetExternalSKUMappings\x12).product.v1.SetExternalSKUMappingsRequest\x1a*.product.v1.SetExternalSKUMappingsResponse\x12r\n" +
+	"\x17ListExternalSKUMappings\x12*.product.v1.ListExternalSKUMappingsRequest\x1a+.product.v1.ListExternalSKUMappingsResponse\x12u\n" +
+	"\x18DeleteExternalSKUMapping\x12+.product.v1.DeleteExternalSKUMappingRequest\x1a,.product.v1.DeleteExternalSKUMappingResponse\x12]\n" +
+	"\x10ListStockChanges\x12#.product.v1.ListStockChangesRequest\x1a$.product.v1.ListStockChangesResponse\x12u\n" +
+	"\x18PushWarehouseAdjustments\x12+.product.v1.PushWarehouseAdjustmentsRequest\x1a,.product.v1.PushWarehouseAdjustmentsResponseB\xb9\x01\n" +
+	"\x0ecom.product.v1B\x19WarehouseSyncServiceProtoP\x01ZCgithub.com/daisuke8000/example-ec-platform/gen/product/v1;productv1\xa2\x02\x03PXX\xaa\x02\n" +
+	"Product.V1\xca\x02\n" +
+	"Product\\V1\xe2\x02\x16Product\\V1\\GPBMetadata\xea\x02\vProduct::V1b\x06proto3"
+
+var (
+	file_product_v1_warehouse_sync_service_proto_rawDescOnce sync.Once
+	file_product_v1_warehouse_sync_service_proto_rawDescData []byte
+)
+
+func file_product_v1_warehouse_sync_service_proto_rawDescGZIP() []byte {
+	file_product_v1_warehouse_sync_service_proto_rawDescOnce.Do(func() {
+		file_product_v1_warehouse_sync_service_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_product_v1_warehouse_sync_service_proto_rawDesc), len(file_product_v1_warehouse_sync_service_proto_rawDesc)))
+	})
+	return file_product_v1_warehouse_sync_service_proto_rawDescData
+}
+
+var file_product_v1_warehouse_sync_service_proto_msgTypes = make([]protoimpl.MessageInfo, 13)
+var file_product_v1_warehouse_sync_service_proto_goTypes = []any{
+	(*ExternalSKUMapping)(nil),               // 0: product.v1.ExternalSKUMapping
+	(*SetExternalSKUMappingsRequest)(nil),    // 1: product.v1.SetExternalSKUMappingsRequest
+	(*SetExternalSKUMappingsResponse)(nil),   // 2: product.v1.SetExternalSKUMappingsResponse
+	(*ListExternalSKUMappingsRequest)(nil),   // 3: product.v1.ListExternalSKUMappingsRequest
+	(*ListExternalSKUMappingsResponse)(nil),  // 4: product.v1.ListExternalSKUMappingsResponse
+	(*DeleteExternalSKUMappingRequest)(nil),  // 5: product.v1.DeleteExternalSKUMappingRequest
+	(*DeleteExternalSKUMappingResponse)(nil), // 6: product.v1.DeleteExternalSKUMappingResponse
+	(*ListStockChangesRequest)(nil),          // 7: product.v1.ListStockChangesRequest
+	(*ListStockChangesResponse)(nil),         // 8: product.v1.ListStockChangesResponse
+	(*StockChange)(nil),                      // 9: product.v1.StockChange
+	(*PushWarehouseAdjustmentsRequest)(nil),  // 10: product.v1.PushWarehouseAdjustmentsRequest
+	(*WarehouseAdjustment)(nil),              // 11: product.v1.WarehouseAdjustment
+	(*PushWarehouseAdjustmentsResponse)(nil), // 12: product.v1.PushWarehouseAdjustmentsResponse
+	(*InventoryMovement)(nil),                // 13: product.v1.InventoryMovement
+}
+var file_product_v1_warehouse_sync_service_proto_depIdxs = []int32{
+	0,  // 0: product.v1.SetExternalSKUMappingsRequest.mappings:type_name -> product.v1.ExternalSKUMapping
+	0,  // 1: product.v1.SetExternalSKUMappingsResponse.mappings:type_name -> product.v1.ExternalSKUMapping
+	0,  // 2: product.v1.ListExternalSKUMappingsResponse.mappings:type_name -> product.v1.ExternalSKUMapping
+	9,  // 3: product.v1.ListStockChangesResponse.changes:type_name -> product.v1.StockChange
+	13, // 4: product.v1.StockChange.movement:type_name -> product.v1.InventoryMovement
+	11, // 5: product.v1.PushWarehouseAdjustmentsRequest.adjustments:type_name -> product.v1.WarehouseAdjustment
+	1,  // 6: product.v1.WarehouseSyncService.SetExternalSKUMappings:input_type -> product.v1.SetExternalSKUMappingsRequest
+	3,  // 7: product.v1.WarehouseSyncService.ListExternalSKUMappings:input_type -> product.v1.ListExternalSKUMappingsRequest
+	5,  // 8: product.v1.WarehouseSyncService.DeleteExternalSKUMapping:input_type -> product.v1.DeleteExternalSKUMappingRequest
+	7,  // 9: product.v1.WarehouseSyncService.ListStockChanges:input_type -> product.v1.ListStockChangesRequest
+	10, // 10: product.v1.WarehouseSyncService.PushWarehouseAdjustments:input_type -> product.v1.PushWarehouseAdjustmentsRequest
+	2,  // 11: product.v1.WarehouseSyncService.SetExternalSKUMappings:output_type -> product.v1.SetExternalSKUMappingsResponse
+	4,  // 12: product.v1.WarehouseSyncService.ListExternalSKUMappings:output_type -> product.v1.ListExternalSKUMappingsResponse
+	6,  // 13: product.v1.WarehouseSyncService.DeleteExternalSKUMapping:output_type -> product.v1.DeleteExternalSKUMappingResponse
+	8,  // 14: product.v1.WarehouseSyncService.ListStockChanges:output_type -> product.v1.ListStockChangesResponse
+	12, // 15: product.v1.WarehouseSyncService.PushWarehouseAdjustments:output_type -> product.v1.PushWarehouseAdjustmentsResponse
+	11, // [11:16] is the sub-list for method output_type
+	6,  // [6:11] is the sub-list for method input_type
+	6,  // [6:6] is the sub-list for extension type_name
+	6,  // [6:6] is the sub-list for extension extendee
+	0,  // [0:6] is the sub-list for field type_name
+}
+
+func init() { file_product_v1_warehouse_sync_service_proto_init() }
+func file_product_v1_warehouse_sync_service_proto_init() {
+	if File_product_v1_warehouse_sync_service_proto != nil {
+		return
+	}
+	file_product_v1_types_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_product_v1_warehouse_sync_service_proto_rawDesc), len(file_product_v1_warehouse_sync_service_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   13,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_product_v1_warehouse_sync_service_proto_goTypes,
+		DependencyIndexes: file_product_v1_warehouse_sync_service_proto_depIdxs,
+		MessageInfos:      file_product_v1_warehouse_sync_service_proto_msgTypes,
+	}.Build()
+	File_product_v1_warehouse_sync_service_proto = out.File
+	file_product_v1_warehouse_sync_service_proto_goTypes = nil
+	file_product_v1_warehouse_sync_service_proto_depIdxs = nil
+}
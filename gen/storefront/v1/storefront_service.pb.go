@@ -0,0 +1,1214 @@
+// ==============================================================================
+// Storefront Service API
+// BFF-only API that composes backend data into storefront page payloads
+// ==============================================================================
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: storefront/v1/storefront_service.proto
+
+package storefrontv1
+
+import (
+	v1 "github.com/daisuke8000/example-ec-platform/gen/product/v1"
+	v11 "github.com/daisuke8000/example-ec-platform/gen/user/v1"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// StockLevel is the coarse stock state shown to customers.
+type StockLevel int32
+
+const (
+	StockLevel_STOCK_LEVEL_UNSPECIFIED  StockLevel = 0
+	StockLevel_STOCK_LEVEL_OUT_OF_STOCK StockLevel = 1
+	StockLevel_STOCK_LEVEL_LOW          StockLevel = 2 // At or below the low-stock threshold
+	StockLevel_STOCK_LEVEL_IN_STOCK     StockLevel = 3
+)
+
+// Enum value maps for StockLevel.
+var (
+	StockLevel_name = map[int32]string{
+		0: "STOCK_LEVEL_UNSPECIFIED",
+		1: "STOCK_LEVEL_OUT_OF_STOCK",
+		2: "STOCK_LEVEL_LOW",
+		3: "STOCK_LEVEL_IN_STOCK",
+	}
+	StockLevel_value = map[string]int32{
+		"STOCK_LEVEL_UNSPECIFIED":  0,
+		"STOCK_LEVEL_OUT_OF_STOCK": 1,
+		"STOCK_LEVEL_LOW":          2,
+		"STOCK_LEVEL_IN_STOCK":     3,
+	}
+)
+
+func (x StockLevel) Enum() *StockLevel {
+	p := new(StockLevel)
+	*p = x
+	return p
+}
+
+func (x StockLevel) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (StockLevel) Descriptor() protoreflect.EnumDescriptor {
+	return file_storefront_v1_storefront_service_proto_enumTypes[0].Descriptor()
+}
+
+func (StockLevel) Type() protoreflect.EnumType {
+	return &file_storefront_v1_storefront_service_proto_enumTypes[0]
+}
+
+func (x StockLevel) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use StockLevel.Descriptor instead.
+func (StockLevel) EnumDescriptor() ([]byte, []int) {
+	return file_storefront_v1_storefront_service_proto_rawDescGZIP(), []int{0}
+}
+
+type GetProductPageRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetProductPageRequest) Reset() {
+	*x = GetProductPageRequest{}
+	mi := &file_storefront_v1_storefront_service_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetProductPageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetProductPageRequest) ProtoMessage() {}
+
+func (x *GetProductPageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_storefront_v1_storefront_service_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetProductPageRequest.ProtoReflect.Descriptor instead.
+func (*GetProductPageRequest) Descriptor() ([]byte, []int) {
+	return file_storefront_v1_storefront_service_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *GetProductPageRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+type GetProductPageResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// SKUs have inventory populated when their stock lookup succeeded, unless
+	// exact counts are hidden for the product's category.
+	Product         *v1.Product        `protobuf:"bytes,1,opt,name=product,proto3" json:"product,omitempty"`
+	Category        *v1.Category       `protobuf:"bytes,2,opt,name=category,proto3" json:"category,omitempty"`               // Unset when the lookup failed
+	Availability    []*SKUAvailability `protobuf:"bytes,3,rep,name=availability,proto3" json:"availability,omitempty"`       // One entry per SKU, in product order
+	InStock         bool               `protobuf:"varint,4,opt,name=in_stock,json=inStock,proto3" json:"in_stock,omitempty"` // True if any SKU is known to be in stock
+	PartialFailures []*PartialFailure  `protobuf:"bytes,5,rep,name=partial_failures,json=partialFailures,proto3" json:"partial_failures,omitempty"`
+	FavoriteCount   int64              `protobuf:"varint,6,opt,name=favorite_count,json=favoriteCount,proto3" json:"favorite_count,omitempty"` // Users who saved the product; 0 when the lookup failed
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *GetProductPageResponse) Reset() {
+	*x = GetProductPageResponse{}
+	mi := &file_storefront_v1_storefront_service_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetProductPageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetProductPageResponse) ProtoMessage() {}
+
+func (x *GetProductPageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_storefront_v1_storefront_service_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetProductPageResponse.ProtoReflect.Descriptor instead.
+func (*GetProductPageResponse) Descriptor() ([]byte, []int) {
+	return file_storefront_v1_storefront_service_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GetProductPageResponse) GetProduct() *v1.Product {
+	if x != nil {
+		return x.Product
+	}
+	return nil
+}
+
+func (x *GetProductPageResponse) GetCategory() *v1.Category {
+	if x != nil {
+		return x.Category
+	}
+	return nil
+}
+
+func (x *GetProductPageResponse) GetAvailability() []*SKUAvailability {
+	if x != nil {
+		return x.Availability
+	}
+	return nil
+}
+
+func (x *GetProductPageResponse) GetInStock() bool {
+	if x != nil {
+		return x.InStock
+	}
+	return false
+}
+
+func (x *GetProductPageResponse) GetPartialFailures() []*PartialFailure {
+	if x != nil {
+		return x.PartialFailures
+	}
+	return nil
+}
+
+func (x *GetProductPageResponse) GetFavoriteCount() int64 {
+	if x != nil {
+		return x.FavoriteCount
+	}
+	return 0
+}
+
+type WatchProductAvailabilityRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WatchProductAvailabilityRequest) Reset() {
+	*x = WatchProductAvailabilityRequest{}
+	mi := &file_storefront_v1_storefront_service_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchProductAvailabilityRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchProductAvailabilityRequest) ProtoMessage() {}
+
+func (x *WatchProductAvailabilityRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_storefront_v1_storefront_service_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchProductAvailabilityRequest.ProtoReflect.Descriptor instead.
+func (*WatchProductAvailabilityRequest) Descriptor() ([]byte, []int) {
+	return file_storefront_v1_storefront_service_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *WatchProductAvailabilityRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+type WatchProductAvailabilityResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Availability  *SKUAvailability       `protobuf:"bytes,1,opt,name=availability,proto3" json:"availability,omitempty"` // Always known
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WatchProductAvailabilityResponse) Reset() {
+	*x = WatchProductAvailabilityResponse{}
+	mi := &file_storefront_v1_storefront_service_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchProductAvailabilityResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchProductAvailabilityResponse) ProtoMessage() {}
+
+func (x *WatchProductAvailabilityResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_storefront_v1_storefront_service_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchProductAvailabilityResponse.ProtoReflect.Descriptor instead.
+func (*WatchProductAvailabilityResponse) Descriptor() ([]byte, []int) {
+	return file_storefront_v1_storefront_service_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *WatchProductAvailabilityResponse) GetAvailability() *SKUAvailability {
+	if x != nil {
+		return x.Availability
+	}
+	return nil
+}
+
+// SKUAvailability is the display-ready stock state of a SKU. How much of the
+// available quantity is revealed is set by the BFF's stock display policy.
+type SKUAvailability struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	SkuId   string                 `protobuf:"bytes,1,opt,name=sku_id,json=skuId,proto3" json:"sku_id,omitempty"`
+	Known   bool                   `protobuf:"varint,2,opt,name=known,proto3" json:"known,omitempty"`                               // False when the stock lookup failed
+	InStock bool                   `protobuf:"varint,3,opt,name=in_stock,json=inStock,proto3" json:"in_stock,omitempty"`            // Available quantity > 0; false when unknown
+	Level   StockLevel             `protobuf:"varint,4,opt,name=level,proto3,enum=storefront.v1.StockLevel" json:"level,omitempty"` // UNSPECIFIED when unknown
+	// Quantity to show customers, at most the display cap (e.g. "only 3 left").
+	// 0 when only the level should be shown: out of stock, or exact counts are
+	// hidden for the product's category.
+	DisplayQuantity int32 `protobuf:"varint,5,opt,name=display_quantity,json=displayQuantity,proto3" json:"display_quantity,omitempty"`
+	// True when more than display_quantity are available (e.g. "10+ in stock")
+	MoreAvailable bool `protobuf:"varint,6,opt,name=more_available,json=moreAvailable,proto3" json:"more_available,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SKUAvailability) Reset() {
+	*x = SKUAvailability{}
+	mi := &file_storefront_v1_storefront_service_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SKUAvailability) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SKUAvailability) ProtoMessage() {}
+
+func (x *SKUAvailability) ProtoReflect() protoreflect.Message {
+	mi := &file_storefront_v1_storefront_service_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SKUAvailability.ProtoReflect.Descriptor instead.
+func (*SKUAvailability) Descriptor() ([]byte, []int) {
+	return file_storefront_v1_storefront_service_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *SKUAvailability) GetSkuId() string {
+	if x != nil {
+		return x.SkuId
+	}
+	return ""
+}
+
+func (x *SKUAvailability) GetKnown() bool {
+	if x != nil {
+		return x.Known
+	}
+	return false
+}
+
+func (x *SKUAvailability) GetInStock() bool {
+	if x != nil {
+		return x.InStock
+	}
+	return false
+}
+
+func (x *SKUAvailability) GetLevel() StockLevel {
+	if x != nil {
+		return x.Level
+	}
+	return StockLevel_STOCK_LEVEL_UNSPECIFIED
+}
+
+func (x *SKUAvailability) GetDisplayQuantity() int32 {
+	if x != nil {
+		return x.DisplayQuantity
+	}
+	return 0
+}
+
+func (x *SKUAvailability) GetMoreAvailable() bool {
+	if x != nil {
+		return x.MoreAvailable
+	}
+	return false
+}
+
+// PartialFailure describes a backend call whose data is missing from the response.
+type PartialFailure struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Procedure     string                 `protobuf:"bytes,1,opt,name=procedure,proto3" json:"procedure,omitempty"`                     // e.g. "/product.v1.InventoryService/GetInventory"
+	ResourceId    string                 `protobuf:"bytes,2,opt,name=resource_id,json=resourceId,proto3" json:"resource_id,omitempty"` // ID passed to the call
+	Code          string                 `protobuf:"bytes,3,opt,name=code,proto3" json:"code,omitempty"`                               // Connect error code, e.g. "deadline_exceeded"
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PartialFailure) Reset() {
+	*x = PartialFailure{}
+	mi := &file_storefront_v1_storefront_service_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PartialFailure) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PartialFailure) ProtoMessage() {}
+
+func (x *PartialFailure) ProtoReflect() protoreflect.Message {
+	mi := &file_storefront_v1_storefront_service_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PartialFailure.ProtoReflect.Descriptor instead.
+func (*PartialFailure) Descriptor() ([]byte, []int) {
+	return file_storefront_v1_storefront_service_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *PartialFailure) GetProcedure() string {
+	if x != nil {
+		return x.Procedure
+	}
+	return ""
+}
+
+func (x *PartialFailure) GetResourceId() string {
+	if x != nil {
+		return x.ResourceId
+	}
+	return ""
+}
+
+func (x *PartialFailure) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+type ListProductsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PageSize      int32                  `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"` // Default: 20, Max: 100
+	PageToken     string                 `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	CategoryId    *string                `protobuf:"bytes,3,opt,name=category_id,json=categoryId,proto3,oneof" json:"category_id,omitempty"`
+	SearchQuery   *string                `protobuf:"bytes,4,opt,name=search_query,json=searchQuery,proto3,oneof" json:"search_query,omitempty"`
+	OrderBy       string                 `protobuf:"bytes,5,opt,name=order_by,json=orderBy,proto3" json:"order_by,omitempty"` // See product.v1.ListProductsRequest.order_by
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListProductsRequest) Reset() {
+	*x = ListProductsRequest{}
+	mi := &file_storefront_v1_storefront_service_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListProductsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListProductsRequest) ProtoMessage() {}
+
+func (x *ListProductsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_storefront_v1_storefront_service_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListProductsRequest.ProtoReflect.Descriptor instead.
+func (*ListProductsRequest) Descriptor() ([]byte, []int) {
+	return file_storefront_v1_storefront_service_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ListProductsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListProductsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListProductsRequest) GetCategoryId() string {
+	if x != nil && x.CategoryId != nil {
+		return *x.CategoryId
+	}
+	return ""
+}
+
+func (x *ListProductsRequest) GetSearchQuery() string {
+	if x != nil && x.SearchQuery != nil {
+		return *x.SearchQuery
+	}
+	return ""
+}
+
+func (x *ListProductsRequest) GetOrderBy() string {
+	if x != nil {
+		return x.OrderBy
+	}
+	return ""
+}
+
+type ListProductsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Products      []*v1.Product          `protobuf:"bytes,1,rep,name=products,proto3" json:"products,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListProductsResponse) Reset() {
+	*x = ListProductsResponse{}
+	mi := &file_storefront_v1_storefront_service_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListProductsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListProductsResponse) ProtoMessage() {}
+
+func (x *ListProductsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_storefront_v1_storefront_service_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListProductsResponse.ProtoReflect.Descriptor instead.
+func (*ListProductsResponse) Descriptor() ([]byte, []int) {
+	return file_storefront_v1_storefront_service_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ListProductsResponse) GetProducts() []*v1.Product {
+	if x != nil {
+		return x.Products
+	}
+	return nil
+}
+
+func (x *ListProductsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type ListCategoriesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCategoriesRequest) Reset() {
+	*x = ListCategoriesRequest{}
+	mi := &file_storefront_v1_storefront_service_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCategoriesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCategoriesRequest) ProtoMessage() {}
+
+func (x *ListCategoriesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_storefront_v1_storefront_service_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCategoriesRequest.ProtoReflect.Descriptor instead.
+func (*ListCategoriesRequest) Descriptor() ([]byte, []int) {
+	return file_storefront_v1_storefront_service_proto_rawDescGZIP(), []int{8}
+}
+
+type ListCategoriesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Categories    []*v1.Category         `protobuf:"bytes,1,rep,name=categories,proto3" json:"categories,omitempty"` // Root categories with children populated
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCategoriesResponse) Reset() {
+	*x = ListCategoriesResponse{}
+	mi := &file_storefront_v1_storefront_service_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCategoriesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCategoriesResponse) ProtoMessage() {}
+
+func (x *ListCategoriesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_storefront_v1_storefront_service_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCategoriesResponse.ProtoReflect.Descriptor instead.
+func (*ListCategoriesResponse) Descriptor() ([]byte, []int) {
+	return file_storefront_v1_storefront_service_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ListCategoriesResponse) GetCategories() []*v1.Category {
+	if x != nil {
+		return x.Categories
+	}
+	return nil
+}
+
+type GetMeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMeRequest) Reset() {
+	*x = GetMeRequest{}
+	mi := &file_storefront_v1_storefront_service_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMeRequest) ProtoMessage() {}
+
+func (x *GetMeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_storefront_v1_storefront_service_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMeRequest.ProtoReflect.Descriptor instead.
+func (*GetMeRequest) Descriptor() ([]byte, []int) {
+	return file_storefront_v1_storefront_service_proto_rawDescGZIP(), []int{10}
+}
+
+type GetMeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	User          *v11.User              `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMeResponse) Reset() {
+	*x = GetMeResponse{}
+	mi := &file_storefront_v1_storefront_service_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMeResponse) ProtoMessage() {}
+
+func (x *GetMeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_storefront_v1_storefront_service_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMeResponse.ProtoReflect.Descriptor instead.
+func (*GetMeResponse) Descriptor() ([]byte, []int) {
+	return file_storefront_v1_storefront_service_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *GetMeResponse) GetUser() *v11.User {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+type AddFavoriteRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	ProductId     string                 `protobuf:"bytes,2,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddFavoriteRequest) Reset() {
+	*x = AddFavoriteRequest{}
+	mi := &file_storefront_v1_storefront_service_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddFavoriteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddFavoriteRequest) ProtoMessage() {}
+
+func (x *AddFavoriteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_storefront_v1_storefront_service_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddFavoriteRequest.ProtoReflect.Descriptor instead.
+func (*AddFavoriteRequest) Descriptor() ([]byte, []int) {
+	return file_storefront_v1_storefront_service_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *AddFavoriteRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *AddFavoriteRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+type AddFavoriteResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddFavoriteResponse) Reset() {
+	*x = AddFavoriteResponse{}
+	mi := &file_storefront_v1_storefront_service_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddFavoriteResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddFavoriteResponse) ProtoMessage() {}
+
+func (x *AddFavoriteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_storefront_v1_storefront_service_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddFavoriteResponse.ProtoReflect.Descriptor instead.
+func (*AddFavoriteResponse) Descriptor() ([]byte, []int) {
+	return file_storefront_v1_storefront_service_proto_rawDescGZIP(), []int{13}
+}
+
+type RemoveFavoriteRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	ProductId     string                 `protobuf:"bytes,2,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveFavoriteRequest) Reset() {
+	*x = RemoveFavoriteRequest{}
+	mi := &file_storefront_v1_storefront_service_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveFavoriteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveFavoriteRequest) ProtoMessage() {}
+
+func (x *RemoveFavoriteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_storefront_v1_storefront_service_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveFavoriteRequest.ProtoReflect.Descriptor instead.
+func (*RemoveFavoriteRequest) Descriptor() ([]byte, []int) {
+	return file_storefront_v1_storefront_service_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *RemoveFavoriteRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *RemoveFavoriteRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+type RemoveFavoriteResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveFavoriteResponse) Reset() {
+	*x = RemoveFavoriteResponse{}
+	mi := &file_storefront_v1_storefront_service_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveFavoriteResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveFavoriteResponse) ProtoMessage() {}
+
+func (x *RemoveFavoriteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_storefront_v1_storefront_service_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveFavoriteResponse.ProtoReflect.Descriptor instead.
+func (*RemoveFavoriteResponse) Descriptor() ([]byte, []int) {
+	return file_storefront_v1_storefront_service_proto_rawDescGZIP(), []int{15}
+}
+
+type ListFavoritesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	PageSize      int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"` // Default: 20, Max: 100
+	PageToken     string                 `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListFavoritesRequest) Reset() {
+	*x = ListFavoritesRequest{}
+	mi := &file_storefront_v1_storefront_service_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListFavoritesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListFavoritesRequest) ProtoMessage() {}
+
+func (x *ListFavoritesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_storefront_v1_storefront_service_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListFavoritesRequest.ProtoReflect.Descriptor instead.
+func (*ListFavoritesRequest) Descriptor() ([]byte, []int) {
+	return file_storefront_v1_storefront_service_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *ListFavoritesRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ListFavoritesRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListFavoritesRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type ListFavoritesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Favorites     []*v1.Favorite         `protobuf:"bytes,1,rep,name=favorites,proto3" json:"favorites,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListFavoritesResponse) Reset() {
+	*x = ListFavoritesResponse{}
+	mi := &file_storefront_v1_storefront_service_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListFavoritesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListFavoritesResponse) ProtoMessage() {}
+
+func (x *ListFavoritesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_storefront_v1_storefront_service_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListFavoritesResponse.ProtoReflect.Descriptor instead.
+func (*ListFavoritesResponse) Descriptor() ([]byte, []int) {
+	return file_storefront_v1_storefront_service_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *ListFavoritesResponse) GetFavorites() []*v1.Favorite {
+	if x != nil {
+		return x.Favorites
+	}
+	return nil
+}
+
+func (x *ListFavoritesResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+var File_storefront_v1_storefront_service_proto protoreflect.FileDescriptor
+
+const file_storefront_v1_storefront_service_proto_rawDesc = "" +
+	"\n" +
+	"&storefront/v1/storefront_service.proto\x12\rstorefront.v1\x1a!product/v1/favorite_service.proto\x1a\x16product/v1/types.proto\x1a\x1auser/v1/user_service.proto\"6\n" +
+	"\x15GetProductPageRequest\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\"\xc9\x02\n" +
+	"\x16GetProductPageResponse\x12-\n" +
+	"\aproduct\x18\x01 \x01(\v2\x13.product.v1.ProductR\aproduct\x120\n" +
+	"\bcategory\x18\x02 \x01(\v2\x14.product.v1.CategoryR\bcategory\x12B\n" +
+	"\favailability\x18\x03 \x03(\v2\x1e.storefront.v1.SKUAvailabilityR\favailability\x12\x19\n" +
+	"\bin_stock\x18\x04 \x01(\bR\ainStock\x12H\n" +
+	"\x10partial_failures\x18\x05 \x03(\v2\x1d.storefront.v1.PartialFailureR\x0fpartialFailures\x12%\n" +
+	"\x0efavorite_count\x18\x06 \x01(\x03R\rfavoriteCount\"@\n" +
+	"\x1fWatchProductAvailabilityRequest\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\"f\n" +
+	" WatchProductAvailabilityResponse\x12B\n" +
+	"\favailability\x18\x01 \x01(\v2\x1e.storefront.v1.SKUAvailabilityR\favailability\"\xdc\x01\n" +
+	"\x0fSKUAvailability\x12\x15\n" +
+	"\x06sku_id\x18\x01 \x01(\tR\x05skuId\x12\x14\n" +
+	"\x05known\x18\x02 \x01(\bR\x05known\x12\x19\n" +
+	"\bin_stock\x18\x03 \x01(\bR\ainStock\x12/\n" +
+	"\x05level\x18\x04 \x01(\x0e2\x19.storefront.v1.StockLevelR\x05level\x12)\n" +
+	"\x10display_quantity\x18\x05 \x01(\x05R\x0fdisplayQuantity\x12%\n" +
+	"\x0emore_available\x18\x06 \x01(\bR\rmoreAvailable\"c\n" +
+	"\x0ePartialFailure\x12\x1c\n" +
+	"\tprocedure\x18\x01 \x01(\tR\tprocedure\x12\x1f\n" +
+	"\vresource_id\x18\x02 \x01(\tR\n" +
+	"resourceId\x12\x12\n" +
+	"\x04code\x18\x03 \x01(\tR\x04code\"\xdb\x01\n" +
+	"\x13ListProductsRequest\x12\x1b\n" +
+	"\tpage_size\x18\x01 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x02 \x01(\tR\tpageToken\x12$\n" +
+	"\vcategory_id\x18\x03 \x01(\tH\x00R\n" +
+	"categoryId\x88\x01\x01\x12&\n" +
+	"\fsearch_query\x18\x04 \x01(\tH\x01R\vsearchQuery\x88\x01\x01\x12\x19\n" +
+	"\border_by\x18\x05 \x01(\tR\aorderByB\x0e\n" +
+	"\f_category_idB\x0f\n" +
+	"\r_search_query\"o\n" +
+	"\x14ListProductsResponse\x12/\n" +
+	"\bproducts\x18\x01 \x03(\v2\x13.product.v1.ProductR\bproducts\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"\x17\n" +
+	"\x15ListCategoriesRequest\"N\n" +
+	"\x16ListCategoriesResponse\x124\n" +
+	"\n" +
+	"categories\x18\x01 \x03(\v2\x14.product.v1.CategoryR\n" +
+	"categories\"\x0e\n" +
+	"\fGetMeRequest\"2\n" +
+	"\rGetMeResponse\x12!\n" +
+	"\x04user\x18\x01 \x01(\v2\r.user.v1.UserR\x04user\"L\n" +
+	"\x12AddFavoriteRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x02 \x01(\tR\tproductId\"\x15\n" +
+	"\x13AddFavoriteResponse\"O\n" +
+	"\x15RemoveFavoriteRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x02 \x01(\tR\tproductId\"\x18\n" +
+	"\x16RemoveFavoriteResponse\"k\n" +
+	"\x14ListFavoritesRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1b\n" +
+	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x03 \x01(\tR\tpageToken\"s\n" +
+	"\x15ListFavoritesResponse\x122\n" +
+	"\tfavorites\x18\x01 \x03(\v2\x14.product.v1.FavoriteR\tfavorites\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken*v\n" +
+	"\n" +
+	"StockLevel\x12\x1b\n" +
+	"\x17STOCK_LEVEL_UNSPECIFIED\x10\x00\x12\x1c\n" +
+	"\x18STOCK_LEVEL_OUT_OF_STOCK\x10\x01\x12\x13\n" +
+	"\x0fSTOCK_LEVEL_LOW\x10\x02\x12\x18\n" +
+	"\x14STOCK_LEVEL_IN_STOCK\x10\x032\xa1\x06\n" +
+	"\x11StorefrontService\x12b\n" +
+	"\x0eGetProductPage\x12$.storefront.v1.GetProductPageRequest\x1a%.storefront.v1.GetProductPageResponse\"\x03\x90\x02\x01\x12}\n" +
+	"\x18WatchProductAvailability\x12..storefront.v1.WatchProductAvailabilityRequest\x1a/.storefront.v1.WatchProductAvailabilityResponse0\x01\x12\\\n" +
+	"\fListProducts\x12\".storefront.v1.ListProductsRequest\x1a#.storefront.v1.ListProductsResponse\"\x03\x90\x02\x01\x12b\n" +
+	"\x0eListCategories\x12$.storefront.v1.ListCategoriesRequest\x1a%.storefront.v1.ListCategoriesResponse\"\x03\x90\x02\x01\x12G\n" +
+	"\x05GetMe\x12\x1b.storefront.v1.GetMeRequest\x1a\x1c.storefront.v1.GetMeResponse\"\x03\x90\x02\x01\x12Y\n" +
+	"\vAddFavorite\x12!.storefront.v1.AddFavoriteRequest\x1a\".storefront.v1.AddFavoriteResponse\"\x03\x90\x02\x02\x12b\n" +
+	"\x0eRemoveFavorite\x12$.storefront.v1.RemoveFavoriteRequest\x1a%.storefront.v1.RemoveFavoriteResponse\"\x03\x90\x02\x02\x12_\n" +
+	"\rListFavorites\x12#.storefront.v1.ListFavoritesRequest\x1a$.storefront.v1.ListFavoritesResponse\"\x03\x90\x02\x01B\xcb\x01\n" +
+	"\x11com.storefront.v1B\x16StorefrontServiceProtoP\x01ZIgithub.com/daisuke8000/example-ec-platform/gen/storefront/v1;storefrontv1\xa2\x02\x03SXX\xaa\x02\rStorefront.V1\xca\x02\rStorefront\\V1\xe2\x02\x19Storefront\\V1\\GPBMetadata\xea\x02\x0eStorefront::V1b\x06proto3"
+
+var (
+	file_storefront_v1_storefront_service_proto_rawDescOnce sync.Once
+	file_storefront_v1_storefront_service_proto_rawDescData []byte
+)
+
+func file_storefront_v1_storefront_service_proto_rawDescGZIP() []byte {
+	file_storefront_v1_storefront_service_proto_rawDescOnce.Do(func() {
+		file_storefront_v1_storefront_service_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_storefront_v1_storefront_service_proto_rawDesc), len(file_storefront_v1_storefront_service_proto_rawDesc)))
+	})
+	return file_storefront_v1_storefront_service_proto_rawDescData
+}
+
+var file_storefront_v1_storefront_service_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_storefront_v1_storefront_service_proto_msgTypes = make([]protoimpl.MessageInfo, 18)
+var file_storefront_v1_storefront_service_proto_goTypes = []any{
+	(StockLevel)(0),                          // 0: storefront.v1.StockLevel
+	(*GetProductPageRequest)(nil),            // 1: storefront.v1.GetProductPageRequest
+	(*GetProductPageResponse)(nil),           // 2: storefront.v1.GetProductPageResponse
+	(*WatchProductAvailabilityRequest)(nil),  // 3: storefront.v1.WatchProductAvailabilityRequest
+	(*WatchProductAvailabilityResponse)(nil), // 4: storefront.v1.WatchProductAvailabilityResponse
+	(*SKUAvailability)(nil),                  // 5: storefront.v1.SKUAvailability
+	(*PartialFailure)(nil),                   // 6: storefront.v1.PartialFailure
+	(*ListProductsRequest)(nil),              // 7: storefront.v1.ListProductsRequest
+	(*ListProductsResponse)(nil),             // 8: storefront.v1.ListProductsResponse
+	(*ListCategoriesRequest)(nil),            // 9: storefront.v1.ListCategoriesRequest
+	(*ListCategoriesResponse)(nil),           // 10: storefront.v1.ListCategoriesResponse
+	(*GetMeRequest)(nil),                     // 11: storefront.v1.GetMeRequest
+	(*GetMeResponse)(nil),                    // 12: storefront.v1.GetMeResponse
+	(*AddFavoriteRequest)(nil),               // 13: storefront.v1.AddFavoriteRequest
+	(*AddFavoriteResponse)(nil),              // 14: storefront.v1.AddFavoriteResponse
+	(*RemoveFavoriteRequest)(nil),            // 15: storefront.v1.RemoveFavoriteRequest
+	(*RemoveFavoriteResponse)(nil),           // 16: storefront.v1.RemoveFavoriteResponse
+	(*ListFavoritesRequest)(nil),             // 17: storefront.v1.ListFavoritesRequest
+	(*ListFavoritesResponse)(nil),            // 18: storefront.v1.ListFavoritesResponse
+	(*v1.Product)(nil),                       // 19: product.v1.Product
+	(*v1.Category)(nil),                      // 20: product.v1.Category
+	(*v11.User)(nil),                         // 21: user.v1.User
+	(*v1.Favorite)(nil),                      // 22: product.v1.Favorite
+}
+var file_storefront_v1_storefront_service_proto_depIdxs = []int32{
+	19, // 0: storefront.v1.GetProductPageResponse.product:type_name -> product.v1.Product
+	20, // 1: storefront.v1.GetProductPageResponse.category:type_name -> product.v1.Category
+	5,  // 2: storefront.v1.GetProductPageResponse.availability:type_name -> storefront.v1.SKUAvailability
+	6,  // 3: storefront.v1.GetProductPageResponse.partial_failures:type_name -> storefront.v1.PartialFailure
+	5,  // 4: storefront.v1.WatchProductAvailabilityResponse.availability:type_name -> storefront.v1.SKUAvailability
+	0,  // 5: storefront.v1.SKUAvailability.level:type_name -> storefront.v1.StockLevel
+	19, // 6: storefront.v1.ListProductsResponse.products:type_name -> product.v1.Product
+	20, // 7: storefront.v1.ListCategoriesResponse.categories:type_name -> product.v1.Category
+	21, // 8: storefront.v1.GetMeResponse.user:type_name -> user.v1.User
+	22, // 9: storefront.v1.ListFavoritesResponse.favorites:type_name -> product.v1.Favorite
+	1,  // 10: storefront.v1.StorefrontService.GetProductPage:input_type -> storefront.v1.GetProductPageRequest
+	3,  // 11: storefront.v1.StorefrontService.WatchProductAvailability:input_type -> storefront.v1.WatchProductAvailabilityRequest
+	7,  // 12: storefront.v1.StorefrontService.ListProducts:input_type -> storefront.v1.ListProductsRequest
+	9,  // 13: storefront.v1.StorefrontService.ListCategories:input_type -> storefront.v1.ListCategoriesRequest
+	11, // 14: storefront.v1.StorefrontService.GetMe:input_type -> storefront.v1.GetMeRequest
+	13, // 15: storefront.v1.StorefrontService.AddFavorite:input_type -> storefront.v1.AddFavoriteRequest
+	15, // 16: storefront.v1.StorefrontService.RemoveFavorite:input_type -> storefront.v1.RemoveFavoriteRequest
+	17, // 17: storefront.v1.StorefrontService.ListFavorites:input_type -> storefront.v1.ListFavoritesRequest
+	2,  // 18: storefront.v1.StorefrontService.GetProductPage:output_type -> storefront.v1.GetProductPageResponse
+	4,  // 19: storefront.v1.StorefrontService.WatchProductAvailability:output_type -> storefront.v1.WatchProductAvailabilityResponse
+	8,  // 20: storefront.v1.StorefrontService.ListProducts:output_type -> storefront.v1.ListProductsResponse
+	10, // 21: storefront.v1.StorefrontService.ListCategories:output_type -> storefront.v1.ListCategoriesResponse
+	12, // 22: storefront.v1.StorefrontService.GetMe:output_type -> storefront.v1.GetMeResponse
+	14, // 23: storefront.v1.StorefrontService.AddFavorite:output_type -> storefront.v1.AddFavoriteResponse
+	16, // 24: storefront.v1.StorefrontService.RemoveFavorite:output_type -> storefront.v1.RemoveFavoriteResponse
+	18, // 25: storefront.v1.StorefrontService.ListFavorites:output_type -> storefront.v1.ListFavoritesResponse
+	18, // [18:26] is the sub-list for method output_type
+	10, // [10:18] is the sub-list for method input_type
+	10, // [10:10] is the sub-list for extension type_name
+	10, // [10:10] is the sub-list for extension extendee
+	0,  // [0:10] is the sub-list for field type_name
+}
+
+func init() { file_storefront_v1_storefront_service_proto_init() }
+func file_storefront_v1_storefront_service_proto_init() {
+	if File_storefront_v1_storefront_service_proto != nil {
+		return
+	}
+	file_storefront_v1_storefront_service_proto_msgTypes[6].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_storefront_v1_storefront_service_proto_rawDesc), len(file_storefront_v1_storefront_service_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   18,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_storefront_v1_storefront_service_proto_goTypes,
+		DependencyIndexes: file_storefront_v1_storefront_service_proto_depIdxs,
+		EnumInfos:         file_storefront_v1_storefront_service_proto_enumTypes,
+		MessageInfos:      file_storefront_v1_storefront_service_proto_msgTypes,
+	}.Build()
+	File_storefront_v1_storefront_service_proto = out.File
+	file_storefront_v1_storefront_service_proto_goTypes = nil
+	file_storefront_v1_storefront_service_proto_depIdxs = nil
+}
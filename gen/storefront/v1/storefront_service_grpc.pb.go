@@ -0,0 +1,454 @@
+// ==============================================================================
+// Storefront Service API
+// BFF-only API that composes backend data into storefront page payloads
+// ==============================================================================
+
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.6.0
+// - protoc             (unknown)
+// source: storefront/v1/storefront_service.proto
+
+package storefrontv1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	StorefrontService_GetProductPage_FullMethodName           = "/storefront.v1.StorefrontService/GetProductPage"
+	StorefrontService_WatchProductAvailability_FullMethodName = "/storefront.v1.StorefrontService/WatchProductAvailability"
+	StorefrontService_ListProducts_FullMethodName             = "/storefront.v1.StorefrontService/ListProducts"
+	StorefrontService_ListCategories_FullMethodName           = "/storefront.v1.StorefrontService/ListCategories"
+	StorefrontService_GetMe_FullMethodName                    = "/storefront.v1.StorefrontService/GetMe"
+	StorefrontService_AddFavorite_FullMethodName              = "/storefront.v1.StorefrontService/AddFavorite"
+	StorefrontService_RemoveFavorite_FullMethodName           = "/storefront.v1.StorefrontService/RemoveFavorite"
+	StorefrontService_ListFavorites_FullMethodName            = "/storefront.v1.StorefrontService/ListFavorites"
+)
+
+// StorefrontServiceClient is the client API for StorefrontService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// StorefrontService serves the storefront UI. It is implemented by the BFF,
+// which fans out to the backend services so a page needs one round-trip.
+type StorefrontServiceClient interface {
+	// GetProductPage returns a product with its SKUs, stock levels, category
+	// and favorite count.
+	// Returns NOT_FOUND if the product doesn't exist.
+	// Stock, category and favorite count lookups that fail are listed in
+	// partial_failures instead of failing the whole request.
+	GetProductPage(ctx context.Context, in *GetProductPageRequest, opts ...grpc.CallOption) (*GetProductPageResponse, error)
+	// WatchProductAvailability streams the stock state of a product's SKUs:
+	// first their current availability, then a SKU's availability whenever
+	// what customers are shown changes. SKUs without inventory are sent once
+	// stock is added. The stream stays open until the client cancels it or
+	// the BFF's stream timeout elapses.
+	// Returns NOT_FOUND if the product doesn't exist.
+	// Returns FAILED_PRECONDITION if the product has more than 100 SKUs.
+	// Returns UNAVAILABLE if changes may have been missed; watch again.
+	WatchProductAvailability(ctx context.Context, in *WatchProductAvailabilityRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[WatchProductAvailabilityResponse], error)
+	// ListProducts lists published products.
+	ListProducts(ctx context.Context, in *ListProductsRequest, opts ...grpc.CallOption) (*ListProductsResponse, error)
+	// ListCategories returns the category tree.
+	ListCategories(ctx context.Context, in *ListCategoriesRequest, opts ...grpc.CallOption) (*ListCategoriesResponse, error)
+	// GetMe returns the authenticated user.
+	// Returns UNAUTHENTICATED without a valid access token.
+	GetMe(ctx context.Context, in *GetMeRequest, opts ...grpc.CallOption) (*GetMeResponse, error)
+	// AddFavorite saves a product to the user's wishlist. Adding a product
+	// already in the wishlist succeeds.
+	// Returns PERMISSION_DENIED if user_id is not the authenticated user.
+	// Returns NOT_FOUND if the product doesn't exist.
+	// Returns FAILED_PRECONDITION if the wishlist already has 500 products.
+	AddFavorite(ctx context.Context, in *AddFavoriteRequest, opts ...grpc.CallOption) (*AddFavoriteResponse, error)
+	// RemoveFavorite removes a product from the user's wishlist.
+	// Returns PERMISSION_DENIED if user_id is not the authenticated user.
+	RemoveFavorite(ctx context.Context, in *RemoveFavoriteRequest, opts ...grpc.CallOption) (*RemoveFavoriteResponse, error)
+	// ListFavorites returns the user's wishlist, most recently added first.
+	// Returns PERMISSION_DENIED if user_id is not the authenticated user.
+	ListFavorites(ctx context.Context, in *ListFavoritesRequest, opts ...grpc.CallOption) (*ListFavoritesResponse, error)
+}
+
+type storefrontServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewStorefrontServiceClient(cc grpc.ClientConnInterface) StorefrontServiceClient {
+	return &storefrontServiceClient{cc}
+}
+
+func (c *storefrontServiceClient) GetProductPage(ctx context.Context, in *GetProductPageRequest, opts ...grpc.CallOption) (*GetProductPageResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetProductPageResponse)
+	err := c.cc.Invoke(ctx, StorefrontService_GetProductPage_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *storefrontServiceClient) WatchProductAvailability(ctx context.Context, in *WatchProductAvailabilityRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[WatchProductAvailabilityResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &StorefrontService_ServiceDesc.Streams[0], StorefrontService_WatchProductAvailability_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[WatchProductAvailabilityRequest, WatchProductAvailabilityResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type StorefrontService_WatchProductAvailabilityClient = grpc.ServerStreamingClient[WatchProductAvailabilityResponse]
+
+func (c *storefrontServiceClient) ListProducts(ctx context.Context, in *ListProductsRequest, opts ...grpc.CallOption) (*ListProductsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListProductsResponse)
+	err := c.cc.Invoke(ctx, StorefrontService_ListProducts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *storefrontServiceClient) ListCategories(ctx context.Context, in *ListCategoriesRequest, opts ...grpc.CallOption) (*ListCategoriesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListCategoriesResponse)
+	err := c.cc.Invoke(ctx, StorefrontService_ListCategories_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *storefrontServiceClient) GetMe(ctx context.Context, in *GetMeRequest, opts ...grpc.CallOption) (*GetMeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetMeResponse)
+	err := c.cc.Invoke(ctx, StorefrontService_GetMe_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *storefrontServiceClient) AddFavorite(ctx context.Context, in *AddFavoriteRequest, opts ...grpc.CallOption) (*AddFavoriteResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AddFavoriteResponse)
+	err := c.cc.Invoke(ctx, StorefrontService_AddFavorite_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *storefrontServiceClient) RemoveFavorite(ctx context.Context, in *RemoveFavoriteRequest, opts ...grpc.CallOption) (*RemoveFavoriteResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RemoveFavoriteResponse)
+	err := c.cc.Invoke(ctx, StorefrontService_RemoveFavorite_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *storefrontServiceClient) ListFavorites(ctx context.Context, in *ListFavoritesRequest, opts ...grpc.CallOption) (*ListFavoritesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListFavoritesResponse)
+	err := c.cc.Invoke(ctx, StorefrontService_ListFavorites_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// StorefrontServiceServer is the server API for StorefrontService service.
+// All implementations must embed UnimplementedStorefrontServiceServer
+// for forward compatibility.
+//
+// StorefrontService serves the storefront UI. It is implemented by the BFF,
+// which fans out to the backend services so a page needs one round-trip.
+type StorefrontServiceServer interface {
+	// GetProductPage returns a product with its SKUs, stock levels, category
+	// and favorite count.
+	// Returns NOT_FOUND if the product doesn't exist.
+	// Stock, category and favorite count lookups that fail are listed in
+	// partial_failures instead of failing the whole request.
+	GetProductPage(context.Context, *GetProductPageRequest) (*GetProductPageResponse, error)
+	// WatchProductAvailability streams the stock state of a product's SKUs:
+	// first their current availability, then a SKU's availability whenever
+	// what customers are shown changes. SKUs without inventory are sent once
+	// stock is added. The stream stays open until the client cancels it or
+	// the BFF's stream timeout elapses.
+	// Returns NOT_FOUND if the product doesn't exist.
+	// Returns FAILED_PRECONDITION if the product has more than 100 SKUs.
+	// Returns UNAVAILABLE if changes may have been missed; watch again.
+	WatchProductAvailability(*WatchProductAvailabilityRequest, grpc.ServerStreamingServer[WatchProductAvailabilityResponse]) error
+	// ListProducts lists published products.
+	ListProducts(context.Context, *ListProductsRequest) (*ListProductsResponse, error)
+	// ListCategories returns the category tree.
+	ListCategories(context.Context, *ListCategoriesRequest) (*ListCategoriesResponse, error)
+	// GetMe returns the authenticated user.
+	// Returns UNAUTHENTICATED without a valid access token.
+	GetMe(context.Context, *GetMeRequest) (*GetMeResponse, error)
+	// AddFavorite saves a product to the user's wishlist. Adding a product
+	// already in the wishlist succeeds.
+	// Returns PERMISSION_DENIED if user_id is not the authenticated user.
+	// Returns NOT_FOUND if the product doesn't exist.
+	// Returns FAILED_PRECONDITION if the wishlist already has 500 products.
+	AddFavorite(context.Context, *AddFavoriteRequest) (*AddFavoriteResponse, error)
+	// RemoveFavorite removes a product from the user's wishlist.
+	// Returns PERMISSION_DENIED if user_id is not the authenticated user.
+	RemoveFavorite(context.Context, *RemoveFavoriteRequest) (*RemoveFavoriteResponse, error)
+	// ListFavorites returns the user's wishlist, most recently added first.
+	// Returns PERMISSION_DENIED if user_id is not the authenticated user.
+	ListFavorites(context.Context, *ListFavoritesRequest) (*ListFavoritesResponse, error)
+	mustEmbedUnimplementedStorefrontServiceServer()
+}
+
+// UnimplementedStorefrontServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedStorefrontServiceServer struct{}
+
+func (UnimplementedStorefrontServiceServer) GetProductPage(context.Context, *GetProductPageRequest) (*GetProductPageResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetProductPage not implemented")
+}
+func (UnimplementedStorefrontServiceServer) WatchProductAvailability(*WatchProductAvailabilityRequest, grpc.ServerStreamingServer[WatchProductAvailabilityResponse]) error {
+	return status.Error(codes.Unimplemented, "method WatchProductAvailability not implemented")
+}
+func (UnimplementedStorefrontServiceServer) ListProducts(context.Context, *ListProductsRequest) (*ListProductsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListProducts not implemented")
+}
+func (UnimplementedStorefrontServiceServer) ListCategories(context.Context, *ListCategoriesRequest) (*ListCategoriesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListCategories not implemented")
+}
+func (UnimplementedStorefrontServiceServer) GetMe(context.Context, *GetMeRequest) (*GetMeResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetMe not implemented")
+}
+func (UnimplementedStorefrontServiceServer) AddFavorite(context.Context, *AddFavoriteRequest) (*AddFavoriteResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method AddFavorite not implemented")
+}
+func (UnimplementedStorefrontServiceServer) RemoveFavorite(context.Context, *RemoveFavoriteRequest) (*RemoveFavoriteResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RemoveFavorite not implemented")
+}
+func (UnimplementedStorefrontServiceServer) ListFavorites(context.Context, *ListFavoritesRequest) (*ListFavoritesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListFavorites not implemented")
+}
+func (UnimplementedStorefrontServiceServer) mustEmbedUnimplementedStorefrontServiceServer() {}
+func (UnimplementedStorefrontServiceServer) testEmbeddedByValue()                           {}
+
+// UnsafeStorefrontServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to StorefrontServiceServer will
+// result in compilation errors.
+type UnsafeStorefrontServiceServer interface {
+	mustEmbedUnimplementedStorefrontServiceServer()
+}
+
+func RegisterStorefrontServiceServer(s grpc.ServiceRegistrar, srv StorefrontServiceServer) {
+	// If the following call panics, it indicates UnimplementedStorefrontServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&StorefrontService_ServiceDesc, srv)
+}
+
+func _StorefrontService_GetProductPage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetProductPageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StorefrontServiceServer).GetProductPage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StorefrontService_GetProductPage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StorefrontServiceServer).GetProductPage(ctx, req.(*GetProductPageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StorefrontService_WatchProductAvailability_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchProductAvailabilityRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(StorefrontServiceServer).WatchProductAvailability(m, &grpc.GenericServerStream[WatchProductAvailabilityRequest, WatchProductAvailabilityResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type StorefrontService_WatchProductAvailabilityServer = grpc.ServerStreamingServer[WatchProductAvailabilityResponse]
+
+func _StorefrontService_ListProducts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListProductsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StorefrontServiceServer).ListProducts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StorefrontService_ListProducts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StorefrontServiceServer).ListProducts(ctx, req.(*ListProductsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StorefrontService_ListCategories_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCategoriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StorefrontServiceServer).ListCategories(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StorefrontService_ListCategories_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StorefrontServiceServer).ListCategories(ctx, req.(*ListCategoriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StorefrontService_GetMe_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StorefrontServiceServer).GetMe(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StorefrontService_GetMe_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StorefrontServiceServer).GetMe(ctx, req.(*GetMeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StorefrontService_AddFavorite_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddFavoriteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StorefrontServiceServer).AddFavorite(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StorefrontService_AddFavorite_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StorefrontServiceServer).AddFavorite(ctx, req.(*AddFavoriteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StorefrontService_RemoveFavorite_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveFavoriteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StorefrontServiceServer).RemoveFavorite(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StorefrontService_RemoveFavorite_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StorefrontServiceServer).RemoveFavorite(ctx, req.(*RemoveFavoriteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StorefrontService_ListFavorites_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListFavoritesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StorefrontServiceServer).ListFavorites(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StorefrontService_ListFavorites_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StorefrontServiceServer).ListFavorites(ctx, req.(*ListFavoritesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// StorefrontService_ServiceDesc is the grpc.ServiceDesc for StorefrontService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var StorefrontService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "storefront.v1.StorefrontService",
+	HandlerType: (*StorefrontServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetProductPage",
+			Handler:    _StorefrontService_GetProductPage_Handler,
+		},
+		{
+			MethodName: "ListProducts",
+			Handler:    _StorefrontService_ListProducts_Handler,
+		},
+		{
+			MethodName: "ListCategories",
+			Handler:    _StorefrontService_ListCategories_Handler,
+		},
+		{
+			MethodName: "GetMe",
+			Handler:    _StorefrontService_GetMe_Handler,
+		},
+		{
+			MethodName: "AddFavorite",
+			Handler:    _StorefrontService_AddFavorite_Handler,
+		},
+		{
+			MethodName: "RemoveFavorite",
+			Handler:    _StorefrontService_RemoveFavorite_Handler,
+		},
+		{
+			MethodName: "ListFavorites",
+			Handler:    _StorefrontService_ListFavorites_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchProductAvailability",
+			Handler:       _StorefrontService_WatchProductAvailability_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "storefront/v1/storefront_service.proto",
+}
@@ -0,0 +1,382 @@
+// ==============================================================================
+// Storefront Service API
+// BFF-only API that composes backend data into storefront page payloads
+// ==============================================================================
+
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: storefront/v1/storefront_service.proto
+
+package storefrontv1connect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	v1 "github.com/daisuke8000/example-ec-platform/gen/storefront/v1"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// StorefrontServiceName is the fully-qualified name of the StorefrontService service.
+	StorefrontServiceName = "storefront.v1.StorefrontService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// StorefrontServiceGetProductPageProcedure is the fully-qualified name of the StorefrontService's
+	// GetProductPage RPC.
+	StorefrontServiceGetProductPageProcedure = "/storefront.v1.StorefrontService/GetProductPage"
+	// StorefrontServiceWatchProductAvailabilityProcedure is the fully-qualified name of the
+	// StorefrontService's WatchProductAvailability RPC.
+	StorefrontServiceWatchProductAvailabilityProcedure = "/storefront.v1.StorefrontService/WatchProductAvailability"
+	// StorefrontServiceListProductsProcedure is the fully-qualified name of the StorefrontService's
+	// ListProducts RPC.
+	StorefrontServiceListProductsProcedure = "/storefront.v1.StorefrontService/ListProducts"
+	// StorefrontServiceListCategoriesProcedure is the fully-qualified name of the StorefrontService's
+	// ListCategories RPC.
+	StorefrontServiceListCategoriesProcedure = "/storefront.v1.StorefrontService/ListCategories"
+	// StorefrontServiceGetMeProcedure is the fully-qualified name of the StorefrontService's GetMe RPC.
+	StorefrontServiceGetMeProcedure = "/storefront.v1.StorefrontService/GetMe"
+	// StorefrontServiceAddFavoriteProcedure is the fully-qualified name of the StorefrontService's
+	// AddFavorite RPC.
+	StorefrontServiceAddFavoriteProcedure = "/storefront.v1.StorefrontService/AddFavorite"
+	// StorefrontServiceRemoveFavoriteProcedure is the fully-qualified name of the StorefrontService's
+	// RemoveFavorite RPC.
+	StorefrontServiceRemoveFavoriteProcedure = "/storefront.v1.StorefrontService/RemoveFavorite"
+	// StorefrontServiceListFavoritesProcedure is the fully-qualified name of the StorefrontService's
+	// ListFavorites RPC.
+	StorefrontServiceListFavoritesProcedure = "/storefront.v1.StorefrontService/ListFavorites"
+)
+
+// StorefrontServiceClient is a client for the storefront.v1.StorefrontService service.
+type StorefrontServiceClient interface {
+	// GetProductPage returns a product with its SKUs, stock levels, category
+	// and favorite count.
+	// Returns NOT_FOUND if the product doesn't exist.
+	// Stock, category and favorite count lookups that fail are listed in
+	// partial_failures instead of failing the whole request.
+	GetProductPage(context.Context, *connect.Request[v1.GetProductPageRequest]) (*connect.Response[v1.GetProductPageResponse], error)
+	// WatchProductAvailability streams the stock state of a product's SKUs:
+	// first their current availability, then a SKU's availability whenever
+	// what customers are shown changes. SKUs without inventory are sent once
+	// stock is added. The stream stays open until the client cancels it or
+	// the BFF's stream timeout elapses.
+	// Returns NOT_FOUND if the product doesn't exist.
+	// Returns FAILED_PRECONDITION if the product has more than 100 SKUs.
+	// Returns UNAVAILABLE if changes may have been missed; watch again.
+	WatchProductAvailability(context.Context, *connect.Request[v1.WatchProductAvailabilityRequest]) (*connect.ServerStreamForClient[v1.WatchProductAvailabilityResponse], error)
+	// ListProducts lists published products.
+	ListProducts(context.Context, *connect.Request[v1.ListProductsRequest]) (*connect.Response[v1.ListProductsResponse], error)
+	// ListCategories returns the category tree.
+	ListCategories(context.Context, *connect.Request[v1.ListCategoriesRequest]) (*connect.Response[v1.ListCategoriesResponse], error)
+	// GetMe returns the authenticated user.
+	// Returns UNAUTHENTICATED without a valid access token.
+	GetMe(context.Context, *connect.Request[v1.GetMeRequest]) (*connect.Response[v1.GetMeResponse], error)
+	// AddFavorite saves a product to the user's wishlist. Adding a product
+	// already in the wishlist succeeds.
+	// Returns PERMISSION_DENIED if user_id is not the authenticated user.
+	// Returns NOT_FOUND if the product doesn't exist.
+	// Returns FAILED_PRECONDITION if the wishlist already has 500 products.
+	AddFavorite(context.Context, *connect.Request[v1.AddFavoriteRequest]) (*connect.Response[v1.AddFavoriteResponse], error)
+	// RemoveFavorite removes a product from the user's wishlist.
+	// Returns PERMISSION_DENIED if user_id is not the authenticated user.
+	RemoveFavorite(context.Context, *connect.Request[v1.RemoveFavoriteRequest]) (*connect.Response[v1.RemoveFavoriteResponse], error)
+	// ListFavorites returns the user's wishlist, most recently added first.
+	// Returns PERMISSION_DENIED if user_id is not the authenticated user.
+	ListFavorites(context.Context, *connect.Request[v1.ListFavoritesRequest]) (*connect.Response[v1.ListFavoritesResponse], error)
+}
+
+// NewStorefrontServiceClient constructs a client for the storefront.v1.StorefrontService service.
+// By default, it uses the Connect protocol with the binary Protobuf Codec, asks for gzipped
+// responses, and sends uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the
+// connect.WithGRPC() or connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewStorefrontServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) StorefrontServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	storefrontServiceMethods := v1.File_storefront_v1_storefront_service_proto.Services().ByName("StorefrontService").Methods()
+	return &storefrontServiceClient{
+		getProductPage: connect.NewClient[v1.GetProductPageRequest, v1.GetProductPageResponse](
+			httpClient,
+			baseURL+StorefrontServiceGetProductPageProcedure,
+			connect.WithSchema(storefrontServiceMethods.ByName("GetProductPage")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+		watchProductAvailability: connect.NewClient[v1.WatchProductAvailabilityRequest, v1.WatchProductAvailabilityResponse](
+			httpClient,
+			baseURL+StorefrontServiceWatchProductAvailabilityProcedure,
+			connect.WithSchema(storefrontServiceMethods.ByName("WatchProductAvailability")),
+			connect.WithClientOptions(opts...),
+		),
+		listProducts: connect.NewClient[v1.ListProductsRequest, v1.ListProductsResponse](
+			httpClient,
+			baseURL+StorefrontServiceListProductsProcedure,
+			connect.WithSchema(storefrontServiceMethods.ByName("ListProducts")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+		listCategories: connect.NewClient[v1.ListCategoriesRequest, v1.ListCategoriesResponse](
+			httpClient,
+			baseURL+StorefrontServiceListCategoriesProcedure,
+			connect.WithSchema(storefrontServiceMethods.ByName("ListCategories")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+		getMe: connect.NewClient[v1.GetMeRequest, v1.GetMeResponse](
+			httpClient,
+			baseURL+StorefrontServiceGetMeProcedure,
+			connect.WithSchema(storefrontServiceMethods.ByName("GetMe")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+		addFavorite: connect.NewClient[v1.AddFavoriteRequest, v1.AddFavoriteResponse](
+			httpClient,
+			baseURL+StorefrontServiceAddFavoriteProcedure,
+			connect.WithSchema(storefrontServiceMethods.ByName("AddFavorite")),
+			connect.WithIdempotency(connect.IdempotencyIdempotent),
+			connect.WithClientOptions(opts...),
+		),
+		removeFavorite: connect.NewClient[v1.RemoveFavoriteRequest, v1.RemoveFavoriteResponse](
+			httpClient,
+			baseURL+StorefrontServiceRemoveFavoriteProcedure,
+			connect.WithSchema(storefrontServiceMethods.ByName("RemoveFavorite")),
+			connect.WithIdempotency(connect.IdempotencyIdempotent),
+			connect.WithClientOptions(opts...),
+		),
+		listFavorites: connect.NewClient[v1.ListFavoritesRequest, v1.ListFavoritesResponse](
+			httpClient,
+			baseURL+StorefrontServiceListFavoritesProcedure,
+			connect.WithSchema(storefrontServiceMethods.ByName("ListFavorites")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// storefrontServiceClient implements StorefrontServiceClient.
+type storefrontServiceClient struct {
+	getProductPage           *connect.Client[v1.GetProductPageRequest, v1.GetProductPageResponse]
+	watchProductAvailability *connect.Client[v1.WatchProductAvailabilityRequest, v1.WatchProductAvailabilityResponse]
+	listProducts             *connect.Client[v1.ListProductsRequest, v1.ListProductsResponse]
+	listCategories           *connect.Client[v1.ListCategoriesRequest, v1.ListCategoriesResponse]
+	getMe                    *connect.Client[v1.GetMeRequest, v1.GetMeResponse]
+	addFavorite              *connect.Client[v1.AddFavoriteRequest, v1.AddFavoriteResponse]
+	removeFavorite           *connect.Client[v1.RemoveFavoriteRequest, v1.RemoveFavoriteResponse]
+	listFavorites            *connect.Client[v1.ListFavoritesRequest, v1.ListFavoritesResponse]
+}
+
+// GetProductPage calls storefront.v1.StorefrontService.GetProductPage.
+func (c *storefrontServiceClient) GetProductPage(ctx context.Context, req *connect.Request[v1.GetProductPageRequest]) (*connect.Response[v1.GetProductPageResponse], error) {
+	return c.getProductPage.CallUnary(ctx, req)
+}
+
+// WatchProductAvailability calls storefront.v1.StorefrontService.WatchProductAvailability.
+func (c *storefrontServiceClient) WatchProductAvailability(ctx context.Context, req *connect.Request[v1.WatchProductAvailabilityRequest]) (*connect.ServerStreamForClient[v1.WatchProductAvailabilityResponse], error) {
+	return c.watchProductAvailability.CallServerStream(ctx, req)
+}
+
+// ListProducts calls storefront.v1.StorefrontService.ListProducts.
+func (c *storefrontServiceClient) ListProducts(ctx context.Context, req *connect.Request[v1.ListProductsRequest]) (*connect.Response[v1.ListProductsResponse], error) {
+	return c.listProducts.CallUnary(ctx, req)
+}
+
+// ListCategories calls storefront.v1.StorefrontService.ListCategories.
+func (c *storefrontServiceClient) ListCategories(ctx context.Context, req *connect.Request[v1.ListCategoriesRequest]) (*connect.Response[v1.ListCategoriesResponse], error) {
+	return c.listCategories.CallUnary(ctx, req)
+}
+
+// GetMe calls storefront.v1.StorefrontService.GetMe.
+func (c *storefrontServiceClient) GetMe(ctx context.Context, req *connect.Request[v1.GetMeRequest]) (*connect.Response[v1.GetMeResponse], error) {
+	return c.getMe.CallUnary(ctx, req)
+}
+
+// AddFavorite calls storefront.v1.StorefrontService.AddFavorite.
+func (c *storefrontServiceClient) AddFavorite(ctx context.Context, req *connect.Request[v1.AddFavoriteRequest]) (*connect.Response[v1.AddFavoriteResponse], error) {
+	return c.addFavorite.CallUnary(ctx, req)
+}
+
+// RemoveFavorite calls storefront.v1.StorefrontService.RemoveFavorite.
+func (c *storefrontServiceClient) RemoveFavorite(ctx context.Context, req *connect.Request[v1.RemoveFavoriteRequest]) (*connect.Response[v1.RemoveFavoriteResponse], error) {
+	return c.removeFavorite.CallUnary(ctx, req)
+}
+
+// ListFavorites calls storefront.v1.StorefrontService.ListFavorites.
+func (c *storefrontServiceClient) ListFavorites(ctx context.Context, req *connect.Request[v1.ListFavoritesRequest]) (*connect.Response[v1.ListFavoritesResponse], error) {
+	return c.listFavorites.CallUnary(ctx, req)
+}
+
+// StorefrontServiceHandler is an implementation of the storefront.v1.StorefrontService service.
+type StorefrontServiceHandler interface {
+	// GetProductPage returns a product with its SKUs, stock levels, category
+	// and favorite count.
+	// Returns NOT_FOUND if the product doesn't exist.
+	// Stock, category and favorite count lookups that fail are listed in
+	// partial_failures instead of failing the whole request.
+	GetProductPage(context.Context, *connect.Request[v1.GetProductPageRequest]) (*connect.Response[v1.GetProductPageResponse], error)
+	// WatchProductAvailability streams the stock state of a product's SKUs:
+	// first their current availability, then a SKU's availability whenever
+	// what customers are shown changes. SKUs without inventory are sent once
+	// stock is added. The stream stays open until the client cancels it or
+	// the BFF's stream timeout elapses.
+	// Returns NOT_FOUND if the product doesn't exist.
+	// Returns FAILED_PRECONDITION if the product has more than 100 SKUs.
+	// Returns UNAVAILABLE if changes may have been missed; watch again.
+	WatchProductAvailability(context.Context, *connect.Request[v1.WatchProductAvailabilityRequest], *connect.ServerStream[v1.WatchProductAvailabilityResponse]) error
+	// ListProducts lists published products.
+	ListProducts(context.Context, *connect.Request[v1.ListProductsRequest]) (*connect.Response[v1.ListProductsResponse], error)
+	// ListCategories returns the category tree.
+	ListCategories(context.Context, *connect.Request[v1.ListCategoriesRequest]) (*connect.Response[v1.ListCategoriesResponse], error)
+	// GetMe returns the authenticated user.
+	// Returns UNAUTHENTICATED without a valid access token.
+	GetMe(context.Context, *connect.Request[v1.GetMeRequest]) (*connect.Response[v1.GetMeResponse], error)
+	// AddFavorite saves a product to the user's wishlist. Adding a product
+	// already in the wishlist succeeds.
+	// Returns PERMISSION_DENIED if user_id is not the authenticated user.
+	// Returns NOT_FOUND if the product doesn't exist.
+	// Returns FAILED_PRECONDITION if the wishlist already has 500 products.
+	AddFavorite(context.Context, *connect.Request[v1.AddFavoriteRequest]) (*connect.Response[v1.AddFavoriteResponse], error)
+	// RemoveFavorite removes a product from the user's wishlist.
+	// Returns PERMISSION_DENIED if user_id is not the authenticated user.
+	RemoveFavorite(context.Context, *connect.Request[v1.RemoveFavoriteRequest]) (*connect.Response[v1.RemoveFavoriteResponse], error)
+	// ListFavorites returns the user's wishlist, most recently added first.
+	// Returns PERMISSION_DENIED if user_id is not the authenticated user.
+	ListFavorites(context.Context, *connect.Request[v1.ListFavoritesRequest]) (*connect.Response[v1.ListFavoritesResponse], error)
+}
+
+// NewStorefrontServiceHandler builds an HTTP handler from the service implementation. It returns
+// the path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewStorefrontServiceHandler(svc StorefrontServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	storefrontServiceMethods := v1.File_storefront_v1_storefront_service_proto.Services().ByName("StorefrontService").Methods()
+	storefrontServiceGetProductPageHandler := connect.NewUnaryHandler(
+		StorefrontServiceGetProductPageProcedure,
+		svc.GetProductPage,
+		connect.WithSchema(storefrontServiceMethods.ByName("GetProductPage")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	storefrontServiceWatchProductAvailabilityHandler := connect.NewServerStreamHandler(
+		StorefrontServiceWatchProductAvailabilityProcedure,
+		svc.WatchProductAvailability,
+		connect.WithSchema(storefrontServiceMethods.ByName("WatchProductAvailability")),
+		connect.WithHandlerOptions(opts...),
+	)
+	storefrontServiceListProductsHandler := connect.NewUnaryHandler(
+		StorefrontServiceListProductsProcedure,
+		svc.ListProducts,
+		connect.WithSchema(storefrontServiceMethods.ByName("ListProducts")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	storefrontServiceListCategoriesHandler := connect.NewUnaryHandler(
+		StorefrontServiceListCategoriesProcedure,
+		svc.ListCategories,
+		connect.WithSchema(storefrontServiceMethods.ByName("ListCategories")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	storefrontServiceGetMeHandler := connect.NewUnaryHandler(
+		StorefrontServiceGetMeProcedure,
+		svc.GetMe,
+		connect.WithSchema(storefrontServiceMethods.ByName("GetMe")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	storefrontServiceAddFavoriteHandler := connect.NewUnaryHandler(
+		StorefrontServiceAddFavoriteProcedure,
+		svc.AddFavorite,
+		connect.WithSchema(storefrontServiceMethods.ByName("AddFavorite")),
+		connect.WithIdempotency(connect.IdempotencyIdempotent),
+		connect.WithHandlerOptions(opts...),
+	)
+	storefrontServiceRemoveFavoriteHandler := connect.NewUnaryHandler(
+		StorefrontServiceRemoveFavoriteProcedure,
+		svc.RemoveFavorite,
+		connect.WithSchema(storefrontServiceMethods.ByName("RemoveFavorite")),
+		connect.WithIdempotency(connect.IdempotencyIdempotent),
+		connect.WithHandlerOptions(opts...),
+	)
+	storefrontServiceListFavoritesHandler := connect.NewUnaryHandler(
+		StorefrontServiceListFavoritesProcedure,
+		svc.ListFavorites,
+		connect.WithSchema(storefrontServiceMethods.ByName("ListFavorites")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/storefront.v1.StorefrontService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case StorefrontServiceGetProductPageProcedure:
+			storefrontServiceGetProductPageHandler.ServeHTTP(w, r)
+		case StorefrontServiceWatchProductAvailabilityProcedure:
+			storefrontServiceWatchProductAvailabilityHandler.ServeHTTP(w, r)
+		case StorefrontServiceListProductsProcedure:
+			storefrontServiceListProductsHandler.ServeHTTP(w, r)
+		case StorefrontServiceListCategoriesProcedure:
+			storefrontServiceListCategoriesHandler.ServeHTTP(w, r)
+		case StorefrontServiceGetMeProcedure:
+			storefrontServiceGetMeHandler.ServeHTTP(w, r)
+		case StorefrontServiceAddFavoriteProcedure:
+			storefrontServiceAddFavoriteHandler.ServeHTTP(w, r)
+		case StorefrontServiceRemoveFavoriteProcedure:
+			storefrontServiceRemoveFavoriteHandler.ServeHTTP(w, r)
+		case StorefrontServiceListFavoritesProcedure:
+			storefrontServiceListFavoritesHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedStorefrontServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedStorefrontServiceHandler struct{}
+
+func (UnimplementedStorefrontServiceHandler) GetProductPage(context.Context, *connect.Request[v1.GetProductPageRequest]) (*connect.Response[v1.GetProductPageResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("storefront.v1.StorefrontService.GetProductPage is not implemented"))
+}
+
+func (UnimplementedStorefrontServiceHandler) WatchProductAvailability(context.Context, *connect.Request[v1.WatchProductAvailabilityRequest], *connect.ServerStream[v1.WatchProductAvailabilityResponse]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("storefront.v1.StorefrontService.WatchProductAvailability is not implemented"))
+}
+
+func (UnimplementedStorefrontServiceHandler) ListProducts(context.Context, *connect.Request[v1.ListProductsRequest]) (*connect.Response[v1.ListProductsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("storefront.v1.StorefrontService.ListProducts is not implemented"))
+}
+
+func (UnimplementedStorefrontServiceHandler) ListCategories(context.Context, *connect.Request[v1.ListCategoriesRequest]) (*connect.Response[v1.ListCategoriesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("storefront.v1.StorefrontService.ListCategories is not implemented"))
+}
+
+func (UnimplementedStorefrontServiceHandler) GetMe(context.Context, *connect.Request[v1.GetMeRequest]) (*connect.Response[v1.GetMeResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("storefront.v1.StorefrontService.GetMe is not implemented"))
+}
+
+func (UnimplementedStorefrontServiceHandler) AddFavorite(context.Context, *connect.Request[v1.AddFavoriteRequest]) (*connect.Response[v1.AddFavoriteResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("storefront.v1.StorefrontService.AddFavorite is not implemented"))
+}
+
+func (UnimplementedStorefrontServiceHandler) RemoveFavorite(context.Context, *connect.Request[v1.RemoveFavoriteRequest]) (*connect.Response[v1.RemoveFavoriteResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("storefront.v1.StorefrontService.RemoveFavorite is not implemented"))
+}
+
+func (UnimplementedStorefrontServiceHandler) ListFavorites(context.Context, *connect.Request[v1.ListFavoritesRequest]) (*connect.Response[v1.ListFavoritesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("storefront.v1.StorefrontService.ListFavorites is not implemented"))
+}
@@ -24,11 +24,39 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	UserService_CreateUser_FullMethodName     = "/user.v1.UserService/CreateUser"
-	UserService_GetUser_FullMethodName        = "/user.v1.UserService/GetUser"
-	UserService_UpdateUser_FullMethodName     = "/user.v1.UserService/UpdateUser"
-	UserService_DeleteUser_FullMethodName     = "/user.v1.UserService/DeleteUser"
-	UserService_VerifyPassword_FullMethodName = "/user.v1.UserService/VerifyPassword"
+	UserService_CreateUser_FullMethodName                = "/user.v1.UserService/CreateUser"
+	UserService_GetUser_FullMethodName                   = "/user.v1.UserService/GetUser"
+	UserService_UpdateUser_FullMethodName                = "/user.v1.UserService/UpdateUser"
+	UserService_DeleteUser_FullMethodName                = "/user.v1.UserService/DeleteUser"
+	UserService_UnlockUser_FullMethodName                = "/user.v1.UserService/UnlockUser"
+	UserService_VerifyPassword_FullMethodName            = "/user.v1.UserService/VerifyPassword"
+	UserService_VerifyEmail_FullMethodName               = "/user.v1.UserService/VerifyEmail"
+	UserService_ResendVerificationEmail_FullMethodName   = "/user.v1.UserService/ResendVerificationEmail"
+	UserService_ListUsers_FullMethodName                 = "/user.v1.UserService/ListUsers"
+	UserService_GetUserRoles_FullMethodName              = "/user.v1.UserService/GetUserRoles"
+	UserService_BatchDeactivateUsers_FullMethodName      = "/user.v1.UserService/BatchDeactivateUsers"
+	UserService_BatchAssignSegment_FullMethodName        = "/user.v1.UserService/BatchAssignSegment"
+	UserService_GetBatchJob_FullMethodName               = "/user.v1.UserService/GetBatchJob"
+	UserService_GetBatchJobReport_FullMethodName         = "/user.v1.UserService/GetBatchJobReport"
+	UserService_ListConsents_FullMethodName              = "/user.v1.UserService/ListConsents"
+	UserService_RevokeConsent_FullMethodName             = "/user.v1.UserService/RevokeConsent"
+	UserService_ListConnectedApps_FullMethodName         = "/user.v1.UserService/ListConnectedApps"
+	UserService_ListSessions_FullMethodName              = "/user.v1.UserService/ListSessions"
+	UserService_RevokeSession_FullMethodName             = "/user.v1.UserService/RevokeSession"
+	UserService_GetLoginHistory_FullMethodName           = "/user.v1.UserService/GetLoginHistory"
+	UserService_AddAddress_FullMethodName                = "/user.v1.UserService/AddAddress"
+	UserService_ListAddresses_FullMethodName             = "/user.v1.UserService/ListAddresses"
+	UserService_SetDefaultShippingAddress_FullMethodName = "/user.v1.UserService/SetDefaultShippingAddress"
+	UserService_DeleteAddress_FullMethodName             = "/user.v1.UserService/DeleteAddress"
+	UserService_GetTwoFactorStatus_FullMethodName        = "/user.v1.UserService/GetTwoFactorStatus"
+	UserService_EnrollTOTP_FullMethodName                = "/user.v1.UserService/EnrollTOTP"
+	UserService_ConfirmTOTP_FullMethodName               = "/user.v1.UserService/ConfirmTOTP"
+	UserService_DisableTOTP_FullMethodName               = "/user.v1.UserService/DisableTOTP"
+	UserService_ChangePassword_FullMethodName            = "/user.v1.UserService/ChangePassword"
+	UserService_CreateAccessGrant_FullMethodName         = "/user.v1.UserService/CreateAccessGrant"
+	UserService_RevokeAccessGrant_FullMethodName         = "/user.v1.UserService/RevokeAccessGrant"
+	UserService_ListAccessGrants_FullMethodName          = "/user.v1.UserService/ListAccessGrants"
+	UserService_GetServerInfo_FullMethodName             = "/user.v1.UserService/GetServerInfo"
 )
 
 // UserServiceClient is the client API for UserService service.
@@ -52,10 +80,145 @@ type UserServiceClient interface {
 	// DeleteUser performs soft deletion of a user account.
 	// Returns NOT_FOUND if user doesn't exist or is already deleted.
 	DeleteUser(ctx context.Context, in *DeleteUserRequest, opts ...grpc.CallOption) (*DeleteUserResponse, error)
+	// UnlockUser lifts the login lockout of the user's email address.
+	// Returns NOT_FOUND if user doesn't exist or is soft-deleted.
+	// Returns UNIMPLEMENTED if login lockout is disabled.
+	UnlockUser(ctx context.Context, in *UnlockUserRequest, opts ...grpc.CallOption) (*UnlockUserResponse, error)
 	// VerifyPassword validates user credentials for authentication.
 	// Returns UNAUTHENTICATED for invalid credentials (timing-safe).
 	// Note: Same error returned for non-existent email or wrong password.
+	// Returns PERMISSION_DENIED (ACCOUNT_LOCKED) while the email address is
+	// locked after repeated failures, whether or not it has an account.
 	VerifyPassword(ctx context.Context, in *VerifyPasswordRequest, opts ...grpc.CallOption) (*VerifyPasswordResponse, error)
+	// VerifyEmail marks the account owning the token as email-verified.
+	// Returns INVALID_ARGUMENT if the token is unknown or already used.
+	// Returns FAILED_PRECONDITION if the token has expired.
+	VerifyEmail(ctx context.Context, in *VerifyEmailRequest, opts ...grpc.CallOption) (*VerifyEmailResponse, error)
+	// ResendVerificationEmail sends a new verification email to the user's
+	// current address and invalidates the tokens sent before.
+	// Returns FAILED_PRECONDITION if the email is already verified.
+	// Returns UNIMPLEMENTED if email verification is disabled.
+	ResendVerificationEmail(ctx context.Context, in *ResendVerificationEmailRequest, opts ...grpc.CallOption) (*ResendVerificationEmailResponse, error)
+	// ListUsers enumerates users for administrative purposes.
+	// Results are ordered by creation time (newest first) with cursor pagination.
+	// Returns INVALID_ARGUMENT if page_token is malformed.
+	ListUsers(ctx context.Context, in *ListUsersRequest, opts ...grpc.CallOption) (*ListUsersResponse, error)
+	// GetUserRoles returns the roles assigned to a user with their permissions.
+	// Used at consent time to embed role claims in issued access tokens.
+	// Returns NOT_FOUND if user doesn't exist or is soft-deleted.
+	GetUserRoles(ctx context.Context, in *GetUserRolesRequest, opts ...grpc.CallOption) (*GetUserRolesResponse, error)
+	// BatchDeactivateUsers starts an asynchronous job that soft-deletes the targeted users.
+	// Returns INVALID_ARGUMENT if the target is empty or exceeds 10,000 users.
+	BatchDeactivateUsers(ctx context.Context, in *BatchDeactivateUsersRequest, opts ...grpc.CallOption) (*BatchDeactivateUsersResponse, error)
+	// BatchAssignSegment starts an asynchronous job that assigns a marketing segment
+	// to the targeted users, replacing any previous segment.
+	// Returns INVALID_ARGUMENT if the segment name is invalid or the target is empty.
+	BatchAssignSegment(ctx context.Context, in *BatchAssignSegmentRequest, opts ...grpc.CallOption) (*BatchAssignSegmentResponse, error)
+	// GetBatchJob returns the current progress of a batch job.
+	// Returns NOT_FOUND if the job doesn't exist.
+	GetBatchJob(ctx context.Context, in *GetBatchJobRequest, opts ...grpc.CallOption) (*GetBatchJobResponse, error)
+	// GetBatchJobReport returns the per-user results of a finished job as CSV.
+	// Returns NOT_FOUND if the job doesn't exist.
+	// Returns FAILED_PRECONDITION if the job is still pending or running.
+	GetBatchJobReport(ctx context.Context, in *GetBatchJobReportRequest, opts ...grpc.CallOption) (*GetBatchJobReportResponse, error)
+	// ListConsents returns the consent receipts recorded for a user, newest first.
+	// Returns INVALID_ARGUMENT if user_id is malformed.
+	ListConsents(ctx context.Context, in *ListConsentsRequest, opts ...grpc.CallOption) (*ListConsentsResponse, error)
+	// RevokeConsent revokes all consent a user granted to an OAuth2 client,
+	// both at Hydra (invalidating issued tokens) and in the receipt history.
+	// Returns INVALID_ARGUMENT if user_id or client_id is missing.
+	RevokeConsent(ctx context.Context, in *RevokeConsentRequest, opts ...grpc.CallOption) (*RevokeConsentResponse, error)
+	// ListConnectedApps returns the OAuth2 clients a user has granted access
+	// to at Hydra, one per client across all login sessions, most recently
+	// granted first. RevokeConsent revokes a client's access and tokens.
+	// Returns INVALID_ARGUMENT if user_id is malformed.
+	ListConnectedApps(ctx context.Context, in *ListConnectedAppsRequest, opts ...grpc.CallOption) (*ListConnectedAppsResponse, error)
+	// ListSessions returns a user's active login sessions at Hydra, one per
+	// browser or device they signed in on, most recently used first, with the
+	// OAuth2 clients used in each.
+	// Returns INVALID_ARGUMENT if user_id is malformed.
+	ListSessions(ctx context.Context, in *ListSessionsRequest, opts ...grpc.CallOption) (*ListSessionsResponse, error)
+	// RevokeSession logs a user out of one login session, so the device has
+	// to sign in again, and revokes the tokens of the clients used only in
+	// that session. Clients also used in other sessions keep their tokens;
+	// RevokeConsent revokes them everywhere.
+	// Returns INVALID_ARGUMENT if user_id or session_id is missing.
+	// Returns NOT_FOUND if the session doesn't exist or belongs to another user.
+	RevokeSession(ctx context.Context, in *RevokeSessionRequest, opts ...grpc.CallOption) (*RevokeSessionResponse, error)
+	// GetLoginHistory returns a user's latest sign-in attempts, newest first:
+	// completed logins and logins refused for a wrong password or code.
+	// page_size defaults to 20 and is capped at 100.
+	// Returns INVALID_ARGUMENT if user_id is malformed.
+	// Returns UNIMPLEMENTED if login history is disabled.
+	GetLoginHistory(ctx context.Context, in *GetLoginHistoryRequest, opts ...grpc.CallOption) (*GetLoginHistoryResponse, error)
+	// AddAddress adds a postal address to a user's address book (at most 10).
+	// The first address, or one added with default_shipping, becomes the
+	// default shipping address. Postal codes and phone numbers are
+	// normalized, e.g. "1000001" to "100-0001" in JP and "+81 90-1234-5678"
+	// to "+819012345678".
+	// Returns INVALID_ARGUMENT if a field is missing or malformed.
+	// Returns NOT_FOUND if the user doesn't exist.
+	// Returns RESOURCE_EXHAUSTED if the address book is full.
+	AddAddress(ctx context.Context, in *AddAddressRequest, opts ...grpc.CallOption) (*AddAddressResponse, error)
+	// ListAddresses returns a user's addresses, the default shipping address
+	// first, then newest first.
+	// Returns INVALID_ARGUMENT if user_id is malformed.
+	ListAddresses(ctx context.Context, in *ListAddressesRequest, opts ...grpc.CallOption) (*ListAddressesResponse, error)
+	// SetDefaultShippingAddress makes an address the user's default shipping
+	// address, replacing the previous one.
+	// Returns NOT_FOUND if the address doesn't exist or belongs to another user.
+	SetDefaultShippingAddress(ctx context.Context, in *SetDefaultShippingAddressRequest, opts ...grpc.CallOption) (*SetDefaultShippingAddressResponse, error)
+	// DeleteAddress removes an address. Deleting the default shipping address
+	// leaves the user without one until another is chosen.
+	// Returns NOT_FOUND if the address doesn't exist or belongs to another user.
+	DeleteAddress(ctx context.Context, in *DeleteAddressRequest, opts ...grpc.CallOption) (*DeleteAddressResponse, error)
+	// GetTwoFactorStatus reports whether sign-in requires a TOTP code.
+	// Returns UNIMPLEMENTED if two-factor authentication is disabled.
+	GetTwoFactorStatus(ctx context.Context, in *GetTwoFactorStatusRequest, opts ...grpc.CallOption) (*GetTwoFactorStatusResponse, error)
+	// EnrollTOTP generates a new TOTP secret for an authenticator app,
+	// replacing a pending enrollment. Sign-in is unaffected until ConfirmTOTP.
+	// Returns FAILED_PRECONDITION if two-factor authentication is already enabled.
+	// Returns UNIMPLEMENTED if two-factor authentication is disabled.
+	EnrollTOTP(ctx context.Context, in *EnrollTOTPRequest, opts ...grpc.CallOption) (*EnrollTOTPResponse, error)
+	// ConfirmTOTP enables two-factor authentication with a code from the
+	// authenticator app and returns single-use recovery codes. They are only
+	// returned here.
+	// Returns INVALID_ARGUMENT if the code is wrong.
+	// Returns NOT_FOUND if there is no enrollment.
+	// Returns FAILED_PRECONDITION if two-factor authentication is already enabled.
+	ConfirmTOTP(ctx context.Context, in *ConfirmTOTPRequest, opts ...grpc.CallOption) (*ConfirmTOTPResponse, error)
+	// DisableTOTP turns two-factor authentication off. It requires a current
+	// code or an unused recovery code.
+	// Returns INVALID_ARGUMENT if the code is wrong.
+	// Returns NOT_FOUND if there is no enrollment.
+	DisableTOTP(ctx context.Context, in *DisableTOTPRequest, opts ...grpc.CallOption) (*DisableTOTPResponse, error)
+	// ChangePassword replaces the password after checking the current one.
+	// The new password must meet the password policy and, if enabled, must
+	// not appear in known data breaches.
+	// Returns INVALID_ARGUMENT if the current password is wrong, the new one
+	// equals it or fails the policy (PASSWORD_TOO_SHORT, PASSWORD_TOO_WEAK,
+	// PASSWORD_BREACHED).
+	// Returns PERMISSION_DENIED (ACCOUNT_LOCKED) after too many wrong
+	// current passwords.
+	ChangePassword(ctx context.Context, in *ChangePasswordRequest, opts ...grpc.CallOption) (*ChangePasswordResponse, error)
+	// CreateAccessGrant gives a user one permission on top of their roles for
+	// duration_hours (1-72), e.g. to let a support agent act on a customer
+	// account. The BFF honors active grants when authorizing requests and
+	// tags actions taken under them in the security audit log.
+	// Returns NOT_FOUND if the grantee doesn't exist.
+	// Returns INVALID_ARGUMENT for an empty or non-grantable permission, a
+	// missing reason, an out-of-range duration or a grant to oneself.
+	CreateAccessGrant(ctx context.Context, in *CreateAccessGrantRequest, opts ...grpc.CallOption) (*CreateAccessGrantResponse, error)
+	// RevokeAccessGrant ends a grant before it expires.
+	// Returns NOT_FOUND if the grant doesn't exist or is already revoked.
+	RevokeAccessGrant(ctx context.Context, in *RevokeAccessGrantRequest, opts ...grpc.CallOption) (*RevokeAccessGrantResponse, error)
+	// ListAccessGrants returns the grants given to a user, newest first.
+	// Returns INVALID_ARGUMENT if user_id is malformed.
+	ListAccessGrants(ctx context.Context, in *ListAccessGrantsRequest, opts ...grpc.CallOption) (*ListAccessGrantsResponse, error)
+	// GetServerInfo returns the service version and the procedures and
+	// optional features it supports, so callers can adapt during mixed-version
+	// rollouts instead of failing with UNIMPLEMENTED.
+	GetServerInfo(ctx context.Context, in *GetServerInfoRequest, opts ...grpc.CallOption) (*GetServerInfoResponse, error)
 }
 
 type userServiceClient struct {
@@ -106,6 +269,16 @@ func (c *userServiceClient) DeleteUser(ctx context.Context, in *DeleteUserReques
 	return out, nil
 }
 
+func (c *userServiceClient) UnlockUser(ctx context.Context, in *UnlockUserRequest, opts ...grpc.CallOption) (*UnlockUserResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UnlockUserResponse)
+	err := c.cc.Invoke(ctx, UserService_UnlockUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *userServiceClient) VerifyPassword(ctx context.Context, in *VerifyPasswordRequest, opts ...grpc.CallOption) (*VerifyPasswordResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(VerifyPasswordResponse)
@@ -116,6 +289,276 @@ func (c *userServiceClient) VerifyPassword(ctx context.Context, in *VerifyPasswo
 	return out, nil
 }
 
+func (c *userServiceClient) VerifyEmail(ctx context.Context, in *VerifyEmailRequest, opts ...grpc.CallOption) (*VerifyEmailResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(VerifyEmailResponse)
+	err := c.cc.Invoke(ctx, UserService_VerifyEmail_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) ResendVerificationEmail(ctx context.Context, in *ResendVerificationEmailRequest, opts ...grpc.CallOption) (*ResendVerificationEmailResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ResendVerificationEmailResponse)
+	err := c.cc.Invoke(ctx, UserService_ResendVerificationEmail_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) ListUsers(ctx context.Context, in *ListUsersRequest, opts ...grpc.CallOption) (*ListUsersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListUsersResponse)
+	err := c.cc.Invoke(ctx, UserService_ListUsers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) GetUserRoles(ctx context.Context, in *GetUserRolesRequest, opts ...grpc.CallOption) (*GetUserRolesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetUserRolesResponse)
+	err := c.cc.Invoke(ctx, UserService_GetUserRoles_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) BatchDeactivateUsers(ctx context.Context, in *BatchDeactivateUsersRequest, opts ...grpc.CallOption) (*BatchDeactivateUsersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BatchDeactivateUsersResponse)
+	err := c.cc.Invoke(ctx, UserService_BatchDeactivateUsers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) BatchAssignSegment(ctx context.Context, in *BatchAssignSegmentRequest, opts ...grpc.CallOption) (*BatchAssignSegmentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BatchAssignSegmentResponse)
+	err := c.cc.Invoke(ctx, UserService_BatchAssignSegment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) GetBatchJob(ctx context.Context, in *GetBatchJobRequest, opts ...grpc.CallOption) (*GetBatchJobResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetBatchJobResponse)
+	err := c.cc.Invoke(ctx, UserService_GetBatchJob_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) GetBatchJobReport(ctx context.Context, in *GetBatchJobReportRequest, opts ...grpc.CallOption) (*GetBatchJobReportResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetBatchJobReportResponse)
+	err := c.cc.Invoke(ctx, UserService_GetBatchJobReport_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) ListConsents(ctx context.Context, in *ListConsentsRequest, opts ...grpc.CallOption) (*ListConsentsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListConsentsResponse)
+	err := c.cc.Invoke(ctx, UserService_ListConsents_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) RevokeConsent(ctx context.Context, in *RevokeConsentRequest, opts ...grpc.CallOption) (*RevokeConsentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RevokeConsentResponse)
+	err := c.cc.Invoke(ctx, UserService_RevokeConsent_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) ListConnectedApps(ctx context.Context, in *ListConnectedAppsRequest, opts ...grpc.CallOption) (*ListConnectedAppsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListConnectedAppsResponse)
+	err := c.cc.Invoke(ctx, UserService_ListConnectedApps_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) ListSessions(ctx context.Context, in *ListSessionsRequest, opts ...grpc.CallOption) (*ListSessionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListSessionsResponse)
+	err := c.cc.Invoke(ctx, UserService_ListSessions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) RevokeSession(ctx context.Context, in *RevokeSessionRequest, opts ...grpc.CallOption) (*RevokeSessionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RevokeSessionResponse)
+	err := c.cc.Invoke(ctx, UserService_RevokeSession_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) GetLoginHistory(ctx context.Context, in *GetLoginHistoryRequest, opts ...grpc.CallOption) (*GetLoginHistoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetLoginHistoryResponse)
+	err := c.cc.Invoke(ctx, UserService_GetLoginHistory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) AddAddress(ctx context.Context, in *AddAddressRequest, opts ...grpc.CallOption) (*AddAddressResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AddAddressResponse)
+	err := c.cc.Invoke(ctx, UserService_AddAddress_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) ListAddresses(ctx context.Context, in *ListAddressesRequest, opts ...grpc.CallOption) (*ListAddressesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListAddressesResponse)
+	err := c.cc.Invoke(ctx, UserService_ListAddresses_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) SetDefaultShippingAddress(ctx context.Context, in *SetDefaultShippingAddressRequest, opts ...grpc.CallOption) (*SetDefaultShippingAddressResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetDefaultShippingAddressResponse)
+	err := c.cc.Invoke(ctx, UserService_SetDefaultShippingAddress_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) DeleteAddress(ctx context.Context, in *DeleteAddressRequest, opts ...grpc.CallOption) (*DeleteAddressResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteAddressResponse)
+	err := c.cc.Invoke(ctx, UserService_DeleteAddress_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) GetTwoFactorStatus(ctx context.Context, in *GetTwoFactorStatusRequest, opts ...grpc.CallOption) (*GetTwoFactorStatusResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetTwoFactorStatusResponse)
+	err := c.cc.Invoke(ctx, UserService_GetTwoFactorStatus_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) EnrollTOTP(ctx context.Context, in *EnrollTOTPRequest, opts ...grpc.CallOption) (*EnrollTOTPResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EnrollTOTPResponse)
+	err := c.cc.Invoke(ctx, UserService_EnrollTOTP_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) ConfirmTOTP(ctx context.Context, in *ConfirmTOTPRequest, opts ...grpc.CallOption) (*ConfirmTOTPResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ConfirmTOTPResponse)
+	err := c.cc.Invoke(ctx, UserService_ConfirmTOTP_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) DisableTOTP(ctx context.Context, in *DisableTOTPRequest, opts ...grpc.CallOption) (*DisableTOTPResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DisableTOTPResponse)
+	err := c.cc.Invoke(ctx, UserService_DisableTOTP_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) ChangePassword(ctx context.Context, in *ChangePasswordRequest, opts ...grpc.CallOption) (*ChangePasswordResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ChangePasswordResponse)
+	err := c.cc.Invoke(ctx, UserService_ChangePassword_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) CreateAccessGrant(ctx context.Context, in *CreateAccessGrantRequest, opts ...grpc.CallOption) (*CreateAccessGrantResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateAccessGrantResponse)
+	err := c.cc.Invoke(ctx, UserService_CreateAccessGrant_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) RevokeAccessGrant(ctx context.Context, in *RevokeAccessGrantRequest, opts ...grpc.CallOption) (*RevokeAccessGrantResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RevokeAccessGrantResponse)
+	err := c.cc.Invoke(ctx, UserService_RevokeAccessGrant_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) ListAccessGrants(ctx context.Context, in *ListAccessGrantsRequest, opts ...grpc.CallOption) (*ListAccessGrantsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListAccessGrantsResponse)
+	err := c.cc.Invoke(ctx, UserService_ListAccessGrants_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) GetServerInfo(ctx context.Context, in *GetServerInfoRequest, opts ...grpc.CallOption) (*GetServerInfoResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetServerInfoResponse)
+	err := c.cc.Invoke(ctx, UserService_GetServerInfo_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // UserServiceServer is the server API for UserService service.
 // All implementations must embed UnimplementedUserServiceServer
 // for forward compatibility.
@@ -137,10 +580,145 @@ type UserServiceServer interface {
 	// DeleteUser performs soft deletion of a user account.
 	// Returns NOT_FOUND if user doesn't exist or is already deleted.
 	DeleteUser(context.Context, *DeleteUserRequest) (*DeleteUserResponse, error)
+	// UnlockUser lifts the login lockout of the user's email address.
+	// Returns NOT_FOUND if user doesn't exist or is soft-deleted.
+	// Returns UNIMPLEMENTED if login lockout is disabled.
+	UnlockUser(context.Context, *UnlockUserRequest) (*UnlockUserResponse, error)
 	// VerifyPassword validates user credentials for authentication.
 	// Returns UNAUTHENTICATED for invalid credentials (timing-safe).
 	// Note: Same error returned for non-existent email or wrong password.
+	// Returns PERMISSION_DENIED (ACCOUNT_LOCKED) while the email address is
+	// locked after repeated failures, whether or not it has an account.
 	VerifyPassword(context.Context, *VerifyPasswordRequest) (*VerifyPasswordResponse, error)
+	// VerifyEmail marks the account owning the token as email-verified.
+	// Returns INVALID_ARGUMENT if the token is unknown or already used.
+	// Returns FAILED_PRECONDITION if the token has expired.
+	VerifyEmail(context.Context, *VerifyEmailRequest) (*VerifyEmailResponse, error)
+	// ResendVerificationEmail sends a new verification email to the user's
+	// current address and invalidates the tokens sent before.
+	// Returns FAILED_PRECONDITION if the email is already verified.
+	// Returns UNIMPLEMENTED if email verification is disabled.
+	ResendVerificationEmail(context.Context, *ResendVerificationEmailRequest) (*ResendVerificationEmailResponse, error)
+	// ListUsers enumerates users for administrative purposes.
+	// Results are ordered by creation time (newest first) with cursor pagination.
+	// Returns INVALID_ARGUMENT if page_token is malformed.
+	ListUsers(context.Context, *ListUsersRequest) (*ListUsersResponse, error)
+	// GetUserRoles returns the roles assigned to a user with their permissions.
+	// Used at consent time to embed role claims in issued access tokens.
+	// Returns NOT_FOUND if user doesn't exist or is soft-deleted.
+	GetUserRoles(context.Context, *GetUserRolesRequest) (*GetUserRolesResponse, error)
+	// BatchDeactivateUsers starts an asynchronous job that soft-deletes the targeted users.
+	// Returns INVALID_ARGUMENT if the target is empty or exceeds 10,000 users.
+	BatchDeactivateUsers(context.Context, *BatchDeactivateUsersRequest) (*BatchDeactivateUsersResponse, error)
+	// BatchAssignSegment starts an asynchronous job that assigns a marketing segment
+	// to the targeted users, replacing any previous segment.
+	// Returns INVALID_ARGUMENT if the segment name is invalid or the target is empty.
+	BatchAssignSegment(context.Context, *BatchAssignSegmentRequest) (*BatchAssignSegmentResponse, error)
+	// GetBatchJob returns the current progress of a batch job.
+	// Returns NOT_FOUND if the job doesn't exist.
+	GetBatchJob(context.Context, *GetBatchJobRequest) (*GetBatchJobResponse, error)
+	// GetBatchJobReport returns the per-user results of a finished job as CSV.
+	// Returns NOT_FOUND if the job doesn't exist.
+	// Returns FAILED_PRECONDITION if the job is still pending or running.
+	GetBatchJobReport(context.Context, *GetBatchJobReportRequest) (*GetBatchJobReportResponse, error)
+	// ListConsents returns the consent receipts recorded for a user, newest first.
+	// Returns INVALID_ARGUMENT if user_id is malformed.
+	ListConsents(context.Context, *ListConsentsRequest) (*ListConsentsResponse, error)
+	// RevokeConsent revokes all consent a user granted to an OAuth2 client,
+	// both at Hydra (invalidating issued tokens) and in the receipt history.
+	// Returns INVALID_ARGUMENT if user_id or client_id is missing.
+	RevokeConsent(context.Context, *RevokeConsentRequest) (*RevokeConsentResponse, error)
+	// ListConnectedApps returns the OAuth2 clients a user has granted access
+	// to at Hydra, one per client across all login sessions, most recently
+	// granted first. RevokeConsent revokes a client's access and tokens.
+	// Returns INVALID_ARGUMENT if user_id is malformed.
+	ListConnectedApps(context.Context, *ListConnectedAppsRequest) (*ListConnectedAppsResponse, error)
+	// ListSessions returns a user's active login sessions at Hydra, one per
+	// browser or device they signed in on, most recently used first, with the
+	// OAuth2 clients used in each.
+	// Returns INVALID_ARGUMENT if user_id is malformed.
+	ListSessions(context.Context, *ListSessionsRequest) (*ListSessionsResponse, error)
+	// RevokeSession logs a user out of one login session, so the device has
+	// to sign in again, and revokes the tokens of the clients used only in
+	// that session. Clients also used in other sessions keep their tokens;
+	// RevokeConsent revokes them everywhere.
+	// Returns INVALID_ARGUMENT if user_id or session_id is missing.
+	// Returns NOT_FOUND if the session doesn't exist or belongs to another user.
+	RevokeSession(context.Context, *RevokeSessionRequest) (*RevokeSessionResponse, error)
+	// GetLoginHistory returns a user's latest sign-in attempts, newest first:
+	// completed logins and logins refused for a wrong password or code.
+	// page_size defaults to 20 and is capped at 100.
+	// Returns INVALID_ARGUMENT if user_id is malformed.
+	// Returns UNIMPLEMENTED if login history is disabled.
+	GetLoginHistory(context.Context, *GetLoginHistoryRequest) (*GetLoginHistoryResponse, error)
+	// AddAddress adds a postal address to a user's address book (at most 10).
+	// The first address, or one added with default_shipping, becomes the
+	// default shipping address. Postal codes and phone numbers are
+	// normalized, e.g. "1000001" to "100-0001" in JP and "+81 90-1234-5678"
+	// to "+819012345678".
+	// Returns INVALID_ARGUMENT if a field is missing or malformed.
+	// Returns NOT_FOUND if the user doesn't exist.
+	// Returns RESOURCE_EXHAUSTED if the address book is full.
+	AddAddress(context.Context, *AddAddressRequest) (*AddAddressResponse, error)
+	// ListAddresses returns a user's addresses, the default shipping address
+	// first, then newest first.
+	// Returns INVALID_ARGUMENT if user_id is malformed.
+	ListAddresses(context.Context, *ListAddressesRequest) (*ListAddressesResponse, error)
+	// SetDefaultShippingAddress makes an address the user's default shipping
+	// address, replacing the previous one.
+	// Returns NOT_FOUND if the address doesn't exist or belongs to another user.
+	SetDefaultShippingAddress(context.Context, *SetDefaultShippingAddressRequest) (*SetDefaultShippingAddressResponse, error)
+	// DeleteAddress removes an address. Deleting the default shipping address
+	// leaves the user without one until another is chosen.
+	// Returns NOT_FOUND if the address doesn't exist or belongs to another user.
+	DeleteAddress(context.Context, *DeleteAddressRequest) (*DeleteAddressResponse, error)
+	// GetTwoFactorStatus reports whether sign-in requires a TOTP code.
+	// Returns UNIMPLEMENTED if two-factor authentication is disabled.
+	GetTwoFactorStatus(context.Context, *GetTwoFactorStatusRequest) (*GetTwoFactorStatusResponse, error)
+	// EnrollTOTP generates a new TOTP secret for an authenticator app,
+	// replacing a pending enrollment. Sign-in is unaffected until ConfirmTOTP.
+	// Returns FAILED_PRECONDITION if two-factor authentication is already enabled.
+	// Returns UNIMPLEMENTED if two-factor authentication is disabled.
+	EnrollTOTP(context.Context, *EnrollTOTPRequest) (*EnrollTOTPResponse, error)
+	// ConfirmTOTP enables two-factor authentication with a code from the
+	// authenticator app and returns single-use recovery codes. They are only
+	// returned here.
+	// Returns INVALID_ARGUMENT if the code is wrong.
+	// Returns NOT_FOUND if there is no enrollment.
+	// Returns FAILED_PRECONDITION if two-factor authentication is already enabled.
+	ConfirmTOTP(context.Context, *ConfirmTOTPRequest) (*ConfirmTOTPResponse, error)
+	// DisableTOTP turns two-factor authentication off. It requires a current
+	// code or an unused recovery code.
+	// Returns INVALID_ARGUMENT if the code is wrong.
+	// Returns NOT_FOUND if there is no enrollment.
+	DisableTOTP(context.Context, *DisableTOTPRequest) (*DisableTOTPResponse, error)
+	// ChangePassword replaces the password after checking the current one.
+	// The new password must meet the password policy and, if enabled, must
+	// not appear in known data breaches.
+	// Returns INVALID_ARGUMENT if the current password is wrong, the new one
+	// equals it or fails the policy (PASSWORD_TOO_SHORT, PASSWORD_TOO_WEAK,
+	// PASSWORD_BREACHED).
+	// Returns PERMISSION_DENIED (ACCOUNT_LOCKED) after too many wrong
+	// current passwords.
+	ChangePassword(context.Context, *ChangePasswordRequest) (*ChangePasswordResponse, error)
+	// CreateAccessGrant gives a user one permission on top of their roles for
+	// duration_hours (1-72), e.g. to let a support agent act on a customer
+	// account. The BFF honors active grants when authorizing requests and
+	// tags actions taken under them in the security audit log.
+	// Returns NOT_FOUND if the grantee doesn't exist.
+	// Returns INVALID_ARGUMENT for an empty or non-grantable permission, a
+	// missing reason, an out-of-range duration or a grant to oneself.
+	CreateAccessGrant(context.Context, *CreateAccessGrantRequest) (*CreateAccessGrantResponse, error)
+	// RevokeAccessGrant ends a grant before it expires.
+	// Returns NOT_FOUND if the grant doesn't exist or is already revoked.
+	RevokeAccessGrant(context.Context, *RevokeAccessGrantRequest) (*RevokeAccessGrantResponse, error)
+	// ListAccessGrants returns the grants given to a user, newest first.
+	// Returns INVALID_ARGUMENT if user_id is malformed.
+	ListAccessGrants(context.Context, *ListAccessGrantsRequest) (*ListAccessGrantsResponse, error)
+	// GetServerInfo returns the service version and the procedures and
+	// optional features it supports, so callers can adapt during mixed-version
+	// rollouts instead of failing with UNIMPLEMENTED.
+	GetServerInfo(context.Context, *GetServerInfoRequest) (*GetServerInfoResponse, error)
 	mustEmbedUnimplementedUserServiceServer()
 }
 
@@ -163,9 +741,93 @@ func (UnimplementedUserServiceServer) UpdateUser(context.Context, *UpdateUserReq
 func (UnimplementedUserServiceServer) DeleteUser(context.Context, *DeleteUserRequest) (*DeleteUserResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method DeleteUser not implemented")
 }
+func (UnimplementedUserServiceServer) UnlockUser(context.Context, *UnlockUserRequest) (*UnlockUserResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method UnlockUser not implemented")
+}
 func (UnimplementedUserServiceServer) VerifyPassword(context.Context, *VerifyPasswordRequest) (*VerifyPasswordResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method VerifyPassword not implemented")
 }
+func (UnimplementedUserServiceServer) VerifyEmail(context.Context, *VerifyEmailRequest) (*VerifyEmailResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method VerifyEmail not implemented")
+}
+func (UnimplementedUserServiceServer) ResendVerificationEmail(context.Context, *ResendVerificationEmailRequest) (*ResendVerificationEmailResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ResendVerificationEmail not implemented")
+}
+func (UnimplementedUserServiceServer) ListUsers(context.Context, *ListUsersRequest) (*ListUsersResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListUsers not implemented")
+}
+func (UnimplementedUserServiceServer) GetUserRoles(context.Context, *GetUserRolesRequest) (*GetUserRolesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetUserRoles not implemented")
+}
+func (UnimplementedUserServiceServer) BatchDeactivateUsers(context.Context, *BatchDeactivateUsersRequest) (*BatchDeactivateUsersResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method BatchDeactivateUsers not implemented")
+}
+func (UnimplementedUserServiceServer) BatchAssignSegment(context.Context, *BatchAssignSegmentRequest) (*BatchAssignSegmentResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method BatchAssignSegment not implemented")
+}
+func (UnimplementedUserServiceServer) GetBatchJob(context.Context, *GetBatchJobRequest) (*GetBatchJobResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetBatchJob not implemented")
+}
+func (UnimplementedUserServiceServer) GetBatchJobReport(context.Context, *GetBatchJobReportRequest) (*GetBatchJobReportResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetBatchJobReport not implemented")
+}
+func (UnimplementedUserServiceServer) ListConsents(context.Context, *ListConsentsRequest) (*ListConsentsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListConsents not implemented")
+}
+func (UnimplementedUserServiceServer) RevokeConsent(context.Context, *RevokeConsentRequest) (*RevokeConsentResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RevokeConsent not implemented")
+}
+func (UnimplementedUserServiceServer) ListConnectedApps(context.Context, *ListConnectedAppsRequest) (*ListConnectedAppsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListConnectedApps not implemented")
+}
+func (UnimplementedUserServiceServer) ListSessions(context.Context, *ListSessionsRequest) (*ListSessionsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListSessions not implemented")
+}
+func (UnimplementedUserServiceServer) RevokeSession(context.Context, *RevokeSessionRequest) (*RevokeSessionResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RevokeSession not implemented")
+}
+func (UnimplementedUserServiceServer) GetLoginHistory(context.Context, *GetLoginHistoryRequest) (*GetLoginHistoryResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetLoginHistory not implemented")
+}
+func (UnimplementedUserServiceServer) AddAddress(context.Context, *AddAddressRequest) (*AddAddressResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method AddAddress not implemented")
+}
+func (UnimplementedUserServiceServer) ListAddresses(context.Context, *ListAddressesRequest) (*ListAddressesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListAddresses not implemented")
+}
+func (UnimplementedUserServiceServer) SetDefaultShippingAddress(context.Context, *SetDefaultShippingAddressRequest) (*SetDefaultShippingAddressResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetDefaultShippingAddress not implemented")
+}
+func (UnimplementedUserServiceServer) DeleteAddress(context.Context, *DeleteAddressRequest) (*DeleteAddressResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteAddress not implemented")
+}
+func (UnimplementedUserServiceServer) GetTwoFactorStatus(context.Context, *GetTwoFactorStatusRequest) (*GetTwoFactorStatusResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetTwoFactorStatus not implemented")
+}
+func (UnimplementedUserServiceServer) EnrollTOTP(context.Context, *EnrollTOTPRequest) (*EnrollTOTPResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method EnrollTOTP not implemented")
+}
+func (UnimplementedUserServiceServer) ConfirmTOTP(context.Context, *ConfirmTOTPRequest) (*ConfirmTOTPResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ConfirmTOTP not implemented")
+}
+func (UnimplementedUserServiceServer) DisableTOTP(context.Context, *DisableTOTPRequest) (*DisableTOTPResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DisableTOTP not implemented")
+}
+func (UnimplementedUserServiceServer) ChangePassword(context.Context, *ChangePasswordRequest) (*ChangePasswordResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ChangePassword not implemented")
+}
+func (UnimplementedUserServiceServer) CreateAccessGrant(context.Context, *CreateAccessGrantRequest) (*CreateAccessGrantResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateAccessGrant not implemented")
+}
+func (UnimplementedUserServiceServer) RevokeAccessGrant(context.Context, *RevokeAccessGrantRequest) (*RevokeAccessGrantResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RevokeAccessGrant not implemented")
+}
+func (UnimplementedUserServiceServer) ListAccessGrants(context.Context, *ListAccessGrantsRequest) (*ListAccessGrantsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListAccessGrants not implemented")
+}
+func (UnimplementedUserServiceServer) GetServerInfo(context.Context, *GetServerInfoRequest) (*GetServerInfoResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetServerInfo not implemented")
+}
 func (UnimplementedUserServiceServer) mustEmbedUnimplementedUserServiceServer() {}
 func (UnimplementedUserServiceServer) testEmbeddedByValue()                     {}
 
@@ -259,6 +921,24 @@ func _UserService_DeleteUser_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _UserService_UnlockUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnlockUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).UnlockUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_UnlockUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).UnlockUser(ctx, req.(*UnlockUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _UserService_VerifyPassword_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(VerifyPasswordRequest)
 	if err := dec(in); err != nil {
@@ -277,21 +957,507 @@ func _UserService_VerifyPassword_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
-// UserService_ServiceDesc is the grpc.ServiceDesc for UserService service.
-// It's only intended for direct use with grpc.RegisterService,
-// and not to be introspected or modified (even as a copy)
-var UserService_ServiceDesc = grpc.ServiceDesc{
-	ServiceName: "user.v1.UserService",
-	HandlerType: (*UserServiceServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "CreateUser",
-			Handler:    _UserService_CreateUser_Handler,
-		},
-		{
-			MethodName: "GetUser",
-			Handler:    _UserService_GetUser_Handler,
-		},
+func _UserService_VerifyEmail_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VerifyEmailRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).VerifyEmail(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_VerifyEmail_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).VerifyEmail(ctx, req.(*VerifyEmailRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_ResendVerificationEmail_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResendVerificationEmailRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).ResendVerificationEmail(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_ResendVerificationEmail_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).ResendVerificationEmail(ctx, req.(*ResendVerificationEmailRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_ListUsers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListUsersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).ListUsers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_ListUsers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).ListUsers(ctx, req.(*ListUsersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_GetUserRoles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUserRolesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).GetUserRoles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_GetUserRoles_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).GetUserRoles(ctx, req.(*GetUserRolesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_BatchDeactivateUsers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchDeactivateUsersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).BatchDeactivateUsers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_BatchDeactivateUsers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).BatchDeactivateUsers(ctx, req.(*BatchDeactivateUsersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_BatchAssignSegment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchAssignSegmentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).BatchAssignSegment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_BatchAssignSegment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).BatchAssignSegment(ctx, req.(*BatchAssignSegmentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_GetBatchJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBatchJobRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).GetBatchJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_GetBatchJob_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).GetBatchJob(ctx, req.(*GetBatchJobRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_GetBatchJobReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBatchJobReportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).GetBatchJobReport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_GetBatchJobReport_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).GetBatchJobReport(ctx, req.(*GetBatchJobReportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_ListConsents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListConsentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).ListConsents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_ListConsents_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).ListConsents(ctx, req.(*ListConsentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_RevokeConsent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeConsentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).RevokeConsent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_RevokeConsent_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).RevokeConsent(ctx, req.(*RevokeConsentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_ListConnectedApps_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListConnectedAppsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).ListConnectedApps(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_ListConnectedApps_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).ListConnectedApps(ctx, req.(*ListConnectedAppsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_ListSessions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSessionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).ListSessions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_ListSessions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).ListSessions(ctx, req.(*ListSessionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_RevokeSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeSessionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).RevokeSession(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_RevokeSession_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).RevokeSession(ctx, req.(*RevokeSessionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_GetLoginHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetLoginHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).GetLoginHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_GetLoginHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).GetLoginHistory(ctx, req.(*GetLoginHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_AddAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddAddressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).AddAddress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_AddAddress_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).AddAddress(ctx, req.(*AddAddressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_ListAddresses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAddressesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).ListAddresses(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_ListAddresses_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).ListAddresses(ctx, req.(*ListAddressesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_SetDefaultShippingAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetDefaultShippingAddressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).SetDefaultShippingAddress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_SetDefaultShippingAddress_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).SetDefaultShippingAddress(ctx, req.(*SetDefaultShippingAddressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_DeleteAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteAddressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).DeleteAddress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_DeleteAddress_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).DeleteAddress(ctx, req.(*DeleteAddressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_GetTwoFactorStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTwoFactorStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).GetTwoFactorStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_GetTwoFactorStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).GetTwoFactorStatus(ctx, req.(*GetTwoFactorStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_EnrollTOTP_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EnrollTOTPRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).EnrollTOTP(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_EnrollTOTP_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).EnrollTOTP(ctx, req.(*EnrollTOTPRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_ConfirmTOTP_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConfirmTOTPRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).ConfirmTOTP(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_ConfirmTOTP_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).ConfirmTOTP(ctx, req.(*ConfirmTOTPRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_DisableTOTP_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DisableTOTPRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).DisableTOTP(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_DisableTOTP_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).DisableTOTP(ctx, req.(*DisableTOTPRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_ChangePassword_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChangePasswordRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).ChangePassword(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_ChangePassword_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).ChangePassword(ctx, req.(*ChangePasswordRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_CreateAccessGrant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateAccessGrantRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).CreateAccessGrant(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_CreateAccessGrant_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).CreateAccessGrant(ctx, req.(*CreateAccessGrantRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_RevokeAccessGrant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeAccessGrantRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).RevokeAccessGrant(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_RevokeAccessGrant_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).RevokeAccessGrant(ctx, req.(*RevokeAccessGrantRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_ListAccessGrants_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAccessGrantsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).ListAccessGrants(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_ListAccessGrants_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).ListAccessGrants(ctx, req.(*ListAccessGrantsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_GetServerInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetServerInfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).GetServerInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_GetServerInfo_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).GetServerInfo(ctx, req.(*GetServerInfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// UserService_ServiceDesc is the grpc.ServiceDesc for UserService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var UserService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "user.v1.UserService",
+	HandlerType: (*UserServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateUser",
+			Handler:    _UserService_CreateUser_Handler,
+		},
+		{
+			MethodName: "GetUser",
+			Handler:    _UserService_GetUser_Handler,
+		},
 		{
 			MethodName: "UpdateUser",
 			Handler:    _UserService_UpdateUser_Handler,
@@ -300,10 +1466,122 @@ var UserService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteUser",
 			Handler:    _UserService_DeleteUser_Handler,
 		},
+		{
+			MethodName: "UnlockUser",
+			Handler:    _UserService_UnlockUser_Handler,
+		},
 		{
 			MethodName: "VerifyPassword",
 			Handler:    _UserService_VerifyPassword_Handler,
 		},
+		{
+			MethodName: "VerifyEmail",
+			Handler:    _UserService_VerifyEmail_Handler,
+		},
+		{
+			MethodName: "ResendVerificationEmail",
+			Handler:    _UserService_ResendVerificationEmail_Handler,
+		},
+		{
+			MethodName: "ListUsers",
+			Handler:    _UserService_ListUsers_Handler,
+		},
+		{
+			MethodName: "GetUserRoles",
+			Handler:    _UserService_GetUserRoles_Handler,
+		},
+		{
+			MethodName: "BatchDeactivateUsers",
+			Handler:    _UserService_BatchDeactivateUsers_Handler,
+		},
+		{
+			MethodName: "BatchAssignSegment",
+			Handler:    _UserService_BatchAssignSegment_Handler,
+		},
+		{
+			MethodName: "GetBatchJob",
+			Handler:    _UserService_GetBatchJob_Handler,
+		},
+		{
+			MethodName: "GetBatchJobReport",
+			Handler:    _UserService_GetBatchJobReport_Handler,
+		},
+		{
+			MethodName: "ListConsents",
+			Handler:    _UserService_ListConsents_Handler,
+		},
+		{
+			MethodName: "RevokeConsent",
+			Handler:    _UserService_RevokeConsent_Handler,
+		},
+		{
+			MethodName: "ListConnectedApps",
+			Handler:    _UserService_ListConnectedApps_Handler,
+		},
+		{
+			MethodName: "ListSessions",
+			Handler:    _UserService_ListSessions_Handler,
+		},
+		{
+			MethodName: "RevokeSession",
+			Handler:    _UserService_RevokeSession_Handler,
+		},
+		{
+			MethodName: "GetLoginHistory",
+			Handler:    _UserService_GetLoginHistory_Handler,
+		},
+		{
+			MethodName: "AddAddress",
+			Handler:    _UserService_AddAddress_Handler,
+		},
+		{
+			MethodName: "ListAddresses",
+			Handler:    _UserService_ListAddresses_Handler,
+		},
+		{
+			MethodName: "SetDefaultShippingAddress",
+			Handler:    _UserService_SetDefaultShippingAddress_Handler,
+		},
+		{
+			MethodName: "DeleteAddress",
+			Handler:    _UserService_DeleteAddress_Handler,
+		},
+		{
+			MethodName: "GetTwoFactorStatus",
+			Handler:    _UserService_GetTwoFactorStatus_Handler,
+		},
+		{
+			MethodName: "EnrollTOTP",
+			Handler:    _UserService_EnrollTOTP_Handler,
+		},
+		{
+			MethodName: "ConfirmTOTP",
+			Handler:    _UserService_ConfirmTOTP_Handler,
+		},
+		{
+			MethodName: "DisableTOTP",
+			Handler:    _UserService_DisableTOTP_Handler,
+		},
+		{
+			MethodName: "ChangePassword",
+			Handler:    _UserService_ChangePassword_Handler,
+		},
+		{
+			MethodName: "CreateAccessGrant",
+			Handler:    _UserService_CreateAccessGrant_Handler,
+		},
+		{
+			MethodName: "RevokeAccessGrant",
+			Handler:    _UserService_RevokeAccessGrant_Handler,
+		},
+		{
+			MethodName: "ListAccessGrants",
+			Handler:    _UserService_ListAccessGrants_Handler,
+		},
+		{
+			MethodName: "GetServerInfo",
+			Handler:    _UserService_GetServerInfo_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "user/v1/user_service.proto",
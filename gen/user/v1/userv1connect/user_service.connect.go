@@ -46,9 +46,85 @@ const (
 	UserServiceUpdateUserProcedure = "/user.v1.UserService/UpdateUser"
 	// UserServiceDeleteUserProcedure is the fully-qualified name of the UserService's DeleteUser RPC.
 	UserServiceDeleteUserProcedure = "/user.v1.UserService/DeleteUser"
+	// UserServiceUnlockUserProcedure is the fully-qualified name of the UserService's UnlockUser RPC.
+	UserServiceUnlockUserProcedure = "/user.v1.UserService/UnlockUser"
 	// UserServiceVerifyPasswordProcedure is the fully-qualified name of the UserService's
 	// VerifyPassword RPC.
 	UserServiceVerifyPasswordProcedure = "/user.v1.UserService/VerifyPassword"
+	// UserServiceVerifyEmailProcedure is the fully-qualified name of the UserService's VerifyEmail RPC.
+	UserServiceVerifyEmailProcedure = "/user.v1.UserService/VerifyEmail"
+	// UserServiceResendVerificationEmailProcedure is the fully-qualified name of the UserService's
+	// ResendVerificationEmail RPC.
+	UserServiceResendVerificationEmailProcedure = "/user.v1.UserService/ResendVerificationEmail"
+	// UserServiceListUsersProcedure is the fully-qualified name of the UserService's ListUsers RPC.
+	UserServiceListUsersProcedure = "/user.v1.UserService/ListUsers"
+	// UserServiceGetUserRolesProcedure is the fully-qualified name of the UserService's GetUserRoles
+	// RPC.
+	UserServiceGetUserRolesProcedure = "/user.v1.UserService/GetUserRoles"
+	// UserServiceBatchDeactivateUsersProcedure is the fully-qualified name of the UserService's
+	// BatchDeactivateUsers RPC.
+	UserServiceBatchDeactivateUsersProcedure = "/user.v1.UserService/BatchDeactivateUsers"
+	// UserServiceBatchAssignSegmentProcedure is the fully-qualified name of the UserService's
+	// BatchAssignSegment RPC.
+	UserServiceBatchAssignSegmentProcedure = "/user.v1.UserService/BatchAssignSegment"
+	// UserServiceGetBatchJobProcedure is the fully-qualified name of the UserService's GetBatchJob RPC.
+	UserServiceGetBatchJobProcedure = "/user.v1.UserService/GetBatchJob"
+	// UserServiceGetBatchJobReportProcedure is the fully-qualified name of the UserService's
+	// GetBatchJobReport RPC.
+	UserServiceGetBatchJobReportProcedure = "/user.v1.UserService/GetBatchJobReport"
+	// UserServiceListConsentsProcedure is the fully-qualified name of the UserService's ListConsents
+	// RPC.
+	UserServiceListConsentsProcedure = "/user.v1.UserService/ListConsents"
+	// UserServiceRevokeConsentProcedure is the fully-qualified name of the UserService's RevokeConsent
+	// RPC.
+	UserServiceRevokeConsentProcedure = "/user.v1.UserService/RevokeConsent"
+	// UserServiceListConnectedAppsProcedure is the fully-qualified name of the UserService's
+	// ListConnectedApps RPC.
+	UserServiceListConnectedAppsProcedure = "/user.v1.UserService/ListConnectedApps"
+	// UserServiceListSessionsProcedure is the fully-qualified name of the UserService's ListSessions
+	// RPC.
+	UserServiceListSessionsProcedure = "/user.v1.UserService/ListSessions"
+	// UserServiceRevokeSessionProcedure is the fully-qualified name of the UserService's RevokeSession
+	// RPC.
+	UserServiceRevokeSessionProcedure = "/user.v1.UserService/RevokeSession"
+	// UserServiceGetLoginHistoryProcedure is the fully-qualified name of the UserService's
+	// GetLoginHistory RPC.
+	UserServiceGetLoginHistoryProcedure = "/user.v1.UserService/GetLoginHistory"
+	// UserServiceAddAddressProcedure is the fully-qualified name of the UserService's AddAddress RPC.
+	UserServiceAddAddressProcedure = "/user.v1.UserService/AddAddress"
+	// UserServiceListAddressesProcedure is the fully-qualified name of the UserService's ListAddresses
+	// RPC.
+	UserServiceListAddressesProcedure = "/user.v1.UserService/ListAddresses"
+	// UserServiceSetDefaultShippingAddressProcedure is the fully-qualified name of the UserService's
+	// SetDefaultShippingAddress RPC.
+	UserServiceSetDefaultShippingAddressProcedure = "/user.v1.UserService/SetDefaultShippingAddress"
+	// UserServiceDeleteAddressProcedure is the fully-qualified name of the UserService's DeleteAddress
+	// RPC.
+	UserServiceDeleteAddressProcedure = "/user.v1.UserService/DeleteAddress"
+	// UserServiceGetTwoFactorStatusProcedure is the fully-qualified name of the UserService's
+	// GetTwoFactorStatus RPC.
+	UserServiceGetTwoFactorStatusProcedure = "/user.v1.UserService/GetTwoFactorStatus"
+	// UserServiceEnrollTOTPProcedure is the fully-qualified name of the UserService's EnrollTOTP RPC.
+	UserServiceEnrollTOTPProcedure = "/user.v1.UserService/EnrollTOTP"
+	// UserServiceConfirmTOTPProcedure is the fully-qualified name of the UserService's ConfirmTOTP RPC.
+	UserServiceConfirmTOTPProcedure = "/user.v1.UserService/ConfirmTOTP"
+	// UserServiceDisableTOTPProcedure is the fully-qualified name of the UserService's DisableTOTP RPC.
+	UserServiceDisableTOTPProcedure = "/user.v1.UserService/DisableTOTP"
+	// UserServiceChangePasswordProcedure is the fully-qualified name of the UserService's
+	// ChangePassword RPC.
+	UserServiceChangePasswordProcedure = "/user.v1.UserService/ChangePassword"
+	// UserServiceCreateAccessGrantProcedure is the fully-qualified name of the UserService's
+	// CreateAccessGrant RPC.
+	UserServiceCreateAccessGrantProcedure = "/user.v1.UserService/CreateAccessGrant"
+	// UserServiceRevokeAccessGrantProcedure is the fully-qualified name of the UserService's
+	// RevokeAccessGrant RPC.
+	UserServiceRevokeAccessGrantProcedure = "/user.v1.UserService/RevokeAccessGrant"
+	// UserServiceListAccessGrantsProcedure is the fully-qualified name of the UserService's
+	// ListAccessGrants RPC.
+	UserServiceListAccessGrantsProcedure = "/user.v1.UserService/ListAccessGrants"
+	// UserServiceGetServerInfoProcedure is the fully-qualified name of the UserService's GetServerInfo
+	// RPC.
+	UserServiceGetServerInfoProcedure = "/user.v1.UserService/GetServerInfo"
 )
 
 // UserServiceClient is a client for the user.v1.UserService service.
@@ -67,10 +143,145 @@ type UserServiceClient interface {
 	// DeleteUser performs soft deletion of a user account.
 	// Returns NOT_FOUND if user doesn't exist or is already deleted.
 	DeleteUser(context.Context, *connect.Request[v1.DeleteUserRequest]) (*connect.Response[v1.DeleteUserResponse], error)
+	// UnlockUser lifts the login lockout of the user's email address.
+	// Returns NOT_FOUND if user doesn't exist or is soft-deleted.
+	// Returns UNIMPLEMENTED if login lockout is disabled.
+	UnlockUser(context.Context, *connect.Request[v1.UnlockUserRequest]) (*connect.Response[v1.UnlockUserResponse], error)
 	// VerifyPassword validates user credentials for authentication.
 	// Returns UNAUTHENTICATED for invalid credentials (timing-safe).
 	// Note: Same error returned for non-existent email or wrong password.
+	// Returns PERMISSION_DENIED (ACCOUNT_LOCKED) while the email address is
+	// locked after repeated failures, whether or not it has an account.
 	VerifyPassword(context.Context, *connect.Request[v1.VerifyPasswordRequest]) (*connect.Response[v1.VerifyPasswordResponse], error)
+	// VerifyEmail marks the account owning the token as email-verified.
+	// Returns INVALID_ARGUMENT if the token is unknown or already used.
+	// Returns FAILED_PRECONDITION if the token has expired.
+	VerifyEmail(context.Context, *connect.Request[v1.VerifyEmailRequest]) (*connect.Response[v1.VerifyEmailResponse], error)
+	// ResendVerificationEmail sends a new verification email to the user's
+	// current address and invalidates the tokens sent before.
+	// Returns FAILED_PRECONDITION if the email is already verified.
+	// Returns UNIMPLEMENTED if email verification is disabled.
+	ResendVerificationEmail(context.Context, *connect.Request[v1.ResendVerificationEmailRequest]) (*connect.Response[v1.ResendVerificationEmailResponse], error)
+	// ListUsers enumerates users for administrative purposes.
+	// Results are ordered by creation time (newest first) with cursor pagination.
+	// Returns INVALID_ARGUMENT if page_token is malformed.
+	ListUsers(context.Context, *connect.Request[v1.ListUsersRequest]) (*connect.Response[v1.ListUsersResponse], error)
+	// GetUserRoles returns the roles assigned to a user with their permissions.
+	// Used at consent time to embed role claims in issued access tokens.
+	// Returns NOT_FOUND if user doesn't exist or is soft-deleted.
+	GetUserRoles(context.Context, *connect.Request[v1.GetUserRolesRequest]) (*connect.Response[v1.GetUserRolesResponse], error)
+	// BatchDeactivateUsers starts an asynchronous job that soft-deletes the targeted users.
+	// Returns INVALID_ARGUMENT if the target is empty or exceeds 10,000 users.
+	BatchDeactivateUsers(context.Context, *connect.Request[v1.BatchDeactivateUsersRequest]) (*connect.Response[v1.BatchDeactivateUsersResponse], error)
+	// BatchAssignSegment starts an asynchronous job that assigns a marketing segment
+	// to the targeted users, replacing any previous segment.
+	// Returns INVALID_ARGUMENT if the segment name is invalid or the target is empty.
+	BatchAssignSegment(context.Context, *connect.Request[v1.BatchAssignSegmentRequest]) (*connect.Response[v1.BatchAssignSegmentResponse], error)
+	// GetBatchJob returns the current progress of a batch job.
+	// Returns NOT_FOUND if the job doesn't exist.
+	GetBatchJob(context.Context, *connect.Request[v1.GetBatchJobRequest]) (*connect.Response[v1.GetBatchJobResponse], error)
+	// GetBatchJobReport returns the per-user results of a finished job as CSV.
+	// Returns NOT_FOUND if the job doesn't exist.
+	// Returns FAILED_PRECONDITION if the job is still pending or running.
+	GetBatchJobReport(context.Context, *connect.Request[v1.GetBatchJobReportRequest]) (*connect.Response[v1.GetBatchJobReportResponse], error)
+	// ListConsents returns the consent receipts recorded for a user, newest first.
+	// Returns INVALID_ARGUMENT if user_id is malformed.
+	ListConsents(context.Context, *connect.Request[v1.ListConsentsRequest]) (*connect.Response[v1.ListConsentsResponse], error)
+	// RevokeConsent revokes all consent a user granted to an OAuth2 client,
+	// both at Hydra (invalidating issued tokens) and in the receipt history.
+	// Returns INVALID_ARGUMENT if user_id or client_id is missing.
+	RevokeConsent(context.Context, *connect.Request[v1.RevokeConsentRequest]) (*connect.Response[v1.RevokeConsentResponse], error)
+	// ListConnectedApps returns the OAuth2 clients a user has granted access
+	// to at Hydra, one per client across all login sessions, most recently
+	// granted first. RevokeConsent revokes a client's access and tokens.
+	// Returns INVALID_ARGUMENT if user_id is malformed.
+	ListConnectedApps(context.Context, *connect.Request[v1.ListConnectedAppsRequest]) (*connect.Response[v1.ListConnectedAppsResponse], error)
+	// ListSessions returns a user's active login sessions at Hydra, one per
+	// browser or device they signed in on, most recently used first, with the
+	// OAuth2 clients used in each.
+	// Returns INVALID_ARGUMENT if user_id is malformed.
+	ListSessions(context.Context, *connect.Request[v1.ListSessionsRequest]) (*connect.Response[v1.ListSessionsResponse], error)
+	// RevokeSession logs a user out of one login session, so the device has
+	// to sign in again, and revokes the tokens of the clients used only in
+	// that session. Clients also used in other sessions keep their tokens;
+	// RevokeConsent revokes them everywhere.
+	// Returns INVALID_ARGUMENT if user_id or session_id is missing.
+	// Returns NOT_FOUND if the session doesn't exist or belongs to another user.
+	RevokeSession(context.Context, *connect.Request[v1.RevokeSessionRequest]) (*connect.Response[v1.RevokeSessionResponse], error)
+	// GetLoginHistory returns a user's latest sign-in attempts, newest first:
+	// completed logins and logins refused for a wrong password or code.
+	// page_size defaults to 20 and is capped at 100.
+	// Returns INVALID_ARGUMENT if user_id is malformed.
+	// Returns UNIMPLEMENTED if login history is disabled.
+	GetLoginHistory(context.Context, *connect.Request[v1.GetLoginHistoryRequest]) (*connect.Response[v1.GetLoginHistoryResponse], error)
+	// AddAddress adds a postal address to a user's address book (at most 10).
+	// The first address, or one added with default_shipping, becomes the
+	// default shipping address. Postal codes and phone numbers are
+	// normalized, e.g. "1000001" to "100-0001" in JP and "+81 90-1234-5678"
+	// to "+819012345678".
+	// Returns INVALID_ARGUMENT if a field is missing or malformed.
+	// Returns NOT_FOUND if the user doesn't exist.
+	// Returns RESOURCE_EXHAUSTED if the address book is full.
+	AddAddress(context.Context, *connect.Request[v1.AddAddressRequest]) (*connect.Response[v1.AddAddressResponse], error)
+	// ListAddresses returns a user's addresses, the default shipping address
+	// first, then newest first.
+	// Returns INVALID_ARGUMENT if user_id is malformed.
+	ListAddresses(context.Context, *connect.Request[v1.ListAddressesRequest]) (*connect.Response[v1.ListAddressesResponse], error)
+	// SetDefaultShippingAddress makes an address the user's default shipping
+	// address, replacing the previous one.
+	// Returns NOT_FOUND if the address doesn't exist or belongs to another user.
+	SetDefaultShippingAddress(context.Context, *connect.Request[v1.SetDefaultShippingAddressRequest]) (*connect.Response[v1.SetDefaultShippingAddressResponse], error)
+	// DeleteAddress removes an address. Deleting the default shipping address
+	// leaves the user without one until another is chosen.
+	// Returns NOT_FOUND if the address doesn't exist or belongs to another user.
+	DeleteAddress(context.Context, *connect.Request[v1.DeleteAddressRequest]) (*connect.Response[v1.DeleteAddressResponse], error)
+	// GetTwoFactorStatus reports whether sign-in requires a TOTP code.
+	// Returns UNIMPLEMENTED if two-factor authentication is disabled.
+	GetTwoFactorStatus(context.Context, *connect.Request[v1.GetTwoFactorStatusRequest]) (*connect.Response[v1.GetTwoFactorStatusResponse], error)
+	// EnrollTOTP generates a new TOTP secret for an authenticator app,
+	// replacing a pending enrollment. Sign-in is unaffected until ConfirmTOTP.
+	// Returns FAILED_PRECONDITION if two-factor authentication is already enabled.
+	// Returns UNIMPLEMENTED if two-factor authentication is disabled.
+	EnrollTOTP(context.Context, *connect.Request[v1.EnrollTOTPRequest]) (*connect.Response[v1.EnrollTOTPResponse], error)
+	// ConfirmTOTP enables two-factor authentication with a code from the
+	// authenticator app and returns single-use recovery codes. They are only
+	// returned here.
+	// Returns INVALID_ARGUMENT if the code is wrong.
+	// Returns NOT_FOUND if there is no enrollment.
+	// Returns FAILED_PRECONDITION if two-factor authentication is already enabled.
+	ConfirmTOTP(context.Context, *connect.Request[v1.ConfirmTOTPRequest]) (*connect.Response[v1.ConfirmTOTPResponse], error)
+	// DisableTOTP turns two-factor authentication off. It requires a current
+	// code or an unused recovery code.
+	// Returns INVALID_ARGUMENT if the code is wrong.
+	// Returns NOT_FOUND if there is no enrollment.
+	DisableTOTP(context.Context, *connect.Request[v1.DisableTOTPRequest]) (*connect.Response[v1.DisableTOTPResponse], error)
+	// ChangePassword replaces the password after checking the current one.
+	// The new password must meet the password policy and, if enabled, must
+	// not appear in known data breaches.
+	// Returns INVALID_ARGUMENT if the current password is wrong, the new one
+	// equals it or fails the policy (PASSWORD_TOO_SHORT, PASSWORD_TOO_WEAK,
+	// PASSWORD_BREACHED).
+	// Returns PERMISSION_DENIED (ACCOUNT_LOCKED) after too many wrong
+	// current passwords.
+	ChangePassword(context.Context, *connect.Request[v1.ChangePasswordRequest]) (*connect.Response[v1.ChangePasswordResponse], error)
+	// CreateAccessGrant gives a user one permission on top of their roles for
+	// duration_hours (1-72), e.g. to let a support agent act on a customer
+	// account. The BFF honors active grants when authorizing requests and
+	// tags actions taken under them in the security audit log.
+	// Returns NOT_FOUND if the grantee doesn't exist.
+	// Returns INVALID_ARGUMENT for an empty or non-grantable permission, a
+	// missing reason, an out-of-range duration or a grant to oneself.
+	CreateAccessGrant(context.Context, *connect.Request[v1.CreateAccessGrantRequest]) (*connect.Response[v1.CreateAccessGrantResponse], error)
+	// RevokeAccessGrant ends a grant before it expires.
+	// Returns NOT_FOUND if the grant doesn't exist or is already revoked.
+	RevokeAccessGrant(context.Context, *connect.Request[v1.RevokeAccessGrantRequest]) (*connect.Response[v1.RevokeAccessGrantResponse], error)
+	// ListAccessGrants returns the grants given to a user, newest first.
+	// Returns INVALID_ARGUMENT if user_id is malformed.
+	ListAccessGrants(context.Context, *connect.Request[v1.ListAccessGrantsRequest]) (*connect.Response[v1.ListAccessGrantsResponse], error)
+	// GetServerInfo returns the service version and the procedures and
+	// optional features it supports, so callers can adapt during mixed-version
+	// rollouts instead of failing with UNIMPLEMENTED.
+	GetServerInfo(context.Context, *connect.Request[v1.GetServerInfoRequest]) (*connect.Response[v1.GetServerInfoResponse], error)
 }
 
 // NewUserServiceClient constructs a client for the user.v1.UserService service. By default, it uses
@@ -94,6 +305,7 @@ func NewUserServiceClient(httpClient connect.HTTPClient, baseURL string, opts ..
 			httpClient,
 			baseURL+UserServiceGetUserProcedure,
 			connect.WithSchema(userServiceMethods.ByName("GetUser")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
 			connect.WithClientOptions(opts...),
 		),
 		updateUser: connect.NewClient[v1.UpdateUserRequest, v1.UpdateUserResponse](
@@ -108,22 +320,230 @@ func NewUserServiceClient(httpClient connect.HTTPClient, baseURL string, opts ..
 			connect.WithSchema(userServiceMethods.ByName("DeleteUser")),
 			connect.WithClientOptions(opts...),
 		),
+		unlockUser: connect.NewClient[v1.UnlockUserRequest, v1.UnlockUserResponse](
+			httpClient,
+			baseURL+UserServiceUnlockUserProcedure,
+			connect.WithSchema(userServiceMethods.ByName("UnlockUser")),
+			connect.WithClientOptions(opts...),
+		),
 		verifyPassword: connect.NewClient[v1.VerifyPasswordRequest, v1.VerifyPasswordResponse](
 			httpClient,
 			baseURL+UserServiceVerifyPasswordProcedure,
 			connect.WithSchema(userServiceMethods.ByName("VerifyPassword")),
 			connect.WithClientOptions(opts...),
 		),
+		verifyEmail: connect.NewClient[v1.VerifyEmailRequest, v1.VerifyEmailResponse](
+			httpClient,
+			baseURL+UserServiceVerifyEmailProcedure,
+			connect.WithSchema(userServiceMethods.ByName("VerifyEmail")),
+			connect.WithClientOptions(opts...),
+		),
+		resendVerificationEmail: connect.NewClient[v1.ResendVerificationEmailRequest, v1.ResendVerificationEmailResponse](
+			httpClient,
+			baseURL+UserServiceResendVerificationEmailProcedure,
+			connect.WithSchema(userServiceMethods.ByName("ResendVerificationEmail")),
+			connect.WithClientOptions(opts...),
+		),
+		listUsers: connect.NewClient[v1.ListUsersRequest, v1.ListUsersResponse](
+			httpClient,
+			baseURL+UserServiceListUsersProcedure,
+			connect.WithSchema(userServiceMethods.ByName("ListUsers")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+		getUserRoles: connect.NewClient[v1.GetUserRolesRequest, v1.GetUserRolesResponse](
+			httpClient,
+			baseURL+UserServiceGetUserRolesProcedure,
+			connect.WithSchema(userServiceMethods.ByName("GetUserRoles")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+		batchDeactivateUsers: connect.NewClient[v1.BatchDeactivateUsersRequest, v1.BatchDeactivateUsersResponse](
+			httpClient,
+			baseURL+UserServiceBatchDeactivateUsersProcedure,
+			connect.WithSchema(userServiceMethods.ByName("BatchDeactivateUsers")),
+			connect.WithClientOptions(opts...),
+		),
+		batchAssignSegment: connect.NewClient[v1.BatchAssignSegmentRequest, v1.BatchAssignSegmentResponse](
+			httpClient,
+			baseURL+UserServiceBatchAssignSegmentProcedure,
+			connect.WithSchema(userServiceMethods.ByName("BatchAssignSegment")),
+			connect.WithClientOptions(opts...),
+		),
+		getBatchJob: connect.NewClient[v1.GetBatchJobRequest, v1.GetBatchJobResponse](
+			httpClient,
+			baseURL+UserServiceGetBatchJobProcedure,
+			connect.WithSchema(userServiceMethods.ByName("GetBatchJob")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+		getBatchJobReport: connect.NewClient[v1.GetBatchJobReportRequest, v1.GetBatchJobReportResponse](
+			httpClient,
+			baseURL+UserServiceGetBatchJobReportProcedure,
+			connect.WithSchema(userServiceMethods.ByName("GetBatchJobReport")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+		listConsents: connect.NewClient[v1.ListConsentsRequest, v1.ListConsentsResponse](
+			httpClient,
+			baseURL+UserServiceListConsentsProcedure,
+			connect.WithSchema(userServiceMethods.ByName("ListConsents")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+		revokeConsent: connect.NewClient[v1.RevokeConsentRequest, v1.RevokeConsentResponse](
+			httpClient,
+			baseURL+UserServiceRevokeConsentProcedure,
+			connect.WithSchema(userServiceMethods.ByName("RevokeConsent")),
+			connect.WithClientOptions(opts...),
+		),
+		listConnectedApps: connect.NewClient[v1.ListConnectedAppsRequest, v1.ListConnectedAppsResponse](
+			httpClient,
+			baseURL+UserServiceListConnectedAppsProcedure,
+			connect.WithSchema(userServiceMethods.ByName("ListConnectedApps")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+		listSessions: connect.NewClient[v1.ListSessionsRequest, v1.ListSessionsResponse](
+			httpClient,
+			baseURL+UserServiceListSessionsProcedure,
+			connect.WithSchema(userServiceMethods.ByName("ListSessions")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+		revokeSession: connect.NewClient[v1.RevokeSessionRequest, v1.RevokeSessionResponse](
+			httpClient,
+			baseURL+UserServiceRevokeSessionProcedure,
+			connect.WithSchema(userServiceMethods.ByName("RevokeSession")),
+			connect.WithClientOptions(opts...),
+		),
+		getLoginHistory: connect.NewClient[v1.GetLoginHistoryRequest, v1.GetLoginHistoryResponse](
+			httpClient,
+			baseURL+UserServiceGetLoginHistoryProcedure,
+			connect.WithSchema(userServiceMethods.ByName("GetLoginHistory")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+		addAddress: connect.NewClient[v1.AddAddressRequest, v1.AddAddressResponse](
+			httpClient,
+			baseURL+UserServiceAddAddressProcedure,
+			connect.WithSchema(userServiceMethods.ByName("AddAddress")),
+			connect.WithClientOptions(opts...),
+		),
+		listAddresses: connect.NewClient[v1.ListAddressesRequest, v1.ListAddressesResponse](
+			httpClient,
+			baseURL+UserServiceListAddressesProcedure,
+			connect.WithSchema(userServiceMethods.ByName("ListAddresses")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+		setDefaultShippingAddress: connect.NewClient[v1.SetDefaultShippingAddressRequest, v1.SetDefaultShippingAddressResponse](
+			httpClient,
+			baseURL+UserServiceSetDefaultShippingAddressProcedure,
+			connect.WithSchema(userServiceMethods.ByName("SetDefaultShippingAddress")),
+			connect.WithClientOptions(opts...),
+		),
+		deleteAddress: connect.NewClient[v1.DeleteAddressRequest, v1.DeleteAddressResponse](
+			httpClient,
+			baseURL+UserServiceDeleteAddressProcedure,
+			connect.WithSchema(userServiceMethods.ByName("DeleteAddress")),
+			connect.WithClientOptions(opts...),
+		),
+		getTwoFactorStatus: connect.NewClient[v1.GetTwoFactorStatusRequest, v1.GetTwoFactorStatusResponse](
+			httpClient,
+			baseURL+UserServiceGetTwoFactorStatusProcedure,
+			connect.WithSchema(userServiceMethods.ByName("GetTwoFactorStatus")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+		enrollTOTP: connect.NewClient[v1.EnrollTOTPRequest, v1.EnrollTOTPResponse](
+			httpClient,
+			baseURL+UserServiceEnrollTOTPProcedure,
+			connect.WithSchema(userServiceMethods.ByName("EnrollTOTP")),
+			connect.WithClientOptions(opts...),
+		),
+		confirmTOTP: connect.NewClient[v1.ConfirmTOTPRequest, v1.ConfirmTOTPResponse](
+			httpClient,
+			baseURL+UserServiceConfirmTOTPProcedure,
+			connect.WithSchema(userServiceMethods.ByName("ConfirmTOTP")),
+			connect.WithClientOptions(opts...),
+		),
+		disableTOTP: connect.NewClient[v1.DisableTOTPRequest, v1.DisableTOTPResponse](
+			httpClient,
+			baseURL+UserServiceDisableTOTPProcedure,
+			connect.WithSchema(userServiceMethods.ByName("DisableTOTP")),
+			connect.WithClientOptions(opts...),
+		),
+		changePassword: connect.NewClient[v1.ChangePasswordRequest, v1.ChangePasswordResponse](
+			httpClient,
+			baseURL+UserServiceChangePasswordProcedure,
+			connect.WithSchema(userServiceMethods.ByName("ChangePassword")),
+			connect.WithClientOptions(opts...),
+		),
+		createAccessGrant: connect.NewClient[v1.CreateAccessGrantRequest, v1.CreateAccessGrantResponse](
+			httpClient,
+			baseURL+UserServiceCreateAccessGrantProcedure,
+			connect.WithSchema(userServiceMethods.ByName("CreateAccessGrant")),
+			connect.WithClientOptions(opts...),
+		),
+		revokeAccessGrant: connect.NewClient[v1.RevokeAccessGrantRequest, v1.RevokeAccessGrantResponse](
+			httpClient,
+			baseURL+UserServiceRevokeAccessGrantProcedure,
+			connect.WithSchema(userServiceMethods.ByName("RevokeAccessGrant")),
+			connect.WithClientOptions(opts...),
+		),
+		listAccessGrants: connect.NewClient[v1.ListAccessGrantsRequest, v1.ListAccessGrantsResponse](
+			httpClient,
+			baseURL+UserServiceListAccessGrantsProcedure,
+			connect.WithSchema(userServiceMethods.ByName("ListAccessGrants")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+		getServerInfo: connect.NewClient[v1.GetServerInfoRequest, v1.GetServerInfoResponse](
+			httpClient,
+			baseURL+UserServiceGetServerInfoProcedure,
+			connect.WithSchema(userServiceMethods.ByName("GetServerInfo")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
 // userServiceClient implements UserServiceClient.
 type userServiceClient struct {
-	createUser     *connect.Client[v1.CreateUserRequest, v1.CreateUserResponse]
-	getUser        *connect.Client[v1.GetUserRequest, v1.GetUserResponse]
-	updateUser     *connect.Client[v1.UpdateUserRequest, v1.UpdateUserResponse]
-	deleteUser     *connect.Client[v1.DeleteUserRequest, v1.DeleteUserResponse]
-	verifyPassword *connect.Client[v1.VerifyPasswordRequest, v1.VerifyPasswordResponse]
+	createUser                *connect.Client[v1.CreateUserRequest, v1.CreateUserResponse]
+	getUser                   *connect.Client[v1.GetUserRequest, v1.GetUserResponse]
+	updateUser                *connect.Client[v1.UpdateUserRequest, v1.UpdateUserResponse]
+	deleteUser                *connect.Client[v1.DeleteUserRequest, v1.DeleteUserResponse]
+	unlockUser                *connect.Client[v1.UnlockUserRequest, v1.UnlockUserResponse]
+	verifyPassword            *connect.Client[v1.VerifyPasswordRequest, v1.VerifyPasswordResponse]
+	verifyEmail               *connect.Client[v1.VerifyEmailRequest, v1.VerifyEmailResponse]
+	resendVerificationEmail   *connect.Client[v1.ResendVerificationEmailRequest, v1.ResendVerificationEmailResponse]
+	listUsers                 *connect.Client[v1.ListUsersRequest, v1.ListUsersResponse]
+	getUserRoles              *connect.Client[v1.GetUserRolesRequest, v1.GetUserRolesResponse]
+	batchDeactivateUsers      *connect.Client[v1.BatchDeactivateUsersRequest, v1.BatchDeactivateUsersResponse]
+	batchAssignSegment        *connect.Client[v1.BatchAssignSegmentRequest, v1.BatchAssignSegmentResponse]
+	getBatchJob               *connect.Client[v1.GetBatchJobRequest, v1.GetBatchJobResponse]
+	getBatchJobReport         *connect.Client[v1.GetBatchJobReportRequest, v1.GetBatchJobReportResponse]
+	listConsents              *connect.Client[v1.ListConsentsRequest, v1.ListConsentsResponse]
+	revokeConsent             *connect.Client[v1.RevokeConsentRequest, v1.RevokeConsentResponse]
+	listConnectedApps         *connect.Client[v1.ListConnectedAppsRequest, v1.ListConnectedAppsResponse]
+	listSessions              *connect.Client[v1.ListSessionsRequest, v1.ListSessionsResponse]
+	revokeSession             *connect.Client[v1.RevokeSessionRequest, v1.RevokeSessionResponse]
+	getLoginHistory           *connect.Client[v1.GetLoginHistoryRequest, v1.GetLoginHistoryResponse]
+	addAddress                *connect.Client[v1.AddAddressRequest, v1.AddAddressResponse]
+	listAddresses             *connect.Client[v1.ListAddressesRequest, v1.ListAddressesResponse]
+	setDefaultShippingAddress *connect.Client[v1.SetDefaultShippingAddressRequest, v1.SetDefaultShippingAddressResponse]
+	deleteAddress             *connect.Client[v1.DeleteAddressRequest, v1.DeleteAddressResponse]
+	getTwoFactorStatus        *connect.Client[v1.GetTwoFactorStatusRequest, v1.GetTwoFactorStatusResponse]
+	enrollTOTP                *connect.Client[v1.EnrollTOTPRequest, v1.EnrollTOTPResponse]
+	confirmTOTP               *connect.Client[v1.ConfirmTOTPRequest, v1.ConfirmTOTPResponse]
+	disableTOTP               *connect.Client[v1.DisableTOTPRequest, v1.DisableTOTPResponse]
+	changePassword            *connect.Client[v1.ChangePasswordRequest, v1.ChangePasswordResponse]
+	createAccessGrant         *connect.Client[v1.CreateAccessGrantRequest, v1.CreateAccessGrantResponse]
+	revokeAccessGrant         *connect.Client[v1.RevokeAccessGrantRequest, v1.RevokeAccessGrantResponse]
+	listAccessGrants          *connect.Client[v1.ListAccessGrantsRequest, v1.ListAccessGrantsResponse]
+	getServerInfo             *connect.Client[v1.GetServerInfoRequest, v1.GetServerInfoResponse]
 }
 
 // CreateUser calls user.v1.UserService.CreateUser.
@@ -146,11 +566,151 @@ func (c *userServiceClient) DeleteUser(ctx context.Context, req *connect.Request
 	return c.deleteUser.CallUnary(ctx, req)
 }
 
+// UnlockUser calls user.v1.UserService.UnlockUser.
+func (c *userServiceClient) UnlockUser(ctx context.Context, req *connect.Request[v1.UnlockUserRequest]) (*connect.Response[v1.UnlockUserResponse], error) {
+	return c.unlockUser.CallUnary(ctx, req)
+}
+
 // VerifyPassword calls user.v1.UserService.VerifyPassword.
 func (c *userServiceClient) VerifyPassword(ctx context.Context, req *connect.Request[v1.VerifyPasswordRequest]) (*connect.Response[v1.VerifyPasswordResponse], error) {
 	return c.verifyPassword.CallUnary(ctx, req)
 }
 
+// VerifyEmail calls user.v1.UserService.VerifyEmail.
+func (c *userServiceClient) VerifyEmail(ctx context.Context, req *connect.Request[v1.VerifyEmailRequest]) (*connect.Response[v1.VerifyEmailResponse], error) {
+	return c.verifyEmail.CallUnary(ctx, req)
+}
+
+// ResendVerificationEmail calls user.v1.UserService.ResendVerificationEmail.
+func (c *userServiceClient) ResendVerificationEmail(ctx context.Context, req *connect.Request[v1.ResendVerificationEmailRequest]) (*connect.Response[v1.ResendVerificationEmailResponse], error) {
+	return c.resendVerificationEmail.CallUnary(ctx, req)
+}
+
+// ListUsers calls user.v1.UserService.ListUsers.
+func (c *userServiceClient) ListUsers(ctx context.Context, req *connect.Request[v1.ListUsersRequest]) (*connect.Response[v1.ListUsersResponse], error) {
+	return c.listUsers.CallUnary(ctx, req)
+}
+
+// GetUserRoles calls user.v1.UserService.GetUserRoles.
+func (c *userServiceClient) GetUserRoles(ctx context.Context, req *connect.Request[v1.GetUserRolesRequest]) (*connect.Response[v1.GetUserRolesResponse], error) {
+	return c.getUserRoles.CallUnary(ctx, req)
+}
+
+// BatchDeactivateUsers calls user.v1.UserService.BatchDeactivateUsers.
+func (c *userServiceClient) BatchDeactivateUsers(ctx context.Context, req *connect.Request[v1.BatchDeactivateUsersRequest]) (*connect.Response[v1.BatchDeactivateUsersResponse], error) {
+	return c.batchDeactivateUsers.CallUnary(ctx, req)
+}
+
+// BatchAssignSegment calls user.v1.UserService.BatchAssignSegment.
+func (c *userServiceClient) BatchAssignSegment(ctx context.Context, req *connect.Request[v1.BatchAssignSegmentRequest]) (*connect.Response[v1.BatchAssignSegmentResponse], error) {
+	return c.batchAssignSegment.CallUnary(ctx, req)
+}
+
+// GetBatchJob calls user.v1.UserService.GetBatchJob.
+func (c *userServiceClient) GetBatchJob(ctx context.Context, req *connect.Request[v1.GetBatchJobRequest]) (*connect.Response[v1.GetBatchJobResponse], error) {
+	return c.getBatchJob.CallUnary(ctx, req)
+}
+
+// GetBatchJobReport calls user.v1.UserService.GetBatchJobReport.
+func (c *userServiceClient) GetBatchJobReport(ctx context.Context, req *connect.Request[v1.GetBatchJobReportRequest]) (*connect.Response[v1.GetBatchJobReportResponse], error) {
+	return c.getBatchJobReport.CallUnary(ctx, req)
+}
+
+// ListConsents calls user.v1.UserService.ListConsents.
+func (c *userServiceClient) ListConsents(ctx context.Context, req *connect.Request[v1.ListConsentsRequest]) (*connect.Response[v1.ListConsentsResponse], error) {
+	return c.listConsents.CallUnary(ctx, req)
+}
+
+// RevokeConsent calls user.v1.UserService.RevokeConsent.
+func (c *userServiceClient) RevokeConsent(ctx context.Context, req *connect.Request[v1.RevokeConsentRequest]) (*connect.Response[v1.RevokeConsentResponse], error) {
+	return c.revokeConsent.CallUnary(ctx, req)
+}
+
+// ListConnectedApps calls user.v1.UserService.ListConnectedApps.
+func (c *userServiceClient) ListConnectedApps(ctx context.Context, req *connect.Request[v1.ListConnectedAppsRequest]) (*connect.Response[v1.ListConnectedAppsResponse], error) {
+	return c.listConnectedApps.CallUnary(ctx, req)
+}
+
+// ListSessions calls user.v1.UserService.ListSessions.
+func (c *userServiceClient) ListSessions(ctx context.Context, req *connect.Request[v1.ListSessionsRequest]) (*connect.Response[v1.ListSessionsResponse], error) {
+	return c.listSessions.CallUnary(ctx, req)
+}
+
+// RevokeSession calls user.v1.UserService.RevokeSession.
+func (c *userServiceClient) RevokeSession(ctx context.Context, req *connect.Request[v1.RevokeSessionRequest]) (*connect.Response[v1.RevokeSessionResponse], error) {
+	return c.revokeSession.CallUnary(ctx, req)
+}
+
+// GetLoginHistory calls user.v1.UserService.GetLoginHistory.
+func (c *userServiceClient) GetLoginHistory(ctx context.Context, req *connect.Request[v1.GetLoginHistoryRequest]) (*connect.Response[v1.GetLoginHistoryResponse], error) {
+	return c.getLoginHistory.CallUnary(ctx, req)
+}
+
+// AddAddress calls user.v1.UserService.AddAddress.
+func (c *userServiceClient) AddAddress(ctx context.Context, req *connect.Request[v1.AddAddressRequest]) (*connect.Response[v1.AddAddressResponse], error) {
+	return c.addAddress.CallUnary(ctx, req)
+}
+
+// ListAddresses calls user.v1.UserService.ListAddresses.
+func (c *userServiceClient) ListAddresses(ctx context.Context, req *connect.Request[v1.ListAddressesRequest]) (*connect.Response[v1.ListAddressesResponse], error) {
+	return c.listAddresses.CallUnary(ctx, req)
+}
+
+// SetDefaultShippingAddress calls user.v1.UserService.SetDefaultShippingAddress.
+func (c *userServiceClient) SetDefaultShippingAddress(ctx context.Context, req *connect.Request[v1.SetDefaultShippingAddressRequest]) (*connect.Response[v1.SetDefaultShippingAddressResponse], error) {
+	return c.setDefaultShippingAddress.CallUnary(ctx, req)
+}
+
+// DeleteAddress calls user.v1.UserService.DeleteAddress.
+func (c *userServiceClient) DeleteAddress(ctx context.Context, req *connect.Request[v1.DeleteAddressRequest]) (*connect.Response[v1.DeleteAddressResponse], error) {
+	return c.deleteAddress.CallUnary(ctx, req)
+}
+
+// GetTwoFactorStatus calls user.v1.UserService.GetTwoFactorStatus.
+func (c *userServiceClient) GetTwoFactorStatus(ctx context.Context, req *connect.Request[v1.GetTwoFactorStatusRequest]) (*connect.Response[v1.GetTwoFactorStatusResponse], error) {
+	return c.getTwoFactorStatus.CallUnary(ctx, req)
+}
+
+// EnrollTOTP calls user.v1.UserService.EnrollTOTP.
+func (c *userServiceClient) EnrollTOTP(ctx context.Context, req *connect.Request[v1.EnrollTOTPRequest]) (*connect.Response[v1.EnrollTOTPResponse], error) {
+	return c.enrollTOTP.CallUnary(ctx, req)
+}
+
+// ConfirmTOTP calls user.v1.UserService.ConfirmTOTP.
+func (c *userServiceClient) ConfirmTOTP(ctx context.Context, req *connect.Request[v1.ConfirmTOTPRequest]) (*connect.Response[v1.ConfirmTOTPResponse], error) {
+	return c.confirmTOTP.CallUnary(ctx, req)
+}
+
+// DisableTOTP calls user.v1.UserService.DisableTOTP.
+func (c *userServiceClient) DisableTOTP(ctx context.Context, req *connect.Request[v1.DisableTOTPRequest]) (*connect.Response[v1.DisableTOTPResponse], error) {
+	return c.disableTOTP.CallUnary(ctx, req)
+}
+
+// ChangePassword calls user.v1.UserService.ChangePassword.
+func (c *userServiceClient) ChangePassword(ctx context.Context, req *connect.Request[v1.ChangePasswordRequest]) (*connect.Response[v1.ChangePasswordResponse], error) {
+	return c.changePassword.CallUnary(ctx, req)
+}
+
+// CreateAccessGrant calls user.v1.UserService.CreateAccessGrant.
+func (c *userServiceClient) CreateAccessGrant(ctx context.Context, req *connect.Request[v1.CreateAccessGrantRequest]) (*connect.Response[v1.CreateAccessGrantResponse], error) {
+	return c.createAccessGrant.CallUnary(ctx, req)
+}
+
+// RevokeAccessGrant calls user.v1.UserService.RevokeAccessGrant.
+func (c *userServiceClient) RevokeAccessGrant(ctx context.Context, req *connect.Request[v1.RevokeAccessGrantRequest]) (*connect.Response[v1.RevokeAccessGrantResponse], error) {
+	return c.revokeAccessGrant.CallUnary(ctx, req)
+}
+
+// ListAccessGrants calls user.v1.UserService.ListAccessGrants.
+func (c *userServiceClient) ListAccessGrants(ctx context.Context, req *connect.Request[v1.ListAccessGrantsRequest]) (*connect.Response[v1.ListAccessGrantsResponse], error) {
+	return c.listAccessGrants.CallUnary(ctx, req)
+}
+
+// GetServerInfo calls user.v1.UserService.GetServerInfo.
+func (c *userServiceClient) GetServerInfo(ctx context.Context, req *connect.Request[v1.GetServerInfoRequest]) (*connect.Response[v1.GetServerInfoResponse], error) {
+	return c.getServerInfo.CallUnary(ctx, req)
+}
+
 // UserServiceHandler is an implementation of the user.v1.UserService service.
 type UserServiceHandler interface {
 	// CreateUser registers a new user with email and password.
@@ -167,10 +727,145 @@ type UserServiceHandler interface {
 	// DeleteUser performs soft deletion of a user account.
 	// Returns NOT_FOUND if user doesn't exist or is already deleted.
 	DeleteUser(context.Context, *connect.Request[v1.DeleteUserRequest]) (*connect.Response[v1.DeleteUserResponse], error)
+	// UnlockUser lifts the login lockout of the user's email address.
+	// Returns NOT_FOUND if user doesn't exist or is soft-deleted.
+	// Returns UNIMPLEMENTED if login lockout is disabled.
+	UnlockUser(context.Context, *connect.Request[v1.UnlockUserRequest]) (*connect.Response[v1.UnlockUserResponse], error)
 	// VerifyPassword validates user credentials for authentication.
 	// Returns UNAUTHENTICATED for invalid credentials (timing-safe).
 	// Note: Same error returned for non-existent email or wrong password.
+	// Returns PERMISSION_DENIED (ACCOUNT_LOCKED) while the email address is
+	// locked after repeated failures, whether or not it has an account.
 	VerifyPassword(context.Context, *connect.Request[v1.VerifyPasswordRequest]) (*connect.Response[v1.VerifyPasswordResponse], error)
+	// VerifyEmail marks the account owning the token as email-verified.
+	// Returns INVALID_ARGUMENT if the token is unknown or already used.
+	// Returns FAILED_PRECONDITION if the token has expired.
+	VerifyEmail(context.Context, *connect.Request[v1.VerifyEmailRequest]) (*connect.Response[v1.VerifyEmailResponse], error)
+	// ResendVerificationEmail sends a new verification email to the user's
+	// current address and invalidates the tokens sent before.
+	// Returns FAILED_PRECONDITION if the email is already verified.
+	// Returns UNIMPLEMENTED if email verification is disabled.
+	ResendVerificationEmail(context.Context, *connect.Request[v1.ResendVerificationEmailRequest]) (*connect.Response[v1.ResendVerificationEmailResponse], error)
+	// ListUsers enumerates users for administrative purposes.
+	// Results are ordered by creation time (newest first) with cursor pagination.
+	// Returns INVALID_ARGUMENT if page_token is malformed.
+	ListUsers(context.Context, *connect.Request[v1.ListUsersRequest]) (*connect.Response[v1.ListUsersResponse], error)
+	// GetUserRoles returns the roles assigned to a user with their permissions.
+	// Used at consent time to embed role claims in issued access tokens.
+	// Returns NOT_FOUND if user doesn't exist or is soft-deleted.
+	GetUserRoles(context.Context, *connect.Request[v1.GetUserRolesRequest]) (*connect.Response[v1.GetUserRolesResponse], error)
+	// BatchDeactivateUsers starts an asynchronous job that soft-deletes the targeted users.
+	// Returns INVALID_ARGUMENT if the target is empty or exceeds 10,000 users.
+	BatchDeactivateUsers(context.Context, *connect.Request[v1.BatchDeactivateUsersRequest]) (*connect.Response[v1.BatchDeactivateUsersResponse], error)
+	// BatchAssignSegment starts an asynchronous job that assigns a marketing segment
+	// to the targeted users, replacing any previous segment.
+	// Returns INVALID_ARGUMENT if the segment name is invalid or the target is empty.
+	BatchAssignSegment(context.Context, *connect.Request[v1.BatchAssignSegmentRequest]) (*connect.Response[v1.BatchAssignSegmentResponse], error)
+	// GetBatchJob returns the current progress of a batch job.
+	// Returns NOT_FOUND if the job doesn't exist.
+	GetBatchJob(context.Context, *connect.Request[v1.GetBatchJobRequest]) (*connect.Response[v1.GetBatchJobResponse], error)
+	// GetBatchJobReport returns the per-user results of a finished job as CSV.
+	// Returns NOT_FOUND if the job doesn't exist.
+	// Returns FAILED_PRECONDITION if the job is still pending or running.
+	GetBatchJobReport(context.Context, *connect.Request[v1.GetBatchJobReportRequest]) (*connect.Response[v1.GetBatchJobReportResponse], error)
+	// ListConsents returns the consent receipts recorded for a user, newest first.
+	// Returns INVALID_ARGUMENT if user_id is malformed.
+	ListConsents(context.Context, *connect.Request[v1.ListConsentsRequest]) (*connect.Response[v1.ListConsentsResponse], error)
+	// RevokeConsent revokes all consent a user granted to an OAuth2 client,
+	// both at Hydra (invalidating issued tokens) and in the receipt history.
+	// Returns INVALID_ARGUMENT if user_id or client_id is missing.
+	RevokeConsent(context.Context, *connect.Request[v1.RevokeConsentRequest]) (*connect.Response[v1.RevokeConsentResponse], error)
+	// ListConnectedApps returns the OAuth2 clients a user has granted access
+	// to at Hydra, one per client across all login sessions, most recently
+	// granted first. RevokeConsent revokes a client's access and tokens.
+	// Returns INVALID_ARGUMENT if user_id is malformed.
+	ListConnectedApps(context.Context, *connect.Request[v1.ListConnectedAppsRequest]) (*connect.Response[v1.ListConnectedAppsResponse], error)
+	// ListSessions returns a user's active login sessions at Hydra, one per
+	// browser or device they signed in on, most recently used first, with the
+	// OAuth2 clients used in each.
+	// Returns INVALID_ARGUMENT if user_id is malformed.
+	ListSessions(context.Context, *connect.Request[v1.ListSessionsRequest]) (*connect.Response[v1.ListSessionsResponse], error)
+	// RevokeSession logs a user out of one login session, so the device has
+	// to sign in again, and revokes the tokens of the clients used only in
+	// that session. Clients also used in other sessions keep their tokens;
+	// RevokeConsent revokes them everywhere.
+	// Returns INVALID_ARGUMENT if user_id or session_id is missing.
+	// Returns NOT_FOUND if the session doesn't exist or belongs to another user.
+	RevokeSession(context.Context, *connect.Request[v1.RevokeSessionRequest]) (*connect.Response[v1.RevokeSessionResponse], error)
+	// GetLoginHistory returns a user's latest sign-in attempts, newest first:
+	// completed logins and logins refused for a wrong password or code.
+	// page_size defaults to 20 and is capped at 100.
+	// Returns INVALID_ARGUMENT if user_id is malformed.
+	// Returns UNIMPLEMENTED if login history is disabled.
+	GetLoginHistory(context.Context, *connect.Request[v1.GetLoginHistoryRequest]) (*connect.Response[v1.GetLoginHistoryResponse], error)
+	// AddAddress adds a postal address to a user's address book (at most 10).
+	// The first address, or one added with default_shipping, becomes the
+	// default shipping address. Postal codes and phone numbers are
+	// normalized, e.g. "1000001" to "100-0001" in JP and "+81 90-1234-5678"
+	// to "+819012345678".
+	// Returns INVALID_ARGUMENT if a field is missing or malformed.
+	// Returns NOT_FOUND if the user doesn't exist.
+	// Returns RESOURCE_EXHAUSTED if the address book is full.
+	AddAddress(context.Context, *connect.Request[v1.AddAddressRequest]) (*connect.Response[v1.AddAddressResponse], error)
+	// ListAddresses returns a user's addresses, the default shipping address
+	// first, then newest first.
+	// Returns INVALID_ARGUMENT if user_id is malformed.
+	ListAddresses(context.Context, *connect.Request[v1.ListAddressesRequest]) (*connect.Response[v1.ListAddressesResponse], error)
+	// SetDefaultShippingAddress makes an address the user's default shipping
+	// address, replacing the previous one.
+	// Returns NOT_FOUND if the address doesn't exist or belongs to another user.
+	SetDefaultShippingAddress(context.Context, *connect.Request[v1.SetDefaultShippingAddressRequest]) (*connect.Response[v1.SetDefaultShippingAddressResponse], error)
+	// DeleteAddress removes an address. Deleting the default shipping address
+	// leaves the user without one until another is chosen.
+	// Returns NOT_FOUND if the address doesn't exist or belongs to another user.
+	DeleteAddress(context.Context, *connect.Request[v1.DeleteAddressRequest]) (*connect.Response[v1.DeleteAddressResponse], error)
+	// GetTwoFactorStatus reports whether sign-in requires a TOTP code.
+	// Returns UNIMPLEMENTED if two-factor authentication is disabled.
+	GetTwoFactorStatus(context.Context, *connect.Request[v1.GetTwoFactorStatusRequest]) (*connect.Response[v1.GetTwoFactorStatusResponse], error)
+	// EnrollTOTP generates a new TOTP secret for an authenticator app,
+	// replacing a pending enrollment. Sign-in is unaffected until ConfirmTOTP.
+	// Returns FAILED_PRECONDITION if two-factor authentication is already enabled.
+	// Returns UNIMPLEMENTED if two-factor authentication is disabled.
+	EnrollTOTP(context.Context, *connect.Request[v1.EnrollTOTPRequest]) (*connect.Response[v1.EnrollTOTPResponse], error)
+	// ConfirmTOTP enables two-factor authentication with a code from the
+	// authenticator app and returns single-use recovery codes. They are only
+	// returned here.
+	// Returns INVALID_ARGUMENT if the code is wrong.
+	// Returns NOT_FOUND if there is no enrollment.
+	// Returns FAILED_PRECONDITION if two-factor authentication is already enabled.
+	ConfirmTOTP(context.Context, *connect.Request[v1.ConfirmTOTPRequest]) (*connect.Response[v1.ConfirmTOTPResponse], error)
+	// DisableTOTP turns two-factor authentication off. It requires a current
+	// code or an unused recovery code.
+	// Returns INVALID_ARGUMENT if the code is wrong.
+	// Returns NOT_FOUND if there is no enrollment.
+	DisableTOTP(context.Context, *connect.Request[v1.DisableTOTPRequest]) (*connect.Response[v1.DisableTOTPResponse], error)
+	// ChangePassword replaces the password after checking the current one.
+	// The new password must meet the password policy and, if enabled, must
+	// not appear in known data breaches.
+	// Returns INVALID_ARGUMENT if the current password is wrong, the new one
+	// equals it or fails the policy (PASSWORD_TOO_SHORT, PASSWORD_TOO_WEAK,
+	// PASSWORD_BREACHED).
+	// Returns PERMISSION_DENIED (ACCOUNT_LOCKED) after too many wrong
+	// current passwords.
+	ChangePassword(context.Context, *connect.Request[v1.ChangePasswordRequest]) (*connect.Response[v1.ChangePasswordResponse], error)
+	// CreateAccessGrant gives a user one permission on top of their roles for
+	// duration_hours (1-72), e.g. to let a support agent act on a customer
+	// account. The BFF honors active grants when authorizing requests and
+	// tags actions taken under them in the security audit log.
+	// Returns NOT_FOUND if the grantee doesn't exist.
+	// Returns INVALID_ARGUMENT for an empty or non-grantable permission, a
+	// missing reason, an out-of-range duration or a grant to oneself.
+	CreateAccessGrant(context.Context, *connect.Request[v1.CreateAccessGrantRequest]) (*connect.Response[v1.CreateAccessGrantResponse], error)
+	// RevokeAccessGrant ends a grant before it expires.
+	// Returns NOT_FOUND if the grant doesn't exist or is already revoked.
+	RevokeAccessGrant(context.Context, *connect.Request[v1.RevokeAccessGrantRequest]) (*connect.Response[v1.RevokeAccessGrantResponse], error)
+	// ListAccessGrants returns the grants given to a user, newest first.
+	// Returns INVALID_ARGUMENT if user_id is malformed.
+	ListAccessGrants(context.Context, *connect.Request[v1.ListAccessGrantsRequest]) (*connect.Response[v1.ListAccessGrantsResponse], error)
+	// GetServerInfo returns the service version and the procedures and
+	// optional features it supports, so callers can adapt during mixed-version
+	// rollouts instead of failing with UNIMPLEMENTED.
+	GetServerInfo(context.Context, *connect.Request[v1.GetServerInfoRequest]) (*connect.Response[v1.GetServerInfoResponse], error)
 }
 
 // NewUserServiceHandler builds an HTTP handler from the service implementation. It returns the path
@@ -190,6 +885,7 @@ func NewUserServiceHandler(svc UserServiceHandler, opts ...connect.HandlerOption
 		UserServiceGetUserProcedure,
 		svc.GetUser,
 		connect.WithSchema(userServiceMethods.ByName("GetUser")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
 		connect.WithHandlerOptions(opts...),
 	)
 	userServiceUpdateUserHandler := connect.NewUnaryHandler(
@@ -204,12 +900,192 @@ func NewUserServiceHandler(svc UserServiceHandler, opts ...connect.HandlerOption
 		connect.WithSchema(userServiceMethods.ByName("DeleteUser")),
 		connect.WithHandlerOptions(opts...),
 	)
+	userServiceUnlockUserHandler := connect.NewUnaryHandler(
+		UserServiceUnlockUserProcedure,
+		svc.UnlockUser,
+		connect.WithSchema(userServiceMethods.ByName("UnlockUser")),
+		connect.WithHandlerOptions(opts...),
+	)
 	userServiceVerifyPasswordHandler := connect.NewUnaryHandler(
 		UserServiceVerifyPasswordProcedure,
 		svc.VerifyPassword,
 		connect.WithSchema(userServiceMethods.ByName("VerifyPassword")),
 		connect.WithHandlerOptions(opts...),
 	)
+	userServiceVerifyEmailHandler := connect.NewUnaryHandler(
+		UserServiceVerifyEmailProcedure,
+		svc.VerifyEmail,
+		connect.WithSchema(userServiceMethods.ByName("VerifyEmail")),
+		connect.WithHandlerOptions(opts...),
+	)
+	userServiceResendVerificationEmailHandler := connect.NewUnaryHandler(
+		UserServiceResendVerificationEmailProcedure,
+		svc.ResendVerificationEmail,
+		connect.WithSchema(userServiceMethods.ByName("ResendVerificationEmail")),
+		connect.WithHandlerOptions(opts...),
+	)
+	userServiceListUsersHandler := connect.NewUnaryHandler(
+		UserServiceListUsersProcedure,
+		svc.ListUsers,
+		connect.WithSchema(userServiceMethods.ByName("ListUsers")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	userServiceGetUserRolesHandler := connect.NewUnaryHandler(
+		UserServiceGetUserRolesProcedure,
+		svc.GetUserRoles,
+		connect.WithSchema(userServiceMethods.ByName("GetUserRoles")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	userServiceBatchDeactivateUsersHandler := connect.NewUnaryHandler(
+		UserServiceBatchDeactivateUsersProcedure,
+		svc.BatchDeactivateUsers,
+		connect.WithSchema(userServiceMethods.ByName("BatchDeactivateUsers")),
+		connect.WithHandlerOptions(opts...),
+	)
+	userServiceBatchAssignSegmentHandler := connect.NewUnaryHandler(
+		UserServiceBatchAssignSegmentProcedure,
+		svc.BatchAssignSegment,
+		connect.WithSchema(userServiceMethods.ByName("BatchAssignSegment")),
+		connect.WithHandlerOptions(opts...),
+	)
+	userServiceGetBatchJobHandler := connect.NewUnaryHandler(
+		UserServiceGetBatchJobProcedure,
+		svc.GetBatchJob,
+		connect.WithSchema(userServiceMethods.ByName("GetBatchJob")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	userServiceGetBatchJobReportHandler := connect.NewUnaryHandler(
+		UserServiceGetBatchJobReportProcedure,
+		svc.GetBatchJobReport,
+		connect.WithSchema(userServiceMethods.ByName("GetBatchJobReport")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	userServiceListConsentsHandler := connect.NewUnaryHandler(
+		UserServiceListConsentsProcedure,
+		svc.ListConsents,
+		connect.WithSchema(userServiceMethods.ByName("ListConsents")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	userServiceRevokeConsentHandler := connect.NewUnaryHandler(
+		UserServiceRevokeConsentProcedure,
+		svc.RevokeConsent,
+		connect.WithSchema(userServiceMethods.ByName("RevokeConsent")),
+		connect.WithHandlerOptions(opts...),
+	)
+	userServiceListConnectedAppsHandler := connect.NewUnaryHandler(
+		UserServiceListConnectedAppsProcedure,
+		svc.ListConnectedApps,
+		connect.WithSchema(userServiceMethods.ByName("ListConnectedApps")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	userServiceListSessionsHandler := connect.NewUnaryHandler(
+		UserServiceListSessionsProcedure,
+		svc.ListSessions,
+		connect.WithSchema(userServiceMethods.ByName("ListSessions")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	userServiceRevokeSessionHandler := connect.NewUnaryHandler(
+		UserServiceRevokeSessionProcedure,
+		svc.RevokeSession,
+		connect.WithSchema(userServiceMethods.ByName("RevokeSession")),
+		connect.WithHandlerOptions(opts...),
+	)
+	userServiceGetLoginHistoryHandler := connect.NewUnaryHandler(
+		UserServiceGetLoginHistoryProcedure,
+		svc.GetLoginHistory,
+		connect.WithSchema(userServiceMethods.ByName("GetLoginHistory")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	userServiceAddAddressHandler := connect.NewUnaryHandler(
+		UserServiceAddAddressProcedure,
+		svc.AddAddress,
+		connect.WithSchema(userServiceMethods.ByName("AddAddress")),
+		connect.WithHandlerOptions(opts...),
+	)
+	userServiceListAddressesHandler := connect.NewUnaryHandler(
+		UserServiceListAddressesProcedure,
+		svc.ListAddresses,
+		connect.WithSchema(userServiceMethods.ByName("ListAddresses")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	userServiceSetDefaultShippingAddressHandler := connect.NewUnaryHandler(
+		UserServiceSetDefaultShippingAddressProcedure,
+		svc.SetDefaultShippingAddress,
+		connect.WithSchema(userServiceMethods.ByName("SetDefaultShippingAddress")),
+		connect.WithHandlerOptions(opts...),
+	)
+	userServiceDeleteAddressHandler := connect.NewUnaryHandler(
+		UserServiceDeleteAddressProcedure,
+		svc.DeleteAddress,
+		connect.WithSchema(userServiceMethods.ByName("DeleteAddress")),
+		connect.WithHandlerOptions(opts...),
+	)
+	userServiceGetTwoFactorStatusHandler := connect.NewUnaryHandler(
+		UserServiceGetTwoFactorStatusProcedure,
+		svc.GetTwoFactorStatus,
+		connect.WithSchema(userServiceMethods.ByName("GetTwoFactorStatus")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	userServiceEnrollTOTPHandler := connect.NewUnaryHandler(
+		UserServiceEnrollTOTPProcedure,
+		svc.EnrollTOTP,
+		connect.WithSchema(userServiceMethods.ByName("EnrollTOTP")),
+		connect.WithHandlerOptions(opts...),
+	)
+	userServiceConfirmTOTPHandler := connect.NewUnaryHandler(
+		UserServiceConfirmTOTPProcedure,
+		svc.ConfirmTOTP,
+		connect.WithSchema(userServiceMethods.ByName("ConfirmTOTP")),
+		connect.WithHandlerOptions(opts...),
+	)
+	userServiceDisableTOTPHandler := connect.NewUnaryHandler(
+		UserServiceDisableTOTPProcedure,
+		svc.DisableTOTP,
+		connect.WithSchema(userServiceMethods.ByName("DisableTOTP")),
+		connect.WithHandlerOptions(opts...),
+	)
+	userServiceChangePasswordHandler := connect.NewUnaryHandler(
+		UserServiceChangePasswordProcedure,
+		svc.ChangePassword,
+		connect.WithSchema(userServiceMethods.ByName("ChangePassword")),
+		connect.WithHandlerOptions(opts...),
+	)
+	userServiceCreateAccessGrantHandler := connect.NewUnaryHandler(
+		UserServiceCreateAccessGrantProcedure,
+		svc.CreateAccessGrant,
+		connect.WithSchema(userServiceMethods.ByName("CreateAccessGrant")),
+		connect.WithHandlerOptions(opts...),
+	)
+	userServiceRevokeAccessGrantHandler := connect.NewUnaryHandler(
+		UserServiceRevokeAccessGrantProcedure,
+		svc.RevokeAccessGrant,
+		connect.WithSchema(userServiceMethods.ByName("RevokeAccessGrant")),
+		connect.WithHandlerOptions(opts...),
+	)
+	userServiceListAccessGrantsHandler := connect.NewUnaryHandler(
+		UserServiceListAccessGrantsProcedure,
+		svc.ListAccessGrants,
+		connect.WithSchema(userServiceMethods.ByName("ListAccessGrants")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	userServiceGetServerInfoHandler := connect.NewUnaryHandler(
+		UserServiceGetServerInfoProcedure,
+		svc.GetServerInfo,
+		connect.WithSchema(userServiceMethods.ByName("GetServerInfo")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/user.v1.UserService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case UserServiceCreateUserProcedure:
@@ -220,8 +1096,64 @@ func NewUserServiceHandler(svc UserServiceHandler, opts ...connect.HandlerOption
 			userServiceUpdateUserHandler.ServeHTTP(w, r)
 		case UserServiceDeleteUserProcedure:
 			userServiceDeleteUserHandler.ServeHTTP(w, r)
+		case UserServiceUnlockUserProcedure:
+			userServiceUnlockUserHandler.ServeHTTP(w, r)
 		case UserServiceVerifyPasswordProcedure:
 			userServiceVerifyPasswordHandler.ServeHTTP(w, r)
+		case UserServiceVerifyEmailProcedure:
+			userServiceVerifyEmailHandler.ServeHTTP(w, r)
+		case UserServiceResendVerificationEmailProcedure:
+			userServiceResendVerificationEmailHandler.ServeHTTP(w, r)
+		case UserServiceListUsersProcedure:
+			userServiceListUsersHandler.ServeHTTP(w, r)
+		case UserServiceGetUserRolesProcedure:
+			userServiceGetUserRolesHandler.ServeHTTP(w, r)
+		case UserServiceBatchDeactivateUsersProcedure:
+			userServiceBatchDeactivateUsersHandler.ServeHTTP(w, r)
+		case UserServiceBatchAssignSegmentProcedure:
+			userServiceBatchAssignSegmentHandler.ServeHTTP(w, r)
+		case UserServiceGetBatchJobProcedure:
+			userServiceGetBatchJobHandler.ServeHTTP(w, r)
+		case UserServiceGetBatchJobReportProcedure:
+			userServiceGetBatchJobReportHandler.ServeHTTP(w, r)
+		case UserServiceListConsentsProcedure:
+			userServiceListConsentsHandler.ServeHTTP(w, r)
+		case UserServiceRevokeConsentProcedure:
+			userServiceRevokeConsentHandler.ServeHTTP(w, r)
+		case UserServiceListConnectedAppsProcedure:
+			userServiceListConnectedAppsHandler.ServeHTTP(w, r)
+		case UserServiceListSessionsProcedure:
+			userServiceListSessionsHandler.ServeHTTP(w, r)
+		case UserServiceRevokeSessionProcedure:
+			userServiceRevokeSessionHandler.ServeHTTP(w, r)
+		case UserServiceGetLoginHistoryProcedure:
+			userServiceGetLoginHistoryHandler.ServeHTTP(w, r)
+		case UserServiceAddAddressProcedure:
+			userServiceAddAddressHandler.ServeHTTP(w, r)
+		case UserServiceListAddressesProcedure:
+			userServiceListAddressesHandler.ServeHTTP(w, r)
+		case UserServiceSetDefaultShippingAddressProcedure:
+			userServiceSetDefaultShippingAddressHandler.ServeHTTP(w, r)
+		case UserServiceDeleteAddressProcedure:
+			userServiceDeleteAddressHandler.ServeHTTP(w, r)
+		case UserServiceGetTwoFactorStatusProcedure:
+			userServiceGetTwoFactorStatusHandler.ServeHTTP(w, r)
+		case UserServiceEnrollTOTPProcedure:
+			userServiceEnrollTOTPHandler.ServeHTTP(w, r)
+		case UserServiceConfirmTOTPProcedure:
+			userServiceConfirmTOTPHandler.ServeHTTP(w, r)
+		case UserServiceDisableTOTPProcedure:
+			userServiceDisableTOTPHandler.ServeHTTP(w, r)
+		case UserServiceChangePasswordProcedure:
+			userServiceChangePasswordHandler.ServeHTTP(w, r)
+		case UserServiceCreateAccessGrantProcedure:
+			userServiceCreateAccessGrantHandler.ServeHTTP(w, r)
+		case UserServiceRevokeAccessGrantProcedure:
+			userServiceRevokeAccessGrantHandler.ServeHTTP(w, r)
+		case UserServiceListAccessGrantsProcedure:
+			userServiceListAccessGrantsHandler.ServeHTTP(w, r)
+		case UserServiceGetServerInfoProcedure:
+			userServiceGetServerInfoHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -247,6 +1179,118 @@ func (UnimplementedUserServiceHandler) DeleteUser(context.Context, *connect.Requ
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("user.v1.UserService.DeleteUser is not implemented"))
 }
 
+func (UnimplementedUserServiceHandler) UnlockUser(context.Context, *connect.Request[v1.UnlockUserRequest]) (*connect.Response[v1.UnlockUserResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("user.v1.UserService.UnlockUser is not implemented"))
+}
+
 func (UnimplementedUserServiceHandler) VerifyPassword(context.Context, *connect.Request[v1.VerifyPasswordRequest]) (*connect.Response[v1.VerifyPasswordResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("user.v1.UserService.VerifyPassword is not implemented"))
 }
+
+func (UnimplementedUserServiceHandler) VerifyEmail(context.Context, *connect.Request[v1.VerifyEmailRequest]) (*connect.Response[v1.VerifyEmailResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("user.v1.UserService.VerifyEmail is not implemented"))
+}
+
+func (UnimplementedUserServiceHandler) ResendVerificationEmail(context.Context, *connect.Request[v1.ResendVerificationEmailRequest]) (*connect.Response[v1.ResendVerificationEmailResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("user.v1.UserService.ResendVerificationEmail is not implemented"))
+}
+
+func (UnimplementedUserServiceHandler) ListUsers(context.Context, *connect.Request[v1.ListUsersRequest]) (*connect.Response[v1.ListUsersResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("user.v1.UserService.ListUsers is not implemented"))
+}
+
+func (UnimplementedUserServiceHandler) GetUserRoles(context.Context, *connect.Request[v1.GetUserRolesRequest]) (*connect.Response[v1.GetUserRolesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("user.v1.UserService.GetUserRoles is not implemented"))
+}
+
+func (UnimplementedUserServiceHandler) BatchDeactivateUsers(context.Context, *connect.Request[v1.BatchDeactivateUsersRequest]) (*connect.Response[v1.BatchDeactivateUsersResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("user.v1.UserService.BatchDeactivateUsers is not implemented"))
+}
+
+func (UnimplementedUserServiceHandler) BatchAssignSegment(context.Context, *connect.Request[v1.BatchAssignSegmentRequest]) (*connect.Response[v1.BatchAssignSegmentResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("user.v1.UserService.BatchAssignSegment is not implemented"))
+}
+
+func (UnimplementedUserServiceHandler) GetBatchJob(context.Context, *connect.Request[v1.GetBatchJobRequest]) (*connect.Response[v1.GetBatchJobResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("user.v1.UserService.GetBatchJob is not implemented"))
+}
+
+func (UnimplementedUserServiceHandler) GetBatchJobReport(context.Context, *connect.Request[v1.GetBatchJobReportRequest]) (*connect.Response[v1.GetBatchJobReportResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("user.v1.UserService.GetBatchJobReport is not implemented"))
+}
+
+func (UnimplementedUserServiceHandler) ListConsents(context.Context, *connect.Request[v1.ListConsentsRequest]) (*connect.Response[v1.ListConsentsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("user.v1.UserService.ListConsents is not implemented"))
+}
+
+func (UnimplementedUserServiceHandler) RevokeConsent(context.Context, *connect.Request[v1.RevokeConsentRequest]) (*connect.Response[v1.RevokeConsentResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("user.v1.UserService.RevokeConsent is not implemented"))
+}
+
+func (UnimplementedUserServiceHandler) ListConnectedApps(context.Context, *connect.Request[v1.ListConnectedAppsRequest]) (*connect.Response[v1.ListConnectedAppsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("user.v1.UserService.ListConnectedApps is not implemented"))
+}
+
+func (UnimplementedUserServiceHandler) ListSessions(context.Context, *connect.Request[v1.ListSessionsRequest]) (*connect.Response[v1.ListSessionsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("user.v1.UserService.ListSessions is not implemented"))
+}
+
+func (UnimplementedUserServiceHandler) RevokeSession(context.Context, *connect.Request[v1.RevokeSessionRequest]) (*connect.Response[v1.RevokeSessionResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("user.v1.UserService.RevokeSession is not implemented"))
+}
+
+func (UnimplementedUserServiceHandler) GetLoginHistory(context.Context, *connect.Request[v1.GetLoginHistoryRequest]) (*connect.Response[v1.GetLoginHistoryResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("user.v1.UserService.GetLoginHistory is not implemented"))
+}
+
+func (UnimplementedUserServiceHandler) AddAddress(context.Context, *connect.Request[v1.AddAddressRequest]) (*connect.Response[v1.AddAddressResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("user.v1.UserService.AddAddress is not implemented"))
+}
+
+func (UnimplementedUserServiceHandler) ListAddresses(context.Context, *connect.Request[v1.ListAddressesRequest]) (*connect.Response[v1.ListAddressesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("user.v1.UserService.ListAddresses is not implemented"))
+}
+
+func (UnimplementedUserServiceHandler) SetDefaultShippingAddress(context.Context, *connect.Request[v1.SetDefaultShippingAddressRequest]) (*connect.Response[v1.SetDefaultShippingAddressResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("user.v1.UserService.SetDefaultShippingAddress is not implemented"))
+}
+
+func (UnimplementedUserServiceHandler) DeleteAddress(context.Context, *connect.Request[v1.DeleteAddressRequest]) (*connect.Response[v1.DeleteAddressResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("user.v1.UserService.DeleteAddress is not implemented"))
+}
+
+func (UnimplementedUserServiceHandler) GetTwoFactorStatus(context.Context, *connect.Request[v1.GetTwoFactorStatusRequest]) (*connect.Response[v1.GetTwoFactorStatusResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("user.v1.UserService.GetTwoFactorStatus is not implemented"))
+}
+
+func (UnimplementedUserServiceHandler) EnrollTOTP(context.Context, *connect.Request[v1.EnrollTOTPRequest]) (*connect.Response[v1.EnrollTOTPResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("user.v1.UserService.EnrollTOTP is not implemented"))
+}
+
+func (UnimplementedUserServiceHandler) ConfirmTOTP(context.Context, *connect.Request[v1.ConfirmTOTPRequest]) (*connect.Response[v1.ConfirmTOTPResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("user.v1.UserService.ConfirmTOTP is not implemented"))
+}
+
+func (UnimplementedUserServiceHandler) DisableTOTP(context.Context, *connect.Request[v1.DisableTOTPRequest]) (*connect.Response[v1.DisableTOTPResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("user.v1.UserService.DisableTOTP is not implemented"))
+}
+
+func (UnimplementedUserServiceHandler) ChangePassword(context.Context, *connect.Request[v1.ChangePasswordRequest]) (*connect.Response[v1.ChangePasswordResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("user.v1.UserService.ChangePassword is not implemented"))
+}
+
+func (UnimplementedUserServiceHandler) CreateAccessGrant(context.Context, *connect.Request[v1.CreateAccessGrantRequest]) (*connect.Response[v1.CreateAccessGrantResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("user.v1.UserService.CreateAccessGrant is not implemented"))
+}
+
+func (UnimplementedUserServiceHandler) RevokeAccessGrant(context.Context, *connect.Request[v1.RevokeAccessGrantRequest]) (*connect.Response[v1.RevokeAccessGrantResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("user.v1.UserService.RevokeAccessGrant is not implemented"))
+}
+
+func (UnimplementedUserServiceHandler) ListAccessGrants(context.Context, *connect.Request[v1.ListAccessGrantsRequest]) (*connect.Response[v1.ListAccessGrantsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("user.v1.UserService.ListAccessGrants is not implemented"))
+}
+
+func (UnimplementedUserServiceHandler) GetServerInfo(context.Context, *connect.Request[v1.GetServerInfoRequest]) (*connect.Response[v1.GetServerInfoResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("user.v1.UserService.GetServerInfo is not implemented"))
+}
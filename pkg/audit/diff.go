@@ -0,0 +1,99 @@
+package audit
+
+import (
+	"bytes"
+	"encoding/json"
+	"slices"
+	"strings"
+
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+// redacted replaces the value of redacted fields.
+var redacted = json.RawMessage(`"[REDACTED]"`)
+
+var marshalOptions = protojson.MarshalOptions{UseProtoNames: true}
+
+// Diff returns the top-level fields that differ between two snapshots of
+// an entity, in field name order. before is nil for a created entity, after
+// for a deleted one. Fields named in redact get a placeholder value at any
+// depth, so a changed secret shows up without its value.
+func Diff(entityID string, before, after proto.Message, redact []string) ([]Change, error) {
+	beforeFields, err := fields(before, redact)
+	if err != nil {
+		return nil, err
+	}
+	afterFields, err := fields(after, redact)
+	if err != nil {
+		return nil, err
+	}
+
+	names := make([]string, 0, len(beforeFields)+len(afterFields))
+	for name := range beforeFields {
+		names = append(names, name)
+	}
+	for name := range afterFields {
+		if _, ok := beforeFields[name]; !ok {
+			names = append(names, name)
+		}
+	}
+	slices.Sort(names)
+
+	var changes []Change
+	for _, name := range names {
+		b, a := beforeFields[name], afterFields[name]
+		if bytes.Equal(b, a) {
+			continue
+		}
+		changes = append(changes, Change{EntityID: entityID, Field: name, Before: b, After: a})
+	}
+	return changes, nil
+}
+
+// Redact returns msg as JSON with the fields named in redact replaced.
+func Redact(msg proto.Message, redact []string) (json.RawMessage, error) {
+	raw, err := marshalOptions.Marshal(msg)
+	if err != nil {
+		return nil, err
+	}
+	var v any
+	if err := json.Unmarshal(raw, &v); err != nil {
+		return nil, err
+	}
+	return json.Marshal(redactValue(v, redact))
+}
+
+// fields returns the compact JSON of each populated top-level field of msg.
+func fields(msg proto.Message, redact []string) (map[string]json.RawMessage, error) {
+	if msg == nil {
+		return nil, nil
+	}
+	raw, err := Redact(msg, redact)
+	if err != nil {
+		return nil, err
+	}
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(raw, &fields); err != nil {
+		return nil, err
+	}
+	return fields, nil
+}
+
+func redactValue(v any, redact []string) any {
+	switch v := v.(type) {
+	case map[string]any:
+		for key, value := range v {
+			if slices.ContainsFunc(redact, func(name string) bool { return strings.EqualFold(name, key) }) {
+				v[key] = redacted
+				continue
+			}
+			v[key] = redactValue(value, redact)
+		}
+	case []any:
+		for i, value := range v {
+			v[i] = redactValue(value, redact)
+		}
+	}
+	return v
+}
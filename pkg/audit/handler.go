@@ -0,0 +1,151 @@
+package audit
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"time"
+
+	"connectrpc.com/connect"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	auditv1 "github.com/daisuke8000/example-ec-platform/gen/audit/v1"
+	"github.com/daisuke8000/example-ec-platform/gen/audit/v1/auditv1connect"
+	"github.com/daisuke8000/example-ec-platform/pkg/listing"
+)
+
+const (
+	defaultPageSize = 20
+	maxPageSize     = 100
+)
+
+var _ auditv1connect.AuditServiceHandler = (*Handler)(nil)
+
+// Handler serves audit.v1.AuditService over a Store.
+type Handler struct {
+	auditv1connect.UnimplementedAuditServiceHandler
+	store      Store
+	pageTokens *listing.Codec
+	logger     *slog.Logger
+}
+
+func NewHandler(store Store, pageTokens *listing.Codec, logger *slog.Logger) *Handler {
+	return &Handler{
+		store:      store,
+		pageTokens: pageTokens,
+		logger:     logger,
+	}
+}
+
+func (h *Handler) ListAuditEntries(
+	ctx context.Context,
+	req *connect.Request[auditv1.ListAuditEntriesRequest],
+) (*connect.Response[auditv1.ListAuditEntriesResponse], error) {
+	pageSize := int(req.Msg.GetPageSize())
+	if pageSize <= 0 {
+		pageSize = defaultPageSize
+	}
+	pageSize = min(pageSize, maxPageSize)
+
+	filter := ListFilter{
+		Actor:      req.Msg.GetActor(),
+		EntityType: req.Msg.GetEntityType(),
+		EntityID:   req.Msg.GetEntityId(),
+		Method:     req.Msg.GetMethod(),
+	}
+	var createdAfter, createdBefore string
+	if req.Msg.CreatedAfter != nil {
+		t := req.Msg.GetCreatedAfter().AsTime()
+		filter.CreatedAfter = &t
+		createdAfter = t.Format(time.RFC3339Nano)
+	}
+	if req.Msg.CreatedBefore != nil {
+		t := req.Msg.GetCreatedBefore().AsTime()
+		filter.CreatedBefore = &t
+		createdBefore = t.Format(time.RFC3339Nano)
+	}
+	if filter.CreatedAfter != nil && filter.CreatedBefore != nil && !filter.CreatedAfter.Before(*filter.CreatedBefore) {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("created_after must be before created_before"))
+	}
+	query := listing.QueryKey(nil, nil, filter.Actor, filter.EntityType, filter.EntityID, filter.Method, createdAfter, createdBefore)
+
+	var after *Cursor
+	if req.Msg.GetPageToken() != "" {
+		after = &Cursor{}
+		if err := h.pageTokens.Decode(req.Msg.GetPageToken(), query, after); err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		}
+	}
+
+	// Fetch one extra row to know whether another page exists.
+	entries, err := h.store.List(ctx, filter, after, pageSize+1)
+	if err != nil {
+		return nil, h.mapError(ctx, err)
+	}
+
+	resp := &auditv1.ListAuditEntriesResponse{}
+	if len(entries) > pageSize {
+		entries = entries[:pageSize]
+		last := entries[len(entries)-1]
+		resp.NextPageToken, err = h.pageTokens.Encode(query, Cursor{CreatedAt: last.CreatedAt, ID: last.ID})
+		if err != nil {
+			return nil, h.mapError(ctx, err)
+		}
+	}
+	for _, e := range entries {
+		resp.Entries = append(resp.Entries, ToProto(e))
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (h *Handler) mapError(ctx context.Context, err error) error {
+	h.logger.ErrorContext(ctx, "audit store error", slog.String("error", err.Error()))
+	return connect.NewError(connect.CodeInternal, errors.New("internal server error"))
+}
+
+// ToProto converts an entry to its API representation.
+func ToProto(e *Entry) *auditv1.AuditEntry {
+	pb := &auditv1.AuditEntry{
+		Id:         e.ID.String(),
+		Actor:      e.Actor,
+		Method:     e.Method,
+		EntityType: e.EntityType,
+		EntityIds:  e.EntityIDs,
+		Request:    toStruct(e.Request),
+		RequestId:  e.RequestID,
+		CreatedAt:  timestamppb.New(e.CreatedAt),
+	}
+	for _, c := range e.Changes {
+		pb.Changes = append(pb.Changes, &auditv1.FieldChange{
+			EntityId: c.EntityID,
+			Field:    c.Field,
+			Before:   toValue(c.Before),
+			After:    toValue(c.After),
+		})
+	}
+	return pb
+}
+
+func toStruct(raw []byte) *structpb.Struct {
+	if len(raw) == 0 {
+		return nil
+	}
+	s := &structpb.Struct{}
+	if err := protojson.Unmarshal(raw, s); err != nil {
+		return nil
+	}
+	return s
+}
+
+func toValue(raw []byte) *structpb.Value {
+	if len(raw) == 0 {
+		return nil
+	}
+	v := &structpb.Value{}
+	if err := protojson.Unmarshal(raw, v); err != nil {
+		return nil
+	}
+	return v
+}
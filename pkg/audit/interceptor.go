@@ -0,0 +1,179 @@
+package audit
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"slices"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/proto"
+
+	pkgmw "github.com/daisuke8000/example-ec-platform/pkg/connect/middleware"
+)
+
+// Target describes how calls of an audited procedure are recorded.
+type Target struct {
+	// EntityType names the entities the procedure changes, e.g. "product".
+	EntityType string
+
+	// EntityIDs returns the IDs of the affected entities from the request
+	// and response messages. It is called with a nil response before the
+	// handler runs, to take the before snapshots; created entities only
+	// have an ID in the response.
+	EntityIDs func(req, resp any) []string
+
+	// Snapshot returns the current state of an entity, or nil if it does
+	// not exist. Without it entries carry the request but no diff.
+	Snapshot func(ctx context.Context, id string) (proto.Message, error)
+
+	// OwnerScoped skips calls on the caller's own entity (e.g. a user
+	// updating their profile), which are not administrative.
+	OwnerScoped bool
+}
+
+// RequestID returns an EntityIDs func reading the ID of the one affected
+// entity from requests of type Req.
+func RequestID[Req any](id func(*Req) string) func(req, resp any) []string {
+	return func(req, _ any) []string {
+		if r, ok := req.(*Req); ok && id(r) != "" {
+			return []string{id(r)}
+		}
+		return nil
+	}
+}
+
+// ResponseID returns an EntityIDs func reading the ID of a created entity
+// from responses of type Resp.
+func ResponseID[Resp any](id func(*Resp) string) func(req, resp any) []string {
+	return func(_, resp any) []string {
+		if r, ok := resp.(*Resp); ok && id(r) != "" {
+			return []string{id(r)}
+		}
+		return nil
+	}
+}
+
+// Config configures the audit interceptor.
+type Config struct {
+	// Targets maps full procedure names to how their calls are recorded.
+	// Other procedures are not audited.
+	Targets map[string]Target
+
+	// Redact names fields, at any depth, whose values are never recorded,
+	// e.g. "password".
+	Redact []string
+}
+
+// Interceptor returns an interceptor that records every successful call of
+// the configured procedures. Failed calls changed nothing and are not
+// recorded. It must run after the propagator interceptor, which puts the
+// caller's user ID into the context, and after idempotent replays, which do
+// not mutate anything again.
+//
+// Recording failures are logged and do not fail the call: the mutation has
+// already been committed.
+func Interceptor(store Store, cfg Config, logger *slog.Logger) connect.UnaryInterceptorFunc {
+	return func(next connect.UnaryFunc) connect.UnaryFunc {
+		return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+			target, ok := cfg.Targets[req.Spec().Procedure]
+			if !ok {
+				return next(ctx, req)
+			}
+
+			// Validation-only calls change nothing.
+			if v, ok := req.Any().(interface{ GetValidateOnly() bool }); ok && v.GetValidateOnly() {
+				return next(ctx, req)
+			}
+
+			actor := pkgmw.GetUserID(ctx)
+			ids := target.EntityIDs(req.Any(), nil)
+			if target.OwnerScoped && actor != "" && slices.Equal(ids, []string{actor}) {
+				return next(ctx, req)
+			}
+			before := snapshots(ctx, target, ids, logger)
+
+			resp, err := next(ctx, req)
+			if err != nil {
+				return resp, err
+			}
+
+			entry := &Entry{
+				ID:         uuid.New(),
+				Actor:      actor,
+				Method:     req.Spec().Procedure,
+				EntityType: target.EntityType,
+				EntityIDs:  target.EntityIDs(req.Any(), resp.Any()),
+				RequestID:  pkgmw.GetRequestID(ctx),
+				CreatedAt:  time.Now().UTC(),
+			}
+			// The request has been served; record even if it was cancelled
+			// meanwhile.
+			recordCtx := context.WithoutCancel(ctx)
+			after := snapshots(recordCtx, target, entry.EntityIDs, logger)
+			if err := entry.fill(req.Any(), before, after, cfg.Redact); err != nil {
+				logger.ErrorContext(ctx, "failed to build audit entry",
+					slog.String("method", entry.Method),
+					slog.String("error", err.Error()),
+				)
+			}
+			if err := store.Record(recordCtx, entry); err != nil {
+				logger.ErrorContext(ctx, "failed to record audit entry",
+					slog.String("method", entry.Method),
+					slog.String("actor", entry.Actor),
+					slog.String("error", err.Error()),
+				)
+			}
+			return resp, nil
+		}
+	}
+}
+
+// snapshots returns the state of each entity by ID. A failed snapshot is
+// logged and treated as absent, so the entry is still recorded.
+func snapshots(ctx context.Context, target Target, ids []string, logger *slog.Logger) map[string]proto.Message {
+	if target.Snapshot == nil {
+		return nil
+	}
+	states := make(map[string]proto.Message, len(ids))
+	for _, id := range ids {
+		state, err := target.Snapshot(ctx, id)
+		if err != nil {
+			logger.WarnContext(ctx, "failed to snapshot audited entity",
+				slog.String("entity_type", target.EntityType),
+				slog.String("entity_id", id),
+				slog.String("error", err.Error()),
+			)
+			continue
+		}
+		states[id] = state
+	}
+	return states
+}
+
+func (e *Entry) fill(req any, before, after map[string]proto.Message, redact []string) error {
+	var errs []error
+	if msg, ok := req.(proto.Message); ok {
+		raw, err := Redact(msg, redact)
+		errs = append(errs, err)
+		e.Request = raw
+	}
+	if before == nil && after == nil {
+		return errors.Join(errs...)
+	}
+
+	ids := slices.Clone(e.EntityIDs)
+	for id := range before {
+		if !slices.Contains(ids, id) {
+			ids = append(ids, id)
+		}
+	}
+	for _, id := range ids {
+		changes, err := Diff(id, before[id], after[id], redact)
+		errs = append(errs, err)
+		e.Changes = append(e.Changes, changes...)
+	}
+	return errors.Join(errs...)
+}
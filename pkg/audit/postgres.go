@@ -0,0 +1,143 @@
+package audit
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+const entryColumns = `id, actor, method, entity_type, entity_ids, changes, request, request_id, created_at`
+
+// PostgresStore implements Store using PostgreSQL.
+type PostgresStore struct {
+	pool  *pgxpool.Pool
+	table string
+}
+
+// NewPostgresStore creates a store over table, a schema-qualified name such
+// as "user_service.audit_log". See deployments/init-db.sql for the schema.
+func NewPostgresStore(pool *pgxpool.Pool, table string) *PostgresStore {
+	return &PostgresStore{pool: pool, table: table}
+}
+
+// Record appends an entry to the log.
+func (s *PostgresStore) Record(ctx context.Context, entry *Entry) error {
+	query := fmt.Sprintf(`
+		INSERT INTO %s (%s)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+	`, s.table, entryColumns)
+
+	changes := []byte("[]")
+	if len(entry.Changes) > 0 {
+		var err error
+		if changes, err = json.Marshal(entry.Changes); err != nil {
+			return err
+		}
+	}
+	_, err := s.pool.Exec(ctx, query,
+		entry.ID,
+		entry.Actor,
+		entry.Method,
+		entry.EntityType,
+		entry.EntityIDs,
+		changes,
+		[]byte(entry.Request),
+		entry.RequestID,
+		entry.CreatedAt,
+	)
+	return err
+}
+
+// List returns entries matching filter, newest first, using keyset
+// pagination on (created_at, id).
+func (s *PostgresStore) List(ctx context.Context, filter ListFilter, after *Cursor, limit int) ([]*Entry, error) {
+	var (
+		conditions []string
+		args       []any
+	)
+	addCondition := func(format string, values ...any) {
+		placeholders := make([]any, len(values))
+		for i, v := range values {
+			args = append(args, v)
+			placeholders[i] = len(args)
+		}
+		conditions = append(conditions, fmt.Sprintf(format, placeholders...))
+	}
+
+	if filter.Actor != "" {
+		addCondition("actor = $%d", filter.Actor)
+	}
+	if filter.EntityType != "" {
+		addCondition("entity_type = $%d", filter.EntityType)
+	}
+	if filter.EntityID != "" {
+		addCondition("entity_ids @> ARRAY[$%d]::TEXT[]", filter.EntityID)
+	}
+	if filter.Method != "" {
+		addCondition("method = $%d", filter.Method)
+	}
+	if filter.CreatedAfter != nil {
+		addCondition("created_at >= $%d", *filter.CreatedAfter)
+	}
+	if filter.CreatedBefore != nil {
+		addCondition("created_at < $%d", *filter.CreatedBefore)
+	}
+	if after != nil {
+		addCondition("(created_at, id) < ($%d, $%d)", after.CreatedAt, after.ID)
+	}
+
+	where := ""
+	if len(conditions) > 0 {
+		where = "WHERE " + strings.Join(conditions, " AND ")
+	}
+	args = append(args, limit)
+	query := fmt.Sprintf(`SELECT %s FROM %s %s ORDER BY created_at DESC, id DESC LIMIT $%d`,
+		entryColumns, s.table, where, len(args))
+
+	rows, err := s.pool.Query(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var entries []*Entry
+	for rows.Next() {
+		entry, err := scanEntry(rows)
+		if err != nil {
+			return nil, err
+		}
+		entries = append(entries, entry)
+	}
+	return entries, rows.Err()
+}
+
+func scanEntry(row pgx.Row) (*Entry, error) {
+	var (
+		entry            Entry
+		changes, request []byte
+	)
+	err := row.Scan(
+		&entry.ID,
+		&entry.Actor,
+		&entry.Method,
+		&entry.EntityType,
+		&entry.EntityIDs,
+		&changes,
+		&request,
+		&entry.RequestID,
+		&entry.CreatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := json.Unmarshal(changes, &entry.Changes); err != nil {
+		return nil, err
+	}
+	entry.Request = request
+	return &entry, nil
+}
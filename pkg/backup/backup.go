@@ -0,0 +1,240 @@
+// Package backup exports the schema of a service with pg_dump into object
+// storage, applies a retention policy to the exports and restores them
+// with pg_restore. pg_dump and pg_restore must be on PATH, in a version no
+// older than the server's.
+package backup
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/url"
+	"os"
+	"os/exec"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/daisuke8000/example-ec-platform/pkg/objectstore"
+)
+
+// keyTimeFormat is the timestamp in backup keys; it sorts chronologically.
+const keyTimeFormat = "20060102T150405Z"
+
+// ErrNoBackups is returned when a schema has no stored backups.
+var ErrNoBackups = errors.New("no backups found")
+
+// Backup is a logical export stored in object storage.
+type Backup struct {
+	Key       string    `json:"key"`
+	Schema    string    `json:"schema"`
+	Size      int64     `json:"size_bytes"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// Retention decides which backups are deleted after an export. The
+// KeepLast newest backups are always kept; older ones are deleted once
+// they are older than MaxAge. A zero MaxAge keeps everything.
+type Retention struct {
+	KeepLast int
+	MaxAge   time.Duration
+}
+
+// Manager exports and restores one schema.
+type Manager struct {
+	store       objectstore.Store
+	databaseURL string
+	schema      string
+	retention   Retention
+	logger      *slog.Logger
+}
+
+func NewManager(store objectstore.Store, databaseURL, schema string, retention Retention, logger *slog.Logger) *Manager {
+	return &Manager{
+		store:       store,
+		databaseURL: databaseURL,
+		schema:      schema,
+		retention:   retention,
+		logger:      logger,
+	}
+}
+
+// Schema returns the schema the manager backs up.
+func (m *Manager) Schema() string {
+	return m.schema
+}
+
+// Export dumps the schema in pg_dump's custom format and uploads it. The
+// dump is staged in a temporary file because object storage needs the
+// size up front.
+func (m *Manager) Export(ctx context.Context) (*Backup, error) {
+	tmp, err := os.CreateTemp("", m.schema+"-*.dump")
+	if err != nil {
+		return nil, err
+	}
+	defer os.Remove(tmp.Name())
+	defer tmp.Close()
+
+	createdAt := time.Now().UTC()
+	if err := run(ctx, m.databaseURL, "pg_dump",
+		"--format=custom",
+		"--no-owner",
+		"--no-privileges",
+		"--schema="+m.schema,
+		"--file="+tmp.Name(),
+	); err != nil {
+		return nil, err
+	}
+
+	info, err := tmp.Stat()
+	if err != nil {
+		return nil, err
+	}
+	b := &Backup{
+		Key:       fmt.Sprintf("%s/%s-%s.dump", m.schema, m.schema, createdAt.Format(keyTimeFormat)),
+		Schema:    m.schema,
+		Size:      info.Size(),
+		CreatedAt: createdAt,
+	}
+	if err := m.store.Put(ctx, b.Key, tmp, b.Size, "application/octet-stream"); err != nil {
+		return nil, fmt.Errorf("upload backup: %w", err)
+	}
+
+	m.logger.Info("backup exported", slog.String("key", b.Key), slog.Int64("size_bytes", b.Size))
+	return b, nil
+}
+
+// List returns the stored backups of the schema, newest first. Objects
+// not named like an export are ignored.
+func (m *Manager) List(ctx context.Context) ([]Backup, error) {
+	prefix := m.schema + "/" + m.schema + "-"
+	objects, err := m.store.List(ctx, prefix)
+	if err != nil {
+		return nil, err
+	}
+
+	var backups []Backup
+	for _, o := range objects {
+		stamp, ok := strings.CutSuffix(strings.TrimPrefix(o.Key, prefix), ".dump")
+		if !ok {
+			continue
+		}
+		createdAt, err := time.Parse(keyTimeFormat, stamp)
+		if err != nil {
+			continue
+		}
+		backups = append(backups, Backup{Key: o.Key, Schema: m.schema, Size: o.Size, CreatedAt: createdAt})
+	}
+	sort.Slice(backups, func(i, j int) bool { return backups[i].CreatedAt.After(backups[j].CreatedAt) })
+	return backups, nil
+}
+
+// Latest returns the newest backup of the schema.
+func (m *Manager) Latest(ctx context.Context) (*Backup, error) {
+	backups, err := m.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(backups) == 0 {
+		return nil, ErrNoBackups
+	}
+	return &backups[0], nil
+}
+
+// Prune deletes the backups outside the retention policy and returns them.
+func (m *Manager) Prune(ctx context.Context) ([]Backup, error) {
+	if m.retention.MaxAge <= 0 {
+		return nil, nil
+	}
+	backups, err := m.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	cutoff := time.Now().Add(-m.retention.MaxAge)
+	var deleted []Backup
+	for i, b := range backups {
+		if i < m.retention.KeepLast || b.CreatedAt.After(cutoff) {
+			continue
+		}
+		if err := m.store.Delete(ctx, b.Key); err != nil {
+			return deleted, fmt.Errorf("delete backup %s: %w", b.Key, err)
+		}
+		m.logger.Info("backup deleted by retention", slog.String("key", b.Key))
+		deleted = append(deleted, b)
+	}
+	return deleted, nil
+}
+
+// Restore downloads a backup and restores it into the database at
+// databaseURL. Objects of the schema that already exist there are dropped
+// first, so the target should be a fresh database or one being replaced.
+func (m *Manager) Restore(ctx context.Context, key, databaseURL string) error {
+	body, err := m.store.Get(ctx, key)
+	if err != nil {
+		return fmt.Errorf("download backup %s: %w", key, err)
+	}
+	defer body.Close()
+
+	tmp, err := os.CreateTemp("", m.schema+"-*.dump")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+	defer tmp.Close()
+
+	if _, err := tmp.ReadFrom(body); err != nil {
+		return fmt.Errorf("download backup %s: %w", key, err)
+	}
+
+	if err := run(ctx, databaseURL, "pg_restore",
+		"--clean",
+		"--if-exists",
+		"--no-owner",
+		"--no-privileges",
+		"--exit-on-error",
+		"--single-transaction",
+		tmp.Name(),
+	); err != nil {
+		return err
+	}
+
+	m.logger.Info("backup restored", slog.String("key", key))
+	return nil
+}
+
+// run executes a PostgreSQL client tool against databaseURL, returning its
+// stderr on failure. The password is passed in PGPASSWORD rather than on
+// the command line, where other users of the host could read it.
+func run(ctx context.Context, databaseURL, name string, args ...string) error {
+	dsn, password, err := splitPassword(databaseURL)
+	if err != nil {
+		return err
+	}
+
+	var stderr strings.Builder
+	cmd := exec.CommandContext(ctx, name, append([]string{"--dbname=" + dsn}, args...)...)
+	cmd.Env = os.Environ()
+	if password != "" {
+		cmd.Env = append(cmd.Env, "PGPASSWORD="+password)
+	}
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		return fmt.Errorf("%s: %w: %s", name, err, strings.TrimSpace(stderr.String()))
+	}
+	return nil
+}
+
+// splitPassword removes the password from a postgres:// URL.
+func splitPassword(databaseURL string) (dsn, password string, err error) {
+	u, err := url.Parse(databaseURL)
+	if err != nil || (u.Scheme != "postgres" && u.Scheme != "postgresql") {
+		return "", "", errors.New("database URL must be a postgres:// URL")
+	}
+	if u.User != nil {
+		password, _ = u.User.Password()
+		u.User = url.User(u.User.Username())
+	}
+	return u.String(), password, nil
+}
@@ -0,0 +1,174 @@
+// Command backup exports, lists, prunes and restores logical backups of a
+// service schema. Storage is configured through the same BACKUP_*
+// variables as the services:
+//
+//	backup -schema user_service export
+//	backup -schema user_service list
+//	backup -schema user_service prune
+//	backup -schema user_service -key <key> -target-url <url> -confirm <db> restore
+//	backup -schema user_service restore-test
+//
+// restore-test restores the newest backup into a scratch database, checks
+// it and drops it again; it is run weekly by the restore-test workflow.
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"log/slog"
+	"net/url"
+	"os"
+	"os/signal"
+	"strconv"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/daisuke8000/example-ec-platform/pkg/backup"
+	"github.com/daisuke8000/example-ec-platform/pkg/objectstore"
+)
+
+func main() {
+	logger := slog.New(slog.NewJSONHandler(os.Stderr, &slog.HandlerOptions{
+		Level: slog.LevelInfo,
+	}))
+	slog.SetDefault(logger)
+
+	if err := run(logger); err != nil {
+		logger.Error("backup command failed", slog.String("error", err.Error()))
+		os.Exit(1)
+	}
+}
+
+func run(logger *slog.Logger) error {
+	databaseURL := flag.String("database-url", os.Getenv("DATABASE_URL"), "database holding the schema")
+	schema := flag.String("schema", "", "schema to back up, e.g. user_service or product_service")
+	key := flag.String("key", "", "backup to restore (restore only; defaults to the newest)")
+	targetURL := flag.String("target-url", "", "database to restore into (restore only)")
+	confirm := flag.String("confirm", "", "name of the target database; must match (restore only)")
+	flag.Parse()
+
+	if flag.NArg() != 1 {
+		return errors.New("usage: backup [flags] export|list|prune|restore|restore-test")
+	}
+	if *schema == "" {
+		return errors.New("-schema is required")
+	}
+
+	retention, err := retentionFromEnv()
+	if err != nil {
+		return err
+	}
+	store, err := objectstore.New(storeConfigFromEnv())
+	if err != nil {
+		return err
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	manager := backup.NewManager(store, *databaseURL, *schema, retention, logger)
+	switch verb := flag.Arg(0); verb {
+	case "export":
+		if *databaseURL == "" {
+			return errors.New("DATABASE_URL or -database-url is required")
+		}
+		b, err := manager.Export(ctx)
+		if err != nil {
+			return err
+		}
+		if _, err := manager.Prune(ctx); err != nil {
+			return err
+		}
+		return printJSON(b)
+
+	case "list":
+		backups, err := manager.List(ctx)
+		if err != nil {
+			return err
+		}
+		return printJSON(backups)
+
+	case "prune":
+		deleted, err := manager.Prune(ctx)
+		if err != nil {
+			return err
+		}
+		return printJSON(deleted)
+
+	case "restore":
+		if *targetURL == "" {
+			return errors.New("-target-url is required")
+		}
+		u, err := url.Parse(*targetURL)
+		if err != nil {
+			return fmt.Errorf("invalid -target-url: %w", err)
+		}
+		if name := strings.TrimPrefix(u.Path, "/"); *confirm != name {
+			return fmt.Errorf("refusing to restore into database %q: pass -confirm %s to proceed", name, name)
+		}
+		if *key == "" {
+			latest, err := manager.Latest(ctx)
+			if err != nil {
+				return err
+			}
+			*key = latest.Key
+		}
+		return manager.Restore(ctx, *key, *targetURL)
+
+	case "restore-test":
+		if *databaseURL == "" {
+			return errors.New("DATABASE_URL or -database-url is required")
+		}
+		report, err := manager.RestoreTest(ctx)
+		if err != nil {
+			return err
+		}
+		return printJSON(report)
+
+	default:
+		return fmt.Errorf("unknown command %q", verb)
+	}
+}
+
+func storeConfigFromEnv() objectstore.Config {
+	return objectstore.Config{
+		Backend: envOr("BACKUP_STORE", "file"),
+		Dir:     envOr("BACKUP_DIR", "backups"),
+		S3: objectstore.S3Config{
+			Endpoint:  os.Getenv("BACKUP_S3_ENDPOINT"),
+			Region:    envOr("BACKUP_S3_REGION", "ap-northeast-1"),
+			Bucket:    os.Getenv("BACKUP_S3_BUCKET"),
+			AccessKey: os.Getenv("BACKUP_S3_ACCESS_KEY"),
+			SecretKey: os.Getenv("BACKUP_S3_SECRET_KEY"),
+		},
+	}
+}
+
+func retentionFromEnv() (backup.Retention, error) {
+	keepLast, err := strconv.Atoi(envOr("BACKUP_KEEP_LAST", "7"))
+	if err != nil || keepLast < 1 {
+		return backup.Retention{}, errors.New("BACKUP_KEEP_LAST must be a positive integer")
+	}
+	maxAge, err := time.ParseDuration(envOr("BACKUP_MAX_AGE", "720h"))
+	if err != nil || maxAge < 0 {
+		return backup.Retention{}, errors.New("BACKUP_MAX_AGE must be a non-negative duration")
+	}
+	return backup.Retention{KeepLast: keepLast, MaxAge: maxAge}, nil
+}
+
+func envOr(key, def string) string {
+	if v := os.Getenv(key); v != "" {
+		return v
+	}
+	return def
+}
+
+func printJSON(v any) error {
+	enc := json.NewEncoder(os.Stdout)
+	enc.SetIndent("", "  ")
+	return enc.Encode(v)
+}
@@ -0,0 +1,33 @@
+module github.com/daisuke8000/example-ec-platform/pkg/backup
+
+go 1.25
+
+require (
+	connectrpc.com/connect v1.18.1
+	github.com/daisuke8000/example-ec-platform/gen v0.0.0
+	github.com/daisuke8000/example-ec-platform/pkg/connect v0.0.0
+	github.com/daisuke8000/example-ec-platform/pkg/objectstore v0.0.0
+	github.com/daisuke8000/example-ec-platform/pkg/operations v0.0.0
+	github.com/jackc/pgx/v5 v5.6.0
+	google.golang.org/protobuf v1.35.2
+)
+
+require (
+	github.com/daisuke8000/example-ec-platform/pkg/listing v0.0.0 // indirect
+	github.com/google/uuid v1.6.0 // indirect
+	github.com/jackc/pgpassfile v1.0.0 // indirect
+	github.com/jackc/pgservicefile v0.0.0-20221227161230-091c0ba34f0a // indirect
+	github.com/jackc/puddle/v2 v2.2.1 // indirect
+	golang.org/x/crypto v0.32.0 // indirect
+	golang.org/x/sync v0.10.0 // indirect
+	golang.org/x/text v0.21.0 // indirect
+	google.golang.org/genproto/googleapis/rpc v0.0.0-20240318140521-94a12d6c2237 // indirect
+)
+
+replace (
+	github.com/daisuke8000/example-ec-platform/gen => ../../gen
+	github.com/daisuke8000/example-ec-platform/pkg/connect => ../connect
+	github.com/daisuke8000/example-ec-platform/pkg/listing => ../listing
+	github.com/daisuke8000/example-ec-platform/pkg/objectstore => ../objectstore
+	github.com/daisuke8000/example-ec-platform/pkg/operations => ../operations
+)
@@ -0,0 +1,94 @@
+package backup
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+
+	"connectrpc.com/connect"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	backupv1 "github.com/daisuke8000/example-ec-platform/gen/backup/v1"
+	"github.com/daisuke8000/example-ec-platform/gen/backup/v1/backupv1connect"
+	pkgmw "github.com/daisuke8000/example-ec-platform/pkg/connect/middleware"
+	"github.com/daisuke8000/example-ec-platform/pkg/operations"
+)
+
+// OperationKind is the kind of the operations started by CreateBackup.
+const OperationKind = "backup_export"
+
+var _ backupv1connect.BackupServiceHandler = (*Handler)(nil)
+
+// Handler serves backup.v1.BackupService for one schema. Exports run as
+// operations, so they survive the RPC deadline.
+type Handler struct {
+	backupv1connect.UnimplementedBackupServiceHandler
+	manager *Manager
+	runner  *operations.Runner
+	logger  *slog.Logger
+}
+
+func NewHandler(manager *Manager, runner *operations.Runner, logger *slog.Logger) *Handler {
+	return &Handler{
+		manager: manager,
+		runner:  runner,
+		logger:  logger,
+	}
+}
+
+// exportResult is the operation result of a successful export.
+type exportResult struct {
+	Backup  *Backup  `json:"backup"`
+	Deleted []Backup `json:"deleted,omitempty"`
+}
+
+func (h *Handler) CreateBackup(
+	ctx context.Context,
+	_ *connect.Request[backupv1.CreateBackupRequest],
+) (*connect.Response[backupv1.CreateBackupResponse], error) {
+	op, err := h.runner.Start(ctx, OperationKind, pkgmw.GetUserID(ctx), func(ctx context.Context, p *operations.Progress) (any, error) {
+		b, err := h.manager.Export(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if err := p.Set(ctx, 90); err != nil {
+			return nil, err
+		}
+
+		// The export has succeeded even if pruning fails; the next export
+		// retries it.
+		deleted, err := h.manager.Prune(ctx)
+		if err != nil {
+			h.logger.Error("failed to apply backup retention", slog.String("error", err.Error()))
+		}
+		return exportResult{Backup: b, Deleted: deleted}, nil
+	})
+	if err != nil {
+		h.logger.ErrorContext(ctx, "failed to start backup", slog.String("error", err.Error()))
+		return nil, connect.NewError(connect.CodeInternal, errors.New("internal server error"))
+	}
+
+	return connect.NewResponse(&backupv1.CreateBackupResponse{Operation: operations.ToProto(op)}), nil
+}
+
+func (h *Handler) ListBackups(
+	ctx context.Context,
+	_ *connect.Request[backupv1.ListBackupsRequest],
+) (*connect.Response[backupv1.ListBackupsResponse], error) {
+	backups, err := h.manager.List(ctx)
+	if err != nil {
+		h.logger.ErrorContext(ctx, "failed to list backups", slog.String("error", err.Error()))
+		return nil, connect.NewError(connect.CodeUnavailable, errors.New("object storage unavailable"))
+	}
+
+	resp := &backupv1.ListBackupsResponse{Backups: make([]*backupv1.Backup, len(backups))}
+	for i, b := range backups {
+		resp.Backups[i] = &backupv1.Backup{
+			Key:       b.Key,
+			Schema:    b.Schema,
+			SizeBytes: b.Size,
+			CreatedAt: timestamppb.New(b.CreatedAt),
+		}
+	}
+	return connect.NewResponse(resp), nil
+}
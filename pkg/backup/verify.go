@@ -0,0 +1,118 @@
+package backup
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"net/url"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+)
+
+// Report is the result of a restore test.
+type Report struct {
+	Backup Backup `json:"backup"`
+	// Tables holds the row count of every restored table of the schema.
+	Tables   map[string]int64 `json:"tables"`
+	Duration time.Duration    `json:"duration"`
+}
+
+// RestoreTest restores the newest backup into a scratch database on the
+// same server, checks that every table of the schema in the source
+// database exists in the copy and can be read, and drops the scratch
+// database again. The database user needs the CREATEDB privilege.
+func (m *Manager) RestoreTest(ctx context.Context) (*Report, error) {
+	start := time.Now()
+	latest, err := m.Latest(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	source, err := pgx.Connect(ctx, m.databaseURL)
+	if err != nil {
+		return nil, fmt.Errorf("connect to source database: %w", err)
+	}
+	defer source.Close(context.WithoutCancel(ctx))
+
+	// Extensions live outside the schema and are not part of the dump, but
+	// restored column defaults may depend on them.
+	extensions, err := queryStrings(ctx, source, `SELECT extname FROM pg_extension WHERE extname <> 'plpgsql'`)
+	if err != nil {
+		return nil, err
+	}
+	tables, err := queryStrings(ctx, source, `
+		SELECT table_name FROM information_schema.tables
+		WHERE table_schema = $1 AND table_type = 'BASE TABLE'
+		ORDER BY table_name
+	`, m.schema)
+	if err != nil {
+		return nil, err
+	}
+
+	scratch := fmt.Sprintf("restore_test_%s_%d", m.schema, start.Unix())
+	if _, err := source.Exec(ctx, "CREATE DATABASE "+pgx.Identifier{scratch}.Sanitize()); err != nil {
+		return nil, fmt.Errorf("create scratch database: %w", err)
+	}
+	defer func() {
+		if _, err := source.Exec(context.WithoutCancel(ctx), "DROP DATABASE IF EXISTS "+pgx.Identifier{scratch}.Sanitize()+" WITH (FORCE)"); err != nil {
+			m.logger.Error("failed to drop scratch database", slog.String("database", scratch), slog.String("error", err.Error()))
+		}
+	}()
+
+	scratchURL, err := withDatabase(m.databaseURL, scratch)
+	if err != nil {
+		return nil, err
+	}
+	target, err := pgx.Connect(ctx, scratchURL)
+	if err != nil {
+		return nil, fmt.Errorf("connect to scratch database: %w", err)
+	}
+	defer target.Close(context.WithoutCancel(ctx))
+
+	for _, ext := range extensions {
+		if _, err := target.Exec(ctx, "CREATE EXTENSION IF NOT EXISTS "+pgx.Identifier{ext}.Sanitize()); err != nil {
+			return nil, fmt.Errorf("create extension %s: %w", ext, err)
+		}
+	}
+
+	if err := m.Restore(ctx, latest.Key, scratchURL); err != nil {
+		return nil, err
+	}
+
+	report := &Report{Backup: *latest, Tables: make(map[string]int64, len(tables))}
+	for _, table := range tables {
+		var count int64
+		query := "SELECT count(*) FROM " + pgx.Identifier{m.schema, table}.Sanitize()
+		if err := target.QueryRow(ctx, query).Scan(&count); err != nil {
+			return nil, fmt.Errorf("table %s.%s missing from restored backup %s: %w", m.schema, table, latest.Key, err)
+		}
+		report.Tables[table] = count
+	}
+	report.Duration = time.Since(start)
+
+	m.logger.Info("restore test passed",
+		slog.String("key", latest.Key),
+		slog.Int("tables", len(tables)),
+		slog.Duration("duration", report.Duration),
+	)
+	return report, nil
+}
+
+func queryStrings(ctx context.Context, conn *pgx.Conn, query string, args ...any) ([]string, error) {
+	rows, err := conn.Query(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	return pgx.CollectRows(rows, pgx.RowTo[string])
+}
+
+// withDatabase returns databaseURL pointing at another database.
+func withDatabase(databaseURL, database string) (string, error) {
+	u, err := url.Parse(databaseURL)
+	if err != nil {
+		return "", fmt.Errorf("invalid database URL: %w", err)
+	}
+	u.Path = "/" + database
+	return u.String(), nil
+}
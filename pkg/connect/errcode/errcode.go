@@ -0,0 +1,62 @@
+// Package errcode attaches stable, machine-readable codes to errors that
+// clients surface to end users. The code travels as the reason of a
+// google.rpc.ErrorInfo detail, so clients and the BFF can branch on it and
+// look up a localized message instead of parsing the English error text.
+package errcode
+
+import (
+	"errors"
+
+	"connectrpc.com/connect"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+)
+
+// Domain is the ErrorInfo domain of every code below.
+const Domain = "example-ec-platform"
+
+// Codes surfaced to end users. Codes are part of the API: never rename or
+// reuse one, add a new code instead.
+const (
+	OutOfStock       = "OUT_OF_STOCK"
+	CouponExpired    = "COUPON_EXPIRED"
+	PasswordEmpty    = "PASSWORD_EMPTY"
+	PasswordTooShort = "PASSWORD_TOO_SHORT"
+	AccountLocked    = "ACCOUNT_LOCKED"
+	PasswordTooWeak  = "PASSWORD_TOO_WEAK"
+	PasswordBreached = "PASSWORD_BREACHED"
+)
+
+// New returns a Connect error with an ErrorInfo detail carrying code and
+// metadata. Metadata values fill the placeholders of localized messages,
+// e.g. {"min_length": "8"} for PasswordTooShort.
+func New(c connect.Code, err error, code string, metadata map[string]string) *connect.Error {
+	connectErr := connect.NewError(c, err)
+	detail, detailErr := connect.NewErrorDetail(&errdetails.ErrorInfo{
+		Reason:   code,
+		Domain:   Domain,
+		Metadata: metadata,
+	})
+	if detailErr == nil {
+		connectErr.AddDetail(detail)
+	}
+	return connectErr
+}
+
+// Info returns the ErrorInfo detail with a code of this package attached
+// to err, if any.
+func Info(err error) (*errdetails.ErrorInfo, bool) {
+	var connectErr *connect.Error
+	if !errors.As(err, &connectErr) {
+		return nil, false
+	}
+	for _, detail := range connectErr.Details() {
+		value, err := detail.Value()
+		if err != nil {
+			continue
+		}
+		if info, ok := value.(*errdetails.ErrorInfo); ok && info.GetDomain() == Domain {
+			return info, true
+		}
+	}
+	return nil, false
+}
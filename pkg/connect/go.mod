@@ -2,11 +2,14 @@ module github.com/daisuke8000/example-ec-platform/pkg/connect
 
 go 1.25
 
-require connectrpc.com/connect v1.18.1
+require (
+	connectrpc.com/connect v1.18.1
+	google.golang.org/genproto/googleapis/rpc v0.0.0-20240318140521-94a12d6c2237
+	google.golang.org/protobuf v1.35.2
+)
 
 require (
 	github.com/google/go-cmp v0.6.0 // indirect
 	golang.org/x/net v0.25.0 // indirect
 	golang.org/x/text v0.21.0 // indirect
-	google.golang.org/protobuf v1.35.2 // indirect
 )
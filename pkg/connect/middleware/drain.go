@@ -0,0 +1,122 @@
+package middleware
+
+import (
+	"context"
+	"errors"
+	"math"
+	"strconv"
+	"sync"
+	"time"
+
+	"connectrpc.com/connect"
+)
+
+// Drainer lets RPCs in flight at shutdown complete while turning away new
+// ones, so that work inside a database transaction (e.g. a reservation) is
+// not cut off when the connection pool closes. http.Server.Shutdown alone
+// does not wait for them: h2c connections are hijacked and not tracked.
+type Drainer struct {
+	retryAfter string
+
+	mu       sync.Mutex
+	draining bool
+	inFlight int
+	drained  chan struct{}
+}
+
+// NewDrainer creates a drainer. Rejected calls tell clients to retry after
+// retryAfter, by which time a load balancer should route them elsewhere.
+func NewDrainer(retryAfter time.Duration) *Drainer {
+	return &Drainer{
+		retryAfter: strconv.Itoa(int(math.Ceil(retryAfter.Seconds()))),
+		drained:    make(chan struct{}),
+	}
+}
+
+// Interceptor creates a Connect-go server interceptor that tracks calls in
+// flight and rejects calls with Unavailable and Retry-After once draining.
+// A streaming call is in flight until its handler returns, so Drain waits
+// for open streams too.
+func (d *Drainer) Interceptor() connect.Interceptor {
+	return &drainInterceptor{drainer: d}
+}
+
+type drainInterceptor struct {
+	drainer *Drainer
+}
+
+func (i *drainInterceptor) WrapUnary(next connect.UnaryFunc) connect.UnaryFunc {
+	return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+		if !i.drainer.begin() {
+			return nil, i.drainer.unavailable()
+		}
+		defer i.drainer.end()
+		return next(ctx, req)
+	}
+}
+
+func (i *drainInterceptor) WrapStreamingClient(next connect.StreamingClientFunc) connect.StreamingClientFunc {
+	return next
+}
+
+func (i *drainInterceptor) WrapStreamingHandler(next connect.StreamingHandlerFunc) connect.StreamingHandlerFunc {
+	return func(ctx context.Context, conn connect.StreamingHandlerConn) error {
+		if !i.drainer.begin() {
+			return i.drainer.unavailable()
+		}
+		defer i.drainer.end()
+		return next(ctx, conn)
+	}
+}
+
+// Draining reports whether Drain was called, e.g. to fail readiness checks.
+func (d *Drainer) Draining() bool {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return d.draining
+}
+
+// Drain stops accepting calls and waits until the calls in flight have
+// completed or ctx is done.
+func (d *Drainer) Drain(ctx context.Context) error {
+	d.mu.Lock()
+	if !d.draining {
+		d.draining = true
+		if d.inFlight == 0 {
+			close(d.drained)
+		}
+	}
+	d.mu.Unlock()
+
+	select {
+	case <-d.drained:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func (d *Drainer) unavailable() error {
+	connectErr := connect.NewError(connect.CodeUnavailable, errors.New("server is shutting down"))
+	connectErr.Meta().Set("Retry-After", d.retryAfter)
+	return connectErr
+}
+
+func (d *Drainer) begin() bool {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if d.draining {
+		return false
+	}
+	d.inFlight++
+	return true
+}
+
+func (d *Drainer) end() {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.inFlight--
+	if d.draining && d.inFlight == 0 {
+		close(d.drained)
+	}
+}
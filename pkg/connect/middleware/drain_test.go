@@ -0,0 +1,178 @@
+package middleware
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+const testWatchProcedure = "/middleware.test.TestService/Watch"
+
+// blockingServer serves a unary and a server-streaming procedure whose
+// handlers signal started and block until release is closed.
+type blockingServer struct {
+	started chan struct{}
+	release chan struct{}
+	server  *httptest.Server
+}
+
+func newBlockingServer(t *testing.T, drainer *Drainer) *blockingServer {
+	t.Helper()
+	s := &blockingServer{started: make(chan struct{}, 1), release: make(chan struct{})}
+
+	mux := http.NewServeMux()
+	mux.Handle(testCreateProcedure, connect.NewUnaryHandler(testCreateProcedure,
+		func(_ context.Context, req *connect.Request[wrapperspb.StringValue]) (*connect.Response[wrapperspb.StringValue], error) {
+			s.started <- struct{}{}
+			<-s.release
+			return connect.NewResponse(req.Msg), nil
+		},
+		connect.WithInterceptors(drainer.Interceptor()),
+	))
+	mux.Handle(testWatchProcedure, connect.NewServerStreamHandler(testWatchProcedure,
+		func(_ context.Context, req *connect.Request[wrapperspb.StringValue], stream *connect.ServerStream[wrapperspb.StringValue]) error {
+			if err := stream.Send(req.Msg); err != nil {
+				return err
+			}
+			s.started <- struct{}{}
+			<-s.release
+			return nil
+		},
+		connect.WithInterceptors(drainer.Interceptor()),
+	))
+	s.server = httptest.NewServer(mux)
+	t.Cleanup(func() {
+		s.unblock()
+		s.server.Close()
+	})
+	return s
+}
+
+func (s *blockingServer) unblock() {
+	select {
+	case <-s.release:
+	default:
+		close(s.release)
+	}
+}
+
+func (s *blockingServer) call(ctx context.Context) error {
+	client := connect.NewClient[wrapperspb.StringValue, wrapperspb.StringValue](s.server.Client(), s.server.URL+testCreateProcedure)
+	_, err := client.CallUnary(ctx, connect.NewRequest(wrapperspb.String("a")))
+	return err
+}
+
+// watch opens a stream and returns once the handler is running. The stream
+// is drained in the background; its error is sent on the returned channel.
+func (s *blockingServer) watch(t *testing.T) <-chan error {
+	t.Helper()
+	client := connect.NewClient[wrapperspb.StringValue, wrapperspb.StringValue](s.server.Client(), s.server.URL+testWatchProcedure)
+	stream, err := client.CallServerStream(context.Background(), connect.NewRequest(wrapperspb.String("a")))
+	if err != nil {
+		t.Fatalf("CallServerStream: %v", err)
+	}
+	done := make(chan error, 1)
+	go func() {
+		for stream.Receive() {
+		}
+		done <- stream.Err()
+	}()
+	<-s.started
+	return done
+}
+
+func TestDrainer_NothingInFlight(t *testing.T) {
+	drainer := NewDrainer(time.Second)
+	if drainer.Draining() {
+		t.Fatal("Draining() = true before Drain")
+	}
+	if err := drainer.Drain(context.Background()); err != nil {
+		t.Fatalf("Drain() error = %v", err)
+	}
+	if !drainer.Draining() {
+		t.Error("Draining() = false after Drain")
+	}
+	// A second Drain, e.g. from a repeated signal, must not panic.
+	if err := drainer.Drain(context.Background()); err != nil {
+		t.Errorf("second Drain() error = %v", err)
+	}
+}
+
+func TestDrainer_WaitsForUnaryCall(t *testing.T) {
+	drainer := NewDrainer(1500 * time.Millisecond)
+	s := newBlockingServer(t, drainer)
+
+	callDone := make(chan error, 1)
+	go func() { callDone <- s.call(context.Background()) }()
+	<-s.started
+
+	drained := make(chan error, 1)
+	go func() { drained <- drainer.Drain(context.Background()) }()
+
+	select {
+	case err := <-drained:
+		t.Fatalf("Drain() returned %v with a call in flight", err)
+	case <-time.After(20 * time.Millisecond):
+	}
+
+	// Calls arriving while draining are turned away.
+	err := s.call(context.Background())
+	if connect.CodeOf(err) != connect.CodeUnavailable {
+		t.Fatalf("new call while draining: code = %v, want %v", connect.CodeOf(err), connect.CodeUnavailable)
+	}
+	var connectErr *connect.Error
+	if !errors.As(err, &connectErr) || connectErr.Meta().Get("Retry-After") != "2" {
+		t.Errorf("Retry-After = %q, want %q", connectErr.Meta().Get("Retry-After"), "2")
+	}
+
+	s.unblock()
+	if err := <-callDone; err != nil {
+		t.Errorf("in-flight call failed: %v", err)
+	}
+	if err := <-drained; err != nil {
+		t.Errorf("Drain() error = %v", err)
+	}
+}
+
+func TestDrainer_WaitsForStream(t *testing.T) {
+	drainer := NewDrainer(time.Second)
+	s := newBlockingServer(t, drainer)
+	streamDone := s.watch(t)
+
+	drained := make(chan error, 1)
+	go func() { drained <- drainer.Drain(context.Background()) }()
+
+	select {
+	case err := <-drained:
+		t.Fatalf("Drain() returned %v with a stream open", err)
+	case <-time.After(20 * time.Millisecond):
+	}
+
+	s.unblock()
+	if err := <-streamDone; err != nil {
+		t.Errorf("open stream failed: %v", err)
+	}
+	if err := <-drained; err != nil {
+		t.Errorf("Drain() error = %v", err)
+	}
+}
+
+func TestDrainer_Timeout(t *testing.T) {
+	drainer := NewDrainer(time.Second)
+	s := newBlockingServer(t, drainer)
+
+	go func() { _ = s.call(context.Background()) }()
+	<-s.started
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	if err := drainer.Drain(ctx); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Drain() error = %v, want %v", err, context.DeadlineExceeded)
+	}
+}
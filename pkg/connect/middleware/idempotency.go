@@ -0,0 +1,239 @@
+package middleware
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"reflect"
+	"time"
+
+	"connectrpc.com/connect"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+const (
+	// IdempotencyKeyHeader carries the client-chosen key for a mutation.
+	IdempotencyKeyHeader = "Idempotency-Key"
+
+	// IdempotentReplayedHeader is set to "true" on responses served from the store.
+	IdempotentReplayedHeader = "Idempotent-Replayed"
+
+	maxIdempotencyKeyLength = 255
+	idempotencyInProgress   = "processing"
+)
+
+// IdempotencyStore persists idempotency records.
+// SetNX must only set the value if the key does not exist.
+type IdempotencyStore interface {
+	Get(ctx context.Context, key string) (string, error)
+	SetNX(ctx context.Context, key string, value string, ttl time.Duration) (bool, error)
+	Set(ctx context.Context, key string, value string, ttl time.Duration) error
+	Del(ctx context.Context, key string) error
+}
+
+// idempotencyRecord is the stored form of a completed response.
+type idempotencyRecord struct {
+	RequestHash  string `json:"request_hash"`
+	ResponseType string `json:"response_type"`
+	Response     []byte `json:"response"`
+}
+
+// ResponseTypes maps response messages to the *connect.Response[T] types of
+// the handler methods returning them. A generated Connect handler only
+// accepts its own response type, so a replayed message must be wrapped in it.
+type ResponseTypes map[protoreflect.FullName]reflect.Type
+
+var anyResponseType = reflect.TypeFor[connect.AnyResponse]()
+
+// HandlerResponseTypes collects the response types of the unary methods of
+// handlers, the service implementations passed to the generated
+// New*ServiceHandler constructors.
+func HandlerResponseTypes(handlers ...any) ResponseTypes {
+	types := make(ResponseTypes)
+	for _, h := range handlers {
+		t := reflect.TypeOf(h)
+		for i := range t.NumMethod() {
+			method := t.Method(i).Type
+			if method.NumOut() != 2 || !method.Out(0).Implements(anyResponseType) || method.Out(0).Kind() != reflect.Pointer {
+				continue
+			}
+			field, ok := method.Out(0).Elem().FieldByName("Msg")
+			if !ok || field.Type.Kind() != reflect.Pointer {
+				continue
+			}
+			msg, ok := reflect.New(field.Type.Elem()).Interface().(proto.Message)
+			if !ok {
+				continue
+			}
+			types[msg.ProtoReflect().Descriptor().FullName()] = method.Out(0)
+		}
+	}
+	return types
+}
+
+// newResponse unmarshals a stored response into the response type of the
+// handler returning it.
+func (t ResponseTypes) newResponse(name protoreflect.FullName, body []byte) (connect.AnyResponse, error) {
+	respType, ok := t[name]
+	if !ok {
+		return nil, fmt.Errorf("no handler returns %s", name)
+	}
+	resp := reflect.New(respType.Elem())
+	msg := resp.Elem().FieldByName("Msg")
+	msg.Set(reflect.New(msg.Type().Elem()))
+	if err := proto.Unmarshal(body, msg.Interface().(proto.Message)); err != nil {
+		return nil, err
+	}
+	return resp.Interface().(connect.AnyResponse), nil
+}
+
+// responseName returns the name of the procedure's response message, if
+// the handler was generated with its schema.
+func responseName(spec connect.Spec) (protoreflect.FullName, bool) {
+	method, ok := spec.Schema.(protoreflect.MethodDescriptor)
+	if !ok {
+		return "", false
+	}
+	return method.Output().FullName(), true
+}
+
+// IdempotencyInterceptor creates a server-side interceptor that honors the
+// Idempotency-Key header on mutating unary RPCs. The first successful response
+// for a key is stored and replayed for retries with the same key; failed calls
+// release the key so the client can retry. Keys are scoped per procedure and
+// per authenticated user, and reusing a key with a different request body is
+// rejected. Procedures declared with no side effects, and procedures whose
+// response type is not in responses, are passed through.
+func IdempotencyInterceptor(store IdempotencyStore, ttl time.Duration, responses ResponseTypes, logger *slog.Logger) connect.UnaryInterceptorFunc {
+	return func(next connect.UnaryFunc) connect.UnaryFunc {
+		return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+			if req.Spec().IsClient || req.Spec().IdempotencyLevel == connect.IdempotencyNoSideEffects {
+				return next(ctx, req)
+			}
+			if name, ok := responseName(req.Spec()); !ok || responses[name] == nil {
+				return next(ctx, req)
+			}
+
+			key := req.Header().Get(IdempotencyKeyHeader)
+			if key == "" {
+				return next(ctx, req)
+			}
+			if len(key) > maxIdempotencyKeyLength {
+				return nil, connect.NewError(connect.CodeInvalidArgument,
+					errors.New("idempotency key must be at most 255 characters"))
+			}
+
+			msg, ok := req.Any().(proto.Message)
+			if !ok {
+				return next(ctx, req)
+			}
+			requestHash, err := hashMessage(msg)
+			if err != nil {
+				return nil, connect.NewError(connect.CodeInternal, err)
+			}
+
+			storeKey := req.Spec().Procedure + ":" + GetUserID(ctx) + ":" + key
+
+			acquired, err := store.SetNX(ctx, storeKey, idempotencyInProgress, ttl)
+			if err != nil {
+				logger.ErrorContext(ctx, "idempotency store unavailable",
+					slog.String("procedure", req.Spec().Procedure),
+					slog.String("error", err.Error()),
+				)
+				return nil, connect.NewError(connect.CodeUnavailable, errors.New("idempotency store unavailable"))
+			}
+			if !acquired {
+				return replayIdempotentResponse(ctx, store, responses, storeKey, requestHash)
+			}
+
+			resp, err := next(ctx, req)
+			if err != nil {
+				// Release the key so a retry can run the request again.
+				if delErr := store.Del(context.WithoutCancel(ctx), storeKey); delErr != nil {
+					logger.WarnContext(ctx, "failed to release idempotency key",
+						slog.String("procedure", req.Spec().Procedure),
+						slog.String("error", delErr.Error()),
+					)
+				}
+				return nil, err
+			}
+
+			if err := saveIdempotentResponse(context.WithoutCancel(ctx), store, storeKey, requestHash, resp, ttl); err != nil {
+				logger.WarnContext(ctx, "failed to store idempotent response",
+					slog.String("procedure", req.Spec().Procedure),
+					slog.String("error", err.Error()),
+				)
+				// Release the key rather than leave it in progress, which
+				// would abort every retry until the TTL expires.
+				if delErr := store.Del(context.WithoutCancel(ctx), storeKey); delErr != nil {
+					logger.WarnContext(ctx, "failed to release idempotency key",
+						slog.String("procedure", req.Spec().Procedure),
+						slog.String("error", delErr.Error()),
+					)
+				}
+			}
+			return resp, nil
+		}
+	}
+}
+
+func hashMessage(msg proto.Message) (string, error) {
+	b, err := proto.MarshalOptions{Deterministic: true}.Marshal(msg)
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256(b)
+	return hex.EncodeToString(sum[:]), nil
+}
+
+func saveIdempotentResponse(ctx context.Context, store IdempotencyStore, key, requestHash string, resp connect.AnyResponse, ttl time.Duration) error {
+	msg, ok := resp.Any().(proto.Message)
+	if !ok {
+		return errors.New("response is not a protobuf message")
+	}
+	body, err := proto.Marshal(msg)
+	if err != nil {
+		return err
+	}
+	record, err := json.Marshal(idempotencyRecord{
+		RequestHash:  requestHash,
+		ResponseType: string(msg.ProtoReflect().Descriptor().FullName()),
+		Response:     body,
+	})
+	if err != nil {
+		return err
+	}
+	return store.Set(ctx, key, string(record), ttl)
+}
+
+func replayIdempotentResponse(ctx context.Context, store IdempotencyStore, responses ResponseTypes, key, requestHash string) (connect.AnyResponse, error) {
+	value, err := store.Get(ctx, key)
+	if err != nil {
+		// The key may have expired or been released between SetNX and Get.
+		return nil, connect.NewError(connect.CodeAborted, errors.New("idempotent request state changed, retry"))
+	}
+	if value == idempotencyInProgress {
+		return nil, connect.NewError(connect.CodeAborted, errors.New("a request with this idempotency key is in progress"))
+	}
+
+	var record idempotencyRecord
+	if err := json.Unmarshal([]byte(value), &record); err != nil {
+		return nil, connect.NewError(connect.CodeInternal, errors.New("corrupt idempotency record"))
+	}
+	if record.RequestHash != requestHash {
+		return nil, connect.NewError(connect.CodeInvalidArgument,
+			errors.New("idempotency key was already used with a different request"))
+	}
+
+	resp, err := responses.newResponse(protoreflect.FullName(record.ResponseType), record.Response)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	resp.Header().Set(IdempotentReplayedHeader, "true")
+	return resp, nil
+}
@@ -0,0 +1,339 @@
+package middleware
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+const (
+	testCreateProcedure = "/middleware.test.TestService/Create"
+	testUpdateProcedure = "/middleware.test.TestService/Update"
+	testUserHeader      = "X-Test-User"
+)
+
+// testService is a schema for handlers built without generated code.
+var testService = func() protoreflect.ServiceDescriptor {
+	stringValue := ".google.protobuf.StringValue"
+	file, err := protodesc.NewFile(&descriptorpb.FileDescriptorProto{
+		Name:       proto.String("middleware_test.proto"),
+		Package:    proto.String("middleware.test"),
+		Dependency: []string{"google/protobuf/wrappers.proto"},
+		Syntax:     proto.String("proto3"),
+		Service: []*descriptorpb.ServiceDescriptorProto{{
+			Name: proto.String("TestService"),
+			Method: []*descriptorpb.MethodDescriptorProto{
+				{Name: proto.String("Create"), InputType: &stringValue, OutputType: &stringValue},
+				{Name: proto.String("Update"), InputType: &stringValue, OutputType: &stringValue},
+			},
+		}},
+	}, protoregistry.GlobalFiles)
+	if err != nil {
+		panic(err)
+	}
+	return file.Services().Get(0)
+}()
+
+// memoryIdempotencyStore is an in-memory IdempotencyStore for tests.
+type memoryIdempotencyStore struct {
+	mu     sync.Mutex
+	values map[string]string
+	setErr error
+	nxErr  error
+}
+
+func newMemoryIdempotencyStore() *memoryIdempotencyStore {
+	return &memoryIdempotencyStore{values: make(map[string]string)}
+}
+
+func (s *memoryIdempotencyStore) Get(_ context.Context, key string) (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	v, ok := s.values[key]
+	if !ok {
+		return "", errors.New("key not found")
+	}
+	return v, nil
+}
+
+func (s *memoryIdempotencyStore) SetNX(_ context.Context, key, value string, _ time.Duration) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.nxErr != nil {
+		return false, s.nxErr
+	}
+	if _, ok := s.values[key]; ok {
+		return false, nil
+	}
+	s.values[key] = value
+	return true, nil
+}
+
+func (s *memoryIdempotencyStore) Set(_ context.Context, key, value string, _ time.Duration) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.setErr != nil {
+		return s.setErr
+	}
+	s.values[key] = value
+	return nil
+}
+
+func (s *memoryIdempotencyStore) Del(_ context.Context, key string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.values, key)
+	return nil
+}
+
+func (s *memoryIdempotencyStore) len() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return len(s.values)
+}
+
+// countingService echoes the request with the number of calls it has run.
+// A request of "fail" returns an error.
+type countingService struct {
+	mu    sync.Mutex
+	calls int
+}
+
+func (s *countingService) Create(_ context.Context, req *connect.Request[wrapperspb.StringValue]) (*connect.Response[wrapperspb.StringValue], error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.calls++
+	if req.Msg.GetValue() == "fail" {
+		return nil, connect.NewError(connect.CodeInternal, errors.New("handler failed"))
+	}
+	return connect.NewResponse(wrapperspb.String(fmt.Sprintf("%s #%d", req.Msg.GetValue(), s.calls))), nil
+}
+
+func (s *countingService) Update(ctx context.Context, req *connect.Request[wrapperspb.StringValue]) (*connect.Response[wrapperspb.StringValue], error) {
+	return s.Create(ctx, req)
+}
+
+func (s *countingService) count() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.calls
+}
+
+type idempotencyTest struct {
+	store  *memoryIdempotencyStore
+	svc    *countingService
+	server *httptest.Server
+}
+
+func newIdempotencyTest(t *testing.T) *idempotencyTest {
+	t.Helper()
+	tt := &idempotencyTest{store: newMemoryIdempotencyStore(), svc: &countingService{}}
+
+	// The user interceptor stands in for the authentication that runs
+	// before IdempotencyInterceptor in the services.
+	withUser := connect.UnaryInterceptorFunc(func(next connect.UnaryFunc) connect.UnaryFunc {
+		return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+			return next(WithUserID(ctx, req.Header().Get(testUserHeader)), req)
+		}
+	})
+	interceptors := connect.WithInterceptors(withUser,
+		IdempotencyInterceptor(tt.store, time.Hour, HandlerResponseTypes(tt.svc), slog.New(slog.DiscardHandler)))
+
+	mux := http.NewServeMux()
+	mux.Handle(testCreateProcedure, connect.NewUnaryHandler(testCreateProcedure, tt.svc.Create,
+		connect.WithSchema(testService.Methods().ByName("Create")), interceptors))
+	mux.Handle(testUpdateProcedure, connect.NewUnaryHandler(testUpdateProcedure, tt.svc.Update,
+		connect.WithSchema(testService.Methods().ByName("Update")), interceptors))
+	tt.server = httptest.NewServer(mux)
+	t.Cleanup(tt.server.Close)
+	return tt
+}
+
+func (tt *idempotencyTest) call(procedure, user, key, value string) (*connect.Response[wrapperspb.StringValue], error) {
+	client := connect.NewClient[wrapperspb.StringValue, wrapperspb.StringValue](tt.server.Client(), tt.server.URL+procedure)
+	req := connect.NewRequest(wrapperspb.String(value))
+	req.Header().Set(testUserHeader, user)
+	if key != "" {
+		req.Header().Set(IdempotencyKeyHeader, key)
+	}
+	return client.CallUnary(context.Background(), req)
+}
+
+func TestIdempotencyInterceptor_Replay(t *testing.T) {
+	tt := newIdempotencyTest(t)
+
+	first, err := tt.call(testCreateProcedure, "user-1", "key-1", "a")
+	if err != nil {
+		t.Fatalf("first call: %v", err)
+	}
+	if first.Header().Get(IdempotentReplayedHeader) != "" {
+		t.Error("first call is marked as replayed")
+	}
+
+	retry, err := tt.call(testCreateProcedure, "user-1", "key-1", "a")
+	if err != nil {
+		t.Fatalf("retry: %v", err)
+	}
+	if retry.Header().Get(IdempotentReplayedHeader) != "true" {
+		t.Error("retry is not marked as replayed")
+	}
+	if retry.Msg.GetValue() != first.Msg.GetValue() {
+		t.Errorf("replayed %q, want %q", retry.Msg.GetValue(), first.Msg.GetValue())
+	}
+	if got := tt.svc.count(); got != 1 {
+		t.Errorf("handler ran %d times, want 1", got)
+	}
+
+	_, err = tt.call(testCreateProcedure, "user-1", "key-1", "b")
+	if connect.CodeOf(err) != connect.CodeInvalidArgument {
+		t.Errorf("same key with a different request: code = %v, want %v", connect.CodeOf(err), connect.CodeInvalidArgument)
+	}
+	if got := tt.svc.count(); got != 1 {
+		t.Errorf("handler ran %d times, want 1", got)
+	}
+}
+
+func TestIdempotencyInterceptor_Scope(t *testing.T) {
+	tests := []struct {
+		name      string
+		procedure string
+		user      string
+	}{
+		{name: "another user", procedure: testCreateProcedure, user: "user-2"},
+		{name: "another procedure", procedure: testUpdateProcedure, user: "user-1"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			tt := newIdempotencyTest(t)
+			if _, err := tt.call(testCreateProcedure, "user-1", "key-1", "a"); err != nil {
+				t.Fatalf("first call: %v", err)
+			}
+
+			resp, err := tt.call(tc.procedure, tc.user, "key-1", "a")
+			if err != nil {
+				t.Fatalf("second call: %v", err)
+			}
+			if resp.Header().Get(IdempotentReplayedHeader) != "" {
+				t.Error("response of another scope was replayed")
+			}
+			if got := tt.svc.count(); got != 2 {
+				t.Errorf("handler ran %d times, want 2", got)
+			}
+		})
+	}
+}
+
+func TestIdempotencyInterceptor_ReleasesKey(t *testing.T) {
+	t.Run("handler error", func(t *testing.T) {
+		tt := newIdempotencyTest(t)
+		for i := range 2 {
+			if _, err := tt.call(testCreateProcedure, "user-1", "key-1", "fail"); connect.CodeOf(err) != connect.CodeInternal {
+				t.Fatalf("call %d: code = %v, want %v", i+1, connect.CodeOf(err), connect.CodeInternal)
+			}
+		}
+		if got := tt.svc.count(); got != 2 {
+			t.Errorf("handler ran %d times, want 2", got)
+		}
+		if got := tt.store.len(); got != 0 {
+			t.Errorf("store holds %d keys, want 0", got)
+		}
+	})
+
+	t.Run("response not stored", func(t *testing.T) {
+		tt := newIdempotencyTest(t)
+		tt.store.setErr = errors.New("connection reset")
+		for i := range 2 {
+			if _, err := tt.call(testCreateProcedure, "user-1", "key-1", "a"); err != nil {
+				t.Fatalf("call %d: %v", i+1, err)
+			}
+		}
+		if got := tt.svc.count(); got != 2 {
+			t.Errorf("handler ran %d times, want 2", got)
+		}
+		if got := tt.store.len(); got != 0 {
+			t.Errorf("store holds %d keys, want 0", got)
+		}
+	})
+}
+
+func TestIdempotencyInterceptor_StoreUnavailable(t *testing.T) {
+	tt := newIdempotencyTest(t)
+	tt.store.nxErr = errors.New("connection refused")
+
+	_, err := tt.call(testCreateProcedure, "user-1", "key-1", "a")
+	if connect.CodeOf(err) != connect.CodeUnavailable {
+		t.Errorf("code = %v, want %v", connect.CodeOf(err), connect.CodeUnavailable)
+	}
+	if got := tt.svc.count(); got != 0 {
+		t.Errorf("handler ran %d times, want 0", got)
+	}
+}
+
+func TestIdempotencyInterceptor_KeyLength(t *testing.T) {
+	tests := []struct {
+		name     string
+		key      string
+		wantCode connect.Code
+	}{
+		{name: "at the limit", key: strings.Repeat("k", 255)},
+		{name: "over the limit", key: strings.Repeat("k", 256), wantCode: connect.CodeInvalidArgument},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			tt := newIdempotencyTest(t)
+			_, err := tt.call(testCreateProcedure, "user-1", tc.key, "a")
+			if tc.wantCode == 0 {
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				return
+			}
+			if connect.CodeOf(err) != tc.wantCode {
+				t.Errorf("code = %v, want %v", connect.CodeOf(err), tc.wantCode)
+			}
+		})
+	}
+}
+
+func TestHandlerResponseTypes_NewResponse(t *testing.T) {
+	types := HandlerResponseTypes(&countingService{})
+	body, err := proto.Marshal(wrapperspb.String("a"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	resp, err := types.newResponse("google.protobuf.StringValue", body)
+	if err != nil {
+		t.Fatalf("newResponse() error = %v", err)
+	}
+	// Generated handlers reject any response that is not their own
+	// *connect.Response[T].
+	typed, ok := resp.(*connect.Response[wrapperspb.StringValue])
+	if !ok {
+		t.Fatalf("newResponse() returned %T", resp)
+	}
+	if typed.Msg.GetValue() != "a" {
+		t.Errorf("Msg = %q, want %q", typed.Msg.GetValue(), "a")
+	}
+
+	if _, err := types.newResponse("google.protobuf.Int64Value", body); err == nil {
+		t.Error("newResponse() for a type no handler returns: want error")
+	}
+}
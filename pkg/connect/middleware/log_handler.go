@@ -0,0 +1,47 @@
+package middleware
+
+import (
+	"context"
+	"log/slog"
+)
+
+// requestIDLogKey is the attribute carrying the request ID in log records.
+const requestIDLogKey = "request_id"
+
+// ContextLogHandler adds the request ID from the context to every record
+// logged with a context (InfoContext, ErrorContext, ...), so the logs of a
+// request can be correlated across the BFF and the backend services.
+type ContextLogHandler struct {
+	slog.Handler
+}
+
+// NewContextLogHandler wraps h.
+func NewContextLogHandler(h slog.Handler) *ContextLogHandler {
+	return &ContextLogHandler{Handler: h}
+}
+
+func (h *ContextLogHandler) Handle(ctx context.Context, r slog.Record) error {
+	if requestID := GetRequestID(ctx); requestID != "" && !hasAttr(r, requestIDLogKey) {
+		r.AddAttrs(slog.String(requestIDLogKey, requestID))
+	}
+	return h.Handler.Handle(ctx, r)
+}
+
+func (h *ContextLogHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	return &ContextLogHandler{Handler: h.Handler.WithAttrs(attrs)}
+}
+
+func (h *ContextLogHandler) WithGroup(name string) slog.Handler {
+	return &ContextLogHandler{Handler: h.Handler.WithGroup(name)}
+}
+
+// hasAttr reports whether the record already has the attribute, e.g. from a
+// logger that sets it explicitly.
+func hasAttr(r slog.Record, key string) bool {
+	found := false
+	r.Attrs(func(a slog.Attr) bool {
+		found = a.Key == key
+		return !found
+	})
+	return found
+}
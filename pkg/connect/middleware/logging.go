@@ -2,15 +2,37 @@ package middleware
 
 import (
 	"context"
+	"encoding/json"
 	"log/slog"
+	"sync"
+	"sync/atomic"
 	"time"
 
 	"connectrpc.com/connect"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
 )
 
+// LoggingConfig configures LoggingInterceptor.
+type LoggingConfig struct {
+	// SampleEvery logs only every Nth successful call of a procedure, for
+	// high-QPS methods (e.g. "/product.v1.ProductService/GetProduct": 100).
+	// Failed and slow calls are always logged.
+	SampleEvery map[string]int
+
+	// SlowThreshold marks calls taking longer as slow; they are logged at
+	// warn level and never sampled out. Zero disables it.
+	SlowThreshold time.Duration
+}
+
 // LoggingInterceptor creates a Connect-go interceptor for request logging.
-// It logs the procedure name, duration, and any errors that occur.
-func LoggingInterceptor(logger *slog.Logger) connect.UnaryInterceptorFunc {
+// Every call is logged with its procedure, status code, duration, request
+// and response sizes, user ID and request ID. When the logger has debug
+// level enabled the request and response messages are logged too, with the
+// fields marked [debug_redact = true] in the proto replaced.
+func LoggingInterceptor(cfg LoggingConfig, logger *slog.Logger) connect.UnaryInterceptorFunc {
+	var counters sync.Map // procedure -> *atomic.Uint64
+
 	return func(next connect.UnaryFunc) connect.UnaryFunc {
 		return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
 			start := time.Now()
@@ -18,23 +40,58 @@ func LoggingInterceptor(logger *slog.Logger) connect.UnaryInterceptorFunc {
 			resp, err := next(ctx, req)
 
 			duration := time.Since(start)
+			procedure := req.Spec().Procedure
+			slow := cfg.SlowThreshold > 0 && duration > cfg.SlowThreshold
 
-			// Extract request ID for correlation
-			requestID := GetRequestID(ctx)
+			sampleEvery := cfg.SampleEvery[procedure]
+			if err == nil && !slow && sampleEvery > 1 {
+				counter, _ := counters.LoadOrStore(procedure, new(atomic.Uint64))
+				if (counter.(*atomic.Uint64).Add(1)-1)%uint64(sampleEvery) != 0 {
+					return resp, err
+				}
+			}
 
+			code := "ok"
+			level := slog.LevelInfo
+			msg := "RPC completed"
 			if err != nil {
-				logger.ErrorContext(ctx, "RPC failed",
-					slog.String("procedure", req.Spec().Procedure),
-					slog.Duration("duration", duration),
-					slog.String("error", err.Error()),
-					slog.String("request_id", requestID),
-				)
-			} else {
-				logger.InfoContext(ctx, "RPC completed",
-					slog.String("procedure", req.Spec().Procedure),
-					slog.Duration("duration", duration),
-					slog.String("request_id", requestID),
-				)
+				code = connect.CodeOf(err).String()
+				level = errorLogLevel(connect.CodeOf(err))
+				msg = "RPC failed"
+			} else if slow {
+				level = slog.LevelWarn
+			}
+
+			attrs := []slog.Attr{
+				slog.String("procedure", procedure),
+				slog.String("code", code),
+				slog.Duration("duration", duration),
+				slog.Int("request_size", messageSize(req.Any())),
+				slog.String("user_id", GetUserID(ctx)),
+				slog.String("request_id", GetRequestID(ctx)),
+				slog.String("peer", req.Peer().Addr),
+			}
+			if err == nil {
+				attrs = append(attrs, slog.Int("response_size", messageSize(resp.Any())))
+			}
+			if err != nil {
+				attrs = append(attrs, slog.String("error", err.Error()))
+			}
+			if sampleEvery > 1 && err == nil && !slow {
+				// Lets log-based counts be scaled back up
+				attrs = append(attrs, slog.Int("sample_every", sampleEvery))
+			}
+			logger.LogAttrs(ctx, level, msg, attrs...)
+
+			if logger.Enabled(ctx, slog.LevelDebug) {
+				payload := []slog.Attr{
+					slog.String("procedure", procedure),
+					slog.Any("request", redactedPayload(req.Any())),
+				}
+				if err == nil {
+					payload = append(payload, slog.Any("response", redactedPayload(resp.Any())))
+				}
+				logger.LogAttrs(ctx, slog.LevelDebug, "RPC payload", payload...)
 			}
 
 			return resp, err
@@ -42,44 +99,37 @@ func LoggingInterceptor(logger *slog.Logger) connect.UnaryInterceptorFunc {
 	}
 }
 
-// DebugLoggingInterceptor creates a more verbose logging interceptor
-// that includes additional request details. Use only in development.
-func DebugLoggingInterceptor(logger *slog.Logger) connect.UnaryInterceptorFunc {
-	return func(next connect.UnaryFunc) connect.UnaryFunc {
-		return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
-			start := time.Now()
-
-			// Log request details
-			requestID := GetRequestID(ctx)
-			userID := GetUserID(ctx)
-
-			logger.DebugContext(ctx, "RPC started",
-				slog.String("procedure", req.Spec().Procedure),
-				slog.String("request_id", requestID),
-				slog.String("user_id", userID),
-				slog.String("peer", req.Peer().Addr),
-			)
-
-			resp, err := next(ctx, req)
-
-			duration := time.Since(start)
+// errorLogLevel logs failures of the service at error level, and errors
+// caused by the caller (invalid arguments, missing entities, ...) at info.
+func errorLogLevel(code connect.Code) slog.Level {
+	switch code {
+	case connect.CodeInternal, connect.CodeUnknown, connect.CodeDataLoss,
+		connect.CodeUnavailable, connect.CodeDeadlineExceeded, connect.CodeUnimplemented:
+		return slog.LevelError
+	case connect.CodeResourceExhausted, connect.CodeAborted:
+		return slog.LevelWarn
+	default:
+		return slog.LevelInfo
+	}
+}
 
-			if err != nil {
-				logger.ErrorContext(ctx, "RPC failed",
-					slog.String("procedure", req.Spec().Procedure),
-					slog.Duration("duration", duration),
-					slog.String("error", err.Error()),
-					slog.String("request_id", requestID),
-				)
-			} else {
-				logger.DebugContext(ctx, "RPC completed",
-					slog.String("procedure", req.Spec().Procedure),
-					slog.Duration("duration", duration),
-					slog.String("request_id", requestID),
-				)
-			}
+func messageSize(v any) int {
+	if msg, ok := v.(proto.Message); ok {
+		return proto.Size(msg)
+	}
+	return 0
+}
 
-			return resp, err
-		}
+// redactedPayload renders a message as JSON with its sensitive fields
+// redacted.
+func redactedPayload(v any) any {
+	msg, ok := v.(proto.Message)
+	if !ok {
+		return nil
+	}
+	b, err := protojson.Marshal(Redact(msg))
+	if err != nil {
+		return err.Error()
 	}
+	return json.RawMessage(b)
 }
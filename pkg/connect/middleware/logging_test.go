@@ -0,0 +1,196 @@
+package middleware
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+// logRecorder collects JSON log records.
+type logRecorder struct {
+	mu  sync.Mutex
+	buf bytes.Buffer
+}
+
+func (r *logRecorder) Write(p []byte) (int, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.buf.Write(p)
+}
+
+func (r *logRecorder) records(t *testing.T) []map[string]any {
+	t.Helper()
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var records []map[string]any
+	for _, line := range strings.Split(strings.TrimSpace(r.buf.String()), "\n") {
+		if line == "" {
+			continue
+		}
+		var record map[string]any
+		if err := json.Unmarshal([]byte(line), &record); err != nil {
+			t.Fatalf("invalid log line %q: %v", line, err)
+		}
+		records = append(records, record)
+	}
+	return records
+}
+
+func newRecordingLogger(level slog.Level) (*slog.Logger, *logRecorder) {
+	r := &logRecorder{}
+	return slog.New(slog.NewJSONHandler(r, &slog.HandlerOptions{Level: level})), r
+}
+
+// scriptedHandler fails with the code named by the request ("internal",
+// "not_found", ...), sleeps for "slow", and succeeds otherwise.
+func scriptedHandler(_ context.Context, req *connect.Request[wrapperspb.StringValue]) (*connect.Response[wrapperspb.StringValue], error) {
+	switch v := req.Msg.GetValue(); v {
+	case "slow":
+		time.Sleep(20 * time.Millisecond)
+	case "ok", "":
+	default:
+		var code connect.Code
+		if err := code.UnmarshalText([]byte(v)); err != nil {
+			return nil, err
+		}
+		return nil, connect.NewError(code, errors.New("scripted failure"))
+	}
+	return connect.NewResponse(wrapperspb.String("done")), nil
+}
+
+func newLoggingTestClient(t *testing.T, cfg LoggingConfig, logger *slog.Logger) *connect.Client[wrapperspb.StringValue, wrapperspb.StringValue] {
+	t.Helper()
+	srv := httptest.NewServer(connect.NewUnaryHandler(testCreateProcedure, scriptedHandler,
+		connect.WithInterceptors(LoggingInterceptor(cfg, logger))))
+	t.Cleanup(srv.Close)
+	return connect.NewClient[wrapperspb.StringValue, wrapperspb.StringValue](srv.Client(), srv.URL+testCreateProcedure)
+}
+
+func TestLoggingInterceptor_Levels(t *testing.T) {
+	tests := []struct {
+		value     string
+		wantLevel string
+		wantMsg   string
+		wantCode  string
+	}{
+		{value: "ok", wantLevel: "INFO", wantMsg: "RPC completed", wantCode: "ok"},
+		{value: "slow", wantLevel: "WARN", wantMsg: "RPC completed", wantCode: "ok"},
+		{value: "internal", wantLevel: "ERROR", wantMsg: "RPC failed", wantCode: "internal"},
+		{value: "unavailable", wantLevel: "ERROR", wantMsg: "RPC failed", wantCode: "unavailable"},
+		{value: "resource_exhausted", wantLevel: "WARN", wantMsg: "RPC failed", wantCode: "resource_exhausted"},
+		{value: "not_found", wantLevel: "INFO", wantMsg: "RPC failed", wantCode: "not_found"},
+		{value: "invalid_argument", wantLevel: "INFO", wantMsg: "RPC failed", wantCode: "invalid_argument"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			logger, recorder := newRecordingLogger(slog.LevelInfo)
+			client := newLoggingTestClient(t, LoggingConfig{SlowThreshold: 10 * time.Millisecond}, logger)
+
+			_, _ = client.CallUnary(context.Background(), connect.NewRequest(wrapperspb.String(tt.value)))
+
+			records := recorder.records(t)
+			if len(records) != 1 {
+				t.Fatalf("got %d log records, want 1", len(records))
+			}
+			record := records[0]
+			if record["level"] != tt.wantLevel || record["msg"] != tt.wantMsg || record["code"] != tt.wantCode {
+				t.Errorf("logged %v %q code=%v, want %s %q code=%s",
+					record["level"], record["msg"], record["code"], tt.wantLevel, tt.wantMsg, tt.wantCode)
+			}
+			if record["procedure"] != testCreateProcedure {
+				t.Errorf("procedure = %v, want %s", record["procedure"], testCreateProcedure)
+			}
+		})
+	}
+}
+
+func TestLoggingInterceptor_Sampling(t *testing.T) {
+	logger, recorder := newRecordingLogger(slog.LevelInfo)
+	cfg := LoggingConfig{
+		SampleEvery:   map[string]int{testCreateProcedure: 3},
+		SlowThreshold: 10 * time.Millisecond,
+	}
+	client := newLoggingTestClient(t, cfg, logger)
+
+	// Successful calls 1 and 4 are logged; the failure and the slow call
+	// are logged although they fall between samples.
+	for _, value := range []string{"ok", "ok", "internal", "slow", "ok", "ok"} {
+		_, _ = client.CallUnary(context.Background(), connect.NewRequest(wrapperspb.String(value)))
+	}
+
+	var codes []string
+	for _, record := range recorder.records(t) {
+		code, _ := record["code"].(string)
+		if record["level"] == "WARN" {
+			code = "slow"
+		}
+		codes = append(codes, code)
+		if sampled := record["sample_every"] != nil; sampled != (record["level"] == "INFO") {
+			t.Errorf("%s record: sample_every = %v", code, record["sample_every"])
+		}
+	}
+	if got, want := strings.Join(codes, ","), "ok,internal,slow,ok"; got != want {
+		t.Errorf("logged %s, want %s", got, want)
+	}
+}
+
+func TestLoggingInterceptor_RedactsPayload(t *testing.T) {
+	tests := []struct {
+		name        string
+		level       slog.Level
+		wantPayload bool
+	}{
+		{name: "debug", level: slog.LevelDebug, wantPayload: true},
+		{name: "info", level: slog.LevelInfo, wantPayload: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			logger, recorder := newRecordingLogger(tt.level)
+			next := func(context.Context, connect.AnyRequest) (connect.AnyResponse, error) {
+				return connect.NewResponse(newSecret("response")), nil
+			}
+
+			_, err := LoggingInterceptor(LoggingConfig{}, logger)(next)(context.Background(), connect.NewRequest(newSecret("request")))
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			var payload map[string]any
+			for _, record := range recorder.records(t) {
+				if record["msg"] == "RPC payload" {
+					payload = record
+				}
+			}
+			if (payload != nil) != tt.wantPayload {
+				t.Fatalf("payload logged = %v, want %v", payload != nil, tt.wantPayload)
+			}
+			if payload == nil {
+				return
+			}
+			for _, key := range []string{"request", "response"} {
+				b, err := json.Marshal(payload[key])
+				if err != nil {
+					t.Fatal(err)
+				}
+				if strings.Contains(string(b), "hunter2") || strings.Contains(string(b), "123456") {
+					t.Errorf("%s payload leaks a secret: %s", key, b)
+				}
+				if !strings.Contains(string(b), RedactedValue) {
+					t.Errorf("%s payload is not redacted: %s", key, b)
+				}
+			}
+		})
+	}
+}
@@ -3,6 +3,8 @@ package middleware
 
 import (
 	"context"
+	"net/http"
+	"time"
 
 	"connectrpc.com/connect"
 )
@@ -17,12 +19,24 @@ const (
 
 	// MetadataRequestID is the header key for request correlation ID.
 	MetadataRequestID = "x-request-id"
+
+	// MetadataChannel is the header key for the sales channel of the request
+	// (web, app, marketplace).
+	MetadataChannel = "x-channel"
+
+	// MetadataMarket is the header key for the market of the request
+	// (ISO 3166-1 alpha-2 country code).
+	MetadataMarket = "x-market"
 )
 
 // Context keys for user information.
 type userIDKey struct{}
 type scopesKey struct{}
 type requestIDKey struct{}
+type rolesKey struct{}
+type permissionsKey struct{}
+type channelKey struct{}
+type marketKey struct{}
 
 // GetUserID retrieves the user ID from context.
 func GetUserID(ctx context.Context) string {
@@ -48,6 +62,38 @@ func GetRequestID(ctx context.Context) string {
 	return ""
 }
 
+// GetRoles retrieves the roles from context as space-separated string.
+func GetRoles(ctx context.Context) string {
+	if v := ctx.Value(rolesKey{}); v != nil {
+		return v.(string)
+	}
+	return ""
+}
+
+// GetPermissions retrieves the permissions from context as space-separated string.
+func GetPermissions(ctx context.Context) string {
+	if v := ctx.Value(permissionsKey{}); v != nil {
+		return v.(string)
+	}
+	return ""
+}
+
+// GetChannel retrieves the sales channel from context.
+func GetChannel(ctx context.Context) string {
+	if v := ctx.Value(channelKey{}); v != nil {
+		return v.(string)
+	}
+	return ""
+}
+
+// GetMarket retrieves the market from context.
+func GetMarket(ctx context.Context) string {
+	if v := ctx.Value(marketKey{}); v != nil {
+		return v.(string)
+	}
+	return ""
+}
+
 // WithUserID adds a user ID to the context.
 func WithUserID(ctx context.Context, userID string) context.Context {
 	return context.WithValue(ctx, userIDKey{}, userID)
@@ -63,6 +109,26 @@ func WithRequestID(ctx context.Context, requestID string) context.Context {
 	return context.WithValue(ctx, requestIDKey{}, requestID)
 }
 
+// WithRoles adds roles to the context.
+func WithRoles(ctx context.Context, roles string) context.Context {
+	return context.WithValue(ctx, rolesKey{}, roles)
+}
+
+// WithPermissions adds permissions to the context.
+func WithPermissions(ctx context.Context, permissions string) context.Context {
+	return context.WithValue(ctx, permissionsKey{}, permissions)
+}
+
+// WithChannel adds a sales channel to the context.
+func WithChannel(ctx context.Context, channel string) context.Context {
+	return context.WithValue(ctx, channelKey{}, channel)
+}
+
+// WithMarket adds a market to the context.
+func WithMarket(ctx context.Context, market string) context.Context {
+	return context.WithValue(ctx, marketKey{}, market)
+}
+
 // InjectUserContext creates a context with user information.
 // This is a convenience function for testing and manual context creation.
 func InjectUserContext(ctx context.Context, userID, scopes string) context.Context {
@@ -82,51 +148,54 @@ func ExtractUserContext(req connect.AnyRequest) (userID, scopes, requestID strin
 }
 
 // ContextPropagator injects validated user context into outgoing gRPC metadata
-// for downstream service communication.
-type ContextPropagator struct{}
+// for downstream service communication. A propagator with signing keys
+// signs the context it sends and only trusts context with a valid
+// signature, so services inside the cluster can not forge x-user-id or
+// x-scopes.
+type ContextPropagator struct {
+	keys   []string
+	maxAge time.Duration
+}
 
 // NewContextPropagator creates a new context propagator.
 func NewContextPropagator() *ContextPropagator {
 	return &ContextPropagator{}
 }
 
+// NewSignedContextPropagator creates a context propagator that signs and
+// verifies the propagated context with cfg.SigningKeys.
+func NewSignedContextPropagator(cfg PropagatorConfig) *ContextPropagator {
+	if cfg.MaxAge <= 0 {
+		cfg.MaxAge = DefaultContextSignatureMaxAge
+	}
+	return &ContextPropagator{keys: cfg.SigningKeys, maxAge: cfg.MaxAge}
+}
+
 // ClientPropagatorInterceptor creates a Connect-go client interceptor that propagates
 // user context to downstream services via gRPC metadata headers.
 //
 // This interceptor should be applied to gRPC clients used by the BFF to call
 // backend services. It reads user information from the context (set by
-// AuthInterceptor) and injects it into outgoing request headers.
-func (p *ContextPropagator) ClientPropagatorInterceptor() connect.UnaryInterceptorFunc {
-	return ClientPropagatorInterceptor()
+// AuthInterceptor) and injects it into outgoing request headers, signed
+// when the propagator has signing keys. Streams carry the context of the
+// call that opened them.
+func (p *ContextPropagator) ClientPropagatorInterceptor() connect.Interceptor {
+	return &clientPropagatorInterceptor{propagator: p}
+}
+
+// ServerPropagatorInterceptor creates a Connect-go server interceptor that
+// injects propagated user context into the Go context. When the propagator
+// has signing keys, requests whose context is not signed with one of them
+// are rejected before any header is trusted. Streams are checked when they
+// open.
+func (p *ContextPropagator) ServerPropagatorInterceptor() connect.Interceptor {
+	return &serverPropagatorInterceptor{propagator: p}
 }
 
 // ClientPropagatorInterceptor creates a Connect-go client interceptor that propagates
 // user context to downstream services via gRPC metadata headers.
-func ClientPropagatorInterceptor() connect.UnaryInterceptorFunc {
-	return func(next connect.UnaryFunc) connect.UnaryFunc {
-		return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
-			// Extract user info from context (set by AuthInterceptor)
-			userID := GetUserID(ctx)
-			scopes := GetScopes(ctx)
-			requestID := GetRequestID(ctx)
-
-			// Only inject metadata if user is authenticated
-			if userID != "" {
-				req.Header().Set(MetadataUserID, userID)
-			}
-
-			if scopes != "" {
-				req.Header().Set(MetadataScopes, scopes)
-			}
-
-			// Always propagate request ID if present (for distributed tracing)
-			if requestID != "" {
-				req.Header().Set(MetadataRequestID, requestID)
-			}
-
-			return next(ctx, req)
-		}
-	}
+func ClientPropagatorInterceptor() connect.Interceptor {
+	return NewContextPropagator().ClientPropagatorInterceptor()
 }
 
 // ServerPropagatorInterceptor creates a Connect-go server interceptor that
@@ -135,26 +204,119 @@ func ClientPropagatorInterceptor() connect.UnaryInterceptorFunc {
 //
 // This interceptor should be applied to backend services that receive
 // requests from the BFF with propagated user context.
-func ServerPropagatorInterceptor() connect.UnaryInterceptorFunc {
-	return func(next connect.UnaryFunc) connect.UnaryFunc {
-		return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
-			// Extract user context from headers
-			userID, scopes, requestID := ExtractUserContext(req)
-
-			// Inject into context
-			if userID != "" {
-				ctx = context.WithValue(ctx, userIDKey{}, userID)
-			}
-
-			if scopes != "" {
-				ctx = context.WithValue(ctx, scopesKey{}, scopes)
-			}
-
-			if requestID != "" {
-				ctx = context.WithValue(ctx, requestIDKey{}, requestID)
-			}
-
-			return next(ctx, req)
+func ServerPropagatorInterceptor() connect.Interceptor {
+	return NewContextPropagator().ServerPropagatorInterceptor()
+}
+
+type clientPropagatorInterceptor struct {
+	propagator *ContextPropagator
+}
+
+func (i *clientPropagatorInterceptor) WrapUnary(next connect.UnaryFunc) connect.UnaryFunc {
+	return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+		i.propagator.inject(ctx, req.Spec().Procedure, req.Header(), time.Now())
+		return next(ctx, req)
+	}
+}
+
+func (i *clientPropagatorInterceptor) WrapStreamingClient(next connect.StreamingClientFunc) connect.StreamingClientFunc {
+	return func(ctx context.Context, spec connect.Spec) connect.StreamingClientConn {
+		conn := next(ctx, spec)
+		// Request headers are sent with the first message.
+		i.propagator.inject(ctx, spec.Procedure, conn.RequestHeader(), time.Now())
+		return conn
+	}
+}
+
+func (i *clientPropagatorInterceptor) WrapStreamingHandler(next connect.StreamingHandlerFunc) connect.StreamingHandlerFunc {
+	return next
+}
+
+type serverPropagatorInterceptor struct {
+	propagator *ContextPropagator
+}
+
+func (i *serverPropagatorInterceptor) WrapUnary(next connect.UnaryFunc) connect.UnaryFunc {
+	return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+		ctx, err := i.propagator.extract(ctx, req.Spec().Procedure, req.Header(), time.Now())
+		if err != nil {
+			return nil, err
+		}
+		return next(ctx, req)
+	}
+}
+
+func (i *serverPropagatorInterceptor) WrapStreamingClient(next connect.StreamingClientFunc) connect.StreamingClientFunc {
+	return next
+}
+
+func (i *serverPropagatorInterceptor) WrapStreamingHandler(next connect.StreamingHandlerFunc) connect.StreamingHandlerFunc {
+	return func(ctx context.Context, conn connect.StreamingHandlerConn) error {
+		ctx, err := i.propagator.extract(ctx, conn.Spec().Procedure, conn.RequestHeader(), time.Now())
+		if err != nil {
+			return err
 		}
+		return next(ctx, conn)
 	}
 }
+
+// inject sets the user context headers of an outgoing call to procedure.
+func (p *ContextPropagator) inject(ctx context.Context, procedure string, header http.Header, now time.Time) {
+	// Only inject metadata if user is authenticated
+	if userID := GetUserID(ctx); userID != "" {
+		header.Set(MetadataUserID, userID)
+	}
+
+	if scopes := GetScopes(ctx); scopes != "" {
+		header.Set(MetadataScopes, scopes)
+	}
+
+	// Always propagate request ID if present (for distributed tracing)
+	if requestID := GetRequestID(ctx); requestID != "" {
+		header.Set(MetadataRequestID, requestID)
+	}
+
+	// Channel and market select what public reads may return
+	if channel := GetChannel(ctx); channel != "" {
+		header.Set(MetadataChannel, channel)
+	}
+	if market := GetMarket(ctx); market != "" {
+		header.Set(MetadataMarket, market)
+	}
+
+	if len(p.keys) > 0 {
+		p.signContext(procedure, header, now)
+	}
+}
+
+// extract returns ctx with the user context of an incoming call to
+// procedure, after checking its signature when the propagator has keys.
+func (p *ContextPropagator) extract(ctx context.Context, procedure string, header http.Header, now time.Time) (context.Context, error) {
+	if len(p.keys) > 0 {
+		if err := p.verifyContext(procedure, header, now); err != nil {
+			return nil, connect.NewError(connect.CodeUnauthenticated, err)
+		}
+	}
+
+	if userID := header.Get(MetadataUserID); userID != "" {
+		ctx = context.WithValue(ctx, userIDKey{}, userID)
+	}
+
+	if scopes := header.Get(MetadataScopes); scopes != "" {
+		ctx = context.WithValue(ctx, scopesKey{}, scopes)
+	}
+
+	if requestID := header.Get(MetadataRequestID); requestID != "" {
+		ctx = context.WithValue(ctx, requestIDKey{}, requestID)
+	}
+
+	if channel := header.Get(MetadataChannel); channel != "" {
+		ctx = context.WithValue(ctx, channelKey{}, channel)
+	}
+
+	if market := header.Get(MetadataMarket); market != "" {
+		ctx = context.WithValue(ctx, marketKey{}, market)
+	}
+
+	return ctx, nil
+}
@@ -0,0 +1,105 @@
+package middleware
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Headers carrying the signature of the propagated user context.
+const (
+	// MetadataContextTimestamp is the Unix time the context was signed at.
+	MetadataContextTimestamp = "x-context-timestamp"
+
+	// MetadataContextSignature is "v1=" followed by the hex HMAC-SHA256 of
+	// the timestamp, the procedure and the signed context headers.
+	MetadataContextSignature = "x-context-signature"
+)
+
+// DefaultContextSignatureMaxAge bounds the age of a context signature.
+const DefaultContextSignatureMaxAge = time.Minute
+
+// signedContextHeaders are the propagated headers that grant access or
+// select what a caller may see, in signing order.
+var signedContextHeaders = []string{MetadataUserID, MetadataScopes, MetadataChannel, MetadataMarket}
+
+var (
+	ErrContextSignatureInvalid = errors.New("invalid context signature")
+	ErrContextSignatureExpired = errors.New("context signature outside tolerance")
+)
+
+// PropagatorConfig configures signing of the propagated user context.
+type PropagatorConfig struct {
+	// SigningKeys are shared secrets. Clients sign with the first key;
+	// servers accept any, so a new key can be rolled out to servers before
+	// clients switch to it.
+	SigningKeys []string
+
+	// MaxAge bounds the age of a signature (DefaultContextSignatureMaxAge
+	// when zero), which limits replays of captured headers.
+	MaxAge time.Duration
+}
+
+// signContext sets the signature headers of an outgoing call to procedure.
+func (p *ContextPropagator) signContext(procedure string, header http.Header, now time.Time) {
+	timestamp := strconv.FormatInt(now.Unix(), 10)
+	header.Set(MetadataContextTimestamp, timestamp)
+	header.Set(MetadataContextSignature, "v1="+hex.EncodeToString(contextMAC(p.keys[0], timestamp, procedure, header)))
+}
+
+// verifyContext checks the signature of an incoming call to procedure.
+// Calls without context headers need no signature.
+func (p *ContextPropagator) verifyContext(procedure string, header http.Header, now time.Time) error {
+	signature := header.Get(MetadataContextSignature)
+	if signature == "" && !hasContextHeaders(header) {
+		return nil
+	}
+
+	timestamp := header.Get(MetadataContextTimestamp)
+	unix, err := strconv.ParseInt(timestamp, 10, 64)
+	if err != nil {
+		return ErrContextSignatureInvalid
+	}
+	if age := now.Sub(time.Unix(unix, 0)); age > p.maxAge || age < -p.maxAge {
+		return ErrContextSignatureExpired
+	}
+
+	got, err := hex.DecodeString(strings.TrimPrefix(signature, "v1="))
+	if err != nil {
+		return ErrContextSignatureInvalid
+	}
+	for _, key := range p.keys {
+		if hmac.Equal(got, contextMAC(key, timestamp, procedure, header)) {
+			return nil
+		}
+	}
+	return ErrContextSignatureInvalid
+}
+
+func hasContextHeaders(header http.Header) bool {
+	for _, h := range signedContextHeaders {
+		if header.Get(h) != "" {
+			return true
+		}
+	}
+	return false
+}
+
+// contextMAC binds the context to the procedure, so captured headers can
+// not be replayed against another RPC.
+func contextMAC(key, timestamp, procedure string, header http.Header) []byte {
+	h := hmac.New(sha256.New, []byte(key))
+	h.Write([]byte(timestamp))
+	h.Write([]byte("\n"))
+	h.Write([]byte(procedure))
+	for _, name := range signedContextHeaders {
+		h.Write([]byte("\n"))
+		h.Write([]byte(header.Get(name)))
+	}
+	return h.Sum(nil)
+}
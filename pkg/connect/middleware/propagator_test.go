@@ -0,0 +1,337 @@
+package middleware
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+func TestContextPropagator_Signature(t *testing.T) {
+	now := time.Unix(1_700_000_000, 0)
+	signer := NewSignedContextPropagator(PropagatorConfig{SigningKeys: []string{"key-1"}})
+
+	signed := func() http.Header {
+		header := http.Header{}
+		header.Set(MetadataUserID, "user-123")
+		header.Set(MetadataScopes, "openid orders")
+		header.Set(MetadataChannel, "web")
+		signer.signContext(testCreateProcedure, header, now)
+		return header
+	}
+
+	tests := []struct {
+		name      string
+		keys      []string
+		header    func() http.Header
+		procedure string
+		now       time.Time
+		wantErr   error
+	}{
+		{
+			name:   "round trip",
+			keys:   []string{"key-1"},
+			header: signed,
+		},
+		{
+			name: "tampered user ID",
+			keys: []string{"key-1"},
+			header: func() http.Header {
+				header := signed()
+				header.Set(MetadataUserID, "admin")
+				return header
+			},
+			wantErr: ErrContextSignatureInvalid,
+		},
+		{
+			name: "added scope",
+			keys: []string{"key-1"},
+			header: func() http.Header {
+				header := signed()
+				header.Set(MetadataScopes, "openid orders admin")
+				return header
+			},
+			wantErr: ErrContextSignatureInvalid,
+		},
+		{
+			name: "tampered signature",
+			keys: []string{"key-1"},
+			header: func() http.Header {
+				header := signed()
+				header.Set(MetadataContextSignature, "v1=not-hex")
+				return header
+			},
+			wantErr: ErrContextSignatureInvalid,
+		},
+		{
+			name:      "replayed against another procedure",
+			keys:      []string{"key-1"},
+			header:    signed,
+			procedure: testUpdateProcedure,
+			wantErr:   ErrContextSignatureInvalid,
+		},
+		{
+			name:    "stale",
+			keys:    []string{"key-1"},
+			header:  signed,
+			now:     now.Add(DefaultContextSignatureMaxAge + time.Second),
+			wantErr: ErrContextSignatureExpired,
+		},
+		{
+			name:    "from the future",
+			keys:    []string{"key-1"},
+			header:  signed,
+			now:     now.Add(-DefaultContextSignatureMaxAge - time.Second),
+			wantErr: ErrContextSignatureExpired,
+		},
+		{
+			name:   "within max age",
+			keys:   []string{"key-1"},
+			header: signed,
+			now:    now.Add(DefaultContextSignatureMaxAge - time.Second),
+		},
+		{
+			name:   "old key still accepted during rotation",
+			keys:   []string{"key-2", "key-1"},
+			header: signed,
+		},
+		{
+			name:    "retired key",
+			keys:    []string{"key-2"},
+			header:  signed,
+			wantErr: ErrContextSignatureInvalid,
+		},
+		{
+			name: "unsigned user context",
+			keys: []string{"key-1"},
+			header: func() http.Header {
+				header := http.Header{}
+				header.Set(MetadataUserID, "user-123")
+				return header
+			},
+			wantErr: ErrContextSignatureInvalid,
+		},
+		{
+			name: "unsigned market",
+			keys: []string{"key-1"},
+			header: func() http.Header {
+				header := http.Header{}
+				header.Set(MetadataMarket, "JP")
+				return header
+			},
+			wantErr: ErrContextSignatureInvalid,
+		},
+		{
+			name: "no user context",
+			keys: []string{"key-1"},
+			header: func() http.Header {
+				header := http.Header{}
+				header.Set(MetadataRequestID, "req-1")
+				return header
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			verifier := NewSignedContextPropagator(PropagatorConfig{SigningKeys: tt.keys})
+			procedure := tt.procedure
+			if procedure == "" {
+				procedure = testCreateProcedure
+			}
+			at := tt.now
+			if at.IsZero() {
+				at = now
+			}
+
+			err := verifier.verifyContext(procedure, tt.header(), at)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("verifyContext() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestContextPropagator_NewKeySignsFirst(t *testing.T) {
+	now := time.Now()
+	header := http.Header{}
+	header.Set(MetadataUserID, "user-123")
+	NewSignedContextPropagator(PropagatorConfig{SigningKeys: []string{"key-2", "key-1"}}).signContext(testCreateProcedure, header, now)
+
+	if err := NewSignedContextPropagator(PropagatorConfig{SigningKeys: []string{"key-2"}}).verifyContext(testCreateProcedure, header, now); err != nil {
+		t.Errorf("server with the new key: %v", err)
+	}
+	if err := NewSignedContextPropagator(PropagatorConfig{SigningKeys: []string{"key-1"}}).verifyContext(testCreateProcedure, header, now); err == nil {
+		t.Error("server with only the old key accepted a context signed with the new one")
+	}
+}
+
+// propagationServer records the user ID seen by its handlers.
+type propagationServer struct {
+	userIDs chan string
+	server  *httptest.Server
+}
+
+func newPropagationServer(t *testing.T, propagator *ContextPropagator) *propagationServer {
+	t.Helper()
+	s := &propagationServer{userIDs: make(chan string, 1)}
+	interceptors := connect.WithInterceptors(propagator.ServerPropagatorInterceptor())
+
+	mux := http.NewServeMux()
+	mux.Handle(testCreateProcedure, connect.NewUnaryHandler(testCreateProcedure,
+		func(ctx context.Context, req *connect.Request[wrapperspb.StringValue]) (*connect.Response[wrapperspb.StringValue], error) {
+			s.userIDs <- GetUserID(ctx)
+			return connect.NewResponse(req.Msg), nil
+		}, interceptors))
+	mux.Handle(testWatchProcedure, connect.NewServerStreamHandler(testWatchProcedure,
+		func(ctx context.Context, req *connect.Request[wrapperspb.StringValue], stream *connect.ServerStream[wrapperspb.StringValue]) error {
+			s.userIDs <- GetUserID(ctx)
+			return stream.Send(req.Msg)
+		}, interceptors))
+	s.server = httptest.NewServer(mux)
+	t.Cleanup(s.server.Close)
+	return s
+}
+
+func (s *propagationServer) call(ctx context.Context, procedure string, interceptor connect.Interceptor) error {
+	client := connect.NewClient[wrapperspb.StringValue, wrapperspb.StringValue](s.server.Client(), s.server.URL+procedure,
+		connect.WithInterceptors(interceptor))
+	req := connect.NewRequest(wrapperspb.String("a"))
+	if procedure == testCreateProcedure {
+		_, err := client.CallUnary(ctx, req)
+		return err
+	}
+	stream, err := client.CallServerStream(ctx, req)
+	if err != nil {
+		return err
+	}
+	defer stream.Close()
+	for stream.Receive() {
+	}
+	return stream.Err()
+}
+
+func TestPropagatorInterceptors(t *testing.T) {
+	signing := PropagatorConfig{SigningKeys: []string{"key-1"}}
+	ctx := WithUserID(context.Background(), "user-123")
+
+	// forgeUser sets x-user-id like a compromised service could.
+	forgeUser := connect.UnaryInterceptorFunc(func(next connect.UnaryFunc) connect.UnaryFunc {
+		return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+			req.Header().Set(MetadataUserID, "admin")
+			return next(ctx, req)
+		}
+	})
+	forgeUserStream := streamingClientHeader(MetadataUserID, "admin")
+
+	tests := []struct {
+		name       string
+		server     *ContextPropagator
+		client     connect.Interceptor
+		procedure  string
+		wantUserID string
+		wantCode   connect.Code
+	}{
+		{
+			name:       "unsigned unary",
+			server:     NewContextPropagator(),
+			client:     ClientPropagatorInterceptor(),
+			procedure:  testCreateProcedure,
+			wantUserID: "user-123",
+		},
+		{
+			name:       "unsigned stream",
+			server:     NewContextPropagator(),
+			client:     ClientPropagatorInterceptor(),
+			procedure:  testWatchProcedure,
+			wantUserID: "user-123",
+		},
+		{
+			name:       "signed unary",
+			server:     NewSignedContextPropagator(signing),
+			client:     NewSignedContextPropagator(signing).ClientPropagatorInterceptor(),
+			procedure:  testCreateProcedure,
+			wantUserID: "user-123",
+		},
+		{
+			name:       "signed stream",
+			server:     NewSignedContextPropagator(signing),
+			client:     NewSignedContextPropagator(signing).ClientPropagatorInterceptor(),
+			procedure:  testWatchProcedure,
+			wantUserID: "user-123",
+		},
+		{
+			name:      "forged unary",
+			server:    NewSignedContextPropagator(signing),
+			client:    forgeUser,
+			procedure: testCreateProcedure,
+			wantCode:  connect.CodeUnauthenticated,
+		},
+		{
+			name:      "forged stream",
+			server:    NewSignedContextPropagator(signing),
+			client:    forgeUserStream,
+			procedure: testWatchProcedure,
+			wantCode:  connect.CodeUnauthenticated,
+		},
+		{
+			name:      "stream signed with another key",
+			server:    NewSignedContextPropagator(signing),
+			client:    NewSignedContextPropagator(PropagatorConfig{SigningKeys: []string{"key-2"}}).ClientPropagatorInterceptor(),
+			procedure: testWatchProcedure,
+			wantCode:  connect.CodeUnauthenticated,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := newPropagationServer(t, tt.server)
+			err := s.call(ctx, tt.procedure, tt.client)
+			if tt.wantCode != 0 {
+				if connect.CodeOf(err) != tt.wantCode {
+					t.Errorf("code = %v, want %v", connect.CodeOf(err), tt.wantCode)
+				}
+				if len(s.userIDs) != 0 {
+					t.Error("handler ran for a rejected call")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got := <-s.userIDs; got != tt.wantUserID {
+				t.Errorf("handler saw user %q, want %q", got, tt.wantUserID)
+			}
+		})
+	}
+}
+
+// streamingClientHeader sets a request header on the streams it opens.
+func streamingClientHeader(key, value string) connect.Interceptor {
+	return &headerStreamInterceptor{key: key, value: value}
+}
+
+type headerStreamInterceptor struct {
+	key, value string
+}
+
+func (i *headerStreamInterceptor) WrapUnary(next connect.UnaryFunc) connect.UnaryFunc {
+	return next
+}
+
+func (i *headerStreamInterceptor) WrapStreamingClient(next connect.StreamingClientFunc) connect.StreamingClientFunc {
+	return func(ctx context.Context, spec connect.Spec) connect.StreamingClientConn {
+		conn := next(ctx, spec)
+		conn.RequestHeader().Set(i.key, i.value)
+		return conn
+	}
+}
+
+func (i *headerStreamInterceptor) WrapStreamingHandler(next connect.StreamingHandlerFunc) connect.StreamingHandlerFunc {
+	return next
+}
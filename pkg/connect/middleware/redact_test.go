@@ -0,0 +1,140 @@
+package middleware
+
+import (
+	"testing"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/dynamicpb"
+)
+
+// secretDescriptor describes a message with redacted fields at every
+// level of nesting:
+//
+//	message Secret {
+//	  string name = 1;
+//	  string password = 2 [debug_redact = true];
+//	  int32 pin = 3 [debug_redact = true];
+//	  repeated string codes = 4 [debug_redact = true];
+//	  Secret child = 5;
+//	  repeated Secret children = 6;
+//	  map<string, Secret> by_name = 7;
+//	}
+var secretDescriptor = func() protoreflect.MessageDescriptor {
+	redact := &descriptorpb.FieldOptions{DebugRedact: proto.Bool(true)}
+	field := func(name string, number int32, label descriptorpb.FieldDescriptorProto_Label, typ descriptorpb.FieldDescriptorProto_Type, typeName string, opts *descriptorpb.FieldOptions) *descriptorpb.FieldDescriptorProto {
+		f := &descriptorpb.FieldDescriptorProto{
+			Name:     proto.String(name),
+			JsonName: proto.String(name),
+			Number:   proto.Int32(number),
+			Label:    label.Enum(),
+			Type:     typ.Enum(),
+			Options:  opts,
+		}
+		if typeName != "" {
+			f.TypeName = proto.String(typeName)
+		}
+		return f
+	}
+	const (
+		optional = descriptorpb.FieldDescriptorProto_LABEL_OPTIONAL
+		repeated = descriptorpb.FieldDescriptorProto_LABEL_REPEATED
+		str      = descriptorpb.FieldDescriptorProto_TYPE_STRING
+		int32T   = descriptorpb.FieldDescriptorProto_TYPE_INT32
+		msg      = descriptorpb.FieldDescriptorProto_TYPE_MESSAGE
+	)
+
+	file, err := protodesc.NewFile(&descriptorpb.FileDescriptorProto{
+		Name:    proto.String("redact_test.proto"),
+		Package: proto.String("middleware.test"),
+		Syntax:  proto.String("proto3"),
+		MessageType: []*descriptorpb.DescriptorProto{{
+			Name: proto.String("Secret"),
+			Field: []*descriptorpb.FieldDescriptorProto{
+				field("name", 1, optional, str, "", nil),
+				field("password", 2, optional, str, "", redact),
+				field("pin", 3, optional, int32T, "", redact),
+				field("codes", 4, repeated, str, "", redact),
+				field("child", 5, optional, msg, ".middleware.test.Secret", nil),
+				field("children", 6, repeated, msg, ".middleware.test.Secret", nil),
+				field("by_name", 7, repeated, msg, ".middleware.test.Secret.ByNameEntry", nil),
+			},
+			NestedType: []*descriptorpb.DescriptorProto{{
+				Name: proto.String("ByNameEntry"),
+				Field: []*descriptorpb.FieldDescriptorProto{
+					field("key", 1, optional, str, "", nil),
+					field("value", 2, optional, msg, ".middleware.test.Secret", nil),
+				},
+				Options: &descriptorpb.MessageOptions{MapEntry: proto.Bool(true)},
+			}},
+		}},
+	}, nil)
+	if err != nil {
+		panic(err)
+	}
+	return file.Messages().Get(0)
+}()
+
+// newSecret returns a Secret with every field set.
+func newSecret(name string) *dynamicpb.Message {
+	m := dynamicpb.NewMessage(secretDescriptor)
+	fields := secretDescriptor.Fields()
+	m.Set(fields.ByName("name"), protoreflect.ValueOfString(name))
+	m.Set(fields.ByName("password"), protoreflect.ValueOfString("hunter2"))
+	m.Set(fields.ByName("pin"), protoreflect.ValueOfInt32(1234))
+	codes := m.Mutable(fields.ByName("codes")).List()
+	codes.Append(protoreflect.ValueOfString("123456"))
+	return m
+}
+
+// assertRedacted checks the fields of a Secret produced by newSecret.
+func assertRedacted(t *testing.T, path string, m protoreflect.Message, name string) {
+	t.Helper()
+	fields := secretDescriptor.Fields()
+	if got := m.Get(fields.ByName("name")).String(); got != name {
+		t.Errorf("%s.name = %q, want %q", path, got, name)
+	}
+	if got := m.Get(fields.ByName("password")).String(); got != RedactedValue {
+		t.Errorf("%s.password = %q, want %q", path, got, RedactedValue)
+	}
+	if m.Has(fields.ByName("pin")) {
+		t.Errorf("%s.pin is set, want cleared", path)
+	}
+	if m.Has(fields.ByName("codes")) {
+		t.Errorf("%s.codes is set, want cleared", path)
+	}
+}
+
+func TestRedact(t *testing.T) {
+	fields := secretDescriptor.Fields()
+	msg := newSecret("root")
+	msg.Set(fields.ByName("child"), protoreflect.ValueOfMessage(newSecret("child")))
+	children := msg.Mutable(fields.ByName("children")).List()
+	children.Append(protoreflect.ValueOfMessage(newSecret("list")))
+	byName := msg.Mutable(fields.ByName("by_name")).Map()
+	byName.Set(protoreflect.ValueOfString("k").MapKey(), protoreflect.ValueOfMessage(newSecret("map")))
+
+	original := proto.Clone(msg)
+	redacted := Redact(msg).ProtoReflect()
+
+	assertRedacted(t, "root", redacted, "root")
+	assertRedacted(t, "child", redacted.Get(fields.ByName("child")).Message(), "child")
+	assertRedacted(t, "children[0]", redacted.Get(fields.ByName("children")).List().Get(0).Message(), "list")
+	assertRedacted(t, "by_name[k]", redacted.Get(fields.ByName("by_name")).Map().Get(protoreflect.ValueOfString("k").MapKey()).Message(), "map")
+
+	if !proto.Equal(msg, original) {
+		t.Error("Redact() modified its argument")
+	}
+}
+
+func TestRedact_LeavesUnsetFieldsUnset(t *testing.T) {
+	msg := dynamicpb.NewMessage(secretDescriptor)
+	msg.Set(secretDescriptor.Fields().ByName("name"), protoreflect.ValueOfString("a"))
+
+	redacted := Redact(msg).ProtoReflect()
+	if redacted.Has(secretDescriptor.Fields().ByName("password")) {
+		t.Error("unset password was set to the redacted value")
+	}
+}
@@ -0,0 +1,108 @@
+package middleware
+
+import (
+	"context"
+	"log/slog"
+	"math/rand/v2"
+	"time"
+
+	"connectrpc.com/connect"
+)
+
+// RetryConfig configures RetryInterceptor.
+type RetryConfig struct {
+	// MaxAttempts is the maximum number of attempts, including the first.
+	MaxAttempts int
+
+	// InitialBackoff is the upper bound of the first backoff. Each further
+	// backoff doubles, up to MaxBackoff. The actual sleep is drawn uniformly
+	// from [0, bound) so retries from many callers spread out.
+	InitialBackoff time.Duration
+	MaxBackoff     time.Duration
+
+	// Budget is the total time a request may spend across all attempts and
+	// backoffs. No retry is started once it is spent. Zero means no budget
+	// beyond the context deadline.
+	Budget time.Duration
+
+	// Codes are the error codes that are retried. Defaults to Unavailable
+	// and DeadlineExceeded.
+	Codes []connect.Code
+}
+
+// RetryInterceptor creates a Connect-go client interceptor that retries
+// transient failures with exponential backoff and full jitter.
+//
+// Only requests that are safe to repeat are retried: procedures declared
+// with no side effects or as idempotent, and requests carrying an
+// Idempotency-Key header, which the server uses to deduplicate them.
+func RetryInterceptor(cfg RetryConfig, logger *slog.Logger) connect.UnaryInterceptorFunc {
+	codes := cfg.Codes
+	if len(codes) == 0 {
+		codes = []connect.Code{connect.CodeUnavailable, connect.CodeDeadlineExceeded}
+	}
+	retryable := make(map[connect.Code]struct{}, len(codes))
+	for _, code := range codes {
+		retryable[code] = struct{}{}
+	}
+
+	return func(next connect.UnaryFunc) connect.UnaryFunc {
+		return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+			if !req.Spec().IsClient || cfg.MaxAttempts <= 1 || !isRetrySafe(req) {
+				return next(ctx, req)
+			}
+
+			var deadline time.Time
+			if cfg.Budget > 0 {
+				deadline = time.Now().Add(cfg.Budget)
+			}
+			backoff := cfg.InitialBackoff
+
+			for attempt := 1; ; attempt++ {
+				resp, err := next(ctx, req)
+				if err == nil {
+					return resp, nil
+				}
+				if _, ok := retryable[connect.CodeOf(err)]; !ok || attempt >= cfg.MaxAttempts || ctx.Err() != nil {
+					return nil, err
+				}
+
+				sleep := time.Duration(0)
+				if backoff > 0 {
+					sleep = rand.N(backoff)
+				}
+				if !deadline.IsZero() && time.Now().Add(sleep).After(deadline) {
+					return nil, err
+				}
+
+				logger.DebugContext(ctx, "retrying RPC",
+					slog.String("procedure", req.Spec().Procedure),
+					slog.Int("attempt", attempt),
+					slog.Duration("backoff", sleep),
+					slog.String("error", err.Error()),
+				)
+
+				timer := time.NewTimer(sleep)
+				select {
+				case <-ctx.Done():
+					timer.Stop()
+					return nil, err
+				case <-timer.C:
+				}
+
+				backoff *= 2
+				if cfg.MaxBackoff > 0 && backoff > cfg.MaxBackoff {
+					backoff = cfg.MaxBackoff
+				}
+			}
+		}
+	}
+}
+
+func isRetrySafe(req connect.AnyRequest) bool {
+	switch req.Spec().IdempotencyLevel {
+	case connect.IdempotencyNoSideEffects, connect.IdempotencyIdempotent:
+		return true
+	}
+	return req.Header().Get(IdempotencyKeyHeader) != ""
+}
@@ -0,0 +1,186 @@
+package middleware
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+// flakyHandler fails its first `failures` calls with code.
+type flakyHandler struct {
+	mu       sync.Mutex
+	failures int
+	code     connect.Code
+	calls    int
+}
+
+func (h *flakyHandler) handle(_ context.Context, req *connect.Request[wrapperspb.StringValue]) (*connect.Response[wrapperspb.StringValue], error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.calls++
+	if h.calls <= h.failures {
+		return nil, connect.NewError(h.code, errors.New("transient failure"))
+	}
+	return connect.NewResponse(req.Msg), nil
+}
+
+func (h *flakyHandler) count() int {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	return h.calls
+}
+
+func TestRetryInterceptor(t *testing.T) {
+	cfg := RetryConfig{
+		MaxAttempts:    3,
+		InitialBackoff: time.Millisecond,
+		MaxBackoff:     2 * time.Millisecond,
+	}
+
+	tests := []struct {
+		name        string
+		cfg         RetryConfig
+		failures    int
+		code        connect.Code
+		idempotency connect.IdempotencyLevel
+		key         string
+		wantCode    connect.Code
+		wantCalls   int
+	}{
+		{
+			name:        "retries until success",
+			cfg:         cfg,
+			failures:    2,
+			code:        connect.CodeUnavailable,
+			idempotency: connect.IdempotencyIdempotent,
+			wantCalls:   3,
+		},
+		{
+			name:        "gives up after max attempts",
+			cfg:         cfg,
+			failures:    5,
+			code:        connect.CodeUnavailable,
+			idempotency: connect.IdempotencyIdempotent,
+			wantCode:    connect.CodeUnavailable,
+			wantCalls:   3,
+		},
+		{
+			name:        "deadline exceeded is retried by default",
+			cfg:         cfg,
+			failures:    1,
+			code:        connect.CodeDeadlineExceeded,
+			idempotency: connect.IdempotencyNoSideEffects,
+			wantCalls:   2,
+		},
+		{
+			name:        "other codes are not retried",
+			cfg:         cfg,
+			failures:    1,
+			code:        connect.CodeInternal,
+			idempotency: connect.IdempotencyIdempotent,
+			wantCode:    connect.CodeInternal,
+			wantCalls:   1,
+		},
+		{
+			name:        "configured codes replace the defaults",
+			cfg:         RetryConfig{MaxAttempts: 3, Codes: []connect.Code{connect.CodeResourceExhausted}},
+			failures:    1,
+			code:        connect.CodeUnavailable,
+			idempotency: connect.IdempotencyIdempotent,
+			wantCode:    connect.CodeUnavailable,
+			wantCalls:   1,
+		},
+		{
+			name:      "mutation without an idempotency key is not retried",
+			cfg:       cfg,
+			failures:  1,
+			code:      connect.CodeUnavailable,
+			wantCode:  connect.CodeUnavailable,
+			wantCalls: 1,
+		},
+		{
+			name:      "mutation with an idempotency key is retried",
+			cfg:       cfg,
+			failures:  1,
+			code:      connect.CodeUnavailable,
+			key:       "key-1",
+			wantCalls: 2,
+		},
+		{
+			name: "no retry once the budget is spent",
+			cfg: RetryConfig{
+				MaxAttempts:    3,
+				InitialBackoff: time.Hour,
+				Budget:         time.Millisecond,
+			},
+			failures:    1,
+			code:        connect.CodeUnavailable,
+			idempotency: connect.IdempotencyIdempotent,
+			wantCode:    connect.CodeUnavailable,
+			wantCalls:   1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := &flakyHandler{failures: tt.failures, code: tt.code}
+			srv := httptest.NewServer(connect.NewUnaryHandler(testCreateProcedure, h.handle))
+			defer srv.Close()
+
+			client := connect.NewClient[wrapperspb.StringValue, wrapperspb.StringValue](
+				srv.Client(), srv.URL+testCreateProcedure,
+				connect.WithIdempotency(tt.idempotency),
+				connect.WithInterceptors(RetryInterceptor(tt.cfg, slog.New(slog.DiscardHandler))),
+			)
+			req := connect.NewRequest(wrapperspb.String("a"))
+			if tt.key != "" {
+				req.Header().Set(IdempotencyKeyHeader, tt.key)
+			}
+
+			_, err := client.CallUnary(context.Background(), req)
+			if tt.wantCode == 0 && err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if tt.wantCode != 0 && connect.CodeOf(err) != tt.wantCode {
+				t.Errorf("code = %v, want %v", connect.CodeOf(err), tt.wantCode)
+			}
+			if got := h.count(); got != tt.wantCalls {
+				t.Errorf("handler ran %d times, want %d", got, tt.wantCalls)
+			}
+		})
+	}
+}
+
+func TestRetryInterceptor_StopsWhenContextDone(t *testing.T) {
+	h := &flakyHandler{failures: 5, code: connect.CodeUnavailable}
+	srv := httptest.NewServer(connect.NewUnaryHandler(testCreateProcedure, h.handle))
+	defer srv.Close()
+
+	cfg := RetryConfig{MaxAttempts: 5, InitialBackoff: time.Hour}
+	client := connect.NewClient[wrapperspb.StringValue, wrapperspb.StringValue](
+		srv.Client(), srv.URL+testCreateProcedure,
+		connect.WithIdempotency(connect.IdempotencyIdempotent),
+		connect.WithInterceptors(RetryInterceptor(cfg, slog.New(slog.DiscardHandler))),
+	)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	start := time.Now()
+	_, err := client.CallUnary(ctx, connect.NewRequest(wrapperspb.String("a")))
+	if connect.CodeOf(err) != connect.CodeUnavailable {
+		t.Errorf("code = %v, want the last attempt's %v", connect.CodeOf(err), connect.CodeUnavailable)
+	}
+	if elapsed := time.Since(start); elapsed > 5*time.Second {
+		t.Errorf("backoff outlived the context: %v", elapsed)
+	}
+	if got := h.count(); got != 1 {
+		t.Errorf("handler ran %d times, want 1", got)
+	}
+}
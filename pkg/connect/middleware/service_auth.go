@@ -0,0 +1,269 @@
+package middleware
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"os"
+	"strings"
+
+	"connectrpc.com/connect"
+)
+
+// MetadataAuthorization is the header carrying the calling service's
+// client credentials access token.
+const MetadataAuthorization = "Authorization"
+
+type serviceIdentityKey struct{}
+type peerCertificateKey struct{}
+
+// GetServiceIdentity retrieves the authenticated calling service from
+// context: its OAuth2 client ID or its client certificate identity.
+func GetServiceIdentity(ctx context.Context) string {
+	if v := ctx.Value(serviceIdentityKey{}); v != nil {
+		return v.(string)
+	}
+	return ""
+}
+
+// ServiceAuthConfig configures ServiceAuthInterceptor.
+type ServiceAuthConfig struct {
+	// Tokens verifies client credentials access tokens. When nil only
+	// mutual TLS identities are accepted.
+	Tokens *ServiceTokenVerifier
+
+	// AllowedServices lists the services allowed to call: OAuth2 client
+	// IDs, and client certificate URI SANs (e.g. spiffe://ec/bff) or
+	// common names.
+	AllowedServices []string
+}
+
+// ServiceAuthInterceptor creates a Connect-go server interceptor that
+// rejects calls without a valid service identity, so that user context
+// headers (x-user-id, x-scopes) are only trusted from known services.
+// A verified client certificate (see PeerCertificateMiddleware) takes
+// precedence over a bearer token. It must run before
+// ServerPropagatorInterceptor. Streaming calls are authenticated the same
+// way when the stream opens.
+func ServiceAuthInterceptor(cfg ServiceAuthConfig, logger *slog.Logger) connect.Interceptor {
+	allowed := make(map[string]bool, len(cfg.AllowedServices))
+	for _, s := range cfg.AllowedServices {
+		allowed[s] = true
+	}
+	return &serviceAuthInterceptor{tokens: cfg.Tokens, allowed: allowed, logger: logger}
+}
+
+type serviceAuthInterceptor struct {
+	tokens  *ServiceTokenVerifier
+	allowed map[string]bool
+	logger  *slog.Logger
+}
+
+func (i *serviceAuthInterceptor) WrapUnary(next connect.UnaryFunc) connect.UnaryFunc {
+	return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+		ctx, err := i.authenticate(ctx, req.Spec().Procedure, req.Peer().Addr, req.Header())
+		if err != nil {
+			return nil, err
+		}
+		return next(ctx, req)
+	}
+}
+
+func (i *serviceAuthInterceptor) WrapStreamingClient(next connect.StreamingClientFunc) connect.StreamingClientFunc {
+	return next
+}
+
+func (i *serviceAuthInterceptor) WrapStreamingHandler(next connect.StreamingHandlerFunc) connect.StreamingHandlerFunc {
+	return func(ctx context.Context, conn connect.StreamingHandlerConn) error {
+		ctx, err := i.authenticate(ctx, conn.Spec().Procedure, conn.Peer().Addr, conn.RequestHeader())
+		if err != nil {
+			return err
+		}
+		return next(ctx, conn)
+	}
+}
+
+// authenticate returns ctx with the identity of the calling service.
+func (i *serviceAuthInterceptor) authenticate(ctx context.Context, procedure, peer string, header http.Header) (context.Context, error) {
+	identity, err := serviceIdentity(ctx, i.tokens, header)
+	if err != nil {
+		i.logger.WarnContext(ctx, "service authentication failed",
+			slog.String("procedure", procedure),
+			slog.String("peer", peer),
+			slog.String("error", err.Error()),
+		)
+		return nil, connect.NewError(connect.CodeUnauthenticated, errors.New("service identity required"))
+	}
+	if !i.allowed[identity] {
+		i.logger.WarnContext(ctx, "service not allowed",
+			slog.String("procedure", procedure),
+			slog.String("service", identity),
+		)
+		return nil, connect.NewError(connect.CodePermissionDenied, fmt.Errorf("service %q is not allowed", identity))
+	}
+	return context.WithValue(ctx, serviceIdentityKey{}, identity), nil
+}
+
+func serviceIdentity(ctx context.Context, tokens *ServiceTokenVerifier, header http.Header) (string, error) {
+	if cert, ok := ctx.Value(peerCertificateKey{}).(*x509.Certificate); ok {
+		return certificateIdentity(cert), nil
+	}
+	if tokens == nil {
+		return "", errors.New("no client certificate")
+	}
+	token, ok := strings.CutPrefix(header.Get(MetadataAuthorization), "Bearer ")
+	if !ok || token == "" {
+		return "", errors.New("no client certificate or bearer token")
+	}
+	return tokens.Verify(ctx, token)
+}
+
+// certificateIdentity is the first URI SAN (a SPIFFE ID) of a client
+// certificate, else its subject common name.
+func certificateIdentity(cert *x509.Certificate) string {
+	if len(cert.URIs) > 0 {
+		return cert.URIs[0].String()
+	}
+	return cert.Subject.CommonName
+}
+
+// PeerCertificateMiddleware passes the verified client certificate of a
+// mutual TLS connection to ServiceAuthInterceptor. Unverified certificates
+// are ignored.
+func PeerCertificateMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.TLS != nil && len(r.TLS.VerifiedChains) > 0 && len(r.TLS.VerifiedChains[0]) > 0 {
+			ctx := context.WithValue(r.Context(), peerCertificateKey{}, r.TLS.VerifiedChains[0][0])
+			r = r.WithContext(ctx)
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+// ServerTLSConfig returns the TLS configuration of a service. When
+// clientCAFile is set, client certificates signed by it are verified;
+// clients without one can still authenticate with a token.
+func ServerTLSConfig(certFile, keyFile, clientCAFile string) (*tls.Config, error) {
+	cert, err := tls.LoadX509KeyPair(certFile, keyFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load server certificate: %w", err)
+	}
+	cfg := &tls.Config{
+		Certificates: []tls.Certificate{cert},
+		MinVersion:   tls.VersionTLS12,
+	}
+	if clientCAFile != "" {
+		pool, err := loadCertPool(clientCAFile)
+		if err != nil {
+			return nil, err
+		}
+		cfg.ClientCAs = pool
+		cfg.ClientAuth = tls.VerifyClientCertIfGiven
+	}
+	return cfg, nil
+}
+
+// ClientTLSConfig returns the TLS configuration for calling services.
+// certFile and keyFile are the client certificate for mutual TLS, and
+// caFile verifies the services; both are optional.
+func ClientTLSConfig(certFile, keyFile, caFile string) (*tls.Config, error) {
+	cfg := &tls.Config{MinVersion: tls.VersionTLS12}
+	if certFile != "" {
+		cert, err := tls.LoadX509KeyPair(certFile, keyFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load client certificate: %w", err)
+		}
+		cfg.Certificates = []tls.Certificate{cert}
+	}
+	if caFile != "" {
+		pool, err := loadCertPool(caFile)
+		if err != nil {
+			return nil, err
+		}
+		cfg.RootCAs = pool
+	}
+	return cfg, nil
+}
+
+func loadCertPool(file string) (*x509.CertPool, error) {
+	pem, err := os.ReadFile(file)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read CA certificates: %w", err)
+	}
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(pem) {
+		return nil, fmt.Errorf("no CA certificates in %s", file)
+	}
+	return pool, nil
+}
+
+// ServiceTokenInterceptor creates a Connect-go client interceptor that
+// authenticates calls with an access token of the calling service. A
+// stream that cannot get a token fails on its first Send or Receive
+// without reaching the server.
+func ServiceTokenInterceptor(tokens *ClientCredentials) connect.Interceptor {
+	return &serviceTokenInterceptor{tokens: tokens}
+}
+
+type serviceTokenInterceptor struct {
+	tokens *ClientCredentials
+}
+
+func (i *serviceTokenInterceptor) WrapUnary(next connect.UnaryFunc) connect.UnaryFunc {
+	return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+		token, err := i.token(ctx)
+		if err != nil {
+			return nil, err
+		}
+		req.Header().Set(MetadataAuthorization, "Bearer "+token)
+		return next(ctx, req)
+	}
+}
+
+func (i *serviceTokenInterceptor) WrapStreamingClient(next connect.StreamingClientFunc) connect.StreamingClientFunc {
+	return func(ctx context.Context, spec connect.Spec) connect.StreamingClientConn {
+		token, err := i.token(ctx)
+		if err != nil {
+			return &failedStreamingClientConn{spec: spec, err: err, requestHeader: make(http.Header)}
+		}
+		conn := next(ctx, spec)
+		// Headers are sent with the first message, so setting them here is
+		// in time.
+		conn.RequestHeader().Set(MetadataAuthorization, "Bearer "+token)
+		return conn
+	}
+}
+
+func (i *serviceTokenInterceptor) WrapStreamingHandler(next connect.StreamingHandlerFunc) connect.StreamingHandlerFunc {
+	return next
+}
+
+func (i *serviceTokenInterceptor) token(ctx context.Context) (string, error) {
+	token, err := i.tokens.Token(ctx)
+	if err != nil {
+		return "", connect.NewError(connect.CodeUnavailable, fmt.Errorf("failed to obtain service token: %w", err))
+	}
+	return token, nil
+}
+
+// failedStreamingClientConn is a stream that could not be opened. It
+// returns err from Send and Receive and never makes a request.
+type failedStreamingClientConn struct {
+	spec          connect.Spec
+	err           error
+	requestHeader http.Header
+}
+
+func (c *failedStreamingClientConn) Spec() connect.Spec           { return c.spec }
+func (c *failedStreamingClientConn) Peer() connect.Peer           { return connect.Peer{} }
+func (c *failedStreamingClientConn) Send(any) error               { return c.err }
+func (c *failedStreamingClientConn) RequestHeader() http.Header   { return c.requestHeader }
+func (c *failedStreamingClientConn) CloseRequest() error          { return nil }
+func (c *failedStreamingClientConn) Receive(any) error            { return c.err }
+func (c *failedStreamingClientConn) ResponseHeader() http.Header  { return http.Header{} }
+func (c *failedStreamingClientConn) ResponseTrailer() http.Header { return http.Header{} }
+func (c *failedStreamingClientConn) CloseResponse() error         { return nil }
@@ -0,0 +1,216 @@
+package middleware
+
+import (
+	"context"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync/atomic"
+	"testing"
+
+	"connectrpc.com/connect"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+func TestServiceAuthInterceptor_Authenticate(t *testing.T) {
+	keys := newTestIssuerKeys(t, "key-1")
+	validToken := signToken(t, keys.keys["key-1"], "RS256", "key-1", serviceClaims())
+	userClaims := serviceClaims()
+	userClaims["sub"] = "user-123"
+	userToken := signToken(t, keys.keys["key-1"], "RS256", "key-1", userClaims)
+
+	spiffeID, _ := url.Parse("spiffe://ec/bff")
+	spiffeCert := &x509.Certificate{URIs: []*url.URL{spiffeID}, Subject: pkix.Name{CommonName: "ignored"}}
+	namedCert := &x509.Certificate{Subject: pkix.Name{CommonName: "reservebench"}}
+	withCert := func(cert *x509.Certificate) context.Context {
+		return context.WithValue(context.Background(), peerCertificateKey{}, cert)
+	}
+
+	tests := []struct {
+		name         string
+		tokens       bool
+		ctx          context.Context
+		header       string
+		wantCode     connect.Code
+		wantIdentity string
+	}{
+		{
+			name:         "allowed service token",
+			tokens:       true,
+			ctx:          context.Background(),
+			header:       "Bearer " + validToken,
+			wantIdentity: "bff",
+		},
+		{
+			name:     "no credentials",
+			tokens:   true,
+			ctx:      context.Background(),
+			wantCode: connect.CodeUnauthenticated,
+		},
+		{
+			name:     "not a bearer token",
+			tokens:   true,
+			ctx:      context.Background(),
+			header:   "Basic " + validToken,
+			wantCode: connect.CodeUnauthenticated,
+		},
+		{
+			name:     "user token",
+			tokens:   true,
+			ctx:      context.Background(),
+			header:   "Bearer " + userToken,
+			wantCode: connect.CodeUnauthenticated,
+		},
+		{
+			name:     "token without a verifier",
+			ctx:      context.Background(),
+			header:   "Bearer " + validToken,
+			wantCode: connect.CodeUnauthenticated,
+		},
+		{
+			name:         "certificate URI SAN",
+			ctx:          withCert(spiffeCert),
+			wantIdentity: "spiffe://ec/bff",
+		},
+		{
+			name:     "certificate common name not allowed",
+			ctx:      withCert(namedCert),
+			wantCode: connect.CodePermissionDenied,
+		},
+		{
+			name:         "certificate takes precedence over a token",
+			tokens:       true,
+			ctx:          withCert(spiffeCert),
+			header:       "Bearer not-a-token",
+			wantIdentity: "spiffe://ec/bff",
+		},
+		{
+			name:     "certificate not allowed although the token is",
+			tokens:   true,
+			ctx:      withCert(namedCert),
+			header:   "Bearer " + validToken,
+			wantCode: connect.CodePermissionDenied,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := ServiceAuthConfig{AllowedServices: []string{"bff", "spiffe://ec/bff"}}
+			if tt.tokens {
+				cfg.Tokens = keys.verifier()
+			}
+			interceptor := ServiceAuthInterceptor(cfg, slog.New(slog.DiscardHandler)).(*serviceAuthInterceptor)
+
+			header := http.Header{}
+			if tt.header != "" {
+				header.Set(MetadataAuthorization, tt.header)
+			}
+			ctx, err := interceptor.authenticate(tt.ctx, testCreateProcedure, "192.0.2.1:443", header)
+			if tt.wantCode != 0 {
+				if connect.CodeOf(err) != tt.wantCode {
+					t.Errorf("authenticate() code = %v, want %v", connect.CodeOf(err), tt.wantCode)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("authenticate() error = %v", err)
+			}
+			if got := GetServiceIdentity(ctx); got != tt.wantIdentity {
+				t.Errorf("service identity = %q, want %q", got, tt.wantIdentity)
+			}
+		})
+	}
+}
+
+// newTokenEndpoint serves client credentials tokens, or fails when fail is set.
+func newTokenEndpoint(t *testing.T, fail bool) *ClientCredentials {
+	t.Helper()
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if fail {
+			w.WriteHeader(http.StatusUnauthorized)
+			json.NewEncoder(w).Encode(map[string]string{"error": "invalid_client"})
+			return
+		}
+		json.NewEncoder(w).Encode(map[string]any{"access_token": "service-token", "expires_in": 3600})
+	}))
+	t.Cleanup(srv.Close)
+	return NewClientCredentials(ClientCredentialsConfig{TokenURL: srv.URL, ClientID: "bff", ClientSecret: "secret"})
+}
+
+func TestServiceTokenInterceptor(t *testing.T) {
+	var calls atomic.Int32
+	var authorization atomic.Value
+	record := func(header http.Header) {
+		calls.Add(1)
+		authorization.Store(header.Get(MetadataAuthorization))
+	}
+
+	mux := http.NewServeMux()
+	mux.Handle(testCreateProcedure, connect.NewUnaryHandler(testCreateProcedure,
+		func(_ context.Context, req *connect.Request[wrapperspb.StringValue]) (*connect.Response[wrapperspb.StringValue], error) {
+			record(req.Header())
+			return connect.NewResponse(req.Msg), nil
+		}))
+	mux.Handle(testWatchProcedure, connect.NewServerStreamHandler(testWatchProcedure,
+		func(_ context.Context, req *connect.Request[wrapperspb.StringValue], stream *connect.ServerStream[wrapperspb.StringValue]) error {
+			record(req.Header())
+			return stream.Send(req.Msg)
+		}))
+	srv := httptest.NewServer(mux)
+	defer srv.Close()
+
+	tests := []struct {
+		name      string
+		fail      bool
+		procedure string
+	}{
+		{name: "unary", procedure: testCreateProcedure},
+		{name: "stream", procedure: testWatchProcedure},
+		{name: "unary without a token", fail: true, procedure: testCreateProcedure},
+		{name: "stream without a token", fail: true, procedure: testWatchProcedure},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			calls.Store(0)
+			authorization.Store("")
+			client := connect.NewClient[wrapperspb.StringValue, wrapperspb.StringValue](srv.Client(), srv.URL+tt.procedure,
+				connect.WithInterceptors(ServiceTokenInterceptor(newTokenEndpoint(t, tt.fail))))
+			req := connect.NewRequest(wrapperspb.String("a"))
+
+			var err error
+			if tt.procedure == testWatchProcedure {
+				var stream *connect.ServerStreamForClient[wrapperspb.StringValue]
+				stream, err = client.CallServerStream(context.Background(), req)
+				if err == nil {
+					for stream.Receive() {
+					}
+					err = stream.Err()
+					stream.Close()
+				}
+			} else {
+				_, err = client.CallUnary(context.Background(), req)
+			}
+
+			if tt.fail {
+				if connect.CodeOf(err) != connect.CodeUnavailable {
+					t.Errorf("code = %v, want %v", connect.CodeOf(err), connect.CodeUnavailable)
+				}
+				if got := calls.Load(); got != 0 {
+					t.Errorf("server called %d times without a token", got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got := authorization.Load(); got != "Bearer service-token" {
+				t.Errorf("Authorization = %q, want %q", got, "Bearer service-token")
+			}
+		})
+	}
+}
@@ -0,0 +1,308 @@
+package middleware
+
+import (
+	"context"
+	"crypto"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+)
+
+// minJWKSRefetchInterval throttles JWKS fetches triggered by tokens signed
+// with an unknown key.
+const minJWKSRefetchInterval = 10 * time.Second
+
+// tokenExpiryMargin is how long before expiry a cached access token is
+// replaced, so it does not expire in flight.
+const tokenExpiryMargin = 30 * time.Second
+
+var errInvalidServiceToken = errors.New("invalid service token")
+
+// ServiceTokenConfig configures the verification of service access tokens.
+type ServiceTokenConfig struct {
+	// JWKSURL is the issuer's key set (Hydra: /.well-known/jwks.json).
+	JWKSURL string
+	// Issuer and Audience must match the iss and aud claims.
+	Issuer   string
+	Audience string
+	// ClockSkew tolerates clock differences in exp and nbf.
+	ClockSkew  time.Duration
+	HTTPClient *http.Client
+}
+
+// ServiceTokenVerifier verifies JWT access tokens that Hydra issues to
+// services with the client credentials grant. Tokens issued for a user
+// (sub differs from client_id) are rejected, so a user's access token can
+// not be passed off as a service identity. Only RS256 is accepted.
+type ServiceTokenVerifier struct {
+	cfg ServiceTokenConfig
+
+	mu        sync.RWMutex
+	keys      map[string]*rsa.PublicKey
+	fetchedAt time.Time
+}
+
+// NewServiceTokenVerifier creates a verifier. Keys are fetched on first use.
+func NewServiceTokenVerifier(cfg ServiceTokenConfig) *ServiceTokenVerifier {
+	if cfg.HTTPClient == nil {
+		cfg.HTTPClient = &http.Client{Timeout: 10 * time.Second}
+	}
+	return &ServiceTokenVerifier{cfg: cfg}
+}
+
+// Verify checks the token and returns the OAuth2 client ID of the service.
+func (v *ServiceTokenVerifier) Verify(ctx context.Context, token string) (string, error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return "", errInvalidServiceToken
+	}
+
+	var header struct {
+		Alg string `json:"alg"`
+		Kid string `json:"kid"`
+	}
+	if err := decodeSegment(parts[0], &header); err != nil {
+		return "", errInvalidServiceToken
+	}
+	if header.Alg != "RS256" {
+		return "", fmt.Errorf("%w: unsupported algorithm %q", errInvalidServiceToken, header.Alg)
+	}
+	signature, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil {
+		return "", errInvalidServiceToken
+	}
+	key, err := v.key(ctx, header.Kid)
+	if err != nil {
+		return "", err
+	}
+	digest := sha256.Sum256([]byte(parts[0] + "." + parts[1]))
+	if err := rsa.VerifyPKCS1v15(key, crypto.SHA256, digest[:], signature); err != nil {
+		return "", fmt.Errorf("%w: bad signature", errInvalidServiceToken)
+	}
+
+	var claims struct {
+		Issuer    string   `json:"iss"`
+		Subject   string   `json:"sub"`
+		Audience  audience `json:"aud"`
+		ClientID  string   `json:"client_id"`
+		ExpiresAt int64    `json:"exp"`
+		NotBefore int64    `json:"nbf"`
+	}
+	if err := decodeSegment(parts[1], &claims); err != nil {
+		return "", errInvalidServiceToken
+	}
+	now := time.Now()
+	switch {
+	case claims.Issuer != v.cfg.Issuer:
+		return "", fmt.Errorf("%w: unexpected issuer %q", errInvalidServiceToken, claims.Issuer)
+	case v.cfg.Audience != "" && !claims.Audience.contains(v.cfg.Audience):
+		return "", fmt.Errorf("%w: audience does not include %q", errInvalidServiceToken, v.cfg.Audience)
+	case claims.ExpiresAt == 0 || now.After(time.Unix(claims.ExpiresAt, 0).Add(v.cfg.ClockSkew)):
+		return "", fmt.Errorf("%w: expired", errInvalidServiceToken)
+	case claims.NotBefore != 0 && now.Add(v.cfg.ClockSkew).Before(time.Unix(claims.NotBefore, 0)):
+		return "", fmt.Errorf("%w: not yet valid", errInvalidServiceToken)
+	case claims.ClientID == "" || claims.Subject != claims.ClientID:
+		return "", fmt.Errorf("%w: not a client credentials token", errInvalidServiceToken)
+	}
+	return claims.ClientID, nil
+}
+
+// key returns the signing key, fetching the key set when the key is unknown.
+func (v *ServiceTokenVerifier) key(ctx context.Context, kid string) (*rsa.PublicKey, error) {
+	v.mu.RLock()
+	key, ok := v.keys[kid]
+	fetchedAt := v.fetchedAt
+	v.mu.RUnlock()
+	if ok {
+		return key, nil
+	}
+	if time.Since(fetchedAt) < minJWKSRefetchInterval {
+		return nil, fmt.Errorf("%w: unknown key %q", errInvalidServiceToken, kid)
+	}
+
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	// Another caller may have fetched the keys meanwhile
+	if key, ok := v.keys[kid]; ok {
+		return key, nil
+	}
+	if time.Since(v.fetchedAt) >= minJWKSRefetchInterval {
+		keys, err := v.fetchKeys(ctx)
+		v.fetchedAt = time.Now()
+		if err != nil {
+			return nil, fmt.Errorf("failed to fetch JWKS: %w", err)
+		}
+		v.keys = keys
+	}
+	if key, ok := v.keys[kid]; ok {
+		return key, nil
+	}
+	return nil, fmt.Errorf("%w: unknown key %q", errInvalidServiceToken, kid)
+}
+
+func (v *ServiceTokenVerifier) fetchKeys(ctx context.Context) (map[string]*rsa.PublicKey, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, v.cfg.JWKSURL, nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := v.cfg.HTTPClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("JWKS returned status %d", resp.StatusCode)
+	}
+
+	var set struct {
+		Keys []struct {
+			Kty string `json:"kty"`
+			Kid string `json:"kid"`
+			Use string `json:"use"`
+			N   string `json:"n"`
+			E   string `json:"e"`
+		} `json:"keys"`
+	}
+	if err := json.NewDecoder(io.LimitReader(resp.Body, 1<<20)).Decode(&set); err != nil {
+		return nil, fmt.Errorf("failed to decode JWKS: %w", err)
+	}
+
+	keys := make(map[string]*rsa.PublicKey, len(set.Keys))
+	for _, k := range set.Keys {
+		if k.Kty != "RSA" || (k.Use != "" && k.Use != "sig") {
+			continue
+		}
+		n, errN := base64.RawURLEncoding.DecodeString(k.N)
+		e, errE := base64.RawURLEncoding.DecodeString(k.E)
+		if errN != nil || errE != nil || len(e) > 4 {
+			continue
+		}
+		keys[k.Kid] = &rsa.PublicKey{
+			N: new(big.Int).SetBytes(n),
+			E: int(new(big.Int).SetBytes(e).Int64()),
+		}
+	}
+	return keys, nil
+}
+
+// audience is the aud claim, which is a string or an array of strings.
+type audience []string
+
+func (a *audience) UnmarshalJSON(data []byte) error {
+	var single string
+	if err := json.Unmarshal(data, &single); err == nil {
+		*a = audience{single}
+		return nil
+	}
+	var multiple []string
+	if err := json.Unmarshal(data, &multiple); err != nil {
+		return err
+	}
+	*a = multiple
+	return nil
+}
+
+func (a audience) contains(aud string) bool {
+	for _, v := range a {
+		if v == aud {
+			return true
+		}
+	}
+	return false
+}
+
+func decodeSegment(segment string, v any) error {
+	data, err := base64.RawURLEncoding.DecodeString(segment)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, v)
+}
+
+// ClientCredentialsConfig configures a ClientCredentials token source.
+type ClientCredentialsConfig struct {
+	// TokenURL is the token endpoint (Hydra: /oauth2/token).
+	TokenURL     string
+	ClientID     string
+	ClientSecret string
+	// Audience is requested for the token; the client must be allowed it.
+	Audience   string
+	Scopes     []string
+	HTTPClient *http.Client
+}
+
+// ClientCredentials obtains access tokens for a service with the OAuth2
+// client credentials grant and caches them until shortly before expiry.
+type ClientCredentials struct {
+	cfg ClientCredentialsConfig
+
+	mu        sync.Mutex
+	token     string
+	expiresAt time.Time
+}
+
+// NewClientCredentials creates a token source. The first token is fetched
+// on first use.
+func NewClientCredentials(cfg ClientCredentialsConfig) *ClientCredentials {
+	if cfg.HTTPClient == nil {
+		cfg.HTTPClient = &http.Client{Timeout: 10 * time.Second}
+	}
+	return &ClientCredentials{cfg: cfg}
+}
+
+// Token returns a valid access token.
+func (c *ClientCredentials) Token(ctx context.Context) (string, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.token != "" && time.Now().Before(c.expiresAt) {
+		return c.token, nil
+	}
+
+	form := url.Values{"grant_type": {"client_credentials"}}
+	if len(c.cfg.Scopes) > 0 {
+		form.Set("scope", strings.Join(c.cfg.Scopes, " "))
+	}
+	if c.cfg.Audience != "" {
+		form.Set("audience", c.cfg.Audience)
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.cfg.TokenURL, strings.NewReader(form.Encode()))
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Accept", "application/json")
+	// client_secret_basic encodes the credentials like form values (RFC 6749 2.3.1)
+	req.SetBasicAuth(url.QueryEscape(c.cfg.ClientID), url.QueryEscape(c.cfg.ClientSecret))
+
+	resp, err := c.cfg.HTTPClient.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("token request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	var body struct {
+		AccessToken string `json:"access_token"`
+		ExpiresIn   int64  `json:"expires_in"`
+		Error       string `json:"error"`
+	}
+	if err := json.NewDecoder(io.LimitReader(resp.Body, 1<<20)).Decode(&body); err != nil && resp.StatusCode == http.StatusOK {
+		return "", fmt.Errorf("failed to decode token response: %w", err)
+	}
+	if resp.StatusCode != http.StatusOK || body.AccessToken == "" {
+		return "", fmt.Errorf("token endpoint returned status %d: %s", resp.StatusCode, body.Error)
+	}
+
+	c.token = body.AccessToken
+	c.expiresAt = time.Now().Add(time.Duration(body.ExpiresIn)*time.Second - tokenExpiryMargin)
+	return c.token, nil
+}
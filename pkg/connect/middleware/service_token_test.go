@@ -0,0 +1,265 @@
+package middleware
+
+import (
+	"context"
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+const (
+	testIssuer   = "https://auth.example.com/"
+	testAudience = "user-service"
+)
+
+// testIssuerKeys serves a JWKS of RSA keys and counts its fetches.
+type testIssuerKeys struct {
+	keys    map[string]*rsa.PrivateKey
+	fetches atomic.Int32
+	server  *httptest.Server
+}
+
+func newTestIssuerKeys(t *testing.T, kids ...string) *testIssuerKeys {
+	t.Helper()
+	k := &testIssuerKeys{keys: make(map[string]*rsa.PrivateKey)}
+	for _, kid := range kids {
+		k.keys[kid] = newRSAKey(t)
+	}
+	k.server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		k.fetches.Add(1)
+		var set struct {
+			Keys []map[string]string `json:"keys"`
+		}
+		for kid, key := range k.keys {
+			set.Keys = append(set.Keys, map[string]string{
+				"kty": "RSA",
+				"kid": kid,
+				"use": "sig",
+				"n":   base64.RawURLEncoding.EncodeToString(key.N.Bytes()),
+				"e":   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(key.E)).Bytes()),
+			})
+		}
+		json.NewEncoder(w).Encode(set)
+	}))
+	t.Cleanup(k.server.Close)
+	return k
+}
+
+func (k *testIssuerKeys) verifier() *ServiceTokenVerifier {
+	return NewServiceTokenVerifier(ServiceTokenConfig{
+		JWKSURL:   k.server.URL,
+		Issuer:    testIssuer,
+		Audience:  testAudience,
+		ClockSkew: 30 * time.Second,
+	})
+}
+
+func newRSAKey(t *testing.T) *rsa.PrivateKey {
+	t.Helper()
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("failed to generate key: %v", err)
+	}
+	return key
+}
+
+// serviceClaims returns the claims of a client credentials token for
+// client "bff".
+func serviceClaims() map[string]any {
+	now := time.Now()
+	return map[string]any{
+		"iss":       testIssuer,
+		"sub":       "bff",
+		"client_id": "bff",
+		"aud":       []string{testAudience},
+		"iat":       now.Unix(),
+		"nbf":       now.Unix(),
+		"exp":       now.Add(time.Hour).Unix(),
+	}
+}
+
+// signToken signs claims with key as an RS256 JWT, or with the header alg
+// unchanged when alg is not RS256.
+func signToken(t *testing.T, key *rsa.PrivateKey, alg, kid string, claims map[string]any) string {
+	t.Helper()
+	header, err := json.Marshal(map[string]string{"alg": alg, "kid": kid, "typ": "JWT"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	payload, err := json.Marshal(claims)
+	if err != nil {
+		t.Fatal(err)
+	}
+	signed := base64.RawURLEncoding.EncodeToString(header) + "." + base64.RawURLEncoding.EncodeToString(payload)
+	digest := sha256.Sum256([]byte(signed))
+	signature, err := rsa.SignPKCS1v15(rand.Reader, key, crypto.SHA256, digest[:])
+	if err != nil {
+		t.Fatal(err)
+	}
+	return signed + "." + base64.RawURLEncoding.EncodeToString(signature)
+}
+
+func TestServiceTokenVerifier_Verify(t *testing.T) {
+	keys := newTestIssuerKeys(t, "key-1")
+	otherKey := newRSAKey(t)
+	now := time.Now()
+
+	with := func(changes map[string]any) map[string]any {
+		claims := serviceClaims()
+		for k, v := range changes {
+			if v == nil {
+				delete(claims, k)
+				continue
+			}
+			claims[k] = v
+		}
+		return claims
+	}
+
+	tests := []struct {
+		name    string
+		token   string
+		wantErr bool
+	}{
+		{
+			name:  "client credentials token",
+			token: signToken(t, keys.keys["key-1"], "RS256", "key-1", serviceClaims()),
+		},
+		{
+			name:  "single audience string",
+			token: signToken(t, keys.keys["key-1"], "RS256", "key-1", with(map[string]any{"aud": testAudience})),
+		},
+		{
+			name:    "not a JWT",
+			token:   "not-a-jwt",
+			wantErr: true,
+		},
+		{
+			name:    "HS256",
+			token:   signToken(t, keys.keys["key-1"], "HS256", "key-1", serviceClaims()),
+			wantErr: true,
+		},
+		{
+			name:    "alg none",
+			token:   signToken(t, keys.keys["key-1"], "none", "key-1", serviceClaims()),
+			wantErr: true,
+		},
+		{
+			name:    "signed by another key",
+			token:   signToken(t, otherKey, "RS256", "key-1", serviceClaims()),
+			wantErr: true,
+		},
+		{
+			name:    "unknown kid",
+			token:   signToken(t, otherKey, "RS256", "key-2", serviceClaims()),
+			wantErr: true,
+		},
+		{
+			name:    "wrong issuer",
+			token:   signToken(t, keys.keys["key-1"], "RS256", "key-1", with(map[string]any{"iss": "https://evil.example.com/"})),
+			wantErr: true,
+		},
+		{
+			name:    "wrong audience",
+			token:   signToken(t, keys.keys["key-1"], "RS256", "key-1", with(map[string]any{"aud": []string{"product-service"}})),
+			wantErr: true,
+		},
+		{
+			name:    "expired beyond clock skew",
+			token:   signToken(t, keys.keys["key-1"], "RS256", "key-1", with(map[string]any{"exp": now.Add(-time.Minute).Unix()})),
+			wantErr: true,
+		},
+		{
+			name:  "expired within clock skew",
+			token: signToken(t, keys.keys["key-1"], "RS256", "key-1", with(map[string]any{"exp": now.Add(-10 * time.Second).Unix()})),
+		},
+		{
+			name:    "no expiry",
+			token:   signToken(t, keys.keys["key-1"], "RS256", "key-1", with(map[string]any{"exp": nil})),
+			wantErr: true,
+		},
+		{
+			name:    "not yet valid beyond clock skew",
+			token:   signToken(t, keys.keys["key-1"], "RS256", "key-1", with(map[string]any{"nbf": now.Add(time.Minute).Unix()})),
+			wantErr: true,
+		},
+		{
+			name:  "not yet valid within clock skew",
+			token: signToken(t, keys.keys["key-1"], "RS256", "key-1", with(map[string]any{"nbf": now.Add(10 * time.Second).Unix()})),
+		},
+		{
+			name:    "user token",
+			token:   signToken(t, keys.keys["key-1"], "RS256", "key-1", with(map[string]any{"sub": "user-123"})),
+			wantErr: true,
+		},
+		{
+			name:    "no client_id",
+			token:   signToken(t, keys.keys["key-1"], "RS256", "key-1", with(map[string]any{"client_id": nil})),
+			wantErr: true,
+		},
+	}
+
+	verifier := keys.verifier()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			clientID, err := verifier.Verify(context.Background(), tt.token)
+			if tt.wantErr {
+				if err == nil {
+					t.Errorf("Verify() = %q, want error", clientID)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Verify() error = %v", err)
+			}
+			if clientID != "bff" {
+				t.Errorf("Verify() = %q, want bff", clientID)
+			}
+		})
+	}
+}
+
+func TestServiceTokenVerifier_RefetchThrottle(t *testing.T) {
+	keys := newTestIssuerKeys(t, "key-1")
+	verifier := keys.verifier()
+	ctx := context.Background()
+
+	if _, err := verifier.Verify(ctx, signToken(t, keys.keys["key-1"], "RS256", "key-1", serviceClaims())); err != nil {
+		t.Fatalf("Verify() error = %v", err)
+	}
+
+	// The issuer rotates in a new key.
+	keys.keys["key-2"] = newRSAKey(t)
+	rotated := signToken(t, keys.keys["key-2"], "RS256", "key-2", serviceClaims())
+
+	// Tokens with unknown keys do not refetch within the interval, so
+	// they cannot be used to flood the issuer.
+	for range 3 {
+		if _, err := verifier.Verify(ctx, rotated); err == nil {
+			t.Fatal("Verify() accepted a key fetched within the refetch interval")
+		}
+	}
+	if got := keys.fetches.Load(); got != 1 {
+		t.Fatalf("JWKS fetched %d times, want 1", got)
+	}
+
+	verifier.mu.Lock()
+	verifier.fetchedAt = time.Now().Add(-minJWKSRefetchInterval)
+	verifier.mu.Unlock()
+
+	if _, err := verifier.Verify(ctx, rotated); err != nil {
+		t.Fatalf("Verify() with the rotated key after the interval: %v", err)
+	}
+	if got := keys.fetches.Load(); got != 2 {
+		t.Errorf("JWKS fetched %d times, want 2", got)
+	}
+}
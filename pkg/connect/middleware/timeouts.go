@@ -0,0 +1,51 @@
+package middleware
+
+import (
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+)
+
+type routeTimeout struct {
+	prefix  string
+	timeout time.Duration
+}
+
+// RouteTimeouts creates an HTTP middleware that extends the server's read
+// and write deadlines for long-running routes, such as imports and backups,
+// so the server-wide http.Server timeouts can stay short.
+//
+// Keys of timeouts are path prefixes: a full procedure path
+// ("/product.v1.ProductService/ImportProducts") matches one RPC, a service
+// path ending in "/" matches all of its RPCs. The longest matching prefix
+// wins. The deadlines are set through http.ResponseController; when the
+// connection does not support them the server-wide timeouts apply.
+func RouteTimeouts(timeouts map[string]time.Duration) func(http.Handler) http.Handler {
+	routes := make([]routeTimeout, 0, len(timeouts))
+	for prefix, timeout := range timeouts {
+		routes = append(routes, routeTimeout{prefix: prefix, timeout: timeout})
+	}
+	sort.Slice(routes, func(i, j int) bool {
+		return len(routes[i].prefix) > len(routes[j].prefix)
+	})
+
+	return func(next http.Handler) http.Handler {
+		if len(routes) == 0 {
+			return next
+		}
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			for _, route := range routes {
+				if !strings.HasPrefix(r.URL.Path, route.prefix) {
+					continue
+				}
+				deadline := time.Now().Add(route.timeout)
+				rc := http.NewResponseController(w)
+				_ = rc.SetReadDeadline(deadline)
+				_ = rc.SetWriteDeadline(deadline)
+				break
+			}
+			next.ServeHTTP(w, r)
+		})
+	}
+}
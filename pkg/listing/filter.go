@@ -0,0 +1,175 @@
+package listing
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// Operator compares a field with a value.
+type Operator string
+
+const (
+	OpEq Operator = "="
+	OpNe Operator = "!="
+	OpLt Operator = "<"
+	OpLe Operator = "<="
+	OpGt Operator = ">"
+	OpGe Operator = ">="
+)
+
+// operators is ordered so two-character operators match first.
+var operators = []Operator{OpNe, OpLe, OpGe, OpEq, OpLt, OpGt}
+
+// Condition is a single "field op value" comparison. Value is unparsed;
+// callers convert it according to the field's type.
+type Condition struct {
+	Field string
+	Op    Operator
+	Value string
+}
+
+// Filter is a conjunction of conditions.
+type Filter []Condition
+
+// String returns the canonical expression.
+func (f Filter) String() string {
+	parts := make([]string, len(f))
+	for i, c := range f {
+		parts[i] = c.Field + " " + string(c.Op) + " " + strconv.Quote(c.Value)
+	}
+	return strings.Join(parts, " AND ")
+}
+
+// FilterFields maps filterable fields to the operators they accept.
+type FilterFields map[string][]Operator
+
+// ParseFilter parses conditions of the form `field op value` joined by AND,
+// e.g. `status = ACTIVE AND created_at >= "2026-01-01T00:00:00Z"`. Values
+// containing spaces must be double-quoted. Fields and operators must be in
+// allowed.
+func ParseFilter(expr string, allowed FilterFields) (Filter, error) {
+	p := &filterParser{input: expr}
+	var filter Filter
+	for {
+		p.skipSpace()
+		if p.done() {
+			if len(filter) > 0 {
+				return nil, fmt.Errorf("%w: trailing AND", ErrInvalidFilter)
+			}
+			return nil, nil
+		}
+
+		c, err := p.condition()
+		if err != nil {
+			return nil, err
+		}
+		ops, ok := allowed[c.Field]
+		if !ok {
+			return nil, fmt.Errorf("%w: cannot filter by %q", ErrInvalidFilter, c.Field)
+		}
+		if !slices.Contains(ops, c.Op) {
+			return nil, fmt.Errorf("%w: operator %q is not supported for %q", ErrInvalidFilter, c.Op, c.Field)
+		}
+		filter = append(filter, c)
+
+		p.skipSpace()
+		if p.done() {
+			return filter, nil
+		}
+		if !p.keyword("AND") {
+			return nil, fmt.Errorf("%w: expected AND at offset %d", ErrInvalidFilter, p.pos)
+		}
+	}
+}
+
+type filterParser struct {
+	input string
+	pos   int
+}
+
+func (p *filterParser) done() bool {
+	return p.pos >= len(p.input)
+}
+
+func (p *filterParser) skipSpace() {
+	for !p.done() && p.input[p.pos] == ' ' {
+		p.pos++
+	}
+}
+
+// keyword consumes kw (case-insensitive) followed by a space.
+func (p *filterParser) keyword(kw string) bool {
+	end := p.pos + len(kw)
+	if end >= len(p.input) || !strings.EqualFold(p.input[p.pos:end], kw) || p.input[end] != ' ' {
+		return false
+	}
+	p.pos = end
+	return true
+}
+
+func (p *filterParser) condition() (Condition, error) {
+	start := p.pos
+	for !p.done() && isIdentChar(p.input[p.pos]) {
+		p.pos++
+	}
+	if p.pos == start {
+		return Condition{}, fmt.Errorf("%w: expected field name at offset %d", ErrInvalidFilter, start)
+	}
+	c := Condition{Field: p.input[start:p.pos]}
+
+	p.skipSpace()
+	for _, op := range operators {
+		if strings.HasPrefix(p.input[p.pos:], string(op)) {
+			c.Op = op
+			p.pos += len(op)
+			break
+		}
+	}
+	if c.Op == "" {
+		return Condition{}, fmt.Errorf("%w: expected operator after %q", ErrInvalidFilter, c.Field)
+	}
+
+	p.skipSpace()
+	value, err := p.value()
+	if err != nil {
+		return Condition{}, err
+	}
+	c.Value = value
+	return c, nil
+}
+
+func (p *filterParser) value() (string, error) {
+	if p.done() {
+		return "", fmt.Errorf("%w: missing value", ErrInvalidFilter)
+	}
+	if p.input[p.pos] == '"' {
+		end := p.pos + 1
+		for end < len(p.input) && p.input[end] != '"' {
+			if p.input[end] == '\\' {
+				end++
+			}
+			end++
+		}
+		if end >= len(p.input) {
+			return "", fmt.Errorf("%w: unterminated string", ErrInvalidFilter)
+		}
+		value, err := strconv.Unquote(p.input[p.pos : end+1])
+		if err != nil {
+			return "", fmt.Errorf("%w: invalid string %s", ErrInvalidFilter, p.input[p.pos:end+1])
+		}
+		p.pos = end + 1
+		return value, nil
+	}
+
+	start := p.pos
+	for !p.done() && p.input[p.pos] != ' ' {
+		p.pos++
+	}
+	return p.input[start:p.pos], nil
+}
+
+func isIdentChar(b byte) bool {
+	return b == '_' || b == '.' || (b >= 'a' && b <= 'z') || (b >= 'A' && b <= 'Z') || (b >= '0' && b <= '9')
+}
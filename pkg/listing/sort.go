@@ -0,0 +1,70 @@
+package listing
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// SortField orders results by a single field.
+type SortField struct {
+	Field string
+	Desc  bool
+}
+
+// Sort is an ordered list of sort fields.
+type Sort []SortField
+
+// String returns the canonical expression, e.g. "name asc, created_at desc".
+func (s Sort) String() string {
+	parts := make([]string, len(s))
+	for i, f := range s {
+		dir := "asc"
+		if f.Desc {
+			dir = "desc"
+		}
+		parts[i] = f.Field + " " + dir
+	}
+	return strings.Join(parts, ", ")
+}
+
+// ParseSort parses a comma-separated list of "field [asc|desc]" entries.
+// Fields must be in allowed and may appear only once; the direction
+// defaults to asc. An empty expression returns def.
+func ParseSort(expr string, allowed []string, def Sort) (Sort, error) {
+	if strings.TrimSpace(expr) == "" {
+		return def, nil
+	}
+
+	var sort Sort
+	seen := make(map[string]struct{})
+	for _, entry := range strings.Split(expr, ",") {
+		parts := strings.Fields(entry)
+		if len(parts) == 0 || len(parts) > 2 {
+			return nil, fmt.Errorf("%w: %q must be \"field [asc|desc]\"", ErrInvalidSort, strings.TrimSpace(entry))
+		}
+
+		field := parts[0]
+		if !slices.Contains(allowed, field) {
+			return nil, fmt.Errorf("%w: cannot sort by %q", ErrInvalidSort, field)
+		}
+		if _, dup := seen[field]; dup {
+			return nil, fmt.Errorf("%w: %q appears more than once", ErrInvalidSort, field)
+		}
+		seen[field] = struct{}{}
+
+		f := SortField{Field: field}
+		if len(parts) == 2 {
+			switch strings.ToLower(parts[1]) {
+			case "asc":
+			case "desc":
+				f.Desc = true
+			default:
+				return nil, fmt.Errorf("%w: unknown direction %q", ErrInvalidSort, parts[1])
+			}
+		}
+		sort = append(sort, f)
+	}
+	return sort, nil
+}
+
@@ -0,0 +1,74 @@
+package listing
+
+import (
+	"errors"
+	"testing"
+	"unicode/utf8"
+)
+
+type fuzzCursor struct {
+	ID    string `json:"id"`
+	Value int64  `json:"v"`
+}
+
+func newFuzzCodec(f *testing.F) *Codec {
+	codec, err := NewCodec("fuzz-secret")
+	if err != nil {
+		f.Fatal(err)
+	}
+	return codec
+}
+
+func FuzzCodecDecode(f *testing.F) {
+	codec := newFuzzCodec(f)
+	valid, err := codec.Encode("query", fuzzCursor{ID: "item-1", Value: 42})
+	if err != nil {
+		f.Fatal(err)
+	}
+	f.Add(valid, "query")
+	f.Add(valid, "other-query")
+	f.Add(valid[:len(valid)-1], "query")
+	f.Add("", "")
+	f.Add("not base64!", "query")
+	f.Add("AAAAAAAAAAAAAAAA", "query")
+
+	f.Fuzz(func(t *testing.T, pageToken, query string) {
+		var cursor fuzzCursor
+		err := codec.Decode(pageToken, query, &cursor)
+		if err != nil && !errors.Is(err, ErrInvalidPageToken) {
+			t.Fatalf("Decode(%q, %q) error = %v, want %v", pageToken, query, err, ErrInvalidPageToken)
+		}
+		if err == nil && (query != "query" || cursor != (fuzzCursor{ID: "item-1", Value: 42})) {
+			t.Fatalf("Decode(%q, %q) = %+v, accepted a token not issued by the codec", pageToken, query, cursor)
+		}
+	})
+}
+
+func FuzzCodecRoundTrip(f *testing.F) {
+	codec := newFuzzCodec(f)
+	f.Add("query", "item-1", int64(42))
+	f.Add("", "", int64(0))
+	f.Add("q\x00", "名前", int64(-1))
+
+	f.Fuzz(func(t *testing.T, query, id string, value int64) {
+		if !utf8.ValidString(query) {
+			t.Skip("queries are QueryKey hex strings; JSON replaces invalid UTF-8")
+		}
+		want := fuzzCursor{ID: id, Value: value}
+		pageToken, err := codec.Encode(query, want)
+		if err != nil {
+			t.Fatalf("Encode() error = %v", err)
+		}
+
+		var got fuzzCursor
+		if err := codec.Decode(pageToken, query, &got); err != nil {
+			t.Fatalf("Decode() error = %v", err)
+		}
+		if got.Value != want.Value || (utf8.ValidString(id) && got.ID != id) {
+			t.Fatalf("Decode() = %+v, want %+v", got, want)
+		}
+		if err := codec.Decode(pageToken, query+"x", &got); !errors.Is(err, ErrInvalidPageToken) {
+			t.Fatalf("Decode() with another query error = %v, want %v", err, ErrInvalidPageToken)
+		}
+	})
+}
@@ -0,0 +1,3 @@
+module github.com/daisuke8000/example-ec-platform/pkg/objectstore
+
+go 1.25
@@ -0,0 +1,63 @@
+// Package objectstore stores blobs in S3-compatible object storage, or in a
+// local directory for development.
+package objectstore
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+)
+
+// ErrNotFound is returned when an object does not exist.
+var ErrNotFound = errors.New("object not found")
+
+// Object describes a stored object.
+type Object struct {
+	Key          string
+	Size         int64
+	LastModified time.Time
+}
+
+// Store is a flat key/value blob store. Keys use "/" as separator.
+type Store interface {
+	// Put stores size bytes read from body under key, replacing any
+	// existing object.
+	Put(ctx context.Context, key string, body io.Reader, size int64, contentType string) error
+	// Get opens an object for reading. The caller must close it.
+	Get(ctx context.Context, key string) (io.ReadCloser, error)
+	// Stat describes an object without reading it.
+	Stat(ctx context.Context, key string) (Object, error)
+	// Delete removes an object. Deleting a missing object is not an error.
+	Delete(ctx context.Context, key string) error
+	// List returns the objects whose key starts with prefix, in key order.
+	List(ctx context.Context, prefix string) ([]Object, error)
+}
+
+// Config selects a store backend.
+type Config struct {
+	Backend string // "s3" or "file"
+	Dir     string // Root directory of the file backend
+	S3      S3Config
+}
+
+// New opens the store selected by cfg.
+func New(cfg Config) (Store, error) {
+	switch cfg.Backend {
+	case "s3":
+		s, err := NewS3(cfg.S3, nil)
+		if err != nil {
+			return nil, err
+		}
+		return s, nil
+	case "file":
+		s, err := NewFileStore(cfg.Dir)
+		if err != nil {
+			return nil, err
+		}
+		return s, nil
+	default:
+		return nil, fmt.Errorf("unknown object store backend %q", cfg.Backend)
+	}
+}
@@ -0,0 +1,88 @@
+package observability
+
+import (
+	"encoding/json"
+	"expvar"
+	"net"
+	"net/http"
+	"net/http/pprof"
+	"runtime"
+	"runtime/debug"
+	"strconv"
+	"time"
+)
+
+// recentGCPauses is the number of most recent GC pauses reported.
+const recentGCPauses = 10
+
+// NewDebugServer returns a server for performance investigations on the
+// given host and port:
+//
+//	/debug/pprof/   CPU, heap, goroutine, block and mutex profiles
+//	/debug/vars     expvar (command line and runtime.MemStats)
+//	/debug/gcstats  GC and heap statistics as JSON
+//
+// It has no authentication. Callers should bind host to a loopback address
+// and reach it with port forwarding; a wider host must still not be
+// reachable from outside the cluster.
+func NewDebugServer(host string, port int) *http.Server {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/debug/pprof/", pprof.Index)
+	mux.HandleFunc("/debug/pprof/cmdline", pprof.Cmdline)
+	mux.HandleFunc("/debug/pprof/profile", pprof.Profile)
+	mux.HandleFunc("/debug/pprof/symbol", pprof.Symbol)
+	mux.HandleFunc("/debug/pprof/trace", pprof.Trace)
+	mux.Handle("/debug/vars", expvar.Handler())
+	mux.HandleFunc("/debug/gcstats", handleGCStats)
+
+	return &http.Server{
+		Addr:              net.JoinHostPort(host, strconv.Itoa(port)),
+		Handler:           mux,
+		ReadHeaderTimeout: 10 * time.Second,
+		// No write timeout: CPU profiles and traces stream for as long as
+		// the caller asks (?seconds=N).
+	}
+}
+
+type gcStats struct {
+	NumGC        int64     `json:"num_gc"`
+	LastGC       time.Time `json:"last_gc"`
+	PauseTotal   string    `json:"pause_total"`
+	RecentPauses []string  `json:"recent_pauses"`
+	HeapAlloc    uint64    `json:"heap_alloc_bytes"`
+	HeapSys      uint64    `json:"heap_sys_bytes"`
+	HeapObjects  uint64    `json:"heap_objects"`
+	NextGC       uint64    `json:"next_gc_bytes"`
+	MemoryLimit  int64     `json:"memory_limit_bytes"`
+	Goroutines   int       `json:"goroutines"`
+	GOMAXPROCS   int       `json:"gomaxprocs"`
+}
+
+func handleGCStats(w http.ResponseWriter, r *http.Request) {
+	var gc debug.GCStats
+	debug.ReadGCStats(&gc)
+	var mem runtime.MemStats
+	runtime.ReadMemStats(&mem)
+
+	stats := gcStats{
+		NumGC:       gc.NumGC,
+		LastGC:      gc.LastGC,
+		PauseTotal:  gc.PauseTotal.String(),
+		HeapAlloc:   mem.HeapAlloc,
+		HeapSys:     mem.HeapSys,
+		HeapObjects: mem.HeapObjects,
+		NextGC:      mem.NextGC,
+		MemoryLimit: debug.SetMemoryLimit(-1), // negative reads the limit
+		Goroutines:  runtime.NumGoroutine(),
+		GOMAXPROCS:  runtime.GOMAXPROCS(0),
+	}
+	for i, pause := range gc.Pause {
+		if i == recentGCPauses {
+			break
+		}
+		stats.RecentPauses = append(stats.RecentPauses, pause.String())
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(stats)
+}
@@ -0,0 +1,184 @@
+package observability
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"math"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+)
+
+// Exporter owns a MeterProvider and serves its metrics in the Prometheus
+// text exposition format. Metrics are collected on every scrape.
+type Exporter struct {
+	reader   *sdkmetric.ManualReader
+	provider *sdkmetric.MeterProvider
+}
+
+// NewExporter creates an exporter with its own MeterProvider.
+func NewExporter() *Exporter {
+	reader := sdkmetric.NewManualReader()
+	return &Exporter{
+		reader:   reader,
+		provider: sdkmetric.NewMeterProvider(sdkmetric.WithReader(reader)),
+	}
+}
+
+// Meter returns a meter whose instruments are exported.
+func (e *Exporter) Meter(name string) metric.Meter {
+	return e.provider.Meter(name)
+}
+
+// Shutdown stops the MeterProvider.
+func (e *Exporter) Shutdown(ctx context.Context) error {
+	return e.provider.Shutdown(ctx)
+}
+
+// ServeHTTP writes the current metrics.
+func (e *Exporter) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	var rm metricdata.ResourceMetrics
+	if err := e.reader.Collect(r.Context(), &rm); err != nil {
+		http.Error(w, "failed to collect metrics", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/plain; version=0.0.4; charset=utf-8")
+	bw := bufio.NewWriter(w)
+	for _, sm := range rm.ScopeMetrics {
+		for _, m := range sm.Metrics {
+			writeMetric(bw, m)
+		}
+	}
+	bw.Flush()
+}
+
+// NewMetricsServer returns a server exposing the exporter on /metrics of
+// the given port.
+func NewMetricsServer(port int, e *Exporter) *http.Server {
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", e)
+	return &http.Server{
+		Addr:              fmt.Sprintf(":%d", port),
+		Handler:           mux,
+		ReadHeaderTimeout: 10 * time.Second,
+		WriteTimeout:      30 * time.Second,
+	}
+}
+
+func writeMetric(w io.Writer, m metricdata.Metrics) {
+	name := sanitizeName(m.Name)
+	switch data := m.Data.(type) {
+	case metricdata.Sum[int64]:
+		writeSum(w, name, m.Description, data.IsMonotonic, data.DataPoints)
+	case metricdata.Sum[float64]:
+		writeSum(w, name, m.Description, data.IsMonotonic, data.DataPoints)
+	case metricdata.Gauge[int64]:
+		writeSum(w, name, m.Description, false, data.DataPoints)
+	case metricdata.Gauge[float64]:
+		writeSum(w, name, m.Description, false, data.DataPoints)
+	case metricdata.Histogram[int64]:
+		writeHistogram(w, name, m.Description, data.DataPoints)
+	case metricdata.Histogram[float64]:
+		writeHistogram(w, name, m.Description, data.DataPoints)
+	}
+}
+
+// writeSum writes monotonic sums as counters and everything else as gauges.
+func writeSum[N int64 | float64](w io.Writer, name, help string, monotonic bool, points []metricdata.DataPoint[N]) {
+	typ := "gauge"
+	if monotonic {
+		typ = "counter"
+	}
+	writeHeader(w, name, help, typ)
+	for _, p := range points {
+		fmt.Fprintf(w, "%s%s %s\n", name, labels(p.Attributes), formatFloat(float64(p.Value)))
+	}
+}
+
+func writeHistogram[N int64 | float64](w io.Writer, name, help string, points []metricdata.HistogramDataPoint[N]) {
+	writeHeader(w, name, help, "histogram")
+	for _, p := range points {
+		// OpenTelemetry buckets are per bound, Prometheus buckets cumulative
+		var cumulative uint64
+		for i, bound := range p.Bounds {
+			cumulative += p.BucketCounts[i]
+			fmt.Fprintf(w, "%s_bucket%s %d\n", name, labels(p.Attributes, "le", formatFloat(bound)), cumulative)
+		}
+		fmt.Fprintf(w, "%s_bucket%s %d\n", name, labels(p.Attributes, "le", "+Inf"), p.Count)
+		fmt.Fprintf(w, "%s_sum%s %s\n", name, labels(p.Attributes), formatFloat(float64(p.Sum)))
+		fmt.Fprintf(w, "%s_count%s %d\n", name, labels(p.Attributes), p.Count)
+	}
+}
+
+func writeHeader(w io.Writer, name, help, typ string) {
+	if help != "" {
+		fmt.Fprintf(w, "# HELP %s %s\n", name, strings.NewReplacer(`\`, `\\`, "\n", `\n`).Replace(help))
+	}
+	fmt.Fprintf(w, "# TYPE %s %s\n", name, typ)
+}
+
+// labels renders the attributes, plus an optional extra label pair (the
+// "le" of histogram buckets), as a Prometheus label set.
+func labels(set attribute.Set, extra ...string) string {
+	if set.Len() == 0 && len(extra) == 0 {
+		return ""
+	}
+	var b strings.Builder
+	b.WriteByte('{')
+	iter := set.Iter()
+	for iter.Next() {
+		kv := iter.Attribute()
+		if b.Len() > 1 {
+			b.WriteByte(',')
+		}
+		writeLabel(&b, sanitizeName(string(kv.Key)), kv.Value.Emit())
+	}
+	for i := 0; i+1 < len(extra); i += 2 {
+		if b.Len() > 1 {
+			b.WriteByte(',')
+		}
+		writeLabel(&b, extra[i], extra[i+1])
+	}
+	b.WriteByte('}')
+	return b.String()
+}
+
+var labelValueEscaper = strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`)
+
+func writeLabel(b *strings.Builder, name, value string) {
+	b.WriteString(name)
+	b.WriteString(`="`)
+	b.WriteString(labelValueEscaper.Replace(value))
+	b.WriteByte('"')
+}
+
+// sanitizeName replaces characters not allowed in Prometheus names, such
+// as the dots of OpenTelemetry names.
+func sanitizeName(name string) string {
+	return strings.Map(func(r rune) rune {
+		if r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r >= '0' && r <= '9' || r == '_' || r == ':' {
+			return r
+		}
+		return '_'
+	}, name)
+}
+
+func formatFloat(v float64) string {
+	switch {
+	case math.IsInf(v, 1):
+		return "+Inf"
+	case math.IsInf(v, -1):
+		return "-Inf"
+	default:
+		return strconv.FormatFloat(v, 'g', -1, 64)
+	}
+}
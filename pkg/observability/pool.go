@@ -0,0 +1,87 @@
+package observability
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+)
+
+// RegisterPoolMetrics exports the statistics of a pgx connection pool,
+// labeled with its name (e.g. "primary"), so pool sizes and lifetimes can be
+// tuned from how often callers wait for a connection and for how long.
+func RegisterPoolMetrics(meter metric.Meter, name string, pool *pgxpool.Pool) error {
+	acquired, err := meter.Int64ObservableGauge(
+		"db_pool_acquired_conns",
+		metric.WithDescription("Connections currently in use"),
+	)
+	if err != nil {
+		return err
+	}
+	idle, err := meter.Int64ObservableGauge(
+		"db_pool_idle_conns",
+		metric.WithDescription("Idle connections"),
+	)
+	if err != nil {
+		return err
+	}
+	total, err := meter.Int64ObservableGauge(
+		"db_pool_total_conns",
+		metric.WithDescription("Open connections, including ones being established"),
+	)
+	if err != nil {
+		return err
+	}
+	maxConns, err := meter.Int64ObservableGauge(
+		"db_pool_max_conns",
+		metric.WithDescription("Maximum size of the pool"),
+	)
+	if err != nil {
+		return err
+	}
+	acquires, err := meter.Int64ObservableCounter(
+		"db_pool_acquires_total",
+		metric.WithDescription("Connections acquired from the pool"),
+	)
+	if err != nil {
+		return err
+	}
+	emptyAcquires, err := meter.Int64ObservableCounter(
+		"db_pool_empty_acquires_total",
+		metric.WithDescription("Acquires that had to wait because no connection was idle"),
+	)
+	if err != nil {
+		return err
+	}
+	canceledAcquires, err := meter.Int64ObservableCounter(
+		"db_pool_canceled_acquires_total",
+		metric.WithDescription("Acquires canceled by their context while waiting"),
+	)
+	if err != nil {
+		return err
+	}
+	acquireWait, err := meter.Float64ObservableCounter(
+		"db_pool_acquire_wait_seconds_total",
+		metric.WithDescription("Total time spent acquiring connections"),
+		metric.WithUnit("s"),
+	)
+	if err != nil {
+		return err
+	}
+
+	attrs := metric.WithAttributes(attribute.String("pool", name))
+	_, err = meter.RegisterCallback(func(_ context.Context, o metric.Observer) error {
+		stat := pool.Stat()
+		o.ObserveInt64(acquired, int64(stat.AcquiredConns()), attrs)
+		o.ObserveInt64(idle, int64(stat.IdleConns()), attrs)
+		o.ObserveInt64(total, int64(stat.TotalConns()), attrs)
+		o.ObserveInt64(maxConns, int64(stat.MaxConns()), attrs)
+		o.ObserveInt64(acquires, stat.AcquireCount(), attrs)
+		o.ObserveInt64(emptyAcquires, stat.EmptyAcquireCount(), attrs)
+		o.ObserveInt64(canceledAcquires, stat.CanceledAcquireCount(), attrs)
+		o.ObserveFloat64(acquireWait, stat.AcquireDuration().Seconds(), attrs)
+		return nil
+	}, acquired, idle, total, maxConns, acquires, emptyAcquires, canceledAcquires, acquireWait)
+	return err
+}
@@ -0,0 +1,121 @@
+package observability
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+)
+
+// QueryTracer is a pgx tracer that bounds every statement with a deadline and
+// logs statements slower than a threshold. Set it as the Tracer of a pool's
+// ConnConfig so every repository call goes through it.
+type QueryTracer struct {
+	timeout       time.Duration
+	slowThreshold time.Duration
+	logger        *slog.Logger
+}
+
+// NewQueryTracer creates a tracer. A zero timeout leaves statements bounded
+// only by the caller's context; a zero threshold disables slow query logs.
+func NewQueryTracer(timeout, slowThreshold time.Duration, logger *slog.Logger) *QueryTracer {
+	return &QueryTracer{timeout: timeout, slowThreshold: slowThreshold, logger: logger}
+}
+
+type queryTraceKey struct{}
+
+type queryTrace struct {
+	start  time.Time
+	sql    string
+	args   []any
+	cancel context.CancelFunc
+}
+
+// TraceQueryStart applies the statement deadline. pgx runs the statement,
+// and reads the rows of Query, with the returned context.
+func (t *QueryTracer) TraceQueryStart(ctx context.Context, _ *pgx.Conn, data pgx.TraceQueryStartData) context.Context {
+	trace := &queryTrace{start: time.Now(), sql: data.SQL, args: data.Args}
+	if t.timeout > 0 {
+		ctx, trace.cancel = context.WithTimeout(ctx, t.timeout)
+	}
+	return context.WithValue(ctx, queryTraceKey{}, trace)
+}
+
+// TraceQueryEnd releases the deadline and logs the statement if it was slow.
+func (t *QueryTracer) TraceQueryEnd(ctx context.Context, _ *pgx.Conn, data pgx.TraceQueryEndData) {
+	trace, ok := ctx.Value(queryTraceKey{}).(*queryTrace)
+	if !ok {
+		return
+	}
+	if trace.cancel != nil {
+		trace.cancel()
+	}
+
+	duration := time.Since(trace.start)
+	if t.slowThreshold <= 0 || duration < t.slowThreshold {
+		return
+	}
+	attrs := []slog.Attr{
+		slog.String("sql", strings.Join(strings.Fields(trace.sql), " ")),
+		slog.Any("args", sanitizeQueryArgs(trace.args)),
+		slog.Duration("duration", duration),
+		slog.Int64("rows_affected", data.CommandTag.RowsAffected()),
+	}
+	if data.Err != nil {
+		attrs = append(attrs, slog.String("error", data.Err.Error()))
+	}
+	t.logger.LogAttrs(ctx, slog.LevelWarn, "slow query", attrs...)
+}
+
+// sanitizeQueryArgs renders statement arguments for logs. Numbers, booleans,
+// times and UUIDs are kept, as they identify rows without being sensitive;
+// strings and bytes, which may be emails, names or secrets, are reduced to
+// their length.
+func sanitizeQueryArgs(args []any) []string {
+	out := make([]string, len(args))
+	for i, arg := range args {
+		out[i] = sanitizeQueryArg(arg)
+	}
+	return out
+}
+
+func sanitizeQueryArg(arg any) string {
+	if arg == nil {
+		return "NULL"
+	}
+	if t, ok := arg.(time.Time); ok {
+		return t.Format(time.RFC3339Nano)
+	}
+
+	v := reflect.ValueOf(arg)
+	for v.Kind() == reflect.Pointer {
+		if v.IsNil() {
+			return "NULL"
+		}
+		v = v.Elem()
+	}
+	switch v.Kind() {
+	case reflect.Bool,
+		reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+		return fmt.Sprint(v.Interface())
+	case reflect.String:
+		return fmt.Sprintf("<string len=%d>", v.Len())
+	case reflect.Array:
+		// UUIDs ([16]byte with a String method)
+		if s, ok := v.Interface().(fmt.Stringer); ok && v.Len() == 16 {
+			return s.String()
+		}
+	case reflect.Slice:
+		if v.Type().Elem().Kind() == reflect.Uint8 {
+			return fmt.Sprintf("<bytes len=%d>", v.Len())
+		}
+		return fmt.Sprintf("<%s len=%d>", v.Type(), v.Len())
+	}
+	return fmt.Sprintf("<%s>", v.Type())
+}
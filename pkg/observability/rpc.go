@@ -0,0 +1,95 @@
+// Package observability provides the metrics shared by the BFF and the
+// backend services, and serves them in the Prometheus text format.
+package observability
+
+import (
+	"context"
+	"time"
+
+	"connectrpc.com/connect"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+)
+
+// rpcDurationBuckets are the bucket boundaries of the RPC duration
+// histogram, in seconds.
+var rpcDurationBuckets = []float64{0.005, 0.01, 0.025, 0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10, 30}
+
+// RPCMetrics records the RED metrics of served RPCs: request rate and
+// errors by procedure and status code, duration, and requests in flight.
+type RPCMetrics struct {
+	requests metric.Int64Counter
+	duration metric.Float64Histogram
+	inFlight metric.Int64UpDownCounter
+}
+
+// NewRPCMetrics creates RPC metrics.
+func NewRPCMetrics(meter metric.Meter) (*RPCMetrics, error) {
+	m := &RPCMetrics{}
+
+	var err error
+
+	m.requests, err = meter.Int64Counter(
+		"rpc_requests_total",
+		metric.WithDescription("Completed RPCs by procedure and status code"),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	m.duration, err = meter.Float64Histogram(
+		"rpc_request_duration_seconds",
+		metric.WithDescription("RPC duration in seconds by procedure and status code"),
+		metric.WithUnit("s"),
+		metric.WithExplicitBucketBoundaries(rpcDurationBuckets...),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	m.inFlight, err = meter.Int64UpDownCounter(
+		"rpc_requests_in_flight",
+		metric.WithDescription("RPCs being served by procedure"),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return m, nil
+}
+
+// Record records a completed RPC.
+func (m *RPCMetrics) Record(ctx context.Context, procedure string, duration time.Duration, err error) {
+	code := "ok"
+	if err != nil {
+		code = connect.CodeOf(err).String()
+	}
+	attrs := metric.WithAttributes(
+		attribute.String("procedure", procedure),
+		attribute.String("code", code),
+	)
+	m.requests.Add(ctx, 1, attrs)
+	m.duration.Record(ctx, duration.Seconds(), attrs)
+}
+
+// Interceptor returns a server-side interceptor recording every unary call.
+// It should run first so calls rejected by other interceptors are counted.
+func (m *RPCMetrics) Interceptor() connect.UnaryInterceptorFunc {
+	return func(next connect.UnaryFunc) connect.UnaryFunc {
+		return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+			if req.Spec().IsClient {
+				return next(ctx, req)
+			}
+			procedure := req.Spec().Procedure
+			inFlightAttrs := metric.WithAttributes(attribute.String("procedure", procedure))
+
+			m.inFlight.Add(ctx, 1, inFlightAttrs)
+			start := time.Now()
+			resp, err := next(ctx, req)
+			m.Record(ctx, procedure, time.Since(start), err)
+			m.inFlight.Add(ctx, -1, inFlightAttrs)
+
+			return resp, err
+		}
+	}
+}
@@ -0,0 +1,193 @@
+package operations
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"strconv"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	operationsv1 "github.com/daisuke8000/example-ec-platform/gen/operations/v1"
+	"github.com/daisuke8000/example-ec-platform/gen/operations/v1/operationsv1connect"
+	"github.com/daisuke8000/example-ec-platform/pkg/listing"
+)
+
+const (
+	defaultPageSize = 20
+	maxPageSize     = 100
+)
+
+var _ operationsv1connect.OperationsServiceHandler = (*Handler)(nil)
+
+// Handler serves operations.v1.OperationsService over a Store.
+type Handler struct {
+	operationsv1connect.UnimplementedOperationsServiceHandler
+	store      Store
+	pageTokens *listing.Codec
+	logger     *slog.Logger
+}
+
+func NewHandler(store Store, pageTokens *listing.Codec, logger *slog.Logger) *Handler {
+	return &Handler{
+		store:      store,
+		pageTokens: pageTokens,
+		logger:     logger,
+	}
+}
+
+func (h *Handler) GetOperation(
+	ctx context.Context,
+	req *connect.Request[operationsv1.GetOperationRequest],
+) (*connect.Response[operationsv1.GetOperationResponse], error) {
+	id, err := parseID(req.Msg.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	op, err := h.store.Get(ctx, id)
+	if err != nil {
+		return nil, h.mapError(ctx, err)
+	}
+	return connect.NewResponse(&operationsv1.GetOperationResponse{Operation: ToProto(op)}), nil
+}
+
+func (h *Handler) ListOperations(
+	ctx context.Context,
+	req *connect.Request[operationsv1.ListOperationsRequest],
+) (*connect.Response[operationsv1.ListOperationsResponse], error) {
+	pageSize := int(req.Msg.GetPageSize())
+	if pageSize <= 0 {
+		pageSize = defaultPageSize
+	}
+	pageSize = min(pageSize, maxPageSize)
+
+	filter := ListFilter{
+		Kind:       req.Msg.GetKind(),
+		CreatedBy:  req.Msg.GetCreatedBy(),
+		ActiveOnly: req.Msg.GetActiveOnly(),
+	}
+	query := listing.QueryKey(nil, nil, filter.Kind, filter.CreatedBy, strconv.FormatBool(filter.ActiveOnly))
+
+	var after *Cursor
+	if req.Msg.GetPageToken() != "" {
+		after = &Cursor{}
+		if err := h.pageTokens.Decode(req.Msg.GetPageToken(), query, after); err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		}
+	}
+
+	// Fetch one extra row to know whether another page exists.
+	ops, err := h.store.List(ctx, filter, after, pageSize+1)
+	if err != nil {
+		return nil, h.mapError(ctx, err)
+	}
+
+	resp := &operationsv1.ListOperationsResponse{}
+	if len(ops) > pageSize {
+		ops = ops[:pageSize]
+		last := ops[len(ops)-1]
+		resp.NextPageToken, err = h.pageTokens.Encode(query, Cursor{CreatedAt: last.CreatedAt, ID: last.ID})
+		if err != nil {
+			return nil, h.mapError(ctx, err)
+		}
+	}
+	for _, op := range ops {
+		resp.Operations = append(resp.Operations, ToProto(op))
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (h *Handler) CancelOperation(
+	ctx context.Context,
+	req *connect.Request[operationsv1.CancelOperationRequest],
+) (*connect.Response[operationsv1.CancelOperationResponse], error) {
+	id, err := parseID(req.Msg.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	op, err := h.store.RequestCancel(ctx, id)
+	if err != nil {
+		return nil, h.mapError(ctx, err)
+	}
+	h.logger.InfoContext(ctx, "operation cancellation requested",
+		slog.String("operation_id", op.ID.String()),
+		slog.String("kind", op.Kind),
+	)
+	return connect.NewResponse(&operationsv1.CancelOperationResponse{Operation: ToProto(op)}), nil
+}
+
+func parseID(s string) (uuid.UUID, error) {
+	id, err := uuid.Parse(s)
+	if err != nil {
+		return uuid.Nil, connect.NewError(connect.CodeInvalidArgument, errors.New("invalid operation id"))
+	}
+	return id, nil
+}
+
+func (h *Handler) mapError(ctx context.Context, err error) error {
+	switch {
+	case errors.Is(err, ErrNotFound):
+		return connect.NewError(connect.CodeNotFound, err)
+	case errors.Is(err, ErrAlreadyDone):
+		return connect.NewError(connect.CodeFailedPrecondition, err)
+	}
+	h.logger.ErrorContext(ctx, "operations store error", slog.String("error", err.Error()))
+	return connect.NewError(connect.CodeInternal, errors.New("internal server error"))
+}
+
+var statusToProto = map[Status]operationsv1.OperationStatus{
+	StatusPending:   operationsv1.OperationStatus_OPERATION_STATUS_PENDING,
+	StatusRunning:   operationsv1.OperationStatus_OPERATION_STATUS_RUNNING,
+	StatusSucceeded: operationsv1.OperationStatus_OPERATION_STATUS_SUCCEEDED,
+	StatusFailed:    operationsv1.OperationStatus_OPERATION_STATUS_FAILED,
+	StatusCancelled: operationsv1.OperationStatus_OPERATION_STATUS_CANCELLED,
+}
+
+// ToProto converts an operation for responses of RPCs that start one.
+func ToProto(op *Operation) *operationsv1.Operation {
+	pb := &operationsv1.Operation{
+		Id:              op.ID.String(),
+		Kind:            op.Kind,
+		Status:          statusToProto[op.Status],
+		ProgressPercent: int32(op.Progress),
+		Result:          toStruct(op.Result),
+		CreatedBy:       op.CreatedBy,
+		CancelRequested: op.CancelRequested,
+		CreatedAt:       timestamppb.New(op.CreatedAt),
+		UpdatedAt:       timestamppb.New(op.UpdatedAt),
+	}
+	if op.DoneAt != nil {
+		pb.DoneAt = timestamppb.New(*op.DoneAt)
+	}
+	if op.Error != nil {
+		pb.Error = &operationsv1.OperationError{
+			Code:    op.Error.Code,
+			Message: op.Error.Message,
+			Details: toStruct(op.Error.Details),
+		}
+	}
+	return pb
+}
+
+// toStruct converts a JSON object to a Struct. Other JSON values (arrays,
+// scalars) are wrapped as {"value": ...}.
+func toStruct(raw []byte) *structpb.Struct {
+	if len(raw) == 0 {
+		return nil
+	}
+	s := &structpb.Struct{}
+	if err := protojson.Unmarshal(raw, s); err == nil {
+		return s
+	}
+	v := &structpb.Value{}
+	if err := protojson.Unmarshal(raw, v); err != nil {
+		return nil
+	}
+	return &structpb.Struct{Fields: map[string]*structpb.Value{"value": v}}
+}
@@ -0,0 +1,90 @@
+// Package operations tracks long-running jobs (imports, exports, bulk
+// actions) in a per-service operations table, runs them in the background
+// with progress reporting and cooperative cancellation, and serves them
+// through operations.v1.OperationsService.
+package operations
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrNotFound    = errors.New("operation not found")
+	ErrAlreadyDone = errors.New("operation already finished")
+	// ErrCancelled is the cancellation cause of a job context when
+	// CancelOperation was requested.
+	ErrCancelled = errors.New("operation cancelled")
+)
+
+type Status string
+
+const (
+	StatusPending   Status = "pending"
+	StatusRunning   Status = "running"
+	StatusSucceeded Status = "succeeded"
+	StatusFailed    Status = "failed"
+	StatusCancelled Status = "cancelled"
+)
+
+// Done reports whether the status is final.
+func (s Status) Done() bool {
+	return s == StatusSucceeded || s == StatusFailed || s == StatusCancelled
+}
+
+// Error is the failure payload of an operation.
+type Error struct {
+	// Code is a Connect error code name, e.g. "invalid_argument".
+	Code    string
+	Message string
+	// Details is an optional job-specific JSON object.
+	Details json.RawMessage
+}
+
+// Operation is the persisted state of a long-running job.
+type Operation struct {
+	ID              uuid.UUID
+	Kind            string
+	Status          Status
+	Progress        int
+	Result          json.RawMessage
+	Error           *Error
+	CreatedBy       string
+	CancelRequested bool
+	CreatedAt       time.Time
+	UpdatedAt       time.Time
+	DoneAt          *time.Time
+}
+
+// ListFilter narrows ListOperations. Empty fields match everything.
+type ListFilter struct {
+	Kind       string
+	CreatedBy  string
+	ActiveOnly bool
+}
+
+// Cursor is the keyset position of the last operation on a page.
+type Cursor struct {
+	CreatedAt time.Time `json:"t"`
+	ID        uuid.UUID `json:"id"`
+}
+
+type Store interface {
+	Create(ctx context.Context, op *Operation) error
+	// Get returns ErrNotFound if the operation doesn't exist.
+	Get(ctx context.Context, id uuid.UUID) (*Operation, error)
+	// List returns up to limit operations after cursor, newest first.
+	List(ctx context.Context, filter ListFilter, after *Cursor, limit int) ([]*Operation, error)
+	// UpdateProgress stores the status and progress of an unfinished
+	// operation and reports whether cancellation has been requested.
+	UpdateProgress(ctx context.Context, id uuid.UUID, status Status, progress int) (cancelRequested bool, err error)
+	// Finish stores the final status, result and error of op.
+	Finish(ctx context.Context, op *Operation) error
+	// RequestCancel flags an unfinished operation for cancellation.
+	// Returns ErrAlreadyDone if it has finished.
+	RequestCancel(ctx context.Context, id uuid.UUID) (*Operation, error)
+}
@@ -0,0 +1,218 @@
+package operations
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+const operationColumns = `id, kind, status, progress, result, error_code, error_message,
+	error_details, created_by, cancel_requested, created_at, updated_at, done_at`
+
+// PostgresStore implements Store using PostgreSQL.
+type PostgresStore struct {
+	pool  *pgxpool.Pool
+	table string
+}
+
+// NewPostgresStore creates a store over table, a schema-qualified name such
+// as "user_service.operations". See deployments/init-db.sql for the schema.
+func NewPostgresStore(pool *pgxpool.Pool, table string) *PostgresStore {
+	return &PostgresStore{pool: pool, table: table}
+}
+
+// Create persists a new operation.
+func (s *PostgresStore) Create(ctx context.Context, op *Operation) error {
+	query := fmt.Sprintf(`
+		INSERT INTO %s (id, kind, status, progress, created_by, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+	`, s.table)
+
+	_, err := s.pool.Exec(ctx, query,
+		op.ID,
+		op.Kind,
+		op.Status,
+		op.Progress,
+		op.CreatedBy,
+		op.CreatedAt,
+		op.UpdatedAt,
+	)
+	return err
+}
+
+// Get retrieves an operation.
+// Returns ErrNotFound if the operation doesn't exist.
+func (s *PostgresStore) Get(ctx context.Context, id uuid.UUID) (*Operation, error) {
+	query := fmt.Sprintf(`SELECT %s FROM %s WHERE id = $1`, operationColumns, s.table)
+
+	op, err := scanOperation(s.pool.QueryRow(ctx, query, id))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, ErrNotFound
+	}
+	return op, err
+}
+
+// List returns operations matching filter, newest first, using keyset
+// pagination on (created_at, id).
+func (s *PostgresStore) List(ctx context.Context, filter ListFilter, after *Cursor, limit int) ([]*Operation, error) {
+	var (
+		conditions []string
+		args       []any
+	)
+	addCondition := func(format string, values ...any) {
+		placeholders := make([]any, len(values))
+		for i, v := range values {
+			args = append(args, v)
+			placeholders[i] = len(args)
+		}
+		conditions = append(conditions, fmt.Sprintf(format, placeholders...))
+	}
+
+	if filter.Kind != "" {
+		addCondition("kind = $%d", filter.Kind)
+	}
+	if filter.CreatedBy != "" {
+		addCondition("created_by = $%d", filter.CreatedBy)
+	}
+	if filter.ActiveOnly {
+		conditions = append(conditions, "done_at IS NULL")
+	}
+	if after != nil {
+		addCondition("(created_at, id) < ($%d, $%d)", after.CreatedAt, after.ID)
+	}
+
+	where := ""
+	if len(conditions) > 0 {
+		where = "WHERE " + strings.Join(conditions, " AND ")
+	}
+	args = append(args, limit)
+	query := fmt.Sprintf(`SELECT %s FROM %s %s ORDER BY created_at DESC, id DESC LIMIT $%d`,
+		operationColumns, s.table, where, len(args))
+
+	rows, err := s.pool.Query(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var ops []*Operation
+	for rows.Next() {
+		op, err := scanOperation(rows)
+		if err != nil {
+			return nil, err
+		}
+		ops = append(ops, op)
+	}
+	return ops, rows.Err()
+}
+
+// UpdateProgress stores the status and progress of an unfinished operation.
+func (s *PostgresStore) UpdateProgress(ctx context.Context, id uuid.UUID, status Status, progress int) (bool, error) {
+	query := fmt.Sprintf(`
+		UPDATE %s
+		SET status = $2, progress = $3, updated_at = NOW()
+		WHERE id = $1 AND done_at IS NULL
+		RETURNING cancel_requested
+	`, s.table)
+
+	var cancelRequested bool
+	err := s.pool.QueryRow(ctx, query, id, status, progress).Scan(&cancelRequested)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return false, ErrNotFound
+	}
+	return cancelRequested, err
+}
+
+// Finish stores the final state of op. Progress is kept from the last
+// update unless the operation succeeded.
+func (s *PostgresStore) Finish(ctx context.Context, op *Operation) error {
+	query := fmt.Sprintf(`
+		UPDATE %s
+		SET status = $2, progress = GREATEST(progress, $3), result = $4,
+			error_code = $5, error_message = $6, error_details = $7,
+			updated_at = $8, done_at = $9
+		WHERE id = $1
+	`, s.table)
+
+	var code, message *string
+	var details []byte
+	if op.Error != nil {
+		code, message = &op.Error.Code, &op.Error.Message
+		details = op.Error.Details
+	}
+	_, err := s.pool.Exec(ctx, query,
+		op.ID,
+		op.Status,
+		op.Progress,
+		[]byte(op.Result),
+		code,
+		message,
+		details,
+		op.UpdatedAt,
+		op.DoneAt,
+	)
+	return err
+}
+
+// RequestCancel flags an unfinished operation for cancellation.
+func (s *PostgresStore) RequestCancel(ctx context.Context, id uuid.UUID) (*Operation, error) {
+	query := fmt.Sprintf(`
+		UPDATE %s
+		SET cancel_requested = TRUE, updated_at = NOW()
+		WHERE id = $1 AND done_at IS NULL
+		RETURNING %s
+	`, s.table, operationColumns)
+
+	op, err := scanOperation(s.pool.QueryRow(ctx, query, id))
+	if errors.Is(err, pgx.ErrNoRows) {
+		// Distinguish a finished operation from a missing one.
+		if _, getErr := s.Get(ctx, id); getErr != nil {
+			return nil, getErr
+		}
+		return nil, ErrAlreadyDone
+	}
+	return op, err
+}
+
+func scanOperation(row pgx.Row) (*Operation, error) {
+	var (
+		op                  Operation
+		result, details     []byte
+		errorCode, errorMsg *string
+		doneAt              *time.Time
+	)
+	err := row.Scan(
+		&op.ID,
+		&op.Kind,
+		&op.Status,
+		&op.Progress,
+		&result,
+		&errorCode,
+		&errorMsg,
+		&details,
+		&op.CreatedBy,
+		&op.CancelRequested,
+		&op.CreatedAt,
+		&op.UpdatedAt,
+		&doneAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	op.Result = result
+	op.DoneAt = doneAt
+	if errorCode != nil {
+		op.Error = &Error{Code: *errorCode, Details: details}
+		if errorMsg != nil {
+			op.Error.Message = *errorMsg
+		}
+	}
+	return &op, nil
+}
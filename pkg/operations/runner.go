@@ -0,0 +1,217 @@
+package operations
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"sync"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+)
+
+// progressInterval is the minimum time between progress writes.
+const progressInterval = time.Second
+
+// Job is the work of an operation. It reports progress through p and
+// returns a JSON-encodable result. It must stop when ctx is done; a job
+// stopped by CancelOperation is recorded as cancelled.
+type Job func(ctx context.Context, p *Progress) (result any, err error)
+
+// DetailedError attaches a job-specific payload, such as rejected rows, to
+// a failure. The payload must encode to a JSON object.
+type DetailedError struct {
+	Err     error
+	Details any
+}
+
+func (e *DetailedError) Error() string { return e.Err.Error() }
+func (e *DetailedError) Unwrap() error { return e.Err }
+
+// WithDetails wraps err with a payload for the operation error.
+func WithDetails(err error, details any) error {
+	return &DetailedError{Err: err, Details: details}
+}
+
+// Runner runs jobs in the background as operations.
+type Runner struct {
+	store  Store
+	logger *slog.Logger
+	wg     sync.WaitGroup
+}
+
+// NewRunner creates a runner recording operations in store.
+// Jobs run in background goroutines; call Wait during shutdown.
+func NewRunner(store Store, logger *slog.Logger) *Runner {
+	return &Runner{store: store, logger: logger}
+}
+
+// Start records a pending operation of the given kind and runs job in the
+// background. It returns the operation as created; callers poll
+// GetOperation for progress.
+func (r *Runner) Start(ctx context.Context, kind, createdBy string, job Job) (*Operation, error) {
+	now := time.Now().UTC()
+	op := &Operation{
+		ID:        uuid.New(),
+		Kind:      kind,
+		Status:    StatusPending,
+		CreatedBy: createdBy,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+	if err := r.store.Create(ctx, op); err != nil {
+		return nil, err
+	}
+
+	// The caller receives a snapshot; the running copy is owned by the worker.
+	snapshot := *op
+
+	r.wg.Add(1)
+	go func() {
+		defer r.wg.Done()
+		r.run(context.WithoutCancel(ctx), op, job)
+	}()
+
+	return &snapshot, nil
+}
+
+// Wait blocks until all running jobs have finished.
+func (r *Runner) Wait() {
+	r.wg.Wait()
+}
+
+func (r *Runner) run(ctx context.Context, op *Operation, job Job) {
+	logger := r.logger.With(
+		slog.String("operation_id", op.ID.String()),
+		slog.String("kind", op.Kind),
+	)
+	logger.Info("operation started")
+
+	jobCtx, cancel := context.WithCancelCause(ctx)
+	defer cancel(nil)
+
+	p := &Progress{store: r.store, id: op.ID, cancel: cancel}
+	if err := p.save(ctx, StatusRunning, 0); err != nil {
+		r.finish(ctx, logger, op, nil, err)
+		return
+	}
+
+	result, err := runJob(jobCtx, job, p)
+	if err != nil && errors.Is(context.Cause(jobCtx), ErrCancelled) {
+		err = ErrCancelled
+	}
+	r.finish(ctx, logger, op, result, err)
+}
+
+// runJob runs job, converting a panic into an error.
+func runJob(ctx context.Context, job Job, p *Progress) (result any, err error) {
+	defer func() {
+		if v := recover(); v != nil {
+			err = connect.NewError(connect.CodeInternal, fmt.Errorf("job panicked: %v", v))
+		}
+	}()
+	return job(ctx, p)
+}
+
+func (r *Runner) finish(ctx context.Context, logger *slog.Logger, op *Operation, result any, jobErr error) {
+	now := time.Now().UTC()
+	op.UpdatedAt = now
+	op.DoneAt = &now
+
+	if jobErr == nil && result != nil {
+		raw, err := json.Marshal(result)
+		if err != nil {
+			jobErr = fmt.Errorf("encode result: %w", err)
+		} else {
+			op.Result = raw
+		}
+	}
+
+	switch {
+	case jobErr == nil:
+		op.Status = StatusSucceeded
+		op.Progress = 100
+	case errors.Is(jobErr, ErrCancelled):
+		op.Status = StatusCancelled
+	default:
+		op.Status = StatusFailed
+		op.Error = toError(jobErr)
+	}
+
+	if err := r.store.Finish(ctx, op); err != nil {
+		logger.Error("failed to save operation result", slog.String("error", err.Error()))
+		return
+	}
+	if op.Status == StatusFailed {
+		logger.Error("operation failed", slog.String("error", jobErr.Error()))
+		return
+	}
+	logger.Info("operation finished", slog.String("status", string(op.Status)))
+}
+
+func toError(err error) *Error {
+	e := &Error{Code: connect.CodeOf(err).String(), Message: err.Error()}
+	var connectErr *connect.Error
+	if errors.As(err, &connectErr) {
+		e.Message = connectErr.Message()
+	}
+	var detailed *DetailedError
+	if errors.As(err, &detailed) && detailed.Details != nil {
+		if raw, err := json.Marshal(detailed.Details); err == nil {
+			e.Details = raw
+		}
+	}
+	return e
+}
+
+// Progress reports the progress of a running job.
+type Progress struct {
+	store  Store
+	id     uuid.UUID
+	cancel context.CancelCauseFunc
+
+	mu       sync.Mutex
+	lastSave time.Time
+}
+
+// Set records that the job is percent (0-100) complete. Writes are
+// throttled to one per second, and each write checks whether cancellation
+// has been requested; if so, the job context is cancelled with ErrCancelled.
+// Jobs should call Set regularly even when progress has not changed.
+func (p *Progress) Set(ctx context.Context, percent int) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if time.Since(p.lastSave) < progressInterval {
+		return nil
+	}
+	return p.saveLocked(context.WithoutCancel(ctx), StatusRunning, min(max(percent, 0), 100))
+}
+
+// SetFraction records done out of total units of work.
+func (p *Progress) SetFraction(ctx context.Context, done, total int) error {
+	if total <= 0 {
+		return nil
+	}
+	return p.Set(ctx, done*100/total)
+}
+
+func (p *Progress) save(ctx context.Context, status Status, percent int) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.saveLocked(ctx, status, percent)
+}
+
+func (p *Progress) saveLocked(ctx context.Context, status Status, percent int) error {
+	cancelRequested, err := p.store.UpdateProgress(ctx, p.id, status, percent)
+	if err != nil {
+		return err
+	}
+	p.lastSave = time.Now()
+	if cancelRequested {
+		p.cancel(ErrCancelled)
+	}
+	return nil
+}
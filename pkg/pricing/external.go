@@ -0,0 +1,92 @@
+package pricing
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+)
+
+// Provider adapts an external tax service (e.g. Avalara, TaxJar) that
+// knows rates this platform does not keep, such as US sales tax by postal
+// code.
+type Provider interface {
+	// Name identifies the service in errors and logs.
+	Name() string
+	// QuoteTax returns the tax on each line of req, in the same order.
+	QuoteTax(ctx context.Context, jurisdiction Jurisdiction, req TaxRequest) ([]TaxLine, error)
+}
+
+// ExternalConfig configures an External calculator.
+type ExternalConfig struct {
+	// Timeout bounds each quote; zero leaves it to ctx.
+	Timeout time.Duration
+	// Fallback, if set, calculates the tax when the provider fails, so an
+	// outage of the tax service does not block checkout.
+	Fallback TaxCalculator
+}
+
+// External calculates tax with a Provider.
+type External struct {
+	provider Provider
+	cfg      ExternalConfig
+	logger   *slog.Logger
+}
+
+func NewExternal(provider Provider, cfg ExternalConfig, logger *slog.Logger) *External {
+	return &External{provider: provider, cfg: cfg, logger: logger}
+}
+
+func (e *External) Calculate(ctx context.Context, req TaxRequest) (*TaxResult, error) {
+	jurisdiction, err := ResolveJurisdiction(req.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := e.quote(ctx, jurisdiction, req)
+	if err == nil {
+		return result, nil
+	}
+	if e.cfg.Fallback == nil || ctx.Err() != nil {
+		return nil, err
+	}
+	e.logger.WarnContext(ctx, "tax provider failed, using fallback",
+		slog.String("provider", e.provider.Name()),
+		slog.String("jurisdiction", jurisdiction.String()),
+		slog.String("error", err.Error()),
+	)
+	return e.cfg.Fallback.Calculate(ctx, req)
+}
+
+// quote asks the provider and checks that its answer covers exactly the
+// request lines, so a misbehaving provider cannot drop or add tax. The
+// provider's line taxes are kept as quoted, so the subtotals follow its
+// rounding rules rather than newResult's.
+func (e *External) quote(ctx context.Context, jurisdiction Jurisdiction, req TaxRequest) (*TaxResult, error) {
+	if e.cfg.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, e.cfg.Timeout)
+		defer cancel()
+	}
+
+	lines, err := e.provider.QuoteTax(ctx, jurisdiction, req)
+	if err != nil {
+		return nil, fmt.Errorf("tax provider %s: %w", e.provider.Name(), err)
+	}
+	if len(lines) != len(req.Lines) {
+		return nil, fmt.Errorf("tax provider %s returned %d lines for %d", e.provider.Name(), len(lines), len(req.Lines))
+	}
+
+	result := &TaxResult{Jurisdiction: jurisdiction, Lines: lines}
+	for i, line := range lines {
+		if line.ID != req.Lines[i].ID {
+			return nil, fmt.Errorf("tax provider %s returned line %q for %q", e.provider.Name(), line.ID, req.Lines[i].ID)
+		}
+		if line.Rate < 0 {
+			return nil, fmt.Errorf("tax provider %s: line %q: %w", e.provider.Name(), line.ID, ErrInvalidRate)
+		}
+		result.addSubtotal(line.Rate, req.Lines[i].Amount, line.Tax)
+		result.Total += line.Tax
+	}
+	return result, nil
+}
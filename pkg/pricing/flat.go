@@ -0,0 +1,21 @@
+package pricing
+
+import "context"
+
+// FlatRate taxes every line at the same rate, wherever it ships.
+type FlatRate struct {
+	rate Rate
+}
+
+func NewFlatRate(rate Rate) (*FlatRate, error) {
+	if rate < 0 {
+		return nil, ErrInvalidRate
+	}
+	return &FlatRate{rate: rate}, nil
+}
+
+func (f *FlatRate) Calculate(ctx context.Context, req TaxRequest) (*TaxResult, error) {
+	return newResult(Jurisdiction{}, req.Lines, func(LineItem) (Rate, error) {
+		return f.rate, nil
+	})
+}
@@ -0,0 +1,210 @@
+// Package pricing calculates the tax on cart and order totals. A
+// TaxCalculator is either a flat rate, a table of rates per jurisdiction
+// and tax category, or an external tax service behind a Provider.
+package pricing
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+var (
+	ErrInvalidAddress = errors.New("shipping address has no valid country")
+	ErrNoTaxRate      = errors.New("no tax rate for jurisdiction")
+	ErrInvalidRate    = errors.New("tax rate must not be negative")
+)
+
+// RateScale is the value of a 100% Rate.
+const RateScale = 1_000_000
+
+// Rate is a tax rate in parts per million, so that rates such as 8.875%
+// (88750) are exact.
+type Rate int64
+
+func (r Rate) String() string {
+	return fmt.Sprintf("%d.%04d%%", r/10_000, r%10_000)
+}
+
+// Address is the part of a shipping address that decides the tax.
+type Address struct {
+	// Country is an ISO 3166-1 alpha-2 code.
+	Country    string
+	Region     string
+	PostalCode string
+}
+
+// Jurisdiction is where an order is taxed. Region is empty for countries
+// taxed at the national level only.
+type Jurisdiction struct {
+	Country string
+	Region  string
+}
+
+func (j Jurisdiction) String() string {
+	if j.Region == "" {
+		return j.Country
+	}
+	return j.Country + "-" + j.Region
+}
+
+// ResolveJurisdiction returns the jurisdiction of a shipping address. The
+// country and region are normalized to upper case so they match table
+// entries regardless of how the address was entered.
+func ResolveJurisdiction(addr Address) (Jurisdiction, error) {
+	country := strings.ToUpper(strings.TrimSpace(addr.Country))
+	if len(country) != 2 || !isUpperASCII(country) {
+		return Jurisdiction{}, ErrInvalidAddress
+	}
+	return Jurisdiction{
+		Country: country,
+		Region:  strings.ToUpper(strings.TrimSpace(addr.Region)),
+	}, nil
+}
+
+func isUpperASCII(s string) bool {
+	for _, c := range s {
+		if c < 'A' || c > 'Z' {
+			return false
+		}
+	}
+	return true
+}
+
+// LineItem is a taxable line of a cart or order, e.g. a SKU times its
+// quantity, or shipping.
+type LineItem struct {
+	ID string
+	// Amount is the line total in minor units of the request currency,
+	// after discounts. It is negative for a credit.
+	Amount int64
+	// TaxCategory selects a reduced or exempt rate (e.g. "food"); empty
+	// means the standard rate.
+	TaxCategory string
+}
+
+type TaxRequest struct {
+	// Currency is an ISO 4217 code; all amounts are in its minor units.
+	Currency string
+	Address  Address
+	Lines    []LineItem
+}
+
+// TaxLine is the tax on one line item.
+type TaxLine struct {
+	ID   string
+	Rate Rate
+	Tax  int64
+}
+
+// RateSubtotal is the taxable amount and tax of all lines at one rate.
+type RateSubtotal struct {
+	Rate   Rate
+	Amount int64
+	Tax    int64
+}
+
+type TaxResult struct {
+	// Jurisdiction is zero when the calculator does not depend on the
+	// address.
+	Jurisdiction Jurisdiction
+	// Lines are in the order of the request lines.
+	Lines []TaxLine
+	// Subtotals has one entry per distinct rate, in the order the rates
+	// first appear in Lines.
+	Subtotals []RateSubtotal
+	Total     int64
+}
+
+// addSubtotal adds a line's amount and tax to the subtotal of its rate.
+func (r *TaxResult) addSubtotal(rate Rate, amount, tax int64) {
+	for i := range r.Subtotals {
+		if r.Subtotals[i].Rate == rate {
+			r.Subtotals[i].Amount += amount
+			r.Subtotals[i].Tax += tax
+			return
+		}
+	}
+	r.Subtotals = append(r.Subtotals, RateSubtotal{Rate: rate, Amount: amount, Tax: tax})
+}
+
+// TaxCalculator calculates the tax on the lines of a cart or order.
+type TaxCalculator interface {
+	Calculate(ctx context.Context, req TaxRequest) (*TaxResult, error)
+}
+
+// Tax returns the tax on amount at rate, rounded half away from zero.
+func Tax(amount int64, rate Rate) int64 {
+	product := amount * int64(rate)
+	if product < 0 {
+		return -((-product + RateScale/2) / RateScale)
+	}
+	return (product + RateScale/2) / RateScale
+}
+
+// newResult applies rateOf to each line of req. The tax is rounded once per
+// rate, on the subtotal of the lines at that rate, as a Japanese qualified
+// invoice requires; rounding per line would let the total drift from the
+// invoice by up to half a minor unit per line.
+func newResult(jurisdiction Jurisdiction, lines []LineItem, rateOf func(LineItem) (Rate, error)) (*TaxResult, error) {
+	result := &TaxResult{
+		Jurisdiction: jurisdiction,
+		Lines:        make([]TaxLine, len(lines)),
+	}
+	for i, line := range lines {
+		rate, err := rateOf(line)
+		if err != nil {
+			return nil, err
+		}
+		result.Lines[i] = TaxLine{ID: line.ID, Rate: rate}
+		result.addSubtotal(rate, line.Amount, 0)
+	}
+	for i := range result.Subtotals {
+		sub := &result.Subtotals[i]
+		sub.Tax = Tax(sub.Amount, sub.Rate)
+		result.Total += sub.Tax
+		allocate(result.Lines, lines, *sub)
+	}
+	return result, nil
+}
+
+// allocate splits the tax of sub over the lines at its rate, so that the
+// line taxes add up to the subtotal. Each line starts at its own rounded
+// tax, and the rounding difference is spread one minor unit at a time over
+// the lines with the largest amounts.
+func allocate(taxLines []TaxLine, lines []LineItem, sub RateSubtotal) {
+	var indexes []int
+	remaining := sub.Tax
+	for i, line := range lines {
+		if taxLines[i].Rate != sub.Rate {
+			continue
+		}
+		taxLines[i].Tax = Tax(line.Amount, sub.Rate)
+		remaining -= taxLines[i].Tax
+		indexes = append(indexes, i)
+	}
+	if remaining == 0 {
+		return
+	}
+
+	sort.SliceStable(indexes, func(a, b int) bool {
+		return abs(lines[indexes[a]].Amount) > abs(lines[indexes[b]].Amount)
+	})
+	step := int64(1)
+	if remaining < 0 {
+		step = -1
+	}
+	for k := 0; remaining != 0; k++ {
+		taxLines[indexes[k%len(indexes)]].Tax += step
+		remaining -= step
+	}
+}
+
+func abs(n int64) int64 {
+	if n < 0 {
+		return -n
+	}
+	return n
+}
@@ -0,0 +1,406 @@
+package pricing
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"testing"
+)
+
+func TestResolveJurisdiction(t *testing.T) {
+	tests := []struct {
+		name    string
+		addr    Address
+		want    Jurisdiction
+		wantErr error
+	}{
+		{
+			name: "country only",
+			addr: Address{Country: "JP", PostalCode: "100-0001"},
+			want: Jurisdiction{Country: "JP"},
+		},
+		{
+			name: "normalizes case and spaces",
+			addr: Address{Country: " us ", Region: "ca"},
+			want: Jurisdiction{Country: "US", Region: "CA"},
+		},
+		{
+			name:    "missing country",
+			addr:    Address{Region: "CA"},
+			wantErr: ErrInvalidAddress,
+		},
+		{
+			name:    "alpha-3 country",
+			addr:    Address{Country: "USA"},
+			wantErr: ErrInvalidAddress,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ResolveJurisdiction(tt.addr)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("ResolveJurisdiction() error = %v, want %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("ResolveJurisdiction() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTax(t *testing.T) {
+	tests := []struct {
+		name   string
+		amount int64
+		rate   Rate
+		want   int64
+	}{
+		{name: "exact", amount: 1000, rate: 100_000, want: 100},
+		{name: "rounds half up", amount: 105, rate: 100_000, want: 11},
+		{name: "rounds down", amount: 104, rate: 100_000, want: 10},
+		{name: "fractional rate", amount: 10_000, rate: 88_750, want: 888},
+		{name: "credit rounds half away from zero", amount: -105, rate: 100_000, want: -11},
+		{name: "zero rate", amount: 1000, rate: 0, want: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Tax(tt.amount, tt.rate); got != tt.want {
+				t.Errorf("Tax(%d, %s) = %d, want %d", tt.amount, tt.rate, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFlatRate_Calculate(t *testing.T) {
+	calc, err := NewFlatRate(100_000)
+	if err != nil {
+		t.Fatalf("NewFlatRate() error = %v", err)
+	}
+
+	result, err := calc.Calculate(context.Background(), TaxRequest{
+		Currency: "JPY",
+		Lines: []LineItem{
+			{ID: "sku-1", Amount: 1980},
+			{ID: "shipping", Amount: 500},
+			{ID: "coupon", Amount: -300},
+		},
+	})
+	if err != nil {
+		t.Fatalf("Calculate() error = %v", err)
+	}
+	// 198 + 50 - 30
+	if result.Total != 218 {
+		t.Errorf("Total = %d, want 218", result.Total)
+	}
+	if len(result.Lines) != 3 || result.Lines[0].ID != "sku-1" || result.Lines[0].Tax != 198 {
+		t.Errorf("Lines = %+v, want sku-1 first with tax 198", result.Lines)
+	}
+	if len(result.Subtotals) != 1 || result.Subtotals[0] != (RateSubtotal{Rate: 100_000, Amount: 2180, Tax: 218}) {
+		t.Errorf("Subtotals = %+v, want one subtotal of 2180 with tax 218", result.Subtotals)
+	}
+
+	if _, err := NewFlatRate(-1); !errors.Is(err, ErrInvalidRate) {
+		t.Errorf("NewFlatRate(-1) error = %v, want %v", err, ErrInvalidRate)
+	}
+}
+
+func TestTable_Calculate(t *testing.T) {
+	table, err := NewTable([]TableRate{
+		{Country: "JP", Rate: 100_000},
+		{Country: "JP", Category: "food", Rate: 80_000},
+		{Country: "US", Region: "CA", Rate: 72_500},
+		{Country: "US", Region: "CA", Category: "food", Rate: 0},
+		{Country: "us", Region: "ny", Rate: 40_000},
+		{Country: "US", Category: "digital", Rate: 10_000},
+	})
+	if err != nil {
+		t.Fatalf("NewTable() error = %v", err)
+	}
+
+	tests := []struct {
+		name             string
+		addr             Address
+		category         string
+		wantRate         Rate
+		wantJurisdiction Jurisdiction
+		wantErr          error
+	}{
+		{
+			name:             "country standard rate",
+			addr:             Address{Country: "JP", Region: "東京都"},
+			wantRate:         100_000,
+			wantJurisdiction: Jurisdiction{Country: "JP", Region: "東京都"},
+		},
+		{
+			name:             "country category rate",
+			addr:             Address{Country: "JP"},
+			category:         "food",
+			wantRate:         80_000,
+			wantJurisdiction: Jurisdiction{Country: "JP"},
+		},
+		{
+			name:             "unknown category falls back to standard",
+			addr:             Address{Country: "JP"},
+			category:         "books",
+			wantRate:         100_000,
+			wantJurisdiction: Jurisdiction{Country: "JP"},
+		},
+		{
+			name:             "region rate",
+			addr:             Address{Country: "US", Region: "ca"},
+			wantRate:         72_500,
+			wantJurisdiction: Jurisdiction{Country: "US", Region: "CA"},
+		},
+		{
+			name:             "region category rate wins over region",
+			addr:             Address{Country: "US", Region: "CA"},
+			category:         "food",
+			wantRate:         0,
+			wantJurisdiction: Jurisdiction{Country: "US", Region: "CA"},
+		},
+		{
+			name:             "region wins over country category",
+			addr:             Address{Country: "US", Region: "NY"},
+			category:         "digital",
+			wantRate:         40_000,
+			wantJurisdiction: Jurisdiction{Country: "US", Region: "NY"},
+		},
+		{
+			name:             "country category without region entry",
+			addr:             Address{Country: "US", Region: "TX"},
+			category:         "digital",
+			wantRate:         10_000,
+			wantJurisdiction: Jurisdiction{Country: "US", Region: "TX"},
+		},
+		{
+			name:    "region without any rate",
+			addr:    Address{Country: "US", Region: "TX"},
+			wantErr: ErrNoTaxRate,
+		},
+		{
+			name:    "unknown country",
+			addr:    Address{Country: "DE"},
+			wantErr: ErrNoTaxRate,
+		},
+		{
+			name:    "invalid address",
+			addr:    Address{},
+			wantErr: ErrInvalidAddress,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := table.Calculate(context.Background(), TaxRequest{
+				Currency: "USD",
+				Address:  tt.addr,
+				Lines:    []LineItem{{ID: "line", Amount: 10_000, TaxCategory: tt.category}},
+			})
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Calculate() error = %v, want %v", err, tt.wantErr)
+			}
+			if err != nil {
+				return
+			}
+			if result.Jurisdiction != tt.wantJurisdiction {
+				t.Errorf("Jurisdiction = %+v, want %+v", result.Jurisdiction, tt.wantJurisdiction)
+			}
+			if got := result.Lines[0].Rate; got != tt.wantRate {
+				t.Errorf("Rate = %s, want %s", got, tt.wantRate)
+			}
+			if want := Tax(10_000, tt.wantRate); result.Total != want {
+				t.Errorf("Total = %d, want %d", result.Total, want)
+			}
+		})
+	}
+}
+
+func TestTable_CalculateRoundsPerRate(t *testing.T) {
+	table, err := NewTable([]TableRate{
+		{Country: "JP", Rate: 100_000},
+		{Country: "JP", Category: "food", Rate: 80_000},
+	})
+	if err != nil {
+		t.Fatalf("NewTable() error = %v", err)
+	}
+
+	result, err := table.Calculate(context.Background(), TaxRequest{
+		Currency: "JPY",
+		Address:  Address{Country: "JP"},
+		Lines: []LineItem{
+			{ID: "onigiri-1", Amount: 105, TaxCategory: "food"},
+			{ID: "towel", Amount: 104},
+			{ID: "onigiri-2", Amount: 105, TaxCategory: "food"},
+			{ID: "soap", Amount: 104},
+			{ID: "onigiri-3", Amount: 105, TaxCategory: "food"},
+		},
+	})
+	if err != nil {
+		t.Fatalf("Calculate() error = %v", err)
+	}
+
+	// 315 at 8% is 25.2 and 208 at 10% is 20.8; rounding each line
+	// instead would give 3 * 8 + 2 * 10 = 44.
+	wantSubtotals := []RateSubtotal{
+		{Rate: 80_000, Amount: 315, Tax: 25},
+		{Rate: 100_000, Amount: 208, Tax: 21},
+	}
+	if len(result.Subtotals) != len(wantSubtotals) {
+		t.Fatalf("Subtotals = %+v, want %+v", result.Subtotals, wantSubtotals)
+	}
+	for i, want := range wantSubtotals {
+		if result.Subtotals[i] != want {
+			t.Errorf("Subtotals[%d] = %+v, want %+v", i, result.Subtotals[i], want)
+		}
+	}
+	if result.Total != 46 {
+		t.Errorf("Total = %d, want 46", result.Total)
+	}
+
+	wantLines := []int64{9, 11, 8, 10, 8}
+	for i, want := range wantLines {
+		if got := result.Lines[i].Tax; got != want {
+			t.Errorf("Lines[%d].Tax = %d, want %d", i, got, want)
+		}
+	}
+}
+
+func TestNewTable_Invalid(t *testing.T) {
+	tests := []struct {
+		name    string
+		rates   []TableRate
+		wantErr error
+	}{
+		{
+			name:    "negative rate",
+			rates:   []TableRate{{Country: "JP", Rate: -1}},
+			wantErr: ErrInvalidRate,
+		},
+		{
+			name:    "invalid country",
+			rates:   []TableRate{{Country: "Japan", Rate: 100_000}},
+			wantErr: ErrInvalidAddress,
+		},
+		{
+			name: "duplicate entry",
+			rates: []TableRate{
+				{Country: "JP", Category: "food", Rate: 80_000},
+				{Country: "jp", Category: "food", Rate: 100_000},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewTable(tt.rates)
+			if err == nil {
+				t.Fatal("NewTable() error = nil, want error")
+			}
+			if tt.wantErr != nil && !errors.Is(err, tt.wantErr) {
+				t.Errorf("NewTable() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+// stubProvider answers with lines, or fails with err.
+type stubProvider struct {
+	lines []TaxLine
+	err   error
+}
+
+func (p *stubProvider) Name() string { return "stub" }
+
+func (p *stubProvider) QuoteTax(ctx context.Context, jurisdiction Jurisdiction, req TaxRequest) ([]TaxLine, error) {
+	return p.lines, p.err
+}
+
+func TestExternal_Calculate(t *testing.T) {
+	fallback, err := NewFlatRate(100_000)
+	if err != nil {
+		t.Fatalf("NewFlatRate() error = %v", err)
+	}
+	req := TaxRequest{
+		Currency: "USD",
+		Address:  Address{Country: "US", Region: "NY", PostalCode: "10001"},
+		Lines: []LineItem{
+			{ID: "sku-1", Amount: 10_000},
+			{ID: "sku-2", Amount: 2_000},
+		},
+	}
+	quoted := []TaxLine{
+		{ID: "sku-1", Rate: 88_750, Tax: 888},
+		{ID: "sku-2", Rate: 88_750, Tax: 178},
+	}
+
+	tests := []struct {
+		name      string
+		provider  *stubProvider
+		fallback  TaxCalculator
+		wantTotal int64
+		wantErr   bool
+	}{
+		{
+			name:      "uses the provider quote",
+			provider:  &stubProvider{lines: quoted},
+			wantTotal: 1066,
+		},
+		{
+			name:     "provider error without fallback",
+			provider: &stubProvider{err: errors.New("unavailable")},
+			wantErr:  true,
+		},
+		{
+			name:      "provider error with fallback",
+			provider:  &stubProvider{err: errors.New("unavailable")},
+			fallback:  fallback,
+			wantTotal: 1200,
+		},
+		{
+			name:     "missing line",
+			provider: &stubProvider{lines: quoted[:1]},
+			wantErr:  true,
+		},
+		{
+			name:     "lines out of order",
+			provider: &stubProvider{lines: []TaxLine{quoted[1], quoted[0]}},
+			wantErr:  true,
+		},
+		{
+			name:      "invalid quote with fallback",
+			provider:  &stubProvider{lines: []TaxLine{quoted[0], {ID: "sku-2", Rate: -1}}},
+			fallback:  fallback,
+			wantTotal: 1200,
+		},
+	}
+
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			calc := NewExternal(tt.provider, ExternalConfig{Fallback: tt.fallback}, logger)
+			result, err := calc.Calculate(context.Background(), req)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Calculate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil {
+				return
+			}
+			if result.Total != tt.wantTotal {
+				t.Errorf("Total = %d, want %d", result.Total, tt.wantTotal)
+			}
+			if len(result.Subtotals) != 1 || result.Subtotals[0].Amount != 12_000 || result.Subtotals[0].Tax != tt.wantTotal {
+				t.Errorf("Subtotals = %+v, want one subtotal of 12000 with tax %d", result.Subtotals, tt.wantTotal)
+			}
+		})
+	}
+
+	t.Run("rejects an address without a country", func(t *testing.T) {
+		calc := NewExternal(&stubProvider{lines: quoted}, ExternalConfig{Fallback: fallback}, logger)
+		if _, err := calc.Calculate(context.Background(), TaxRequest{Lines: req.Lines}); !errors.Is(err, ErrInvalidAddress) {
+			t.Errorf("Calculate() error = %v, want %v", err, ErrInvalidAddress)
+		}
+	})
+}
@@ -0,0 +1,78 @@
+package pricing
+
+import (
+	"context"
+	"fmt"
+	"strings"
+)
+
+// TableRate is an entry of a Table. An empty Region applies to the whole
+// country, and an empty Category to lines without a more specific entry.
+type TableRate struct {
+	Country  string
+	Region   string
+	Category string
+	Rate     Rate
+}
+
+type tableKey struct {
+	jurisdiction Jurisdiction
+	category     string
+}
+
+// Table looks up the rate of each line by the jurisdiction of the shipping
+// address and the line's tax category.
+type Table struct {
+	rates map[tableKey]Rate
+}
+
+// NewTable creates a table from rates. Countries and regions are matched
+// case-insensitively; each combination with a category may appear once.
+func NewTable(rates []TableRate) (*Table, error) {
+	t := &Table{rates: make(map[tableKey]Rate, len(rates))}
+	for _, r := range rates {
+		if r.Rate < 0 {
+			return nil, fmt.Errorf("%w: %s %s %q", ErrInvalidRate, r.Country, r.Region, r.Category)
+		}
+		jurisdiction, err := ResolveJurisdiction(Address{Country: r.Country, Region: r.Region})
+		if err != nil {
+			return nil, fmt.Errorf("tax table entry %q: %w", r.Country, err)
+		}
+		key := tableKey{jurisdiction: jurisdiction, category: strings.TrimSpace(r.Category)}
+		if _, ok := t.rates[key]; ok {
+			return nil, fmt.Errorf("duplicate tax table entry for %s %q", jurisdiction, key.category)
+		}
+		t.rates[key] = r.Rate
+	}
+	return t, nil
+}
+
+// Calculate resolves the jurisdiction of req.Address and taxes each line
+// at the most specific rate, trying in order: the region and category, the
+// region, the country and category, then the country. A line without any
+// matching rate fails the whole calculation with ErrNoTaxRate.
+func (t *Table) Calculate(ctx context.Context, req TaxRequest) (*TaxResult, error) {
+	jurisdiction, err := ResolveJurisdiction(req.Address)
+	if err != nil {
+		return nil, err
+	}
+	return newResult(jurisdiction, req.Lines, func(line LineItem) (Rate, error) {
+		return t.lookup(jurisdiction, strings.TrimSpace(line.TaxCategory))
+	})
+}
+
+func (t *Table) lookup(jurisdiction Jurisdiction, category string) (Rate, error) {
+	country := Jurisdiction{Country: jurisdiction.Country}
+	candidates := []tableKey{
+		{jurisdiction, category},
+		{jurisdiction, ""},
+		{country, category},
+		{country, ""},
+	}
+	for _, key := range candidates {
+		if rate, ok := t.rates[key]; ok {
+			return rate, nil
+		}
+	}
+	return 0, fmt.Errorf("%w %s", ErrNoTaxRate, jurisdiction)
+}
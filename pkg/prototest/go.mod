@@ -0,0 +1,5 @@
+module github.com/daisuke8000/example-ec-platform/pkg/prototest
+
+go 1.25
+
+require google.golang.org/protobuf v1.35.2
@@ -0,0 +1,90 @@
+// Package prototest checks conversions between domain types and protobuf
+// messages against golden files, so that a field dropped by a converter or
+// added to a message without one shows up as a test failure.
+//
+// Golden files live in the testdata directory of the calling package.
+// Run the tests with UPDATE_GOLDEN=1 to rewrite them after an intended
+// change, and review the diff.
+package prototest
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// UpdateEnv is the environment variable that rewrites golden files.
+const UpdateEnv = "UPDATE_GOLDEN"
+
+// Golden compares msg, encoded as JSON with every field present, to
+// testdata/<name>.json.
+func Golden(t testing.TB, name string, msg proto.Message) {
+	t.Helper()
+
+	got, err := Marshal(msg)
+	if err != nil {
+		t.Fatalf("marshal %s: %v", name, err)
+	}
+
+	path := filepath.Join("testdata", name+".json")
+	if os.Getenv(UpdateEnv) != "" {
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, got, 0o644); err != nil {
+			t.Fatal(err)
+		}
+		return
+	}
+
+	want, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("read golden file (run with %s=1 to create it): %v", UpdateEnv, err)
+	}
+	if !bytes.Equal(got, want) {
+		t.Errorf("%s does not match %s (run with %s=1 to update it)\ngot:\n%s\nwant:\n%s", name, path, UpdateEnv, got, want)
+	}
+}
+
+// Marshal encodes msg as indented JSON with unpopulated fields included.
+// Unlike protojson's own output, the result is stable across runs and
+// protobuf versions.
+func Marshal(msg proto.Message) ([]byte, error) {
+	raw, err := protojson.MarshalOptions{EmitUnpopulated: true, UseProtoNames: true}.Marshal(msg)
+	if err != nil {
+		return nil, err
+	}
+	var buf bytes.Buffer
+	if err := json.Indent(&buf, raw, "", "  "); err != nil {
+		return nil, err
+	}
+	buf.WriteByte('\n')
+	return buf.Bytes(), nil
+}
+
+// Populated fails the test for every field of msg that is unset, except
+// the ones named in skip. Converting a fully populated fixture and
+// checking the result catches fields the converter does not fill,
+// including ones added to the message later.
+func Populated(t testing.TB, msg proto.Message, skip ...string) {
+	t.Helper()
+
+	skipped := make(map[protoreflect.Name]bool, len(skip))
+	for _, name := range skip {
+		skipped[protoreflect.Name(name)] = true
+	}
+	m := msg.ProtoReflect()
+	fields := m.Descriptor().Fields()
+	for i := 0; i < fields.Len(); i++ {
+		fd := fields.Get(i)
+		if !skipped[fd.Name()] && !m.Has(fd) {
+			t.Errorf("%s.%s is not set", m.Descriptor().FullName(), fd.Name())
+		}
+	}
+}
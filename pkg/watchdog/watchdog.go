@@ -0,0 +1,200 @@
+// Package watchdog samples a service's heap size and goroutine count and
+// reacts when they stay above their limits: it forces a garbage collection,
+// logs diagnostics and, optionally, asks the orchestrator for a restart by
+// starting the service's graceful shutdown. Slow leaks are caught as a clean
+// restart instead of an OOM kill in the middle of requests.
+package watchdog
+
+import (
+	"bytes"
+	"context"
+	"log/slog"
+	"os"
+	"runtime/debug"
+	"runtime/metrics"
+	"runtime/pprof"
+	"strings"
+	"sync/atomic"
+	"syscall"
+	"time"
+)
+
+const (
+	heapMetric       = "/memory/classes/heap/objects:bytes"
+	goroutinesMetric = "/sched/goroutines:goroutines"
+
+	// maxStacksLogged bounds the goroutine stacks logged on a breach.
+	maxStacksLogged = 5
+)
+
+// Config sets the limits. A zero limit is not checked.
+type Config struct {
+	// Interval between samples.
+	Interval time.Duration
+
+	// MaxHeapBytes is the live heap size above which a GC is forced. It is
+	// breached if the heap is still above it after the GC.
+	MaxHeapBytes uint64
+
+	// MaxGoroutines is the goroutine count above which diagnostics are logged.
+	MaxGoroutines int
+
+	// RestartAfter is the number of consecutive breached samples after which
+	// a restart is requested. 0 never requests one.
+	RestartAfter int
+
+	// OnSample, if set, is called with every sample, e.g. to export metrics.
+	OnSample func(Sample)
+
+	// OnRestart requests the restart. Defaults to sending SIGTERM to the
+	// process, which runs the service's graceful shutdown; the orchestrator
+	// then starts a new instance.
+	OnRestart func()
+}
+
+// Sample is one reading of the watched resources.
+type Sample struct {
+	HeapBytes  uint64
+	Goroutines int
+	// ForcedGC is set when the heap exceeded MaxHeapBytes and was collected;
+	// HeapBytes is the size after the collection.
+	ForcedGC bool
+	// Breached is set when a limit is exceeded after any forced GC.
+	Breached bool
+	// RestartRequested is set on the sample that requested a restart.
+	RestartRequested bool
+}
+
+// Watchdog checks the limits on every tick of Start.
+type Watchdog struct {
+	cfg      Config
+	logger   *slog.Logger
+	last     atomic.Pointer[Sample]
+	breaches int
+	// restarting is set once a restart has been requested; it is requested
+	// only once per process.
+	restarting bool
+}
+
+func New(cfg Config, logger *slog.Logger) *Watchdog {
+	if cfg.OnRestart == nil {
+		cfg.OnRestart = terminateSelf
+	}
+	return &Watchdog{cfg: cfg, logger: logger}
+}
+
+// Enabled reports whether any limit is configured.
+func (w *Watchdog) Enabled() bool {
+	return w.cfg.MaxHeapBytes > 0 || w.cfg.MaxGoroutines > 0
+}
+
+// Last returns the most recent sample, or a zero Sample before the first.
+func (w *Watchdog) Last() Sample {
+	if s := w.last.Load(); s != nil {
+		return *s
+	}
+	return Sample{}
+}
+
+func (w *Watchdog) Start(ctx context.Context) {
+	w.logger.Info("watchdog starting",
+		"interval", w.cfg.Interval,
+		"max_heap_bytes", w.cfg.MaxHeapBytes,
+		"max_goroutines", w.cfg.MaxGoroutines,
+	)
+	ticker := time.NewTicker(w.cfg.Interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			w.logger.Info("watchdog shutting down")
+			return
+		case <-ticker.C:
+			w.check()
+		}
+	}
+}
+
+func (w *Watchdog) check() {
+	s := read()
+
+	heapBreached := false
+	if w.cfg.MaxHeapBytes > 0 && s.HeapBytes > w.cfg.MaxHeapBytes {
+		before := s.HeapBytes
+		// FreeOSMemory runs a full collection and returns freed memory to
+		// the OS, so the container's RSS drops as well.
+		debug.FreeOSMemory()
+		s = read()
+		s.ForcedGC = true
+		heapBreached = s.HeapBytes > w.cfg.MaxHeapBytes
+		w.logger.Warn("heap above limit, forced garbage collection",
+			"heap_bytes_before", before,
+			"heap_bytes_after", s.HeapBytes,
+			"max_heap_bytes", w.cfg.MaxHeapBytes,
+		)
+	}
+	goroutinesBreached := w.cfg.MaxGoroutines > 0 && s.Goroutines > w.cfg.MaxGoroutines
+	if goroutinesBreached {
+		w.logger.Warn("goroutines above limit",
+			"goroutines", s.Goroutines,
+			"max_goroutines", w.cfg.MaxGoroutines,
+			"top_stacks", topStacks(maxStacksLogged),
+		)
+	}
+
+	s.Breached = heapBreached || goroutinesBreached
+	if s.Breached {
+		w.breaches++
+	} else {
+		w.breaches = 0
+	}
+
+	if w.cfg.RestartAfter > 0 && w.breaches >= w.cfg.RestartAfter && !w.restarting {
+		w.restarting = true
+		s.RestartRequested = true
+		w.logger.Error("resource limits exceeded, requesting restart",
+			"consecutive_breaches", w.breaches,
+			"heap_bytes", s.HeapBytes,
+			"goroutines", s.Goroutines,
+		)
+		w.cfg.OnRestart()
+	}
+
+	w.last.Store(&s)
+	if w.cfg.OnSample != nil {
+		w.cfg.OnSample(s)
+	}
+}
+
+func read() Sample {
+	samples := []metrics.Sample{{Name: heapMetric}, {Name: goroutinesMetric}}
+	metrics.Read(samples)
+	return Sample{
+		HeapBytes:  samples[0].Value.Uint64(),
+		Goroutines: int(samples[1].Value.Uint64()),
+	}
+}
+
+// topStacks returns the n most common goroutine stacks with their counts.
+func topStacks(n int) string {
+	var buf bytes.Buffer
+	// debug=1 groups identical stacks, most frequent first.
+	if err := pprof.Lookup("goroutine").WriteTo(&buf, 1); err != nil {
+		return ""
+	}
+	// The first block is the profile header; stacks follow, one per block.
+	blocks := strings.Split(buf.String(), "\n\n")
+	if len(blocks) > n+1 {
+		blocks = blocks[:n+1]
+	}
+	return strings.Join(blocks, "\n\n")
+}
+
+func terminateSelf() {
+	p, err := os.FindProcess(os.Getpid())
+	if err != nil {
+		return
+	}
+	_ = p.Signal(syscall.SIGTERM)
+}
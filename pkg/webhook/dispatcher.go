@@ -0,0 +1,241 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// maxErrorLength bounds the stored error of a failed attempt.
+const maxErrorLength = 512
+
+// Publisher records events for delivery.
+type Publisher struct {
+	store  Store
+	logger *slog.Logger
+}
+
+func NewPublisher(store Store, logger *slog.Logger) *Publisher {
+	return &Publisher{store: store, logger: logger}
+}
+
+// envelope is the JSON body of a delivery.
+type envelope struct {
+	ID         uuid.UUID       `json:"id"`
+	Type       string          `json:"type"`
+	OccurredAt time.Time       `json:"occurred_at"`
+	Data       json.RawMessage `json:"data"`
+}
+
+// Publish enqueues an event of eventType with a JSON-encodable payload for
+// every subscribed endpoint. Callers publish after their change has been
+// committed.
+func (p *Publisher) Publish(ctx context.Context, eventType string, data any) error {
+	raw, err := json.Marshal(data)
+	if err != nil {
+		return fmt.Errorf("encode %s event: %w", eventType, err)
+	}
+	event := Event{ID: uuid.New(), Type: eventType, OccurredAt: time.Now().UTC()}
+	event.Payload, err = json.Marshal(envelope{ID: event.ID, Type: event.Type, OccurredAt: event.OccurredAt, Data: raw})
+	if err != nil {
+		return fmt.Errorf("encode %s event: %w", eventType, err)
+	}
+
+	n, err := p.store.Enqueue(context.WithoutCancel(ctx), event)
+	if err != nil {
+		p.logger.ErrorContext(ctx, "failed to enqueue webhook event",
+			slog.String("event_type", eventType),
+			slog.String("event_id", event.ID.String()),
+			slog.String("error", err.Error()),
+		)
+		return err
+	}
+	if n > 0 {
+		p.logger.DebugContext(ctx, "webhook event enqueued",
+			slog.String("event_type", eventType),
+			slog.String("event_id", event.ID.String()),
+			slog.Int("deliveries", n),
+		)
+	}
+	return nil
+}
+
+type DispatcherConfig struct {
+	// Interval is how often due deliveries are polled.
+	Interval time.Duration
+	// BatchSize is the maximum number of deliveries sent concurrently.
+	BatchSize int
+	// MaxAttempts is the number of attempts before a delivery is dead.
+	MaxAttempts int
+	// Timeout bounds each attempt.
+	Timeout time.Duration
+	// InitialBackoff is the delay after the first failure; it doubles per
+	// attempt up to MaxBackoff.
+	InitialBackoff time.Duration
+	MaxBackoff     time.Duration
+}
+
+// Dispatcher sends due deliveries. Several dispatchers may run against the
+// same store; each claims a disjoint batch.
+type Dispatcher struct {
+	store  Store
+	client *http.Client
+	cfg    DispatcherConfig
+	logger *slog.Logger
+}
+
+// NewDispatcher creates a dispatcher. client should not follow redirects
+// to other hosts; nil uses a client that doesn't follow redirects at all.
+func NewDispatcher(store Store, client *http.Client, cfg DispatcherConfig, logger *slog.Logger) *Dispatcher {
+	if client == nil {
+		client = &http.Client{
+			CheckRedirect: func(*http.Request, []*http.Request) error {
+				return http.ErrUseLastResponse
+			},
+		}
+	}
+	return &Dispatcher{store: store, client: client, cfg: cfg, logger: logger}
+}
+
+// Start polls for due deliveries until ctx is done. In-flight attempts are
+// finished before it returns.
+func (d *Dispatcher) Start(ctx context.Context) {
+	d.logger.Info("webhook dispatcher starting", "interval", d.cfg.Interval)
+	ticker := time.NewTicker(d.cfg.Interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			d.logger.Info("webhook dispatcher shutting down")
+			return
+		case <-ticker.C:
+			// Keep draining while full batches are claimed.
+			for d.dispatch(ctx) == d.cfg.BatchSize {
+				if ctx.Err() != nil {
+					break
+				}
+			}
+		}
+	}
+}
+
+// dispatch sends one batch and returns its size.
+func (d *Dispatcher) dispatch(ctx context.Context) int {
+	// The lease outlives the attempt so a slow receiver isn't sent the same
+	// delivery twice concurrently.
+	attempts, err := d.store.Claim(ctx, d.cfg.BatchSize, time.Now().Add(2*d.cfg.Timeout))
+	if err != nil {
+		d.logger.Error("failed to claim webhook deliveries", "error", err)
+		return 0
+	}
+
+	// Attempts are finished and recorded even if ctx is cancelled meanwhile.
+	sendCtx := context.WithoutCancel(ctx)
+	var wg sync.WaitGroup
+	for _, a := range attempts {
+		wg.Go(func() { d.deliver(sendCtx, a) })
+	}
+	wg.Wait()
+	return len(attempts)
+}
+
+func (d *Dispatcher) deliver(ctx context.Context, a *Attempt) {
+	delivery := a.Delivery
+	logger := d.logger.With(
+		slog.String("delivery_id", delivery.ID.String()),
+		slog.String("endpoint_id", delivery.EndpointID.String()),
+		slog.String("event_type", delivery.EventType),
+	)
+
+	statusCode, err := d.send(ctx, a)
+
+	now := time.Now().UTC()
+	delivery.Attempts++
+	delivery.LastStatusCode = statusCode
+	delivery.UpdatedAt = now
+	switch {
+	case err == nil:
+		delivery.Status = DeliverySucceeded
+		delivery.LastError = ""
+		delivery.DeliveredAt = &now
+	case delivery.Attempts >= d.cfg.MaxAttempts:
+		delivery.Status = DeliveryDead
+		delivery.LastError = truncate(err.Error(), maxErrorLength)
+		logger.Warn("webhook delivery dead-lettered",
+			slog.Int("attempts", delivery.Attempts),
+			slog.String("error", err.Error()),
+		)
+	default:
+		delivery.LastError = truncate(err.Error(), maxErrorLength)
+		delivery.NextAttemptAt = now.Add(d.backoff(delivery.Attempts))
+		logger.Info("webhook delivery failed, will retry",
+			slog.Int("attempts", delivery.Attempts),
+			slog.Time("next_attempt_at", delivery.NextAttemptAt),
+			slog.String("error", err.Error()),
+		)
+	}
+
+	if err := d.store.RecordAttempt(ctx, delivery); err != nil {
+		// The lease expires and the delivery is retried.
+		logger.Error("failed to record webhook delivery attempt", slog.String("error", err.Error()))
+	}
+}
+
+// send posts the delivery and returns the response status code.
+func (d *Dispatcher) send(ctx context.Context, a *Attempt) (int, error) {
+	ctx, cancel := context.WithTimeout(ctx, d.cfg.Timeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, a.URL, bytes.NewReader(a.Delivery.Payload))
+	if err != nil {
+		return 0, err
+	}
+	now := time.Now()
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(HeaderID, a.Delivery.EventID.String())
+	req.Header.Set(HeaderEvent, a.Delivery.EventType)
+	req.Header.Set(HeaderTimestamp, strconv.FormatInt(now.Unix(), 10))
+	req.Header.Set(HeaderSignature, Sign(a.Secret, now, a.Delivery.Payload))
+
+	resp, err := d.client.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+	// Drain a bounded amount so the connection can be reused.
+	io.Copy(io.Discard, io.LimitReader(resp.Body, 64<<10))
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return resp.StatusCode, fmt.Errorf("endpoint responded %s", resp.Status)
+	}
+	return resp.StatusCode, nil
+}
+
+// backoff returns the delay after the given number of failed attempts.
+func (d *Dispatcher) backoff(attempts int) time.Duration {
+	delay := d.cfg.InitialBackoff
+	for range attempts - 1 {
+		delay *= 2
+		if delay >= d.cfg.MaxBackoff {
+			return d.cfg.MaxBackoff
+		}
+	}
+	return delay
+}
+
+func truncate(s string, n int) string {
+	if len(s) <= n {
+		return s
+	}
+	return s[:n]
+}
@@ -0,0 +1,404 @@
+package webhook
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/url"
+	"slices"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	webhookv1 "github.com/daisuke8000/example-ec-platform/gen/webhook/v1"
+	"github.com/daisuke8000/example-ec-platform/gen/webhook/v1/webhookv1connect"
+	pkgmw "github.com/daisuke8000/example-ec-platform/pkg/connect/middleware"
+	"github.com/daisuke8000/example-ec-platform/pkg/listing"
+)
+
+const (
+	defaultPageSize = 20
+	maxPageSize     = 100
+
+	maxDescriptionLength = 255
+)
+
+var _ webhookv1connect.WebhookServiceHandler = (*Handler)(nil)
+
+// HandlerConfig configures the endpoints a Handler accepts.
+type HandlerConfig struct {
+	// EventTypes are the event types the service publishes. Endpoint
+	// filters may only name these.
+	EventTypes []string
+
+	// AllowHTTP accepts plain http endpoint URLs, for local development.
+	AllowHTTP bool
+}
+
+// Handler serves webhook.v1.WebhookService over a Store.
+type Handler struct {
+	webhookv1connect.UnimplementedWebhookServiceHandler
+	store      Store
+	cfg        HandlerConfig
+	pageTokens *listing.Codec
+	logger     *slog.Logger
+}
+
+func NewHandler(store Store, cfg HandlerConfig, pageTokens *listing.Codec, logger *slog.Logger) *Handler {
+	return &Handler{
+		store:      store,
+		cfg:        cfg,
+		pageTokens: pageTokens,
+		logger:     logger,
+	}
+}
+
+func (h *Handler) CreateEndpoint(
+	ctx context.Context,
+	req *connect.Request[webhookv1.CreateEndpointRequest],
+) (*connect.Response[webhookv1.CreateEndpointResponse], error) {
+	if err := h.validateURL(req.Msg.GetUrl()); err != nil {
+		return nil, err
+	}
+	if err := h.validateEventTypes(req.Msg.GetEventTypes()); err != nil {
+		return nil, err
+	}
+	if err := validateDescription(req.Msg.GetDescription()); err != nil {
+		return nil, err
+	}
+
+	secret, err := NewSecret()
+	if err != nil {
+		return nil, h.mapError(ctx, err)
+	}
+	now := time.Now().UTC()
+	endpoint := &Endpoint{
+		ID:          uuid.New(),
+		URL:         req.Msg.GetUrl(),
+		Secret:      secret,
+		EventTypes:  append([]string{}, req.Msg.GetEventTypes()...),
+		Description: req.Msg.GetDescription(),
+		Active:      true,
+		CreatedBy:   pkgmw.GetUserID(ctx),
+		CreatedAt:   now,
+		UpdatedAt:   now,
+	}
+	if err := h.store.CreateEndpoint(ctx, endpoint); err != nil {
+		return nil, h.mapError(ctx, err)
+	}
+
+	h.logger.InfoContext(ctx, "webhook endpoint created",
+		slog.String("endpoint_id", endpoint.ID.String()),
+		slog.String("created_by", endpoint.CreatedBy),
+	)
+	return connect.NewResponse(&webhookv1.CreateEndpointResponse{
+		Endpoint: endpointToProto(endpoint),
+		Secret:   secret,
+	}), nil
+}
+
+func (h *Handler) GetEndpoint(
+	ctx context.Context,
+	req *connect.Request[webhookv1.GetEndpointRequest],
+) (*connect.Response[webhookv1.GetEndpointResponse], error) {
+	id, err := parseID(req.Msg.GetId(), "endpoint")
+	if err != nil {
+		return nil, err
+	}
+
+	endpoint, err := h.store.GetEndpoint(ctx, id)
+	if err != nil {
+		return nil, h.mapError(ctx, err)
+	}
+	return connect.NewResponse(&webhookv1.GetEndpointResponse{Endpoint: endpointToProto(endpoint)}), nil
+}
+
+func (h *Handler) ListEndpoints(
+	ctx context.Context,
+	req *connect.Request[webhookv1.ListEndpointsRequest],
+) (*connect.Response[webhookv1.ListEndpointsResponse], error) {
+	pageSize := clampPageSize(req.Msg.GetPageSize())
+	query := listing.QueryKey(nil, nil, "endpoints")
+
+	after, err := h.decodeCursor(req.Msg.GetPageToken(), query)
+	if err != nil {
+		return nil, err
+	}
+
+	// Fetch one extra row to know whether another page exists.
+	endpoints, err := h.store.ListEndpoints(ctx, after, pageSize+1)
+	if err != nil {
+		return nil, h.mapError(ctx, err)
+	}
+
+	resp := &webhookv1.ListEndpointsResponse{}
+	if len(endpoints) > pageSize {
+		endpoints = endpoints[:pageSize]
+		last := endpoints[len(endpoints)-1]
+		resp.NextPageToken, err = h.pageTokens.Encode(query, Cursor{CreatedAt: last.CreatedAt, ID: last.ID})
+		if err != nil {
+			return nil, h.mapError(ctx, err)
+		}
+	}
+	for _, e := range endpoints {
+		resp.Endpoints = append(resp.Endpoints, endpointToProto(e))
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (h *Handler) UpdateEndpoint(
+	ctx context.Context,
+	req *connect.Request[webhookv1.UpdateEndpointRequest],
+) (*connect.Response[webhookv1.UpdateEndpointResponse], error) {
+	id, err := parseID(req.Msg.GetId(), "endpoint")
+	if err != nil {
+		return nil, err
+	}
+
+	update := EndpointUpdate{
+		URL:         req.Msg.Url,
+		Description: req.Msg.Description,
+		Active:      req.Msg.Active,
+	}
+	if update.URL != nil {
+		if err := h.validateURL(*update.URL); err != nil {
+			return nil, err
+		}
+	}
+	if update.Description != nil {
+		if err := validateDescription(*update.Description); err != nil {
+			return nil, err
+		}
+	}
+	if req.Msg.EventTypes != nil {
+		eventTypes := req.Msg.GetEventTypes().GetValues()
+		if err := h.validateEventTypes(eventTypes); err != nil {
+			return nil, err
+		}
+		update.EventTypes = &eventTypes
+	}
+
+	endpoint, err := h.store.UpdateEndpoint(ctx, id, update)
+	if err != nil {
+		return nil, h.mapError(ctx, err)
+	}
+	return connect.NewResponse(&webhookv1.UpdateEndpointResponse{Endpoint: endpointToProto(endpoint)}), nil
+}
+
+func (h *Handler) DeleteEndpoint(
+	ctx context.Context,
+	req *connect.Request[webhookv1.DeleteEndpointRequest],
+) (*connect.Response[webhookv1.DeleteEndpointResponse], error) {
+	id, err := parseID(req.Msg.GetId(), "endpoint")
+	if err != nil {
+		return nil, err
+	}
+
+	if err := h.store.DeleteEndpoint(ctx, id); err != nil {
+		return nil, h.mapError(ctx, err)
+	}
+	h.logger.InfoContext(ctx, "webhook endpoint deleted", slog.String("endpoint_id", id.String()))
+	return connect.NewResponse(&webhookv1.DeleteEndpointResponse{}), nil
+}
+
+func (h *Handler) RotateSecret(
+	ctx context.Context,
+	req *connect.Request[webhookv1.RotateSecretRequest],
+) (*connect.Response[webhookv1.RotateSecretResponse], error) {
+	id, err := parseID(req.Msg.GetId(), "endpoint")
+	if err != nil {
+		return nil, err
+	}
+
+	secret, err := NewSecret()
+	if err != nil {
+		return nil, h.mapError(ctx, err)
+	}
+	if err := h.store.SetSecret(ctx, id, secret); err != nil {
+		return nil, h.mapError(ctx, err)
+	}
+	h.logger.InfoContext(ctx, "webhook endpoint secret rotated", slog.String("endpoint_id", id.String()))
+	return connect.NewResponse(&webhookv1.RotateSecretResponse{Secret: secret}), nil
+}
+
+func (h *Handler) ListDeliveries(
+	ctx context.Context,
+	req *connect.Request[webhookv1.ListDeliveriesRequest],
+) (*connect.Response[webhookv1.ListDeliveriesResponse], error) {
+	pageSize := clampPageSize(req.Msg.GetPageSize())
+
+	var filter DeliveryFilter
+	if req.Msg.GetEndpointId() != "" {
+		id, err := parseID(req.Msg.GetEndpointId(), "endpoint")
+		if err != nil {
+			return nil, err
+		}
+		filter.EndpointID = &id
+	}
+	if req.Msg.GetStatus() != webhookv1.DeliveryStatus_DELIVERY_STATUS_UNSPECIFIED {
+		status, ok := statusFromProto[req.Msg.GetStatus()]
+		if !ok {
+			return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("invalid delivery status"))
+		}
+		filter.Status = status
+	}
+	query := listing.QueryKey(nil, nil, "deliveries", req.Msg.GetEndpointId(), string(filter.Status))
+
+	after, err := h.decodeCursor(req.Msg.GetPageToken(), query)
+	if err != nil {
+		return nil, err
+	}
+
+	deliveries, err := h.store.ListDeliveries(ctx, filter, after, pageSize+1)
+	if err != nil {
+		return nil, h.mapError(ctx, err)
+	}
+
+	resp := &webhookv1.ListDeliveriesResponse{}
+	if len(deliveries) > pageSize {
+		deliveries = deliveries[:pageSize]
+		last := deliveries[len(deliveries)-1]
+		resp.NextPageToken, err = h.pageTokens.Encode(query, Cursor{CreatedAt: last.CreatedAt, ID: last.ID})
+		if err != nil {
+			return nil, h.mapError(ctx, err)
+		}
+	}
+	for _, d := range deliveries {
+		resp.Deliveries = append(resp.Deliveries, deliveryToProto(d))
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (h *Handler) RedeliverDelivery(
+	ctx context.Context,
+	req *connect.Request[webhookv1.RedeliverDeliveryRequest],
+) (*connect.Response[webhookv1.RedeliverDeliveryResponse], error) {
+	id, err := parseID(req.Msg.GetId(), "delivery")
+	if err != nil {
+		return nil, err
+	}
+
+	delivery, err := h.store.Redeliver(ctx, id)
+	if err != nil {
+		return nil, h.mapError(ctx, err)
+	}
+	h.logger.InfoContext(ctx, "webhook redelivery requested",
+		slog.String("delivery_id", delivery.ID.String()),
+		slog.String("endpoint_id", delivery.EndpointID.String()),
+	)
+	return connect.NewResponse(&webhookv1.RedeliverDeliveryResponse{Delivery: deliveryToProto(delivery)}), nil
+}
+
+func (h *Handler) validateURL(raw string) error {
+	u, err := url.Parse(raw)
+	if err != nil || u.Host == "" || (u.Scheme != "https" && !(h.cfg.AllowHTTP && u.Scheme == "http")) {
+		return connect.NewError(connect.CodeInvalidArgument, errors.New("url must be an absolute https URL"))
+	}
+	if u.User != nil {
+		return connect.NewError(connect.CodeInvalidArgument, errors.New("url must not contain credentials"))
+	}
+	return nil
+}
+
+func (h *Handler) validateEventTypes(eventTypes []string) error {
+	for _, t := range eventTypes {
+		if !slices.Contains(h.cfg.EventTypes, t) {
+			return connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("unknown event type %q", t))
+		}
+	}
+	return nil
+}
+
+func validateDescription(description string) error {
+	if len(description) > maxDescriptionLength {
+		return connect.NewError(connect.CodeInvalidArgument,
+			fmt.Errorf("description must be at most %d characters", maxDescriptionLength))
+	}
+	return nil
+}
+
+func (h *Handler) decodeCursor(pageToken, query string) (*Cursor, error) {
+	if pageToken == "" {
+		return nil, nil
+	}
+	after := &Cursor{}
+	if err := h.pageTokens.Decode(pageToken, query, after); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	return after, nil
+}
+
+func clampPageSize(pageSize int32) int {
+	if pageSize <= 0 {
+		return defaultPageSize
+	}
+	return min(int(pageSize), maxPageSize)
+}
+
+func parseID(s, kind string) (uuid.UUID, error) {
+	id, err := uuid.Parse(s)
+	if err != nil {
+		return uuid.Nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid %s id", kind))
+	}
+	return id, nil
+}
+
+func (h *Handler) mapError(ctx context.Context, err error) error {
+	switch {
+	case errors.Is(err, ErrEndpointNotFound), errors.Is(err, ErrDeliveryNotFound):
+		return connect.NewError(connect.CodeNotFound, err)
+	case errors.Is(err, ErrDeliveryPending):
+		return connect.NewError(connect.CodeFailedPrecondition, err)
+	}
+	h.logger.ErrorContext(ctx, "webhook store error", slog.String("error", err.Error()))
+	return connect.NewError(connect.CodeInternal, errors.New("internal server error"))
+}
+
+var statusToProto = map[DeliveryStatus]webhookv1.DeliveryStatus{
+	DeliveryPending:   webhookv1.DeliveryStatus_DELIVERY_STATUS_PENDING,
+	DeliverySucceeded: webhookv1.DeliveryStatus_DELIVERY_STATUS_SUCCEEDED,
+	DeliveryDead:      webhookv1.DeliveryStatus_DELIVERY_STATUS_DEAD,
+}
+
+var statusFromProto = map[webhookv1.DeliveryStatus]DeliveryStatus{
+	webhookv1.DeliveryStatus_DELIVERY_STATUS_PENDING:   DeliveryPending,
+	webhookv1.DeliveryStatus_DELIVERY_STATUS_SUCCEEDED: DeliverySucceeded,
+	webhookv1.DeliveryStatus_DELIVERY_STATUS_DEAD:      DeliveryDead,
+}
+
+func endpointToProto(e *Endpoint) *webhookv1.Endpoint {
+	return &webhookv1.Endpoint{
+		Id:          e.ID.String(),
+		Url:         e.URL,
+		EventTypes:  e.EventTypes,
+		Description: e.Description,
+		Active:      e.Active,
+		CreatedBy:   e.CreatedBy,
+		CreatedAt:   timestamppb.New(e.CreatedAt),
+		UpdatedAt:   timestamppb.New(e.UpdatedAt),
+	}
+}
+
+func deliveryToProto(d *Delivery) *webhookv1.Delivery {
+	pb := &webhookv1.Delivery{
+		Id:             d.ID.String(),
+		EndpointId:     d.EndpointID.String(),
+		EventId:        d.EventID.String(),
+		EventType:      d.EventType,
+		Status:         statusToProto[d.Status],
+		Attempts:       int32(d.Attempts),
+		LastStatusCode: int32(d.LastStatusCode),
+		LastError:      d.LastError,
+		CreatedAt:      timestamppb.New(d.CreatedAt),
+	}
+	if d.Status == DeliveryPending {
+		pb.NextAttemptAt = timestamppb.New(d.NextAttemptAt)
+	}
+	if d.DeliveredAt != nil {
+		pb.DeliveredAt = timestamppb.New(*d.DeliveredAt)
+	}
+	return pb
+}
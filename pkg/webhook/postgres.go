@@ -0,0 +1,365 @@
+package webhook
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+const endpointColumns = `id, url, secret, event_types, description, active, created_by, created_at, updated_at`
+
+const deliveryColumns = `id, endpoint_id, event_id, event_type, payload, status, attempts,
+	last_status_code, last_error, next_attempt_at, created_at, updated_at, delivered_at`
+
+// PostgresStore implements Store using PostgreSQL.
+type PostgresStore struct {
+	pool       *pgxpool.Pool
+	endpoints  string
+	deliveries string
+}
+
+// NewPostgresStore creates a store over the webhook_endpoints and
+// webhook_deliveries tables of schema, e.g. "product_service". See
+// services/product/migrations for the schema.
+func NewPostgresStore(pool *pgxpool.Pool, schema string) *PostgresStore {
+	return &PostgresStore{
+		pool:       pool,
+		endpoints:  schema + ".webhook_endpoints",
+		deliveries: schema + ".webhook_deliveries",
+	}
+}
+
+// CreateEndpoint persists a new endpoint.
+func (s *PostgresStore) CreateEndpoint(ctx context.Context, e *Endpoint) error {
+	query := fmt.Sprintf(`
+		INSERT INTO %s (id, url, secret, event_types, description, active, created_by, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+	`, s.endpoints)
+
+	_, err := s.pool.Exec(ctx, query,
+		e.ID,
+		e.URL,
+		e.Secret,
+		e.EventTypes,
+		e.Description,
+		e.Active,
+		e.CreatedBy,
+		e.CreatedAt,
+		e.UpdatedAt,
+	)
+	return err
+}
+
+// GetEndpoint retrieves an endpoint.
+// Returns ErrEndpointNotFound if the endpoint doesn't exist.
+func (s *PostgresStore) GetEndpoint(ctx context.Context, id uuid.UUID) (*Endpoint, error) {
+	query := fmt.Sprintf(`SELECT %s FROM %s WHERE id = $1`, endpointColumns, s.endpoints)
+
+	e, err := scanEndpoint(s.pool.QueryRow(ctx, query, id))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, ErrEndpointNotFound
+	}
+	return e, err
+}
+
+// ListEndpoints returns endpoints newest first, using keyset pagination on
+// (created_at, id).
+func (s *PostgresStore) ListEndpoints(ctx context.Context, after *Cursor, limit int) ([]*Endpoint, error) {
+	args := []any{limit}
+	where := ""
+	if after != nil {
+		args = append(args, after.CreatedAt, after.ID)
+		where = "WHERE (created_at, id) < ($2, $3)"
+	}
+	query := fmt.Sprintf(`SELECT %s FROM %s %s ORDER BY created_at DESC, id DESC LIMIT $1`,
+		endpointColumns, s.endpoints, where)
+
+	rows, err := s.pool.Query(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var endpoints []*Endpoint
+	for rows.Next() {
+		e, err := scanEndpoint(rows)
+		if err != nil {
+			return nil, err
+		}
+		endpoints = append(endpoints, e)
+	}
+	return endpoints, rows.Err()
+}
+
+// UpdateEndpoint applies the non-nil fields of update.
+func (s *PostgresStore) UpdateEndpoint(ctx context.Context, id uuid.UUID, update EndpointUpdate) (*Endpoint, error) {
+	query := fmt.Sprintf(`
+		UPDATE %s
+		SET url = COALESCE($2, url),
+			event_types = COALESCE($3, event_types),
+			description = COALESCE($4, description),
+			active = COALESCE($5, active),
+			updated_at = NOW()
+		WHERE id = $1
+		RETURNING %s
+	`, s.endpoints, endpointColumns)
+
+	var eventTypes []string
+	if update.EventTypes != nil {
+		// A non-nil empty slice encodes as '{}' rather than NULL.
+		eventTypes = append([]string{}, *update.EventTypes...)
+	}
+	e, err := scanEndpoint(s.pool.QueryRow(ctx, query, id, update.URL, eventTypes, update.Description, update.Active))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, ErrEndpointNotFound
+	}
+	return e, err
+}
+
+// SetSecret replaces the signing secret of an endpoint.
+func (s *PostgresStore) SetSecret(ctx context.Context, id uuid.UUID, secret string) error {
+	query := fmt.Sprintf(`UPDATE %s SET secret = $2, updated_at = NOW() WHERE id = $1`, s.endpoints)
+
+	tag, err := s.pool.Exec(ctx, query, id, secret)
+	if err != nil {
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return ErrEndpointNotFound
+	}
+	return nil
+}
+
+// DeleteEndpoint removes an endpoint; its deliveries are removed by the
+// foreign key cascade.
+func (s *PostgresStore) DeleteEndpoint(ctx context.Context, id uuid.UUID) error {
+	query := fmt.Sprintf(`DELETE FROM %s WHERE id = $1`, s.endpoints)
+
+	tag, err := s.pool.Exec(ctx, query, id)
+	if err != nil {
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return ErrEndpointNotFound
+	}
+	return nil
+}
+
+// Enqueue fans event out to the subscribed active endpoints in one statement.
+func (s *PostgresStore) Enqueue(ctx context.Context, event Event) (int, error) {
+	query := fmt.Sprintf(`
+		INSERT INTO %s (id, endpoint_id, event_id, event_type, payload, status, next_attempt_at, created_at, updated_at)
+		SELECT gen_random_uuid(), id, $1, $2, $3, $4, NOW(), NOW(), NOW()
+		FROM %s
+		WHERE active AND (cardinality(event_types) = 0 OR $2 = ANY(event_types))
+	`, s.deliveries, s.endpoints)
+
+	tag, err := s.pool.Exec(ctx, query, event.ID, event.Type, []byte(event.Payload), DeliveryPending)
+	if err != nil {
+		return 0, err
+	}
+	return int(tag.RowsAffected()), nil
+}
+
+// Claim locks due deliveries with SKIP LOCKED so concurrent dispatchers
+// claim disjoint batches, oldest first.
+func (s *PostgresStore) Claim(ctx context.Context, limit int, leaseUntil time.Time) ([]*Attempt, error) {
+	query := fmt.Sprintf(`
+		UPDATE %[1]s d
+		SET next_attempt_at = $3, updated_at = NOW()
+		FROM %[2]s e
+		WHERE e.id = d.endpoint_id AND d.id IN (
+			SELECT d2.id FROM %[1]s d2
+			JOIN %[2]s e2 ON e2.id = d2.endpoint_id
+			WHERE d2.status = $1 AND d2.next_attempt_at <= NOW() AND e2.active
+			ORDER BY d2.next_attempt_at
+			LIMIT $2
+			FOR UPDATE OF d2 SKIP LOCKED
+		)
+		RETURNING %[3]s, e.url, e.secret
+	`, s.deliveries, s.endpoints, prefixColumns("d.", deliveryColumns))
+
+	rows, err := s.pool.Query(ctx, query, DeliveryPending, limit, leaseUntil)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var attempts []*Attempt
+	for rows.Next() {
+		var a Attempt
+		a.Delivery, err = scanDelivery(rows, &a.URL, &a.Secret)
+		if err != nil {
+			return nil, err
+		}
+		attempts = append(attempts, &a)
+	}
+	return attempts, rows.Err()
+}
+
+// RecordAttempt stores the outcome of an attempt.
+func (s *PostgresStore) RecordAttempt(ctx context.Context, d *Delivery) error {
+	query := fmt.Sprintf(`
+		UPDATE %s
+		SET status = $2, attempts = $3, last_status_code = $4, last_error = $5,
+			next_attempt_at = $6, updated_at = $7, delivered_at = $8
+		WHERE id = $1
+	`, s.deliveries)
+
+	_, err := s.pool.Exec(ctx, query,
+		d.ID,
+		d.Status,
+		d.Attempts,
+		d.LastStatusCode,
+		d.LastError,
+		d.NextAttemptAt,
+		d.UpdatedAt,
+		d.DeliveredAt,
+	)
+	return err
+}
+
+// GetDelivery retrieves a delivery.
+// Returns ErrDeliveryNotFound if the delivery doesn't exist.
+func (s *PostgresStore) GetDelivery(ctx context.Context, id uuid.UUID) (*Delivery, error) {
+	query := fmt.Sprintf(`SELECT %s FROM %s WHERE id = $1`, deliveryColumns, s.deliveries)
+
+	d, err := scanDelivery(s.pool.QueryRow(ctx, query, id))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, ErrDeliveryNotFound
+	}
+	return d, err
+}
+
+// ListDeliveries returns deliveries matching filter, newest first, using
+// keyset pagination on (created_at, id).
+func (s *PostgresStore) ListDeliveries(ctx context.Context, filter DeliveryFilter, after *Cursor, limit int) ([]*Delivery, error) {
+	var (
+		conditions []string
+		args       []any
+	)
+	addCondition := func(format string, values ...any) {
+		placeholders := make([]any, len(values))
+		for i, v := range values {
+			args = append(args, v)
+			placeholders[i] = len(args)
+		}
+		conditions = append(conditions, fmt.Sprintf(format, placeholders...))
+	}
+
+	if filter.EndpointID != nil {
+		addCondition("endpoint_id = $%d", *filter.EndpointID)
+	}
+	if filter.Status != "" {
+		addCondition("status = $%d", filter.Status)
+	}
+	if after != nil {
+		addCondition("(created_at, id) < ($%d, $%d)", after.CreatedAt, after.ID)
+	}
+
+	where := ""
+	if len(conditions) > 0 {
+		where = "WHERE " + strings.Join(conditions, " AND ")
+	}
+	args = append(args, limit)
+	query := fmt.Sprintf(`SELECT %s FROM %s %s ORDER BY created_at DESC, id DESC LIMIT $%d`,
+		deliveryColumns, s.deliveries, where, len(args))
+
+	rows, err := s.pool.Query(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var deliveries []*Delivery
+	for rows.Next() {
+		d, err := scanDelivery(rows)
+		if err != nil {
+			return nil, err
+		}
+		deliveries = append(deliveries, d)
+	}
+	return deliveries, rows.Err()
+}
+
+// Redeliver resets a finished delivery to pending.
+func (s *PostgresStore) Redeliver(ctx context.Context, id uuid.UUID) (*Delivery, error) {
+	query := fmt.Sprintf(`
+		UPDATE %s
+		SET status = $2, attempts = 0, next_attempt_at = NOW(), updated_at = NOW(), delivered_at = NULL
+		WHERE id = $1 AND status <> $2
+		RETURNING %s
+	`, s.deliveries, deliveryColumns)
+
+	d, err := scanDelivery(s.pool.QueryRow(ctx, query, id, DeliveryPending))
+	if errors.Is(err, pgx.ErrNoRows) {
+		// Distinguish a pending delivery from a missing one.
+		if _, getErr := s.GetDelivery(ctx, id); getErr != nil {
+			return nil, getErr
+		}
+		return nil, ErrDeliveryPending
+	}
+	return d, err
+}
+
+// prefixColumns qualifies a comma-separated column list with prefix.
+func prefixColumns(prefix, columns string) string {
+	fields := strings.Split(columns, ",")
+	for i, f := range fields {
+		fields[i] = prefix + strings.TrimSpace(f)
+	}
+	return strings.Join(fields, ", ")
+}
+
+func scanEndpoint(row pgx.Row) (*Endpoint, error) {
+	var e Endpoint
+	err := row.Scan(
+		&e.ID,
+		&e.URL,
+		&e.Secret,
+		&e.EventTypes,
+		&e.Description,
+		&e.Active,
+		&e.CreatedBy,
+		&e.CreatedAt,
+		&e.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &e, nil
+}
+
+// scanDelivery scans deliveryColumns followed by extra destinations.
+func scanDelivery(row pgx.Row, extra ...any) (*Delivery, error) {
+	var (
+		d       Delivery
+		payload []byte
+	)
+	dest := []any{
+		&d.ID,
+		&d.EndpointID,
+		&d.EventID,
+		&d.EventType,
+		&payload,
+		&d.Status,
+		&d.Attempts,
+		&d.LastStatusCode,
+		&d.LastError,
+		&d.NextAttemptAt,
+		&d.CreatedAt,
+		&d.UpdatedAt,
+		&d.DeliveredAt,
+	}
+	if err := row.Scan(append(dest, extra...)...); err != nil {
+		return nil, err
+	}
+	d.Payload = payload
+	return &d, nil
+}
@@ -0,0 +1,75 @@
+package webhook
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Headers set on every delivery.
+const (
+	// HeaderID is the event ID. Receivers should use it to drop duplicate
+	// deliveries, since a delivery may be sent more than once.
+	HeaderID        = "Webhook-Id"
+	HeaderEvent     = "Webhook-Event"
+	HeaderTimestamp = "Webhook-Timestamp"
+	// HeaderSignature is "v1=" followed by the hex HMAC-SHA256 of
+	// "<timestamp>.<body>" keyed with the endpoint secret.
+	HeaderSignature = "Webhook-Signature"
+)
+
+const secretPrefix = "whsec_"
+
+var (
+	ErrInvalidSignature = errors.New("invalid webhook signature")
+	ErrTimestampExpired = errors.New("webhook timestamp outside tolerance")
+)
+
+// NewSecret returns a random endpoint secret.
+func NewSecret() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return secretPrefix + base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+// Sign returns the HeaderSignature value of body sent at timestamp.
+func Sign(secret string, timestamp time.Time, body []byte) string {
+	return "v1=" + hex.EncodeToString(mac(secret, strconv.FormatInt(timestamp.Unix(), 10), body))
+}
+
+// Verify checks the signature headers of a received delivery. Deliveries
+// signed more than tolerance ago are rejected to limit replays.
+func Verify(secret, timestampHeader, signatureHeader string, body []byte, tolerance time.Duration) error {
+	unix, err := strconv.ParseInt(timestampHeader, 10, 64)
+	if err != nil {
+		return ErrInvalidSignature
+	}
+	if age := time.Since(time.Unix(unix, 0)); age > tolerance || age < -tolerance {
+		return ErrTimestampExpired
+	}
+
+	want := mac(secret, timestampHeader, body)
+	for _, sig := range strings.Split(signatureHeader, ",") {
+		got, err := hex.DecodeString(strings.TrimPrefix(strings.TrimSpace(sig), "v1="))
+		if err == nil && hmac.Equal(got, want) {
+			return nil
+		}
+	}
+	return ErrInvalidSignature
+}
+
+func mac(secret, timestamp string, body []byte) []byte {
+	h := hmac.New(sha256.New, []byte(secret))
+	h.Write([]byte(timestamp))
+	h.Write([]byte("."))
+	h.Write(body)
+	return h.Sum(nil)
+}
@@ -0,0 +1,133 @@
+// Package webhook delivers service events to merchant-registered HTTP
+// endpoints. Events are fanned out into per-endpoint deliveries persisted
+// in a per-service table, sent by a background Dispatcher with HMAC
+// signatures, retried with backoff and dead-lettered after a maximum number
+// of attempts. Endpoints are managed through webhook.v1.WebhookService.
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrEndpointNotFound = errors.New("webhook endpoint not found")
+	ErrDeliveryNotFound = errors.New("webhook delivery not found")
+	// ErrDeliveryPending is returned when redelivering a delivery that has
+	// not finished yet.
+	ErrDeliveryPending = errors.New("webhook delivery is still pending")
+)
+
+// Event is a change published to webhook endpoints.
+type Event struct {
+	ID         uuid.UUID
+	Type       string
+	OccurredAt time.Time
+	// Payload is the JSON body sent to endpoints.
+	Payload json.RawMessage
+}
+
+// Endpoint is a registered receiver of events.
+type Endpoint struct {
+	ID  uuid.UUID
+	URL string
+	// Secret is the key deliveries are signed with.
+	Secret string
+	// EventTypes filters the events sent to the endpoint; empty means all.
+	EventTypes  []string
+	Description string
+	Active      bool
+	CreatedBy   string
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+// EndpointUpdate holds the fields changed by UpdateEndpoint. Nil fields are
+// left unchanged.
+type EndpointUpdate struct {
+	URL         *string
+	EventTypes  *[]string
+	Description *string
+	Active      *bool
+}
+
+type DeliveryStatus string
+
+const (
+	DeliveryPending   DeliveryStatus = "pending"
+	DeliverySucceeded DeliveryStatus = "succeeded"
+	DeliveryDead      DeliveryStatus = "dead"
+)
+
+// Delivery is one event sent to one endpoint.
+type Delivery struct {
+	ID             uuid.UUID
+	EndpointID     uuid.UUID
+	EventID        uuid.UUID
+	EventType      string
+	Payload        json.RawMessage
+	Status         DeliveryStatus
+	Attempts       int
+	LastStatusCode int
+	LastError      string
+	NextAttemptAt  time.Time
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+	DeliveredAt    *time.Time
+}
+
+// Attempt is a delivery claimed by the dispatcher together with the
+// endpoint it is sent to.
+type Attempt struct {
+	Delivery *Delivery
+	URL      string
+	Secret   string
+}
+
+// DeliveryFilter narrows ListDeliveries. Empty fields match everything.
+type DeliveryFilter struct {
+	EndpointID *uuid.UUID
+	Status     DeliveryStatus
+}
+
+// Cursor is the keyset position of the last row on a page.
+type Cursor struct {
+	CreatedAt time.Time `json:"t"`
+	ID        uuid.UUID `json:"id"`
+}
+
+type Store interface {
+	CreateEndpoint(ctx context.Context, endpoint *Endpoint) error
+	// GetEndpoint returns ErrEndpointNotFound if the endpoint doesn't exist.
+	GetEndpoint(ctx context.Context, id uuid.UUID) (*Endpoint, error)
+	// ListEndpoints returns up to limit endpoints after cursor, newest first.
+	ListEndpoints(ctx context.Context, after *Cursor, limit int) ([]*Endpoint, error)
+	// UpdateEndpoint applies update and returns the updated endpoint.
+	UpdateEndpoint(ctx context.Context, id uuid.UUID, update EndpointUpdate) (*Endpoint, error)
+	// SetSecret replaces the signing secret of an endpoint.
+	SetSecret(ctx context.Context, id uuid.UUID, secret string) error
+	// DeleteEndpoint removes an endpoint and its deliveries.
+	DeleteEndpoint(ctx context.Context, id uuid.UUID) error
+
+	// Enqueue creates a pending delivery of event for every active endpoint
+	// subscribed to its type and returns how many were created.
+	Enqueue(ctx context.Context, event Event) (int, error)
+	// Claim returns up to limit due deliveries to active endpoints and
+	// postpones their next attempt to leaseUntil so that no other
+	// dispatcher claims them while they are in flight.
+	Claim(ctx context.Context, limit int, leaseUntil time.Time) ([]*Attempt, error)
+	// RecordAttempt stores the outcome of an attempt. d holds the new
+	// status, attempt count, last response and next attempt time.
+	RecordAttempt(ctx context.Context, d *Delivery) error
+	// GetDelivery returns ErrDeliveryNotFound if the delivery doesn't exist.
+	GetDelivery(ctx context.Context, id uuid.UUID) (*Delivery, error)
+	// ListDeliveries returns up to limit deliveries after cursor, newest first.
+	ListDeliveries(ctx context.Context, filter DeliveryFilter, after *Cursor, limit int) ([]*Delivery, error)
+	// Redeliver resets a finished delivery to pending with no attempts.
+	// Returns ErrDeliveryPending if it is still pending.
+	Redeliver(ctx context.Context, id uuid.UUID) (*Delivery, error)
+}
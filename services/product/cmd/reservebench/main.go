@@ -0,0 +1,369 @@
+// Command reservebench compares the optimistic and pessimistic reservation
+// paths of BatchReserveInventory under contention. It creates a throwaway
+// product with a few hot SKUs, runs the same concurrent reservation load
+// through each path and prints throughput, latency percentiles and error
+// counts, then deletes everything it created.
+//
+// Run it against a migrated development database, never production:
+//
+//	DATABASE_URL=postgres://.../ec_platform \
+//	    go run ./services/product/cmd/reservebench -concurrency 64 -skus 3
+//
+// A -stock below -requests x -items / -skus simulates a flash sale that
+// sells out part way through.
+//
+// With -soak the command instead runs an endurance test for the given
+// duration (see soak.go):
+//
+//	go run ./services/product/cmd/reservebench -soak 4h -concurrency 16
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"log/slog"
+	"math/rand/v2"
+	"os"
+	"os/signal"
+	"slices"
+	"strings"
+	"sync"
+	"syscall"
+	"text/tabwriter"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	redisAdapter "github.com/daisuke8000/example-ec-platform/services/product/internal/adapter/redis"
+	"github.com/daisuke8000/example-ec-platform/services/product/internal/adapter/repository"
+	"github.com/daisuke8000/example-ec-platform/services/product/internal/domain"
+	"github.com/daisuke8000/example-ec-platform/services/product/internal/usecase"
+)
+
+func main() {
+	logger := slog.New(slog.NewJSONHandler(os.Stderr, &slog.HandlerOptions{
+		Level: slog.LevelInfo,
+	}))
+	slog.SetDefault(logger)
+
+	if err := run(logger); err != nil {
+		logger.Error("benchmark failed", slog.String("error", err.Error()))
+		os.Exit(1)
+	}
+}
+
+type options struct {
+	skus         int
+	stock        int64
+	items        int
+	requests     int
+	concurrency  int
+	lockTimeout  time.Duration
+	retryMax     int
+	retryBackoff time.Duration
+}
+
+func run(logger *slog.Logger) error {
+	databaseURL := flag.String("database-url", os.Getenv("DATABASE_URL"), "product service database")
+	modes := flag.String("modes", "optimistic,pessimistic", "comma-separated reservation paths to run")
+	var opts options
+	flag.IntVar(&opts.skus, "skus", 3, "number of hot SKUs")
+	flag.Int64Var(&opts.stock, "stock", 1_000_000, "initial stock of each SKU")
+	flag.IntVar(&opts.items, "items", 2, "SKUs per reservation, one unit each")
+	flag.IntVar(&opts.requests, "requests", 5000, "reservations per path")
+	flag.IntVar(&opts.concurrency, "concurrency", 64, "concurrent reservations")
+	flag.DurationVar(&opts.lockTimeout, "lock-timeout", 2*time.Second, "pessimistic lock timeout (RESERVATION_LOCK_TIMEOUT)")
+	flag.IntVar(&opts.retryMax, "retry-attempts", 3, "attempts per reservation (LOCK_RETRY_MAX_ATTEMPTS)")
+	flag.DurationVar(&opts.retryBackoff, "retry-backoff", 10*time.Millisecond, "initial retry backoff (LOCK_RETRY_INITIAL_BACKOFF)")
+	var soak soakOptions
+	flag.DurationVar(&soak.duration, "soak", 0, "run the soak test for this long instead of the benchmark")
+	flag.DurationVar(&soak.checkInterval, "soak-check-interval", 30*time.Second, "interval between inventory invariant checks")
+	flag.DurationVar(&soak.ttl, "soak-ttl", 5*time.Second, "TTL of soak reservations; abandoned ones expire after it")
+	flag.Float64Var(&soak.confirmRatio, "soak-confirm-ratio", 0.4, "share of soak reservations confirmed")
+	flag.Float64Var(&soak.releaseRatio, "soak-release-ratio", 0.4, "share of soak reservations released; the rest expire")
+	flag.IntVar(&soak.expirers, "soak-expirers", 2, "concurrent reservation expirers")
+	flag.DurationVar(&soak.expireInterval, "soak-expire-interval", time.Second, "reservation expirer interval (TTL_WORKER_INTERVAL)")
+	flag.Parse()
+
+	switch {
+	case *databaseURL == "":
+		return errors.New("DATABASE_URL or -database-url is required")
+	case opts.skus < 1 || opts.items < 1 || opts.items > opts.skus:
+		return errors.New("-skus must be at least 1 and -items between 1 and -skus")
+	case opts.requests < 1 || opts.concurrency < 1:
+		return errors.New("-requests and -concurrency must be at least 1")
+	}
+	if soak.duration > 0 {
+		if err := soak.validate(); err != nil {
+			return err
+		}
+	}
+
+	var lockings []usecase.ReserveLocking
+	for _, m := range strings.Split(*modes, ",") {
+		l, err := usecase.ParseReserveLocking(strings.TrimSpace(m))
+		if err != nil {
+			return err
+		}
+		lockings = append(lockings, l)
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	poolCfg, err := pgxpool.ParseConfig(*databaseURL)
+	if err != nil {
+		return fmt.Errorf("failed to parse database URL: %w", err)
+	}
+	// One connection per worker and expirer, so the pool does not become
+	// the bottleneck.
+	poolCfg.MaxConns = int32(opts.concurrency + soak.expirers + 2)
+	pool, err := pgxpool.NewWithConfig(ctx, poolCfg)
+	if err != nil {
+		return fmt.Errorf("failed to connect to database: %w", err)
+	}
+	defer pool.Close()
+
+	f, err := createFixture(ctx, pool, opts.skus, opts.stock)
+	if err != nil {
+		return fmt.Errorf("create fixture: %w", err)
+	}
+	// Clean up even if the run was interrupted.
+	defer func() {
+		if err := f.drop(context.WithoutCancel(ctx), pool); err != nil {
+			logger.Error("failed to drop fixture", slog.String("product_id", f.productID.String()), slog.String("error", err.Error()))
+		}
+	}()
+	logger.Info("fixture created", slog.String("product_id", f.productID.String()), slog.Int("skus", opts.skus))
+
+	if soak.duration > 0 {
+		report, err := runSoak(ctx, pool, f, lockings, opts, soak, logger)
+		if err != nil {
+			return err
+		}
+		printSoakReport(os.Stdout, opts, soak, report)
+		if report.violations > 0 {
+			return fmt.Errorf("%d inventory invariant violations", report.violations)
+		}
+		return nil
+	}
+
+	var results []result
+	for _, locking := range lockings {
+		if err := f.reset(ctx, pool, opts.stock); err != nil {
+			return fmt.Errorf("reset fixture: %w", err)
+		}
+		logger.Info("running", slog.String("locking", locking.String()), slog.Int("requests", opts.requests))
+		r, err := bench(ctx, pool, f, locking, opts)
+		if err != nil {
+			return err
+		}
+		if r.firstErr != nil {
+			logger.Warn("reservations failed", slog.String("locking", locking.String()), slog.Int("count", r.failed), slog.String("first_error", r.firstErr.Error()))
+		}
+		results = append(results, r)
+	}
+
+	printResults(os.Stdout, opts, results)
+	return nil
+}
+
+// fixture is the throwaway product and SKUs reserved by the benchmark.
+// Every reservation of its SKUs belongs to the benchmark.
+type fixture struct {
+	productID uuid.UUID
+	skuIDs    []uuid.UUID
+}
+
+func createFixture(ctx context.Context, pool *pgxpool.Pool, skus int, stock int64) (*fixture, error) {
+	f := &fixture{productID: uuid.New()}
+	tag := f.productID.String()[:8]
+
+	tx, err := pool.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	if _, err := tx.Exec(ctx,
+		`INSERT INTO product_service.products (id, name, status) VALUES ($1, $2, 1)`,
+		f.productID, "reservebench "+tag,
+	); err != nil {
+		return nil, err
+	}
+	for i := range skus {
+		skuID := uuid.New()
+		if _, err := tx.Exec(ctx,
+			`INSERT INTO product_service.skus (id, product_id, sku_code, price_amount) VALUES ($1, $2, $3, 0)`,
+			skuID, f.productID, fmt.Sprintf("RESERVEBENCH-%s-%d", tag, i),
+		); err != nil {
+			return nil, err
+		}
+		if _, err := tx.Exec(ctx,
+			`INSERT INTO product_service.inventory (sku_id, quantity) VALUES ($1, $2)`,
+			skuID, stock,
+		); err != nil {
+			return nil, err
+		}
+		f.skuIDs = append(f.skuIDs, skuID)
+	}
+	return f, tx.Commit(ctx)
+}
+
+// reset deletes the reservations of the previous run and restores stock.
+func (f *fixture) reset(ctx context.Context, pool *pgxpool.Pool, stock int64) error {
+	if err := f.deleteReservations(ctx, pool); err != nil {
+		return err
+	}
+	_, err := pool.Exec(ctx,
+		`UPDATE product_service.inventory SET quantity = $2, reserved = 0, version = version + 1 WHERE sku_id = ANY($1)`,
+		f.skuIDs, stock,
+	)
+	return err
+}
+
+func (f *fixture) drop(ctx context.Context, pool *pgxpool.Pool) error {
+	if err := f.deleteReservations(ctx, pool); err != nil {
+		return err
+	}
+	if _, err := pool.Exec(ctx, `DELETE FROM product_service.inventory_movements WHERE sku_id = ANY($1)`, f.skuIDs); err != nil {
+		return err
+	}
+	// SKUs and inventory are deleted by cascade.
+	_, err := pool.Exec(ctx, `DELETE FROM product_service.products WHERE id = $1`, f.productID)
+	return err
+}
+
+func (f *fixture) deleteReservations(ctx context.Context, pool *pgxpool.Pool) error {
+	_, err := pool.Exec(ctx, `
+		DELETE FROM product_service.reservations r
+		USING unnest($1::uuid[]) AS s(sku_id)
+		WHERE r.items @> jsonb_build_array(jsonb_build_object('SKUID', s.sku_id))
+	`, f.skuIDs)
+	return err
+}
+
+type result struct {
+	locking      usecase.ReserveLocking
+	elapsed      time.Duration
+	latencies    []time.Duration // Successful reservations only
+	reserved     int
+	insufficient int
+	conflicts    int
+	lockTimeouts int
+	failed       int
+	firstErr     error
+}
+
+// newInventoryUseCase builds the use case as the server does, without
+// Redis: idempotency, caching and events are no-ops.
+func newInventoryUseCase(pool *pgxpool.Pool, locking usecase.ReserveLocking, ttl time.Duration, opts options) usecase.InventoryUseCase {
+	return usecase.NewInventoryUseCase(
+		repository.NewPostgresInventoryRepository(pool),
+		repository.NewPostgresReservationRepository(pool),
+		redisAdapter.NewNoopIdempotencyStore(),
+		redisAdapter.NewNoopInventoryCache(),
+		repository.NewTxManager(pool),
+		usecase.NewNoopEventPublisher(),
+		usecase.LockRetryPolicy{
+			MaxAttempts:    opts.retryMax,
+			InitialBackoff: opts.retryBackoff,
+			MaxBackoff:     20 * opts.retryBackoff,
+		},
+		usecase.ReserveLockingPolicy{
+			Default:     locking,
+			LockTimeout: opts.lockTimeout,
+		},
+		usecase.ReservationTTLPolicy{Default: ttl, Min: ttl, Max: ttl},
+		opts.items,
+		time.Hour,
+	)
+}
+
+func bench(ctx context.Context, pool *pgxpool.Pool, f *fixture, locking usecase.ReserveLocking, opts options) (result, error) {
+	inventoryUC := newInventoryUseCase(pool, locking, time.Hour, opts)
+
+	r := result{locking: locking}
+	var mu sync.Mutex
+	jobs := make(chan struct{})
+	var wg sync.WaitGroup
+	start := time.Now()
+	for range opts.concurrency {
+		wg.Go(func() {
+			for range jobs {
+				items := make([]usecase.ReserveItem, 0, opts.items)
+				for _, i := range rand.Perm(len(f.skuIDs))[:opts.items] {
+					items = append(items, usecase.ReserveItem{SKUID: f.skuIDs[i], Quantity: 1})
+				}
+
+				begin := time.Now()
+				_, err := inventoryUC.BatchReserveInventory(ctx, usecase.BatchReserveInput{
+					Items: items,
+					Actor: "system:reservebench",
+				})
+				latency := time.Since(begin)
+
+				mu.Lock()
+				switch {
+				case err == nil:
+					r.reserved++
+					r.latencies = append(r.latencies, latency)
+				case errors.Is(err, domain.ErrInsufficientStock):
+					r.insufficient++
+				case errors.Is(err, domain.ErrOptimisticLockConflict):
+					r.conflicts++
+				case errors.Is(err, domain.ErrInventoryLockTimeout):
+					r.lockTimeouts++
+				default:
+					r.failed++
+					if r.firstErr == nil {
+						r.firstErr = err
+					}
+				}
+				mu.Unlock()
+			}
+		})
+	}
+
+	for range opts.requests {
+		select {
+		case jobs <- struct{}{}:
+		case <-ctx.Done():
+		}
+	}
+	close(jobs)
+	wg.Wait()
+	r.elapsed = time.Since(start)
+	return r, ctx.Err()
+}
+
+func printResults(out io.Writer, opts options, results []result) {
+	fmt.Fprintf(out, "%d reservations of %d of %d SKUs, %d concurrent\n\n", opts.requests, opts.items, opts.skus, opts.concurrency)
+	w := tabwriter.NewWriter(out, 0, 0, 2, ' ', tabwriter.AlignRight)
+	fmt.Fprintln(w, "locking\treserved/s\tp50\tp95\tp99\treserved\tinsufficient\tconflicts\tlock timeouts\tother errors\t")
+	for _, r := range results {
+		slices.Sort(r.latencies)
+		fmt.Fprintf(w, "%s\t%.0f\t%s\t%s\t%s\t%d\t%d\t%d\t%d\t%d\t\n",
+			r.locking,
+			float64(r.reserved)/r.elapsed.Seconds(),
+			percentile(r.latencies, 0.50),
+			percentile(r.latencies, 0.95),
+			percentile(r.latencies, 0.99),
+			r.reserved, r.insufficient, r.conflicts, r.lockTimeouts, r.failed,
+		)
+	}
+	w.Flush()
+}
+
+// percentile returns the p-th percentile of sorted latencies.
+func percentile(sorted []time.Duration, p float64) time.Duration {
+	if len(sorted) == 0 {
+		return 0
+	}
+	i := int(float64(len(sorted)-1) * p)
+	return sorted[i].Round(10 * time.Microsecond)
+}
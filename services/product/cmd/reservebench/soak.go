@@ -0,0 +1,465 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"math/rand/v2"
+	"os"
+	"sync"
+	"text/tabwriter"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	redisAdapter "github.com/daisuke8000/example-ec-platform/services/product/internal/adapter/redis"
+	"github.com/daisuke8000/example-ec-platform/services/product/internal/adapter/repository"
+	"github.com/daisuke8000/example-ec-platform/services/product/internal/domain"
+	"github.com/daisuke8000/example-ec-platform/services/product/internal/usecase"
+	"github.com/daisuke8000/example-ec-platform/services/product/internal/worker"
+)
+
+// The soak test drives reserve, confirm, release and expire cycles against
+// the fixture for hours and checks the inventory invariants while it runs:
+//
+//   - quantity and reserved equal the initial stock plus the sums of the
+//     SKU's inventory movements (the ledger has no gaps)
+//   - reserved equals the quantity of the SKU in pending reservations
+//   - 0 <= reserved <= quantity
+//   - no reservation has more than one confirm, release, expire or
+//     force_release movement per SKU
+//
+// Each check reads one REPEATABLE READ snapshot, so in-flight transactions
+// cannot show up as drift. Reservations past their TTL that are still
+// pending are reported as expirer lag, not as violations.
+//
+// The expirers started by the soak test expire any expired reservation in
+// the database, not just the fixture's: stop the product service's expirer
+// or expect it to compete, which is part of what the test exercises.
+
+const soakActor = "system:reservebench-soak"
+
+type soakOptions struct {
+	duration       time.Duration
+	checkInterval  time.Duration
+	ttl            time.Duration
+	confirmRatio   float64
+	releaseRatio   float64
+	expirers       int
+	expireInterval time.Duration
+}
+
+func (o soakOptions) validate() error {
+	switch {
+	case o.checkInterval <= 0 || o.ttl <= 0 || o.expireInterval <= 0:
+		return errors.New("-soak-check-interval, -soak-ttl and -soak-expire-interval must be positive")
+	case o.confirmRatio < 0 || o.releaseRatio < 0 || o.confirmRatio+o.releaseRatio > 1:
+		return errors.New("-soak-confirm-ratio and -soak-release-ratio must be non-negative and add up to at most 1")
+	case o.expirers < 1:
+		return errors.New("-soak-expirers must be at least 1")
+	}
+	return nil
+}
+
+// soakReport accumulates the outcome of a soak run.
+type soakReport struct {
+	mu           sync.Mutex
+	elapsed      time.Duration
+	reserved     int
+	confirmed    int
+	released     int
+	abandoned    int
+	lostToExpiry int // Confirms and releases that found the reservation expired
+	insufficient int
+	conflicts    int
+	lockTimeouts int
+	restocks     int
+	failed       int
+	firstErr     error
+
+	checks     int
+	violations int
+	maxDrift   int64
+	maxLag     time.Duration
+}
+
+func (r *soakReport) record(fn func(r *soakReport)) {
+	r.mu.Lock()
+	fn(r)
+	r.mu.Unlock()
+}
+
+func (r *soakReport) fail(err error) {
+	r.record(func(r *soakReport) {
+		r.failed++
+		if r.firstErr == nil {
+			r.firstErr = err
+		}
+	})
+}
+
+func runSoak(ctx context.Context, pool *pgxpool.Pool, f *fixture, lockings []usecase.ReserveLocking, opts options, soak soakOptions, logger *slog.Logger) (*soakReport, error) {
+	inventoryUC := newInventoryUseCase(pool, usecase.ReserveLockingOptimistic, soak.ttl, opts)
+	report := &soakReport{}
+
+	// The expirers log every expired reservation at info; keep warnings only.
+	expirerLogger := slog.New(slog.NewJSONHandler(os.Stderr, &slog.HandlerOptions{Level: slog.LevelWarn}))
+	expirerCtx, stopExpirers := context.WithCancel(ctx)
+	defer stopExpirers()
+	var expirers sync.WaitGroup
+	for range soak.expirers {
+		expirer := worker.NewReservationExpirer(
+			repository.NewTxManager(pool),
+			repository.NewPostgresReservationRepository(pool),
+			repository.NewPostgresInventoryRepository(pool),
+			redisAdapter.NewNoopInventoryCache(),
+			nil,
+			expirerLogger,
+			soak.expireInterval,
+			100,
+		)
+		expirers.Go(func() { expirer.Start(expirerCtx) })
+	}
+
+	loadCtx, stopLoad := context.WithTimeout(ctx, soak.duration)
+	defer stopLoad()
+	var load sync.WaitGroup
+	for range opts.concurrency {
+		load.Go(func() { soakWorker(loadCtx, inventoryUC, f, lockings, opts, soak, report) })
+	}
+	load.Go(func() { restock(loadCtx, inventoryUC, f, opts, soak, report) })
+
+	logger.Info("soak test running",
+		slog.Duration("duration", soak.duration),
+		slog.Duration("ttl", soak.ttl),
+		slog.Int("expirers", soak.expirers),
+	)
+	start := time.Now()
+	ticker := time.NewTicker(soak.checkInterval)
+	defer ticker.Stop()
+	for loadCtx.Err() == nil {
+		select {
+		case <-loadCtx.Done():
+		case <-ticker.C:
+			if err := checkInvariants(loadCtx, pool, f, opts, soak, report, logger, false); err != nil && loadCtx.Err() == nil {
+				return nil, fmt.Errorf("check invariants: %w", err)
+			}
+			report.record(func(r *soakReport) {
+				logger.Info("soak progress",
+					slog.Duration("elapsed", time.Since(start).Round(time.Second)),
+					slog.Int("reserved", r.reserved),
+					slog.Int("confirmed", r.confirmed),
+					slog.Int("released", r.released),
+					slog.Int("abandoned", r.abandoned),
+					slog.Int("violations", r.violations),
+					slog.Int64("max_drift", r.maxDrift),
+				)
+			})
+		}
+	}
+	load.Wait()
+	report.elapsed = time.Since(start)
+
+	// Let the expirers catch up with the abandoned reservations, then
+	// every reservation must have reached a terminal state.
+	final := ctx.Err() == nil
+	if final {
+		waitForExpiry(ctx, pool, f, soak.ttl+10*soak.expireInterval)
+	}
+	stopExpirers()
+	expirers.Wait()
+
+	if err := checkInvariants(context.WithoutCancel(ctx), pool, f, opts, soak, report, logger, final); err != nil {
+		return nil, fmt.Errorf("final invariant check: %w", err)
+	}
+	return report, nil
+}
+
+// soakWorker reserves random SKUs and confirms, releases or abandons each
+// reservation by the configured ratios, until ctx is done.
+func soakWorker(ctx context.Context, inventoryUC usecase.InventoryUseCase, f *fixture, lockings []usecase.ReserveLocking, opts options, soak soakOptions, report *soakReport) {
+	for ctx.Err() == nil {
+		items := make([]usecase.ReserveItem, 0, opts.items)
+		for _, i := range rand.Perm(len(f.skuIDs))[:opts.items] {
+			items = append(items, usecase.ReserveItem{SKUID: f.skuIDs[i], Quantity: rand.Int64N(3) + 1})
+		}
+
+		reservation, err := inventoryUC.BatchReserveInventory(ctx, usecase.BatchReserveInput{
+			Items:   items,
+			TTL:     soak.ttl,
+			Actor:   soakActor,
+			Locking: lockings[rand.IntN(len(lockings))],
+		})
+		switch {
+		case err == nil:
+			report.record(func(r *soakReport) { r.reserved++ })
+		case ctx.Err() != nil:
+			return
+		case errors.Is(err, domain.ErrInsufficientStock):
+			report.record(func(r *soakReport) { r.insufficient++ })
+			continue
+		case errors.Is(err, domain.ErrOptimisticLockConflict):
+			report.record(func(r *soakReport) { r.conflicts++ })
+			continue
+		case errors.Is(err, domain.ErrInventoryLockTimeout):
+			report.record(func(r *soakReport) { r.lockTimeouts++ })
+			continue
+		default:
+			report.fail(err)
+			continue
+		}
+
+		// Hold the reservation for a while, sometimes past its TTL, so
+		// confirms and releases race the expirers.
+		hold := time.Duration(rand.Int64N(int64(soak.ttl) * 5 / 4))
+		select {
+		case <-time.After(hold):
+		case <-ctx.Done():
+			return
+		}
+
+		var outcome *int
+		p := rand.Float64()
+		switch {
+		case p < soak.confirmRatio:
+			err = inventoryUC.ConfirmReservation(ctx, reservation.ID, "", soakActor)
+			outcome = &report.confirmed
+		case p < soak.confirmRatio+soak.releaseRatio:
+			err = inventoryUC.ReleaseReservation(ctx, reservation.ID, "", soakActor)
+			outcome = &report.released
+		default:
+			report.record(func(r *soakReport) { r.abandoned++ })
+			continue
+		}
+		switch {
+		case err == nil:
+			report.record(func(*soakReport) { *outcome++ })
+		case ctx.Err() != nil:
+			return
+		case errors.Is(err, domain.ErrReservationExpired), errors.Is(err, domain.ErrReservationNotPending):
+			report.record(func(r *soakReport) { r.lostToExpiry++ })
+		default:
+			report.fail(err)
+		}
+	}
+}
+
+// restock tops up SKUs whose available stock fell below a tenth of the
+// initial stock, so the test does not settle into selling out.
+func restock(ctx context.Context, inventoryUC usecase.InventoryUseCase, f *fixture, opts options, soak soakOptions, report *soakReport) {
+	ticker := time.NewTicker(soak.expireInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+		for _, skuID := range f.skuIDs {
+			inv, err := inventoryUC.GetInventory(ctx, skuID)
+			if err != nil {
+				if ctx.Err() == nil {
+					report.fail(err)
+				}
+				continue
+			}
+			if inv.Available() >= opts.stock/10 {
+				continue
+			}
+			// Reservations taken in between may raise reserved above the
+			// new quantity; the update is then refused and retried next tick.
+			err = inventoryUC.UpdateInventory(ctx, skuID, inv.Quantity+opts.stock-inv.Available(), soakActor)
+			switch {
+			case err == nil:
+				report.record(func(r *soakReport) { r.restocks++ })
+			case ctx.Err() != nil, errors.Is(err, domain.ErrInsufficientStock), errors.Is(err, domain.ErrOptimisticLockConflict):
+			default:
+				report.fail(err)
+			}
+		}
+	}
+}
+
+// waitForExpiry waits until the fixture has no pending reservations left,
+// or until timeout.
+func waitForExpiry(ctx context.Context, pool *pgxpool.Pool, f *fixture, timeout time.Duration) {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+	for {
+		var pending int
+		err := pool.QueryRow(ctx, `
+			SELECT COUNT(*) FROM product_service.reservations r
+			WHERE r.status = 0 AND EXISTS (
+				SELECT 1 FROM unnest($1::uuid[]) AS s(sku_id)
+				WHERE r.items @> jsonb_build_array(jsonb_build_object('SKUID', s.sku_id))
+			)
+		`, f.skuIDs).Scan(&pending)
+		if err != nil || pending == 0 {
+			return
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(500 * time.Millisecond):
+		}
+	}
+}
+
+// skuState is one SKU's inventory row next to what the ledger and the
+// pending reservations say it should be.
+type skuState struct {
+	skuID         uuid.UUID
+	quantity      int64
+	reserved      int64
+	quantityDelta int64 // Sum of quantity_delta over its movements
+	reservedDelta int64 // Sum of reserved_delta over its movements
+	pending       int64 // Quantity in pending reservations
+}
+
+// checkInvariants checks the fixture in one snapshot, logs and counts
+// every violation. A final check also requires that no reservation is
+// still pending.
+func checkInvariants(ctx context.Context, pool *pgxpool.Pool, f *fixture, opts options, soak soakOptions, report *soakReport, logger *slog.Logger, final bool) error {
+	tx, err := pool.BeginTx(ctx, pgx.TxOptions{IsoLevel: pgx.RepeatableRead, AccessMode: pgx.ReadOnly})
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	rows, err := tx.Query(ctx, `
+		SELECT i.sku_id, i.quantity, i.reserved,
+			COALESCE(m.quantity_delta, 0), COALESCE(m.reserved_delta, 0), COALESCE(p.quantity, 0)
+		FROM product_service.inventory i
+		LEFT JOIN LATERAL (
+			SELECT SUM(quantity_delta) AS quantity_delta, SUM(reserved_delta) AS reserved_delta
+			FROM product_service.inventory_movements
+			WHERE sku_id = i.sku_id
+		) m ON TRUE
+		LEFT JOIN LATERAL (
+			SELECT SUM((item->>'Quantity')::bigint) AS quantity
+			FROM product_service.reservations r, jsonb_array_elements(r.items) AS item
+			WHERE r.status = 0
+				AND r.items @> jsonb_build_array(jsonb_build_object('SKUID', i.sku_id))
+				AND (item->>'SKUID')::uuid = i.sku_id
+		) p ON TRUE
+		WHERE i.sku_id = ANY($1)
+		ORDER BY i.sku_id
+	`, f.skuIDs)
+	if err != nil {
+		return err
+	}
+	states, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (skuState, error) {
+		var s skuState
+		err := row.Scan(&s.skuID, &s.quantity, &s.reserved, &s.quantityDelta, &s.reservedDelta, &s.pending)
+		return s, err
+	})
+	if err != nil {
+		return err
+	}
+
+	var violations int
+	var maxDrift int64
+	violation := func(msg string, attrs ...any) {
+		violations++
+		logger.Error("inventory invariant violated: "+msg, attrs...)
+	}
+	drift := func(d int64) int64 {
+		d = max(d, -d)
+		maxDrift = max(maxDrift, d)
+		return d
+	}
+	for _, s := range states {
+		sku := slog.String("sku_id", s.skuID.String())
+		if d := drift(s.quantity - (opts.stock + s.quantityDelta)); d != 0 {
+			violation("quantity does not match the movement ledger", sku, slog.Int64("quantity", s.quantity), slog.Int64("ledger", opts.stock+s.quantityDelta))
+		}
+		if d := drift(s.reserved - s.reservedDelta); d != 0 {
+			violation("reserved does not match the movement ledger", sku, slog.Int64("reserved", s.reserved), slog.Int64("ledger", s.reservedDelta))
+		}
+		if d := drift(s.reserved - s.pending); d != 0 {
+			violation("reserved does not match pending reservations", sku, slog.Int64("reserved", s.reserved), slog.Int64("pending", s.pending))
+		}
+		if s.reserved < 0 || s.reserved > s.quantity {
+			violation("reserved out of range", sku, slog.Int64("quantity", s.quantity), slog.Int64("reserved", s.reserved))
+		}
+	}
+
+	rows, err = tx.Query(ctx, `
+		SELECT reservation_id, sku_id, COUNT(*)
+		FROM product_service.inventory_movements
+		WHERE sku_id = ANY($1)
+			AND reason IN ('confirm', 'release', 'expire', 'force_release')
+		GROUP BY reservation_id, sku_id
+		HAVING COUNT(*) > 1
+		LIMIT 10
+	`, f.skuIDs)
+	if err != nil {
+		return err
+	}
+	for rows.Next() {
+		var reservationID, skuID uuid.UUID
+		var count int
+		if err := rows.Scan(&reservationID, &skuID, &count); err != nil {
+			rows.Close()
+			return err
+		}
+		violation("reservation settled more than once",
+			slog.String("reservation_id", reservationID.String()),
+			slog.String("sku_id", skuID.String()),
+			slog.Int("terminal_movements", count),
+		)
+	}
+	if err := rows.Err(); err != nil {
+		return err
+	}
+
+	var pending, overdue int
+	var lagSeconds float64
+	if err := tx.QueryRow(ctx, `
+		SELECT COUNT(*),
+			COUNT(*) FILTER (WHERE r.expires_at < NOW()),
+			COALESCE(EXTRACT(EPOCH FROM MAX(NOW() - r.expires_at)), 0)::float8
+		FROM product_service.reservations r
+		WHERE r.status = 0 AND EXISTS (
+			SELECT 1 FROM unnest($1::uuid[]) AS s(sku_id)
+			WHERE r.items @> jsonb_build_array(jsonb_build_object('SKUID', s.sku_id))
+		)
+	`, f.skuIDs).Scan(&pending, &overdue, &lagSeconds); err != nil {
+		return err
+	}
+	lag := time.Duration(max(lagSeconds, 0) * float64(time.Second))
+	if overdue > 0 && lag > 2*soak.expireInterval {
+		logger.Warn("expirer lagging", slog.Int("overdue", overdue), slog.Duration("max_lag", lag.Round(time.Millisecond)))
+	}
+	if final && pending > 0 {
+		violation("reservations still pending after the expirers caught up", slog.Int("pending", pending))
+	}
+
+	report.record(func(r *soakReport) {
+		r.checks++
+		r.violations += violations
+		r.maxDrift = max(r.maxDrift, maxDrift)
+		r.maxLag = max(r.maxLag, lag)
+	})
+	return nil
+}
+
+func printSoakReport(out io.Writer, opts options, soak soakOptions, r *soakReport) {
+	fmt.Fprintf(out, "soak of %s, %d concurrent on %d SKUs, %d expirers, TTL %s\n\n",
+		r.elapsed.Round(time.Second), opts.concurrency, opts.skus, soak.expirers, soak.ttl)
+	w := tabwriter.NewWriter(out, 0, 0, 2, ' ', tabwriter.AlignRight)
+	fmt.Fprintln(w, "reserved\tconfirmed\treleased\tabandoned\tlost to expiry\tinsufficient\tconflicts\tlock timeouts\trestocks\tother errors\t")
+	fmt.Fprintf(w, "%d\t%d\t%d\t%d\t%d\t%d\t%d\t%d\t%d\t%d\t\n",
+		r.reserved, r.confirmed, r.released, r.abandoned, r.lostToExpiry,
+		r.insufficient, r.conflicts, r.lockTimeouts, r.restocks, r.failed)
+	w.Flush()
+	fmt.Fprintf(out, "\n%d checks, %d violations, max drift %d, max expirer lag %s\n",
+		r.checks, r.violations, r.maxDrift, r.maxLag.Round(time.Millisecond))
+	if r.firstErr != nil {
+		fmt.Fprintf(out, "first error: %v\n", r.firstErr)
+	}
+}
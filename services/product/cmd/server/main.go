@@ -13,34 +13,53 @@ import (
 	"time"
 
 	"connectrpc.com/connect"
+	"connectrpc.com/grpcreflect"
 	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/redis/go-redis/v9"
 	"golang.org/x/net/http2"
 	"golang.org/x/net/http2/h2c"
 
+	"github.com/daisuke8000/example-ec-platform/gen/audit/v1/auditv1connect"
+	"github.com/daisuke8000/example-ec-platform/gen/backup/v1/backupv1connect"
+	"github.com/daisuke8000/example-ec-platform/gen/operations/v1/operationsv1connect"
 	"github.com/daisuke8000/example-ec-platform/gen/product/v1/productv1connect"
+	"github.com/daisuke8000/example-ec-platform/gen/webhook/v1/webhookv1connect"
+	"github.com/daisuke8000/example-ec-platform/pkg/audit"
+	"github.com/daisuke8000/example-ec-platform/pkg/backup"
 	pkgmiddleware "github.com/daisuke8000/example-ec-platform/pkg/connect/middleware"
+	"github.com/daisuke8000/example-ec-platform/pkg/listing"
+	"github.com/daisuke8000/example-ec-platform/pkg/objectstore"
+	"github.com/daisuke8000/example-ec-platform/pkg/observability"
+	"github.com/daisuke8000/example-ec-platform/pkg/operations"
+	"github.com/daisuke8000/example-ec-platform/pkg/watchdog"
+	"github.com/daisuke8000/example-ec-platform/pkg/webhook"
 	connectHandler "github.com/daisuke8000/example-ec-platform/services/product/internal/adapter/connect"
 	redisAdapter "github.com/daisuke8000/example-ec-platform/services/product/internal/adapter/redis"
 	"github.com/daisuke8000/example-ec-platform/services/product/internal/adapter/repository"
+	"github.com/daisuke8000/example-ec-platform/services/product/internal/adapter/storage"
 	"github.com/daisuke8000/example-ec-platform/services/product/internal/config"
+	"github.com/daisuke8000/example-ec-platform/services/product/internal/domain"
 	"github.com/daisuke8000/example-ec-platform/services/product/internal/usecase"
 	"github.com/daisuke8000/example-ec-platform/services/product/internal/worker"
 )
 
 func main() {
-	logger := slog.New(slog.NewJSONHandler(os.Stdout, &slog.HandlerOptions{
-		Level: slog.LevelInfo,
-	}))
+	// Records logged with a request's context carry the request ID
+	// propagated by the BFF
+	// The level is set from LOG_LEVEL once the configuration is loaded.
+	logLevel := new(slog.LevelVar)
+	logger := slog.New(pkgmiddleware.NewContextLogHandler(slog.NewJSONHandler(os.Stdout, &slog.HandlerOptions{
+		Level: logLevel,
+	})))
 	slog.SetDefault(logger)
 
-	if err := run(logger); err != nil {
+	if err := run(logger, logLevel); err != nil {
 		logger.Error("server failed", slog.String("error", err.Error()))
 		os.Exit(1)
 	}
 }
 
-func run(logger *slog.Logger) error {
+func run(logger *slog.Logger, logLevel *slog.LevelVar) error {
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
 
@@ -48,6 +67,9 @@ func run(logger *slog.Logger) error {
 	if err != nil {
 		return fmt.Errorf("failed to load config: %w", err)
 	}
+	if err := logLevel.UnmarshalText([]byte(cfg.LogLevel)); err != nil {
+		return fmt.Errorf("invalid log level: %w", err)
+	}
 
 	logger.Info("configuration loaded",
 		slog.String("service", cfg.ServiceName),
@@ -56,18 +78,46 @@ func run(logger *slog.Logger) error {
 		slog.Duration("ttl_worker_interval", cfg.TTLWorkerInterval),
 	)
 
-	pool, err := pgxpool.New(ctx, cfg.DatabaseURL)
+	// Metrics, served on the metrics port
+	metricsExporter := observability.NewExporter()
+	meter := metricsExporter.Meter("product-service")
+
+	pool, err := newDatabasePool(ctx, cfg.DatabaseURL, cfg, logger)
 	if err != nil {
 		return fmt.Errorf("failed to create database pool: %w", err)
 	}
 	defer pool.Close()
+	if err := observability.RegisterPoolMetrics(meter, "primary", pool); err != nil {
+		return fmt.Errorf("failed to register database pool metrics: %w", err)
+	}
 
 	if err := pool.Ping(ctx); err != nil {
 		return fmt.Errorf("failed to ping database: %w", err)
 	}
 	logger.Info("database connection established")
 
+	var replicaPool *pgxpool.Pool
+	if cfg.DatabaseReplicaURL != "" {
+		replicaPool, err = newDatabasePool(ctx, cfg.DatabaseReplicaURL, cfg, logger)
+		if err != nil {
+			return fmt.Errorf("failed to create database replica pool: %w", err)
+		}
+		defer replicaPool.Close()
+		if err := observability.RegisterPoolMetrics(meter, "replica", replicaPool); err != nil {
+			return fmt.Errorf("failed to register database pool metrics: %w", err)
+		}
+		// An unreachable replica is not fatal: reads fall back to the primary.
+		if err := replicaPool.Ping(ctx); err != nil {
+			logger.Warn("failed to ping database replica, reading from primary", slog.String("error", err.Error()))
+		} else {
+			logger.Info("database replica connection established")
+		}
+	}
+	readRouter := repository.NewReadRouter(pool, replicaPool, logger.With("component", "read-router"))
+
 	var idempotencyStore usecase.IdempotencyStore
+	var inventoryCache usecase.InventoryCache
+	var rpcIdempotencyStore pkgmiddleware.IdempotencyStore
 	var redisClient *redis.Client
 	if cfg.RedisURL != "" {
 		redisOpts, err := redis.ParseURL(cfg.RedisURL)
@@ -82,6 +132,11 @@ func run(logger *slog.Logger) error {
 			} else {
 				logger.Info("Redis connection established")
 				idempotencyStore = redisAdapter.NewIdempotencyStore(redisClient, "product:idempotency:")
+				rpcIdempotencyStore = redisAdapter.NewIdempotencyStore(redisClient, "product:rpc-idempotency:")
+				if cfg.InventoryCacheEnabled {
+					inventoryCache = redisAdapter.NewInventoryCache(redisClient, "product:inventory:", cfg.InventoryCacheTTL)
+					logger.Info("inventory cache enabled", slog.Duration("ttl", cfg.InventoryCacheTTL))
+				}
 			}
 		}
 	} else {
@@ -96,33 +151,234 @@ func run(logger *slog.Logger) error {
 		logger.Warn("using no-op idempotency store")
 	}
 
+	if inventoryCache == nil {
+		inventoryCache = redisAdapter.NewNoopInventoryCache()
+	}
+
 	txManager := repository.NewTxManager(pool)
-	productRepo := repository.NewPostgresProductRepository(pool)
+	productRepo := repository.NewPostgresProductRepository(pool, readRouter)
 	skuRepo := repository.NewPostgresSKURepository(pool)
-	categoryRepo := repository.NewPostgresCategoryRepository(pool)
+	categoryRepo := repository.NewPostgresCategoryRepository(pool, readRouter)
 	inventoryRepo := repository.NewPostgresInventoryRepository(pool)
 	reservationRepo := repository.NewPostgresReservationRepository(pool)
+	movementRepo := repository.NewPostgresInventoryMovementRepository(pool)
+	priceChangeRepo := repository.NewPostgresPriceChangeRepository(pool)
+	imageRepo := repository.NewPostgresProductImageRepository(pool)
+	attributeRepo := repository.NewPostgresAttributeDefinitionRepository(pool)
+	favoriteRepo := repository.NewPostgresFavoriteRepository(pool)
+
+	var imageStorage domain.ImageStorage
+	if cfg.ImagesEnabled {
+		imageS3, err := objectstore.NewS3(objectstore.S3Config{
+			Endpoint:  cfg.ImageS3Endpoint,
+			Region:    cfg.ImageS3Region,
+			Bucket:    cfg.ImageS3Bucket,
+			AccessKey: cfg.ImageS3AccessKey,
+			SecretKey: cfg.ImageS3SecretKey,
+		}, nil)
+		if err != nil {
+			return fmt.Errorf("failed to initialize image storage: %w", err)
+		}
+		imageStorage = storage.NewS3ImageStorage(imageS3, cfg.ImagePublicURL, cfg.ImageUploadURLTTL)
+		logger.Info("image storage enabled", slog.String("bucket", cfg.ImageS3Bucket))
+	}
+
+	var downloadStorage domain.DownloadStorage
+	if cfg.DownloadsEnabled {
+		downloadS3, err := objectstore.NewS3(objectstore.S3Config{
+			Endpoint:  cfg.DownloadS3Endpoint,
+			Region:    cfg.DownloadS3Region,
+			Bucket:    cfg.DownloadS3Bucket,
+			AccessKey: cfg.DownloadS3AccessKey,
+			SecretKey: cfg.DownloadS3SecretKey,
+		}, nil)
+		if err != nil {
+			return fmt.Errorf("failed to initialize download storage: %w", err)
+		}
+		downloadStorage = storage.NewS3DownloadStorage(downloadS3, cfg.DownloadURLTTL)
+		logger.Info("download storage enabled", slog.String("bucket", cfg.DownloadS3Bucket))
+	}
+
+	var webhookStore *webhook.PostgresStore
+	events := usecase.NewNoopEventPublisher()
+	if cfg.WebhooksEnabled {
+		webhookStore = webhook.NewPostgresStore(pool, "product_service")
+		events = webhook.NewPublisher(webhookStore, logger.With("component", "webhook-publisher"))
+		logger.Info("webhooks enabled", slog.Duration("dispatch_interval", cfg.WebhookDispatchInterval))
+	}
 
-	productUC := usecase.NewProductUseCase(productRepo, categoryRepo)
-	skuUC := usecase.NewSKUUseCase(skuRepo, productRepo, inventoryRepo)
+	productUC := usecase.NewProductUseCase(productRepo, categoryRepo, imageRepo, events)
+	skuUC := usecase.NewSKUUseCase(skuRepo, productRepo, inventoryRepo, priceChangeRepo, attributeRepo, events)
 	categoryUC := usecase.NewCategoryUseCase(categoryRepo)
+	attributeUC := usecase.NewAttributeDefinitionUseCase(attributeRepo, categoryRepo)
+	favoriteUC := usecase.NewFavoriteUseCase(favoriteRepo, productRepo)
+	imageUC := usecase.NewProductImageUseCase(imageRepo, productRepo, imageStorage, events)
+	reserveLocking, err := usecase.ParseReserveLocking(cfg.ReservationLocking)
+	if err != nil {
+		return err
+	}
 	inventoryUC := usecase.NewInventoryUseCase(
 		inventoryRepo,
 		reservationRepo,
 		idempotencyStore,
+		inventoryCache,
 		txManager,
+		events,
+		usecase.LockRetryPolicy{
+			MaxAttempts:    cfg.LockRetryMaxAttempts,
+			InitialBackoff: cfg.LockRetryInitialBackoff,
+			MaxBackoff:     cfg.LockRetryMaxBackoff,
+		},
+		usecase.ReserveLockingPolicy{
+			Default:     reserveLocking,
+			LockTimeout: cfg.ReservationLockTimeout,
+		},
+		usecase.ReservationTTLPolicy{
+			Default:  cfg.ReservationTTL,
+			Min:      cfg.ReservationTTLMin,
+			Max:      cfg.ReservationTTLMax,
+			ScopeMax: cfg.ReservationTTLScopeMax,
+		},
 		cfg.MaxBatchSize,
-		cfg.ReservationTTL,
 		cfg.IdempotencyKeyTTL,
 	)
+	velocityUC := usecase.NewVelocityUseCase(reservationRepo, cfg.VelocityWindows, cfg.MaxBatchSize)
+	movementUC := usecase.NewInventoryMovementUseCase(movementRepo)
+	inventoryFeed := repository.NewPostgresInventoryFeed(pool, logger.With("component", "inventory-feed"))
+	watchUC := usecase.NewInventoryWatchUseCase(inventoryRepo, inventoryFeed)
+	lowStockUC := usecase.NewLowStockUseCase(
+		repository.NewPostgresLowStockRepository(pool),
+		events,
+		cfg.LowStockDefaultThreshold,
+	)
+	warehouseSyncUC := usecase.NewWarehouseSyncUseCase(
+		repository.NewPostgresWarehouseSyncRepository(pool),
+		inventoryCache,
+		events,
+	)
+	digitalGoodsUC := usecase.NewDigitalGoodsUseCase(
+		repository.NewPostgresDigitalGoodsRepository(pool),
+		downloadStorage,
+	)
+	preorderUC := usecase.NewPreorderUseCase(repository.NewPostgresPreorderRepository(pool), events)
+	pickupUC := usecase.NewPickupUseCase(repository.NewPostgresPickupRepository(pool), events)
+	workerPauseRepo := repository.NewPostgresWorkerPauseRepository(pool)
+	workerUC := usecase.NewWorkerUseCase(workerPauseRepo, worker.Names)
+
+	pageTokenSecret := cfg.PageTokenSecret
+	if pageTokenSecret == "" {
+		logger.Warn("PAGE_TOKEN_SECRET not set, page tokens will not survive restarts")
+		pageTokenSecret = listing.RandomSecret()
+	}
+	pageTokens, err := listing.NewCodec(pageTokenSecret)
+	if err != nil {
+		return fmt.Errorf("failed to initialize page tokens: %w", err)
+	}
+
+	operationsStore := operations.NewPostgresStore(pool, "product_service.operations")
+	operationsHandler := operations.NewHandler(operationsStore, pageTokens, logger.With("component", "operations"))
+	operationsRunner := operations.NewRunner(operationsStore, logger.With("component", "operations"))
+	importUC := usecase.NewProductImportUseCase(
+		repository.NewPostgresProductImporter(pool),
+		categoryRepo,
+		operationsRunner,
+		operationsStore,
+		events,
+	)
+
+	productHandler := connectHandler.NewProductHandler(productUC, skuUC, categoryUC, attributeUC, imageUC, importUC, pageTokens)
+	inventoryHandler := connectHandler.NewInventoryHandler(inventoryUC, velocityUC, movementUC, lowStockUC, watchUC)
+	warehouseSyncHandler := connectHandler.NewWarehouseSyncHandler(warehouseSyncUC)
+	favoriteHandler := connectHandler.NewFavoriteHandler(favoriteUC, pageTokens)
+	digitalGoodsHandler := connectHandler.NewDigitalGoodsHandler(digitalGoodsUC)
+	preorderHandler := connectHandler.NewPreorderHandler(preorderUC)
+	pickupHandler := connectHandler.NewPickupHandler(pickupUC)
+	workerHandler := connectHandler.NewWorkerHandler(workerUC)
+
+	auditStore := audit.NewPostgresStore(pool, "product_service.audit_log")
+	auditHandler := audit.NewHandler(auditStore, pageTokens, logger.With("component", "audit"))
+
+	var webhookHandler *webhook.Handler
+	if webhookStore != nil {
+		webhookHandler = webhook.NewHandler(
+			webhookStore,
+			webhook.HandlerConfig{EventTypes: usecase.EventTypes, AllowHTTP: cfg.WebhookAllowHTTP},
+			pageTokens,
+			logger.With("component", "webhooks"),
+		)
+	}
+
+	var backupHandler *backup.Handler
+	if cfg.BackupEnabled {
+		backupStore, err := objectstore.New(objectstore.Config{
+			Backend: cfg.BackupStore,
+			Dir:     cfg.BackupDir,
+			S3: objectstore.S3Config{
+				Endpoint:  cfg.BackupS3Endpoint,
+				Region:    cfg.BackupS3Region,
+				Bucket:    cfg.BackupS3Bucket,
+				AccessKey: cfg.BackupS3AccessKey,
+				SecretKey: cfg.BackupS3SecretKey,
+			},
+		})
+		if err != nil {
+			return fmt.Errorf("failed to initialize backup store: %w", err)
+		}
+		backupManager := backup.NewManager(backupStore, cfg.DatabaseURL, "product_service", backup.Retention{
+			KeepLast: cfg.BackupKeepLast,
+			MaxAge:   cfg.BackupMaxAge,
+		}, logger.With("component", "backup"))
+		backupHandler = backup.NewHandler(backupManager, operationsRunner, logger.With("component", "backup"))
+		logger.Info("backups enabled", slog.String("store", cfg.BackupStore))
+	}
 
-	productHandler := connectHandler.NewProductHandler(productUC, skuUC, categoryUC)
-	inventoryHandler := connectHandler.NewInventoryHandler(inventoryUC)
+	rpcMetrics, err := observability.NewRPCMetrics(meter)
+	if err != nil {
+		return fmt.Errorf("failed to create RPC metrics: %w", err)
+	}
 
-	interceptors := connect.WithInterceptors(
-		pkgmiddleware.ServerPropagatorInterceptor(),
-		pkgmiddleware.LoggingInterceptor(logger),
+	// Turns away new RPCs on shutdown while those in flight complete
+	drainer := pkgmiddleware.NewDrainer(cfg.ShutdownRetryAfter)
+
+	// Metrics come first so rejected calls are counted. Service
+	// authentication runs after the drainer so that propagated user context
+	// is only trusted from known services.
+	serverInterceptors := []connect.Interceptor{rpcMetrics.Interceptor(), drainer.Interceptor()}
+	if cfg.ServiceAuthEnabled {
+		serverInterceptors = append(serverInterceptors, newServiceAuthInterceptor(cfg, logger))
+		logger.Info("service authentication enabled", slog.Any("allowed", cfg.ServiceAuthAllowed))
+	}
+	serverInterceptors = append(serverInterceptors,
+		newContextPropagator(cfg.ContextSigningKeys).ServerPropagatorInterceptor(),
+		pkgmiddleware.LoggingInterceptor(pkgmiddleware.LoggingConfig{
+			SampleEvery:   cfg.LogSampleEvery,
+			SlowThreshold: cfg.LogSlowThreshold,
+		}, logger),
 	)
+	if rpcIdempotencyStore != nil {
+		serverInterceptors = append(serverInterceptors,
+			pkgmiddleware.IdempotencyInterceptor(rpcIdempotencyStore, cfg.IdempotencyKeyTTL, pkgmiddleware.HandlerResponseTypes(
+				productHandler,
+				inventoryHandler,
+				warehouseSyncHandler,
+				favoriteHandler,
+				digitalGoodsHandler,
+				preorderHandler,
+				pickupHandler,
+				workerHandler,
+				operationsHandler,
+				auditHandler,
+				webhookHandler,
+				backupHandler,
+			), logger),
+		)
+	}
+	// Auditing runs last, so idempotent replays are not recorded twice.
+	serverInterceptors = append(serverInterceptors, audit.Interceptor(auditStore, audit.Config{
+		Targets: connectHandler.AuditTargets(productHandler, inventoryHandler),
+		Redact:  []string{"data", "secret", "keys"},
+	}, logger.With("component", "audit")))
+	interceptors := connect.WithInterceptors(serverInterceptors...)
 
 	mux := http.NewServeMux()
 
@@ -132,8 +388,52 @@ func run(logger *slog.Logger) error {
 	inventoryPath, inventorySvcHandler := productv1connect.NewInventoryServiceHandler(inventoryHandler, interceptors)
 	mux.Handle(inventoryPath, inventorySvcHandler)
 
+	mux.Handle(productv1connect.NewWarehouseSyncServiceHandler(warehouseSyncHandler, interceptors))
+
+	mux.Handle(productv1connect.NewFavoriteServiceHandler(favoriteHandler, interceptors))
+
+	mux.Handle(productv1connect.NewDigitalGoodsServiceHandler(digitalGoodsHandler, interceptors))
+
+	mux.Handle(productv1connect.NewPreorderServiceHandler(preorderHandler, interceptors))
+
+	mux.Handle(productv1connect.NewPickupServiceHandler(pickupHandler, interceptors))
+
+	mux.Handle(productv1connect.NewWorkerServiceHandler(workerHandler, interceptors))
+
+	mux.Handle(operationsv1connect.NewOperationsServiceHandler(operationsHandler, interceptors))
+
+	mux.Handle(auditv1connect.NewAuditServiceHandler(auditHandler, interceptors))
+
+	serviceNames := []string{
+		productv1connect.ProductServiceName,
+		productv1connect.InventoryServiceName,
+		productv1connect.WarehouseSyncServiceName,
+		productv1connect.FavoriteServiceName,
+		productv1connect.DigitalGoodsServiceName,
+		productv1connect.PreorderServiceName,
+		productv1connect.PickupServiceName,
+		productv1connect.WorkerServiceName,
+		operationsv1connect.OperationsServiceName,
+		auditv1connect.AuditServiceName,
+	}
+	if webhookHandler != nil {
+		mux.Handle(webhookv1connect.NewWebhookServiceHandler(webhookHandler, interceptors))
+		serviceNames = append(serviceNames, webhookv1connect.WebhookServiceName)
+	}
+	if backupHandler != nil {
+		mux.Handle(backupv1connect.NewBackupServiceHandler(backupHandler, interceptors))
+		serviceNames = append(serviceNames, backupv1connect.BackupServiceName)
+	}
+
+	if cfg.EnableReflection {
+		reflector := grpcreflect.NewStaticReflector(serviceNames...)
+		mux.Handle(grpcreflect.NewHandlerV1(reflector))
+		mux.Handle(grpcreflect.NewHandlerV1Alpha(reflector))
+		logger.Info("gRPC server reflection enabled")
+	}
+
 	mux.HandleFunc("/healthz", handleHealthz)
-	mux.HandleFunc("/readyz", handleReadyz(pool, redisClient, logger))
+	mux.HandleFunc("/readyz", handleReadyz(pool, redisClient, drainer, logger))
 	mux.HandleFunc("/health", func(w http.ResponseWriter, r *http.Request) {
 		w.WriteHeader(http.StatusOK)
 		w.Write([]byte("OK"))
@@ -141,11 +441,18 @@ func run(logger *slog.Logger) error {
 
 	grpcAddr := fmt.Sprintf(":%d", cfg.GRPCPort)
 	server := &http.Server{
-		Addr:         grpcAddr,
-		Handler:      h2c.NewHandler(mux, &http2.Server{}),
-		ReadTimeout:  30 * time.Second,
-		WriteTimeout: 30 * time.Second,
-		IdleTimeout:  120 * time.Second,
+		Addr:              grpcAddr,
+		Handler:           h2c.NewHandler(pkgmiddleware.PeerCertificateMiddleware(pkgmiddleware.RouteTimeouts(cfg.ServerRouteTimeouts)(mux)), &http2.Server{}),
+		ReadTimeout:       cfg.ServerReadTimeout,
+		ReadHeaderTimeout: cfg.ServerReadHeaderTimeout,
+		WriteTimeout:      cfg.ServerWriteTimeout,
+		IdleTimeout:       cfg.ServerIdleTimeout,
+	}
+	if cfg.ServiceTLSCertFile != "" {
+		server.TLSConfig, err = pkgmiddleware.ServerTLSConfig(cfg.ServiceTLSCertFile, cfg.ServiceTLSKeyFile, cfg.ServiceTLSClientCAFile)
+		if err != nil {
+			return fmt.Errorf("failed to load service TLS configuration: %w", err)
+		}
 	}
 
 	sigCh := make(chan os.Signal, 1)
@@ -159,7 +466,9 @@ func run(logger *slog.Logger) error {
 		txManager,
 		reservationRepo,
 		inventoryRepo,
-		logger.With("component", "reservation-expirer"),
+		inventoryCache,
+		workerPauseRepo,
+		logger.With("component", worker.ReservationExpirerName),
 		cfg.TTLWorkerInterval,
 		cfg.TTLWorkerBatchSize,
 	)
@@ -169,28 +478,117 @@ func run(logger *slog.Logger) error {
 		expirer.Start(workerCtx)
 	}()
 
+	activator := worker.NewPriceChangeActivator(
+		skuUC,
+		workerPauseRepo,
+		logger.With("component", worker.PriceChangeActivatorName),
+		cfg.PriceChangeWorkerInterval,
+		cfg.PriceChangeWorkerBatchSize,
+	)
+	wg.Go(func() { activator.Start(workerCtx) })
+
+	lowStockMonitor := worker.NewLowStockMonitor(
+		lowStockUC,
+		workerPauseRepo,
+		logger.With("component", worker.LowStockMonitorName),
+		cfg.LowStockWorkerInterval,
+		cfg.LowStockWorkerBatchSize,
+	)
+	wg.Go(func() { lowStockMonitor.Start(workerCtx) })
+
+	// Stopping the feed ends WatchInventory streams with Unavailable, so
+	// clients reconnect to another replica.
+	wg.Go(func() { inventoryFeed.Start(workerCtx) })
+
+	if replicaPool != nil {
+		wg.Go(func() { readRouter.Start(workerCtx, cfg.DatabaseReplicaCheckInterval) })
+	}
+
+	if webhookStore != nil {
+		dispatcher := webhook.NewDispatcher(webhookStore, nil, webhook.DispatcherConfig{
+			Interval:       cfg.WebhookDispatchInterval,
+			BatchSize:      cfg.WebhookBatchSize,
+			MaxAttempts:    cfg.WebhookMaxAttempts,
+			Timeout:        cfg.WebhookTimeout,
+			InitialBackoff: cfg.WebhookInitialBackoff,
+			MaxBackoff:     cfg.WebhookMaxBackoff,
+		}, logger.With("component", "webhook-dispatcher"))
+		wg.Go(func() { dispatcher.Start(workerCtx) })
+	}
+
+	// The restart request is a SIGTERM to this process, which lands in sigCh
+	// below and runs the normal graceful shutdown.
+	wd := watchdog.New(watchdog.Config{
+		Interval:      cfg.WatchdogInterval,
+		MaxHeapBytes:  uint64(cfg.WatchdogMaxHeapMB) << 20,
+		MaxGoroutines: cfg.WatchdogMaxGoroutines,
+		RestartAfter:  cfg.WatchdogRestartAfter,
+	}, logger.With("component", "watchdog"))
+	if wd.Enabled() {
+		wg.Go(func() { wd.Start(workerCtx) })
+	}
+
 	go func() {
 		logger.Info("server starting",
 			slog.String("address", grpcAddr),
 			slog.String("protocols", "Connect, gRPC, gRPC-Web"),
 		)
-		if err := server.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+		var err error
+		if server.TLSConfig != nil {
+			err = server.ListenAndServeTLS("", "")
+		} else {
+			err = server.ListenAndServe()
+		}
+		if err != nil && err != http.ErrServerClosed {
 			errCh <- fmt.Errorf("server error: %w", err)
 		}
 	}()
 
+	if cfg.DebugPort != 0 {
+		// Optional; a failure is logged but does not stop the service
+		debugServer := observability.NewDebugServer(cfg.DebugAddr, cfg.DebugPort)
+		defer debugServer.Close()
+		go func() {
+			logger.Warn("debug server starting", slog.String("address", debugServer.Addr))
+			if err := debugServer.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+				logger.Error("debug server error", slog.String("error", err.Error()))
+			}
+		}()
+	}
+
+	metricsServer := observability.NewMetricsServer(cfg.MetricsPort, metricsExporter)
+	go func() {
+		logger.Info("metrics server starting", slog.String("address", metricsServer.Addr))
+		if err := metricsServer.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+			errCh <- fmt.Errorf("metrics server error: %w", err)
+		}
+	}()
+
 	select {
 	case sig := <-sigCh:
 		logger.Info("received shutdown signal", slog.String("signal", sig.String()))
 	case err := <-errCh:
+		workerCancel()
+		wg.Wait()
 		return err
 	}
 
 	logger.Info("initiating graceful shutdown")
 
+	// Fail readiness so load balancers stop routing here, reject new RPCs,
+	// and let those in flight (e.g. reservations inside a transaction)
+	// complete before the workers and the database pool stop.
+	drainCtx, drainCancel := context.WithTimeout(context.Background(), cfg.ShutdownDrainTimeout)
+	if err := drainer.Drain(drainCtx); err != nil {
+		logger.Warn("RPCs still in flight after drain timeout", slog.Duration("timeout", cfg.ShutdownDrainTimeout))
+	} else {
+		logger.Info("in-flight RPCs drained")
+	}
+	drainCancel()
+
 	workerCancel()
 	wg.Wait()
-	logger.Info("reservation expirer stopped")
+	logger.Info("background workers stopped")
 
 	shutdownCtx, shutdownCancel := context.WithTimeout(context.Background(), 30*time.Second)
 	defer shutdownCancel()
@@ -200,21 +598,82 @@ func run(logger *slog.Logger) error {
 	} else {
 		logger.Info("server stopped")
 	}
+	if err := metricsServer.Shutdown(shutdownCtx); err != nil {
+		logger.Error("metrics server shutdown error", slog.String("error", err.Error()))
+	}
+	metricsExporter.Shutdown(shutdownCtx)
+
+	// Let running operations finish before the database pool closes
+	operationsRunner.Wait()
 
 	return nil
 }
 
+// newDatabasePool creates a connection pool sized and recycled as
+// configured, whose statements are bounded by the query timeout and logged
+// when slow.
+func newDatabasePool(ctx context.Context, databaseURL string, cfg *config.Config, logger *slog.Logger) (*pgxpool.Pool, error) {
+	poolCfg, err := pgxpool.ParseConfig(databaseURL)
+	if err != nil {
+		return nil, fmt.Errorf("invalid database URL: %w", err)
+	}
+	poolCfg.MaxConns = cfg.DBMaxConns
+	poolCfg.MinConns = cfg.DBMinConns
+	poolCfg.MaxConnLifetime = cfg.DBMaxConnLifetime
+	poolCfg.MaxConnIdleTime = cfg.DBMaxConnIdleTime
+	poolCfg.HealthCheckPeriod = cfg.DBHealthCheckPeriod
+	poolCfg.ConnConfig.Tracer = observability.NewQueryTracer(cfg.DBQueryTimeout, cfg.DBSlowQueryThreshold, logger.With("component", "database"))
+	return pgxpool.NewWithConfig(ctx, poolCfg)
+}
+
+// newServiceAuthInterceptor returns the interceptor that rejects RPCs from
+// callers without an allowed service identity.
+func newServiceAuthInterceptor(cfg *config.Config, logger *slog.Logger) connect.Interceptor {
+	var tokens *pkgmiddleware.ServiceTokenVerifier
+	if cfg.ServiceAuthJWKSURL != "" {
+		tokens = pkgmiddleware.NewServiceTokenVerifier(pkgmiddleware.ServiceTokenConfig{
+			JWKSURL:   cfg.ServiceAuthJWKSURL,
+			Issuer:    cfg.ServiceAuthIssuer,
+			Audience:  cfg.ServiceAuthAudience,
+			ClockSkew: 30 * time.Second,
+		})
+	}
+	return pkgmiddleware.ServiceAuthInterceptor(pkgmiddleware.ServiceAuthConfig{
+		Tokens:          tokens,
+		AllowedServices: cfg.ServiceAuthAllowed,
+	}, logger.With("component", "service-auth"))
+}
+
+// newContextPropagator returns the propagator reading user context from
+// the BFF, verifying its signature when signing keys are configured.
+func newContextPropagator(signingKeys []string) *pkgmiddleware.ContextPropagator {
+	if len(signingKeys) == 0 {
+		return pkgmiddleware.NewContextPropagator()
+	}
+	return pkgmiddleware.NewSignedContextPropagator(pkgmiddleware.PropagatorConfig{SigningKeys: signingKeys})
+}
+
 func handleHealthz(w http.ResponseWriter, r *http.Request) {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(http.StatusOK)
 	json.NewEncoder(w).Encode(map[string]string{"status": "serving"})
 }
 
-// handleReadyz checks database (required) and Redis (optional, degraded mode allowed).
-func handleReadyz(pool *pgxpool.Pool, redisClient *redis.Client, logger *slog.Logger) http.HandlerFunc {
+// handleReadyz checks database (required) and Redis (optional, degraded mode
+// allowed), and fails while the server drains on shutdown.
+func handleReadyz(pool *pgxpool.Pool, redisClient *redis.Client, drainer *pkgmiddleware.Drainer, logger *slog.Logger) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		w.Header().Set("Content-Type", "application/json")
 
+		if drainer.Draining() {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			json.NewEncoder(w).Encode(map[string]string{
+				"status": "not_ready",
+				"reason": "draining",
+			})
+			return
+		}
+
 		if err := pool.Ping(r.Context()); err != nil {
 			w.WriteHeader(http.StatusServiceUnavailable)
 			json.NewEncoder(w).Encode(map[string]string{
@@ -0,0 +1,266 @@
+package connect
+
+import (
+	"context"
+
+	"connectrpc.com/connect"
+	"google.golang.org/protobuf/proto"
+
+	productv1 "github.com/daisuke8000/example-ec-platform/gen/product/v1"
+	"github.com/daisuke8000/example-ec-platform/gen/product/v1/productv1connect"
+	"github.com/daisuke8000/example-ec-platform/pkg/audit"
+	pkgmw "github.com/daisuke8000/example-ec-platform/pkg/connect/middleware"
+)
+
+// Entity types recorded in the audit log.
+const (
+	auditProduct        = "product"
+	auditSKU            = "sku"
+	auditProductImage   = "product_image"
+	auditCategory       = "category"
+	auditAttributeDef   = "attribute_definition"
+	auditInventory      = "inventory"
+	auditReservation    = "reservation"
+	auditSKUMapping     = "external_sku_mapping"
+	auditProductImport  = "product_import"
+	auditDigitalSKU     = "digital_sku"
+	auditPreorder       = "preorder_campaign"
+	auditPickupLocation = "pickup_location"
+	auditPickupSlot     = "pickup_slot"
+	auditWorker         = "worker"
+)
+
+// AuditTargets returns the administrative mutations of the Product Service
+// recorded by the audit interceptor. Products, SKUs, categories and
+// inventory are diffed against their Get RPCs; the other entities are
+// recorded with their request only.
+func AuditTargets(products *ProductHandler, inventory *InventoryHandler) map[string]audit.Target {
+	product := snapshot(products.GetProduct,
+		func(id string) *productv1.GetProductRequest { return &productv1.GetProductRequest{Id: id} },
+		func(resp *productv1.GetProductResponse) proto.Message { return resp.GetProduct() })
+	sku := snapshot(products.GetSKU,
+		func(id string) *productv1.GetSKURequest { return &productv1.GetSKURequest{Id: id} },
+		func(resp *productv1.GetSKUResponse) proto.Message { return resp.GetSku() })
+	category := snapshot(products.GetCategory,
+		func(id string) *productv1.GetCategoryRequest { return &productv1.GetCategoryRequest{Id: id} },
+		func(resp *productv1.GetCategoryResponse) proto.Message { return resp.GetCategory() })
+	stock := snapshot(inventory.GetInventory,
+		func(id string) *productv1.GetInventoryRequest { return &productv1.GetInventoryRequest{SkuId: id} },
+		func(resp *productv1.GetInventoryResponse) proto.Message { return resp.GetInventory() })
+
+	productByID := func(ids func(req, resp any) []string) audit.Target {
+		return audit.Target{EntityType: auditProduct, EntityIDs: ids, Snapshot: product}
+	}
+
+	return map[string]audit.Target{
+		productv1connect.ProductServiceCreateProductProcedure: productByID(
+			audit.ResponseID(func(r *productv1.CreateProductResponse) string { return r.GetProduct().GetId() })),
+		productv1connect.ProductServiceUpdateProductProcedure: productByID(
+			audit.RequestID((*productv1.UpdateProductRequest).GetId)),
+		productv1connect.ProductServiceDeleteProductProcedure: productByID(
+			audit.RequestID((*productv1.DeleteProductRequest).GetId)),
+		productv1connect.ProductServicePublishProductProcedure: productByID(
+			audit.RequestID((*productv1.PublishProductRequest).GetId)),
+		productv1connect.ProductServiceHideProductProcedure: productByID(
+			audit.RequestID((*productv1.HideProductRequest).GetId)),
+		productv1connect.ProductServiceUnpublishProductProcedure: productByID(
+			audit.RequestID((*productv1.UnpublishProductRequest).GetId)),
+		productv1connect.ProductServiceUpdateProductVisibilityProcedure: productByID(
+			audit.RequestID((*productv1.UpdateProductVisibilityRequest).GetId)),
+		productv1connect.ProductServiceImportProductsProcedure: {
+			EntityType: auditProductImport,
+			EntityIDs:  audit.ResponseID(func(r *productv1.ImportProductsResponse) string { return r.GetOperation().GetId() }),
+		},
+
+		productv1connect.ProductServiceCreateSKUProcedure: {
+			EntityType: auditSKU,
+			EntityIDs:  audit.ResponseID(func(r *productv1.CreateSKUResponse) string { return r.GetSku().GetId() }),
+			Snapshot:   sku,
+		},
+		productv1connect.ProductServiceGenerateSKUsProcedure: {
+			EntityType: auditSKU,
+			EntityIDs: func(_, resp any) []string {
+				r, _ := resp.(*productv1.GenerateSKUsResponse)
+				var ids []string
+				for _, s := range r.GetSkus() {
+					ids = append(ids, s.GetId())
+				}
+				return ids
+			},
+		},
+		productv1connect.ProductServiceUpdateSKUProcedure: {
+			EntityType: auditSKU,
+			EntityIDs:  audit.RequestID((*productv1.UpdateSKURequest).GetId),
+			Snapshot:   sku,
+		},
+		productv1connect.ProductServiceDeleteSKUProcedure: {
+			EntityType: auditSKU,
+			EntityIDs:  audit.RequestID((*productv1.DeleteSKURequest).GetId),
+			Snapshot:   sku,
+		},
+		productv1connect.ProductServiceSchedulePriceChangeProcedure: {
+			EntityType: auditSKU,
+			EntityIDs:  audit.RequestID((*productv1.SchedulePriceChangeRequest).GetSkuId),
+		},
+
+		productv1connect.ProductServiceCreateProductImageUploadProcedure: {
+			EntityType: auditProductImage,
+			EntityIDs:  audit.ResponseID(func(r *productv1.CreateProductImageUploadResponse) string { return r.GetImage().GetId() }),
+		},
+		productv1connect.ProductServiceCompleteProductImageUploadProcedure: {
+			EntityType: auditProductImage,
+			EntityIDs:  audit.RequestID((*productv1.CompleteProductImageUploadRequest).GetId),
+		},
+		productv1connect.ProductServiceUpdateProductImageProcedure: {
+			EntityType: auditProductImage,
+			EntityIDs:  audit.RequestID((*productv1.UpdateProductImageRequest).GetId),
+		},
+		productv1connect.ProductServiceReorderProductImagesProcedure: productByID(
+			audit.RequestID((*productv1.ReorderProductImagesRequest).GetProductId)),
+		productv1connect.ProductServiceDeleteProductImageProcedure: {
+			EntityType: auditProductImage,
+			EntityIDs:  audit.RequestID((*productv1.DeleteProductImageRequest).GetId),
+		},
+
+		productv1connect.ProductServiceCreateCategoryProcedure: {
+			EntityType: auditCategory,
+			EntityIDs:  audit.ResponseID(func(r *productv1.CreateCategoryResponse) string { return r.GetCategory().GetId() }),
+			Snapshot:   category,
+		},
+		productv1connect.ProductServiceUpdateCategoryProcedure: {
+			EntityType: auditCategory,
+			EntityIDs:  audit.RequestID((*productv1.UpdateCategoryRequest).GetId),
+			Snapshot:   category,
+		},
+		productv1connect.ProductServiceDeleteCategoryProcedure: {
+			EntityType: auditCategory,
+			EntityIDs:  audit.RequestID((*productv1.DeleteCategoryRequest).GetId),
+			Snapshot:   category,
+		},
+		productv1connect.ProductServiceCreateAttributeDefinitionProcedure: {
+			EntityType: auditAttributeDef,
+			EntityIDs: audit.ResponseID(func(r *productv1.CreateAttributeDefinitionResponse) string {
+				return r.GetAttributeDefinition().GetId()
+			}),
+		},
+		productv1connect.ProductServiceUpdateAttributeDefinitionProcedure: {
+			EntityType: auditAttributeDef,
+			EntityIDs:  audit.RequestID((*productv1.UpdateAttributeDefinitionRequest).GetId),
+		},
+		productv1connect.ProductServiceDeleteAttributeDefinitionProcedure: {
+			EntityType: auditAttributeDef,
+			EntityIDs:  audit.RequestID((*productv1.DeleteAttributeDefinitionRequest).GetId),
+		},
+
+		productv1connect.InventoryServiceUpdateInventoryProcedure: {
+			EntityType: auditInventory,
+			EntityIDs:  audit.RequestID((*productv1.UpdateInventoryRequest).GetSkuId),
+			Snapshot:   stock,
+		},
+		productv1connect.InventoryServiceBatchUpdateInventoryProcedure: {
+			EntityType: auditInventory,
+			EntityIDs: func(req, _ any) []string {
+				var ids []string
+				for _, item := range req.(*productv1.BatchUpdateInventoryRequest).GetItems() {
+					ids = append(ids, item.GetSkuId())
+				}
+				return ids
+			},
+			Snapshot: stock,
+		},
+		productv1connect.InventoryServiceSetLowStockThresholdProcedure: {
+			EntityType: auditInventory,
+			EntityIDs:  audit.RequestID((*productv1.SetLowStockThresholdRequest).GetSkuId),
+			Snapshot:   stock,
+		},
+		productv1connect.InventoryServiceForceReleaseReservationProcedure: {
+			EntityType: auditReservation,
+			EntityIDs:  audit.RequestID((*productv1.ForceReleaseReservationRequest).GetReservationId),
+		},
+		productv1connect.InventoryServiceDrainSKUReservationsProcedure: {
+			EntityType: auditInventory,
+			EntityIDs:  audit.RequestID((*productv1.DrainSKUReservationsRequest).GetSkuId),
+			Snapshot:   stock,
+		},
+
+		productv1connect.WarehouseSyncServiceSetExternalSKUMappingsProcedure: {
+			EntityType: auditSKUMapping,
+			EntityIDs: func(req, _ any) []string {
+				r := req.(*productv1.SetExternalSKUMappingsRequest)
+				var ids []string
+				for _, m := range r.GetMappings() {
+					ids = append(ids, r.GetProvider()+"/"+m.GetExternalSku())
+				}
+				return ids
+			},
+		},
+		productv1connect.WarehouseSyncServiceDeleteExternalSKUMappingProcedure: {
+			EntityType: auditSKUMapping,
+			EntityIDs: func(req, _ any) []string {
+				r := req.(*productv1.DeleteExternalSKUMappingRequest)
+				return []string{r.GetProvider() + "/" + r.GetExternalSku()}
+			},
+		},
+
+		productv1connect.DigitalGoodsServiceSetDigitalFulfillmentProcedure: {
+			EntityType: auditDigitalSKU,
+			EntityIDs:  audit.RequestID((*productv1.SetDigitalFulfillmentRequest).GetSkuId),
+		},
+		productv1connect.DigitalGoodsServiceAddLicenseKeysProcedure: {
+			EntityType: auditDigitalSKU,
+			EntityIDs:  audit.RequestID((*productv1.AddLicenseKeysRequest).GetSkuId),
+		},
+
+		productv1connect.PreorderServiceSetPreorderCampaignProcedure: {
+			EntityType: auditPreorder,
+			EntityIDs:  audit.RequestID((*productv1.SetPreorderCampaignRequest).GetSkuId),
+		},
+
+		productv1connect.PickupServiceCreatePickupLocationProcedure: {
+			EntityType: auditPickupLocation,
+			EntityIDs:  audit.ResponseID(func(r *productv1.CreatePickupLocationResponse) string { return r.GetLocation().GetId() }),
+		},
+		productv1connect.PickupServiceSetPickupLocationActiveProcedure: {
+			EntityType: auditPickupLocation,
+			EntityIDs:  audit.RequestID((*productv1.SetPickupLocationActiveRequest).GetLocationId),
+		},
+		productv1connect.PickupServiceSetPickupStockProcedure: {
+			EntityType: auditPickupLocation,
+			EntityIDs:  audit.RequestID((*productv1.SetPickupStockRequest).GetLocationId),
+		},
+		productv1connect.PickupServiceCreatePickupSlotProcedure: {
+			EntityType: auditPickupSlot,
+			EntityIDs:  audit.ResponseID(func(r *productv1.CreatePickupSlotResponse) string { return r.GetSlot().GetId() }),
+		},
+
+		productv1connect.WorkerServicePauseWorkerProcedure: {
+			EntityType: auditWorker,
+			EntityIDs:  audit.RequestID((*productv1.PauseWorkerRequest).GetName),
+		},
+		productv1connect.WorkerServiceResumeWorkerProcedure: {
+			EntityType: auditWorker,
+			EntityIDs:  audit.RequestID((*productv1.ResumeWorkerRequest).GetName),
+		},
+	}
+}
+
+// snapshot adapts a Get RPC of the service to an audit snapshot. Entities
+// that are not found, e.g. before a create or after a delete, are absent.
+// The caller's channel is cleared so that products hidden from it are
+// still diffed.
+func snapshot[Req, Resp any](
+	get func(context.Context, *connect.Request[Req]) (*connect.Response[Resp], error),
+	request func(id string) *Req,
+	entity func(*Resp) proto.Message,
+) func(ctx context.Context, id string) (proto.Message, error) {
+	return func(ctx context.Context, id string) (proto.Message, error) {
+		resp, err := get(pkgmw.WithChannel(ctx, ""), connect.NewRequest(request(id)))
+		if connect.CodeOf(err) == connect.CodeNotFound {
+			return nil, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		return entity(resp.Msg), nil
+	}
+}
@@ -7,6 +7,7 @@ import (
 
 	productv1 "github.com/daisuke8000/example-ec-platform/gen/product/v1"
 	"github.com/daisuke8000/example-ec-platform/services/product/internal/domain"
+	"github.com/daisuke8000/example-ec-platform/services/product/internal/usecase"
 )
 
 func toProtoProduct(p *domain.Product) *productv1.Product {
@@ -18,6 +19,8 @@ func toProtoProduct(p *domain.Product) *productv1.Product {
 		Name:        p.Name,
 		Description: stringOrEmpty(p.Description),
 		Status:      toProtoProductStatus(p.Status),
+		Channels:    p.Visibility.Channels,
+		Markets:     p.Visibility.Markets,
 		CreatedAt:   timestamppb.New(p.CreatedAt),
 		UpdatedAt:   timestamppb.New(p.UpdatedAt),
 	}
@@ -27,7 +30,7 @@ func toProtoProduct(p *domain.Product) *productv1.Product {
 	return pb
 }
 
-func toProtoProductWithSKUs(p *domain.ProductWithSKUs) *productv1.Product {
+func toProtoProductWithSKUs(p *domain.ProductWithSKUs, imageURL func(*domain.ProductImage) string) *productv1.Product {
 	if p == nil {
 		return nil
 	}
@@ -35,6 +38,9 @@ func toProtoProductWithSKUs(p *domain.ProductWithSKUs) *productv1.Product {
 	for _, sku := range p.SKUs {
 		pb.Skus = append(pb.Skus, toProtoSKU(sku))
 	}
+	for _, img := range p.Images {
+		pb.Images = append(pb.Images, toProtoProductImage(img, imageURL(img)))
+	}
 	return pb
 }
 
@@ -55,7 +61,7 @@ func toProtoSKU(s *domain.SKU) *productv1.SKU {
 	if s == nil {
 		return nil
 	}
-	return &productv1.SKU{
+	pb := &productv1.SKU{
 		Id:         s.ID.String(),
 		ProductId:  s.ProductID.String(),
 		SkuCode:    s.SKUCode,
@@ -64,6 +70,21 @@ func toProtoSKU(s *domain.SKU) *productv1.SKU {
 		CreatedAt:  timestamppb.New(s.CreatedAt),
 		UpdatedAt:  timestamppb.New(s.UpdatedAt),
 	}
+	for _, p := range s.Prices {
+		pb.AdditionalPrices = append(pb.AdditionalPrices, toProtoMoney(p))
+	}
+	return pb
+}
+
+// preferCurrency swaps the SKU's price with its additional price in
+// currency, if it has one.
+func preferCurrency(pb *productv1.SKU, currency string) {
+	for i, p := range pb.AdditionalPrices {
+		if p.CurrencyCode == currency {
+			pb.Price, pb.AdditionalPrices[i] = p, pb.Price
+			return
+		}
+	}
 }
 
 func toProtoSKUWithInventory(s *domain.SKUWithInventory) *productv1.SKU {
@@ -77,6 +98,30 @@ func toProtoSKUWithInventory(s *domain.SKUWithInventory) *productv1.SKU {
 	return pb
 }
 
+func fromProtoMoneys(ms []*productv1.Money) []domain.Money {
+	result := make([]domain.Money, len(ms))
+	for i, m := range ms {
+		result[i] = domain.Money{Amount: m.Amount, Currency: m.CurrencyCode}
+	}
+	return result
+}
+
+func fromProtoSKUMatrix(req *productv1.GenerateSKUsRequest) domain.SKUMatrix {
+	matrix := domain.SKUMatrix{
+		Dimensions: make([]domain.SKUDimension, len(req.Dimensions)),
+		BasePrice:  domain.Money{Amount: req.GetBasePrice().GetAmount(), Currency: req.GetBasePrice().GetCurrencyCode()},
+		PriceRules: make([]domain.SKUPriceRule, len(req.PriceRules)),
+		CodePrefix: req.SkuCodePrefix,
+	}
+	for i, d := range req.Dimensions {
+		matrix.Dimensions[i] = domain.SKUDimension{Name: d.Name, Values: d.Values}
+	}
+	for i, r := range req.PriceRules {
+		matrix.PriceRules[i] = domain.SKUPriceRule{Attribute: r.Attribute, Value: r.Value, AmountDelta: r.AmountDelta}
+	}
+	return matrix
+}
+
 func toProtoMoney(m domain.Money) *productv1.Money {
 	return &productv1.Money{
 		Amount:       m.Amount,
@@ -101,6 +146,59 @@ func toProtoCategory(c *domain.Category) *productv1.Category {
 	return pb
 }
 
+func toProtoAttributeDefinition(d *domain.AttributeDefinition) *productv1.AttributeDefinition {
+	return &productv1.AttributeDefinition{
+		Id:            d.ID.String(),
+		CategoryId:    d.CategoryID.String(),
+		Name:          d.Name,
+		Type:          toProtoAttributeType(d.Type),
+		AllowedValues: d.AllowedValues,
+		Required:      d.Required,
+		CreatedAt:     timestamppb.New(d.CreatedAt),
+		UpdatedAt:     timestamppb.New(d.UpdatedAt),
+	}
+}
+
+func toProtoAttributeType(t domain.AttributeType) productv1.AttributeType {
+	switch t {
+	case domain.AttributeTypeString:
+		return productv1.AttributeType_ATTRIBUTE_TYPE_STRING
+	case domain.AttributeTypeNumber:
+		return productv1.AttributeType_ATTRIBUTE_TYPE_NUMBER
+	case domain.AttributeTypeBoolean:
+		return productv1.AttributeType_ATTRIBUTE_TYPE_BOOLEAN
+	default:
+		return productv1.AttributeType_ATTRIBUTE_TYPE_UNSPECIFIED
+	}
+}
+
+// toDomainAttributeType returns "" for UNSPECIFIED, which the domain rejects.
+func toDomainAttributeType(t productv1.AttributeType) domain.AttributeType {
+	switch t {
+	case productv1.AttributeType_ATTRIBUTE_TYPE_STRING:
+		return domain.AttributeTypeString
+	case productv1.AttributeType_ATTRIBUTE_TYPE_NUMBER:
+		return domain.AttributeTypeNumber
+	case productv1.AttributeType_ATTRIBUTE_TYPE_BOOLEAN:
+		return domain.AttributeTypeBoolean
+	default:
+		return ""
+	}
+}
+
+func toProtoCategoryTreeNode(n *domain.CategoryNode) *productv1.CategoryTreeNode {
+	pb := &productv1.CategoryTreeNode{
+		Category:          toProtoCategory(n.Category),
+		Depth:             int32(n.Depth),
+		ProductCount:      n.ProductCount,
+		TotalProductCount: n.TotalProductCount,
+	}
+	for _, child := range n.Children {
+		pb.Children = append(pb.Children, toProtoCategoryTreeNode(child))
+	}
+	return pb
+}
+
 func toProtoInventory(i *domain.Inventory) *productv1.Inventory {
 	if i == nil {
 		return nil
@@ -119,10 +217,12 @@ func toProtoReservation(r *domain.Reservation) *productv1.Reservation {
 		return nil
 	}
 	pb := &productv1.Reservation{
-		Id:        r.ID.String(),
-		Status:    toProtoReservationStatus(r.Status),
-		CreatedAt: timestamppb.New(r.CreatedAt),
-		ExpiresAt: timestamppb.New(r.ExpiresAt),
+		Id:            r.ID.String(),
+		Status:        toProtoReservationStatus(r.Status),
+		CreatedAt:     timestamppb.New(r.CreatedAt),
+		ExpiresAt:     timestamppb.New(r.ExpiresAt),
+		UpdatedAt:     timestamppb.New(r.UpdatedAt),
+		ReleaseReason: r.ReleaseReason,
 	}
 
 	if r.Status == domain.ReservationStatusPending {
@@ -157,9 +257,184 @@ func toProtoReservationStatus(s domain.ReservationStatus) productv1.ReservationS
 	}
 }
 
+// toDomainReservationStatus returns nil for UNSPECIFIED (no status filter).
+func toDomainReservationStatus(s productv1.ReservationStatus) *domain.ReservationStatus {
+	var status domain.ReservationStatus
+	switch s {
+	case productv1.ReservationStatus_RESERVATION_STATUS_PENDING:
+		status = domain.ReservationStatusPending
+	case productv1.ReservationStatus_RESERVATION_STATUS_CONFIRMED:
+		status = domain.ReservationStatusConfirmed
+	case productv1.ReservationStatus_RESERVATION_STATUS_RELEASED:
+		status = domain.ReservationStatusReleased
+	case productv1.ReservationStatus_RESERVATION_STATUS_EXPIRED:
+		status = domain.ReservationStatusExpired
+	default:
+		return nil
+	}
+	return &status
+}
+
+func toProtoInventoryMovement(m *domain.InventoryMovement) *productv1.InventoryMovement {
+	if m == nil {
+		return nil
+	}
+	pb := &productv1.InventoryMovement{
+		Id:            m.ID,
+		SkuId:         m.SKUID.String(),
+		Reason:        toProtoMovementReason(m.Reason),
+		Actor:         m.Actor,
+		QuantityDelta: m.QuantityDelta,
+		ReservedDelta: m.ReservedDelta,
+		QuantityAfter: m.QuantityAfter,
+		ReservedAfter: m.ReservedAfter,
+		CreatedAt:     timestamppb.New(m.CreatedAt),
+	}
+	if m.ReservationID != nil {
+		pb.ReservationId = m.ReservationID.String()
+	}
+	return pb
+}
+
+func toProtoMovementReason(r domain.MovementReason) productv1.InventoryMovementReason {
+	switch r {
+	case domain.MovementReasonAdjustment:
+		return productv1.InventoryMovementReason_INVENTORY_MOVEMENT_REASON_ADJUSTMENT
+	case domain.MovementReasonReserve:
+		return productv1.InventoryMovementReason_INVENTORY_MOVEMENT_REASON_RESERVE
+	case domain.MovementReasonConfirm:
+		return productv1.InventoryMovementReason_INVENTORY_MOVEMENT_REASON_CONFIRM
+	case domain.MovementReasonRelease:
+		return productv1.InventoryMovementReason_INVENTORY_MOVEMENT_REASON_RELEASE
+	case domain.MovementReasonExpire:
+		return productv1.InventoryMovementReason_INVENTORY_MOVEMENT_REASON_EXPIRE
+	case domain.MovementReasonForceRelease:
+		return productv1.InventoryMovementReason_INVENTORY_MOVEMENT_REASON_FORCE_RELEASE
+	default:
+		return productv1.InventoryMovementReason_INVENTORY_MOVEMENT_REASON_UNSPECIFIED
+	}
+}
+
 func stringOrEmpty(s *string) string {
 	if s == nil {
 		return ""
 	}
 	return *s
 }
+
+func toProtoSKUVelocity(v *domain.SKUVelocity) *productv1.SKUVelocity {
+	if v == nil {
+		return nil
+	}
+
+	windows := make([]*productv1.VelocityWindow, len(v.Windows))
+	for i, w := range v.Windows {
+		windows[i] = &productv1.VelocityWindow{
+			WindowDays:  int32(w.Days),
+			UnitsSold:   w.UnitsSold,
+			UnitsPerDay: w.UnitsPerDay(),
+		}
+	}
+
+	return &productv1.SKUVelocity{
+		SkuId:   v.SKUID.String(),
+		Windows: windows,
+	}
+}
+
+func toProtoPriceChange(c *domain.PriceChange) *productv1.PriceChange {
+	if c == nil {
+		return nil
+	}
+	pb := &productv1.PriceChange{
+		Id:             c.ID.String(),
+		SkuId:          c.SKUID.String(),
+		Price:          toProtoMoney(c.Price),
+		PreviousAmount: c.PreviousAmount,
+		EffectiveFrom:  timestamppb.New(c.EffectiveFrom),
+		Status:         toProtoPriceChangeStatus(c.Status),
+		Actor:          c.Actor,
+		CreatedAt:      timestamppb.New(c.CreatedAt),
+	}
+	if c.AppliedAt != nil {
+		pb.AppliedAt = timestamppb.New(*c.AppliedAt)
+	}
+	return pb
+}
+
+func toProtoLowStockSKU(s *domain.LowStockSKU) *productv1.LowStockSKU {
+	pb := &productv1.LowStockSKU{
+		SkuId:     s.SKUID.String(),
+		Quantity:  s.Quantity,
+		Reserved:  s.Reserved,
+		Available: s.Available(),
+		Threshold: s.Threshold,
+	}
+	if s.AlertedAt != nil {
+		pb.AlertedAt = timestamppb.New(*s.AlertedAt)
+	}
+	return pb
+}
+
+func toProtoPriceChangeStatus(s domain.PriceChangeStatus) productv1.PriceChangeStatus {
+	switch s {
+	case domain.PriceChangeStatusScheduled:
+		return productv1.PriceChangeStatus_PRICE_CHANGE_STATUS_SCHEDULED
+	case domain.PriceChangeStatusApplied:
+		return productv1.PriceChangeStatus_PRICE_CHANGE_STATUS_APPLIED
+	case domain.PriceChangeStatusCancelled:
+		return productv1.PriceChangeStatus_PRICE_CHANGE_STATUS_CANCELLED
+	default:
+		return productv1.PriceChangeStatus_PRICE_CHANGE_STATUS_UNSPECIFIED
+	}
+}
+
+func toProtoProductImage(img *domain.ProductImage, url string) *productv1.ProductImage {
+	if img == nil {
+		return nil
+	}
+	return &productv1.ProductImage{
+		Id:          img.ID.String(),
+		ProductId:   img.ProductID.String(),
+		Url:         url,
+		AltText:     img.AltText,
+		Position:    img.Position,
+		ContentType: img.ContentType,
+		SizeBytes:   img.SizeBytes,
+		Status:      toProtoProductImageStatus(img.Status),
+		CreatedAt:   timestamppb.New(img.CreatedAt),
+		UpdatedAt:   timestamppb.New(img.UpdatedAt),
+	}
+}
+
+func toProtoProductImageStatus(s domain.ProductImageStatus) productv1.ProductImageStatus {
+	switch s {
+	case domain.ProductImageStatusPending:
+		return productv1.ProductImageStatus_PRODUCT_IMAGE_STATUS_PENDING
+	case domain.ProductImageStatusReady:
+		return productv1.ProductImageStatus_PRODUCT_IMAGE_STATUS_READY
+	default:
+		return productv1.ProductImageStatus_PRODUCT_IMAGE_STATUS_UNSPECIFIED
+	}
+}
+
+func toProtoProductImportReport(r *usecase.ImportReport) *productv1.ProductImportReport {
+	if r == nil {
+		return nil
+	}
+	pb := &productv1.ProductImportReport{
+		ValidateOnly:     r.ValidateOnly,
+		TotalProducts:    int32(r.TotalProducts),
+		ImportedProducts: int32(r.ImportedProducts),
+		FailedProducts:   int32(r.FailedProducts),
+		ErrorsTruncated:  r.ErrorsTruncated,
+	}
+	for _, e := range r.Errors {
+		pb.Errors = append(pb.Errors, &productv1.ProductImportRowError{
+			Line:    int32(e.Line),
+			SkuCode: e.SKUCode,
+			Message: e.Message,
+		})
+	}
+	return pb
+}
@@ -0,0 +1,245 @@
+package connect
+
+import (
+	"maps"
+	"slices"
+	"testing"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/proto"
+
+	productv1 "github.com/daisuke8000/example-ec-platform/gen/product/v1"
+	"github.com/daisuke8000/example-ec-platform/pkg/prototest"
+	"github.com/daisuke8000/example-ec-platform/services/product/internal/domain"
+)
+
+var (
+	fixtureProductID  = uuid.MustParse("0b6c8d3e-1f2a-4b5c-9d6e-7f8091a2b3c4")
+	fixtureCategoryID = uuid.MustParse("1c7d9e4f-2a3b-4c6d-8e7f-8091a2b3c4d5")
+	fixtureSKUID      = uuid.MustParse("2d8eaf50-3b4c-4d7e-9f80-91a2b3c4d5e6")
+	fixtureTime       = time.Date(2025, 1, 2, 3, 4, 5, 600_000_000, time.UTC)
+)
+
+// fixtureProduct has every field toProtoProduct converts set.
+func fixtureProduct() *domain.Product {
+	description := "Organic cotton T-shirt"
+	return &domain.Product{
+		ID:          fixtureProductID,
+		Name:        "T-Shirt",
+		Description: &description,
+		CategoryID:  &fixtureCategoryID,
+		Status:      domain.ProductStatusPublished,
+		Visibility:  domain.Visibility{Channels: []string{"app", "web"}, Markets: []string{"JP", "US"}},
+		CreatedAt:   fixtureTime,
+		UpdatedAt:   fixtureTime.Add(time.Hour),
+	}
+}
+
+// fixtureSKU has every field toProtoSKU converts set.
+func fixtureSKU() *domain.SKU {
+	return &domain.SKU{
+		ID:         fixtureSKUID,
+		ProductID:  fixtureProductID,
+		SKUCode:    "TSHIRT-BLU-M",
+		Price:      domain.Money{Amount: 2980, Currency: "JPY"},
+		Prices:     []domain.Money{{Amount: 1999, Currency: "USD"}, {Amount: 1899, Currency: "EUR"}},
+		Attributes: map[string]string{"size": "M", "color": "blue"},
+		CreatedAt:  fixtureTime,
+		UpdatedAt:  fixtureTime.Add(time.Hour),
+	}
+}
+
+// fromProtoProduct is the inverse of toProtoProduct. An empty description
+// or category ID converts back to nil.
+func fromProtoProduct(pb *productv1.Product) (*domain.Product, error) {
+	id, err := uuid.Parse(pb.GetId())
+	if err != nil {
+		return nil, err
+	}
+	p := &domain.Product{
+		ID:         id,
+		Name:       pb.GetName(),
+		Status:     toDomainProductStatus(pb.GetStatus()),
+		Visibility: domain.Visibility{Channels: pb.GetChannels(), Markets: pb.GetMarkets()},
+		CreatedAt:  pb.GetCreatedAt().AsTime(),
+		UpdatedAt:  pb.GetUpdatedAt().AsTime(),
+	}
+	if d := pb.GetDescription(); d != "" {
+		p.Description = &d
+	}
+	if pb.GetCategoryId() != "" {
+		categoryID, err := uuid.Parse(pb.GetCategoryId())
+		if err != nil {
+			return nil, err
+		}
+		p.CategoryID = &categoryID
+	}
+	return p, nil
+}
+
+// fromProtoSKU is the inverse of toProtoSKU.
+func fromProtoSKU(pb *productv1.SKU) (*domain.SKU, error) {
+	id, err := uuid.Parse(pb.GetId())
+	if err != nil {
+		return nil, err
+	}
+	productID, err := uuid.Parse(pb.GetProductId())
+	if err != nil {
+		return nil, err
+	}
+	s := &domain.SKU{
+		ID:         id,
+		ProductID:  productID,
+		SKUCode:    pb.GetSkuCode(),
+		Price:      fromProtoMoneys([]*productv1.Money{pb.GetPrice()})[0],
+		Attributes: pb.GetAttributes(),
+		CreatedAt:  pb.GetCreatedAt().AsTime(),
+		UpdatedAt:  pb.GetUpdatedAt().AsTime(),
+	}
+	if len(pb.GetAdditionalPrices()) > 0 {
+		s.Prices = fromProtoMoneys(pb.GetAdditionalPrices())
+	}
+	return s, nil
+}
+
+func TestToProtoProduct_Golden(t *testing.T) {
+	draft := fixtureProduct()
+	draft.Description = nil
+	draft.CategoryID = nil
+	draft.Status = domain.ProductStatusDraft
+	draft.Visibility = domain.Visibility{}
+
+	tests := []struct {
+		name    string
+		product *domain.Product
+	}{
+		{"product", fixtureProduct()},
+		{"product_draft_minimal", draft},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			prototest.Golden(t, tt.name, toProtoProduct(tt.product))
+		})
+	}
+}
+
+func TestToProtoSKU_Golden(t *testing.T) {
+	prototest.Golden(t, "sku", toProtoSKU(fixtureSKU()))
+}
+
+func TestToProtoProduct_SetsEveryField(t *testing.T) {
+	// SKUs and images are added by toProtoProductWithSKUs; the price range
+	// is not populated.
+	prototest.Populated(t, toProtoProduct(fixtureProduct()), "skus", "images", "min_price", "max_price")
+}
+
+func TestToProtoSKU_SetsEveryField(t *testing.T) {
+	// Inventory is added by toProtoSKUWithInventory.
+	prototest.Populated(t, toProtoSKU(fixtureSKU()), "inventory")
+}
+
+func TestToProtoProduct_RoundTrip(t *testing.T) {
+	want := fixtureProduct()
+	got, err := fromProtoProduct(toProtoProduct(want))
+	if err != nil {
+		t.Fatalf("fromProtoProduct() error = %v", err)
+	}
+	assertProductsEqual(t, got, want)
+}
+
+func TestToProtoSKU_RoundTrip(t *testing.T) {
+	want := fixtureSKU()
+	got, err := fromProtoSKU(toProtoSKU(want))
+	if err != nil {
+		t.Fatalf("fromProtoSKU() error = %v", err)
+	}
+	assertSKUsEqual(t, got, want)
+}
+
+func FuzzToProtoSKU(f *testing.F) {
+	f.Add(fixtureSKUID[:], "TSHIRT-BLU-M", int64(2980), "JPY", "color", "blue", fixtureTime.Unix(), int64(fixtureTime.Nanosecond()))
+	f.Add(make([]byte, 16), "", int64(-1), "", "", "", int64(0), int64(0))
+
+	f.Fuzz(func(t *testing.T, id []byte, code string, amount int64, currency, attrKey, attrValue string, sec, nsec int64) {
+		skuID, err := uuid.FromBytes(id)
+		if err != nil {
+			t.Skip()
+		}
+		for _, s := range []string{code, currency, attrKey, attrValue} {
+			if !utf8.ValidString(s) {
+				t.Skip()
+			}
+		}
+		// Keep the time within the range of google.protobuf.Timestamp.
+		at := time.Unix(sec%253402300799, nsec%1_000_000_000).UTC()
+		if at.Year() < 1 {
+			t.Skip()
+		}
+
+		want := &domain.SKU{
+			ID:         skuID,
+			ProductID:  fixtureProductID,
+			SKUCode:    code,
+			Price:      domain.Money{Amount: amount, Currency: currency},
+			Prices:     []domain.Money{{Amount: -amount, Currency: currency + "X"}},
+			Attributes: map[string]string{attrKey: attrValue},
+			CreatedAt:  at,
+			UpdatedAt:  at,
+		}
+
+		// Through the wire and back, as a client would see it.
+		b, err := proto.Marshal(toProtoSKU(want))
+		if err != nil {
+			t.Fatalf("proto.Marshal() error = %v", err)
+		}
+		var pb productv1.SKU
+		if err := proto.Unmarshal(b, &pb); err != nil {
+			t.Fatalf("proto.Unmarshal() error = %v", err)
+		}
+		if !proto.Equal(&pb, toProtoSKU(want)) {
+			t.Fatalf("message changed on the wire: %v", &pb)
+		}
+		got, err := fromProtoSKU(&pb)
+		if err != nil {
+			t.Fatalf("fromProtoSKU() error = %v", err)
+		}
+		assertSKUsEqual(t, got, want)
+	})
+}
+
+func assertProductsEqual(t *testing.T, got, want *domain.Product) {
+	t.Helper()
+	if got.ID != want.ID || got.Name != want.Name || got.Status != want.Status {
+		t.Errorf("product = %+v, want %+v", got, want)
+	}
+	if stringOrEmpty(got.Description) != stringOrEmpty(want.Description) {
+		t.Errorf("description = %v, want %v", got.Description, want.Description)
+	}
+	if (got.CategoryID == nil) != (want.CategoryID == nil) || (got.CategoryID != nil && *got.CategoryID != *want.CategoryID) {
+		t.Errorf("category_id = %v, want %v", got.CategoryID, want.CategoryID)
+	}
+	if !slices.Equal(got.Visibility.Channels, want.Visibility.Channels) || !slices.Equal(got.Visibility.Markets, want.Visibility.Markets) {
+		t.Errorf("visibility = %+v, want %+v", got.Visibility, want.Visibility)
+	}
+	if !got.CreatedAt.Equal(want.CreatedAt) || !got.UpdatedAt.Equal(want.UpdatedAt) {
+		t.Errorf("timestamps = %v/%v, want %v/%v", got.CreatedAt, got.UpdatedAt, want.CreatedAt, want.UpdatedAt)
+	}
+}
+
+func assertSKUsEqual(t *testing.T, got, want *domain.SKU) {
+	t.Helper()
+	if got.ID != want.ID || got.ProductID != want.ProductID || got.SKUCode != want.SKUCode || got.Price != want.Price {
+		t.Errorf("sku = %+v, want %+v", got, want)
+	}
+	if !slices.Equal(got.Prices, want.Prices) {
+		t.Errorf("prices = %v, want %v", got.Prices, want.Prices)
+	}
+	if !maps.Equal(got.Attributes, want.Attributes) {
+		t.Errorf("attributes = %v, want %v", got.Attributes, want.Attributes)
+	}
+	if !got.CreatedAt.Equal(want.CreatedAt) || !got.UpdatedAt.Equal(want.UpdatedAt) {
+		t.Errorf("timestamps = %v/%v, want %v/%v", got.CreatedAt, got.UpdatedAt, want.CreatedAt, want.UpdatedAt)
+	}
+}
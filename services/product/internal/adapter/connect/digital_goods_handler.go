@@ -0,0 +1,219 @@
+package connect
+
+import (
+	"context"
+	"errors"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	productv1 "github.com/daisuke8000/example-ec-platform/gen/product/v1"
+	"github.com/daisuke8000/example-ec-platform/gen/product/v1/productv1connect"
+	pkgmw "github.com/daisuke8000/example-ec-platform/pkg/connect/middleware"
+	"github.com/daisuke8000/example-ec-platform/services/product/internal/domain"
+	"github.com/daisuke8000/example-ec-platform/services/product/internal/usecase"
+)
+
+type DigitalGoodsHandler struct {
+	productv1connect.UnimplementedDigitalGoodsServiceHandler
+	digitalUC usecase.DigitalGoodsUseCase
+}
+
+func NewDigitalGoodsHandler(digitalUC usecase.DigitalGoodsUseCase) *DigitalGoodsHandler {
+	return &DigitalGoodsHandler{digitalUC: digitalUC}
+}
+
+func (h *DigitalGoodsHandler) SetDigitalFulfillment(
+	ctx context.Context,
+	req *connect.Request[productv1.SetDigitalFulfillmentRequest],
+) (*connect.Response[productv1.SetDigitalFulfillmentResponse], error) {
+	skuID, err := uuid.Parse(req.Msg.SkuId)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	sku, err := h.digitalUC.SetFulfillment(ctx, usecase.SetDigitalFulfillmentInput{
+		SKUID:        skuID,
+		Kind:         toDomainDigitalKind(req.Msg.Kind),
+		ObjectKey:    req.Msg.ObjectKey,
+		MaxDownloads: int(req.Msg.MaxDownloads),
+	})
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+
+	return connect.NewResponse(&productv1.SetDigitalFulfillmentResponse{
+		DigitalSku: toProtoDigitalSKU(sku),
+	}), nil
+}
+
+func (h *DigitalGoodsHandler) GetDigitalSKUs(
+	ctx context.Context,
+	req *connect.Request[productv1.GetDigitalSKUsRequest],
+) (*connect.Response[productv1.GetDigitalSKUsResponse], error) {
+	skuIDs := make([]uuid.UUID, len(req.Msg.SkuIds))
+	for i, id := range req.Msg.SkuIds {
+		skuID, err := uuid.Parse(id)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		}
+		skuIDs[i] = skuID
+	}
+
+	skus, err := h.digitalUC.GetDigitalSKUs(ctx, skuIDs)
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+
+	resp := &productv1.GetDigitalSKUsResponse{
+		DigitalSkus: make([]*productv1.DigitalSKU, len(skus)),
+	}
+	for i, s := range skus {
+		resp.DigitalSkus[i] = toProtoDigitalSKU(s)
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (h *DigitalGoodsHandler) AddLicenseKeys(
+	ctx context.Context,
+	req *connect.Request[productv1.AddLicenseKeysRequest],
+) (*connect.Response[productv1.AddLicenseKeysResponse], error) {
+	skuID, err := uuid.Parse(req.Msg.SkuId)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	added, err := h.digitalUC.AddLicenseKeys(ctx, skuID, req.Msg.Keys)
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+
+	return connect.NewResponse(&productv1.AddLicenseKeysResponse{
+		AddedCount:     int32(added),
+		DuplicateCount: int32(len(req.Msg.Keys) - added),
+	}), nil
+}
+
+func (h *DigitalGoodsHandler) FulfillDigitalOrder(
+	ctx context.Context,
+	req *connect.Request[productv1.FulfillDigitalOrderRequest],
+) (*connect.Response[productv1.FulfillDigitalOrderResponse], error) {
+	orderID, err := uuid.Parse(req.Msg.OrderId)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	items := make([]domain.DigitalOrderItem, len(req.Msg.Items))
+	for i, item := range req.Msg.Items {
+		skuID, err := uuid.Parse(item.SkuId)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		}
+		items[i] = domain.DigitalOrderItem{SKUID: skuID, Quantity: item.Quantity}
+	}
+
+	fulfilled, err := h.digitalUC.FulfillOrder(ctx, usecase.FulfillOrderInput{
+		OrderID: orderID,
+		UserID:  req.Msg.UserId,
+		Items:   items,
+	})
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+
+	resp := &productv1.FulfillDigitalOrderResponse{
+		Goods:    make([]*productv1.DigitalGood, len(fulfilled.Entitlements)),
+		Replayed: fulfilled.Replayed,
+	}
+	for i, e := range fulfilled.Entitlements {
+		good := toProtoDigitalGood(&usecase.DigitalGood{Entitlement: e})
+		good.LicenseKey = ""
+		resp.Goods[i] = good
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (h *DigitalGoodsHandler) RetrieveDigitalGoods(
+	ctx context.Context,
+	req *connect.Request[productv1.RetrieveDigitalGoodsRequest],
+) (*connect.Response[productv1.RetrieveDigitalGoodsResponse], error) {
+	userID := pkgmw.GetUserID(ctx)
+	if userID == "" {
+		return nil, connect.NewError(connect.CodeUnauthenticated, errors.New("authentication required"))
+	}
+	orderID, err := uuid.Parse(req.Msg.OrderId)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	input := usecase.RetrieveGoodsInput{OrderID: orderID, UserID: userID}
+	if req.Msg.EntitlementId != nil {
+		entitlementID, err := uuid.Parse(*req.Msg.EntitlementId)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		}
+		input.EntitlementID = &entitlementID
+	}
+
+	goods, err := h.digitalUC.RetrieveGoods(ctx, input)
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+
+	resp := &productv1.RetrieveDigitalGoodsResponse{
+		Goods: make([]*productv1.DigitalGood, len(goods)),
+	}
+	for i, g := range goods {
+		resp.Goods[i] = toProtoDigitalGood(g)
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func toProtoDigitalSKU(s *domain.DigitalSKU) *productv1.DigitalSKU {
+	return &productv1.DigitalSKU{
+		SkuId:         s.SKUID.String(),
+		Kind:          toProtoDigitalKind(s.Kind),
+		ObjectKey:     s.ObjectKey,
+		MaxDownloads:  int32(s.MaxDownloads),
+		AvailableKeys: s.AvailableKeys,
+	}
+}
+
+func toProtoDigitalGood(g *usecase.DigitalGood) *productv1.DigitalGood {
+	e := g.Entitlement
+	pb := &productv1.DigitalGood{
+		Id:                 e.ID.String(),
+		OrderId:            e.OrderID.String(),
+		SkuId:              e.SKUID.String(),
+		Kind:               toProtoDigitalKind(e.Kind),
+		LicenseKey:         e.LicenseKey,
+		DownloadUrl:        g.DownloadURL,
+		DownloadsRemaining: int32(e.DownloadsRemaining()),
+		CreatedAt:          timestamppb.New(e.CreatedAt),
+	}
+	if g.DownloadURL != "" {
+		pb.DownloadUrlExpiresAt = timestamppb.New(g.URLExpiresAt)
+	}
+	return pb
+}
+
+func toProtoDigitalKind(k domain.DigitalKind) productv1.DigitalKind {
+	switch k {
+	case domain.DigitalKindLicenseKey:
+		return productv1.DigitalKind_DIGITAL_KIND_LICENSE_KEY
+	case domain.DigitalKindDownload:
+		return productv1.DigitalKind_DIGITAL_KIND_DOWNLOAD
+	default:
+		return productv1.DigitalKind_DIGITAL_KIND_UNSPECIFIED
+	}
+}
+
+func toDomainDigitalKind(k productv1.DigitalKind) domain.DigitalKind {
+	switch k {
+	case productv1.DigitalKind_DIGITAL_KIND_LICENSE_KEY:
+		return domain.DigitalKindLicenseKey
+	case productv1.DigitalKind_DIGITAL_KIND_DOWNLOAD:
+		return domain.DigitalKindDownload
+	default:
+		return ""
+	}
+}
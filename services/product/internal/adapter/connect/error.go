@@ -5,6 +5,7 @@ import (
 
 	"connectrpc.com/connect"
 
+	"github.com/daisuke8000/example-ec-platform/pkg/connect/errcode"
 	"github.com/daisuke8000/example-ec-platform/services/product/internal/domain"
 )
 
@@ -18,36 +19,123 @@ func toConnectError(err error) error {
 		errors.Is(err, domain.ErrSKUNotFound),
 		errors.Is(err, domain.ErrCategoryNotFound),
 		errors.Is(err, domain.ErrInventoryNotFound),
-		errors.Is(err, domain.ErrReservationNotFound):
+		errors.Is(err, domain.ErrReservationNotFound),
+		errors.Is(err, domain.ErrProductImageNotFound),
+		errors.Is(err, domain.ErrImportNotFound),
+		errors.Is(err, domain.ErrExternalSKUNotMapped),
+		errors.Is(err, domain.ErrExternalSKUMappingNotFound),
+		errors.Is(err, domain.ErrDigitalOrderNotFound),
+		errors.Is(err, domain.ErrPreorderCampaignNotFound),
+		errors.Is(err, domain.ErrPreorderAllocationNotFound),
+		errors.Is(err, domain.ErrPickupLocationNotFound),
+		errors.Is(err, domain.ErrPickupSlotNotFound),
+		errors.Is(err, domain.ErrPickupReservationNotFound),
+		errors.Is(err, domain.ErrWorkerNotFound),
+		errors.Is(err, domain.ErrAttributeDefinitionNotFound):
 		return connect.NewError(connect.CodeNotFound, err)
 
 	case errors.Is(err, domain.ErrSKUCodeAlreadyExists),
-		errors.Is(err, domain.ErrCategoryNameExists):
+		errors.Is(err, domain.ErrCategoryNameExists),
+		errors.Is(err, domain.ErrExternalSKUConflict),
+		errors.Is(err, domain.ErrPickupSlotExists),
+		errors.Is(err, domain.ErrAttributeDefinitionExists):
 		return connect.NewError(connect.CodeAlreadyExists, err)
 
-	case errors.Is(err, domain.ErrInsufficientStock):
+	case errors.Is(err, domain.ErrInsufficientStock),
+		errors.Is(err, domain.ErrLicenseKeysExhausted),
+		errors.Is(err, domain.ErrPreorderAllocationExhausted):
+		return errcode.New(connect.CodeResourceExhausted, err, errcode.OutOfStock, nil)
+
+	case errors.Is(err, domain.ErrPickupSlotFull):
 		return connect.NewError(connect.CodeResourceExhausted, err)
 
 	case errors.Is(err, domain.ErrOptimisticLockConflict),
+		errors.Is(err, domain.ErrInventoryLockTimeout),
 		errors.Is(err, domain.ErrReservationExpired):
 		return connect.NewError(connect.CodeAborted, err)
 
 	case errors.Is(err, domain.ErrReservationNotPending),
 		errors.Is(err, domain.ErrInvalidProductStatus),
-		errors.Is(err, domain.ErrInvalidReservationStatus):
+		errors.Is(err, domain.ErrInvalidReservationStatus),
+		errors.Is(err, domain.ErrCategoryCycle),
+		errors.Is(err, domain.ErrTooManyImages),
+		errors.Is(err, domain.ErrTooManyFavorites),
+		errors.Is(err, domain.ErrImageNotUploaded),
+		errors.Is(err, domain.ErrImageNotPending),
+		errors.Is(err, domain.ErrNotLicenseKeySKU),
+		errors.Is(err, domain.ErrDigitalKindChange),
+		errors.Is(err, domain.ErrPreorderNotOpen),
+		errors.Is(err, domain.ErrPickupLocationInactive),
+		errors.Is(err, domain.ErrPickupSlotClosed),
+		errors.Is(err, domain.ErrInvalidPickupTransition):
 		return connect.NewError(connect.CodeFailedPrecondition, err)
 
 	case errors.Is(err, domain.ErrInvalidQuantity),
 		errors.Is(err, domain.ErrBatchSizeExceeded),
+		errors.Is(err, domain.ErrDuplicateBatchSKU),
+		errors.Is(err, domain.ErrEmptyBatch),
+		errors.Is(err, domain.ErrInvalidReleaseReason),
+		errors.Is(err, domain.ErrInvalidCreatedRange),
+		errors.Is(err, domain.ErrReservationTTLOutOfRange),
+		errors.Is(err, domain.ErrInvalidProvider),
+		errors.Is(err, domain.ErrInvalidExternalSKU),
+		errors.Is(err, domain.ErrInvalidQuantityDelta),
+		errors.Is(err, domain.ErrInvalidIdempotencyKey),
+		errors.Is(err, domain.ErrInvalidLowStockThreshold),
 		errors.Is(err, domain.ErrEmptyProductName),
 		errors.Is(err, domain.ErrProductNameTooLong),
 		errors.Is(err, domain.ErrEmptySKUCode),
 		errors.Is(err, domain.ErrSKUCodeTooLong),
 		errors.Is(err, domain.ErrEmptyCategoryName),
 		errors.Is(err, domain.ErrCategoryNameTooLong),
-		errors.Is(err, domain.ErrInvalidPrice):
+		errors.Is(err, domain.ErrInvalidPrice),
+		errors.Is(err, domain.ErrInvalidCurrency),
+		errors.Is(err, domain.ErrDuplicateCurrency),
+		errors.Is(err, domain.ErrTooManyPrices),
+		errors.Is(err, domain.ErrInvalidVelocityWindow),
+		errors.Is(err, domain.ErrInvalidPageToken),
+		errors.Is(err, domain.ErrInvalidEffectiveFrom),
+		errors.Is(err, domain.ErrInvalidChannel),
+		errors.Is(err, domain.ErrInvalidMarket),
+		errors.Is(err, domain.ErrInvalidImageContentType),
+		errors.Is(err, domain.ErrImageAltTextTooLong),
+		errors.Is(err, domain.ErrImageTooLarge),
+		errors.Is(err, domain.ErrImageOrderMismatch),
+		errors.Is(err, domain.ErrInvalidImportFormat),
+		errors.Is(err, domain.ErrImportTooLarge),
+		errors.Is(err, domain.ErrMalformedImport),
+		errors.Is(err, domain.ErrEmptyImport),
+		errors.Is(err, domain.ErrInvalidDigitalKind),
+		errors.Is(err, domain.ErrInvalidDownloadObject),
+		errors.Is(err, domain.ErrInvalidDownloadLimit),
+		errors.Is(err, domain.ErrInvalidLicenseKey),
+		errors.Is(err, domain.ErrMissingBuyer),
+		errors.Is(err, domain.ErrInvalidPreorderWindow),
+		errors.Is(err, domain.ErrInvalidPreorderShipDate),
+		errors.Is(err, domain.ErrInvalidAllocationCap),
+		errors.Is(err, domain.ErrInvalidPickupLocationName),
+		errors.Is(err, domain.ErrInvalidPickupLocationAddress),
+		errors.Is(err, domain.ErrInvalidPickupSlotWindow),
+		errors.Is(err, domain.ErrInvalidPickupSlotCapacity),
+		errors.Is(err, domain.ErrInvalidPickupSlotRange),
+		errors.Is(err, domain.ErrInvalidPauseReason),
+		errors.Is(err, domain.ErrInvalidAttributeName),
+		errors.Is(err, domain.ErrInvalidAttributeType),
+		errors.Is(err, domain.ErrInvalidAllowedValues),
+		errors.Is(err, domain.ErrMissingRequiredAttribute),
+		errors.Is(err, domain.ErrInvalidAttributeValue),
+		errors.Is(err, domain.ErrInvalidSKUDimensions),
+		errors.Is(err, domain.ErrInvalidSKUPriceRule),
+		errors.Is(err, domain.ErrTooManySKUCombinations):
 		return connect.NewError(connect.CodeInvalidArgument, err)
 
+	case errors.Is(err, domain.ErrInventoryWatchInterrupted):
+		return connect.NewError(connect.CodeUnavailable, err)
+
+	case errors.Is(err, domain.ErrImageStorageDisabled),
+		errors.Is(err, domain.ErrDownloadStorageDisabled):
+		return connect.NewError(connect.CodeUnimplemented, err)
+
 	case errors.Is(err, domain.ErrIdempotencyKeyExists):
 		return connect.NewError(connect.CodeAlreadyExists, err)
 
@@ -0,0 +1,138 @@
+package connect
+
+import (
+	"context"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	productv1 "github.com/daisuke8000/example-ec-platform/gen/product/v1"
+	"github.com/daisuke8000/example-ec-platform/gen/product/v1/productv1connect"
+	"github.com/daisuke8000/example-ec-platform/pkg/listing"
+	"github.com/daisuke8000/example-ec-platform/services/product/internal/domain"
+	"github.com/daisuke8000/example-ec-platform/services/product/internal/usecase"
+)
+
+type FavoriteHandler struct {
+	productv1connect.UnimplementedFavoriteServiceHandler
+	favoriteUC usecase.FavoriteUseCase
+	pageTokens *listing.Codec
+}
+
+func NewFavoriteHandler(favoriteUC usecase.FavoriteUseCase, pageTokens *listing.Codec) *FavoriteHandler {
+	return &FavoriteHandler{favoriteUC: favoriteUC, pageTokens: pageTokens}
+}
+
+func (h *FavoriteHandler) AddFavorite(
+	ctx context.Context,
+	req *connect.Request[productv1.AddFavoriteRequest],
+) (*connect.Response[productv1.AddFavoriteResponse], error) {
+	userID, productID, err := parseFavorite(req.Msg.UserId, req.Msg.ProductId)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	if err := h.favoriteUC.AddFavorite(ctx, userID, productID, audienceFromContext(ctx)); err != nil {
+		return nil, toConnectError(err)
+	}
+	return connect.NewResponse(&productv1.AddFavoriteResponse{}), nil
+}
+
+func (h *FavoriteHandler) RemoveFavorite(
+	ctx context.Context,
+	req *connect.Request[productv1.RemoveFavoriteRequest],
+) (*connect.Response[productv1.RemoveFavoriteResponse], error) {
+	userID, productID, err := parseFavorite(req.Msg.UserId, req.Msg.ProductId)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	if err := h.favoriteUC.RemoveFavorite(ctx, userID, productID); err != nil {
+		return nil, toConnectError(err)
+	}
+	return connect.NewResponse(&productv1.RemoveFavoriteResponse{}), nil
+}
+
+func (h *FavoriteHandler) ListFavorites(
+	ctx context.Context,
+	req *connect.Request[productv1.ListFavoritesRequest],
+) (*connect.Response[productv1.ListFavoritesResponse], error) {
+	userID, err := uuid.Parse(req.Msg.UserId)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	// Tokens are bound to the user so they cannot be replayed for another.
+	query := listing.QueryKey(nil, nil, userID.String())
+	input := usecase.ListFavoritesInput{
+		UserID:   userID,
+		PageSize: int(req.Msg.PageSize),
+		Audience: audienceFromContext(ctx),
+	}
+	if req.Msg.PageToken != "" {
+		var cursor domain.FavoriteCursor
+		if err := h.pageTokens.Decode(req.Msg.PageToken, query, &cursor); err != nil {
+			return nil, toConnectError(domain.ErrInvalidPageToken)
+		}
+		input.After = &cursor
+	}
+
+	out, err := h.favoriteUC.ListFavorites(ctx, input)
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+
+	resp := &productv1.ListFavoritesResponse{
+		Favorites: make([]*productv1.Favorite, len(out.Favorites)),
+	}
+	for i, f := range out.Favorites {
+		resp.Favorites[i] = &productv1.Favorite{
+			Product:   toProtoProduct(f.Product),
+			CreatedAt: timestamppb.New(f.CreatedAt),
+		}
+	}
+	if out.Next != nil {
+		resp.NextPageToken, err = h.pageTokens.Encode(query, out.Next)
+		if err != nil {
+			return nil, toConnectError(err)
+		}
+	}
+
+	return connect.NewResponse(resp), nil
+}
+
+func (h *FavoriteHandler) GetFavoriteCounts(
+	ctx context.Context,
+	req *connect.Request[productv1.GetFavoriteCountsRequest],
+) (*connect.Response[productv1.GetFavoriteCountsResponse], error) {
+	productIDs, err := parseUUIDs(req.Msg.ProductIds)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	counts, err := h.favoriteUC.GetFavoriteCounts(ctx, productIDs)
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+
+	resp := &productv1.GetFavoriteCountsResponse{
+		Counts: make(map[string]int64, len(counts)),
+	}
+	for id, count := range counts {
+		resp.Counts[id.String()] = count
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func parseFavorite(userID, productID string) (uuid.UUID, uuid.UUID, error) {
+	user, err := uuid.Parse(userID)
+	if err != nil {
+		return uuid.Nil, uuid.Nil, err
+	}
+	product, err := uuid.Parse(productID)
+	if err != nil {
+		return uuid.Nil, uuid.Nil, err
+	}
+	return user, product, nil
+}
@@ -2,22 +2,37 @@ package connect
 
 import (
 	"context"
+	"errors"
+	"strings"
+	"time"
 
 	"connectrpc.com/connect"
 	"github.com/google/uuid"
 
 	productv1 "github.com/daisuke8000/example-ec-platform/gen/product/v1"
 	"github.com/daisuke8000/example-ec-platform/gen/product/v1/productv1connect"
+	pkgmw "github.com/daisuke8000/example-ec-platform/pkg/connect/middleware"
+	"github.com/daisuke8000/example-ec-platform/services/product/internal/domain"
 	"github.com/daisuke8000/example-ec-platform/services/product/internal/usecase"
 )
 
 type InventoryHandler struct {
 	productv1connect.UnimplementedInventoryServiceHandler
 	inventoryUC usecase.InventoryUseCase
+	velocityUC  usecase.VelocityUseCase
+	movementUC  usecase.InventoryMovementUseCase
+	lowStockUC  usecase.LowStockUseCase
+	watchUC     usecase.InventoryWatchUseCase
 }
 
-func NewInventoryHandler(inventoryUC usecase.InventoryUseCase) *InventoryHandler {
-	return &InventoryHandler{inventoryUC: inventoryUC}
+func NewInventoryHandler(
+	inventoryUC usecase.InventoryUseCase,
+	velocityUC usecase.VelocityUseCase,
+	movementUC usecase.InventoryMovementUseCase,
+	lowStockUC usecase.LowStockUseCase,
+	watchUC usecase.InventoryWatchUseCase,
+) *InventoryHandler {
+	return &InventoryHandler{inventoryUC: inventoryUC, velocityUC: velocityUC, movementUC: movementUC, lowStockUC: lowStockUC, watchUC: watchUC}
 }
 
 func (h *InventoryHandler) GetInventory(
@@ -39,6 +54,26 @@ func (h *InventoryHandler) GetInventory(
 	}), nil
 }
 
+func (h *InventoryHandler) WatchInventory(
+	ctx context.Context,
+	req *connect.Request[productv1.WatchInventoryRequest],
+	stream *connect.ServerStream[productv1.WatchInventoryResponse],
+) error {
+	skuIDs, err := parseUUIDs(req.Msg.SkuIds)
+	if err != nil {
+		return connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	err = h.watchUC.WatchInventory(ctx, skuIDs, func(inv *domain.Inventory) error {
+		return stream.Send(&productv1.WatchInventoryResponse{Inventory: toProtoInventory(inv)})
+	})
+	if err != nil && ctx.Err() == nil {
+		return toConnectError(err)
+	}
+	// The client went away
+	return nil
+}
+
 func (h *InventoryHandler) UpdateInventory(
 	ctx context.Context,
 	req *connect.Request[productv1.UpdateInventoryRequest],
@@ -48,7 +83,7 @@ func (h *InventoryHandler) UpdateInventory(
 		return nil, connect.NewError(connect.CodeInvalidArgument, err)
 	}
 
-	err = h.inventoryUC.UpdateInventory(ctx, skuID, req.Msg.Quantity)
+	err = h.inventoryUC.UpdateInventory(ctx, skuID, req.Msg.Quantity, pkgmw.GetUserID(ctx))
 	if err != nil {
 		return nil, toConnectError(err)
 	}
@@ -63,6 +98,57 @@ func (h *InventoryHandler) UpdateInventory(
 	}), nil
 }
 
+func (h *InventoryHandler) BatchUpdateInventory(
+	ctx context.Context,
+	req *connect.Request[productv1.BatchUpdateInventoryRequest],
+) (*connect.Response[productv1.BatchUpdateInventoryResponse], error) {
+	if len(req.Msg.Items) > domain.MaxInventoryUpdateBatch {
+		return nil, toConnectError(domain.ErrBatchSizeExceeded)
+	}
+
+	// Malformed IDs are reported per item; the rest go to the use case,
+	// with indexes mapping its results back to request order.
+	results := make([]*productv1.InventoryUpdateResult, len(req.Msg.Items))
+	updates := make([]domain.QuantityUpdate, 0, len(req.Msg.Items))
+	indexes := make([]int, 0, len(req.Msg.Items))
+	for i, item := range req.Msg.Items {
+		skuID, err := uuid.Parse(item.SkuId)
+		if err != nil {
+			results[i] = toProtoInventoryUpdateError(item.SkuId, connect.NewError(connect.CodeInvalidArgument, err))
+			continue
+		}
+		updates = append(updates, domain.QuantityUpdate{SKUID: skuID, Quantity: item.Quantity})
+		indexes = append(indexes, i)
+	}
+
+	if len(updates) > 0 || len(req.Msg.Items) == 0 {
+		updated, err := h.inventoryUC.BatchUpdateInventory(ctx, updates, pkgmw.GetUserID(ctx))
+		if err != nil {
+			return nil, toConnectError(err)
+		}
+		for j, result := range updated {
+			if result.Err != nil {
+				results[indexes[j]] = toProtoInventoryUpdateError(result.SKUID.String(), toConnectError(result.Err))
+				continue
+			}
+			results[indexes[j]] = &productv1.InventoryUpdateResult{
+				SkuId:     result.SKUID.String(),
+				Inventory: toProtoInventory(result.Inventory),
+			}
+		}
+	}
+
+	resp := &productv1.BatchUpdateInventoryResponse{Results: results}
+	for _, result := range results {
+		if result.ErrorCode == "" {
+			resp.UpdatedCount++
+		} else {
+			resp.FailedCount++
+		}
+	}
+	return connect.NewResponse(resp), nil
+}
+
 func (h *InventoryHandler) BatchReserveInventory(
 	ctx context.Context,
 	req *connect.Request[productv1.BatchReserveInventoryRequest],
@@ -82,6 +168,10 @@ func (h *InventoryHandler) BatchReserveInventory(
 	input := usecase.BatchReserveInput{
 		Items:          items,
 		IdempotencyKey: req.Msg.IdempotencyKey,
+		TTL:            time.Duration(req.Msg.TtlSeconds) * time.Second,
+		Scopes:         strings.Fields(pkgmw.GetScopes(ctx)),
+		Actor:          pkgmw.GetUserID(ctx),
+		Locking:        toReserveLocking(req.Msg.Locking),
 	}
 
 	reservation, err := h.inventoryUC.BatchReserveInventory(ctx, input)
@@ -103,7 +193,7 @@ func (h *InventoryHandler) ConfirmReservation(
 		return nil, connect.NewError(connect.CodeInvalidArgument, err)
 	}
 
-	err = h.inventoryUC.ConfirmReservation(ctx, reservationID, req.Msg.IdempotencyKey)
+	err = h.inventoryUC.ConfirmReservation(ctx, reservationID, req.Msg.IdempotencyKey, pkgmw.GetUserID(ctx))
 	if err != nil {
 		return nil, toConnectError(err)
 	}
@@ -127,7 +217,7 @@ func (h *InventoryHandler) ReleaseInventory(
 		return nil, connect.NewError(connect.CodeInvalidArgument, err)
 	}
 
-	err = h.inventoryUC.ReleaseReservation(ctx, reservationID, req.Msg.IdempotencyKey)
+	err = h.inventoryUC.ReleaseReservation(ctx, reservationID, req.Msg.IdempotencyKey, pkgmw.GetUserID(ctx))
 	if err != nil {
 		return nil, toConnectError(err)
 	}
@@ -160,3 +250,211 @@ func (h *InventoryHandler) GetReservationStatus(
 		Reservation: toProtoReservation(reservation),
 	}), nil
 }
+
+func (h *InventoryHandler) GetSKUVelocity(
+	ctx context.Context,
+	req *connect.Request[productv1.GetSKUVelocityRequest],
+) (*connect.Response[productv1.GetSKUVelocityResponse], error) {
+	skuIDs := make([]uuid.UUID, len(req.Msg.SkuIds))
+	for i, id := range req.Msg.SkuIds {
+		skuID, err := uuid.Parse(id)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		}
+		skuIDs[i] = skuID
+	}
+
+	windowDays := make([]int, len(req.Msg.WindowDays))
+	for i, days := range req.Msg.WindowDays {
+		windowDays[i] = int(days)
+	}
+
+	velocities, err := h.velocityUC.GetSKUVelocity(ctx, usecase.GetSKUVelocityInput{
+		SKUIDs:     skuIDs,
+		WindowDays: windowDays,
+	})
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+
+	resp := &productv1.GetSKUVelocityResponse{
+		Velocities: make([]*productv1.SKUVelocity, len(velocities)),
+	}
+	for i, v := range velocities {
+		resp.Velocities[i] = toProtoSKUVelocity(v)
+	}
+
+	return connect.NewResponse(resp), nil
+}
+
+func (h *InventoryHandler) ListInventoryMovements(
+	ctx context.Context,
+	req *connect.Request[productv1.ListInventoryMovementsRequest],
+) (*connect.Response[productv1.ListInventoryMovementsResponse], error) {
+	skuID, err := uuid.Parse(req.Msg.SkuId)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	out, err := h.movementUC.ListInventoryMovements(ctx, usecase.ListInventoryMovementsInput{
+		SKUID:     skuID,
+		PageSize:  int(req.Msg.PageSize),
+		PageToken: req.Msg.PageToken,
+	})
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+
+	resp := &productv1.ListInventoryMovementsResponse{
+		Movements:     make([]*productv1.InventoryMovement, len(out.Movements)),
+		NextPageToken: out.NextPageToken,
+	}
+	for i, m := range out.Movements {
+		resp.Movements[i] = toProtoInventoryMovement(m)
+	}
+
+	return connect.NewResponse(resp), nil
+}
+
+func (h *InventoryHandler) SetLowStockThreshold(
+	ctx context.Context,
+	req *connect.Request[productv1.SetLowStockThresholdRequest],
+) (*connect.Response[productv1.SetLowStockThresholdResponse], error) {
+	skuID, err := uuid.Parse(req.Msg.SkuId)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	if err := h.lowStockUC.SetThreshold(ctx, skuID, req.Msg.Threshold); err != nil {
+		return nil, toConnectError(err)
+	}
+	return connect.NewResponse(&productv1.SetLowStockThresholdResponse{}), nil
+}
+
+func (h *InventoryHandler) ListLowStockSKUs(
+	ctx context.Context,
+	req *connect.Request[productv1.ListLowStockSKUsRequest],
+) (*connect.Response[productv1.ListLowStockSKUsResponse], error) {
+	skus, nextPageToken, err := h.lowStockUC.ListLowStockSKUs(ctx, int(req.Msg.PageSize), req.Msg.PageToken)
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+
+	resp := &productv1.ListLowStockSKUsResponse{
+		Skus:          make([]*productv1.LowStockSKU, len(skus)),
+		NextPageToken: nextPageToken,
+	}
+	for i, s := range skus {
+		resp.Skus[i] = toProtoLowStockSKU(s)
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (h *InventoryHandler) ListReservations(
+	ctx context.Context,
+	req *connect.Request[productv1.ListReservationsRequest],
+) (*connect.Response[productv1.ListReservationsResponse], error) {
+	filter := domain.ReservationFilter{
+		Status: toDomainReservationStatus(req.Msg.Status),
+	}
+	if req.Msg.SkuId != "" {
+		skuID, err := uuid.Parse(req.Msg.SkuId)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		}
+		filter.SKUID = &skuID
+	}
+	if req.Msg.CreatedAfter != nil {
+		filter.CreatedAfter = req.Msg.CreatedAfter.AsTime()
+	}
+	if req.Msg.CreatedBefore != nil {
+		filter.CreatedBefore = req.Msg.CreatedBefore.AsTime()
+	}
+
+	out, err := h.inventoryUC.ListReservations(ctx, usecase.ListReservationsInput{
+		Filter:    filter,
+		PageSize:  int(req.Msg.PageSize),
+		PageToken: req.Msg.PageToken,
+	})
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+
+	resp := &productv1.ListReservationsResponse{
+		Reservations:  make([]*productv1.Reservation, len(out.Reservations)),
+		NextPageToken: out.NextPageToken,
+	}
+	for i, r := range out.Reservations {
+		resp.Reservations[i] = toProtoReservation(r)
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (h *InventoryHandler) ForceReleaseReservation(
+	ctx context.Context,
+	req *connect.Request[productv1.ForceReleaseReservationRequest],
+) (*connect.Response[productv1.ForceReleaseReservationResponse], error) {
+	reservationID, err := uuid.Parse(req.Msg.ReservationId)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	reservation, err := h.inventoryUC.ForceReleaseReservation(ctx, reservationID, req.Msg.Reason, pkgmw.GetUserID(ctx))
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+
+	return connect.NewResponse(&productv1.ForceReleaseReservationResponse{
+		Reservation: toProtoReservation(reservation),
+	}), nil
+}
+
+func (h *InventoryHandler) DrainSKUReservations(
+	ctx context.Context,
+	req *connect.Request[productv1.DrainSKUReservationsRequest],
+) (*connect.Response[productv1.DrainSKUReservationsResponse], error) {
+	skuID, err := uuid.Parse(req.Msg.SkuId)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	out, err := h.inventoryUC.DrainSKUReservations(ctx, skuID, req.Msg.Reason, pkgmw.GetUserID(ctx))
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+
+	resp := &productv1.DrainSKUReservationsResponse{
+		ReleasedReservationIds: make([]string, len(out.Released)),
+		Skipped:                int32(out.Skipped),
+		HasMore:                out.HasMore,
+	}
+	for i, id := range out.Released {
+		resp.ReleasedReservationIds[i] = id.String()
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func toReserveLocking(l productv1.ReservationLocking) usecase.ReserveLocking {
+	switch l {
+	case productv1.ReservationLocking_RESERVATION_LOCKING_OPTIMISTIC:
+		return usecase.ReserveLockingOptimistic
+	case productv1.ReservationLocking_RESERVATION_LOCKING_PESSIMISTIC:
+		return usecase.ReserveLockingPessimistic
+	default:
+		return usecase.ReserveLockingDefault
+	}
+}
+
+// toProtoInventoryUpdateError reports a failed bulk update item. err must
+// come from toConnectError or connect.NewError.
+func toProtoInventoryUpdateError(skuID string, err error) *productv1.InventoryUpdateResult {
+	result := &productv1.InventoryUpdateResult{
+		SkuId:     skuID,
+		ErrorCode: connect.CodeOf(err).String(),
+	}
+	var connectErr *connect.Error
+	if errors.As(err, &connectErr) {
+		result.ErrorMessage = connectErr.Message()
+	}
+	return result
+}
@@ -0,0 +1,346 @@
+package connect
+
+import (
+	"context"
+	"errors"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	productv1 "github.com/daisuke8000/example-ec-platform/gen/product/v1"
+	"github.com/daisuke8000/example-ec-platform/gen/product/v1/productv1connect"
+	"github.com/daisuke8000/example-ec-platform/services/product/internal/domain"
+	"github.com/daisuke8000/example-ec-platform/services/product/internal/usecase"
+)
+
+type PickupHandler struct {
+	productv1connect.UnimplementedPickupServiceHandler
+	pickupUC usecase.PickupUseCase
+}
+
+func NewPickupHandler(pickupUC usecase.PickupUseCase) *PickupHandler {
+	return &PickupHandler{pickupUC: pickupUC}
+}
+
+func (h *PickupHandler) CreatePickupLocation(
+	ctx context.Context,
+	req *connect.Request[productv1.CreatePickupLocationRequest],
+) (*connect.Response[productv1.CreatePickupLocationResponse], error) {
+	location, err := h.pickupUC.CreateLocation(ctx, req.Msg.Name, req.Msg.Address)
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+
+	return connect.NewResponse(&productv1.CreatePickupLocationResponse{
+		Location: toProtoPickupLocation(location),
+	}), nil
+}
+
+func (h *PickupHandler) SetPickupLocationActive(
+	ctx context.Context,
+	req *connect.Request[productv1.SetPickupLocationActiveRequest],
+) (*connect.Response[productv1.SetPickupLocationActiveResponse], error) {
+	locationID, err := uuid.Parse(req.Msg.LocationId)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	location, err := h.pickupUC.SetLocationActive(ctx, locationID, req.Msg.Active)
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+
+	return connect.NewResponse(&productv1.SetPickupLocationActiveResponse{
+		Location: toProtoPickupLocation(location),
+	}), nil
+}
+
+func (h *PickupHandler) ListPickupLocations(
+	ctx context.Context,
+	req *connect.Request[productv1.ListPickupLocationsRequest],
+) (*connect.Response[productv1.ListPickupLocationsResponse], error) {
+	locations, err := h.pickupUC.ListLocations(ctx, !req.Msg.IncludeInactive)
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+
+	resp := &productv1.ListPickupLocationsResponse{
+		Locations: make([]*productv1.PickupLocation, len(locations)),
+	}
+	for i, l := range locations {
+		resp.Locations[i] = toProtoPickupLocation(l)
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (h *PickupHandler) SetPickupStock(
+	ctx context.Context,
+	req *connect.Request[productv1.SetPickupStockRequest],
+) (*connect.Response[productv1.SetPickupStockResponse], error) {
+	locationID, err := uuid.Parse(req.Msg.LocationId)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	skuID, err := uuid.Parse(req.Msg.SkuId)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	if err := h.pickupUC.SetStock(ctx, locationID, skuID, req.Msg.Quantity); err != nil {
+		return nil, toConnectError(err)
+	}
+	return connect.NewResponse(&productv1.SetPickupStockResponse{}), nil
+}
+
+func (h *PickupHandler) CheckPickupAvailability(
+	ctx context.Context,
+	req *connect.Request[productv1.CheckPickupAvailabilityRequest],
+) (*connect.Response[productv1.CheckPickupAvailabilityResponse], error) {
+	items, err := toDomainPickupItems(req.Msg.Items)
+	if err != nil {
+		return nil, err
+	}
+
+	availability, err := h.pickupUC.CheckAvailability(ctx, items)
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+
+	resp := &productv1.CheckPickupAvailabilityResponse{
+		Locations: make([]*productv1.PickupAvailability, len(availability)),
+	}
+	for i, a := range availability {
+		resp.Locations[i] = &productv1.PickupAvailability{
+			Location:  toProtoPickupLocation(a.Location),
+			Available: a.Available,
+		}
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (h *PickupHandler) CreatePickupSlot(
+	ctx context.Context,
+	req *connect.Request[productv1.CreatePickupSlotRequest],
+) (*connect.Response[productv1.CreatePickupSlotResponse], error) {
+	locationID, err := uuid.Parse(req.Msg.LocationId)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	if req.Msg.StartsAt == nil || req.Msg.EndsAt == nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("starts_at and ends_at are required"))
+	}
+
+	slot, err := h.pickupUC.CreateSlot(ctx, locationID, req.Msg.StartsAt.AsTime(), req.Msg.EndsAt.AsTime(), req.Msg.Capacity)
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+
+	return connect.NewResponse(&productv1.CreatePickupSlotResponse{
+		Slot: toProtoPickupSlot(slot),
+	}), nil
+}
+
+func (h *PickupHandler) ListPickupSlots(
+	ctx context.Context,
+	req *connect.Request[productv1.ListPickupSlotsRequest],
+) (*connect.Response[productv1.ListPickupSlotsResponse], error) {
+	locationID, err := uuid.Parse(req.Msg.LocationId)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	if req.Msg.From == nil || req.Msg.To == nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("from and to are required"))
+	}
+
+	slots, err := h.pickupUC.ListSlots(ctx, locationID, req.Msg.From.AsTime(), req.Msg.To.AsTime())
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+
+	resp := &productv1.ListPickupSlotsResponse{
+		Slots: make([]*productv1.PickupSlot, len(slots)),
+	}
+	for i, s := range slots {
+		resp.Slots[i] = toProtoPickupSlot(s)
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (h *PickupHandler) ReservePickup(
+	ctx context.Context,
+	req *connect.Request[productv1.ReservePickupRequest],
+) (*connect.Response[productv1.ReservePickupResponse], error) {
+	orderID, err := uuid.Parse(req.Msg.OrderId)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	slotID, err := uuid.Parse(req.Msg.SlotId)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	items, err := toDomainPickupItems(req.Msg.Items)
+	if err != nil {
+		return nil, err
+	}
+
+	reserved, err := h.pickupUC.Reserve(ctx, orderID, slotID, items)
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+
+	return connect.NewResponse(&productv1.ReservePickupResponse{
+		Reservation: toProtoPickupReservation(reserved.Reservation),
+		Replayed:    reserved.Replayed,
+	}), nil
+}
+
+func (h *PickupHandler) GetPickupReservation(
+	ctx context.Context,
+	req *connect.Request[productv1.GetPickupReservationRequest],
+) (*connect.Response[productv1.GetPickupReservationResponse], error) {
+	orderID, err := uuid.Parse(req.Msg.OrderId)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	reservation, err := h.pickupUC.GetReservation(ctx, orderID)
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+
+	return connect.NewResponse(&productv1.GetPickupReservationResponse{
+		Reservation: toProtoPickupReservation(reservation),
+	}), nil
+}
+
+func (h *PickupHandler) MarkPickupReady(
+	ctx context.Context,
+	req *connect.Request[productv1.MarkPickupReadyRequest],
+) (*connect.Response[productv1.MarkPickupReadyResponse], error) {
+	orderID, err := uuid.Parse(req.Msg.OrderId)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	reservation, err := h.pickupUC.MarkReady(ctx, orderID)
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+
+	return connect.NewResponse(&productv1.MarkPickupReadyResponse{
+		Reservation: toProtoPickupReservation(reservation),
+	}), nil
+}
+
+func (h *PickupHandler) MarkPickupCollected(
+	ctx context.Context,
+	req *connect.Request[productv1.MarkPickupCollectedRequest],
+) (*connect.Response[productv1.MarkPickupCollectedResponse], error) {
+	orderID, err := uuid.Parse(req.Msg.OrderId)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	reservation, err := h.pickupUC.MarkCollected(ctx, orderID)
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+
+	return connect.NewResponse(&productv1.MarkPickupCollectedResponse{
+		Reservation: toProtoPickupReservation(reservation),
+	}), nil
+}
+
+func (h *PickupHandler) CancelPickup(
+	ctx context.Context,
+	req *connect.Request[productv1.CancelPickupRequest],
+) (*connect.Response[productv1.CancelPickupResponse], error) {
+	orderID, err := uuid.Parse(req.Msg.OrderId)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	reservation, err := h.pickupUC.Cancel(ctx, orderID)
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+
+	return connect.NewResponse(&productv1.CancelPickupResponse{
+		Reservation: toProtoPickupReservation(reservation),
+	}), nil
+}
+
+func toDomainPickupItems(items []*productv1.PickupItem) ([]domain.PickupItem, error) {
+	out := make([]domain.PickupItem, len(items))
+	for i, item := range items {
+		skuID, err := uuid.Parse(item.SkuId)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		}
+		out[i] = domain.PickupItem{SKUID: skuID, Quantity: item.Quantity}
+	}
+	return out, nil
+}
+
+func toProtoPickupLocation(l *domain.PickupLocation) *productv1.PickupLocation {
+	return &productv1.PickupLocation{
+		Id:        l.ID.String(),
+		Name:      l.Name,
+		Address:   l.Address,
+		Active:    l.Active,
+		CreatedAt: timestamppb.New(l.CreatedAt),
+		UpdatedAt: timestamppb.New(l.UpdatedAt),
+	}
+}
+
+func toProtoPickupSlot(s *domain.PickupSlot) *productv1.PickupSlot {
+	return &productv1.PickupSlot{
+		Id:         s.ID.String(),
+		LocationId: s.LocationID.String(),
+		StartsAt:   timestamppb.New(s.StartsAt),
+		EndsAt:     timestamppb.New(s.EndsAt),
+		Capacity:   s.Capacity,
+		Reserved:   s.Reserved,
+		Remaining:  s.Remaining(),
+	}
+}
+
+func toProtoPickupReservation(r *domain.PickupReservation) *productv1.PickupReservation {
+	pb := &productv1.PickupReservation{
+		OrderId:    r.OrderID.String(),
+		LocationId: r.LocationID.String(),
+		SlotId:     r.SlotID.String(),
+		Status:     toProtoPickupStatus(r.Status),
+		Items:      make([]*productv1.PickupItem, len(r.Items)),
+		CreatedAt:  timestamppb.New(r.CreatedAt),
+	}
+	for i, item := range r.Items {
+		pb.Items[i] = &productv1.PickupItem{SkuId: item.SKUID.String(), Quantity: item.Quantity}
+	}
+	if r.ReadyAt != nil {
+		pb.ReadyAt = timestamppb.New(*r.ReadyAt)
+	}
+	if r.CollectedAt != nil {
+		pb.CollectedAt = timestamppb.New(*r.CollectedAt)
+	}
+	if r.CancelledAt != nil {
+		pb.CancelledAt = timestamppb.New(*r.CancelledAt)
+	}
+	return pb
+}
+
+func toProtoPickupStatus(s domain.PickupStatus) productv1.PickupStatus {
+	switch s {
+	case domain.PickupReserved:
+		return productv1.PickupStatus_PICKUP_STATUS_RESERVED
+	case domain.PickupReady:
+		return productv1.PickupStatus_PICKUP_STATUS_READY
+	case domain.PickupCollected:
+		return productv1.PickupStatus_PICKUP_STATUS_COLLECTED
+	case domain.PickupCancelled:
+		return productv1.PickupStatus_PICKUP_STATUS_CANCELLED
+	default:
+		return productv1.PickupStatus_PICKUP_STATUS_UNSPECIFIED
+	}
+}
@@ -0,0 +1,168 @@
+package connect
+
+import (
+	"context"
+	"errors"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	productv1 "github.com/daisuke8000/example-ec-platform/gen/product/v1"
+	"github.com/daisuke8000/example-ec-platform/gen/product/v1/productv1connect"
+	"github.com/daisuke8000/example-ec-platform/services/product/internal/domain"
+	"github.com/daisuke8000/example-ec-platform/services/product/internal/usecase"
+)
+
+type PreorderHandler struct {
+	productv1connect.UnimplementedPreorderServiceHandler
+	preorderUC usecase.PreorderUseCase
+}
+
+func NewPreorderHandler(preorderUC usecase.PreorderUseCase) *PreorderHandler {
+	return &PreorderHandler{preorderUC: preorderUC}
+}
+
+func (h *PreorderHandler) SetPreorderCampaign(
+	ctx context.Context,
+	req *connect.Request[productv1.SetPreorderCampaignRequest],
+) (*connect.Response[productv1.SetPreorderCampaignResponse], error) {
+	skuID, err := uuid.Parse(req.Msg.SkuId)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	if req.Msg.StartsAt == nil || req.Msg.EndsAt == nil || req.Msg.ShipDate == nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("starts_at, ends_at and ship_date are required"))
+	}
+
+	campaign, err := h.preorderUC.SetCampaign(ctx, usecase.SetPreorderCampaignInput{
+		SKUID:         skuID,
+		StartsAt:      req.Msg.StartsAt.AsTime(),
+		EndsAt:        req.Msg.EndsAt.AsTime(),
+		ShipDate:      req.Msg.ShipDate.AsTime(),
+		AllocationCap: req.Msg.AllocationCap,
+	})
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+
+	return connect.NewResponse(&productv1.SetPreorderCampaignResponse{
+		Campaign: toProtoPreorderCampaign(campaign),
+	}), nil
+}
+
+func (h *PreorderHandler) GetPreorderCampaigns(
+	ctx context.Context,
+	req *connect.Request[productv1.GetPreorderCampaignsRequest],
+) (*connect.Response[productv1.GetPreorderCampaignsResponse], error) {
+	skuIDs := make([]uuid.UUID, len(req.Msg.SkuIds))
+	for i, id := range req.Msg.SkuIds {
+		skuID, err := uuid.Parse(id)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		}
+		skuIDs[i] = skuID
+	}
+
+	campaigns, err := h.preorderUC.GetCampaigns(ctx, skuIDs)
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+
+	resp := &productv1.GetPreorderCampaignsResponse{
+		Campaigns: make([]*productv1.PreorderCampaign, len(campaigns)),
+	}
+	for i, c := range campaigns {
+		resp.Campaigns[i] = toProtoPreorderCampaign(c)
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (h *PreorderHandler) AllocatePreorder(
+	ctx context.Context,
+	req *connect.Request[productv1.AllocatePreorderRequest],
+) (*connect.Response[productv1.AllocatePreorderResponse], error) {
+	orderID, err := uuid.Parse(req.Msg.OrderId)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	items := make([]domain.PreorderItem, len(req.Msg.Items))
+	for i, item := range req.Msg.Items {
+		skuID, err := uuid.Parse(item.SkuId)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		}
+		items[i] = domain.PreorderItem{SKUID: skuID, Quantity: item.Quantity}
+	}
+
+	allocated, err := h.preorderUC.AllocateOrder(ctx, orderID, items)
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+
+	return connect.NewResponse(&productv1.AllocatePreorderResponse{
+		Allocations: toProtoPreorderAllocations(allocated.Allocations),
+		Replayed:    allocated.Replayed,
+	}), nil
+}
+
+func (h *PreorderHandler) ReleasePreorder(
+	ctx context.Context,
+	req *connect.Request[productv1.ReleasePreorderRequest],
+) (*connect.Response[productv1.ReleasePreorderResponse], error) {
+	orderID, err := uuid.Parse(req.Msg.OrderId)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	released, err := h.preorderUC.ReleaseOrder(ctx, orderID)
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+
+	return connect.NewResponse(&productv1.ReleasePreorderResponse{
+		Allocations: toProtoPreorderAllocations(released),
+	}), nil
+}
+
+func toProtoPreorderCampaign(c *domain.PreorderCampaign) *productv1.PreorderCampaign {
+	return &productv1.PreorderCampaign{
+		SkuId:         c.SKUID.String(),
+		StartsAt:      timestamppb.New(c.StartsAt),
+		EndsAt:        timestamppb.New(c.EndsAt),
+		ShipDate:      timestamppb.New(c.ShipDate),
+		AllocationCap: c.AllocationCap,
+		Allocated:     c.Allocated,
+		Remaining:     c.Remaining(),
+		UpdatedAt:     timestamppb.New(c.UpdatedAt),
+	}
+}
+
+func toProtoPreorderAllocations(allocations []*domain.PreorderAllocation) []*productv1.PreorderAllocation {
+	out := make([]*productv1.PreorderAllocation, len(allocations))
+	for i, a := range allocations {
+		pb := &productv1.PreorderAllocation{
+			OrderId:   a.OrderID.String(),
+			SkuId:     a.SKUID.String(),
+			Quantity:  a.Quantity,
+			Status:    toProtoPreorderAllocationStatus(a.Status),
+			CreatedAt: timestamppb.New(a.CreatedAt),
+		}
+		if a.ReleasedAt != nil {
+			pb.ReleasedAt = timestamppb.New(*a.ReleasedAt)
+		}
+		out[i] = pb
+	}
+	return out
+}
+
+func toProtoPreorderAllocationStatus(s domain.PreorderAllocationStatus) productv1.PreorderAllocationStatus {
+	switch s {
+	case domain.PreorderAllocationAllocated:
+		return productv1.PreorderAllocationStatus_PREORDER_ALLOCATION_STATUS_ALLOCATED
+	case domain.PreorderAllocationReleased:
+		return productv1.PreorderAllocationStatus_PREORDER_ALLOCATION_STATUS_RELEASED
+	default:
+		return productv1.PreorderAllocationStatus_PREORDER_ALLOCATION_STATUS_UNSPECIFIED
+	}
+}
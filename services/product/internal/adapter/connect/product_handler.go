@@ -2,41 +2,76 @@ package connect
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"time"
 
 	"connectrpc.com/connect"
 	"github.com/google/uuid"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	productv1 "github.com/daisuke8000/example-ec-platform/gen/product/v1"
 	"github.com/daisuke8000/example-ec-platform/gen/product/v1/productv1connect"
+	pkgmw "github.com/daisuke8000/example-ec-platform/pkg/connect/middleware"
+	"github.com/daisuke8000/example-ec-platform/pkg/listing"
+	"github.com/daisuke8000/example-ec-platform/pkg/operations"
 	"github.com/daisuke8000/example-ec-platform/services/product/internal/domain"
 	"github.com/daisuke8000/example-ec-platform/services/product/internal/usecase"
 )
 
 type ProductHandler struct {
 	productv1connect.UnimplementedProductServiceHandler
-	productUC  usecase.ProductUseCase
-	skuUC      usecase.SKUUseCase
-	categoryUC usecase.CategoryUseCase
+	productUC   usecase.ProductUseCase
+	skuUC       usecase.SKUUseCase
+	categoryUC  usecase.CategoryUseCase
+	attributeUC usecase.AttributeDefinitionUseCase
+	imageUC     usecase.ProductImageUseCase
+	importUC    usecase.ProductImportUseCase
+	pageTokens  *listing.Codec
 }
 
 func NewProductHandler(
 	productUC usecase.ProductUseCase,
 	skuUC usecase.SKUUseCase,
 	categoryUC usecase.CategoryUseCase,
+	attributeUC usecase.AttributeDefinitionUseCase,
+	imageUC usecase.ProductImageUseCase,
+	importUC usecase.ProductImportUseCase,
+	pageTokens *listing.Codec,
 ) *ProductHandler {
 	return &ProductHandler{
-		productUC:  productUC,
-		skuUC:      skuUC,
-		categoryUC: categoryUC,
+		productUC:   productUC,
+		skuUC:       skuUC,
+		categoryUC:  categoryUC,
+		attributeUC: attributeUC,
+		imageUC:     imageUC,
+		importUC:    importUC,
+		pageTokens:  pageTokens,
 	}
 }
 
+var (
+	defaultProductSort = listing.Sort{{Field: "created_at", Desc: true}}
+
+	productFilterFields = listing.FilterFields{
+		"status":      {listing.OpEq},
+		"category_id": {listing.OpEq},
+		"created_at":  {listing.OpGe, listing.OpLt},
+	}
+)
+
+// productCursor is the page token payload for ListProducts.
+type productCursor struct {
+	Offset int32 `json:"o"`
+}
+
 func (h *ProductHandler) CreateProduct(
 	ctx context.Context,
 	req *connect.Request[productv1.CreateProductRequest],
 ) (*connect.Response[productv1.CreateProductResponse], error) {
 	input := usecase.CreateProductInput{
-		Name: req.Msg.Name,
+		Name:         req.Msg.Name,
+		ValidateOnly: req.Msg.ValidateOnly,
 	}
 	if req.Msg.Description != "" {
 		input.Description = &req.Msg.Description
@@ -68,16 +103,45 @@ func (h *ProductHandler) GetProduct(
 		return nil, connect.NewError(connect.CodeInvalidArgument, err)
 	}
 
-	product, err := h.productUC.GetProductWithSKUs(ctx, productID)
+	product, err := h.productUC.GetProductWithSKUs(ctx, productID, audienceFromContext(ctx))
 	if err != nil {
 		return nil, toConnectError(err)
 	}
 
 	return connect.NewResponse(&productv1.GetProductResponse{
-		Product: toProtoProductWithSKUs(product),
+		Product: toProtoProductWithSKUs(product, h.imageUC.ImageURL),
 	}), nil
 }
 
+func (h *ProductHandler) GetProductsByIDs(
+	ctx context.Context,
+	req *connect.Request[productv1.GetProductsByIDsRequest],
+) (*connect.Response[productv1.GetProductsByIDsResponse], error) {
+	ids, err := parseUUIDs(req.Msg.Ids)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	found, err := h.productUC.GetProductsByIDs(ctx, ids, audienceFromContext(ctx))
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+
+	resp := &productv1.GetProductsByIDsResponse{
+		Results: make([]*productv1.ProductLookup, len(ids)),
+	}
+	for i, id := range ids {
+		result := &productv1.ProductLookup{Id: req.Msg.Ids[i]}
+		if p, ok := found[id]; ok {
+			result.Found = true
+			result.Product = toProtoProduct(p)
+		}
+		resp.Results[i] = result
+	}
+
+	return connect.NewResponse(resp), nil
+}
+
 func (h *ProductHandler) UpdateProduct(
 	ctx context.Context,
 	req *connect.Request[productv1.UpdateProductRequest],
@@ -149,6 +213,19 @@ func (h *ProductHandler) ListProducts(
 		filter.Status = &status
 	}
 
+	sort, err := listing.ParseSort(req.Msg.OrderBy, domain.ProductSortFields, defaultProductSort)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	conditions, err := listing.ParseFilter(req.Msg.Filter, productFilterFields)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	if err := applyProductConditions(&filter, conditions); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	filter.Audience = audienceFromContext(ctx)
+
 	pageSize := req.Msg.PageSize
 	if pageSize <= 0 {
 		pageSize = 20
@@ -157,9 +234,25 @@ func (h *ProductHandler) ListProducts(
 		pageSize = 100
 	}
 
+	// Tokens are bound to the query so a page cannot be requested with
+	// different sorting or filters.
+	query := listing.QueryKey(sort, conditions,
+		req.Msg.GetCategoryId(), req.Msg.GetSearchQuery(), req.Msg.GetStatus().String(),
+		pkgmw.GetChannel(ctx), pkgmw.GetMarket(ctx))
+	var cursor productCursor
+	if req.Msg.PageToken != "" {
+		if err := h.pageTokens.Decode(req.Msg.PageToken, query, &cursor); err != nil {
+			return nil, toConnectError(domain.ErrInvalidPageToken)
+		}
+	}
+
 	pagination := domain.Pagination{
 		PageSize:  pageSize,
 		PageToken: req.Msg.PageToken,
+		Offset:    cursor.Offset,
+	}
+	for _, f := range sort {
+		pagination.Sort = append(pagination.Sort, domain.SortField{Field: f.Field, Desc: f.Desc})
 	}
 
 	products, total, err := h.productUC.ListProducts(ctx, filter, pagination)
@@ -174,9 +267,54 @@ func (h *ProductHandler) ListProducts(
 		resp.Products = append(resp.Products, toProtoProduct(p))
 	}
 
+	if next := cursor.Offset + int32(len(products)); len(products) == int(pageSize) && int64(next) < total {
+		resp.NextPageToken, err = h.pageTokens.Encode(query, productCursor{Offset: next})
+		if err != nil {
+			return nil, toConnectError(err)
+		}
+	}
+
 	return connect.NewResponse(resp), nil
 }
 
+// applyProductConditions merges filter expression conditions into filter.
+// A field may be set by either the typed request field or the expression.
+func applyProductConditions(filter *domain.ProductFilter, conditions listing.Filter) error {
+	for _, c := range conditions {
+		switch c.Field {
+		case "status":
+			if filter.Status != nil {
+				return fmt.Errorf("%w: status is already set", listing.ErrInvalidFilter)
+			}
+			status, err := domain.ParseProductStatus(c.Value)
+			if err != nil {
+				return fmt.Errorf("%w: unknown status %q", listing.ErrInvalidFilter, c.Value)
+			}
+			filter.Status = &status
+		case "category_id":
+			if filter.CategoryID != nil {
+				return fmt.Errorf("%w: category_id is already set", listing.ErrInvalidFilter)
+			}
+			id, err := uuid.Parse(c.Value)
+			if err != nil {
+				return fmt.Errorf("%w: invalid category_id %q", listing.ErrInvalidFilter, c.Value)
+			}
+			filter.CategoryID = &id
+		case "created_at":
+			t, err := time.Parse(time.RFC3339, c.Value)
+			if err != nil {
+				return fmt.Errorf("%w: created_at must be an RFC 3339 timestamp", listing.ErrInvalidFilter)
+			}
+			if c.Op == listing.OpGe {
+				filter.CreatedAfter = &t
+			} else {
+				filter.CreatedBefore = &t
+			}
+		}
+	}
+	return nil
+}
+
 func (h *ProductHandler) PublishProduct(
 	ctx context.Context,
 	req *connect.Request[productv1.PublishProductRequest],
@@ -249,6 +387,72 @@ func (h *ProductHandler) UnpublishProduct(
 	}), nil
 }
 
+func (h *ProductHandler) UpdateProductVisibility(
+	ctx context.Context,
+	req *connect.Request[productv1.UpdateProductVisibilityRequest],
+) (*connect.Response[productv1.UpdateProductVisibilityResponse], error) {
+	productID, err := uuid.Parse(req.Msg.Id)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	product, err := h.productUC.UpdateProductVisibility(ctx, productID, req.Msg.Channels, req.Msg.Markets)
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+
+	return connect.NewResponse(&productv1.UpdateProductVisibilityResponse{
+		Product: toProtoProduct(product),
+	}), nil
+}
+
+func (h *ProductHandler) ImportProducts(
+	ctx context.Context,
+	req *connect.Request[productv1.ImportProductsRequest],
+) (*connect.Response[productv1.ImportProductsResponse], error) {
+	var format usecase.ImportFormat
+	switch req.Msg.Format {
+	case productv1.ImportFormat_IMPORT_FORMAT_CSV:
+		format = usecase.ImportFormatCSV
+	case productv1.ImportFormat_IMPORT_FORMAT_NDJSON:
+		format = usecase.ImportFormatNDJSON
+	}
+
+	op, err := h.importUC.StartImport(ctx, usecase.ImportProductsInput{
+		Format:       format,
+		Data:         req.Msg.Data,
+		ValidateOnly: req.Msg.ValidateOnly,
+		Actor:        pkgmw.GetUserID(ctx),
+	})
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+
+	return connect.NewResponse(&productv1.ImportProductsResponse{
+		Operation: operations.ToProto(op),
+	}), nil
+}
+
+func (h *ProductHandler) GetProductImport(
+	ctx context.Context,
+	req *connect.Request[productv1.GetProductImportRequest],
+) (*connect.Response[productv1.GetProductImportResponse], error) {
+	id, err := uuid.Parse(req.Msg.OperationId)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	op, report, err := h.importUC.GetImport(ctx, id)
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+
+	return connect.NewResponse(&productv1.GetProductImportResponse{
+		Operation: operations.ToProto(op),
+		Report:    toProtoProductImportReport(report),
+	}), nil
+}
+
 func (h *ProductHandler) CreateSKU(
 	ctx context.Context,
 	req *connect.Request[productv1.CreateSKURequest],
@@ -265,6 +469,8 @@ func (h *ProductHandler) CreateSKU(
 		PriceCurrency:   req.Msg.Price.CurrencyCode,
 		Attributes:      req.Msg.Attributes,
 		InitialQuantity: req.Msg.InitialQuantity,
+		Prices:          fromProtoMoneys(req.Msg.AdditionalPrices),
+		ValidateOnly:    req.Msg.ValidateOnly,
 	}
 
 	sku, err := h.skuUC.CreateSKU(ctx, input)
@@ -277,6 +483,35 @@ func (h *ProductHandler) CreateSKU(
 	}), nil
 }
 
+func (h *ProductHandler) GenerateSKUs(
+	ctx context.Context,
+	req *connect.Request[productv1.GenerateSKUsRequest],
+) (*connect.Response[productv1.GenerateSKUsResponse], error) {
+	productID, err := uuid.Parse(req.Msg.ProductId)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	output, err := h.skuUC.GenerateSKUs(ctx, usecase.GenerateSKUsInput{
+		ProductID:       productID,
+		Matrix:          fromProtoSKUMatrix(req.Msg),
+		InitialQuantity: req.Msg.InitialQuantity,
+		ValidateOnly:    req.Msg.ValidateOnly,
+	})
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+
+	skus := make([]*productv1.SKU, len(output.SKUs))
+	for i, sku := range output.SKUs {
+		skus[i] = toProtoSKU(sku)
+	}
+	return connect.NewResponse(&productv1.GenerateSKUsResponse{
+		Skus:         skus,
+		SkippedCount: int32(output.Skipped),
+	}), nil
+}
+
 func (h *ProductHandler) GetSKU(
 	ctx context.Context,
 	req *connect.Request[productv1.GetSKURequest],
@@ -286,16 +521,49 @@ func (h *ProductHandler) GetSKU(
 		return nil, connect.NewError(connect.CodeInvalidArgument, err)
 	}
 
-	sku, err := h.skuUC.GetSKUWithInventory(ctx, skuID)
+	sku, err := h.skuUC.GetSKUWithInventory(ctx, skuID, req.Msg.PreferredCurrency)
 	if err != nil {
 		return nil, toConnectError(err)
 	}
 
+	pb := toProtoSKUWithInventory(sku)
+	if req.Msg.PreferredCurrency != "" {
+		preferCurrency(pb, req.Msg.PreferredCurrency)
+	}
 	return connect.NewResponse(&productv1.GetSKUResponse{
-		Sku: toProtoSKUWithInventory(sku),
+		Sku: pb,
 	}), nil
 }
 
+func (h *ProductHandler) GetSKUsByIDs(
+	ctx context.Context,
+	req *connect.Request[productv1.GetSKUsByIDsRequest],
+) (*connect.Response[productv1.GetSKUsByIDsResponse], error) {
+	ids, err := parseUUIDs(req.Msg.Ids)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	found, err := h.skuUC.GetSKUsByIDs(ctx, ids)
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+
+	resp := &productv1.GetSKUsByIDsResponse{
+		Results: make([]*productv1.SKULookup, len(ids)),
+	}
+	for i, id := range ids {
+		result := &productv1.SKULookup{Id: req.Msg.Ids[i]}
+		if s, ok := found[id]; ok {
+			result.Found = true
+			result.Sku = toProtoSKUWithInventory(s)
+		}
+		resp.Results[i] = result
+	}
+
+	return connect.NewResponse(resp), nil
+}
+
 func (h *ProductHandler) UpdateSKU(
 	ctx context.Context,
 	req *connect.Request[productv1.UpdateSKURequest],
@@ -306,7 +574,9 @@ func (h *ProductHandler) UpdateSKU(
 	}
 
 	input := usecase.UpdateSKUInput{
-		Attributes: req.Msg.Attributes,
+		Attributes:   req.Msg.Attributes,
+		Actor:        pkgmw.GetUserID(ctx),
+		ValidateOnly: req.Msg.ValidateOnly,
 	}
 	if req.Msg.SkuCode != nil {
 		input.SKUCode = req.Msg.SkuCode
@@ -315,6 +585,10 @@ func (h *ProductHandler) UpdateSKU(
 		input.PriceAmount = &req.Msg.Price.Amount
 		input.PriceCurrency = &req.Msg.Price.CurrencyCode
 	}
+	if req.Msg.AdditionalPrices != nil {
+		prices := fromProtoMoneys(req.Msg.AdditionalPrices.Values)
+		input.Prices = &prices
+	}
 
 	sku, err := h.skuUC.UpdateSKU(ctx, skuID, input)
 	if err != nil {
@@ -343,6 +617,185 @@ func (h *ProductHandler) DeleteSKU(
 	return connect.NewResponse(&productv1.DeleteSKUResponse{}), nil
 }
 
+func (h *ProductHandler) SchedulePriceChange(
+	ctx context.Context,
+	req *connect.Request[productv1.SchedulePriceChangeRequest],
+) (*connect.Response[productv1.SchedulePriceChangeResponse], error) {
+	skuID, err := uuid.Parse(req.Msg.SkuId)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	if req.Msg.Price == nil || req.Msg.EffectiveFrom == nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("price and effective_from are required"))
+	}
+
+	change, err := h.skuUC.SchedulePriceChange(ctx, usecase.SchedulePriceChangeInput{
+		SKUID:         skuID,
+		Price:         domain.Money{Amount: req.Msg.Price.Amount, Currency: req.Msg.Price.CurrencyCode},
+		EffectiveFrom: req.Msg.EffectiveFrom.AsTime(),
+		Actor:         pkgmw.GetUserID(ctx),
+	})
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+
+	return connect.NewResponse(&productv1.SchedulePriceChangeResponse{
+		PriceChange: toProtoPriceChange(change),
+	}), nil
+}
+
+func (h *ProductHandler) GetPriceHistory(
+	ctx context.Context,
+	req *connect.Request[productv1.GetPriceHistoryRequest],
+) (*connect.Response[productv1.GetPriceHistoryResponse], error) {
+	skuID, err := uuid.Parse(req.Msg.SkuId)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	// Tokens are bound to the SKU so they cannot be replayed for another.
+	query := listing.QueryKey(nil, nil, skuID.String())
+	input := usecase.GetPriceHistoryInput{
+		SKUID:    skuID,
+		PageSize: int(req.Msg.PageSize),
+	}
+	if req.Msg.PageToken != "" {
+		var cursor domain.PriceChangeCursor
+		if err := h.pageTokens.Decode(req.Msg.PageToken, query, &cursor); err != nil {
+			return nil, toConnectError(domain.ErrInvalidPageToken)
+		}
+		input.After = &cursor
+	}
+
+	out, err := h.skuUC.GetPriceHistory(ctx, input)
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+
+	resp := &productv1.GetPriceHistoryResponse{
+		PriceChanges: make([]*productv1.PriceChange, len(out.Changes)),
+	}
+	for i, c := range out.Changes {
+		resp.PriceChanges[i] = toProtoPriceChange(c)
+	}
+	if out.Next != nil {
+		resp.NextPageToken, err = h.pageTokens.Encode(query, out.Next)
+		if err != nil {
+			return nil, toConnectError(err)
+		}
+	}
+
+	return connect.NewResponse(resp), nil
+}
+
+func (h *ProductHandler) CreateProductImageUpload(
+	ctx context.Context,
+	req *connect.Request[productv1.CreateProductImageUploadRequest],
+) (*connect.Response[productv1.CreateProductImageUploadResponse], error) {
+	productID, err := uuid.Parse(req.Msg.ProductId)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	upload, err := h.imageUC.CreateImageUpload(ctx, usecase.CreateImageUploadInput{
+		ProductID:   productID,
+		ContentType: req.Msg.ContentType,
+		AltText:     req.Msg.AltText,
+	})
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+
+	return connect.NewResponse(&productv1.CreateProductImageUploadResponse{
+		Image:           toProtoProductImage(upload.Image, h.imageUC.ImageURL(upload.Image)),
+		UploadUrl:       upload.UploadURL,
+		UploadExpiresAt: timestamppb.New(upload.ExpiresAt),
+	}), nil
+}
+
+func (h *ProductHandler) CompleteProductImageUpload(
+	ctx context.Context,
+	req *connect.Request[productv1.CompleteProductImageUploadRequest],
+) (*connect.Response[productv1.CompleteProductImageUploadResponse], error) {
+	imageID, err := uuid.Parse(req.Msg.Id)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	image, err := h.imageUC.CompleteImageUpload(ctx, imageID)
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+
+	return connect.NewResponse(&productv1.CompleteProductImageUploadResponse{
+		Image: toProtoProductImage(image, h.imageUC.ImageURL(image)),
+	}), nil
+}
+
+func (h *ProductHandler) UpdateProductImage(
+	ctx context.Context,
+	req *connect.Request[productv1.UpdateProductImageRequest],
+) (*connect.Response[productv1.UpdateProductImageResponse], error) {
+	imageID, err := uuid.Parse(req.Msg.Id)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	image, err := h.imageUC.UpdateImage(ctx, imageID, usecase.UpdateImageInput{
+		AltText: req.Msg.AltText,
+	})
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+
+	return connect.NewResponse(&productv1.UpdateProductImageResponse{
+		Image: toProtoProductImage(image, h.imageUC.ImageURL(image)),
+	}), nil
+}
+
+func (h *ProductHandler) ReorderProductImages(
+	ctx context.Context,
+	req *connect.Request[productv1.ReorderProductImagesRequest],
+) (*connect.Response[productv1.ReorderProductImagesResponse], error) {
+	productID, err := uuid.Parse(req.Msg.ProductId)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	imageIDs, err := parseUUIDs(req.Msg.ImageIds)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	images, err := h.imageUC.ReorderImages(ctx, productID, imageIDs)
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+
+	resp := &productv1.ReorderProductImagesResponse{
+		Images: make([]*productv1.ProductImage, len(images)),
+	}
+	for i, img := range images {
+		resp.Images[i] = toProtoProductImage(img, h.imageUC.ImageURL(img))
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (h *ProductHandler) DeleteProductImage(
+	ctx context.Context,
+	req *connect.Request[productv1.DeleteProductImageRequest],
+) (*connect.Response[productv1.DeleteProductImageResponse], error) {
+	imageID, err := uuid.Parse(req.Msg.Id)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	if err := h.imageUC.DeleteImage(ctx, imageID); err != nil {
+		return nil, toConnectError(err)
+	}
+
+	return connect.NewResponse(&productv1.DeleteProductImageResponse{}), nil
+}
+
 func (h *ProductHandler) CreateCategory(
 	ctx context.Context,
 	req *connect.Request[productv1.CreateCategoryRequest],
@@ -404,6 +857,40 @@ func (h *ProductHandler) ListCategories(
 	return connect.NewResponse(resp), nil
 }
 
+func (h *ProductHandler) GetCategoryTree(
+	ctx context.Context,
+	req *connect.Request[productv1.GetCategoryTreeRequest],
+) (*connect.Response[productv1.GetCategoryTreeResponse], error) {
+	var rootID *uuid.UUID
+	if req.Msg.RootId != nil {
+		id, err := uuid.Parse(*req.Msg.RootId)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		}
+		rootID = &id
+	}
+	maxDepth := -1
+	if req.Msg.MaxDepth != nil {
+		if *req.Msg.MaxDepth < 0 {
+			return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("max_depth must not be negative"))
+		}
+		maxDepth = int(*req.Msg.MaxDepth)
+	}
+
+	nodes, err := h.categoryUC.GetCategoryTree(ctx, rootID, maxDepth)
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+
+	resp := &productv1.GetCategoryTreeResponse{
+		Nodes: make([]*productv1.CategoryTreeNode, len(nodes)),
+	}
+	for i, n := range nodes {
+		resp.Nodes[i] = toProtoCategoryTreeNode(n)
+	}
+	return connect.NewResponse(resp), nil
+}
+
 func (h *ProductHandler) UpdateCategory(
 	ctx context.Context,
 	req *connect.Request[productv1.UpdateCategoryRequest],
@@ -452,6 +939,91 @@ func (h *ProductHandler) DeleteCategory(
 	return connect.NewResponse(&productv1.DeleteCategoryResponse{}), nil
 }
 
+func (h *ProductHandler) CreateAttributeDefinition(
+	ctx context.Context,
+	req *connect.Request[productv1.CreateAttributeDefinitionRequest],
+) (*connect.Response[productv1.CreateAttributeDefinitionResponse], error) {
+	categoryID, err := uuid.Parse(req.Msg.CategoryId)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	def, err := h.attributeUC.CreateAttributeDefinition(ctx, usecase.CreateAttributeDefinitionInput{
+		CategoryID:    categoryID,
+		Name:          req.Msg.Name,
+		Type:          toDomainAttributeType(req.Msg.Type),
+		AllowedValues: req.Msg.AllowedValues,
+		Required:      req.Msg.Required,
+	})
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+
+	return connect.NewResponse(&productv1.CreateAttributeDefinitionResponse{
+		AttributeDefinition: toProtoAttributeDefinition(def),
+	}), nil
+}
+
+func (h *ProductHandler) ListAttributeDefinitions(
+	ctx context.Context,
+	req *connect.Request[productv1.ListAttributeDefinitionsRequest],
+) (*connect.Response[productv1.ListAttributeDefinitionsResponse], error) {
+	categoryID, err := uuid.Parse(req.Msg.CategoryId)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	defs, err := h.attributeUC.ListAttributeDefinitions(ctx, categoryID)
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+
+	resp := &productv1.ListAttributeDefinitionsResponse{}
+	for _, d := range defs {
+		resp.AttributeDefinitions = append(resp.AttributeDefinitions, toProtoAttributeDefinition(d))
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (h *ProductHandler) UpdateAttributeDefinition(
+	ctx context.Context,
+	req *connect.Request[productv1.UpdateAttributeDefinitionRequest],
+) (*connect.Response[productv1.UpdateAttributeDefinitionResponse], error) {
+	id, err := uuid.Parse(req.Msg.Id)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	def, err := h.attributeUC.UpdateAttributeDefinition(ctx, id, usecase.UpdateAttributeDefinitionInput{
+		Type:          toDomainAttributeType(req.Msg.Type),
+		AllowedValues: req.Msg.AllowedValues,
+		Required:      req.Msg.Required,
+	})
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+
+	return connect.NewResponse(&productv1.UpdateAttributeDefinitionResponse{
+		AttributeDefinition: toProtoAttributeDefinition(def),
+	}), nil
+}
+
+func (h *ProductHandler) DeleteAttributeDefinition(
+	ctx context.Context,
+	req *connect.Request[productv1.DeleteAttributeDefinitionRequest],
+) (*connect.Response[productv1.DeleteAttributeDefinitionResponse], error) {
+	id, err := uuid.Parse(req.Msg.Id)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	if err := h.attributeUC.DeleteAttributeDefinition(ctx, id); err != nil {
+		return nil, toConnectError(err)
+	}
+
+	return connect.NewResponse(&productv1.DeleteAttributeDefinitionResponse{}), nil
+}
+
 func toDomainProductStatus(s productv1.ProductStatus) domain.ProductStatus {
 	switch s {
 	case productv1.ProductStatus_PRODUCT_STATUS_DRAFT:
@@ -464,3 +1036,26 @@ func toDomainProductStatus(s productv1.ProductStatus) domain.ProductStatus {
 		return domain.ProductStatusDraft
 	}
 }
+
+// audienceFromContext returns the audience of a public read, propagated by
+// the BFF. Calls without a channel (internal and admin tools) see every
+// product.
+func audienceFromContext(ctx context.Context) *domain.Audience {
+	channel := pkgmw.GetChannel(ctx)
+	if channel == "" {
+		return nil
+	}
+	return &domain.Audience{Channel: channel, Market: pkgmw.GetMarket(ctx)}
+}
+
+func parseUUIDs(ids []string) ([]uuid.UUID, error) {
+	parsed := make([]uuid.UUID, len(ids))
+	for i, id := range ids {
+		u, err := uuid.Parse(id)
+		if err != nil {
+			return nil, err
+		}
+		parsed[i] = u
+	}
+	return parsed, nil
+}
@@ -0,0 +1,139 @@
+package connect
+
+import (
+	"context"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+
+	productv1 "github.com/daisuke8000/example-ec-platform/gen/product/v1"
+	"github.com/daisuke8000/example-ec-platform/gen/product/v1/productv1connect"
+	"github.com/daisuke8000/example-ec-platform/services/product/internal/domain"
+	"github.com/daisuke8000/example-ec-platform/services/product/internal/usecase"
+)
+
+type WarehouseSyncHandler struct {
+	productv1connect.UnimplementedWarehouseSyncServiceHandler
+	syncUC usecase.WarehouseSyncUseCase
+}
+
+func NewWarehouseSyncHandler(syncUC usecase.WarehouseSyncUseCase) *WarehouseSyncHandler {
+	return &WarehouseSyncHandler{syncUC: syncUC}
+}
+
+func (h *WarehouseSyncHandler) SetExternalSKUMappings(
+	ctx context.Context,
+	req *connect.Request[productv1.SetExternalSKUMappingsRequest],
+) (*connect.Response[productv1.SetExternalSKUMappingsResponse], error) {
+	mappings := make([]usecase.ExternalSKU, len(req.Msg.Mappings))
+	for i, m := range req.Msg.Mappings {
+		skuID, err := uuid.Parse(m.SkuId)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		}
+		mappings[i] = usecase.ExternalSKU{ExternalSKU: m.ExternalSku, SKUID: skuID}
+	}
+
+	saved, err := h.syncUC.SetMappings(ctx, req.Msg.Provider, mappings)
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+
+	resp := &productv1.SetExternalSKUMappingsResponse{
+		Mappings: make([]*productv1.ExternalSKUMapping, len(saved)),
+	}
+	for i, m := range saved {
+		resp.Mappings[i] = toProtoExternalSKUMapping(m)
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (h *WarehouseSyncHandler) ListExternalSKUMappings(
+	ctx context.Context,
+	req *connect.Request[productv1.ListExternalSKUMappingsRequest],
+) (*connect.Response[productv1.ListExternalSKUMappingsResponse], error) {
+	mappings, nextPageToken, err := h.syncUC.ListMappings(ctx, req.Msg.Provider, int(req.Msg.PageSize), req.Msg.PageToken)
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+
+	resp := &productv1.ListExternalSKUMappingsResponse{
+		Mappings:      make([]*productv1.ExternalSKUMapping, len(mappings)),
+		NextPageToken: nextPageToken,
+	}
+	for i, m := range mappings {
+		resp.Mappings[i] = toProtoExternalSKUMapping(m)
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (h *WarehouseSyncHandler) DeleteExternalSKUMapping(
+	ctx context.Context,
+	req *connect.Request[productv1.DeleteExternalSKUMappingRequest],
+) (*connect.Response[productv1.DeleteExternalSKUMappingResponse], error) {
+	if err := h.syncUC.DeleteMapping(ctx, req.Msg.Provider, req.Msg.ExternalSku); err != nil {
+		return nil, toConnectError(err)
+	}
+	return connect.NewResponse(&productv1.DeleteExternalSKUMappingResponse{}), nil
+}
+
+func (h *WarehouseSyncHandler) ListStockChanges(
+	ctx context.Context,
+	req *connect.Request[productv1.ListStockChangesRequest],
+) (*connect.Response[productv1.ListStockChangesResponse], error) {
+	out, err := h.syncUC.ListStockChanges(ctx, usecase.ListStockChangesInput{
+		Provider: req.Msg.Provider,
+		Cursor:   req.Msg.Cursor,
+		PageSize: int(req.Msg.PageSize),
+	})
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+
+	resp := &productv1.ListStockChangesResponse{
+		Changes:    make([]*productv1.StockChange, len(out.Changes)),
+		NextCursor: out.NextCursor,
+	}
+	for i, c := range out.Changes {
+		resp.Changes[i] = &productv1.StockChange{
+			Movement:    toProtoInventoryMovement(c.Movement),
+			ExternalSku: c.ExternalSKU,
+		}
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (h *WarehouseSyncHandler) PushWarehouseAdjustments(
+	ctx context.Context,
+	req *connect.Request[productv1.PushWarehouseAdjustmentsRequest],
+) (*connect.Response[productv1.PushWarehouseAdjustmentsResponse], error) {
+	adjustments := make([]domain.WarehouseAdjustment, len(req.Msg.Adjustments))
+	for i, a := range req.Msg.Adjustments {
+		adjustments[i] = domain.WarehouseAdjustment{
+			ExternalSKU:   a.ExternalSku,
+			QuantityDelta: a.QuantityDelta,
+		}
+	}
+
+	applied, err := h.syncUC.PushAdjustments(ctx, usecase.PushAdjustmentsInput{
+		Provider:       req.Msg.Provider,
+		IdempotencyKey: req.Msg.IdempotencyKey,
+		Adjustments:    adjustments,
+	})
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+
+	return connect.NewResponse(&productv1.PushWarehouseAdjustmentsResponse{
+		Replayed:     applied.Replayed,
+		AppliedCount: int32(applied.Count),
+	}), nil
+}
+
+func toProtoExternalSKUMapping(m *domain.ExternalSKUMapping) *productv1.ExternalSKUMapping {
+	return &productv1.ExternalSKUMapping{
+		Provider:    m.Provider,
+		ExternalSku: m.ExternalSKU,
+		SkuId:       m.SKUID.String(),
+	}
+}
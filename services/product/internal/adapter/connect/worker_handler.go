@@ -0,0 +1,78 @@
+package connect
+
+import (
+	"context"
+
+	"connectrpc.com/connect"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	productv1 "github.com/daisuke8000/example-ec-platform/gen/product/v1"
+	"github.com/daisuke8000/example-ec-platform/gen/product/v1/productv1connect"
+	pkgmw "github.com/daisuke8000/example-ec-platform/pkg/connect/middleware"
+	"github.com/daisuke8000/example-ec-platform/services/product/internal/domain"
+	"github.com/daisuke8000/example-ec-platform/services/product/internal/usecase"
+)
+
+type WorkerHandler struct {
+	productv1connect.UnimplementedWorkerServiceHandler
+	workerUC usecase.WorkerUseCase
+}
+
+func NewWorkerHandler(workerUC usecase.WorkerUseCase) *WorkerHandler {
+	return &WorkerHandler{workerUC: workerUC}
+}
+
+func (h *WorkerHandler) ListWorkers(
+	ctx context.Context,
+	_ *connect.Request[productv1.ListWorkersRequest],
+) (*connect.Response[productv1.ListWorkersResponse], error) {
+	workers, err := h.workerUC.ListWorkers(ctx)
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+
+	resp := &productv1.ListWorkersResponse{Workers: make([]*productv1.Worker, len(workers))}
+	for i, w := range workers {
+		resp.Workers[i] = toProtoWorker(w)
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (h *WorkerHandler) PauseWorker(
+	ctx context.Context,
+	req *connect.Request[productv1.PauseWorkerRequest],
+) (*connect.Response[productv1.PauseWorkerResponse], error) {
+	worker, err := h.workerUC.PauseWorker(ctx, req.Msg.Name, req.Msg.Reason, pkgmw.GetUserID(ctx))
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+
+	return connect.NewResponse(&productv1.PauseWorkerResponse{
+		Worker: toProtoWorker(worker),
+	}), nil
+}
+
+func (h *WorkerHandler) ResumeWorker(
+	ctx context.Context,
+	req *connect.Request[productv1.ResumeWorkerRequest],
+) (*connect.Response[productv1.ResumeWorkerResponse], error) {
+	worker, err := h.workerUC.ResumeWorker(ctx, req.Msg.Name)
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+
+	return connect.NewResponse(&productv1.ResumeWorkerResponse{
+		Worker: toProtoWorker(worker),
+	}), nil
+}
+
+func toProtoWorker(w *domain.WorkerStatus) *productv1.Worker {
+	pw := &productv1.Worker{Name: w.Name}
+	if w.Pause != nil {
+		pw.Paused = true
+		pw.PauseReason = w.Pause.Reason
+		pw.PausedBy = w.Pause.PausedBy
+		pw.PausedAt = timestamppb.New(w.Pause.PausedAt)
+	}
+	return pw
+}
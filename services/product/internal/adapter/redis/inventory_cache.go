@@ -0,0 +1,83 @@
+package redis
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+
+	"github.com/daisuke8000/example-ec-platform/services/product/internal/domain"
+)
+
+// InventoryCache caches inventory levels per SKU with a short TTL.
+// Entries are invalidated by the usecases that mutate stock; the TTL bounds
+// staleness from races between a read-through fill and a concurrent write.
+type InventoryCache struct {
+	client *redis.Client
+	prefix string
+	ttl    time.Duration
+}
+
+func NewInventoryCache(client *redis.Client, prefix string, ttl time.Duration) *InventoryCache {
+	if prefix == "" {
+		prefix = "product:inventory:"
+	}
+	return &InventoryCache{
+		client: client,
+		prefix: prefix,
+		ttl:    ttl,
+	}
+}
+
+type cachedInventory struct {
+	Quantity int64 `json:"q"`
+	Reserved int64 `json:"r"`
+	Version  int64 `json:"v"`
+}
+
+func (c *InventoryCache) Get(ctx context.Context, skuID uuid.UUID) (*domain.Inventory, error) {
+	val, err := c.client.Get(ctx, c.prefix+skuID.String()).Bytes()
+	if errors.Is(err, redis.Nil) {
+		return nil, ErrKeyNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var cached cachedInventory
+	if err := json.Unmarshal(val, &cached); err != nil {
+		return nil, err
+	}
+	return &domain.Inventory{
+		SKUID:    skuID,
+		Quantity: cached.Quantity,
+		Reserved: cached.Reserved,
+		Version:  cached.Version,
+	}, nil
+}
+
+func (c *InventoryCache) Set(ctx context.Context, inventory *domain.Inventory) error {
+	val, err := json.Marshal(cachedInventory{
+		Quantity: inventory.Quantity,
+		Reserved: inventory.Reserved,
+		Version:  inventory.Version,
+	})
+	if err != nil {
+		return err
+	}
+	return c.client.Set(ctx, c.prefix+inventory.SKUID.String(), val, c.ttl).Err()
+}
+
+func (c *InventoryCache) Invalidate(ctx context.Context, skuIDs ...uuid.UUID) error {
+	if len(skuIDs) == 0 {
+		return nil
+	}
+	keys := make([]string, len(skuIDs))
+	for i, id := range skuIDs {
+		keys[i] = c.prefix + id.String()
+	}
+	return c.client.Del(ctx, keys...).Err()
+}
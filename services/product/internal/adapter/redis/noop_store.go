@@ -3,6 +3,10 @@ package redis
 import (
 	"context"
 	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/daisuke8000/example-ec-platform/services/product/internal/domain"
 )
 
 // NoopIdempotencyStore provides no idempotency guarantees. Use only when Redis is unavailable.
@@ -27,3 +31,22 @@ func (s *NoopIdempotencyStore) Set(ctx context.Context, key string, value string
 func (s *NoopIdempotencyStore) Del(ctx context.Context, key string) error {
 	return nil
 }
+
+// NoopInventoryCache never caches. Used when the inventory cache is disabled.
+type NoopInventoryCache struct{}
+
+func NewNoopInventoryCache() *NoopInventoryCache {
+	return &NoopInventoryCache{}
+}
+
+func (c *NoopInventoryCache) Get(ctx context.Context, skuID uuid.UUID) (*domain.Inventory, error) {
+	return nil, ErrKeyNotFound
+}
+
+func (c *NoopInventoryCache) Set(ctx context.Context, inventory *domain.Inventory) error {
+	return nil
+}
+
+func (c *NoopInventoryCache) Invalidate(ctx context.Context, skuIDs ...uuid.UUID) error {
+	return nil
+}
@@ -0,0 +1,127 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/daisuke8000/example-ec-platform/services/product/internal/domain"
+)
+
+const attributeDefinitionColumns = `id, category_id, name, type, allowed_values, required, created_at, updated_at`
+
+type PostgresAttributeDefinitionRepository struct {
+	pool *pgxpool.Pool
+}
+
+func NewPostgresAttributeDefinitionRepository(pool *pgxpool.Pool) *PostgresAttributeDefinitionRepository {
+	return &PostgresAttributeDefinitionRepository{pool: pool}
+}
+
+func (r *PostgresAttributeDefinitionRepository) Create(ctx context.Context, def *domain.AttributeDefinition) error {
+	_, err := r.pool.Exec(ctx, `
+		INSERT INTO product_service.attribute_definitions (`+attributeDefinitionColumns+`)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+	`,
+		def.ID,
+		def.CategoryID,
+		def.Name,
+		def.Type,
+		def.AllowedValues,
+		def.Required,
+		def.CreatedAt,
+		def.UpdatedAt,
+	)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == pgUniqueViolation {
+			return domain.ErrAttributeDefinitionExists
+		}
+		return err
+	}
+	return nil
+}
+
+func (r *PostgresAttributeDefinitionRepository) FindByID(ctx context.Context, id uuid.UUID) (*domain.AttributeDefinition, error) {
+	def, err := scanAttributeDefinition(r.pool.QueryRow(ctx, `
+		SELECT `+attributeDefinitionColumns+`
+		FROM product_service.attribute_definitions
+		WHERE id = $1
+	`, id))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, domain.ErrAttributeDefinitionNotFound
+	}
+	return def, err
+}
+
+func (r *PostgresAttributeDefinitionRepository) FindByCategoryID(ctx context.Context, categoryID uuid.UUID) ([]*domain.AttributeDefinition, error) {
+	rows, err := r.pool.Query(ctx, `
+		SELECT `+attributeDefinitionColumns+`
+		FROM product_service.attribute_definitions
+		WHERE category_id = $1
+		ORDER BY name
+	`, categoryID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var defs []*domain.AttributeDefinition
+	for rows.Next() {
+		def, err := scanAttributeDefinition(rows)
+		if err != nil {
+			return nil, err
+		}
+		defs = append(defs, def)
+	}
+	return defs, rows.Err()
+}
+
+func (r *PostgresAttributeDefinitionRepository) Update(ctx context.Context, def *domain.AttributeDefinition) error {
+	result, err := r.pool.Exec(ctx, `
+		UPDATE product_service.attribute_definitions
+		SET type = $2, allowed_values = $3, required = $4, updated_at = $5
+		WHERE id = $1
+	`, def.ID, def.Type, def.AllowedValues, def.Required, def.UpdatedAt)
+	if err != nil {
+		return err
+	}
+	if result.RowsAffected() == 0 {
+		return domain.ErrAttributeDefinitionNotFound
+	}
+	return nil
+}
+
+func (r *PostgresAttributeDefinitionRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	result, err := r.pool.Exec(ctx, `
+		DELETE FROM product_service.attribute_definitions WHERE id = $1
+	`, id)
+	if err != nil {
+		return err
+	}
+	if result.RowsAffected() == 0 {
+		return domain.ErrAttributeDefinitionNotFound
+	}
+	return nil
+}
+
+func scanAttributeDefinition(row pgx.Row) (*domain.AttributeDefinition, error) {
+	var def domain.AttributeDefinition
+	if err := row.Scan(
+		&def.ID,
+		&def.CategoryID,
+		&def.Name,
+		&def.Type,
+		&def.AllowedValues,
+		&def.Required,
+		&def.CreatedAt,
+		&def.UpdatedAt,
+	); err != nil {
+		return nil, err
+	}
+	return &def, nil
+}
@@ -12,12 +12,15 @@ import (
 	"github.com/daisuke8000/example-ec-platform/services/product/internal/domain"
 )
 
+// PostgresCategoryRepository reads the category tree through reads, which
+// may be a read replica; everything else uses the primary pool.
 type PostgresCategoryRepository struct {
-	pool *pgxpool.Pool
+	pool  *pgxpool.Pool
+	reads *ReadRouter
 }
 
-func NewPostgresCategoryRepository(pool *pgxpool.Pool) *PostgresCategoryRepository {
-	return &PostgresCategoryRepository{pool: pool}
+func NewPostgresCategoryRepository(pool *pgxpool.Pool, reads *ReadRouter) *PostgresCategoryRepository {
+	return &PostgresCategoryRepository{pool: pool, reads: reads}
 }
 
 func (r *PostgresCategoryRepository) Create(ctx context.Context, category *domain.Category) error {
@@ -91,6 +94,101 @@ func (r *PostgresCategoryRepository) FindAll(ctx context.Context) ([]*domain.Cat
 	return r.scanCategories(rows)
 }
 
+// FindTree walks the hierarchy with a recursive CTE. The path array guards
+// against cycles in existing data, and the published-product counts are
+// summed over the full subtree before nodes below maxDepth are dropped.
+func (r *PostgresCategoryRepository) FindTree(ctx context.Context, rootID *uuid.UUID, maxDepth int) ([]*domain.CategoryNode, error) {
+	query := `
+		WITH RECURSIVE tree AS (
+			SELECT id, 0 AS depth, ARRAY[id] AS path
+			FROM product_service.categories
+			WHERE deleted_at IS NULL
+				AND (($1::uuid IS NULL AND parent_id IS NULL) OR id = $1::uuid)
+			UNION ALL
+			SELECT c.id, t.depth + 1, t.path || c.id
+			FROM product_service.categories c
+			JOIN tree t ON c.parent_id = t.id
+			WHERE c.deleted_at IS NULL AND NOT c.id = ANY(t.path)
+		),
+		counts AS (
+			SELECT category_id, COUNT(*) AS n
+			FROM product_service.products
+			WHERE status = $3 AND deleted_at IS NULL AND category_id IS NOT NULL
+			GROUP BY category_id
+		)
+		SELECT c.id, c.name, c.description, c.parent_id, c.created_at, c.updated_at, c.deleted_at,
+			t.depth,
+			COALESCE(own.n, 0),
+			(
+				SELECT COALESCE(SUM(sub.n), 0)
+				FROM tree d
+				JOIN counts sub ON sub.category_id = d.id
+				WHERE t.id = ANY(d.path)
+			)
+		FROM tree t
+		JOIN product_service.categories c ON c.id = t.id
+		LEFT JOIN counts own ON own.category_id = t.id
+		WHERE $2 < 0 OR t.depth <= $2
+		ORDER BY t.depth, c.name
+	`
+	rows, err := r.reads.Query(ctx, query, rootID, maxDepth, domain.ProductStatusPublished)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var nodes []*domain.CategoryNode
+	for rows.Next() {
+		var c domain.Category
+		var n domain.CategoryNode
+		if err := rows.Scan(
+			&c.ID,
+			&c.Name,
+			&c.Description,
+			&c.ParentID,
+			&c.CreatedAt,
+			&c.UpdatedAt,
+			&c.DeletedAt,
+			&n.Depth,
+			&n.ProductCount,
+			&n.TotalProductCount,
+		); err != nil {
+			return nil, err
+		}
+		n.Category = &c
+		nodes = append(nodes, &n)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	if rootID != nil && len(nodes) == 0 {
+		return nil, domain.ErrCategoryNotFound
+	}
+	return nodes, nil
+}
+
+// IsDescendant walks up from id; the path array stops the walk if the
+// stored hierarchy already contains a cycle.
+func (r *PostgresCategoryRepository) IsDescendant(ctx context.Context, id, ancestorID uuid.UUID) (bool, error) {
+	query := `
+		WITH RECURSIVE ancestors AS (
+			SELECT id, parent_id, ARRAY[id] AS path
+			FROM product_service.categories
+			WHERE id = $1
+			UNION ALL
+			SELECT c.id, c.parent_id, a.path || c.id
+			FROM product_service.categories c
+			JOIN ancestors a ON c.id = a.parent_id
+			WHERE NOT c.id = ANY(a.path)
+		)
+		SELECT EXISTS(SELECT 1 FROM ancestors WHERE id = $2)
+	`
+	var descendant bool
+	err := r.pool.QueryRow(ctx, query, id, ancestorID).Scan(&descendant)
+	return descendant, err
+}
+
 func (r *PostgresCategoryRepository) Update(ctx context.Context, category *domain.Category) error {
 	query := `
 		UPDATE product_service.categories
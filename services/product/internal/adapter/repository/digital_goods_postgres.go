@@ -0,0 +1,337 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/daisuke8000/example-ec-platform/services/product/internal/domain"
+)
+
+const entitlementColumns = `
+	e.id, e.order_id, o.user_id, e.sku_id, e.kind, COALESCE(k.key, ''),
+	COALESCE(e.object_key, ''), e.download_count, e.max_downloads, e.created_at
+`
+
+type PostgresDigitalGoodsRepository struct {
+	pool *pgxpool.Pool
+}
+
+func NewPostgresDigitalGoodsRepository(pool *pgxpool.Pool) *PostgresDigitalGoodsRepository {
+	return &PostgresDigitalGoodsRepository{pool: pool}
+}
+
+func (r *PostgresDigitalGoodsRepository) UpsertDigitalSKU(ctx context.Context, sku *domain.DigitalSKU) error {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	err = tx.QueryRow(ctx, `
+		INSERT INTO product_service.digital_skus (sku_id, kind, object_key, max_downloads, created_at, updated_at)
+		VALUES ($1, $2, NULLIF($3, ''), $4, $5, $6)
+		ON CONFLICT (sku_id) DO UPDATE SET
+			kind = EXCLUDED.kind,
+			object_key = EXCLUDED.object_key,
+			max_downloads = EXCLUDED.max_downloads,
+			updated_at = EXCLUDED.updated_at
+		RETURNING created_at
+	`, sku.SKUID, sku.Kind, sku.ObjectKey, sku.MaxDownloads, sku.CreatedAt, sku.UpdatedAt).Scan(&sku.CreatedAt)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == pgForeignKeyViolation {
+			return fmt.Errorf("%w: %s", domain.ErrSKUNotFound, sku.SKUID)
+		}
+		return err
+	}
+
+	if sku.Kind != domain.DigitalKindLicenseKey {
+		var hasKeys bool
+		if err := tx.QueryRow(ctx, `
+			SELECT EXISTS (SELECT 1 FROM product_service.license_keys WHERE sku_id = $1)
+		`, sku.SKUID).Scan(&hasKeys); err != nil {
+			return err
+		}
+		if hasKeys {
+			return domain.ErrDigitalKindChange
+		}
+	}
+
+	return tx.Commit(ctx)
+}
+
+func (r *PostgresDigitalGoodsRepository) FindDigitalSKUs(ctx context.Context, skuIDs []uuid.UUID) ([]*domain.DigitalSKU, error) {
+	query := `
+		SELECT d.sku_id, d.kind, COALESCE(d.object_key, ''), d.max_downloads, d.created_at, d.updated_at,
+			(SELECT COUNT(*) FROM product_service.license_keys k
+			 WHERE k.sku_id = d.sku_id AND k.status = 'available')
+		FROM product_service.digital_skus d
+		WHERE d.sku_id = ANY($1)
+	`
+	rows, err := r.pool.Query(ctx, query, skuIDs)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var skus []*domain.DigitalSKU
+	for rows.Next() {
+		var s domain.DigitalSKU
+		if err := rows.Scan(
+			&s.SKUID,
+			&s.Kind,
+			&s.ObjectKey,
+			&s.MaxDownloads,
+			&s.CreatedAt,
+			&s.UpdatedAt,
+			&s.AvailableKeys,
+		); err != nil {
+			return nil, err
+		}
+		skus = append(skus, &s)
+	}
+	return skus, rows.Err()
+}
+
+// AddLicenseKeys share-locks the SKU's fulfillment so that its kind cannot
+// change while keys are added.
+func (r *PostgresDigitalGoodsRepository) AddLicenseKeys(ctx context.Context, skuID uuid.UUID, keys []string) (int, error) {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return 0, err
+	}
+	defer tx.Rollback(ctx)
+
+	var kind domain.DigitalKind
+	err = tx.QueryRow(ctx, `
+		SELECT kind FROM product_service.digital_skus WHERE sku_id = $1 FOR SHARE
+	`, skuID).Scan(&kind)
+	if errors.Is(err, pgx.ErrNoRows) || (err == nil && kind != domain.DigitalKindLicenseKey) {
+		return 0, domain.ErrNotLicenseKeySKU
+	}
+	if err != nil {
+		return 0, err
+	}
+
+	result, err := tx.Exec(ctx, `
+		INSERT INTO product_service.license_keys (sku_id, key)
+		SELECT $1, unnest($2::text[])
+		ON CONFLICT (sku_id, key) DO NOTHING
+	`, skuID, keys)
+	if err != nil {
+		return 0, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return 0, err
+	}
+	return int(result.RowsAffected()), nil
+}
+
+// Fulfill claims the order first: a concurrent fulfillment of the same
+// order blocks on the primary key until this transaction ends, then sees
+// the order as fulfilled. Keys are taken with SKIP LOCKED so that orders of
+// the same SKU do not queue behind each other; keys locked by a transaction
+// that later rolls back count as unavailable meanwhile.
+func (r *PostgresDigitalGoodsRepository) Fulfill(ctx context.Context, orderID uuid.UUID, userID string, items []domain.DigitalOrderItem) (*domain.FulfilledOrder, error) {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	result, err := tx.Exec(ctx, `
+		INSERT INTO product_service.digital_orders (order_id, user_id)
+		VALUES ($1, $2)
+		ON CONFLICT (order_id) DO NOTHING
+	`, orderID, userID)
+	if err != nil {
+		return nil, err
+	}
+	if result.RowsAffected() == 0 {
+		entitlements, err := findEntitlements(ctx, tx, `WHERE e.order_id = $1`, orderID)
+		if err != nil {
+			return nil, err
+		}
+		return &domain.FulfilledOrder{Replayed: true, Entitlements: entitlements}, nil
+	}
+
+	skuIDs := make([]uuid.UUID, len(items))
+	for i, item := range items {
+		skuIDs[i] = item.SKUID
+	}
+	rows, err := tx.Query(ctx, `
+		SELECT sku_id, kind, COALESCE(object_key, ''), max_downloads
+		FROM product_service.digital_skus
+		WHERE sku_id = ANY($1)
+	`, skuIDs)
+	if err != nil {
+		return nil, err
+	}
+	digital := make(map[uuid.UUID]*domain.DigitalSKU, len(items))
+	for rows.Next() {
+		var s domain.DigitalSKU
+		if err := rows.Scan(&s.SKUID, &s.Kind, &s.ObjectKey, &s.MaxDownloads); err != nil {
+			rows.Close()
+			return nil, err
+		}
+		digital[s.SKUID] = &s
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	// Lines are fulfilled in SKU order for a stable entitlement order.
+	sorted := make([]domain.DigitalOrderItem, len(items))
+	copy(sorted, items)
+	sort.Slice(sorted, func(a, b int) bool {
+		return sorted[a].SKUID.String() < sorted[b].SKUID.String()
+	})
+
+	for _, item := range sorted {
+		sku, ok := digital[item.SKUID]
+		if !ok {
+			continue
+		}
+		switch sku.Kind {
+		case domain.DigitalKindLicenseKey:
+			if err := assignLicenseKeys(ctx, tx, orderID, item); err != nil {
+				return nil, err
+			}
+		case domain.DigitalKindDownload:
+			if _, err := tx.Exec(ctx, `
+				INSERT INTO product_service.digital_entitlements (order_id, sku_id, kind, object_key, max_downloads)
+				VALUES ($1, $2, $3, $4, $5)
+			`, orderID, sku.SKUID, sku.Kind, sku.ObjectKey, sku.MaxDownloads); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	entitlements, err := findEntitlements(ctx, tx, `WHERE e.order_id = $1`, orderID)
+	if err != nil {
+		return nil, err
+	}
+	if err := tx.Commit(ctx); err != nil {
+		return nil, err
+	}
+	return &domain.FulfilledOrder{Entitlements: entitlements}, nil
+}
+
+// assignLicenseKeys assigns the oldest available keys of a SKU to an order
+// line, one entitlement per key.
+func assignLicenseKeys(ctx context.Context, tx pgx.Tx, orderID uuid.UUID, item domain.DigitalOrderItem) error {
+	result, err := tx.Exec(ctx, `
+		WITH assigned AS (
+			UPDATE product_service.license_keys
+			SET status = 'assigned', order_id = $2, assigned_at = NOW()
+			WHERE id IN (
+				SELECT id FROM product_service.license_keys
+				WHERE sku_id = $1 AND status = 'available'
+				ORDER BY created_at
+				LIMIT $3
+				FOR UPDATE SKIP LOCKED
+			)
+			RETURNING id, sku_id
+		)
+		INSERT INTO product_service.digital_entitlements (order_id, sku_id, kind, license_key_id)
+		SELECT $2, sku_id, $4, id FROM assigned
+	`, item.SKUID, orderID, item.Quantity, domain.DigitalKindLicenseKey)
+	if err != nil {
+		return err
+	}
+	if result.RowsAffected() < item.Quantity {
+		return fmt.Errorf("%w: %s", domain.ErrLicenseKeysExhausted, item.SKUID)
+	}
+	return nil
+}
+
+func (r *PostgresDigitalGoodsRepository) FindEntitlements(ctx context.Context, orderID uuid.UUID, userID string) ([]*domain.Entitlement, error) {
+	entitlements, err := findEntitlements(ctx, r.pool, `WHERE e.order_id = $1 AND o.user_id = $2`, orderID, userID)
+	if err != nil {
+		return nil, err
+	}
+	if len(entitlements) == 0 {
+		return nil, domain.ErrDigitalOrderNotFound
+	}
+	return entitlements, nil
+}
+
+func (r *PostgresDigitalGoodsRepository) ConsumeDownload(ctx context.Context, entitlementID uuid.UUID) (*domain.Entitlement, error) {
+	rows, err := r.pool.Query(ctx, `
+		WITH e AS (
+			UPDATE product_service.digital_entitlements
+			SET download_count = download_count + 1
+			WHERE id = $1 AND download_count < max_downloads
+			RETURNING *
+		)
+		SELECT `+entitlementColumns+`
+		FROM e
+		JOIN product_service.digital_orders o ON o.order_id = e.order_id
+		LEFT JOIN product_service.license_keys k ON k.id = e.license_key_id
+	`, entitlementID)
+	if err != nil {
+		return nil, err
+	}
+	entitlements, err := scanEntitlements(rows)
+	if err != nil {
+		return nil, err
+	}
+	if len(entitlements) == 0 {
+		return nil, domain.ErrDownloadLimitReached
+	}
+	return entitlements[0], nil
+}
+
+// queryer is satisfied by both the pool and a transaction.
+type queryer interface {
+	Query(ctx context.Context, sql string, args ...any) (pgx.Rows, error)
+}
+
+func findEntitlements(ctx context.Context, q queryer, where string, args ...any) ([]*domain.Entitlement, error) {
+	rows, err := q.Query(ctx, `
+		SELECT `+entitlementColumns+`
+		FROM product_service.digital_entitlements e
+		JOIN product_service.digital_orders o ON o.order_id = e.order_id
+		LEFT JOIN product_service.license_keys k ON k.id = e.license_key_id
+		`+where+`
+		ORDER BY e.sku_id, e.created_at, e.id
+	`, args...)
+	if err != nil {
+		return nil, err
+	}
+	return scanEntitlements(rows)
+}
+
+func scanEntitlements(rows pgx.Rows) ([]*domain.Entitlement, error) {
+	defer rows.Close()
+
+	var entitlements []*domain.Entitlement
+	for rows.Next() {
+		var e domain.Entitlement
+		if err := rows.Scan(
+			&e.ID,
+			&e.OrderID,
+			&e.UserID,
+			&e.SKUID,
+			&e.Kind,
+			&e.LicenseKey,
+			&e.ObjectKey,
+			&e.DownloadCount,
+			&e.MaxDownloads,
+			&e.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		entitlements = append(entitlements, &e)
+	}
+	return entitlements, rows.Err()
+}
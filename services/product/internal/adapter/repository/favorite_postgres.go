@@ -0,0 +1,133 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/daisuke8000/example-ec-platform/services/product/internal/domain"
+)
+
+type PostgresFavoriteRepository struct {
+	pool *pgxpool.Pool
+}
+
+func NewPostgresFavoriteRepository(pool *pgxpool.Pool) *PostgresFavoriteRepository {
+	return &PostgresFavoriteRepository{pool: pool}
+}
+
+// Add inserts the favorite only while the user is below the limit, counting
+// favorites of deleted products out as they cannot be listed.
+func (r *PostgresFavoriteRepository) Add(ctx context.Context, favorite *domain.Favorite) error {
+	result, err := r.pool.Exec(ctx, `
+		INSERT INTO product_service.favorites (user_id, product_id, created_at)
+		SELECT $1, $2, $3
+		WHERE (
+			SELECT COUNT(*)
+			FROM product_service.favorites f
+			JOIN product_service.products p ON p.id = f.product_id
+			WHERE f.user_id = $1 AND p.deleted_at IS NULL
+		) < $4
+		ON CONFLICT (user_id, product_id) DO NOTHING
+	`, favorite.UserID, favorite.ProductID, favorite.CreatedAt, domain.MaxFavorites)
+	if err != nil {
+		return err
+	}
+	if result.RowsAffected() > 0 {
+		return nil
+	}
+
+	// Nothing was inserted: either the favorite exists or the limit is reached.
+	var exists bool
+	if err := r.pool.QueryRow(ctx, `
+		SELECT EXISTS (
+			SELECT 1 FROM product_service.favorites
+			WHERE user_id = $1 AND product_id = $2
+		)
+	`, favorite.UserID, favorite.ProductID).Scan(&exists); err != nil {
+		return err
+	}
+	if !exists {
+		return domain.ErrTooManyFavorites
+	}
+	return nil
+}
+
+func (r *PostgresFavoriteRepository) Remove(ctx context.Context, userID, productID uuid.UUID) error {
+	_, err := r.pool.Exec(ctx, `
+		DELETE FROM product_service.favorites
+		WHERE user_id = $1 AND product_id = $2
+	`, userID, productID)
+	return err
+}
+
+func (r *PostgresFavoriteRepository) ListByUser(ctx context.Context, userID uuid.UUID, limit int, after *domain.FavoriteCursor) ([]*domain.Favorite, error) {
+	query := `
+		SELECT f.user_id, f.product_id, f.created_at
+		FROM product_service.favorites f
+		JOIN product_service.products p ON p.id = f.product_id
+		WHERE f.user_id = $1 AND p.deleted_at IS NULL
+		ORDER BY f.created_at DESC, f.product_id DESC
+		LIMIT $2
+	`
+	args := []any{userID, limit}
+	if after != nil {
+		query = `
+			SELECT f.user_id, f.product_id, f.created_at
+			FROM product_service.favorites f
+			JOIN product_service.products p ON p.id = f.product_id
+			WHERE f.user_id = $1 AND p.deleted_at IS NULL
+				AND (f.created_at, f.product_id) < ($3, $4)
+			ORDER BY f.created_at DESC, f.product_id DESC
+			LIMIT $2
+		`
+		args = append(args, after.CreatedAt, after.ProductID)
+	}
+
+	rows, err := r.pool.Query(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var favorites []*domain.Favorite
+	for rows.Next() {
+		var f domain.Favorite
+		if err := rows.Scan(&f.UserID, &f.ProductID, &f.CreatedAt); err != nil {
+			return nil, err
+		}
+		favorites = append(favorites, &f)
+	}
+	return favorites, rows.Err()
+}
+
+func (r *PostgresFavoriteRepository) CountByProductIDs(ctx context.Context, productIDs []uuid.UUID) (map[uuid.UUID]int64, error) {
+	counts := make(map[uuid.UUID]int64, len(productIDs))
+	if len(productIDs) == 0 {
+		return counts, nil
+	}
+
+	rows, err := r.pool.Query(ctx, `
+		SELECT product_id, COUNT(*)
+		FROM product_service.favorites
+		WHERE product_id = ANY($1)
+		GROUP BY product_id
+	`, productIDs)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var (
+			id    uuid.UUID
+			count int64
+		)
+		if err := rows.Scan(&id, &count); err != nil {
+			return nil, err
+		}
+		counts[id] = count
+	}
+	return counts, rows.Err()
+}
@@ -0,0 +1,177 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/daisuke8000/example-ec-platform/services/product/internal/domain"
+)
+
+const (
+	// inventoryChangesChannel is notified by the inventory table's trigger.
+	inventoryChangesChannel = "inventory_changes"
+	// inventoryFeedBuffer is the number of changes a subscriber may lag
+	// behind before it is dropped.
+	inventoryFeedBuffer = 64
+	// inventoryFeedRetryInterval is the wait before reconnecting.
+	inventoryFeedRetryInterval = time.Second
+)
+
+// PostgresInventoryFeed fans out the inventory_changes notifications of
+// Postgres to subscribers. It listens on a dedicated connection, outside the
+// pool, that it reopens when lost.
+type PostgresInventoryFeed struct {
+	pool   *pgxpool.Pool
+	logger *slog.Logger
+
+	mu        sync.Mutex
+	listening bool
+	subs      map[uuid.UUID]map[*inventorySubscription]struct{}
+}
+
+type inventorySubscription struct {
+	skuIDs  []uuid.UUID
+	changes chan *domain.Inventory
+	dropped bool
+}
+
+func NewPostgresInventoryFeed(pool *pgxpool.Pool, logger *slog.Logger) *PostgresInventoryFeed {
+	return &PostgresInventoryFeed{
+		pool:   pool,
+		logger: logger,
+		subs:   make(map[uuid.UUID]map[*inventorySubscription]struct{}),
+	}
+}
+
+// Subscribe returns a closed channel while the feed is not listening, as
+// changes committed meanwhile would be missed.
+func (f *PostgresInventoryFeed) Subscribe(skuIDs []uuid.UUID) (<-chan *domain.Inventory, func()) {
+	sub := &inventorySubscription{skuIDs: skuIDs, changes: make(chan *domain.Inventory, inventoryFeedBuffer)}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if !f.listening {
+		sub.dropped = true
+		close(sub.changes)
+		return sub.changes, func() {}
+	}
+	for _, id := range skuIDs {
+		if f.subs[id] == nil {
+			f.subs[id] = make(map[*inventorySubscription]struct{})
+		}
+		f.subs[id][sub] = struct{}{}
+	}
+	return sub.changes, func() {
+		f.mu.Lock()
+		defer f.mu.Unlock()
+		f.drop(sub)
+	}
+}
+
+// Start listens for changes until ctx is done, reconnecting after
+// connection failures. Subscriptions are interrupted whenever the feed stops
+// listening.
+func (f *PostgresInventoryFeed) Start(ctx context.Context) {
+	for {
+		err := f.listen(ctx)
+		f.setListening(false)
+		if ctx.Err() != nil {
+			return
+		}
+		f.logger.Warn("inventory feed disconnected", slog.String("error", err.Error()))
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(inventoryFeedRetryInterval):
+		}
+	}
+}
+
+func (f *PostgresInventoryFeed) listen(ctx context.Context) error {
+	conn, err := pgx.ConnectConfig(ctx, f.pool.Config().ConnConfig.Copy())
+	if err != nil {
+		return err
+	}
+	defer conn.Close(context.WithoutCancel(ctx))
+
+	if _, err := conn.Exec(ctx, "LISTEN "+inventoryChangesChannel); err != nil {
+		return err
+	}
+	f.setListening(true)
+
+	for {
+		notification, err := conn.WaitForNotification(ctx)
+		if err != nil {
+			return err
+		}
+		var change struct {
+			SKUID    uuid.UUID `json:"sku_id"`
+			Quantity int64     `json:"quantity"`
+			Reserved int64     `json:"reserved"`
+			Version  int64     `json:"version"`
+		}
+		if err := json.Unmarshal([]byte(notification.Payload), &change); err != nil {
+			f.logger.Error("invalid inventory change notification", slog.String("error", err.Error()))
+			continue
+		}
+		f.dispatch(&domain.Inventory{
+			SKUID:    change.SKUID,
+			Quantity: change.Quantity,
+			Reserved: change.Reserved,
+			Version:  change.Version,
+		})
+	}
+}
+
+// dispatch delivers a change without blocking. Subscribers whose buffer is
+// full are dropped rather than silently missing the change.
+func (f *PostgresInventoryFeed) dispatch(inventory *domain.Inventory) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	for sub := range f.subs[inventory.SKUID] {
+		select {
+		case sub.changes <- inventory:
+		default:
+			f.drop(sub)
+		}
+	}
+}
+
+// setListening records whether notifications are being received, and
+// interrupts every subscription when they stop.
+func (f *PostgresInventoryFeed) setListening(listening bool) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.listening = listening
+	if listening {
+		return
+	}
+	for _, subs := range f.subs {
+		for sub := range subs {
+			f.drop(sub)
+		}
+	}
+}
+
+// drop removes a subscription and closes its channel; f.mu must be held.
+func (f *PostgresInventoryFeed) drop(sub *inventorySubscription) {
+	if sub.dropped {
+		return
+	}
+	sub.dropped = true
+	for _, id := range sub.skuIDs {
+		delete(f.subs[id], sub)
+		if len(f.subs[id]) == 0 {
+			delete(f.subs, id)
+		}
+	}
+	close(sub.changes)
+}
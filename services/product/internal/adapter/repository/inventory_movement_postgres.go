@@ -0,0 +1,55 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/daisuke8000/example-ec-platform/services/product/internal/domain"
+)
+
+type PostgresInventoryMovementRepository struct {
+	pool *pgxpool.Pool
+}
+
+func NewPostgresInventoryMovementRepository(pool *pgxpool.Pool) *PostgresInventoryMovementRepository {
+	return &PostgresInventoryMovementRepository{pool: pool}
+}
+
+func (r *PostgresInventoryMovementRepository) ListBySKU(ctx context.Context, skuID uuid.UUID, limit int, beforeID int64) ([]*domain.InventoryMovement, error) {
+	query := `
+		SELECT id, sku_id, reason, actor, reservation_id,
+			quantity_delta, reserved_delta, quantity_after, reserved_after, created_at
+		FROM product_service.inventory_movements
+		WHERE sku_id = $1 AND ($3 <= 0 OR id < $3)
+		ORDER BY id DESC
+		LIMIT $2
+	`
+	rows, err := r.pool.Query(ctx, query, skuID, limit, beforeID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var movements []*domain.InventoryMovement
+	for rows.Next() {
+		var m domain.InventoryMovement
+		if err := rows.Scan(
+			&m.ID,
+			&m.SKUID,
+			&m.Reason,
+			&m.Actor,
+			&m.ReservationID,
+			&m.QuantityDelta,
+			&m.ReservedDelta,
+			&m.QuantityAfter,
+			&m.ReservedAfter,
+			&m.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		movements = append(movements, &m)
+	}
+	return movements, rows.Err()
+}
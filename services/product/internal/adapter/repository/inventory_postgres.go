@@ -3,14 +3,22 @@ package repository
 import (
 	"context"
 	"errors"
+	"strconv"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
 	"github.com/jackc/pgx/v5/pgxpool"
 
 	"github.com/daisuke8000/example-ec-platform/services/product/internal/domain"
 )
 
+const (
+	pgLockNotAvailable = "55P03"
+	pgQueryCanceled    = "57014"
+)
+
 type PostgresInventoryRepository struct {
 	pool *pgxpool.Pool
 }
@@ -105,13 +113,27 @@ func (r *PostgresInventoryRepository) Update(ctx context.Context, inventory *dom
 	return nil
 }
 
-func (r *PostgresInventoryRepository) UpdateQuantity(ctx context.Context, skuID uuid.UUID, quantity int64) error {
+// UpdateQuantity sets the absolute stock quantity and records the change as a movement.
+func (r *PostgresInventoryRepository) UpdateQuantity(ctx context.Context, skuID uuid.UUID, quantity int64, src domain.MovementSource) error {
 	query := `
-		UPDATE product_service.inventory
-		SET quantity = $2, version = version + 1, updated_at = NOW()
-		WHERE sku_id = $1 AND quantity - reserved <= $2 - reserved
+		WITH prev AS (
+			SELECT sku_id, quantity
+			FROM product_service.inventory
+			WHERE sku_id = $1
+			FOR UPDATE
+		), updated AS (
+			UPDATE product_service.inventory i
+			SET quantity = $2, version = i.version + 1, updated_at = NOW()
+			FROM prev
+			WHERE i.sku_id = prev.sku_id AND i.quantity - i.reserved <= $2 - i.reserved
+			RETURNING i.sku_id, i.quantity, i.reserved, i.quantity - prev.quantity AS quantity_delta
+		)
+		INSERT INTO product_service.inventory_movements
+			(sku_id, reason, actor, reservation_id, quantity_delta, reserved_delta, quantity_after, reserved_after)
+		SELECT sku_id, $3, $4, $5, quantity_delta, 0, quantity, reserved
+		FROM updated
 	`
-	result, err := r.pool.Exec(ctx, query, skuID, quantity)
+	result, err := r.pool.Exec(ctx, query, skuID, quantity, src.Reason, src.Actor, src.ReservationID)
 	if err != nil {
 		return err
 	}
@@ -122,13 +144,85 @@ func (r *PostgresInventoryRepository) UpdateQuantity(ctx context.Context, skuID
 	return nil
 }
 
-func (r *PostgresInventoryRepository) Reserve(ctx context.Context, skuID uuid.UUID, amount int64, expectedVersion int64) error {
+// SetQuantities queues one statement per update in a single batch. A skipped
+// item leaves no row in "updated", which the final SELECT reports as a NULL
+// quantity, so one item never aborts the transaction for the others.
+func (r *PostgresInventoryRepository) SetQuantities(ctx context.Context, updates []domain.QuantityUpdate, src domain.MovementSource) ([]*domain.Inventory, []error, error) {
 	query := `
-		UPDATE product_service.inventory
-		SET reserved = reserved + $2, version = version + 1, updated_at = NOW()
-		WHERE sku_id = $1 AND version = $3 AND quantity - reserved >= $2
+		WITH prev AS (
+			SELECT sku_id, quantity
+			FROM product_service.inventory
+			WHERE sku_id = $1
+			FOR UPDATE
+		), updated AS (
+			UPDATE product_service.inventory i
+			SET quantity = $2, version = i.version + 1, updated_at = NOW()
+			FROM prev
+			WHERE i.sku_id = prev.sku_id AND $2 >= i.reserved
+			RETURNING i.sku_id, i.quantity, i.reserved, i.version, i.quantity - prev.quantity AS quantity_delta
+		), moved AS (
+			INSERT INTO product_service.inventory_movements
+				(sku_id, reason, actor, reservation_id, quantity_delta, reserved_delta, quantity_after, reserved_after)
+			SELECT sku_id, $3, $4, $5, quantity_delta, 0, quantity, reserved
+			FROM updated
+		)
+		SELECT updated.quantity, updated.reserved, updated.version
+		FROM prev LEFT JOIN updated ON updated.sku_id = prev.sku_id
 	`
-	result, err := r.pool.Exec(ctx, query, skuID, amount, expectedVersion)
+
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	batch := &pgx.Batch{}
+	for _, u := range updates {
+		batch.Queue(query, u.SKUID, u.Quantity, src.Reason, src.Actor, src.ReservationID)
+	}
+
+	results := tx.SendBatch(ctx, batch)
+	updated := make([]*domain.Inventory, len(updates))
+	itemErrs := make([]error, len(updates))
+	for i, u := range updates {
+		var quantity, reserved, version *int64
+		err := results.QueryRow().Scan(&quantity, &reserved, &version)
+		switch {
+		case errors.Is(err, pgx.ErrNoRows):
+			itemErrs[i] = domain.ErrInventoryNotFound
+		case err != nil:
+			results.Close()
+			return nil, nil, err
+		case quantity == nil:
+			itemErrs[i] = domain.ErrInsufficientStock
+		default:
+			updated[i] = &domain.Inventory{SKUID: u.SKUID, Quantity: *quantity, Reserved: *reserved, Version: *version}
+		}
+	}
+	if err := results.Close(); err != nil {
+		return nil, nil, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, nil, err
+	}
+	return updated, itemErrs, nil
+}
+
+func (r *PostgresInventoryRepository) Reserve(ctx context.Context, skuID uuid.UUID, amount int64, expectedVersion int64, src domain.MovementSource) error {
+	query := `
+		WITH updated AS (
+			UPDATE product_service.inventory
+			SET reserved = reserved + $2, version = version + 1, updated_at = NOW()
+			WHERE sku_id = $1 AND version = $3 AND quantity - reserved >= $2
+			RETURNING sku_id, quantity, reserved
+		)
+		INSERT INTO product_service.inventory_movements
+			(sku_id, reason, actor, reservation_id, quantity_delta, reserved_delta, quantity_after, reserved_after)
+		SELECT sku_id, $4, $5, $6, 0, $2, quantity, reserved
+		FROM updated
+	`
+	result, err := r.pool.Exec(ctx, query, skuID, amount, expectedVersion, src.Reason, src.Actor, src.ReservationID)
 	if err != nil {
 		return err
 	}
@@ -146,13 +240,20 @@ func (r *PostgresInventoryRepository) Reserve(ctx context.Context, skuID uuid.UU
 	return nil
 }
 
-func (r *PostgresInventoryRepository) ConfirmReservation(ctx context.Context, skuID uuid.UUID, amount int64) error {
+func (r *PostgresInventoryRepository) ConfirmReservation(ctx context.Context, skuID uuid.UUID, amount int64, src domain.MovementSource) error {
 	query := `
-		UPDATE product_service.inventory
-		SET quantity = quantity - $2, reserved = reserved - $2, version = version + 1, updated_at = NOW()
-		WHERE sku_id = $1 AND reserved >= $2
+		WITH updated AS (
+			UPDATE product_service.inventory
+			SET quantity = quantity - $2, reserved = reserved - $2, version = version + 1, updated_at = NOW()
+			WHERE sku_id = $1 AND reserved >= $2
+			RETURNING sku_id, quantity, reserved
+		)
+		INSERT INTO product_service.inventory_movements
+			(sku_id, reason, actor, reservation_id, quantity_delta, reserved_delta, quantity_after, reserved_after)
+		SELECT sku_id, $3, $4, $5, -$2::BIGINT, -$2::BIGINT, quantity, reserved
+		FROM updated
 	`
-	result, err := r.pool.Exec(ctx, query, skuID, amount)
+	result, err := r.pool.Exec(ctx, query, skuID, amount, src.Reason, src.Actor, src.ReservationID)
 	if err != nil {
 		return err
 	}
@@ -163,13 +264,20 @@ func (r *PostgresInventoryRepository) ConfirmReservation(ctx context.Context, sk
 	return nil
 }
 
-func (r *PostgresInventoryRepository) ReleaseReservation(ctx context.Context, skuID uuid.UUID, amount int64) error {
+func (r *PostgresInventoryRepository) ReleaseReservation(ctx context.Context, skuID uuid.UUID, amount int64, src domain.MovementSource) error {
 	query := `
-		UPDATE product_service.inventory
-		SET reserved = reserved - $2, version = version + 1, updated_at = NOW()
-		WHERE sku_id = $1 AND reserved >= $2
+		WITH updated AS (
+			UPDATE product_service.inventory
+			SET reserved = reserved - $2, version = version + 1, updated_at = NOW()
+			WHERE sku_id = $1 AND reserved >= $2
+			RETURNING sku_id, quantity, reserved
+		)
+		INSERT INTO product_service.inventory_movements
+			(sku_id, reason, actor, reservation_id, quantity_delta, reserved_delta, quantity_after, reserved_after)
+		SELECT sku_id, $3, $4, $5, 0, -$2::BIGINT, quantity, reserved
+		FROM updated
 	`
-	result, err := r.pool.Exec(ctx, query, skuID, amount)
+	result, err := r.pool.Exec(ctx, query, skuID, amount, src.Reason, src.Actor, src.ReservationID)
 	if err != nil {
 		return err
 	}
@@ -180,13 +288,45 @@ func (r *PostgresInventoryRepository) ReleaseReservation(ctx context.Context, sk
 	return nil
 }
 
-func (r *PostgresInventoryRepository) ReserveWithTx(ctx context.Context, tx pgx.Tx, skuID uuid.UUID, amount int64) error {
+// ReleaseReservationWithTx is ReleaseReservation within tx.
+func (r *PostgresInventoryRepository) ReleaseReservationWithTx(ctx context.Context, tx pgx.Tx, skuID uuid.UUID, amount int64, src domain.MovementSource) error {
 	query := `
-		UPDATE product_service.inventory
-		SET reserved = reserved + $2, version = version + 1, updated_at = NOW()
-		WHERE sku_id = $1 AND quantity - reserved >= $2
+		WITH updated AS (
+			UPDATE product_service.inventory
+			SET reserved = reserved - $2, version = version + 1, updated_at = NOW()
+			WHERE sku_id = $1 AND reserved >= $2
+			RETURNING sku_id, quantity, reserved
+		)
+		INSERT INTO product_service.inventory_movements
+			(sku_id, reason, actor, reservation_id, quantity_delta, reserved_delta, quantity_after, reserved_after)
+		SELECT sku_id, $3, $4, $5, 0, -$2::BIGINT, quantity, reserved
+		FROM updated
 	`
-	result, err := tx.Exec(ctx, query, skuID, amount)
+	result, err := tx.Exec(ctx, query, skuID, amount, src.Reason, src.Actor, src.ReservationID)
+	if err != nil {
+		return err
+	}
+
+	if result.RowsAffected() == 0 {
+		return domain.ErrInvalidReserved
+	}
+	return nil
+}
+
+func (r *PostgresInventoryRepository) ReserveWithTx(ctx context.Context, tx pgx.Tx, skuID uuid.UUID, amount int64, src domain.MovementSource) error {
+	query := `
+		WITH updated AS (
+			UPDATE product_service.inventory
+			SET reserved = reserved + $2, version = version + 1, updated_at = NOW()
+			WHERE sku_id = $1 AND quantity - reserved >= $2
+			RETURNING sku_id, quantity, reserved
+		)
+		INSERT INTO product_service.inventory_movements
+			(sku_id, reason, actor, reservation_id, quantity_delta, reserved_delta, quantity_after, reserved_after)
+		SELECT sku_id, $3, $4, $5, 0, $2, quantity, reserved
+		FROM updated
+	`
+	result, err := tx.Exec(ctx, query, skuID, amount, src.Reason, src.Actor, src.ReservationID)
 	if err != nil {
 		return err
 	}
@@ -196,3 +336,54 @@ func (r *PostgresInventoryRepository) ReserveWithTx(ctx context.Context, tx pgx.
 	}
 	return nil
 }
+
+// LockForUpdateWithTx locks the inventory rows of skuIDs with SELECT ... FOR
+// UPDATE in sku_id order and returns those that exist. Callers locking
+// overlapping sets therefore acquire the locks in the same order and cannot
+// deadlock. timeout is set as the lock and statement timeout of the rest of
+// tx; running out while waiting returns domain.ErrInventoryLockTimeout.
+func (r *PostgresInventoryRepository) LockForUpdateWithTx(ctx context.Context, tx pgx.Tx, skuIDs []uuid.UUID, timeout time.Duration) ([]*domain.Inventory, error) {
+	if len(skuIDs) == 0 {
+		return []*domain.Inventory{}, nil
+	}
+
+	if timeout > 0 {
+		ms := strconv.FormatInt(timeout.Milliseconds(), 10)
+		if _, err := tx.Exec(ctx, `SELECT set_config('lock_timeout', $1, true), set_config('statement_timeout', $1, true)`, ms); err != nil {
+			return nil, err
+		}
+	}
+
+	query := `
+		SELECT sku_id, quantity, reserved, version
+		FROM product_service.inventory
+		WHERE sku_id = ANY($1)
+		ORDER BY sku_id
+		FOR UPDATE
+	`
+	rows, err := tx.Query(ctx, query, skuIDs)
+	if err != nil {
+		return nil, lockTimeout(err)
+	}
+	defer rows.Close()
+
+	var inventories []*domain.Inventory
+	for rows.Next() {
+		var inv domain.Inventory
+		if err := rows.Scan(&inv.SKUID, &inv.Quantity, &inv.Reserved, &inv.Version); err != nil {
+			return nil, err
+		}
+		inventories = append(inventories, &inv)
+	}
+	return inventories, lockTimeout(rows.Err())
+}
+
+// lockTimeout reports a lock or statement timeout as
+// domain.ErrInventoryLockTimeout.
+func lockTimeout(err error) error {
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) && (pgErr.Code == pgLockNotAvailable || pgErr.Code == pgQueryCanceled) {
+		return domain.ErrInventoryLockTimeout
+	}
+	return err
+}
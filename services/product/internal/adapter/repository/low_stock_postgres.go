@@ -0,0 +1,99 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/daisuke8000/example-ec-platform/services/product/internal/domain"
+)
+
+type PostgresLowStockRepository struct {
+	pool *pgxpool.Pool
+}
+
+func NewPostgresLowStockRepository(pool *pgxpool.Pool) *PostgresLowStockRepository {
+	return &PostgresLowStockRepository{pool: pool}
+}
+
+func (r *PostgresLowStockRepository) SetThreshold(ctx context.Context, skuID uuid.UUID, threshold *int64) error {
+	result, err := r.pool.Exec(ctx, `
+		UPDATE product_service.inventory
+		SET low_stock_threshold = $2
+		WHERE sku_id = $1
+	`, skuID, threshold)
+	if err != nil {
+		return err
+	}
+	if result.RowsAffected() == 0 {
+		return domain.ErrInventoryNotFound
+	}
+	return nil
+}
+
+func (r *PostgresLowStockRepository) List(ctx context.Context, defaultThreshold int64, afterSKUID uuid.UUID, limit int) ([]*domain.LowStockSKU, error) {
+	query := `
+		SELECT sku_id, quantity, reserved, COALESCE(low_stock_threshold, $1), low_stock_alerted_at
+		FROM product_service.inventory
+		WHERE quantity - reserved < COALESCE(low_stock_threshold, $1) AND sku_id > $2
+		ORDER BY sku_id
+		LIMIT $3
+	`
+	rows, err := r.pool.Query(ctx, query, defaultThreshold, afterSKUID, limit)
+	if err != nil {
+		return nil, err
+	}
+	return scanLowStockSKUs(rows)
+}
+
+// ClaimAlerts skips rows locked by another replica's worker, so each SKU is
+// claimed once.
+func (r *PostgresLowStockRepository) ClaimAlerts(ctx context.Context, defaultThreshold int64, limit int) ([]*domain.LowStockSKU, error) {
+	query := `
+		UPDATE product_service.inventory
+		SET low_stock_alerted_at = NOW()
+		WHERE sku_id IN (
+			SELECT sku_id FROM product_service.inventory
+			WHERE low_stock_alerted_at IS NULL
+				AND quantity - reserved < COALESCE(low_stock_threshold, $1)
+			ORDER BY sku_id
+			LIMIT $2
+			FOR UPDATE SKIP LOCKED
+		)
+		RETURNING sku_id, quantity, reserved, COALESCE(low_stock_threshold, $1), low_stock_alerted_at
+	`
+	rows, err := r.pool.Query(ctx, query, defaultThreshold, limit)
+	if err != nil {
+		return nil, err
+	}
+	return scanLowStockSKUs(rows)
+}
+
+func (r *PostgresLowStockRepository) ResetRecovered(ctx context.Context, defaultThreshold int64) (int64, error) {
+	result, err := r.pool.Exec(ctx, `
+		UPDATE product_service.inventory
+		SET low_stock_alerted_at = NULL
+		WHERE low_stock_alerted_at IS NOT NULL
+			AND quantity - reserved >= COALESCE(low_stock_threshold, $1)
+	`, defaultThreshold)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+func scanLowStockSKUs(rows pgx.Rows) ([]*domain.LowStockSKU, error) {
+	defer rows.Close()
+
+	var skus []*domain.LowStockSKU
+	for rows.Next() {
+		var s domain.LowStockSKU
+		if err := rows.Scan(&s.SKUID, &s.Quantity, &s.Reserved, &s.Threshold, &s.AlertedAt); err != nil {
+			return nil, err
+		}
+		skus = append(skus, &s)
+	}
+	return skus, rows.Err()
+}
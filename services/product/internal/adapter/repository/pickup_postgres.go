@@ -0,0 +1,413 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/daisuke8000/example-ec-platform/services/product/internal/domain"
+)
+
+const (
+	pickupLocationColumns = `
+		id, name, address, active, created_at, updated_at
+	`
+	pickupSlotColumns = `
+		id, location_id, starts_at, ends_at, capacity, reserved, created_at
+	`
+	pickupReservationColumns = `
+		order_id, location_id, slot_id, status, created_at, ready_at, collected_at, cancelled_at
+	`
+)
+
+type PostgresPickupRepository struct {
+	pool *pgxpool.Pool
+}
+
+func NewPostgresPickupRepository(pool *pgxpool.Pool) *PostgresPickupRepository {
+	return &PostgresPickupRepository{pool: pool}
+}
+
+func (r *PostgresPickupRepository) CreateLocation(ctx context.Context, location *domain.PickupLocation) error {
+	_, err := r.pool.Exec(ctx, `
+		INSERT INTO product_service.pickup_locations (id, name, address, active, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6)
+	`, location.ID, location.Name, location.Address, location.Active, location.CreatedAt, location.UpdatedAt)
+	return err
+}
+
+func (r *PostgresPickupRepository) SetLocationActive(ctx context.Context, id uuid.UUID, active bool) (*domain.PickupLocation, error) {
+	location, err := scanPickupLocation(r.pool.QueryRow(ctx, `
+		UPDATE product_service.pickup_locations
+		SET active = $2, updated_at = NOW()
+		WHERE id = $1
+		RETURNING `+pickupLocationColumns, id, active))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, domain.ErrPickupLocationNotFound
+	}
+	return location, err
+}
+
+func (r *PostgresPickupRepository) FindLocations(ctx context.Context, activeOnly bool) ([]*domain.PickupLocation, error) {
+	rows, err := r.pool.Query(ctx, `
+		SELECT `+pickupLocationColumns+`
+		FROM product_service.pickup_locations
+		WHERE active OR NOT $1
+		ORDER BY name, id
+	`, activeOnly)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var locations []*domain.PickupLocation
+	for rows.Next() {
+		l, err := scanPickupLocation(rows)
+		if err != nil {
+			return nil, err
+		}
+		locations = append(locations, l)
+	}
+	return locations, rows.Err()
+}
+
+func (r *PostgresPickupRepository) SetStock(ctx context.Context, locationID, skuID uuid.UUID, quantity int64) error {
+	_, err := r.pool.Exec(ctx, `
+		INSERT INTO product_service.pickup_stock (location_id, sku_id, quantity, updated_at)
+		VALUES ($1, $2, $3, NOW())
+		ON CONFLICT (location_id, sku_id) DO UPDATE SET
+			quantity = EXCLUDED.quantity,
+			updated_at = EXCLUDED.updated_at
+	`, locationID, skuID, quantity)
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) && pgErr.Code == pgForeignKeyViolation {
+		if pgErr.ConstraintName == "pickup_stock_location_id_fkey" {
+			return fmt.Errorf("%w: %s", domain.ErrPickupLocationNotFound, locationID)
+		}
+		return fmt.Errorf("%w: %s", domain.ErrSKUNotFound, skuID)
+	}
+	return err
+}
+
+// Availability counts, per active location, the lines it has enough units
+// of; a location is available when that is every line.
+func (r *PostgresPickupRepository) Availability(ctx context.Context, items []domain.PickupItem) ([]*domain.PickupAvailability, error) {
+	skuIDs := make([]uuid.UUID, len(items))
+	quantities := make([]int64, len(items))
+	for i, item := range items {
+		skuIDs[i] = item.SKUID
+		quantities[i] = item.Quantity
+	}
+
+	rows, err := r.pool.Query(ctx, `
+		SELECT `+pickupLocationColumns+`, (
+			SELECT COUNT(*)
+			FROM UNNEST($1::uuid[], $2::bigint[]) AS item(sku_id, quantity)
+			JOIN product_service.pickup_stock s
+				ON s.location_id = l.id AND s.sku_id = item.sku_id AND s.quantity >= item.quantity
+		) = $3
+		FROM product_service.pickup_locations l
+		WHERE l.active
+		ORDER BY l.name, l.id
+	`, skuIDs, quantities, len(items))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var availability []*domain.PickupAvailability
+	for rows.Next() {
+		var l domain.PickupLocation
+		var a domain.PickupAvailability
+		if err := rows.Scan(
+			&l.ID,
+			&l.Name,
+			&l.Address,
+			&l.Active,
+			&l.CreatedAt,
+			&l.UpdatedAt,
+			&a.Available,
+		); err != nil {
+			return nil, err
+		}
+		a.Location = &l
+		availability = append(availability, &a)
+	}
+	return availability, rows.Err()
+}
+
+func (r *PostgresPickupRepository) CreateSlot(ctx context.Context, slot *domain.PickupSlot) error {
+	_, err := r.pool.Exec(ctx, `
+		INSERT INTO product_service.pickup_slots (id, location_id, starts_at, ends_at, capacity, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6)
+	`, slot.ID, slot.LocationID, slot.StartsAt, slot.EndsAt, slot.Capacity, slot.CreatedAt)
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) {
+		switch pgErr.Code {
+		case pgForeignKeyViolation:
+			return fmt.Errorf("%w: %s", domain.ErrPickupLocationNotFound, slot.LocationID)
+		case pgUniqueViolation:
+			return domain.ErrPickupSlotExists
+		}
+	}
+	return err
+}
+
+func (r *PostgresPickupRepository) FindSlots(ctx context.Context, locationID uuid.UUID, from, to time.Time) ([]*domain.PickupSlot, error) {
+	rows, err := r.pool.Query(ctx, `
+		SELECT `+pickupSlotColumns+`
+		FROM product_service.pickup_slots
+		WHERE location_id = $1 AND starts_at >= $2 AND starts_at < $3
+		ORDER BY starts_at
+	`, locationID, from, to)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var slots []*domain.PickupSlot
+	for rows.Next() {
+		s, err := scanPickupSlot(rows)
+		if err != nil {
+			return nil, err
+		}
+		slots = append(slots, s)
+	}
+	return slots, rows.Err()
+}
+
+// Reserve locks the slot, then the location's stock rows in SKU order, so
+// reservations of the same slot or SKUs serialize without deadlocking and a
+// retried order sees the reservation of the first attempt.
+func (r *PostgresPickupRepository) Reserve(ctx context.Context, orderID, slotID uuid.UUID, items []domain.PickupItem, at time.Time) (*domain.ReservedPickup, error) {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	slot, err := scanPickupSlot(tx.QueryRow(ctx, `
+		SELECT `+pickupSlotColumns+`
+		FROM product_service.pickup_slots
+		WHERE id = $1
+		FOR UPDATE
+	`, slotID))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, domain.ErrPickupSlotNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	existing, err := findPickupReservation(ctx, tx, orderID, false)
+	if err == nil {
+		return &domain.ReservedPickup{Replayed: true, Reservation: existing}, nil
+	}
+	if !errors.Is(err, domain.ErrPickupReservationNotFound) {
+		return nil, err
+	}
+
+	var active bool
+	if err := tx.QueryRow(ctx, `
+		SELECT active FROM product_service.pickup_locations WHERE id = $1
+	`, slot.LocationID).Scan(&active); err != nil {
+		return nil, err
+	}
+	if !active {
+		return nil, domain.ErrPickupLocationInactive
+	}
+	if err := slot.Reserve(at); err != nil {
+		return nil, err
+	}
+
+	sorted := make([]domain.PickupItem, len(items))
+	copy(sorted, items)
+	sort.Slice(sorted, func(a, b int) bool {
+		return sorted[a].SKUID.String() < sorted[b].SKUID.String()
+	})
+
+	if _, err := tx.Exec(ctx, `
+		UPDATE product_service.pickup_slots SET reserved = $2 WHERE id = $1
+	`, slot.ID, slot.Reserved); err != nil {
+		return nil, err
+	}
+	if _, err := tx.Exec(ctx, `
+		INSERT INTO product_service.pickup_reservations (order_id, location_id, slot_id, created_at)
+		VALUES ($1, $2, $3, $4)
+	`, orderID, slot.LocationID, slot.ID, at); err != nil {
+		return nil, err
+	}
+	for _, item := range sorted {
+		tag, err := tx.Exec(ctx, `
+			UPDATE product_service.pickup_stock
+			SET quantity = quantity - $3, updated_at = $4
+			WHERE location_id = $1 AND sku_id = $2 AND quantity >= $3
+		`, slot.LocationID, item.SKUID, item.Quantity, at)
+		if err != nil {
+			return nil, err
+		}
+		if tag.RowsAffected() == 0 {
+			return nil, fmt.Errorf("%w: %s", domain.ErrInsufficientStock, item.SKUID)
+		}
+		if _, err := tx.Exec(ctx, `
+			INSERT INTO product_service.pickup_reservation_items (order_id, sku_id, quantity)
+			VALUES ($1, $2, $3)
+		`, orderID, item.SKUID, item.Quantity); err != nil {
+			return nil, err
+		}
+	}
+
+	reservation, err := findPickupReservation(ctx, tx, orderID, false)
+	if err != nil {
+		return nil, err
+	}
+	if err := tx.Commit(ctx); err != nil {
+		return nil, err
+	}
+	return &domain.ReservedPickup{Reservation: reservation}, nil
+}
+
+func (r *PostgresPickupRepository) FindReservation(ctx context.Context, orderID uuid.UUID) (*domain.PickupReservation, error) {
+	return findPickupReservation(ctx, r.pool, orderID, false)
+}
+
+// Transition returns the slot and stock of a cancelled reservation in the
+// same transaction.
+func (r *PostgresPickupRepository) Transition(ctx context.Context, orderID uuid.UUID, status domain.PickupStatus, at time.Time) (*domain.PickupReservation, error) {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	reservation, err := findPickupReservation(ctx, tx, orderID, true)
+	if err != nil {
+		return nil, err
+	}
+	if err := reservation.TransitionTo(status, at); err != nil {
+		return nil, err
+	}
+
+	if _, err := tx.Exec(ctx, `
+		UPDATE product_service.pickup_reservations
+		SET status = $2, ready_at = $3, collected_at = $4, cancelled_at = $5
+		WHERE order_id = $1
+	`, orderID, reservation.Status, reservation.ReadyAt, reservation.CollectedAt, reservation.CancelledAt); err != nil {
+		return nil, err
+	}
+
+	if status == domain.PickupCancelled {
+		if _, err := tx.Exec(ctx, `
+			UPDATE product_service.pickup_slots SET reserved = reserved - 1 WHERE id = $1
+		`, reservation.SlotID); err != nil {
+			return nil, err
+		}
+		if _, err := tx.Exec(ctx, `
+			UPDATE product_service.pickup_stock s
+			SET quantity = s.quantity + i.quantity, updated_at = $2
+			FROM product_service.pickup_reservation_items i
+			WHERE i.order_id = $1 AND s.location_id = $3 AND s.sku_id = i.sku_id
+		`, orderID, at, reservation.LocationID); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, err
+	}
+	return reservation, nil
+}
+
+type pickupQueryer interface {
+	queryer
+	QueryRow(ctx context.Context, sql string, args ...any) pgx.Row
+}
+
+// findPickupReservation reads a reservation with its items, locking the
+// reservation row if forUpdate is set.
+func findPickupReservation(ctx context.Context, q pickupQueryer, orderID uuid.UUID, forUpdate bool) (*domain.PickupReservation, error) {
+	lock := ""
+	if forUpdate {
+		lock = "FOR UPDATE"
+	}
+
+	var res domain.PickupReservation
+	err := q.QueryRow(ctx, `
+		SELECT `+pickupReservationColumns+`
+		FROM product_service.pickup_reservations
+		WHERE order_id = $1
+		`+lock, orderID).Scan(
+		&res.OrderID,
+		&res.LocationID,
+		&res.SlotID,
+		&res.Status,
+		&res.CreatedAt,
+		&res.ReadyAt,
+		&res.CollectedAt,
+		&res.CancelledAt,
+	)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, domain.ErrPickupReservationNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := q.Query(ctx, `
+		SELECT sku_id, quantity
+		FROM product_service.pickup_reservation_items
+		WHERE order_id = $1
+		ORDER BY sku_id
+	`, orderID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var item domain.PickupItem
+		if err := rows.Scan(&item.SKUID, &item.Quantity); err != nil {
+			return nil, err
+		}
+		res.Items = append(res.Items, item)
+	}
+	return &res, rows.Err()
+}
+
+func scanPickupLocation(row pgx.Row) (*domain.PickupLocation, error) {
+	var l domain.PickupLocation
+	if err := row.Scan(
+		&l.ID,
+		&l.Name,
+		&l.Address,
+		&l.Active,
+		&l.CreatedAt,
+		&l.UpdatedAt,
+	); err != nil {
+		return nil, err
+	}
+	return &l, nil
+}
+
+func scanPickupSlot(row pgx.Row) (*domain.PickupSlot, error) {
+	var s domain.PickupSlot
+	if err := row.Scan(
+		&s.ID,
+		&s.LocationID,
+		&s.StartsAt,
+		&s.EndsAt,
+		&s.Capacity,
+		&s.Reserved,
+		&s.CreatedAt,
+	); err != nil {
+		return nil, err
+	}
+	return &s, nil
+}
@@ -0,0 +1,291 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/daisuke8000/example-ec-platform/services/product/internal/domain"
+)
+
+const (
+	preorderCampaignColumns = `
+		sku_id, starts_at, ends_at, ship_date, allocation_cap, allocated, created_at, updated_at
+	`
+	preorderAllocationColumns = `
+		order_id, sku_id, quantity, status, created_at, released_at
+	`
+)
+
+type PostgresPreorderRepository struct {
+	pool *pgxpool.Pool
+}
+
+func NewPostgresPreorderRepository(pool *pgxpool.Pool) *PostgresPreorderRepository {
+	return &PostgresPreorderRepository{pool: pool}
+}
+
+// UpsertCampaign locks the previous campaign so that concurrent changes
+// each see the ship date they replace.
+func (r *PostgresPreorderRepository) UpsertCampaign(ctx context.Context, campaign *domain.PreorderCampaign) (*domain.PreorderCampaign, error) {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	previous, err := scanPreorderCampaign(tx.QueryRow(ctx, `
+		SELECT `+preorderCampaignColumns+`
+		FROM product_service.preorder_campaigns
+		WHERE sku_id = $1
+		FOR UPDATE
+	`, campaign.SKUID))
+	if errors.Is(err, pgx.ErrNoRows) {
+		previous = nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	err = tx.QueryRow(ctx, `
+		INSERT INTO product_service.preorder_campaigns
+			(sku_id, starts_at, ends_at, ship_date, allocation_cap, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+		ON CONFLICT (sku_id) DO UPDATE SET
+			starts_at = EXCLUDED.starts_at,
+			ends_at = EXCLUDED.ends_at,
+			ship_date = EXCLUDED.ship_date,
+			allocation_cap = EXCLUDED.allocation_cap,
+			updated_at = EXCLUDED.updated_at
+		RETURNING allocated, created_at
+	`, campaign.SKUID, campaign.StartsAt, campaign.EndsAt, campaign.ShipDate,
+		campaign.AllocationCap, campaign.CreatedAt, campaign.UpdatedAt,
+	).Scan(&campaign.Allocated, &campaign.CreatedAt)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == pgForeignKeyViolation {
+			return nil, fmt.Errorf("%w: %s", domain.ErrSKUNotFound, campaign.SKUID)
+		}
+		return nil, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, err
+	}
+	return previous, nil
+}
+
+func (r *PostgresPreorderRepository) FindCampaigns(ctx context.Context, skuIDs []uuid.UUID) ([]*domain.PreorderCampaign, error) {
+	return findPreorderCampaigns(ctx, r.pool, `WHERE sku_id = ANY($1)`, skuIDs)
+}
+
+// Allocate locks the order's campaigns in SKU order, so allocations of the
+// same SKUs serialize without deadlocking and a retried order sees the
+// allocations of the first attempt.
+func (r *PostgresPreorderRepository) Allocate(ctx context.Context, orderID uuid.UUID, items []domain.PreorderItem, at time.Time) (*domain.AllocatedPreorder, error) {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	skuIDs := make([]uuid.UUID, len(items))
+	for i, item := range items {
+		skuIDs[i] = item.SKUID
+	}
+	campaigns, err := findPreorderCampaigns(ctx, tx, `WHERE sku_id = ANY($1) ORDER BY sku_id FOR UPDATE`, skuIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	existing, err := findPreorderAllocations(ctx, tx, orderID)
+	if err != nil {
+		return nil, err
+	}
+	if len(existing) > 0 {
+		return &domain.AllocatedPreorder{Replayed: true, Allocations: existing}, nil
+	}
+
+	bySKU := make(map[uuid.UUID]*domain.PreorderCampaign, len(campaigns))
+	for _, c := range campaigns {
+		bySKU[c.SKUID] = c
+	}
+
+	// Lines are allocated in SKU order for a stable allocation order.
+	sorted := make([]domain.PreorderItem, len(items))
+	copy(sorted, items)
+	sort.Slice(sorted, func(a, b int) bool {
+		return sorted[a].SKUID.String() < sorted[b].SKUID.String()
+	})
+
+	for _, item := range sorted {
+		campaign, ok := bySKU[item.SKUID]
+		if !ok {
+			return nil, fmt.Errorf("%w: %s", domain.ErrPreorderCampaignNotFound, item.SKUID)
+		}
+		if err := campaign.Allocate(item.Quantity, at); err != nil {
+			return nil, fmt.Errorf("%w: %s", err, item.SKUID)
+		}
+		if _, err := tx.Exec(ctx, `
+			UPDATE product_service.preorder_campaigns SET allocated = $2 WHERE sku_id = $1
+		`, campaign.SKUID, campaign.Allocated); err != nil {
+			return nil, err
+		}
+		if _, err := tx.Exec(ctx, `
+			INSERT INTO product_service.preorder_allocations (order_id, sku_id, quantity, created_at)
+			VALUES ($1, $2, $3, $4)
+		`, orderID, item.SKUID, item.Quantity, at); err != nil {
+			return nil, err
+		}
+	}
+
+	allocations, err := findPreorderAllocations(ctx, tx, orderID)
+	if err != nil {
+		return nil, err
+	}
+	if err := tx.Commit(ctx); err != nil {
+		return nil, err
+	}
+	return &domain.AllocatedPreorder{Allocations: allocations}, nil
+}
+
+// Release returns the released units to their campaigns in the same
+// statement.
+func (r *PostgresPreorderRepository) Release(ctx context.Context, orderID uuid.UUID) ([]*domain.PreorderAllocation, error) {
+	rows, err := r.pool.Query(ctx, `
+		WITH released AS (
+			UPDATE product_service.preorder_allocations
+			SET status = 'released', released_at = NOW()
+			WHERE order_id = $1 AND status = 'allocated'
+			RETURNING `+preorderAllocationColumns+`
+		), returned AS (
+			UPDATE product_service.preorder_campaigns c
+			SET allocated = c.allocated - released.quantity
+			FROM released
+			WHERE c.sku_id = released.sku_id
+		)
+		SELECT `+preorderAllocationColumns+` FROM released ORDER BY sku_id
+	`, orderID)
+	if err != nil {
+		return nil, err
+	}
+	released, err := scanPreorderAllocations(rows)
+	if err != nil {
+		return nil, err
+	}
+	if len(released) > 0 {
+		return released, nil
+	}
+
+	var exists bool
+	if err := r.pool.QueryRow(ctx, `
+		SELECT EXISTS (SELECT 1 FROM product_service.preorder_allocations WHERE order_id = $1)
+	`, orderID).Scan(&exists); err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, domain.ErrPreorderAllocationNotFound
+	}
+	return nil, nil
+}
+
+func (r *PostgresPreorderRepository) AllocatedOrderIDs(ctx context.Context, skuID uuid.UUID) ([]uuid.UUID, error) {
+	rows, err := r.pool.Query(ctx, `
+		SELECT order_id
+		FROM product_service.preorder_allocations
+		WHERE sku_id = $1 AND status = 'allocated'
+		ORDER BY created_at, order_id
+	`, skuID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var orderIDs []uuid.UUID
+	for rows.Next() {
+		var id uuid.UUID
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		orderIDs = append(orderIDs, id)
+	}
+	return orderIDs, rows.Err()
+}
+
+func findPreorderCampaigns(ctx context.Context, q queryer, where string, args ...any) ([]*domain.PreorderCampaign, error) {
+	rows, err := q.Query(ctx, `
+		SELECT `+preorderCampaignColumns+`
+		FROM product_service.preorder_campaigns
+		`+where, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var campaigns []*domain.PreorderCampaign
+	for rows.Next() {
+		c, err := scanPreorderCampaign(rows)
+		if err != nil {
+			return nil, err
+		}
+		campaigns = append(campaigns, c)
+	}
+	return campaigns, rows.Err()
+}
+
+func scanPreorderCampaign(row pgx.Row) (*domain.PreorderCampaign, error) {
+	var c domain.PreorderCampaign
+	if err := row.Scan(
+		&c.SKUID,
+		&c.StartsAt,
+		&c.EndsAt,
+		&c.ShipDate,
+		&c.AllocationCap,
+		&c.Allocated,
+		&c.CreatedAt,
+		&c.UpdatedAt,
+	); err != nil {
+		return nil, err
+	}
+	return &c, nil
+}
+
+func findPreorderAllocations(ctx context.Context, q queryer, orderID uuid.UUID) ([]*domain.PreorderAllocation, error) {
+	rows, err := q.Query(ctx, `
+		SELECT `+preorderAllocationColumns+`
+		FROM product_service.preorder_allocations
+		WHERE order_id = $1
+		ORDER BY sku_id
+	`, orderID)
+	if err != nil {
+		return nil, err
+	}
+	return scanPreorderAllocations(rows)
+}
+
+func scanPreorderAllocations(rows pgx.Rows) ([]*domain.PreorderAllocation, error) {
+	defer rows.Close()
+
+	var allocations []*domain.PreorderAllocation
+	for rows.Next() {
+		var a domain.PreorderAllocation
+		if err := rows.Scan(
+			&a.OrderID,
+			&a.SKUID,
+			&a.Quantity,
+			&a.Status,
+			&a.CreatedAt,
+			&a.ReleasedAt,
+		); err != nil {
+			return nil, err
+		}
+		allocations = append(allocations, &a)
+	}
+	return allocations, rows.Err()
+}
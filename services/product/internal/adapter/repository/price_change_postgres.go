@@ -0,0 +1,238 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/daisuke8000/example-ec-platform/services/product/internal/domain"
+)
+
+type PostgresPriceChangeRepository struct {
+	pool *pgxpool.Pool
+}
+
+func NewPostgresPriceChangeRepository(pool *pgxpool.Pool) *PostgresPriceChangeRepository {
+	return &PostgresPriceChangeRepository{pool: pool}
+}
+
+func (r *PostgresPriceChangeRepository) Create(ctx context.Context, changes ...*domain.PriceChange) error {
+	if len(changes) == 0 {
+		return nil
+	}
+
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	query := `
+		INSERT INTO product_service.price_changes
+			(id, sku_id, currency, amount, previous_amount, effective_from, status, actor, created_at, applied_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+	`
+	for _, c := range changes {
+		if _, err := tx.Exec(ctx, query,
+			c.ID,
+			c.SKUID,
+			c.Price.Currency,
+			c.Price.Amount,
+			c.PreviousAmount,
+			c.EffectiveFrom,
+			c.Status,
+			c.Actor,
+			c.CreatedAt,
+			c.AppliedAt,
+		); err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit(ctx)
+}
+
+func (r *PostgresPriceChangeRepository) ListBySKU(ctx context.Context, skuID uuid.UUID, limit int, after *domain.PriceChangeCursor) ([]*domain.PriceChange, error) {
+	query := `
+		SELECT id, sku_id, currency, amount, previous_amount, effective_from, status, actor, created_at, applied_at
+		FROM product_service.price_changes
+		WHERE sku_id = $1
+		ORDER BY effective_from DESC, id DESC
+		LIMIT $2
+	`
+	args := []any{skuID, limit}
+	if after != nil {
+		query = `
+			SELECT id, sku_id, currency, amount, previous_amount, effective_from, status, actor, created_at, applied_at
+			FROM product_service.price_changes
+			WHERE sku_id = $1 AND (effective_from, id) < ($3, $4)
+			ORDER BY effective_from DESC, id DESC
+			LIMIT $2
+		`
+		args = append(args, after.EffectiveFrom, after.ID)
+	}
+
+	rows, err := r.pool.Query(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var changes []*domain.PriceChange
+	for rows.Next() {
+		var c domain.PriceChange
+		if err := rows.Scan(
+			&c.ID,
+			&c.SKUID,
+			&c.Price.Currency,
+			&c.Price.Amount,
+			&c.PreviousAmount,
+			&c.EffectiveFrom,
+			&c.Status,
+			&c.Actor,
+			&c.CreatedAt,
+			&c.AppliedAt,
+		); err != nil {
+			return nil, err
+		}
+		changes = append(changes, &c)
+	}
+	return changes, rows.Err()
+}
+
+// ApplyDue locks due changes together with their SKUs, so a concurrent
+// UpdateSKU cannot interleave with the read of the previous price.
+// A change whose currency is the SKU's base currency at the time it is
+// applied updates the base price; any other currency is upserted into
+// sku_prices. Changes for deleted SKUs are cancelled.
+func (r *PostgresPriceChangeRepository) ApplyDue(ctx context.Context, limit int) ([]*domain.PriceChange, error) {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	query := `
+		SELECT pc.id, pc.sku_id, pc.currency, pc.amount, pc.effective_from, pc.actor, pc.created_at,
+			s.price_currency, s.price_amount, sp.amount, s.deleted_at IS NOT NULL
+		FROM product_service.price_changes pc
+		JOIN product_service.skus s ON s.id = pc.sku_id
+		LEFT JOIN product_service.sku_prices sp ON sp.sku_id = pc.sku_id AND sp.currency = pc.currency
+		WHERE pc.status = 'scheduled' AND pc.effective_from <= NOW()
+		ORDER BY pc.effective_from, pc.created_at
+		LIMIT $1
+		FOR UPDATE OF pc, s SKIP LOCKED
+	`
+	rows, err := tx.Query(ctx, query, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	type dueChange struct {
+		change       *domain.PriceChange
+		baseCurrency string
+		baseAmount   int64
+		priceAmount  *int64
+		skuDeleted   bool
+	}
+	var due []dueChange
+	for rows.Next() {
+		c := &domain.PriceChange{Status: domain.PriceChangeStatusScheduled}
+		var d dueChange
+		if err := rows.Scan(
+			&c.ID,
+			&c.SKUID,
+			&c.Price.Currency,
+			&c.Price.Amount,
+			&c.EffectiveFrom,
+			&c.Actor,
+			&c.CreatedAt,
+			&d.baseCurrency,
+			&d.baseAmount,
+			&d.priceAmount,
+			&d.skuDeleted,
+		); err != nil {
+			rows.Close()
+			return nil, err
+		}
+		d.change = c
+		due = append(due, d)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	if len(due) == 0 {
+		return nil, nil
+	}
+
+	now := time.Now().UTC()
+	// Several changes to the same price may be due in one batch; each must
+	// record the amount set by the one before it.
+	type priceKey struct {
+		skuID    uuid.UUID
+		currency string
+	}
+	current := make(map[priceKey]*int64)
+
+	changes := make([]*domain.PriceChange, len(due))
+	for i, d := range due {
+		c := d.change
+		changes[i] = c
+
+		if d.skuDeleted {
+			c.Status = domain.PriceChangeStatusCancelled
+			if _, err := tx.Exec(ctx, `
+				UPDATE product_service.price_changes SET status = $2 WHERE id = $1
+			`, c.ID, c.Status); err != nil {
+				return nil, err
+			}
+			continue
+		}
+
+		key := priceKey{skuID: c.SKUID, currency: c.Price.Currency}
+		previous, seen := current[key]
+		if !seen {
+			previous = d.priceAmount
+			if c.Price.Currency == d.baseCurrency {
+				previous = &d.baseAmount
+			}
+		}
+
+		if c.Price.Currency == d.baseCurrency {
+			_, err = tx.Exec(ctx, `
+				UPDATE product_service.skus SET price_amount = $2, updated_at = $3 WHERE id = $1
+			`, c.SKUID, c.Price.Amount, now)
+		} else {
+			_, err = tx.Exec(ctx, `
+				INSERT INTO product_service.sku_prices (sku_id, currency, amount)
+				VALUES ($1, $2, $3)
+				ON CONFLICT (sku_id, currency) DO UPDATE SET amount = EXCLUDED.amount
+			`, c.SKUID, c.Price.Currency, c.Price.Amount)
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		c.Status = domain.PriceChangeStatusApplied
+		c.PreviousAmount = previous
+		c.AppliedAt = &now
+		if _, err := tx.Exec(ctx, `
+			UPDATE product_service.price_changes
+			SET status = $2, previous_amount = $3, applied_at = $4
+			WHERE id = $1
+		`, c.ID, c.Status, c.PreviousAmount, c.AppliedAt); err != nil {
+			return nil, err
+		}
+
+		amount := c.Price.Amount
+		current[key] = &amount
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, err
+	}
+	return changes, nil
+}
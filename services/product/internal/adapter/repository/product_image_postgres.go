@@ -0,0 +1,243 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/daisuke8000/example-ec-platform/services/product/internal/domain"
+)
+
+type PostgresProductImageRepository struct {
+	pool *pgxpool.Pool
+}
+
+func NewPostgresProductImageRepository(pool *pgxpool.Pool) *PostgresProductImageRepository {
+	return &PostgresProductImageRepository{pool: pool}
+}
+
+func (r *PostgresProductImageRepository) Create(ctx context.Context, image *domain.ProductImage) error {
+	query := `
+		INSERT INTO product_service.product_images
+			(id, product_id, object_key, content_type, size_bytes, alt_text, position, status, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+	`
+	_, err := r.pool.Exec(ctx, query,
+		image.ID,
+		image.ProductID,
+		image.ObjectKey,
+		image.ContentType,
+		image.SizeBytes,
+		image.AltText,
+		image.Position,
+		image.Status,
+		image.CreatedAt,
+		image.UpdatedAt,
+	)
+	return err
+}
+
+func (r *PostgresProductImageRepository) FindByID(ctx context.Context, id uuid.UUID) (*domain.ProductImage, error) {
+	query := `
+		SELECT id, product_id, object_key, content_type, size_bytes, alt_text, position, status, created_at, updated_at
+		FROM product_service.product_images
+		WHERE id = $1
+	`
+	var img domain.ProductImage
+	err := r.pool.QueryRow(ctx, query, id).Scan(
+		&img.ID,
+		&img.ProductID,
+		&img.ObjectKey,
+		&img.ContentType,
+		&img.SizeBytes,
+		&img.AltText,
+		&img.Position,
+		&img.Status,
+		&img.CreatedAt,
+		&img.UpdatedAt,
+	)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, domain.ErrProductImageNotFound
+		}
+		return nil, err
+	}
+	return &img, nil
+}
+
+func (r *PostgresProductImageRepository) ListByProduct(ctx context.Context, productID uuid.UUID) ([]*domain.ProductImage, error) {
+	query := `
+		SELECT id, product_id, object_key, content_type, size_bytes, alt_text, position, status, created_at, updated_at
+		FROM product_service.product_images
+		WHERE product_id = $1 AND status = 'ready'
+		ORDER BY position, created_at
+	`
+	rows, err := r.pool.Query(ctx, query, productID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var images []*domain.ProductImage
+	for rows.Next() {
+		var img domain.ProductImage
+		if err := rows.Scan(
+			&img.ID,
+			&img.ProductID,
+			&img.ObjectKey,
+			&img.ContentType,
+			&img.SizeBytes,
+			&img.AltText,
+			&img.Position,
+			&img.Status,
+			&img.CreatedAt,
+			&img.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		images = append(images, &img)
+	}
+	return images, rows.Err()
+}
+
+// MarkReady locks the product row so concurrent completions cannot exceed
+// MaxProductImages or take the same position.
+func (r *PostgresProductImageRepository) MarkReady(ctx context.Context, image *domain.ProductImage) error {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	if err := lockProduct(ctx, tx, image.ProductID); err != nil {
+		return err
+	}
+
+	var count int
+	var next int32
+	if err := tx.QueryRow(ctx, `
+		SELECT COUNT(*), COALESCE(MAX(position) + 1, 0)
+		FROM product_service.product_images
+		WHERE product_id = $1 AND status = 'ready'
+	`, image.ProductID).Scan(&count, &next); err != nil {
+		return err
+	}
+	if count >= domain.MaxProductImages {
+		return domain.ErrTooManyImages
+	}
+
+	result, err := tx.Exec(ctx, `
+		UPDATE product_service.product_images
+		SET status = 'ready', size_bytes = $2, position = $3, updated_at = $4
+		WHERE id = $1 AND status = 'pending'
+	`, image.ID, image.SizeBytes, next, image.UpdatedAt)
+	if err != nil {
+		return err
+	}
+	if result.RowsAffected() == 0 {
+		return domain.ErrImageNotPending
+	}
+	if err := tx.Commit(ctx); err != nil {
+		return err
+	}
+
+	image.Status = domain.ProductImageStatusReady
+	image.Position = next
+	return nil
+}
+
+func (r *PostgresProductImageRepository) UpdateAltText(ctx context.Context, image *domain.ProductImage) error {
+	result, err := r.pool.Exec(ctx, `
+		UPDATE product_service.product_images
+		SET alt_text = $2, updated_at = $3
+		WHERE id = $1
+	`, image.ID, image.AltText, image.UpdatedAt)
+	if err != nil {
+		return err
+	}
+	if result.RowsAffected() == 0 {
+		return domain.ErrProductImageNotFound
+	}
+	return nil
+}
+
+func (r *PostgresProductImageRepository) Reorder(ctx context.Context, productID uuid.UUID, ids []uuid.UUID) error {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	if err := lockProduct(ctx, tx, productID); err != nil {
+		return err
+	}
+
+	rows, err := tx.Query(ctx, `
+		SELECT id FROM product_service.product_images
+		WHERE product_id = $1 AND status = 'ready'
+	`, productID)
+	if err != nil {
+		return err
+	}
+	remaining := make(map[uuid.UUID]bool)
+	for rows.Next() {
+		var id uuid.UUID
+		if err := rows.Scan(&id); err != nil {
+			rows.Close()
+			return err
+		}
+		remaining[id] = true
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return err
+	}
+
+	if len(remaining) != len(ids) {
+		return domain.ErrImageOrderMismatch
+	}
+	for _, id := range ids {
+		if !remaining[id] {
+			return domain.ErrImageOrderMismatch
+		}
+		delete(remaining, id)
+	}
+
+	if _, err := tx.Exec(ctx, `
+		UPDATE product_service.product_images pi
+		SET position = o.ord - 1, updated_at = NOW()
+		FROM unnest($1::uuid[]) WITH ORDINALITY AS o(id, ord)
+		WHERE pi.id = o.id
+	`, ids); err != nil {
+		return err
+	}
+
+	return tx.Commit(ctx)
+}
+
+func (r *PostgresProductImageRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	result, err := r.pool.Exec(ctx, `DELETE FROM product_service.product_images WHERE id = $1`, id)
+	if err != nil {
+		return err
+	}
+	if result.RowsAffected() == 0 {
+		return domain.ErrProductImageNotFound
+	}
+	return nil
+}
+
+func lockProduct(ctx context.Context, tx pgx.Tx, productID uuid.UUID) error {
+	var id uuid.UUID
+	err := tx.QueryRow(ctx, `
+		SELECT id FROM product_service.products
+		WHERE id = $1 AND deleted_at IS NULL
+		FOR UPDATE
+	`, productID).Scan(&id)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return domain.ErrProductNotFound
+	}
+	return err
+}
@@ -0,0 +1,107 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/daisuke8000/example-ec-platform/services/product/internal/domain"
+)
+
+type PostgresProductImporter struct {
+	pool *pgxpool.Pool
+}
+
+func NewPostgresProductImporter(pool *pgxpool.Pool) *PostgresProductImporter {
+	return &PostgresProductImporter{pool: pool}
+}
+
+// ImportBatch writes each product under its own savepoint, so a product
+// with a taken SKU code is dropped without losing the rest of the batch.
+func (r *PostgresProductImporter) ImportBatch(ctx context.Context, products []*domain.ImportedProduct) ([]error, error) {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	rowErrs := make([]error, len(products))
+	for i, product := range products {
+		savepoint, err := tx.Begin(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if err := insertImportedProduct(ctx, savepoint, product); err != nil {
+			if rbErr := savepoint.Rollback(ctx); rbErr != nil {
+				return nil, rbErr
+			}
+			if !errors.Is(err, domain.ErrSKUCodeAlreadyExists) {
+				return nil, err
+			}
+			rowErrs[i] = err
+			continue
+		}
+		if err := savepoint.Commit(ctx); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, err
+	}
+	return rowErrs, nil
+}
+
+func insertImportedProduct(ctx context.Context, tx pgx.Tx, product *domain.ImportedProduct) error {
+	p := product.Product
+	if _, err := tx.Exec(ctx, `
+		INSERT INTO product_service.products (id, name, description, category_id, status, channels, markets, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+	`,
+		p.ID,
+		p.Name,
+		p.Description,
+		p.CategoryID,
+		p.Status,
+		textArray(p.Visibility.Channels),
+		textArray(p.Visibility.Markets),
+		p.CreatedAt,
+		p.UpdatedAt,
+	); err != nil {
+		return err
+	}
+
+	for i, sku := range product.SKUs {
+		if _, err := tx.Exec(ctx, `
+			INSERT INTO product_service.skus (id, product_id, sku_code, price_amount, price_currency, attributes, created_at, updated_at)
+			VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+		`,
+			sku.ID,
+			sku.ProductID,
+			sku.SKUCode,
+			sku.Price.Amount,
+			sku.Price.Currency,
+			sku.Attributes,
+			sku.CreatedAt,
+			sku.UpdatedAt,
+		); err != nil {
+			var pgErr *pgconn.PgError
+			if errors.As(err, &pgErr) && pgErr.Code == pgUniqueViolation {
+				return domain.ErrSKUCodeAlreadyExists
+			}
+			return err
+		}
+
+		inventory := product.Inventories[i]
+		if _, err := tx.Exec(ctx, `
+			INSERT INTO product_service.inventory (sku_id, quantity, reserved, version)
+			VALUES ($1, $2, $3, $4)
+		`, inventory.SKUID, inventory.Quantity, inventory.Reserved, inventory.Version); err != nil {
+			return err
+		}
+	}
+	return nil
+}
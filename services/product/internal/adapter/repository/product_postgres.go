@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
@@ -13,18 +14,22 @@ import (
 	"github.com/daisuke8000/example-ec-platform/services/product/internal/domain"
 )
 
+// PostgresProductRepository reads the storefront queries (GetProduct and
+// ListProducts) through reads, which may be a read replica; everything else
+// uses the primary pool.
 type PostgresProductRepository struct {
-	pool *pgxpool.Pool
+	pool  *pgxpool.Pool
+	reads *ReadRouter
 }
 
-func NewPostgresProductRepository(pool *pgxpool.Pool) *PostgresProductRepository {
-	return &PostgresProductRepository{pool: pool}
+func NewPostgresProductRepository(pool *pgxpool.Pool, reads *ReadRouter) *PostgresProductRepository {
+	return &PostgresProductRepository{pool: pool, reads: reads}
 }
 
 func (r *PostgresProductRepository) Create(ctx context.Context, product *domain.Product) error {
 	query := `
-		INSERT INTO product_service.products (id, name, description, category_id, status, created_at, updated_at)
-		VALUES ($1, $2, $3, $4, $5, $6, $7)
+		INSERT INTO product_service.products (id, name, description, category_id, status, channels, markets, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
 	`
 	_, err := r.pool.Exec(ctx, query,
 		product.ID,
@@ -32,6 +37,8 @@ func (r *PostgresProductRepository) Create(ctx context.Context, product *domain.
 		product.Description,
 		product.CategoryID,
 		product.Status,
+		textArray(product.Visibility.Channels),
+		textArray(product.Visibility.Markets),
 		product.CreatedAt,
 		product.UpdatedAt,
 	)
@@ -39,16 +46,39 @@ func (r *PostgresProductRepository) Create(ctx context.Context, product *domain.
 }
 
 func (r *PostgresProductRepository) FindByID(ctx context.Context, id uuid.UUID) (*domain.Product, error) {
+	return r.findByID(ctx, r.pool, id)
+}
+
+func (r *PostgresProductRepository) findByID(ctx context.Context, q querier, id uuid.UUID) (*domain.Product, error) {
 	query := `
-		SELECT id, name, description, category_id, status, created_at, updated_at, deleted_at
+		SELECT id, name, description, category_id, status, channels, markets, created_at, updated_at, deleted_at
 		FROM product_service.products
 		WHERE id = $1 AND deleted_at IS NULL
 	`
-	return r.scanProduct(ctx, query, id)
+	return r.scanProduct(ctx, q, query, id)
+}
+
+func (r *PostgresProductRepository) FindByIDs(ctx context.Context, ids []uuid.UUID) ([]*domain.Product, error) {
+	if len(ids) == 0 {
+		return []*domain.Product{}, nil
+	}
+
+	query := `
+		SELECT id, name, description, category_id, status, channels, markets, created_at, updated_at, deleted_at
+		FROM product_service.products
+		WHERE id = ANY($1) AND deleted_at IS NULL
+	`
+	rows, err := r.pool.Query(ctx, query, ids)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	return r.scanProducts(rows)
 }
 
 func (r *PostgresProductRepository) FindByIDWithSKUs(ctx context.Context, id uuid.UUID) (*domain.ProductWithSKUs, error) {
-	product, err := r.FindByID(ctx, id)
+	product, err := r.findByID(ctx, r.reads, id)
 	if err != nil {
 		return nil, err
 	}
@@ -59,7 +89,7 @@ func (r *PostgresProductRepository) FindByIDWithSKUs(ctx context.Context, id uui
 		WHERE product_id = $1 AND deleted_at IS NULL
 		ORDER BY created_at
 	`
-	rows, err := r.pool.Query(ctx, query, id)
+	rows, err := r.reads.Query(ctx, query, id)
 	if err != nil {
 		return nil, err
 	}
@@ -116,20 +146,44 @@ func (r *PostgresProductRepository) List(ctx context.Context, filter domain.Prod
 		argIdx++
 	}
 
+	if filter.CreatedAfter != nil {
+		baseQuery += fmt.Sprintf(" AND created_at >= $%d", argIdx)
+		args = append(args, *filter.CreatedAfter)
+		argIdx++
+	}
+
+	if filter.CreatedBefore != nil {
+		baseQuery += fmt.Sprintf(" AND created_at < $%d", argIdx)
+		args = append(args, *filter.CreatedBefore)
+		argIdx++
+	}
+
+	if filter.Audience != nil {
+		baseQuery += fmt.Sprintf(" AND (cardinality(channels) = 0 OR $%d = ANY(channels))", argIdx)
+		args = append(args, filter.Audience.Channel)
+		argIdx++
+		baseQuery += fmt.Sprintf(" AND (cardinality(markets) = 0 OR $%d = ANY(markets))", argIdx)
+		args = append(args, filter.Audience.Market)
+		argIdx++
+	}
+
 	countQuery := "SELECT COUNT(*) " + baseQuery
 	var totalCount int64
-	if err := r.pool.QueryRow(ctx, countQuery, args...).Scan(&totalCount); err != nil {
+	if err := r.reads.QueryRow(ctx, countQuery, args...).Scan(&totalCount); err != nil {
 		return nil, 0, err
 	}
 
-	selectQuery := `SELECT id, name, description, category_id, status, created_at, updated_at, deleted_at ` + baseQuery
-	selectQuery += " ORDER BY created_at DESC"
+	selectQuery := `SELECT id, name, description, category_id, status, channels, markets, created_at, updated_at, deleted_at ` + baseQuery
+	selectQuery += " ORDER BY " + productOrderBy(pagination.Sort)
 
 	if pagination.PageSize > 0 {
 		selectQuery += fmt.Sprintf(" LIMIT %d", pagination.PageSize)
 	}
+	if pagination.Offset > 0 {
+		selectQuery += fmt.Sprintf(" OFFSET %d", pagination.Offset)
+	}
 
-	rows, err := r.pool.Query(ctx, selectQuery, args...)
+	rows, err := r.reads.Query(ctx, selectQuery, args...)
 	if err != nil {
 		return nil, 0, err
 	}
@@ -143,6 +197,33 @@ func (r *PostgresProductRepository) List(ctx context.Context, filter domain.Prod
 	return products, totalCount, nil
 }
 
+// productSortColumns maps sort fields to columns. Only mapped fields reach SQL.
+var productSortColumns = map[string]string{
+	"created_at": "created_at",
+	"updated_at": "updated_at",
+	"name":       "name",
+}
+
+// productOrderBy builds the ORDER BY clause, with id as a tiebreaker so
+// offsets are stable across pages.
+func productOrderBy(sort []domain.SortField) string {
+	if len(sort) == 0 {
+		return "created_at DESC, id DESC"
+	}
+	parts := make([]string, 0, len(sort)+1)
+	for _, f := range sort {
+		column, ok := productSortColumns[f.Field]
+		if !ok {
+			continue
+		}
+		if f.Desc {
+			column += " DESC"
+		}
+		parts = append(parts, column)
+	}
+	return strings.Join(append(parts, "id"), ", ")
+}
+
 func (r *PostgresProductRepository) Update(ctx context.Context, product *domain.Product) error {
 	query := `
 		UPDATE product_service.products
@@ -168,6 +249,30 @@ func (r *PostgresProductRepository) Update(ctx context.Context, product *domain.
 	return nil
 }
 
+func (r *PostgresProductRepository) UpdateVisibility(ctx context.Context, product *domain.Product) error {
+	query := `
+		UPDATE product_service.products
+		SET channels = $2, markets = $3, updated_at = $4
+		WHERE id = $1 AND deleted_at IS NULL
+	`
+	product.UpdatedAt = time.Now().UTC()
+
+	result, err := r.pool.Exec(ctx, query,
+		product.ID,
+		textArray(product.Visibility.Channels),
+		textArray(product.Visibility.Markets),
+		product.UpdatedAt,
+	)
+	if err != nil {
+		return err
+	}
+
+	if result.RowsAffected() == 0 {
+		return domain.ErrProductNotFound
+	}
+	return nil
+}
+
 func (r *PostgresProductRepository) UpdateStatus(ctx context.Context, id uuid.UUID, status domain.ProductStatus) error {
 	query := `
 		UPDATE product_service.products
@@ -241,14 +346,16 @@ func (r *PostgresProductRepository) SoftDeleteWithSKUs(ctx context.Context, id u
 	return tx.Commit(ctx)
 }
 
-func (r *PostgresProductRepository) scanProduct(ctx context.Context, query string, args ...any) (*domain.Product, error) {
+func (r *PostgresProductRepository) scanProduct(ctx context.Context, q querier, query string, args ...any) (*domain.Product, error) {
 	var p domain.Product
-	err := r.pool.QueryRow(ctx, query, args...).Scan(
+	err := q.QueryRow(ctx, query, args...).Scan(
 		&p.ID,
 		&p.Name,
 		&p.Description,
 		&p.CategoryID,
 		&p.Status,
+		&p.Visibility.Channels,
+		&p.Visibility.Markets,
 		&p.CreatedAt,
 		&p.UpdatedAt,
 		&p.DeletedAt,
@@ -272,6 +379,8 @@ func (r *PostgresProductRepository) scanProducts(rows pgx.Rows) ([]*domain.Produ
 			&p.Description,
 			&p.CategoryID,
 			&p.Status,
+			&p.Visibility.Channels,
+			&p.Visibility.Markets,
 			&p.CreatedAt,
 			&p.UpdatedAt,
 			&p.DeletedAt,
@@ -282,3 +391,12 @@ func (r *PostgresProductRepository) scanProducts(rows pgx.Rows) ([]*domain.Produ
 	}
 	return products, rows.Err()
 }
+
+// textArray keeps nil slices from being written as NULL to NOT NULL array
+// columns.
+func textArray(values []string) []string {
+	if values == nil {
+		return []string{}
+	}
+	return values
+}
@@ -0,0 +1,135 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"sync/atomic"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// querier is the subset of a pool used by read-only queries.
+type querier interface {
+	Query(ctx context.Context, sql string, args ...any) (pgx.Rows, error)
+	QueryRow(ctx context.Context, sql string, args ...any) pgx.Row
+}
+
+// ReadRouter sends catalog reads that tolerate replication lag to a read
+// replica, and falls back to the primary while the replica is unreachable.
+// Writes and transactional flows never go through it: repositories use the
+// primary pool for those, so a read followed by a write sees its own data.
+type ReadRouter struct {
+	primary *pgxpool.Pool
+	replica *pgxpool.Pool
+	logger  *slog.Logger
+
+	replicaDown atomic.Bool
+}
+
+// NewReadRouter creates a router. With a nil replica every read goes to the
+// primary.
+func NewReadRouter(primary, replica *pgxpool.Pool, logger *slog.Logger) *ReadRouter {
+	return &ReadRouter{primary: primary, replica: replica, logger: logger}
+}
+
+func (r *ReadRouter) Query(ctx context.Context, sql string, args ...any) (pgx.Rows, error) {
+	if !r.useReplica() {
+		return r.primary.Query(ctx, sql, args...)
+	}
+	rows, err := r.replica.Query(ctx, sql, args...)
+	if err != nil && r.fallback(ctx, err) {
+		return r.primary.Query(ctx, sql, args...)
+	}
+	return rows, err
+}
+
+func (r *ReadRouter) QueryRow(ctx context.Context, sql string, args ...any) pgx.Row {
+	if !r.useReplica() {
+		return r.primary.QueryRow(ctx, sql, args...)
+	}
+	return &fallbackRow{
+		router: r,
+		row:    r.replica.QueryRow(ctx, sql, args...),
+		ctx:    ctx,
+		sql:    sql,
+		args:   args,
+	}
+}
+
+// Start checks the replica every interval, so reads move back to it once it
+// is reachable again, until ctx is done.
+func (r *ReadRouter) Start(ctx context.Context, interval time.Duration) {
+	if r.replica == nil {
+		return
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			r.check(ctx, interval)
+		}
+	}
+}
+
+func (r *ReadRouter) check(ctx context.Context, timeout time.Duration) {
+	pingCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	if err := r.replica.Ping(pingCtx); err != nil {
+		if ctx.Err() == nil && !r.replicaDown.Swap(true) {
+			r.logger.Warn("read replica unreachable, reading from primary", slog.String("error", err.Error()))
+		}
+		return
+	}
+	if r.replicaDown.Swap(false) {
+		r.logger.Info("read replica reachable again")
+	}
+}
+
+func (r *ReadRouter) useReplica() bool {
+	return r.replica != nil && !r.replicaDown.Load()
+}
+
+// fallback reports whether a failed replica read should be retried on the
+// primary: the replica could not be reached, as opposed to the query itself
+// failing or the caller giving up. The replica is then skipped until Start
+// sees it reachable again.
+func (r *ReadRouter) fallback(ctx context.Context, err error) bool {
+	if ctx.Err() != nil {
+		return false
+	}
+	var connectErr *pgconn.ConnectError
+	if !errors.As(err, &connectErr) && !pgconn.SafeToRetry(err) {
+		return false
+	}
+	if !r.replicaDown.Swap(true) {
+		r.logger.Warn("read replica unreachable, reading from primary", slog.String("error", err.Error()))
+	}
+	return true
+}
+
+// fallbackRow retries a single-row replica read on the primary; pgx reports
+// errors of QueryRow only on Scan.
+type fallbackRow struct {
+	router *ReadRouter
+	row    pgx.Row
+	ctx    context.Context
+	sql    string
+	args   []any
+}
+
+func (f *fallbackRow) Scan(dest ...any) error {
+	err := f.row.Scan(dest...)
+	if err != nil && f.router.fallback(f.ctx, err) {
+		return f.router.primary.QueryRow(f.ctx, f.sql, f.args...).Scan(dest...)
+	}
+	return err
+}
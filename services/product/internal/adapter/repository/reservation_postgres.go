@@ -44,32 +44,15 @@ func (r *PostgresReservationRepository) Create(ctx context.Context, reservation
 
 func (r *PostgresReservationRepository) FindByID(ctx context.Context, id uuid.UUID) (*domain.Reservation, error) {
 	query := `
-		SELECT id, status, items, expires_at, created_at, updated_at
+		SELECT ` + reservationColumns + `
 		FROM product_service.reservations
 		WHERE id = $1
 	`
-	var res domain.Reservation
-	var itemsJSON []byte
-
-	err := r.pool.QueryRow(ctx, query, id).Scan(
-		&res.ID,
-		&res.Status,
-		&itemsJSON,
-		&res.ExpiresAt,
-		&res.CreatedAt,
-		&res.UpdatedAt,
-	)
-	if err != nil {
-		if errors.Is(err, pgx.ErrNoRows) {
-			return nil, domain.ErrReservationNotFound
-		}
-		return nil, err
-	}
-
-	if err := json.Unmarshal(itemsJSON, &res.Items); err != nil {
-		return nil, err
+	res, err := scanReservation(r.pool.QueryRow(ctx, query, id))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, domain.ErrReservationNotFound
 	}
-	return &res, nil
+	return res, err
 }
 
 func (r *PostgresReservationRepository) UpdateStatus(ctx context.Context, id uuid.UUID, status domain.ReservationStatus) error {
@@ -90,58 +73,96 @@ func (r *PostgresReservationRepository) UpdateStatus(ctx context.Context, id uui
 	return nil
 }
 
-func (r *PostgresReservationRepository) FindExpiredPending(ctx context.Context, limit int) ([]*domain.Reservation, error) {
+// ClaimExpiredPendingWithTx locks up to limit pending reservations past
+// their expiry, oldest first, until tx ends. Rows locked by another
+// transaction are skipped, so concurrent expirers claim disjoint batches.
+func (r *PostgresReservationRepository) ClaimExpiredPendingWithTx(ctx context.Context, tx pgx.Tx, limit int) ([]*domain.Reservation, error) {
 	query := `
-		SELECT id, status, items, expires_at, created_at, updated_at
+		SELECT ` + reservationColumns + `
 		FROM product_service.reservations
 		WHERE status = $1 AND expires_at < $2
 		ORDER BY expires_at
 		LIMIT $3
 		FOR UPDATE SKIP LOCKED
 	`
-	rows, err := r.pool.Query(ctx, query, domain.ReservationStatusPending, time.Now().UTC(), limit)
+	rows, err := tx.Query(ctx, query, domain.ReservationStatusPending, time.Now().UTC(), limit)
 	if err != nil {
 		return nil, err
 	}
-	defer rows.Close()
-
-	var reservations []*domain.Reservation
-	for rows.Next() {
-		var res domain.Reservation
-		var itemsJSON []byte
-
-		if err := rows.Scan(
-			&res.ID,
-			&res.Status,
-			&itemsJSON,
-			&res.ExpiresAt,
-			&res.CreatedAt,
-			&res.UpdatedAt,
-		); err != nil {
-			return nil, err
-		}
+	return scanReservations(rows)
+}
 
-		if err := json.Unmarshal(itemsJSON, &res.Items); err != nil {
-			return nil, err
-		}
-		reservations = append(reservations, &res)
+// ExpirePendingWithTx marks a pending reservation EXPIRED. It fails with
+// domain.ErrReservationNotPending if the reservation is no longer pending.
+func (r *PostgresReservationRepository) ExpirePendingWithTx(ctx context.Context, tx pgx.Tx, id uuid.UUID) error {
+	query := `
+		UPDATE product_service.reservations
+		SET status = $2, updated_at = NOW()
+		WHERE id = $1 AND status = $3
+	`
+	result, err := tx.Exec(ctx, query, id, domain.ReservationStatusExpired, domain.ReservationStatusPending)
+	if err != nil {
+		return err
 	}
-	return reservations, rows.Err()
+	if result.RowsAffected() == 0 {
+		return domain.ErrReservationNotPending
+	}
+	return nil
 }
 
-func (r *PostgresReservationRepository) BatchUpdateExpired(ctx context.Context, ids []uuid.UUID) error {
-	if len(ids) == 0 {
-		return nil
+func (r *PostgresReservationRepository) List(ctx context.Context, filter domain.ReservationFilter, beforeID uuid.UUID, limit int) ([]*domain.Reservation, error) {
+	query := `
+		SELECT ` + reservationColumns + `
+		FROM product_service.reservations
+		WHERE ($1::smallint IS NULL OR status = $1)
+			AND ($2::uuid IS NULL OR items @> jsonb_build_array(jsonb_build_object('SKUID', $2::uuid)))
+			AND ($3::timestamptz IS NULL OR created_at >= $3)
+			AND ($4::timestamptz IS NULL OR created_at < $4)
+			AND ($5::uuid IS NULL OR id < $5)
+		ORDER BY id DESC
+		LIMIT $6
+	`
+	var createdAfter, createdBefore *time.Time
+	if !filter.CreatedAfter.IsZero() {
+		createdAfter = &filter.CreatedAfter
+	}
+	if !filter.CreatedBefore.IsZero() {
+		createdBefore = &filter.CreatedBefore
+	}
+	var before *uuid.UUID
+	if beforeID != uuid.Nil {
+		before = &beforeID
+	}
+
+	rows, err := r.pool.Query(ctx, query, filter.Status, filter.SKUID, createdAfter, createdBefore, before, limit)
+	if err != nil {
+		return nil, err
 	}
+	return scanReservations(rows)
+}
 
+// ReleasePendingWithTx marks a pending reservation RELEASED with reason and
+// returns it. The status check and update are one statement, so a concurrent
+// confirmation or expiry cannot release the same reservation twice.
+func (r *PostgresReservationRepository) ReleasePendingWithTx(ctx context.Context, tx pgx.Tx, id uuid.UUID, reason string) (*domain.Reservation, error) {
 	query := `
 		UPDATE product_service.reservations
-		SET status = $1, updated_at = $2
-		WHERE id = ANY($3) AND status = $4
-	`
-	now := time.Now().UTC()
-	_, err := r.pool.Exec(ctx, query, domain.ReservationStatusExpired, now, ids, domain.ReservationStatusPending)
-	return err
+		SET status = $2, release_reason = $3, updated_at = NOW()
+		WHERE id = $1 AND status = $4
+		RETURNING ` + reservationColumns
+	res, err := scanReservation(tx.QueryRow(ctx, query, id, domain.ReservationStatusReleased, reason, domain.ReservationStatusPending))
+	if !errors.Is(err, pgx.ErrNoRows) {
+		return res, err
+	}
+
+	var exists bool
+	if err := tx.QueryRow(ctx, `SELECT EXISTS (SELECT 1 FROM product_service.reservations WHERE id = $1)`, id).Scan(&exists); err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, domain.ErrReservationNotFound
+	}
+	return nil, domain.ErrReservationNotPending
 }
 
 func (r *PostgresReservationRepository) CreateWithTx(ctx context.Context, tx pgx.Tx, reservation *domain.Reservation) error {
@@ -164,3 +185,73 @@ func (r *PostgresReservationRepository) CreateWithTx(ctx context.Context, tx pgx
 	)
 	return err
 }
+
+// SumConfirmedQuantities aggregates confirmed reservation items per SKU.
+// Confirmed reservations are final, so updated_at is the confirmation time.
+func (r *PostgresReservationRepository) SumConfirmedQuantities(ctx context.Context, skuIDs []uuid.UUID, since time.Time) (map[uuid.UUID]int64, error) {
+	result := make(map[uuid.UUID]int64, len(skuIDs))
+	if len(skuIDs) == 0 {
+		return result, nil
+	}
+
+	query := `
+		SELECT (item->>'SKUID')::uuid AS sku_id, SUM((item->>'Quantity')::bigint)
+		FROM product_service.reservations r,
+			jsonb_array_elements(r.items) AS item
+		WHERE r.status = $1
+			AND r.updated_at >= $2
+			AND (item->>'SKUID')::uuid = ANY($3)
+		GROUP BY sku_id
+	`
+	rows, err := r.pool.Query(ctx, query, domain.ReservationStatusConfirmed, since, skuIDs)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var skuID uuid.UUID
+		var units int64
+		if err := rows.Scan(&skuID, &units); err != nil {
+			return nil, err
+		}
+		result[skuID] = units
+	}
+	return result, rows.Err()
+}
+
+const reservationColumns = `id, status, items, expires_at, created_at, updated_at, COALESCE(release_reason, '')`
+
+func scanReservation(row pgx.Row) (*domain.Reservation, error) {
+	var res domain.Reservation
+	var itemsJSON []byte
+	if err := row.Scan(
+		&res.ID,
+		&res.Status,
+		&itemsJSON,
+		&res.ExpiresAt,
+		&res.CreatedAt,
+		&res.UpdatedAt,
+		&res.ReleaseReason,
+	); err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(itemsJSON, &res.Items); err != nil {
+		return nil, err
+	}
+	return &res, nil
+}
+
+func scanReservations(rows pgx.Rows) ([]*domain.Reservation, error) {
+	defer rows.Close()
+
+	var reservations []*domain.Reservation
+	for rows.Next() {
+		res, err := scanReservation(rows)
+		if err != nil {
+			return nil, err
+		}
+		reservations = append(reservations, res)
+	}
+	return reservations, rows.Err()
+}
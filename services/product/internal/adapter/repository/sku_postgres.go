@@ -107,6 +107,65 @@ func (r *PostgresSKURepository) FindByIDWithInventory(ctx context.Context, id uu
 	return result, nil
 }
 
+func (r *PostgresSKURepository) FindByIDsWithInventory(ctx context.Context, ids []uuid.UUID) ([]*domain.SKUWithInventory, error) {
+	if len(ids) == 0 {
+		return []*domain.SKUWithInventory{}, nil
+	}
+
+	query := `
+		SELECT s.id, s.product_id, s.sku_code, s.price_amount, s.price_currency, s.attributes, s.created_at, s.updated_at, s.deleted_at,
+		       i.sku_id, i.quantity, i.reserved, i.version
+		FROM product_service.skus s
+		LEFT JOIN product_service.inventory i ON s.id = i.sku_id
+		WHERE s.id = ANY($1) AND s.deleted_at IS NULL
+	`
+	rows, err := r.pool.Query(ctx, query, ids)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var results []*domain.SKUWithInventory
+	for rows.Next() {
+		var s domain.SKU
+		var inv struct {
+			SKUID    *uuid.UUID
+			Quantity *int64
+			Reserved *int64
+			Version  *int64
+		}
+		if err := rows.Scan(
+			&s.ID,
+			&s.ProductID,
+			&s.SKUCode,
+			&s.Price.Amount,
+			&s.Price.Currency,
+			&s.Attributes,
+			&s.CreatedAt,
+			&s.UpdatedAt,
+			&s.DeletedAt,
+			&inv.SKUID,
+			&inv.Quantity,
+			&inv.Reserved,
+			&inv.Version,
+		); err != nil {
+			return nil, err
+		}
+
+		result := &domain.SKUWithInventory{SKU: &s}
+		if inv.SKUID != nil {
+			result.Inventory = &domain.Inventory{
+				SKUID:    *inv.SKUID,
+				Quantity: *inv.Quantity,
+				Reserved: *inv.Reserved,
+				Version:  *inv.Version,
+			}
+		}
+		results = append(results, result)
+	}
+	return results, rows.Err()
+}
+
 func (r *PostgresSKURepository) FindByProductID(ctx context.Context, productID uuid.UUID) ([]*domain.SKU, error) {
 	query := `
 		SELECT id, product_id, sku_code, price_amount, price_currency, attributes, created_at, updated_at, deleted_at
@@ -198,6 +257,124 @@ func (r *PostgresSKURepository) ExistsBySKUCode(ctx context.Context, skuCode str
 	return exists, err
 }
 
+func (r *PostgresSKURepository) FindTakenSKUCodes(ctx context.Context, skuCodes []string) ([]string, error) {
+	query := `
+		SELECT sku_code
+		FROM product_service.skus
+		WHERE sku_code = ANY($1) AND deleted_at IS NULL
+		ORDER BY sku_code
+	`
+	rows, err := r.pool.Query(ctx, query, skuCodes)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var taken []string
+	for rows.Next() {
+		var code string
+		if err := rows.Scan(&code); err != nil {
+			return nil, err
+		}
+		taken = append(taken, code)
+	}
+	return taken, rows.Err()
+}
+
+func (r *PostgresSKURepository) CreateBatch(ctx context.Context, skus []*domain.SKU, inventories []*domain.Inventory) error {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	for i, sku := range skus {
+		if _, err := tx.Exec(ctx, `
+			INSERT INTO product_service.skus (id, product_id, sku_code, price_amount, price_currency, attributes, created_at, updated_at)
+			VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+		`,
+			sku.ID,
+			sku.ProductID,
+			sku.SKUCode,
+			sku.Price.Amount,
+			sku.Price.Currency,
+			sku.Attributes,
+			sku.CreatedAt,
+			sku.UpdatedAt,
+		); err != nil {
+			var pgErr *pgconn.PgError
+			if errors.As(err, &pgErr) && pgErr.Code == pgUniqueViolation {
+				return domain.ErrSKUCodeAlreadyExists
+			}
+			return err
+		}
+
+		inventory := inventories[i]
+		if _, err := tx.Exec(ctx, `
+			INSERT INTO product_service.inventory (sku_id, quantity, reserved, version)
+			VALUES ($1, $2, $3, $4)
+		`, inventory.SKUID, inventory.Quantity, inventory.Reserved, inventory.Version); err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit(ctx)
+}
+
+func (r *PostgresSKURepository) FindPrices(ctx context.Context, skuID uuid.UUID) ([]domain.Money, error) {
+	query := `
+		SELECT amount, currency
+		FROM product_service.sku_prices
+		WHERE sku_id = $1
+		ORDER BY currency
+	`
+	rows, err := r.pool.Query(ctx, query, skuID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var prices []domain.Money
+	for rows.Next() {
+		var m domain.Money
+		if err := rows.Scan(&m.Amount, &m.Currency); err != nil {
+			return nil, err
+		}
+		prices = append(prices, m)
+	}
+	return prices, rows.Err()
+}
+
+func (r *PostgresSKURepository) ReplacePrices(ctx context.Context, skuID uuid.UUID, prices []domain.Money) error {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	if _, err := tx.Exec(ctx, `DELETE FROM product_service.sku_prices WHERE sku_id = $1`, skuID); err != nil {
+		return err
+	}
+
+	if len(prices) > 0 {
+		amounts := make([]int64, len(prices))
+		currencies := make([]string, len(prices))
+		for i, p := range prices {
+			amounts[i] = p.Amount
+			currencies[i] = p.Currency
+		}
+		query := `
+			INSERT INTO product_service.sku_prices (sku_id, currency, amount)
+			SELECT $1, c, a FROM unnest($2::text[], $3::bigint[]) AS t(c, a)
+		`
+		if _, err := tx.Exec(ctx, query, skuID, currencies, amounts); err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit(ctx)
+}
+
 func (r *PostgresSKURepository) scanSKU(ctx context.Context, query string, args ...any) (*domain.SKU, error) {
 	var s domain.SKU
 	err := r.pool.QueryRow(ctx, query, args...).Scan(
@@ -2,9 +2,19 @@ package repository
 
 import (
 	"context"
+	"errors"
+	"fmt"
 
 	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
 	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/daisuke8000/example-ec-platform/services/product/internal/domain"
+)
+
+const (
+	pgSerializationFailure = "40001"
+	pgDeadlockDetected     = "40P01"
 )
 
 type TxManager interface {
@@ -28,10 +38,10 @@ func (m *txManager) Do(ctx context.Context, fn func(ctx context.Context) error)
 	defer tx.Rollback(ctx)
 
 	if err := fn(ctx); err != nil {
-		return err
+		return lockConflict(err)
 	}
 
-	return tx.Commit(ctx)
+	return lockConflict(tx.Commit(ctx))
 }
 
 func (m *txManager) DoWithTx(ctx context.Context, fn func(ctx context.Context, tx pgx.Tx) error) error {
@@ -42,10 +52,21 @@ func (m *txManager) DoWithTx(ctx context.Context, fn func(ctx context.Context, t
 	defer tx.Rollback(ctx)
 
 	if err := fn(ctx, tx); err != nil {
-		return err
+		return lockConflict(err)
 	}
 
-	return tx.Commit(ctx)
+	return lockConflict(tx.Commit(ctx))
+}
+
+// lockConflict reports serialization failures and deadlocks as
+// domain.ErrOptimisticLockConflict: the transaction lost a race with a
+// concurrent one and can be retried as a whole.
+func lockConflict(err error) error {
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) && (pgErr.Code == pgSerializationFailure || pgErr.Code == pgDeadlockDetected) {
+		return fmt.Errorf("%w: %s", domain.ErrOptimisticLockConflict, pgErr.Message)
+	}
+	return err
 }
 
 type txContextKey struct{}
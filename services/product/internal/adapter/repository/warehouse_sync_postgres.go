@@ -0,0 +1,237 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/daisuke8000/example-ec-platform/services/product/internal/domain"
+)
+
+const pgForeignKeyViolation = "23503"
+
+type PostgresWarehouseSyncRepository struct {
+	pool *pgxpool.Pool
+}
+
+func NewPostgresWarehouseSyncRepository(pool *pgxpool.Pool) *PostgresWarehouseSyncRepository {
+	return &PostgresWarehouseSyncRepository{pool: pool}
+}
+
+func (r *PostgresWarehouseSyncRepository) UpsertMappings(ctx context.Context, mappings []*domain.ExternalSKUMapping) error {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	for _, m := range mappings {
+		err := tx.QueryRow(ctx, `
+			INSERT INTO product_service.external_sku_mappings (provider, external_sku, sku_id, created_at)
+			VALUES ($1, $2, $3, $4)
+			ON CONFLICT (provider, external_sku) DO UPDATE SET sku_id = EXCLUDED.sku_id
+			RETURNING created_at
+		`, m.Provider, m.ExternalSKU, m.SKUID, m.CreatedAt).Scan(&m.CreatedAt)
+		if err != nil {
+			var pgErr *pgconn.PgError
+			if errors.As(err, &pgErr) {
+				switch pgErr.Code {
+				case pgForeignKeyViolation:
+					return fmt.Errorf("%w: %s", domain.ErrSKUNotFound, m.SKUID)
+				case pgUniqueViolation:
+					return fmt.Errorf("%w: %s", domain.ErrExternalSKUConflict, m.SKUID)
+				}
+			}
+			return err
+		}
+	}
+
+	return tx.Commit(ctx)
+}
+
+func (r *PostgresWarehouseSyncRepository) ListMappings(ctx context.Context, provider string, limit int, afterExternalSKU string) ([]*domain.ExternalSKUMapping, error) {
+	query := `
+		SELECT provider, external_sku, sku_id, created_at
+		FROM product_service.external_sku_mappings
+		WHERE provider = $1 AND external_sku > $3
+		ORDER BY external_sku
+		LIMIT $2
+	`
+	rows, err := r.pool.Query(ctx, query, provider, limit, afterExternalSKU)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var mappings []*domain.ExternalSKUMapping
+	for rows.Next() {
+		var m domain.ExternalSKUMapping
+		if err := rows.Scan(&m.Provider, &m.ExternalSKU, &m.SKUID, &m.CreatedAt); err != nil {
+			return nil, err
+		}
+		mappings = append(mappings, &m)
+	}
+	return mappings, rows.Err()
+}
+
+func (r *PostgresWarehouseSyncRepository) DeleteMapping(ctx context.Context, provider, externalSKU string) error {
+	result, err := r.pool.Exec(ctx, `
+		DELETE FROM product_service.external_sku_mappings
+		WHERE provider = $1 AND external_sku = $2
+	`, provider, externalSKU)
+	if err != nil {
+		return err
+	}
+	if result.RowsAffected() == 0 {
+		return domain.ErrExternalSKUMappingNotFound
+	}
+	return nil
+}
+
+// ApplyAdjustments claims the idempotency key first: a concurrent push with
+// the same key blocks on the primary key until this transaction ends, then
+// sees the key as used.
+func (r *PostgresWarehouseSyncRepository) ApplyAdjustments(ctx context.Context, provider, idempotencyKey string, adjustments []domain.WarehouseAdjustment, src domain.MovementSource) (*domain.AppliedAdjustments, error) {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	result, err := tx.Exec(ctx, `
+		INSERT INTO product_service.warehouse_adjustments (provider, idempotency_key, item_count)
+		VALUES ($1, $2, $3)
+		ON CONFLICT (provider, idempotency_key) DO NOTHING
+	`, provider, idempotencyKey, len(adjustments))
+	if err != nil {
+		return nil, err
+	}
+	if result.RowsAffected() == 0 {
+		var count int
+		if err := tx.QueryRow(ctx, `
+			SELECT item_count FROM product_service.warehouse_adjustments
+			WHERE provider = $1 AND idempotency_key = $2
+		`, provider, idempotencyKey).Scan(&count); err != nil {
+			return nil, err
+		}
+		return &domain.AppliedAdjustments{Replayed: true, Count: count}, nil
+	}
+
+	externalSKUs := make([]string, len(adjustments))
+	for i, a := range adjustments {
+		externalSKUs[i] = a.ExternalSKU
+	}
+	rows, err := tx.Query(ctx, `
+		SELECT external_sku, sku_id FROM product_service.external_sku_mappings
+		WHERE provider = $1 AND external_sku = ANY($2)
+	`, provider, externalSKUs)
+	if err != nil {
+		return nil, err
+	}
+	skuIDs := make(map[string]uuid.UUID, len(adjustments))
+	for rows.Next() {
+		var externalSKU string
+		var skuID uuid.UUID
+		if err := rows.Scan(&externalSKU, &skuID); err != nil {
+			rows.Close()
+			return nil, err
+		}
+		skuIDs[externalSKU] = skuID
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	applied := &domain.AppliedAdjustments{Count: len(adjustments), SKUIDs: make([]uuid.UUID, len(adjustments))}
+	for i, a := range adjustments {
+		skuID, ok := skuIDs[a.ExternalSKU]
+		if !ok {
+			return nil, fmt.Errorf("%w: %s", domain.ErrExternalSKUNotMapped, a.ExternalSKU)
+		}
+		applied.SKUIDs[i] = skuID
+	}
+
+	// Rows are locked in SKU order so concurrent pushes cannot deadlock.
+	order := make([]int, len(adjustments))
+	for i := range order {
+		order[i] = i
+	}
+	sort.Slice(order, func(a, b int) bool {
+		return applied.SKUIDs[order[a]].String() < applied.SKUIDs[order[b]].String()
+	})
+
+	for _, i := range order {
+		a := adjustments[i]
+		result, err := tx.Exec(ctx, `
+			WITH updated AS (
+				UPDATE product_service.inventory
+				SET quantity = quantity + $2, version = version + 1, updated_at = NOW()
+				WHERE sku_id = $1 AND quantity + $2 >= reserved
+				RETURNING sku_id, quantity, reserved
+			)
+			INSERT INTO product_service.inventory_movements
+				(sku_id, reason, actor, reservation_id, quantity_delta, reserved_delta, quantity_after, reserved_after)
+			SELECT sku_id, $3, $4, $5, $2, 0, quantity, reserved
+			FROM updated
+		`, applied.SKUIDs[i], a.QuantityDelta, src.Reason, src.Actor, src.ReservationID)
+		if err != nil {
+			return nil, err
+		}
+		if result.RowsAffected() == 0 {
+			return nil, fmt.Errorf("%w: %s", domain.ErrInsufficientStock, a.ExternalSKU)
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, err
+	}
+	return applied, nil
+}
+
+func (r *PostgresWarehouseSyncRepository) ListStockChanges(ctx context.Context, provider string, afterID int64, createdBefore time.Time, limit int) ([]*domain.StockChange, error) {
+	query := `
+		SELECT m.id, m.sku_id, m.reason, m.actor, m.reservation_id,
+			m.quantity_delta, m.reserved_delta, m.quantity_after, m.reserved_after, m.created_at,
+			e.external_sku
+		FROM product_service.inventory_movements m
+		JOIN product_service.external_sku_mappings e ON e.sku_id = m.sku_id AND e.provider = $1
+		WHERE m.id > $2 AND m.created_at < $3
+		ORDER BY m.id
+		LIMIT $4
+	`
+	rows, err := r.pool.Query(ctx, query, provider, afterID, createdBefore, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var changes []*domain.StockChange
+	for rows.Next() {
+		var m domain.InventoryMovement
+		var externalSKU string
+		if err := rows.Scan(
+			&m.ID,
+			&m.SKUID,
+			&m.Reason,
+			&m.Actor,
+			&m.ReservationID,
+			&m.QuantityDelta,
+			&m.ReservedDelta,
+			&m.QuantityAfter,
+			&m.ReservedAfter,
+			&m.CreatedAt,
+			&externalSKU,
+		); err != nil {
+			return nil, err
+		}
+		changes = append(changes, &domain.StockChange{Movement: &m, ExternalSKU: externalSKU})
+	}
+	return changes, rows.Err()
+}
@@ -0,0 +1,80 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/daisuke8000/example-ec-platform/services/product/internal/domain"
+)
+
+const workerPauseColumns = `worker, reason, paused_by, paused_at`
+
+type PostgresWorkerPauseRepository struct {
+	pool *pgxpool.Pool
+}
+
+func NewPostgresWorkerPauseRepository(pool *pgxpool.Pool) *PostgresWorkerPauseRepository {
+	return &PostgresWorkerPauseRepository{pool: pool}
+}
+
+func (r *PostgresWorkerPauseRepository) Pause(ctx context.Context, pause *domain.WorkerPause) (*domain.WorkerPause, error) {
+	// The no-op update makes RETURNING yield the existing row on conflict.
+	return scanWorkerPause(r.pool.QueryRow(ctx, `
+		INSERT INTO product_service.worker_pauses (worker, reason, paused_by, paused_at)
+		VALUES ($1, $2, $3, $4)
+		ON CONFLICT (worker) DO UPDATE SET worker = EXCLUDED.worker
+		RETURNING `+workerPauseColumns,
+		pause.Worker, pause.Reason, pause.PausedBy, pause.PausedAt))
+}
+
+func (r *PostgresWorkerPauseRepository) Resume(ctx context.Context, worker string) error {
+	_, err := r.pool.Exec(ctx, `
+		DELETE FROM product_service.worker_pauses WHERE worker = $1
+	`, worker)
+	return err
+}
+
+func (r *PostgresWorkerPauseRepository) Find(ctx context.Context, worker string) (*domain.WorkerPause, error) {
+	pause, err := scanWorkerPause(r.pool.QueryRow(ctx, `
+		SELECT `+workerPauseColumns+`
+		FROM product_service.worker_pauses
+		WHERE worker = $1
+	`, worker))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, nil
+	}
+	return pause, err
+}
+
+func (r *PostgresWorkerPauseRepository) FindAll(ctx context.Context) ([]*domain.WorkerPause, error) {
+	rows, err := r.pool.Query(ctx, `
+		SELECT `+workerPauseColumns+`
+		FROM product_service.worker_pauses
+		ORDER BY worker
+	`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var pauses []*domain.WorkerPause
+	for rows.Next() {
+		p, err := scanWorkerPause(rows)
+		if err != nil {
+			return nil, err
+		}
+		pauses = append(pauses, p)
+	}
+	return pauses, rows.Err()
+}
+
+func scanWorkerPause(row pgx.Row) (*domain.WorkerPause, error) {
+	var p domain.WorkerPause
+	if err := row.Scan(&p.Worker, &p.Reason, &p.PausedBy, &p.PausedAt); err != nil {
+		return nil, err
+	}
+	return &p, nil
+}
@@ -0,0 +1,56 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/daisuke8000/example-ec-platform/pkg/objectstore"
+	"github.com/daisuke8000/example-ec-platform/services/product/internal/domain"
+)
+
+// S3ImageStorage keeps product images in an S3-compatible bucket such as
+// AWS S3 or MinIO. Images are served from publicURL, typically a CDN in
+// front of the bucket.
+type S3ImageStorage struct {
+	s3        *objectstore.S3
+	publicURL string
+	uploadTTL time.Duration
+}
+
+func NewS3ImageStorage(s3 *objectstore.S3, publicURL string, uploadTTL time.Duration) *S3ImageStorage {
+	return &S3ImageStorage{
+		s3:        s3,
+		publicURL: strings.TrimSuffix(publicURL, "/"),
+		uploadTTL: uploadTTL,
+	}
+}
+
+func (s *S3ImageStorage) PresignUpload(_ context.Context, key, contentType string) (string, time.Time, error) {
+	expiresAt := time.Now().Add(s.uploadTTL).UTC()
+	url, err := s.s3.PresignPut(key, contentType, s.uploadTTL)
+	if err != nil {
+		return "", time.Time{}, err
+	}
+	return url, expiresAt, nil
+}
+
+func (s *S3ImageStorage) Size(ctx context.Context, key string) (int64, error) {
+	obj, err := s.s3.Stat(ctx, key)
+	if errors.Is(err, objectstore.ErrNotFound) {
+		return 0, domain.ErrImageNotUploaded
+	}
+	if err != nil {
+		return 0, err
+	}
+	return obj.Size, nil
+}
+
+func (s *S3ImageStorage) Delete(ctx context.Context, key string) error {
+	return s.s3.Delete(ctx, key)
+}
+
+func (s *S3ImageStorage) URL(key string) string {
+	return s.publicURL + "/" + key
+}
@@ -3,6 +3,8 @@ package config
 import (
 	"context"
 	"fmt"
+	"log/slog"
+	"strings"
 	"time"
 
 	"github.com/sethvargo/go-envconfig"
@@ -12,6 +14,9 @@ type Config struct {
 	ServiceName        string        `env:"SERVICE_NAME,default=product-service"`
 	LogLevel           string        `env:"LOG_LEVEL,default=info"`
 	GRPCPort           int           `env:"GRPC_PORT,default=50052"`
+	MetricsPort        int           `env:"METRICS_PORT,default=9052"`
+	DebugPort          int           `env:"DEBUG_PORT,default=0"`         // pprof, expvar and GC stats; 0 disables
+	DebugAddr          string        `env:"DEBUG_ADDR,default=127.0.0.1"` // Host the debug server binds to
 	DatabaseURL        string        `env:"DATABASE_URL,required"`
 	RedisURL           string        `env:"REDIS_URL"`
 	ReservationTTL     time.Duration `env:"RESERVATION_TTL,default=15m"`
@@ -19,6 +24,158 @@ type Config struct {
 	TTLWorkerBatchSize int           `env:"TTL_WORKER_BATCH_SIZE,default=100"`
 	MaxBatchSize       int           `env:"MAX_BATCH_SIZE,default=50"`
 	IdempotencyKeyTTL  time.Duration `env:"IDEMPOTENCY_KEY_TTL,default=24h"`
+	VelocityWindows    []int         `env:"VELOCITY_WINDOWS,default=7,30,90"`
+	EnableReflection   bool          `env:"ENABLE_REFLECTION,default=false"`
+
+	// Database connection pool (pgxpool). Tune with the db_pool_* metrics:
+	// waiting acquires mean too few connections, many idle ones too many.
+	DBMaxConns          int32         `env:"DB_MAX_CONNS,default=10"`
+	DBMinConns          int32         `env:"DB_MIN_CONNS,default=0"`
+	DBMaxConnLifetime   time.Duration `env:"DB_MAX_CONN_LIFETIME,default=1h"`
+	DBMaxConnIdleTime   time.Duration `env:"DB_MAX_CONN_IDLE_TIME,default=30m"`
+	DBHealthCheckPeriod time.Duration `env:"DB_HEALTH_CHECK_PERIOD,default=1m"`
+
+	// Every statement gets DB_QUERY_TIMEOUT unless the caller's deadline is
+	// sooner, so a pathological query cannot hold a connection. Statements
+	// slower than DB_SLOW_QUERY_THRESHOLD are logged with their arguments
+	// reduced to non-sensitive values. 0 disables either.
+	DBQueryTimeout       time.Duration `env:"DB_QUERY_TIMEOUT,default=5s"`
+	DBSlowQueryThreshold time.Duration `env:"DB_SLOW_QUERY_THRESHOLD,default=500ms"`
+
+	// Optional read replica for GetProduct, ListProducts and the category
+	// tree, which tolerate replication lag. Reads fall back to the primary
+	// while the replica is unreachable; it is checked every check interval.
+	DatabaseReplicaURL           string        `env:"PRODUCT_DATABASE_REPLICA_URL"`
+	DatabaseReplicaCheckInterval time.Duration `env:"PRODUCT_DATABASE_REPLICA_CHECK_INTERVAL,default=5s"`
+
+	// RPC logging. At LOG_LEVEL=debug request and response payloads are
+	// logged, with sensitive fields redacted. LOG_SAMPLE_EVERY logs every Nth
+	// successful call of high-QPS procedures (procedure:N, e.g.
+	// "/product.v1.ProductService/GetProduct:100"); failed calls and calls
+	// slower than LOG_SLOW_THRESHOLD are always logged.
+	LogSlowThreshold time.Duration  `env:"LOG_SLOW_THRESHOLD,default=1s"`
+	LogSampleEvery   map[string]int `env:"LOG_SAMPLE_EVERY"`
+
+	// HTTP server timeouts. Route timeouts override the read and write
+	// timeouts for long-running RPCs, keyed by procedure or service path
+	// prefix ("/pkg.v1.Service/Method:10m,/pkg.v1.Other/:1h").
+	ServerReadTimeout       time.Duration            `env:"SERVER_READ_TIMEOUT,default=30s"`
+	ServerReadHeaderTimeout time.Duration            `env:"SERVER_READ_HEADER_TIMEOUT,default=10s"`
+	ServerWriteTimeout      time.Duration            `env:"SERVER_WRITE_TIMEOUT,default=30s"`
+	ServerIdleTimeout       time.Duration            `env:"SERVER_IDLE_TIMEOUT,default=120s"`
+	ServerRouteTimeouts     map[string]time.Duration `env:"SERVER_ROUTE_TIMEOUTS,default=/product.v1.ProductService/ImportProducts:5m,/product.v1.InventoryService/WatchInventory:30m,/backup.v1.BackupService/CreateBackup:30m"`
+
+	// Graceful shutdown: /readyz fails and new RPCs are rejected with
+	// Unavailable and Retry-After, while RPCs in flight get up to the drain
+	// timeout to complete before the workers and the database pool stop.
+	ShutdownDrainTimeout time.Duration `env:"SHUTDOWN_DRAIN_TIMEOUT,default=25s"`
+	ShutdownRetryAfter   time.Duration `env:"SHUTDOWN_RETRY_AFTER,default=1s"`
+
+	// Bounds of the TTL a client may request for a reservation. Callers
+	// holding a scope listed in RESERVATION_TTL_SCOPE_MAX (scope:max, e.g.
+	// "internal:6h") may request up to that maximum instead.
+	ReservationTTLMin      time.Duration            `env:"RESERVATION_TTL_MIN,default=1m"`
+	ReservationTTLMax      time.Duration            `env:"RESERVATION_TTL_MAX,default=1h"`
+	ReservationTTLScopeMax map[string]time.Duration `env:"RESERVATION_TTL_SCOPE_MAX"`
+
+	// Key for encrypting list page tokens; must be shared by all replicas.
+	// When empty a random key is used and tokens do not survive restarts.
+	PageTokenSecret string `env:"PAGE_TOKEN_SECRET,default="`
+
+	// Retries of reservations aborted as a deadlock or serialization failure
+	LockRetryMaxAttempts    int           `env:"LOCK_RETRY_MAX_ATTEMPTS,default=3"`
+	LockRetryInitialBackoff time.Duration `env:"LOCK_RETRY_INITIAL_BACKOFF,default=10ms"`
+	LockRetryMaxBackoff     time.Duration `env:"LOCK_RETRY_MAX_BACKOFF,default=200ms"`
+
+	// Reservation path for requests that do not choose one: "optimistic"
+	// (conditional updates, retried on conflict) or "pessimistic" (rows
+	// locked up front, for hot SKUs). The lock timeout bounds the wait for
+	// the row locks of a pessimistic reservation.
+	ReservationLocking     string        `env:"RESERVATION_LOCKING,default=optimistic"`
+	ReservationLockTimeout time.Duration `env:"RESERVATION_LOCK_TIMEOUT,default=2s"`
+
+	// Inventory availability cache (requires Redis)
+	InventoryCacheEnabled bool          `env:"INVENTORY_CACHE_ENABLED,default=true"`
+	InventoryCacheTTL     time.Duration `env:"INVENTORY_CACHE_TTL,default=5s"`
+
+	// Activation of scheduled price changes
+	PriceChangeWorkerInterval  time.Duration `env:"PRICE_CHANGE_WORKER_INTERVAL,default=30s"`
+	PriceChangeWorkerBatchSize int           `env:"PRICE_CHANGE_WORKER_BATCH_SIZE,default=100"`
+
+	// Low-stock alerts. SKUs without their own threshold use the default;
+	// 0 disables alerts for them.
+	LowStockDefaultThreshold int64         `env:"LOW_STOCK_DEFAULT_THRESHOLD,default=10"`
+	LowStockWorkerInterval   time.Duration `env:"LOW_STOCK_WORKER_INTERVAL,default=1m"`
+	LowStockWorkerBatchSize  int           `env:"LOW_STOCK_WORKER_BATCH_SIZE,default=100"`
+
+	// Memory and goroutine watchdog; a zero limit is not checked. After
+	// WATCHDOG_RESTART_AFTER consecutive breaches (0 never) the service shuts
+	// down gracefully so the orchestrator restarts it.
+	WatchdogInterval      time.Duration `env:"WATCHDOG_INTERVAL,default=30s"`
+	WatchdogMaxHeapMB     int           `env:"WATCHDOG_MAX_HEAP_MB,default=0"`
+	WatchdogMaxGoroutines int           `env:"WATCHDOG_MAX_GOROUTINES,default=0"`
+	WatchdogRestartAfter  int           `env:"WATCHDOG_RESTART_AFTER,default=0"`
+
+	// Webhook delivery of product and inventory events
+	WebhooksEnabled         bool          `env:"WEBHOOKS_ENABLED,default=false"`
+	WebhookAllowHTTP        bool          `env:"WEBHOOK_ALLOW_HTTP,default=false"`
+	WebhookDispatchInterval time.Duration `env:"WEBHOOK_DISPATCH_INTERVAL,default=5s"`
+	WebhookBatchSize        int           `env:"WEBHOOK_BATCH_SIZE,default=20"`
+	WebhookTimeout          time.Duration `env:"WEBHOOK_TIMEOUT,default=10s"`
+	WebhookMaxAttempts      int           `env:"WEBHOOK_MAX_ATTEMPTS,default=8"`
+	WebhookInitialBackoff   time.Duration `env:"WEBHOOK_INITIAL_BACKOFF,default=30s"`
+	WebhookMaxBackoff       time.Duration `env:"WEBHOOK_MAX_BACKOFF,default=6h"`
+
+	// Product images in S3-compatible storage (AWS S3 or MinIO). Clients
+	// upload through presigned URLs, so the endpoint must be reachable by
+	// them; images are served from IMAGE_PUBLIC_URL.
+	ImagesEnabled     bool          `env:"IMAGES_ENABLED,default=false"`
+	ImageS3Endpoint   string        `env:"IMAGE_S3_ENDPOINT"`
+	ImageS3Region     string        `env:"IMAGE_S3_REGION,default=ap-northeast-1"`
+	ImageS3Bucket     string        `env:"IMAGE_S3_BUCKET"`
+	ImageS3AccessKey  string        `env:"IMAGE_S3_ACCESS_KEY"`
+	ImageS3SecretKey  string        `env:"IMAGE_S3_SECRET_KEY"`
+	ImagePublicURL    string        `env:"IMAGE_PUBLIC_URL"`
+	ImageUploadURLTTL time.Duration `env:"IMAGE_UPLOAD_URL_TTL,default=15m"`
+
+	// Files of download SKUs in a private S3-compatible bucket. Buyers
+	// download through presigned URLs valid for DOWNLOAD_URL_TTL.
+	DownloadsEnabled    bool          `env:"DOWNLOADS_ENABLED,default=false"`
+	DownloadS3Endpoint  string        `env:"DOWNLOAD_S3_ENDPOINT"`
+	DownloadS3Region    string        `env:"DOWNLOAD_S3_REGION,default=ap-northeast-1"`
+	DownloadS3Bucket    string        `env:"DOWNLOAD_S3_BUCKET"`
+	DownloadS3AccessKey string        `env:"DOWNLOAD_S3_ACCESS_KEY"`
+	DownloadS3SecretKey string        `env:"DOWNLOAD_S3_SECRET_KEY"`
+	DownloadURLTTL      time.Duration `env:"DOWNLOAD_URL_TTL,default=5m"`
+
+	// Logical backups of the product_service schema (BackupService)
+	BackupEnabled     bool          `env:"BACKUP_ENABLED,default=false"`
+	BackupStore       string        `env:"BACKUP_STORE,default=file"` // "s3" or "file"
+	BackupDir         string        `env:"BACKUP_DIR,default=backups"`
+	BackupS3Endpoint  string        `env:"BACKUP_S3_ENDPOINT"`
+	BackupS3Region    string        `env:"BACKUP_S3_REGION,default=ap-northeast-1"`
+	BackupS3Bucket    string        `env:"BACKUP_S3_BUCKET"`
+	BackupS3AccessKey string        `env:"BACKUP_S3_ACCESS_KEY"`
+	BackupS3SecretKey string        `env:"BACKUP_S3_SECRET_KEY"`
+	BackupKeepLast    int           `env:"BACKUP_KEEP_LAST,default=7"`
+	BackupMaxAge      time.Duration `env:"BACKUP_MAX_AGE,default=720h"`
+
+	// Service-to-service authentication. When enabled, RPCs are only served
+	// to the services in SERVICE_AUTH_ALLOWED, identified by a client
+	// credentials access token from Hydra or a client certificate signed by
+	// SERVICE_TLS_CLIENT_CA_FILE.
+	ServiceAuthEnabled     bool     `env:"SERVICE_AUTH_ENABLED,default=false"`
+	ServiceAuthJWKSURL     string   `env:"SERVICE_AUTH_JWKS_URL"`
+	ServiceAuthIssuer      string   `env:"SERVICE_AUTH_ISSUER"`
+	ServiceAuthAudience    string   `env:"SERVICE_AUTH_AUDIENCE"`
+	ServiceAuthAllowed     []string `env:"SERVICE_AUTH_ALLOWED"`
+	ServiceTLSCertFile     string   `env:"SERVICE_TLS_CERT_FILE"`
+	ServiceTLSKeyFile      string   `env:"SERVICE_TLS_KEY_FILE"`
+	ServiceTLSClientCAFile string   `env:"SERVICE_TLS_CLIENT_CA_FILE"`
+
+	// Keys verifying the signature of propagated user context; when set,
+	// unsigned context is rejected
+	ContextSigningKeys []string `env:"CONTEXT_SIGNING_KEYS"`
 }
 
 func Load(ctx context.Context) (*Config, error) {
@@ -35,17 +192,244 @@ func Load(ctx context.Context) (*Config, error) {
 }
 
 func (c *Config) validate() error {
+	if c.MetricsPort < 1 || c.MetricsPort > 65535 {
+		return fmt.Errorf("metrics port must be between 1 and 65535, got %d", c.MetricsPort)
+	}
+
+	if c.DBMaxConns < 1 || c.DBMaxConns > 1000 {
+		return fmt.Errorf("db max conns must be between 1 and 1000, got %d", c.DBMaxConns)
+	}
+
+	if c.DBMinConns < 0 || c.DBMinConns > c.DBMaxConns {
+		return fmt.Errorf("db min conns must be between 0 and db max conns, got %d", c.DBMinConns)
+	}
+
+	if c.DBMaxConnLifetime < time.Minute || c.DBMaxConnIdleTime < time.Second || c.DBHealthCheckPeriod < time.Second {
+		return fmt.Errorf("db max conn lifetime must be at least 1 minute, max conn idle time and health check period at least 1 second, got %v, %v and %v",
+			c.DBMaxConnLifetime, c.DBMaxConnIdleTime, c.DBHealthCheckPeriod)
+	}
+
+	if c.DBQueryTimeout < 0 || c.DBSlowQueryThreshold < 0 {
+		return fmt.Errorf("db query timeout and slow query threshold must not be negative, got %v and %v",
+			c.DBQueryTimeout, c.DBSlowQueryThreshold)
+	}
+
+	if c.DatabaseReplicaURL != "" && c.DatabaseReplicaCheckInterval < time.Second {
+		return fmt.Errorf("database replica check interval must be at least 1 second, got %v", c.DatabaseReplicaCheckInterval)
+	}
+
+	if c.DebugPort < 0 || c.DebugPort > 65535 {
+		return fmt.Errorf("debug port must be between 0 and 65535, got %d", c.DebugPort)
+	}
+
+	var level slog.Level
+	if err := level.UnmarshalText([]byte(c.LogLevel)); err != nil {
+		return fmt.Errorf("log level must be debug, info, warn or error, got %q", c.LogLevel)
+	}
+
+	if c.LogSlowThreshold < 0 {
+		return fmt.Errorf("log slow threshold must not be negative, got %v", c.LogSlowThreshold)
+	}
+
+	for procedure, every := range c.LogSampleEvery {
+		if !strings.HasPrefix(procedure, "/") {
+			return fmt.Errorf("log sample procedure must start with /, got %q", procedure)
+		}
+		if every < 1 {
+			return fmt.Errorf("log sample rate for %s must be at least 1, got %d", procedure, every)
+		}
+	}
+
 	if c.MaxBatchSize < 1 || c.MaxBatchSize > 100 {
 		return fmt.Errorf("max batch size must be between 1 and 100, got %d", c.MaxBatchSize)
 	}
 
-	if c.ReservationTTL < time.Minute || c.ReservationTTL > time.Hour {
-		return fmt.Errorf("reservation TTL must be between 1 minute and 1 hour, got %v", c.ReservationTTL)
+	if c.ServerReadTimeout < time.Second || c.ServerReadTimeout > 10*time.Minute {
+		return fmt.Errorf("server read timeout must be between 1 second and 10 minutes, got %v", c.ServerReadTimeout)
+	}
+
+	if c.ServerReadHeaderTimeout < time.Second || c.ServerReadHeaderTimeout > c.ServerReadTimeout {
+		return fmt.Errorf("server read header timeout must be between 1 second and the read timeout, got %v", c.ServerReadHeaderTimeout)
+	}
+
+	if c.ServerWriteTimeout < time.Second || c.ServerWriteTimeout > 10*time.Minute {
+		return fmt.Errorf("server write timeout must be between 1 second and 10 minutes, got %v", c.ServerWriteTimeout)
+	}
+
+	if c.ServerIdleTimeout < time.Second || c.ServerIdleTimeout > time.Hour {
+		return fmt.Errorf("server idle timeout must be between 1 second and 1 hour, got %v", c.ServerIdleTimeout)
+	}
+
+	for route, timeout := range c.ServerRouteTimeouts {
+		if !strings.HasPrefix(route, "/") {
+			return fmt.Errorf("server route timeout route must start with /, got %q", route)
+		}
+		if timeout < time.Second || timeout > 2*time.Hour {
+			return fmt.Errorf("server route timeout for %s must be between 1 second and 2 hours, got %v", route, timeout)
+		}
+	}
+
+	if c.ShutdownDrainTimeout < time.Second || c.ShutdownDrainTimeout > 5*time.Minute {
+		return fmt.Errorf("shutdown drain timeout must be between 1 second and 5 minutes, got %v", c.ShutdownDrainTimeout)
+	}
+
+	if c.ShutdownRetryAfter < time.Second || c.ShutdownRetryAfter > time.Minute {
+		return fmt.Errorf("shutdown retry after must be between 1 second and 1 minute, got %v", c.ShutdownRetryAfter)
+	}
+
+	if c.ReservationTTLMin < 10*time.Second || c.ReservationTTLMax < c.ReservationTTLMin || c.ReservationTTLMax > 24*time.Hour {
+		return fmt.Errorf("reservation TTL bounds must be at least 10 seconds with max at least min and at most 24 hours, got %v and %v", c.ReservationTTLMin, c.ReservationTTLMax)
+	}
+
+	if c.ReservationTTL < c.ReservationTTLMin || c.ReservationTTL > c.ReservationTTLMax {
+		return fmt.Errorf("reservation TTL must be between %v and %v, got %v", c.ReservationTTLMin, c.ReservationTTLMax, c.ReservationTTL)
+	}
+
+	for scope, maxTTL := range c.ReservationTTLScopeMax {
+		if maxTTL < c.ReservationTTLMax || maxTTL > 24*time.Hour {
+			return fmt.Errorf("reservation TTL max for scope %s must be between %v and 24 hours, got %v", scope, c.ReservationTTLMax, maxTTL)
+		}
+	}
+
+	if c.LockRetryMaxAttempts < 1 || c.LockRetryMaxAttempts > 10 {
+		return fmt.Errorf("lock retry max attempts must be between 1 and 10, got %d", c.LockRetryMaxAttempts)
+	}
+
+	if c.LockRetryInitialBackoff < 0 || c.LockRetryMaxBackoff < c.LockRetryInitialBackoff || c.LockRetryMaxBackoff > 5*time.Second {
+		return fmt.Errorf("lock retry backoff must be non-negative with max at least initial and at most 5 seconds, got %v and %v", c.LockRetryInitialBackoff, c.LockRetryMaxBackoff)
+	}
+
+	if c.ReservationLocking != "optimistic" && c.ReservationLocking != "pessimistic" {
+		return fmt.Errorf("reservation locking must be optimistic or pessimistic, got %q", c.ReservationLocking)
+	}
+
+	if c.ReservationLockTimeout < 10*time.Millisecond || c.ReservationLockTimeout > 30*time.Second {
+		return fmt.Errorf("reservation lock timeout must be between 10 milliseconds and 30 seconds, got %v", c.ReservationLockTimeout)
 	}
 
 	if c.TTLWorkerInterval < 10*time.Second || c.TTLWorkerInterval > 5*time.Minute {
 		return fmt.Errorf("TTL worker interval must be between 10 seconds and 5 minutes, got %v", c.TTLWorkerInterval)
 	}
 
+	if c.PriceChangeWorkerInterval < time.Second || c.PriceChangeWorkerInterval > 5*time.Minute {
+		return fmt.Errorf("price change worker interval must be between 1 second and 5 minutes, got %v", c.PriceChangeWorkerInterval)
+	}
+
+	if c.PriceChangeWorkerBatchSize < 1 || c.PriceChangeWorkerBatchSize > 1000 {
+		return fmt.Errorf("price change worker batch size must be between 1 and 1000, got %d", c.PriceChangeWorkerBatchSize)
+	}
+
+	if c.LowStockDefaultThreshold < 0 {
+		return fmt.Errorf("low stock default threshold must not be negative, got %d", c.LowStockDefaultThreshold)
+	}
+
+	if c.LowStockWorkerInterval < 10*time.Second || c.LowStockWorkerInterval > time.Hour {
+		return fmt.Errorf("low stock worker interval must be between 10 seconds and 1 hour, got %v", c.LowStockWorkerInterval)
+	}
+
+	if c.LowStockWorkerBatchSize < 1 || c.LowStockWorkerBatchSize > 1000 {
+		return fmt.Errorf("low stock worker batch size must be between 1 and 1000, got %d", c.LowStockWorkerBatchSize)
+	}
+
+	if c.WatchdogMaxHeapMB < 0 || c.WatchdogMaxGoroutines < 0 || c.WatchdogRestartAfter < 0 {
+		return fmt.Errorf("watchdog limits must not be negative, got heap %dMB, %d goroutines, restart after %d", c.WatchdogMaxHeapMB, c.WatchdogMaxGoroutines, c.WatchdogRestartAfter)
+	}
+
+	if c.WatchdogInterval < time.Second || c.WatchdogInterval > 10*time.Minute {
+		return fmt.Errorf("watchdog interval must be between 1 second and 10 minutes, got %v", c.WatchdogInterval)
+	}
+
+	if c.InventoryCacheEnabled && (c.InventoryCacheTTL < time.Second || c.InventoryCacheTTL > 5*time.Minute) {
+		return fmt.Errorf("inventory cache TTL must be between 1 second and 5 minutes, got %v", c.InventoryCacheTTL)
+	}
+
+	if c.WebhooksEnabled {
+		if c.WebhookDispatchInterval < time.Second || c.WebhookDispatchInterval > time.Minute {
+			return fmt.Errorf("webhook dispatch interval must be between 1 second and 1 minute, got %v", c.WebhookDispatchInterval)
+		}
+		if c.WebhookBatchSize < 1 || c.WebhookBatchSize > 100 {
+			return fmt.Errorf("webhook batch size must be between 1 and 100, got %d", c.WebhookBatchSize)
+		}
+		if c.WebhookTimeout < time.Second || c.WebhookTimeout > time.Minute {
+			return fmt.Errorf("webhook timeout must be between 1 second and 1 minute, got %v", c.WebhookTimeout)
+		}
+		if c.WebhookMaxAttempts < 1 {
+			return fmt.Errorf("webhook max attempts must be at least 1, got %d", c.WebhookMaxAttempts)
+		}
+		if c.WebhookInitialBackoff <= 0 || c.WebhookMaxBackoff < c.WebhookInitialBackoff {
+			return fmt.Errorf("webhook backoff must be positive with max at least initial, got %v and %v", c.WebhookInitialBackoff, c.WebhookMaxBackoff)
+		}
+	}
+
+	if c.ImagesEnabled {
+		if c.ImageS3Endpoint == "" || c.ImageS3Bucket == "" {
+			return fmt.Errorf("image S3 endpoint and bucket are required when images are enabled")
+		}
+		if c.ImagePublicURL == "" {
+			return fmt.Errorf("image public URL is required when images are enabled")
+		}
+		if c.ImageUploadURLTTL < time.Minute || c.ImageUploadURLTTL > time.Hour {
+			return fmt.Errorf("image upload URL TTL must be between 1 minute and 1 hour, got %v", c.ImageUploadURLTTL)
+		}
+	}
+
+	if c.DownloadsEnabled {
+		if c.DownloadS3Endpoint == "" || c.DownloadS3Bucket == "" {
+			return fmt.Errorf("download S3 endpoint and bucket are required when downloads are enabled")
+		}
+		if c.DownloadURLTTL < time.Minute || c.DownloadURLTTL > time.Hour {
+			return fmt.Errorf("download URL TTL must be between 1 minute and 1 hour, got %v", c.DownloadURLTTL)
+		}
+	}
+
+	if c.BackupEnabled {
+		if c.BackupStore != "s3" && c.BackupStore != "file" {
+			return fmt.Errorf("backup store must be s3 or file, got %q", c.BackupStore)
+		}
+		if c.BackupStore == "s3" && (c.BackupS3Endpoint == "" || c.BackupS3Bucket == "") {
+			return fmt.Errorf("backup S3 endpoint and bucket are required when BACKUP_STORE is s3")
+		}
+		if c.BackupKeepLast < 1 {
+			return fmt.Errorf("backup keep last must be at least 1, got %d", c.BackupKeepLast)
+		}
+		if c.BackupMaxAge < 0 {
+			return fmt.Errorf("backup max age must not be negative, got %v", c.BackupMaxAge)
+		}
+	}
+
+	if (c.ServiceTLSCertFile == "") != (c.ServiceTLSKeyFile == "") {
+		return fmt.Errorf("service TLS certificate and key files must be set together")
+	}
+	if c.ServiceTLSClientCAFile != "" && c.ServiceTLSCertFile == "" {
+		return fmt.Errorf("service TLS client CA file requires a server certificate and key")
+	}
+
+	for _, key := range c.ContextSigningKeys {
+		if len(key) < 32 {
+			return fmt.Errorf("context signing keys must be at least 32 characters")
+		}
+	}
+
+	if c.ServiceAuthEnabled {
+		if len(c.ServiceAuthAllowed) == 0 {
+			return fmt.Errorf("service auth allowed services are required when service auth is enabled")
+		}
+		if c.ServiceAuthJWKSURL == "" && c.ServiceTLSClientCAFile == "" {
+			return fmt.Errorf("service auth JWKS URL or TLS client CA file is required when service auth is enabled")
+		}
+		if c.ServiceAuthJWKSURL != "" && c.ServiceAuthIssuer == "" {
+			return fmt.Errorf("service auth issuer is required with a JWKS URL")
+		}
+	}
+
+	if len(c.VelocityWindows) == 0 {
+		return fmt.Errorf("velocity windows must not be empty")
+	}
+	for _, days := range c.VelocityWindows {
+		if days < 1 || days > 365 {
+			return fmt.Errorf("velocity window must be between 1 and 365 days, got %d", days)
+		}
+	}
+
 	return nil
 }
@@ -0,0 +1,158 @@
+package domain
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"regexp"
+	"strconv"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+)
+
+// AttributeType is the type of the values of a SKU attribute. Values are
+// stored as strings in every case.
+type AttributeType string
+
+const (
+	AttributeTypeString AttributeType = "string"
+	// AttributeTypeNumber values are decimal numbers such as "42" or "27.5".
+	AttributeTypeNumber AttributeType = "number"
+	// AttributeTypeBoolean values are "true" or "false".
+	AttributeTypeBoolean AttributeType = "boolean"
+)
+
+const (
+	MaxAllowedAttributeValues = 100
+	MaxAttributeValueLength   = 255
+)
+
+var attributeNamePattern = regexp.MustCompile(`^[a-z][a-z0-9_]{0,63}$`)
+
+// AttributeDefinition is the schema of a SKU attribute for the products of a
+// category: SKUs must use the attribute's type and, if set, one of its
+// allowed values, and must have it when it is required.
+type AttributeDefinition struct {
+	ID            uuid.UUID
+	CategoryID    uuid.UUID
+	Name          string
+	Type          AttributeType
+	AllowedValues []string
+	Required      bool
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+}
+
+type AttributeDefinitionRepository interface {
+	// Create returns ErrAttributeDefinitionExists if the category already
+	// defines the name.
+	Create(ctx context.Context, def *AttributeDefinition) error
+	FindByID(ctx context.Context, id uuid.UUID) (*AttributeDefinition, error)
+	// FindByCategoryID returns the definitions of a category ordered by name.
+	FindByCategoryID(ctx context.Context, categoryID uuid.UUID) ([]*AttributeDefinition, error)
+	Update(ctx context.Context, def *AttributeDefinition) error
+	Delete(ctx context.Context, id uuid.UUID) error
+}
+
+func NewAttributeDefinition(categoryID uuid.UUID, name string, typ AttributeType, allowedValues []string, required bool) (*AttributeDefinition, error) {
+	if !attributeNamePattern.MatchString(name) {
+		return nil, ErrInvalidAttributeName
+	}
+
+	now := time.Now().UTC()
+	def := &AttributeDefinition{
+		ID:         uuid.New(),
+		CategoryID: categoryID,
+		Name:       name,
+		CreatedAt:  now,
+	}
+	if err := def.Update(typ, allowedValues, required); err != nil {
+		return nil, err
+	}
+	def.UpdatedAt = now
+	return def, nil
+}
+
+// Update replaces the type, allowed values and required flag. SKUs created
+// under the previous definition are not revalidated.
+func (d *AttributeDefinition) Update(typ AttributeType, allowedValues []string, required bool) error {
+	switch typ {
+	case AttributeTypeString, AttributeTypeNumber, AttributeTypeBoolean:
+	default:
+		return ErrInvalidAttributeType
+	}
+	if len(allowedValues) > MaxAllowedAttributeValues {
+		return ErrInvalidAllowedValues
+	}
+	seen := make(map[string]struct{}, len(allowedValues))
+	for _, v := range allowedValues {
+		if _, ok := seen[v]; ok || validateAttributeValue(typ, v) != nil {
+			return ErrInvalidAllowedValues
+		}
+		seen[v] = struct{}{}
+	}
+
+	d.Type = typ
+	d.AllowedValues = allowedValues
+	if d.AllowedValues == nil {
+		d.AllowedValues = []string{}
+	}
+	d.Required = required
+	d.UpdatedAt = time.Now().UTC()
+	return nil
+}
+
+// ValidateValue checks a value of the attribute against the definition.
+func (d *AttributeDefinition) ValidateValue(value string) error {
+	if err := validateAttributeValue(d.Type, value); err != nil {
+		return fmt.Errorf("%w: %s must be a %s, got %q", ErrInvalidAttributeValue, d.Name, d.Type, value)
+	}
+	if len(d.AllowedValues) == 0 {
+		return nil
+	}
+	for _, allowed := range d.AllowedValues {
+		if value == allowed {
+			return nil
+		}
+	}
+	return fmt.Errorf("%w: %s must be one of %q, got %q", ErrInvalidAttributeValue, d.Name, d.AllowedValues, value)
+}
+
+func validateAttributeValue(typ AttributeType, value string) error {
+	if value == "" || utf8.RuneCountInString(value) > MaxAttributeValueLength {
+		return ErrInvalidAttributeValue
+	}
+	switch typ {
+	case AttributeTypeNumber:
+		f, err := strconv.ParseFloat(value, 64)
+		if err != nil || math.IsInf(f, 0) || math.IsNaN(f) {
+			return ErrInvalidAttributeValue
+		}
+	case AttributeTypeBoolean:
+		if value != "true" && value != "false" {
+			return ErrInvalidAttributeValue
+		}
+	}
+	return nil
+}
+
+// ValidateSKUAttributes checks the attributes of a SKU against the
+// definitions of its product's category. Attributes without a definition
+// are accepted as they are.
+func ValidateSKUAttributes(defs []*AttributeDefinition, attributes map[string]string) error {
+	for _, def := range defs {
+		value, ok := attributes[def.Name]
+		if !ok {
+			if def.Required {
+				return fmt.Errorf("%w: %s", ErrMissingRequiredAttribute, def.Name)
+			}
+			continue
+		}
+		if err := def.ValidateValue(value); err != nil {
+			return err
+		}
+	}
+	return nil
+}
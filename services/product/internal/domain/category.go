@@ -20,11 +20,28 @@ type Category struct {
 	DeletedAt   *time.Time
 }
 
+// CategoryNode is a category in a tree. ProductCount counts the published
+// products directly in the category; TotalProductCount adds those of every
+// descendant, including descendants below the requested depth.
+type CategoryNode struct {
+	Category          *Category
+	Depth             int
+	ProductCount      int64
+	TotalProductCount int64
+	Children          []*CategoryNode
+}
+
 type CategoryRepository interface {
 	Create(ctx context.Context, category *Category) error
 	FindByID(ctx context.Context, id uuid.UUID) (*Category, error)
 	FindByParentID(ctx context.Context, parentID *uuid.UUID) ([]*Category, error)
 	FindAll(ctx context.Context) ([]*Category, error)
+	// FindTree returns the subtree under rootID, or every root category when
+	// rootID is nil, as nodes without children ordered by depth. Nodes deeper
+	// than maxDepth are omitted unless maxDepth is negative.
+	FindTree(ctx context.Context, rootID *uuid.UUID, maxDepth int) ([]*CategoryNode, error)
+	// IsDescendant reports whether id is ancestorID or below it.
+	IsDescendant(ctx context.Context, id, ancestorID uuid.UUID) (bool, error)
 	Update(ctx context.Context, category *Category) error
 	SoftDelete(ctx context.Context, id uuid.UUID) error
 	ExistsByNameAndParent(ctx context.Context, name string, parentID *uuid.UUID, excludeID *uuid.UUID) (bool, error)
@@ -81,3 +98,21 @@ func (c *Category) Update(name string, description *string, parentID *uuid.UUID)
 	c.UpdatedAt = time.Now().UTC()
 	return nil
 }
+
+// BuildCategoryTree links nodes ordered by depth into trees and returns the
+// top-level nodes.
+func BuildCategoryTree(nodes []*CategoryNode) []*CategoryNode {
+	byID := make(map[uuid.UUID]*CategoryNode, len(nodes))
+	var roots []*CategoryNode
+	for _, n := range nodes {
+		byID[n.Category.ID] = n
+		if n.Depth == 0 {
+			roots = append(roots, n)
+			continue
+		}
+		if parent, ok := byID[*n.Category.ParentID]; ok {
+			parent.Children = append(parent.Children, n)
+		}
+	}
+	return roots
+}
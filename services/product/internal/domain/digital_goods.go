@@ -0,0 +1,145 @@
+package domain
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// DigitalKind is how a digital SKU is delivered instead of being shipped.
+type DigitalKind string
+
+const (
+	// DigitalKindLicenseKey assigns one key from the SKU's pool per unit.
+	DigitalKindLicenseKey DigitalKind = "license_key"
+	// DigitalKindDownload grants a limited number of downloads of a file.
+	DigitalKindDownload DigitalKind = "download"
+)
+
+const (
+	// MaxLicenseKeysPerCall bounds the keys added to a pool in one call.
+	MaxLicenseKeysPerCall = 1000
+
+	MaxLicenseKeyLength = 255
+	MaxDownloadLimit    = 100
+	MaxObjectKeyLength  = 1024
+)
+
+// DigitalSKU marks a SKU as delivered digitally. SKUs without one are
+// physical and shipped.
+type DigitalSKU struct {
+	SKUID uuid.UUID
+	Kind  DigitalKind
+	// ObjectKey and MaxDownloads are set for download SKUs: the file in
+	// storage and how often each buyer may download it.
+	ObjectKey    string
+	MaxDownloads int
+	// AvailableKeys is the number of unassigned keys of a license key SKU.
+	AvailableKeys int64
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+}
+
+// NewDigitalSKU validates the fulfillment of a digital SKU.
+func NewDigitalSKU(skuID uuid.UUID, kind DigitalKind, objectKey string, maxDownloads int) (*DigitalSKU, error) {
+	switch kind {
+	case DigitalKindLicenseKey:
+		objectKey, maxDownloads = "", 0
+	case DigitalKindDownload:
+		if objectKey == "" || len(objectKey) > MaxObjectKeyLength {
+			return nil, ErrInvalidDownloadObject
+		}
+		if maxDownloads < 1 || maxDownloads > MaxDownloadLimit {
+			return nil, ErrInvalidDownloadLimit
+		}
+	default:
+		return nil, ErrInvalidDigitalKind
+	}
+
+	now := time.Now().UTC()
+	return &DigitalSKU{
+		SKUID:        skuID,
+		Kind:         kind,
+		ObjectKey:    objectKey,
+		MaxDownloads: maxDownloads,
+		CreatedAt:    now,
+		UpdatedAt:    now,
+	}, nil
+}
+
+// ValidateLicenseKey checks a license key added to a pool.
+func ValidateLicenseKey(key string) error {
+	if key == "" || len(key) > MaxLicenseKeyLength {
+		return ErrInvalidLicenseKey
+	}
+	return nil
+}
+
+// DigitalOrderItem is a paid order line. Lines of physical SKUs are
+// ignored by fulfillment.
+type DigitalOrderItem struct {
+	SKUID    uuid.UUID
+	Quantity int64
+}
+
+// Entitlement is a buyer's right to one digital good of an order: a
+// license key, or downloads of a file.
+type Entitlement struct {
+	ID      uuid.UUID
+	OrderID uuid.UUID
+	UserID  string
+	SKUID   uuid.UUID
+	Kind    DigitalKind
+	// LicenseKey is the assigned key of a license key good.
+	LicenseKey string
+	// ObjectKey, DownloadCount and MaxDownloads are set for downloads.
+	ObjectKey     string
+	DownloadCount int
+	MaxDownloads  int
+	CreatedAt     time.Time
+}
+
+// DownloadsRemaining is how often the good can still be downloaded.
+func (e *Entitlement) DownloadsRemaining() int {
+	return max(e.MaxDownloads-e.DownloadCount, 0)
+}
+
+// FulfilledOrder is the outcome of fulfilling an order's digital goods.
+type FulfilledOrder struct {
+	// Replayed is set when the order was fulfilled before; nothing was
+	// assigned and Entitlements are the original ones.
+	Replayed     bool
+	Entitlements []*Entitlement
+}
+
+type DigitalGoodsRepository interface {
+	// UpsertDigitalSKU creates or replaces a SKU's fulfillment. Returns
+	// ErrSKUNotFound if the SKU doesn't exist and ErrDigitalKindChange if
+	// it would change the kind of a SKU that has license keys.
+	UpsertDigitalSKU(ctx context.Context, sku *DigitalSKU) error
+	// FindDigitalSKUs returns the digital SKUs among skuIDs with their
+	// available key counts. Physical SKUs are omitted.
+	FindDigitalSKUs(ctx context.Context, skuIDs []uuid.UUID) ([]*DigitalSKU, error)
+	// AddLicenseKeys adds keys to a license key SKU's pool, skipping keys
+	// already in it, and returns how many were added. Returns
+	// ErrNotLicenseKeySKU if the SKU doesn't deliver license keys.
+	AddLicenseKeys(ctx context.Context, skuID uuid.UUID, keys []string) (int, error)
+	// Fulfill creates the entitlements of an order's digital lines in one
+	// transaction, assigning license keys from the pools. Returns
+	// ErrLicenseKeysExhausted, wrapped with the SKU, if a pool has too few
+	// keys; nothing is assigned then.
+	Fulfill(ctx context.Context, orderID uuid.UUID, userID string, items []DigitalOrderItem) (*FulfilledOrder, error)
+	// FindEntitlements returns an order's entitlements owned by userID.
+	// Returns ErrDigitalOrderNotFound if there are none.
+	FindEntitlements(ctx context.Context, orderID uuid.UUID, userID string) ([]*Entitlement, error)
+	// ConsumeDownload counts one download of an entitlement and returns it
+	// updated. Returns ErrDownloadLimitReached if none is left.
+	ConsumeDownload(ctx context.Context, entitlementID uuid.UUID) (*Entitlement, error)
+}
+
+// DownloadStorage holds the files of download SKUs. Buyers download
+// directly from the storage through short-lived presigned URLs.
+type DownloadStorage interface {
+	PresignDownload(ctx context.Context, key string) (url string, expiresAt time.Time, err error)
+}
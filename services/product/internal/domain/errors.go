@@ -16,28 +16,145 @@ var (
 	ErrEmptySKUCode        = errors.New("sku code cannot be empty")
 	ErrSKUCodeTooLong      = errors.New("sku code must be 100 characters or less")
 	ErrInvalidPrice        = errors.New("price must be non-negative")
+	ErrInvalidCurrency     = errors.New("currency must be an ISO 4217 code")
+	ErrDuplicateCurrency   = errors.New("sku has more than one price in the same currency")
+	ErrTooManyPrices       = errors.New("sku has too many prices")
 	ErrEmptyCategoryName   = errors.New("category name cannot be empty")
 	ErrCategoryNameTooLong = errors.New("category name must be 255 characters or less")
 	ErrSelfParentCategory  = errors.New("category cannot be its own parent")
+	ErrCategoryCycle       = errors.New("category cannot be moved under its own descendant")
 	ErrInvalidQuantity     = errors.New("quantity must be non-negative")
 	ErrInvalidReserved     = errors.New("reserved must be non-negative")
+	ErrInvalidChannel      = errors.New("channel must be web, app or marketplace")
+	ErrInvalidMarket       = errors.New("market must be an ISO 3166-1 alpha-2 code")
 )
 
 var (
 	ErrSKUCodeAlreadyExists   = errors.New("sku code already exists")
 	ErrCategoryNameExists     = errors.New("category name already exists in same parent")
 	ErrOptimisticLockConflict = errors.New("concurrent modification detected")
+	ErrInventoryLockTimeout   = errors.New("timed out waiting for inventory lock")
 	ErrIdempotencyKeyExists   = errors.New("idempotency key already processed")
 )
 
 var (
-	ErrInsufficientStock     = errors.New("insufficient stock available")
-	ErrReservationExpired    = errors.New("reservation has expired")
-	ErrReservationNotPending = errors.New("reservation is not in pending status")
-	ErrBatchSizeExceeded     = errors.New("batch size exceeds maximum limit")
+	ErrInsufficientStock        = errors.New("insufficient stock available")
+	ErrReservationExpired       = errors.New("reservation has expired")
+	ErrReservationNotPending    = errors.New("reservation is not in pending status")
+	ErrBatchSizeExceeded        = errors.New("batch size exceeds maximum limit")
+	ErrDuplicateBatchSKU        = errors.New("sku appears more than once in the batch")
+	ErrEmptyBatch               = errors.New("batch must contain at least one item")
+	ErrInvalidReleaseReason     = errors.New("release reason is required and must be 500 characters or less")
+	ErrInvalidCreatedRange      = errors.New("created_after must be before created_before")
+	ErrReservationTTLOutOfRange = errors.New("reservation TTL is out of range")
 )
 
 var (
 	ErrInvalidProductStatus     = errors.New("invalid product status")
 	ErrInvalidReservationStatus = errors.New("invalid reservation status")
 )
+
+var (
+	ErrInvalidVelocityWindow = errors.New("velocity window must be between 1 and 365 days")
+	ErrInvalidPageToken      = errors.New("invalid page token")
+	ErrInvalidEffectiveFrom  = errors.New("effective_from must be in the future")
+)
+
+var (
+	ErrProductImageNotFound    = errors.New("product image not found")
+	ErrInvalidImageContentType = errors.New("image content type must be image/jpeg, image/png, image/webp or image/avif")
+	ErrImageAltTextTooLong     = errors.New("image alt text must be 500 characters or less")
+	ErrImageTooLarge           = errors.New("image must be 10 MiB or less")
+	ErrTooManyImages           = errors.New("product has too many images")
+	ErrImageOrderMismatch      = errors.New("image_ids must list every image of the product exactly once")
+	ErrImageNotUploaded        = errors.New("image has not been uploaded")
+	ErrImageNotPending         = errors.New("image upload is already complete")
+	ErrImageStorageDisabled    = errors.New("image storage is not configured")
+)
+
+var (
+	ErrInvalidImportFormat = errors.New("import format must be CSV or NDJSON")
+	ErrImportTooLarge      = errors.New("import payload must be 32 MiB or less")
+	ErrMalformedImport     = errors.New("import payload is malformed")
+	ErrEmptyImport         = errors.New("import payload contains no products")
+	ErrImportNotFound      = errors.New("product import not found")
+)
+
+var (
+	ErrInvalidProvider            = errors.New("provider must be 1-64 lowercase letters, digits or hyphens")
+	ErrInvalidExternalSKU         = errors.New("external sku must be 1-255 characters")
+	ErrExternalSKUNotMapped       = errors.New("external sku is not mapped")
+	ErrExternalSKUConflict        = errors.New("sku is already mapped to another external sku for this provider")
+	ErrExternalSKUMappingNotFound = errors.New("external sku mapping not found")
+	ErrInvalidQuantityDelta       = errors.New("quantity delta must be non-zero")
+	ErrInvalidIdempotencyKey      = errors.New("idempotency key is required and must be 256 characters or less")
+)
+
+var (
+	ErrInvalidLowStockThreshold = errors.New("low stock threshold must not be negative")
+)
+
+var (
+	ErrInvalidDigitalKind      = errors.New("digital kind must be license_key or download")
+	ErrInvalidDownloadObject   = errors.New("download skus need an object key of 1024 characters or less")
+	ErrInvalidDownloadLimit    = errors.New("max downloads must be between 1 and 100")
+	ErrInvalidLicenseKey       = errors.New("license keys must be 1-255 characters")
+	ErrNotLicenseKeySKU        = errors.New("sku does not deliver license keys")
+	ErrDigitalKindChange       = errors.New("sku with license keys cannot change its digital kind")
+	ErrLicenseKeysExhausted    = errors.New("not enough license keys available")
+	ErrDigitalOrderNotFound    = errors.New("digital order not found")
+	ErrMissingBuyer            = errors.New("user id of the buyer is required")
+	ErrDownloadLimitReached    = errors.New("download limit reached")
+	ErrDownloadStorageDisabled = errors.New("download storage is not configured")
+)
+
+var (
+	ErrInvalidPreorderWindow       = errors.New("preorder window must end after it starts")
+	ErrInvalidPreorderShipDate     = errors.New("preorder ship date must not be before the window starts")
+	ErrInvalidAllocationCap        = errors.New("preorder allocation cap must be positive")
+	ErrPreorderCampaignNotFound    = errors.New("preorder campaign not found")
+	ErrPreorderNotOpen             = errors.New("preorder window is not open")
+	ErrPreorderAllocationExhausted = errors.New("preorder allocation cap reached")
+	ErrPreorderAllocationNotFound  = errors.New("preorder allocation not found")
+)
+
+var (
+	ErrInvalidPickupLocationName    = errors.New("pickup location name must be 1-100 characters")
+	ErrInvalidPickupLocationAddress = errors.New("pickup location address must be 1-500 characters")
+	ErrInvalidPickupSlotWindow      = errors.New("pickup slot must end after it starts")
+	ErrInvalidPickupSlotCapacity    = errors.New("pickup slot capacity must be positive")
+	ErrInvalidPickupSlotRange       = errors.New("pickup slot range must end after it starts and span at most 31 days")
+	ErrPickupLocationNotFound       = errors.New("pickup location not found")
+	ErrPickupLocationInactive       = errors.New("pickup location is not taking reservations")
+	ErrPickupSlotNotFound           = errors.New("pickup slot not found")
+	ErrPickupSlotExists             = errors.New("pickup slot already exists at this time")
+	ErrPickupSlotClosed             = errors.New("pickup slot has already started")
+	ErrPickupSlotFull               = errors.New("pickup slot is full")
+	ErrPickupReservationNotFound    = errors.New("pickup reservation not found")
+	ErrInvalidPickupTransition      = errors.New("invalid pickup status transition")
+)
+
+var (
+	ErrWorkerNotFound     = errors.New("worker not found")
+	ErrInvalidPauseReason = errors.New("pause reason is required and must be 500 characters or less")
+)
+
+var (
+	ErrInvalidAttributeName        = errors.New("attribute name must be 1-64 lowercase letters, digits or underscores, starting with a letter")
+	ErrInvalidAttributeType        = errors.New("attribute type must be string, number or boolean")
+	ErrInvalidAllowedValues        = errors.New("allowed values must be at most 100 distinct values of the attribute type")
+	ErrAttributeDefinitionNotFound = errors.New("attribute definition not found")
+	ErrAttributeDefinitionExists   = errors.New("attribute is already defined for this category")
+	ErrMissingRequiredAttribute    = errors.New("required attribute is missing")
+	ErrInvalidAttributeValue       = errors.New("attribute value does not match its definition")
+)
+
+var (
+	ErrInvalidSKUDimensions   = errors.New("invalid SKU dimensions")
+	ErrInvalidSKUPriceRule    = errors.New("invalid SKU price rule")
+	ErrTooManySKUCombinations = errors.New("SKU matrix exceeds 500 combinations")
+)
+
+var ErrInventoryWatchInterrupted = errors.New("inventory watch interrupted; watch again to resume")
+
+var ErrTooManyFavorites = errors.New("wishlist cannot have more than 500 products")
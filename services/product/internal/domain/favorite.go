@@ -0,0 +1,50 @@
+package domain
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// MaxFavorites caps the products of a user's wishlist.
+const MaxFavorites = 500
+
+// Favorite is a product a user saved to their wishlist.
+type Favorite struct {
+	UserID    uuid.UUID
+	ProductID uuid.UUID
+	CreatedAt time.Time
+	// Product is set when favorites are listed.
+	Product *Product
+}
+
+func NewFavorite(userID, productID uuid.UUID) *Favorite {
+	return &Favorite{
+		UserID:    userID,
+		ProductID: productID,
+		CreatedAt: time.Now().UTC(),
+	}
+}
+
+// FavoriteCursor is the position of the last favorite of a wishlist page.
+type FavoriteCursor struct {
+	CreatedAt time.Time `json:"created_at"`
+	ProductID uuid.UUID `json:"product_id"`
+}
+
+type FavoriteRepository interface {
+	// Add saves a favorite unless the user already has it. It returns
+	// ErrTooManyFavorites if the user has MaxFavorites already.
+	Add(ctx context.Context, favorite *Favorite) error
+	// Remove deletes a favorite; removing one the user doesn't have is not
+	// an error.
+	Remove(ctx context.Context, userID, productID uuid.UUID) error
+	// ListByUser returns a user's favorites of products that are not
+	// deleted, most recently added first. When after is set only favorites
+	// following it are returned.
+	ListByUser(ctx context.Context, userID uuid.UUID, limit int, after *FavoriteCursor) ([]*Favorite, error)
+	// CountByProductIDs returns the number of users who saved each product.
+	// Products nobody saved are absent.
+	CountByProductIDs(ctx context.Context, productIDs []uuid.UUID) (map[uuid.UUID]int64, error)
+}
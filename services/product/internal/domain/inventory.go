@@ -6,6 +6,14 @@ import (
 	"github.com/google/uuid"
 )
 
+const (
+	// MaxInventoryUpdateBatch bounds the items of one bulk quantity update.
+	MaxInventoryUpdateBatch = 5000
+	// InventoryUpdateChunkSize is the number of items applied per transaction
+	// in a bulk quantity update.
+	InventoryUpdateChunkSize = 500
+)
+
 type Inventory struct {
 	SKUID    uuid.UUID
 	Quantity int64
@@ -18,10 +26,34 @@ type InventoryRepository interface {
 	FindBySKUID(ctx context.Context, skuID uuid.UUID) (*Inventory, error)
 	FindBySKUIDs(ctx context.Context, skuIDs []uuid.UUID) ([]*Inventory, error)
 	Update(ctx context.Context, inventory *Inventory) error
-	UpdateQuantity(ctx context.Context, skuID uuid.UUID, quantity int64) error
-	Reserve(ctx context.Context, skuID uuid.UUID, amount int64, expectedVersion int64) error
-	ConfirmReservation(ctx context.Context, skuID uuid.UUID, amount int64) error
-	ReleaseReservation(ctx context.Context, skuID uuid.UUID, amount int64) error
+	UpdateQuantity(ctx context.Context, skuID uuid.UUID, quantity int64, src MovementSource) error
+	Reserve(ctx context.Context, skuID uuid.UUID, amount int64, expectedVersion int64, src MovementSource) error
+	ConfirmReservation(ctx context.Context, skuID uuid.UUID, amount int64, src MovementSource) error
+	ReleaseReservation(ctx context.Context, skuID uuid.UUID, amount int64, src MovementSource) error
+	// SetQuantities sets absolute quantities in one transaction, recording a
+	// movement for each change. Items that are not found or would fall below
+	// their reserved quantity are skipped with an error at their index in
+	// itemErrs; the others are applied and returned at the same index.
+	SetQuantities(ctx context.Context, updates []QuantityUpdate, src MovementSource) (updated []*Inventory, itemErrs []error, err error)
+}
+
+// MaxWatchedSKUs bounds the SKUs of one inventory watch.
+const MaxWatchedSKUs = 100
+
+// InventoryFeed delivers the stock levels of SKUs as changes to them are
+// committed.
+type InventoryFeed interface {
+	// Subscribe delivers the inventory of skuIDs whenever it changes until
+	// cancel is called. The channel is closed when changes may have been
+	// missed: the subscriber fell behind or the feed is not connected. The
+	// subscriber should then reread the current levels and subscribe again.
+	Subscribe(skuIDs []uuid.UUID) (changes <-chan *Inventory, cancel func())
+}
+
+// QuantityUpdate sets the on-hand quantity of a SKU.
+type QuantityUpdate struct {
+	SKUID    uuid.UUID
+	Quantity int64
 }
 
 func NewInventory(skuID uuid.UUID, quantity int64) (*Inventory, error) {
@@ -0,0 +1,48 @@
+package domain
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type MovementReason string
+
+const (
+	MovementReasonAdjustment MovementReason = "adjustment"
+	MovementReasonReserve    MovementReason = "reserve"
+	MovementReasonConfirm    MovementReason = "confirm"
+	MovementReasonRelease    MovementReason = "release"
+	MovementReasonExpire     MovementReason = "expire"
+	// MovementReasonForceRelease is a release by support staff
+	// (ForceReleaseReservation) rather than by the reservation's owner.
+	MovementReasonForceRelease MovementReason = "force_release"
+)
+
+// MovementSource describes why and by whom an inventory change is made.
+// It is recorded alongside the change in the same statement.
+type MovementSource struct {
+	Reason        MovementReason
+	Actor         string
+	ReservationID *uuid.UUID
+}
+
+type InventoryMovement struct {
+	ID            int64
+	SKUID         uuid.UUID
+	Reason        MovementReason
+	Actor         string
+	ReservationID *uuid.UUID
+	QuantityDelta int64
+	ReservedDelta int64
+	QuantityAfter int64
+	ReservedAfter int64
+	CreatedAt     time.Time
+}
+
+type InventoryMovementRepository interface {
+	// ListBySKU returns movements for a SKU newest first.
+	// When beforeID is positive only movements with a smaller ID are returned.
+	ListBySKU(ctx context.Context, skuID uuid.UUID, limit int, beforeID int64) ([]*InventoryMovement, error)
+}
@@ -0,0 +1,42 @@
+package domain
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// LowStockSKU is a SKU whose available quantity is below its low-stock
+// threshold.
+type LowStockSKU struct {
+	SKUID    uuid.UUID
+	Quantity int64
+	Reserved int64
+	// Threshold is the SKU's own threshold, or the default if it has none.
+	Threshold int64
+	// AlertedAt is when the outstanding LowStock event was emitted; nil if
+	// the alert worker has not seen the SKU yet.
+	AlertedAt *time.Time
+}
+
+func (s *LowStockSKU) Available() int64 {
+	return s.Quantity - s.Reserved
+}
+
+// LowStockRepository reads and tracks low-stock state. defaultThreshold
+// applies to SKUs without a threshold of their own; a threshold of 0 means
+// the SKU is never low on stock.
+type LowStockRepository interface {
+	// SetThreshold sets a SKU's threshold; nil reverts it to the default.
+	// Returns ErrInventoryNotFound if the SKU has no inventory.
+	SetThreshold(ctx context.Context, skuID uuid.UUID, threshold *int64) error
+	// List returns low-stock SKUs ordered by SKU ID, starting after afterSKUID.
+	List(ctx context.Context, defaultThreshold int64, afterSKUID uuid.UUID, limit int) ([]*LowStockSKU, error)
+	// ClaimAlerts marks up to limit low-stock SKUs without an outstanding
+	// alert as alerted and returns them.
+	ClaimAlerts(ctx context.Context, defaultThreshold int64, limit int) ([]*LowStockSKU, error)
+	// ResetRecovered clears the outstanding alert of SKUs that are no longer
+	// low on stock, so they alert again on their next drop.
+	ResetRecovered(ctx context.Context, defaultThreshold int64) (int64, error)
+}
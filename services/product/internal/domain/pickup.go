@@ -0,0 +1,192 @@
+package domain
+
+import (
+	"context"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+)
+
+const (
+	MaxPickupLocationNameLength    = 100
+	MaxPickupLocationAddressLength = 500
+)
+
+// PickupLocation is a store or locker where buyers collect their orders.
+type PickupLocation struct {
+	ID      uuid.UUID
+	Name    string
+	Address string
+	// Active is cleared to stop taking new reservations; existing ones are
+	// still handed over.
+	Active    bool
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// NewPickupLocation validates a new, active pickup location.
+func NewPickupLocation(name, address string) (*PickupLocation, error) {
+	if name == "" || utf8.RuneCountInString(name) > MaxPickupLocationNameLength {
+		return nil, ErrInvalidPickupLocationName
+	}
+	if address == "" || utf8.RuneCountInString(address) > MaxPickupLocationAddressLength {
+		return nil, ErrInvalidPickupLocationAddress
+	}
+
+	now := time.Now().UTC()
+	return &PickupLocation{
+		ID:        uuid.New(),
+		Name:      name,
+		Address:   address,
+		Active:    true,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}, nil
+}
+
+// PickupSlot is a time window in which a location hands over up to
+// Capacity orders.
+type PickupSlot struct {
+	ID         uuid.UUID
+	LocationID uuid.UUID
+	StartsAt   time.Time
+	EndsAt     time.Time
+	Capacity   int32
+	// Reserved is the number of orders reserved and not cancelled.
+	Reserved  int32
+	CreatedAt time.Time
+}
+
+// NewPickupSlot validates a location's pickup slot.
+func NewPickupSlot(locationID uuid.UUID, startsAt, endsAt time.Time, capacity int32) (*PickupSlot, error) {
+	if !endsAt.After(startsAt) {
+		return nil, ErrInvalidPickupSlotWindow
+	}
+	if capacity <= 0 {
+		return nil, ErrInvalidPickupSlotCapacity
+	}
+
+	return &PickupSlot{
+		ID:         uuid.New(),
+		LocationID: locationID,
+		StartsAt:   startsAt.UTC(),
+		EndsAt:     endsAt.UTC(),
+		Capacity:   capacity,
+		CreatedAt:  time.Now().UTC(),
+	}, nil
+}
+
+// Remaining is the number of orders the slot can still take.
+func (s *PickupSlot) Remaining() int32 {
+	return max(s.Capacity-s.Reserved, 0)
+}
+
+// Reserve checks that the slot can take one more order at t. Slots that
+// have started take no new orders.
+func (s *PickupSlot) Reserve(t time.Time) error {
+	if !t.Before(s.StartsAt) {
+		return ErrPickupSlotClosed
+	}
+	if s.Remaining() == 0 {
+		return ErrPickupSlotFull
+	}
+	s.Reserved++
+	return nil
+}
+
+// PickupStatus is the state of a pickup reservation.
+type PickupStatus string
+
+const (
+	PickupReserved PickupStatus = "reserved"
+	// PickupReady orders are packed at the location and the buyer has been
+	// notified.
+	PickupReady     PickupStatus = "ready"
+	PickupCollected PickupStatus = "collected"
+	// PickupCancelled orders no longer count against the slot or the
+	// location's stock.
+	PickupCancelled PickupStatus = "cancelled"
+)
+
+// PickupItem is an order line to be collected.
+type PickupItem struct {
+	SKUID    uuid.UUID
+	Quantity int64
+}
+
+// PickupReservation is an order to be collected in a slot.
+type PickupReservation struct {
+	OrderID     uuid.UUID
+	LocationID  uuid.UUID
+	SlotID      uuid.UUID
+	Status      PickupStatus
+	Items       []PickupItem
+	CreatedAt   time.Time
+	ReadyAt     *time.Time
+	CollectedAt *time.Time
+	CancelledAt *time.Time
+}
+
+// TransitionTo moves the reservation to status at t. Reservations become
+// ready, then collected; they can be cancelled until collected.
+func (r *PickupReservation) TransitionTo(status PickupStatus, t time.Time) error {
+	switch {
+	case status == PickupReady && r.Status == PickupReserved:
+		r.ReadyAt = &t
+	case status == PickupCollected && r.Status == PickupReady:
+		r.CollectedAt = &t
+	case status == PickupCancelled && (r.Status == PickupReserved || r.Status == PickupReady):
+		r.CancelledAt = &t
+	default:
+		return ErrInvalidPickupTransition
+	}
+	r.Status = status
+	return nil
+}
+
+// PickupAvailability reports whether a location has every line of an
+// order on hand.
+type PickupAvailability struct {
+	Location  *PickupLocation
+	Available bool
+}
+
+// ReservedPickup is the outcome of reserving a pickup.
+type ReservedPickup struct {
+	// Replayed is set when the order was reserved before; nothing was
+	// reserved and Reservation is the original one.
+	Replayed    bool
+	Reservation *PickupReservation
+}
+
+type PickupRepository interface {
+	CreateLocation(ctx context.Context, location *PickupLocation) error
+	// SetLocationActive returns ErrPickupLocationNotFound if the location
+	// doesn't exist.
+	SetLocationActive(ctx context.Context, id uuid.UUID, active bool) (*PickupLocation, error)
+	FindLocations(ctx context.Context, activeOnly bool) ([]*PickupLocation, error)
+	// SetStock sets the units of a SKU on hand at a location. Returns
+	// ErrPickupLocationNotFound or ErrSKUNotFound.
+	SetStock(ctx context.Context, locationID, skuID uuid.UUID, quantity int64) error
+	// Availability checks every active location for items.
+	Availability(ctx context.Context, items []PickupItem) ([]*PickupAvailability, error)
+	// CreateSlot returns ErrPickupLocationNotFound if the location doesn't
+	// exist and ErrPickupSlotExists if it has a slot starting at the same
+	// time.
+	CreateSlot(ctx context.Context, slot *PickupSlot) error
+	// FindSlots returns a location's slots starting in [from, to).
+	FindSlots(ctx context.Context, locationID uuid.UUID, from, to time.Time) ([]*PickupSlot, error)
+	// Reserve reserves the slot and deducts the items from the location's
+	// stock in one transaction. Returns ErrPickupSlotNotFound,
+	// ErrPickupLocationInactive, ErrPickupSlotClosed, ErrPickupSlotFull or
+	// ErrInsufficientStock; nothing is reserved then.
+	Reserve(ctx context.Context, orderID, slotID uuid.UUID, items []PickupItem, at time.Time) (*ReservedPickup, error)
+	// FindReservation returns ErrPickupReservationNotFound if the order has
+	// no reservation.
+	FindReservation(ctx context.Context, orderID uuid.UUID) (*PickupReservation, error)
+	// Transition applies PickupReservation.TransitionTo to the stored
+	// reservation. Cancelling returns the slot and the items to the
+	// location.
+	Transition(ctx context.Context, orderID uuid.UUID, status PickupStatus, at time.Time) (*PickupReservation, error)
+}
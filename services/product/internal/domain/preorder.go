@@ -0,0 +1,126 @@
+package domain
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// PreorderCampaign sells a SKU before it is in stock: orders placed while
+// the window is open are allocated against the cap and ship from stock
+// received by the ship date.
+type PreorderCampaign struct {
+	SKUID    uuid.UUID
+	StartsAt time.Time
+	EndsAt   time.Time
+	// ShipDate is the estimated ship date announced to buyers.
+	ShipDate      time.Time
+	AllocationCap int64
+	// Allocated is the number of units of orders allocated so far.
+	Allocated int64
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// NewPreorderCampaign validates a SKU's preorder campaign.
+func NewPreorderCampaign(skuID uuid.UUID, startsAt, endsAt, shipDate time.Time, allocationCap int64) (*PreorderCampaign, error) {
+	if !endsAt.After(startsAt) {
+		return nil, ErrInvalidPreorderWindow
+	}
+	if shipDate.Before(startsAt) {
+		return nil, ErrInvalidPreorderShipDate
+	}
+	if allocationCap <= 0 {
+		return nil, ErrInvalidAllocationCap
+	}
+
+	now := time.Now().UTC()
+	return &PreorderCampaign{
+		SKUID:         skuID,
+		StartsAt:      startsAt.UTC(),
+		EndsAt:        endsAt.UTC(),
+		ShipDate:      shipDate.UTC(),
+		AllocationCap: allocationCap,
+		CreatedAt:     now,
+		UpdatedAt:     now,
+	}, nil
+}
+
+// IsOpen reports whether orders can be allocated at t.
+func (c *PreorderCampaign) IsOpen(t time.Time) bool {
+	return !t.Before(c.StartsAt) && t.Before(c.EndsAt)
+}
+
+// Remaining is the number of units that can still be allocated. It is 0
+// when the cap was lowered below the units already allocated.
+func (c *PreorderCampaign) Remaining() int64 {
+	return max(c.AllocationCap-c.Allocated, 0)
+}
+
+// Allocate checks that quantity more units can be allocated at t.
+func (c *PreorderCampaign) Allocate(quantity int64, t time.Time) error {
+	if !c.IsOpen(t) {
+		return ErrPreorderNotOpen
+	}
+	if quantity > c.Remaining() {
+		return ErrPreorderAllocationExhausted
+	}
+	c.Allocated += quantity
+	return nil
+}
+
+// PreorderAllocationStatus is the state of an order line allocated against
+// a preorder campaign.
+type PreorderAllocationStatus string
+
+const (
+	PreorderAllocationAllocated PreorderAllocationStatus = "allocated"
+	// PreorderAllocationReleased lines no longer count against the cap.
+	PreorderAllocationReleased PreorderAllocationStatus = "released"
+)
+
+// PreorderItem is an order line of a preorder SKU.
+type PreorderItem struct {
+	SKUID    uuid.UUID
+	Quantity int64
+}
+
+// PreorderAllocation is an order line allocated against a campaign.
+type PreorderAllocation struct {
+	OrderID    uuid.UUID
+	SKUID      uuid.UUID
+	Quantity   int64
+	Status     PreorderAllocationStatus
+	CreatedAt  time.Time
+	ReleasedAt *time.Time
+}
+
+// AllocatedPreorder is the outcome of allocating an order's preorder lines.
+type AllocatedPreorder struct {
+	// Replayed is set when the order was allocated before; nothing was
+	// allocated and Allocations are the original ones.
+	Replayed    bool
+	Allocations []*PreorderAllocation
+}
+
+type PreorderRepository interface {
+	// UpsertCampaign creates or replaces a SKU's campaign, keeping its
+	// allocations, and returns the previous campaign (nil if there was
+	// none). Returns ErrSKUNotFound if the SKU doesn't exist.
+	UpsertCampaign(ctx context.Context, campaign *PreorderCampaign) (*PreorderCampaign, error)
+	// FindCampaigns returns the campaigns among skuIDs. SKUs without one
+	// are omitted.
+	FindCampaigns(ctx context.Context, skuIDs []uuid.UUID) ([]*PreorderCampaign, error)
+	// Allocate allocates every line of an order in one transaction.
+	// Returns ErrPreorderCampaignNotFound, ErrPreorderNotOpen or
+	// ErrPreorderAllocationExhausted, wrapped with the SKU, if a line
+	// cannot be allocated; nothing is allocated then.
+	Allocate(ctx context.Context, orderID uuid.UUID, items []PreorderItem, at time.Time) (*AllocatedPreorder, error)
+	// Release releases an order's allocated lines and returns them; lines
+	// released before are not returned again. Returns
+	// ErrPreorderAllocationNotFound if the order has no allocations.
+	Release(ctx context.Context, orderID uuid.UUID) ([]*PreorderAllocation, error)
+	// AllocatedOrderIDs returns the orders with allocated lines of a SKU.
+	AllocatedOrderIDs(ctx context.Context, skuID uuid.UUID) ([]uuid.UUID, error)
+}
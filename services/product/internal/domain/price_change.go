@@ -0,0 +1,113 @@
+package domain
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type PriceChangeStatus string
+
+const (
+	PriceChangeStatusScheduled PriceChangeStatus = "scheduled"
+	PriceChangeStatusApplied   PriceChangeStatus = "applied"
+	// PriceChangeStatusCancelled is set when the SKU was deleted before the
+	// change took effect.
+	PriceChangeStatusCancelled PriceChangeStatus = "cancelled"
+)
+
+// PriceChange is a change of a SKU's price in one currency. The currency
+// is matched against the SKU's base price when the change is applied, so a
+// change in any other currency updates the SKU's additional prices.
+type PriceChange struct {
+	ID             uuid.UUID
+	SKUID          uuid.UUID
+	Price          Money
+	PreviousAmount *int64
+	EffectiveFrom  time.Time
+	Status         PriceChangeStatus
+	Actor          string
+	CreatedAt      time.Time
+	AppliedAt      *time.Time
+}
+
+// NewScheduledPriceChange creates a change that takes effect at
+// effectiveFrom, which must be in the future.
+func NewScheduledPriceChange(skuID uuid.UUID, price Money, effectiveFrom time.Time, actor string) (*PriceChange, error) {
+	if err := price.Validate(); err != nil {
+		return nil, err
+	}
+	now := time.Now().UTC()
+	if !effectiveFrom.After(now) {
+		return nil, ErrInvalidEffectiveFrom
+	}
+	return &PriceChange{
+		ID:            uuid.New(),
+		SKUID:         skuID,
+		Price:         price,
+		EffectiveFrom: effectiveFrom.UTC(),
+		Status:        PriceChangeStatusScheduled,
+		Actor:         actor,
+		CreatedAt:     now,
+	}, nil
+}
+
+// NewAppliedPriceChange records a change that took effect immediately.
+// previous is nil when the SKU had no price in the currency.
+func NewAppliedPriceChange(skuID uuid.UUID, price Money, previous *int64, actor string) *PriceChange {
+	now := time.Now().UTC()
+	return &PriceChange{
+		ID:             uuid.New(),
+		SKUID:          skuID,
+		Price:          price,
+		PreviousAmount: previous,
+		EffectiveFrom:  now,
+		Status:         PriceChangeStatusApplied,
+		Actor:          actor,
+		CreatedAt:      now,
+		AppliedAt:      &now,
+	}
+}
+
+// PriceChangesBetween returns the changes that turn the prices of before
+// into those of after, one per currency whose amount differs or that was
+// added. Removed currencies are not recorded.
+func PriceChangesBetween(skuID uuid.UUID, before, after []Money, actor string) []*PriceChange {
+	previous := make(map[string]int64, len(before))
+	for _, p := range before {
+		previous[p.Currency] = p.Amount
+	}
+
+	var changes []*PriceChange
+	for _, p := range after {
+		amount, ok := previous[p.Currency]
+		if ok && amount == p.Amount {
+			continue
+		}
+		var prev *int64
+		if ok {
+			prev = &amount
+		}
+		changes = append(changes, NewAppliedPriceChange(skuID, p, prev, actor))
+	}
+	return changes
+}
+
+// PriceChangeCursor is the position of the last change of a history page.
+type PriceChangeCursor struct {
+	EffectiveFrom time.Time `json:"effective_from"`
+	ID            uuid.UUID `json:"id"`
+}
+
+type PriceChangeRepository interface {
+	Create(ctx context.Context, changes ...*PriceChange) error
+	// ListBySKU returns a SKU's changes, scheduled ones included, newest
+	// effective_from first. When after is set only changes following it
+	// are returned.
+	ListBySKU(ctx context.Context, skuID uuid.UUID, limit int, after *PriceChangeCursor) ([]*PriceChange, error)
+	// ApplyDue applies up to limit scheduled changes whose effective_from
+	// has passed, oldest first, and returns them with their new status.
+	// Rows being applied by another replica are skipped.
+	ApplyDue(ctx context.Context, limit int) ([]*PriceChange, error)
+}
@@ -42,6 +42,7 @@ type Product struct {
 	Description *string
 	CategoryID  *uuid.UUID
 	Status      ProductStatus
+	Visibility  Visibility
 	CreatedAt   time.Time
 	UpdatedAt   time.Time
 	DeletedAt   *time.Time
@@ -50,28 +51,51 @@ type Product struct {
 type ProductWithSKUs struct {
 	Product *Product
 	SKUs    []*SKU
+	Images  []*ProductImage
 }
 
 type ProductRepository interface {
 	Create(ctx context.Context, product *Product) error
 	FindByID(ctx context.Context, id uuid.UUID) (*Product, error)
+	FindByIDs(ctx context.Context, ids []uuid.UUID) ([]*Product, error)
 	FindByIDWithSKUs(ctx context.Context, id uuid.UUID) (*ProductWithSKUs, error)
 	List(ctx context.Context, filter ProductFilter, pagination Pagination) ([]*Product, int64, error)
 	Update(ctx context.Context, product *Product) error
 	UpdateStatus(ctx context.Context, id uuid.UUID, status ProductStatus) error
+	UpdateVisibility(ctx context.Context, product *Product) error
 	SoftDelete(ctx context.Context, id uuid.UUID) error
 	SoftDeleteWithSKUs(ctx context.Context, id uuid.UUID) error
 }
 
 type ProductFilter struct {
-	CategoryID *uuid.UUID
-	Status     *ProductStatus
-	Search     *string
+	CategoryID    *uuid.UUID
+	Status        *ProductStatus
+	Search        *string
+	CreatedAfter  *time.Time
+	CreatedBefore *time.Time
+
+	// Audience, when set, excludes products not visible to it.
+	Audience *Audience
+}
+
+// ProductSortFields are the fields products can be ordered by.
+var ProductSortFields = []string{"created_at", "updated_at", "name"}
+
+// SortField orders a listing by one field.
+type SortField struct {
+	Field string
+	Desc  bool
 }
 
 type Pagination struct {
 	PageSize  int32
 	PageToken string
+
+	// Offset is the number of results to skip, decoded from PageToken.
+	Offset int32
+
+	// Sort orders results; ties are broken by ID. Defaults to newest first.
+	Sort []SortField
 }
 
 func NewProduct(name string, description *string, categoryID *uuid.UUID) (*Product, error) {
@@ -101,6 +125,16 @@ func ValidateProductName(name string) error {
 	return nil
 }
 
+// ParseProductStatus parses a status name as returned by String.
+func ParseProductStatus(s string) (ProductStatus, error) {
+	for _, status := range []ProductStatus{ProductStatusDraft, ProductStatusPublished, ProductStatusHidden} {
+		if status.String() == s {
+			return status, nil
+		}
+	}
+	return ProductStatusUnspecified, ErrInvalidProductStatus
+}
+
 func ValidateProductStatus(status ProductStatus) error {
 	if !status.IsValid() {
 		return ErrInvalidProductStatus
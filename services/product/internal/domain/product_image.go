@@ -0,0 +1,106 @@
+package domain
+
+import (
+	"context"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+)
+
+const (
+	MaxProductImages      = 20
+	MaxImageAltTextLength = 500
+	MaxImageSizeBytes     = 10 << 20
+)
+
+// ImageContentTypes maps the accepted image content types to the file
+// extension used in object keys.
+var ImageContentTypes = map[string]string{
+	"image/jpeg": ".jpg",
+	"image/png":  ".png",
+	"image/webp": ".webp",
+	"image/avif": ".avif",
+}
+
+type ProductImageStatus string
+
+const (
+	// ProductImageStatusPending is set until the client reports the upload
+	// to the presigned URL as complete.
+	ProductImageStatusPending ProductImageStatus = "pending"
+	ProductImageStatusReady   ProductImageStatus = "ready"
+)
+
+// ProductImage is an image of a product kept in object storage. Only ready
+// images are shown with the product, ordered by Position.
+type ProductImage struct {
+	ID          uuid.UUID
+	ProductID   uuid.UUID
+	ObjectKey   string
+	ContentType string
+	SizeBytes   int64
+	AltText     string
+	Position    int32
+	Status      ProductImageStatus
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+// NewProductImage creates a pending image whose object key is derived from
+// the product and image IDs.
+func NewProductImage(productID uuid.UUID, contentType, altText string) (*ProductImage, error) {
+	ext, ok := ImageContentTypes[contentType]
+	if !ok {
+		return nil, ErrInvalidImageContentType
+	}
+	if err := ValidateImageAltText(altText); err != nil {
+		return nil, err
+	}
+
+	now := time.Now().UTC()
+	id := uuid.New()
+	return &ProductImage{
+		ID:          id,
+		ProductID:   productID,
+		ObjectKey:   "products/" + productID.String() + "/" + id.String() + ext,
+		ContentType: contentType,
+		AltText:     altText,
+		Status:      ProductImageStatusPending,
+		CreatedAt:   now,
+		UpdatedAt:   now,
+	}, nil
+}
+
+func ValidateImageAltText(altText string) error {
+	if utf8.RuneCountInString(altText) > MaxImageAltTextLength {
+		return ErrImageAltTextTooLong
+	}
+	return nil
+}
+
+type ProductImageRepository interface {
+	Create(ctx context.Context, image *ProductImage) error
+	FindByID(ctx context.Context, id uuid.UUID) (*ProductImage, error)
+	// ListByProduct returns the ready images of a product by position.
+	ListByProduct(ctx context.Context, productID uuid.UUID) ([]*ProductImage, error)
+	// MarkReady records the uploaded size and moves the image after the
+	// product's other ready images.
+	MarkReady(ctx context.Context, image *ProductImage) error
+	UpdateAltText(ctx context.Context, image *ProductImage) error
+	// Reorder sets the positions of a product's ready images to their index
+	// in ids, which must hold every ready image exactly once.
+	Reorder(ctx context.Context, productID uuid.UUID, ids []uuid.UUID) error
+	Delete(ctx context.Context, id uuid.UUID) error
+}
+
+// ImageStorage holds image files. Clients upload directly to the storage
+// through presigned URLs, so image bytes never pass through the service.
+type ImageStorage interface {
+	PresignUpload(ctx context.Context, key, contentType string) (url string, expiresAt time.Time, err error)
+	// Size returns the size of an uploaded object, or ErrImageNotUploaded.
+	Size(ctx context.Context, key string) (int64, error)
+	Delete(ctx context.Context, key string) error
+	// URL is the public URL images are served from.
+	URL(key string) string
+}
@@ -0,0 +1,32 @@
+package domain
+
+import "context"
+
+const (
+	// MaxImportSizeBytes is the largest payload ImportProducts accepts.
+	MaxImportSizeBytes = 32 << 20
+
+	// ImportBatchSize is the number of products written per transaction.
+	ImportBatchSize = 100
+
+	// MaxImportRowErrors caps the row errors kept in an import report; the
+	// failed product count stays exact.
+	MaxImportRowErrors = 1000
+)
+
+// ImportedProduct is a validated product of an import together with its
+// SKUs and their initial inventory, in the same order.
+type ImportedProduct struct {
+	Product     *Product
+	SKUs        []*SKU
+	Inventories []*Inventory
+}
+
+// ProductImporter writes imported products.
+type ProductImporter interface {
+	// ImportBatch creates products in one transaction. A product whose SKU
+	// codes are already taken is rolled back on its own and its error
+	// returned at its index in rowErrs; any other failure rolls back the
+	// whole batch.
+	ImportBatch(ctx context.Context, products []*ImportedProduct) (rowErrs []error, err error)
+}
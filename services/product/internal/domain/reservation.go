@@ -44,6 +44,9 @@ type ReservationItem struct {
 	Quantity int64
 }
 
+// MaxReleaseReasonLength bounds the reason given for a forced release.
+const MaxReleaseReasonLength = 500
+
 type Reservation struct {
 	ID        uuid.UUID
 	Status    ReservationStatus
@@ -51,14 +54,27 @@ type Reservation struct {
 	ExpiresAt time.Time
 	CreatedAt time.Time
 	UpdatedAt time.Time
+	// ReleaseReason is set when support staff force-released the reservation.
+	ReleaseReason string
+}
+
+// ReservationFilter narrows a reservation listing. Zero fields match every
+// reservation.
+type ReservationFilter struct {
+	Status        *ReservationStatus
+	SKUID         *uuid.UUID
+	CreatedAfter  time.Time // Inclusive
+	CreatedBefore time.Time // Exclusive
 }
 
 type ReservationRepository interface {
 	Create(ctx context.Context, reservation *Reservation) error
 	FindByID(ctx context.Context, id uuid.UUID) (*Reservation, error)
 	UpdateStatus(ctx context.Context, id uuid.UUID, status ReservationStatus) error
-	FindExpiredPending(ctx context.Context, limit int) ([]*Reservation, error)
-	BatchUpdateExpired(ctx context.Context, ids []uuid.UUID) error
+	// List returns reservations matching filter newest first. When beforeID
+	// is not nil only reservations with a smaller (older, as IDs are UUIDv7)
+	// ID are returned.
+	List(ctx context.Context, filter ReservationFilter, beforeID uuid.UUID, limit int) ([]*Reservation, error)
 }
 
 func NewReservation(items []ReservationItem, ttl time.Duration) (*Reservation, error) {
@@ -150,3 +166,11 @@ func (r *Reservation) GetItemBySKUID(skuID uuid.UUID) *ReservationItem {
 	}
 	return nil
 }
+
+func (r *Reservation) SKUIDs() []uuid.UUID {
+	ids := make([]uuid.UUID, len(r.Items))
+	for i, item := range r.Items {
+		ids[i] = item.SKUID
+	}
+	return ids
+}
@@ -10,29 +10,43 @@ import (
 
 const MaxSKUCodeLength = 100
 
+// MaxBatchGetIDs is the maximum number of IDs accepted by batch lookups.
+const MaxBatchGetIDs = 100
+
 type Money struct {
 	Amount   int64
 	Currency string
 }
 
 func NewMoney(amount int64, currency string) (*Money, error) {
-	if amount < 0 {
-		return nil, ErrInvalidPrice
-	}
 	if currency == "" {
-		currency = "JPY"
+		currency = DefaultCurrency
 	}
-	return &Money{
+	m := &Money{
 		Amount:   amount,
 		Currency: currency,
-	}, nil
+	}
+	if err := m.Validate(); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (m Money) Validate() error {
+	if m.Amount < 0 {
+		return ErrInvalidPrice
+	}
+	return ValidateCurrency(m.Currency)
 }
 
 type SKU struct {
-	ID         uuid.UUID
-	ProductID  uuid.UUID
-	SKUCode    string
-	Price      Money
+	ID        uuid.UUID
+	ProductID uuid.UUID
+	SKUCode   string
+	Price     Money
+	// Prices holds the SKU's prices in currencies other than Price's, at
+	// most one per currency. Only loaded by lookups of a single SKU.
+	Prices     []Money
 	Attributes map[string]string
 	CreatedAt  time.Time
 	UpdatedAt  time.Time
@@ -48,19 +62,31 @@ type SKURepository interface {
 	Create(ctx context.Context, sku *SKU) error
 	FindByID(ctx context.Context, id uuid.UUID) (*SKU, error)
 	FindByIDWithInventory(ctx context.Context, id uuid.UUID) (*SKUWithInventory, error)
+	FindByIDsWithInventory(ctx context.Context, ids []uuid.UUID) ([]*SKUWithInventory, error)
 	FindByProductID(ctx context.Context, productID uuid.UUID) ([]*SKU, error)
 	FindBySKUCode(ctx context.Context, skuCode string) (*SKU, error)
 	Update(ctx context.Context, sku *SKU) error
 	SoftDelete(ctx context.Context, id uuid.UUID) error
 	ExistsBySKUCode(ctx context.Context, skuCode string, excludeID *uuid.UUID) (bool, error)
+	// FindTakenSKUCodes returns the codes of skuCodes used by SKUs that are
+	// not deleted.
+	FindTakenSKUCodes(ctx context.Context, skuCodes []string) ([]string, error)
+	// CreateBatch creates SKUs and their initial inventory, in the same
+	// order, in one transaction. It returns ErrSKUCodeAlreadyExists, and
+	// creates nothing, if any SKU code is taken.
+	CreateBatch(ctx context.Context, skus []*SKU, inventories []*Inventory) error
+	// FindPrices returns the additional currency prices of a SKU.
+	FindPrices(ctx context.Context, skuID uuid.UUID) ([]Money, error)
+	// ReplacePrices replaces the additional currency prices of a SKU.
+	ReplacePrices(ctx context.Context, skuID uuid.UUID, prices []Money) error
 }
 
 func NewSKU(productID uuid.UUID, skuCode string, price Money, attributes map[string]string) (*SKU, error) {
 	if err := ValidateSKUCode(skuCode); err != nil {
 		return nil, err
 	}
-	if price.Amount < 0 {
-		return nil, ErrInvalidPrice
+	if err := price.Validate(); err != nil {
+		return nil, err
 	}
 
 	if attributes == nil {
@@ -97,8 +123,8 @@ func (s *SKU) Update(skuCode string, price Money, attributes map[string]string)
 	if err := ValidateSKUCode(skuCode); err != nil {
 		return err
 	}
-	if price.Amount < 0 {
-		return ErrInvalidPrice
+	if err := ValidatePrices(price, s.Prices); err != nil {
+		return err
 	}
 
 	s.SKUCode = skuCode
@@ -111,10 +137,53 @@ func (s *SKU) Update(skuCode string, price Money, attributes map[string]string)
 }
 
 func (s *SKU) UpdatePrice(price Money) error {
-	if price.Amount < 0 {
-		return ErrInvalidPrice
+	if err := ValidatePrices(price, s.Prices); err != nil {
+		return err
 	}
 	s.Price = price
 	s.UpdatedAt = time.Now().UTC()
 	return nil
 }
+
+// SetPrices replaces the SKU's additional currency prices.
+func (s *SKU) SetPrices(prices []Money) error {
+	if err := ValidatePrices(s.Price, prices); err != nil {
+		return err
+	}
+	s.Prices = prices
+	s.UpdatedAt = time.Now().UTC()
+	return nil
+}
+
+// PriceIn returns the SKU's price in currency, falling back to Price when
+// the SKU has no price in that currency.
+func (s *SKU) PriceIn(currency string) Money {
+	for _, p := range s.Prices {
+		if p.Currency == currency {
+			return p
+		}
+	}
+	return s.Price
+}
+
+// ValidatePrices checks a base price together with additional prices: every
+// price must be valid and no two may share a currency.
+func ValidatePrices(base Money, prices []Money) error {
+	if err := base.Validate(); err != nil {
+		return err
+	}
+	if len(prices) > MaxSKUPrices {
+		return ErrTooManyPrices
+	}
+	seen := map[string]struct{}{base.Currency: {}}
+	for _, p := range prices {
+		if err := p.Validate(); err != nil {
+			return err
+		}
+		if _, ok := seen[p.Currency]; ok {
+			return ErrDuplicateCurrency
+		}
+		seen[p.Currency] = struct{}{}
+	}
+	return nil
+}
@@ -0,0 +1,173 @@
+package domain
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+
+	"github.com/google/uuid"
+)
+
+const (
+	MaxSKUDimensions      = 3
+	MaxSKUDimensionValues = 50
+	// MaxGeneratedSKUs caps the combinations of a SKU matrix.
+	MaxGeneratedSKUs = 500
+)
+
+// SKUDimension is an attribute whose values a SKU matrix combines, e.g.
+// size with S, M and L.
+type SKUDimension struct {
+	Name   string
+	Values []string
+}
+
+// SKUPriceRule adds AmountDelta, which may be negative, to the base price
+// of the SKUs whose Attribute is Value.
+type SKUPriceRule struct {
+	Attribute   string
+	Value       string
+	AmountDelta int64
+}
+
+// SKUMatrix describes the SKUs of a product as every combination of the
+// values of its dimensions.
+type SKUMatrix struct {
+	Dimensions []SKUDimension
+	BasePrice  Money
+	PriceRules []SKUPriceRule
+	// CodePrefix starts every generated SKU code.
+	CodePrefix string
+}
+
+// Validate checks the dimensions and price rules; prices are checked per
+// SKU by NewSKU.
+func (m SKUMatrix) Validate() error {
+	if len(m.Dimensions) == 0 || len(m.Dimensions) > MaxSKUDimensions {
+		return fmt.Errorf("%w: between 1 and %d dimensions are required", ErrInvalidSKUDimensions, MaxSKUDimensions)
+	}
+
+	combinations := 1
+	names := make(map[string]map[string]struct{}, len(m.Dimensions))
+	for _, d := range m.Dimensions {
+		if d.Name == "" {
+			return fmt.Errorf("%w: dimension name is empty", ErrInvalidSKUDimensions)
+		}
+		if _, ok := names[d.Name]; ok {
+			return fmt.Errorf("%w: duplicate dimension %s", ErrInvalidSKUDimensions, d.Name)
+		}
+		if len(d.Values) == 0 || len(d.Values) > MaxSKUDimensionValues {
+			return fmt.Errorf("%w: %s must have between 1 and %d values", ErrInvalidSKUDimensions, d.Name, MaxSKUDimensionValues)
+		}
+
+		values := make(map[string]struct{}, len(d.Values))
+		segments := make(map[string]string, len(d.Values))
+		for _, v := range d.Values {
+			if _, ok := values[v]; ok {
+				return fmt.Errorf("%w: duplicate value %q of %s", ErrInvalidSKUDimensions, v, d.Name)
+			}
+			segment := skuCodeSegment(v)
+			if segment == "" {
+				return fmt.Errorf("%w: value %q of %s has no letters or digits", ErrInvalidSKUDimensions, v, d.Name)
+			}
+			if other, ok := segments[segment]; ok {
+				return fmt.Errorf("%w: values %q and %q of %s generate the same SKU code", ErrInvalidSKUDimensions, other, v, d.Name)
+			}
+			values[v] = struct{}{}
+			segments[segment] = v
+		}
+		names[d.Name] = values
+
+		combinations *= len(d.Values)
+		if combinations > MaxGeneratedSKUs {
+			return ErrTooManySKUCombinations
+		}
+	}
+
+	for _, r := range m.PriceRules {
+		values, ok := names[r.Attribute]
+		if !ok {
+			return fmt.Errorf("%w: %s is not a dimension", ErrInvalidSKUPriceRule, r.Attribute)
+		}
+		if _, ok := values[r.Value]; !ok {
+			return fmt.Errorf("%w: %q is not a value of %s", ErrInvalidSKUPriceRule, r.Value, r.Attribute)
+		}
+	}
+	return nil
+}
+
+// Combinations returns the attributes of every SKU of the matrix, varying
+// the last dimension fastest.
+func (m SKUMatrix) Combinations() []map[string]string {
+	combinations := []map[string]string{{}}
+	for _, d := range m.Dimensions {
+		next := make([]map[string]string, 0, len(combinations)*len(d.Values))
+		for _, c := range combinations {
+			for _, v := range d.Values {
+				attrs := make(map[string]string, len(c)+1)
+				for k, cv := range c {
+					attrs[k] = cv
+				}
+				attrs[d.Name] = v
+				next = append(next, attrs)
+			}
+		}
+		combinations = next
+	}
+	return combinations
+}
+
+// NewSKU creates the SKU of a combination. Its code is the prefix followed
+// by each value in dimension order, uppercased and joined by hyphens, e.g.
+// "TSHIRT-NAVY-BLUE-XL" for navy blue in XL.
+func (m SKUMatrix) NewSKU(productID uuid.UUID, attributes map[string]string) (*SKU, error) {
+	parts := make([]string, 0, len(m.Dimensions)+1)
+	if prefix := strings.Trim(m.CodePrefix, "-"); prefix != "" {
+		parts = append(parts, prefix)
+	}
+	for _, d := range m.Dimensions {
+		parts = append(parts, skuCodeSegment(attributes[d.Name]))
+	}
+
+	price := m.BasePrice
+	for _, r := range m.PriceRules {
+		if attributes[r.Attribute] == r.Value {
+			price.Amount += r.AmountDelta
+		}
+	}
+	if price.Amount < 0 {
+		return nil, fmt.Errorf("%w: price rules make %v negative", ErrInvalidPrice, attributes)
+	}
+
+	return NewSKU(productID, strings.Join(parts, "-"), price, attributes)
+}
+
+// skuCodeSegment uppercases the letters and digits of a value and replaces
+// every run of other characters with a single hyphen.
+func skuCodeSegment(value string) string {
+	var b strings.Builder
+	hyphen := false
+	for _, r := range value {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			if hyphen && b.Len() > 0 {
+				b.WriteByte('-')
+			}
+			hyphen = false
+			b.WriteRune(unicode.ToUpper(r))
+			continue
+		}
+		hyphen = true
+	}
+	return b.String()
+}
+
+// HasCombination reports whether the SKU has the values of attributes for
+// every attribute in it; the SKU may have other attributes as well.
+func (s *SKU) HasCombination(attributes map[string]string) bool {
+	for k, v := range attributes {
+		if s.Attributes[k] != v {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,33 @@
+package domain
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const MaxVelocityWindowDays = 365
+
+type VelocityWindow struct {
+	Days      int
+	UnitsSold int64
+}
+
+func (w VelocityWindow) UnitsPerDay() float64 {
+	if w.Days <= 0 {
+		return 0
+	}
+	return float64(w.UnitsSold) / float64(w.Days)
+}
+
+type SKUVelocity struct {
+	SKUID   uuid.UUID
+	Windows []VelocityWindow
+}
+
+type SalesVelocityRepository interface {
+	// SumConfirmedQuantities returns units per SKU in reservations confirmed at or after since.
+	// SKUs without sales are omitted from the result.
+	SumConfirmedQuantities(ctx context.Context, skuIDs []uuid.UUID, since time.Time) (map[uuid.UUID]int64, error)
+}
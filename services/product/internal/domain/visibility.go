@@ -0,0 +1,62 @@
+package domain
+
+import (
+	"regexp"
+	"slices"
+	"strings"
+)
+
+// Sales channels a product can be restricted to.
+const (
+	ChannelWeb         = "web"
+	ChannelApp         = "app"
+	ChannelMarketplace = "marketplace"
+)
+
+var Channels = []string{ChannelWeb, ChannelApp, ChannelMarketplace}
+
+var marketPattern = regexp.MustCompile(`^[A-Z]{2}$`)
+
+// Visibility restricts where public reads return a product, e.g. to soft
+// launch it in the app or in Japan only. An empty list places no
+// restriction on that dimension.
+type Visibility struct {
+	Channels []string
+	Markets  []string // ISO 3166-1 alpha-2
+}
+
+// NewVisibility validates channels and markets and returns them sorted and
+// without duplicates. Market codes are upper-cased.
+func NewVisibility(channels, markets []string) (Visibility, error) {
+	v := Visibility{Channels: []string{}, Markets: []string{}}
+	for _, c := range channels {
+		if !slices.Contains(Channels, c) {
+			return Visibility{}, ErrInvalidChannel
+		}
+		v.Channels = append(v.Channels, c)
+	}
+	for _, m := range markets {
+		m = strings.ToUpper(m)
+		if !marketPattern.MatchString(m) {
+			return Visibility{}, ErrInvalidMarket
+		}
+		v.Markets = append(v.Markets, m)
+	}
+	slices.Sort(v.Channels)
+	slices.Sort(v.Markets)
+	v.Channels = slices.Compact(v.Channels)
+	v.Markets = slices.Compact(v.Markets)
+	return v, nil
+}
+
+// Audience describes where a public read comes from. Market is empty when
+// unknown, which hides products restricted to any market.
+type Audience struct {
+	Channel string
+	Market  string
+}
+
+func (v Visibility) VisibleTo(a Audience) bool {
+	return (len(v.Channels) == 0 || slices.Contains(v.Channels, a.Channel)) &&
+		(len(v.Markets) == 0 || slices.Contains(v.Markets, a.Market))
+}
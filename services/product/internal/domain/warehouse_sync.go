@@ -0,0 +1,95 @@
+package domain
+
+import (
+	"context"
+	"regexp"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	// MaxWarehouseBatch bounds the mappings or adjustments of one call.
+	MaxWarehouseBatch = 500
+
+	MaxExternalSKULength = 255
+
+	// StockChangeSettleDelay holds back recent movements from the stock
+	// change feed. Movement IDs are assigned before commit, so a concurrent
+	// transaction can commit a lower ID after a higher one has been read;
+	// waiting lets such transactions finish before the cursor passes them.
+	StockChangeSettleDelay = 10 * time.Second
+)
+
+var providerPattern = regexp.MustCompile(`^[a-z0-9][a-z0-9-]{0,63}$`)
+
+// ExternalSKUMapping links a third-party logistics (3PL) provider's SKU
+// identifier to an internal SKU. A SKU has at most one identifier per
+// provider.
+type ExternalSKUMapping struct {
+	Provider    string
+	ExternalSKU string
+	SKUID       uuid.UUID
+	CreatedAt   time.Time
+}
+
+// WarehouseAdjustment changes the on-hand quantity of a SKU by a delta,
+// e.g. +100 for goods received or -2 for damaged stock.
+type WarehouseAdjustment struct {
+	ExternalSKU   string
+	QuantityDelta int64
+}
+
+// AppliedAdjustments is the outcome of a warehouse adjustment push.
+type AppliedAdjustments struct {
+	// Replayed is set when the idempotency key was already used; nothing
+	// was changed and Count is the size of the original push.
+	Replayed bool
+	Count    int
+	// SKUIDs are the adjusted SKUs in push order, empty when Replayed.
+	SKUIDs []uuid.UUID
+}
+
+// StockChange is an inventory movement of a SKU mapped for a provider.
+type StockChange struct {
+	Movement    *InventoryMovement
+	ExternalSKU string
+}
+
+type WarehouseSyncRepository interface {
+	// UpsertMappings creates or repoints mappings in one transaction.
+	// Returns ErrSKUNotFound if a SKU doesn't exist and
+	// ErrExternalSKUConflict if a SKU is already mapped under another
+	// identifier.
+	UpsertMappings(ctx context.Context, mappings []*ExternalSKUMapping) error
+	// ListMappings returns a provider's mappings ordered by external SKU,
+	// starting after afterExternalSKU.
+	ListMappings(ctx context.Context, provider string, limit int, afterExternalSKU string) ([]*ExternalSKUMapping, error)
+	DeleteMapping(ctx context.Context, provider, externalSKU string) error
+	// ApplyAdjustments applies all adjustments or none in one transaction,
+	// recording idempotencyKey with them. Returns ErrExternalSKUNotMapped
+	// or ErrInsufficientStock, wrapped with the external SKU, if any
+	// adjustment cannot be applied.
+	ApplyAdjustments(ctx context.Context, provider, idempotencyKey string, adjustments []WarehouseAdjustment, src MovementSource) (*AppliedAdjustments, error)
+	// ListStockChanges returns movements of the provider's mapped SKUs with
+	// an ID greater than afterID and created before createdBefore, oldest
+	// first.
+	ListStockChanges(ctx context.Context, provider string, afterID int64, createdBefore time.Time, limit int) ([]*StockChange, error)
+}
+
+// ValidateProvider checks a 3PL provider identifier: lowercase letters,
+// digits and hyphens, at most 64 characters.
+func ValidateProvider(provider string) error {
+	if !providerPattern.MatchString(provider) {
+		return ErrInvalidProvider
+	}
+	return nil
+}
+
+// ValidateExternalSKU checks a provider's SKU identifier.
+func ValidateExternalSKU(externalSKU string) error {
+	if externalSKU == "" || len(externalSKU) > MaxExternalSKULength {
+		return ErrInvalidExternalSKU
+	}
+	return nil
+}
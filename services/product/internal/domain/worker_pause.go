@@ -0,0 +1,34 @@
+package domain
+
+import (
+	"context"
+	"time"
+)
+
+// MaxPauseReasonLength bounds the reason given for pausing a worker.
+const MaxPauseReasonLength = 500
+
+// WorkerPause records that an operator paused a background worker. A
+// paused worker skips its ticks on every instance until resumed.
+type WorkerPause struct {
+	Worker   string
+	Reason   string
+	PausedBy string
+	PausedAt time.Time
+}
+
+// WorkerStatus is a background worker and its pause, nil if it runs.
+type WorkerStatus struct {
+	Name  string
+	Pause *WorkerPause
+}
+
+type WorkerPauseRepository interface {
+	// Pause keeps an existing pause of the worker and returns it.
+	Pause(ctx context.Context, pause *WorkerPause) (*WorkerPause, error)
+	// Resume removes the worker's pause, if any.
+	Resume(ctx context.Context, worker string) error
+	// Find returns nil if the worker is not paused.
+	Find(ctx context.Context, worker string) (*WorkerPause, error)
+	FindAll(ctx context.Context) ([]*WorkerPause, error)
+}
@@ -0,0 +1,83 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"github.com/daisuke8000/example-ec-platform/services/product/internal/domain"
+)
+
+// AttributeDefinitionUseCase manages the SKU attribute schemas of
+// categories, which SKUUseCase enforces on the SKUs of their products.
+type AttributeDefinitionUseCase interface {
+	CreateAttributeDefinition(ctx context.Context, input CreateAttributeDefinitionInput) (*domain.AttributeDefinition, error)
+	ListAttributeDefinitions(ctx context.Context, categoryID uuid.UUID) ([]*domain.AttributeDefinition, error)
+	UpdateAttributeDefinition(ctx context.Context, id uuid.UUID, input UpdateAttributeDefinitionInput) (*domain.AttributeDefinition, error)
+	DeleteAttributeDefinition(ctx context.Context, id uuid.UUID) error
+}
+
+type CreateAttributeDefinitionInput struct {
+	CategoryID    uuid.UUID
+	Name          string
+	Type          domain.AttributeType
+	AllowedValues []string
+	Required      bool
+}
+
+// UpdateAttributeDefinitionInput replaces everything but the category and
+// the name.
+type UpdateAttributeDefinitionInput struct {
+	Type          domain.AttributeType
+	AllowedValues []string
+	Required      bool
+}
+
+type attributeDefinitionUseCase struct {
+	repo         domain.AttributeDefinitionRepository
+	categoryRepo domain.CategoryRepository
+}
+
+func NewAttributeDefinitionUseCase(repo domain.AttributeDefinitionRepository, categoryRepo domain.CategoryRepository) AttributeDefinitionUseCase {
+	return &attributeDefinitionUseCase{repo: repo, categoryRepo: categoryRepo}
+}
+
+func (uc *attributeDefinitionUseCase) CreateAttributeDefinition(ctx context.Context, input CreateAttributeDefinitionInput) (*domain.AttributeDefinition, error) {
+	if _, err := uc.categoryRepo.FindByID(ctx, input.CategoryID); err != nil {
+		return nil, err
+	}
+
+	def, err := domain.NewAttributeDefinition(input.CategoryID, input.Name, input.Type, input.AllowedValues, input.Required)
+	if err != nil {
+		return nil, err
+	}
+	if err := uc.repo.Create(ctx, def); err != nil {
+		return nil, err
+	}
+	return def, nil
+}
+
+func (uc *attributeDefinitionUseCase) ListAttributeDefinitions(ctx context.Context, categoryID uuid.UUID) ([]*domain.AttributeDefinition, error) {
+	if _, err := uc.categoryRepo.FindByID(ctx, categoryID); err != nil {
+		return nil, err
+	}
+	return uc.repo.FindByCategoryID(ctx, categoryID)
+}
+
+func (uc *attributeDefinitionUseCase) UpdateAttributeDefinition(ctx context.Context, id uuid.UUID, input UpdateAttributeDefinitionInput) (*domain.AttributeDefinition, error) {
+	def, err := uc.repo.FindByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if err := def.Update(input.Type, input.AllowedValues, input.Required); err != nil {
+		return nil, err
+	}
+	if err := uc.repo.Update(ctx, def); err != nil {
+		return nil, err
+	}
+	return def, nil
+}
+
+func (uc *attributeDefinitionUseCase) DeleteAttributeDefinition(ctx context.Context, id uuid.UUID) error {
+	return uc.repo.Delete(ctx, id)
+}
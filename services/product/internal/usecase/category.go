@@ -12,6 +12,10 @@ type CategoryUseCase interface {
 	CreateCategory(ctx context.Context, input CreateCategoryInput) (*domain.Category, error)
 	GetCategory(ctx context.Context, id uuid.UUID) (*domain.Category, error)
 	ListCategories(ctx context.Context, parentID *uuid.UUID) ([]*domain.Category, error)
+	// GetCategoryTree returns the tree under rootID, or the whole forest when
+	// rootID is nil, down to maxDepth levels below the top (all levels when
+	// maxDepth is negative).
+	GetCategoryTree(ctx context.Context, rootID *uuid.UUID, maxDepth int) ([]*domain.CategoryNode, error)
 	UpdateCategory(ctx context.Context, id uuid.UUID, input UpdateCategoryInput) (*domain.Category, error)
 	DeleteCategory(ctx context.Context, id uuid.UUID) error
 }
@@ -73,6 +77,14 @@ func (uc *categoryUseCase) ListCategories(ctx context.Context, parentID *uuid.UU
 	return uc.repo.FindByParentID(ctx, parentID)
 }
 
+func (uc *categoryUseCase) GetCategoryTree(ctx context.Context, rootID *uuid.UUID, maxDepth int) ([]*domain.CategoryNode, error) {
+	nodes, err := uc.repo.FindTree(ctx, rootID, maxDepth)
+	if err != nil {
+		return nil, err
+	}
+	return domain.BuildCategoryTree(nodes), nil
+}
+
 func (uc *categoryUseCase) UpdateCategory(ctx context.Context, id uuid.UUID, input UpdateCategoryInput) (*domain.Category, error) {
 	category, err := uc.repo.FindByID(ctx, id)
 	if err != nil {
@@ -95,6 +107,15 @@ func (uc *categoryUseCase) UpdateCategory(ctx context.Context, id uuid.UUID, inp
 		if _, err := uc.repo.FindByID(ctx, *parentID); err != nil {
 			return nil, err
 		}
+		if *parentID != id {
+			descendant, err := uc.repo.IsDescendant(ctx, *parentID, id)
+			if err != nil {
+				return nil, err
+			}
+			if descendant {
+				return nil, domain.ErrCategoryCycle
+			}
+		}
 	}
 
 	if name != category.Name || (parentID != nil && category.ParentID != nil && *parentID != *category.ParentID) {
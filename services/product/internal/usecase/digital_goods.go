@@ -0,0 +1,192 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/daisuke8000/example-ec-platform/services/product/internal/domain"
+)
+
+// DigitalGoodsUseCase fulfills SKUs that are delivered digitally instead of
+// shipped: license keys from per-SKU pools, or download links with a
+// per-buyer download limit.
+type DigitalGoodsUseCase interface {
+	SetFulfillment(ctx context.Context, input SetDigitalFulfillmentInput) (*domain.DigitalSKU, error)
+	GetDigitalSKUs(ctx context.Context, skuIDs []uuid.UUID) ([]*domain.DigitalSKU, error)
+	// AddLicenseKeys returns how many keys were added; keys already in the
+	// pool are skipped.
+	AddLicenseKeys(ctx context.Context, skuID uuid.UUID, keys []string) (int, error)
+	FulfillOrder(ctx context.Context, input FulfillOrderInput) (*domain.FulfilledOrder, error)
+	RetrieveGoods(ctx context.Context, input RetrieveGoodsInput) ([]*DigitalGood, error)
+}
+
+type SetDigitalFulfillmentInput struct {
+	SKUID        uuid.UUID
+	Kind         domain.DigitalKind
+	ObjectKey    string
+	MaxDownloads int
+}
+
+type FulfillOrderInput struct {
+	OrderID uuid.UUID
+	UserID  string
+	Items   []domain.DigitalOrderItem
+}
+
+type RetrieveGoodsInput struct {
+	OrderID uuid.UUID
+	UserID  string
+	// EntitlementID limits retrieval to one good of the order. Download
+	// URLs are only issued for a single requested good.
+	EntitlementID *uuid.UUID
+}
+
+// DigitalGood is an entitlement as handed to its buyer. DownloadURL is set
+// when a download was requested and one was left; issuing it used one up.
+type DigitalGood struct {
+	Entitlement  *domain.Entitlement
+	DownloadURL  string
+	URLExpiresAt time.Time
+}
+
+type digitalGoodsUseCase struct {
+	repo    domain.DigitalGoodsRepository
+	storage domain.DownloadStorage
+}
+
+// NewDigitalGoodsUseCase creates the digital goods use case. storage may be
+// nil, in which case license keys are still delivered but download SKUs
+// cannot be configured or retrieved (ErrDownloadStorageDisabled).
+func NewDigitalGoodsUseCase(repo domain.DigitalGoodsRepository, storage domain.DownloadStorage) DigitalGoodsUseCase {
+	return &digitalGoodsUseCase{
+		repo:    repo,
+		storage: storage,
+	}
+}
+
+func (uc *digitalGoodsUseCase) SetFulfillment(ctx context.Context, input SetDigitalFulfillmentInput) (*domain.DigitalSKU, error) {
+	if input.Kind == domain.DigitalKindDownload && uc.storage == nil {
+		return nil, domain.ErrDownloadStorageDisabled
+	}
+	sku, err := domain.NewDigitalSKU(input.SKUID, input.Kind, input.ObjectKey, input.MaxDownloads)
+	if err != nil {
+		return nil, err
+	}
+	if err := uc.repo.UpsertDigitalSKU(ctx, sku); err != nil {
+		return nil, err
+	}
+	return sku, nil
+}
+
+func (uc *digitalGoodsUseCase) GetDigitalSKUs(ctx context.Context, skuIDs []uuid.UUID) ([]*domain.DigitalSKU, error) {
+	if len(skuIDs) == 0 {
+		return nil, domain.ErrEmptyBatch
+	}
+	if len(skuIDs) > domain.MaxBatchGetIDs {
+		return nil, domain.ErrBatchSizeExceeded
+	}
+	return uc.repo.FindDigitalSKUs(ctx, skuIDs)
+}
+
+func (uc *digitalGoodsUseCase) AddLicenseKeys(ctx context.Context, skuID uuid.UUID, keys []string) (int, error) {
+	if len(keys) == 0 {
+		return 0, domain.ErrEmptyBatch
+	}
+	if len(keys) > domain.MaxLicenseKeysPerCall {
+		return 0, domain.ErrBatchSizeExceeded
+	}
+	for _, key := range keys {
+		if err := domain.ValidateLicenseKey(key); err != nil {
+			return 0, err
+		}
+	}
+	return uc.repo.AddLicenseKeys(ctx, skuID, keys)
+}
+
+// FulfillOrder assigns the digital goods of a paid order. Lines of the same
+// SKU are merged, so a retried call with reordered lines is still a replay.
+func (uc *digitalGoodsUseCase) FulfillOrder(ctx context.Context, input FulfillOrderInput) (*domain.FulfilledOrder, error) {
+	if input.UserID == "" {
+		return nil, domain.ErrMissingBuyer
+	}
+	if len(input.Items) == 0 {
+		return nil, domain.ErrEmptyBatch
+	}
+	if len(input.Items) > domain.MaxBatchGetIDs {
+		return nil, domain.ErrBatchSizeExceeded
+	}
+
+	quantities := make(map[uuid.UUID]int64, len(input.Items))
+	var items []domain.DigitalOrderItem
+	for _, item := range input.Items {
+		if item.Quantity <= 0 {
+			return nil, domain.ErrInvalidQuantity
+		}
+		if _, ok := quantities[item.SKUID]; !ok {
+			items = append(items, domain.DigitalOrderItem{SKUID: item.SKUID})
+		}
+		quantities[item.SKUID] += item.Quantity
+	}
+	for i := range items {
+		items[i].Quantity = quantities[items[i].SKUID]
+	}
+
+	return uc.repo.Fulfill(ctx, input.OrderID, input.UserID, items)
+}
+
+// RetrieveGoods returns the buyer's goods of an order. Listing the goods
+// uses no downloads; requesting a single download good issues a fresh
+// short-lived URL that counts against its limit. Downloads that reached the
+// limit are returned without a URL.
+func (uc *digitalGoodsUseCase) RetrieveGoods(ctx context.Context, input RetrieveGoodsInput) ([]*DigitalGood, error) {
+	entitlements, err := uc.repo.FindEntitlements(ctx, input.OrderID, input.UserID)
+	if err != nil {
+		return nil, err
+	}
+	if input.EntitlementID != nil {
+		var match *domain.Entitlement
+		for _, e := range entitlements {
+			if e.ID == *input.EntitlementID {
+				match = e
+			}
+		}
+		if match == nil {
+			return nil, domain.ErrDigitalOrderNotFound
+		}
+		entitlements = []*domain.Entitlement{match}
+	}
+
+	goods := make([]*DigitalGood, len(entitlements))
+	for i, e := range entitlements {
+		goods[i] = &DigitalGood{Entitlement: e}
+	}
+	if input.EntitlementID == nil {
+		return goods, nil
+	}
+
+	e := entitlements[0]
+	if e.Kind != domain.DigitalKindDownload || e.DownloadsRemaining() == 0 {
+		return goods, nil
+	}
+	if uc.storage == nil {
+		return nil, domain.ErrDownloadStorageDisabled
+	}
+	consumed, err := uc.repo.ConsumeDownload(ctx, e.ID)
+	if errors.Is(err, domain.ErrDownloadLimitReached) {
+		// A concurrent retrieval used the last download.
+		e.DownloadCount = e.MaxDownloads
+		return goods, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	url, expiresAt, err := uc.storage.PresignDownload(ctx, consumed.ObjectKey)
+	if err != nil {
+		return nil, err
+	}
+	goods[0] = &DigitalGood{Entitlement: consumed, DownloadURL: url, URLExpiresAt: expiresAt}
+	return goods, nil
+}
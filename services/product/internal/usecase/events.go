@@ -0,0 +1,123 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/daisuke8000/example-ec-platform/services/product/internal/domain"
+)
+
+// Event types published to webhook endpoints.
+const (
+	EventProductCreated    = "product.created"
+	EventProductUpdated    = "product.updated"
+	EventProductDeleted    = "product.deleted"
+	EventInventoryUpdated  = "inventory.updated"
+	EventInventoryLowStock = "inventory.low_stock"
+	EventSKUPriceChanged   = "sku.price_changed"
+
+	EventPreorderShipDateChanged = "preorder.ship_date_changed"
+	EventPickupReady             = "pickup.ready"
+)
+
+// EventTypes lists every event type the Product Service publishes.
+var EventTypes = []string{
+	EventProductCreated,
+	EventProductUpdated,
+	EventProductDeleted,
+	EventInventoryUpdated,
+	EventInventoryLowStock,
+	EventSKUPriceChanged,
+	EventPreorderShipDateChanged,
+	EventPickupReady,
+}
+
+// EventPublisher records events for delivery to webhook endpoints.
+type EventPublisher interface {
+	Publish(ctx context.Context, eventType string, data any) error
+}
+
+type noopEventPublisher struct{}
+
+// NewNoopEventPublisher returns a publisher that drops every event, for
+// deployments without webhooks.
+func NewNoopEventPublisher() EventPublisher {
+	return noopEventPublisher{}
+}
+
+func (noopEventPublisher) Publish(context.Context, string, any) error { return nil }
+
+type productEvent struct {
+	ID          uuid.UUID  `json:"id"`
+	Name        string     `json:"name,omitempty"`
+	Description *string    `json:"description,omitempty"`
+	CategoryID  *uuid.UUID `json:"category_id,omitempty"`
+	Status      string     `json:"status,omitempty"`
+	Channels    []string   `json:"channels,omitempty"`
+	Markets     []string   `json:"markets,omitempty"`
+	UpdatedAt   *time.Time `json:"updated_at,omitempty"`
+}
+
+func newProductEvent(p *domain.Product) productEvent {
+	return productEvent{
+		ID:          p.ID,
+		Name:        p.Name,
+		Description: p.Description,
+		CategoryID:  p.CategoryID,
+		Status:      p.Status.String(),
+		Channels:    p.Visibility.Channels,
+		Markets:     p.Visibility.Markets,
+		UpdatedAt:   &p.UpdatedAt,
+	}
+}
+
+type inventoryEvent struct {
+	SKUID  uuid.UUID             `json:"sku_id"`
+	Reason domain.MovementReason `json:"reason"`
+	// Quantity is the new on-hand quantity of an adjustment.
+	Quantity *int64 `json:"quantity,omitempty"`
+	// QuantityDelta is the change in on-hand quantity of other movements.
+	QuantityDelta *int64 `json:"quantity_delta,omitempty"`
+}
+
+// lowStockEvent is emitted once when a SKU's available quantity drops below
+// its low-stock threshold.
+type lowStockEvent struct {
+	SKUID     uuid.UUID `json:"sku_id"`
+	Available int64     `json:"available"`
+	Threshold int64     `json:"threshold"`
+}
+
+// priceChangedEvent is emitted for each applied change of a SKU's price,
+// whether updated directly or by a scheduled change taking effect.
+type priceChangedEvent struct {
+	SKUID    uuid.UUID `json:"sku_id"`
+	Currency string    `json:"currency"`
+	Amount   int64     `json:"amount"`
+}
+
+// preorderShipDateChangedEvent is emitted when a preorder campaign's ship
+// date moves, so that the buyers of the allocated orders can be notified.
+type preorderShipDateChangedEvent struct {
+	SKUID            uuid.UUID   `json:"sku_id"`
+	PreviousShipDate time.Time   `json:"previous_ship_date"`
+	ShipDate         time.Time   `json:"ship_date"`
+	OrderIDs         []uuid.UUID `json:"order_ids"`
+}
+
+// pickupReadyEvent is emitted when a pickup order is ready at its
+// location, so that the buyer can be notified.
+type pickupReadyEvent struct {
+	OrderID    uuid.UUID `json:"order_id"`
+	LocationID uuid.UUID `json:"location_id"`
+	SlotID     uuid.UUID `json:"slot_id"`
+	ReadyAt    time.Time `json:"ready_at"`
+}
+
+// publish records an event after a committed change. Failures are logged by
+// the publisher and do not fail the request.
+func publish(ctx context.Context, events EventPublisher, eventType string, data any) {
+	_ = events.Publish(ctx, eventType, data)
+}
@@ -0,0 +1,125 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"github.com/daisuke8000/example-ec-platform/services/product/internal/domain"
+)
+
+const (
+	defaultFavoritePageSize = 20
+	maxFavoritePageSize     = 100
+)
+
+// FavoriteUseCase manages user wishlists. Callers are trusted to act for
+// userID; the BFF only lets users reach their own wishlist.
+type FavoriteUseCase interface {
+	// AddFavorite is idempotent: adding a product already in the wishlist
+	// keeps its original position.
+	AddFavorite(ctx context.Context, userID, productID uuid.UUID, audience *domain.Audience) error
+	RemoveFavorite(ctx context.Context, userID, productID uuid.UUID) error
+	ListFavorites(ctx context.Context, input ListFavoritesInput) (*ListFavoritesOutput, error)
+	GetFavoriteCounts(ctx context.Context, productIDs []uuid.UUID) (map[uuid.UUID]int64, error)
+}
+
+type ListFavoritesInput struct {
+	UserID   uuid.UUID
+	PageSize int
+	After    *domain.FavoriteCursor
+	// Audience, if set, omits products it cannot see.
+	Audience *domain.Audience
+}
+
+type ListFavoritesOutput struct {
+	// Favorites have Product set.
+	Favorites []*domain.Favorite
+	Next      *domain.FavoriteCursor
+}
+
+type favoriteUseCase struct {
+	favoriteRepo domain.FavoriteRepository
+	productRepo  domain.ProductRepository
+}
+
+func NewFavoriteUseCase(favoriteRepo domain.FavoriteRepository, productRepo domain.ProductRepository) FavoriteUseCase {
+	return &favoriteUseCase{favoriteRepo: favoriteRepo, productRepo: productRepo}
+}
+
+func (uc *favoriteUseCase) AddFavorite(ctx context.Context, userID, productID uuid.UUID, audience *domain.Audience) error {
+	product, err := uc.productRepo.FindByID(ctx, productID)
+	if err != nil {
+		return err
+	}
+	if audience != nil && !product.Visibility.VisibleTo(*audience) {
+		return domain.ErrProductNotFound
+	}
+	return uc.favoriteRepo.Add(ctx, domain.NewFavorite(userID, productID))
+}
+
+func (uc *favoriteUseCase) RemoveFavorite(ctx context.Context, userID, productID uuid.UUID) error {
+	return uc.favoriteRepo.Remove(ctx, userID, productID)
+}
+
+// ListFavorites hydrates a page of favorites with their products in one
+// query. Favorites of products hidden from the audience are omitted, so a
+// page may be shorter than requested while Next is set.
+func (uc *favoriteUseCase) ListFavorites(ctx context.Context, input ListFavoritesInput) (*ListFavoritesOutput, error) {
+	pageSize := input.PageSize
+	if pageSize <= 0 {
+		pageSize = defaultFavoritePageSize
+	}
+	if pageSize > maxFavoritePageSize {
+		pageSize = maxFavoritePageSize
+	}
+
+	// Fetch one extra row to know whether another page exists.
+	favorites, err := uc.favoriteRepo.ListByUser(ctx, input.UserID, pageSize+1, input.After)
+	if err != nil {
+		return nil, err
+	}
+
+	output := &ListFavoritesOutput{}
+	if len(favorites) > pageSize {
+		favorites = favorites[:pageSize]
+		last := favorites[pageSize-1]
+		output.Next = &domain.FavoriteCursor{CreatedAt: last.CreatedAt, ProductID: last.ProductID}
+	}
+	if len(favorites) == 0 {
+		return output, nil
+	}
+
+	ids := make([]uuid.UUID, len(favorites))
+	for i, f := range favorites {
+		ids[i] = f.ProductID
+	}
+	products, err := uc.productRepo.FindByIDs(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+	byID := make(map[uuid.UUID]*domain.Product, len(products))
+	for _, p := range products {
+		byID[p.ID] = p
+	}
+
+	for _, f := range favorites {
+		p, ok := byID[f.ProductID]
+		if !ok || (input.Audience != nil && !p.Visibility.VisibleTo(*input.Audience)) {
+			continue
+		}
+		f.Product = p
+		output.Favorites = append(output.Favorites, f)
+	}
+	return output, nil
+}
+
+func (uc *favoriteUseCase) GetFavoriteCounts(ctx context.Context, productIDs []uuid.UUID) (map[uuid.UUID]int64, error) {
+	if len(productIDs) == 0 {
+		return nil, domain.ErrEmptyBatch
+	}
+	if len(productIDs) > domain.MaxBatchGetIDs {
+		return nil, domain.ErrBatchSizeExceeded
+	}
+	return uc.favoriteRepo.CountByProductIDs(ctx, productIDs)
+}
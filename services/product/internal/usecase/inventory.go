@@ -13,17 +13,26 @@ import (
 
 type InventoryUseCase interface {
 	GetInventory(ctx context.Context, skuID uuid.UUID) (*domain.Inventory, error)
-	UpdateInventory(ctx context.Context, skuID uuid.UUID, quantity int64) error
+	UpdateInventory(ctx context.Context, skuID uuid.UUID, quantity int64, actor string) error
+	BatchUpdateInventory(ctx context.Context, updates []domain.QuantityUpdate, actor string) ([]InventoryUpdateResult, error)
 	BatchReserveInventory(ctx context.Context, input BatchReserveInput) (*domain.Reservation, error)
-	ConfirmReservation(ctx context.Context, reservationID uuid.UUID, idempotencyKey string) error
-	ReleaseReservation(ctx context.Context, reservationID uuid.UUID, idempotencyKey string) error
+	ConfirmReservation(ctx context.Context, reservationID uuid.UUID, idempotencyKey string, actor string) error
+	ReleaseReservation(ctx context.Context, reservationID uuid.UUID, idempotencyKey string, actor string) error
 	GetReservationStatus(ctx context.Context, reservationID uuid.UUID) (*domain.Reservation, error)
+	ListReservations(ctx context.Context, input ListReservationsInput) (*ListReservationsOutput, error)
+	ForceReleaseReservation(ctx context.Context, reservationID uuid.UUID, reason string, actor string) (*domain.Reservation, error)
+	DrainSKUReservations(ctx context.Context, skuID uuid.UUID, reason string, actor string) (*DrainSKUReservationsOutput, error)
 }
 
 type BatchReserveInput struct {
 	Items          []ReserveItem
 	IdempotencyKey string
-	TTL            time.Duration
+	// TTL of the reservation; 0 uses the policy default. It must lie within
+	// the bounds of ReservationTTLPolicy for the caller's Scopes.
+	TTL     time.Duration
+	Scopes  []string
+	Actor   string
+	Locking ReserveLocking
 }
 
 type ReserveItem struct {
@@ -31,6 +40,14 @@ type ReserveItem struct {
 	Quantity int64
 }
 
+// InventoryUpdateResult is the outcome of one item of a bulk update.
+// Inventory is set when the update was applied, Err otherwise.
+type InventoryUpdateResult struct {
+	SKUID     uuid.UUID
+	Inventory *domain.Inventory
+	Err       error
+}
+
 type IdempotencyStore interface {
 	Get(ctx context.Context, key string) (string, error)
 	SetNX(ctx context.Context, key string, value string, ttl time.Duration) (bool, error)
@@ -38,27 +55,48 @@ type IdempotencyStore interface {
 	Del(ctx context.Context, key string) error
 }
 
+// InventoryCache caches inventory levels per SKU.
+// Implementations must tolerate Invalidate for SKUs that are not cached.
+type InventoryCache interface {
+	Get(ctx context.Context, skuID uuid.UUID) (*domain.Inventory, error)
+	Set(ctx context.Context, inventory *domain.Inventory) error
+	Invalidate(ctx context.Context, skuIDs ...uuid.UUID) error
+}
+
 type TxManager interface {
 	DoWithTx(ctx context.Context, fn func(ctx context.Context, tx pgx.Tx) error) error
 }
 
 type TxInventoryRepository interface {
 	domain.InventoryRepository
-	ReserveWithTx(ctx context.Context, tx pgx.Tx, skuID uuid.UUID, amount int64) error
+	ReserveWithTx(ctx context.Context, tx pgx.Tx, skuID uuid.UUID, amount int64, src domain.MovementSource) error
+	ReleaseReservationWithTx(ctx context.Context, tx pgx.Tx, skuID uuid.UUID, amount int64, src domain.MovementSource) error
+	// LockForUpdateWithTx locks the inventory rows of skuIDs in SKU ID order
+	// and returns those that exist. timeout bounds each further statement of
+	// tx, including this one's wait for the locks.
+	LockForUpdateWithTx(ctx context.Context, tx pgx.Tx, skuIDs []uuid.UUID, timeout time.Duration) ([]*domain.Inventory, error)
 }
 
 type TxReservationRepository interface {
 	domain.ReservationRepository
 	CreateWithTx(ctx context.Context, tx pgx.Tx, reservation *domain.Reservation) error
+	// ReleasePendingWithTx marks a pending reservation RELEASED with reason
+	// and returns it. It fails with domain.ErrReservationNotPending if the
+	// reservation is no longer pending.
+	ReleasePendingWithTx(ctx context.Context, tx pgx.Tx, id uuid.UUID, reason string) (*domain.Reservation, error)
 }
 
 type inventoryUseCase struct {
 	inventoryRepo   TxInventoryRepository
 	reservationRepo TxReservationRepository
 	idempotency     IdempotencyStore
+	cache           InventoryCache
 	txManager       TxManager
+	events          EventPublisher
+	lockRetry       LockRetryPolicy
+	reserveLocking  ReserveLockingPolicy
+	ttlPolicy       ReservationTTLPolicy
 	maxBatchSize    int
-	defaultTTL      time.Duration
 	idempotencyTTL  time.Duration
 }
 
@@ -66,28 +104,137 @@ func NewInventoryUseCase(
 	inventoryRepo TxInventoryRepository,
 	reservationRepo TxReservationRepository,
 	idempotency IdempotencyStore,
+	cache InventoryCache,
 	txManager TxManager,
+	events EventPublisher,
+	lockRetry LockRetryPolicy,
+	reserveLocking ReserveLockingPolicy,
+	ttlPolicy ReservationTTLPolicy,
 	maxBatchSize int,
-	defaultTTL time.Duration,
 	idempotencyTTL time.Duration,
 ) InventoryUseCase {
 	return &inventoryUseCase{
 		inventoryRepo:   inventoryRepo,
 		reservationRepo: reservationRepo,
 		idempotency:     idempotency,
+		cache:           cache,
 		txManager:       txManager,
+		events:          events,
+		lockRetry:       lockRetry,
+		reserveLocking:  reserveLocking,
+		ttlPolicy:       ttlPolicy,
 		maxBatchSize:    maxBatchSize,
-		defaultTTL:      defaultTTL,
 		idempotencyTTL:  idempotencyTTL,
 	}
 }
 
 func (uc *inventoryUseCase) GetInventory(ctx context.Context, skuID uuid.UUID) (*domain.Inventory, error) {
-	return uc.inventoryRepo.FindBySKUID(ctx, skuID)
+	if inv, err := uc.cache.Get(ctx, skuID); err == nil {
+		return inv, nil
+	}
+
+	inv, err := uc.inventoryRepo.FindBySKUID(ctx, skuID)
+	if err != nil {
+		return nil, err
+	}
+	_ = uc.cache.Set(ctx, inv)
+	return inv, nil
 }
 
-func (uc *inventoryUseCase) UpdateInventory(ctx context.Context, skuID uuid.UUID, quantity int64) error {
-	return uc.inventoryRepo.UpdateQuantity(ctx, skuID, quantity)
+func (uc *inventoryUseCase) UpdateInventory(ctx context.Context, skuID uuid.UUID, quantity int64, actor string) error {
+	err := uc.inventoryRepo.UpdateQuantity(ctx, skuID, quantity, domain.MovementSource{
+		Reason: domain.MovementReasonAdjustment,
+		Actor:  actor,
+	})
+	if err != nil {
+		return err
+	}
+	uc.invalidate(ctx, skuID)
+	publish(ctx, uc.events, EventInventoryUpdated, inventoryEvent{
+		SKUID:    skuID,
+		Reason:   domain.MovementReasonAdjustment,
+		Quantity: &quantity,
+	})
+	return nil
+}
+
+// BatchUpdateInventory applies absolute quantities in chunks of
+// domain.InventoryUpdateChunkSize, one transaction each. Invalid items are
+// reported without affecting the others. If a chunk fails as a whole, the
+// chunks before it stay applied and every item not yet applied carries the
+// error, so the caller can retry just those.
+func (uc *inventoryUseCase) BatchUpdateInventory(ctx context.Context, updates []domain.QuantityUpdate, actor string) ([]InventoryUpdateResult, error) {
+	if len(updates) == 0 {
+		return nil, domain.ErrInvalidQuantity
+	}
+	if len(updates) > domain.MaxInventoryUpdateBatch {
+		return nil, domain.ErrBatchSizeExceeded
+	}
+
+	results := make([]InventoryUpdateResult, len(updates))
+	seen := make(map[uuid.UUID]bool, len(updates))
+	pending := make([]int, 0, len(updates))
+	for i, u := range updates {
+		results[i].SKUID = u.SKUID
+		switch {
+		case u.Quantity < 0:
+			results[i].Err = domain.ErrInvalidQuantity
+		case seen[u.SKUID]:
+			results[i].Err = domain.ErrDuplicateBatchSKU
+		default:
+			seen[u.SKUID] = true
+			pending = append(pending, i)
+		}
+	}
+
+	// Rows are locked in SKU order, as in BatchReserveInventory, so
+	// concurrent batches cannot deadlock.
+	sort.Slice(pending, func(a, b int) bool {
+		return updates[pending[a]].SKUID.String() < updates[pending[b]].SKUID.String()
+	})
+
+	src := domain.MovementSource{
+		Reason: domain.MovementReasonAdjustment,
+		Actor:  actor,
+	}
+	for start := 0; start < len(pending); start += domain.InventoryUpdateChunkSize {
+		chunk := pending[start:min(start+domain.InventoryUpdateChunkSize, len(pending))]
+		batch := make([]domain.QuantityUpdate, len(chunk))
+		for j, idx := range chunk {
+			batch[j] = updates[idx]
+		}
+
+		updated, itemErrs, err := uc.inventoryRepo.SetQuantities(ctx, batch, src)
+		if err != nil {
+			for _, idx := range pending[start:] {
+				results[idx].Err = err
+			}
+			break
+		}
+
+		skuIDs := make([]uuid.UUID, 0, len(chunk))
+		for j, idx := range chunk {
+			if itemErrs[j] != nil {
+				results[idx].Err = itemErrs[j]
+				continue
+			}
+			results[idx].Inventory = updated[j]
+			skuIDs = append(skuIDs, updated[j].SKUID)
+		}
+		uc.invalidate(ctx, skuIDs...)
+		for _, idx := range chunk {
+			if inv := results[idx].Inventory; inv != nil {
+				quantity := inv.Quantity
+				publish(ctx, uc.events, EventInventoryUpdated, inventoryEvent{
+					SKUID:    inv.SKUID,
+					Reason:   domain.MovementReasonAdjustment,
+					Quantity: &quantity,
+				})
+			}
+		}
+	}
+
+	return results, nil
 }
 
 func (uc *inventoryUseCase) BatchReserveInventory(ctx context.Context, input BatchReserveInput) (*domain.Reservation, error) {
@@ -97,6 +244,10 @@ func (uc *inventoryUseCase) BatchReserveInventory(ctx context.Context, input Bat
 	if len(input.Items) > uc.maxBatchSize {
 		return nil, domain.ErrBatchSizeExceeded
 	}
+	ttl, err := uc.ttlPolicy.resolve(input.TTL, input.Scopes)
+	if err != nil {
+		return nil, err
+	}
 
 	var lockAcquired bool
 	if input.IdempotencyKey != "" {
@@ -134,11 +285,6 @@ func (uc *inventoryUseCase) BatchReserveInventory(ctx context.Context, input Bat
 		return sortedItems[i].SKUID.String() < sortedItems[j].SKUID.String()
 	})
 
-	ttl := input.TTL
-	if ttl == 0 {
-		ttl = uc.defaultTTL
-	}
-
 	reservationItems := make([]domain.ReservationItem, len(sortedItems))
 	for i, item := range sortedItems {
 		reservationItems[i] = domain.ReservationItem{
@@ -152,18 +298,39 @@ func (uc *inventoryUseCase) BatchReserveInventory(ctx context.Context, input Bat
 		return nil, err
 	}
 
-	err = uc.txManager.DoWithTx(ctx, func(ctx context.Context, tx pgx.Tx) error {
-		for _, item := range sortedItems {
-			if err := uc.inventoryRepo.ReserveWithTx(ctx, tx, item.SKUID, item.Quantity); err != nil {
-				return err
+	src := domain.MovementSource{
+		Reason:        domain.MovementReasonReserve,
+		Actor:         input.Actor,
+		ReservationID: &reservation.ID,
+	}
+	locking := input.Locking
+	if locking == ReserveLockingDefault {
+		locking = uc.reserveLocking.Default
+	}
+	// A transaction aborted as a deadlock or serialization failure is rolled
+	// back and retried from scratch, so each attempt sees current stock.
+	// Running out of stock is not retried on either path.
+	err = retryOnLockConflict(ctx, uc.lockRetry, func() error {
+		return uc.txManager.DoWithTx(ctx, func(ctx context.Context, tx pgx.Tx) error {
+			if locking == ReserveLockingPessimistic {
+				if err := uc.reservePessimistic(ctx, tx, sortedItems, src); err != nil {
+					return err
+				}
+			} else {
+				for _, item := range sortedItems {
+					if err := uc.inventoryRepo.ReserveWithTx(ctx, tx, item.SKUID, item.Quantity, src); err != nil {
+						return err
+					}
+				}
 			}
-		}
-		return uc.reservationRepo.CreateWithTx(ctx, tx, reservation)
+			return uc.reservationRepo.CreateWithTx(ctx, tx, reservation)
+		})
 	})
 
 	if err != nil {
 		return nil, err
 	}
+	uc.invalidate(ctx, reservation.SKUIDs()...)
 
 	committed = true
 	if input.IdempotencyKey != "" {
@@ -173,7 +340,7 @@ func (uc *inventoryUseCase) BatchReserveInventory(ctx context.Context, input Bat
 	return reservation, nil
 }
 
-func (uc *inventoryUseCase) ConfirmReservation(ctx context.Context, reservationID uuid.UUID, idempotencyKey string) error {
+func (uc *inventoryUseCase) ConfirmReservation(ctx context.Context, reservationID uuid.UUID, idempotencyKey string, actor string) error {
 	if idempotencyKey != "" {
 		if _, err := uc.idempotency.Get(ctx, "confirm:"+idempotencyKey); err == nil {
 			return nil
@@ -189,15 +356,30 @@ func (uc *inventoryUseCase) ConfirmReservation(ctx context.Context, reservationI
 		return err
 	}
 
+	src := domain.MovementSource{
+		Reason:        domain.MovementReasonConfirm,
+		Actor:         actor,
+		ReservationID: &reservationID,
+	}
 	for _, item := range reservation.Items {
-		if err := uc.inventoryRepo.ConfirmReservation(ctx, item.SKUID, item.Quantity); err != nil {
+		if err := uc.inventoryRepo.ConfirmReservation(ctx, item.SKUID, item.Quantity, src); err != nil {
+			uc.invalidate(ctx, reservation.SKUIDs()...)
 			return err
 		}
 	}
+	uc.invalidate(ctx, reservation.SKUIDs()...)
 
 	if err := uc.reservationRepo.UpdateStatus(ctx, reservationID, domain.ReservationStatusConfirmed); err != nil {
 		return err
 	}
+	for _, item := range reservation.Items {
+		delta := -item.Quantity
+		publish(ctx, uc.events, EventInventoryUpdated, inventoryEvent{
+			SKUID:         item.SKUID,
+			Reason:        domain.MovementReasonConfirm,
+			QuantityDelta: &delta,
+		})
+	}
 
 	if idempotencyKey != "" {
 		_ = uc.idempotency.Set(ctx, "confirm:"+idempotencyKey, "done", uc.idempotencyTTL)
@@ -206,7 +388,7 @@ func (uc *inventoryUseCase) ConfirmReservation(ctx context.Context, reservationI
 	return nil
 }
 
-func (uc *inventoryUseCase) ReleaseReservation(ctx context.Context, reservationID uuid.UUID, idempotencyKey string) error {
+func (uc *inventoryUseCase) ReleaseReservation(ctx context.Context, reservationID uuid.UUID, idempotencyKey string, actor string) error {
 	if idempotencyKey != "" {
 		if _, err := uc.idempotency.Get(ctx, "release:"+idempotencyKey); err == nil {
 			return nil
@@ -222,11 +404,18 @@ func (uc *inventoryUseCase) ReleaseReservation(ctx context.Context, reservationI
 		return err
 	}
 
+	src := domain.MovementSource{
+		Reason:        domain.MovementReasonRelease,
+		Actor:         actor,
+		ReservationID: &reservationID,
+	}
 	for _, item := range reservation.Items {
-		if err := uc.inventoryRepo.ReleaseReservation(ctx, item.SKUID, item.Quantity); err != nil {
+		if err := uc.inventoryRepo.ReleaseReservation(ctx, item.SKUID, item.Quantity, src); err != nil {
+			uc.invalidate(ctx, reservation.SKUIDs()...)
 			return err
 		}
 	}
+	uc.invalidate(ctx, reservation.SKUIDs()...)
 
 	if err := uc.reservationRepo.UpdateStatus(ctx, reservationID, domain.ReservationStatusReleased); err != nil {
 		return err
@@ -242,3 +431,9 @@ func (uc *inventoryUseCase) ReleaseReservation(ctx context.Context, reservationI
 func (uc *inventoryUseCase) GetReservationStatus(ctx context.Context, reservationID uuid.UUID) (*domain.Reservation, error) {
 	return uc.reservationRepo.FindByID(ctx, reservationID)
 }
+
+// invalidate drops cached inventory after a stock mutation.
+// Failures are ignored; the cache TTL bounds how long a stale entry can live.
+func (uc *inventoryUseCase) invalidate(ctx context.Context, skuIDs ...uuid.UUID) {
+	_ = uc.cache.Invalidate(context.WithoutCancel(ctx), skuIDs...)
+}
@@ -0,0 +1,72 @@
+package usecase
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/google/uuid"
+
+	"github.com/daisuke8000/example-ec-platform/services/product/internal/domain"
+)
+
+const (
+	defaultMovementPageSize = 50
+	maxMovementPageSize     = 200
+)
+
+type InventoryMovementUseCase interface {
+	ListInventoryMovements(ctx context.Context, input ListInventoryMovementsInput) (*ListInventoryMovementsOutput, error)
+}
+
+type ListInventoryMovementsInput struct {
+	SKUID     uuid.UUID
+	PageSize  int
+	PageToken string
+}
+
+type ListInventoryMovementsOutput struct {
+	Movements     []*domain.InventoryMovement
+	NextPageToken string
+}
+
+type inventoryMovementUseCase struct {
+	movementRepo domain.InventoryMovementRepository
+}
+
+func NewInventoryMovementUseCase(movementRepo domain.InventoryMovementRepository) InventoryMovementUseCase {
+	return &inventoryMovementUseCase{movementRepo: movementRepo}
+}
+
+// ListInventoryMovements pages through a SKU's movements newest first.
+// The page token is the ID of the last movement of the previous page.
+func (uc *inventoryMovementUseCase) ListInventoryMovements(ctx context.Context, input ListInventoryMovementsInput) (*ListInventoryMovementsOutput, error) {
+	pageSize := input.PageSize
+	if pageSize <= 0 {
+		pageSize = defaultMovementPageSize
+	}
+	if pageSize > maxMovementPageSize {
+		pageSize = maxMovementPageSize
+	}
+
+	var beforeID int64
+	if input.PageToken != "" {
+		id, err := strconv.ParseInt(input.PageToken, 10, 64)
+		if err != nil || id <= 0 {
+			return nil, domain.ErrInvalidPageToken
+		}
+		beforeID = id
+	}
+
+	// Fetch one extra row to know whether another page exists.
+	movements, err := uc.movementRepo.ListBySKU(ctx, input.SKUID, pageSize+1, beforeID)
+	if err != nil {
+		return nil, err
+	}
+
+	output := &ListInventoryMovementsOutput{Movements: movements}
+	if len(movements) > pageSize {
+		output.Movements = movements[:pageSize]
+		output.NextPageToken = strconv.FormatInt(movements[pageSize-1].ID, 10)
+	}
+	return output, nil
+}
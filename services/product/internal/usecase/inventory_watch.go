@@ -0,0 +1,78 @@
+package usecase
+
+import (
+	"context"
+	"slices"
+
+	"github.com/google/uuid"
+
+	"github.com/daisuke8000/example-ec-platform/services/product/internal/domain"
+)
+
+// InventoryWatchUseCase streams stock levels, e.g. to keep "only 2 left" on
+// a storefront page current without polling.
+type InventoryWatchUseCase interface {
+	// WatchInventory sends the current inventory of skuIDs, then their
+	// inventory whenever the available quantity changes, until ctx is done.
+	// Unknown SKUs are ignored. It returns ErrInventoryWatchInterrupted when
+	// changes may have been missed; callers should watch again.
+	WatchInventory(ctx context.Context, skuIDs []uuid.UUID, send func(*domain.Inventory) error) error
+}
+
+type inventoryWatchUseCase struct {
+	inventoryRepo domain.InventoryRepository
+	feed          domain.InventoryFeed
+}
+
+func NewInventoryWatchUseCase(inventoryRepo domain.InventoryRepository, feed domain.InventoryFeed) InventoryWatchUseCase {
+	return &inventoryWatchUseCase{inventoryRepo: inventoryRepo, feed: feed}
+}
+
+func (uc *inventoryWatchUseCase) WatchInventory(ctx context.Context, skuIDs []uuid.UUID, send func(*domain.Inventory) error) error {
+	if len(skuIDs) == 0 {
+		return domain.ErrEmptyBatch
+	}
+	skuIDs = slices.Clone(skuIDs)
+	slices.SortFunc(skuIDs, func(a, b uuid.UUID) int { return slices.Compare(a[:], b[:]) })
+	skuIDs = slices.Compact(skuIDs)
+	if len(skuIDs) > domain.MaxWatchedSKUs {
+		return domain.ErrBatchSizeExceeded
+	}
+
+	// Subscribe before reading the current levels so that no change
+	// committed in between is missed.
+	changes, cancel := uc.feed.Subscribe(skuIDs)
+	defer cancel()
+
+	current, err := uc.inventoryRepo.FindBySKUIDs(ctx, skuIDs)
+	if err != nil {
+		return err
+	}
+	sent := make(map[uuid.UUID]*domain.Inventory, len(current))
+	for _, inv := range current {
+		if err := send(inv); err != nil {
+			return err
+		}
+		sent[inv.SKUID] = inv
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case inv, ok := <-changes:
+			if !ok {
+				return domain.ErrInventoryWatchInterrupted
+			}
+			// Skip changes already reflected in what was sent, including
+			// notifications older than the initial read.
+			if last, ok := sent[inv.SKUID]; ok && (inv.Version <= last.Version || inv.Available() == last.Available()) {
+				continue
+			}
+			if err := send(inv); err != nil {
+				return err
+			}
+			sent[inv.SKUID] = inv
+		}
+	}
+}
@@ -0,0 +1,225 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+
+	"github.com/daisuke8000/example-ec-platform/services/product/internal/domain"
+)
+
+// fakeInventoryFeed delivers the changes queued on its channel to the one
+// subscriber. Closing the channel interrupts the subscription, as the feed
+// does when a subscriber falls behind.
+type fakeInventoryFeed struct {
+	changes    chan *domain.Inventory
+	subscribed []uuid.UUID
+	cancelled  bool
+}
+
+func newFakeInventoryFeed() *fakeInventoryFeed {
+	return &fakeInventoryFeed{changes: make(chan *domain.Inventory, 16)}
+}
+
+func (f *fakeInventoryFeed) Subscribe(skuIDs []uuid.UUID) (<-chan *domain.Inventory, func()) {
+	f.subscribed = skuIDs
+	return f.changes, func() { f.cancelled = true }
+}
+
+// mockInventoryReader serves the current levels read by WatchInventory.
+type mockInventoryReader struct {
+	domain.InventoryRepository
+	current []*domain.Inventory
+	err     error
+
+	// onRead runs when the current levels are read.
+	onRead func()
+}
+
+func (m *mockInventoryReader) FindBySKUIDs(ctx context.Context, skuIDs []uuid.UUID) ([]*domain.Inventory, error) {
+	if m.onRead != nil {
+		m.onRead()
+	}
+	return m.current, m.err
+}
+
+// watch runs WatchInventory and returns what it sent.
+func watch(ctx context.Context, uc InventoryWatchUseCase, skuIDs []uuid.UUID) ([]*domain.Inventory, error) {
+	var sent []*domain.Inventory
+	err := uc.WatchInventory(ctx, skuIDs, func(inv *domain.Inventory) error {
+		sent = append(sent, inv)
+		return nil
+	})
+	return sent, err
+}
+
+func TestWatchInventory_SubscribesBeforeReading(t *testing.T) {
+	skuID := uuid.New()
+	feed := newFakeInventoryFeed()
+	repo := &mockInventoryReader{current: []*domain.Inventory{{SKUID: skuID, Quantity: 5, Version: 1}}}
+	// A change committed while the current levels are read.
+	repo.onRead = func() {
+		if feed.subscribed == nil {
+			t.Fatal("current levels read before subscribing")
+		}
+		feed.changes <- &domain.Inventory{SKUID: skuID, Quantity: 4, Version: 2}
+		close(feed.changes)
+	}
+
+	sent, err := watch(context.Background(), NewInventoryWatchUseCase(repo, feed), []uuid.UUID{skuID})
+	if !errors.Is(err, domain.ErrInventoryWatchInterrupted) {
+		t.Errorf("WatchInventory() error = %v, want %v", err, domain.ErrInventoryWatchInterrupted)
+	}
+	if len(sent) != 2 || sent[0].Version != 1 || sent[1].Version != 2 {
+		t.Errorf("sent %+v, want versions 1 and 2", sent)
+	}
+	if !feed.cancelled {
+		t.Error("subscription not cancelled")
+	}
+}
+
+func TestWatchInventory_Changes(t *testing.T) {
+	watched, unread := uuid.New(), uuid.New()
+	current := &domain.Inventory{SKUID: watched, Quantity: 10, Version: 3}
+
+	tests := []struct {
+		name     string
+		change   *domain.Inventory
+		wantSent bool
+	}{
+		{
+			name:     "newer version with a new available quantity",
+			change:   &domain.Inventory{SKUID: watched, Quantity: 8, Version: 4},
+			wantSent: true,
+		},
+		{
+			name:   "older than the current read",
+			change: &domain.Inventory{SKUID: watched, Quantity: 7, Version: 2},
+		},
+		{
+			name:   "same version as the current read",
+			change: &domain.Inventory{SKUID: watched, Quantity: 9, Version: 3},
+		},
+		{
+			name:   "newer version with the same available quantity",
+			change: &domain.Inventory{SKUID: watched, Quantity: 11, Reserved: 1, Version: 4},
+		},
+		{
+			name:     "SKU without inventory when read",
+			change:   &domain.Inventory{SKUID: unread, Quantity: 1, Version: 1},
+			wantSent: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			feed := newFakeInventoryFeed()
+			feed.changes <- tt.change
+			close(feed.changes)
+			repo := &mockInventoryReader{current: []*domain.Inventory{current}}
+
+			sent, err := watch(context.Background(), NewInventoryWatchUseCase(repo, feed), []uuid.UUID{watched, unread})
+			if !errors.Is(err, domain.ErrInventoryWatchInterrupted) {
+				t.Errorf("WatchInventory() error = %v, want %v", err, domain.ErrInventoryWatchInterrupted)
+			}
+			if len(sent) == 0 || sent[0] != current {
+				t.Fatalf("sent %+v, want the current level first", sent)
+			}
+			if gotSent := len(sent) == 2 && sent[1] == tt.change; gotSent != tt.wantSent || len(sent) > 2 {
+				t.Errorf("sent %+v, want change sent = %v", sent[1:], tt.wantSent)
+			}
+		})
+	}
+}
+
+func TestWatchInventory_Ends(t *testing.T) {
+	skuID := uuid.New()
+	sendErr := errors.New("stream closed")
+
+	t.Run("context done", func(t *testing.T) {
+		feed := newFakeInventoryFeed()
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		_, err := watch(ctx, NewInventoryWatchUseCase(&mockInventoryReader{}, feed), []uuid.UUID{skuID})
+		if err != nil {
+			t.Errorf("WatchInventory() error = %v, want nil", err)
+		}
+		if !feed.cancelled {
+			t.Error("subscription not cancelled")
+		}
+	})
+
+	t.Run("send fails", func(t *testing.T) {
+		feed := newFakeInventoryFeed()
+		repo := &mockInventoryReader{current: []*domain.Inventory{{SKUID: skuID, Quantity: 1}}}
+
+		err := NewInventoryWatchUseCase(repo, feed).WatchInventory(context.Background(), []uuid.UUID{skuID},
+			func(*domain.Inventory) error { return sendErr })
+		if !errors.Is(err, sendErr) {
+			t.Errorf("WatchInventory() error = %v, want %v", err, sendErr)
+		}
+		if !feed.cancelled {
+			t.Error("subscription not cancelled")
+		}
+	})
+
+	t.Run("read fails", func(t *testing.T) {
+		feed := newFakeInventoryFeed()
+		readErr := errors.New("connection refused")
+
+		_, err := watch(context.Background(), NewInventoryWatchUseCase(&mockInventoryReader{err: readErr}, feed), []uuid.UUID{skuID})
+		if !errors.Is(err, readErr) {
+			t.Errorf("WatchInventory() error = %v, want %v", err, readErr)
+		}
+		if !feed.cancelled {
+			t.Error("subscription not cancelled")
+		}
+	})
+}
+
+func TestWatchInventory_SKUs(t *testing.T) {
+	distinct := func(n int) []uuid.UUID {
+		ids := make([]uuid.UUID, n)
+		for i := range ids {
+			ids[i] = uuid.New()
+		}
+		return ids
+	}
+	duplicated := append(distinct(domain.MaxWatchedSKUs), uuid.Nil)
+	duplicated[len(duplicated)-1] = duplicated[0]
+
+	tests := []struct {
+		name           string
+		skuIDs         []uuid.UUID
+		wantErr        error
+		wantSubscribed int
+	}{
+		{name: "none", wantErr: domain.ErrEmptyBatch},
+		{name: "too many", skuIDs: distinct(domain.MaxWatchedSKUs + 1), wantErr: domain.ErrBatchSizeExceeded},
+		{name: "duplicates counted once", skuIDs: duplicated, wantSubscribed: domain.MaxWatchedSKUs},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			feed := newFakeInventoryFeed()
+			close(feed.changes)
+
+			_, err := watch(context.Background(), NewInventoryWatchUseCase(&mockInventoryReader{}, feed), tt.skuIDs)
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Errorf("WatchInventory() error = %v, want %v", err, tt.wantErr)
+				}
+				if feed.subscribed != nil {
+					t.Error("subscribed to an invalid watch")
+				}
+				return
+			}
+			if len(feed.subscribed) != tt.wantSubscribed {
+				t.Errorf("subscribed to %d SKUs, want %d", len(feed.subscribed), tt.wantSubscribed)
+			}
+		})
+	}
+}
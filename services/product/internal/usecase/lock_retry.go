@@ -0,0 +1,53 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"math/rand/v2"
+	"time"
+
+	"github.com/daisuke8000/example-ec-platform/services/product/internal/domain"
+)
+
+// LockRetryPolicy bounds the retries of a reservation transaction that
+// Postgres aborted as a deadlock (40P01) or serialization failure (40001),
+// which the transaction manager reports as domain.ErrOptimisticLockConflict.
+// The conditional reservation UPDATE itself never conflicts: a concurrent
+// reservation of the same SKU waits for the row lock and re-checks stock.
+type LockRetryPolicy struct {
+	// MaxAttempts includes the first attempt; 1 disables retries.
+	MaxAttempts int
+	// InitialBackoff is the upper bound of the first backoff. Each further
+	// backoff doubles, up to MaxBackoff. The actual sleep is drawn uniformly
+	// from [0, bound) so contending callers spread out.
+	InitialBackoff time.Duration
+	MaxBackoff     time.Duration
+}
+
+// retryOnLockConflict runs fn until it succeeds, fails with an error other
+// than domain.ErrOptimisticLockConflict or the attempts are spent. fn must re-read whatever state it depends on,
+// since each attempt starts over.
+func retryOnLockConflict(ctx context.Context, policy LockRetryPolicy, fn func() error) error {
+	backoff := policy.InitialBackoff
+	for attempt := 1; ; attempt++ {
+		err := fn()
+		if err == nil || !errors.Is(err, domain.ErrOptimisticLockConflict) || attempt >= policy.MaxAttempts {
+			return err
+		}
+
+		var sleep time.Duration
+		if backoff > 0 {
+			sleep = rand.N(backoff)
+		}
+		select {
+		case <-ctx.Done():
+			return err
+		case <-time.After(sleep):
+		}
+
+		backoff *= 2
+		if policy.MaxBackoff > 0 && backoff > policy.MaxBackoff {
+			backoff = policy.MaxBackoff
+		}
+	}
+}
@@ -0,0 +1,99 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"github.com/daisuke8000/example-ec-platform/services/product/internal/domain"
+)
+
+const (
+	defaultLowStockPageSize = 100
+	maxLowStockPageSize     = 1000
+)
+
+// LowStockUseCase tracks SKUs whose available quantity is below their
+// low-stock threshold so merchants can restock before they sell out.
+type LowStockUseCase interface {
+	SetThreshold(ctx context.Context, skuID uuid.UUID, threshold *int64) error
+	ListLowStockSKUs(ctx context.Context, pageSize int, pageToken string) ([]*domain.LowStockSKU, string, error)
+	// EmitAlerts publishes a LowStock event for up to limit SKUs that became
+	// low on stock since their last alert and returns how many it published.
+	EmitAlerts(ctx context.Context, limit int) (int, error)
+}
+
+type lowStockUseCase struct {
+	repo             domain.LowStockRepository
+	events           EventPublisher
+	defaultThreshold int64
+}
+
+func NewLowStockUseCase(repo domain.LowStockRepository, events EventPublisher, defaultThreshold int64) LowStockUseCase {
+	return &lowStockUseCase{
+		repo:             repo,
+		events:           events,
+		defaultThreshold: defaultThreshold,
+	}
+}
+
+// SetThreshold sets a SKU's threshold; nil reverts it to the default and 0
+// disables low-stock tracking for the SKU.
+func (uc *lowStockUseCase) SetThreshold(ctx context.Context, skuID uuid.UUID, threshold *int64) error {
+	if threshold != nil && *threshold < 0 {
+		return domain.ErrInvalidLowStockThreshold
+	}
+	return uc.repo.SetThreshold(ctx, skuID, threshold)
+}
+
+// ListLowStockSKUs pages through low-stock SKUs by SKU ID. The page token
+// is the ID of the last SKU of the previous page.
+func (uc *lowStockUseCase) ListLowStockSKUs(ctx context.Context, pageSize int, pageToken string) ([]*domain.LowStockSKU, string, error) {
+	if pageSize <= 0 {
+		pageSize = defaultLowStockPageSize
+	}
+	pageSize = min(pageSize, maxLowStockPageSize)
+
+	var afterSKUID uuid.UUID
+	if pageToken != "" {
+		id, err := uuid.Parse(pageToken)
+		if err != nil {
+			return nil, "", domain.ErrInvalidPageToken
+		}
+		afterSKUID = id
+	}
+
+	// Fetch one extra row to know whether another page exists.
+	skus, err := uc.repo.List(ctx, uc.defaultThreshold, afterSKUID, pageSize+1)
+	if err != nil {
+		return nil, "", err
+	}
+
+	var nextPageToken string
+	if len(skus) > pageSize {
+		skus = skus[:pageSize]
+		nextPageToken = skus[pageSize-1].SKUID.String()
+	}
+	return skus, nextPageToken, nil
+}
+
+// EmitAlerts first re-arms SKUs that recovered, so a SKU alerts once per
+// drop below its threshold rather than on every scan.
+func (uc *lowStockUseCase) EmitAlerts(ctx context.Context, limit int) (int, error) {
+	if _, err := uc.repo.ResetRecovered(ctx, uc.defaultThreshold); err != nil {
+		return 0, err
+	}
+
+	skus, err := uc.repo.ClaimAlerts(ctx, uc.defaultThreshold, limit)
+	if err != nil {
+		return 0, err
+	}
+	for _, s := range skus {
+		publish(ctx, uc.events, EventInventoryLowStock, lowStockEvent{
+			SKUID:     s.SKUID,
+			Available: s.Available(),
+			Threshold: s.Threshold,
+		})
+	}
+	return len(skus), nil
+}
@@ -0,0 +1,164 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/daisuke8000/example-ec-platform/services/product/internal/domain"
+)
+
+// maxPickupSlotRange bounds the time range of one slot listing.
+const maxPickupSlotRange = 31 * 24 * time.Hour
+
+// PickupUseCase lets buyers collect orders at stores (click & collect).
+// Checkout checks which locations have the order on hand and reserves a
+// slot there; the location marks the order ready, which notifies the buyer
+// through EventPickupReady, and collected when it is handed over.
+type PickupUseCase interface {
+	CreateLocation(ctx context.Context, name, address string) (*domain.PickupLocation, error)
+	SetLocationActive(ctx context.Context, id uuid.UUID, active bool) (*domain.PickupLocation, error)
+	ListLocations(ctx context.Context, activeOnly bool) ([]*domain.PickupLocation, error)
+	SetStock(ctx context.Context, locationID, skuID uuid.UUID, quantity int64) error
+	CheckAvailability(ctx context.Context, items []domain.PickupItem) ([]*domain.PickupAvailability, error)
+	CreateSlot(ctx context.Context, locationID uuid.UUID, startsAt, endsAt time.Time, capacity int32) (*domain.PickupSlot, error)
+	ListSlots(ctx context.Context, locationID uuid.UUID, from, to time.Time) ([]*domain.PickupSlot, error)
+	Reserve(ctx context.Context, orderID, slotID uuid.UUID, items []domain.PickupItem) (*domain.ReservedPickup, error)
+	GetReservation(ctx context.Context, orderID uuid.UUID) (*domain.PickupReservation, error)
+	// MarkReady emits EventPickupReady.
+	MarkReady(ctx context.Context, orderID uuid.UUID) (*domain.PickupReservation, error)
+	MarkCollected(ctx context.Context, orderID uuid.UUID) (*domain.PickupReservation, error)
+	// Cancel returns the slot and the items to the location, e.g. when the
+	// order is cancelled or not collected.
+	Cancel(ctx context.Context, orderID uuid.UUID) (*domain.PickupReservation, error)
+}
+
+type pickupUseCase struct {
+	repo   domain.PickupRepository
+	events EventPublisher
+	now    func() time.Time
+}
+
+func NewPickupUseCase(repo domain.PickupRepository, events EventPublisher) PickupUseCase {
+	return &pickupUseCase{
+		repo:   repo,
+		events: events,
+		now:    time.Now,
+	}
+}
+
+func (uc *pickupUseCase) CreateLocation(ctx context.Context, name, address string) (*domain.PickupLocation, error) {
+	location, err := domain.NewPickupLocation(name, address)
+	if err != nil {
+		return nil, err
+	}
+	if err := uc.repo.CreateLocation(ctx, location); err != nil {
+		return nil, err
+	}
+	return location, nil
+}
+
+func (uc *pickupUseCase) SetLocationActive(ctx context.Context, id uuid.UUID, active bool) (*domain.PickupLocation, error) {
+	return uc.repo.SetLocationActive(ctx, id, active)
+}
+
+func (uc *pickupUseCase) ListLocations(ctx context.Context, activeOnly bool) ([]*domain.PickupLocation, error) {
+	return uc.repo.FindLocations(ctx, activeOnly)
+}
+
+func (uc *pickupUseCase) SetStock(ctx context.Context, locationID, skuID uuid.UUID, quantity int64) error {
+	if quantity < 0 {
+		return domain.ErrInvalidQuantity
+	}
+	return uc.repo.SetStock(ctx, locationID, skuID, quantity)
+}
+
+func (uc *pickupUseCase) CheckAvailability(ctx context.Context, items []domain.PickupItem) ([]*domain.PickupAvailability, error) {
+	merged, err := mergePickupItems(items)
+	if err != nil {
+		return nil, err
+	}
+	return uc.repo.Availability(ctx, merged)
+}
+
+func (uc *pickupUseCase) CreateSlot(ctx context.Context, locationID uuid.UUID, startsAt, endsAt time.Time, capacity int32) (*domain.PickupSlot, error) {
+	slot, err := domain.NewPickupSlot(locationID, startsAt, endsAt, capacity)
+	if err != nil {
+		return nil, err
+	}
+	if err := uc.repo.CreateSlot(ctx, slot); err != nil {
+		return nil, err
+	}
+	return slot, nil
+}
+
+func (uc *pickupUseCase) ListSlots(ctx context.Context, locationID uuid.UUID, from, to time.Time) ([]*domain.PickupSlot, error) {
+	if !to.After(from) || to.Sub(from) > maxPickupSlotRange {
+		return nil, domain.ErrInvalidPickupSlotRange
+	}
+	return uc.repo.FindSlots(ctx, locationID, from, to)
+}
+
+// Reserve reserves a slot for an order all or nothing. Lines of the same
+// SKU are merged, so a retried call with reordered lines is still a replay.
+func (uc *pickupUseCase) Reserve(ctx context.Context, orderID, slotID uuid.UUID, items []domain.PickupItem) (*domain.ReservedPickup, error) {
+	merged, err := mergePickupItems(items)
+	if err != nil {
+		return nil, err
+	}
+	return uc.repo.Reserve(ctx, orderID, slotID, merged, uc.now().UTC())
+}
+
+func (uc *pickupUseCase) GetReservation(ctx context.Context, orderID uuid.UUID) (*domain.PickupReservation, error) {
+	return uc.repo.FindReservation(ctx, orderID)
+}
+
+func (uc *pickupUseCase) MarkReady(ctx context.Context, orderID uuid.UUID) (*domain.PickupReservation, error) {
+	reservation, err := uc.repo.Transition(ctx, orderID, domain.PickupReady, uc.now().UTC())
+	if err != nil {
+		return nil, err
+	}
+	publish(ctx, uc.events, EventPickupReady, pickupReadyEvent{
+		OrderID:    reservation.OrderID,
+		LocationID: reservation.LocationID,
+		SlotID:     reservation.SlotID,
+		ReadyAt:    *reservation.ReadyAt,
+	})
+	return reservation, nil
+}
+
+func (uc *pickupUseCase) MarkCollected(ctx context.Context, orderID uuid.UUID) (*domain.PickupReservation, error) {
+	return uc.repo.Transition(ctx, orderID, domain.PickupCollected, uc.now().UTC())
+}
+
+func (uc *pickupUseCase) Cancel(ctx context.Context, orderID uuid.UUID) (*domain.PickupReservation, error) {
+	return uc.repo.Transition(ctx, orderID, domain.PickupCancelled, uc.now().UTC())
+}
+
+// mergePickupItems validates an order's lines and merges those of the same
+// SKU, keeping the order of first appearance.
+func mergePickupItems(items []domain.PickupItem) ([]domain.PickupItem, error) {
+	if len(items) == 0 {
+		return nil, domain.ErrEmptyBatch
+	}
+	if len(items) > domain.MaxBatchGetIDs {
+		return nil, domain.ErrBatchSizeExceeded
+	}
+
+	quantities := make(map[uuid.UUID]int64, len(items))
+	var merged []domain.PickupItem
+	for _, item := range items {
+		if item.Quantity <= 0 {
+			return nil, domain.ErrInvalidQuantity
+		}
+		if _, ok := quantities[item.SKUID]; !ok {
+			merged = append(merged, domain.PickupItem{SKUID: item.SKUID})
+		}
+		quantities[item.SKUID] += item.Quantity
+	}
+	for i := range merged {
+		merged[i].Quantity = quantities[merged[i].SKUID]
+	}
+	return merged, nil
+}
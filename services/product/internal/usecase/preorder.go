@@ -0,0 +1,115 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/daisuke8000/example-ec-platform/services/product/internal/domain"
+)
+
+// PreorderUseCase sells SKUs before they are in stock. Orders are allocated
+// against a campaign's cap while its window is open; payment is authorized
+// when the order is placed and captured when it ships.
+type PreorderUseCase interface {
+	// SetCampaign creates or replaces a SKU's campaign. Moving the ship
+	// date of a campaign with allocated orders emits
+	// EventPreorderShipDateChanged.
+	SetCampaign(ctx context.Context, input SetPreorderCampaignInput) (*domain.PreorderCampaign, error)
+	GetCampaigns(ctx context.Context, skuIDs []uuid.UUID) ([]*domain.PreorderCampaign, error)
+	AllocateOrder(ctx context.Context, orderID uuid.UUID, items []domain.PreorderItem) (*domain.AllocatedPreorder, error)
+	// ReleaseOrder releases an order's allocations, e.g. when it is
+	// cancelled or its payment authorization fails.
+	ReleaseOrder(ctx context.Context, orderID uuid.UUID) ([]*domain.PreorderAllocation, error)
+}
+
+type SetPreorderCampaignInput struct {
+	SKUID         uuid.UUID
+	StartsAt      time.Time
+	EndsAt        time.Time
+	ShipDate      time.Time
+	AllocationCap int64
+}
+
+type preorderUseCase struct {
+	repo   domain.PreorderRepository
+	events EventPublisher
+	now    func() time.Time
+}
+
+func NewPreorderUseCase(repo domain.PreorderRepository, events EventPublisher) PreorderUseCase {
+	return &preorderUseCase{
+		repo:   repo,
+		events: events,
+		now:    time.Now,
+	}
+}
+
+func (uc *preorderUseCase) SetCampaign(ctx context.Context, input SetPreorderCampaignInput) (*domain.PreorderCampaign, error) {
+	campaign, err := domain.NewPreorderCampaign(input.SKUID, input.StartsAt, input.EndsAt, input.ShipDate, input.AllocationCap)
+	if err != nil {
+		return nil, err
+	}
+	previous, err := uc.repo.UpsertCampaign(ctx, campaign)
+	if err != nil {
+		return nil, err
+	}
+
+	if previous != nil && previous.Allocated > 0 && !previous.ShipDate.Equal(campaign.ShipDate) {
+		orderIDs, err := uc.repo.AllocatedOrderIDs(ctx, campaign.SKUID)
+		if err != nil {
+			return nil, err
+		}
+		publish(ctx, uc.events, EventPreorderShipDateChanged, preorderShipDateChangedEvent{
+			SKUID:            campaign.SKUID,
+			PreviousShipDate: previous.ShipDate,
+			ShipDate:         campaign.ShipDate,
+			OrderIDs:         orderIDs,
+		})
+	}
+	return campaign, nil
+}
+
+func (uc *preorderUseCase) GetCampaigns(ctx context.Context, skuIDs []uuid.UUID) ([]*domain.PreorderCampaign, error) {
+	if len(skuIDs) == 0 {
+		return nil, domain.ErrEmptyBatch
+	}
+	if len(skuIDs) > domain.MaxBatchGetIDs {
+		return nil, domain.ErrBatchSizeExceeded
+	}
+	return uc.repo.FindCampaigns(ctx, skuIDs)
+}
+
+// AllocateOrder allocates an order's preorder lines all or nothing. Lines
+// of the same SKU are merged, so a retried call with reordered lines is
+// still a replay.
+func (uc *preorderUseCase) AllocateOrder(ctx context.Context, orderID uuid.UUID, items []domain.PreorderItem) (*domain.AllocatedPreorder, error) {
+	if len(items) == 0 {
+		return nil, domain.ErrEmptyBatch
+	}
+	if len(items) > domain.MaxBatchGetIDs {
+		return nil, domain.ErrBatchSizeExceeded
+	}
+
+	quantities := make(map[uuid.UUID]int64, len(items))
+	var merged []domain.PreorderItem
+	for _, item := range items {
+		if item.Quantity <= 0 {
+			return nil, domain.ErrInvalidQuantity
+		}
+		if _, ok := quantities[item.SKUID]; !ok {
+			merged = append(merged, domain.PreorderItem{SKUID: item.SKUID})
+		}
+		quantities[item.SKUID] += item.Quantity
+	}
+	for i := range merged {
+		merged[i].Quantity = quantities[merged[i].SKUID]
+	}
+
+	return uc.repo.Allocate(ctx, orderID, merged, uc.now().UTC())
+}
+
+func (uc *preorderUseCase) ReleaseOrder(ctx context.Context, orderID uuid.UUID) ([]*domain.PreorderAllocation, error) {
+	return uc.repo.Release(ctx, orderID)
+}
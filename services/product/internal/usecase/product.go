@@ -11,10 +11,14 @@ import (
 type ProductUseCase interface {
 	CreateProduct(ctx context.Context, input CreateProductInput) (*domain.Product, error)
 	GetProduct(ctx context.Context, id uuid.UUID) (*domain.Product, error)
-	GetProductWithSKUs(ctx context.Context, id uuid.UUID) (*domain.ProductWithSKUs, error)
+	// GetProductWithSKUs and GetProductsByIDs treat products not visible to
+	// audience as missing. A nil audience sees every product.
+	GetProductWithSKUs(ctx context.Context, id uuid.UUID, audience *domain.Audience) (*domain.ProductWithSKUs, error)
+	GetProductsByIDs(ctx context.Context, ids []uuid.UUID, audience *domain.Audience) (map[uuid.UUID]*domain.Product, error)
 	ListProducts(ctx context.Context, filter domain.ProductFilter, pagination domain.Pagination) ([]*domain.Product, int64, error)
 	UpdateProduct(ctx context.Context, id uuid.UUID, input UpdateProductInput) (*domain.Product, error)
 	UpdateProductStatus(ctx context.Context, id uuid.UUID, status domain.ProductStatus) error
+	UpdateProductVisibility(ctx context.Context, id uuid.UUID, channels, markets []string) (*domain.Product, error)
 	DeleteProduct(ctx context.Context, id uuid.UUID) error
 }
 
@@ -22,6 +26,10 @@ type CreateProductInput struct {
 	Name        string
 	Description *string
 	CategoryID  *uuid.UUID
+
+	// ValidateOnly runs all validation and returns the product without
+	// creating it.
+	ValidateOnly bool
 }
 
 type UpdateProductInput struct {
@@ -33,12 +41,16 @@ type UpdateProductInput struct {
 type productUseCase struct {
 	productRepo  domain.ProductRepository
 	categoryRepo domain.CategoryRepository
+	imageRepo    domain.ProductImageRepository
+	events       EventPublisher
 }
 
-func NewProductUseCase(productRepo domain.ProductRepository, categoryRepo domain.CategoryRepository) ProductUseCase {
+func NewProductUseCase(productRepo domain.ProductRepository, categoryRepo domain.CategoryRepository, imageRepo domain.ProductImageRepository, events EventPublisher) ProductUseCase {
 	return &productUseCase{
 		productRepo:  productRepo,
 		categoryRepo: categoryRepo,
+		imageRepo:    imageRepo,
+		events:       events,
 	}
 }
 
@@ -53,10 +65,14 @@ func (uc *productUseCase) CreateProduct(ctx context.Context, input CreateProduct
 	if err != nil {
 		return nil, err
 	}
+	if input.ValidateOnly {
+		return product, nil
+	}
 
 	if err := uc.productRepo.Create(ctx, product); err != nil {
 		return nil, err
 	}
+	publish(ctx, uc.events, EventProductCreated, newProductEvent(product))
 	return product, nil
 }
 
@@ -64,8 +80,44 @@ func (uc *productUseCase) GetProduct(ctx context.Context, id uuid.UUID) (*domain
 	return uc.productRepo.FindByID(ctx, id)
 }
 
-func (uc *productUseCase) GetProductWithSKUs(ctx context.Context, id uuid.UUID) (*domain.ProductWithSKUs, error) {
-	return uc.productRepo.FindByIDWithSKUs(ctx, id)
+func (uc *productUseCase) GetProductWithSKUs(ctx context.Context, id uuid.UUID, audience *domain.Audience) (*domain.ProductWithSKUs, error) {
+	product, err := uc.productRepo.FindByIDWithSKUs(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if audience != nil && !product.Product.Visibility.VisibleTo(*audience) {
+		return nil, domain.ErrProductNotFound
+	}
+	product.Images, err = uc.imageRepo.ListByProduct(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return product, nil
+}
+
+// GetProductsByIDs looks up products in a single query.
+// Missing or deleted products are absent from the returned map.
+func (uc *productUseCase) GetProductsByIDs(ctx context.Context, ids []uuid.UUID, audience *domain.Audience) (map[uuid.UUID]*domain.Product, error) {
+	if len(ids) == 0 {
+		return nil, domain.ErrInvalidQuantity
+	}
+	if len(ids) > domain.MaxBatchGetIDs {
+		return nil, domain.ErrBatchSizeExceeded
+	}
+
+	products, err := uc.productRepo.FindByIDs(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+
+	found := make(map[uuid.UUID]*domain.Product, len(products))
+	for _, p := range products {
+		if audience != nil && !p.Visibility.VisibleTo(*audience) {
+			continue
+		}
+		found[p.ID] = p
+	}
+	return found, nil
 }
 
 func (uc *productUseCase) ListProducts(ctx context.Context, filter domain.ProductFilter, pagination domain.Pagination) ([]*domain.Product, int64, error) {
@@ -103,6 +155,7 @@ func (uc *productUseCase) UpdateProduct(ctx context.Context, id uuid.UUID, input
 	if err := uc.productRepo.Update(ctx, product); err != nil {
 		return nil, err
 	}
+	publish(ctx, uc.events, EventProductUpdated, newProductEvent(product))
 	return product, nil
 }
 
@@ -110,9 +163,35 @@ func (uc *productUseCase) UpdateProductStatus(ctx context.Context, id uuid.UUID,
 	if err := domain.ValidateProductStatus(status); err != nil {
 		return err
 	}
-	return uc.productRepo.UpdateStatus(ctx, id, status)
+	if err := uc.productRepo.UpdateStatus(ctx, id, status); err != nil {
+		return err
+	}
+	publish(ctx, uc.events, EventProductUpdated, productEvent{ID: id, Status: status.String()})
+	return nil
+}
+
+func (uc *productUseCase) UpdateProductVisibility(ctx context.Context, id uuid.UUID, channels, markets []string) (*domain.Product, error) {
+	visibility, err := domain.NewVisibility(channels, markets)
+	if err != nil {
+		return nil, err
+	}
+
+	product, err := uc.productRepo.FindByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	product.Visibility = visibility
+	if err := uc.productRepo.UpdateVisibility(ctx, product); err != nil {
+		return nil, err
+	}
+	publish(ctx, uc.events, EventProductUpdated, newProductEvent(product))
+	return product, nil
 }
 
 func (uc *productUseCase) DeleteProduct(ctx context.Context, id uuid.UUID) error {
-	return uc.productRepo.SoftDeleteWithSKUs(ctx, id)
+	if err := uc.productRepo.SoftDeleteWithSKUs(ctx, id); err != nil {
+		return err
+	}
+	publish(ctx, uc.events, EventProductDeleted, productEvent{ID: id})
+	return nil
 }
@@ -0,0 +1,201 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/daisuke8000/example-ec-platform/services/product/internal/domain"
+)
+
+type ProductImageUseCase interface {
+	CreateImageUpload(ctx context.Context, input CreateImageUploadInput) (*ImageUpload, error)
+	CompleteImageUpload(ctx context.Context, id uuid.UUID) (*domain.ProductImage, error)
+	UpdateImage(ctx context.Context, id uuid.UUID, input UpdateImageInput) (*domain.ProductImage, error)
+	ReorderImages(ctx context.Context, productID uuid.UUID, ids []uuid.UUID) ([]*domain.ProductImage, error)
+	DeleteImage(ctx context.Context, id uuid.UUID) error
+	// ImageURL returns the public URL of an image, or "" when image storage
+	// is not configured.
+	ImageURL(image *domain.ProductImage) string
+}
+
+type CreateImageUploadInput struct {
+	ProductID   uuid.UUID
+	ContentType string
+	AltText     string
+}
+
+// ImageUpload is a pending image and the presigned URL to PUT its file to.
+type ImageUpload struct {
+	Image     *domain.ProductImage
+	UploadURL string
+	ExpiresAt time.Time
+}
+
+type UpdateImageInput struct {
+	AltText *string
+}
+
+type productImageUseCase struct {
+	imageRepo   domain.ProductImageRepository
+	productRepo domain.ProductRepository
+	storage     domain.ImageStorage
+	events      EventPublisher
+}
+
+// NewProductImageUseCase creates the image use case. storage may be nil, in
+// which case existing images are still listed but uploads fail with
+// ErrImageStorageDisabled.
+func NewProductImageUseCase(
+	imageRepo domain.ProductImageRepository,
+	productRepo domain.ProductRepository,
+	storage domain.ImageStorage,
+	events EventPublisher,
+) ProductImageUseCase {
+	return &productImageUseCase{
+		imageRepo:   imageRepo,
+		productRepo: productRepo,
+		storage:     storage,
+		events:      events,
+	}
+}
+
+func (uc *productImageUseCase) CreateImageUpload(ctx context.Context, input CreateImageUploadInput) (*ImageUpload, error) {
+	if uc.storage == nil {
+		return nil, domain.ErrImageStorageDisabled
+	}
+	if _, err := uc.productRepo.FindByID(ctx, input.ProductID); err != nil {
+		return nil, err
+	}
+
+	image, err := domain.NewProductImage(input.ProductID, input.ContentType, input.AltText)
+	if err != nil {
+		return nil, err
+	}
+
+	// Checked again when the upload completes; this only spares the client
+	// an upload that could never be used.
+	images, err := uc.imageRepo.ListByProduct(ctx, input.ProductID)
+	if err != nil {
+		return nil, err
+	}
+	if len(images) >= domain.MaxProductImages {
+		return nil, domain.ErrTooManyImages
+	}
+
+	uploadURL, expiresAt, err := uc.storage.PresignUpload(ctx, image.ObjectKey, image.ContentType)
+	if err != nil {
+		return nil, err
+	}
+	if err := uc.imageRepo.Create(ctx, image); err != nil {
+		return nil, err
+	}
+
+	return &ImageUpload{Image: image, UploadURL: uploadURL, ExpiresAt: expiresAt}, nil
+}
+
+// CompleteImageUpload verifies that the file was uploaded and makes the
+// image visible as the product's last image. Oversized files are deleted
+// together with the image.
+func (uc *productImageUseCase) CompleteImageUpload(ctx context.Context, id uuid.UUID) (*domain.ProductImage, error) {
+	if uc.storage == nil {
+		return nil, domain.ErrImageStorageDisabled
+	}
+
+	image, err := uc.imageRepo.FindByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if image.Status != domain.ProductImageStatusPending {
+		return nil, domain.ErrImageNotPending
+	}
+
+	size, err := uc.storage.Size(ctx, image.ObjectKey)
+	if err != nil {
+		return nil, err
+	}
+	if size > domain.MaxImageSizeBytes {
+		if err := uc.deleteImage(ctx, image); err != nil {
+			return nil, err
+		}
+		return nil, domain.ErrImageTooLarge
+	}
+
+	image.SizeBytes = size
+	image.UpdatedAt = time.Now().UTC()
+	if err := uc.imageRepo.MarkReady(ctx, image); err != nil {
+		return nil, err
+	}
+	publish(ctx, uc.events, EventProductUpdated, productEvent{ID: image.ProductID})
+	return image, nil
+}
+
+func (uc *productImageUseCase) UpdateImage(ctx context.Context, id uuid.UUID, input UpdateImageInput) (*domain.ProductImage, error) {
+	image, err := uc.imageRepo.FindByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if input.AltText == nil {
+		return image, nil
+	}
+	if err := domain.ValidateImageAltText(*input.AltText); err != nil {
+		return nil, err
+	}
+
+	image.AltText = *input.AltText
+	image.UpdatedAt = time.Now().UTC()
+	if err := uc.imageRepo.UpdateAltText(ctx, image); err != nil {
+		return nil, err
+	}
+	if image.Status == domain.ProductImageStatusReady {
+		publish(ctx, uc.events, EventProductUpdated, productEvent{ID: image.ProductID})
+	}
+	return image, nil
+}
+
+func (uc *productImageUseCase) ReorderImages(ctx context.Context, productID uuid.UUID, ids []uuid.UUID) ([]*domain.ProductImage, error) {
+	if err := uc.imageRepo.Reorder(ctx, productID, ids); err != nil {
+		return nil, err
+	}
+	publish(ctx, uc.events, EventProductUpdated, productEvent{ID: productID})
+	return uc.imageRepo.ListByProduct(ctx, productID)
+}
+
+func (uc *productImageUseCase) DeleteImage(ctx context.Context, id uuid.UUID) error {
+	image, err := uc.imageRepo.FindByID(ctx, id)
+	if err != nil {
+		return err
+	}
+	if err := uc.deleteImage(ctx, image); err != nil {
+		return err
+	}
+	if image.Status == domain.ProductImageStatusReady {
+		publish(ctx, uc.events, EventProductUpdated, productEvent{ID: image.ProductID})
+	}
+	return nil
+}
+
+// deleteImage removes the file before the row, so a failure never leaves a
+// file that no image refers to.
+func (uc *productImageUseCase) deleteImage(ctx context.Context, image *domain.ProductImage) error {
+	if uc.storage == nil {
+		return domain.ErrImageStorageDisabled
+	}
+	if err := uc.storage.Delete(ctx, image.ObjectKey); err != nil {
+		return err
+	}
+	err := uc.imageRepo.Delete(ctx, image.ID)
+	if errors.Is(err, domain.ErrProductImageNotFound) {
+		return nil
+	}
+	return err
+}
+
+func (uc *productImageUseCase) ImageURL(image *domain.ProductImage) string {
+	if uc.storage == nil {
+		return ""
+	}
+	return uc.storage.URL(image.ObjectKey)
+}
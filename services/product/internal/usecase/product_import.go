@@ -0,0 +1,483 @@
+package usecase
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/google/uuid"
+
+	"github.com/daisuke8000/example-ec-platform/pkg/operations"
+	"github.com/daisuke8000/example-ec-platform/services/product/internal/domain"
+)
+
+// ProductImportOperationKind is the kind of the operations started by
+// StartImport.
+const ProductImportOperationKind = "product_import"
+
+type ImportFormat int
+
+const (
+	// ImportFormatCSV has a header row and one row per SKU. Rows sharing a
+	// product_ref belong to one product, whose fields are taken from the
+	// first of them.
+	ImportFormatCSV ImportFormat = iota + 1
+	// ImportFormatNDJSON has one JSON product with its SKUs per line.
+	ImportFormatNDJSON
+)
+
+// csvColumns are the columns a CSV import may have.
+var csvColumns = []string{
+	"product_ref", "name", "description", "category_id", "status",
+	"sku_code", "price_amount", "price_currency", "quantity", "attributes",
+}
+
+var errImportNoSKUs = errors.New("at least one SKU is required")
+
+type ProductImportUseCase interface {
+	// StartImport parses the payload and imports it in the background.
+	// Malformed payloads are rejected up front; invalid products are
+	// reported in the import's result.
+	StartImport(ctx context.Context, input ImportProductsInput) (*operations.Operation, error)
+	// GetImport returns an import and, once it has succeeded, its report.
+	GetImport(ctx context.Context, id uuid.UUID) (*operations.Operation, *ImportReport, error)
+}
+
+type ImportProductsInput struct {
+	Format ImportFormat
+	Data   []byte
+	// ValidateOnly checks every product without writing any.
+	ValidateOnly bool
+	Actor        string
+}
+
+// ImportRowError reports why a product was not imported. Line is the
+// 1-based line of the payload the product starts on.
+type ImportRowError struct {
+	Line    int    `json:"line"`
+	SKUCode string `json:"sku_code,omitempty"`
+	Message string `json:"message"`
+}
+
+// ImportReport is the result of a finished import. For validate-only
+// imports, ImportedProducts counts the products that would be imported.
+type ImportReport struct {
+	ValidateOnly     bool             `json:"validate_only"`
+	TotalProducts    int              `json:"total_products"`
+	ImportedProducts int              `json:"imported_products"`
+	FailedProducts   int              `json:"failed_products"`
+	Errors           []ImportRowError `json:"errors,omitempty"`
+	ErrorsTruncated  bool             `json:"errors_truncated,omitempty"`
+}
+
+func (r *ImportReport) fail(rowErr ImportRowError) {
+	r.FailedProducts++
+	if len(r.Errors) == domain.MaxImportRowErrors {
+		r.ErrorsTruncated = true
+		return
+	}
+	r.Errors = append(r.Errors, rowErr)
+}
+
+// importRow is one product of the payload before validation.
+type importRow struct {
+	Line        int         `json:"-"`
+	Name        string      `json:"name"`
+	Description string      `json:"description"`
+	CategoryID  string      `json:"category_id"`
+	Status      string      `json:"status"`
+	SKUs        []importSKU `json:"skus"`
+
+	// err is set when the row could not be parsed.
+	err        error
+	errSKUCode string
+}
+
+type importSKU struct {
+	SKUCode       string            `json:"sku_code"`
+	PriceAmount   int64             `json:"price_amount"`
+	PriceCurrency string            `json:"price_currency"`
+	Quantity      int64             `json:"quantity"`
+	Attributes    map[string]string `json:"attributes"`
+}
+
+type productImportUseCase struct {
+	importer     domain.ProductImporter
+	categoryRepo domain.CategoryRepository
+	runner       *operations.Runner
+	operations   operations.Store
+	events       EventPublisher
+}
+
+func NewProductImportUseCase(
+	importer domain.ProductImporter,
+	categoryRepo domain.CategoryRepository,
+	runner *operations.Runner,
+	operationsStore operations.Store,
+	events EventPublisher,
+) ProductImportUseCase {
+	return &productImportUseCase{
+		importer:     importer,
+		categoryRepo: categoryRepo,
+		runner:       runner,
+		operations:   operationsStore,
+		events:       events,
+	}
+}
+
+func (uc *productImportUseCase) StartImport(ctx context.Context, input ImportProductsInput) (*operations.Operation, error) {
+	if len(input.Data) > domain.MaxImportSizeBytes {
+		return nil, domain.ErrImportTooLarge
+	}
+
+	var rows []*importRow
+	var err error
+	switch input.Format {
+	case ImportFormatCSV:
+		rows, err = parseImportCSV(input.Data)
+	case ImportFormatNDJSON:
+		rows, err = parseImportNDJSON(input.Data)
+	default:
+		return nil, domain.ErrInvalidImportFormat
+	}
+	if err != nil {
+		return nil, err
+	}
+	if len(rows) == 0 {
+		return nil, domain.ErrEmptyImport
+	}
+
+	return uc.runner.Start(ctx, ProductImportOperationKind, input.Actor, func(ctx context.Context, p *operations.Progress) (any, error) {
+		return uc.importRows(ctx, rows, input.ValidateOnly, p)
+	})
+}
+
+// importRows validates the rows and writes the valid products in batches of
+// ImportBatchSize. Batches committed before a failure or cancellation stay
+// imported.
+func (uc *productImportUseCase) importRows(ctx context.Context, rows []*importRow, validateOnly bool, p *operations.Progress) (*ImportReport, error) {
+	report := &ImportReport{ValidateOnly: validateOnly, TotalProducts: len(rows)}
+	skuLines := make(map[string]int)
+	categories := make(map[uuid.UUID]error)
+
+	var batch []*domain.ImportedProduct
+	var batchRows []*importRow
+	flush := func() error {
+		if len(batch) == 0 {
+			return nil
+		}
+		rowErrs, err := uc.importer.ImportBatch(ctx, batch)
+		if err != nil {
+			return err
+		}
+		for i, rowErr := range rowErrs {
+			if rowErr != nil {
+				report.fail(ImportRowError{Line: batchRows[i].Line, Message: rowErr.Error()})
+				continue
+			}
+			report.ImportedProducts++
+			publish(ctx, uc.events, EventProductCreated, newProductEvent(batch[i].Product))
+		}
+		batch, batchRows = batch[:0], batchRows[:0]
+		return nil
+	}
+
+	for i, row := range rows {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		product, rowErr, err := uc.validateRow(ctx, row, skuLines, categories)
+		if err != nil {
+			return nil, err
+		}
+		switch {
+		case rowErr != nil:
+			report.fail(*rowErr)
+		case validateOnly:
+			report.ImportedProducts++
+		default:
+			batch = append(batch, product)
+			batchRows = append(batchRows, row)
+			if len(batch) == domain.ImportBatchSize {
+				if err := flush(); err != nil {
+					return nil, err
+				}
+			}
+		}
+
+		if err := p.SetFraction(ctx, i+1, len(rows)); err != nil {
+			return nil, err
+		}
+	}
+	if err := flush(); err != nil {
+		return nil, err
+	}
+	return report, nil
+}
+
+// validateRow builds the product of a row. A row that cannot be imported is
+// reported through rowErr; err is only set when validation itself failed.
+// skuLines and categories carry the SKU codes and category lookups of
+// earlier rows.
+func (uc *productImportUseCase) validateRow(
+	ctx context.Context,
+	row *importRow,
+	skuLines map[string]int,
+	categories map[uuid.UUID]error,
+) (product *domain.ImportedProduct, rowErr *ImportRowError, err error) {
+	reject := func(skuCode string, err error) (*domain.ImportedProduct, *ImportRowError, error) {
+		return nil, &ImportRowError{Line: row.Line, SKUCode: skuCode, Message: err.Error()}, nil
+	}
+
+	if row.err != nil {
+		return reject(row.errSKUCode, row.err)
+	}
+	if len(row.SKUs) == 0 {
+		return reject("", errImportNoSKUs)
+	}
+
+	var categoryID *uuid.UUID
+	if row.CategoryID != "" {
+		id, err := uuid.Parse(row.CategoryID)
+		if err != nil {
+			return reject("", fmt.Errorf("invalid category_id %q", row.CategoryID))
+		}
+		lookupErr, ok := categories[id]
+		if !ok {
+			_, lookupErr = uc.categoryRepo.FindByID(ctx, id)
+			if lookupErr != nil && !errors.Is(lookupErr, domain.ErrCategoryNotFound) {
+				return nil, nil, lookupErr
+			}
+			categories[id] = lookupErr
+		}
+		if lookupErr != nil {
+			return reject("", lookupErr)
+		}
+		categoryID = &id
+	}
+
+	status := domain.ProductStatusDraft
+	if row.Status != "" {
+		status, err = domain.ParseProductStatus(strings.ToUpper(row.Status))
+		if err != nil {
+			return reject("", err)
+		}
+	}
+
+	var description *string
+	if row.Description != "" {
+		description = &row.Description
+	}
+	p, err := domain.NewProduct(row.Name, description, categoryID)
+	if err != nil {
+		return reject("", err)
+	}
+	p.Status = status
+
+	product = &domain.ImportedProduct{Product: p}
+	codes := make(map[string]bool, len(row.SKUs))
+	for _, in := range row.SKUs {
+		if line, ok := skuLines[in.SKUCode]; ok || codes[in.SKUCode] {
+			if !ok {
+				line = row.Line
+			}
+			return reject(in.SKUCode, fmt.Errorf("%w on line %d", domain.ErrSKUCodeAlreadyExists, line))
+		}
+		codes[in.SKUCode] = true
+
+		price, err := domain.NewMoney(in.PriceAmount, in.PriceCurrency)
+		if err != nil {
+			return reject(in.SKUCode, err)
+		}
+		sku, err := domain.NewSKU(p.ID, in.SKUCode, *price, in.Attributes)
+		if err != nil {
+			return reject(in.SKUCode, err)
+		}
+		inventory, err := domain.NewInventory(sku.ID, in.Quantity)
+		if err != nil {
+			return reject(in.SKUCode, err)
+		}
+		product.SKUs = append(product.SKUs, sku)
+		product.Inventories = append(product.Inventories, inventory)
+	}
+
+	for code := range codes {
+		skuLines[code] = row.Line
+	}
+	return product, nil, nil
+}
+
+func (uc *productImportUseCase) GetImport(ctx context.Context, id uuid.UUID) (*operations.Operation, *ImportReport, error) {
+	op, err := uc.operations.Get(ctx, id)
+	if err != nil {
+		if errors.Is(err, operations.ErrNotFound) {
+			return nil, nil, domain.ErrImportNotFound
+		}
+		return nil, nil, err
+	}
+	if op.Kind != ProductImportOperationKind {
+		return nil, nil, domain.ErrImportNotFound
+	}
+
+	if op.Status != operations.StatusSucceeded || len(op.Result) == 0 {
+		return op, nil, nil
+	}
+	var report ImportReport
+	if err := json.Unmarshal(op.Result, &report); err != nil {
+		return nil, nil, fmt.Errorf("decode import report: %w", err)
+	}
+	return op, &report, nil
+}
+
+// parseImportCSV reads a CSV payload. Rows with unparsable values are kept
+// and reported during validation; only a bad header or broken CSV syntax
+// fails the whole import.
+func parseImportCSV(data []byte) ([]*importRow, error) {
+	r := csv.NewReader(bytes.NewReader(data))
+	r.FieldsPerRecord = -1
+	r.TrimLeadingSpace = true
+
+	header, err := r.Read()
+	if err != nil {
+		if errors.Is(err, io.EOF) {
+			return nil, domain.ErrEmptyImport
+		}
+		return nil, fmt.Errorf("%w: %v", domain.ErrMalformedImport, err)
+	}
+	columns := make(map[string]int, len(header))
+	for i, name := range header {
+		name = strings.ToLower(strings.TrimSpace(strings.TrimPrefix(name, "\ufeff")))
+		if !slices.Contains(csvColumns, name) {
+			return nil, fmt.Errorf("%w: unknown column %q", domain.ErrMalformedImport, name)
+		}
+		columns[name] = i
+	}
+	for _, name := range []string{"name", "sku_code", "price_amount"} {
+		if _, ok := columns[name]; !ok {
+			return nil, fmt.Errorf("%w: missing column %q", domain.ErrMalformedImport, name)
+		}
+	}
+
+	var rows []*importRow
+	byRef := make(map[string]*importRow)
+	for {
+		record, err := r.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("%w: %v", domain.ErrMalformedImport, err)
+		}
+		line, _ := r.FieldPos(0)
+		field := func(name string) string {
+			if i, ok := columns[name]; ok && i < len(record) {
+				return strings.TrimSpace(record[i])
+			}
+			return ""
+		}
+
+		sku, skuErr := parseCSVSKU(field)
+		if skuErr == nil && len(record) != len(header) {
+			skuErr = fmt.Errorf("line has %d fields, header has %d", len(record), len(header))
+		}
+
+		ref := field("product_ref")
+		if row, ok := byRef[ref]; ok && ref != "" {
+			row.SKUs = append(row.SKUs, sku)
+			if skuErr != nil && row.err == nil {
+				row.err = fmt.Errorf("line %d: %w", line, skuErr)
+				row.errSKUCode = sku.SKUCode
+			}
+			continue
+		}
+
+		row := &importRow{
+			Line:        line,
+			Name:        field("name"),
+			Description: field("description"),
+			CategoryID:  field("category_id"),
+			Status:      field("status"),
+			SKUs:        []importSKU{sku},
+		}
+		if skuErr != nil {
+			row.err = skuErr
+			row.errSKUCode = sku.SKUCode
+		}
+		rows = append(rows, row)
+		if ref != "" {
+			byRef[ref] = row
+		}
+	}
+	return rows, nil
+}
+
+// parseCSVSKU reads the SKU columns of a CSV line. Attributes are written as
+// "key=value" pairs separated by semicolons.
+func parseCSVSKU(field func(string) string) (importSKU, error) {
+	sku := importSKU{
+		SKUCode:       field("sku_code"),
+		PriceCurrency: field("price_currency"),
+	}
+
+	var err error
+	if sku.PriceAmount, err = strconv.ParseInt(field("price_amount"), 10, 64); err != nil {
+		return sku, fmt.Errorf("invalid price_amount %q", field("price_amount"))
+	}
+	if v := field("quantity"); v != "" {
+		if sku.Quantity, err = strconv.ParseInt(v, 10, 64); err != nil {
+			return sku, fmt.Errorf("invalid quantity %q", v)
+		}
+	}
+	if v := field("attributes"); v != "" {
+		sku.Attributes = make(map[string]string)
+		for _, pair := range strings.Split(v, ";") {
+			key, value, ok := strings.Cut(pair, "=")
+			key = strings.TrimSpace(key)
+			if !ok || key == "" {
+				return sku, fmt.Errorf("invalid attribute %q, want key=value", pair)
+			}
+			sku.Attributes[key] = strings.TrimSpace(value)
+		}
+	}
+	return sku, nil
+}
+
+// parseImportNDJSON reads an NDJSON payload. Blank lines are skipped; lines
+// that are not a valid product object are kept and reported during
+// validation.
+func parseImportNDJSON(data []byte) ([]*importRow, error) {
+	var rows []*importRow
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	scanner.Buffer(nil, domain.MaxImportSizeBytes)
+
+	line := 0
+	for scanner.Scan() {
+		line++
+		text := bytes.TrimSpace(scanner.Bytes())
+		if len(text) == 0 {
+			continue
+		}
+
+		row := &importRow{}
+		dec := json.NewDecoder(bytes.NewReader(text))
+		dec.DisallowUnknownFields()
+		if err := dec.Decode(row); err != nil {
+			row = &importRow{err: fmt.Errorf("invalid JSON: %v", err)}
+		}
+		row.Line = line
+		rows = append(rows, row)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("%w: %v", domain.ErrMalformedImport, err)
+	}
+	return rows, nil
+}
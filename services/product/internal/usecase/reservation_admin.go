@@ -0,0 +1,165 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+
+	"github.com/daisuke8000/example-ec-platform/services/product/internal/domain"
+)
+
+const (
+	defaultReservationPageSize = 50
+	maxReservationPageSize     = 200
+
+	// maxDrainReservations bounds the reservations released by one drain.
+	maxDrainReservations = 1000
+)
+
+type ListReservationsInput struct {
+	Filter    domain.ReservationFilter
+	PageSize  int
+	PageToken string
+}
+
+type ListReservationsOutput struct {
+	Reservations  []*domain.Reservation
+	NextPageToken string
+}
+
+// ListReservations pages through reservations newest first. The page token
+// is the ID of the last reservation of the previous page.
+func (uc *inventoryUseCase) ListReservations(ctx context.Context, input ListReservationsInput) (*ListReservationsOutput, error) {
+	pageSize := input.PageSize
+	if pageSize <= 0 {
+		pageSize = defaultReservationPageSize
+	}
+	if pageSize > maxReservationPageSize {
+		pageSize = maxReservationPageSize
+	}
+
+	f := input.Filter
+	if f.Status != nil && !f.Status.IsValid() {
+		return nil, domain.ErrInvalidReservationStatus
+	}
+	if !f.CreatedAfter.IsZero() && !f.CreatedBefore.IsZero() && !f.CreatedAfter.Before(f.CreatedBefore) {
+		return nil, domain.ErrInvalidCreatedRange
+	}
+
+	var beforeID uuid.UUID
+	if input.PageToken != "" {
+		id, err := uuid.Parse(input.PageToken)
+		if err != nil {
+			return nil, domain.ErrInvalidPageToken
+		}
+		beforeID = id
+	}
+
+	// Fetch one extra row to know whether another page exists.
+	reservations, err := uc.reservationRepo.List(ctx, f, beforeID, pageSize+1)
+	if err != nil {
+		return nil, err
+	}
+
+	output := &ListReservationsOutput{Reservations: reservations}
+	if len(reservations) > pageSize {
+		output.Reservations = reservations[:pageSize]
+		output.NextPageToken = reservations[pageSize-1].ID.String()
+	}
+	return output, nil
+}
+
+// ForceReleaseReservation releases a pending reservation on behalf of
+// support staff, e.g. one left behind by a failed checkout, and records
+// reason with it. Unlike ReleaseReservation the status change and stock
+// release share one transaction, so a release racing with the owner's
+// confirmation or the TTL expiry either wins completely or fails with
+// domain.ErrReservationNotPending.
+func (uc *inventoryUseCase) ForceReleaseReservation(ctx context.Context, reservationID uuid.UUID, reason string, actor string) (*domain.Reservation, error) {
+	reason = strings.TrimSpace(reason)
+	if reason == "" || utf8.RuneCountInString(reason) > domain.MaxReleaseReasonLength {
+		return nil, domain.ErrInvalidReleaseReason
+	}
+
+	src := domain.MovementSource{
+		Reason:        domain.MovementReasonForceRelease,
+		Actor:         actor,
+		ReservationID: &reservationID,
+	}
+	var released *domain.Reservation
+	err := uc.txManager.DoWithTx(ctx, func(ctx context.Context, tx pgx.Tx) error {
+		reservation, err := uc.reservationRepo.ReleasePendingWithTx(ctx, tx, reservationID, reason)
+		if err != nil {
+			return err
+		}
+		for _, item := range reservation.Items {
+			if err := uc.inventoryRepo.ReleaseReservationWithTx(ctx, tx, item.SKUID, item.Quantity, src); err != nil {
+				return err
+			}
+		}
+		released = reservation
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	uc.invalidate(ctx, released.SKUIDs()...)
+	return released, nil
+}
+
+type DrainSKUReservationsOutput struct {
+	Released []uuid.UUID
+	// Skipped counts reservations confirmed, released or expired between
+	// being listed and released.
+	Skipped int
+	// HasMore is set when maxDrainReservations was reached with pending
+	// reservations left.
+	HasMore bool
+}
+
+// DrainSKUReservations force-releases every pending reservation holding
+// skuID, e.g. when the SKU was pulled during an incident. Each reservation
+// is released by ForceReleaseReservation in its own transaction, so an
+// error stops the drain with the earlier releases kept; draining again
+// picks up where it stopped.
+func (uc *inventoryUseCase) DrainSKUReservations(ctx context.Context, skuID uuid.UUID, reason string, actor string) (*DrainSKUReservationsOutput, error) {
+	reason = strings.TrimSpace(reason)
+	if reason == "" || utf8.RuneCountInString(reason) > domain.MaxReleaseReasonLength {
+		return nil, domain.ErrInvalidReleaseReason
+	}
+
+	pending := domain.ReservationStatusPending
+	filter := domain.ReservationFilter{Status: &pending, SKUID: &skuID}
+	output := &DrainSKUReservationsOutput{}
+	var beforeID uuid.UUID
+	for {
+		reservations, err := uc.reservationRepo.List(ctx, filter, beforeID, maxReservationPageSize)
+		if err != nil {
+			return nil, err
+		}
+		for _, r := range reservations {
+			if len(output.Released)+output.Skipped == maxDrainReservations {
+				output.HasMore = true
+				return output, nil
+			}
+			_, err := uc.ForceReleaseReservation(ctx, r.ID, reason, actor)
+			switch {
+			case errors.Is(err, domain.ErrReservationNotPending):
+				output.Skipped++
+			case err != nil:
+				return nil, fmt.Errorf("drain stopped after releasing %d reservations: %w", len(output.Released), err)
+			default:
+				output.Released = append(output.Released, r.ID)
+			}
+		}
+		if len(reservations) < maxReservationPageSize {
+			return output, nil
+		}
+		beforeID = reservations[len(reservations)-1].ID
+	}
+}
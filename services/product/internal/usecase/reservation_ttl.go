@@ -0,0 +1,42 @@
+package usecase
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/daisuke8000/example-ec-platform/services/product/internal/domain"
+)
+
+// ReservationTTLPolicy bounds the TTLs clients may request for a reservation.
+type ReservationTTLPolicy struct {
+	// Default is used by requests that do not set a TTL.
+	Default time.Duration
+	// Min and Max bound a requested TTL.
+	Min time.Duration
+	Max time.Duration
+	// ScopeMax raises Max for callers holding the scope, e.g. internal
+	// services that hold stock across a longer checkout. The largest
+	// maximum of the caller's scopes applies.
+	ScopeMax map[string]time.Duration
+}
+
+// resolve returns the TTL of a reservation requested with ttl by a caller
+// holding scopes, or domain.ErrReservationTTLOutOfRange with the bounds that
+// apply to the caller.
+func (p ReservationTTLPolicy) resolve(ttl time.Duration, scopes []string) (time.Duration, error) {
+	if ttl == 0 {
+		return p.Default, nil
+	}
+
+	maxTTL := p.Max
+	for _, scope := range scopes {
+		if m, ok := p.ScopeMax[scope]; ok && m > maxTTL {
+			maxTTL = m
+		}
+	}
+
+	if ttl < p.Min || ttl > maxTTL {
+		return 0, fmt.Errorf("%w: requested %v, allowed between %v and %v", domain.ErrReservationTTLOutOfRange, ttl, p.Min, maxTTL)
+	}
+	return ttl, nil
+}
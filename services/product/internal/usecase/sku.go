@@ -2,6 +2,10 @@ package usecase
 
 import (
 	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
 
 	"github.com/google/uuid"
 
@@ -10,13 +14,25 @@ import (
 
 type SKUUseCase interface {
 	CreateSKU(ctx context.Context, input CreateSKUInput) (*domain.SKU, error)
+	GenerateSKUs(ctx context.Context, input GenerateSKUsInput) (*GenerateSKUsOutput, error)
 	GetSKU(ctx context.Context, id uuid.UUID) (*domain.SKU, error)
-	GetSKUWithInventory(ctx context.Context, id uuid.UUID) (*domain.SKUWithInventory, error)
+	GetSKUWithInventory(ctx context.Context, id uuid.UUID, preferredCurrency string) (*domain.SKUWithInventory, error)
+	GetSKUsByIDs(ctx context.Context, ids []uuid.UUID) (map[uuid.UUID]*domain.SKUWithInventory, error)
 	GetSKUsByProductID(ctx context.Context, productID uuid.UUID) ([]*domain.SKU, error)
 	UpdateSKU(ctx context.Context, id uuid.UUID, input UpdateSKUInput) (*domain.SKU, error)
 	DeleteSKU(ctx context.Context, id uuid.UUID) error
+	SchedulePriceChange(ctx context.Context, input SchedulePriceChangeInput) (*domain.PriceChange, error)
+	GetPriceHistory(ctx context.Context, input GetPriceHistoryInput) (*GetPriceHistoryOutput, error)
+	// ApplyDuePriceChanges applies up to limit scheduled price changes whose
+	// effective_from has passed and returns them with their new status.
+	ApplyDuePriceChanges(ctx context.Context, limit int) ([]*domain.PriceChange, error)
 }
 
+const (
+	defaultPriceHistoryPageSize = 20
+	maxPriceHistoryPageSize     = 100
+)
+
 type CreateSKUInput struct {
 	ProductID       uuid.UUID
 	SKUCode         string
@@ -24,6 +40,29 @@ type CreateSKUInput struct {
 	PriceCurrency   string
 	Attributes      map[string]string
 	InitialQuantity int64
+	// Prices holds prices in currencies other than PriceCurrency.
+	Prices []domain.Money
+
+	// ValidateOnly runs all validation, including the SKU code uniqueness
+	// check, and returns the SKU without creating it.
+	ValidateOnly bool
+}
+
+type GenerateSKUsInput struct {
+	ProductID       uuid.UUID
+	Matrix          domain.SKUMatrix
+	InitialQuantity int64
+
+	// ValidateOnly runs all validation, including the SKU code uniqueness
+	// check, and returns the SKUs without creating them.
+	ValidateOnly bool
+}
+
+type GenerateSKUsOutput struct {
+	SKUs []*domain.SKU
+	// Skipped counts the combinations that an existing SKU of the product
+	// already has.
+	Skipped int
 }
 
 type UpdateSKUInput struct {
@@ -31,24 +70,70 @@ type UpdateSKUInput struct {
 	PriceAmount   *int64
 	PriceCurrency *string
 	Attributes    map[string]string
+	// Prices replaces the prices in other currencies when non-nil.
+	Prices *[]domain.Money
+	// Actor is recorded in the price history for changed prices.
+	Actor string
+
+	// ValidateOnly runs all validation and returns the updated SKU without
+	// saving it.
+	ValidateOnly bool
+}
+
+type SchedulePriceChangeInput struct {
+	SKUID         uuid.UUID
+	Price         domain.Money
+	EffectiveFrom time.Time
+	Actor         string
+}
+
+type GetPriceHistoryInput struct {
+	SKUID    uuid.UUID
+	PageSize int
+	After    *domain.PriceChangeCursor
+}
+
+type GetPriceHistoryOutput struct {
+	Changes []*domain.PriceChange
+	// Next is set when another page exists.
+	Next *domain.PriceChangeCursor
 }
 
 type skuUseCase struct {
-	skuRepo       domain.SKURepository
-	productRepo   domain.ProductRepository
-	inventoryRepo domain.InventoryRepository
+	skuRepo         domain.SKURepository
+	productRepo     domain.ProductRepository
+	inventoryRepo   domain.InventoryRepository
+	priceChangeRepo domain.PriceChangeRepository
+	attributeRepo   domain.AttributeDefinitionRepository
+	events          EventPublisher
 }
 
-func NewSKUUseCase(skuRepo domain.SKURepository, productRepo domain.ProductRepository, inventoryRepo domain.InventoryRepository) SKUUseCase {
+func NewSKUUseCase(
+	skuRepo domain.SKURepository,
+	productRepo domain.ProductRepository,
+	inventoryRepo domain.InventoryRepository,
+	priceChangeRepo domain.PriceChangeRepository,
+	attributeRepo domain.AttributeDefinitionRepository,
+	events EventPublisher,
+) SKUUseCase {
 	return &skuUseCase{
-		skuRepo:       skuRepo,
-		productRepo:   productRepo,
-		inventoryRepo: inventoryRepo,
+		skuRepo:         skuRepo,
+		productRepo:     productRepo,
+		inventoryRepo:   inventoryRepo,
+		priceChangeRepo: priceChangeRepo,
+		attributeRepo:   attributeRepo,
+		events:          events,
 	}
 }
 
+// CreateSKU validates the attributes against the attribute definitions of
+// the product's category.
 func (uc *skuUseCase) CreateSKU(ctx context.Context, input CreateSKUInput) (*domain.SKU, error) {
-	if _, err := uc.productRepo.FindByID(ctx, input.ProductID); err != nil {
+	product, err := uc.productRepo.FindByID(ctx, input.ProductID)
+	if err != nil {
+		return nil, err
+	}
+	if err := uc.validateAttributes(ctx, product, input.Attributes); err != nil {
 		return nil, err
 	}
 
@@ -69,8 +154,7 @@ func (uc *skuUseCase) CreateSKU(ctx context.Context, input CreateSKUInput) (*dom
 	if err != nil {
 		return nil, err
 	}
-
-	if err := uc.skuRepo.Create(ctx, sku); err != nil {
+	if err := sku.SetPrices(input.Prices); err != nil {
 		return nil, err
 	}
 
@@ -78,31 +162,162 @@ func (uc *skuUseCase) CreateSKU(ctx context.Context, input CreateSKUInput) (*dom
 	if err != nil {
 		return nil, err
 	}
+	if input.ValidateOnly {
+		return sku, nil
+	}
+
+	if err := uc.skuRepo.Create(ctx, sku); err != nil {
+		return nil, err
+	}
 	if err := uc.inventoryRepo.Create(ctx, inventory); err != nil {
 		return nil, err
 	}
+	if len(sku.Prices) > 0 {
+		if err := uc.skuRepo.ReplacePrices(ctx, sku.ID, sku.Prices); err != nil {
+			return nil, err
+		}
+	}
 
 	return sku, nil
 }
 
+// GenerateSKUs creates a SKU for every combination of the matrix that no
+// existing SKU of the product has, so a matrix can be extended with a new
+// value and generated again. All SKUs are created in one transaction.
+func (uc *skuUseCase) GenerateSKUs(ctx context.Context, input GenerateSKUsInput) (*GenerateSKUsOutput, error) {
+	product, err := uc.productRepo.FindByID(ctx, input.ProductID)
+	if err != nil {
+		return nil, err
+	}
+	if input.Matrix.BasePrice.Currency == "" {
+		input.Matrix.BasePrice.Currency = domain.DefaultCurrency
+	}
+	if err := input.Matrix.Validate(); err != nil {
+		return nil, err
+	}
+	defs, err := uc.attributeDefinitions(ctx, product)
+	if err != nil {
+		return nil, err
+	}
+	existing, err := uc.skuRepo.FindByProductID(ctx, product.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	output := &GenerateSKUsOutput{}
+	var inventories []*domain.Inventory
+	var codes []string
+	for _, attributes := range input.Matrix.Combinations() {
+		if slices.ContainsFunc(existing, func(s *domain.SKU) bool { return s.HasCombination(attributes) }) {
+			output.Skipped++
+			continue
+		}
+		if err := domain.ValidateSKUAttributes(defs, attributes); err != nil {
+			return nil, err
+		}
+
+		sku, err := input.Matrix.NewSKU(product.ID, attributes)
+		if err != nil {
+			return nil, err
+		}
+		inventory, err := domain.NewInventory(sku.ID, input.InitialQuantity)
+		if err != nil {
+			return nil, err
+		}
+		output.SKUs = append(output.SKUs, sku)
+		inventories = append(inventories, inventory)
+		codes = append(codes, sku.SKUCode)
+	}
+	if len(output.SKUs) == 0 {
+		return output, nil
+	}
+
+	taken, err := uc.skuRepo.FindTakenSKUCodes(ctx, codes)
+	if err != nil {
+		return nil, err
+	}
+	if len(taken) > 0 {
+		return nil, fmt.Errorf("%w: %s", domain.ErrSKUCodeAlreadyExists, strings.Join(taken, ", "))
+	}
+	if input.ValidateOnly {
+		return output, nil
+	}
+
+	if err := uc.skuRepo.CreateBatch(ctx, output.SKUs, inventories); err != nil {
+		return nil, err
+	}
+	return output, nil
+}
+
 func (uc *skuUseCase) GetSKU(ctx context.Context, id uuid.UUID) (*domain.SKU, error) {
 	return uc.skuRepo.FindByID(ctx, id)
 }
 
-func (uc *skuUseCase) GetSKUWithInventory(ctx context.Context, id uuid.UUID) (*domain.SKUWithInventory, error) {
-	return uc.skuRepo.FindByIDWithInventory(ctx, id)
+// GetSKUWithInventory returns a SKU with its inventory and prices in other
+// currencies. preferredCurrency, if set, must be an ISO 4217 code; it is
+// validated here and applied by the caller when presenting the SKU.
+func (uc *skuUseCase) GetSKUWithInventory(ctx context.Context, id uuid.UUID, preferredCurrency string) (*domain.SKUWithInventory, error) {
+	if preferredCurrency != "" {
+		if err := domain.ValidateCurrency(preferredCurrency); err != nil {
+			return nil, err
+		}
+	}
+
+	s, err := uc.skuRepo.FindByIDWithInventory(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if s.SKU.Prices, err = uc.skuRepo.FindPrices(ctx, id); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// GetSKUsByIDs looks up SKUs with their inventory in a single query.
+// Missing or deleted SKUs are absent from the returned map.
+func (uc *skuUseCase) GetSKUsByIDs(ctx context.Context, ids []uuid.UUID) (map[uuid.UUID]*domain.SKUWithInventory, error) {
+	if len(ids) == 0 {
+		return nil, domain.ErrInvalidQuantity
+	}
+	if len(ids) > domain.MaxBatchGetIDs {
+		return nil, domain.ErrBatchSizeExceeded
+	}
+
+	skus, err := uc.skuRepo.FindByIDsWithInventory(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+
+	found := make(map[uuid.UUID]*domain.SKUWithInventory, len(skus))
+	for _, s := range skus {
+		found[s.SKU.ID] = s
+	}
+	return found, nil
 }
 
 func (uc *skuUseCase) GetSKUsByProductID(ctx context.Context, productID uuid.UUID) ([]*domain.SKU, error) {
 	return uc.skuRepo.FindByProductID(ctx, productID)
 }
 
+// UpdateSKU validates new attributes against the attribute definitions of
+// the product's category. SKUs whose attributes are left unchanged are not
+// revalidated, so definitions added later do not block price updates.
 func (uc *skuUseCase) UpdateSKU(ctx context.Context, id uuid.UUID, input UpdateSKUInput) (*domain.SKU, error) {
 	sku, err := uc.skuRepo.FindByID(ctx, id)
 	if err != nil {
 		return nil, err
 	}
 
+	if input.Attributes != nil {
+		product, err := uc.productRepo.FindByID(ctx, sku.ProductID)
+		if err != nil {
+			return nil, err
+		}
+		if err := uc.validateAttributes(ctx, product, input.Attributes); err != nil {
+			return nil, err
+		}
+	}
+
 	skuCode := sku.SKUCode
 	if input.SKUCode != nil {
 		skuCode = *input.SKUCode
@@ -117,12 +332,24 @@ func (uc *skuUseCase) UpdateSKU(ctx context.Context, id uuid.UUID, input UpdateS
 		}
 	}
 
-	price := sku.Price
+	if sku.Prices, err = uc.skuRepo.FindPrices(ctx, id); err != nil {
+		return nil, err
+	}
+	before := append([]domain.Money{sku.Price}, sku.Prices...)
+	if input.Prices != nil {
+		sku.Prices = *input.Prices
+	}
+
+	amount, currency := sku.Price.Amount, sku.Price.Currency
 	if input.PriceAmount != nil {
-		price.Amount = *input.PriceAmount
+		amount = *input.PriceAmount
 	}
 	if input.PriceCurrency != nil {
-		price.Currency = *input.PriceCurrency
+		currency = *input.PriceCurrency
+	}
+	price, err := domain.NewMoney(amount, currency)
+	if err != nil {
+		return nil, err
 	}
 
 	attributes := sku.Attributes
@@ -130,16 +357,134 @@ func (uc *skuUseCase) UpdateSKU(ctx context.Context, id uuid.UUID, input UpdateS
 		attributes = input.Attributes
 	}
 
-	if err := sku.Update(skuCode, price, attributes); err != nil {
+	if err := sku.Update(skuCode, *price, attributes); err != nil {
 		return nil, err
 	}
+	if input.ValidateOnly {
+		return sku, nil
+	}
 
 	if err := uc.skuRepo.Update(ctx, sku); err != nil {
 		return nil, err
 	}
+	if input.Prices != nil {
+		if err := uc.skuRepo.ReplacePrices(ctx, id, sku.Prices); err != nil {
+			return nil, err
+		}
+	}
+
+	after := append([]domain.Money{sku.Price}, sku.Prices...)
+	changes := domain.PriceChangesBetween(id, before, after, input.Actor)
+	if err := uc.priceChangeRepo.Create(ctx, changes...); err != nil {
+		return nil, err
+	}
+	uc.publishPriceChanges(ctx, changes)
 	return sku, nil
 }
 
+// validateAttributes checks SKU attributes against the attribute
+// definitions of the product's category. Products without a category have
+// no definitions.
+func (uc *skuUseCase) validateAttributes(ctx context.Context, product *domain.Product, attributes map[string]string) error {
+	defs, err := uc.attributeDefinitions(ctx, product)
+	if err != nil {
+		return err
+	}
+	return domain.ValidateSKUAttributes(defs, attributes)
+}
+
+func (uc *skuUseCase) attributeDefinitions(ctx context.Context, product *domain.Product) ([]*domain.AttributeDefinition, error) {
+	if product.CategoryID == nil {
+		return nil, nil
+	}
+	return uc.attributeRepo.FindByCategoryID(ctx, *product.CategoryID)
+}
+
 func (uc *skuUseCase) DeleteSKU(ctx context.Context, id uuid.UUID) error {
 	return uc.skuRepo.SoftDelete(ctx, id)
 }
+
+// SchedulePriceChange records a price that the price change activator
+// applies once effective_from has passed. A price in a currency the SKU
+// has no price in yet counts towards domain.MaxSKUPrices.
+func (uc *skuUseCase) SchedulePriceChange(ctx context.Context, input SchedulePriceChangeInput) (*domain.PriceChange, error) {
+	sku, err := uc.skuRepo.FindByID(ctx, input.SKUID)
+	if err != nil {
+		return nil, err
+	}
+
+	change, err := domain.NewScheduledPriceChange(sku.ID, input.Price, input.EffectiveFrom, input.Actor)
+	if err != nil {
+		return nil, err
+	}
+
+	if change.Price.Currency != sku.Price.Currency {
+		prices, err := uc.skuRepo.FindPrices(ctx, sku.ID)
+		if err != nil {
+			return nil, err
+		}
+		sku.Prices = prices
+		if sku.PriceIn(change.Price.Currency) == sku.Price && len(prices) >= domain.MaxSKUPrices {
+			return nil, domain.ErrTooManyPrices
+		}
+	}
+
+	if err := uc.priceChangeRepo.Create(ctx, change); err != nil {
+		return nil, err
+	}
+	return change, nil
+}
+
+// GetPriceHistory pages through a SKU's price changes, scheduled ones
+// included, newest effective_from first.
+func (uc *skuUseCase) GetPriceHistory(ctx context.Context, input GetPriceHistoryInput) (*GetPriceHistoryOutput, error) {
+	if _, err := uc.skuRepo.FindByID(ctx, input.SKUID); err != nil {
+		return nil, err
+	}
+
+	pageSize := input.PageSize
+	if pageSize <= 0 {
+		pageSize = defaultPriceHistoryPageSize
+	}
+	if pageSize > maxPriceHistoryPageSize {
+		pageSize = maxPriceHistoryPageSize
+	}
+
+	// Fetch one extra row to know whether another page exists.
+	changes, err := uc.priceChangeRepo.ListBySKU(ctx, input.SKUID, pageSize+1, input.After)
+	if err != nil {
+		return nil, err
+	}
+
+	output := &GetPriceHistoryOutput{Changes: changes}
+	if len(changes) > pageSize {
+		output.Changes = changes[:pageSize]
+		last := changes[pageSize-1]
+		output.Next = &domain.PriceChangeCursor{EffectiveFrom: last.EffectiveFrom, ID: last.ID}
+	}
+	return output, nil
+}
+
+func (uc *skuUseCase) ApplyDuePriceChanges(ctx context.Context, limit int) ([]*domain.PriceChange, error) {
+	changes, err := uc.priceChangeRepo.ApplyDue(ctx, limit)
+	if err != nil {
+		return nil, err
+	}
+	uc.publishPriceChanges(ctx, changes)
+	return changes, nil
+}
+
+// publishPriceChanges publishes a PriceChanged event for every applied
+// change; cancelled ones did not change a price.
+func (uc *skuUseCase) publishPriceChanges(ctx context.Context, changes []*domain.PriceChange) {
+	for _, c := range changes {
+		if c.Status != domain.PriceChangeStatusApplied {
+			continue
+		}
+		publish(ctx, uc.events, EventSKUPriceChanged, priceChangedEvent{
+			SKUID:    c.SKUID,
+			Currency: c.Price.Currency,
+			Amount:   c.Price.Amount,
+		})
+	}
+}
@@ -0,0 +1,82 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/daisuke8000/example-ec-platform/services/product/internal/domain"
+)
+
+type VelocityUseCase interface {
+	GetSKUVelocity(ctx context.Context, input GetSKUVelocityInput) ([]*domain.SKUVelocity, error)
+}
+
+type GetSKUVelocityInput struct {
+	SKUIDs     []uuid.UUID
+	WindowDays []int
+}
+
+type velocityUseCase struct {
+	salesRepo      domain.SalesVelocityRepository
+	defaultWindows []int
+	maxBatchSize   int
+}
+
+func NewVelocityUseCase(
+	salesRepo domain.SalesVelocityRepository,
+	defaultWindows []int,
+	maxBatchSize int,
+) VelocityUseCase {
+	return &velocityUseCase{
+		salesRepo:      salesRepo,
+		defaultWindows: defaultWindows,
+		maxBatchSize:   maxBatchSize,
+	}
+}
+
+func (uc *velocityUseCase) GetSKUVelocity(ctx context.Context, input GetSKUVelocityInput) ([]*domain.SKUVelocity, error) {
+	if len(input.SKUIDs) == 0 {
+		return nil, domain.ErrInvalidQuantity
+	}
+	if len(input.SKUIDs) > uc.maxBatchSize {
+		return nil, domain.ErrBatchSizeExceeded
+	}
+
+	windows := input.WindowDays
+	if len(windows) == 0 {
+		windows = uc.defaultWindows
+	}
+	for _, days := range windows {
+		if days < 1 || days > domain.MaxVelocityWindowDays {
+			return nil, domain.ErrInvalidVelocityWindow
+		}
+	}
+
+	velocities := make([]*domain.SKUVelocity, len(input.SKUIDs))
+	for i, skuID := range input.SKUIDs {
+		velocities[i] = &domain.SKUVelocity{
+			SKUID:   skuID,
+			Windows: make([]domain.VelocityWindow, len(windows)),
+		}
+	}
+
+	now := time.Now().UTC()
+	for w, days := range windows {
+		since := now.AddDate(0, 0, -days)
+		sums, err := uc.salesRepo.SumConfirmedQuantities(ctx, input.SKUIDs, since)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range velocities {
+			v.Windows[w] = domain.VelocityWindow{
+				Days:      days,
+				UnitsSold: sums[v.SKUID],
+			}
+		}
+	}
+
+	return velocities, nil
+}
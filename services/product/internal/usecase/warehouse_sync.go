@@ -0,0 +1,227 @@
+package usecase
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/daisuke8000/example-ec-platform/services/product/internal/domain"
+)
+
+const (
+	defaultWarehousePageSize = 100
+	maxWarehousePageSize     = 1000
+	maxIdempotencyKeyLength  = 256
+)
+
+// WarehouseSyncUseCase is the integration surface for third-party logistics
+// (3PL) providers: SKU identifier mappings, a pull feed of stock changes and
+// pushed warehouse adjustments.
+type WarehouseSyncUseCase interface {
+	SetMappings(ctx context.Context, provider string, mappings []ExternalSKU) ([]*domain.ExternalSKUMapping, error)
+	ListMappings(ctx context.Context, provider string, pageSize int, pageToken string) ([]*domain.ExternalSKUMapping, string, error)
+	DeleteMapping(ctx context.Context, provider, externalSKU string) error
+	ListStockChanges(ctx context.Context, input ListStockChangesInput) (*ListStockChangesOutput, error)
+	PushAdjustments(ctx context.Context, input PushAdjustmentsInput) (*domain.AppliedAdjustments, error)
+}
+
+type ExternalSKU struct {
+	ExternalSKU string
+	SKUID       uuid.UUID
+}
+
+type ListStockChangesInput struct {
+	Provider string
+	// Cursor is the NextCursor of a previous call; empty starts from the
+	// beginning of the feed.
+	Cursor   string
+	PageSize int
+}
+
+type ListStockChangesOutput struct {
+	Changes []*domain.StockChange
+	// NextCursor resumes the feed after Changes. It equals the input
+	// cursor when there are no new changes.
+	NextCursor string
+}
+
+type PushAdjustmentsInput struct {
+	Provider       string
+	IdempotencyKey string
+	Adjustments    []domain.WarehouseAdjustment
+}
+
+type warehouseSyncUseCase struct {
+	repo   domain.WarehouseSyncRepository
+	cache  InventoryCache
+	events EventPublisher
+	now    func() time.Time
+}
+
+func NewWarehouseSyncUseCase(repo domain.WarehouseSyncRepository, cache InventoryCache, events EventPublisher) WarehouseSyncUseCase {
+	return &warehouseSyncUseCase{
+		repo:   repo,
+		cache:  cache,
+		events: events,
+		now:    time.Now,
+	}
+}
+
+func (uc *warehouseSyncUseCase) SetMappings(ctx context.Context, provider string, mappings []ExternalSKU) ([]*domain.ExternalSKUMapping, error) {
+	if err := domain.ValidateProvider(provider); err != nil {
+		return nil, err
+	}
+	if len(mappings) == 0 {
+		return nil, domain.ErrEmptyBatch
+	}
+	if len(mappings) > domain.MaxWarehouseBatch {
+		return nil, domain.ErrBatchSizeExceeded
+	}
+
+	now := uc.now().UTC()
+	seen := make(map[string]bool, len(mappings))
+	result := make([]*domain.ExternalSKUMapping, len(mappings))
+	for i, m := range mappings {
+		if err := domain.ValidateExternalSKU(m.ExternalSKU); err != nil {
+			return nil, err
+		}
+		if seen[m.ExternalSKU] {
+			return nil, domain.ErrDuplicateBatchSKU
+		}
+		seen[m.ExternalSKU] = true
+		result[i] = &domain.ExternalSKUMapping{
+			Provider:    provider,
+			ExternalSKU: m.ExternalSKU,
+			SKUID:       m.SKUID,
+			CreatedAt:   now,
+		}
+	}
+
+	if err := uc.repo.UpsertMappings(ctx, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// ListMappings pages through a provider's mappings by external SKU. The
+// page token is the external SKU of the last mapping of the previous page.
+func (uc *warehouseSyncUseCase) ListMappings(ctx context.Context, provider string, pageSize int, pageToken string) ([]*domain.ExternalSKUMapping, string, error) {
+	if err := domain.ValidateProvider(provider); err != nil {
+		return nil, "", err
+	}
+	pageSize = warehousePageSize(pageSize)
+
+	// Fetch one extra row to know whether another page exists.
+	mappings, err := uc.repo.ListMappings(ctx, provider, pageSize+1, pageToken)
+	if err != nil {
+		return nil, "", err
+	}
+
+	var nextPageToken string
+	if len(mappings) > pageSize {
+		mappings = mappings[:pageSize]
+		nextPageToken = mappings[pageSize-1].ExternalSKU
+	}
+	return mappings, nextPageToken, nil
+}
+
+func (uc *warehouseSyncUseCase) DeleteMapping(ctx context.Context, provider, externalSKU string) error {
+	if err := domain.ValidateProvider(provider); err != nil {
+		return err
+	}
+	return uc.repo.DeleteMapping(ctx, provider, externalSKU)
+}
+
+// ListStockChanges returns movements of the provider's mapped SKUs after
+// the cursor, which is the ID of the last movement returned. Movements
+// younger than domain.StockChangeSettleDelay are held back so the cursor
+// never skips a movement committed out of ID order.
+func (uc *warehouseSyncUseCase) ListStockChanges(ctx context.Context, input ListStockChangesInput) (*ListStockChangesOutput, error) {
+	if err := domain.ValidateProvider(input.Provider); err != nil {
+		return nil, err
+	}
+
+	var afterID int64
+	if input.Cursor != "" {
+		id, err := strconv.ParseInt(input.Cursor, 10, 64)
+		if err != nil || id < 0 {
+			return nil, domain.ErrInvalidPageToken
+		}
+		afterID = id
+	}
+
+	createdBefore := uc.now().Add(-domain.StockChangeSettleDelay)
+	changes, err := uc.repo.ListStockChanges(ctx, input.Provider, afterID, createdBefore, warehousePageSize(input.PageSize))
+	if err != nil {
+		return nil, err
+	}
+
+	output := &ListStockChangesOutput{Changes: changes, NextCursor: input.Cursor}
+	if len(changes) > 0 {
+		output.NextCursor = strconv.FormatInt(changes[len(changes)-1].Movement.ID, 10)
+	}
+	return output, nil
+}
+
+// PushAdjustments applies a provider's adjustments all or nothing. A push
+// whose idempotency key was already applied changes nothing and reports
+// the original push as replayed.
+func (uc *warehouseSyncUseCase) PushAdjustments(ctx context.Context, input PushAdjustmentsInput) (*domain.AppliedAdjustments, error) {
+	if err := domain.ValidateProvider(input.Provider); err != nil {
+		return nil, err
+	}
+	if input.IdempotencyKey == "" || len(input.IdempotencyKey) > maxIdempotencyKeyLength {
+		return nil, domain.ErrInvalidIdempotencyKey
+	}
+	if len(input.Adjustments) == 0 {
+		return nil, domain.ErrEmptyBatch
+	}
+	if len(input.Adjustments) > domain.MaxWarehouseBatch {
+		return nil, domain.ErrBatchSizeExceeded
+	}
+
+	seen := make(map[string]bool, len(input.Adjustments))
+	for _, a := range input.Adjustments {
+		if err := domain.ValidateExternalSKU(a.ExternalSKU); err != nil {
+			return nil, err
+		}
+		if a.QuantityDelta == 0 {
+			return nil, domain.ErrInvalidQuantityDelta
+		}
+		if seen[a.ExternalSKU] {
+			return nil, domain.ErrDuplicateBatchSKU
+		}
+		seen[a.ExternalSKU] = true
+	}
+
+	applied, err := uc.repo.ApplyAdjustments(ctx, input.Provider, input.IdempotencyKey, input.Adjustments, domain.MovementSource{
+		Reason: domain.MovementReasonAdjustment,
+		Actor:  "3pl:" + input.Provider,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if applied.Replayed {
+		return applied, nil
+	}
+
+	_ = uc.cache.Invalidate(context.WithoutCancel(ctx), applied.SKUIDs...)
+	for i, skuID := range applied.SKUIDs {
+		delta := input.Adjustments[i].QuantityDelta
+		publish(ctx, uc.events, EventInventoryUpdated, inventoryEvent{
+			SKUID:         skuID,
+			Reason:        domain.MovementReasonAdjustment,
+			QuantityDelta: &delta,
+		})
+	}
+	return applied, nil
+}
+
+func warehousePageSize(pageSize int) int {
+	if pageSize <= 0 {
+		return defaultWarehousePageSize
+	}
+	return min(pageSize, maxWarehousePageSize)
+}
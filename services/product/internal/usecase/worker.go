@@ -0,0 +1,86 @@
+package usecase
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/daisuke8000/example-ec-platform/services/product/internal/domain"
+)
+
+// WorkerUseCase lets on-call pause and resume background workers
+// without redeploying. Pauses are stored, so they apply to every instance
+// and survive restarts.
+type WorkerUseCase interface {
+	ListWorkers(ctx context.Context) ([]*domain.WorkerStatus, error)
+	// PauseWorker keeps an existing pause of the worker.
+	PauseWorker(ctx context.Context, name, reason, actor string) (*domain.WorkerStatus, error)
+	ResumeWorker(ctx context.Context, name string) (*domain.WorkerStatus, error)
+}
+
+type workerUseCase struct {
+	pauses  domain.WorkerPauseRepository
+	workers []string
+	now     func() time.Time
+}
+
+// NewWorkerUseCase manages pauses of the named workers.
+func NewWorkerUseCase(pauses domain.WorkerPauseRepository, workers []string) WorkerUseCase {
+	workers = slices.Clone(workers)
+	slices.Sort(workers)
+	return &workerUseCase{
+		pauses:  pauses,
+		workers: workers,
+		now:     time.Now,
+	}
+}
+
+func (uc *workerUseCase) ListWorkers(ctx context.Context) ([]*domain.WorkerStatus, error) {
+	pauses, err := uc.pauses.FindAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+	statuses := make([]*domain.WorkerStatus, len(uc.workers))
+	for i, name := range uc.workers {
+		statuses[i] = &domain.WorkerStatus{Name: name}
+		for _, p := range pauses {
+			if p.Worker == name {
+				statuses[i].Pause = p
+			}
+		}
+	}
+	return statuses, nil
+}
+
+func (uc *workerUseCase) PauseWorker(ctx context.Context, name, reason, actor string) (*domain.WorkerStatus, error) {
+	if !slices.Contains(uc.workers, name) {
+		return nil, domain.ErrWorkerNotFound
+	}
+	reason = strings.TrimSpace(reason)
+	if reason == "" || utf8.RuneCountInString(reason) > domain.MaxPauseReasonLength {
+		return nil, domain.ErrInvalidPauseReason
+	}
+
+	pause, err := uc.pauses.Pause(ctx, &domain.WorkerPause{
+		Worker:   name,
+		Reason:   reason,
+		PausedBy: actor,
+		PausedAt: uc.now().UTC(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &domain.WorkerStatus{Name: name, Pause: pause}, nil
+}
+
+func (uc *workerUseCase) ResumeWorker(ctx context.Context, name string) (*domain.WorkerStatus, error) {
+	if !slices.Contains(uc.workers, name) {
+		return nil, domain.ErrWorkerNotFound
+	}
+	if err := uc.pauses.Resume(ctx, name); err != nil {
+		return nil, err
+	}
+	return &domain.WorkerStatus{Name: name}, nil
+}
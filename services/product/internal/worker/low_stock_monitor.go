@@ -0,0 +1,75 @@
+package worker
+
+import (
+	"context"
+	"log/slog"
+	"time"
+)
+
+// LowStockAlerter publishes alerts for SKUs that became low on stock.
+type LowStockAlerter interface {
+	EmitAlerts(ctx context.Context, limit int) (int, error)
+}
+
+// LowStockMonitor periodically scans inventory and emits a LowStock event
+// for each SKU that dropped below its low-stock threshold.
+type LowStockMonitor struct {
+	alerter   LowStockAlerter
+	pauses    PauseChecker
+	logger    *slog.Logger
+	interval  time.Duration
+	batchSize int
+}
+
+func NewLowStockMonitor(
+	alerter LowStockAlerter,
+	pauses PauseChecker,
+	logger *slog.Logger,
+	interval time.Duration,
+	batchSize int,
+) *LowStockMonitor {
+	return &LowStockMonitor{
+		alerter:   alerter,
+		pauses:    pauses,
+		logger:    logger,
+		interval:  interval,
+		batchSize: batchSize,
+	}
+}
+
+func (w *LowStockMonitor) Start(ctx context.Context) {
+	w.logger.Info("low stock monitor starting", "interval", w.interval)
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			w.logger.Info("low stock monitor shutting down")
+			return
+		case <-ticker.C:
+			if !isPaused(ctx, w.pauses, LowStockMonitorName, w.logger) {
+				w.scan(ctx)
+			}
+		}
+	}
+}
+
+// scan emits alerts batch by batch until none are left.
+func (w *LowStockMonitor) scan(ctx context.Context) {
+	total := 0
+	for ctx.Err() == nil {
+		n, err := w.alerter.EmitAlerts(ctx, w.batchSize)
+		if err != nil {
+			w.logger.Error("failed to emit low stock alerts", "error", err)
+			return
+		}
+		total += n
+		if n < w.batchSize {
+			break
+		}
+	}
+	if total > 0 {
+		w.logger.Info("low stock alerts emitted", "count", total)
+	}
+}
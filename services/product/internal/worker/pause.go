@@ -0,0 +1,41 @@
+package worker
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/daisuke8000/example-ec-platform/services/product/internal/domain"
+)
+
+// Names under which operators pause the workers.
+const (
+	ReservationExpirerName   = "reservation-expirer"
+	PriceChangeActivatorName = "price-change-activator"
+	LowStockMonitorName      = "low-stock-monitor"
+)
+
+// Names lists every worker that can be paused.
+var Names = []string{ReservationExpirerName, PriceChangeActivatorName, LowStockMonitorName}
+
+// PauseChecker looks up whether operators paused a worker.
+type PauseChecker interface {
+	Find(ctx context.Context, worker string) (*domain.WorkerPause, error)
+}
+
+// isPaused reports whether the worker should skip this tick. The tick runs
+// when the check fails: a pause is an operational aid, not an interlock.
+func isPaused(ctx context.Context, pauses PauseChecker, name string, logger *slog.Logger) bool {
+	if pauses == nil {
+		return false
+	}
+	pause, err := pauses.Find(ctx, name)
+	if err != nil {
+		logger.Warn("failed to check worker pause", "error", err)
+		return false
+	}
+	if pause != nil {
+		logger.Debug("worker paused, skipping tick", "paused_by", pause.PausedBy, "reason", pause.Reason)
+		return true
+	}
+	return false
+}
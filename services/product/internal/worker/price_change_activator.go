@@ -0,0 +1,84 @@
+package worker
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/daisuke8000/example-ec-platform/services/product/internal/domain"
+)
+
+// PriceChangeApplier applies scheduled price changes and announces them.
+type PriceChangeApplier interface {
+	ApplyDuePriceChanges(ctx context.Context, limit int) ([]*domain.PriceChange, error)
+}
+
+// PriceChangeActivator applies scheduled price changes once their
+// effective_from has passed.
+type PriceChangeActivator struct {
+	applier   PriceChangeApplier
+	pauses    PauseChecker
+	logger    *slog.Logger
+	interval  time.Duration
+	batchSize int
+}
+
+func NewPriceChangeActivator(
+	applier PriceChangeApplier,
+	pauses PauseChecker,
+	logger *slog.Logger,
+	interval time.Duration,
+	batchSize int,
+) *PriceChangeActivator {
+	return &PriceChangeActivator{
+		applier:   applier,
+		pauses:    pauses,
+		logger:    logger,
+		interval:  interval,
+		batchSize: batchSize,
+	}
+}
+
+func (w *PriceChangeActivator) Start(ctx context.Context) {
+	w.logger.Info("price change activator starting", "interval", w.interval)
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			w.logger.Info("price change activator shutting down")
+			return
+		case <-ticker.C:
+			if !isPaused(ctx, w.pauses, PriceChangeActivatorName, w.logger) {
+				w.processDue(ctx)
+			}
+		}
+	}
+}
+
+// processDue applies due changes batch by batch until none are left, so a
+// backlog after downtime is cleared within one tick.
+func (w *PriceChangeActivator) processDue(ctx context.Context) {
+	for ctx.Err() == nil {
+		changes, err := w.applier.ApplyDuePriceChanges(ctx, w.batchSize)
+		if err != nil {
+			w.logger.Error("failed to apply price changes", "error", err)
+			return
+		}
+
+		for _, c := range changes {
+			w.logger.Info("price change processed",
+				"price_change_id", c.ID,
+				"sku_id", c.SKUID,
+				"currency", c.Price.Currency,
+				"amount", c.Price.Amount,
+				"status", c.Status,
+			)
+		}
+
+		if len(changes) < w.batchSize {
+			return
+		}
+	}
+}
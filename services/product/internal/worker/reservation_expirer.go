@@ -5,17 +5,49 @@ import (
 	"log/slog"
 	"time"
 
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+
 	"github.com/daisuke8000/example-ec-platform/services/product/internal/domain"
 )
 
+// expirerActor identifies the worker in the inventory movement audit trail.
+const expirerActor = "system:reservation-expirer"
+
 type TxManager interface {
-	Do(ctx context.Context, fn func(ctx context.Context) error) error
+	DoWithTx(ctx context.Context, fn func(ctx context.Context, tx pgx.Tx) error) error
+}
+
+// ExpiredReservationRepository claims and expires reservations past their TTL.
+type ExpiredReservationRepository interface {
+	ClaimExpiredPendingWithTx(ctx context.Context, tx pgx.Tx, limit int) ([]*domain.Reservation, error)
+	ExpirePendingWithTx(ctx context.Context, tx pgx.Tx, id uuid.UUID) error
+}
+
+// ReservedStockReleaser returns reserved stock to the available quantity.
+type ReservedStockReleaser interface {
+	ReleaseReservationWithTx(ctx context.Context, tx pgx.Tx, skuID uuid.UUID, amount int64, src domain.MovementSource) error
+}
+
+// InventoryCacheInvalidator drops cached inventory for SKUs whose stock changed.
+type InventoryCacheInvalidator interface {
+	Invalidate(ctx context.Context, skuIDs ...uuid.UUID) error
 }
 
+// ReservationExpirer expires pending reservations past their TTL and
+// releases their stock.
+//
+// Each batch is claimed with FOR UPDATE SKIP LOCKED, and a reservation is
+// marked expired and its stock released in the same transaction, guarded on
+// its pending status. Concurrent expirers therefore claim disjoint batches,
+// a crash rolls the whole batch back, and a reservation confirmed or
+// released in the meantime is left alone: no reservation is released twice.
 type ReservationExpirer struct {
 	txManager       TxManager
-	reservationRepo domain.ReservationRepository
-	inventoryRepo   domain.InventoryRepository
+	reservationRepo ExpiredReservationRepository
+	inventoryRepo   ReservedStockReleaser
+	inventoryCache  InventoryCacheInvalidator
+	pauses          PauseChecker
 	logger          *slog.Logger
 	interval        time.Duration
 	batchSize       int
@@ -23,8 +55,10 @@ type ReservationExpirer struct {
 
 func NewReservationExpirer(
 	txManager TxManager,
-	reservationRepo domain.ReservationRepository,
-	inventoryRepo domain.InventoryRepository,
+	reservationRepo ExpiredReservationRepository,
+	inventoryRepo ReservedStockReleaser,
+	inventoryCache InventoryCacheInvalidator,
+	pauses PauseChecker,
 	logger *slog.Logger,
 	interval time.Duration,
 	batchSize int,
@@ -33,6 +67,8 @@ func NewReservationExpirer(
 		txManager:       txManager,
 		reservationRepo: reservationRepo,
 		inventoryRepo:   inventoryRepo,
+		inventoryCache:  inventoryCache,
+		pauses:          pauses,
 		logger:          logger,
 		interval:        interval,
 		batchSize:       batchSize,
@@ -50,49 +86,97 @@ func (w *ReservationExpirer) Start(ctx context.Context) {
 			w.logger.Info("reservation expirer shutting down")
 			return
 		case <-ticker.C:
-			w.processExpired(ctx)
+			if !isPaused(ctx, w.pauses, ReservationExpirerName, w.logger) {
+				w.processExpired(ctx)
+			}
 		}
 	}
 }
 
+// processExpired expires reservations batch by batch until none are left,
+// so a backlog after downtime is cleared within one tick.
 func (w *ReservationExpirer) processExpired(ctx context.Context) {
-	reservations, err := w.reservationRepo.FindExpiredPending(ctx, w.batchSize)
-	if err != nil {
-		w.logger.Error("failed to find expired reservations", "error", err)
-		return
-	}
-
-	if len(reservations) == 0 {
-		return
-	}
-
-	for _, res := range reservations {
-		if ctx.Err() != nil {
-			w.logger.Info("context cancelled, stopping process loop")
+	for ctx.Err() == nil {
+		claimed, expired, err := w.expireBatch(ctx)
+		if err != nil {
+			w.logger.Error("failed to expire reservations", "error", err)
 			return
 		}
 
-		logger := w.logger.With("reservation_id", res.ID)
+		var skuIDs []uuid.UUID
+		for _, res := range expired {
+			skuIDs = append(skuIDs, res.SKUIDs()...)
+			w.logger.Info("expired reservation successfully", "reservation_id", res.ID)
+		}
+		if len(skuIDs) > 0 {
+			if cacheErr := w.inventoryCache.Invalidate(ctx, skuIDs...); cacheErr != nil {
+				w.logger.Warn("failed to invalidate inventory cache", "error", cacheErr)
+			}
+		}
 
-		err := w.txManager.Do(ctx, func(txCtx context.Context) error {
-			return w.expireReservation(txCtx, res)
-		})
+		// Stop once the backlog is drained, or when no reservation of the
+		// batch could be expired; those stay pending for the next tick.
+		if claimed < w.batchSize || len(expired) == 0 {
+			return
+		}
+	}
+}
 
+// expireBatch claims one batch and expires its reservations in a single
+// transaction. It returns the number claimed and the reservations expired.
+func (w *ReservationExpirer) expireBatch(ctx context.Context) (int, []*domain.Reservation, error) {
+	var claimed int
+	var expired []*domain.Reservation
+	err := w.txManager.DoWithTx(ctx, func(ctx context.Context, tx pgx.Tx) error {
+		reservations, err := w.reservationRepo.ClaimExpiredPendingWithTx(ctx, tx, w.batchSize)
 		if err != nil {
-			logger.Error("failed to expire reservation", "error", err)
-			continue
+			return err
 		}
-
-		logger.Info("expired reservation successfully")
+		claimed = len(reservations)
+
+		for _, res := range reservations {
+			// A savepoint per reservation keeps one that cannot be released
+			// (e.g. inconsistent reserved stock) from failing the batch.
+			sp, err := tx.Begin(ctx)
+			if err != nil {
+				return err
+			}
+			if err := w.expireReservation(ctx, sp, res); err != nil {
+				w.logger.Error("failed to expire reservation", "reservation_id", res.ID, "error", err)
+				if err := sp.Rollback(ctx); err != nil {
+					return err
+				}
+				continue
+			}
+			if err := sp.Commit(ctx); err != nil {
+				return err
+			}
+			expired = append(expired, res)
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, nil, err
 	}
+	return claimed, expired, nil
 }
 
-func (w *ReservationExpirer) expireReservation(ctx context.Context, res *domain.Reservation) error {
+func (w *ReservationExpirer) expireReservation(ctx context.Context, tx pgx.Tx, res *domain.Reservation) error {
+	// The status is updated first: if the reservation is no longer pending
+	// nothing is released.
+	if err := w.reservationRepo.ExpirePendingWithTx(ctx, tx, res.ID); err != nil {
+		return err
+	}
+
+	src := domain.MovementSource{
+		Reason:        domain.MovementReasonExpire,
+		Actor:         expirerActor,
+		ReservationID: &res.ID,
+	}
 	for _, item := range res.Items {
-		if err := w.inventoryRepo.ReleaseReservation(ctx, item.SKUID, item.Quantity); err != nil {
+		if err := w.inventoryRepo.ReleaseReservationWithTx(ctx, tx, item.SKUID, item.Quantity, src); err != nil {
 			return err
 		}
 	}
-
-	return w.reservationRepo.UpdateStatus(ctx, res.ID, domain.ReservationStatusExpired)
+	return nil
 }
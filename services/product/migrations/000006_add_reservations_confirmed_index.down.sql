@@ -0,0 +1,5 @@
+-- ==============================================================================
+-- Rollback: Drop confirmed reservations index
+-- ==============================================================================
+
+DROP INDEX IF EXISTS product_service.idx_reservations_confirmed_updated;
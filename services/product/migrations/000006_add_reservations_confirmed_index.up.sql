@@ -0,0 +1,10 @@
+-- ==============================================================================
+-- Migration: Add index for confirmed reservations
+-- Product Service - Sales velocity aggregation (GetSKUVelocity)
+-- ==============================================================================
+
+-- Partial index for velocity queries: CONFIRMED reservations by confirmation time.
+-- CONFIRMED is a final state, so updated_at records when the sale was confirmed.
+CREATE INDEX IF NOT EXISTS idx_reservations_confirmed_updated
+    ON product_service.reservations(updated_at)
+    WHERE status = 1;  -- CONFIRMED only
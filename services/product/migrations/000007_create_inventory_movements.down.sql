@@ -0,0 +1,5 @@
+-- ==============================================================================
+-- Rollback: Drop inventory_movements table
+-- ==============================================================================
+
+DROP TABLE IF EXISTS product_service.inventory_movements CASCADE;
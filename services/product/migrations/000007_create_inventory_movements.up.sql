@@ -0,0 +1,27 @@
+-- ==============================================================================
+-- Migration: Create inventory_movements table
+-- Product Service - Inventory audit trail
+-- ==============================================================================
+
+-- Append-only log of every change to product_service.inventory.
+-- sku_id is intentionally not a foreign key so the trail survives SKU deletion.
+CREATE TABLE IF NOT EXISTS product_service.inventory_movements (
+    id BIGSERIAL PRIMARY KEY,
+    sku_id UUID NOT NULL,
+    reason VARCHAR(32) NOT NULL,           -- adjustment, reserve, confirm, release, expire
+    actor VARCHAR(255) NOT NULL DEFAULT '', -- x-user-id of the caller, or system:<component>
+    reservation_id UUID,                   -- Set for reservation-driven movements
+    quantity_delta BIGINT NOT NULL,
+    reserved_delta BIGINT NOT NULL,
+    quantity_after BIGINT NOT NULL,
+    reserved_after BIGINT NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+-- Index for per-SKU history, newest first (ListInventoryMovements)
+CREATE INDEX IF NOT EXISTS idx_inventory_movements_sku_id
+    ON product_service.inventory_movements(sku_id, id DESC);
+
+COMMENT ON TABLE product_service.inventory_movements IS 'Audit trail of inventory changes';
+COMMENT ON COLUMN product_service.inventory_movements.quantity_after IS 'Inventory quantity after the movement';
+COMMENT ON COLUMN product_service.inventory_movements.reserved_after IS 'Reserved quantity after the movement';
@@ -0,0 +1,5 @@
+-- ==============================================================================
+-- Rollback: Drop operations table
+-- ==============================================================================
+
+DROP TABLE IF EXISTS product_service.operations CASCADE;
@@ -0,0 +1,26 @@
+-- ==============================================================================
+-- Migration: Create operations table
+-- Product Service - Long-running operations (see pkg/operations)
+-- ==============================================================================
+
+CREATE TABLE IF NOT EXISTS product_service.operations (
+    id UUID PRIMARY KEY,
+    kind VARCHAR(64) NOT NULL,             -- e.g. product_import
+    status VARCHAR(16) NOT NULL,           -- pending, running, succeeded, failed, cancelled
+    progress SMALLINT NOT NULL DEFAULT 0,  -- 0-100
+    result JSONB,
+    error_code VARCHAR(32),
+    error_message TEXT,
+    error_details JSONB,
+    created_by VARCHAR(255) NOT NULL DEFAULT '',
+    cancel_requested BOOLEAN NOT NULL DEFAULT FALSE,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    done_at TIMESTAMPTZ
+);
+
+-- Index for ListOperations (keyset pagination, newest first)
+CREATE INDEX IF NOT EXISTS idx_operations_created_at_id
+    ON product_service.operations(created_at DESC, id DESC);
+
+COMMENT ON TABLE product_service.operations IS 'Status of asynchronous jobs served by OperationsService';
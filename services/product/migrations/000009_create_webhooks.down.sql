@@ -0,0 +1,6 @@
+-- ==============================================================================
+-- Rollback: Drop webhook tables
+-- ==============================================================================
+
+DROP TABLE IF EXISTS product_service.webhook_deliveries CASCADE;
+DROP TABLE IF EXISTS product_service.webhook_endpoints CASCADE;
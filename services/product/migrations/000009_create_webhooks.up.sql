@@ -0,0 +1,52 @@
+-- ==============================================================================
+-- Migration: Create webhook tables
+-- Product Service - Merchant webhook endpoints and deliveries (see pkg/webhook)
+-- ==============================================================================
+
+CREATE TABLE IF NOT EXISTS product_service.webhook_endpoints (
+    id UUID PRIMARY KEY,
+    url TEXT NOT NULL,
+    secret VARCHAR(64) NOT NULL,             -- HMAC-SHA256 signing key
+    event_types TEXT[] NOT NULL DEFAULT '{}', -- Empty receives every event type
+    description VARCHAR(255) NOT NULL DEFAULT '',
+    active BOOLEAN NOT NULL DEFAULT TRUE,
+    created_by VARCHAR(255) NOT NULL DEFAULT '',
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+-- Index for ListEndpoints (keyset pagination, newest first)
+CREATE INDEX IF NOT EXISTS idx_webhook_endpoints_created_at_id
+    ON product_service.webhook_endpoints(created_at DESC, id DESC);
+
+CREATE TABLE IF NOT EXISTS product_service.webhook_deliveries (
+    id UUID PRIMARY KEY,
+    endpoint_id UUID NOT NULL REFERENCES product_service.webhook_endpoints(id) ON DELETE CASCADE,
+    event_id UUID NOT NULL,
+    event_type VARCHAR(64) NOT NULL,        -- e.g. product.updated
+    payload JSONB NOT NULL,                 -- Event envelope sent as the request body
+    status VARCHAR(16) NOT NULL,            -- pending, succeeded, dead
+    attempts INTEGER NOT NULL DEFAULT 0,
+    last_status_code INTEGER NOT NULL DEFAULT 0,
+    last_error TEXT NOT NULL DEFAULT '',
+    next_attempt_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    delivered_at TIMESTAMPTZ
+);
+
+-- Partial index for the dispatcher's due-delivery scan
+CREATE INDEX IF NOT EXISTS idx_webhook_deliveries_due
+    ON product_service.webhook_deliveries(next_attempt_at)
+    WHERE status = 'pending';
+
+-- Index for ListDeliveries by endpoint (keyset pagination, newest first)
+CREATE INDEX IF NOT EXISTS idx_webhook_deliveries_endpoint_created_at
+    ON product_service.webhook_deliveries(endpoint_id, created_at DESC, id DESC);
+
+-- Index for ListDeliveries across endpoints
+CREATE INDEX IF NOT EXISTS idx_webhook_deliveries_created_at_id
+    ON product_service.webhook_deliveries(created_at DESC, id DESC);
+
+COMMENT ON TABLE product_service.webhook_endpoints IS 'Merchant endpoints receiving webhook events';
+COMMENT ON TABLE product_service.webhook_deliveries IS 'Webhook deliveries with retry state; status dead is the dead-letter queue';
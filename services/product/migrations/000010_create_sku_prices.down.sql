@@ -0,0 +1,5 @@
+-- ==============================================================================
+-- Rollback: Drop SKU prices table
+-- ==============================================================================
+
+DROP TABLE IF EXISTS product_service.sku_prices CASCADE;
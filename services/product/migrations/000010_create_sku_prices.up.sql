@@ -0,0 +1,24 @@
+-- ==============================================================================
+-- Migration: Create SKU prices table
+-- Product Service - Prices of a SKU in additional currencies
+-- ==============================================================================
+
+-- Additional currency prices of a SKU. The base price stays on skus;
+-- this table holds at most one price per other currency.
+CREATE TABLE IF NOT EXISTS product_service.sku_prices (
+    sku_id UUID NOT NULL REFERENCES product_service.skus(id) ON DELETE CASCADE,
+    currency VARCHAR(3) NOT NULL,           -- ISO 4217
+    amount BIGINT NOT NULL,                 -- Smallest currency unit (cents, yen)
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+
+    PRIMARY KEY (sku_id, currency),
+
+    -- Price must be non-negative
+    CONSTRAINT chk_sku_prices_amount_positive CHECK (amount >= 0),
+
+    -- Currency codes are three upper-case letters
+    CONSTRAINT chk_sku_prices_currency_format CHECK (currency ~ '^[A-Z]{3}$')
+);
+
+COMMENT ON TABLE product_service.sku_prices IS 'SKU prices in currencies other than the base price';
+COMMENT ON COLUMN product_service.sku_prices.amount IS 'Price in smallest currency unit (cents, yen)';
@@ -0,0 +1,5 @@
+-- ==============================================================================
+-- Rollback: Drop price_changes table
+-- ==============================================================================
+
+DROP TABLE IF EXISTS product_service.price_changes CASCADE;
@@ -0,0 +1,38 @@
+-- ==============================================================================
+-- Migration: Create price_changes table
+-- Product Service - Price history and scheduled price changes
+-- ==============================================================================
+
+-- Every price change of a SKU, in any currency. Changes made through
+-- UpdateSKU are recorded as applied; changes made through
+-- SchedulePriceChange stay scheduled until effective_from has passed and
+-- the price change activator applies them.
+CREATE TABLE IF NOT EXISTS product_service.price_changes (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    sku_id UUID NOT NULL REFERENCES product_service.skus(id) ON DELETE CASCADE,
+    currency VARCHAR(3) NOT NULL,           -- ISO 4217
+    amount BIGINT NOT NULL,                 -- Smallest currency unit (cents, yen)
+    previous_amount BIGINT,                 -- Price replaced; NULL for a new currency or until applied
+    effective_from TIMESTAMPTZ NOT NULL,
+    status VARCHAR(16) NOT NULL,            -- scheduled, applied, cancelled
+    actor VARCHAR(255) NOT NULL DEFAULT '', -- x-user-id of the caller
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    applied_at TIMESTAMPTZ,
+
+    -- Price must be non-negative
+    CONSTRAINT chk_price_changes_amount_positive CHECK (amount >= 0),
+
+    CONSTRAINT chk_price_changes_status CHECK (status IN ('scheduled', 'applied', 'cancelled'))
+);
+
+-- Index for the activator: due scheduled changes in order
+CREATE INDEX IF NOT EXISTS idx_price_changes_due
+    ON product_service.price_changes(effective_from)
+    WHERE status = 'scheduled';
+
+-- Index for per-SKU history, newest first (GetPriceHistory)
+CREATE INDEX IF NOT EXISTS idx_price_changes_sku
+    ON product_service.price_changes(sku_id, effective_from DESC, id DESC);
+
+COMMENT ON TABLE product_service.price_changes IS 'Applied and scheduled SKU price changes';
+COMMENT ON COLUMN product_service.price_changes.effective_from IS 'When the price takes effect';
@@ -0,0 +1,5 @@
+-- ==============================================================================
+-- Rollback: Drop product_images table
+-- ==============================================================================
+
+DROP TABLE IF EXISTS product_service.product_images CASCADE;
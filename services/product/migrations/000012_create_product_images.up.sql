@@ -0,0 +1,34 @@
+-- ==============================================================================
+-- Migration: Create product_images table
+-- Product Service - Product images in object storage
+-- ==============================================================================
+
+-- Images of a product. The files live in object storage under object_key
+-- and are uploaded by clients through presigned URLs; a row stays pending
+-- until the upload is reported complete and verified.
+CREATE TABLE IF NOT EXISTS product_service.product_images (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    product_id UUID NOT NULL REFERENCES product_service.products(id) ON DELETE CASCADE,
+    object_key VARCHAR(255) NOT NULL,
+    content_type VARCHAR(64) NOT NULL,
+    size_bytes BIGINT NOT NULL DEFAULT 0,
+    alt_text VARCHAR(500) NOT NULL DEFAULT '',
+    position INTEGER NOT NULL DEFAULT 0,    -- Display order among ready images, 0 first
+    status VARCHAR(16) NOT NULL,            -- pending, ready
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+
+    CONSTRAINT uq_product_images_object_key UNIQUE (object_key),
+
+    CONSTRAINT chk_product_images_size CHECK (size_bytes >= 0),
+
+    CONSTRAINT chk_product_images_status CHECK (status IN ('pending', 'ready'))
+);
+
+-- Index for the images of a product in display order (GetProduct)
+CREATE INDEX IF NOT EXISTS idx_product_images_product
+    ON product_service.product_images(product_id, position)
+    WHERE status = 'ready';
+
+COMMENT ON TABLE product_service.product_images IS 'Product images stored in object storage';
+COMMENT ON COLUMN product_service.product_images.object_key IS 'Key of the image in the image bucket';
@@ -0,0 +1,8 @@
+-- ==============================================================================
+-- Rollback: Remove product visibility
+-- ==============================================================================
+
+ALTER TABLE product_service.products
+    DROP CONSTRAINT IF EXISTS chk_products_channels,
+    DROP COLUMN IF EXISTS markets,
+    DROP COLUMN IF EXISTS channels;
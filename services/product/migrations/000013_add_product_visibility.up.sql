@@ -0,0 +1,16 @@
+-- ==============================================================================
+-- Migration: Add product visibility
+-- Product Service - Per-channel and per-market visibility (soft launches)
+-- ==============================================================================
+
+-- An empty array means the product is visible everywhere; otherwise public
+-- reads only return it for the listed sales channels and markets.
+ALTER TABLE product_service.products
+    ADD COLUMN IF NOT EXISTS channels TEXT[] NOT NULL DEFAULT '{}',  -- web, app, marketplace
+    ADD COLUMN IF NOT EXISTS markets TEXT[] NOT NULL DEFAULT '{}';   -- ISO 3166-1 alpha-2, e.g. JP
+
+ALTER TABLE product_service.products
+    ADD CONSTRAINT chk_products_channels CHECK (channels <@ ARRAY['web', 'app', 'marketplace']::TEXT[]);
+
+COMMENT ON COLUMN product_service.products.channels IS 'Sales channels the product is visible in; empty for all';
+COMMENT ON COLUMN product_service.products.markets IS 'Markets the product is visible in; empty for all';
@@ -0,0 +1,6 @@
+-- ==============================================================================
+-- Rollback: Drop warehouse sync tables
+-- ==============================================================================
+
+DROP TABLE IF EXISTS product_service.warehouse_adjustments CASCADE;
+DROP TABLE IF EXISTS product_service.external_sku_mappings CASCADE;
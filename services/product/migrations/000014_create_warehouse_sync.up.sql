@@ -0,0 +1,29 @@
+-- ==============================================================================
+-- Migration: Create warehouse sync tables
+-- Product Service - External SKU mappings and pushed adjustments for 3PL sync
+-- ==============================================================================
+
+-- Maps a third-party logistics provider's SKU identifiers to internal SKUs.
+-- Each SKU has at most one identifier per provider.
+CREATE TABLE IF NOT EXISTS product_service.external_sku_mappings (
+    provider VARCHAR(64) NOT NULL,         -- 3PL identifier, e.g. acme-logistics
+    external_sku VARCHAR(255) NOT NULL,
+    sku_id UUID NOT NULL REFERENCES product_service.skus(id) ON DELETE CASCADE,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (provider, external_sku),
+    CONSTRAINT uk_external_sku_mappings_sku UNIQUE (provider, sku_id)
+);
+
+-- Idempotency keys of applied warehouse adjustment pushes. The row is written
+-- in the same transaction as the inventory changes, so a retried push is
+-- applied exactly once.
+CREATE TABLE IF NOT EXISTS product_service.warehouse_adjustments (
+    provider VARCHAR(64) NOT NULL,
+    idempotency_key VARCHAR(256) NOT NULL,
+    item_count INT NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (provider, idempotency_key)
+);
+
+COMMENT ON TABLE product_service.external_sku_mappings IS '3PL SKU identifiers mapped to internal SKUs';
+COMMENT ON TABLE product_service.warehouse_adjustments IS 'Applied 3PL adjustment pushes, by idempotency key';
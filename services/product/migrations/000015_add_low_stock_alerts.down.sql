@@ -0,0 +1,10 @@
+-- ==============================================================================
+-- Rollback: Remove low-stock alerts
+-- ==============================================================================
+
+DROP INDEX IF EXISTS product_service.idx_inventory_low_stock_alerted;
+
+ALTER TABLE product_service.inventory
+    DROP CONSTRAINT IF EXISTS chk_inventory_low_stock_threshold,
+    DROP COLUMN IF EXISTS low_stock_alerted_at,
+    DROP COLUMN IF EXISTS low_stock_threshold;
@@ -0,0 +1,22 @@
+-- ==============================================================================
+-- Migration: Add low-stock alerts
+-- Product Service - Per-SKU low-stock thresholds and alert state
+-- ==============================================================================
+
+-- A SKU is low on stock while its available quantity (quantity - reserved)
+-- is below its threshold. NULL uses the service-wide LOW_STOCK_DEFAULT_THRESHOLD;
+-- 0 disables alerts for the SKU.
+ALTER TABLE product_service.inventory
+    ADD COLUMN IF NOT EXISTS low_stock_threshold BIGINT,
+    ADD COLUMN IF NOT EXISTS low_stock_alerted_at TIMESTAMPTZ;  -- Set while an alert is outstanding
+
+ALTER TABLE product_service.inventory
+    ADD CONSTRAINT chk_inventory_low_stock_threshold CHECK (low_stock_threshold >= 0);
+
+-- The alert worker resets SKUs that have recovered; keep that scan small.
+CREATE INDEX IF NOT EXISTS idx_inventory_low_stock_alerted
+    ON product_service.inventory (sku_id)
+    WHERE low_stock_alerted_at IS NOT NULL;
+
+COMMENT ON COLUMN product_service.inventory.low_stock_threshold IS 'Available quantity below which the SKU is low on stock; NULL for the default';
+COMMENT ON COLUMN product_service.inventory.low_stock_alerted_at IS 'When the outstanding low-stock alert was emitted; cleared once the SKU recovers';
@@ -0,0 +1,8 @@
+-- ==============================================================================
+-- Rollback: Remove reservation admin support
+-- ==============================================================================
+
+DROP INDEX IF EXISTS product_service.idx_reservations_items;
+
+ALTER TABLE product_service.reservations
+    DROP COLUMN IF EXISTS release_reason;
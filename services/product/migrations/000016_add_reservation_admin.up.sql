@@ -0,0 +1,14 @@
+-- ==============================================================================
+-- Migration: Add reservation admin support
+-- Product Service - Reservation listing and forced release (ListReservations,
+-- ForceReleaseReservation)
+-- ==============================================================================
+
+ALTER TABLE product_service.reservations
+    ADD COLUMN IF NOT EXISTS release_reason TEXT;  -- Set by ForceReleaseReservation
+
+-- ListReservations filters by SKU with items @> '[{"SKUID": "..."}]'.
+CREATE INDEX IF NOT EXISTS idx_reservations_items
+    ON product_service.reservations USING GIN (items jsonb_path_ops);
+
+COMMENT ON COLUMN product_service.reservations.release_reason IS 'Why support staff force-released the reservation';
@@ -0,0 +1,5 @@
+-- ==============================================================================
+-- Rollback: Drop audit log table
+-- ==============================================================================
+
+DROP TABLE IF EXISTS product_service.audit_log CASCADE;
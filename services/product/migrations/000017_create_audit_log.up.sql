@@ -0,0 +1,28 @@
+-- ==============================================================================
+-- Migration: Create audit log table
+-- Product Service - Administrative mutations (see pkg/audit)
+-- ==============================================================================
+
+CREATE TABLE IF NOT EXISTS product_service.audit_log (
+    id UUID PRIMARY KEY,
+    actor VARCHAR(255) NOT NULL DEFAULT '',     -- user ID from the propagated context
+    method VARCHAR(255) NOT NULL,               -- full procedure name
+    entity_type VARCHAR(64) NOT NULL,           -- e.g. product, sku, inventory
+    entity_ids TEXT[] NOT NULL DEFAULT '{}',
+    changes JSONB NOT NULL DEFAULT '[]',        -- field-level before/after diff
+    request JSONB,                              -- request message, secrets redacted
+    request_id VARCHAR(255) NOT NULL DEFAULT '',
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+-- Index for ListAuditEntries (keyset pagination, newest first)
+CREATE INDEX IF NOT EXISTS idx_audit_log_created_at_id
+    ON product_service.audit_log(created_at DESC, id DESC);
+
+-- Indexes for filtering by entity and by actor
+CREATE INDEX IF NOT EXISTS idx_audit_log_entity_ids
+    ON product_service.audit_log USING GIN (entity_ids);
+CREATE INDEX IF NOT EXISTS idx_audit_log_actor_created_at
+    ON product_service.audit_log(actor, created_at DESC);
+
+COMMENT ON TABLE product_service.audit_log IS 'Who changed what through administrative RPCs, served by AuditService';
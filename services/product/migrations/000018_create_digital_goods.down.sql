@@ -0,0 +1,8 @@
+-- ==============================================================================
+-- Rollback: Drop digital goods tables
+-- ==============================================================================
+
+DROP TABLE IF EXISTS product_service.digital_entitlements CASCADE;
+DROP TABLE IF EXISTS product_service.digital_orders CASCADE;
+DROP TABLE IF EXISTS product_service.license_keys CASCADE;
+DROP TABLE IF EXISTS product_service.digital_skus CASCADE;
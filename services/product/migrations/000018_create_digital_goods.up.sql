@@ -0,0 +1,71 @@
+-- ==============================================================================
+-- Migration: Create digital goods tables
+-- Product Service - License key pools, download files and buyer entitlements
+-- ==============================================================================
+
+-- Marks a SKU as delivered digitally instead of shipped: a key from its
+-- license key pool or a limited number of downloads of a file.
+CREATE TABLE IF NOT EXISTS product_service.digital_skus (
+    sku_id UUID PRIMARY KEY REFERENCES product_service.skus(id) ON DELETE CASCADE,
+    kind VARCHAR(20) NOT NULL,              -- license_key or download
+    object_key VARCHAR(1024),               -- file of a download SKU
+    max_downloads INT NOT NULL DEFAULT 0,   -- downloads per buyer of a download SKU
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    CONSTRAINT chk_digital_skus_kind CHECK (kind IN ('license_key', 'download')),
+    CONSTRAINT chk_digital_skus_download CHECK (
+        kind <> 'download' OR (object_key IS NOT NULL AND max_downloads > 0)
+    )
+);
+
+-- License keys of a SKU. A key is assigned to exactly one order line and
+-- never returns to the pool.
+CREATE TABLE IF NOT EXISTS product_service.license_keys (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    sku_id UUID NOT NULL REFERENCES product_service.digital_skus(sku_id) ON DELETE CASCADE,
+    key VARCHAR(255) NOT NULL,
+    status VARCHAR(20) NOT NULL DEFAULT 'available',
+    order_id UUID,
+    assigned_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    CONSTRAINT uk_license_keys_sku_key UNIQUE (sku_id, key),
+    CONSTRAINT chk_license_keys_status CHECK (status IN ('available', 'assigned'))
+);
+
+-- Assignment takes the oldest available keys of a SKU.
+CREATE INDEX IF NOT EXISTS idx_license_keys_available
+    ON product_service.license_keys(sku_id, created_at)
+    WHERE status = 'available';
+
+-- Paid orders whose digital goods were fulfilled. The row is written in the
+-- same transaction as the entitlements, so a retried fulfillment assigns
+-- keys exactly once.
+CREATE TABLE IF NOT EXISTS product_service.digital_orders (
+    order_id UUID PRIMARY KEY,
+    user_id VARCHAR(255) NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+-- A buyer's right to one digital good of an order: an assigned license key
+-- or downloads of a file. The download limit is copied from the SKU so that
+-- later changes do not affect goods already sold.
+CREATE TABLE IF NOT EXISTS product_service.digital_entitlements (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    order_id UUID NOT NULL REFERENCES product_service.digital_orders(order_id) ON DELETE CASCADE,
+    sku_id UUID NOT NULL REFERENCES product_service.skus(id),
+    kind VARCHAR(20) NOT NULL,
+    license_key_id UUID REFERENCES product_service.license_keys(id),
+    object_key VARCHAR(1024),
+    download_count INT NOT NULL DEFAULT 0,
+    max_downloads INT NOT NULL DEFAULT 0,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    CONSTRAINT chk_digital_entitlements_downloads CHECK (download_count <= max_downloads)
+);
+
+CREATE INDEX IF NOT EXISTS idx_digital_entitlements_order
+    ON product_service.digital_entitlements(order_id);
+
+COMMENT ON TABLE product_service.digital_skus IS 'SKUs delivered as license keys or downloads instead of shipped';
+COMMENT ON TABLE product_service.license_keys IS 'License key pools of digital SKUs';
+COMMENT ON TABLE product_service.digital_orders IS 'Paid orders whose digital goods were fulfilled';
+COMMENT ON TABLE product_service.digital_entitlements IS 'License keys and downloads owned by buyers, per order';
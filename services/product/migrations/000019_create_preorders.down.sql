@@ -0,0 +1,6 @@
+-- ==============================================================================
+-- Rollback: Drop preorder tables
+-- ==============================================================================
+
+DROP TABLE IF EXISTS product_service.preorder_allocations CASCADE;
+DROP TABLE IF EXISTS product_service.preorder_campaigns CASCADE;
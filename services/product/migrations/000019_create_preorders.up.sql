@@ -0,0 +1,43 @@
+-- ==============================================================================
+-- Migration: Create preorder tables
+-- Product Service - Preorder campaigns and the orders allocated against them
+-- ==============================================================================
+
+-- A SKU sold before it is in stock. Orders are allocated against the cap
+-- while the window is open and ship from stock received by the ship date.
+CREATE TABLE IF NOT EXISTS product_service.preorder_campaigns (
+    sku_id UUID PRIMARY KEY REFERENCES product_service.skus(id) ON DELETE CASCADE,
+    starts_at TIMESTAMPTZ NOT NULL,
+    ends_at TIMESTAMPTZ NOT NULL,
+    ship_date TIMESTAMPTZ NOT NULL,          -- estimated, announced to buyers
+    allocation_cap BIGINT NOT NULL,
+    allocated BIGINT NOT NULL DEFAULT 0,     -- units of allocated orders
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    CONSTRAINT chk_preorder_campaigns_window CHECK (ends_at > starts_at),
+    CONSTRAINT chk_preorder_campaigns_ship_date CHECK (ship_date >= starts_at),
+    CONSTRAINT chk_preorder_campaigns_cap CHECK (allocation_cap > 0),
+    CONSTRAINT chk_preorder_campaigns_allocated CHECK (allocated >= 0)
+);
+
+-- Order lines allocated against a campaign. A released line (cancelled
+-- order or failed payment authorization) no longer counts against the cap.
+CREATE TABLE IF NOT EXISTS product_service.preorder_allocations (
+    order_id UUID NOT NULL,
+    sku_id UUID NOT NULL REFERENCES product_service.preorder_campaigns(sku_id),
+    quantity BIGINT NOT NULL,
+    status VARCHAR(20) NOT NULL DEFAULT 'allocated',
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    released_at TIMESTAMPTZ,
+    PRIMARY KEY (order_id, sku_id),
+    CONSTRAINT chk_preorder_allocations_quantity CHECK (quantity > 0),
+    CONSTRAINT chk_preorder_allocations_status CHECK (status IN ('allocated', 'released'))
+);
+
+-- Ship date changes notify the orders allocated against a SKU.
+CREATE INDEX IF NOT EXISTS idx_preorder_allocations_sku
+    ON product_service.preorder_allocations(sku_id)
+    WHERE status = 'allocated';
+
+COMMENT ON TABLE product_service.preorder_campaigns IS 'Preorder windows, ship dates and allocation caps of SKUs';
+COMMENT ON TABLE product_service.preorder_allocations IS 'Order lines allocated against preorder campaigns';
@@ -0,0 +1,9 @@
+-- ==============================================================================
+-- Rollback: Drop store pickup tables
+-- ==============================================================================
+
+DROP TABLE IF EXISTS product_service.pickup_reservation_items CASCADE;
+DROP TABLE IF EXISTS product_service.pickup_reservations CASCADE;
+DROP TABLE IF EXISTS product_service.pickup_slots CASCADE;
+DROP TABLE IF EXISTS product_service.pickup_stock CASCADE;
+DROP TABLE IF EXISTS product_service.pickup_locations CASCADE;
@@ -0,0 +1,72 @@
+-- ==============================================================================
+-- Migration: Create store pickup tables
+-- Product Service - Pickup locations, their stock, slots and reservations
+-- ==============================================================================
+
+-- A store or locker where buyers collect orders (click & collect).
+CREATE TABLE IF NOT EXISTS product_service.pickup_locations (
+    id UUID PRIMARY KEY,
+    name VARCHAR(100) NOT NULL,
+    address VARCHAR(500) NOT NULL,
+    active BOOLEAN NOT NULL DEFAULT TRUE,    -- inactive locations take no new reservations
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+-- Units of a SKU on hand at a location, managed apart from the central
+-- inventory. Reserved pickups are deducted until they are cancelled.
+CREATE TABLE IF NOT EXISTS product_service.pickup_stock (
+    location_id UUID NOT NULL REFERENCES product_service.pickup_locations(id) ON DELETE CASCADE,
+    sku_id UUID NOT NULL REFERENCES product_service.skus(id) ON DELETE CASCADE,
+    quantity BIGINT NOT NULL,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (location_id, sku_id),
+    CONSTRAINT chk_pickup_stock_quantity CHECK (quantity >= 0)
+);
+
+-- A time window in which a location hands over up to capacity orders.
+CREATE TABLE IF NOT EXISTS product_service.pickup_slots (
+    id UUID PRIMARY KEY,
+    location_id UUID NOT NULL REFERENCES product_service.pickup_locations(id) ON DELETE CASCADE,
+    starts_at TIMESTAMPTZ NOT NULL,
+    ends_at TIMESTAMPTZ NOT NULL,
+    capacity INT NOT NULL,
+    reserved INT NOT NULL DEFAULT 0,         -- orders reserved and not cancelled
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    CONSTRAINT uk_pickup_slots_start UNIQUE (location_id, starts_at),
+    CONSTRAINT chk_pickup_slots_window CHECK (ends_at > starts_at),
+    CONSTRAINT chk_pickup_slots_capacity CHECK (capacity > 0),
+    CONSTRAINT chk_pickup_slots_reserved CHECK (reserved >= 0)
+);
+
+-- An order to be collected in a slot. status follows the order through
+-- reserved -> ready -> collected, or cancelled from reserved or ready.
+CREATE TABLE IF NOT EXISTS product_service.pickup_reservations (
+    order_id UUID PRIMARY KEY,
+    location_id UUID NOT NULL REFERENCES product_service.pickup_locations(id),
+    slot_id UUID NOT NULL REFERENCES product_service.pickup_slots(id),
+    status VARCHAR(20) NOT NULL DEFAULT 'reserved',
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    ready_at TIMESTAMPTZ,
+    collected_at TIMESTAMPTZ,
+    cancelled_at TIMESTAMPTZ,
+    CONSTRAINT chk_pickup_reservations_status CHECK (status IN ('reserved', 'ready', 'collected', 'cancelled'))
+);
+
+CREATE TABLE IF NOT EXISTS product_service.pickup_reservation_items (
+    order_id UUID NOT NULL REFERENCES product_service.pickup_reservations(order_id) ON DELETE CASCADE,
+    sku_id UUID NOT NULL,
+    quantity BIGINT NOT NULL,
+    PRIMARY KEY (order_id, sku_id),
+    CONSTRAINT chk_pickup_reservation_items_quantity CHECK (quantity > 0)
+);
+
+-- Slot listings of a location by time.
+CREATE INDEX IF NOT EXISTS idx_pickup_slots_location_start
+    ON product_service.pickup_slots(location_id, starts_at);
+
+COMMENT ON TABLE product_service.pickup_locations IS 'Stores and lockers offering click & collect';
+COMMENT ON TABLE product_service.pickup_stock IS 'Units of SKUs on hand at pickup locations';
+COMMENT ON TABLE product_service.pickup_slots IS 'Pickup time windows and their capacity';
+COMMENT ON TABLE product_service.pickup_reservations IS 'Orders to be collected at pickup locations';
+COMMENT ON TABLE product_service.pickup_reservation_items IS 'Order lines of pickup reservations';
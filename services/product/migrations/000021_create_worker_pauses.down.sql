@@ -0,0 +1,5 @@
+-- ==============================================================================
+-- Rollback: Drop worker pauses table
+-- ==============================================================================
+
+DROP TABLE IF EXISTS product_service.worker_pauses CASCADE;
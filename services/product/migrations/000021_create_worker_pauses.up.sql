@@ -0,0 +1,13 @@
+-- ==============================================================================
+-- Migration: Create worker pauses table
+-- Product Service - Background workers paused by operators
+-- ==============================================================================
+
+-- A row pauses the named background worker on every instance until it is
+-- deleted (resumed). Workers check it on each tick.
+CREATE TABLE IF NOT EXISTS product_service.worker_pauses (
+    worker VARCHAR(64) PRIMARY KEY,
+    reason VARCHAR(500) NOT NULL,
+    paused_by VARCHAR(255) NOT NULL,
+    paused_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
@@ -0,0 +1,5 @@
+-- ==============================================================================
+-- Rollback: Drop attribute definitions table
+-- ==============================================================================
+
+DROP TABLE IF EXISTS product_service.attribute_definitions CASCADE;
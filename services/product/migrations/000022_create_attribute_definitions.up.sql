@@ -0,0 +1,27 @@
+-- ==============================================================================
+-- Migration: Create attribute definitions table
+-- Product Service - SKU attribute schemas per category
+-- ==============================================================================
+
+-- Attributes that SKUs of products in a category may or must have. SKUs are
+-- validated against the definitions of their product's category when they
+-- are created or their attributes are updated; existing SKUs are not
+-- revalidated when definitions change.
+CREATE TABLE IF NOT EXISTS product_service.attribute_definitions (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    category_id UUID NOT NULL REFERENCES product_service.categories(id) ON DELETE CASCADE,
+    name VARCHAR(64) NOT NULL,              -- Attribute key, e.g. color
+    type VARCHAR(16) NOT NULL,              -- string, number or boolean
+    allowed_values TEXT[] NOT NULL DEFAULT '{}',  -- Empty allows any value of the type
+    required BOOLEAN NOT NULL DEFAULT FALSE,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+
+    -- One definition per attribute name in a category
+    CONSTRAINT uk_attribute_definitions_category_name UNIQUE (category_id, name),
+
+    CONSTRAINT chk_attribute_definitions_type CHECK (type IN ('string', 'number', 'boolean'))
+);
+
+COMMENT ON TABLE product_service.attribute_definitions IS 'SKU attribute schemas per category';
+COMMENT ON COLUMN product_service.attribute_definitions.allowed_values IS 'Values a SKU may use; empty allows any value of the type';
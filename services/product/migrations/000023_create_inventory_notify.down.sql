@@ -0,0 +1,6 @@
+-- ==============================================================================
+-- Rollback: Stop notifying inventory availability changes
+-- ==============================================================================
+
+DROP TRIGGER IF EXISTS trg_inventory_notify_change ON product_service.inventory;
+DROP FUNCTION IF EXISTS product_service.notify_inventory_change();
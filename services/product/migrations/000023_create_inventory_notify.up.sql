@@ -0,0 +1,27 @@
+-- ==============================================================================
+-- Migration: Notify inventory availability changes
+-- Product Service - Live stock updates for WatchInventory
+-- ==============================================================================
+
+-- Sends the new stock level of a SKU on the inventory_changes channel
+-- whenever its available quantity (quantity - reserved) changes. NOTIFY is
+-- delivered on commit, so listeners never see rolled back changes, and every
+-- writer (reservations, warehouse sync, imports, manual fixes) is covered.
+CREATE OR REPLACE FUNCTION product_service.notify_inventory_change() RETURNS trigger AS $$
+BEGIN
+    IF TG_OP = 'UPDATE' AND NEW.quantity - NEW.reserved = OLD.quantity - OLD.reserved THEN
+        RETURN NULL;
+    END IF;
+    PERFORM pg_notify('inventory_changes', json_build_object(
+        'sku_id', NEW.sku_id,
+        'quantity', NEW.quantity,
+        'reserved', NEW.reserved,
+        'version', NEW.version
+    )::text);
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER trg_inventory_notify_change
+    AFTER INSERT OR UPDATE OF quantity, reserved ON product_service.inventory
+    FOR EACH ROW EXECUTE FUNCTION product_service.notify_inventory_change();
@@ -0,0 +1,5 @@
+-- ==============================================================================
+-- Rollback: Drop favorites table
+-- ==============================================================================
+
+DROP TABLE IF EXISTS product_service.favorites CASCADE;
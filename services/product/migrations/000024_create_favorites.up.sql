@@ -0,0 +1,25 @@
+-- ==============================================================================
+-- Migration: Create favorites table
+-- Product Service - Per-user wishlists
+-- ==============================================================================
+
+-- Products a user has saved to their wishlist. The user ID comes from the
+-- User Service and is not a foreign key. Favorites of soft-deleted products
+-- are kept but neither listed nor counted.
+CREATE TABLE IF NOT EXISTS product_service.favorites (
+    user_id UUID NOT NULL,
+    product_id UUID NOT NULL REFERENCES product_service.products(id) ON DELETE CASCADE,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+
+    PRIMARY KEY (user_id, product_id)
+);
+
+-- Wishlist pages, newest first
+CREATE INDEX IF NOT EXISTS idx_favorites_user_created
+    ON product_service.favorites(user_id, created_at DESC, product_id DESC);
+
+-- Favorite counts per product
+CREATE INDEX IF NOT EXISTS idx_favorites_product
+    ON product_service.favorites(product_id);
+
+COMMENT ON TABLE product_service.favorites IS 'Products saved to user wishlists';
@@ -0,0 +1,118 @@
+// Command anonymize rewrites personal data in a restored copy of the
+// production database so it can be used in staging. Emails, names and
+// shipping addresses are replaced with deterministic fakes keyed by
+// ANONYMIZE_KEY; IDs are untouched, so references between tables and
+// services keep working.
+//
+// The target database name must be passed with -confirm to guard against
+// running it on the wrong database:
+//
+//	ANONYMIZE_KEY=... DATABASE_URL=postgres://.../ec_staging \
+//	    go run ./services/user/cmd/anonymize -confirm ec_staging
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"log/slog"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/daisuke8000/example-ec-platform/services/user/internal/anonymize"
+)
+
+// minKeyLength is the minimum ANONYMIZE_KEY length in bytes.
+const minKeyLength = 16
+
+func main() {
+	logger := slog.New(slog.NewJSONHandler(os.Stdout, &slog.HandlerOptions{
+		Level: slog.LevelInfo,
+	}))
+	slog.SetDefault(logger)
+
+	if err := run(logger); err != nil {
+		logger.Error("anonymization failed", slog.String("error", err.Error()))
+		os.Exit(1)
+	}
+}
+
+func run(logger *slog.Logger) error {
+	databaseURL := flag.String("database-url", os.Getenv("DATABASE_URL"), "user service database")
+	orderDatabaseURL := flag.String("order-database-url", os.Getenv("ORDER_DATABASE_URL"), "order service database (defaults to -database-url)")
+	confirm := flag.String("confirm", "", "name of the database to anonymize; must match the target")
+	batchSize := flag.Int("batch-size", 1000, "rows rewritten per statement")
+	flag.Parse()
+
+	key := os.Getenv("ANONYMIZE_KEY")
+	switch {
+	case *databaseURL == "":
+		return errors.New("DATABASE_URL or -database-url is required")
+	case len(key) < minKeyLength:
+		return fmt.Errorf("ANONYMIZE_KEY must be at least %d bytes", minKeyLength)
+	case *batchSize < 1:
+		return errors.New("-batch-size must be at least 1")
+	}
+	if *orderDatabaseURL == "" {
+		*orderDatabaseURL = *databaseURL
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	anonymizer := anonymize.New(anonymize.NewFaker([]byte(key)), *batchSize, logger)
+
+	userPool, err := connect(ctx, *databaseURL, *confirm)
+	if err != nil {
+		return err
+	}
+	defer userPool.Close()
+
+	users, err := anonymizer.Users(ctx, userPool)
+	if err != nil {
+		return fmt.Errorf("anonymize users: %w", err)
+	}
+
+	orderPool := userPool
+	if *orderDatabaseURL != *databaseURL {
+		orderPool, err = connect(ctx, *orderDatabaseURL, *confirm)
+		if err != nil {
+			return err
+		}
+		defer orderPool.Close()
+	}
+
+	orders, err := anonymizer.Orders(ctx, orderPool)
+	if err != nil {
+		return fmt.Errorf("anonymize orders: %w", err)
+	}
+
+	logger.Info("anonymization completed",
+		slog.Int("users", users),
+		slog.Int("orders", orders),
+	)
+	return nil
+}
+
+// connect opens a pool and checks that it points at the confirmed database.
+func connect(ctx context.Context, url, confirm string) (*pgxpool.Pool, error) {
+	pool, err := pgxpool.New(ctx, url)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create database pool: %w", err)
+	}
+
+	var name string
+	if err := pool.QueryRow(ctx, `SELECT current_database()`).Scan(&name); err != nil {
+		pool.Close()
+		return nil, fmt.Errorf("failed to query database name: %w", err)
+	}
+	if confirm != name {
+		pool.Close()
+		return nil, fmt.Errorf("refusing to anonymize database %q: pass -confirm %s to proceed", name, name)
+	}
+	return pool, nil
+}
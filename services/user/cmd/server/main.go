@@ -9,40 +9,63 @@ import (
 	"net/http"
 	"os"
 	"os/signal"
+	"sync"
 	"syscall"
 	"time"
 
 	"connectrpc.com/connect"
+	"connectrpc.com/grpcreflect"
 	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/redis/go-redis/v9"
 	"golang.org/x/net/http2"
 	"golang.org/x/net/http2/h2c"
 
+	"github.com/daisuke8000/example-ec-platform/gen/audit/v1/auditv1connect"
+	"github.com/daisuke8000/example-ec-platform/gen/backup/v1/backupv1connect"
+	"github.com/daisuke8000/example-ec-platform/gen/operations/v1/operationsv1connect"
 	"github.com/daisuke8000/example-ec-platform/gen/user/v1/userv1connect"
+	"github.com/daisuke8000/example-ec-platform/pkg/audit"
+	"github.com/daisuke8000/example-ec-platform/pkg/backup"
 	pkgmiddleware "github.com/daisuke8000/example-ec-platform/pkg/connect/middleware"
+	"github.com/daisuke8000/example-ec-platform/pkg/listing"
+	"github.com/daisuke8000/example-ec-platform/pkg/objectstore"
+	"github.com/daisuke8000/example-ec-platform/pkg/observability"
+	"github.com/daisuke8000/example-ec-platform/pkg/operations"
+	"github.com/daisuke8000/example-ec-platform/pkg/watchdog"
 	connectHandler "github.com/daisuke8000/example-ec-platform/services/user/internal/adapter/connect"
+	"github.com/daisuke8000/example-ec-platform/services/user/internal/adapter/hibp"
 	httpAdapter "github.com/daisuke8000/example-ec-platform/services/user/internal/adapter/http"
 	"github.com/daisuke8000/example-ec-platform/services/user/internal/adapter/hydra"
+	"github.com/daisuke8000/example-ec-platform/services/user/internal/adapter/mailer"
 	"github.com/daisuke8000/example-ec-platform/services/user/internal/adapter/ratelimit"
 	"github.com/daisuke8000/example-ec-platform/services/user/internal/adapter/repository"
+	"github.com/daisuke8000/example-ec-platform/services/user/internal/adapter/revocation"
+	"github.com/daisuke8000/example-ec-platform/services/user/internal/adapter/upstream"
 	"github.com/daisuke8000/example-ec-platform/services/user/internal/config"
+	"github.com/daisuke8000/example-ec-platform/services/user/internal/domain"
+	"github.com/daisuke8000/example-ec-platform/services/user/internal/passwordhash"
+	"github.com/daisuke8000/example-ec-platform/services/user/internal/secretbox"
 	"github.com/daisuke8000/example-ec-platform/services/user/internal/usecase"
+	"github.com/daisuke8000/example-ec-platform/services/user/internal/worker"
 )
 
 func main() {
-	// Setup structured logging
-	logger := slog.New(slog.NewJSONHandler(os.Stdout, &slog.HandlerOptions{
-		Level: slog.LevelInfo,
-	}))
+	// Setup structured logging. Records logged with a request's context
+	// carry the request ID propagated by the BFF.
+	// The level is set from LOG_LEVEL once the configuration is loaded.
+	logLevel := new(slog.LevelVar)
+	logger := slog.New(pkgmiddleware.NewContextLogHandler(slog.NewJSONHandler(os.Stdout, &slog.HandlerOptions{
+		Level: logLevel,
+	})))
 	slog.SetDefault(logger)
 
-	if err := run(logger); err != nil {
+	if err := run(logger, logLevel); err != nil {
 		logger.Error("server failed", slog.String("error", err.Error()))
 		os.Exit(1)
 	}
 }
 
-func run(logger *slog.Logger) error {
+func run(logger *slog.Logger, logLevel *slog.LevelVar) error {
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
 
@@ -51,18 +74,28 @@ func run(logger *slog.Logger) error {
 	if err != nil {
 		return fmt.Errorf("failed to load config: %w", err)
 	}
+	if err := logLevel.UnmarshalText([]byte(cfg.LogLevel)); err != nil {
+		return fmt.Errorf("invalid log level: %w", err)
+	}
 
 	logger.Info("configuration loaded",
 		slog.Int("grpc_port", cfg.GRPCPort),
 		slog.Int("http_port", cfg.HTTPPort),
 	)
 
+	// Metrics, served on the metrics port
+	metricsExporter := observability.NewExporter()
+	meter := metricsExporter.Meter("user-service")
+
 	// Initialize database connection pool
-	pool, err := pgxpool.New(ctx, cfg.DatabaseURL)
+	pool, err := newDatabasePool(ctx, cfg.DatabaseURL, cfg, logger)
 	if err != nil {
 		return fmt.Errorf("failed to create database pool: %w", err)
 	}
 	defer pool.Close()
+	if err := observability.RegisterPoolMetrics(meter, "primary", pool); err != nil {
+		return fmt.Errorf("failed to register database pool metrics: %w", err)
+	}
 
 	// Verify database connectivity
 	if err := pool.Ping(ctx); err != nil {
@@ -72,8 +105,74 @@ func run(logger *slog.Logger) error {
 
 	// Wire dependencies
 	userRepo := repository.NewPostgresUserRepository(pool)
-	userUseCase := usecase.NewUserUseCase(userRepo, cfg.BcryptCost)
-	userHandler := connectHandler.NewUserServiceHandler(userUseCase, logger)
+	var verification *usecase.EmailVerificationConfig
+	if cfg.EmailVerificationRequired {
+		verification = &usecase.EmailVerificationConfig{
+			Tokens:   repository.NewPostgresEmailVerificationRepository(pool),
+			Sender:   mailer.NewLogSender(cfg.PublicBaseURL, logger),
+			TokenTTL: cfg.EmailVerificationTTL,
+			Logger:   logger.With("component", "email-verification"),
+		}
+		logger.Info("email verification enabled", slog.Duration("token_ttl", cfg.EmailVerificationTTL))
+	}
+	var lockout *usecase.LockoutConfig
+	if cfg.LoginLockoutEnabled {
+		lockout = &usecase.LockoutConfig{
+			Lockouts:    repository.NewPostgresLoginLockoutRepository(pool),
+			MaxAttempts: cfg.LoginLockoutMaxAttempts,
+			Duration:    cfg.LoginLockoutDuration,
+		}
+		logger.Info("login lockout enabled",
+			slog.Int("max_attempts", cfg.LoginLockoutMaxAttempts),
+			slog.Duration("duration", cfg.LoginLockoutDuration),
+		)
+	}
+	passwords := &usecase.PasswordConfig{
+		Policy: domain.PasswordPolicy{
+			MinLength:      cfg.PasswordMinLength,
+			MinCharClasses: cfg.PasswordMinCharClasses,
+		},
+		Logger: logger.With("component", "password-policy"),
+	}
+	if cfg.PasswordBreachCheckEnabled {
+		passwords.Breached = hibp.NewClient(cfg.PasswordBreachCheckURL, cfg.PasswordBreachCheckTimeout)
+		logger.Info("breached password check enabled", slog.String("url", cfg.PasswordBreachCheckURL))
+	}
+	hasher, err := passwordhash.New(cfg.PasswordHashAlgorithm, cfg.BcryptCost, passwordhash.Argon2idParams{
+		Memory:      cfg.Argon2Memory,
+		Iterations:  cfg.Argon2Iterations,
+		Parallelism: cfg.Argon2Parallelism,
+	})
+	if err != nil {
+		return err
+	}
+	logger.Info("password hashing configured", slog.String("algorithm", cfg.PasswordHashAlgorithm))
+	userUseCase := usecase.NewUserUseCase(userRepo, repository.NewTxManager(pool), hasher, verification, lockout, passwords)
+	batchUseCase := usecase.NewBatchUserUseCase(
+		userRepo,
+		userRepo,
+		repository.NewPostgresBatchJobRepository(pool),
+		logger.With("component", "batch-jobs"),
+	)
+
+	// Start soft-deleted user purge worker (optional)
+	var wg sync.WaitGroup
+	workerCtx, workerCancel := context.WithCancel(ctx)
+	defer workerCancel()
+	if cfg.UserPurgeEnabled {
+		purger := worker.NewUserPurger(userRepo, logger.With("component", "user-purger"), worker.UserPurgerConfig{
+			Retention: cfg.UserPurgeRetention,
+			Interval:  cfg.UserPurgeInterval,
+			BatchSize: cfg.UserPurgeBatchSize,
+			Mode:      domain.PurgeMode(cfg.UserPurgeMode),
+			DryRun:    cfg.UserPurgeDryRun,
+		})
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			purger.Start(workerCtx)
+		}()
+	}
 
 	// Initialize Redis client for rate limiting (optional - graceful fallback if unavailable)
 	var rateLimiter httpAdapter.RateLimiter
@@ -98,23 +197,155 @@ func run(logger *slog.Logger) error {
 	}
 
 	// Initialize Hydra client
-	hydraClient := hydra.NewClient(cfg.HydraAdminURL)
+	hydraClient := hydra.NewClientWithConfig(cfg.HydraAdminURL, hydra.Config{
+		AttemptTimeout:          cfg.HydraTimeout,
+		RetryMaxAttempts:        cfg.HydraRetryMaxAttempts,
+		RetryInitialBackoff:     cfg.HydraRetryInitialBackoff,
+		RetryMaxBackoff:         cfg.HydraRetryMaxBackoff,
+		BreakerFailureThreshold: cfg.HydraBreakerFailureThreshold,
+		BreakerOpenTimeout:      cfg.HydraBreakerOpenTimeout,
+	})
 	logger.Info("Hydra client initialized", slog.String("admin_url", cfg.HydraAdminURL))
 
+	consentUseCase := usecase.NewConsentUseCase(repository.NewPostgresConsentRepository(pool), hydraClient)
+	pageTokenSecret := cfg.PageTokenSecret
+	if pageTokenSecret == "" {
+		logger.Warn("PAGE_TOKEN_SECRET not set, page tokens will not survive restarts")
+		pageTokenSecret = listing.RandomSecret()
+	}
+	pageTokens, err := listing.NewCodec(pageTokenSecret)
+	if err != nil {
+		return fmt.Errorf("failed to initialize page tokens: %w", err)
+	}
+	accessGrantUseCase := usecase.NewAccessGrantUseCase(repository.NewPostgresAccessGrantRepository(pool), userRepo)
+	sessionUseCase := usecase.NewSessionUseCase(hydraClient, consentUseCase)
+	addressUseCase := usecase.NewAddressUseCase(repository.NewPostgresAddressRepository(pool), userRepo)
+
+	// TOTP two-factor authentication (optional)
+	var twoFactorUseCase usecase.TwoFactorUseCase
+	if cfg.TwoFactorEnabled {
+		totpSecrets, err := secretbox.New(cfg.TwoFactorSecretKey, "totp-secret")
+		if err != nil {
+			return fmt.Errorf("failed to initialize TOTP secret encryption: %w", err)
+		}
+		twoFactorUseCase = usecase.NewTwoFactorUseCase(repository.NewPostgresTwoFactorRepository(pool, totpSecrets), userRepo, cfg.TwoFactorIssuer)
+		logger.Info("two-factor authentication enabled")
+	}
+
+	// Login history with new device notices (optional)
+	var loginHistoryUseCase usecase.LoginHistoryUseCase
+	if cfg.LoginHistoryEnabled {
+		loginHistoryUseCase = usecase.NewLoginHistoryUseCase(usecase.LoginHistoryConfig{
+			Events:    repository.NewPostgresLoginEventRepository(pool),
+			Users:     userRepo,
+			Notifier:  mailer.NewLogSender(cfg.PublicBaseURL, logger),
+			Retention: cfg.LoginHistoryRetention,
+			Logger:    logger.With("component", "login-history"),
+		})
+		logger.Info("login history enabled", slog.Duration("retention", cfg.LoginHistoryRetention))
+	}
+
+	// Social login with upstream identity providers (optional)
+	var upstreamProviders []*upstream.Provider
+	var federatedLoginUseCase usecase.FederatedLoginUseCase
+	if cfg.SocialLoginEnabled {
+		if cfg.GoogleClientID != "" {
+			upstreamProviders = append(upstreamProviders, upstream.NewGoogle(cfg.GoogleClientID, cfg.GoogleClientSecret, cfg.SocialLoginTimeout))
+		}
+		if cfg.GitHubClientID != "" {
+			upstreamProviders = append(upstreamProviders, upstream.NewGitHub(cfg.GitHubClientID, cfg.GitHubClientSecret, cfg.SocialLoginTimeout))
+		}
+		federatedLoginUseCase = usecase.NewFederatedLoginUseCase(repository.NewPostgresExternalIdentityRepository(pool), userRepo, usecase.FederatedLoginConfig{
+			AutoProvision: cfg.SocialLoginAutoProvision,
+		})
+		logger.Info("social login enabled",
+			slog.Int("providers", len(upstreamProviders)),
+			slog.Bool("auto_provision", cfg.SocialLoginAutoProvision),
+		)
+	}
+
+	userHandler := connectHandler.NewUserServiceHandler(userUseCase, batchUseCase, consentUseCase, accessGrantUseCase, sessionUseCase, addressUseCase, twoFactorUseCase, loginHistoryUseCase, cfg.ServiceVersion, pageTokens, logger)
+	operationsStore := operations.NewPostgresStore(pool, "user_service.operations")
+	operationsHandler := operations.NewHandler(operationsStore, pageTokens, logger.With("component", "operations"))
+	operationsRunner := operations.NewRunner(operationsStore, logger.With("component", "operations"))
+	auditStore := audit.NewPostgresStore(pool, "user_service.audit_log")
+	auditHandler := audit.NewHandler(auditStore, pageTokens, logger.With("component", "audit"))
+
+	// Logical backups of the user_service schema (optional)
+	var backupHandler *backup.Handler
+	if cfg.BackupEnabled {
+		backupStore, err := objectstore.New(objectstore.Config{
+			Backend: cfg.BackupStore,
+			Dir:     cfg.BackupDir,
+			S3: objectstore.S3Config{
+				Endpoint:  cfg.BackupS3Endpoint,
+				Region:    cfg.BackupS3Region,
+				Bucket:    cfg.BackupS3Bucket,
+				AccessKey: cfg.BackupS3AccessKey,
+				SecretKey: cfg.BackupS3SecretKey,
+			},
+		})
+		if err != nil {
+			return fmt.Errorf("failed to initialize backup store: %w", err)
+		}
+		backupManager := backup.NewManager(backupStore, cfg.DatabaseURL, "user_service", backup.Retention{
+			KeepLast: cfg.BackupKeepLast,
+			MaxAge:   cfg.BackupMaxAge,
+		}, logger.With("component", "backup"))
+		backupHandler = backup.NewHandler(backupManager, operationsRunner, logger.With("component", "backup"))
+		logger.Info("backups enabled", slog.String("store", cfg.BackupStore))
+	}
+
 	// Create HTTP handler for OAuth2 UI
-	oauth2Handler, err := httpAdapter.NewHandler(hydraClient, userUseCase, rateLimiter, logger, httpAdapter.HandlerConfig{
+	oauth2Handler, err := httpAdapter.NewHandler(hydraClient, userUseCase, consentUseCase, rateLimiter, logger, httpAdapter.HandlerConfig{
 		LoginRememberFor:   cfg.LoginRememberFor,
 		ConsentRememberFor: cfg.ConsentRememberFor,
+		TwoFactor:          twoFactorUseCase,
+		TwoFactorSecretKey: cfg.TwoFactorSecretKey,
+		LoginHistory:       loginHistoryUseCase,
+		TrustedProxyHeader: cfg.TrustedProxyHeader,
+		Addresses:          addressUseCase,
+		Branding: httpAdapter.Branding{
+			ProductName:     cfg.BrandingProductName,
+			LogoURI:         cfg.BrandingLogoURI,
+			PrimaryColor:    cfg.BrandingPrimaryColor,
+			BackgroundColor: cfg.BrandingBackgroundColor,
+		},
+		AssetsDir:               cfg.BrandingAssetsDir,
+		UpstreamProviders:       upstreamProviders,
+		FederatedLogin:          federatedLoginUseCase,
+		UpstreamSecretKey:       cfg.SocialLoginSecretKey,
+		UpstreamCallbackBaseURL: cfg.SocialLoginCallbackBaseURL,
 	})
 	if err != nil {
 		return fmt.Errorf("failed to create HTTP handler: %w", err)
 	}
 
-	// Create Connect-go interceptors
-	interceptors := connect.WithInterceptors(
-		pkgmiddleware.ServerPropagatorInterceptor(),
-		pkgmiddleware.LoggingInterceptor(logger),
+	rpcMetrics, err := observability.NewRPCMetrics(meter)
+	if err != nil {
+		return fmt.Errorf("failed to create RPC metrics: %w", err)
+	}
+
+	// Create Connect-go interceptors. Metrics come first so rejected calls
+	// are counted. Service authentication runs next so that propagated user
+	// context is only trusted from known services.
+	serverInterceptors := []connect.Interceptor{rpcMetrics.Interceptor()}
+	if cfg.ServiceAuthEnabled {
+		serverInterceptors = append(serverInterceptors, newServiceAuthInterceptor(cfg, logger))
+		logger.Info("service authentication enabled", slog.Any("allowed", cfg.ServiceAuthAllowed))
+	}
+	serverInterceptors = append(serverInterceptors,
+		newContextPropagator(cfg.ContextSigningKeys).ServerPropagatorInterceptor(),
+		pkgmiddleware.LoggingInterceptor(pkgmiddleware.LoggingConfig{
+			SampleEvery:   cfg.LogSampleEvery,
+			SlowThreshold: cfg.LogSlowThreshold,
+		}, logger),
+		audit.Interceptor(auditStore, audit.Config{
+			Targets: userHandler.AuditTargets(),
+			Redact:  []string{"password", "secret"},
+		}, logger.With("component", "audit")),
 	)
+	interceptors := connect.WithInterceptors(serverInterceptors...)
 
 	// Create Connect-go handler
 	path, handler := userv1connect.NewUserServiceHandler(userHandler, interceptors)
@@ -124,9 +355,38 @@ func run(logger *slog.Logger) error {
 
 	// Mount Connect-go handler (handles /user.v1.UserService/*)
 	mux.Handle(path, handler)
+	mux.Handle(operationsv1connect.NewOperationsServiceHandler(operationsHandler, interceptors))
+	mux.Handle(auditv1connect.NewAuditServiceHandler(auditHandler, interceptors))
+
+	serviceNames := []string{userv1connect.UserServiceName, operationsv1connect.OperationsServiceName, auditv1connect.AuditServiceName}
+	if backupHandler != nil {
+		mux.Handle(backupv1connect.NewBackupServiceHandler(backupHandler, interceptors))
+		serviceNames = append(serviceNames, backupv1connect.BackupServiceName)
+	}
+
+	if cfg.EnableReflection {
+		reflector := grpcreflect.NewStaticReflector(serviceNames...)
+		mux.Handle(grpcreflect.NewHandlerV1(reflector))
+		mux.Handle(grpcreflect.NewHandlerV1Alpha(reflector))
+		logger.Info("gRPC server reflection enabled")
+	}
+
+	// Mount the receiver of Hydra's token and consent events (optional)
+	if cfg.HydraWebhookEnabled {
+		var notifier usecase.TokenRevocationNotifier
+		if len(cfg.TokenRevocationWebhookURLs) > 0 {
+			notifier = revocation.NewNotifier(cfg.TokenRevocationWebhookURLs, cfg.TokenRevocationWebhookSecret, cfg.TokenRevocationWebhookTimeout)
+		}
+		hydraEventLogger := logger.With("component", "hydra-events")
+		hydraEventUseCase := usecase.NewHydraEventUseCase(auditStore, notifier, hydraEventLogger)
+		mux.Handle("/webhooks/hydra", httpAdapter.NewHydraEventHandler(hydraEventUseCase, cfg.HydraWebhookSecret, hydraEventLogger))
+		logger.Info("hydra webhook enabled", slog.Int("revocation_endpoints", len(cfg.TokenRevocationWebhookURLs)))
+	}
 
-	// Mount OAuth2 handlers (handles /oauth2/*, /health)
-	mux.Handle("/oauth2/", oauth2Handler.Router())
+	// Mount OAuth2 and account handlers (handles /oauth2/*, /account/*)
+	oauth2Router := oauth2Handler.Router()
+	mux.Handle("/oauth2/", oauth2Router)
+	mux.Handle("/account/", oauth2Router)
 	mux.HandleFunc("/health", func(w http.ResponseWriter, r *http.Request) {
 		w.WriteHeader(http.StatusOK)
 		w.Write([]byte("OK"))
@@ -140,7 +400,11 @@ func run(logger *slog.Logger) error {
 	corp := httpAdapter.NewCrossOriginProtection(cfg.TrustedOrigins)
 	wrappedHandler := corp.Handler(
 		httpAdapter.SecurityHeadersMiddleware(
-			httpAdapter.LoggingMiddleware(logger)(mux),
+			httpAdapter.LoggingMiddleware(logger)(
+				pkgmiddleware.PeerCertificateMiddleware(
+					pkgmiddleware.RouteTimeouts(cfg.ServerRouteTimeouts)(mux),
+				),
+			),
 		),
 	)
 
@@ -153,9 +417,18 @@ func run(logger *slog.Logger) error {
 			wrappedHandler,
 			&http2.Server{},
 		),
-		ReadTimeout:  30 * time.Second,
-		WriteTimeout: 30 * time.Second,
-		IdleTimeout:  120 * time.Second,
+		ReadTimeout:       cfg.ServerReadTimeout,
+		ReadHeaderTimeout: cfg.ServerReadHeaderTimeout,
+		WriteTimeout:      cfg.ServerWriteTimeout,
+		IdleTimeout:       cfg.ServerIdleTimeout,
+	}
+	if cfg.ServiceTLSCertFile != "" {
+		// Serves TLS (HTTP/2 via ALPN) and verifies client certificates
+		// for mutual TLS when a client CA is set
+		server.TLSConfig, err = pkgmiddleware.ServerTLSConfig(cfg.ServiceTLSCertFile, cfg.ServiceTLSKeyFile, cfg.ServiceTLSClientCAFile)
+		if err != nil {
+			return fmt.Errorf("failed to load service TLS configuration: %w", err)
+		}
 	}
 
 	// Handle shutdown signals
@@ -164,17 +437,60 @@ func run(logger *slog.Logger) error {
 
 	errCh := make(chan error, 1)
 
+	// Start memory and goroutine watchdog (optional). It is started after
+	// signal.Notify so its restart request (SIGTERM) runs the graceful
+	// shutdown below instead of killing the process.
+	wd := watchdog.New(watchdog.Config{
+		Interval:      cfg.WatchdogInterval,
+		MaxHeapBytes:  uint64(cfg.WatchdogMaxHeapMB) << 20,
+		MaxGoroutines: cfg.WatchdogMaxGoroutines,
+		RestartAfter:  cfg.WatchdogRestartAfter,
+	}, logger.With("component", "watchdog"))
+	if wd.Enabled() {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			wd.Start(workerCtx)
+		}()
+	}
+
 	// Start server
 	go func() {
 		logger.Info("Connect-go server starting",
 			slog.String("address", grpcAddr),
 			slog.String("protocols", "Connect, gRPC, gRPC-Web, HTTP/1.1, HTTP/2"),
 		)
-		if err := server.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+		var err error
+		if server.TLSConfig != nil {
+			err = server.ListenAndServeTLS("", "")
+		} else {
+			err = server.ListenAndServe()
+		}
+		if err != nil && err != http.ErrServerClosed {
 			errCh <- fmt.Errorf("server error: %w", err)
 		}
 	}()
 
+	if cfg.DebugPort != 0 {
+		// Optional; a failure is logged but does not stop the service
+		debugServer := observability.NewDebugServer(cfg.DebugAddr, cfg.DebugPort)
+		defer debugServer.Close()
+		go func() {
+			logger.Warn("debug server starting", slog.String("address", debugServer.Addr))
+			if err := debugServer.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+				logger.Error("debug server error", slog.String("error", err.Error()))
+			}
+		}()
+	}
+
+	metricsServer := observability.NewMetricsServer(cfg.MetricsPort, metricsExporter)
+	go func() {
+		logger.Info("metrics server starting", slog.String("address", metricsServer.Addr))
+		if err := metricsServer.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+			errCh <- fmt.Errorf("metrics server error: %w", err)
+		}
+	}()
+
 	// Wait for shutdown signal or error
 	select {
 	case sig := <-sigCh:
@@ -195,10 +511,66 @@ func run(logger *slog.Logger) error {
 	} else {
 		logger.Info("server stopped")
 	}
+	if err := metricsServer.Shutdown(shutdownCtx); err != nil {
+		logger.Error("metrics server shutdown error", slog.String("error", err.Error()))
+	}
+	metricsExporter.Shutdown(shutdownCtx)
+
+	// Let in-flight batch jobs finish before the database pool closes
+	batchUseCase.Wait()
+	operationsRunner.Wait()
+	logger.Info("batch jobs drained")
+
+	workerCancel()
+	wg.Wait()
 
 	return nil
 }
 
+// newDatabasePool creates a connection pool sized and recycled as
+// configured, whose statements are bounded by the query timeout and logged
+// when slow.
+func newDatabasePool(ctx context.Context, databaseURL string, cfg *config.Config, logger *slog.Logger) (*pgxpool.Pool, error) {
+	poolCfg, err := pgxpool.ParseConfig(databaseURL)
+	if err != nil {
+		return nil, fmt.Errorf("invalid database URL: %w", err)
+	}
+	poolCfg.MaxConns = cfg.DBMaxConns
+	poolCfg.MinConns = cfg.DBMinConns
+	poolCfg.MaxConnLifetime = cfg.DBMaxConnLifetime
+	poolCfg.MaxConnIdleTime = cfg.DBMaxConnIdleTime
+	poolCfg.HealthCheckPeriod = cfg.DBHealthCheckPeriod
+	poolCfg.ConnConfig.Tracer = observability.NewQueryTracer(cfg.DBQueryTimeout, cfg.DBSlowQueryThreshold, logger.With("component", "database"))
+	return pgxpool.NewWithConfig(ctx, poolCfg)
+}
+
+// newServiceAuthInterceptor returns the interceptor that rejects RPCs from
+// callers without an allowed service identity.
+func newServiceAuthInterceptor(cfg *config.Config, logger *slog.Logger) connect.Interceptor {
+	var tokens *pkgmiddleware.ServiceTokenVerifier
+	if cfg.ServiceAuthJWKSURL != "" {
+		tokens = pkgmiddleware.NewServiceTokenVerifier(pkgmiddleware.ServiceTokenConfig{
+			JWKSURL:   cfg.ServiceAuthJWKSURL,
+			Issuer:    cfg.ServiceAuthIssuer,
+			Audience:  cfg.ServiceAuthAudience,
+			ClockSkew: 30 * time.Second,
+		})
+	}
+	return pkgmiddleware.ServiceAuthInterceptor(pkgmiddleware.ServiceAuthConfig{
+		Tokens:          tokens,
+		AllowedServices: cfg.ServiceAuthAllowed,
+	}, logger.With("component", "service-auth"))
+}
+
+// newContextPropagator returns the propagator reading user context from
+// the BFF, verifying its signature when signing keys are configured.
+func newContextPropagator(signingKeys []string) *pkgmiddleware.ContextPropagator {
+	if len(signingKeys) == 0 {
+		return pkgmiddleware.NewContextPropagator()
+	}
+	return pkgmiddleware.NewSignedContextPropagator(pkgmiddleware.PropagatorConfig{SigningKeys: signingKeys})
+}
+
 // handleHealthz returns OK if the service is running (liveness probe).
 func handleHealthz(w http.ResponseWriter, r *http.Request) {
 	w.Header().Set("Content-Type", "application/json")
@@ -4,8 +4,18 @@ go 1.25
 
 require (
 	connectrpc.com/connect v1.18.1
+	connectrpc.com/grpcreflect v1.3.0
 	github.com/daisuke8000/example-ec-platform/gen v0.0.0
+	github.com/daisuke8000/example-ec-platform/pkg/backup v0.0.0
+	github.com/daisuke8000/example-ec-platform/pkg/audit v0.0.0
 	github.com/daisuke8000/example-ec-platform/pkg/connect v0.0.0
+	github.com/daisuke8000/example-ec-platform/pkg/listing v0.0.0
+	github.com/daisuke8000/example-ec-platform/pkg/objectstore v0.0.0
+	github.com/daisuke8000/example-ec-platform/pkg/observability v0.0.0
+	github.com/daisuke8000/example-ec-platform/pkg/operations v0.0.0
+	github.com/daisuke8000/example-ec-platform/pkg/prototest v0.0.0
+	github.com/daisuke8000/example-ec-platform/pkg/watchdog v0.0.0
+	github.com/daisuke8000/example-ec-platform/pkg/webhook v0.0.0
 	github.com/google/uuid v1.6.0
 	github.com/jackc/pgx/v5 v5.6.0
 	github.com/redis/go-redis/v9 v9.17.2
@@ -18,10 +28,17 @@ require (
 require (
 	github.com/cespare/xxhash/v2 v2.3.0 // indirect
 	github.com/dgryski/go-rendezvous v0.0.0-20200823014737-9f7001d12a5f // indirect
+	github.com/go-logr/logr v1.4.2 // indirect
+	github.com/go-logr/stdr v1.2.2 // indirect
 	github.com/jackc/pgpassfile v1.0.0 // indirect
 	github.com/jackc/pgservicefile v0.0.0-20221227161230-091c0ba34f0a // indirect
 	github.com/jackc/puddle/v2 v2.2.1 // indirect
 	github.com/stretchr/testify v1.10.0 // indirect
+	go.opentelemetry.io/otel v1.32.0 // indirect
+	go.opentelemetry.io/otel/metric v1.32.0 // indirect
+	go.opentelemetry.io/otel/sdk v1.32.0 // indirect
+	go.opentelemetry.io/otel/sdk/metric v1.32.0 // indirect
+	go.opentelemetry.io/otel/trace v1.32.0 // indirect
 	golang.org/x/sync v0.10.0 // indirect
 	golang.org/x/sys v0.31.0 // indirect
 	golang.org/x/text v0.21.0 // indirect
@@ -31,5 +48,14 @@ require (
 
 replace (
 	github.com/daisuke8000/example-ec-platform/gen => ../../gen
+	github.com/daisuke8000/example-ec-platform/pkg/backup => ../../pkg/backup
+	github.com/daisuke8000/example-ec-platform/pkg/audit => ../../pkg/audit
 	github.com/daisuke8000/example-ec-platform/pkg/connect => ../../pkg/connect
+	github.com/daisuke8000/example-ec-platform/pkg/listing => ../../pkg/listing
+	github.com/daisuke8000/example-ec-platform/pkg/objectstore => ../../pkg/objectstore
+	github.com/daisuke8000/example-ec-platform/pkg/observability => ../../pkg/observability
+	github.com/daisuke8000/example-ec-platform/pkg/operations => ../../pkg/operations
+	github.com/daisuke8000/example-ec-platform/pkg/prototest => ../../pkg/prototest
+	github.com/daisuke8000/example-ec-platform/pkg/watchdog => ../../pkg/watchdog
+	github.com/daisuke8000/example-ec-platform/pkg/webhook => ../../pkg/webhook
 )
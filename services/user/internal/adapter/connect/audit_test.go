@@ -0,0 +1,169 @@
+package connect
+
+import (
+	"context"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+
+	v1 "github.com/daisuke8000/example-ec-platform/gen/user/v1"
+	"github.com/daisuke8000/example-ec-platform/gen/user/v1/userv1connect"
+	"github.com/daisuke8000/example-ec-platform/pkg/audit"
+	pkgmw "github.com/daisuke8000/example-ec-platform/pkg/connect/middleware"
+	"github.com/daisuke8000/example-ec-platform/pkg/listing"
+	"github.com/daisuke8000/example-ec-platform/services/user/internal/domain"
+	"github.com/daisuke8000/example-ec-platform/services/user/internal/usecase"
+)
+
+// recordingAuditStore is an in-memory audit.Store.
+type recordingAuditStore struct {
+	entries []*audit.Entry
+}
+
+func (s *recordingAuditStore) Record(ctx context.Context, entry *audit.Entry) error {
+	s.entries = append(s.entries, entry)
+	return nil
+}
+
+func (s *recordingAuditStore) List(ctx context.Context, filter audit.ListFilter, after *audit.Cursor, limit int) ([]*audit.Entry, error) {
+	return s.entries, nil
+}
+
+func TestAuditTargets_UpdateUser(t *testing.T) {
+	stored := createTestUser()
+	uc := &mockUserUseCase{
+		getUserFn: func(ctx context.Context, id uuid.UUID) (*domain.User, error) {
+			if id != stored.ID {
+				return nil, domain.ErrUserNotFound
+			}
+			user := *stored
+			return &user, nil
+		},
+		updateUserFn: func(ctx context.Context, id uuid.UUID, input usecase.UpdateUserInput) (*domain.User, error) {
+			stored.Email = *input.Email
+			user := *stored
+			return &user, nil
+		},
+	}
+
+	tests := []struct {
+		name        string
+		actor       string
+		wantEntries int
+	}{
+		{name: "admin update is recorded", actor: uuid.NewString(), wantEntries: 1},
+		{name: "own update is not recorded", actor: stored.ID.String(), wantEntries: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store := &recordingAuditStore{}
+			logger := slog.New(slog.NewTextHandler(os.Stdout, &slog.HandlerOptions{Level: slog.LevelError}))
+			pageTokens, _ := listing.NewCodec("test-secret")
+			handler := NewUserServiceHandler(uc, &mockBatchUserUseCase{}, &mockConsentUseCase{}, nil, nil, nil, nil, nil, "test", pageTokens, logger)
+
+			actor := connect.UnaryInterceptorFunc(func(next connect.UnaryFunc) connect.UnaryFunc {
+				return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+					return next(pkgmw.WithUserID(ctx, tt.actor), req)
+				}
+			})
+			interceptor := audit.Interceptor(store, audit.Config{Targets: handler.AuditTargets()}, logger)
+
+			mux := http.NewServeMux()
+			mux.Handle(userv1connect.NewUserServiceHandler(handler, connect.WithInterceptors(actor, interceptor)))
+			server := httptest.NewServer(mux)
+			defer server.Close()
+			client := userv1connect.NewUserServiceClient(http.DefaultClient, server.URL)
+
+			email := uuid.NewString() + "@example.com"
+			_, err := client.UpdateUser(context.Background(), connect.NewRequest(&v1.UpdateUserRequest{
+				Id:    stored.ID.String(),
+				Email: &email,
+			}))
+			if err != nil {
+				t.Fatalf("UpdateUser() error = %v", err)
+			}
+
+			if len(store.entries) != tt.wantEntries {
+				t.Fatalf("recorded %d entries, want %d", len(store.entries), tt.wantEntries)
+			}
+			if tt.wantEntries == 0 {
+				return
+			}
+			entry := store.entries[0]
+			if entry.Actor != tt.actor {
+				t.Errorf("entry actor = %q, want %q", entry.Actor, tt.actor)
+			}
+			if entry.EntityType != auditUser || len(entry.EntityIDs) != 1 || entry.EntityIDs[0] != stored.ID.String() {
+				t.Errorf("entry entity = %s %v, want %s [%s]", entry.EntityType, entry.EntityIDs, auditUser, stored.ID)
+			}
+			var emailChanged bool
+			for _, change := range entry.Changes {
+				if change.Field == "email" {
+					emailChanged = string(change.After) == `"`+email+`"`
+				}
+			}
+			if !emailChanged {
+				t.Errorf("entry changes = %+v, want email changed to %s", entry.Changes, email)
+			}
+		})
+	}
+}
+
+func TestAuditTargets_RevokeConsentBySelf(t *testing.T) {
+	userID := uuid.New()
+	apps := []*domain.ConnectedApp{{ClientID: "spa"}, {ClientID: "partner"}}
+	consent := &mockConsentUseCase{
+		revokeConsentFn: func(ctx context.Context, id uuid.UUID, clientID string) (int, error) {
+			apps = apps[:1]
+			return 1, nil
+		},
+	}
+	sessions := &mockSessionUseCase{
+		listConnectedAppsFn: func(ctx context.Context, id uuid.UUID) ([]*domain.ConnectedApp, error) {
+			return apps, nil
+		},
+	}
+
+	store := &recordingAuditStore{}
+	logger := slog.New(slog.NewTextHandler(os.Stdout, &slog.HandlerOptions{Level: slog.LevelError}))
+	pageTokens, _ := listing.NewCodec("test-secret")
+	handler := NewUserServiceHandler(&mockUserUseCase{}, &mockBatchUserUseCase{}, consent, nil, sessions, nil, nil, nil, "test", pageTokens, logger)
+
+	actor := connect.UnaryInterceptorFunc(func(next connect.UnaryFunc) connect.UnaryFunc {
+		return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+			return next(pkgmw.WithUserID(ctx, userID.String()), req)
+		}
+	})
+	interceptor := audit.Interceptor(store, audit.Config{Targets: handler.AuditTargets()}, logger)
+
+	mux := http.NewServeMux()
+	mux.Handle(userv1connect.NewUserServiceHandler(handler, connect.WithInterceptors(actor, interceptor)))
+	server := httptest.NewServer(mux)
+	defer server.Close()
+	client := userv1connect.NewUserServiceClient(http.DefaultClient, server.URL)
+
+	if _, err := client.RevokeConsent(context.Background(), connect.NewRequest(&v1.RevokeConsentRequest{
+		UserId:   userID.String(),
+		ClientId: "partner",
+	})); err != nil {
+		t.Fatalf("RevokeConsent() error = %v", err)
+	}
+
+	// Revoking one's own app access is recorded, unlike other own changes.
+	if len(store.entries) != 1 {
+		t.Fatalf("recorded %d entries, want 1", len(store.entries))
+	}
+	entry := store.entries[0]
+	if entry.EntityType != auditConsent || entry.Actor != userID.String() {
+		t.Errorf("entry = %s by %s, want %s by %s", entry.EntityType, entry.Actor, auditConsent, userID)
+	}
+	if len(entry.Changes) == 0 {
+		t.Error("entry has no changes, want the revoked app in the diff")
+	}
+}
@@ -0,0 +1,152 @@
+package connect
+
+import (
+	"testing"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/proto"
+
+	v1 "github.com/daisuke8000/example-ec-platform/gen/user/v1"
+	"github.com/daisuke8000/example-ec-platform/pkg/prototest"
+	"github.com/daisuke8000/example-ec-platform/services/user/internal/domain"
+)
+
+var (
+	fixtureUserID = uuid.MustParse("6f1c2b9e-3d4a-4e5f-8a7b-9c0d1e2f3a4b")
+	fixtureTime   = time.Date(2025, 1, 2, 3, 4, 5, 600_000_000, time.UTC)
+)
+
+// fixtureUser has every field domainUserToProto converts set.
+func fixtureUser() *domain.User {
+	name := "Taro Yamada"
+	deletedAt := fixtureTime.Add(48 * time.Hour)
+	return &domain.User{
+		ID:            fixtureUserID,
+		Email:         "taro@example.com",
+		PasswordHash:  "$2a$04$not-converted",
+		Name:          &name,
+		EmailVerified: true,
+		IsDeleted:     true,
+		DeletedAt:     &deletedAt,
+		CreatedAt:     fixtureTime,
+		UpdatedAt:     fixtureTime.Add(time.Hour),
+	}
+}
+
+// protoUserToDomain is the inverse of domainUserToProto, for the fields
+// the message carries.
+func protoUserToDomain(pb *v1.User) (*domain.User, error) {
+	id, err := uuid.Parse(pb.GetId())
+	if err != nil {
+		return nil, err
+	}
+	user := &domain.User{
+		ID:            id,
+		Email:         pb.GetEmail(),
+		Name:          pb.Name,
+		EmailVerified: pb.GetEmailVerified(),
+		CreatedAt:     pb.GetCreatedAt().AsTime(),
+		UpdatedAt:     pb.GetUpdatedAt().AsTime(),
+	}
+	if pb.DeletedAt != nil {
+		deletedAt := pb.GetDeletedAt().AsTime()
+		user.DeletedAt = &deletedAt
+		user.IsDeleted = true
+	}
+	return user, nil
+}
+
+func TestDomainUserToProto_Golden(t *testing.T) {
+	active := fixtureUser()
+	active.Name = nil
+	active.IsDeleted = false
+	active.DeletedAt = nil
+
+	tests := []struct {
+		name string
+		user *domain.User
+	}{
+		{"user", fixtureUser()},
+		{"user_active_without_name", active},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			prototest.Golden(t, tt.name, domainUserToProto(tt.user))
+		})
+	}
+}
+
+func TestDomainUserToProto_SetsEveryField(t *testing.T) {
+	prototest.Populated(t, domainUserToProto(fixtureUser()))
+}
+
+func TestDomainUserToProto_RoundTrip(t *testing.T) {
+	want := fixtureUser()
+	got, err := protoUserToDomain(domainUserToProto(want))
+	if err != nil {
+		t.Fatalf("protoUserToDomain() error = %v", err)
+	}
+	assertUsersEqual(t, got, want)
+}
+
+func FuzzDomainUserToProto(f *testing.F) {
+	f.Add(fixtureUserID[:], "taro@example.com", "Taro", true, true, fixtureTime.Unix(), int64(fixtureTime.Nanosecond()))
+	f.Add(make([]byte, 16), "", "", false, false, int64(0), int64(0))
+
+	f.Fuzz(func(t *testing.T, id []byte, email, name string, hasName, deleted bool, sec, nsec int64) {
+		userID, err := uuid.FromBytes(id)
+		if err != nil || !utf8.ValidString(email) || !utf8.ValidString(name) {
+			t.Skip()
+		}
+		// Keep the time within the range of google.protobuf.Timestamp.
+		at := time.Unix(sec%253402300799, nsec%1_000_000_000).UTC()
+		if at.Year() < 1 {
+			t.Skip()
+		}
+
+		want := &domain.User{ID: userID, Email: email, CreatedAt: at, UpdatedAt: at}
+		if hasName {
+			want.Name = &name
+		}
+		if deleted {
+			want.IsDeleted = true
+			want.DeletedAt = &at
+		}
+
+		// Through the wire and back, as a client would see it.
+		b, err := proto.Marshal(domainUserToProto(want))
+		if err != nil {
+			t.Fatalf("proto.Marshal() error = %v", err)
+		}
+		var pb v1.User
+		if err := proto.Unmarshal(b, &pb); err != nil {
+			t.Fatalf("proto.Unmarshal() error = %v", err)
+		}
+		if !proto.Equal(&pb, domainUserToProto(want)) {
+			t.Fatalf("message changed on the wire: %v", &pb)
+		}
+		got, err := protoUserToDomain(&pb)
+		if err != nil {
+			t.Fatalf("protoUserToDomain() error = %v", err)
+		}
+		assertUsersEqual(t, got, want)
+	})
+}
+
+func assertUsersEqual(t *testing.T, got, want *domain.User) {
+	t.Helper()
+	if got.ID != want.ID || got.Email != want.Email || got.EmailVerified != want.EmailVerified || got.IsDeleted != want.IsDeleted {
+		t.Errorf("user = %+v, want %+v", got, want)
+	}
+	if (got.Name == nil) != (want.Name == nil) || (got.Name != nil && *got.Name != *want.Name) {
+		t.Errorf("name = %v, want %v", got.Name, want.Name)
+	}
+	if !got.CreatedAt.Equal(want.CreatedAt) || !got.UpdatedAt.Equal(want.UpdatedAt) {
+		t.Errorf("timestamps = %v/%v, want %v/%v", got.CreatedAt, got.UpdatedAt, want.CreatedAt, want.UpdatedAt)
+	}
+	if (got.DeletedAt == nil) != (want.DeletedAt == nil) || (got.DeletedAt != nil && !got.DeletedAt.Equal(*want.DeletedAt)) {
+		t.Errorf("deleted_at = %v, want %v", got.DeletedAt, want.DeletedAt)
+	}
+}
@@ -2,9 +2,14 @@
 package connect
 
 import (
+	"bytes"
 	"context"
+	"encoding/csv"
 	"errors"
+	"fmt"
 	"log/slog"
+	"strconv"
+	"time"
 
 	"connectrpc.com/connect"
 	"github.com/google/uuid"
@@ -12,6 +17,9 @@ import (
 
 	v1 "github.com/daisuke8000/example-ec-platform/gen/user/v1"
 	"github.com/daisuke8000/example-ec-platform/gen/user/v1/userv1connect"
+	"github.com/daisuke8000/example-ec-platform/pkg/connect/errcode"
+	pkgmw "github.com/daisuke8000/example-ec-platform/pkg/connect/middleware"
+	"github.com/daisuke8000/example-ec-platform/pkg/listing"
 	"github.com/daisuke8000/example-ec-platform/services/user/internal/domain"
 	"github.com/daisuke8000/example-ec-platform/services/user/internal/usecase"
 )
@@ -19,15 +27,55 @@ import (
 // UserServiceHandler implements the Connect-go UserServiceHandler interface.
 type UserServiceHandler struct {
 	userv1connect.UnimplementedUserServiceHandler
-	uc     usecase.UserUseCase
-	logger *slog.Logger
+	uc        usecase.UserUseCase
+	batchUC   usecase.BatchUserUseCase
+	consentUC usecase.ConsentUseCase
+	grantUC   usecase.AccessGrantUseCase
+	sessionUC usecase.SessionUseCase
+	addressUC usecase.AddressUseCase
+	// twoFactorUC is nil when two-factor authentication is disabled.
+	twoFactorUC usecase.TwoFactorUseCase
+	// loginHistoryUC is nil when login history is disabled.
+	loginHistoryUC usecase.LoginHistoryUseCase
+	version        string
+	pageTokens     *listing.Codec
+	logger         *slog.Logger
+}
+
+// serverFeatures lists optional behaviours advertised by GetServerInfo.
+// Add an entry whenever a request field is added to an existing procedure so
+// callers can stop sending it to instances that would silently ignore it.
+var serverFeatures = []string{
+	"list_users.include_deleted",
+	"list_consents.include_revoked",
 }
 
 // NewUserServiceHandler creates a new Connect-go handler for user operations.
-func NewUserServiceHandler(uc usecase.UserUseCase, logger *slog.Logger) *UserServiceHandler {
+func NewUserServiceHandler(
+	uc usecase.UserUseCase,
+	batchUC usecase.BatchUserUseCase,
+	consentUC usecase.ConsentUseCase,
+	grantUC usecase.AccessGrantUseCase,
+	sessionUC usecase.SessionUseCase,
+	addressUC usecase.AddressUseCase,
+	twoFactorUC usecase.TwoFactorUseCase,
+	loginHistoryUC usecase.LoginHistoryUseCase,
+	version string,
+	pageTokens *listing.Codec,
+	logger *slog.Logger,
+) *UserServiceHandler {
 	return &UserServiceHandler{
-		uc:     uc,
-		logger: logger,
+		uc:             uc,
+		batchUC:        batchUC,
+		consentUC:      consentUC,
+		grantUC:        grantUC,
+		sessionUC:      sessionUC,
+		addressUC:      addressUC,
+		twoFactorUC:    twoFactorUC,
+		loginHistoryUC: loginHistoryUC,
+		version:        version,
+		pageTokens:     pageTokens,
+		logger:         logger,
 	}
 }
 
@@ -161,6 +209,32 @@ func (h *UserServiceHandler) DeleteUser(
 	return connect.NewResponse(&v1.DeleteUserResponse{}), nil
 }
 
+// UnlockUser lifts the login lockout of a user's email address.
+func (h *UserServiceHandler) UnlockUser(
+	ctx context.Context,
+	req *connect.Request[v1.UnlockUserRequest],
+) (*connect.Response[v1.UnlockUserResponse], error) {
+	id, err := uuid.Parse(req.Msg.GetId())
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument,
+			errors.New("invalid user ID format"))
+	}
+
+	if err := h.uc.UnlockUser(ctx, id); err != nil {
+		h.logger.ErrorContext(ctx, "UnlockUser failed",
+			slog.String("user_id", req.Msg.GetId()),
+			slog.String("error", err.Error()),
+		)
+		return nil, mapDomainError(err)
+	}
+
+	h.logger.InfoContext(ctx, "user unlocked",
+		slog.String("user_id", req.Msg.GetId()),
+	)
+
+	return connect.NewResponse(&v1.UnlockUserResponse{}), nil
+}
+
 // VerifyPassword handles credential verification requests.
 // Security: Returns the same error for both non-existent users and invalid passwords
 // to prevent account enumeration attacks (OWASP A07:2021).
@@ -194,6 +268,874 @@ func (h *UserServiceHandler) VerifyPassword(
 	}), nil
 }
 
+// VerifyEmail handles email verification token redemption.
+func (h *UserServiceHandler) VerifyEmail(
+	ctx context.Context,
+	req *connect.Request[v1.VerifyEmailRequest],
+) (*connect.Response[v1.VerifyEmailResponse], error) {
+	// Note: The token is a credential and must never be logged
+	h.logger.InfoContext(ctx, "VerifyEmail request received")
+
+	user, err := h.uc.VerifyEmail(ctx, req.Msg.GetToken())
+	if err != nil {
+		h.logger.WarnContext(ctx, "VerifyEmail failed",
+			slog.String("error", err.Error()),
+		)
+		return nil, mapDomainError(err)
+	}
+
+	h.logger.InfoContext(ctx, "VerifyEmail succeeded",
+		slog.String("user_id", user.ID.String()),
+	)
+
+	return connect.NewResponse(&v1.VerifyEmailResponse{
+		User: domainUserToProto(user),
+	}), nil
+}
+
+// ResendVerificationEmail sends a new verification email.
+// Ownership is enforced by the BFF.
+func (h *UserServiceHandler) ResendVerificationEmail(
+	ctx context.Context,
+	req *connect.Request[v1.ResendVerificationEmailRequest],
+) (*connect.Response[v1.ResendVerificationEmailResponse], error) {
+	userID, err := uuid.Parse(req.Msg.GetUserId())
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument,
+			errors.New("invalid user ID format"))
+	}
+
+	if err := h.uc.ResendVerificationEmail(ctx, userID); err != nil {
+		h.logger.WarnContext(ctx, "ResendVerificationEmail failed",
+			slog.String("user_id", req.Msg.GetUserId()),
+			slog.String("error", err.Error()),
+		)
+		return nil, mapDomainError(err)
+	}
+
+	h.logger.InfoContext(ctx, "verification email resent",
+		slog.String("user_id", req.Msg.GetUserId()),
+	)
+
+	return connect.NewResponse(&v1.ResendVerificationEmailResponse{}), nil
+}
+
+// ListUsers handles administrative user enumeration.
+// Authorization (admin scope) is enforced by the BFF.
+func (h *UserServiceHandler) ListUsers(
+	ctx context.Context,
+	req *connect.Request[v1.ListUsersRequest],
+) (*connect.Response[v1.ListUsersResponse], error) {
+	h.logger.InfoContext(ctx, "ListUsers request received",
+		slog.Int("page_size", int(req.Msg.GetPageSize())),
+		slog.Bool("include_deleted", req.Msg.GetIncludeDeleted()),
+	)
+
+	// Page tokens are encrypted and bound to the filters they were issued
+	// for; the use case only sees its own cursor format.
+	query := listUsersQuery(req.Msg)
+	var cursor string
+	if req.Msg.GetPageToken() != "" {
+		if err := h.pageTokens.Decode(req.Msg.GetPageToken(), query, &cursor); err != nil {
+			return nil, mapDomainError(domain.ErrInvalidPageToken)
+		}
+	}
+
+	input := usecase.ListUsersInput{
+		Filter: domain.UserFilter{
+			EmailContains:  req.Msg.EmailContains,
+			IncludeDeleted: req.Msg.GetIncludeDeleted(),
+		},
+		PageSize:  int(req.Msg.GetPageSize()),
+		PageToken: cursor,
+	}
+	if req.Msg.CreatedAfter != nil {
+		t := req.Msg.CreatedAfter.AsTime()
+		input.Filter.CreatedAfter = &t
+	}
+	if req.Msg.CreatedBefore != nil {
+		t := req.Msg.CreatedBefore.AsTime()
+		input.Filter.CreatedBefore = &t
+	}
+
+	out, err := h.uc.ListUsers(ctx, input)
+	if err != nil {
+		h.logger.ErrorContext(ctx, "ListUsers failed",
+			slog.String("error", err.Error()),
+		)
+		return nil, mapDomainError(err)
+	}
+
+	resp := &v1.ListUsersResponse{}
+	if out.NextPageToken != "" {
+		resp.NextPageToken, err = h.pageTokens.Encode(query, out.NextPageToken)
+		if err != nil {
+			h.logger.ErrorContext(ctx, "failed to encode page token",
+				slog.String("error", err.Error()),
+			)
+			return nil, connect.NewError(connect.CodeInternal, errors.New("internal server error"))
+		}
+	}
+	for _, user := range out.Users {
+		resp.Users = append(resp.Users, domainUserToProto(user))
+	}
+
+	return connect.NewResponse(resp), nil
+}
+
+// listUsersQuery identifies the filters of a ListUsers request.
+func listUsersQuery(msg *v1.ListUsersRequest) string {
+	var after, before string
+	if msg.CreatedAfter != nil {
+		after = msg.CreatedAfter.AsTime().String()
+	}
+	if msg.CreatedBefore != nil {
+		before = msg.CreatedBefore.AsTime().String()
+	}
+	return listing.QueryKey(nil, nil,
+		msg.GetEmailContains(), after, before, strconv.FormatBool(msg.GetIncludeDeleted()))
+}
+
+// GetUserRoles returns the roles assigned to a user.
+// Called by the consent flow and trusted internal services.
+func (h *UserServiceHandler) GetUserRoles(
+	ctx context.Context,
+	req *connect.Request[v1.GetUserRolesRequest],
+) (*connect.Response[v1.GetUserRolesResponse], error) {
+	id, err := uuid.Parse(req.Msg.GetUserId())
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument,
+			errors.New("invalid user ID format"))
+	}
+
+	roles, err := h.uc.GetUserRoles(ctx, id)
+	if err != nil {
+		h.logger.ErrorContext(ctx, "GetUserRoles failed",
+			slog.String("user_id", req.Msg.GetUserId()),
+			slog.String("error", err.Error()),
+		)
+		return nil, mapDomainError(err)
+	}
+
+	resp := &v1.GetUserRolesResponse{}
+	for _, role := range roles {
+		resp.Roles = append(resp.Roles, &v1.Role{
+			Name:        role.Name,
+			Permissions: role.Permissions,
+		})
+	}
+
+	return connect.NewResponse(resp), nil
+}
+
+// BatchDeactivateUsers starts an asynchronous bulk deactivation job.
+// Authorization (users:bulk permission) is enforced by the BFF.
+func (h *UserServiceHandler) BatchDeactivateUsers(
+	ctx context.Context,
+	req *connect.Request[v1.BatchDeactivateUsersRequest],
+) (*connect.Response[v1.BatchDeactivateUsersResponse], error) {
+	target, err := protoBatchTargetToDomain(req.Msg.GetTarget())
+	if err != nil {
+		return nil, err
+	}
+
+	job, err := h.batchUC.BatchDeactivateUsers(ctx, target, pkgmw.GetUserID(ctx))
+	if err != nil {
+		h.logger.ErrorContext(ctx, "BatchDeactivateUsers failed",
+			slog.String("error", err.Error()),
+		)
+		return nil, mapDomainError(err)
+	}
+
+	h.logger.InfoContext(ctx, "BatchDeactivateUsers job created",
+		slog.String("job_id", job.ID.String()),
+		slog.Int("total", job.Total),
+	)
+
+	return connect.NewResponse(&v1.BatchDeactivateUsersResponse{
+		Job: domainBatchJobToProto(job),
+	}), nil
+}
+
+// BatchAssignSegment starts an asynchronous bulk segment assignment job.
+// Authorization (users:bulk permission) is enforced by the BFF.
+func (h *UserServiceHandler) BatchAssignSegment(
+	ctx context.Context,
+	req *connect.Request[v1.BatchAssignSegmentRequest],
+) (*connect.Response[v1.BatchAssignSegmentResponse], error) {
+	target, err := protoBatchTargetToDomain(req.Msg.GetTarget())
+	if err != nil {
+		return nil, err
+	}
+
+	job, err := h.batchUC.BatchAssignSegment(ctx, target, req.Msg.GetSegment(), pkgmw.GetUserID(ctx))
+	if err != nil {
+		h.logger.ErrorContext(ctx, "BatchAssignSegment failed",
+			slog.String("segment", req.Msg.GetSegment()),
+			slog.String("error", err.Error()),
+		)
+		return nil, mapDomainError(err)
+	}
+
+	h.logger.InfoContext(ctx, "BatchAssignSegment job created",
+		slog.String("job_id", job.ID.String()),
+		slog.String("segment", job.Segment),
+		slog.Int("total", job.Total),
+	)
+
+	return connect.NewResponse(&v1.BatchAssignSegmentResponse{
+		Job: domainBatchJobToProto(job),
+	}), nil
+}
+
+// GetBatchJob returns the progress of a batch job.
+func (h *UserServiceHandler) GetBatchJob(
+	ctx context.Context,
+	req *connect.Request[v1.GetBatchJobRequest],
+) (*connect.Response[v1.GetBatchJobResponse], error) {
+	id, err := uuid.Parse(req.Msg.GetJobId())
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument,
+			errors.New("invalid job ID format"))
+	}
+
+	job, err := h.batchUC.GetBatchJob(ctx, id)
+	if err != nil {
+		return nil, mapDomainError(err)
+	}
+
+	return connect.NewResponse(&v1.GetBatchJobResponse{
+		Job: domainBatchJobToProto(job),
+	}), nil
+}
+
+// GetBatchJobReport returns the per-user results of a finished job as CSV.
+func (h *UserServiceHandler) GetBatchJobReport(
+	ctx context.Context,
+	req *connect.Request[v1.GetBatchJobReportRequest],
+) (*connect.Response[v1.GetBatchJobReportResponse], error) {
+	id, err := uuid.Parse(req.Msg.GetJobId())
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument,
+			errors.New("invalid job ID format"))
+	}
+
+	results, err := h.batchUC.GetBatchJobReport(ctx, id)
+	if err != nil {
+		return nil, mapDomainError(err)
+	}
+
+	var buf bytes.Buffer
+	w := csv.NewWriter(&buf)
+	_ = w.Write([]string{"user_id", "status", "error"})
+	for _, res := range results {
+		_ = w.Write([]string{res.UserID.String(), string(res.Status), res.Error})
+	}
+	w.Flush()
+	if err := w.Error(); err != nil {
+		h.logger.ErrorContext(ctx, "GetBatchJobReport failed to encode CSV",
+			slog.String("job_id", req.Msg.GetJobId()),
+			slog.String("error", err.Error()),
+		)
+		return nil, connect.NewError(connect.CodeInternal, errors.New("internal server error"))
+	}
+
+	return connect.NewResponse(&v1.GetBatchJobReportResponse{
+		ContentType: "text/csv",
+		Content:     buf.Bytes(),
+	}), nil
+}
+
+// ListConsents returns the consent receipts recorded for a user.
+// Ownership is enforced by the BFF.
+func (h *UserServiceHandler) ListConsents(
+	ctx context.Context,
+	req *connect.Request[v1.ListConsentsRequest],
+) (*connect.Response[v1.ListConsentsResponse], error) {
+	userID, err := uuid.Parse(req.Msg.GetUserId())
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument,
+			errors.New("invalid user ID format"))
+	}
+
+	receipts, err := h.consentUC.ListConsents(ctx, userID, req.Msg.GetIncludeRevoked())
+	if err != nil {
+		h.logger.ErrorContext(ctx, "ListConsents failed",
+			slog.String("user_id", req.Msg.GetUserId()),
+			slog.String("error", err.Error()),
+		)
+		return nil, mapDomainError(err)
+	}
+
+	resp := &v1.ListConsentsResponse{
+		Consents: make([]*v1.ConsentReceipt, 0, len(receipts)),
+	}
+	for _, receipt := range receipts {
+		resp.Consents = append(resp.Consents, domainConsentToProto(receipt))
+	}
+
+	return connect.NewResponse(resp), nil
+}
+
+// RevokeConsent revokes a user's consent for an OAuth2 client.
+// Ownership is enforced by the BFF.
+func (h *UserServiceHandler) RevokeConsent(
+	ctx context.Context,
+	req *connect.Request[v1.RevokeConsentRequest],
+) (*connect.Response[v1.RevokeConsentResponse], error) {
+	userID, err := uuid.Parse(req.Msg.GetUserId())
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument,
+			errors.New("invalid user ID format"))
+	}
+
+	revoked, err := h.consentUC.RevokeConsent(ctx, userID, req.Msg.GetClientId())
+	if err != nil {
+		h.logger.ErrorContext(ctx, "RevokeConsent failed",
+			slog.String("user_id", req.Msg.GetUserId()),
+			slog.String("client_id", req.Msg.GetClientId()),
+			slog.String("error", err.Error()),
+		)
+		return nil, mapDomainError(err)
+	}
+
+	h.logger.InfoContext(ctx, "consent revoked",
+		slog.String("user_id", req.Msg.GetUserId()),
+		slog.String("client_id", req.Msg.GetClientId()),
+		slog.Int("revoked_count", revoked),
+	)
+
+	return connect.NewResponse(&v1.RevokeConsentResponse{
+		RevokedCount: int32(revoked),
+	}), nil
+}
+
+// ListConnectedApps returns the OAuth2 clients a user has granted access to.
+// Ownership is enforced by the BFF.
+func (h *UserServiceHandler) ListConnectedApps(
+	ctx context.Context,
+	req *connect.Request[v1.ListConnectedAppsRequest],
+) (*connect.Response[v1.ListConnectedAppsResponse], error) {
+	userID, err := uuid.Parse(req.Msg.GetUserId())
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument,
+			errors.New("invalid user ID format"))
+	}
+
+	apps, err := h.sessionUC.ListConnectedApps(ctx, userID)
+	if err != nil {
+		h.logger.ErrorContext(ctx, "ListConnectedApps failed",
+			slog.String("user_id", req.Msg.GetUserId()),
+			slog.String("error", err.Error()),
+		)
+		return nil, mapDomainError(err)
+	}
+
+	return connect.NewResponse(connectedAppsToProto(apps)), nil
+}
+
+// ListSessions returns a user's login sessions.
+// Ownership is enforced by the BFF.
+func (h *UserServiceHandler) ListSessions(
+	ctx context.Context,
+	req *connect.Request[v1.ListSessionsRequest],
+) (*connect.Response[v1.ListSessionsResponse], error) {
+	userID, err := uuid.Parse(req.Msg.GetUserId())
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument,
+			errors.New("invalid user ID format"))
+	}
+
+	sessions, err := h.sessionUC.ListSessions(ctx, userID)
+	if err != nil {
+		h.logger.ErrorContext(ctx, "ListSessions failed",
+			slog.String("user_id", req.Msg.GetUserId()),
+			slog.String("error", err.Error()),
+		)
+		return nil, mapDomainError(err)
+	}
+
+	resp := &v1.ListSessionsResponse{
+		Sessions: make([]*v1.Session, 0, len(sessions)),
+	}
+	for _, session := range sessions {
+		resp.Sessions = append(resp.Sessions, domainSessionToProto(session))
+	}
+
+	return connect.NewResponse(resp), nil
+}
+
+// GetLoginHistory returns a user's latest sign-in attempts.
+// Ownership is enforced by the BFF.
+func (h *UserServiceHandler) GetLoginHistory(
+	ctx context.Context,
+	req *connect.Request[v1.GetLoginHistoryRequest],
+) (*connect.Response[v1.GetLoginHistoryResponse], error) {
+	if h.loginHistoryUC == nil {
+		return nil, mapDomainError(domain.ErrLoginHistoryDisabled)
+	}
+	userID, err := uuid.Parse(req.Msg.GetUserId())
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument,
+			errors.New("invalid user ID format"))
+	}
+
+	events, err := h.loginHistoryUC.GetLoginHistory(ctx, userID, int(req.Msg.GetPageSize()))
+	if err != nil {
+		h.logger.ErrorContext(ctx, "GetLoginHistory failed",
+			slog.String("user_id", req.Msg.GetUserId()),
+			slog.String("error", err.Error()),
+		)
+		return nil, mapDomainError(err)
+	}
+
+	resp := &v1.GetLoginHistoryResponse{
+		Events: make([]*v1.LoginEvent, 0, len(events)),
+	}
+	for _, event := range events {
+		resp.Events = append(resp.Events, domainLoginEventToProto(event))
+	}
+
+	return connect.NewResponse(resp), nil
+}
+
+// AddAddress adds an address to a user's address book.
+// Ownership is enforced by the BFF.
+func (h *UserServiceHandler) AddAddress(
+	ctx context.Context,
+	req *connect.Request[v1.AddAddressRequest],
+) (*connect.Response[v1.AddAddressResponse], error) {
+	userID, err := uuid.Parse(req.Msg.GetUserId())
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument,
+			errors.New("invalid user ID format"))
+	}
+
+	address, err := h.addressUC.AddAddress(ctx, usecase.AddAddressInput{
+		UserID: userID,
+		AddressInput: domain.AddressInput{
+			Recipient:   req.Msg.GetRecipient(),
+			PhoneNumber: req.Msg.GetPhoneNumber(),
+			Country:     req.Msg.GetCountry(),
+			PostalCode:  req.Msg.GetPostalCode(),
+			Region:      req.Msg.GetRegion(),
+			City:        req.Msg.GetCity(),
+			Line1:       req.Msg.GetLine1(),
+			Line2:       req.Msg.GetLine2(),
+		},
+		DefaultShipping: req.Msg.GetDefaultShipping(),
+	})
+	if err != nil {
+		h.logger.ErrorContext(ctx, "AddAddress failed",
+			slog.String("user_id", req.Msg.GetUserId()),
+			slog.String("error", err.Error()),
+		)
+		return nil, mapDomainError(err)
+	}
+
+	return connect.NewResponse(&v1.AddAddressResponse{
+		Address: domainAddressToProto(address),
+	}), nil
+}
+
+// ListAddresses returns a user's address book.
+// Ownership is enforced by the BFF.
+func (h *UserServiceHandler) ListAddresses(
+	ctx context.Context,
+	req *connect.Request[v1.ListAddressesRequest],
+) (*connect.Response[v1.ListAddressesResponse], error) {
+	userID, err := uuid.Parse(req.Msg.GetUserId())
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument,
+			errors.New("invalid user ID format"))
+	}
+
+	addresses, err := h.addressUC.ListAddresses(ctx, userID)
+	if err != nil {
+		h.logger.ErrorContext(ctx, "ListAddresses failed",
+			slog.String("user_id", req.Msg.GetUserId()),
+			slog.String("error", err.Error()),
+		)
+		return nil, mapDomainError(err)
+	}
+
+	resp := &v1.ListAddressesResponse{
+		Addresses: make([]*v1.Address, 0, len(addresses)),
+	}
+	for _, address := range addresses {
+		resp.Addresses = append(resp.Addresses, domainAddressToProto(address))
+	}
+
+	return connect.NewResponse(resp), nil
+}
+
+// SetDefaultShippingAddress changes a user's default shipping address.
+// Ownership is enforced by the BFF.
+func (h *UserServiceHandler) SetDefaultShippingAddress(
+	ctx context.Context,
+	req *connect.Request[v1.SetDefaultShippingAddressRequest],
+) (*connect.Response[v1.SetDefaultShippingAddressResponse], error) {
+	userID, addressID, err := parseAddressIDs(req.Msg.GetUserId(), req.Msg.GetAddressId())
+	if err != nil {
+		return nil, err
+	}
+
+	address, err := h.addressUC.SetDefaultShippingAddress(ctx, userID, addressID)
+	if err != nil {
+		h.logger.ErrorContext(ctx, "SetDefaultShippingAddress failed",
+			slog.String("user_id", req.Msg.GetUserId()),
+			slog.String("address_id", req.Msg.GetAddressId()),
+			slog.String("error", err.Error()),
+		)
+		return nil, mapDomainError(err)
+	}
+
+	return connect.NewResponse(&v1.SetDefaultShippingAddressResponse{
+		Address: domainAddressToProto(address),
+	}), nil
+}
+
+// DeleteAddress removes an address from a user's address book.
+// Ownership is enforced by the BFF.
+func (h *UserServiceHandler) DeleteAddress(
+	ctx context.Context,
+	req *connect.Request[v1.DeleteAddressRequest],
+) (*connect.Response[v1.DeleteAddressResponse], error) {
+	userID, addressID, err := parseAddressIDs(req.Msg.GetUserId(), req.Msg.GetAddressId())
+	if err != nil {
+		return nil, err
+	}
+
+	if err := h.addressUC.DeleteAddress(ctx, userID, addressID); err != nil {
+		h.logger.ErrorContext(ctx, "DeleteAddress failed",
+			slog.String("user_id", req.Msg.GetUserId()),
+			slog.String("address_id", req.Msg.GetAddressId()),
+			slog.String("error", err.Error()),
+		)
+		return nil, mapDomainError(err)
+	}
+
+	return connect.NewResponse(&v1.DeleteAddressResponse{}), nil
+}
+
+func parseAddressIDs(rawUserID, rawAddressID string) (uuid.UUID, uuid.UUID, error) {
+	userID, err := uuid.Parse(rawUserID)
+	if err != nil {
+		return uuid.Nil, uuid.Nil, connect.NewError(connect.CodeInvalidArgument,
+			errors.New("invalid user ID format"))
+	}
+	addressID, err := uuid.Parse(rawAddressID)
+	if err != nil {
+		return uuid.Nil, uuid.Nil, connect.NewError(connect.CodeInvalidArgument,
+			errors.New("invalid address ID format"))
+	}
+	return userID, addressID, nil
+}
+
+// RevokeSession logs a user out of one login session.
+// Ownership is enforced by the BFF.
+func (h *UserServiceHandler) RevokeSession(
+	ctx context.Context,
+	req *connect.Request[v1.RevokeSessionRequest],
+) (*connect.Response[v1.RevokeSessionResponse], error) {
+	userID, err := uuid.Parse(req.Msg.GetUserId())
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument,
+			errors.New("invalid user ID format"))
+	}
+
+	revoked, err := h.sessionUC.RevokeSession(ctx, userID, req.Msg.GetSessionId())
+	if err != nil {
+		h.logger.ErrorContext(ctx, "RevokeSession failed",
+			slog.String("user_id", req.Msg.GetUserId()),
+			slog.String("session_id", req.Msg.GetSessionId()),
+			slog.String("error", err.Error()),
+		)
+		return nil, mapDomainError(err)
+	}
+
+	h.logger.InfoContext(ctx, "session revoked",
+		slog.String("user_id", req.Msg.GetUserId()),
+		slog.String("session_id", req.Msg.GetSessionId()),
+		slog.Any("revoked_client_ids", revoked),
+	)
+
+	return connect.NewResponse(&v1.RevokeSessionResponse{
+		RevokedClientIds: revoked,
+	}), nil
+}
+
+// GetTwoFactorStatus reports whether a user signs in with a TOTP code.
+// Ownership is enforced by the BFF.
+func (h *UserServiceHandler) GetTwoFactorStatus(
+	ctx context.Context,
+	req *connect.Request[v1.GetTwoFactorStatusRequest],
+) (*connect.Response[v1.GetTwoFactorStatusResponse], error) {
+	if h.twoFactorUC == nil {
+		return nil, mapDomainError(domain.ErrTwoFactorDisabled)
+	}
+	userID, err := uuid.Parse(req.Msg.GetUserId())
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument,
+			errors.New("invalid user ID format"))
+	}
+
+	status, err := h.twoFactorUC.GetStatus(ctx, userID)
+	if err != nil {
+		h.logger.ErrorContext(ctx, "GetTwoFactorStatus failed",
+			slog.String("user_id", req.Msg.GetUserId()),
+			slog.String("error", err.Error()),
+		)
+		return nil, mapDomainError(err)
+	}
+
+	return connect.NewResponse(&v1.GetTwoFactorStatusResponse{
+		Enabled:                status.Enabled,
+		RecoveryCodesRemaining: int32(status.RecoveryCodesRemaining),
+	}), nil
+}
+
+// EnrollTOTP starts a TOTP enrollment for a user.
+// Ownership is enforced by the BFF.
+func (h *UserServiceHandler) EnrollTOTP(
+	ctx context.Context,
+	req *connect.Request[v1.EnrollTOTPRequest],
+) (*connect.Response[v1.EnrollTOTPResponse], error) {
+	if h.twoFactorUC == nil {
+		return nil, mapDomainError(domain.ErrTwoFactorDisabled)
+	}
+	userID, err := uuid.Parse(req.Msg.GetUserId())
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument,
+			errors.New("invalid user ID format"))
+	}
+
+	enrollment, err := h.twoFactorUC.EnrollTOTP(ctx, userID)
+	if err != nil {
+		h.logger.ErrorContext(ctx, "EnrollTOTP failed",
+			slog.String("user_id", req.Msg.GetUserId()),
+			slog.String("error", err.Error()),
+		)
+		return nil, mapDomainError(err)
+	}
+
+	return connect.NewResponse(&v1.EnrollTOTPResponse{
+		Secret:          enrollment.Secret,
+		ProvisioningUri: enrollment.ProvisioningURI,
+	}), nil
+}
+
+// ConfirmTOTP enables two-factor authentication for a user.
+// Ownership is enforced by the BFF.
+func (h *UserServiceHandler) ConfirmTOTP(
+	ctx context.Context,
+	req *connect.Request[v1.ConfirmTOTPRequest],
+) (*connect.Response[v1.ConfirmTOTPResponse], error) {
+	if h.twoFactorUC == nil {
+		return nil, mapDomainError(domain.ErrTwoFactorDisabled)
+	}
+	userID, err := uuid.Parse(req.Msg.GetUserId())
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument,
+			errors.New("invalid user ID format"))
+	}
+
+	codes, err := h.twoFactorUC.ConfirmTOTP(ctx, userID, req.Msg.GetCode())
+	if err != nil {
+		h.logger.ErrorContext(ctx, "ConfirmTOTP failed",
+			slog.String("user_id", req.Msg.GetUserId()),
+			slog.String("error", err.Error()),
+		)
+		return nil, mapDomainError(err)
+	}
+
+	h.logger.InfoContext(ctx, "two-factor authentication enabled",
+		slog.String("user_id", req.Msg.GetUserId()),
+	)
+
+	return connect.NewResponse(&v1.ConfirmTOTPResponse{
+		RecoveryCodes: codes,
+	}), nil
+}
+
+// DisableTOTP turns two-factor authentication off for a user.
+// Ownership is enforced by the BFF.
+func (h *UserServiceHandler) DisableTOTP(
+	ctx context.Context,
+	req *connect.Request[v1.DisableTOTPRequest],
+) (*connect.Response[v1.DisableTOTPResponse], error) {
+	if h.twoFactorUC == nil {
+		return nil, mapDomainError(domain.ErrTwoFactorDisabled)
+	}
+	userID, err := uuid.Parse(req.Msg.GetUserId())
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument,
+			errors.New("invalid user ID format"))
+	}
+
+	if err := h.twoFactorUC.DisableTOTP(ctx, userID, req.Msg.GetCode()); err != nil {
+		h.logger.ErrorContext(ctx, "DisableTOTP failed",
+			slog.String("user_id", req.Msg.GetUserId()),
+			slog.String("error", err.Error()),
+		)
+		return nil, mapDomainError(err)
+	}
+
+	h.logger.InfoContext(ctx, "two-factor authentication disabled",
+		slog.String("user_id", req.Msg.GetUserId()),
+	)
+
+	return connect.NewResponse(&v1.DisableTOTPResponse{}), nil
+}
+
+// ChangePassword replaces a user's password after checking the current one.
+// Ownership is enforced by the BFF.
+func (h *UserServiceHandler) ChangePassword(
+	ctx context.Context,
+	req *connect.Request[v1.ChangePasswordRequest],
+) (*connect.Response[v1.ChangePasswordResponse], error) {
+	userID, err := uuid.Parse(req.Msg.GetUserId())
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument,
+			errors.New("invalid user ID format"))
+	}
+
+	if err := h.uc.ChangePassword(ctx, userID, req.Msg.GetCurrentPassword(), req.Msg.GetNewPassword()); err != nil {
+		h.logger.WarnContext(ctx, "ChangePassword failed",
+			slog.String("user_id", req.Msg.GetUserId()),
+			slog.String("error", err.Error()),
+		)
+		return nil, mapDomainError(err)
+	}
+
+	h.logger.InfoContext(ctx, "password changed",
+		slog.String("user_id", req.Msg.GetUserId()),
+	)
+
+	return connect.NewResponse(&v1.ChangePasswordResponse{}), nil
+}
+
+// CreateAccessGrant delegates a permission to a user for a limited time.
+// Which permissions the caller may grant is enforced by the BFF.
+func (h *UserServiceHandler) CreateAccessGrant(
+	ctx context.Context,
+	req *connect.Request[v1.CreateAccessGrantRequest],
+) (*connect.Response[v1.CreateAccessGrantResponse], error) {
+	userID, err := uuid.Parse(req.Msg.GetUserId())
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument,
+			errors.New("invalid user ID format"))
+	}
+
+	grant, err := h.grantUC.GrantAccess(ctx, usecase.GrantAccessInput{
+		GranteeID:  userID,
+		Permission: req.Msg.GetPermission(),
+		Reason:     req.Msg.GetReason(),
+		Duration:   time.Duration(req.Msg.GetDurationHours()) * time.Hour,
+		GrantedBy:  pkgmw.GetUserID(ctx),
+	})
+	if err != nil {
+		h.logger.ErrorContext(ctx, "CreateAccessGrant failed",
+			slog.String("user_id", req.Msg.GetUserId()),
+			slog.String("permission", req.Msg.GetPermission()),
+			slog.String("error", err.Error()),
+		)
+		return nil, mapDomainError(err)
+	}
+
+	h.logger.InfoContext(ctx, "access granted",
+		slog.String("grant_id", grant.ID.String()),
+		slog.String("user_id", req.Msg.GetUserId()),
+		slog.String("permission", grant.Permission),
+		slog.String("granted_by", grant.GrantedBy),
+		slog.Time("expires_at", grant.ExpiresAt),
+	)
+
+	return connect.NewResponse(&v1.CreateAccessGrantResponse{
+		Grant: domainAccessGrantToProto(grant),
+	}), nil
+}
+
+// RevokeAccessGrant ends an access grant before it expires.
+func (h *UserServiceHandler) RevokeAccessGrant(
+	ctx context.Context,
+	req *connect.Request[v1.RevokeAccessGrantRequest],
+) (*connect.Response[v1.RevokeAccessGrantResponse], error) {
+	id, err := uuid.Parse(req.Msg.GetId())
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument,
+			errors.New("invalid grant ID format"))
+	}
+
+	grant, err := h.grantUC.RevokeAccessGrant(ctx, id, pkgmw.GetUserID(ctx))
+	if err != nil {
+		h.logger.ErrorContext(ctx, "RevokeAccessGrant failed",
+			slog.String("grant_id", req.Msg.GetId()),
+			slog.String("error", err.Error()),
+		)
+		return nil, mapDomainError(err)
+	}
+
+	h.logger.InfoContext(ctx, "access grant revoked",
+		slog.String("grant_id", req.Msg.GetId()),
+		slog.String("revoked_by", grant.RevokedBy),
+	)
+
+	return connect.NewResponse(&v1.RevokeAccessGrantResponse{
+		Grant: domainAccessGrantToProto(grant),
+	}), nil
+}
+
+// ListAccessGrants returns the access grants given to a user.
+func (h *UserServiceHandler) ListAccessGrants(
+	ctx context.Context,
+	req *connect.Request[v1.ListAccessGrantsRequest],
+) (*connect.Response[v1.ListAccessGrantsResponse], error) {
+	userID, err := uuid.Parse(req.Msg.GetUserId())
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument,
+			errors.New("invalid user ID format"))
+	}
+
+	grants, err := h.grantUC.ListAccessGrants(ctx, userID, req.Msg.GetActiveOnly())
+	if err != nil {
+		h.logger.ErrorContext(ctx, "ListAccessGrants failed",
+			slog.String("user_id", req.Msg.GetUserId()),
+			slog.String("error", err.Error()),
+		)
+		return nil, mapDomainError(err)
+	}
+
+	resp := &v1.ListAccessGrantsResponse{
+		Grants: make([]*v1.AccessGrant, 0, len(grants)),
+	}
+	for _, grant := range grants {
+		resp.Grants = append(resp.Grants, domainAccessGrantToProto(grant))
+	}
+
+	return connect.NewResponse(resp), nil
+}
+
+// GetServerInfo reports the version and capabilities of this instance.
+// Procedures are taken from the compiled service descriptor, so the list
+// always matches the API this binary was built against.
+func (h *UserServiceHandler) GetServerInfo(
+	ctx context.Context,
+	req *connect.Request[v1.GetServerInfoRequest],
+) (*connect.Response[v1.GetServerInfoResponse], error) {
+	methods := v1.File_user_v1_user_service_proto.Services().ByName("UserService").Methods()
+	procedures := make([]string, 0, methods.Len())
+	for i := 0; i < methods.Len(); i++ {
+		procedures = append(procedures, "/"+userv1connect.UserServiceName+"/"+string(methods.Get(i).Name()))
+	}
+
+	return connect.NewResponse(&v1.GetServerInfoResponse{
+		Version:    h.version,
+		Procedures: procedures,
+		Features:   serverFeatures,
+	}), nil
+}
+
 // mapDomainError converts domain errors to Connect errors.
 func mapDomainError(err error) error {
 	switch {
@@ -206,24 +1148,266 @@ func mapDomainError(err error) error {
 	case errors.Is(err, domain.ErrInvalidEmail):
 		return connect.NewError(connect.CodeInvalidArgument, errors.New("invalid email format"))
 	case errors.Is(err, domain.ErrPasswordTooShort):
-		return connect.NewError(connect.CodeInvalidArgument, errors.New("password must be at least 8 characters"))
+		minLength := domain.MinPasswordLength
+		var short *domain.PasswordTooShortError
+		if errors.As(err, &short) {
+			minLength = short.MinLength
+		}
+		return errcode.New(connect.CodeInvalidArgument, fmt.Errorf("password must be at least %d characters", minLength),
+			errcode.PasswordTooShort, map[string]string{"min_length": strconv.Itoa(minLength)})
+	case errors.Is(err, domain.ErrPasswordTooWeak):
+		var weak *domain.PasswordTooWeakError
+		if !errors.As(err, &weak) {
+			weak = &domain.PasswordTooWeakError{MinCharClasses: domain.MaxPasswordCharClasses}
+		}
+		return errcode.New(connect.CodeInvalidArgument, weak,
+			errcode.PasswordTooWeak, map[string]string{"min_classes": strconv.Itoa(weak.MinCharClasses)})
+	case errors.Is(err, domain.ErrPasswordBreached):
+		return errcode.New(connect.CodeInvalidArgument, errors.New("password has appeared in a data breach"), errcode.PasswordBreached, nil)
+	case errors.Is(err, domain.ErrPasswordUnchanged):
+		return connect.NewError(connect.CodeInvalidArgument, errors.New("new password must differ from the current one"))
+	case errors.Is(err, domain.ErrIncorrectPassword):
+		return connect.NewError(connect.CodeInvalidArgument, errors.New("current password is incorrect"))
 	case errors.Is(err, domain.ErrEmptyEmail):
 		return connect.NewError(connect.CodeInvalidArgument, errors.New("email cannot be empty"))
 	case errors.Is(err, domain.ErrEmptyPassword):
-		return connect.NewError(connect.CodeInvalidArgument, errors.New("password cannot be empty"))
+		return errcode.New(connect.CodeInvalidArgument, errors.New("password cannot be empty"), errcode.PasswordEmpty, nil)
 	case errors.Is(err, domain.ErrNameTooLong):
 		return connect.NewError(connect.CodeInvalidArgument, errors.New("name is too long"))
+	case errors.Is(err, domain.ErrInvalidVerificationToken):
+		return connect.NewError(connect.CodeInvalidArgument, errors.New("invalid verification token"))
+	case errors.Is(err, domain.ErrVerificationTokenExpired):
+		return connect.NewError(connect.CodeFailedPrecondition, errors.New("verification token expired"))
+	case errors.Is(err, domain.ErrEmailVerificationDisabled):
+		return connect.NewError(connect.CodeUnimplemented, errors.New("email verification is disabled"))
+	case errors.Is(err, domain.ErrEmailAlreadyVerified):
+		return connect.NewError(connect.CodeFailedPrecondition, errors.New("email is already verified"))
+	case errors.Is(err, domain.ErrInvalidPageToken):
+		return connect.NewError(connect.CodeInvalidArgument, errors.New("invalid page token"))
+	case errors.Is(err, domain.ErrInvalidDateRange):
+		return connect.NewError(connect.CodeInvalidArgument, errors.New("created_after must be before created_before"))
+	case errors.Is(err, domain.ErrBatchJobNotFound):
+		return connect.NewError(connect.CodeNotFound, errors.New("batch job not found"))
+	case errors.Is(err, domain.ErrBatchJobNotFinished):
+		return connect.NewError(connect.CodeFailedPrecondition, errors.New("batch job has not finished"))
+	case errors.Is(err, domain.ErrEmptyBatchTarget),
+		errors.Is(err, domain.ErrBatchTooLarge),
+		errors.Is(err, domain.ErrInvalidSegment):
+		return connect.NewError(connect.CodeInvalidArgument, err)
+	case errors.Is(err, domain.ErrEmptyClientID):
+		return connect.NewError(connect.CodeInvalidArgument, errors.New("client ID cannot be empty"))
+	case errors.Is(err, domain.ErrSessionNotFound):
+		return connect.NewError(connect.CodeNotFound, errors.New("session not found"))
+	case errors.Is(err, domain.ErrEmptySessionID):
+		return connect.NewError(connect.CodeInvalidArgument, errors.New("session ID cannot be empty"))
+	case errors.Is(err, domain.ErrAccessGrantNotFound):
+		return connect.NewError(connect.CodeNotFound, errors.New("access grant not found"))
+	case errors.Is(err, domain.ErrEmptyGrantPermission),
+		errors.Is(err, domain.ErrPermissionNotGrantable),
+		errors.Is(err, domain.ErrInvalidGrantReason),
+		errors.Is(err, domain.ErrInvalidGrantDuration),
+		errors.Is(err, domain.ErrSelfGrant):
+		return connect.NewError(connect.CodeInvalidArgument, err)
+	case errors.Is(err, domain.ErrTwoFactorDisabled):
+		return connect.NewError(connect.CodeUnimplemented, errors.New("two-factor authentication is disabled"))
+	case errors.Is(err, domain.ErrTwoFactorNotEnrolled):
+		return connect.NewError(connect.CodeNotFound, errors.New("two-factor authentication is not enrolled"))
+	case errors.Is(err, domain.ErrTwoFactorAlreadyEnabled):
+		return connect.NewError(connect.CodeFailedPrecondition, errors.New("two-factor authentication is already enabled"))
+	case errors.Is(err, domain.ErrInvalidTOTPCode):
+		return connect.NewError(connect.CodeInvalidArgument, errors.New("invalid authentication code"))
+	case errors.Is(err, domain.ErrAccountLocked):
+		return errcode.New(connect.CodePermissionDenied, errors.New("account is temporarily locked"), errcode.AccountLocked, nil)
+	case errors.Is(err, domain.ErrLoginLockoutDisabled):
+		return connect.NewError(connect.CodeUnimplemented, errors.New("login lockout is disabled"))
+	case errors.Is(err, domain.ErrLoginHistoryDisabled):
+		return connect.NewError(connect.CodeUnimplemented, errors.New("login history is disabled"))
+	case errors.Is(err, domain.ErrAddressNotFound):
+		return connect.NewError(connect.CodeNotFound, errors.New("address not found"))
+	case errors.Is(err, domain.ErrTooManyAddresses):
+		return connect.NewError(connect.CodeResourceExhausted, fmt.Errorf("address book is full (at most %d addresses)", domain.MaxAddressesPerUser))
+	case errors.Is(err, domain.ErrInvalidCountryCode),
+		errors.Is(err, domain.ErrInvalidPostalCode),
+		errors.Is(err, domain.ErrInvalidPhoneNumber),
+		errors.Is(err, domain.ErrInvalidRecipient),
+		errors.Is(err, domain.ErrInvalidAddressLine),
+		errors.Is(err, domain.ErrInvalidLocality):
+		return connect.NewError(connect.CodeInvalidArgument, err)
 	default:
 		return connect.NewError(connect.CodeInternal, errors.New("internal server error"))
 	}
 }
 
 func domainUserToProto(user *domain.User) *v1.User {
-	return &v1.User{
-		Id:        user.ID.String(),
-		Email:     user.Email,
-		Name:      user.Name,
-		CreatedAt: timestamppb.New(user.CreatedAt),
-		UpdatedAt: timestamppb.New(user.UpdatedAt),
+	pb := &v1.User{
+		Id:            user.ID.String(),
+		Email:         user.Email,
+		Name:          user.Name,
+		CreatedAt:     timestamppb.New(user.CreatedAt),
+		UpdatedAt:     timestamppb.New(user.UpdatedAt),
+		EmailVerified: user.EmailVerified,
+	}
+	if user.DeletedAt != nil {
+		pb.DeletedAt = timestamppb.New(*user.DeletedAt)
+	}
+	return pb
+}
+
+func protoBatchTargetToDomain(target *v1.BatchTarget) (usecase.BatchTarget, error) {
+	var out usecase.BatchTarget
+	if ids := target.GetUserIds(); ids != nil {
+		for _, raw := range ids.GetIds() {
+			id, err := uuid.Parse(raw)
+			if err != nil {
+				return out, connect.NewError(connect.CodeInvalidArgument,
+					errors.New("invalid user ID format"))
+			}
+			out.UserIDs = append(out.UserIDs, id)
+		}
+		return out, nil
+	}
+
+	if f := target.GetFilter(); f != nil {
+		filter := &domain.UserFilter{EmailContains: f.EmailContains}
+		if f.CreatedAfter != nil {
+			t := f.CreatedAfter.AsTime()
+			filter.CreatedAfter = &t
+		}
+		if f.CreatedBefore != nil {
+			t := f.CreatedBefore.AsTime()
+			filter.CreatedBefore = &t
+		}
+		out.Filter = filter
+	}
+	return out, nil
+}
+
+func domainBatchJobToProto(job *domain.BatchJob) *v1.BatchJob {
+	pb := &v1.BatchJob{
+		Id:        job.ID.String(),
+		Total:     int32(job.Total),
+		Processed: int32(job.Processed),
+		Succeeded: int32(job.Succeeded),
+		Failed:    int32(job.Failed),
+		CreatedAt: timestamppb.New(job.CreatedAt),
+		Segment:   job.Segment,
+	}
+	switch job.Kind {
+	case domain.BatchJobDeactivate:
+		pb.Kind = v1.BatchJobKind_BATCH_JOB_KIND_DEACTIVATE
+	case domain.BatchJobAssignSegment:
+		pb.Kind = v1.BatchJobKind_BATCH_JOB_KIND_ASSIGN_SEGMENT
+	}
+	switch job.Status {
+	case domain.BatchJobPending:
+		pb.Status = v1.BatchJobStatus_BATCH_JOB_STATUS_PENDING
+	case domain.BatchJobRunning:
+		pb.Status = v1.BatchJobStatus_BATCH_JOB_STATUS_RUNNING
+	case domain.BatchJobCompleted:
+		pb.Status = v1.BatchJobStatus_BATCH_JOB_STATUS_COMPLETED
+	case domain.BatchJobFailed:
+		pb.Status = v1.BatchJobStatus_BATCH_JOB_STATUS_FAILED
+	}
+	if job.CompletedAt != nil {
+		pb.CompletedAt = timestamppb.New(*job.CompletedAt)
+	}
+	return pb
+}
+
+func domainConsentToProto(receipt *domain.ConsentReceipt) *v1.ConsentReceipt {
+	pb := &v1.ConsentReceipt{
+		Id:         receipt.ID.String(),
+		ClientId:   receipt.ClientID,
+		ClientName: receipt.ClientName,
+		Scopes:     receipt.Scopes,
+		Remember:   receipt.Remember,
+		GrantedAt:  timestamppb.New(receipt.GrantedAt),
+	}
+	if receipt.RevokedAt != nil {
+		pb.RevokedAt = timestamppb.New(*receipt.RevokedAt)
+	}
+	return pb
+}
+
+func domainSessionToProto(session *domain.Session) *v1.Session {
+	pb := &v1.Session{
+		Id:         session.ID,
+		UserAgent:  session.UserAgent,
+		LastUsedAt: timestamppb.New(session.LastUsedAt),
+		Clients:    make([]*v1.SessionClient, 0, len(session.Clients)),
+	}
+	if !session.AuthenticatedAt.IsZero() {
+		pb.AuthenticatedAt = timestamppb.New(session.AuthenticatedAt)
+	}
+	for _, client := range session.Clients {
+		pb.Clients = append(pb.Clients, &v1.SessionClient{
+			ClientId:   client.ClientID,
+			ClientName: client.ClientName,
+			Scopes:     client.Scopes,
+		})
+	}
+	return pb
+}
+
+func connectedAppsToProto(apps []*domain.ConnectedApp) *v1.ListConnectedAppsResponse {
+	resp := &v1.ListConnectedAppsResponse{
+		Apps: make([]*v1.ConnectedApp, 0, len(apps)),
+	}
+	for _, app := range apps {
+		resp.Apps = append(resp.Apps, &v1.ConnectedApp{
+			ClientId:       app.ClientID,
+			ClientName:     app.ClientName,
+			Scopes:         app.Scopes,
+			FirstGrantedAt: timestamppb.New(app.FirstGrantedAt),
+			LastGrantedAt:  timestamppb.New(app.LastGrantedAt),
+			SessionCount:   int32(app.Sessions),
+		})
+	}
+	return resp
+}
+
+func domainLoginEventToProto(event *domain.LoginEvent) *v1.LoginEvent {
+	return &v1.LoginEvent{
+		Id:            event.ID.String(),
+		IpAddress:     event.IPAddress,
+		UserAgent:     event.UserAgent,
+		Succeeded:     event.Succeeded,
+		FailureReason: event.FailureReason,
+		NewDevice:     event.NewDevice,
+		OccurredAt:    timestamppb.New(event.OccurredAt),
+	}
+}
+
+func domainAddressToProto(address *domain.Address) *v1.Address {
+	return &v1.Address{
+		Id:              address.ID.String(),
+		Recipient:       address.Recipient,
+		PhoneNumber:     string(address.PhoneNumber),
+		Country:         address.Country,
+		PostalCode:      address.PostalCode,
+		Region:          address.Region,
+		City:            address.City,
+		Line1:           address.Line1,
+		Line2:           address.Line2,
+		DefaultShipping: address.DefaultShipping,
+		CreatedAt:       timestamppb.New(address.CreatedAt),
+		UpdatedAt:       timestamppb.New(address.UpdatedAt),
+	}
+}
+
+func domainAccessGrantToProto(grant *domain.AccessGrant) *v1.AccessGrant {
+	pb := &v1.AccessGrant{
+		Id:         grant.ID.String(),
+		UserId:     grant.GranteeID.String(),
+		Permission: grant.Permission,
+		Reason:     grant.Reason,
+		GrantedBy:  grant.GrantedBy,
+		GrantedAt:  timestamppb.New(grant.GrantedAt),
+		ExpiresAt:  timestamppb.New(grant.ExpiresAt),
+		RevokedBy:  grant.RevokedBy,
+	}
+	if grant.RevokedAt != nil {
+		pb.RevokedAt = timestamppb.New(*grant.RevokedAt)
 	}
+	return pb
 }
@@ -15,6 +15,8 @@ import (
 
 	v1 "github.com/daisuke8000/example-ec-platform/gen/user/v1"
 	"github.com/daisuke8000/example-ec-platform/gen/user/v1/userv1connect"
+	"github.com/daisuke8000/example-ec-platform/pkg/connect/errcode"
+	"github.com/daisuke8000/example-ec-platform/pkg/listing"
 	"github.com/daisuke8000/example-ec-platform/services/user/internal/domain"
 	"github.com/daisuke8000/example-ec-platform/services/user/internal/usecase"
 )
@@ -26,6 +28,12 @@ type mockUserUseCase struct {
 	updateUserFn     func(ctx context.Context, id uuid.UUID, input usecase.UpdateUserInput) (*domain.User, error)
 	deleteUserFn     func(ctx context.Context, id uuid.UUID) error
 	verifyPasswordFn func(ctx context.Context, email, password string) (*domain.User, error)
+	verifyEmailFn    func(ctx context.Context, token string) (*domain.User, error)
+	resendFn         func(ctx context.Context, id uuid.UUID) error
+	listUsersFn      func(ctx context.Context, input usecase.ListUsersInput) (*usecase.ListUsersOutput, error)
+	getUserRolesFn   func(ctx context.Context, id uuid.UUID) ([]*domain.Role, error)
+	unlockUserFn     func(ctx context.Context, id uuid.UUID) error
+	changePasswordFn func(ctx context.Context, id uuid.UUID, currentPassword, newPassword string) error
 }
 
 func (m *mockUserUseCase) CreateUser(ctx context.Context, input usecase.CreateUserInput) (*domain.User, error) {
@@ -63,9 +71,141 @@ func (m *mockUserUseCase) VerifyPassword(ctx context.Context, email, password st
 	return nil, nil
 }
 
+func (m *mockUserUseCase) VerifyEmail(ctx context.Context, token string) (*domain.User, error) {
+	if m.verifyEmailFn != nil {
+		return m.verifyEmailFn(ctx, token)
+	}
+	return nil, nil
+}
+
+func (m *mockUserUseCase) ResendVerificationEmail(ctx context.Context, id uuid.UUID) error {
+	if m.resendFn != nil {
+		return m.resendFn(ctx, id)
+	}
+	return nil
+}
+
+func (m *mockUserUseCase) ListUsers(ctx context.Context, input usecase.ListUsersInput) (*usecase.ListUsersOutput, error) {
+	if m.listUsersFn != nil {
+		return m.listUsersFn(ctx, input)
+	}
+	return &usecase.ListUsersOutput{}, nil
+}
+
+func (m *mockUserUseCase) GetUserRoles(ctx context.Context, id uuid.UUID) ([]*domain.Role, error) {
+	if m.getUserRolesFn != nil {
+		return m.getUserRolesFn(ctx, id)
+	}
+	return nil, nil
+}
+
+func (m *mockUserUseCase) UnlockUser(ctx context.Context, id uuid.UUID) error {
+	if m.unlockUserFn != nil {
+		return m.unlockUserFn(ctx, id)
+	}
+	return nil
+}
+
+func (m *mockUserUseCase) ChangePassword(ctx context.Context, id uuid.UUID, currentPassword, newPassword string) error {
+	if m.changePasswordFn != nil {
+		return m.changePasswordFn(ctx, id, currentPassword, newPassword)
+	}
+	return nil
+}
+
+// mockBatchUserUseCase is a test double for usecase.BatchUserUseCase.
+type mockBatchUserUseCase struct {
+	batchDeactivateFn func(ctx context.Context, target usecase.BatchTarget, requestedBy string) (*domain.BatchJob, error)
+	getBatchJobFn     func(ctx context.Context, id uuid.UUID) (*domain.BatchJob, error)
+	getReportFn       func(ctx context.Context, id uuid.UUID) ([]domain.BatchJobResult, error)
+}
+
+func (m *mockBatchUserUseCase) BatchDeactivateUsers(ctx context.Context, target usecase.BatchTarget, requestedBy string) (*domain.BatchJob, error) {
+	if m.batchDeactivateFn != nil {
+		return m.batchDeactivateFn(ctx, target, requestedBy)
+	}
+	return nil, nil
+}
+
+func (m *mockBatchUserUseCase) BatchAssignSegment(ctx context.Context, target usecase.BatchTarget, segment, requestedBy string) (*domain.BatchJob, error) {
+	return nil, nil
+}
+
+func (m *mockBatchUserUseCase) GetBatchJob(ctx context.Context, id uuid.UUID) (*domain.BatchJob, error) {
+	if m.getBatchJobFn != nil {
+		return m.getBatchJobFn(ctx, id)
+	}
+	return nil, nil
+}
+
+func (m *mockBatchUserUseCase) GetBatchJobReport(ctx context.Context, id uuid.UUID) ([]domain.BatchJobResult, error) {
+	if m.getReportFn != nil {
+		return m.getReportFn(ctx, id)
+	}
+	return nil, nil
+}
+
+func (m *mockBatchUserUseCase) Wait() {}
+
+// mockConsentUseCase is a test double for usecase.ConsentUseCase.
+type mockConsentUseCase struct {
+	listConsentsFn  func(ctx context.Context, userID uuid.UUID, includeRevoked bool) ([]*domain.ConsentReceipt, error)
+	revokeConsentFn func(ctx context.Context, userID uuid.UUID, clientID string) (int, error)
+}
+
+func (m *mockConsentUseCase) RecordConsent(ctx context.Context, input usecase.RecordConsentInput) (*domain.ConsentReceipt, error) {
+	return nil, nil
+}
+
+func (m *mockConsentUseCase) ListConsents(ctx context.Context, userID uuid.UUID, includeRevoked bool) ([]*domain.ConsentReceipt, error) {
+	if m.listConsentsFn != nil {
+		return m.listConsentsFn(ctx, userID, includeRevoked)
+	}
+	return nil, nil
+}
+
+func (m *mockConsentUseCase) RevokeConsent(ctx context.Context, userID uuid.UUID, clientID string) (int, error) {
+	if m.revokeConsentFn != nil {
+		return m.revokeConsentFn(ctx, userID, clientID)
+	}
+	return 0, nil
+}
+
+type mockSessionUseCase struct {
+	listConnectedAppsFn func(ctx context.Context, userID uuid.UUID) ([]*domain.ConnectedApp, error)
+}
+
+func (m *mockSessionUseCase) ListSessions(ctx context.Context, userID uuid.UUID) ([]*domain.Session, error) {
+	return nil, nil
+}
+
+func (m *mockSessionUseCase) RevokeSession(ctx context.Context, userID uuid.UUID, sessionID string) ([]string, error) {
+	return nil, nil
+}
+
+func (m *mockSessionUseCase) ListConnectedApps(ctx context.Context, userID uuid.UUID) ([]*domain.ConnectedApp, error) {
+	if m.listConnectedAppsFn != nil {
+		return m.listConnectedAppsFn(ctx, userID)
+	}
+	return nil, nil
+}
+
 func newTestServer(uc *mockUserUseCase) (*httptest.Server, userv1connect.UserServiceClient) {
+	return newTestServerWithBatch(uc, &mockBatchUserUseCase{})
+}
+
+func newTestServerWithBatch(uc *mockUserUseCase, batchUC *mockBatchUserUseCase) (*httptest.Server, userv1connect.UserServiceClient) {
+	return newTestServerWithDeps(uc, batchUC, &mockConsentUseCase{})
+}
+
+func newTestServerWithConsent(consentUC *mockConsentUseCase) (*httptest.Server, userv1connect.UserServiceClient) {
+	return newTestServerWithDeps(&mockUserUseCase{}, &mockBatchUserUseCase{}, consentUC)
+}
+
+func newTestServerWithDeps(uc *mockUserUseCase, batchUC *mockBatchUserUseCase, consentUC *mockConsentUseCase) (*httptest.Server, userv1connect.UserServiceClient) {
 	logger := slog.New(slog.NewTextHandler(os.Stdout, &slog.HandlerOptions{Level: slog.LevelError}))
-	handler := NewUserServiceHandler(uc, logger)
+	pageTokens, _ := listing.NewCodec("test-secret")
+	handler := NewUserServiceHandler(uc, batchUC, consentUC, nil, nil, nil, nil, nil, "test", pageTokens, logger)
 
 	mux := http.NewServeMux()
 	path, h := userv1connect.NewUserServiceHandler(handler)
@@ -168,6 +308,121 @@ func TestCreateUser(t *testing.T) {
 	}
 }
 
+func TestCreateUser_PasswordErrorCarriesCode(t *testing.T) {
+	mock := &mockUserUseCase{createUserFn: func(ctx context.Context, input usecase.CreateUserInput) (*domain.User, error) {
+		return nil, domain.ErrPasswordTooShort
+	}}
+	server, client := newTestServer(mock)
+	defer server.Close()
+
+	_, err := client.CreateUser(context.Background(), connect.NewRequest(&v1.CreateUserRequest{
+		Email:    "test@example.com",
+		Password: "short",
+	}))
+
+	info, ok := errcode.Info(err)
+	if !ok {
+		t.Fatalf("CreateUser() error has no error code: %v", err)
+	}
+	if info.GetReason() != errcode.PasswordTooShort {
+		t.Errorf("reason = %q, want %q", info.GetReason(), errcode.PasswordTooShort)
+	}
+	if got := info.GetMetadata()["min_length"]; got != "8" {
+		t.Errorf("min_length = %q, want %q", got, "8")
+	}
+}
+
+func TestChangePassword(t *testing.T) {
+	userID := uuid.New()
+
+	tests := []struct {
+		name     string
+		userID   string
+		err      error
+		wantCode connect.Code
+		wantErr  string
+	}{
+		{"changes the password", userID.String(), nil, 0, ""},
+		{"invalid user ID", "not-a-uuid", nil, connect.CodeInvalidArgument, ""},
+		{"incorrect current password", userID.String(), domain.ErrIncorrectPassword, connect.CodeInvalidArgument, ""},
+		{"unchanged", userID.String(), domain.ErrPasswordUnchanged, connect.CodeInvalidArgument, ""},
+		{"too weak", userID.String(), &domain.PasswordTooWeakError{MinCharClasses: 3}, connect.CodeInvalidArgument, errcode.PasswordTooWeak},
+		{"breached", userID.String(), domain.ErrPasswordBreached, connect.CodeInvalidArgument, errcode.PasswordBreached},
+		{"locked", userID.String(), domain.ErrAccountLocked, connect.CodePermissionDenied, errcode.AccountLocked},
+		{"not found", userID.String(), domain.ErrUserNotFound, connect.CodeNotFound, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var gotCurrent, gotNew string
+			mock := &mockUserUseCase{changePasswordFn: func(ctx context.Context, id uuid.UUID, currentPassword, newPassword string) error {
+				gotCurrent, gotNew = currentPassword, newPassword
+				return tt.err
+			}}
+			server, client := newTestServer(mock)
+			defer server.Close()
+
+			_, err := client.ChangePassword(context.Background(), connect.NewRequest(&v1.ChangePasswordRequest{
+				UserId:          tt.userID,
+				CurrentPassword: "password123",
+				NewPassword:     "new-password-456",
+			}))
+
+			if tt.wantCode == 0 {
+				if err != nil {
+					t.Fatalf("ChangePassword() error = %v, want nil", err)
+				}
+				if gotCurrent != "password123" || gotNew != "new-password-456" {
+					t.Errorf("passwords = %q, %q", gotCurrent, gotNew)
+				}
+				return
+			}
+			if connect.CodeOf(err) != tt.wantCode {
+				t.Errorf("ChangePassword() error code = %v, want %v", connect.CodeOf(err), tt.wantCode)
+			}
+			if tt.wantErr != "" {
+				if info, ok := errcode.Info(err); !ok || info.GetReason() != tt.wantErr {
+					t.Errorf("ChangePassword() error code reason = %v, want %q", info.GetReason(), tt.wantErr)
+				}
+			}
+		})
+	}
+}
+
+func TestChangePassword_PolicyErrorsCarryRequirement(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		key  string
+		want string
+	}{
+		{"too short", &domain.PasswordTooShortError{MinLength: 12}, "min_length", "12"},
+		{"too weak", &domain.PasswordTooWeakError{MinCharClasses: 3}, "min_classes", "3"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mock := &mockUserUseCase{changePasswordFn: func(ctx context.Context, id uuid.UUID, currentPassword, newPassword string) error {
+				return tt.err
+			}}
+			server, client := newTestServer(mock)
+			defer server.Close()
+
+			_, err := client.ChangePassword(context.Background(), connect.NewRequest(&v1.ChangePasswordRequest{
+				UserId:          uuid.NewString(),
+				CurrentPassword: "password123",
+				NewPassword:     "weak",
+			}))
+
+			info, ok := errcode.Info(err)
+			if !ok {
+				t.Fatalf("ChangePassword() error has no error code: %v", err)
+			}
+			if got := info.GetMetadata()[tt.key]; got != tt.want {
+				t.Errorf("%s = %q, want %q", tt.key, got, tt.want)
+			}
+		})
+	}
+}
+
 func TestGetUser(t *testing.T) {
 	testUser := createTestUser()
 
@@ -462,6 +717,542 @@ func TestVerifyPassword(t *testing.T) {
 	}
 }
 
+func TestVerifyPassword_LockedErrorCarriesCode(t *testing.T) {
+	mock := &mockUserUseCase{verifyPasswordFn: func(ctx context.Context, email, password string) (*domain.User, error) {
+		return nil, domain.ErrAccountLocked
+	}}
+	server, client := newTestServer(mock)
+	defer server.Close()
+
+	_, err := client.VerifyPassword(context.Background(), connect.NewRequest(&v1.VerifyPasswordRequest{
+		Email:    "test@example.com",
+		Password: "password123",
+	}))
+
+	if connect.CodeOf(err) != connect.CodePermissionDenied {
+		t.Errorf("VerifyPassword() error code = %v, want %v", connect.CodeOf(err), connect.CodePermissionDenied)
+	}
+	info, ok := errcode.Info(err)
+	if !ok || info.GetReason() != errcode.AccountLocked {
+		t.Errorf("VerifyPassword() error code reason = %v, want %q", info.GetReason(), errcode.AccountLocked)
+	}
+}
+
+func TestVerifyEmail(t *testing.T) {
+	testUser := createTestUser()
+	testUser.EmailVerified = true
+
+	tests := []struct {
+		name     string
+		mockFn   func(ctx context.Context, token string) (*domain.User, error)
+		wantCode connect.Code
+	}{
+		{
+			name: "verifies email successfully",
+			mockFn: func(ctx context.Context, token string) (*domain.User, error) {
+				return testUser, nil
+			},
+			wantCode: 0,
+		},
+		{
+			name: "returns invalid argument for unknown token",
+			mockFn: func(ctx context.Context, token string) (*domain.User, error) {
+				return nil, domain.ErrInvalidVerificationToken
+			},
+			wantCode: connect.CodeInvalidArgument,
+		},
+		{
+			name: "returns failed precondition for expired token",
+			mockFn: func(ctx context.Context, token string) (*domain.User, error) {
+				return nil, domain.ErrVerificationTokenExpired
+			},
+			wantCode: connect.CodeFailedPrecondition,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mock := &mockUserUseCase{verifyEmailFn: tt.mockFn}
+			server, client := newTestServer(mock)
+			defer server.Close()
+
+			resp, err := client.VerifyEmail(context.Background(), connect.NewRequest(&v1.VerifyEmailRequest{
+				Token: "token",
+			}))
+
+			if tt.wantCode == 0 {
+				if err != nil {
+					t.Errorf("VerifyEmail() error = %v, want nil", err)
+					return
+				}
+				if !resp.Msg.GetUser().GetEmailVerified() {
+					t.Error("VerifyEmail() returned user with email_verified = false")
+				}
+			} else {
+				var connectErr *connect.Error
+				if !errors.As(err, &connectErr) {
+					t.Errorf("VerifyEmail() error is not a Connect error: %v", err)
+					return
+				}
+				if connectErr.Code() != tt.wantCode {
+					t.Errorf("VerifyEmail() error code = %v, want %v", connectErr.Code(), tt.wantCode)
+				}
+			}
+		})
+	}
+}
+
+func TestListUsers(t *testing.T) {
+	testUser := createTestUser()
+
+	t.Run("maps filters and returns page", func(t *testing.T) {
+		var gotInput usecase.ListUsersInput
+		mock := &mockUserUseCase{
+			listUsersFn: func(ctx context.Context, input usecase.ListUsersInput) (*usecase.ListUsersOutput, error) {
+				gotInput = input
+				return &usecase.ListUsersOutput{
+					Users:         []*domain.User{testUser},
+					NextPageToken: "next",
+				}, nil
+			},
+		}
+		server, client := newTestServer(mock)
+		defer server.Close()
+
+		contains := "example"
+		resp, err := client.ListUsers(context.Background(), connect.NewRequest(&v1.ListUsersRequest{
+			PageSize:       10,
+			EmailContains:  &contains,
+			IncludeDeleted: true,
+		}))
+		if err != nil {
+			t.Fatalf("ListUsers() error = %v", err)
+		}
+		if len(resp.Msg.GetUsers()) != 1 || resp.Msg.GetNextPageToken() == "" || resp.Msg.GetNextPageToken() == "next" {
+			t.Errorf("ListUsers() = %v, want 1 user and an opaque next token", resp.Msg)
+		}
+		if gotInput.PageSize != 10 || !gotInput.Filter.IncludeDeleted || gotInput.Filter.EmailContains == nil {
+			t.Errorf("ListUsers() input = %+v, filters not mapped", gotInput)
+		}
+
+		_, err = client.ListUsers(context.Background(), connect.NewRequest(&v1.ListUsersRequest{
+			PageSize:       10,
+			PageToken:      resp.Msg.GetNextPageToken(),
+			EmailContains:  &contains,
+			IncludeDeleted: true,
+		}))
+		if err != nil {
+			t.Fatalf("ListUsers(next page) error = %v", err)
+		}
+		if gotInput.PageToken != "next" {
+			t.Errorf("ListUsers(next page) cursor = %q, want %q", gotInput.PageToken, "next")
+		}
+
+		// The token is bound to the filters it was issued for.
+		_, err = client.ListUsers(context.Background(), connect.NewRequest(&v1.ListUsersRequest{
+			PageToken: resp.Msg.GetNextPageToken(),
+		}))
+		if connect.CodeOf(err) != connect.CodeInvalidArgument {
+			t.Errorf("ListUsers(changed filters) code = %v, want %v", connect.CodeOf(err), connect.CodeInvalidArgument)
+		}
+	})
+
+	t.Run("returns invalid argument for bad page token", func(t *testing.T) {
+		mock := &mockUserUseCase{
+			listUsersFn: func(ctx context.Context, input usecase.ListUsersInput) (*usecase.ListUsersOutput, error) {
+				return nil, domain.ErrInvalidPageToken
+			},
+		}
+		server, client := newTestServer(mock)
+		defer server.Close()
+
+		_, err := client.ListUsers(context.Background(), connect.NewRequest(&v1.ListUsersRequest{PageToken: "bad"}))
+		if connect.CodeOf(err) != connect.CodeInvalidArgument {
+			t.Errorf("ListUsers() error code = %v, want %v", connect.CodeOf(err), connect.CodeInvalidArgument)
+		}
+	})
+}
+
+func TestGetUserRoles(t *testing.T) {
+	t.Run("returns roles with permissions", func(t *testing.T) {
+		mock := &mockUserUseCase{
+			getUserRolesFn: func(ctx context.Context, id uuid.UUID) ([]*domain.Role, error) {
+				return []*domain.Role{{Name: "admin", Permissions: []string{"users:list"}}}, nil
+			},
+		}
+		server, client := newTestServer(mock)
+		defer server.Close()
+
+		resp, err := client.GetUserRoles(context.Background(), connect.NewRequest(&v1.GetUserRolesRequest{
+			UserId: uuid.New().String(),
+		}))
+		if err != nil {
+			t.Fatalf("GetUserRoles() error = %v", err)
+		}
+		roles := resp.Msg.GetRoles()
+		if len(roles) != 1 || roles[0].GetName() != "admin" || len(roles[0].GetPermissions()) != 1 {
+			t.Errorf("GetUserRoles() = %v, want admin with 1 permission", roles)
+		}
+	})
+
+	t.Run("invalid user ID", func(t *testing.T) {
+		server, client := newTestServer(&mockUserUseCase{})
+		defer server.Close()
+
+		_, err := client.GetUserRoles(context.Background(), connect.NewRequest(&v1.GetUserRolesRequest{UserId: "bad"}))
+		if connect.CodeOf(err) != connect.CodeInvalidArgument {
+			t.Errorf("GetUserRoles() error code = %v, want %v", connect.CodeOf(err), connect.CodeInvalidArgument)
+		}
+	})
+
+	t.Run("user not found", func(t *testing.T) {
+		mock := &mockUserUseCase{
+			getUserRolesFn: func(ctx context.Context, id uuid.UUID) ([]*domain.Role, error) {
+				return nil, domain.ErrUserNotFound
+			},
+		}
+		server, client := newTestServer(mock)
+		defer server.Close()
+
+		_, err := client.GetUserRoles(context.Background(), connect.NewRequest(&v1.GetUserRolesRequest{
+			UserId: uuid.New().String(),
+		}))
+		if connect.CodeOf(err) != connect.CodeNotFound {
+			t.Errorf("GetUserRoles() error code = %v, want %v", connect.CodeOf(err), connect.CodeNotFound)
+		}
+	})
+}
+
+func TestBatchDeactivateUsers(t *testing.T) {
+	t.Run("maps user IDs and returns job", func(t *testing.T) {
+		userID := uuid.New()
+		var gotTarget usecase.BatchTarget
+		batch := &mockBatchUserUseCase{
+			batchDeactivateFn: func(ctx context.Context, target usecase.BatchTarget, requestedBy string) (*domain.BatchJob, error) {
+				gotTarget = target
+				return domain.NewBatchJob(domain.BatchJobDeactivate, "", requestedBy, len(target.UserIDs)), nil
+			},
+		}
+		server, client := newTestServerWithBatch(&mockUserUseCase{}, batch)
+		defer server.Close()
+
+		resp, err := client.BatchDeactivateUsers(context.Background(), connect.NewRequest(&v1.BatchDeactivateUsersRequest{
+			Target: &v1.BatchTarget{Target: &v1.BatchTarget_UserIds{
+				UserIds: &v1.UserIdList{Ids: []string{userID.String()}},
+			}},
+		}))
+		if err != nil {
+			t.Fatalf("BatchDeactivateUsers() error = %v", err)
+		}
+		if resp.Msg.GetJob().GetStatus() != v1.BatchJobStatus_BATCH_JOB_STATUS_PENDING || resp.Msg.GetJob().GetTotal() != 1 {
+			t.Errorf("BatchDeactivateUsers() job = %v, want pending with total 1", resp.Msg.GetJob())
+		}
+		if len(gotTarget.UserIDs) != 1 || gotTarget.UserIDs[0] != userID {
+			t.Errorf("BatchDeactivateUsers() target = %+v, want [%s]", gotTarget, userID)
+		}
+	})
+
+	t.Run("rejects malformed user ID", func(t *testing.T) {
+		server, client := newTestServer(&mockUserUseCase{})
+		defer server.Close()
+
+		_, err := client.BatchDeactivateUsers(context.Background(), connect.NewRequest(&v1.BatchDeactivateUsersRequest{
+			Target: &v1.BatchTarget{Target: &v1.BatchTarget_UserIds{
+				UserIds: &v1.UserIdList{Ids: []string{"bad"}},
+			}},
+		}))
+		if connect.CodeOf(err) != connect.CodeInvalidArgument {
+			t.Errorf("BatchDeactivateUsers() error code = %v, want %v", connect.CodeOf(err), connect.CodeInvalidArgument)
+		}
+	})
+}
+
+func TestGetBatchJobReport(t *testing.T) {
+	t.Run("returns CSV report", func(t *testing.T) {
+		userID := uuid.New()
+		batch := &mockBatchUserUseCase{
+			getReportFn: func(ctx context.Context, id uuid.UUID) ([]domain.BatchJobResult, error) {
+				return []domain.BatchJobResult{{UserID: userID, Status: domain.BatchResultSucceeded}}, nil
+			},
+		}
+		server, client := newTestServerWithBatch(&mockUserUseCase{}, batch)
+		defer server.Close()
+
+		resp, err := client.GetBatchJobReport(context.Background(), connect.NewRequest(&v1.GetBatchJobReportRequest{
+			JobId: uuid.New().String(),
+		}))
+		if err != nil {
+			t.Fatalf("GetBatchJobReport() error = %v", err)
+		}
+		want := "user_id,status,error\n" + userID.String() + ",succeeded,\n"
+		if string(resp.Msg.GetContent()) != want || resp.Msg.GetContentType() != "text/csv" {
+			t.Errorf("GetBatchJobReport() = %q (%s), want %q", resp.Msg.GetContent(), resp.Msg.GetContentType(), want)
+		}
+	})
+
+	t.Run("job still running", func(t *testing.T) {
+		batch := &mockBatchUserUseCase{
+			getReportFn: func(ctx context.Context, id uuid.UUID) ([]domain.BatchJobResult, error) {
+				return nil, domain.ErrBatchJobNotFinished
+			},
+		}
+		server, client := newTestServerWithBatch(&mockUserUseCase{}, batch)
+		defer server.Close()
+
+		_, err := client.GetBatchJobReport(context.Background(), connect.NewRequest(&v1.GetBatchJobReportRequest{
+			JobId: uuid.New().String(),
+		}))
+		if connect.CodeOf(err) != connect.CodeFailedPrecondition {
+			t.Errorf("GetBatchJobReport() error code = %v, want %v", connect.CodeOf(err), connect.CodeFailedPrecondition)
+		}
+	})
+}
+
+func TestListConsents(t *testing.T) {
+	userID := uuid.New()
+	revokedAt := time.Now().UTC()
+	consent := &mockConsentUseCase{
+		listConsentsFn: func(ctx context.Context, id uuid.UUID, includeRevoked bool) ([]*domain.ConsentReceipt, error) {
+			if id != userID || !includeRevoked {
+				t.Errorf("ListConsents(%s, %v), want (%s, true)", id, includeRevoked, userID)
+			}
+			return []*domain.ConsentReceipt{
+				{ID: uuid.New(), UserID: userID, ClientID: "spa", Scopes: []string{"openid"}, GrantedAt: revokedAt},
+				{ID: uuid.New(), UserID: userID, ClientID: "legacy", GrantedAt: revokedAt, RevokedAt: &revokedAt},
+			}, nil
+		},
+	}
+	server, client := newTestServerWithConsent(consent)
+	defer server.Close()
+
+	resp, err := client.ListConsents(context.Background(), connect.NewRequest(&v1.ListConsentsRequest{
+		UserId:         userID.String(),
+		IncludeRevoked: true,
+	}))
+	if err != nil {
+		t.Fatalf("ListConsents() error = %v", err)
+	}
+	consents := resp.Msg.GetConsents()
+	if len(consents) != 2 {
+		t.Fatalf("ListConsents() returned %d consents, want 2", len(consents))
+	}
+	if consents[0].GetRevokedAt() != nil || consents[1].GetRevokedAt() == nil {
+		t.Errorf("revoked_at should only be set on revoked receipts")
+	}
+}
+
+func TestRevokeConsent(t *testing.T) {
+	tests := []struct {
+		name     string
+		req      *v1.RevokeConsentRequest
+		mockFn   func(ctx context.Context, userID uuid.UUID, clientID string) (int, error)
+		want     int32
+		wantCode connect.Code
+	}{
+		{
+			name: "revokes consent",
+			req:  &v1.RevokeConsentRequest{UserId: uuid.New().String(), ClientId: "spa"},
+			mockFn: func(ctx context.Context, userID uuid.UUID, clientID string) (int, error) {
+				return 2, nil
+			},
+			want: 2,
+		},
+		{
+			name:     "invalid user ID",
+			req:      &v1.RevokeConsentRequest{UserId: "not-a-uuid", ClientId: "spa"},
+			wantCode: connect.CodeInvalidArgument,
+		},
+		{
+			name: "missing client ID",
+			req:  &v1.RevokeConsentRequest{UserId: uuid.New().String()},
+			mockFn: func(ctx context.Context, userID uuid.UUID, clientID string) (int, error) {
+				return 0, domain.ErrEmptyClientID
+			},
+			wantCode: connect.CodeInvalidArgument,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server, client := newTestServerWithConsent(&mockConsentUseCase{revokeConsentFn: tt.mockFn})
+			defer server.Close()
+
+			resp, err := client.RevokeConsent(context.Background(), connect.NewRequest(tt.req))
+			if tt.wantCode != 0 {
+				if connect.CodeOf(err) != tt.wantCode {
+					t.Errorf("RevokeConsent() error code = %v, want %v", connect.CodeOf(err), tt.wantCode)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("RevokeConsent() error = %v", err)
+			}
+			if resp.Msg.GetRevokedCount() != tt.want {
+				t.Errorf("RevokeConsent() revoked_count = %d, want %d", resp.Msg.GetRevokedCount(), tt.want)
+			}
+		})
+	}
+}
+
+func TestGetServerInfo(t *testing.T) {
+	server, client := newTestServer(&mockUserUseCase{})
+	defer server.Close()
+
+	resp, err := client.GetServerInfo(context.Background(), connect.NewRequest(&v1.GetServerInfoRequest{}))
+	if err != nil {
+		t.Fatalf("GetServerInfo() error = %v", err)
+	}
+	if resp.Msg.GetVersion() != "test" {
+		t.Errorf("GetServerInfo() version = %q, want %q", resp.Msg.GetVersion(), "test")
+	}
+
+	procedures := make(map[string]bool)
+	for _, p := range resp.Msg.GetProcedures() {
+		procedures[p] = true
+	}
+	for _, want := range []string{
+		userv1connect.UserServiceGetUserProcedure,
+		userv1connect.UserServiceListConsentsProcedure,
+		userv1connect.UserServiceGetServerInfoProcedure,
+	} {
+		if !procedures[want] {
+			t.Errorf("GetServerInfo() procedures missing %s", want)
+		}
+	}
+	if len(resp.Msg.GetFeatures()) == 0 {
+		t.Error("GetServerInfo() returned no features")
+	}
+}
+
+// mockLoginHistoryUseCase returns events and records the requested page size.
+type mockLoginHistoryUseCase struct {
+	usecase.LoginHistoryUseCase
+	events   []*domain.LoginEvent
+	pageSize int
+}
+
+func (m *mockLoginHistoryUseCase) GetLoginHistory(ctx context.Context, userID uuid.UUID, pageSize int) ([]*domain.LoginEvent, error) {
+	m.pageSize = pageSize
+	return m.events, nil
+}
+
+func TestGetLoginHistory(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(os.Stdout, &slog.HandlerOptions{Level: slog.LevelError}))
+	event := domain.NewLoginEvent(uuid.New(), "203.0.113.7", "Firefox", "", time.Now())
+	event.NewDevice = true
+	loginHistoryUC := &mockLoginHistoryUseCase{events: []*domain.LoginEvent{event}}
+	handler := NewUserServiceHandler(&mockUserUseCase{}, &mockBatchUserUseCase{}, &mockConsentUseCase{}, nil, nil, nil, nil, loginHistoryUC, "test", nil, logger)
+
+	resp, err := handler.GetLoginHistory(context.Background(), connect.NewRequest(&v1.GetLoginHistoryRequest{
+		UserId:   event.UserID.String(),
+		PageSize: 5,
+	}))
+	if err != nil {
+		t.Fatalf("GetLoginHistory() error = %v", err)
+	}
+	if loginHistoryUC.pageSize != 5 {
+		t.Errorf("page size = %d, want 5", loginHistoryUC.pageSize)
+	}
+	if len(resp.Msg.GetEvents()) != 1 {
+		t.Fatalf("GetLoginHistory() returned %d events, want 1", len(resp.Msg.GetEvents()))
+	}
+	got := resp.Msg.GetEvents()[0]
+	if got.GetId() != event.ID.String() || got.GetIpAddress() != "203.0.113.7" || !got.GetSucceeded() || !got.GetNewDevice() {
+		t.Errorf("event = %v", got)
+	}
+
+	_, err = handler.GetLoginHistory(context.Background(), connect.NewRequest(&v1.GetLoginHistoryRequest{UserId: "not-a-uuid"}))
+	if connect.CodeOf(err) != connect.CodeInvalidArgument {
+		t.Errorf("malformed user ID: code = %v, want %v", connect.CodeOf(err), connect.CodeInvalidArgument)
+	}
+}
+
+func TestGetLoginHistory_Disabled(t *testing.T) {
+	server, client := newTestServer(&mockUserUseCase{})
+	defer server.Close()
+
+	_, err := client.GetLoginHistory(context.Background(), connect.NewRequest(&v1.GetLoginHistoryRequest{UserId: uuid.NewString()}))
+	if connect.CodeOf(err) != connect.CodeUnimplemented {
+		t.Errorf("GetLoginHistory() code = %v, want %v", connect.CodeOf(err), connect.CodeUnimplemented)
+	}
+}
+
+// mockAddressUseCase validates input like the real use case and records it.
+type mockAddressUseCase struct {
+	usecase.AddressUseCase
+	input usecase.AddAddressInput
+	err   error
+}
+
+func (m *mockAddressUseCase) AddAddress(ctx context.Context, input usecase.AddAddressInput) (*domain.Address, error) {
+	m.input = input
+	if m.err != nil {
+		return nil, m.err
+	}
+	address, err := domain.NewAddress(input.UserID, input.AddressInput)
+	if err != nil {
+		return nil, err
+	}
+	address.DefaultShipping = input.DefaultShipping
+	return address, nil
+}
+
+func TestAddAddress(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(os.Stdout, &slog.HandlerOptions{Level: slog.LevelError}))
+	userID := uuid.New()
+	validRequest := func() *v1.AddAddressRequest {
+		return &v1.AddAddressRequest{
+			UserId:          userID.String(),
+			Recipient:       "Taro Yamada",
+			PhoneNumber:     "+81 90-1234-5678",
+			Country:         "JP",
+			PostalCode:      "1000001",
+			City:            "Chiyoda-ku",
+			Line1:           "1-1 Chiyoda",
+			DefaultShipping: true,
+		}
+	}
+
+	addressUC := &mockAddressUseCase{}
+	handler := NewUserServiceHandler(&mockUserUseCase{}, &mockBatchUserUseCase{}, &mockConsentUseCase{}, nil, nil, addressUC, nil, nil, "test", nil, logger)
+	resp, err := handler.AddAddress(context.Background(), connect.NewRequest(validRequest()))
+	if err != nil {
+		t.Fatalf("AddAddress() error = %v", err)
+	}
+	if addressUC.input.UserID != userID || !addressUC.input.DefaultShipping {
+		t.Errorf("input = %+v", addressUC.input)
+	}
+	got := resp.Msg.GetAddress()
+	if got.GetPostalCode() != "100-0001" || got.GetPhoneNumber() != "+819012345678" || !got.GetDefaultShipping() {
+		t.Errorf("address = %v", got)
+	}
+
+	tests := []struct {
+		name     string
+		modify   func(*v1.AddAddressRequest)
+		err      error
+		wantCode connect.Code
+	}{
+		{name: "malformed user ID", modify: func(r *v1.AddAddressRequest) { r.UserId = "not-a-uuid" }, wantCode: connect.CodeInvalidArgument},
+		{name: "invalid postal code", modify: func(r *v1.AddAddressRequest) { r.PostalCode = "94105" }, wantCode: connect.CodeInvalidArgument},
+		{name: "unknown user", err: domain.ErrUserNotFound, wantCode: connect.CodeNotFound},
+		{name: "address book full", err: domain.ErrTooManyAddresses, wantCode: connect.CodeResourceExhausted},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := validRequest()
+			if tt.modify != nil {
+				tt.modify(req)
+			}
+			handler := NewUserServiceHandler(&mockUserUseCase{}, &mockBatchUserUseCase{}, &mockConsentUseCase{}, nil, nil, &mockAddressUseCase{err: tt.err}, nil, nil, "test", nil, logger)
+			_, err := handler.AddAddress(context.Background(), connect.NewRequest(req))
+			if connect.CodeOf(err) != tt.wantCode {
+				t.Errorf("AddAddress() code = %v, want %v", connect.CodeOf(err), tt.wantCode)
+			}
+		})
+	}
+}
+
 func createTestUser() *domain.User {
 	name := "Test User"
 	now := time.Now().UTC()
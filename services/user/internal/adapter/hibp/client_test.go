@@ -0,0 +1,82 @@
+package hibp
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+// SHA-1 of "password" is 5BAA61E4C9B93F3F0682250B6CF8331B7EE68FD8.
+const (
+	passwordPrefix = "5BAA6"
+	passwordSuffix = "1E4C9B93F3F0682250B6CF8331B7EE68FD8"
+)
+
+func TestClient_IsBreached(t *testing.T) {
+	tests := []struct {
+		name     string
+		body     string
+		status   int
+		password string
+		want     bool
+		wantErr  bool
+	}{
+		{
+			name:     "listed password",
+			body:     "0018A45C4D1DEF81644B54AB7F969B88D65:1\r\n" + passwordSuffix + ":9545824\r\n",
+			status:   http.StatusOK,
+			password: "password",
+			want:     true,
+		},
+		{
+			name:     "padding entry is not a match",
+			body:     passwordSuffix + ":0\r\n",
+			status:   http.StatusOK,
+			password: "password",
+			want:     false,
+		},
+		{
+			name:     "unlisted password",
+			body:     "0018A45C4D1DEF81644B54AB7F969B88D65:1\r\n",
+			status:   http.StatusOK,
+			password: "password",
+			want:     false,
+		},
+		{
+			name:     "server error",
+			status:   http.StatusServiceUnavailable,
+			password: "password",
+			wantErr:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var gotPath, gotPadding string
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				gotPath = r.URL.Path
+				gotPadding = r.Header.Get("Add-Padding")
+				w.WriteHeader(tt.status)
+				fmt.Fprint(w, tt.body)
+			}))
+			defer server.Close()
+
+			got, err := NewClient(server.URL, time.Second).IsBreached(context.Background(), tt.password)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("IsBreached() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("IsBreached() = %v, want %v", got, tt.want)
+			}
+			if gotPath != "/range/"+passwordPrefix {
+				t.Errorf("path = %q, want only the hash prefix sent", gotPath)
+			}
+			if gotPadding != "true" {
+				t.Errorf("Add-Padding = %q, want true", gotPadding)
+			}
+		})
+	}
+}
@@ -0,0 +1,145 @@
+package http
+
+import (
+	"embed"
+	"errors"
+	"fmt"
+	"io/fs"
+	"net/http"
+	"net/url"
+	"os"
+	"regexp"
+	"strings"
+
+	"github.com/daisuke8000/example-ec-platform/services/user/internal/adapter/hydra"
+)
+
+//go:embed static
+var staticFS embed.FS
+
+// staticMaxAge is the Cache-Control max-age of files under /static/.
+const staticMaxAge = "3600"
+
+// brandingMetadataKey is the OAuth2 client metadata key holding the
+// client's branding.
+const brandingMetadataKey = "branding"
+
+var colorPattern = regexp.MustCompile(`^#(?:[0-9a-fA-F]{3}|[0-9a-fA-F]{6})$`)
+
+// Branding is the look of the login, consent and logout pages. An OAuth2
+// client overrides the defaults with its logo_uri and a "branding" object
+// in its metadata, so several storefronts can share the identity provider:
+//
+//	{"branding": {"product_name": "Acme Store", "primary_color": "#0f766e",
+//	  "background_color": "#134e4a", "stylesheet": "acme/theme.css"}}
+//
+// Invalid client values are ignored and the default is kept.
+type Branding struct {
+	ProductName string
+	// LogoURI is an absolute http(s) URL.
+	LogoURI string
+	// PrimaryColor and BackgroundColor are hex colors (#rgb or #rrggbb).
+	PrimaryColor    string
+	BackgroundColor string
+	// Stylesheet is a CSS file under /static/, loaded after the page's own
+	// styles.
+	Stylesheet string
+}
+
+func (b Branding) validate() error {
+	if b.LogoURI != "" && !validLogoURI(b.LogoURI) {
+		return fmt.Errorf("branding logo URI must be an absolute http(s) URL, got %q", b.LogoURI)
+	}
+	if b.PrimaryColor != "" && !colorPattern.MatchString(b.PrimaryColor) {
+		return fmt.Errorf("branding primary color must be a hex color, got %q", b.PrimaryColor)
+	}
+	if b.BackgroundColor != "" && !colorPattern.MatchString(b.BackgroundColor) {
+		return fmt.Errorf("branding background color must be a hex color, got %q", b.BackgroundColor)
+	}
+	if b.Stylesheet != "" && !validStylesheet(b.Stylesheet) {
+		return fmt.Errorf("branding stylesheet must be a relative .css path, got %q", b.Stylesheet)
+	}
+	return nil
+}
+
+// forClient returns the branding of a client's pages.
+func (b Branding) forClient(client hydra.OAuth2Client) Branding {
+	if client.LogoURI != "" && validLogoURI(client.LogoURI) {
+		b.LogoURI = client.LogoURI
+	}
+	meta, _ := client.Metadata[brandingMetadataKey].(map[string]interface{})
+	if name := metadataString(meta, "product_name"); name != "" {
+		b.ProductName = name
+	}
+	if color := metadataString(meta, "primary_color"); colorPattern.MatchString(color) {
+		b.PrimaryColor = color
+	}
+	if color := metadataString(meta, "background_color"); colorPattern.MatchString(color) {
+		b.BackgroundColor = color
+	}
+	if sheet := metadataString(meta, "stylesheet"); validStylesheet(sheet) {
+		b.Stylesheet = sheet
+	}
+	return b
+}
+
+func metadataString(meta map[string]interface{}, key string) string {
+	s, _ := meta[key].(string)
+	return strings.TrimSpace(s)
+}
+
+func validLogoURI(raw string) bool {
+	u, err := url.Parse(raw)
+	return err == nil && (u.Scheme == "https" || u.Scheme == "http") && u.Host != ""
+}
+
+func validStylesheet(name string) bool {
+	return strings.HasSuffix(name, ".css") && fs.ValidPath(name)
+}
+
+// staticHandler serves the CSS and JS under /static/. Files in assetsDir
+// take precedence over the embedded ones, so storefronts can ship their
+// own stylesheets without rebuilding the service.
+func staticHandler(assetsDir string) (http.Handler, error) {
+	embedded, err := fs.Sub(staticFS, "static")
+	if err != nil {
+		return nil, err
+	}
+	files := embedded
+	if assetsDir != "" {
+		info, err := os.Stat(assetsDir)
+		if err != nil {
+			return nil, fmt.Errorf("branding assets dir: %w", err)
+		}
+		if !info.IsDir() {
+			return nil, fmt.Errorf("branding assets dir %s is not a directory", assetsDir)
+		}
+		files = overlayFS{top: os.DirFS(assetsDir), bottom: embedded}
+	}
+
+	fileServer := http.StripPrefix("/static/", http.FileServerFS(files))
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// No directory listings
+		if strings.HasSuffix(r.URL.Path, "/") {
+			http.NotFound(w, r)
+			return
+		}
+		// Assets are not user data; let browsers cache them
+		w.Header().Set("Cache-Control", "public, max-age="+staticMaxAge)
+		w.Header().Del("Pragma")
+		fileServer.ServeHTTP(w, r)
+	}), nil
+}
+
+// overlayFS opens files from top, falling back to bottom.
+type overlayFS struct {
+	top, bottom fs.FS
+}
+
+func (o overlayFS) Open(name string) (fs.File, error) {
+	f, err := o.top.Open(name)
+	if errors.Is(err, fs.ErrNotExist) {
+		return o.bottom.Open(name)
+	}
+	return f, err
+}
@@ -2,13 +2,23 @@ package http
 
 import (
 	"embed"
+	"errors"
+	"fmt"
 	"html/template"
 	"log/slog"
+	"net"
 	"net/http"
 	"net/url"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
 
 	"github.com/daisuke8000/example-ec-platform/services/user/internal/adapter/hydra"
+	"github.com/daisuke8000/example-ec-platform/services/user/internal/adapter/upstream"
 	"github.com/daisuke8000/example-ec-platform/services/user/internal/domain"
+	"github.com/daisuke8000/example-ec-platform/services/user/internal/secretbox"
 	"github.com/daisuke8000/example-ec-platform/services/user/internal/usecase"
 )
 
@@ -18,11 +28,28 @@ var templateFS embed.FS
 type Handler struct {
 	hydra              *hydra.Client
 	userUC             usecase.UserUseCase
+	consentUC          usecase.ConsentUseCase
 	rateLimit          RateLimiter
 	templates          *template.Template
 	logger             *slog.Logger
 	loginRememberFor   int
 	consentRememberFor int
+	// twoFactorUC is nil when two-factor authentication is disabled.
+	twoFactorUC usecase.TwoFactorUseCase
+	loginState  *secretbox.Box
+	// loginHistoryUC is nil when login history is disabled.
+	loginHistoryUC     usecase.LoginHistoryUseCase
+	trustedProxyHeader string
+	// addressUC is nil when the address claim is not issued.
+	addressUC usecase.AddressUseCase
+	// branding is the default look of the pages; clients override it.
+	branding Branding
+	static   http.Handler
+	// upstreams are the social login providers; none when it is disabled.
+	upstreams               []*upstream.Provider
+	federatedLoginUC        usecase.FederatedLoginUseCase
+	upstreamState           *secretbox.Box
+	upstreamCallbackBaseURL string
 }
 
 type RateLimiter interface {
@@ -38,9 +65,34 @@ func (n *NoOpRateLimiter) Reset(key string) error { return nil }
 type HandlerConfig struct {
 	LoginRememberFor   int
 	ConsentRememberFor int
+	// TwoFactor enables the TOTP step of the login flow; SecretKey seals the
+	// login state carried between the password and code steps.
+	TwoFactor          usecase.TwoFactorUseCase
+	TwoFactorSecretKey string
+	// LoginHistory, if set, records login attempts.
+	LoginHistory usecase.LoginHistoryUseCase
+	// TrustedProxyHeader is the header to read the client IP address of
+	// login attempts from (e.g., X-Real-IP, X-Forwarded-For).
+	TrustedProxyHeader string
+	// Addresses, if set, fills the address claim of the "address" scope
+	// from the default shipping address.
+	Addresses usecase.AddressUseCase
+	// Branding is the default look of the login, consent and logout pages.
+	Branding Branding
+	// AssetsDir, if set, is served under /static/ ahead of the built-in
+	// assets, for client stylesheets.
+	AssetsDir string
+	// UpstreamProviders are offered as social logins on the login page and
+	// FederatedLogin maps their accounts to users. UpstreamSecretKey seals
+	// the flow state; UpstreamCallbackBaseURL is the public URL of these
+	// pages, registered with the providers.
+	UpstreamProviders       []*upstream.Provider
+	FederatedLogin          usecase.FederatedLoginUseCase
+	UpstreamSecretKey       string
+	UpstreamCallbackBaseURL string
 }
 
-func NewHandler(hydraClient *hydra.Client, userUC usecase.UserUseCase, rateLimit RateLimiter, logger *slog.Logger, cfg HandlerConfig) (*Handler, error) {
+func NewHandler(hydraClient *hydra.Client, userUC usecase.UserUseCase, consentUC usecase.ConsentUseCase, rateLimit RateLimiter, logger *slog.Logger, cfg HandlerConfig) (*Handler, error) {
 	tmpl, err := template.ParseFS(templateFS, "templates/*.html")
 	if err != nil {
 		return nil, err
@@ -50,14 +102,50 @@ func NewHandler(hydraClient *hydra.Client, userUC usecase.UserUseCase, rateLimit
 		rateLimit = &NoOpRateLimiter{}
 	}
 
+	if err := cfg.Branding.validate(); err != nil {
+		return nil, err
+	}
+	static, err := staticHandler(cfg.AssetsDir)
+	if err != nil {
+		return nil, err
+	}
+
+	var loginState *secretbox.Box
+	if cfg.TwoFactor != nil {
+		loginState, err = secretbox.New(cfg.TwoFactorSecretKey, "login-state")
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var upstreamState *secretbox.Box
+	if len(cfg.UpstreamProviders) > 0 {
+		upstreamState, err = secretbox.New(cfg.UpstreamSecretKey, "upstream-login-state")
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	return &Handler{
-		hydra:              hydraClient,
-		userUC:             userUC,
-		rateLimit:          rateLimit,
-		templates:          tmpl,
-		logger:             logger,
-		loginRememberFor:   cfg.LoginRememberFor,
-		consentRememberFor: cfg.ConsentRememberFor,
+		hydra:                   hydraClient,
+		userUC:                  userUC,
+		consentUC:               consentUC,
+		rateLimit:               rateLimit,
+		templates:               tmpl,
+		logger:                  logger,
+		loginRememberFor:        cfg.LoginRememberFor,
+		consentRememberFor:      cfg.ConsentRememberFor,
+		twoFactorUC:             cfg.TwoFactor,
+		loginState:              loginState,
+		loginHistoryUC:          cfg.LoginHistory,
+		trustedProxyHeader:      cfg.TrustedProxyHeader,
+		addressUC:               cfg.Addresses,
+		branding:                cfg.Branding,
+		static:                  static,
+		upstreams:               cfg.UpstreamProviders,
+		federatedLoginUC:        cfg.FederatedLogin,
+		upstreamState:           upstreamState,
+		upstreamCallbackBaseURL: strings.TrimRight(cfg.UpstreamCallbackBaseURL, "/"),
 	}, nil
 }
 
@@ -68,6 +156,11 @@ func (h *Handler) Router() http.Handler {
 	// Login flow
 	mux.HandleFunc("GET /oauth2/login", h.handleLoginGet)
 	mux.HandleFunc("POST /oauth2/login", h.handleLoginPost)
+	mux.HandleFunc("POST /oauth2/login/2fa", h.handleLoginTwoFactorPost)
+	if len(h.upstreams) > 0 {
+		mux.HandleFunc("GET "+upstreamPathPrefix+"{provider}", h.handleUpstreamLogin)
+		mux.HandleFunc("GET "+upstreamPathPrefix+"{provider}/callback", h.handleUpstreamCallback)
+	}
 
 	// Consent flow
 	mux.HandleFunc("GET /oauth2/consent", h.handleConsentGet)
@@ -80,6 +173,13 @@ func (h *Handler) Router() http.Handler {
 	// Error page
 	mux.HandleFunc("GET /oauth2/error", h.handleError)
 
+	// Email verification
+	mux.HandleFunc("GET /account/verify-email", h.handleVerifyEmailGet)
+	mux.HandleFunc("POST /account/verify-email", h.handleVerifyEmailPost)
+
+	// Stylesheets and scripts of the pages
+	mux.Handle("GET /static/", h.static)
+
 	// Health check
 	mux.HandleFunc("GET /health", h.handleHealth)
 
@@ -92,6 +192,9 @@ type LoginData struct {
 	ClientName string
 	Email      string
 	Error      string
+	Branding   Branding
+	// Providers are the social logins offered next to the password.
+	Providers []LoginProvider
 }
 
 // handleLoginGet renders the login form.
@@ -105,7 +208,7 @@ func (h *Handler) handleLoginGet(w http.ResponseWriter, r *http.Request) {
 	loginReq, err := h.hydra.GetLoginRequest(r.Context(), challenge)
 	if err != nil {
 		h.logger.Error("failed to get login request", slog.String("error", err.Error()))
-		h.redirectToError(w, r, "server_error", "Failed to process login request")
+		h.redirectToServerError(w, r, err, "Failed to process login request")
 		return
 	}
 
@@ -113,10 +216,13 @@ func (h *Handler) handleLoginGet(w http.ResponseWriter, r *http.Request) {
 	if loginReq.Skip {
 		resp, err := h.hydra.AcceptLogin(r.Context(), challenge, hydra.AcceptLoginRequest{
 			Subject: loginReq.Subject,
+			Context: map[string]interface{}{
+				hydra.LoginContextUserAgent: r.UserAgent(),
+			},
 		})
 		if err != nil {
 			h.logger.Error("failed to accept login (skip)", slog.String("error", err.Error()))
-			h.redirectToError(w, r, "server_error", "Failed to process login")
+			h.redirectToServerError(w, r, err, "Failed to process login")
 			return
 		}
 		http.Redirect(w, r, resp.RedirectTo, http.StatusFound)
@@ -132,6 +238,8 @@ func (h *Handler) handleLoginGet(w http.ResponseWriter, r *http.Request) {
 	data := LoginData{
 		Challenge:  challenge,
 		ClientName: clientName,
+		Branding:   h.branding.forClient(loginReq.Client),
+		Providers:  h.loginProviders(),
 	}
 
 	if err := h.templates.ExecuteTemplate(w, "login.html", data); err != nil {
@@ -159,11 +267,14 @@ func (h *Handler) handleLoginPost(w http.ResponseWriter, r *http.Request) {
 
 	// Check rate limiting
 	if !h.rateLimit.Allow(email) {
+		clientName, branding := h.loginClient(r, challenge)
 		data := LoginData{
 			Challenge:  challenge,
-			ClientName: "Application",
+			ClientName: clientName,
 			Email:      email,
 			Error:      "Too many login attempts. Please try again later.",
+			Branding:   branding,
+			Providers:  h.loginProviders(),
 		}
 		w.WriteHeader(http.StatusTooManyRequests)
 		h.templates.ExecuteTemplate(w, "login.html", data)
@@ -178,21 +289,23 @@ func (h *Handler) handleLoginPost(w http.ResponseWriter, r *http.Request) {
 		)
 
 		// Re-render login form with error
-		loginReq, _ := h.hydra.GetLoginRequest(r.Context(), challenge)
-		clientName := "Application"
-		if loginReq != nil && loginReq.Client.ClientName != "" {
-			clientName = loginReq.Client.ClientName
-		}
-
+		clientName, branding := h.loginClient(r, challenge)
 		data := LoginData{
 			Challenge:  challenge,
 			ClientName: clientName,
 			Email:      email,
 			Error:      "Invalid email or password",
+			Branding:   branding,
+			Providers:  h.loginProviders(),
 		}
 
 		if err == domain.ErrInvalidCredentials {
+			h.recordLogin(r, usecase.LoginAttempt{Email: email, FailureReason: domain.LoginFailureInvalidPassword})
 			w.WriteHeader(http.StatusUnauthorized)
+		} else if err == domain.ErrAccountLocked {
+			h.recordLogin(r, usecase.LoginAttempt{Email: email, FailureReason: domain.LoginFailureAccountLocked})
+			w.WriteHeader(http.StatusTooManyRequests)
+			data.Error = "Too many failed login attempts. Please try again later."
 		} else {
 			w.WriteHeader(http.StatusInternalServerError)
 			data.Error = "An error occurred. Please try again."
@@ -205,9 +318,35 @@ func (h *Handler) handleLoginPost(w http.ResponseWriter, r *http.Request) {
 	// Reset rate limit on successful login
 	h.rateLimit.Reset(email)
 
-	// Accept login
+	// Ask for the second factor if the account has one
+	if h.twoFactorUC != nil {
+		required, err := h.twoFactorUC.RequiresCode(r.Context(), user.ID)
+		if err != nil {
+			h.logger.Error("failed to check two-factor status", slog.String("error", err.Error()))
+			h.redirectToServerError(w, r, err, "Failed to complete login")
+			return
+		}
+		if required {
+			h.renderTwoFactorForm(w, r, challenge, user.ID, remember, hydra.AMRPassword)
+			return
+		}
+	}
+
+	h.acceptLogin(w, r, challenge, user.ID, remember, hydra.ACRPassword, []string{hydra.AMRPassword})
+}
+
+// acceptLogin accepts the login request for an authenticated user and
+// redirects back to Hydra.
+func (h *Handler) acceptLogin(w http.ResponseWriter, r *http.Request, challenge string, userID uuid.UUID, remember bool, acr string, amr []string) {
 	acceptReq := hydra.AcceptLoginRequest{
-		Subject: user.ID.String(),
+		Subject: userID.String(),
+		ACR:     acr,
+		AMR:     amr,
+		// Shown to the user by ListSessions
+		Context: map[string]interface{}{
+			hydra.LoginContextUserAgent:       r.UserAgent(),
+			hydra.LoginContextAuthenticatedAt: time.Now().UTC().Format(time.RFC3339),
+		},
 	}
 
 	if remember {
@@ -218,18 +357,50 @@ func (h *Handler) handleLoginPost(w http.ResponseWriter, r *http.Request) {
 	resp, err := h.hydra.AcceptLogin(r.Context(), challenge, acceptReq)
 	if err != nil {
 		h.logger.Error("failed to accept login", slog.String("error", err.Error()))
-		h.redirectToError(w, r, "server_error", "Failed to complete login")
+		h.redirectToServerError(w, r, err, "Failed to complete login")
 		return
 	}
 
 	h.logger.Info("user logged in",
-		slog.String("user_id", user.ID.String()),
+		slog.String("user_id", userID.String()),
 		slog.Bool("remember", remember),
+		slog.String("acr", acr),
 	)
+	h.recordLogin(r, usecase.LoginAttempt{UserID: userID})
 
 	http.Redirect(w, r, resp.RedirectTo, http.StatusFound)
 }
 
+// recordLogin adds a login attempt to the login history, if enabled. A
+// failure to record it does not affect the login.
+func (h *Handler) recordLogin(r *http.Request, attempt usecase.LoginAttempt) {
+	if h.loginHistoryUC == nil {
+		return
+	}
+	attempt.IPAddress = clientIP(r, h.trustedProxyHeader)
+	attempt.UserAgent = r.UserAgent()
+	if err := h.loginHistoryUC.RecordLogin(r.Context(), attempt); err != nil {
+		h.logger.Error("failed to record login", slog.String("error", err.Error()))
+	}
+}
+
+// clientIP returns the IP address of the client, read from trustedHeader
+// when set and present.
+func clientIP(r *http.Request, trustedHeader string) string {
+	if trustedHeader != "" {
+		if ip := r.Header.Get(trustedHeader); ip != "" {
+			// X-Forwarded-For lists the client first
+			first, _, _ := strings.Cut(ip, ",")
+			return strings.TrimSpace(first)
+		}
+	}
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		return r.RemoteAddr
+	}
+	return host
+}
+
 // ScopeInfo holds information about an OAuth2 scope for display.
 type ScopeInfo struct {
 	ID          string
@@ -242,6 +413,7 @@ type ConsentData struct {
 	Challenge  string
 	ClientName string
 	Scopes     []ScopeInfo
+	Branding   Branding
 }
 
 var scopeDescriptions = map[string]ScopeInfo{
@@ -260,6 +432,11 @@ var scopeDescriptions = map[string]ScopeInfo{
 		Name:        "Email",
 		Description: "Access your email address",
 	},
+	"address": {
+		ID:          "address",
+		Name:        "Address",
+		Description: "Access your default shipping address",
+	},
 	"offline_access": {
 		ID:          "offline_access",
 		Name:        "Offline Access",
@@ -278,18 +455,29 @@ func (h *Handler) handleConsentGet(w http.ResponseWriter, r *http.Request) {
 	consentReq, err := h.hydra.GetConsentRequest(r.Context(), challenge)
 	if err != nil {
 		h.logger.Error("failed to get consent request", slog.String("error", err.Error()))
-		h.redirectToError(w, r, "server_error", "Failed to process consent request")
+		h.redirectToServerError(w, r, err, "Failed to process consent request")
 		return
 	}
 
-	// If skip is true, accept consent with previously granted scopes
+	// If skip is true, accept consent with previously granted scopes. Hydra
+	// does not remember session claims, so they are built again.
 	if consentReq.Skip {
+		session, err := h.consentSession(r, consentReq.Subject, consentReq.RequestedScope)
+		if err != nil {
+			h.logger.Error("failed to build consent session (skip)",
+				slog.String("subject", consentReq.Subject),
+				slog.String("error", err.Error()),
+			)
+			h.redirectToServerError(w, r, err, "Failed to process consent")
+			return
+		}
 		resp, err := h.hydra.AcceptConsent(r.Context(), challenge, hydra.AcceptConsentRequest{
 			GrantScope: consentReq.RequestedScope,
+			Session:    session,
 		})
 		if err != nil {
 			h.logger.Error("failed to accept consent (skip)", slog.String("error", err.Error()))
-			h.redirectToError(w, r, "server_error", "Failed to process consent")
+			h.redirectToServerError(w, r, err, "Failed to process consent")
 			return
 		}
 		http.Redirect(w, r, resp.RedirectTo, http.StatusFound)
@@ -319,6 +507,7 @@ func (h *Handler) handleConsentGet(w http.ResponseWriter, r *http.Request) {
 		Challenge:  challenge,
 		ClientName: clientName,
 		Scopes:     scopes,
+		Branding:   h.branding.forClient(consentReq.Client),
 	}
 
 	if err := h.templates.ExecuteTemplate(w, "consent.html", data); err != nil {
@@ -350,7 +539,7 @@ func (h *Handler) handleConsentPost(w http.ResponseWriter, r *http.Request) {
 		})
 		if err != nil {
 			h.logger.Error("failed to reject consent", slog.String("error", err.Error()))
-			h.redirectToError(w, r, "server_error", "Failed to process consent")
+			h.redirectToServerError(w, r, err, "Failed to process consent")
 			return
 		}
 		http.Redirect(w, r, resp.RedirectTo, http.StatusFound)
@@ -361,7 +550,7 @@ func (h *Handler) handleConsentPost(w http.ResponseWriter, r *http.Request) {
 	consentReq, err := h.hydra.GetConsentRequest(r.Context(), challenge)
 	if err != nil {
 		h.logger.Error("failed to get consent request", slog.String("error", err.Error()))
-		h.redirectToError(w, r, "server_error", "Failed to process consent")
+		h.redirectToServerError(w, r, err, "Failed to process consent")
 		return
 	}
 
@@ -373,23 +562,14 @@ func (h *Handler) handleConsentPost(w http.ResponseWriter, r *http.Request) {
 
 	remember := r.FormValue("remember") == "true"
 
-	// Build session with user claims
-	session := &hydra.ConsentSession{
-		IDToken: map[string]interface{}{
-			"sub": consentReq.Subject,
-		},
-	}
-
-	// Add email claim if email scope is granted
-	for _, scope := range grantedScopes {
-		if scope == "email" {
-			// In a real implementation, fetch user email from database
-			session.IDToken["email"] = consentReq.Subject + "@example.com"
-			session.IDToken["email_verified"] = true
-		}
-		if scope == "profile" {
-			session.IDToken["name"] = "User"
-		}
+	session, err := h.consentSession(r, consentReq.Subject, grantedScopes)
+	if err != nil {
+		h.logger.Error("failed to build consent session",
+			slog.String("subject", consentReq.Subject),
+			slog.String("error", err.Error()),
+		)
+		h.redirectToServerError(w, r, err, "Failed to process consent")
+		return
 	}
 
 	acceptReq := hydra.AcceptConsentRequest{
@@ -405,7 +585,7 @@ func (h *Handler) handleConsentPost(w http.ResponseWriter, r *http.Request) {
 	resp, err := h.hydra.AcceptConsent(r.Context(), challenge, acceptReq)
 	if err != nil {
 		h.logger.Error("failed to accept consent", slog.String("error", err.Error()))
-		h.redirectToError(w, r, "server_error", "Failed to complete consent")
+		h.redirectToServerError(w, r, err, "Failed to complete consent")
 		return
 	}
 
@@ -415,12 +595,22 @@ func (h *Handler) handleConsentPost(w http.ResponseWriter, r *http.Request) {
 		slog.Bool("remember", remember),
 	)
 
+	// The grant is already effective at Hydra, so a failed receipt must not block the redirect
+	if err := h.recordConsent(r, consentReq, grantedScopes, remember); err != nil {
+		h.logger.Error("failed to record consent receipt",
+			slog.String("subject", consentReq.Subject),
+			slog.String("client_id", consentReq.Client.ClientID),
+			slog.String("error", err.Error()),
+		)
+	}
+
 	http.Redirect(w, r, resp.RedirectTo, http.StatusFound)
 }
 
 // LogoutData holds data for the logout template.
 type LogoutData struct {
 	Challenge string
+	Branding  Branding
 }
 
 // handleLogoutGet renders the logout confirmation page.
@@ -431,15 +621,20 @@ func (h *Handler) handleLogoutGet(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	_, err := h.hydra.GetLogoutRequest(r.Context(), challenge)
+	logoutReq, err := h.hydra.GetLogoutRequest(r.Context(), challenge)
 	if err != nil {
 		h.logger.Error("failed to get logout request", slog.String("error", err.Error()))
-		h.redirectToError(w, r, "server_error", "Failed to process logout request")
+		h.redirectToServerError(w, r, err, "Failed to process logout request")
 		return
 	}
 
+	branding := h.branding
+	if logoutReq.Client != nil {
+		branding = branding.forClient(*logoutReq.Client)
+	}
 	data := LogoutData{
 		Challenge: challenge,
+		Branding:  branding,
 	}
 
 	if err := h.templates.ExecuteTemplate(w, "logout.html", data); err != nil {
@@ -467,7 +662,7 @@ func (h *Handler) handleLogoutPost(w http.ResponseWriter, r *http.Request) {
 	if action == "cancel" {
 		if err := h.hydra.RejectLogout(r.Context(), challenge); err != nil {
 			h.logger.Error("failed to reject logout", slog.String("error", err.Error()))
-			h.redirectToError(w, r, "server_error", "Failed to cancel logout")
+			h.redirectToServerError(w, r, err, "Failed to cancel logout")
 			return
 		}
 		// Redirect to a default page since logout was cancelled
@@ -479,7 +674,7 @@ func (h *Handler) handleLogoutPost(w http.ResponseWriter, r *http.Request) {
 	resp, err := h.hydra.AcceptLogout(r.Context(), challenge)
 	if err != nil {
 		h.logger.Error("failed to accept logout", slog.String("error", err.Error()))
-		h.redirectToError(w, r, "server_error", "Failed to complete logout")
+		h.redirectToServerError(w, r, err, "Failed to complete logout")
 		return
 	}
 
@@ -488,11 +683,22 @@ func (h *Handler) handleLogoutPost(w http.ResponseWriter, r *http.Request) {
 	http.Redirect(w, r, resp.RedirectTo, http.StatusFound)
 }
 
+// errTemporarilyUnavailable is the OAuth2 error code of the error page
+// shown while Hydra is unavailable.
+const errTemporarilyUnavailable = "temporarily_unavailable"
+
+// retryAfterSeconds is the Retry-After of the temporarily unavailable page.
+const retryAfterSeconds = "30"
+
 // ErrorData holds data for the error template.
 type ErrorData struct {
 	ErrorCode        string
 	ErrorDescription string
 	ErrorHint        string
+	// Retryable asks the user to try again shortly instead of checking
+	// their input.
+	Retryable bool
+	Branding  Branding
 }
 
 // handleError renders the error page.
@@ -501,15 +707,185 @@ func (h *Handler) handleError(w http.ResponseWriter, r *http.Request) {
 		ErrorCode:        r.URL.Query().Get("error"),
 		ErrorDescription: r.URL.Query().Get("error_description"),
 		ErrorHint:        r.URL.Query().Get("error_hint"),
+		Branding:         h.branding,
 	}
 
-	w.WriteHeader(http.StatusBadRequest)
+	if data.ErrorCode == errTemporarilyUnavailable {
+		data.Retryable = true
+		w.Header().Set("Retry-After", retryAfterSeconds)
+		w.WriteHeader(http.StatusServiceUnavailable)
+	} else {
+		w.WriteHeader(http.StatusBadRequest)
+	}
 	if err := h.templates.ExecuteTemplate(w, "error.html", data); err != nil {
 		h.logger.Error("failed to render error template", slog.String("error", err.Error()))
 		http.Error(w, "Internal server error", http.StatusInternalServerError)
 	}
 }
 
+// VerifyEmailData holds data for the email verification template.
+type VerifyEmailData struct {
+	Token    string
+	Verified bool
+	Error    string
+}
+
+// handleVerifyEmailGet renders the verification confirmation page.
+// The token is only redeemed on POST so that link prefetchers (mail scanners)
+// cannot consume it.
+func (h *Handler) handleVerifyEmailGet(w http.ResponseWriter, r *http.Request) {
+	data := VerifyEmailData{Token: r.URL.Query().Get("token")}
+	if data.Token == "" {
+		data.Error = "The verification link is invalid."
+		w.WriteHeader(http.StatusBadRequest)
+	}
+	h.renderVerifyEmail(w, data)
+}
+
+// handleVerifyEmailPost redeems the verification token.
+func (h *Handler) handleVerifyEmailPost(w http.ResponseWriter, r *http.Request) {
+	if err := r.ParseForm(); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		h.renderVerifyEmail(w, VerifyEmailData{Error: "Failed to parse form."})
+		return
+	}
+
+	user, err := h.userUC.VerifyEmail(r.Context(), r.FormValue("token"))
+	if err != nil {
+		data := VerifyEmailData{}
+		switch {
+		case errors.Is(err, domain.ErrInvalidVerificationToken):
+			w.WriteHeader(http.StatusBadRequest)
+			data.Error = "The verification link is invalid or has already been used."
+		case errors.Is(err, domain.ErrVerificationTokenExpired):
+			w.WriteHeader(http.StatusBadRequest)
+			data.Error = "The verification link has expired."
+		default:
+			h.logger.Error("failed to verify email", slog.String("error", err.Error()))
+			w.WriteHeader(http.StatusInternalServerError)
+			data.Error = "An error occurred. Please try again later."
+		}
+		h.renderVerifyEmail(w, data)
+		return
+	}
+
+	h.logger.Info("email verified", slog.String("user_id", user.ID.String()))
+	h.renderVerifyEmail(w, VerifyEmailData{Verified: true})
+}
+
+func (h *Handler) renderVerifyEmail(w http.ResponseWriter, data VerifyEmailData) {
+	if err := h.templates.ExecuteTemplate(w, "verify_email.html", data); err != nil {
+		h.logger.Error("failed to render verify email template", slog.String("error", err.Error()))
+		http.Error(w, "Internal server error", http.StatusInternalServerError)
+	}
+}
+
+// lookupSubject loads the user identified by a Hydra subject.
+func (h *Handler) lookupSubject(r *http.Request, subject string) (*domain.User, error) {
+	id, err := uuid.Parse(subject)
+	if err != nil {
+		return nil, err
+	}
+	return h.userUC.GetUser(r.Context(), id)
+}
+
+// consentSession builds the claims of a consent from the database: role
+// claims in the access token for BFF authorization, and in the ID token the
+// user's claims of the granted scopes only.
+func (h *Handler) consentSession(r *http.Request, subject string, grantedScopes []string) (*hydra.ConsentSession, error) {
+	roles, err := h.lookupRoles(r, subject)
+	if err != nil {
+		return nil, fmt.Errorf("load roles: %w", err)
+	}
+	session := &hydra.ConsentSession{
+		AccessToken: map[string]interface{}{
+			"roles":       domain.RoleNames(roles),
+			"permissions": domain.EffectivePermissions(roles),
+		},
+		IDToken: map[string]interface{}{
+			"sub": subject,
+		},
+	}
+
+	if slices.Contains(grantedScopes, "email") || slices.Contains(grantedScopes, "profile") {
+		user, err := h.lookupSubject(r, subject)
+		if err != nil {
+			return nil, fmt.Errorf("load user: %w", err)
+		}
+		addUserClaims(session.IDToken, user, grantedScopes)
+	}
+
+	if slices.Contains(grantedScopes, "address") && h.addressUC != nil {
+		claim, err := h.lookupAddressClaim(r, subject)
+		if err != nil {
+			return nil, fmt.Errorf("load address: %w", err)
+		}
+		if claim != nil {
+			session.IDToken["address"] = claim
+		}
+	}
+	return session, nil
+}
+
+// addUserClaims sets the standard claims of the granted email and profile
+// scopes. The name claim is left out for users who have not set a name.
+func addUserClaims(claims map[string]interface{}, user *domain.User, grantedScopes []string) {
+	if slices.Contains(grantedScopes, "email") {
+		claims["email"] = user.Email
+		claims["email_verified"] = user.EmailVerified
+	}
+	if slices.Contains(grantedScopes, "profile") && user.Name != nil && *user.Name != "" {
+		claims["name"] = *user.Name
+	}
+}
+
+// lookupAddressClaim builds the OpenID Connect address claim from the
+// default shipping address of the user identified by a Hydra subject.
+// Returns nil if the user has no default shipping address.
+func (h *Handler) lookupAddressClaim(r *http.Request, subject string) (map[string]interface{}, error) {
+	id, err := uuid.Parse(subject)
+	if err != nil {
+		return nil, err
+	}
+	address, err := h.addressUC.DefaultShippingAddress(r.Context(), id)
+	if err != nil || address == nil {
+		return nil, err
+	}
+	return map[string]interface{}{
+		"formatted":      address.Formatted(),
+		"street_address": address.StreetAddress(),
+		"locality":       address.City,
+		"region":         address.Region,
+		"postal_code":    address.PostalCode,
+		"country":        address.Country,
+	}, nil
+}
+
+// lookupRoles loads the roles of the user identified by a Hydra subject.
+func (h *Handler) lookupRoles(r *http.Request, subject string) ([]*domain.Role, error) {
+	id, err := uuid.Parse(subject)
+	if err != nil {
+		return nil, err
+	}
+	return h.userUC.GetUserRoles(r.Context(), id)
+}
+
+// recordConsent stores a receipt for a consent grant.
+func (h *Handler) recordConsent(r *http.Request, consentReq *hydra.ConsentRequest, scopes []string, remember bool) error {
+	id, err := uuid.Parse(consentReq.Subject)
+	if err != nil {
+		return err
+	}
+	_, err = h.consentUC.RecordConsent(r.Context(), usecase.RecordConsentInput{
+		UserID:     id,
+		ClientID:   consentReq.Client.ClientID,
+		ClientName: consentReq.Client.ClientName,
+		Scopes:     scopes,
+		Remember:   remember,
+	})
+	return err
+}
+
 // handleHealth returns OK if the service is healthy.
 func (h *Handler) handleHealth(w http.ResponseWriter, r *http.Request) {
 	w.WriteHeader(http.StatusOK)
@@ -521,3 +897,14 @@ func (h *Handler) redirectToError(w http.ResponseWriter, r *http.Request, errorC
 	redirectURL := "/oauth2/error?error=" + url.QueryEscape(errorCode) + "&error_description=" + url.QueryEscape(description)
 	http.Redirect(w, r, redirectURL, http.StatusFound)
 }
+
+// redirectToServerError sends the user to the error page for a failed step:
+// a "try again shortly" page while Hydra is unavailable, a server error
+// with the description otherwise.
+func (h *Handler) redirectToServerError(w http.ResponseWriter, r *http.Request, err error, description string) {
+	if errors.Is(err, hydra.ErrUnavailable) {
+		h.redirectToError(w, r, errTemporarilyUnavailable, "The sign-in service is temporarily unavailable. Please try again in a few moments.")
+		return
+	}
+	h.redirectToError(w, r, "server_error", description)
+}
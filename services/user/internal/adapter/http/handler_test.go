@@ -0,0 +1,297 @@
+package http
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"reflect"
+	"slices"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/google/uuid"
+
+	"github.com/daisuke8000/example-ec-platform/services/user/internal/adapter/hydra"
+	"github.com/daisuke8000/example-ec-platform/services/user/internal/domain"
+	"github.com/daisuke8000/example-ec-platform/services/user/internal/usecase"
+)
+
+const hydraRedirect = "https://hydra.example.com/oauth2/auth?continue"
+
+// fakeHydra serves the Hydra admin API endpoints of the login and consent
+// flows and records the requests accepted by the handler.
+type fakeHydra struct {
+	*httptest.Server
+
+	// consentRequest is returned for every consent challenge.
+	consentRequest hydra.ConsentRequest
+
+	mu              sync.Mutex
+	acceptedLogin   *hydra.AcceptLoginRequest
+	acceptedConsent *hydra.AcceptConsentRequest
+}
+
+func newFakeHydra(t *testing.T) *fakeHydra {
+	t.Helper()
+	f := &fakeHydra{}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /admin/oauth2/auth/requests/login", func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, hydra.LoginRequest{
+			Challenge: r.URL.Query().Get("login_challenge"),
+			Client:    hydra.OAuth2Client{ClientID: "web", ClientName: "Web"},
+		})
+	})
+	mux.HandleFunc("PUT /admin/oauth2/auth/requests/login/accept", func(w http.ResponseWriter, r *http.Request) {
+		var accept hydra.AcceptLoginRequest
+		if err := json.NewDecoder(r.Body).Decode(&accept); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		f.mu.Lock()
+		f.acceptedLogin = &accept
+		f.mu.Unlock()
+		writeJSON(w, hydra.RedirectResponse{RedirectTo: hydraRedirect})
+	})
+
+	mux.HandleFunc("GET /admin/oauth2/auth/requests/consent", func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, f.consentRequest)
+	})
+	mux.HandleFunc("PUT /admin/oauth2/auth/requests/consent/accept", func(w http.ResponseWriter, r *http.Request) {
+		var accept hydra.AcceptConsentRequest
+		if err := json.NewDecoder(r.Body).Decode(&accept); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		f.mu.Lock()
+		f.acceptedConsent = &accept
+		f.mu.Unlock()
+		writeJSON(w, hydra.RedirectResponse{RedirectTo: hydraRedirect})
+	})
+
+	f.Server = httptest.NewServer(mux)
+	t.Cleanup(f.Close)
+	return f
+}
+
+func (f *fakeHydra) loginAccepted() *hydra.AcceptLoginRequest {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.acceptedLogin
+}
+
+func (f *fakeHydra) consentAccepted() *hydra.AcceptConsentRequest {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.acceptedConsent
+}
+
+func writeJSON(w http.ResponseWriter, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(v)
+}
+
+func newTestHandler(t *testing.T, f *fakeHydra, userUC usecase.UserUseCase, consentUC usecase.ConsentUseCase, cfg HandlerConfig) *Handler {
+	t.Helper()
+	h, err := NewHandler(hydra.NewClient(f.URL), userUC, consentUC, nil, slog.New(slog.DiscardHandler), cfg)
+	if err != nil {
+		t.Fatalf("NewHandler() error = %v", err)
+	}
+	return h
+}
+
+// mockUserUseCase serves the user and roles the consent session is built from.
+type mockUserUseCase struct {
+	usecase.UserUseCase
+	user  *domain.User
+	roles []*domain.Role
+}
+
+func (m *mockUserUseCase) GetUser(ctx context.Context, id uuid.UUID) (*domain.User, error) {
+	if id != m.user.ID {
+		return nil, domain.ErrUserNotFound
+	}
+	return m.user, nil
+}
+
+func (m *mockUserUseCase) GetUserRoles(ctx context.Context, id uuid.UUID) ([]*domain.Role, error) {
+	return m.roles, nil
+}
+
+// mockConsentUseCase records consent receipts.
+type mockConsentUseCase struct {
+	usecase.ConsentUseCase
+	recorded []usecase.RecordConsentInput
+}
+
+func (m *mockConsentUseCase) RecordConsent(ctx context.Context, input usecase.RecordConsentInput) (*domain.ConsentReceipt, error) {
+	m.recorded = append(m.recorded, input)
+	return &domain.ConsentReceipt{}, nil
+}
+
+func TestConsentSession(t *testing.T) {
+	name := "Taro Yamada"
+	user := &domain.User{ID: uuid.New(), Email: "taro@example.com", Name: &name, EmailVerified: true}
+	unnamed := &domain.User{ID: uuid.New(), Email: "hanako@example.com"}
+	roles := []*domain.Role{{Name: "admin", Permissions: []string{"users:list", "users:write"}}}
+	wantAccessToken := map[string]interface{}{
+		"roles":       []interface{}{"admin"},
+		"permissions": []interface{}{"users:list", "users:write"},
+	}
+
+	tests := []struct {
+		name        string
+		user        *domain.User
+		skip        bool
+		requested   []string
+		granted     []string
+		wantIDToken map[string]interface{}
+	}{
+		{
+			name:      "email and profile granted",
+			user:      user,
+			requested: []string{"openid", "email", "profile"},
+			granted:   []string{"openid", "email", "profile"},
+			wantIDToken: map[string]interface{}{
+				"sub":            user.ID.String(),
+				"email":          "taro@example.com",
+				"email_verified": true,
+				"name":           "Taro Yamada",
+			},
+		},
+		{
+			name:      "requested scopes not granted",
+			user:      user,
+			requested: []string{"openid", "email", "profile"},
+			granted:   []string{"openid"},
+			wantIDToken: map[string]interface{}{
+				"sub": user.ID.String(),
+			},
+		},
+		{
+			name:      "email granted, profile not",
+			user:      user,
+			requested: []string{"openid", "email", "profile"},
+			granted:   []string{"openid", "email"},
+			wantIDToken: map[string]interface{}{
+				"sub":            user.ID.String(),
+				"email":          "taro@example.com",
+				"email_verified": true,
+			},
+		},
+		{
+			name:      "profile granted to a user without a name",
+			user:      unnamed,
+			requested: []string{"openid", "email", "profile"},
+			granted:   []string{"openid", "email", "profile"},
+			wantIDToken: map[string]interface{}{
+				"sub":            unnamed.ID.String(),
+				"email":          "hanako@example.com",
+				"email_verified": false,
+			},
+		},
+		{
+			name:      "skip uses the previously granted scopes",
+			user:      user,
+			skip:      true,
+			requested: []string{"openid", "email"},
+			wantIDToken: map[string]interface{}{
+				"sub":            user.ID.String(),
+				"email":          "taro@example.com",
+				"email_verified": true,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := newFakeHydra(t)
+			f.consentRequest = hydra.ConsentRequest{
+				Challenge:      "consent-challenge",
+				RequestedScope: tt.requested,
+				Skip:           tt.skip,
+				Subject:        tt.user.ID.String(),
+				Client:         hydra.OAuth2Client{ClientID: "web", ClientName: "Web"},
+			}
+			consentUC := &mockConsentUseCase{}
+			h := newTestHandler(t, f, &mockUserUseCase{user: tt.user, roles: roles}, consentUC, HandlerConfig{})
+
+			var req *http.Request
+			if tt.skip {
+				req = httptest.NewRequest(http.MethodGet, "/oauth2/consent?consent_challenge=consent-challenge", nil)
+			} else {
+				form := url.Values{"consent_challenge": {"consent-challenge"}, "action": {"accept"}, "grant_scope": tt.granted}
+				req = httptest.NewRequest(http.MethodPost, "/oauth2/consent", strings.NewReader(form.Encode()))
+				req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+			}
+			rec := httptest.NewRecorder()
+			h.Router().ServeHTTP(rec, req)
+
+			if rec.Code != http.StatusFound || rec.Header().Get("Location") != hydraRedirect {
+				t.Fatalf("response = %d to %q, want a redirect to Hydra", rec.Code, rec.Header().Get("Location"))
+			}
+			accepted := f.consentAccepted()
+			if accepted == nil || accepted.Session == nil {
+				t.Fatal("consent not accepted with a session")
+			}
+			wantScopes := tt.granted
+			if tt.skip {
+				wantScopes = tt.requested
+			}
+			if !slices.Equal(accepted.GrantScope, wantScopes) {
+				t.Errorf("granted scopes = %v, want %v", accepted.GrantScope, wantScopes)
+			}
+			if !reflect.DeepEqual(accepted.Session.IDToken, tt.wantIDToken) {
+				t.Errorf("ID token claims = %v, want %v", accepted.Session.IDToken, tt.wantIDToken)
+			}
+			if !reflect.DeepEqual(accepted.Session.AccessToken, wantAccessToken) {
+				t.Errorf("access token claims = %v, want %v", accepted.Session.AccessToken, wantAccessToken)
+			}
+			// A skipped consent was recorded when it was first given.
+			if wantRecorded := !tt.skip; (len(consentUC.recorded) == 1) != wantRecorded {
+				t.Errorf("recorded %d consent receipts", len(consentUC.recorded))
+			}
+		})
+	}
+}
+
+func TestAddUserClaims(t *testing.T) {
+	empty := ""
+	tests := []struct {
+		name     string
+		userName *string
+		granted  []string
+		want     map[string]interface{}
+	}{
+		{
+			name:    "no user scopes",
+			granted: []string{"openid"},
+			want:    map[string]interface{}{},
+		},
+		{
+			name:    "email",
+			granted: []string{"openid", "email"},
+			want:    map[string]interface{}{"email": "a@example.com", "email_verified": false},
+		},
+		{
+			name:     "profile with an empty name",
+			userName: &empty,
+			granted:  []string{"profile"},
+			want:     map[string]interface{}{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			claims := map[string]interface{}{}
+			addUserClaims(claims, &domain.User{Email: "a@example.com", Name: tt.userName}, tt.granted)
+			if !reflect.DeepEqual(claims, tt.want) {
+				t.Errorf("claims = %v, want %v", claims, tt.want)
+			}
+		})
+	}
+}
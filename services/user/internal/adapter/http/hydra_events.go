@@ -0,0 +1,84 @@
+package http
+
+import (
+	"crypto/subtle"
+	"encoding/json"
+	"errors"
+	"io"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/daisuke8000/example-ec-platform/services/user/internal/domain"
+	"github.com/daisuke8000/example-ec-platform/services/user/internal/usecase"
+)
+
+// HydraWebhookSecretHeader carries the shared secret of Hydra event
+// deliveries. Configure it in Hydra as api_key authentication of the
+// webhook.
+const HydraWebhookSecretHeader = "X-Hydra-Webhook-Secret"
+
+// maxHydraEventBytes bounds the body of an event delivery.
+const maxHydraEventBytes = 64 << 10
+
+// hydraEvent is the body of an event delivery.
+type hydraEvent struct {
+	ID         string    `json:"id"`
+	Type       string    `json:"type"`
+	OccurredAt time.Time `json:"occurred_at"`
+	Subject    string    `json:"subject"`
+	ClientID   string    `json:"client_id"`
+	SessionID  string    `json:"session_id"`
+}
+
+// NewHydraEventHandler returns the endpoint Hydra delivers token and
+// consent events to. Deliveries must carry secret in
+// HydraWebhookSecretHeader. Failures answer 5xx so Hydra redelivers.
+func NewHydraEventHandler(uc usecase.HydraEventUseCase, secret string, logger *slog.Logger) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			w.Header().Set("Allow", http.MethodPost)
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+		if subtle.ConstantTimeCompare([]byte(r.Header.Get(HydraWebhookSecretHeader)), []byte(secret)) != 1 {
+			logger.Warn("rejected hydra event with an invalid secret")
+			http.Error(w, "invalid secret", http.StatusUnauthorized)
+			return
+		}
+
+		body, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxHydraEventBytes))
+		if err != nil {
+			http.Error(w, "request body too large", http.StatusRequestEntityTooLarge)
+			return
+		}
+		var e hydraEvent
+		if err := json.Unmarshal(body, &e); err != nil {
+			http.Error(w, "malformed event", http.StatusBadRequest)
+			return
+		}
+
+		err = uc.HandleHydraEvent(r.Context(), domain.HydraEvent{
+			ID:         e.ID,
+			Type:       e.Type,
+			Subject:    e.Subject,
+			ClientID:   e.ClientID,
+			SessionID:  e.SessionID,
+			OccurredAt: e.OccurredAt,
+		})
+		if errors.Is(err, domain.ErrInvalidHydraEvent) {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		if err != nil {
+			logger.ErrorContext(r.Context(), "failed to handle hydra event",
+				slog.String("event_id", e.ID),
+				slog.String("type", e.Type),
+				slog.String("error", err.Error()),
+			)
+			http.Error(w, "internal server error", http.StatusInternalServerError)
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
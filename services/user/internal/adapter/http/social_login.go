@@ -0,0 +1,238 @@
+package http
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/daisuke8000/example-ec-platform/services/user/internal/adapter/hydra"
+	"github.com/daisuke8000/example-ec-platform/services/user/internal/adapter/upstream"
+	"github.com/daisuke8000/example-ec-platform/services/user/internal/domain"
+)
+
+const (
+	// upstreamStateTTL bounds the time spent at the upstream provider.
+	upstreamStateTTL = 10 * time.Minute
+	// upstreamStateCookie carries the sealed flow state to the callback.
+	upstreamStateCookie = "upstream_login"
+	upstreamPathPrefix  = "/oauth2/login/upstream/"
+)
+
+// upstreamState ties a callback to the login request and browser that
+// started it: the state parameter must match and only this browser holds
+// the PKCE code verifier.
+type upstreamState struct {
+	Challenge string `json:"c"`
+	Provider  string `json:"p"`
+	State     string `json:"s"`
+	Verifier  string `json:"v"`
+	ExpiresAt int64  `json:"e"`
+}
+
+// LoginProvider is a social login button on the login page.
+type LoginProvider struct {
+	Name        string
+	DisplayName string
+}
+
+// loginProviders returns the social login buttons of the login page.
+func (h *Handler) loginProviders() []LoginProvider {
+	providers := make([]LoginProvider, 0, len(h.upstreams))
+	for _, p := range h.upstreams {
+		providers = append(providers, LoginProvider{Name: p.Name, DisplayName: p.DisplayName})
+	}
+	return providers
+}
+
+func (h *Handler) upstreamProvider(name string) *upstream.Provider {
+	for _, p := range h.upstreams {
+		if p.Name == name {
+			return p
+		}
+	}
+	return nil
+}
+
+func (h *Handler) upstreamRedirectURI(p *upstream.Provider) string {
+	return h.upstreamCallbackBaseURL + upstreamPathPrefix + p.Name + "/callback"
+}
+
+// handleUpstreamLogin starts a social login by redirecting to the provider.
+func (h *Handler) handleUpstreamLogin(w http.ResponseWriter, r *http.Request) {
+	p := h.upstreamProvider(r.PathValue("provider"))
+	if p == nil {
+		http.NotFound(w, r)
+		return
+	}
+	challenge := r.URL.Query().Get("login_challenge")
+	if challenge == "" {
+		h.redirectToError(w, r, "invalid_request", "Missing login challenge")
+		return
+	}
+
+	state, err := upstream.NewRandomToken()
+	if err != nil {
+		h.redirectToServerError(w, r, err, "Failed to start sign-in")
+		return
+	}
+	verifier, err := upstream.NewRandomToken()
+	if err != nil {
+		h.redirectToServerError(w, r, err, "Failed to start sign-in")
+		return
+	}
+	sealed, err := h.sealUpstreamState(upstreamState{
+		Challenge: challenge,
+		Provider:  p.Name,
+		State:     state,
+		Verifier:  verifier,
+		ExpiresAt: time.Now().Add(upstreamStateTTL).Unix(),
+	})
+	if err != nil {
+		h.logger.Error("failed to seal upstream login state", slog.String("error", err.Error()))
+		h.redirectToServerError(w, r, err, "Failed to start sign-in")
+		return
+	}
+
+	h.setUpstreamStateCookie(w, sealed, int(upstreamStateTTL.Seconds()))
+	http.Redirect(w, r, p.AuthCodeURL(h.upstreamRedirectURI(p), state, verifier), http.StatusFound)
+}
+
+// handleUpstreamCallback completes a social login: it redeems the code,
+// maps the upstream account to a user and accepts the login request.
+func (h *Handler) handleUpstreamCallback(w http.ResponseWriter, r *http.Request) {
+	p := h.upstreamProvider(r.PathValue("provider"))
+	if p == nil {
+		http.NotFound(w, r)
+		return
+	}
+
+	cookie, err := r.Cookie(upstreamStateCookie)
+	if err != nil {
+		h.redirectToError(w, r, "invalid_request", "The sign-in has expired. Please sign in again.")
+		return
+	}
+	// The state is single use
+	h.setUpstreamStateCookie(w, "", -1)
+	state, err := h.openUpstreamState(cookie.Value)
+	if err != nil || state.Provider != p.Name || state.State != r.URL.Query().Get("state") {
+		h.redirectToError(w, r, "invalid_request", "The sign-in has expired. Please sign in again.")
+		return
+	}
+
+	// The user cancelled at the provider; let them pick another way
+	if r.URL.Query().Get("error") != "" {
+		http.Redirect(w, r, "/oauth2/login?login_challenge="+url.QueryEscape(state.Challenge), http.StatusFound)
+		return
+	}
+
+	profile, err := p.Exchange(r.Context(), h.upstreamRedirectURI(p), r.URL.Query().Get("code"), state.Verifier)
+	if err != nil {
+		h.logger.Error("upstream sign-in failed",
+			slog.String("provider", p.Name),
+			slog.String("error", err.Error()),
+		)
+		h.renderUpstreamError(w, r, state.Challenge, http.StatusBadGateway,
+			"Signing in with "+p.DisplayName+" failed. Please try again.")
+		return
+	}
+
+	user, err := h.federatedLoginUC.Login(r.Context(), *profile)
+	if err != nil {
+		h.logger.Debug("federated login refused",
+			slog.String("provider", p.Name),
+			slog.String("error", err.Error()),
+		)
+		switch {
+		case errors.Is(err, domain.ErrUpstreamEmailNotVerified):
+			h.renderUpstreamError(w, r, state.Challenge, http.StatusForbidden,
+				"Your "+p.DisplayName+" account has no verified email address.")
+		case errors.Is(err, domain.ErrFederatedLoginConflict):
+			h.renderUpstreamError(w, r, state.Challenge, http.StatusConflict,
+				"An account with this email address already exists. Sign in with your password.")
+		case errors.Is(err, domain.ErrFederatedSignupDisabled):
+			h.renderUpstreamError(w, r, state.Challenge, http.StatusForbidden,
+				"There is no account for this "+p.DisplayName+" account.")
+		default:
+			h.logger.Error("failed to complete federated login", slog.String("error", err.Error()))
+			h.redirectToServerError(w, r, err, "Failed to complete login")
+		}
+		return
+	}
+
+	if h.twoFactorUC != nil {
+		required, err := h.twoFactorUC.RequiresCode(r.Context(), user.ID)
+		if err != nil {
+			h.logger.Error("failed to check two-factor status", slog.String("error", err.Error()))
+			h.redirectToServerError(w, r, err, "Failed to complete login")
+			return
+		}
+		if required {
+			h.renderTwoFactorForm(w, r, state.Challenge, user.ID, false, hydra.AMRFederated)
+			return
+		}
+	}
+
+	h.acceptLogin(w, r, state.Challenge, user.ID, false, hydra.ACRPassword, []string{hydra.AMRFederated})
+}
+
+// renderUpstreamError shows the login page again with an error.
+func (h *Handler) renderUpstreamError(w http.ResponseWriter, r *http.Request, challenge string, status int, message string) {
+	clientName, branding := h.loginClient(r, challenge)
+	w.WriteHeader(status)
+	h.templates.ExecuteTemplate(w, "login.html", LoginData{
+		Challenge:  challenge,
+		ClientName: clientName,
+		Error:      message,
+		Branding:   branding,
+		Providers:  h.loginProviders(),
+	})
+}
+
+func (h *Handler) setUpstreamStateCookie(w http.ResponseWriter, value string, maxAge int) {
+	http.SetCookie(w, &http.Cookie{
+		Name:     upstreamStateCookie,
+		Value:    value,
+		Path:     upstreamPathPrefix,
+		MaxAge:   maxAge,
+		HttpOnly: true,
+		Secure:   strings.HasPrefix(h.upstreamCallbackBaseURL, "https://"),
+		// Sent on the top-level redirect back from the provider
+		SameSite: http.SameSiteLaxMode,
+	})
+}
+
+func (h *Handler) sealUpstreamState(state upstreamState) (string, error) {
+	plaintext, err := json.Marshal(state)
+	if err != nil {
+		return "", err
+	}
+	ciphertext, err := h.upstreamState.Seal(plaintext)
+	if err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(ciphertext), nil
+}
+
+func (h *Handler) openUpstreamState(sealed string) (*upstreamState, error) {
+	ciphertext, err := base64.RawURLEncoding.DecodeString(sealed)
+	if err != nil {
+		return nil, errLoginStateInvalid
+	}
+	plaintext, err := h.upstreamState.Open(ciphertext)
+	if err != nil {
+		return nil, errLoginStateInvalid
+	}
+	var state upstreamState
+	if err := json.Unmarshal(plaintext, &state); err != nil {
+		return nil, errLoginStateInvalid
+	}
+	if time.Now().Unix() > state.ExpiresAt {
+		return nil, errLoginStateInvalid
+	}
+	return &state, nil
+}
@@ -0,0 +1,186 @@
+package http
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/daisuke8000/example-ec-platform/services/user/internal/adapter/hydra"
+	"github.com/daisuke8000/example-ec-platform/services/user/internal/domain"
+	"github.com/daisuke8000/example-ec-platform/services/user/internal/usecase"
+)
+
+// loginStateTTL bounds the time between the password and code steps.
+const loginStateTTL = 5 * time.Minute
+
+var errLoginStateInvalid = errors.New("login state is invalid or expired")
+
+// loginState is what the password step proved, carried sealed through the
+// code form so the code step cannot be started for another user.
+type loginState struct {
+	Challenge string    `json:"c"`
+	UserID    uuid.UUID `json:"u"`
+	Remember  bool      `json:"r"`
+	ExpiresAt int64     `json:"e"`
+	// FirstFactor is the amr of the first step; empty means a password.
+	FirstFactor string `json:"f,omitempty"`
+}
+
+// TwoFactorData holds data for the TOTP template.
+type TwoFactorData struct {
+	ClientName string
+	State      string
+	Error      string
+	Branding   Branding
+}
+
+// renderTwoFactorForm asks a user who passed the first step (password or
+// social login, as amr firstFactor) for a code.
+func (h *Handler) renderTwoFactorForm(w http.ResponseWriter, r *http.Request, challenge string, userID uuid.UUID, remember bool, firstFactor string) {
+	state, err := h.sealLoginState(loginState{
+		Challenge:   challenge,
+		UserID:      userID,
+		Remember:    remember,
+		ExpiresAt:   time.Now().Add(loginStateTTL).Unix(),
+		FirstFactor: firstFactor,
+	})
+	if err != nil {
+		h.logger.Error("failed to seal login state", slog.String("error", err.Error()))
+		h.redirectToServerError(w, r, err, "Failed to complete login")
+		return
+	}
+
+	clientName, branding := h.loginClient(r, challenge)
+	h.renderTwoFactor(w, TwoFactorData{
+		ClientName: clientName,
+		State:      state,
+		Branding:   branding,
+	})
+}
+
+// handleLoginTwoFactorPost completes a login with a TOTP or recovery code.
+func (h *Handler) handleLoginTwoFactorPost(w http.ResponseWriter, r *http.Request) {
+	if h.twoFactorUC == nil {
+		http.NotFound(w, r)
+		return
+	}
+	if err := r.ParseForm(); err != nil {
+		h.redirectToError(w, r, "invalid_request", "Failed to parse form")
+		return
+	}
+
+	sealed := r.FormValue("login_state")
+	state, err := h.openLoginState(sealed)
+	if err != nil {
+		h.redirectToError(w, r, "invalid_request", "The sign-in has expired. Please sign in again.")
+		return
+	}
+
+	clientName, branding := h.loginClient(r, state.Challenge)
+	data := TwoFactorData{
+		ClientName: clientName,
+		State:      sealed,
+		Branding:   branding,
+	}
+
+	// Codes have only a million values, so attempts are limited per user
+	rateKey := "2fa:" + state.UserID.String()
+	if !h.rateLimit.Allow(rateKey) {
+		data.Error = "Too many attempts. Please try again later."
+		w.WriteHeader(http.StatusTooManyRequests)
+		h.renderTwoFactor(w, data)
+		return
+	}
+
+	firstFactor := state.FirstFactor
+	if firstFactor == "" {
+		firstFactor = hydra.AMRPassword
+	}
+
+	err = h.twoFactorUC.VerifyCode(r.Context(), state.UserID, r.FormValue("code"))
+	if errors.Is(err, domain.ErrTwoFactorNotEnrolled) {
+		// Two-factor authentication was disabled after the first step, so
+		// the first factor is all a new login would ask for.
+		h.rateLimit.Reset(rateKey)
+		h.acceptLogin(w, r, state.Challenge, state.UserID, state.Remember,
+			hydra.ACRPassword, []string{firstFactor})
+		return
+	}
+	if err != nil {
+		h.logger.Debug("two-factor verification failed",
+			slog.String("user_id", state.UserID.String()),
+			slog.String("error", err.Error()),
+		)
+		if errors.Is(err, domain.ErrInvalidTOTPCode) {
+			h.recordLogin(r, usecase.LoginAttempt{UserID: state.UserID, FailureReason: domain.LoginFailureInvalidCode})
+			w.WriteHeader(http.StatusUnauthorized)
+			data.Error = "Invalid authentication code"
+		} else {
+			w.WriteHeader(http.StatusInternalServerError)
+			data.Error = "An error occurred. Please try again."
+		}
+		h.renderTwoFactor(w, data)
+		return
+	}
+
+	h.rateLimit.Reset(rateKey)
+	h.acceptLogin(w, r, state.Challenge, state.UserID, state.Remember,
+		hydra.ACRTwoFactor, []string{firstFactor, hydra.AMROTP})
+}
+
+func (h *Handler) renderTwoFactor(w http.ResponseWriter, data TwoFactorData) {
+	if err := h.templates.ExecuteTemplate(w, "totp.html", data); err != nil {
+		h.logger.Error("failed to render totp template", slog.String("error", err.Error()))
+		http.Error(w, "Internal server error", http.StatusInternalServerError)
+	}
+}
+
+// loginClient returns the display name and branding of the client behind a
+// login request, or generic ones if the request cannot be loaded.
+func (h *Handler) loginClient(r *http.Request, challenge string) (string, Branding) {
+	loginReq, err := h.hydra.GetLoginRequest(r.Context(), challenge)
+	if err != nil {
+		return "Application", h.branding
+	}
+	name := loginReq.Client.ClientName
+	if name == "" {
+		name = "Application"
+	}
+	return name, h.branding.forClient(loginReq.Client)
+}
+
+func (h *Handler) sealLoginState(state loginState) (string, error) {
+	plaintext, err := json.Marshal(state)
+	if err != nil {
+		return "", err
+	}
+	ciphertext, err := h.loginState.Seal(plaintext)
+	if err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(ciphertext), nil
+}
+
+func (h *Handler) openLoginState(sealed string) (*loginState, error) {
+	ciphertext, err := base64.RawURLEncoding.DecodeString(sealed)
+	if err != nil {
+		return nil, errLoginStateInvalid
+	}
+	plaintext, err := h.loginState.Open(ciphertext)
+	if err != nil {
+		return nil, errLoginStateInvalid
+	}
+	var state loginState
+	if err := json.Unmarshal(plaintext, &state); err != nil {
+		return nil, errLoginStateInvalid
+	}
+	if time.Now().Unix() > state.ExpiresAt {
+		return nil, errLoginStateInvalid
+	}
+	return &state, nil
+}
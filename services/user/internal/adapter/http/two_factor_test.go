@@ -0,0 +1,118 @@
+package http
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/daisuke8000/example-ec-platform/services/user/internal/adapter/hydra"
+	"github.com/daisuke8000/example-ec-platform/services/user/internal/domain"
+	"github.com/daisuke8000/example-ec-platform/services/user/internal/usecase"
+)
+
+// mockTwoFactorUseCase answers VerifyCode with err.
+type mockTwoFactorUseCase struct {
+	usecase.TwoFactorUseCase
+	err error
+}
+
+func (m *mockTwoFactorUseCase) VerifyCode(ctx context.Context, userID uuid.UUID, code string) error {
+	return m.err
+}
+
+func TestHandleLoginTwoFactorPost(t *testing.T) {
+	tests := []struct {
+		name        string
+		verifyErr   error
+		firstFactor string
+		wantStatus  int
+		wantACR     string
+		wantAMR     []string
+	}{
+		{
+			name:       "valid code",
+			wantStatus: http.StatusFound,
+			wantACR:    hydra.ACRTwoFactor,
+			wantAMR:    []string{hydra.AMRPassword, hydra.AMROTP},
+		},
+		{
+			name:        "valid code after a social login",
+			firstFactor: hydra.AMRFederated,
+			wantStatus:  http.StatusFound,
+			wantACR:     hydra.ACRTwoFactor,
+			wantAMR:     []string{hydra.AMRFederated, hydra.AMROTP},
+		},
+		{
+			name:       "invalid code",
+			verifyErr:  domain.ErrInvalidTOTPCode,
+			wantStatus: http.StatusUnauthorized,
+		},
+		{
+			name:       "two-factor disabled after the password step",
+			verifyErr:  domain.ErrTwoFactorNotEnrolled,
+			wantStatus: http.StatusFound,
+			wantACR:    hydra.ACRPassword,
+			wantAMR:    []string{hydra.AMRPassword},
+		},
+		{
+			name:        "two-factor disabled after a social login",
+			verifyErr:   domain.ErrTwoFactorNotEnrolled,
+			firstFactor: hydra.AMRFederated,
+			wantStatus:  http.StatusFound,
+			wantACR:     hydra.ACRPassword,
+			wantAMR:     []string{hydra.AMRFederated},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := newFakeHydra(t)
+			h := newTestHandler(t, f, nil, nil, HandlerConfig{
+				TwoFactor:          &mockTwoFactorUseCase{err: tt.verifyErr},
+				TwoFactorSecretKey: "test-secret",
+			})
+			state, err := h.sealLoginState(loginState{
+				Challenge:   "login-challenge",
+				UserID:      uuid.New(),
+				ExpiresAt:   time.Now().Add(loginStateTTL).Unix(),
+				FirstFactor: tt.firstFactor,
+			})
+			if err != nil {
+				t.Fatalf("sealLoginState() error = %v", err)
+			}
+
+			form := url.Values{"login_state": {state}, "code": {"123456"}}
+			req := httptest.NewRequest(http.MethodPost, "/oauth2/login/2fa", strings.NewReader(form.Encode()))
+			req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+			rec := httptest.NewRecorder()
+			h.Router().ServeHTTP(rec, req)
+
+			if rec.Code != tt.wantStatus {
+				t.Fatalf("status = %d, want %d", rec.Code, tt.wantStatus)
+			}
+			accepted := f.loginAccepted()
+			if tt.wantACR == "" {
+				if accepted != nil {
+					t.Errorf("login accepted with %+v", accepted)
+				}
+				return
+			}
+			if rec.Header().Get("Location") != hydraRedirect {
+				t.Errorf("redirected to %q, want %q", rec.Header().Get("Location"), hydraRedirect)
+			}
+			if accepted == nil {
+				t.Fatal("login not accepted")
+			}
+			if accepted.ACR != tt.wantACR || !slices.Equal(accepted.AMR, tt.wantAMR) {
+				t.Errorf("accepted acr=%s amr=%v, want acr=%s amr=%v", accepted.ACR, accepted.AMR, tt.wantACR, tt.wantAMR)
+			}
+		})
+	}
+}
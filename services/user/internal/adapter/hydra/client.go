@@ -2,7 +2,6 @@
 package hydra
 
 import (
-	"bytes"
 	"context"
 	"encoding/json"
 	"fmt"
@@ -10,21 +9,33 @@ import (
 	"net/http"
 	"net/url"
 	"time"
+
+	"github.com/daisuke8000/example-ec-platform/services/user/internal/domain"
 )
 
-// Client handles communication with the Hydra Admin API.
+// Client handles communication with the Hydra Admin API. Calls are
+// retried and guarded by a circuit breaker (see Config); errors of an
+// unavailable Hydra match ErrUnavailable.
 type Client struct {
 	adminURL   string
 	httpClient *http.Client
+	cfg        Config
+	breaker    *breaker
 }
 
-// NewClient creates a new Hydra Admin API client.
+// NewClient creates a new Hydra Admin API client with DefaultConfig.
 func NewClient(adminURL string) *Client {
+	return NewClientWithConfig(adminURL, DefaultConfig())
+}
+
+// NewClientWithConfig creates a new Hydra Admin API client.
+func NewClientWithConfig(adminURL string, cfg Config) *Client {
+	cfg = cfg.withDefaults()
 	return &Client{
-		adminURL: adminURL,
-		httpClient: &http.Client{
-			Timeout: 10 * time.Second,
-		},
+		adminURL:   adminURL,
+		httpClient: &http.Client{},
+		cfg:        cfg,
+		breaker:    newBreaker(cfg.BreakerFailureThreshold, cfg.BreakerOpenTimeout),
 	}
 }
 
@@ -63,6 +74,7 @@ type AcceptLoginRequest struct {
 	Remember    bool   `json:"remember,omitempty"`
 	RememberFor int    `json:"remember_for,omitempty"` // Seconds
 	ACR         string `json:"acr,omitempty"`
+	AMR         []string `json:"amr,omitempty"`
 	Context     map[string]interface{} `json:"context,omitempty"`
 }
 
@@ -116,6 +128,8 @@ type LogoutRequest struct {
 	SessionID       string `json:"sid,omitempty"`
 	RequestURL      string `json:"request_url,omitempty"`
 	RPInitiated     bool   `json:"rp_initiated"`
+	// Client is the client that initiated an RP-initiated logout.
+	Client *OAuth2Client `json:"client,omitempty"`
 }
 
 // GetLoginRequest fetches login request details from Hydra.
@@ -123,12 +137,7 @@ func (c *Client) GetLoginRequest(ctx context.Context, challenge string) (*LoginR
 	endpoint := fmt.Sprintf("%s/admin/oauth2/auth/requests/login?login_challenge=%s",
 		c.adminURL, url.QueryEscape(challenge))
 
-	req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint, nil)
-	if err != nil {
-		return nil, fmt.Errorf("failed to create request: %w", err)
-	}
-
-	resp, err := c.httpClient.Do(req)
+	resp, err := c.do(ctx, http.MethodGet, endpoint, nil)
 	if err != nil {
 		return nil, fmt.Errorf("failed to fetch login request: %w", err)
 	}
@@ -156,13 +165,7 @@ func (c *Client) AcceptLogin(ctx context.Context, challenge string, accept Accep
 		return nil, fmt.Errorf("failed to marshal accept request: %w", err)
 	}
 
-	req, err := http.NewRequestWithContext(ctx, http.MethodPut, endpoint, bytes.NewReader(body))
-	if err != nil {
-		return nil, fmt.Errorf("failed to create request: %w", err)
-	}
-	req.Header.Set("Content-Type", "application/json")
-
-	resp, err := c.httpClient.Do(req)
+	resp, err := c.do(ctx, http.MethodPut, endpoint, body)
 	if err != nil {
 		return nil, fmt.Errorf("failed to accept login: %w", err)
 	}
@@ -190,13 +193,7 @@ func (c *Client) RejectLogin(ctx context.Context, challenge string, reject Rejec
 		return nil, fmt.Errorf("failed to marshal reject request: %w", err)
 	}
 
-	req, err := http.NewRequestWithContext(ctx, http.MethodPut, endpoint, bytes.NewReader(body))
-	if err != nil {
-		return nil, fmt.Errorf("failed to create request: %w", err)
-	}
-	req.Header.Set("Content-Type", "application/json")
-
-	resp, err := c.httpClient.Do(req)
+	resp, err := c.do(ctx, http.MethodPut, endpoint, body)
 	if err != nil {
 		return nil, fmt.Errorf("failed to reject login: %w", err)
 	}
@@ -219,12 +216,7 @@ func (c *Client) GetConsentRequest(ctx context.Context, challenge string) (*Cons
 	endpoint := fmt.Sprintf("%s/admin/oauth2/auth/requests/consent?consent_challenge=%s",
 		c.adminURL, url.QueryEscape(challenge))
 
-	req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint, nil)
-	if err != nil {
-		return nil, fmt.Errorf("failed to create request: %w", err)
-	}
-
-	resp, err := c.httpClient.Do(req)
+	resp, err := c.do(ctx, http.MethodGet, endpoint, nil)
 	if err != nil {
 		return nil, fmt.Errorf("failed to fetch consent request: %w", err)
 	}
@@ -252,13 +244,7 @@ func (c *Client) AcceptConsent(ctx context.Context, challenge string, accept Acc
 		return nil, fmt.Errorf("failed to marshal accept request: %w", err)
 	}
 
-	req, err := http.NewRequestWithContext(ctx, http.MethodPut, endpoint, bytes.NewReader(body))
-	if err != nil {
-		return nil, fmt.Errorf("failed to create request: %w", err)
-	}
-	req.Header.Set("Content-Type", "application/json")
-
-	resp, err := c.httpClient.Do(req)
+	resp, err := c.do(ctx, http.MethodPut, endpoint, body)
 	if err != nil {
 		return nil, fmt.Errorf("failed to accept consent: %w", err)
 	}
@@ -286,13 +272,7 @@ func (c *Client) RejectConsent(ctx context.Context, challenge string, reject Rej
 		return nil, fmt.Errorf("failed to marshal reject request: %w", err)
 	}
 
-	req, err := http.NewRequestWithContext(ctx, http.MethodPut, endpoint, bytes.NewReader(body))
-	if err != nil {
-		return nil, fmt.Errorf("failed to create request: %w", err)
-	}
-	req.Header.Set("Content-Type", "application/json")
-
-	resp, err := c.httpClient.Do(req)
+	resp, err := c.do(ctx, http.MethodPut, endpoint, body)
 	if err != nil {
 		return nil, fmt.Errorf("failed to reject consent: %w", err)
 	}
@@ -315,12 +295,7 @@ func (c *Client) GetLogoutRequest(ctx context.Context, challenge string) (*Logou
 	endpoint := fmt.Sprintf("%s/admin/oauth2/auth/requests/logout?logout_challenge=%s",
 		c.adminURL, url.QueryEscape(challenge))
 
-	req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint, nil)
-	if err != nil {
-		return nil, fmt.Errorf("failed to create request: %w", err)
-	}
-
-	resp, err := c.httpClient.Do(req)
+	resp, err := c.do(ctx, http.MethodGet, endpoint, nil)
 	if err != nil {
 		return nil, fmt.Errorf("failed to fetch logout request: %w", err)
 	}
@@ -343,12 +318,7 @@ func (c *Client) AcceptLogout(ctx context.Context, challenge string) (*RedirectR
 	endpoint := fmt.Sprintf("%s/admin/oauth2/auth/requests/logout/accept?logout_challenge=%s",
 		c.adminURL, url.QueryEscape(challenge))
 
-	req, err := http.NewRequestWithContext(ctx, http.MethodPut, endpoint, nil)
-	if err != nil {
-		return nil, fmt.Errorf("failed to create request: %w", err)
-	}
-
-	resp, err := c.httpClient.Do(req)
+	resp, err := c.do(ctx, http.MethodPut, endpoint, nil)
 	if err != nil {
 		return nil, fmt.Errorf("failed to accept logout: %w", err)
 	}
@@ -371,12 +341,7 @@ func (c *Client) RejectLogout(ctx context.Context, challenge string) error {
 	endpoint := fmt.Sprintf("%s/admin/oauth2/auth/requests/logout/reject?logout_challenge=%s",
 		c.adminURL, url.QueryEscape(challenge))
 
-	req, err := http.NewRequestWithContext(ctx, http.MethodPut, endpoint, nil)
-	if err != nil {
-		return fmt.Errorf("failed to create request: %w", err)
-	}
-
-	resp, err := c.httpClient.Do(req)
+	resp, err := c.do(ctx, http.MethodPut, endpoint, nil)
 	if err != nil {
 		return fmt.Errorf("failed to reject logout: %w", err)
 	}
@@ -390,6 +355,115 @@ func (c *Client) RejectLogout(ctx context.Context, challenge string) error {
 	return nil
 }
 
+// RevokeConsentSessions revokes all consent sessions a subject granted to a client,
+// invalidating the associated access and refresh tokens.
+func (c *Client) RevokeConsentSessions(ctx context.Context, subject, clientID string) error {
+	endpoint := fmt.Sprintf("%s/admin/oauth2/auth/sessions/consent?subject=%s&client=%s",
+		c.adminURL, url.QueryEscape(subject), url.QueryEscape(clientID))
+
+	resp, err := c.do(ctx, http.MethodDelete, endpoint, nil)
+	if err != nil {
+		return fmt.Errorf("failed to revoke consent sessions: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusNoContent {
+		return c.handleErrorResponse(resp)
+	}
+
+	return nil
+}
+
+// Keys of the login context recorded when a user signs in. Hydra passes the
+// context on to the consent requests of the login session.
+const (
+	LoginContextUserAgent       = "user_agent"
+	LoginContextAuthenticatedAt = "authenticated_at"
+)
+
+// Authentication context class (acr) and methods (amr, RFC 8176) of a login.
+// Relying parties can require ACRTwoFactor for sensitive operations.
+const (
+	ACRPassword  = "aal1"
+	ACRTwoFactor = "aal2"
+
+	AMRPassword = "pwd"
+	AMROTP      = "otp"
+	// AMRFederated is a sign-in at an upstream identity provider; it is
+	// not in RFC 8176 but is what Microsoft Entra ID uses.
+	AMRFederated = "fed"
+)
+
+// maxConsentSessions bounds the consent sessions listed per subject.
+const maxConsentSessions = 500
+
+// PreviousConsentSession is a consent a subject granted, as listed by Hydra.
+type PreviousConsentSession struct {
+	ConsentRequest ConsentRequest `json:"consent_request"`
+	GrantScope     []string       `json:"grant_scope"`
+	HandledAt      time.Time      `json:"handled_at"`
+}
+
+// ListSessionGrants returns the consents a subject granted that are still
+// active, with the login session each was granted in.
+func (c *Client) ListSessionGrants(ctx context.Context, subject string) ([]domain.SessionGrant, error) {
+	endpoint := fmt.Sprintf("%s/admin/oauth2/auth/sessions/consent?subject=%s&page_size=%d",
+		c.adminURL, url.QueryEscape(subject), maxConsentSessions)
+
+	resp, err := c.do(ctx, http.MethodGet, endpoint, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list consent sessions: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, c.handleErrorResponse(resp)
+	}
+
+	var sessions []PreviousConsentSession
+	if err := json.NewDecoder(resp.Body).Decode(&sessions); err != nil {
+		return nil, fmt.Errorf("failed to decode consent sessions: %w", err)
+	}
+
+	grants := make([]domain.SessionGrant, 0, len(sessions))
+	for _, s := range sessions {
+		grant := domain.SessionGrant{
+			SessionID:  s.ConsentRequest.LoginSessionID,
+			ClientID:   s.ConsentRequest.Client.ClientID,
+			ClientName: s.ConsentRequest.Client.ClientName,
+			Scopes:     s.GrantScope,
+			GrantedAt:  s.HandledAt,
+		}
+		if ua, ok := s.ConsentRequest.Context[LoginContextUserAgent].(string); ok {
+			grant.UserAgent = ua
+		}
+		if at, ok := s.ConsentRequest.Context[LoginContextAuthenticatedAt].(string); ok {
+			grant.AuthenticatedAt, _ = time.Parse(time.RFC3339, at)
+		}
+		grants = append(grants, grant)
+	}
+	return grants, nil
+}
+
+// RevokeLoginSession ends one login session, so the browser has to sign in
+// again. Tokens already issued are not revoked.
+func (c *Client) RevokeLoginSession(ctx context.Context, sessionID string) error {
+	endpoint := fmt.Sprintf("%s/admin/oauth2/auth/sessions/login?sid=%s",
+		c.adminURL, url.QueryEscape(sessionID))
+
+	resp, err := c.do(ctx, http.MethodDelete, endpoint, nil)
+	if err != nil {
+		return fmt.Errorf("failed to revoke login session: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusNoContent {
+		return c.handleErrorResponse(resp)
+	}
+
+	return nil
+}
+
 // HydraError represents an error returned by Hydra API.
 type HydraError struct {
 	Error            string `json:"error"`
@@ -397,19 +471,20 @@ type HydraError struct {
 	StatusCode       int    `json:"status_code,omitempty"`
 }
 
+// Err returns the error as an *APIError.
 func (e *HydraError) Err() error {
-	return fmt.Errorf("hydra error: %s - %s (status: %d)", e.Error, e.ErrorDescription, e.StatusCode)
+	return &APIError{StatusCode: e.StatusCode, Code: e.Error, Description: e.ErrorDescription}
 }
 
 func (c *Client) handleErrorResponse(resp *http.Response) error {
 	body, err := io.ReadAll(resp.Body)
 	if err != nil {
-		return fmt.Errorf("hydra API error (status %d): failed to read response body", resp.StatusCode)
+		return &APIError{StatusCode: resp.StatusCode, Description: "failed to read response body"}
 	}
 
 	var hydraErr HydraError
 	if err := json.Unmarshal(body, &hydraErr); err != nil {
-		return fmt.Errorf("hydra API error (status %d): %s", resp.StatusCode, string(body))
+		return &APIError{StatusCode: resp.StatusCode, Description: string(body)}
 	}
 
 	hydraErr.StatusCode = resp.StatusCode
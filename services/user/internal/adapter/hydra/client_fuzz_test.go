@@ -0,0 +1,35 @@
+package hydra
+
+import (
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+func FuzzHandleErrorResponse(f *testing.F) {
+	f.Add(400, `{"error":"invalid_request","error_description":"The request is missing a required parameter."}`)
+	f.Add(404, `{"error":"Not Found","status_code":404}`)
+	f.Add(500, `internal server error`)
+	f.Add(502, ``)
+	f.Add(409, `{"error":null}`)
+	f.Add(400, `{"error":{"nested":true}}`)
+
+	client := NewClient("http://hydra.invalid")
+
+	f.Fuzz(func(t *testing.T, status int, body string) {
+		resp := &http.Response{
+			StatusCode: status,
+			Body:       io.NopCloser(strings.NewReader(body)),
+		}
+
+		err := client.handleErrorResponse(resp)
+		if err == nil {
+			t.Fatalf("handleErrorResponse(%d, %q) = nil, want an error", status, body)
+		}
+		if !strings.Contains(err.Error(), strconv.Itoa(status)) {
+			t.Fatalf("handleErrorResponse(%d, %q) = %q, want the status code in the message", status, body, err)
+		}
+	})
+}
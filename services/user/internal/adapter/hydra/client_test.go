@@ -6,6 +6,7 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 )
 
 func TestGetLoginRequest(t *testing.T) {
@@ -500,3 +501,89 @@ func TestRejectLogout(t *testing.T) {
 		t.Errorf("unexpected error: %v", err)
 	}
 }
+
+func TestRevokeConsentSessions(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodDelete {
+			t.Errorf("expected DELETE, got %s", r.Method)
+		}
+		if got := r.URL.Query().Get("subject"); got != "user-123" {
+			t.Errorf("expected subject user-123, got %s", got)
+		}
+		if got := r.URL.Query().Get("client"); got != "test-client" {
+			t.Errorf("expected client test-client, got %s", got)
+		}
+
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL)
+	err := client.RevokeConsentSessions(context.Background(), "user-123", "test-client")
+
+	if err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
+
+func TestListSessionGrants(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			t.Errorf("expected GET, got %s", r.Method)
+		}
+		if got := r.URL.Query().Get("subject"); got != "user-123" {
+			t.Errorf("expected subject user-123, got %s", got)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`[{
+			"consent_request": {
+				"login_session_id": "session-1",
+				"client": {"client_id": "test-client", "client_name": "Test Client"},
+				"context": {"user_agent": "Mozilla/5.0", "authenticated_at": "2024-01-01T00:00:00Z"}
+			},
+			"grant_scope": ["openid", "email"],
+			"handled_at": "2024-01-01T00:00:05Z"
+		}]`))
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL)
+	grants, err := client.ListSessionGrants(context.Background(), "user-123")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(grants) != 1 {
+		t.Fatalf("expected 1 grant, got %d", len(grants))
+	}
+	g := grants[0]
+	if g.SessionID != "session-1" || g.ClientID != "test-client" || g.UserAgent != "Mozilla/5.0" {
+		t.Errorf("unexpected grant: %+v", g)
+	}
+	if want := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC); !g.AuthenticatedAt.Equal(want) {
+		t.Errorf("expected authenticated_at %v, got %v", want, g.AuthenticatedAt)
+	}
+	if len(g.Scopes) != 2 {
+		t.Errorf("expected 2 scopes, got %v", g.Scopes)
+	}
+}
+
+func TestRevokeLoginSession(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodDelete {
+			t.Errorf("expected DELETE, got %s", r.Method)
+		}
+		if got := r.URL.Query().Get("sid"); got != "session-1" {
+			t.Errorf("expected sid session-1, got %s", got)
+		}
+
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL)
+	if err := client.RevokeLoginSession(context.Background(), "session-1"); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
@@ -0,0 +1,221 @@
+package hydra
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"math/rand/v2"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// ErrUnavailable matches errors of calls that failed because Hydra could
+// not be reached, timed out or answered with a server error after all
+// retries, and calls rejected by the open circuit breaker. The login pages
+// show a "try again shortly" page for them instead of a generic error.
+var ErrUnavailable = errors.New("hydra is unavailable")
+
+// ErrCircuitOpen is returned without contacting Hydra while the circuit
+// breaker is open. It matches ErrUnavailable.
+var ErrCircuitOpen = fmt.Errorf("%w: circuit breaker open", ErrUnavailable)
+
+// maxResponseSize bounds the Hydra responses read into memory.
+const maxResponseSize = 10 << 20
+
+// APIError is an error response of the Hydra Admin API. Server errors
+// (5xx) match ErrUnavailable.
+type APIError struct {
+	StatusCode int
+	// Code is the OAuth2 error code, e.g. "invalid_request"; empty if the
+	// body was not a Hydra error.
+	Code        string
+	Description string
+}
+
+func (e *APIError) Error() string {
+	if e.Code == "" {
+		return fmt.Sprintf("hydra API error (status %d): %s", e.StatusCode, e.Description)
+	}
+	return fmt.Sprintf("hydra error: %s - %s (status: %d)", e.Code, e.Description, e.StatusCode)
+}
+
+func (e *APIError) Is(target error) bool {
+	return target == ErrUnavailable && e.StatusCode >= http.StatusInternalServerError
+}
+
+// Config tunes the Hydra client. Zero values fall back to DefaultConfig.
+type Config struct {
+	// AttemptTimeout bounds each HTTP attempt, including reading the body.
+	AttemptTimeout time.Duration
+
+	// RetryMaxAttempts is the number of attempts per call, including the
+	// first. Connection errors, timeouts and 5xx responses are retried;
+	// every Admin API call is a GET, PUT or DELETE and safe to repeat.
+	RetryMaxAttempts    int
+	RetryInitialBackoff time.Duration
+	RetryMaxBackoff     time.Duration
+
+	// BreakerFailureThreshold is the number of consecutive failed attempts
+	// that opens the circuit breaker; BreakerOpenTimeout is how long it
+	// stays open before a single probe is let through.
+	BreakerFailureThreshold int
+	BreakerOpenTimeout      time.Duration
+}
+
+// DefaultConfig returns the settings used by NewClient.
+func DefaultConfig() Config {
+	return Config{
+		AttemptTimeout:          5 * time.Second,
+		RetryMaxAttempts:        3,
+		RetryInitialBackoff:     100 * time.Millisecond,
+		RetryMaxBackoff:         time.Second,
+		BreakerFailureThreshold: 5,
+		BreakerOpenTimeout:      30 * time.Second,
+	}
+}
+
+func (cfg Config) withDefaults() Config {
+	def := DefaultConfig()
+	if cfg.AttemptTimeout <= 0 {
+		cfg.AttemptTimeout = def.AttemptTimeout
+	}
+	if cfg.RetryMaxAttempts < 1 {
+		cfg.RetryMaxAttempts = def.RetryMaxAttempts
+	}
+	if cfg.RetryInitialBackoff <= 0 {
+		cfg.RetryInitialBackoff = def.RetryInitialBackoff
+	}
+	if cfg.RetryMaxBackoff < cfg.RetryInitialBackoff {
+		cfg.RetryMaxBackoff = max(def.RetryMaxBackoff, cfg.RetryInitialBackoff)
+	}
+	if cfg.BreakerFailureThreshold < 1 {
+		cfg.BreakerFailureThreshold = def.BreakerFailureThreshold
+	}
+	if cfg.BreakerOpenTimeout <= 0 {
+		cfg.BreakerOpenTimeout = def.BreakerOpenTimeout
+	}
+	return cfg
+}
+
+// do sends a request to Hydra, retrying failed attempts with exponential
+// backoff. The returned response has its body read into memory, so it
+// outlives the attempt timeout. Callers still close it.
+func (c *Client) do(ctx context.Context, method, endpoint string, body []byte) (*http.Response, error) {
+	backoff := c.cfg.RetryInitialBackoff
+	for attempt := 1; ; attempt++ {
+		if !c.breaker.allow() {
+			return nil, ErrCircuitOpen
+		}
+		resp, err := c.attempt(ctx, method, endpoint, body)
+		if ctx.Err() != nil {
+			// The caller gave up; that says nothing about Hydra's health.
+			c.breaker.release()
+			return nil, ctx.Err()
+		}
+		failed := err != nil || resp.StatusCode >= http.StatusInternalServerError
+		c.breaker.record(failed)
+		if !failed || attempt >= c.cfg.RetryMaxAttempts {
+			if err != nil {
+				return nil, fmt.Errorf("%w: %w", ErrUnavailable, err)
+			}
+			return resp, nil
+		}
+
+		// Equal jitter keeps concurrent retries from arriving together.
+		wait := backoff/2 + rand.N(backoff/2+1)
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(wait):
+		}
+		backoff = min(2*backoff, c.cfg.RetryMaxBackoff)
+	}
+}
+
+func (c *Client) attempt(ctx context.Context, method, endpoint string, body []byte) (*http.Response, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.cfg.AttemptTimeout)
+	defer cancel()
+
+	var reader io.Reader
+	if body != nil {
+		reader = bytes.NewReader(body)
+	}
+	req, err := http.NewRequestWithContext(ctx, method, endpoint, reader)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	data, err := io.ReadAll(io.LimitReader(resp.Body, maxResponseSize))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response: %w", err)
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(data))
+	return resp, nil
+}
+
+// breaker fails calls fast after consecutive failed attempts, so a Hydra
+// outage does not hold every login page for the full timeout and retries.
+// After the open timeout a single probe decides whether to close it again.
+type breaker struct {
+	threshold int
+	openFor   time.Duration
+	now       func() time.Time
+
+	mu       sync.Mutex
+	failures int
+	open     bool
+	openedAt time.Time
+	probing  bool
+}
+
+func newBreaker(threshold int, openFor time.Duration) *breaker {
+	return &breaker{threshold: threshold, openFor: openFor, now: time.Now}
+}
+
+func (b *breaker) allow() bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if !b.open {
+		return true
+	}
+	if b.probing || b.now().Sub(b.openedAt) < b.openFor {
+		return false
+	}
+	b.probing = true
+	return true
+}
+
+func (b *breaker) record(failed bool) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.probing = false
+	if !failed {
+		b.failures = 0
+		b.open = false
+		return
+	}
+	b.failures++
+	if b.open || b.failures >= b.threshold {
+		b.open = true
+		b.openedAt = b.now()
+	}
+}
+
+// release ends an attempt without an outcome, letting another probe through.
+func (b *breaker) release() {
+	b.mu.Lock()
+	b.probing = false
+	b.mu.Unlock()
+}
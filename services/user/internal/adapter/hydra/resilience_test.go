@@ -0,0 +1,120 @@
+package hydra
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func testConfig() Config {
+	return Config{
+		AttemptTimeout:          time.Second,
+		RetryMaxAttempts:        3,
+		RetryInitialBackoff:     time.Millisecond,
+		RetryMaxBackoff:         2 * time.Millisecond,
+		BreakerFailureThreshold: 3,
+		BreakerOpenTimeout:      time.Hour,
+	}
+}
+
+func TestClient_RetriesServerErrors(t *testing.T) {
+	var calls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if calls.Add(1) < 3 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	client := NewClientWithConfig(server.URL, testConfig())
+	if err := client.RevokeLoginSession(context.Background(), "session-1"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if calls.Load() != 3 {
+		t.Errorf("expected 3 attempts, got %d", calls.Load())
+	}
+}
+
+func TestClient_DoesNotRetryClientErrors(t *testing.T) {
+	var calls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		w.WriteHeader(http.StatusNotFound)
+		w.Write([]byte(`{"error":"Not Found","error_description":"Unable to locate the resource"}`))
+	}))
+	defer server.Close()
+
+	client := NewClientWithConfig(server.URL, testConfig())
+	_, err := client.GetLoginRequest(context.Background(), "challenge")
+
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) || apiErr.StatusCode != http.StatusNotFound || apiErr.Code != "Not Found" {
+		t.Fatalf("expected a 404 APIError, got %v", err)
+	}
+	if errors.Is(err, ErrUnavailable) {
+		t.Error("a 404 should not match ErrUnavailable")
+	}
+	if calls.Load() != 1 {
+		t.Errorf("expected 1 attempt, got %d", calls.Load())
+	}
+}
+
+func TestClient_CircuitBreaker(t *testing.T) {
+	var calls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		w.WriteHeader(http.StatusBadGateway)
+	}))
+	defer server.Close()
+
+	client := NewClientWithConfig(server.URL, testConfig())
+	_, err := client.GetLoginRequest(context.Background(), "challenge")
+	if !errors.Is(err, ErrUnavailable) {
+		t.Fatalf("expected ErrUnavailable after retries, got %v", err)
+	}
+
+	// Three failed attempts opened the breaker.
+	_, err = client.GetLoginRequest(context.Background(), "challenge")
+	if !errors.Is(err, ErrCircuitOpen) || !errors.Is(err, ErrUnavailable) {
+		t.Fatalf("expected ErrCircuitOpen, got %v", err)
+	}
+	if calls.Load() != 3 {
+		t.Errorf("expected 3 attempts, got %d", calls.Load())
+	}
+
+	// After the open timeout one probe is let through and closes it.
+	now := time.Now().Add(2 * time.Hour)
+	client.breaker.now = func() time.Time { return now }
+	server.Config.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	})
+	if err := client.RevokeLoginSession(context.Background(), "session-1"); err != nil {
+		t.Fatalf("probe failed: %v", err)
+	}
+	if !client.breaker.allow() {
+		t.Error("expected the breaker to close after a successful probe")
+	}
+}
+
+func TestClient_AttemptTimeout(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-r.Context().Done()
+	}))
+	defer server.Close()
+
+	cfg := testConfig()
+	cfg.AttemptTimeout = 20 * time.Millisecond
+	cfg.RetryMaxAttempts = 2
+	client := NewClientWithConfig(server.URL, cfg)
+
+	_, err := client.GetLoginRequest(context.Background(), "challenge")
+	if !errors.Is(err, ErrUnavailable) {
+		t.Fatalf("expected ErrUnavailable for timed out attempts, got %v", err)
+	}
+}
@@ -0,0 +1,48 @@
+// Package mailer provides outbound email delivery implementations.
+package mailer
+
+import (
+	"context"
+	"log/slog"
+	"net/url"
+	"strings"
+
+	"github.com/daisuke8000/example-ec-platform/services/user/internal/domain"
+)
+
+// LogSender writes verification links and new device notices to the log
+// instead of sending email. Intended for local development only: the
+// logged link is a live credential.
+type LogSender struct {
+	baseURL string
+	logger  *slog.Logger
+}
+
+// NewLogSender creates a sender that builds links against baseURL.
+func NewLogSender(baseURL string, logger *slog.Logger) *LogSender {
+	return &LogSender{
+		baseURL: strings.TrimRight(baseURL, "/"),
+		logger:  logger,
+	}
+}
+
+// SendVerification logs the verification link for the user.
+func (s *LogSender) SendVerification(ctx context.Context, user *domain.User, token string) error {
+	link := s.baseURL + "/account/verify-email?token=" + url.QueryEscape(token)
+	s.logger.InfoContext(ctx, "email verification link issued",
+		slog.String("user_id", user.ID.String()),
+		slog.String("link", link),
+	)
+	return nil
+}
+
+// NotifyNewDevice logs the notice of a login from a new device.
+func (s *LogSender) NotifyNewDevice(ctx context.Context, user *domain.User, event *domain.LoginEvent) error {
+	s.logger.InfoContext(ctx, "new device login notice",
+		slog.String("user_id", user.ID.String()),
+		slog.String("ip_address", event.IPAddress),
+		slog.String("user_agent", event.UserAgent),
+		slog.Time("occurred_at", event.OccurredAt),
+	)
+	return nil
+}
@@ -0,0 +1,120 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/daisuke8000/example-ec-platform/services/user/internal/domain"
+)
+
+// PostgresAccessGrantRepository implements AccessGrantRepository using PostgreSQL.
+type PostgresAccessGrantRepository struct {
+	pool *pgxpool.Pool
+}
+
+// NewPostgresAccessGrantRepository creates a new PostgreSQL-backed access grant repository.
+func NewPostgresAccessGrantRepository(pool *pgxpool.Pool) *PostgresAccessGrantRepository {
+	return &PostgresAccessGrantRepository{pool: pool}
+}
+
+const accessGrantColumns = `id, grantee_id, permission, reason, granted_by, granted_at, expires_at, revoked_at, revoked_by`
+
+// Create persists a new access grant.
+func (r *PostgresAccessGrantRepository) Create(ctx context.Context, grant *domain.AccessGrant) error {
+	query := `
+		INSERT INTO user_service.access_grants
+			(id, grantee_id, permission, reason, granted_by, granted_at, expires_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+	`
+
+	_, err := r.pool.Exec(ctx, query,
+		grant.ID,
+		grant.GranteeID,
+		grant.Permission,
+		grant.Reason,
+		grant.GrantedBy,
+		grant.GrantedAt,
+		grant.ExpiresAt,
+	)
+	return err
+}
+
+// FindByID returns a grant by ID.
+func (r *PostgresAccessGrantRepository) FindByID(ctx context.Context, id uuid.UUID) (*domain.AccessGrant, error) {
+	query := `SELECT ` + accessGrantColumns + ` FROM user_service.access_grants WHERE id = $1`
+
+	grant, err := scanAccessGrant(r.pool.QueryRow(ctx, query, id))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, domain.ErrAccessGrantNotFound
+	}
+	return grant, err
+}
+
+// ListByGrantee returns the user's grants, newest first.
+func (r *PostgresAccessGrantRepository) ListByGrantee(ctx context.Context, granteeID uuid.UUID, activeAt *time.Time) ([]*domain.AccessGrant, error) {
+	query := `
+		SELECT ` + accessGrantColumns + `
+		FROM user_service.access_grants
+		WHERE grantee_id = $1
+			AND ($2::timestamptz IS NULL OR (revoked_at IS NULL AND expires_at > $2))
+		ORDER BY granted_at DESC, id DESC
+	`
+
+	rows, err := r.pool.Query(ctx, query, granteeID, activeAt)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var grants []*domain.AccessGrant
+	for rows.Next() {
+		grant, err := scanAccessGrant(rows)
+		if err != nil {
+			return nil, err
+		}
+		grants = append(grants, grant)
+	}
+
+	return grants, rows.Err()
+}
+
+// Revoke marks an unrevoked grant as revoked.
+func (r *PostgresAccessGrantRepository) Revoke(ctx context.Context, id uuid.UUID, revokedBy string, revokedAt time.Time) error {
+	query := `
+		UPDATE user_service.access_grants
+		SET revoked_at = $3, revoked_by = $2
+		WHERE id = $1 AND revoked_at IS NULL
+	`
+
+	result, err := r.pool.Exec(ctx, query, id, revokedBy, revokedAt)
+	if err != nil {
+		return err
+	}
+	if result.RowsAffected() == 0 {
+		return domain.ErrAccessGrantNotFound
+	}
+	return nil
+}
+
+func scanAccessGrant(row pgx.Row) (*domain.AccessGrant, error) {
+	var g domain.AccessGrant
+	if err := row.Scan(
+		&g.ID,
+		&g.GranteeID,
+		&g.Permission,
+		&g.Reason,
+		&g.GrantedBy,
+		&g.GrantedAt,
+		&g.ExpiresAt,
+		&g.RevokedAt,
+		&g.RevokedBy,
+	); err != nil {
+		return nil, err
+	}
+	return &g, nil
+}
@@ -0,0 +1,154 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/daisuke8000/example-ec-platform/services/user/internal/domain"
+)
+
+// PostgresAddressRepository implements AddressRepository using PostgreSQL.
+// A partial unique index keeps at most one default shipping address per user.
+type PostgresAddressRepository struct {
+	pool *pgxpool.Pool
+}
+
+// NewPostgresAddressRepository creates a new PostgreSQL-backed address repository.
+func NewPostgresAddressRepository(pool *pgxpool.Pool) *PostgresAddressRepository {
+	return &PostgresAddressRepository{pool: pool}
+}
+
+// Create inserts the address, clearing the previous default first when it
+// is the new default shipping address.
+func (r *PostgresAddressRepository) Create(ctx context.Context, address *domain.Address) error {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	if address.DefaultShipping {
+		if err := clearDefaultShipping(ctx, tx, address.UserID, address.CreatedAt); err != nil {
+			return err
+		}
+	}
+
+	if _, err := tx.Exec(ctx, `
+		INSERT INTO user_service.addresses
+			(id, user_id, recipient, phone_number, country, postal_code, region, city, line1, line2, default_shipping, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13)
+	`,
+		address.ID,
+		address.UserID,
+		address.Recipient,
+		string(address.PhoneNumber),
+		address.Country,
+		address.PostalCode,
+		address.Region,
+		address.City,
+		address.Line1,
+		address.Line2,
+		address.DefaultShipping,
+		address.CreatedAt,
+		address.UpdatedAt,
+	); err != nil {
+		return err
+	}
+	return tx.Commit(ctx)
+}
+
+// ListByUser returns the user's addresses, the default shipping address first.
+func (r *PostgresAddressRepository) ListByUser(ctx context.Context, userID uuid.UUID) ([]*domain.Address, error) {
+	query := `
+		SELECT id, user_id, recipient, phone_number, country, postal_code, region, city, line1, line2, default_shipping, created_at, updated_at
+		FROM user_service.addresses
+		WHERE user_id = $1
+		ORDER BY default_shipping DESC, created_at DESC, id
+	`
+
+	rows, err := r.pool.Query(ctx, query, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var addresses []*domain.Address
+	for rows.Next() {
+		var a domain.Address
+		var phone string
+		if err := rows.Scan(
+			&a.ID,
+			&a.UserID,
+			&a.Recipient,
+			&phone,
+			&a.Country,
+			&a.PostalCode,
+			&a.Region,
+			&a.City,
+			&a.Line1,
+			&a.Line2,
+			&a.DefaultShipping,
+			&a.CreatedAt,
+			&a.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		a.PhoneNumber = domain.PhoneNumber(phone)
+		addresses = append(addresses, &a)
+	}
+	return addresses, rows.Err()
+}
+
+// SetDefaultShipping moves the user's default to the address in one transaction.
+func (r *PostgresAddressRepository) SetDefaultShipping(ctx context.Context, userID, addressID uuid.UUID, now time.Time) error {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	if err := clearDefaultShipping(ctx, tx, userID, now); err != nil {
+		return err
+	}
+
+	result, err := tx.Exec(ctx, `
+		UPDATE user_service.addresses
+		SET default_shipping = TRUE, updated_at = $3
+		WHERE id = $1 AND user_id = $2
+	`, addressID, userID, now)
+	if err != nil {
+		return err
+	}
+	if result.RowsAffected() == 0 {
+		return domain.ErrAddressNotFound
+	}
+	return tx.Commit(ctx)
+}
+
+// Delete removes one of the user's addresses.
+func (r *PostgresAddressRepository) Delete(ctx context.Context, userID, addressID uuid.UUID) error {
+	result, err := r.pool.Exec(ctx, `
+		DELETE FROM user_service.addresses
+		WHERE id = $1 AND user_id = $2
+	`, addressID, userID)
+	if err != nil {
+		return err
+	}
+	if result.RowsAffected() == 0 {
+		return domain.ErrAddressNotFound
+	}
+	return nil
+}
+
+func clearDefaultShipping(ctx context.Context, tx pgx.Tx, userID uuid.UUID, now time.Time) error {
+	_, err := tx.Exec(ctx, `
+		UPDATE user_service.addresses
+		SET default_shipping = FALSE, updated_at = $2
+		WHERE user_id = $1 AND default_shipping
+	`, userID, now)
+	return err
+}
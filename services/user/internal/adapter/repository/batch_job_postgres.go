@@ -0,0 +1,153 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/daisuke8000/example-ec-platform/services/user/internal/domain"
+)
+
+// PostgresBatchJobRepository implements BatchJobRepository using PostgreSQL.
+type PostgresBatchJobRepository struct {
+	pool *pgxpool.Pool
+}
+
+// NewPostgresBatchJobRepository creates a new PostgreSQL-backed batch job repository.
+func NewPostgresBatchJobRepository(pool *pgxpool.Pool) *PostgresBatchJobRepository {
+	return &PostgresBatchJobRepository{pool: pool}
+}
+
+// Create persists a new batch job.
+func (r *PostgresBatchJobRepository) Create(ctx context.Context, job *domain.BatchJob) error {
+	query := `
+		INSERT INTO user_service.batch_jobs
+			(id, kind, status, segment, requested_by, total, created_at, updated_at)
+		VALUES ($1, $2, $3, NULLIF($4, ''), $5, $6, $7, $7)
+	`
+
+	_, err := r.pool.Exec(ctx, query,
+		job.ID,
+		job.Kind,
+		job.Status,
+		job.Segment,
+		job.RequestedBy,
+		job.Total,
+		job.CreatedAt,
+	)
+	return err
+}
+
+// FindByID retrieves a batch job.
+// Returns ErrBatchJobNotFound if the job doesn't exist.
+func (r *PostgresBatchJobRepository) FindByID(ctx context.Context, id uuid.UUID) (*domain.BatchJob, error) {
+	query := `
+		SELECT id, kind, status, COALESCE(segment, ''), requested_by,
+			total, processed, succeeded, failed, created_at, completed_at
+		FROM user_service.batch_jobs
+		WHERE id = $1
+	`
+
+	var job domain.BatchJob
+	err := r.pool.QueryRow(ctx, query, id).Scan(
+		&job.ID,
+		&job.Kind,
+		&job.Status,
+		&job.Segment,
+		&job.RequestedBy,
+		&job.Total,
+		&job.Processed,
+		&job.Succeeded,
+		&job.Failed,
+		&job.CreatedAt,
+		&job.CompletedAt,
+	)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, domain.ErrBatchJobNotFound
+		}
+		return nil, err
+	}
+
+	return &job, nil
+}
+
+// SaveProgress updates the job counters and appends results in a single transaction.
+// Results are numbered after those already stored so the report keeps processing order.
+func (r *PostgresBatchJobRepository) SaveProgress(ctx context.Context, job *domain.BatchJob, results []domain.BatchJobResult) error {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	if len(results) > 0 {
+		first := job.Processed - len(results)
+		rows := make([][]any, len(results))
+		for i, res := range results {
+			rows[i] = []any{job.ID, first + i, res.UserID, string(res.Status), res.Error}
+		}
+		if _, err := tx.CopyFrom(ctx,
+			pgx.Identifier{"user_service", "batch_job_results"},
+			[]string{"job_id", "seq", "user_id", "status", "error"},
+			pgx.CopyFromRows(rows),
+		); err != nil {
+			return err
+		}
+	}
+
+	query := `
+		UPDATE user_service.batch_jobs
+		SET status = $2, processed = $3, succeeded = $4, failed = $5,
+			completed_at = $6, updated_at = $7
+		WHERE id = $1
+	`
+	result, err := tx.Exec(ctx, query,
+		job.ID,
+		job.Status,
+		job.Processed,
+		job.Succeeded,
+		job.Failed,
+		job.CompletedAt,
+		time.Now().UTC(),
+	)
+	if err != nil {
+		return err
+	}
+	if result.RowsAffected() == 0 {
+		return domain.ErrBatchJobNotFound
+	}
+
+	return tx.Commit(ctx)
+}
+
+// ListResults returns the per-user results of a job in processing order.
+func (r *PostgresBatchJobRepository) ListResults(ctx context.Context, id uuid.UUID) ([]domain.BatchJobResult, error) {
+	query := `
+		SELECT user_id, status, error
+		FROM user_service.batch_job_results
+		WHERE job_id = $1
+		ORDER BY seq
+	`
+
+	rows, err := r.pool.Query(ctx, query, id)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var results []domain.BatchJobResult
+	for rows.Next() {
+		var res domain.BatchJobResult
+		if err := rows.Scan(&res.UserID, &res.Status, &res.Error); err != nil {
+			return nil, err
+		}
+		results = append(results, res)
+	}
+
+	return results, rows.Err()
+}
@@ -0,0 +1,93 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/daisuke8000/example-ec-platform/services/user/internal/domain"
+)
+
+// PostgresConsentRepository implements ConsentRepository using PostgreSQL.
+type PostgresConsentRepository struct {
+	pool *pgxpool.Pool
+}
+
+// NewPostgresConsentRepository creates a new PostgreSQL-backed consent receipt repository.
+func NewPostgresConsentRepository(pool *pgxpool.Pool) *PostgresConsentRepository {
+	return &PostgresConsentRepository{pool: pool}
+}
+
+// Create persists a new consent receipt.
+func (r *PostgresConsentRepository) Create(ctx context.Context, receipt *domain.ConsentReceipt) error {
+	query := `
+		INSERT INTO user_service.consent_receipts
+			(id, user_id, client_id, client_name, scopes, remember, granted_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+	`
+
+	_, err := r.pool.Exec(ctx, query,
+		receipt.ID,
+		receipt.UserID,
+		receipt.ClientID,
+		receipt.ClientName,
+		receipt.Scopes,
+		receipt.Remember,
+		receipt.GrantedAt,
+	)
+	return err
+}
+
+// ListByUser returns the user's consent receipts, newest first.
+func (r *PostgresConsentRepository) ListByUser(ctx context.Context, userID uuid.UUID, includeRevoked bool) ([]*domain.ConsentReceipt, error) {
+	query := `
+		SELECT id, user_id, client_id, client_name, scopes, remember, granted_at, revoked_at
+		FROM user_service.consent_receipts
+		WHERE user_id = $1 AND ($2 OR revoked_at IS NULL)
+		ORDER BY granted_at DESC, id DESC
+	`
+
+	rows, err := r.pool.Query(ctx, query, userID, includeRevoked)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var receipts []*domain.ConsentReceipt
+	for rows.Next() {
+		var c domain.ConsentReceipt
+		if err := rows.Scan(
+			&c.ID,
+			&c.UserID,
+			&c.ClientID,
+			&c.ClientName,
+			&c.Scopes,
+			&c.Remember,
+			&c.GrantedAt,
+			&c.RevokedAt,
+		); err != nil {
+			return nil, err
+		}
+		receipts = append(receipts, &c)
+	}
+
+	return receipts, rows.Err()
+}
+
+// RevokeByClient marks all active receipts for the client as revoked.
+func (r *PostgresConsentRepository) RevokeByClient(ctx context.Context, userID uuid.UUID, clientID string, revokedAt time.Time) (int, error) {
+	query := `
+		UPDATE user_service.consent_receipts
+		SET revoked_at = $3
+		WHERE user_id = $1 AND client_id = $2 AND revoked_at IS NULL
+	`
+
+	result, err := r.pool.Exec(ctx, query, userID, clientID, revokedAt)
+	if err != nil {
+		return 0, err
+	}
+
+	return int(result.RowsAffected()), nil
+}
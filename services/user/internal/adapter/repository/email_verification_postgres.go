@@ -0,0 +1,75 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/daisuke8000/example-ec-platform/services/user/internal/domain"
+)
+
+// PostgresEmailVerificationRepository implements EmailVerificationRepository using PostgreSQL.
+type PostgresEmailVerificationRepository struct {
+	pool *pgxpool.Pool
+}
+
+// NewPostgresEmailVerificationRepository creates a new PostgreSQL-backed verification token repository.
+func NewPostgresEmailVerificationRepository(pool *pgxpool.Pool) *PostgresEmailVerificationRepository {
+	return &PostgresEmailVerificationRepository{pool: pool}
+}
+
+// Create persists a new verification token.
+func (r *PostgresEmailVerificationRepository) Create(ctx context.Context, token *domain.EmailVerificationToken) error {
+	query := `
+		INSERT INTO user_service.email_verification_tokens (token_hash, user_id, email, expires_at, created_at)
+		VALUES ($1, $2, $3, $4, $5)
+	`
+
+	_, err := conn(ctx, r.pool).Exec(ctx, query,
+		token.TokenHash,
+		token.UserID,
+		token.Email,
+		token.ExpiresAt,
+		token.CreatedAt,
+	)
+	return err
+}
+
+// Consume atomically marks an unused token as used and returns it, expired
+// or not. Returns ErrInvalidVerificationToken if it doesn't exist or was
+// already used.
+func (r *PostgresEmailVerificationRepository) Consume(ctx context.Context, tokenHash string) (*domain.EmailVerificationToken, error) {
+	query := `
+		UPDATE user_service.email_verification_tokens
+		SET used_at = NOW()
+		WHERE token_hash = $1 AND used_at IS NULL
+		RETURNING token_hash, user_id, email, expires_at, created_at
+	`
+
+	var token domain.EmailVerificationToken
+	err := conn(ctx, r.pool).QueryRow(ctx, query, tokenHash).Scan(
+		&token.TokenHash,
+		&token.UserID,
+		&token.Email,
+		&token.ExpiresAt,
+		&token.CreatedAt,
+	)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, domain.ErrInvalidVerificationToken
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &token, nil
+}
+
+// DeleteByUser deletes all verification tokens of a user.
+func (r *PostgresEmailVerificationRepository) DeleteByUser(ctx context.Context, userID uuid.UUID) error {
+	_, err := conn(ctx, r.pool).Exec(ctx, `
+		DELETE FROM user_service.email_verification_tokens WHERE user_id = $1
+	`, userID)
+	return err
+}
@@ -0,0 +1,108 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/daisuke8000/example-ec-platform/services/user/internal/domain"
+)
+
+// PostgresExternalIdentityRepository implements ExternalIdentityRepository
+// using PostgreSQL. A user has at most one link per provider.
+type PostgresExternalIdentityRepository struct {
+	pool *pgxpool.Pool
+}
+
+// NewPostgresExternalIdentityRepository creates a new PostgreSQL-backed repository of social login links.
+func NewPostgresExternalIdentityRepository(pool *pgxpool.Pool) *PostgresExternalIdentityRepository {
+	return &PostgresExternalIdentityRepository{pool: pool}
+}
+
+// Find returns the link of an upstream account.
+func (r *PostgresExternalIdentityRepository) Find(ctx context.Context, provider, subject string) (*domain.ExternalIdentity, error) {
+	query := `
+		SELECT provider, subject, user_id, email, created_at
+		FROM user_service.external_identities
+		WHERE provider = $1 AND subject = $2
+	`
+
+	var identity domain.ExternalIdentity
+	err := r.pool.QueryRow(ctx, query, provider, subject).Scan(
+		&identity.Provider,
+		&identity.Subject,
+		&identity.UserID,
+		&identity.Email,
+		&identity.CreatedAt,
+	)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, domain.ErrExternalIdentityNotFound
+		}
+		return nil, err
+	}
+	return &identity, nil
+}
+
+// Link inserts the link of an upstream account to an existing user.
+func (r *PostgresExternalIdentityRepository) Link(ctx context.Context, identity *domain.ExternalIdentity) error {
+	if _, err := r.pool.Exec(ctx, insertExternalIdentityQuery, externalIdentityArgs(identity)...); err != nil {
+		return linkError(err)
+	}
+	return nil
+}
+
+// Provision inserts a new user and its link in one transaction.
+func (r *PostgresExternalIdentityRepository) Provision(ctx context.Context, user *domain.User, identity *domain.ExternalIdentity) error {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	if _, err := tx.Exec(ctx, `
+		INSERT INTO user_service.users (id, email, password_hash, name, email_verified, email_verified_at, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+	`,
+		user.ID,
+		user.Email,
+		user.PasswordHash,
+		user.Name,
+		user.EmailVerified,
+		user.EmailVerifiedAt,
+		user.CreatedAt,
+		user.UpdatedAt,
+	); err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == pgUniqueViolation {
+			return domain.ErrEmailAlreadyExists
+		}
+		return err
+	}
+
+	if _, err := tx.Exec(ctx, insertExternalIdentityQuery, externalIdentityArgs(identity)...); err != nil {
+		return linkError(err)
+	}
+	return tx.Commit(ctx)
+}
+
+const insertExternalIdentityQuery = `
+	INSERT INTO user_service.external_identities (provider, subject, user_id, email, created_at)
+	VALUES ($1, $2, $3, $4, $5)
+`
+
+func externalIdentityArgs(identity *domain.ExternalIdentity) []any {
+	return []any{identity.Provider, identity.Subject, identity.UserID, identity.Email, identity.CreatedAt}
+}
+
+// linkError maps a unique violation of a link to ErrExternalIdentityAlreadyLinked.
+func linkError(err error) error {
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) && pgErr.Code == pgUniqueViolation {
+		return domain.ErrExternalIdentityAlreadyLinked
+	}
+	return err
+}
@@ -0,0 +1,113 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/daisuke8000/example-ec-platform/services/user/internal/domain"
+)
+
+// PostgresLoginEventRepository implements LoginEventRepository using PostgreSQL.
+type PostgresLoginEventRepository struct {
+	pool *pgxpool.Pool
+}
+
+// NewPostgresLoginEventRepository creates a new PostgreSQL-backed login event repository.
+func NewPostgresLoginEventRepository(pool *pgxpool.Pool) *PostgresLoginEventRepository {
+	return &PostgresLoginEventRepository{pool: pool}
+}
+
+// Create persists a new login event.
+func (r *PostgresLoginEventRepository) Create(ctx context.Context, event *domain.LoginEvent) error {
+	query := `
+		INSERT INTO user_service.login_events
+			(id, user_id, ip_address, user_agent, succeeded, failure_reason, new_device, occurred_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+	`
+
+	_, err := r.pool.Exec(ctx, query,
+		event.ID,
+		event.UserID,
+		event.IPAddress,
+		event.UserAgent,
+		event.Succeeded,
+		event.FailureReason,
+		event.NewDevice,
+		event.OccurredAt,
+	)
+	return err
+}
+
+// ListByUser returns the latest limit events of a user, newest first.
+func (r *PostgresLoginEventRepository) ListByUser(ctx context.Context, userID uuid.UUID, limit int) ([]*domain.LoginEvent, error) {
+	query := `
+		SELECT id, user_id, ip_address, user_agent, succeeded, failure_reason, new_device, occurred_at
+		FROM user_service.login_events
+		WHERE user_id = $1
+		ORDER BY occurred_at DESC, id DESC
+		LIMIT $2
+	`
+
+	rows, err := r.pool.Query(ctx, query, userID, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var events []*domain.LoginEvent
+	for rows.Next() {
+		var e domain.LoginEvent
+		if err := rows.Scan(
+			&e.ID,
+			&e.UserID,
+			&e.IPAddress,
+			&e.UserAgent,
+			&e.Succeeded,
+			&e.FailureReason,
+			&e.NewDevice,
+			&e.OccurredAt,
+		); err != nil {
+			return nil, err
+		}
+		events = append(events, &e)
+	}
+
+	return events, rows.Err()
+}
+
+// HasSucceeded reports whether the user ever logged in successfully.
+func (r *PostgresLoginEventRepository) HasSucceeded(ctx context.Context, userID uuid.UUID) (bool, error) {
+	var exists bool
+	err := r.pool.QueryRow(ctx, `
+		SELECT EXISTS (
+			SELECT 1 FROM user_service.login_events
+			WHERE user_id = $1 AND succeeded
+		)
+	`, userID).Scan(&exists)
+	return exists, err
+}
+
+// HasSucceededFrom reports whether the user logged in successfully from
+// ipAddress with userAgent before.
+func (r *PostgresLoginEventRepository) HasSucceededFrom(ctx context.Context, userID uuid.UUID, ipAddress, userAgent string) (bool, error) {
+	var exists bool
+	err := r.pool.QueryRow(ctx, `
+		SELECT EXISTS (
+			SELECT 1 FROM user_service.login_events
+			WHERE user_id = $1 AND succeeded AND ip_address = $2 AND user_agent = $3
+		)
+	`, userID, ipAddress, userAgent).Scan(&exists)
+	return exists, err
+}
+
+// DeleteBefore deletes the user's events older than before.
+func (r *PostgresLoginEventRepository) DeleteBefore(ctx context.Context, userID uuid.UUID, before time.Time) error {
+	_, err := r.pool.Exec(ctx, `
+		DELETE FROM user_service.login_events
+		WHERE user_id = $1 AND occurred_at < $2
+	`, userID, before)
+	return err
+}
@@ -0,0 +1,93 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/daisuke8000/example-ec-platform/services/user/internal/domain"
+)
+
+// PostgresLoginLockoutRepository implements LoginLockoutRepository using PostgreSQL.
+type PostgresLoginLockoutRepository struct {
+	pool *pgxpool.Pool
+}
+
+// NewPostgresLoginLockoutRepository creates a new PostgreSQL-backed login lockout repository.
+func NewPostgresLoginLockoutRepository(pool *pgxpool.Pool) *PostgresLoginLockoutRepository {
+	return &PostgresLoginLockoutRepository{pool: pool}
+}
+
+// Get returns the lockout of an address, with no failures if none were recorded.
+func (r *PostgresLoginLockoutRepository) Get(ctx context.Context, emailHash string) (*domain.LoginLockout, error) {
+	lockout, err := scanLoginLockout(conn(ctx, r.pool).QueryRow(ctx, `
+		SELECT email_hash, failed_attempts, locked_until
+		FROM user_service.login_lockouts
+		WHERE email_hash = $1
+	`, emailHash))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return &domain.LoginLockout{EmailHash: emailHash}, nil
+	}
+	return lockout, err
+}
+
+// RecordFailure locks the address's row so that concurrent failures are
+// all counted.
+func (r *PostgresLoginLockoutRepository) RecordFailure(ctx context.Context, emailHash string, maxAttempts int, lockFor time.Duration, now time.Time) (*domain.LoginLockout, error) {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	if _, err := tx.Exec(ctx, `
+		INSERT INTO user_service.login_lockouts (email_hash, updated_at)
+		VALUES ($1, $2)
+		ON CONFLICT (email_hash) DO NOTHING
+	`, emailHash, now); err != nil {
+		return nil, err
+	}
+
+	lockout, err := scanLoginLockout(tx.QueryRow(ctx, `
+		SELECT email_hash, failed_attempts, locked_until
+		FROM user_service.login_lockouts
+		WHERE email_hash = $1
+		FOR UPDATE
+	`, emailHash))
+	if err != nil {
+		return nil, err
+	}
+
+	lockout.RecordFailure(maxAttempts, lockFor, now)
+	if _, err := tx.Exec(ctx, `
+		UPDATE user_service.login_lockouts
+		SET failed_attempts = $2, locked_until = $3, updated_at = $4
+		WHERE email_hash = $1
+	`, emailHash, lockout.FailedAttempts, lockout.LockedUntil, now); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, err
+	}
+	return lockout, nil
+}
+
+// Reset clears the failures and lock of an address.
+func (r *PostgresLoginLockoutRepository) Reset(ctx context.Context, emailHash string) error {
+	_, err := conn(ctx, r.pool).Exec(ctx, `
+		DELETE FROM user_service.login_lockouts WHERE email_hash = $1
+	`, emailHash)
+	return err
+}
+
+func scanLoginLockout(row pgx.Row) (*domain.LoginLockout, error) {
+	var l domain.LoginLockout
+	if err := row.Scan(&l.EmailHash, &l.FailedAttempts, &l.LockedUntil); err != nil {
+		return nil, err
+	}
+	return &l, nil
+}
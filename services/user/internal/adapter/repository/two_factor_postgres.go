@@ -0,0 +1,154 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/daisuke8000/example-ec-platform/services/user/internal/domain"
+	"github.com/daisuke8000/example-ec-platform/services/user/internal/secretbox"
+)
+
+// PostgresTwoFactorRepository implements TwoFactorRepository using PostgreSQL.
+// TOTP secrets are stored encrypted with box.
+type PostgresTwoFactorRepository struct {
+	pool *pgxpool.Pool
+	box  *secretbox.Box
+}
+
+// NewPostgresTwoFactorRepository creates a new PostgreSQL-backed TOTP enrollment repository.
+func NewPostgresTwoFactorRepository(pool *pgxpool.Pool, box *secretbox.Box) *PostgresTwoFactorRepository {
+	return &PostgresTwoFactorRepository{pool: pool, box: box}
+}
+
+// Get returns the user's enrollment with its secret decrypted.
+func (r *PostgresTwoFactorRepository) Get(ctx context.Context, userID uuid.UUID) (*domain.TwoFactor, error) {
+	query := `
+		SELECT user_id, secret_ciphertext, enabled_at, last_used_step, recovery_code_hashes, created_at
+		FROM user_service.two_factor
+		WHERE user_id = $1
+	`
+
+	var tf domain.TwoFactor
+	var ciphertext []byte
+	err := r.pool.QueryRow(ctx, query, userID).Scan(
+		&tf.UserID,
+		&ciphertext,
+		&tf.EnabledAt,
+		&tf.LastUsedStep,
+		&tf.RecoveryCodeHashes,
+		&tf.CreatedAt,
+	)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, domain.ErrTwoFactorNotEnrolled
+		}
+		return nil, err
+	}
+
+	tf.Secret, err = r.box.Open(ciphertext)
+	if err != nil {
+		return nil, err
+	}
+	return &tf, nil
+}
+
+// SavePending inserts or replaces a pending enrollment. An enabled one is
+// left untouched.
+func (r *PostgresTwoFactorRepository) SavePending(ctx context.Context, tf *domain.TwoFactor) error {
+	ciphertext, err := r.box.Seal(tf.Secret)
+	if err != nil {
+		return err
+	}
+
+	query := `
+		INSERT INTO user_service.two_factor (user_id, secret_ciphertext, created_at)
+		VALUES ($1, $2, $3)
+		ON CONFLICT (user_id) DO UPDATE SET
+			secret_ciphertext = EXCLUDED.secret_ciphertext,
+			last_used_step = 0,
+			recovery_code_hashes = '{}',
+			created_at = EXCLUDED.created_at
+		WHERE user_service.two_factor.enabled_at IS NULL
+	`
+
+	result, err := r.pool.Exec(ctx, query, tf.UserID, ciphertext, tf.CreatedAt)
+	if err != nil {
+		return err
+	}
+	if result.RowsAffected() == 0 {
+		return domain.ErrTwoFactorAlreadyEnabled
+	}
+	return nil
+}
+
+// Enable enables a pending enrollment.
+func (r *PostgresTwoFactorRepository) Enable(ctx context.Context, userID uuid.UUID, step int64, recoveryCodeHashes []string, enabledAt time.Time) error {
+	query := `
+		UPDATE user_service.two_factor
+		SET enabled_at = $4, last_used_step = $2, recovery_code_hashes = $3
+		WHERE user_id = $1 AND enabled_at IS NULL
+	`
+
+	result, err := r.pool.Exec(ctx, query, userID, step, recoveryCodeHashes, enabledAt)
+	if err != nil {
+		return err
+	}
+	if result.RowsAffected() == 0 {
+		return domain.ErrTwoFactorAlreadyEnabled
+	}
+	return nil
+}
+
+// UseStep advances the last used step; concurrent logins with the same
+// code cannot both succeed.
+func (r *PostgresTwoFactorRepository) UseStep(ctx context.Context, userID uuid.UUID, step int64) error {
+	query := `
+		UPDATE user_service.two_factor
+		SET last_used_step = $2
+		WHERE user_id = $1 AND last_used_step < $2
+	`
+
+	result, err := r.pool.Exec(ctx, query, userID, step)
+	if err != nil {
+		return err
+	}
+	if result.RowsAffected() == 0 {
+		return domain.ErrInvalidTOTPCode
+	}
+	return nil
+}
+
+// UseRecoveryCode removes a recovery code so it cannot be used again.
+func (r *PostgresTwoFactorRepository) UseRecoveryCode(ctx context.Context, userID uuid.UUID, codeHash string) error {
+	query := `
+		UPDATE user_service.two_factor
+		SET recovery_code_hashes = array_remove(recovery_code_hashes, $2)
+		WHERE user_id = $1 AND $2 = ANY(recovery_code_hashes)
+	`
+
+	result, err := r.pool.Exec(ctx, query, userID, codeHash)
+	if err != nil {
+		return err
+	}
+	if result.RowsAffected() == 0 {
+		return domain.ErrInvalidTOTPCode
+	}
+	return nil
+}
+
+// Delete removes the user's enrollment.
+func (r *PostgresTwoFactorRepository) Delete(ctx context.Context, userID uuid.UUID) error {
+	result, err := r.pool.Exec(ctx, `DELETE FROM user_service.two_factor WHERE user_id = $1`, userID)
+	if err != nil {
+		return err
+	}
+	if result.RowsAffected() == 0 {
+		return domain.ErrTwoFactorNotEnrolled
+	}
+	return nil
+}
@@ -0,0 +1,74 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// TxManager runs multi-step flows in a single database transaction.
+type TxManager interface {
+	// Do runs fn in a transaction carried by ctx: repository calls made
+	// with the ctx passed to fn join it.
+	Do(ctx context.Context, fn func(ctx context.Context) error) error
+	DoWithTx(ctx context.Context, fn func(ctx context.Context, tx pgx.Tx) error) error
+}
+
+type txManager struct {
+	pool *pgxpool.Pool
+}
+
+// NewTxManager creates a transaction manager over pool.
+func NewTxManager(pool *pgxpool.Pool) TxManager {
+	return &txManager{pool: pool}
+}
+
+func (m *txManager) Do(ctx context.Context, fn func(ctx context.Context) error) error {
+	return m.DoWithTx(ctx, func(ctx context.Context, tx pgx.Tx) error {
+		return fn(WithTx(ctx, tx))
+	})
+}
+
+func (m *txManager) DoWithTx(ctx context.Context, fn func(ctx context.Context, tx pgx.Tx) error) error {
+	tx, err := m.pool.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	if err := fn(ctx, tx); err != nil {
+		return err
+	}
+
+	return tx.Commit(ctx)
+}
+
+type txContextKey struct{}
+
+// WithTx returns a context carrying tx.
+func WithTx(ctx context.Context, tx pgx.Tx) context.Context {
+	return context.WithValue(ctx, txContextKey{}, tx)
+}
+
+// TxFromContext returns the transaction carried by ctx, if any.
+func TxFromContext(ctx context.Context) (pgx.Tx, bool) {
+	tx, ok := ctx.Value(txContextKey{}).(pgx.Tx)
+	return tx, ok
+}
+
+// dbtx is what pools and transactions have in common.
+type dbtx interface {
+	Exec(ctx context.Context, sql string, args ...any) (pgconn.CommandTag, error)
+	Query(ctx context.Context, sql string, args ...any) (pgx.Rows, error)
+	QueryRow(ctx context.Context, sql string, args ...any) pgx.Row
+}
+
+// conn returns the transaction carried by ctx, or pool outside of one.
+func conn(ctx context.Context, pool *pgxpool.Pool) dbtx {
+	if tx, ok := TxFromContext(ctx); ok {
+		return tx
+	}
+	return pool
+}
@@ -4,6 +4,8 @@ package repository
 import (
 	"context"
 	"errors"
+	"fmt"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
@@ -18,6 +20,7 @@ import (
 const pgUniqueViolation = "23505"
 
 // PostgresUserRepository implements UserRepository using PostgreSQL.
+// Calls join the transaction of TxManager.Do carried by ctx.
 type PostgresUserRepository struct {
 	pool *pgxpool.Pool
 }
@@ -31,15 +34,17 @@ func NewPostgresUserRepository(pool *pgxpool.Pool) *PostgresUserRepository {
 // Returns ErrEmailAlreadyExists if the email is already taken.
 func (r *PostgresUserRepository) Create(ctx context.Context, user *domain.User) error {
 	query := `
-		INSERT INTO user_service.users (id, email, password_hash, name, is_deleted, deleted_at, created_at, updated_at)
-		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+		INSERT INTO user_service.users (id, email, password_hash, name, email_verified, email_verified_at, is_deleted, deleted_at, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
 	`
 
-	_, err := r.pool.Exec(ctx, query,
+	_, err := conn(ctx, r.pool).Exec(ctx, query,
 		user.ID,
 		user.Email,
 		user.PasswordHash,
 		user.Name,
+		user.EmailVerified,
+		user.EmailVerifiedAt,
 		user.IsDeleted,
 		user.DeletedAt,
 		user.CreatedAt,
@@ -60,7 +65,7 @@ func (r *PostgresUserRepository) Create(ctx context.Context, user *domain.User)
 // Returns ErrUserNotFound if the user doesn't exist or is soft-deleted.
 func (r *PostgresUserRepository) FindByID(ctx context.Context, id uuid.UUID) (*domain.User, error) {
 	query := `
-		SELECT id, email, password_hash, name, is_deleted, deleted_at, created_at, updated_at
+		SELECT id, email, password_hash, name, email_verified, email_verified_at, is_deleted, deleted_at, created_at, updated_at
 		FROM user_service.users
 		WHERE id = $1 AND is_deleted = FALSE
 	`
@@ -72,7 +77,7 @@ func (r *PostgresUserRepository) FindByID(ctx context.Context, id uuid.UUID) (*d
 // Returns ErrUserNotFound if the user doesn't exist or is soft-deleted.
 func (r *PostgresUserRepository) FindByEmail(ctx context.Context, email string) (*domain.User, error) {
 	query := `
-		SELECT id, email, password_hash, name, is_deleted, deleted_at, created_at, updated_at
+		SELECT id, email, password_hash, name, email_verified, email_verified_at, is_deleted, deleted_at, created_at, updated_at
 		FROM user_service.users
 		WHERE email = $1 AND is_deleted = FALSE
 	`
@@ -84,11 +89,13 @@ func (r *PostgresUserRepository) FindByEmail(ctx context.Context, email string)
 func (r *PostgresUserRepository) scanUser(ctx context.Context, query string, args ...any) (*domain.User, error) {
 	var user domain.User
 
-	err := r.pool.QueryRow(ctx, query, args...).Scan(
+	err := conn(ctx, r.pool).QueryRow(ctx, query, args...).Scan(
 		&user.ID,
 		&user.Email,
 		&user.PasswordHash,
 		&user.Name,
+		&user.EmailVerified,
+		&user.EmailVerifiedAt,
 		&user.IsDeleted,
 		&user.DeletedAt,
 		&user.CreatedAt,
@@ -110,16 +117,18 @@ func (r *PostgresUserRepository) scanUser(ctx context.Context, query string, arg
 func (r *PostgresUserRepository) Update(ctx context.Context, user *domain.User) error {
 	query := `
 		UPDATE user_service.users
-		SET email = $2, name = $3, updated_at = $4
+		SET email = $2, name = $3, email_verified = $4, email_verified_at = $5, updated_at = $6
 		WHERE id = $1 AND is_deleted = FALSE
 	`
 
 	user.UpdatedAt = time.Now().UTC()
 
-	result, err := r.pool.Exec(ctx, query,
+	result, err := conn(ctx, r.pool).Exec(ctx, query,
 		user.ID,
 		user.Email,
 		user.Name,
+		user.EmailVerified,
+		user.EmailVerifiedAt,
 		user.UpdatedAt,
 	)
 	if err != nil {
@@ -147,7 +156,7 @@ func (r *PostgresUserRepository) SoftDelete(ctx context.Context, id uuid.UUID) e
 	`
 
 	now := time.Now().UTC()
-	result, err := r.pool.Exec(ctx, query, id, now)
+	result, err := conn(ctx, r.pool).Exec(ctx, query, id, now)
 	if err != nil {
 		return err
 	}
@@ -158,3 +167,181 @@ func (r *PostgresUserRepository) SoftDelete(ctx context.Context, id uuid.UUID) e
 
 	return nil
 }
+
+// MarkEmailVerified records that the user has verified their email address.
+// Returns ErrUserNotFound if the user doesn't exist or is soft-deleted.
+func (r *PostgresUserRepository) MarkEmailVerified(ctx context.Context, id uuid.UUID, verifiedAt time.Time) error {
+	query := `
+		UPDATE user_service.users
+		SET email_verified = TRUE, email_verified_at = $2, updated_at = $2
+		WHERE id = $1 AND is_deleted = FALSE
+	`
+
+	result, err := conn(ctx, r.pool).Exec(ctx, query, id, verifiedAt)
+	if err != nil {
+		return err
+	}
+
+	if result.RowsAffected() == 0 {
+		return domain.ErrUserNotFound
+	}
+
+	return nil
+}
+
+func (r *PostgresUserRepository) UpdatePasswordHash(ctx context.Context, id uuid.UUID, passwordHash string) error {
+	query := `
+		UPDATE user_service.users
+		SET password_hash = $2, updated_at = NOW()
+		WHERE id = $1 AND is_deleted = FALSE
+	`
+
+	result, err := conn(ctx, r.pool).Exec(ctx, query, id, passwordHash)
+	if err != nil {
+		return err
+	}
+
+	if result.RowsAffected() == 0 {
+		return domain.ErrUserNotFound
+	}
+
+	return nil
+}
+
+// FindRoles returns the user's roles with their permissions, ordered by role name.
+func (r *PostgresUserRepository) FindRoles(ctx context.Context, userID uuid.UUID) ([]*domain.Role, error) {
+	query := `
+		SELECT r.name,
+			COALESCE(array_agg(rp.permission ORDER BY rp.permission)
+				FILTER (WHERE rp.permission IS NOT NULL), '{}')
+		FROM user_service.user_roles ur
+		JOIN user_service.roles r ON r.name = ur.role_name
+		LEFT JOIN user_service.role_permissions rp ON rp.role_name = r.name
+		WHERE ur.user_id = $1
+		GROUP BY r.name
+		ORDER BY r.name
+	`
+
+	rows, err := conn(ctx, r.pool).Query(ctx, query, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var roles []*domain.Role
+	for rows.Next() {
+		var role domain.Role
+		if err := rows.Scan(&role.Name, &role.Permissions); err != nil {
+			return nil, err
+		}
+		roles = append(roles, &role)
+	}
+
+	return roles, rows.Err()
+}
+
+// AssignSegment sets the user's marketing segment, replacing any previous one.
+func (r *PostgresUserRepository) AssignSegment(ctx context.Context, userID uuid.UUID, segment string) error {
+	query := `
+		INSERT INTO user_service.user_segments (user_id, segment, assigned_at)
+		VALUES ($1, $2, NOW())
+		ON CONFLICT (user_id) DO UPDATE
+		SET segment = EXCLUDED.segment, assigned_at = EXCLUDED.assigned_at
+	`
+
+	_, err := conn(ctx, r.pool).Exec(ctx, query, userID, segment)
+	return err
+}
+
+// List returns users matching the filter ordered by (created_at, id) descending.
+// Pagination uses a keyset cursor so pages stay stable under concurrent inserts.
+func (r *PostgresUserRepository) List(ctx context.Context, filter domain.UserFilter, page domain.Pagination) ([]*domain.User, string, error) {
+	var conditions []string
+	var args []any
+	argIdx := 1
+
+	if !filter.IncludeDeleted {
+		conditions = append(conditions, "is_deleted = FALSE")
+	}
+	if filter.EmailContains != nil && *filter.EmailContains != "" {
+		conditions = append(conditions, fmt.Sprintf("email ILIKE $%d", argIdx))
+		args = append(args, "%"+escapeLike(*filter.EmailContains)+"%")
+		argIdx++
+	}
+	if filter.CreatedAfter != nil {
+		conditions = append(conditions, fmt.Sprintf("created_at >= $%d", argIdx))
+		args = append(args, *filter.CreatedAfter)
+		argIdx++
+	}
+	if filter.CreatedBefore != nil {
+		conditions = append(conditions, fmt.Sprintf("created_at < $%d", argIdx))
+		args = append(args, *filter.CreatedBefore)
+		argIdx++
+	}
+	if page.PageToken != "" {
+		cursor, err := domain.ParseUserCursor(page.PageToken)
+		if err != nil {
+			return nil, "", err
+		}
+		conditions = append(conditions, fmt.Sprintf("(created_at, id) < ($%d, $%d)", argIdx, argIdx+1))
+		args = append(args, cursor.CreatedAt, cursor.ID)
+		argIdx += 2
+	}
+
+	whereClause := ""
+	if len(conditions) > 0 {
+		whereClause = "WHERE " + strings.Join(conditions, " AND ")
+	}
+
+	// Fetch one extra row to detect whether another page exists
+	query := fmt.Sprintf(`
+		SELECT id, email, password_hash, name, email_verified, email_verified_at, is_deleted, deleted_at, created_at, updated_at
+		FROM user_service.users
+		%s
+		ORDER BY created_at DESC, id DESC
+		LIMIT $%d
+	`, whereClause, argIdx)
+	args = append(args, page.PageSize+1)
+
+	rows, err := conn(ctx, r.pool).Query(ctx, query, args...)
+	if err != nil {
+		return nil, "", err
+	}
+	defer rows.Close()
+
+	var users []*domain.User
+	for rows.Next() {
+		var user domain.User
+		if err := rows.Scan(
+			&user.ID,
+			&user.Email,
+			&user.PasswordHash,
+			&user.Name,
+			&user.EmailVerified,
+			&user.EmailVerifiedAt,
+			&user.IsDeleted,
+			&user.DeletedAt,
+			&user.CreatedAt,
+			&user.UpdatedAt,
+		); err != nil {
+			return nil, "", err
+		}
+		users = append(users, &user)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, "", err
+	}
+
+	if len(users) <= page.PageSize {
+		return users, "", nil
+	}
+
+	users = users[:page.PageSize]
+	last := users[len(users)-1]
+	return users, domain.UserCursor{CreatedAt: last.CreatedAt, ID: last.ID}.Encode(), nil
+}
+
+// escapeLike escapes LIKE wildcards so user input is matched literally.
+func escapeLike(s string) string {
+	return strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`).Replace(s)
+}
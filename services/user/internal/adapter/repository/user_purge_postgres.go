@@ -0,0 +1,100 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/daisuke8000/example-ec-platform/services/user/internal/domain"
+)
+
+// FindPurgeable returns IDs of users soft-deleted before cutoff that have not
+// been purged yet, oldest deletion first.
+func (r *PostgresUserRepository) FindPurgeable(ctx context.Context, cutoff time.Time, limit int) ([]uuid.UUID, error) {
+	query := `
+		SELECT id
+		FROM user_service.users
+		WHERE is_deleted = TRUE AND purged_at IS NULL AND deleted_at < $1
+		ORDER BY deleted_at
+		LIMIT $2
+	`
+
+	rows, err := r.pool.Query(ctx, query, cutoff, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var ids []uuid.UUID
+	for rows.Next() {
+		var id uuid.UUID
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		ids = append(ids, id)
+	}
+	return ids, rows.Err()
+}
+
+// Anonymize erases personal data of a soft-deleted user. The email is
+// replaced with a unique placeholder so the unique constraint still holds
+// and the address can be registered again. The TOTP enrollment and social
+// login links are deleted.
+// Returns ErrUserNotFound if the user is not soft-deleted or already purged.
+func (r *PostgresUserRepository) Anonymize(ctx context.Context, id uuid.UUID) error {
+	query := `
+		WITH purged AS (
+			UPDATE user_service.users
+			SET email = 'purged+' || id::text || '@invalid',
+				password_hash = '',
+				name = NULL,
+				email_verified = FALSE,
+				email_verified_at = NULL,
+				purged_at = $2,
+				updated_at = $2
+			WHERE id = $1 AND is_deleted = TRUE AND purged_at IS NULL
+			RETURNING id
+		), two_factor AS (
+			DELETE FROM user_service.two_factor
+			WHERE user_id IN (SELECT id FROM purged)
+		), external_identities AS (
+			DELETE FROM user_service.external_identities
+			WHERE user_id IN (SELECT id FROM purged)
+		)
+		SELECT COUNT(*) FROM purged
+	`
+
+	var purged int
+	if err := r.pool.QueryRow(ctx, query, id, time.Now().UTC()).Scan(&purged); err != nil {
+		return err
+	}
+
+	if purged == 0 {
+		return domain.ErrUserNotFound
+	}
+
+	return nil
+}
+
+// HardDelete removes a soft-deleted user. Tokens, roles, segments, consent
+// receipts, the TOTP enrollment and social login links are removed by ON
+// DELETE CASCADE.
+// Returns ErrUserNotFound if the user is not soft-deleted.
+func (r *PostgresUserRepository) HardDelete(ctx context.Context, id uuid.UUID) error {
+	query := `
+		DELETE FROM user_service.users
+		WHERE id = $1 AND is_deleted = TRUE
+	`
+
+	result, err := r.pool.Exec(ctx, query, id)
+	if err != nil {
+		return err
+	}
+
+	if result.RowsAffected() == 0 {
+		return domain.ErrUserNotFound
+	}
+
+	return nil
+}
@@ -0,0 +1,103 @@
+// Package revocation tells the BFF replicas to reject tokens that were
+// revoked at the authorization server before they expire. Events are sent
+// as webhook deliveries signed like the Product Service's (see package
+// webhook), to every configured BFF endpoint.
+package revocation
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/daisuke8000/example-ec-platform/pkg/webhook"
+)
+
+// EventTokensRevoked is the type of the events sent.
+const EventTokensRevoked = "user.tokens_revoked"
+
+// envelope is the JSON body of a delivery.
+type envelope struct {
+	ID         uuid.UUID   `json:"id"`
+	Type       string      `json:"type"`
+	OccurredAt time.Time   `json:"occurred_at"`
+	Data       revokedData `json:"data"`
+}
+
+type revokedData struct {
+	UserID string `json:"user_id"`
+	// ClientID is empty when the tokens of every client are revoked.
+	ClientID  string    `json:"client_id,omitempty"`
+	RevokedAt time.Time `json:"revoked_at"`
+}
+
+// Notifier delivers revocations to the BFF.
+type Notifier struct {
+	urls       []string
+	secret     string
+	httpClient *http.Client
+}
+
+// NewNotifier creates a notifier for the BFF endpoints at urls, signing
+// deliveries with secret.
+func NewNotifier(urls []string, secret string, timeout time.Duration) *Notifier {
+	return &Notifier{
+		urls:   urls,
+		secret: secret,
+		httpClient: &http.Client{
+			Timeout: timeout,
+		},
+	}
+}
+
+// NotifyTokensRevoked sends the revocation to every endpoint. It fails if
+// any endpoint did not accept it; resending is harmless.
+func (n *Notifier) NotifyTokensRevoked(ctx context.Context, userID, clientID string, revokedAt time.Time) error {
+	id := uuid.New()
+	body, err := json.Marshal(envelope{
+		ID:         id,
+		Type:       EventTokensRevoked,
+		OccurredAt: time.Now().UTC(),
+		Data:       revokedData{UserID: userID, ClientID: clientID, RevokedAt: revokedAt.UTC()},
+	})
+	if err != nil {
+		return err
+	}
+
+	var errs []error
+	for _, url := range n.urls {
+		if err := n.deliver(ctx, url, id.String(), body); err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", url, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func (n *Notifier) deliver(ctx context.Context, url, id string, body []byte) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	now := time.Now()
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(webhook.HeaderID, id)
+	req.Header.Set(webhook.HeaderEvent, EventTokensRevoked)
+	req.Header.Set(webhook.HeaderTimestamp, strconv.FormatInt(now.Unix(), 10))
+	req.Header.Set(webhook.HeaderSignature, webhook.Sign(n.secret, now, body))
+
+	resp, err := n.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("unexpected status %d", resp.StatusCode)
+	}
+	return nil
+}
@@ -0,0 +1,72 @@
+package revocation
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/daisuke8000/example-ec-platform/pkg/webhook"
+)
+
+func TestNotifier_NotifyTokensRevoked(t *testing.T) {
+	const secret = "whsec_test"
+	revokedAt := time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	var received []envelope
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		if err := webhook.Verify(secret, r.Header.Get(webhook.HeaderTimestamp), r.Header.Get(webhook.HeaderSignature), body, time.Minute); err != nil {
+			t.Errorf("signature: %v", err)
+		}
+		if r.Header.Get(webhook.HeaderEvent) != EventTokensRevoked {
+			t.Errorf("event header = %q", r.Header.Get(webhook.HeaderEvent))
+		}
+		var e envelope
+		if err := json.Unmarshal(body, &e); err != nil {
+			t.Errorf("body: %v", err)
+		}
+		received = append(received, e)
+		w.WriteHeader(http.StatusNoContent)
+	})
+	first := httptest.NewServer(handler)
+	defer first.Close()
+	second := httptest.NewServer(handler)
+	defer second.Close()
+
+	n := NewNotifier([]string{first.URL, second.URL}, secret, time.Second)
+	if err := n.NotifyTokensRevoked(context.Background(), "user-1", "web", revokedAt); err != nil {
+		t.Fatalf("NotifyTokensRevoked() error = %v", err)
+	}
+
+	if len(received) != 2 {
+		t.Fatalf("deliveries = %d, want 2", len(received))
+	}
+	for _, e := range received {
+		if e.Type != EventTokensRevoked || e.Data.UserID != "user-1" || e.Data.ClientID != "web" || !e.Data.RevokedAt.Equal(revokedAt) {
+			t.Errorf("event = %+v", e)
+		}
+	}
+	if received[0].ID != received[1].ID {
+		t.Error("endpoints received different event IDs")
+	}
+}
+
+func TestNotifier_FailsWhenAnEndpointRejects(t *testing.T) {
+	ok := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer ok.Close()
+	rejecting := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+	}))
+	defer rejecting.Close()
+
+	n := NewNotifier([]string{ok.URL, rejecting.URL}, "whsec_test", time.Second)
+	if err := n.NotifyTokensRevoked(context.Background(), "user-1", "", time.Now()); err == nil {
+		t.Error("NotifyTokensRevoked() error = nil, want error")
+	}
+}
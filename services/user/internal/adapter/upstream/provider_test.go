@@ -0,0 +1,148 @@
+package upstream
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+)
+
+// newTestServer fakes a provider's token endpoint and account APIs. It
+// checks the PKCE code verifier against the challenge of authURL.
+func newTestServer(t *testing.T, p *Provider, accounts map[string]any) *httptest.Server {
+	t.Helper()
+	var challenge string
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /authorize", func(w http.ResponseWriter, r *http.Request) {
+		challenge = r.URL.Query().Get("code_challenge")
+		w.WriteHeader(http.StatusNoContent)
+	})
+	mux.HandleFunc("POST /token", func(w http.ResponseWriter, r *http.Request) {
+		sum := sha256.Sum256([]byte(r.FormValue("code_verifier")))
+		if r.FormValue("code") != "code-1" || base64.RawURLEncoding.EncodeToString(sum[:]) != challenge {
+			json.NewEncoder(w).Encode(map[string]string{"error": "bad_verification_code", "error_description": "The code is incorrect."})
+			return
+		}
+		json.NewEncoder(w).Encode(map[string]string{"access_token": "token-1", "token_type": "bearer"})
+	})
+	for path, body := range accounts {
+		mux.HandleFunc("GET "+path, func(w http.ResponseWriter, r *http.Request) {
+			if r.Header.Get("Authorization") != "Bearer token-1" {
+				w.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+			json.NewEncoder(w).Encode(body)
+		})
+	}
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+	p.authURL = server.URL + "/authorize"
+	p.tokenURL = server.URL + "/token"
+	return server
+}
+
+// authorize follows the authorization URL so the fake server records the
+// code challenge.
+func authorize(t *testing.T, p *Provider, verifier string) {
+	t.Helper()
+	authURL := p.AuthCodeURL("https://login.example.com/callback", "state-1", verifier)
+	u, err := url.Parse(authURL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if u.Query().Get("state") != "state-1" || u.Query().Get("code_challenge_method") != "S256" {
+		t.Fatalf("unexpected authorization URL %s", authURL)
+	}
+	resp, err := http.Get(authURL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+}
+
+func TestGoogle_Exchange(t *testing.T) {
+	p := NewGoogle("client", "secret", time.Second)
+	server := newTestServer(t, p, map[string]any{
+		"/userinfo": map[string]any{"sub": "1234", "email": "taro@example.com", "email_verified": true, "name": "Taro Yamada"},
+	})
+	p.profile = googleProfile(server.URL + "/userinfo")
+
+	verifier, err := NewRandomToken()
+	if err != nil {
+		t.Fatal(err)
+	}
+	authorize(t, p, verifier)
+
+	profile, err := p.Exchange(context.Background(), "https://login.example.com/callback", "code-1", verifier)
+	if err != nil {
+		t.Fatalf("Exchange failed: %v", err)
+	}
+	if profile.Provider != "google" || profile.Subject != "1234" || profile.Email != "taro@example.com" || !profile.EmailVerified || profile.Name != "Taro Yamada" {
+		t.Errorf("unexpected profile %+v", profile)
+	}
+}
+
+func TestGoogle_ExchangeRejectsWrongVerifier(t *testing.T) {
+	p := NewGoogle("client", "secret", time.Second)
+	server := newTestServer(t, p, nil)
+	p.profile = googleProfile(server.URL + "/userinfo")
+
+	authorize(t, p, "verifier-1")
+	if _, err := p.Exchange(context.Background(), "https://login.example.com/callback", "code-1", "verifier-2"); err == nil {
+		t.Error("expected an error for a wrong code verifier")
+	}
+}
+
+func TestGitHub_Exchange(t *testing.T) {
+	tests := []struct {
+		name         string
+		emails       []map[string]any
+		wantEmail    string
+		wantVerified bool
+	}{
+		{
+			name: "verified primary email",
+			emails: []map[string]any{
+				{"email": "old@example.com", "primary": false, "verified": true},
+				{"email": "octo@example.com", "primary": true, "verified": true},
+			},
+			wantEmail:    "octo@example.com",
+			wantVerified: true,
+		},
+		{
+			name: "unverified primary email",
+			emails: []map[string]any{
+				{"email": "octo@example.com", "primary": true, "verified": false},
+			},
+			wantEmail: "octo@example.com",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := NewGitHub("client", "secret", time.Second)
+			server := newTestServer(t, p, map[string]any{
+				"/user":        map[string]any{"id": 583231, "login": "octocat", "name": ""},
+				"/user/emails": tt.emails,
+			})
+			p.profile = githubProfile(server.URL)
+
+			authorize(t, p, "verifier-1")
+			profile, err := p.Exchange(context.Background(), "https://login.example.com/callback", "code-1", "verifier-1")
+			if err != nil {
+				t.Fatalf("Exchange failed: %v", err)
+			}
+			if profile.Provider != "github" || profile.Subject != "583231" || profile.Name != "octocat" {
+				t.Errorf("unexpected profile %+v", profile)
+			}
+			if profile.Email != tt.wantEmail || profile.EmailVerified != tt.wantVerified {
+				t.Errorf("expected email %q (verified %v), got %q (verified %v)", tt.wantEmail, tt.wantVerified, profile.Email, profile.EmailVerified)
+			}
+		})
+	}
+}
@@ -0,0 +1,167 @@
+package anonymize
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// Anonymizer rewrites personal data table by table in batches, each batch
+// in its own statement, so a large copy is never locked as a whole.
+type Anonymizer struct {
+	faker     *Faker
+	batchSize int
+	logger    *slog.Logger
+}
+
+func New(faker *Faker, batchSize int, logger *slog.Logger) *Anonymizer {
+	return &Anonymizer{faker: faker, batchSize: batchSize, logger: logger}
+}
+
+// Users rewrites emails and names in user_service.users and clears
+// password hashes so production credentials cannot be used on the copy.
+// Users already carrying a fake or purged address are skipped, so the run
+// can be resumed. Pending email verification tokens, TOTP enrollments,
+// login events (which hold IP addresses), address books and social login
+// links are deleted.
+// Returns the number of users rewritten.
+func (a *Anonymizer) Users(ctx context.Context, pool *pgxpool.Pool) (int, error) {
+	if _, err := pool.Exec(ctx, `DELETE FROM user_service.email_verification_tokens`); err != nil {
+		return 0, err
+	}
+	if _, err := pool.Exec(ctx, `DELETE FROM user_service.two_factor`); err != nil {
+		return 0, err
+	}
+	if _, err := pool.Exec(ctx, `DELETE FROM user_service.login_events`); err != nil {
+		return 0, err
+	}
+	if _, err := pool.Exec(ctx, `DELETE FROM user_service.addresses`); err != nil {
+		return 0, err
+	}
+	if _, err := pool.Exec(ctx, `DELETE FROM user_service.external_identities`); err != nil {
+		return 0, err
+	}
+
+	selectQuery := `
+		SELECT id, email, COALESCE(name, '')
+		FROM user_service.users
+		WHERE id > $1 AND email NOT LIKE ('%@' || $3) AND email NOT LIKE 'purged+%@invalid'
+		ORDER BY id
+		LIMIT $2
+	`
+	updateQuery := `
+		UPDATE user_service.users u
+		SET email = v.email,
+			name = NULLIF(v.name, ''),
+			password_hash = ''
+		FROM unnest($1::uuid[], $2::text[], $3::text[]) AS v(id, email, name)
+		WHERE u.id = v.id
+	`
+
+	total := 0
+	after := uuid.Nil
+	for {
+		rows, err := pool.Query(ctx, selectQuery, after, a.batchSize, FakeEmailDomain)
+		if err != nil {
+			return total, err
+		}
+		var ids []uuid.UUID
+		var emails, names []string
+		for rows.Next() {
+			var id uuid.UUID
+			var email, name string
+			if err := rows.Scan(&id, &email, &name); err != nil {
+				rows.Close()
+				return total, err
+			}
+			ids = append(ids, id)
+			emails = append(emails, a.faker.Email(email))
+			names = append(names, a.faker.Name(name))
+		}
+		rows.Close()
+		if err := rows.Err(); err != nil {
+			return total, err
+		}
+		if len(ids) == 0 {
+			return total, nil
+		}
+
+		if _, err := pool.Exec(ctx, updateQuery, ids, emails, names); err != nil {
+			return total, err
+		}
+		total += len(ids)
+		after = ids[len(ids)-1]
+		a.logger.Info("anonymized users", slog.Int("total", total))
+	}
+}
+
+// Orders rewrites shipping addresses in order_service.orders. User IDs are
+// left as they are, so orders still belong to the same (anonymized) users.
+// Returns the number of orders rewritten, or 0 if the order schema doesn't
+// exist in the database.
+func (a *Anonymizer) Orders(ctx context.Context, pool *pgxpool.Pool) (int, error) {
+	var exists bool
+	if err := pool.QueryRow(ctx, `SELECT to_regclass('order_service.orders') IS NOT NULL`).Scan(&exists); err != nil {
+		return 0, err
+	}
+	if !exists {
+		a.logger.Info("order_service.orders not found, skipping orders")
+		return 0, nil
+	}
+
+	selectQuery := `
+		SELECT id, shipping_address
+		FROM order_service.orders
+		WHERE id > $1 AND shipping_address IS NOT NULL
+		ORDER BY id
+		LIMIT $2
+	`
+	updateQuery := `
+		UPDATE order_service.orders o
+		SET shipping_address = v.address
+		FROM unnest($1::uuid[], $2::jsonb[]) AS v(id, address)
+		WHERE o.id = v.id
+	`
+
+	total := 0
+	after := uuid.Nil
+	for {
+		rows, err := pool.Query(ctx, selectQuery, after, a.batchSize)
+		if err != nil {
+			return total, err
+		}
+		var ids []uuid.UUID
+		var addresses []string
+		for rows.Next() {
+			var id uuid.UUID
+			var raw []byte
+			if err := rows.Scan(&id, &raw); err != nil {
+				rows.Close()
+				return total, err
+			}
+			fake, err := a.faker.Address(raw)
+			if err != nil {
+				rows.Close()
+				return total, err
+			}
+			ids = append(ids, id)
+			addresses = append(addresses, string(fake))
+		}
+		rows.Close()
+		if err := rows.Err(); err != nil {
+			return total, err
+		}
+		if len(ids) == 0 {
+			return total, nil
+		}
+
+		if _, err := pool.Exec(ctx, updateQuery, ids, addresses); err != nil {
+			return total, err
+		}
+		total += len(ids)
+		after = ids[len(ids)-1]
+		a.logger.Info("anonymized orders", slog.Int("total", total))
+	}
+}
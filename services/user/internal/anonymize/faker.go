@@ -0,0 +1,153 @@
+// Package anonymize rewrites personal data in a copy of the production
+// database so it can be restored into staging. Values are replaced with
+// fakes derived from a keyed hash of the original, so the same input always
+// maps to the same fake: duplicates stay duplicates, unique values stay
+// unique, and rows referring to each other by these values still match.
+package anonymize
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// FakeEmailDomain is the domain of every generated email address. It is
+// reserved (RFC 2606), so staging can never mail a real recipient.
+const FakeEmailDomain = "example.invalid"
+
+var (
+	firstNames = []string{
+		"Haruto", "Yui", "Sota", "Aoi", "Riku", "Hina", "Yuto", "Mei",
+		"Minato", "Sakura", "Ren", "Yuna", "Kaito", "Rin", "Hayato", "Mio",
+	}
+	lastNames = []string{
+		"Sato", "Suzuki", "Takahashi", "Tanaka", "Watanabe", "Ito", "Yamamoto", "Nakamura",
+		"Kobayashi", "Kato", "Yoshida", "Yamada", "Sasaki", "Yamaguchi", "Matsumoto", "Inoue",
+	}
+)
+
+// Faker derives fake personal data from originals.
+type Faker struct {
+	key []byte
+}
+
+// NewFaker creates a faker. key must be kept secret: anyone holding it can
+// confirm a guessed original by recomputing its fake.
+func NewFaker(key []byte) *Faker {
+	return &Faker{key: key}
+}
+
+// sum returns the keyed hash of value. kind separates the fakes of
+// different fields derived from the same value.
+func (f *Faker) sum(kind, value string) []byte {
+	mac := hmac.New(sha256.New, f.key)
+	mac.Write([]byte(kind))
+	mac.Write([]byte{0})
+	mac.Write([]byte(value))
+	return mac.Sum(nil)
+}
+
+// number returns a number in [0, n) derived from the hash of value.
+func (f *Faker) number(kind, value string, n uint64) uint64 {
+	return binary.BigEndian.Uint64(f.sum(kind, value)) % n
+}
+
+// Email returns a fake address on FakeEmailDomain.
+func (f *Faker) Email(email string) string {
+	return "user-" + hex.EncodeToString(f.sum("email", email)[:8]) + "@" + FakeEmailDomain
+}
+
+// Name returns a fake full name. Empty names stay empty.
+func (f *Faker) Name(name string) string {
+	if name == "" {
+		return ""
+	}
+	sum := f.sum("name", name)
+	return firstNames[int(sum[0])%len(firstNames)] + " " + lastNames[int(sum[1])%len(lastNames)]
+}
+
+// Phone returns a fake Japanese mobile number.
+func (f *Faker) Phone(phone string) string {
+	n := f.number("phone", phone, 100000000)
+	return fmt.Sprintf("090-%04d-%04d", n/10000, n%10000)
+}
+
+// PostalCode returns a fake Japanese postal code.
+func (f *Faker) PostalCode(code string) string {
+	n := f.number("postal_code", code, 10000000)
+	return fmt.Sprintf("%03d-%04d", n/10000, n%10000)
+}
+
+// AddressLine returns a fake street address.
+func (f *Faker) AddressLine(line string) string {
+	n := f.number("address", line, 1000000)
+	return fmt.Sprintf("%d-%d-%d Example-cho", n/10000%100+1, n/100%100+1, n%100+1)
+}
+
+// addressKeys maps keys of a JSON address to the fake used for their
+// value. Keys not listed are treated as address lines; keepKeys are left
+// unchanged because they are needed for shipping and tax rules but do not
+// identify anyone.
+var (
+	addressKeys = map[string]func(*Faker, string) string{
+		"name":           (*Faker).Name,
+		"full_name":      (*Faker).Name,
+		"first_name":     (*Faker).Name,
+		"last_name":      (*Faker).Name,
+		"recipient":      (*Faker).Name,
+		"recipient_name": (*Faker).Name,
+		"email":          (*Faker).Email,
+		"phone":          (*Faker).Phone,
+		"phone_number":   (*Faker).Phone,
+		"tel":            (*Faker).Phone,
+		"postal_code":    (*Faker).PostalCode,
+		"zip":            (*Faker).PostalCode,
+		"zip_code":       (*Faker).PostalCode,
+	}
+	keepKeys = map[string]bool{
+		"country":      true,
+		"country_code": true,
+		"prefecture":   true,
+		"state":        true,
+		"region":       true,
+	}
+)
+
+// Address rewrites the string values of a JSON address object, including
+// nested objects and arrays. Non-string values are kept.
+func (f *Faker) Address(raw []byte) ([]byte, error) {
+	var v any
+	if err := json.Unmarshal(raw, &v); err != nil {
+		return nil, fmt.Errorf("decode address: %w", err)
+	}
+	return json.Marshal(f.address("", v))
+}
+
+func (f *Faker) address(key string, v any) any {
+	switch v := v.(type) {
+	case map[string]any:
+		for k, child := range v {
+			v[k] = f.address(strings.ToLower(k), child)
+		}
+		return v
+	case []any:
+		for i, child := range v {
+			v[i] = f.address(key, child)
+		}
+		return v
+	case string:
+		if keepKeys[key] || v == "" {
+			return v
+		}
+		if fake, ok := addressKeys[key]; ok {
+			return fake(f, v)
+		}
+		return f.AddressLine(v)
+	default:
+		return v
+	}
+}
@@ -0,0 +1,87 @@
+package anonymize
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+func TestFaker_Deterministic(t *testing.T) {
+	f := NewFaker([]byte("0123456789abcdef"))
+	other := NewFaker([]byte("fedcba9876543210"))
+
+	if f.Email("a@example.com") != f.Email("a@example.com") {
+		t.Error("Email is not deterministic")
+	}
+	if f.Email("a@example.com") == f.Email("b@example.com") {
+		t.Error("different emails map to the same fake")
+	}
+	if f.Email("a@example.com") == other.Email("a@example.com") {
+		t.Error("fake does not depend on the key")
+	}
+	if !strings.HasSuffix(f.Email("a@example.com"), "@"+FakeEmailDomain) {
+		t.Errorf("Email = %q, want domain %s", f.Email("a@example.com"), FakeEmailDomain)
+	}
+	if f.Name("Taro Yamada") != f.Name("Taro Yamada") {
+		t.Error("Name is not deterministic")
+	}
+	if f.Name("") != "" {
+		t.Error("empty name should stay empty")
+	}
+}
+
+func TestFaker_Address(t *testing.T) {
+	f := NewFaker([]byte("0123456789abcdef"))
+	raw := []byte(`{
+		"recipient_name": "Taro Yamada",
+		"phone": "080-1111-2222",
+		"postal_code": "150-0001",
+		"prefecture": "Tokyo",
+		"line1": "1-2-3 Jingumae",
+		"lines": ["Shibuya-ku", "Room 101"],
+		"floor": 3
+	}`)
+
+	out, err := f.Address(raw)
+	if err != nil {
+		t.Fatalf("Address: %v", err)
+	}
+	var got map[string]any
+	if err := json.Unmarshal(out, &got); err != nil {
+		t.Fatalf("output is not JSON: %v", err)
+	}
+
+	if got["recipient_name"] != f.Name("Taro Yamada") {
+		t.Errorf("recipient_name = %v", got["recipient_name"])
+	}
+	if got["phone"] != f.Phone("080-1111-2222") {
+		t.Errorf("phone = %v", got["phone"])
+	}
+	if got["postal_code"] != f.PostalCode("150-0001") {
+		t.Errorf("postal_code = %v", got["postal_code"])
+	}
+	if got["prefecture"] != "Tokyo" {
+		t.Errorf("prefecture = %v, want it kept", got["prefecture"])
+	}
+	if got["line1"] == "1-2-3 Jingumae" {
+		t.Error("line1 was not rewritten")
+	}
+	if lines, _ := got["lines"].([]any); len(lines) != 2 || lines[1] == "Room 101" {
+		t.Errorf("lines = %v", got["lines"])
+	}
+	if got["floor"] != float64(3) {
+		t.Errorf("floor = %v, want it kept", got["floor"])
+	}
+
+	again, _ := f.Address(raw)
+	if string(again) != string(out) {
+		t.Error("Address is not deterministic")
+	}
+}
+
+func TestFaker_AddressRejectsInvalidJSON(t *testing.T) {
+	f := NewFaker([]byte("0123456789abcdef"))
+	if _, err := f.Address([]byte(`{`)); err == nil {
+		t.Error("expected error for invalid JSON")
+	}
+}
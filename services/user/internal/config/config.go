@@ -3,6 +3,9 @@ package config
 import (
 	"context"
 	"fmt"
+	"log/slog"
+	"net/url"
+	"strings"
 	"time"
 
 	"github.com/sethvargo/go-envconfig"
@@ -11,22 +14,202 @@ import (
 type Config struct {
 	GRPCPort    int    `env:"GRPC_PORT,default=50051"`
 	HTTPPort    int    `env:"HTTP_PORT,default=8051"`
+	MetricsPort int    `env:"METRICS_PORT,default=9051"`
+	DebugPort   int    `env:"DEBUG_PORT,default=0"`         // pprof, expvar and GC stats; 0 disables
+	DebugAddr   string `env:"DEBUG_ADDR,default=127.0.0.1"` // Host the debug server binds to
 	DatabaseURL string `env:"DATABASE_URL,required"`
 	RedisURL    string `env:"REDIS_URL,default=localhost:6379"`
 
+	// Database connection pool (pgxpool). Tune with the db_pool_* metrics:
+	// waiting acquires mean too few connections, many idle ones too many.
+	DBMaxConns          int32         `env:"DB_MAX_CONNS,default=10"`
+	DBMinConns          int32         `env:"DB_MIN_CONNS,default=0"`
+	DBMaxConnLifetime   time.Duration `env:"DB_MAX_CONN_LIFETIME,default=1h"`
+	DBMaxConnIdleTime   time.Duration `env:"DB_MAX_CONN_IDLE_TIME,default=30m"`
+	DBHealthCheckPeriod time.Duration `env:"DB_HEALTH_CHECK_PERIOD,default=1m"`
+
+	// Every statement gets DB_QUERY_TIMEOUT unless the caller's deadline is
+	// sooner, so a pathological query cannot hold a connection. Statements
+	// slower than DB_SLOW_QUERY_THRESHOLD are logged with their arguments
+	// reduced to non-sensitive values. 0 disables either.
+	DBQueryTimeout       time.Duration `env:"DB_QUERY_TIMEOUT,default=5s"`
+	DBSlowQueryThreshold time.Duration `env:"DB_SLOW_QUERY_THRESHOLD,default=500ms"`
+
+	// RPC logging. At LOG_LEVEL=debug request and response payloads are
+	// logged, with sensitive fields redacted. LOG_SAMPLE_EVERY logs every Nth
+	// successful call of high-QPS procedures (procedure:N); failed calls and
+	// calls slower than LOG_SLOW_THRESHOLD are always logged.
+	LogLevel         string         `env:"LOG_LEVEL,default=info"`
+	LogSlowThreshold time.Duration  `env:"LOG_SLOW_THRESHOLD,default=1s"`
+	LogSampleEvery   map[string]int `env:"LOG_SAMPLE_EVERY"`
+
 	HydraAdminURL string `env:"HYDRA_ADMIN_URL,required"`
+	// Hydra Admin API calls: timeout per attempt, retries of connection
+	// errors and 5xx responses, and the circuit breaker that makes the
+	// login pages fail fast while Hydra is down.
+	HydraTimeout                 time.Duration `env:"HYDRA_TIMEOUT,default=5s"`
+	HydraRetryMaxAttempts        int           `env:"HYDRA_RETRY_MAX_ATTEMPTS,default=3"`
+	HydraRetryInitialBackoff     time.Duration `env:"HYDRA_RETRY_INITIAL_BACKOFF,default=100ms"`
+	HydraRetryMaxBackoff         time.Duration `env:"HYDRA_RETRY_MAX_BACKOFF,default=1s"`
+	HydraBreakerFailureThreshold int           `env:"HYDRA_BREAKER_FAILURE_THRESHOLD,default=5"`
+	HydraBreakerOpenTimeout      time.Duration `env:"HYDRA_BREAKER_OPEN_TIMEOUT,default=30s"`
 
-	BcryptCost int `env:"BCRYPT_COST,default=10"`
+	// Receiver of Hydra's token and consent events (/webhooks/hydra).
+	// Deliveries must carry the secret in X-Hydra-Webhook-Secret.
+	// Revocations are forwarded to the BFF endpoints in
+	// TOKEN_REVOCATION_WEBHOOK_URLS, signed with their secret.
+	HydraWebhookEnabled           bool          `env:"HYDRA_WEBHOOK_ENABLED,default=false"`
+	HydraWebhookSecret            string        `env:"HYDRA_WEBHOOK_SECRET"`
+	TokenRevocationWebhookURLs    []string      `env:"TOKEN_REVOCATION_WEBHOOK_URLS"`
+	TokenRevocationWebhookSecret  string        `env:"TOKEN_REVOCATION_WEBHOOK_SECRET"`
+	TokenRevocationWebhookTimeout time.Duration `env:"TOKEN_REVOCATION_WEBHOOK_TIMEOUT,default=5s"`
+
+	// Hash algorithm for new passwords: "bcrypt" or "argon2id". Hashes of
+	// the other algorithm keep working and are rehashed at the next login.
+	PasswordHashAlgorithm string `env:"PASSWORD_HASH_ALGORITHM,default=bcrypt"`
+	BcryptCost            int    `env:"BCRYPT_COST,default=10"`
+	// Argon2id parameters; memory is in KiB
+	Argon2Memory      uint32 `env:"ARGON2_MEMORY,default=65536"`
+	Argon2Iterations  uint32 `env:"ARGON2_ITERATIONS,default=3"`
+	Argon2Parallelism uint8  `env:"ARGON2_PARALLELISM,default=2"`
 
 	LoginRateLimitAttempts int           `env:"LOGIN_RATE_LIMIT_ATTEMPTS,default=5"`
 	LoginRateLimitWindow   time.Duration `env:"LOGIN_RATE_LIMIT_WINDOW,default=15m"`
 
+	// Persistent lockout of an email address after consecutive failed logins
+	LoginLockoutEnabled     bool          `env:"LOGIN_LOCKOUT_ENABLED,default=false"`
+	LoginLockoutMaxAttempts int           `env:"LOGIN_LOCKOUT_MAX_ATTEMPTS,default=10"`
+	LoginLockoutDuration    time.Duration `env:"LOGIN_LOCKOUT_DURATION,default=30m"`
+
+	// Login history shown by GetLoginHistory, with a notice to the user on
+	// logins from a new IP address and user agent. Events older than the
+	// retention are deleted.
+	LoginHistoryEnabled   bool          `env:"LOGIN_HISTORY_ENABLED,default=false"`
+	LoginHistoryRetention time.Duration `env:"LOGIN_HISTORY_RETENTION,default=2160h"` // 90 days
+	// Header to read the client IP address of logins from (e.g., X-Real-IP,
+	// X-Forwarded-For) when the login pages are behind a proxy
+	TrustedProxyHeader string `env:"TRUSTED_PROXY_HEADER"`
+
+	// Password policy for new passwords. The breach check looks passwords up
+	// in the Pwned Passwords range API by SHA-1 prefix (k-anonymity).
+	PasswordMinLength          int           `env:"PASSWORD_MIN_LENGTH,default=8"`
+	PasswordMinCharClasses     int           `env:"PASSWORD_MIN_CHAR_CLASSES,default=1"`
+	PasswordBreachCheckEnabled bool          `env:"PASSWORD_BREACH_CHECK_ENABLED,default=false"`
+	PasswordBreachCheckURL     string        `env:"PASSWORD_BREACH_CHECK_URL,default=https://api.pwnedpasswords.com"`
+	PasswordBreachCheckTimeout time.Duration `env:"PASSWORD_BREACH_CHECK_TIMEOUT,default=2s"`
+
 	// Session duration when "Remember Me" is checked (in seconds)
 	LoginRememberFor   int `env:"LOGIN_REMEMBER_FOR,default=604800"`   // 7 days
 	ConsentRememberFor int `env:"CONSENT_REMEMBER_FOR,default=2592000"` // 30 days
 
 	// CSRF protection: trusted origins for cross-origin requests
 	TrustedOrigins []string `env:"TRUSTED_ORIGINS"`
+
+	// Email verification: new accounts start unverified and receive a token
+	EmailVerificationRequired bool          `env:"EMAIL_VERIFICATION_REQUIRED,default=false"`
+	EmailVerificationTTL      time.Duration `env:"EMAIL_VERIFICATION_TTL,default=24h"`
+	// Base URL used to build links sent to users
+	PublicBaseURL string `env:"PUBLIC_BASE_URL,default=http://localhost:50051"`
+
+	// gRPC server reflection for grpcurl/buf curl; keep disabled in production
+	EnableReflection bool `env:"ENABLE_REFLECTION,default=false"`
+
+	// HTTP server timeouts. Route timeouts override the read and write
+	// timeouts for long-running RPCs, keyed by procedure or service path
+	// prefix ("/pkg.v1.Service/Method:10m,/pkg.v1.Other/:1h").
+	ServerReadTimeout       time.Duration            `env:"SERVER_READ_TIMEOUT,default=30s"`
+	ServerReadHeaderTimeout time.Duration            `env:"SERVER_READ_HEADER_TIMEOUT,default=10s"`
+	ServerWriteTimeout      time.Duration            `env:"SERVER_WRITE_TIMEOUT,default=30s"`
+	ServerIdleTimeout       time.Duration            `env:"SERVER_IDLE_TIMEOUT,default=120s"`
+	ServerRouteTimeouts     map[string]time.Duration `env:"SERVER_ROUTE_TIMEOUTS,default=/backup.v1.BackupService/CreateBackup:30m"`
+
+	// Build version reported by GetServerInfo
+	ServiceVersion string `env:"SERVICE_VERSION,default=dev"`
+
+	// Key for encrypting list page tokens; must be shared by all replicas.
+	// When empty a random key is used and tokens do not survive restarts.
+	PageTokenSecret string `env:"PAGE_TOKEN_SECRET,default="`
+
+	// Purge of soft-deleted users after the retention period
+	UserPurgeEnabled   bool          `env:"USER_PURGE_ENABLED,default=false"`
+	UserPurgeRetention time.Duration `env:"USER_PURGE_RETENTION,default=720h"` // 30 days
+	UserPurgeInterval  time.Duration `env:"USER_PURGE_INTERVAL,default=1h"`
+	UserPurgeBatchSize int           `env:"USER_PURGE_BATCH_SIZE,default=100"`
+	// "anonymize" erases personal data and keeps the row; "delete" removes it
+	UserPurgeMode   string `env:"USER_PURGE_MODE,default=anonymize"`
+	UserPurgeDryRun bool   `env:"USER_PURGE_DRY_RUN,default=false"`
+
+	// Memory and goroutine watchdog; a zero limit is not checked. After
+	// WATCHDOG_RESTART_AFTER consecutive breaches (0 never) the service shuts
+	// down gracefully so the orchestrator restarts it.
+	WatchdogInterval      time.Duration `env:"WATCHDOG_INTERVAL,default=30s"`
+	WatchdogMaxHeapMB     int           `env:"WATCHDOG_MAX_HEAP_MB,default=0"`
+	WatchdogMaxGoroutines int           `env:"WATCHDOG_MAX_GOROUTINES,default=0"`
+	WatchdogRestartAfter  int           `env:"WATCHDOG_RESTART_AFTER,default=0"`
+
+	// Logical backups of the user_service schema (BackupService)
+	BackupEnabled     bool          `env:"BACKUP_ENABLED,default=false"`
+	BackupStore       string        `env:"BACKUP_STORE,default=file"` // "s3" or "file"
+	BackupDir         string        `env:"BACKUP_DIR,default=backups"`
+	BackupS3Endpoint  string        `env:"BACKUP_S3_ENDPOINT"`
+	BackupS3Region    string        `env:"BACKUP_S3_REGION,default=ap-northeast-1"`
+	BackupS3Bucket    string        `env:"BACKUP_S3_BUCKET"`
+	BackupS3AccessKey string        `env:"BACKUP_S3_ACCESS_KEY"`
+	BackupS3SecretKey string        `env:"BACKUP_S3_SECRET_KEY"`
+	BackupKeepLast    int           `env:"BACKUP_KEEP_LAST,default=7"`
+	BackupMaxAge      time.Duration `env:"BACKUP_MAX_AGE,default=720h"` // 30 days
+
+	// Optional TOTP two-factor authentication. The key encrypts TOTP secrets
+	// at rest and the login state between the password and code steps; it
+	// must be shared by all replicas and kept when rotating other secrets.
+	TwoFactorEnabled   bool   `env:"TWO_FACTOR_ENABLED,default=false"`
+	TwoFactorSecretKey string `env:"TWO_FACTOR_SECRET_KEY"`
+	// Service name shown in authenticator apps
+	TwoFactorIssuer string `env:"TWO_FACTOR_ISSUER,default=EC Platform"`
+
+	// Default branding of the login, consent and logout pages. OAuth2
+	// clients override it with their logo_uri and a "branding" object in
+	// their metadata. Files in the assets directory are served under
+	// /static/ (e.g., client stylesheets).
+	BrandingProductName     string `env:"BRANDING_PRODUCT_NAME"`
+	BrandingLogoURI         string `env:"BRANDING_LOGO_URI"`
+	BrandingPrimaryColor    string `env:"BRANDING_PRIMARY_COLOR"`
+	BrandingBackgroundColor string `env:"BRANDING_BACKGROUND_COLOR"`
+	BrandingAssetsDir       string `env:"BRANDING_ASSETS_DIR"`
+
+	// Social login on the login page. A provider is offered when its client
+	// ID is set; register <callback base URL>/oauth2/login/upstream/<provider>/callback
+	// as its redirect URI. The key seals the flow state and must be shared
+	// by all replicas. Auto-provisioning creates users for upstream
+	// accounts whose verified email has no account yet.
+	SocialLoginEnabled         bool          `env:"SOCIAL_LOGIN_ENABLED,default=false"`
+	SocialLoginSecretKey       string        `env:"SOCIAL_LOGIN_SECRET_KEY"`
+	SocialLoginCallbackBaseURL string        `env:"SOCIAL_LOGIN_CALLBACK_BASE_URL"`
+	SocialLoginAutoProvision   bool          `env:"SOCIAL_LOGIN_AUTO_PROVISION,default=true"`
+	SocialLoginTimeout         time.Duration `env:"SOCIAL_LOGIN_TIMEOUT,default=10s"`
+	GoogleClientID             string        `env:"GOOGLE_CLIENT_ID"`
+	GoogleClientSecret         string        `env:"GOOGLE_CLIENT_SECRET"`
+	GitHubClientID             string        `env:"GITHUB_CLIENT_ID"`
+	GitHubClientSecret         string        `env:"GITHUB_CLIENT_SECRET"`
+
+	// Service-to-service authentication. When enabled, RPCs are only served
+	// to the services in SERVICE_AUTH_ALLOWED: callers present a client
+	// credentials access token from Hydra (verified against
+	// SERVICE_AUTH_JWKS_URL) or, when SERVICE_TLS_CLIENT_CA_FILE is set, a
+	// client certificate signed by that CA.
+	ServiceAuthEnabled     bool     `env:"SERVICE_AUTH_ENABLED,default=false"`
+	ServiceAuthJWKSURL     string   `env:"SERVICE_AUTH_JWKS_URL"`
+	ServiceAuthIssuer      string   `env:"SERVICE_AUTH_ISSUER"`
+	ServiceAuthAudience    string   `env:"SERVICE_AUTH_AUDIENCE"`
+	ServiceAuthAllowed     []string `env:"SERVICE_AUTH_ALLOWED"`
+	ServiceTLSCertFile     string   `env:"SERVICE_TLS_CERT_FILE"`
+	ServiceTLSKeyFile      string   `env:"SERVICE_TLS_KEY_FILE"`
+	ServiceTLSClientCAFile string   `env:"SERVICE_TLS_CLIENT_CA_FILE"`
+
+	// Keys verifying the signature of propagated user context (the BFF's
+	// CONTEXT_SIGNING_KEY). When set, unsigned context is rejected; list the
+	// new key next to the old one while rotating.
+	ContextSigningKeys []string `env:"CONTEXT_SIGNING_KEYS"`
 }
 
 func Load(ctx context.Context) (*Config, error) {
@@ -35,9 +218,218 @@ func Load(ctx context.Context) (*Config, error) {
 		return nil, fmt.Errorf("failed to load config: %w", err)
 	}
 
+	if cfg.MetricsPort < 1 || cfg.MetricsPort > 65535 {
+		return nil, fmt.Errorf("metrics port must be between 1 and 65535, got %d", cfg.MetricsPort)
+	}
+
+	if cfg.DBMaxConns < 1 || cfg.DBMaxConns > 1000 {
+		return nil, fmt.Errorf("db max conns must be between 1 and 1000, got %d", cfg.DBMaxConns)
+	}
+	if cfg.DBMinConns < 0 || cfg.DBMinConns > cfg.DBMaxConns {
+		return nil, fmt.Errorf("db min conns must be between 0 and db max conns, got %d", cfg.DBMinConns)
+	}
+	if cfg.DBMaxConnLifetime < time.Minute || cfg.DBMaxConnIdleTime < time.Second || cfg.DBHealthCheckPeriod < time.Second {
+		return nil, fmt.Errorf("db max conn lifetime must be at least 1m, max conn idle time and health check period at least 1s, got %s, %s and %s",
+			cfg.DBMaxConnLifetime, cfg.DBMaxConnIdleTime, cfg.DBHealthCheckPeriod)
+	}
+	if cfg.DBQueryTimeout < 0 || cfg.DBSlowQueryThreshold < 0 {
+		return nil, fmt.Errorf("db query timeout and slow query threshold must not be negative, got %s and %s",
+			cfg.DBQueryTimeout, cfg.DBSlowQueryThreshold)
+	}
+
+	if cfg.DebugPort < 0 || cfg.DebugPort > 65535 {
+		return nil, fmt.Errorf("debug port must be between 0 and 65535, got %d", cfg.DebugPort)
+	}
+
+	var level slog.Level
+	if err := level.UnmarshalText([]byte(cfg.LogLevel)); err != nil {
+		return nil, fmt.Errorf("log level must be debug, info, warn or error, got %q", cfg.LogLevel)
+	}
+	if cfg.LogSlowThreshold < 0 {
+		return nil, fmt.Errorf("log slow threshold must not be negative, got %s", cfg.LogSlowThreshold)
+	}
+	for procedure, every := range cfg.LogSampleEvery {
+		if !strings.HasPrefix(procedure, "/") {
+			return nil, fmt.Errorf("log sample procedure must start with /, got %q", procedure)
+		}
+		if every < 1 {
+			return nil, fmt.Errorf("log sample rate for %s must be at least 1, got %d", procedure, every)
+		}
+	}
+
 	if cfg.BcryptCost < 4 || cfg.BcryptCost > 31 {
 		return nil, fmt.Errorf("bcrypt cost must be between 4 and 31, got %d", cfg.BcryptCost)
 	}
+	switch cfg.PasswordHashAlgorithm {
+	case "bcrypt":
+	case "argon2id":
+		if cfg.Argon2Memory < 8*1024 || cfg.Argon2Memory > 1024*1024 {
+			return nil, fmt.Errorf("argon2 memory must be between 8192 and 1048576 KiB, got %d", cfg.Argon2Memory)
+		}
+		if cfg.Argon2Iterations < 1 || cfg.Argon2Iterations > 10 {
+			return nil, fmt.Errorf("argon2 iterations must be between 1 and 10, got %d", cfg.Argon2Iterations)
+		}
+		if cfg.Argon2Parallelism < 1 {
+			return nil, fmt.Errorf("argon2 parallelism must be at least 1, got %d", cfg.Argon2Parallelism)
+		}
+	default:
+		return nil, fmt.Errorf("password hash algorithm must be bcrypt or argon2id, got %q", cfg.PasswordHashAlgorithm)
+	}
+
+	if cfg.ServerReadTimeout < time.Second || cfg.ServerReadTimeout > 10*time.Minute {
+		return nil, fmt.Errorf("server read timeout must be between 1s and 10m, got %s", cfg.ServerReadTimeout)
+	}
+	if cfg.ServerReadHeaderTimeout < time.Second || cfg.ServerReadHeaderTimeout > cfg.ServerReadTimeout {
+		return nil, fmt.Errorf("server read header timeout must be between 1s and the read timeout, got %s", cfg.ServerReadHeaderTimeout)
+	}
+	if cfg.ServerWriteTimeout < time.Second || cfg.ServerWriteTimeout > 10*time.Minute {
+		return nil, fmt.Errorf("server write timeout must be between 1s and 10m, got %s", cfg.ServerWriteTimeout)
+	}
+	if cfg.ServerIdleTimeout < time.Second || cfg.ServerIdleTimeout > time.Hour {
+		return nil, fmt.Errorf("server idle timeout must be between 1s and 1h, got %s", cfg.ServerIdleTimeout)
+	}
+	for route, timeout := range cfg.ServerRouteTimeouts {
+		if !strings.HasPrefix(route, "/") {
+			return nil, fmt.Errorf("server route timeout route must start with /, got %q", route)
+		}
+		if timeout < time.Second || timeout > 2*time.Hour {
+			return nil, fmt.Errorf("server route timeout for %s must be between 1s and 2h, got %s", route, timeout)
+		}
+	}
+
+	if cfg.UserPurgeEnabled {
+		if cfg.UserPurgeMode != "anonymize" && cfg.UserPurgeMode != "delete" {
+			return nil, fmt.Errorf("user purge mode must be anonymize or delete, got %q", cfg.UserPurgeMode)
+		}
+		if cfg.UserPurgeRetention < 24*time.Hour {
+			return nil, fmt.Errorf("user purge retention must be at least 24h, got %s", cfg.UserPurgeRetention)
+		}
+		if cfg.UserPurgeInterval < time.Minute {
+			return nil, fmt.Errorf("user purge interval must be at least 1m, got %s", cfg.UserPurgeInterval)
+		}
+		if cfg.UserPurgeBatchSize < 1 || cfg.UserPurgeBatchSize > 10000 {
+			return nil, fmt.Errorf("user purge batch size must be between 1 and 10000, got %d", cfg.UserPurgeBatchSize)
+		}
+	}
+
+	if cfg.WatchdogMaxHeapMB < 0 || cfg.WatchdogMaxGoroutines < 0 || cfg.WatchdogRestartAfter < 0 {
+		return nil, fmt.Errorf("watchdog limits must not be negative, got heap %dMB, %d goroutines, restart after %d", cfg.WatchdogMaxHeapMB, cfg.WatchdogMaxGoroutines, cfg.WatchdogRestartAfter)
+	}
+	if cfg.WatchdogInterval < time.Second || cfg.WatchdogInterval > 10*time.Minute {
+		return nil, fmt.Errorf("watchdog interval must be between 1s and 10m, got %s", cfg.WatchdogInterval)
+	}
+
+	if cfg.BackupEnabled {
+		if cfg.BackupStore != "s3" && cfg.BackupStore != "file" {
+			return nil, fmt.Errorf("backup store must be s3 or file, got %q", cfg.BackupStore)
+		}
+		if cfg.BackupStore == "s3" && (cfg.BackupS3Endpoint == "" || cfg.BackupS3Bucket == "") {
+			return nil, fmt.Errorf("backup S3 endpoint and bucket are required when BACKUP_STORE is s3")
+		}
+		if cfg.BackupKeepLast < 1 {
+			return nil, fmt.Errorf("backup keep last must be at least 1, got %d", cfg.BackupKeepLast)
+		}
+		if cfg.BackupMaxAge < 0 {
+			return nil, fmt.Errorf("backup max age must not be negative, got %s", cfg.BackupMaxAge)
+		}
+	}
+
+	if cfg.LoginLockoutEnabled {
+		if cfg.LoginLockoutMaxAttempts < 1 {
+			return nil, fmt.Errorf("login lockout max attempts must be at least 1, got %d", cfg.LoginLockoutMaxAttempts)
+		}
+		if cfg.LoginLockoutDuration < time.Minute || cfg.LoginLockoutDuration > 24*time.Hour {
+			return nil, fmt.Errorf("login lockout duration must be between 1m and 24h, got %s", cfg.LoginLockoutDuration)
+		}
+	}
+
+	if cfg.LoginHistoryEnabled && (cfg.LoginHistoryRetention < 24*time.Hour || cfg.LoginHistoryRetention > 8760*time.Hour) {
+		return nil, fmt.Errorf("login history retention must be between 24h and 8760h, got %s", cfg.LoginHistoryRetention)
+	}
+
+	if cfg.PasswordMinLength < 8 || cfg.PasswordMinLength > 72 {
+		return nil, fmt.Errorf("password min length must be between 8 and 72, got %d", cfg.PasswordMinLength)
+	}
+	if cfg.PasswordMinCharClasses < 1 || cfg.PasswordMinCharClasses > 4 {
+		return nil, fmt.Errorf("password min char classes must be between 1 and 4, got %d", cfg.PasswordMinCharClasses)
+	}
+	if cfg.PasswordBreachCheckEnabled && (cfg.PasswordBreachCheckTimeout < 100*time.Millisecond || cfg.PasswordBreachCheckTimeout > 10*time.Second) {
+		return nil, fmt.Errorf("password breach check timeout must be between 100ms and 10s, got %s", cfg.PasswordBreachCheckTimeout)
+	}
+
+	if cfg.HydraTimeout < 100*time.Millisecond || cfg.HydraTimeout > time.Minute {
+		return nil, fmt.Errorf("hydra timeout must be between 100ms and 1m, got %s", cfg.HydraTimeout)
+	}
+	if cfg.HydraRetryMaxAttempts < 1 || cfg.HydraRetryMaxAttempts > 10 {
+		return nil, fmt.Errorf("hydra retry max attempts must be between 1 and 10, got %d", cfg.HydraRetryMaxAttempts)
+	}
+	if cfg.HydraRetryInitialBackoff <= 0 || cfg.HydraRetryMaxBackoff < cfg.HydraRetryInitialBackoff {
+		return nil, fmt.Errorf("hydra retry backoff must be positive with a max of at least the initial backoff, got %s and %s", cfg.HydraRetryInitialBackoff, cfg.HydraRetryMaxBackoff)
+	}
+	if cfg.HydraBreakerFailureThreshold < 1 {
+		return nil, fmt.Errorf("hydra breaker failure threshold must be at least 1, got %d", cfg.HydraBreakerFailureThreshold)
+	}
+	if cfg.HydraBreakerOpenTimeout < time.Second {
+		return nil, fmt.Errorf("hydra breaker open timeout must be at least 1s, got %s", cfg.HydraBreakerOpenTimeout)
+	}
+
+	if cfg.HydraWebhookEnabled {
+		if len(cfg.HydraWebhookSecret) < 32 {
+			return nil, fmt.Errorf("hydra webhook secret must be at least 32 characters when HYDRA_WEBHOOK_ENABLED is true")
+		}
+		if len(cfg.TokenRevocationWebhookURLs) > 0 && cfg.TokenRevocationWebhookSecret == "" {
+			return nil, fmt.Errorf("token revocation webhook secret is required when TOKEN_REVOCATION_WEBHOOK_URLS is set")
+		}
+		if cfg.TokenRevocationWebhookTimeout < 100*time.Millisecond || cfg.TokenRevocationWebhookTimeout > 30*time.Second {
+			return nil, fmt.Errorf("token revocation webhook timeout must be between 100ms and 30s, got %s", cfg.TokenRevocationWebhookTimeout)
+		}
+	}
+
+	if cfg.TwoFactorEnabled && len(cfg.TwoFactorSecretKey) < 32 {
+		return nil, fmt.Errorf("two-factor secret key must be at least 32 characters when TWO_FACTOR_ENABLED is true")
+	}
+
+	if cfg.SocialLoginEnabled {
+		if len(cfg.SocialLoginSecretKey) < 32 {
+			return nil, fmt.Errorf("social login secret key must be at least 32 characters when SOCIAL_LOGIN_ENABLED is true")
+		}
+		if u, err := url.Parse(cfg.SocialLoginCallbackBaseURL); err != nil || (u.Scheme != "https" && u.Scheme != "http") || u.Host == "" {
+			return nil, fmt.Errorf("social login callback base URL must be an absolute http(s) URL, got %q", cfg.SocialLoginCallbackBaseURL)
+		}
+		if cfg.GoogleClientID == "" && cfg.GitHubClientID == "" {
+			return nil, fmt.Errorf("GOOGLE_CLIENT_ID or GITHUB_CLIENT_ID is required when SOCIAL_LOGIN_ENABLED is true")
+		}
+		if (cfg.GoogleClientID != "" && cfg.GoogleClientSecret == "") || (cfg.GitHubClientID != "" && cfg.GitHubClientSecret == "") {
+			return nil, fmt.Errorf("social login client secrets are required for the configured providers")
+		}
+		if cfg.SocialLoginTimeout < time.Second || cfg.SocialLoginTimeout > time.Minute {
+			return nil, fmt.Errorf("social login timeout must be between 1s and 1m, got %s", cfg.SocialLoginTimeout)
+		}
+	}
+
+	if (cfg.ServiceTLSCertFile == "") != (cfg.ServiceTLSKeyFile == "") {
+		return nil, fmt.Errorf("service TLS certificate and key files must be set together")
+	}
+	if cfg.ServiceTLSClientCAFile != "" && cfg.ServiceTLSCertFile == "" {
+		return nil, fmt.Errorf("service TLS client CA file requires SERVICE_TLS_CERT_FILE and SERVICE_TLS_KEY_FILE")
+	}
+	for _, key := range cfg.ContextSigningKeys {
+		if len(key) < 32 {
+			return nil, fmt.Errorf("context signing keys must be at least 32 characters")
+		}
+	}
+
+	if cfg.ServiceAuthEnabled {
+		if len(cfg.ServiceAuthAllowed) == 0 {
+			return nil, fmt.Errorf("SERVICE_AUTH_ALLOWED is required when SERVICE_AUTH_ENABLED is true")
+		}
+		if cfg.ServiceAuthJWKSURL == "" && cfg.ServiceTLSClientCAFile == "" {
+			return nil, fmt.Errorf("SERVICE_AUTH_JWKS_URL or SERVICE_TLS_CLIENT_CA_FILE is required when SERVICE_AUTH_ENABLED is true")
+		}
+		if cfg.ServiceAuthJWKSURL != "" && cfg.ServiceAuthIssuer == "" {
+			return nil, fmt.Errorf("SERVICE_AUTH_ISSUER is required when SERVICE_AUTH_JWKS_URL is set")
+		}
+	}
 
 	return &cfg, nil
 }
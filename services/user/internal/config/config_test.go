@@ -55,12 +55,30 @@ func TestLoad(t *testing.T) {
 				if cfg.BcryptCost != 10 {
 					t.Errorf("BcryptCost = %d, want %d", cfg.BcryptCost, 10)
 				}
+				if cfg.PasswordHashAlgorithm != "bcrypt" {
+					t.Errorf("PasswordHashAlgorithm = %q, want %q", cfg.PasswordHashAlgorithm, "bcrypt")
+				}
 				if cfg.LoginRateLimitAttempts != 5 {
 					t.Errorf("LoginRateLimitAttempts = %d, want %d", cfg.LoginRateLimitAttempts, 5)
 				}
 				if cfg.LoginRateLimitWindow != 15*time.Minute {
 					t.Errorf("LoginRateLimitWindow = %v, want %v", cfg.LoginRateLimitWindow, 15*time.Minute)
 				}
+				if cfg.EmailVerificationRequired {
+					t.Error("EmailVerificationRequired = true, want false")
+				}
+				if cfg.EmailVerificationTTL != 24*time.Hour {
+					t.Errorf("EmailVerificationTTL = %v, want %v", cfg.EmailVerificationTTL, 24*time.Hour)
+				}
+				if cfg.PasswordMinLength != 8 || cfg.PasswordMinCharClasses != 1 {
+					t.Errorf("password policy = %d chars, %d classes, want 8, 1", cfg.PasswordMinLength, cfg.PasswordMinCharClasses)
+				}
+				if cfg.PasswordBreachCheckEnabled {
+					t.Error("PasswordBreachCheckEnabled = true, want false")
+				}
+				if cfg.HydraTimeout != 5*time.Second || cfg.HydraRetryMaxAttempts != 3 || cfg.HydraBreakerFailureThreshold != 5 {
+					t.Errorf("hydra client = %v timeout, %d attempts, breaker after %d, want 5s, 3, 5", cfg.HydraTimeout, cfg.HydraRetryMaxAttempts, cfg.HydraBreakerFailureThreshold)
+				}
 			},
 		},
 		{
@@ -125,6 +143,423 @@ func TestLoad(t *testing.T) {
 			},
 			wantErr: true,
 		},
+		{
+			name: "loads user purge settings",
+			envVars: map[string]string{
+				"DATABASE_URL":          "postgres://localhost/db",
+				"HYDRA_ADMIN_URL":       "http://localhost:4445",
+				"USER_PURGE_ENABLED":    "true",
+				"USER_PURGE_MODE":       "delete",
+				"USER_PURGE_RETENTION":  "2160h",
+				"USER_PURGE_BATCH_SIZE": "500",
+				"USER_PURGE_DRY_RUN":    "true",
+			},
+			wantErr: false,
+			checkConfig: func(t *testing.T, cfg *Config) {
+				if cfg.UserPurgeMode != "delete" {
+					t.Errorf("UserPurgeMode = %q, want %q", cfg.UserPurgeMode, "delete")
+				}
+				if cfg.UserPurgeRetention != 90*24*time.Hour {
+					t.Errorf("UserPurgeRetention = %v, want %v", cfg.UserPurgeRetention, 90*24*time.Hour)
+				}
+				if cfg.UserPurgeBatchSize != 500 {
+					t.Errorf("UserPurgeBatchSize = %d, want %d", cfg.UserPurgeBatchSize, 500)
+				}
+				if !cfg.UserPurgeDryRun {
+					t.Error("UserPurgeDryRun = false, want true")
+				}
+			},
+		},
+		{
+			name: "fails when user purge mode is invalid",
+			envVars: map[string]string{
+				"DATABASE_URL":       "postgres://localhost/db",
+				"HYDRA_ADMIN_URL":    "http://localhost:4445",
+				"USER_PURGE_ENABLED": "true",
+				"USER_PURGE_MODE":    "shred",
+			},
+			wantErr: true,
+		},
+		{
+			name: "fails when user purge retention is too short",
+			envVars: map[string]string{
+				"DATABASE_URL":         "postgres://localhost/db",
+				"HYDRA_ADMIN_URL":      "http://localhost:4445",
+				"USER_PURGE_ENABLED":   "true",
+				"USER_PURGE_RETENTION": "1h",
+			},
+			wantErr: true,
+		},
+		{
+			name: "loads server timeouts",
+			envVars: map[string]string{
+				"DATABASE_URL":          "postgres://localhost/db",
+				"HYDRA_ADMIN_URL":       "http://localhost:4445",
+				"SERVER_WRITE_TIMEOUT":  "1m",
+				"SERVER_ROUTE_TIMEOUTS": "/backup.v1.BackupService/:1h,/user.v1.UserService/GetBatchJobReport:5m",
+			},
+			wantErr: false,
+			checkConfig: func(t *testing.T, cfg *Config) {
+				if cfg.ServerWriteTimeout != time.Minute {
+					t.Errorf("ServerWriteTimeout = %v, want %v", cfg.ServerWriteTimeout, time.Minute)
+				}
+				if cfg.ServerReadTimeout != 30*time.Second {
+					t.Errorf("ServerReadTimeout = %v, want %v", cfg.ServerReadTimeout, 30*time.Second)
+				}
+				if got := cfg.ServerRouteTimeouts["/backup.v1.BackupService/"]; got != time.Hour {
+					t.Errorf("ServerRouteTimeouts[backup] = %v, want %v", got, time.Hour)
+				}
+				if got := cfg.ServerRouteTimeouts["/user.v1.UserService/GetBatchJobReport"]; got != 5*time.Minute {
+					t.Errorf("ServerRouteTimeouts[report] = %v, want %v", got, 5*time.Minute)
+				}
+			},
+		},
+		{
+			name: "fails when server read header timeout exceeds read timeout",
+			envVars: map[string]string{
+				"DATABASE_URL":               "postgres://localhost/db",
+				"HYDRA_ADMIN_URL":            "http://localhost:4445",
+				"SERVER_READ_TIMEOUT":        "5s",
+				"SERVER_READ_HEADER_TIMEOUT": "10s",
+			},
+			wantErr: true,
+		},
+		{
+			name: "fails when server route timeout route is not a path",
+			envVars: map[string]string{
+				"DATABASE_URL":          "postgres://localhost/db",
+				"HYDRA_ADMIN_URL":       "http://localhost:4445",
+				"SERVER_ROUTE_TIMEOUTS": "CreateBackup:1h",
+			},
+			wantErr: true,
+		},
+		{
+			name: "fails when backup S3 bucket is missing",
+			envVars: map[string]string{
+				"DATABASE_URL":       "postgres://localhost/db",
+				"HYDRA_ADMIN_URL":    "http://localhost:4445",
+				"BACKUP_ENABLED":     "true",
+				"BACKUP_STORE":       "s3",
+				"BACKUP_S3_ENDPOINT": "http://localhost:9000",
+			},
+			wantErr: true,
+		},
+		{
+			name: "fails when two-factor secret key is too short",
+			envVars: map[string]string{
+				"DATABASE_URL":          "postgres://localhost/db",
+				"HYDRA_ADMIN_URL":       "http://localhost:4445",
+				"TWO_FACTOR_ENABLED":    "true",
+				"TWO_FACTOR_SECRET_KEY": "short",
+			},
+			wantErr: true,
+		},
+		{
+			name: "fails when login lockout duration is out of range",
+			envVars: map[string]string{
+				"DATABASE_URL":           "postgres://localhost/db",
+				"HYDRA_ADMIN_URL":        "http://localhost:4445",
+				"LOGIN_LOCKOUT_ENABLED":  "true",
+				"LOGIN_LOCKOUT_DURATION": "10s",
+			},
+			wantErr: true,
+		},
+		{
+			name: "fails when login history retention is out of range",
+			envVars: map[string]string{
+				"DATABASE_URL":            "postgres://localhost/db",
+				"HYDRA_ADMIN_URL":         "http://localhost:4445",
+				"LOGIN_HISTORY_ENABLED":   "true",
+				"LOGIN_HISTORY_RETENTION": "1h",
+			},
+			wantErr: true,
+		},
+		{
+			name: "loads argon2id parameters",
+			envVars: map[string]string{
+				"DATABASE_URL":            "postgres://localhost/db",
+				"HYDRA_ADMIN_URL":         "http://localhost:4445",
+				"PASSWORD_HASH_ALGORITHM": "argon2id",
+				"ARGON2_MEMORY":           "19456",
+				"ARGON2_ITERATIONS":       "2",
+				"ARGON2_PARALLELISM":      "1",
+			},
+			wantErr: false,
+			checkConfig: func(t *testing.T, cfg *Config) {
+				if cfg.Argon2Memory != 19456 || cfg.Argon2Iterations != 2 || cfg.Argon2Parallelism != 1 {
+					t.Errorf("argon2 = m=%d,t=%d,p=%d, want m=19456,t=2,p=1", cfg.Argon2Memory, cfg.Argon2Iterations, cfg.Argon2Parallelism)
+				}
+			},
+		},
+		{
+			name: "fails on unknown password hash algorithm",
+			envVars: map[string]string{
+				"DATABASE_URL":            "postgres://localhost/db",
+				"HYDRA_ADMIN_URL":         "http://localhost:4445",
+				"PASSWORD_HASH_ALGORITHM": "scrypt",
+			},
+			wantErr: true,
+		},
+		{
+			name: "fails when argon2 memory is too low",
+			envVars: map[string]string{
+				"DATABASE_URL":            "postgres://localhost/db",
+				"HYDRA_ADMIN_URL":         "http://localhost:4445",
+				"PASSWORD_HASH_ALGORITHM": "argon2id",
+				"ARGON2_MEMORY":           "1024",
+			},
+			wantErr: true,
+		},
+		{
+			name: "loads hydra webhook settings",
+			envVars: map[string]string{
+				"DATABASE_URL":                    "postgres://localhost/db",
+				"HYDRA_ADMIN_URL":                 "http://localhost:4445",
+				"HYDRA_WEBHOOK_ENABLED":           "true",
+				"HYDRA_WEBHOOK_SECRET":            "0123456789abcdef0123456789abcdef",
+				"TOKEN_REVOCATION_WEBHOOK_URLS":   "http://bff-1:8080/webhooks/user-events,http://bff-2:8080/webhooks/user-events",
+				"TOKEN_REVOCATION_WEBHOOK_SECRET": "whsec_test",
+			},
+			wantErr: false,
+			checkConfig: func(t *testing.T, cfg *Config) {
+				if len(cfg.TokenRevocationWebhookURLs) != 2 {
+					t.Errorf("TokenRevocationWebhookURLs = %v, want 2 URLs", cfg.TokenRevocationWebhookURLs)
+				}
+				if cfg.TokenRevocationWebhookTimeout != 5*time.Second {
+					t.Errorf("TokenRevocationWebhookTimeout = %v, want %v", cfg.TokenRevocationWebhookTimeout, 5*time.Second)
+				}
+			},
+		},
+		{
+			name: "fails when hydra webhook secret is too short",
+			envVars: map[string]string{
+				"DATABASE_URL":          "postgres://localhost/db",
+				"HYDRA_ADMIN_URL":       "http://localhost:4445",
+				"HYDRA_WEBHOOK_ENABLED": "true",
+				"HYDRA_WEBHOOK_SECRET":  "short",
+			},
+			wantErr: true,
+		},
+		{
+			name: "fails when token revocation webhook secret is missing",
+			envVars: map[string]string{
+				"DATABASE_URL":                  "postgres://localhost/db",
+				"HYDRA_ADMIN_URL":               "http://localhost:4445",
+				"HYDRA_WEBHOOK_ENABLED":         "true",
+				"HYDRA_WEBHOOK_SECRET":          "0123456789abcdef0123456789abcdef",
+				"TOKEN_REVOCATION_WEBHOOK_URLS": "http://bff:8080/webhooks/user-events",
+			},
+			wantErr: true,
+		},
+		{
+			name: "fails when password min char classes is out of range",
+			envVars: map[string]string{
+				"DATABASE_URL":              "postgres://localhost/db",
+				"HYDRA_ADMIN_URL":           "http://localhost:4445",
+				"PASSWORD_MIN_CHAR_CLASSES": "5",
+			},
+			wantErr: true,
+		},
+		{
+			name: "fails when password min length is below the minimum",
+			envVars: map[string]string{
+				"DATABASE_URL":        "postgres://localhost/db",
+				"HYDRA_ADMIN_URL":     "http://localhost:4445",
+				"PASSWORD_MIN_LENGTH": "6",
+			},
+			wantErr: true,
+		},
+		{
+			name: "loads social login settings",
+			envVars: map[string]string{
+				"DATABASE_URL":                   "postgres://localhost/db",
+				"HYDRA_ADMIN_URL":                "http://localhost:4445",
+				"SOCIAL_LOGIN_ENABLED":           "true",
+				"SOCIAL_LOGIN_SECRET_KEY":        "0123456789abcdef0123456789abcdef",
+				"SOCIAL_LOGIN_CALLBACK_BASE_URL": "https://login.example.com",
+				"GOOGLE_CLIENT_ID":               "google-client",
+				"GOOGLE_CLIENT_SECRET":           "google-secret",
+			},
+			wantErr: false,
+			checkConfig: func(t *testing.T, cfg *Config) {
+				if !cfg.SocialLoginAutoProvision {
+					t.Error("SocialLoginAutoProvision = false, want true")
+				}
+				if cfg.SocialLoginTimeout != 10*time.Second {
+					t.Errorf("SocialLoginTimeout = %v, want %v", cfg.SocialLoginTimeout, 10*time.Second)
+				}
+			},
+		},
+		{
+			name: "fails when social login has no provider",
+			envVars: map[string]string{
+				"DATABASE_URL":                   "postgres://localhost/db",
+				"HYDRA_ADMIN_URL":                "http://localhost:4445",
+				"SOCIAL_LOGIN_ENABLED":           "true",
+				"SOCIAL_LOGIN_SECRET_KEY":        "0123456789abcdef0123456789abcdef",
+				"SOCIAL_LOGIN_CALLBACK_BASE_URL": "https://login.example.com",
+			},
+			wantErr: true,
+		},
+		{
+			name: "fails when social login callback base URL is relative",
+			envVars: map[string]string{
+				"DATABASE_URL":                   "postgres://localhost/db",
+				"HYDRA_ADMIN_URL":                "http://localhost:4445",
+				"SOCIAL_LOGIN_ENABLED":           "true",
+				"SOCIAL_LOGIN_SECRET_KEY":        "0123456789abcdef0123456789abcdef",
+				"SOCIAL_LOGIN_CALLBACK_BASE_URL": "/oauth2",
+				"GITHUB_CLIENT_ID":               "github-client",
+				"GITHUB_CLIENT_SECRET":           "github-secret",
+			},
+			wantErr: true,
+		},
+		{
+			name: "fails when hydra max backoff is below the initial backoff",
+			envVars: map[string]string{
+				"DATABASE_URL":                "postgres://localhost/db",
+				"HYDRA_ADMIN_URL":             "http://localhost:4445",
+				"HYDRA_RETRY_INITIAL_BACKOFF": "2s",
+				"HYDRA_RETRY_MAX_BACKOFF":     "1s",
+			},
+			wantErr: true,
+		},
+		{
+			name: "loads service auth settings",
+			envVars: map[string]string{
+				"DATABASE_URL":          "postgres://localhost/db",
+				"HYDRA_ADMIN_URL":       "http://localhost:4445",
+				"SERVICE_AUTH_ENABLED":  "true",
+				"SERVICE_AUTH_JWKS_URL": "http://hydra:4444/.well-known/jwks.json",
+				"SERVICE_AUTH_ISSUER":   "http://localhost:4444",
+				"SERVICE_AUTH_ALLOWED":  "bff,spiffe://ec/bff",
+			},
+			wantErr: false,
+			checkConfig: func(t *testing.T, cfg *Config) {
+				if len(cfg.ServiceAuthAllowed) != 2 || cfg.ServiceAuthAllowed[1] != "spiffe://ec/bff" {
+					t.Errorf("ServiceAuthAllowed = %v, want [bff spiffe://ec/bff]", cfg.ServiceAuthAllowed)
+				}
+			},
+		},
+		{
+			name: "fails when service auth has no allowed services",
+			envVars: map[string]string{
+				"DATABASE_URL":          "postgres://localhost/db",
+				"HYDRA_ADMIN_URL":       "http://localhost:4445",
+				"SERVICE_AUTH_ENABLED":  "true",
+				"SERVICE_AUTH_JWKS_URL": "http://hydra:4444/.well-known/jwks.json",
+				"SERVICE_AUTH_ISSUER":   "http://localhost:4444",
+			},
+			wantErr: true,
+		},
+		{
+			name: "fails when service TLS client CA has no server certificate",
+			envVars: map[string]string{
+				"DATABASE_URL":               "postgres://localhost/db",
+				"HYDRA_ADMIN_URL":            "http://localhost:4445",
+				"SERVICE_TLS_CLIENT_CA_FILE": "/etc/ec/ca.pem",
+			},
+			wantErr: true,
+		},
+		{
+			name: "fails when a context signing key is too short",
+			envVars: map[string]string{
+				"DATABASE_URL":         "postgres://localhost/db",
+				"HYDRA_ADMIN_URL":      "http://localhost:4445",
+				"CONTEXT_SIGNING_KEYS": "0123456789abcdef0123456789abcdef,short",
+			},
+			wantErr: true,
+		},
+		{
+			name: "loads log sampling rates",
+			envVars: map[string]string{
+				"DATABASE_URL":     "postgres://localhost/db",
+				"HYDRA_ADMIN_URL":  "http://localhost:4445",
+				"LOG_LEVEL":        "debug",
+				"LOG_SAMPLE_EVERY": "/user.v1.UserService/GetUser:100",
+			},
+			wantErr: false,
+			checkConfig: func(t *testing.T, cfg *Config) {
+				if cfg.LogSampleEvery["/user.v1.UserService/GetUser"] != 100 {
+					t.Errorf("LogSampleEvery = %v, want GetUser:100", cfg.LogSampleEvery)
+				}
+			},
+		},
+		{
+			name: "fails with invalid metrics port",
+			envVars: map[string]string{
+				"DATABASE_URL":    "postgres://localhost/db",
+				"HYDRA_ADMIN_URL": "http://localhost:4445",
+				"METRICS_PORT":    "0",
+			},
+			wantErr: true,
+		},
+		{
+			name: "fails with invalid debug port",
+			envVars: map[string]string{
+				"DATABASE_URL":    "postgres://localhost/db",
+				"HYDRA_ADMIN_URL": "http://localhost:4445",
+				"DEBUG_PORT":      "-1",
+			},
+			wantErr: true,
+		},
+		{
+			name: "loads database pool settings",
+			envVars: map[string]string{
+				"DATABASE_URL":          "postgres://localhost/db",
+				"HYDRA_ADMIN_URL":       "http://localhost:4445",
+				"DB_MAX_CONNS":          "25",
+				"DB_MIN_CONNS":          "5",
+				"DB_MAX_CONN_LIFETIME":  "30m",
+				"DB_MAX_CONN_IDLE_TIME": "5m",
+			},
+			wantErr: false,
+			checkConfig: func(t *testing.T, cfg *Config) {
+				if cfg.DBMaxConns != 25 || cfg.DBMinConns != 5 {
+					t.Errorf("DBMaxConns, DBMinConns = %d, %d, want 25, 5", cfg.DBMaxConns, cfg.DBMinConns)
+				}
+				if cfg.DBMaxConnLifetime != 30*time.Minute || cfg.DBMaxConnIdleTime != 5*time.Minute {
+					t.Errorf("DBMaxConnLifetime, DBMaxConnIdleTime = %s, %s, want 30m, 5m", cfg.DBMaxConnLifetime, cfg.DBMaxConnIdleTime)
+				}
+			},
+		},
+		{
+			name: "fails with negative db query timeout",
+			envVars: map[string]string{
+				"DATABASE_URL":     "postgres://localhost/db",
+				"HYDRA_ADMIN_URL":  "http://localhost:4445",
+				"DB_QUERY_TIMEOUT": "-1s",
+			},
+			wantErr: true,
+		},
+		{
+			name: "fails when db min conns exceed max conns",
+			envVars: map[string]string{
+				"DATABASE_URL":    "postgres://localhost/db",
+				"HYDRA_ADMIN_URL": "http://localhost:4445",
+				"DB_MAX_CONNS":    "4",
+				"DB_MIN_CONNS":    "8",
+			},
+			wantErr: true,
+		},
+		{
+			name: "fails with invalid log level",
+			envVars: map[string]string{
+				"DATABASE_URL":    "postgres://localhost/db",
+				"HYDRA_ADMIN_URL": "http://localhost:4445",
+				"LOG_LEVEL":       "verbose",
+			},
+			wantErr: true,
+		},
+		{
+			name: "fails with zero log sampling rate",
+			envVars: map[string]string{
+				"DATABASE_URL":     "postgres://localhost/db",
+				"HYDRA_ADMIN_URL":  "http://localhost:4445",
+				"LOG_SAMPLE_EVERY": "/user.v1.UserService/GetUser:0",
+			},
+			wantErr: true,
+		},
 	}
 
 	for _, tt := range tests {
@@ -0,0 +1,189 @@
+package domain
+
+import (
+	"context"
+	"regexp"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+)
+
+const (
+	// MaxAddressesPerUser bounds the address book of one user.
+	MaxAddressesPerUser = 10
+
+	MaxRecipientLength   = 100
+	MaxAddressLineLength = 200
+	MaxCityLength        = 100
+	MaxRegionLength      = 100
+)
+
+var (
+	countryCodeRegex = regexp.MustCompile(`^[A-Z]{2}$`)
+	// e164Regex matches phone numbers in E.164 format, e.g. +819012345678.
+	e164Regex = regexp.MustCompile(`^\+[1-9][0-9]{6,14}$`)
+	// postalCodeRegexes hold the formats of the countries shipped to most.
+	// Other countries accept any short alphanumeric code.
+	postalCodeRegexes = map[string]*regexp.Regexp{
+		"JP": regexp.MustCompile(`^[0-9]{3}-[0-9]{4}$`),
+		"US": regexp.MustCompile(`^[0-9]{5}(-[0-9]{4})?$`),
+		"CA": regexp.MustCompile(`^[A-Z][0-9][A-Z] [0-9][A-Z][0-9]$`),
+		"GB": regexp.MustCompile(`^[A-Z]{1,2}[0-9][A-Z0-9]? [0-9][A-Z]{2}$`),
+		"DE": regexp.MustCompile(`^[0-9]{5}$`),
+		"FR": regexp.MustCompile(`^[0-9]{5}$`),
+	}
+	genericPostalCodeRegex = regexp.MustCompile(`^[A-Z0-9][A-Z0-9 -]{1,9}$`)
+)
+
+// Address is a postal address in the user's address book. At most one
+// address per user is the default shipping address.
+type Address struct {
+	ID        uuid.UUID
+	UserID    uuid.UUID
+	Recipient string
+	// PhoneNumber is the recipient's number in E.164 format, for carriers.
+	PhoneNumber PhoneNumber
+	// Country is an ISO 3166-1 alpha-2 code.
+	Country         string
+	PostalCode      string
+	Region          string
+	City            string
+	Line1           string
+	Line2           string
+	DefaultShipping bool
+	CreatedAt       time.Time
+	UpdatedAt       time.Time
+}
+
+type AddressRepository interface {
+	// Create inserts the address. When it is the default shipping address,
+	// the previous default is cleared in the same transaction.
+	Create(ctx context.Context, address *Address) error
+	// ListByUser returns the user's addresses, the default shipping address
+	// first and then newest first.
+	ListByUser(ctx context.Context, userID uuid.UUID) ([]*Address, error)
+	// SetDefaultShipping makes the address the user's only default shipping
+	// address. Returns ErrAddressNotFound if the user has no such address.
+	SetDefaultShipping(ctx context.Context, userID, addressID uuid.UUID, now time.Time) error
+	// Delete returns ErrAddressNotFound if the user has no such address.
+	Delete(ctx context.Context, userID, addressID uuid.UUID) error
+}
+
+// PhoneNumber is a phone number in E.164 format.
+type PhoneNumber string
+
+// ParsePhoneNumber normalizes a number written with spaces, hyphens,
+// dots or parentheses, e.g. "+81 90-1234-5678", to E.164.
+func ParsePhoneNumber(s string) (PhoneNumber, error) {
+	normalized := strings.Map(func(r rune) rune {
+		switch r {
+		case ' ', '-', '.', '(', ')':
+			return -1
+		}
+		return r
+	}, strings.TrimSpace(s))
+	if !e164Regex.MatchString(normalized) {
+		return "", ErrInvalidPhoneNumber
+	}
+	return PhoneNumber(normalized), nil
+}
+
+// AddressInput holds the fields of an address as entered by the user.
+type AddressInput struct {
+	Recipient   string
+	PhoneNumber string
+	Country     string
+	PostalCode  string
+	Region      string
+	City        string
+	Line1       string
+	Line2       string
+}
+
+// NewAddress validates and normalizes input into a new address of the user.
+func NewAddress(userID uuid.UUID, input AddressInput) (*Address, error) {
+	country := strings.ToUpper(strings.TrimSpace(input.Country))
+	if !countryCodeRegex.MatchString(country) {
+		return nil, ErrInvalidCountryCode
+	}
+	postalCode, err := NormalizePostalCode(country, input.PostalCode)
+	if err != nil {
+		return nil, err
+	}
+	phone, err := ParsePhoneNumber(input.PhoneNumber)
+	if err != nil {
+		return nil, err
+	}
+
+	recipient := strings.TrimSpace(input.Recipient)
+	line1 := strings.TrimSpace(input.Line1)
+	line2 := strings.TrimSpace(input.Line2)
+	city := strings.TrimSpace(input.City)
+	region := strings.TrimSpace(input.Region)
+	switch {
+	case recipient == "" || utf8.RuneCountInString(recipient) > MaxRecipientLength:
+		return nil, ErrInvalidRecipient
+	case line1 == "" || utf8.RuneCountInString(line1) > MaxAddressLineLength,
+		utf8.RuneCountInString(line2) > MaxAddressLineLength:
+		return nil, ErrInvalidAddressLine
+	case city == "" || utf8.RuneCountInString(city) > MaxCityLength,
+		utf8.RuneCountInString(region) > MaxRegionLength:
+		return nil, ErrInvalidLocality
+	}
+
+	now := time.Now().UTC()
+	return &Address{
+		ID:          uuid.New(),
+		UserID:      userID,
+		Recipient:   recipient,
+		PhoneNumber: phone,
+		Country:     country,
+		PostalCode:  postalCode,
+		Region:      region,
+		City:        city,
+		Line1:       line1,
+		Line2:       line2,
+		CreatedAt:   now,
+		UpdatedAt:   now,
+	}, nil
+}
+
+// NormalizePostalCode upper-cases the code and checks it against the
+// format of the country. Japanese codes may be written without the hyphen.
+func NormalizePostalCode(country, postalCode string) (string, error) {
+	code := strings.ToUpper(strings.TrimSpace(postalCode))
+	if country == "JP" && len(code) == 7 && !strings.Contains(code, "-") {
+		code = code[:3] + "-" + code[3:]
+	}
+
+	re, ok := postalCodeRegexes[country]
+	if !ok {
+		re = genericPostalCodeRegex
+	}
+	if !re.MatchString(code) {
+		return "", ErrInvalidPostalCode
+	}
+	return code, nil
+}
+
+// StreetAddress joins the address lines as in the OpenID Connect address
+// claim.
+func (a *Address) StreetAddress() string {
+	if a.Line2 == "" {
+		return a.Line1
+	}
+	return a.Line1 + "\n" + a.Line2
+}
+
+// Formatted returns the full address on multiple lines, recipient excluded.
+func (a *Address) Formatted() string {
+	lines := []string{a.StreetAddress()}
+	locality := a.City
+	if a.Region != "" {
+		locality += ", " + a.Region
+	}
+	lines = append(lines, locality+" "+a.PostalCode, a.Country)
+	return strings.Join(lines, "\n")
+}
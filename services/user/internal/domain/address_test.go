@@ -0,0 +1,88 @@
+package domain
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+)
+
+func validAddressInput() AddressInput {
+	return AddressInput{
+		Recipient:   "Taro Yamada",
+		PhoneNumber: "+81 90-1234-5678",
+		Country:     "jp",
+		PostalCode:  "1000001",
+		Region:      "Tokyo",
+		City:        "Chiyoda-ku",
+		Line1:       "1-1 Chiyoda",
+	}
+}
+
+func TestNewAddress(t *testing.T) {
+	userID := uuid.New()
+	a, err := NewAddress(userID, validAddressInput())
+	if err != nil {
+		t.Fatalf("NewAddress() error = %v", err)
+	}
+	if a.UserID != userID || a.ID == uuid.Nil || a.DefaultShipping {
+		t.Errorf("NewAddress() = %+v", a)
+	}
+	if a.Country != "JP" || a.PostalCode != "100-0001" || a.PhoneNumber != "+819012345678" {
+		t.Errorf("country = %q, postal code = %q, phone = %q, want normalized values", a.Country, a.PostalCode, a.PhoneNumber)
+	}
+}
+
+func TestNewAddress_Invalid(t *testing.T) {
+	tests := []struct {
+		name    string
+		modify  func(*AddressInput)
+		wantErr error
+	}{
+		{"unknown country format", func(in *AddressInput) { in.Country = "JPN" }, ErrInvalidCountryCode},
+		{"postal code of another country", func(in *AddressInput) { in.PostalCode = "94105" }, ErrInvalidPostalCode},
+		{"local phone number", func(in *AddressInput) { in.PhoneNumber = "090-1234-5678" }, ErrInvalidPhoneNumber},
+		{"missing recipient", func(in *AddressInput) { in.Recipient = "  " }, ErrInvalidRecipient},
+		{"missing first line", func(in *AddressInput) { in.Line1 = "" }, ErrInvalidAddressLine},
+		{"long second line", func(in *AddressInput) { in.Line2 = strings.Repeat("a", MaxAddressLineLength+1) }, ErrInvalidAddressLine},
+		{"missing city", func(in *AddressInput) { in.City = "" }, ErrInvalidLocality},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			input := validAddressInput()
+			tt.modify(&input)
+			if _, err := NewAddress(uuid.New(), input); !errors.Is(err, tt.wantErr) {
+				t.Errorf("NewAddress() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestNormalizePostalCode(t *testing.T) {
+	tests := []struct {
+		country, code, want string
+		wantErr             bool
+	}{
+		{country: "JP", code: "100-0001", want: "100-0001"},
+		{country: "US", code: "94105-1234", want: "94105-1234"},
+		{country: "GB", code: "sw1a 1aa", want: "SW1A 1AA"},
+		{country: "CA", code: "K1A0B1", wantErr: true},
+		{country: "NZ", code: "6011", want: "6011"},
+		{country: "NZ", code: "", wantErr: true},
+	}
+	for _, tt := range tests {
+		got, err := NormalizePostalCode(tt.country, tt.code)
+		if (err != nil) != tt.wantErr || got != tt.want {
+			t.Errorf("NormalizePostalCode(%q, %q) = %q, %v", tt.country, tt.code, got, err)
+		}
+	}
+}
+
+func TestAddress_Formatted(t *testing.T) {
+	a := &Address{Country: "JP", PostalCode: "100-0001", Region: "Tokyo", City: "Chiyoda-ku", Line1: "1-1 Chiyoda", Line2: "Room 101"}
+	want := "1-1 Chiyoda\nRoom 101\nChiyoda-ku, Tokyo 100-0001\nJP"
+	if got := a.Formatted(); got != want {
+		t.Errorf("Formatted() = %q, want %q", got, want)
+	}
+}
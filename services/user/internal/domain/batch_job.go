@@ -0,0 +1,116 @@
+package domain
+
+import (
+	"context"
+	"regexp"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// MaxBatchTargets caps the number of users a single batch job may touch.
+const MaxBatchTargets = 10000
+
+var segmentRegex = regexp.MustCompile(`^[a-z0-9][a-z0-9_\-]{0,63}$`)
+
+type BatchJobKind string
+
+const (
+	BatchJobDeactivate    BatchJobKind = "deactivate"
+	BatchJobAssignSegment BatchJobKind = "assign_segment"
+)
+
+type BatchJobStatus string
+
+const (
+	BatchJobPending   BatchJobStatus = "pending"
+	BatchJobRunning   BatchJobStatus = "running"
+	BatchJobCompleted BatchJobStatus = "completed"
+	BatchJobFailed    BatchJobStatus = "failed"
+)
+
+type BatchResultStatus string
+
+const (
+	BatchResultSucceeded BatchResultStatus = "succeeded"
+	BatchResultSkipped   BatchResultStatus = "skipped"
+	BatchResultFailed    BatchResultStatus = "failed"
+)
+
+// BatchJob is an asynchronous administrative action over a set of users.
+type BatchJob struct {
+	ID     uuid.UUID
+	Kind   BatchJobKind
+	Status BatchJobStatus
+	// Segment is the target segment for BatchJobAssignSegment jobs.
+	Segment     string
+	RequestedBy string
+	Total       int
+	Processed   int
+	Succeeded   int
+	Failed      int
+	CreatedAt   time.Time
+	CompletedAt *time.Time
+}
+
+// BatchJobResult records the outcome of a batch job for a single user.
+type BatchJobResult struct {
+	UserID uuid.UUID
+	Status BatchResultStatus
+	Error  string
+}
+
+type BatchJobRepository interface {
+	Create(ctx context.Context, job *BatchJob) error
+	FindByID(ctx context.Context, id uuid.UUID) (*BatchJob, error)
+	// SaveProgress persists counters and status along with new per-user results.
+	SaveProgress(ctx context.Context, job *BatchJob, results []BatchJobResult) error
+	// ListResults returns all per-user results of a job in processing order.
+	ListResults(ctx context.Context, id uuid.UUID) ([]BatchJobResult, error)
+}
+
+// SegmentRepository assigns marketing segments to users.
+type SegmentRepository interface {
+	AssignSegment(ctx context.Context, userID uuid.UUID, segment string) error
+}
+
+func NewBatchJob(kind BatchJobKind, segment, requestedBy string, total int) *BatchJob {
+	return &BatchJob{
+		ID:          uuid.New(),
+		Kind:        kind,
+		Status:      BatchJobPending,
+		Segment:     segment,
+		RequestedBy: requestedBy,
+		Total:       total,
+		CreatedAt:   time.Now().UTC(),
+	}
+}
+
+// Record applies a per-user outcome to the job counters.
+func (j *BatchJob) Record(result BatchJobResult) {
+	j.Processed++
+	switch result.Status {
+	case BatchResultSucceeded:
+		j.Succeeded++
+	case BatchResultFailed:
+		j.Failed++
+	}
+}
+
+// Finish marks the job as completed.
+func (j *BatchJob) Finish(status BatchJobStatus) {
+	now := time.Now().UTC()
+	j.Status = status
+	j.CompletedAt = &now
+}
+
+func (j *BatchJob) IsDone() bool {
+	return j.Status == BatchJobCompleted || j.Status == BatchJobFailed
+}
+
+func ValidateSegment(segment string) error {
+	if !segmentRegex.MatchString(segment) {
+		return ErrInvalidSegment
+	}
+	return nil
+}
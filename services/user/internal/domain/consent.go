@@ -0,0 +1,53 @@
+package domain
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ConsentReceipt records a user's grant of scopes to an OAuth2 client.
+// Receipts are never deleted; revocation only sets RevokedAt.
+type ConsentReceipt struct {
+	ID         uuid.UUID
+	UserID     uuid.UUID
+	ClientID   string
+	ClientName string
+	Scopes     []string
+	Remember   bool
+	GrantedAt  time.Time
+	RevokedAt  *time.Time
+}
+
+type ConsentRepository interface {
+	Create(ctx context.Context, receipt *ConsentReceipt) error
+	// ListByUser returns the user's receipts, newest first.
+	ListByUser(ctx context.Context, userID uuid.UUID, includeRevoked bool) ([]*ConsentReceipt, error)
+	// RevokeByClient marks the user's active receipts for the client as revoked
+	// and returns how many were updated.
+	RevokeByClient(ctx context.Context, userID uuid.UUID, clientID string, revokedAt time.Time) (int, error)
+}
+
+// NewConsentReceipt creates a receipt for a consent granted now.
+func NewConsentReceipt(userID uuid.UUID, clientID, clientName string, scopes []string, remember bool) (*ConsentReceipt, error) {
+	if clientID == "" {
+		return nil, ErrEmptyClientID
+	}
+	if scopes == nil {
+		scopes = []string{}
+	}
+	return &ConsentReceipt{
+		ID:         uuid.New(),
+		UserID:     userID,
+		ClientID:   clientID,
+		ClientName: clientName,
+		Scopes:     scopes,
+		Remember:   remember,
+		GrantedAt:  time.Now().UTC(),
+	}, nil
+}
+
+func (c *ConsentReceipt) IsRevoked() bool {
+	return c.RevokedAt != nil
+}
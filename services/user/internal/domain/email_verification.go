@@ -0,0 +1,67 @@
+package domain
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// verificationTokenBytes is the entropy of a verification token (256 bits).
+const verificationTokenBytes = 32
+
+// EmailVerificationToken is a single-use token proving ownership of an email.
+// Only the SHA-256 hash of the token is persisted. Email is the address the
+// token was sent to; the token verifies nothing once the user's address
+// has changed.
+type EmailVerificationToken struct {
+	TokenHash string
+	UserID    uuid.UUID
+	Email     string
+	ExpiresAt time.Time
+	CreatedAt time.Time
+}
+
+type EmailVerificationRepository interface {
+	Create(ctx context.Context, token *EmailVerificationToken) error
+	// Consume marks the token as used and returns it, whether or not it
+	// has expired; callers check expiry and roll back to keep it unused.
+	// Returns ErrInvalidVerificationToken if the token doesn't exist or was already used.
+	Consume(ctx context.Context, tokenHash string) (*EmailVerificationToken, error)
+	// DeleteByUser deletes all of the user's tokens, used or not.
+	DeleteByUser(ctx context.Context, userID uuid.UUID) error
+}
+
+// NewEmailVerificationToken generates a random token for the user's
+// current email address.
+// It returns the plaintext token to deliver and the entity to persist.
+func NewEmailVerificationToken(user *User, ttl time.Duration) (string, *EmailVerificationToken, error) {
+	buf := make([]byte, verificationTokenBytes)
+	if _, err := rand.Read(buf); err != nil {
+		return "", nil, err
+	}
+	token := base64.RawURLEncoding.EncodeToString(buf)
+
+	now := time.Now().UTC()
+	return token, &EmailVerificationToken{
+		TokenHash: HashVerificationToken(token),
+		UserID:    user.ID,
+		Email:     user.Email,
+		ExpiresAt: now.Add(ttl),
+		CreatedAt: now,
+	}, nil
+}
+
+// HashVerificationToken returns the hex-encoded SHA-256 hash of a plaintext token.
+func HashVerificationToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+func (t *EmailVerificationToken) IsExpired(now time.Time) bool {
+	return !now.Before(t.ExpiresAt)
+}
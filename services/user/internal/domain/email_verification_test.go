@@ -0,0 +1,47 @@
+package domain
+
+import (
+	"testing"
+	"time"
+)
+
+func TestNewEmailVerificationToken(t *testing.T) {
+	user := NewUser("test@example.com", "hash", nil)
+
+	token, stored, err := NewEmailVerificationToken(user, time.Hour)
+	if err != nil {
+		t.Fatalf("NewEmailVerificationToken() error = %v", err)
+	}
+	if stored.TokenHash != HashVerificationToken(token) || stored.TokenHash == token {
+		t.Error("stored token is not the hash of the plaintext")
+	}
+	if stored.UserID != user.ID || stored.Email != user.Email {
+		t.Errorf("token for %s <%s>, want %s <%s>", stored.UserID, stored.Email, user.ID, user.Email)
+	}
+	if got := stored.ExpiresAt.Sub(stored.CreatedAt); got != time.Hour {
+		t.Errorf("lifetime = %v, want 1h", got)
+	}
+}
+
+func TestEmailVerificationToken_IsExpired(t *testing.T) {
+	expiresAt := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	token := &EmailVerificationToken{ExpiresAt: expiresAt}
+
+	tests := []struct {
+		name string
+		now  time.Time
+		want bool
+	}{
+		{name: "before expiry", now: expiresAt.Add(-time.Second), want: false},
+		{name: "at expiry", now: expiresAt, want: true},
+		{name: "after expiry", now: expiresAt.Add(time.Second), want: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := token.IsExpired(tt.now); got != tt.want {
+				t.Errorf("IsExpired(%v) = %v, want %v", tt.now, got, tt.want)
+			}
+		})
+	}
+}
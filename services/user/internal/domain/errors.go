@@ -11,4 +11,61 @@ var (
 	ErrEmptyEmail         = errors.New("email cannot be empty")
 	ErrEmptyPassword      = errors.New("password cannot be empty")
 	ErrNameTooLong        = errors.New("name must be 100 characters or less")
+
+	ErrInvalidVerificationToken  = errors.New("invalid verification token")
+	ErrVerificationTokenExpired  = errors.New("verification token expired")
+	ErrEmailVerificationDisabled = errors.New("email verification is disabled")
+	ErrEmailAlreadyVerified      = errors.New("email is already verified")
+
+	ErrInvalidPageToken = errors.New("invalid page token")
+	ErrInvalidDateRange = errors.New("created_after must be before created_before")
+
+	ErrBatchJobNotFound    = errors.New("batch job not found")
+	ErrBatchJobNotFinished = errors.New("batch job has not finished")
+	ErrEmptyBatchTarget    = errors.New("batch target must specify user IDs or a filter")
+	ErrBatchTooLarge       = errors.New("batch target exceeds maximum size")
+	ErrInvalidSegment      = errors.New("segment must be 1-64 lowercase letters, digits, '_' or '-'")
+
+	ErrEmptyClientID = errors.New("client ID cannot be empty")
+
+	ErrSessionNotFound = errors.New("session not found")
+	ErrEmptySessionID  = errors.New("session ID cannot be empty")
+
+	ErrAccessGrantNotFound    = errors.New("access grant not found")
+	ErrEmptyGrantPermission   = errors.New("grant permission cannot be empty")
+	ErrPermissionNotGrantable = errors.New("permission cannot be granted")
+	ErrInvalidGrantReason     = errors.New("grant reason must be 1-500 characters")
+	ErrInvalidGrantDuration   = errors.New("grant duration must be between 1 and 72 hours")
+	ErrSelfGrant              = errors.New("users cannot grant access to themselves")
+
+	ErrTwoFactorDisabled       = errors.New("two-factor authentication is disabled")
+	ErrTwoFactorNotEnrolled    = errors.New("two-factor authentication is not enrolled")
+	ErrTwoFactorAlreadyEnabled = errors.New("two-factor authentication is already enabled")
+	ErrInvalidTOTPCode         = errors.New("invalid authentication code")
+
+	ErrAccountLocked        = errors.New("account is temporarily locked")
+	ErrLoginLockoutDisabled = errors.New("login lockout is disabled")
+	ErrLoginHistoryDisabled = errors.New("login history is disabled")
+
+	ErrPasswordTooWeak   = errors.New("password does not mix enough kinds of characters")
+	ErrPasswordBreached  = errors.New("password has appeared in a data breach")
+	ErrPasswordUnchanged = errors.New("new password must differ from the current one")
+	ErrIncorrectPassword = errors.New("current password is incorrect")
+
+	ErrInvalidHydraEvent = errors.New("hydra event is missing required fields")
+
+	ErrAddressNotFound    = errors.New("address not found")
+	ErrTooManyAddresses   = errors.New("address book is full")
+	ErrInvalidCountryCode = errors.New("country must be an ISO 3166-1 alpha-2 code")
+	ErrInvalidPostalCode  = errors.New("postal code does not match the country's format")
+	ErrInvalidPhoneNumber = errors.New("phone number must be in international format, e.g. +81 90 1234 5678")
+	ErrInvalidRecipient   = errors.New("recipient must be 1-100 characters")
+	ErrInvalidAddressLine = errors.New("address lines must be at most 200 characters and the first is required")
+	ErrInvalidLocality    = errors.New("city is required and city and region must be at most 100 characters")
+
+	ErrExternalIdentityNotFound      = errors.New("upstream account is not linked")
+	ErrExternalIdentityAlreadyLinked = errors.New("upstream account is already linked")
+	ErrUpstreamEmailNotVerified      = errors.New("upstream account has no verified email address")
+	ErrFederatedLoginConflict        = errors.New("an account with this email already exists; sign in with its password")
+	ErrFederatedSignupDisabled       = errors.New("no account is linked to this upstream account")
 )
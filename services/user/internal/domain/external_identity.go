@@ -0,0 +1,77 @@
+package domain
+
+import (
+	"context"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+)
+
+// ExternalIdentity links a user to their account at an upstream identity
+// provider (social login), identified by the provider's subject.
+type ExternalIdentity struct {
+	Provider string
+	Subject  string
+	UserID   uuid.UUID
+	// Email is the upstream address when the link was made, for support.
+	Email     string
+	CreatedAt time.Time
+}
+
+// UpstreamProfile is what an upstream identity provider reports about the
+// account that signed in.
+type UpstreamProfile struct {
+	Provider string
+	Subject  string
+	Email    string
+	// EmailVerified reports whether the provider has verified Email. Only
+	// verified addresses are matched to or used for local users.
+	EmailVerified bool
+	Name          string
+}
+
+type ExternalIdentityRepository interface {
+	// Find returns ErrExternalIdentityNotFound if the upstream account is
+	// not linked.
+	Find(ctx context.Context, provider, subject string) (*ExternalIdentity, error)
+	// Link links an upstream account to an existing user. Returns
+	// ErrExternalIdentityAlreadyLinked if the account or the user already
+	// has a link for the provider.
+	Link(ctx context.Context, identity *ExternalIdentity) error
+	// Provision creates user and its link in one transaction. Returns
+	// ErrEmailAlreadyExists or ErrExternalIdentityAlreadyLinked.
+	Provision(ctx context.Context, user *User, identity *ExternalIdentity) error
+}
+
+// NewExternalIdentity links profile's account to userID.
+func NewExternalIdentity(profile UpstreamProfile, userID uuid.UUID) *ExternalIdentity {
+	return &ExternalIdentity{
+		Provider:  profile.Provider,
+		Subject:   profile.Subject,
+		UserID:    userID,
+		Email:     profile.Email,
+		CreatedAt: time.Now().UTC(),
+	}
+}
+
+// NewFederatedUser creates a user for an upstream account. The user has no
+// password and signs in through the provider; the email is verified by it.
+func NewFederatedUser(profile UpstreamProfile) *User {
+	var name *string
+	if n := truncateName(strings.TrimSpace(profile.Name)); n != "" {
+		name = &n
+	}
+	user := NewUser(strings.ToLower(profile.Email), "", name)
+	user.MarkEmailVerified(user.CreatedAt)
+	return user
+}
+
+// truncateName cuts an upstream display name to MaxNameLength characters.
+func truncateName(name string) string {
+	if utf8.RuneCountInString(name) <= MaxNameLength {
+		return name
+	}
+	return string([]rune(name)[:MaxNameLength])
+}
@@ -0,0 +1,37 @@
+package domain
+
+import (
+	"strings"
+	"testing"
+	"unicode/utf8"
+)
+
+func TestNewFederatedUser(t *testing.T) {
+	user := NewFederatedUser(UpstreamProfile{
+		Provider:      "google",
+		Subject:       "1234",
+		Email:         "Taro@Example.com",
+		EmailVerified: true,
+		Name:          "  " + strings.Repeat("山", MaxNameLength+5) + "  ",
+	})
+
+	if user.Email != "taro@example.com" {
+		t.Errorf("expected a lowercase email, got %q", user.Email)
+	}
+	if !user.EmailVerified || user.EmailVerifiedAt == nil {
+		t.Error("expected the email to be verified")
+	}
+	if user.PasswordHash != "" {
+		t.Error("expected no password")
+	}
+	if user.Name == nil || utf8.RuneCountInString(*user.Name) != MaxNameLength {
+		t.Errorf("expected the name to be cut to %d characters, got %v", MaxNameLength, user.Name)
+	}
+	if err := ValidateName(user.Name); err != nil {
+		t.Errorf("expected a valid name, got %v", err)
+	}
+
+	if user := NewFederatedUser(UpstreamProfile{Email: "taro@example.com"}); user.Name != nil {
+		t.Errorf("expected no name for an empty upstream name, got %q", *user.Name)
+	}
+}
@@ -0,0 +1,40 @@
+package domain
+
+import "time"
+
+// Types of the events Hydra delivers to the webhook.
+const (
+	HydraEventTokenIssued         = "token.issued"
+	HydraEventConsentRevoked      = "consent.revoked"
+	HydraEventLoginSessionRevoked = "login_session.revoked"
+)
+
+// HydraEvent is an event Hydra reported about a user's tokens.
+type HydraEvent struct {
+	ID   string
+	Type string
+	// Subject is the user ID.
+	Subject string
+	// ClientID is empty for events that affect every client.
+	ClientID   string
+	SessionID  string
+	OccurredAt time.Time
+}
+
+// Validate checks the event has the fields its type requires. Events of
+// unknown types are valid and ignored by the receiver.
+func (e HydraEvent) Validate() error {
+	if e.ID == "" || e.Subject == "" || e.OccurredAt.IsZero() {
+		return ErrInvalidHydraEvent
+	}
+	if e.Type == HydraEventConsentRevoked && e.ClientID == "" {
+		return ErrInvalidHydraEvent
+	}
+	return nil
+}
+
+// RevokesTokens reports whether the event invalidates tokens issued
+// before it.
+func (e HydraEvent) RevokesTokens() bool {
+	return e.Type == HydraEventConsentRevoked || e.Type == HydraEventLoginSessionRevoked
+}
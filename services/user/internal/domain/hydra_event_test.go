@@ -0,0 +1,55 @@
+package domain
+
+import (
+	"testing"
+	"time"
+)
+
+func TestHydraEvent_Validate(t *testing.T) {
+	valid := HydraEvent{
+		ID:         "evt-1",
+		Type:       HydraEventConsentRevoked,
+		Subject:    "user-1",
+		ClientID:   "web",
+		OccurredAt: time.Now(),
+	}
+
+	tests := []struct {
+		name    string
+		modify  func(e *HydraEvent)
+		wantErr error
+	}{
+		{"valid", func(e *HydraEvent) {}, nil},
+		{"missing ID", func(e *HydraEvent) { e.ID = "" }, ErrInvalidHydraEvent},
+		{"missing subject", func(e *HydraEvent) { e.Subject = "" }, ErrInvalidHydraEvent},
+		{"missing time", func(e *HydraEvent) { e.OccurredAt = time.Time{} }, ErrInvalidHydraEvent},
+		{"consent revocation without client", func(e *HydraEvent) { e.ClientID = "" }, ErrInvalidHydraEvent},
+		{"session revocation without client", func(e *HydraEvent) {
+			e.Type = HydraEventLoginSessionRevoked
+			e.ClientID = ""
+		}, nil},
+		{"unknown type", func(e *HydraEvent) { e.Type = "flow.expired" }, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := valid
+			tt.modify(&e)
+			if err := e.Validate(); err != tt.wantErr {
+				t.Errorf("Validate() = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestHydraEvent_RevokesTokens(t *testing.T) {
+	for eventType, want := range map[string]bool{
+		HydraEventTokenIssued:         false,
+		HydraEventConsentRevoked:      true,
+		HydraEventLoginSessionRevoked: true,
+		"flow.expired":                false,
+	} {
+		if got := (HydraEvent{Type: eventType}).RevokesTokens(); got != want {
+			t.Errorf("RevokesTokens(%s) = %v, want %v", eventType, got, want)
+		}
+	}
+}
@@ -0,0 +1,74 @@
+package domain
+
+import (
+	"context"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+)
+
+// Reasons of failed login events.
+const (
+	LoginFailureInvalidPassword = "invalid_password"
+	LoginFailureAccountLocked   = "account_locked"
+	LoginFailureInvalidCode     = "invalid_code"
+)
+
+// MaxLoginUserAgentLength bounds the user agents stored with login events.
+const MaxLoginUserAgentLength = 512
+
+// LoginEvent is a sign-in attempt on an account: a completed login or one
+// refused for a wrong password or code. Attempts on addresses without an
+// account are not recorded.
+type LoginEvent struct {
+	ID        uuid.UUID
+	UserID    uuid.UUID
+	IPAddress string
+	UserAgent string
+	Succeeded bool
+	// FailureReason is one of the LoginFailure constants for failed logins.
+	FailureReason string
+	// NewDevice is set on successful logins from an IP address and user
+	// agent the account had not logged in from before.
+	NewDevice  bool
+	OccurredAt time.Time
+}
+
+// NewLoginEvent creates the event of a login attempt. An empty
+// failureReason records a successful login.
+func NewLoginEvent(userID uuid.UUID, ipAddress, userAgent, failureReason string, now time.Time) *LoginEvent {
+	if len(userAgent) > MaxLoginUserAgentLength {
+		userAgent = truncateUTF8(userAgent, MaxLoginUserAgentLength)
+	}
+	return &LoginEvent{
+		ID:            uuid.New(),
+		UserID:        userID,
+		IPAddress:     ipAddress,
+		UserAgent:     userAgent,
+		Succeeded:     failureReason == "",
+		FailureReason: failureReason,
+		OccurredAt:    now,
+	}
+}
+
+// truncateUTF8 cuts s to at most n bytes without splitting a character.
+func truncateUTF8(s string, n int) string {
+	for n > 0 && !utf8.RuneStart(s[n]) {
+		n--
+	}
+	return s[:n]
+}
+
+type LoginEventRepository interface {
+	Create(ctx context.Context, event *LoginEvent) error
+	// ListByUser returns the latest limit events of a user, newest first.
+	ListByUser(ctx context.Context, userID uuid.UUID, limit int) ([]*LoginEvent, error)
+	// HasSucceeded reports whether the user ever logged in successfully.
+	HasSucceeded(ctx context.Context, userID uuid.UUID) (bool, error)
+	// HasSucceededFrom reports whether the user logged in successfully
+	// from ipAddress with userAgent before.
+	HasSucceededFrom(ctx context.Context, userID uuid.UUID, ipAddress, userAgent string) (bool, error)
+	// DeleteBefore deletes the user's events older than before.
+	DeleteBefore(ctx context.Context, userID uuid.UUID, before time.Time) error
+}
@@ -0,0 +1,34 @@
+package domain
+
+import (
+	"strings"
+	"testing"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+)
+
+func TestNewLoginEvent(t *testing.T) {
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	userID := uuid.New()
+
+	ok := NewLoginEvent(userID, "203.0.113.7", "Mozilla/5.0", "", now)
+	if !ok.Succeeded || ok.FailureReason != "" || ok.UserID != userID || !ok.OccurredAt.Equal(now) {
+		t.Errorf("successful login = %+v", ok)
+	}
+
+	failed := NewLoginEvent(userID, "203.0.113.7", "Mozilla/5.0", LoginFailureInvalidPassword, now)
+	if failed.Succeeded || failed.FailureReason != LoginFailureInvalidPassword {
+		t.Errorf("failed login = %+v", failed)
+	}
+}
+
+func TestNewLoginEvent_TruncatesUserAgent(t *testing.T) {
+	// Multi-byte characters straddle the limit.
+	userAgent := strings.Repeat("あ", MaxLoginUserAgentLength)
+	e := NewLoginEvent(uuid.New(), "203.0.113.7", userAgent, "", time.Now())
+	if len(e.UserAgent) > MaxLoginUserAgentLength || !utf8.ValidString(e.UserAgent) {
+		t.Errorf("user agent = %d bytes, valid UTF-8 = %v", len(e.UserAgent), utf8.ValidString(e.UserAgent))
+	}
+}
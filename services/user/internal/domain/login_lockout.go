@@ -0,0 +1,57 @@
+package domain
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"time"
+)
+
+// LoginLockout counts the consecutive failed password logins of an email
+// address. Lockouts are keyed by the address rather than the account, so
+// addresses without an account lock the same way and a lockout does not
+// reveal which addresses are registered. Only the SHA-256 hash of the
+// address is persisted.
+type LoginLockout struct {
+	EmailHash      string
+	FailedAttempts int
+	// LockedUntil is set once the address is locked.
+	LockedUntil *time.Time
+}
+
+// HashLoginEmail returns the key lockouts of email are stored under.
+func HashLoginEmail(email string) string {
+	sum := sha256.Sum256([]byte(email))
+	return hex.EncodeToString(sum[:])
+}
+
+// IsLocked reports whether password logins are refused at now.
+func (l *LoginLockout) IsLocked(now time.Time) bool {
+	return l.LockedUntil != nil && now.Before(*l.LockedUntil)
+}
+
+// RecordFailure counts a failed login at now and locks the address for
+// lockFor once maxAttempts consecutive failures are reached. The count
+// starts over after a lock expires.
+func (l *LoginLockout) RecordFailure(maxAttempts int, lockFor time.Duration, now time.Time) {
+	if l.LockedUntil != nil && !l.IsLocked(now) {
+		l.FailedAttempts = 0
+		l.LockedUntil = nil
+	}
+	l.FailedAttempts++
+	if l.FailedAttempts >= maxAttempts {
+		until := now.Add(lockFor)
+		l.LockedUntil = &until
+	}
+}
+
+type LoginLockoutRepository interface {
+	// Get returns the lockout of an address, with no failures if none were
+	// recorded.
+	Get(ctx context.Context, emailHash string) (*LoginLockout, error)
+	// RecordFailure applies LoginLockout.RecordFailure to the stored
+	// lockout atomically and returns the result.
+	RecordFailure(ctx context.Context, emailHash string, maxAttempts int, lockFor time.Duration, now time.Time) (*LoginLockout, error)
+	// Reset clears the failures and lock of an address.
+	Reset(ctx context.Context, emailHash string) error
+}
@@ -0,0 +1,44 @@
+package domain
+
+import (
+	"testing"
+	"time"
+)
+
+func TestLoginLockout_RecordFailure(t *testing.T) {
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	l := &LoginLockout{EmailHash: HashLoginEmail("test@example.com")}
+
+	for i := 0; i < 2; i++ {
+		l.RecordFailure(3, 15*time.Minute, now)
+		if l.IsLocked(now) {
+			t.Fatalf("locked after %d failures, want 3", l.FailedAttempts)
+		}
+	}
+	l.RecordFailure(3, 15*time.Minute, now)
+	if !l.IsLocked(now) {
+		t.Fatal("not locked after 3 failures")
+	}
+	if !l.IsLocked(now.Add(15*time.Minute - time.Second)) {
+		t.Error("lock ended early")
+	}
+	if l.IsLocked(now.Add(15 * time.Minute)) {
+		t.Error("lock did not end after its duration")
+	}
+
+	// The count starts over once the lock has expired.
+	later := now.Add(time.Hour)
+	l.RecordFailure(3, 15*time.Minute, later)
+	if l.FailedAttempts != 1 || l.IsLocked(later) {
+		t.Errorf("after expiry: failed attempts = %d, locked = %v, want 1, false", l.FailedAttempts, l.IsLocked(later))
+	}
+}
+
+func TestHashLoginEmail(t *testing.T) {
+	if HashLoginEmail("a@example.com") == HashLoginEmail("b@example.com") {
+		t.Error("different addresses hash to the same key")
+	}
+	if got := len(HashLoginEmail("a@example.com")); got != 64 {
+		t.Errorf("hash length = %d, want 64", got)
+	}
+}
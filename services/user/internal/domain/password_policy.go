@@ -0,0 +1,86 @@
+package domain
+
+import (
+	"fmt"
+	"unicode"
+	"unicode/utf8"
+)
+
+// MaxPasswordCharClasses is the number of character classes a password can
+// mix: lowercase and uppercase letters, digits and other characters.
+const MaxPasswordCharClasses = 4
+
+// PasswordPolicy is the strength new passwords must have.
+type PasswordPolicy struct {
+	// MinLength is counted in characters, not bytes.
+	MinLength int
+	// MinCharClasses is how many character classes a password must mix,
+	// from 1 to MaxPasswordCharClasses.
+	MinCharClasses int
+}
+
+// DefaultPasswordPolicy only requires MinPasswordLength characters.
+func DefaultPasswordPolicy() PasswordPolicy {
+	return PasswordPolicy{MinLength: MinPasswordLength, MinCharClasses: 1}
+}
+
+// PasswordTooShortError reports the length a password fell short of. It
+// matches ErrPasswordTooShort.
+type PasswordTooShortError struct {
+	MinLength int
+}
+
+func (e *PasswordTooShortError) Error() string {
+	return fmt.Sprintf("password must be at least %d characters", e.MinLength)
+}
+
+func (e *PasswordTooShortError) Is(target error) bool {
+	return target == ErrPasswordTooShort
+}
+
+// PasswordTooWeakError reports the character classes a password had to
+// mix. It matches ErrPasswordTooWeak.
+type PasswordTooWeakError struct {
+	MinCharClasses int
+}
+
+func (e *PasswordTooWeakError) Error() string {
+	return fmt.Sprintf("password must mix at least %d of lowercase, uppercase, digits and symbols", e.MinCharClasses)
+}
+
+func (e *PasswordTooWeakError) Is(target error) bool {
+	return target == ErrPasswordTooWeak
+}
+
+// Validate checks password against the policy. It returns ErrEmptyPassword,
+// a *PasswordTooShortError or a *PasswordTooWeakError.
+func (p PasswordPolicy) Validate(password string) error {
+	if password == "" {
+		return ErrEmptyPassword
+	}
+	if utf8.RuneCountInString(password) < p.MinLength {
+		return &PasswordTooShortError{MinLength: p.MinLength}
+	}
+	if passwordCharClasses(password) < p.MinCharClasses {
+		return &PasswordTooWeakError{MinCharClasses: p.MinCharClasses}
+	}
+	return nil
+}
+
+// passwordCharClasses counts the character classes password mixes.
+func passwordCharClasses(password string) int {
+	var lower, upper, digit, other int
+	for _, r := range password {
+		switch {
+		case unicode.IsLower(r):
+			lower = 1
+		case unicode.IsUpper(r):
+			upper = 1
+		case unicode.IsDigit(r):
+			digit = 1
+		default:
+			other = 1
+		}
+	}
+	return lower + upper + digit + other
+}
@@ -0,0 +1,55 @@
+package domain
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestPasswordPolicy_Validate(t *testing.T) {
+	policy := PasswordPolicy{MinLength: 10, MinCharClasses: 3}
+
+	tests := []struct {
+		name     string
+		password string
+		wantErr  error
+	}{
+		{"three classes", "abcdefgh1!", nil},
+		{"all classes", "Abcdefgh1!", nil},
+		{"counts characters, not bytes", "パスワードpass1!", nil},
+		{"empty", "", ErrEmptyPassword},
+		{"too short", "Abc1!", ErrPasswordTooShort},
+		{"two classes", "abcdefgh12", ErrPasswordTooWeak},
+		{"one class", "abcdefghij", ErrPasswordTooWeak},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := policy.Validate(tt.password)
+			if !errors.Is(err, tt.wantErr) || (err == nil) != (tt.wantErr == nil) {
+				t.Errorf("Validate(%q) = %v, want %v", tt.password, err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestPasswordPolicy_ValidateReportsRequirement(t *testing.T) {
+	policy := PasswordPolicy{MinLength: 12, MinCharClasses: 2}
+
+	var short *PasswordTooShortError
+	if err := policy.Validate("short"); !errors.As(err, &short) || short.MinLength != 12 {
+		t.Errorf("Validate(short) = %v, want *PasswordTooShortError with MinLength 12", err)
+	}
+	var weak *PasswordTooWeakError
+	if err := policy.Validate("onlylowercase"); !errors.As(err, &weak) || weak.MinCharClasses != 2 {
+		t.Errorf("Validate(onlylowercase) = %v, want *PasswordTooWeakError with MinCharClasses 2", err)
+	}
+}
+
+func TestDefaultPasswordPolicy(t *testing.T) {
+	policy := DefaultPasswordPolicy()
+	if err := policy.Validate("12345678"); err != nil {
+		t.Errorf("Validate(12345678) = %v, want nil", err)
+	}
+	if err := policy.Validate("1234567"); !errors.Is(err, ErrPasswordTooShort) {
+		t.Errorf("Validate(1234567) = %v, want ErrPasswordTooShort", err)
+	}
+}
@@ -0,0 +1,35 @@
+package domain
+
+import "sort"
+
+// Role is a named set of permissions assigned to users.
+type Role struct {
+	Name        string
+	Permissions []string
+}
+
+// RoleNames returns the names of the given roles.
+func RoleNames(roles []*Role) []string {
+	names := make([]string, 0, len(roles))
+	for _, r := range roles {
+		names = append(names, r.Name)
+	}
+	return names
+}
+
+// EffectivePermissions returns the sorted, de-duplicated union of role permissions.
+func EffectivePermissions(roles []*Role) []string {
+	seen := make(map[string]struct{})
+	perms := []string{}
+	for _, r := range roles {
+		for _, p := range r.Permissions {
+			if _, ok := seen[p]; ok {
+				continue
+			}
+			seen[p] = struct{}{}
+			perms = append(perms, p)
+		}
+	}
+	sort.Strings(perms)
+	return perms
+}
@@ -0,0 +1,36 @@
+package domain
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestEffectivePermissions(t *testing.T) {
+	roles := []*Role{
+		{Name: "support", Permissions: []string{"users:read", "users:list"}},
+		{Name: "admin", Permissions: []string{"users:delete", "users:read"}},
+	}
+
+	got := EffectivePermissions(roles)
+	want := []string{"users:delete", "users:list", "users:read"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("EffectivePermissions() = %v, want %v", got, want)
+	}
+}
+
+func TestEffectivePermissions_NoRoles(t *testing.T) {
+	got := EffectivePermissions(nil)
+	if got == nil || len(got) != 0 {
+		t.Errorf("EffectivePermissions(nil) = %#v, want empty non-nil slice", got)
+	}
+}
+
+func TestRoleNames(t *testing.T) {
+	roles := []*Role{{Name: "admin"}, {Name: "support"}}
+
+	got := RoleNames(roles)
+	want := []string{"admin", "support"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("RoleNames() = %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,133 @@
+package domain
+
+import (
+	"slices"
+	"time"
+)
+
+// Session is a login session at the authorization server: one browser or
+// device a user signed in on.
+type Session struct {
+	ID        string
+	UserAgent string
+	// AuthenticatedAt is zero if the session predates recording it.
+	AuthenticatedAt time.Time
+	LastUsedAt      time.Time
+	Clients         []SessionClient
+}
+
+// SessionClient is an OAuth2 client the user signed in to in a session.
+type SessionClient struct {
+	ClientID   string
+	ClientName string
+	Scopes     []string
+}
+
+// SessionGrant is one consent a user gave a client within a login session,
+// as reported by the authorization server.
+type SessionGrant struct {
+	SessionID  string
+	ClientID   string
+	ClientName string
+	Scopes     []string
+	// UserAgent and AuthenticatedAt are recorded when the user signs in.
+	UserAgent       string
+	AuthenticatedAt time.Time
+	GrantedAt       time.Time
+}
+
+// SessionsFromGrants groups grants into sessions, most recently used first.
+// Grants outside a login session are skipped.
+func SessionsFromGrants(grants []SessionGrant) []*Session {
+	byID := make(map[string]*Session)
+	var sessions []*Session
+	for _, g := range grants {
+		if g.SessionID == "" {
+			continue
+		}
+		s, ok := byID[g.SessionID]
+		if !ok {
+			s = &Session{ID: g.SessionID}
+			byID[g.SessionID] = s
+			sessions = append(sessions, s)
+		}
+		if s.UserAgent == "" {
+			s.UserAgent = g.UserAgent
+		}
+		if s.AuthenticatedAt.IsZero() {
+			s.AuthenticatedAt = g.AuthenticatedAt
+		}
+		if g.GrantedAt.After(s.LastUsedAt) {
+			s.LastUsedAt = g.GrantedAt
+		}
+
+		i := slices.IndexFunc(s.Clients, func(c SessionClient) bool { return c.ClientID == g.ClientID })
+		if i < 0 {
+			s.Clients = append(s.Clients, SessionClient{ClientID: g.ClientID, ClientName: g.ClientName})
+			i = len(s.Clients) - 1
+		}
+		for _, scope := range g.Scopes {
+			if !slices.Contains(s.Clients[i].Scopes, scope) {
+				s.Clients[i].Scopes = append(s.Clients[i].Scopes, scope)
+			}
+		}
+	}
+
+	slices.SortStableFunc(sessions, func(a, b *Session) int {
+		return b.LastUsedAt.Compare(a.LastUsedAt)
+	})
+	return sessions
+}
+
+// ConnectedApp is an OAuth2 client a user has granted access to, across all
+// of their login sessions.
+type ConnectedApp struct {
+	ClientID   string
+	ClientName string
+	// Scopes is the union of the scopes granted to the client.
+	Scopes         []string
+	FirstGrantedAt time.Time
+	LastGrantedAt  time.Time
+	// Sessions is the number of login sessions the client was used in.
+	Sessions int
+}
+
+// ConnectedAppsFromGrants groups grants by client, most recently granted
+// first. Unlike SessionsFromGrants it keeps grants made outside a login
+// session, which still hold tokens.
+func ConnectedAppsFromGrants(grants []SessionGrant) []*ConnectedApp {
+	byID := make(map[string]*ConnectedApp)
+	sessions := make(map[string][]string)
+	var apps []*ConnectedApp
+	for _, g := range grants {
+		app, ok := byID[g.ClientID]
+		if !ok {
+			app = &ConnectedApp{ClientID: g.ClientID, FirstGrantedAt: g.GrantedAt}
+			byID[g.ClientID] = app
+			apps = append(apps, app)
+		}
+		if app.ClientName == "" {
+			app.ClientName = g.ClientName
+		}
+		if g.GrantedAt.Before(app.FirstGrantedAt) {
+			app.FirstGrantedAt = g.GrantedAt
+		}
+		if g.GrantedAt.After(app.LastGrantedAt) {
+			app.LastGrantedAt = g.GrantedAt
+		}
+		if g.SessionID != "" && !slices.Contains(sessions[g.ClientID], g.SessionID) {
+			sessions[g.ClientID] = append(sessions[g.ClientID], g.SessionID)
+			app.Sessions++
+		}
+		for _, scope := range g.Scopes {
+			if !slices.Contains(app.Scopes, scope) {
+				app.Scopes = append(app.Scopes, scope)
+			}
+		}
+	}
+
+	slices.SortStableFunc(apps, func(a, b *ConnectedApp) int {
+		return b.LastGrantedAt.Compare(a.LastGrantedAt)
+	})
+	return apps
+}
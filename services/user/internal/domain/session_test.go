@@ -0,0 +1,55 @@
+package domain
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestSessionsFromGrants(t *testing.T) {
+	login := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	grants := []SessionGrant{
+		{SessionID: "laptop", ClientID: "spa", Scopes: []string{"openid"}, UserAgent: "Firefox", AuthenticatedAt: login, GrantedAt: login},
+		{SessionID: "phone", ClientID: "spa", Scopes: []string{"openid"}, GrantedAt: login.Add(time.Minute)},
+		// A later consent in the same session, after a skipped login.
+		{SessionID: "laptop", ClientID: "spa", Scopes: []string{"openid", "email"}, GrantedAt: login.Add(time.Hour)},
+		{ClientID: "cli", GrantedAt: login},
+	}
+
+	got := SessionsFromGrants(grants)
+	if len(got) != 2 {
+		t.Fatalf("SessionsFromGrants() = %d sessions, want 2", len(got))
+	}
+
+	laptop := got[0]
+	if laptop.ID != "laptop" || !laptop.LastUsedAt.Equal(login.Add(time.Hour)) {
+		t.Errorf("first session = %s last used %v, want laptop last used %v", laptop.ID, laptop.LastUsedAt, login.Add(time.Hour))
+	}
+	if laptop.UserAgent != "Firefox" || !laptop.AuthenticatedAt.Equal(login) {
+		t.Errorf("laptop user agent = %q, authenticated at %v", laptop.UserAgent, laptop.AuthenticatedAt)
+	}
+	wantClients := []SessionClient{{ClientID: "spa", Scopes: []string{"openid", "email"}}}
+	if !reflect.DeepEqual(laptop.Clients, wantClients) {
+		t.Errorf("laptop clients = %+v, want %+v", laptop.Clients, wantClients)
+	}
+}
+
+func TestConnectedAppsFromGrants(t *testing.T) {
+	login := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	grants := []SessionGrant{
+		{SessionID: "laptop", ClientID: "spa", ClientName: "Storefront", Scopes: []string{"openid"}, GrantedAt: login},
+		{SessionID: "phone", ClientID: "spa", Scopes: []string{"openid", "email"}, GrantedAt: login.Add(time.Hour)},
+		{SessionID: "laptop", ClientID: "spa", Scopes: []string{"openid"}, GrantedAt: login.Add(time.Minute)},
+		// Grants outside a login session still hold tokens.
+		{ClientID: "cli", Scopes: []string{"offline_access"}, GrantedAt: login.Add(2 * time.Hour)},
+	}
+
+	got := ConnectedAppsFromGrants(grants)
+	want := []*ConnectedApp{
+		{ClientID: "cli", Scopes: []string{"offline_access"}, FirstGrantedAt: login.Add(2 * time.Hour), LastGrantedAt: login.Add(2 * time.Hour)},
+		{ClientID: "spa", ClientName: "Storefront", Scopes: []string{"openid", "email"}, FirstGrantedAt: login, LastGrantedAt: login.Add(time.Hour), Sessions: 2},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("ConnectedAppsFromGrants() = %+v, want %+v", got, want)
+	}
+}
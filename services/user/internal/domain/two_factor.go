@@ -0,0 +1,178 @@
+package domain
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base32"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	// TOTP parameters (RFC 6238) understood by common authenticator apps.
+	TOTPDigits = 6
+	TOTPPeriod = 30 * time.Second
+
+	// RecoveryCodeCount is the number of single-use recovery codes issued
+	// when two-factor authentication is enabled.
+	RecoveryCodeCount = 10
+
+	totpSecretBytes   = 20 // 160 bits, as recommended for HMAC-SHA1
+	totpSkew          = 1  // steps accepted either side of the current one
+	recoveryCodeBytes = 5  // 8 base32 characters
+	totpModulus       = 1_000_000
+)
+
+var base32NoPadding = base32.StdEncoding.WithPadding(base32.NoPadding)
+
+// TwoFactor is a user's TOTP enrollment. It only guards sign-in once it is
+// enabled by a first valid code, which proves the authenticator app holds
+// the secret.
+type TwoFactor struct {
+	UserID uuid.UUID
+	Secret []byte
+	// EnabledAt is nil while the enrollment awaits its first code.
+	EnabledAt *time.Time
+	// LastUsedStep is the time step of the last accepted code. Codes of that
+	// step or earlier are rejected, so an observed code cannot be replayed.
+	LastUsedStep int64
+	// RecoveryCodeHashes are the SHA-256 hashes of the unused recovery codes.
+	RecoveryCodeHashes []string
+	CreatedAt          time.Time
+}
+
+type TwoFactorRepository interface {
+	// Get returns ErrTwoFactorNotEnrolled if the user has no enrollment.
+	Get(ctx context.Context, userID uuid.UUID) (*TwoFactor, error)
+	// SavePending stores a new enrollment, replacing a pending one.
+	// Returns ErrTwoFactorAlreadyEnabled if the user's enrollment is enabled.
+	SavePending(ctx context.Context, tf *TwoFactor) error
+	// Enable enables a pending enrollment with the step of its first code.
+	// Returns ErrTwoFactorAlreadyEnabled if it is not pending.
+	Enable(ctx context.Context, userID uuid.UUID, step int64, recoveryCodeHashes []string, enabledAt time.Time) error
+	// UseStep records the step of an accepted code. Returns ErrInvalidTOTPCode
+	// if a code of that step or a later one was already used.
+	UseStep(ctx context.Context, userID uuid.UUID, step int64) error
+	// UseRecoveryCode removes a recovery code. Returns ErrInvalidTOTPCode if
+	// the code is not among the unused ones.
+	UseRecoveryCode(ctx context.Context, userID uuid.UUID, codeHash string) error
+	Delete(ctx context.Context, userID uuid.UUID) error
+}
+
+// NewTwoFactor creates a pending enrollment with a random secret.
+func NewTwoFactor(userID uuid.UUID) (*TwoFactor, error) {
+	secret := make([]byte, totpSecretBytes)
+	if _, err := rand.Read(secret); err != nil {
+		return nil, err
+	}
+	return &TwoFactor{
+		UserID:    userID,
+		Secret:    secret,
+		CreatedAt: time.Now().UTC(),
+	}, nil
+}
+
+func (t *TwoFactor) Enabled() bool {
+	return t.EnabledAt != nil
+}
+
+// EncodedSecret is the secret as entered into an authenticator app.
+func (t *TwoFactor) EncodedSecret() string {
+	return base32NoPadding.EncodeToString(t.Secret)
+}
+
+// ProvisioningURI is the otpauth:// URI authenticator apps import, usually
+// from a QR code.
+func (t *TwoFactor) ProvisioningURI(issuer, account string) string {
+	q := url.Values{}
+	q.Set("secret", t.EncodedSecret())
+	q.Set("issuer", issuer)
+	q.Set("algorithm", "SHA1")
+	q.Set("digits", fmt.Sprint(TOTPDigits))
+	q.Set("period", fmt.Sprint(int(TOTPPeriod/time.Second)))
+	label := url.PathEscape(issuer) + ":" + url.PathEscape(account)
+	return "otpauth://totp/" + label + "?" + q.Encode()
+}
+
+// MatchCode returns the time step of code if it is valid at now, allowing
+// one step of clock drift, and newer than LastUsedStep.
+func (t *TwoFactor) MatchCode(code string, now time.Time) (int64, bool) {
+	code = strings.TrimSpace(code)
+	if len(code) != TOTPDigits {
+		return 0, false
+	}
+	current := now.Unix() / int64(TOTPPeriod/time.Second)
+	for step := current - totpSkew; step <= current+totpSkew; step++ {
+		if step <= t.LastUsedStep {
+			continue
+		}
+		if subtle.ConstantTimeCompare([]byte(TOTPCode(t.Secret, step)), []byte(code)) == 1 {
+			return step, true
+		}
+	}
+	return 0, false
+}
+
+// HasRecoveryCode reports whether code is one of the unused recovery codes.
+func (t *TwoFactor) HasRecoveryCode(code string) bool {
+	hash := HashRecoveryCode(code)
+	for _, h := range t.RecoveryCodeHashes {
+		if subtle.ConstantTimeCompare([]byte(h), []byte(hash)) == 1 {
+			return true
+		}
+	}
+	return false
+}
+
+// TOTPCode computes the code of a time step (RFC 6238 with HMAC-SHA1).
+func TOTPCode(secret []byte, step int64) string {
+	var msg [8]byte
+	binary.BigEndian.PutUint64(msg[:], uint64(step))
+	mac := hmac.New(sha1.New, secret)
+	mac.Write(msg[:])
+	sum := mac.Sum(nil)
+
+	offset := sum[len(sum)-1] & 0x0f
+	value := binary.BigEndian.Uint32(sum[offset:offset+4]) & 0x7fffffff
+	return fmt.Sprintf("%0*d", TOTPDigits, value%totpModulus)
+}
+
+// NewRecoveryCodes generates recovery codes formatted as "xxxx-xxxx" and
+// the hashes to persist.
+func NewRecoveryCodes() (codes, hashes []string, err error) {
+	codes = make([]string, RecoveryCodeCount)
+	hashes = make([]string, RecoveryCodeCount)
+	buf := make([]byte, recoveryCodeBytes)
+	for i := range codes {
+		if _, err := rand.Read(buf); err != nil {
+			return nil, nil, err
+		}
+		raw := strings.ToLower(base32NoPadding.EncodeToString(buf))
+		codes[i] = raw[:4] + "-" + raw[4:]
+		hashes[i] = HashRecoveryCode(codes[i])
+	}
+	return codes, hashes, nil
+}
+
+// HashRecoveryCode returns the hex-encoded SHA-256 hash of a recovery code,
+// ignoring case, spaces and hyphens.
+func HashRecoveryCode(code string) string {
+	normalized := strings.Map(func(r rune) rune {
+		if r == '-' || r == ' ' {
+			return -1
+		}
+		return r
+	}, strings.ToLower(strings.TrimSpace(code)))
+	sum := sha256.Sum256([]byte(normalized))
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,85 @@
+package domain
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+func TestTOTPCode(t *testing.T) {
+	// RFC 6238 appendix B, SHA-1, truncated to 6 digits.
+	secret := []byte("12345678901234567890")
+	tests := []struct {
+		unix int64
+		want string
+	}{
+		{59, "287082"},
+		{1111111109, "081804"},
+		{1234567890, "005924"},
+		{2000000000, "279037"},
+	}
+	for _, tt := range tests {
+		if got := TOTPCode(secret, tt.unix/30); got != tt.want {
+			t.Errorf("TOTPCode(T=%d) = %s, want %s", tt.unix, got, tt.want)
+		}
+	}
+}
+
+func TestTwoFactor_MatchCode(t *testing.T) {
+	tf := &TwoFactor{UserID: uuid.New(), Secret: []byte("12345678901234567890")}
+	now := time.Unix(1111111109, 0)
+	step := now.Unix() / 30
+
+	if got, ok := tf.MatchCode(TOTPCode(tf.Secret, step-1), now); !ok || got != step-1 {
+		t.Errorf("MatchCode(previous step) = %d, %v; want %d, true", got, ok, step-1)
+	}
+	if _, ok := tf.MatchCode(TOTPCode(tf.Secret, step-2), now); ok {
+		t.Error("MatchCode accepted a code two steps old")
+	}
+	if _, ok := tf.MatchCode("12345", now); ok {
+		t.Error("MatchCode accepted a short code")
+	}
+
+	tf.LastUsedStep = step
+	if _, ok := tf.MatchCode(TOTPCode(tf.Secret, step), now); ok {
+		t.Error("MatchCode accepted a code of an already used step")
+	}
+	if got, ok := tf.MatchCode(TOTPCode(tf.Secret, step+1), now); !ok || got != step+1 {
+		t.Errorf("MatchCode(next step) = %d, %v; want %d, true", got, ok, step+1)
+	}
+}
+
+func TestTwoFactor_ProvisioningURI(t *testing.T) {
+	tf := &TwoFactor{Secret: []byte("12345678901234567890")}
+	uri := tf.ProvisioningURI("EC Platform", "alice@example.com")
+
+	if !strings.HasPrefix(uri, "otpauth://totp/EC%20Platform:alice@example.com?") {
+		t.Errorf("ProvisioningURI() = %s, want otpauth://totp/ label with issuer and account", uri)
+	}
+	if !strings.Contains(uri, "secret=GEZDGNBVGY3TQOJQGEZDGNBVGY3TQOJQ") {
+		t.Errorf("ProvisioningURI() = %s, want base32 secret without padding", uri)
+	}
+}
+
+func TestRecoveryCodes(t *testing.T) {
+	codes, hashes, err := NewRecoveryCodes()
+	if err != nil {
+		t.Fatalf("NewRecoveryCodes() error = %v", err)
+	}
+	if len(codes) != RecoveryCodeCount || len(hashes) != RecoveryCodeCount {
+		t.Fatalf("NewRecoveryCodes() = %d codes, %d hashes, want %d", len(codes), len(hashes), RecoveryCodeCount)
+	}
+
+	tf := &TwoFactor{RecoveryCodeHashes: hashes}
+	code := codes[0]
+	for _, typed := range []string{code, strings.ToUpper(code), strings.ReplaceAll(code, "-", ""), " " + code + " "} {
+		if !tf.HasRecoveryCode(typed) {
+			t.Errorf("HasRecoveryCode(%q) = false, want true", typed)
+		}
+	}
+	if tf.HasRecoveryCode("aaaa-aaaa") {
+		t.Error("HasRecoveryCode accepted an unknown code")
+	}
+}
@@ -21,10 +21,13 @@ type User struct {
 	Email        string
 	PasswordHash string
 	Name         *string
-	IsDeleted    bool
-	DeletedAt    *time.Time
-	CreatedAt    time.Time
-	UpdatedAt    time.Time
+	// EmailVerified reports whether the user has proven ownership of Email.
+	EmailVerified   bool
+	EmailVerifiedAt *time.Time
+	IsDeleted       bool
+	DeletedAt       *time.Time
+	CreatedAt       time.Time
+	UpdatedAt       time.Time
 }
 
 type UserRepository interface {
@@ -33,6 +36,12 @@ type UserRepository interface {
 	FindByEmail(ctx context.Context, email string) (*User, error)
 	Update(ctx context.Context, user *User) error
 	SoftDelete(ctx context.Context, id uuid.UUID) error
+	MarkEmailVerified(ctx context.Context, id uuid.UUID, verifiedAt time.Time) error
+	UpdatePasswordHash(ctx context.Context, id uuid.UUID, passwordHash string) error
+	// List returns users matching the filter and the token for the next page.
+	List(ctx context.Context, filter UserFilter, page Pagination) ([]*User, string, error)
+	// FindRoles returns the roles assigned to the user, ordered by name.
+	FindRoles(ctx context.Context, userID uuid.UUID) ([]*Role, error)
 }
 
 func ValidateEmail(email string) error {
@@ -75,3 +84,9 @@ func NewUser(email, passwordHash string, name *string) *User {
 		UpdatedAt:    now,
 	}
 }
+
+// MarkEmailVerified records that the user has proven ownership of their email.
+func (u *User) MarkEmailVerified(at time.Time) {
+	u.EmailVerified = true
+	u.EmailVerifiedAt = &at
+}
@@ -0,0 +1,65 @@
+package domain
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	DefaultPageSize = 20
+	MaxPageSize     = 100
+)
+
+// UserFilter narrows the set of users returned by a listing.
+type UserFilter struct {
+	EmailContains  *string
+	CreatedAfter   *time.Time
+	CreatedBefore  *time.Time
+	IncludeDeleted bool
+}
+
+// Pagination holds keyset pagination parameters.
+type Pagination struct {
+	PageSize  int
+	PageToken string
+}
+
+// NormalizePageSize clamps a requested page size to [1, MaxPageSize].
+func NormalizePageSize(size int) int {
+	if size <= 0 {
+		return DefaultPageSize
+	}
+	if size > MaxPageSize {
+		return MaxPageSize
+	}
+	return size
+}
+
+// UserCursor identifies the last user of a page in (created_at DESC, id DESC) order.
+type UserCursor struct {
+	CreatedAt time.Time `json:"c"`
+	ID        uuid.UUID `json:"i"`
+}
+
+// Encode returns the opaque page token for the cursor.
+func (c UserCursor) Encode() string {
+	b, _ := json.Marshal(c)
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+// ParseUserCursor decodes a page token produced by UserCursor.Encode.
+// Returns ErrInvalidPageToken if the token is malformed.
+func ParseUserCursor(token string) (*UserCursor, error) {
+	b, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, ErrInvalidPageToken
+	}
+	var c UserCursor
+	if err := json.Unmarshal(b, &c); err != nil || c.ID == uuid.Nil {
+		return nil, ErrInvalidPageToken
+	}
+	return &c, nil
+}
@@ -0,0 +1,66 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+func TestNormalizePageSize(t *testing.T) {
+	tests := []struct {
+		name string
+		size int
+		want int
+	}{
+		{name: "zero uses default", size: 0, want: DefaultPageSize},
+		{name: "negative uses default", size: -5, want: DefaultPageSize},
+		{name: "within range", size: 50, want: 50},
+		{name: "clamped to max", size: 1000, want: MaxPageSize},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := NormalizePageSize(tt.size); got != tt.want {
+				t.Errorf("NormalizePageSize(%d) = %d, want %d", tt.size, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestUserCursor_RoundTrip(t *testing.T) {
+	cursor := UserCursor{
+		CreatedAt: time.Date(2024, 1, 2, 3, 4, 5, 6000, time.UTC),
+		ID:        uuid.New(),
+	}
+
+	got, err := ParseUserCursor(cursor.Encode())
+	if err != nil {
+		t.Fatalf("ParseUserCursor() error = %v", err)
+	}
+	if !got.CreatedAt.Equal(cursor.CreatedAt) {
+		t.Errorf("CreatedAt = %v, want %v", got.CreatedAt, cursor.CreatedAt)
+	}
+	if got.ID != cursor.ID {
+		t.Errorf("ID = %v, want %v", got.ID, cursor.ID)
+	}
+}
+
+func TestParseUserCursor_Invalid(t *testing.T) {
+	tests := []struct {
+		name  string
+		token string
+	}{
+		{name: "not base64", token: "!!!"},
+		{name: "not json", token: "bm90LWpzb24"},
+		{name: "missing id", token: "e30"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := ParseUserCursor(tt.token); err != ErrInvalidPageToken {
+				t.Errorf("ParseUserCursor(%q) error = %v, want %v", tt.token, err, ErrInvalidPageToken)
+			}
+		})
+	}
+}
@@ -0,0 +1,32 @@
+package domain
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// PurgeMode selects how soft-deleted users are purged after retention.
+type PurgeMode string
+
+const (
+	// PurgeAnonymize keeps the row (and references to its ID) but erases
+	// personal data.
+	PurgeAnonymize PurgeMode = "anonymize"
+	// PurgeDelete removes the row; dependent rows are removed by cascade.
+	PurgeDelete PurgeMode = "delete"
+)
+
+// UserPurgeRepository permanently removes personal data of soft-deleted users.
+type UserPurgeRepository interface {
+	// FindPurgeable returns IDs of users soft-deleted before cutoff that have
+	// not been purged yet, oldest deletion first.
+	FindPurgeable(ctx context.Context, cutoff time.Time, limit int) ([]uuid.UUID, error)
+	// Anonymize erases personal data of a soft-deleted user.
+	// Returns ErrUserNotFound if the user is not soft-deleted or already purged.
+	Anonymize(ctx context.Context, id uuid.UUID) error
+	// HardDelete removes a soft-deleted user.
+	// Returns ErrUserNotFound if the user is not soft-deleted.
+	HardDelete(ctx context.Context, id uuid.UUID) error
+}
@@ -0,0 +1,103 @@
+package passwordhash
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+// testArgon2idParams keep the tests fast.
+var testArgon2idParams = Argon2idParams{Memory: 64, Iterations: 1, Parallelism: 1}
+
+func TestHashers_RoundTrip(t *testing.T) {
+	hashers := map[string]Hasher{
+		"bcrypt":   NewBcrypt(4),
+		"argon2id": NewArgon2id(testArgon2idParams),
+	}
+	for name, h := range hashers {
+		t.Run(name, func(t *testing.T) {
+			hash, err := h.Hash("password123")
+			if err != nil {
+				t.Fatalf("Hash() error = %v", err)
+			}
+			if err := Compare(hash, "password123"); err != nil {
+				t.Errorf("Compare(correct) error = %v", err)
+			}
+			if err := Compare(hash, "wrongpassword"); !errors.Is(err, ErrMismatch) {
+				t.Errorf("Compare(wrong) error = %v, want ErrMismatch", err)
+			}
+			if h.NeedsRehash(hash) {
+				t.Error("NeedsRehash() = true for a hash of the same hasher")
+			}
+
+			other, _ := h.Hash("password123")
+			if other == hash {
+				t.Error("Hash() is not salted")
+			}
+		})
+	}
+}
+
+func TestArgon2id_Format(t *testing.T) {
+	hash, err := NewArgon2id(testArgon2idParams).Hash("password123")
+	if err != nil {
+		t.Fatalf("Hash() error = %v", err)
+	}
+	if !strings.HasPrefix(hash, "$argon2id$v=19$m=64,t=1,p=1$") {
+		t.Errorf("Hash() = %q, want PHC format with the parameters", hash)
+	}
+}
+
+func TestNeedsRehash(t *testing.T) {
+	bcrypt4, _ := NewBcrypt(4).Hash("password123")
+	bcrypt5, _ := NewBcrypt(5).Hash("password123")
+	argon, _ := NewArgon2id(testArgon2idParams).Hash("password123")
+
+	tests := []struct {
+		name   string
+		hasher Hasher
+		hash   string
+		want   bool
+	}{
+		{"bcrypt of a lower cost", NewBcrypt(5), bcrypt4, true},
+		{"bcrypt of a higher cost", NewBcrypt(4), bcrypt5, false},
+		{"argon2id hash for bcrypt", NewBcrypt(4), argon, true},
+		{"bcrypt hash for argon2id", NewArgon2id(testArgon2idParams), bcrypt4, true},
+		{"argon2id with other parameters", NewArgon2id(Argon2idParams{Memory: 128, Iterations: 1, Parallelism: 1}), argon, true},
+		{"malformed", NewArgon2id(testArgon2idParams), "$argon2id$v=19$m=64", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.hasher.NeedsRehash(tt.hash); got != tt.want {
+				t.Errorf("NeedsRehash() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCompare_InvalidHash(t *testing.T) {
+	for _, hash := range []string{
+		"",
+		"plaintext",
+		"$argon2id$v=19$m=64,t=1,p=1$c2FsdA",
+		"$argon2id$v=18$m=64,t=1,p=1$c2FsdA$a2V5",
+		"$argon2id$v=19$m=0,t=1,p=1$c2FsdA$a2V5",
+		"$argon2id$v=19$m=64,t=1,p=1$c2FsdA$!!",
+	} {
+		if err := Compare(hash, "password123"); !errors.Is(err, ErrInvalidHash) {
+			t.Errorf("Compare(%q) error = %v, want ErrInvalidHash", hash, err)
+		}
+	}
+}
+
+func TestNew(t *testing.T) {
+	if h, err := New(AlgorithmBcrypt, 4, testArgon2idParams); err != nil || h == nil {
+		t.Errorf("New(bcrypt) = %v, %v", h, err)
+	}
+	if h, err := New(AlgorithmArgon2id, 4, testArgon2idParams); err != nil || h == nil {
+		t.Errorf("New(argon2id) = %v, %v", h, err)
+	}
+	if _, err := New("md5", 4, testArgon2idParams); err == nil {
+		t.Error("New(md5) error = nil, want error")
+	}
+}
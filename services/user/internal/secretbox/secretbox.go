@@ -0,0 +1,61 @@
+// Package secretbox encrypts small values such as TOTP secrets with
+// AES-256-GCM. Keys are derived from a configured secret and a purpose, so
+// one secret can protect several kinds of values without a ciphertext of
+// one kind being accepted as another.
+package secretbox
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"errors"
+)
+
+var ErrInvalidCiphertext = errors.New("ciphertext is invalid or was sealed with another key")
+
+type Box struct {
+	aead cipher.AEAD
+}
+
+// New creates a box keyed by secret and purpose (e.g. "totp-secret").
+func New(secret, purpose string) (*Box, error) {
+	if secret == "" {
+		return nil, errors.New("secretbox secret is empty")
+	}
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(purpose))
+	block, err := aes.NewCipher(mac.Sum(nil))
+	if err != nil {
+		return nil, err
+	}
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, err
+	}
+	return &Box{aead: aead}, nil
+}
+
+// Seal encrypts plaintext under a random nonce, which is prepended to the
+// result.
+func (b *Box) Seal(plaintext []byte) ([]byte, error) {
+	nonce := make([]byte, b.aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, err
+	}
+	return b.aead.Seal(nonce, nonce, plaintext, nil), nil
+}
+
+// Open decrypts a value sealed by Seal.
+func (b *Box) Open(ciphertext []byte) ([]byte, error) {
+	if len(ciphertext) < b.aead.NonceSize() {
+		return nil, ErrInvalidCiphertext
+	}
+	nonce, sealed := ciphertext[:b.aead.NonceSize()], ciphertext[b.aead.NonceSize():]
+	plaintext, err := b.aead.Open(nil, nonce, sealed, nil)
+	if err != nil {
+		return nil, ErrInvalidCiphertext
+	}
+	return plaintext, nil
+}
@@ -0,0 +1,73 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/daisuke8000/example-ec-platform/services/user/internal/domain"
+)
+
+// AccessGrantUseCase manages time-boxed permissions delegated to users.
+// Which permissions a caller may grant is decided by the BFF.
+type AccessGrantUseCase interface {
+	GrantAccess(ctx context.Context, input GrantAccessInput) (*domain.AccessGrant, error)
+	RevokeAccessGrant(ctx context.Context, id uuid.UUID, revokedBy string) (*domain.AccessGrant, error)
+	// ListAccessGrants returns the user's grants, newest first, optionally
+	// only those in effect now.
+	ListAccessGrants(ctx context.Context, granteeID uuid.UUID, activeOnly bool) ([]*domain.AccessGrant, error)
+}
+
+type GrantAccessInput struct {
+	GranteeID  uuid.UUID
+	Permission string
+	Reason     string
+	Duration   time.Duration
+	GrantedBy  string
+}
+
+type accessGrantUseCase struct {
+	repo     domain.AccessGrantRepository
+	userRepo domain.UserRepository
+}
+
+// NewAccessGrantUseCase creates the access grant use case.
+func NewAccessGrantUseCase(repo domain.AccessGrantRepository, userRepo domain.UserRepository) AccessGrantUseCase {
+	return &accessGrantUseCase{
+		repo:     repo,
+		userRepo: userRepo,
+	}
+}
+
+func (uc *accessGrantUseCase) GrantAccess(ctx context.Context, input GrantAccessInput) (*domain.AccessGrant, error) {
+	grant, err := domain.NewAccessGrant(input.GranteeID, input.Permission, input.Reason, input.GrantedBy, input.Duration)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := uc.userRepo.FindByID(ctx, input.GranteeID); err != nil {
+		return nil, err
+	}
+
+	if err := uc.repo.Create(ctx, grant); err != nil {
+		return nil, err
+	}
+	return grant, nil
+}
+
+func (uc *accessGrantUseCase) RevokeAccessGrant(ctx context.Context, id uuid.UUID, revokedBy string) (*domain.AccessGrant, error) {
+	if err := uc.repo.Revoke(ctx, id, revokedBy, time.Now().UTC()); err != nil {
+		return nil, err
+	}
+	return uc.repo.FindByID(ctx, id)
+}
+
+func (uc *accessGrantUseCase) ListAccessGrants(ctx context.Context, granteeID uuid.UUID, activeOnly bool) ([]*domain.AccessGrant, error) {
+	var activeAt *time.Time
+	if activeOnly {
+		now := time.Now().UTC()
+		activeAt = &now
+	}
+	return uc.repo.ListByGrantee(ctx, granteeID, activeAt)
+}
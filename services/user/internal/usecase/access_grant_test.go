@@ -0,0 +1,160 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/daisuke8000/example-ec-platform/services/user/internal/domain"
+)
+
+// mockAccessGrantRepository is an in-memory domain.AccessGrantRepository.
+type mockAccessGrantRepository struct {
+	grants []*domain.AccessGrant
+}
+
+func (m *mockAccessGrantRepository) Create(ctx context.Context, grant *domain.AccessGrant) error {
+	m.grants = append(m.grants, grant)
+	return nil
+}
+
+func (m *mockAccessGrantRepository) FindByID(ctx context.Context, id uuid.UUID) (*domain.AccessGrant, error) {
+	for _, g := range m.grants {
+		if g.ID == id {
+			return g, nil
+		}
+	}
+	return nil, domain.ErrAccessGrantNotFound
+}
+
+func (m *mockAccessGrantRepository) ListByGrantee(ctx context.Context, granteeID uuid.UUID, activeAt *time.Time) ([]*domain.AccessGrant, error) {
+	var out []*domain.AccessGrant
+	for i := len(m.grants) - 1; i >= 0; i-- {
+		g := m.grants[i]
+		if g.GranteeID == granteeID && (activeAt == nil || g.IsActive(*activeAt)) {
+			out = append(out, g)
+		}
+	}
+	return out, nil
+}
+
+func (m *mockAccessGrantRepository) Revoke(ctx context.Context, id uuid.UUID, revokedBy string, revokedAt time.Time) error {
+	for _, g := range m.grants {
+		if g.ID == id && g.RevokedAt == nil {
+			g.RevokedAt = &revokedAt
+			g.RevokedBy = revokedBy
+			return nil
+		}
+	}
+	return domain.ErrAccessGrantNotFound
+}
+
+func TestAccessGrantUseCase_GrantAccess(t *testing.T) {
+	userRepo := newMockUserRepository()
+	agent := &domain.User{ID: uuid.New(), Email: "agent@example.com"}
+	userRepo.users[agent.ID] = agent
+	adminID := uuid.New().String()
+
+	tests := []struct {
+		name    string
+		input   GrantAccessInput
+		wantErr error
+	}{
+		{
+			name:  "grants permission for hours",
+			input: GrantAccessInput{GranteeID: agent.ID, Permission: "users:write", Reason: "ticket 123", Duration: 4 * time.Hour, GrantedBy: adminID},
+		},
+		{
+			name:    "unknown grantee",
+			input:   GrantAccessInput{GranteeID: uuid.New(), Permission: "users:write", Reason: "ticket 123", Duration: time.Hour, GrantedBy: adminID},
+			wantErr: domain.ErrUserNotFound,
+		},
+		{
+			name:    "duration too long",
+			input:   GrantAccessInput{GranteeID: agent.ID, Permission: "users:write", Reason: "ticket 123", Duration: 73 * time.Hour, GrantedBy: adminID},
+			wantErr: domain.ErrInvalidGrantDuration,
+		},
+		{
+			name:    "missing reason",
+			input:   GrantAccessInput{GranteeID: agent.ID, Permission: "users:write", Duration: time.Hour, GrantedBy: adminID},
+			wantErr: domain.ErrInvalidGrantReason,
+		},
+		{
+			name:    "grant permission is not delegable",
+			input:   GrantAccessInput{GranteeID: agent.ID, Permission: domain.PermissionGrantAccess, Reason: "ticket 123", Duration: time.Hour, GrantedBy: adminID},
+			wantErr: domain.ErrPermissionNotGrantable,
+		},
+		{
+			name:    "self grant",
+			input:   GrantAccessInput{GranteeID: agent.ID, Permission: "users:write", Reason: "ticket 123", Duration: time.Hour, GrantedBy: agent.ID.String()},
+			wantErr: domain.ErrSelfGrant,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			uc := NewAccessGrantUseCase(&mockAccessGrantRepository{}, userRepo)
+
+			grant, err := uc.GrantAccess(context.Background(), tt.input)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("GrantAccess() error = %v, want %v", err, tt.wantErr)
+			}
+			if err != nil {
+				return
+			}
+			if got := grant.ExpiresAt.Sub(grant.GrantedAt); got != tt.input.Duration {
+				t.Errorf("grant lasts %v, want %v", got, tt.input.Duration)
+			}
+		})
+	}
+}
+
+func TestAccessGrantUseCase_RevokeAndList(t *testing.T) {
+	userRepo := newMockUserRepository()
+	agent := &domain.User{ID: uuid.New(), Email: "agent@example.com"}
+	userRepo.users[agent.ID] = agent
+	repo := &mockAccessGrantRepository{}
+	uc := NewAccessGrantUseCase(repo, userRepo)
+	ctx := context.Background()
+
+	kept, err := uc.GrantAccess(ctx, GrantAccessInput{GranteeID: agent.ID, Permission: "users:read", Reason: "ticket 1", Duration: time.Hour, GrantedBy: "admin"})
+	if err != nil {
+		t.Fatalf("GrantAccess() error = %v", err)
+	}
+	revoked, err := uc.GrantAccess(ctx, GrantAccessInput{GranteeID: agent.ID, Permission: "users:write", Reason: "ticket 2", Duration: time.Hour, GrantedBy: "admin"})
+	if err != nil {
+		t.Fatalf("GrantAccess() error = %v", err)
+	}
+	expired := &domain.AccessGrant{ID: uuid.New(), GranteeID: agent.ID, Permission: "users:delete", ExpiresAt: time.Now().Add(-time.Minute)}
+	repo.grants = append(repo.grants, expired)
+
+	got, err := uc.RevokeAccessGrant(ctx, revoked.ID, "admin")
+	if err != nil {
+		t.Fatalf("RevokeAccessGrant() error = %v", err)
+	}
+	if got.RevokedAt == nil || got.RevokedBy != "admin" {
+		t.Errorf("RevokeAccessGrant() = %+v, want revoked by admin", got)
+	}
+	if _, err := uc.RevokeAccessGrant(ctx, revoked.ID, "admin"); !errors.Is(err, domain.ErrAccessGrantNotFound) {
+		t.Errorf("second RevokeAccessGrant() error = %v, want %v", err, domain.ErrAccessGrantNotFound)
+	}
+
+	active, err := uc.ListAccessGrants(ctx, agent.ID, true)
+	if err != nil {
+		t.Fatalf("ListAccessGrants() error = %v", err)
+	}
+	if len(active) != 1 || active[0].ID != kept.ID {
+		t.Errorf("ListAccessGrants(active) = %+v, want only %s", active, kept.ID)
+	}
+
+	all, err := uc.ListAccessGrants(ctx, agent.ID, false)
+	if err != nil {
+		t.Fatalf("ListAccessGrants() error = %v", err)
+	}
+	if len(all) != 3 {
+		t.Errorf("ListAccessGrants(all) returned %d grants, want 3", len(all))
+	}
+}
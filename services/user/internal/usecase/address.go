@@ -0,0 +1,112 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/daisuke8000/example-ec-platform/services/user/internal/domain"
+)
+
+// AddressUseCase manages the users' address books.
+type AddressUseCase interface {
+	// AddAddress adds an address. The first address of a user always
+	// becomes the default shipping address.
+	AddAddress(ctx context.Context, input AddAddressInput) (*domain.Address, error)
+	// ListAddresses returns the default shipping address first, then the
+	// others newest first.
+	ListAddresses(ctx context.Context, userID uuid.UUID) ([]*domain.Address, error)
+	SetDefaultShippingAddress(ctx context.Context, userID, addressID uuid.UUID) (*domain.Address, error)
+	// DeleteAddress removes an address. Deleting the default shipping
+	// address leaves the user without one until another is chosen.
+	DeleteAddress(ctx context.Context, userID, addressID uuid.UUID) error
+	// DefaultShippingAddress returns nil if the user has none.
+	DefaultShippingAddress(ctx context.Context, userID uuid.UUID) (*domain.Address, error)
+}
+
+type AddAddressInput struct {
+	UserID uuid.UUID
+	domain.AddressInput
+	DefaultShipping bool
+}
+
+type addressUseCase struct {
+	repo     domain.AddressRepository
+	userRepo domain.UserRepository
+}
+
+// NewAddressUseCase creates the address book use case.
+func NewAddressUseCase(repo domain.AddressRepository, userRepo domain.UserRepository) AddressUseCase {
+	return &addressUseCase{
+		repo:     repo,
+		userRepo: userRepo,
+	}
+}
+
+func (uc *addressUseCase) AddAddress(ctx context.Context, input AddAddressInput) (*domain.Address, error) {
+	address, err := domain.NewAddress(input.UserID, input.AddressInput)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := uc.userRepo.FindByID(ctx, input.UserID); err != nil {
+		return nil, err
+	}
+
+	existing, err := uc.repo.ListByUser(ctx, input.UserID)
+	if err != nil {
+		return nil, err
+	}
+	if len(existing) >= domain.MaxAddressesPerUser {
+		return nil, domain.ErrTooManyAddresses
+	}
+	address.DefaultShipping = input.DefaultShipping || defaultShipping(existing) == nil
+
+	if err := uc.repo.Create(ctx, address); err != nil {
+		return nil, err
+	}
+	return address, nil
+}
+
+func (uc *addressUseCase) ListAddresses(ctx context.Context, userID uuid.UUID) ([]*domain.Address, error) {
+	return uc.repo.ListByUser(ctx, userID)
+}
+
+func (uc *addressUseCase) SetDefaultShippingAddress(ctx context.Context, userID, addressID uuid.UUID) (*domain.Address, error) {
+	if err := uc.repo.SetDefaultShipping(ctx, userID, addressID, time.Now().UTC()); err != nil {
+		return nil, err
+	}
+
+	addresses, err := uc.repo.ListByUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	for _, a := range addresses {
+		if a.ID == addressID {
+			return a, nil
+		}
+	}
+	return nil, domain.ErrAddressNotFound
+}
+
+func (uc *addressUseCase) DeleteAddress(ctx context.Context, userID, addressID uuid.UUID) error {
+	return uc.repo.Delete(ctx, userID, addressID)
+}
+
+func (uc *addressUseCase) DefaultShippingAddress(ctx context.Context, userID uuid.UUID) (*domain.Address, error) {
+	addresses, err := uc.repo.ListByUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	return defaultShipping(addresses), nil
+}
+
+func defaultShipping(addresses []*domain.Address) *domain.Address {
+	for _, a := range addresses {
+		if a.DefaultShipping {
+			return a
+		}
+	}
+	return nil
+}
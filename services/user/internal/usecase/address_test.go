@@ -0,0 +1,182 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/daisuke8000/example-ec-platform/services/user/internal/domain"
+)
+
+// mockAddressRepository is an in-memory domain.AddressRepository.
+type mockAddressRepository struct {
+	addresses []*domain.Address
+}
+
+func (m *mockAddressRepository) Create(ctx context.Context, address *domain.Address) error {
+	if address.DefaultShipping {
+		m.clearDefault(address.UserID)
+	}
+	m.addresses = append(m.addresses, address)
+	return nil
+}
+
+func (m *mockAddressRepository) ListByUser(ctx context.Context, userID uuid.UUID) ([]*domain.Address, error) {
+	var out []*domain.Address
+	for i := len(m.addresses) - 1; i >= 0; i-- {
+		if a := m.addresses[i]; a.UserID == userID {
+			if a.DefaultShipping {
+				out = append([]*domain.Address{a}, out...)
+			} else {
+				out = append(out, a)
+			}
+		}
+	}
+	return out, nil
+}
+
+func (m *mockAddressRepository) SetDefaultShipping(ctx context.Context, userID, addressID uuid.UUID, now time.Time) error {
+	for _, a := range m.addresses {
+		if a.ID == addressID && a.UserID == userID {
+			m.clearDefault(userID)
+			a.DefaultShipping = true
+			a.UpdatedAt = now
+			return nil
+		}
+	}
+	return domain.ErrAddressNotFound
+}
+
+func (m *mockAddressRepository) Delete(ctx context.Context, userID, addressID uuid.UUID) error {
+	for i, a := range m.addresses {
+		if a.ID == addressID && a.UserID == userID {
+			m.addresses = append(m.addresses[:i], m.addresses[i+1:]...)
+			return nil
+		}
+	}
+	return domain.ErrAddressNotFound
+}
+
+func (m *mockAddressRepository) clearDefault(userID uuid.UUID) {
+	for _, a := range m.addresses {
+		if a.UserID == userID {
+			a.DefaultShipping = false
+		}
+	}
+}
+
+func newTestAddressUseCase() (AddressUseCase, *mockAddressRepository, *domain.User) {
+	userRepo := newMockUserRepository()
+	user := &domain.User{ID: uuid.New(), Email: "user@example.com"}
+	userRepo.users[user.ID] = user
+	repo := &mockAddressRepository{}
+	return NewAddressUseCase(repo, userRepo), repo, user
+}
+
+func testAddressInput(userID uuid.UUID, line1 string) AddAddressInput {
+	return AddAddressInput{
+		UserID: userID,
+		AddressInput: domain.AddressInput{
+			Recipient:   "Taro Yamada",
+			PhoneNumber: "+819012345678",
+			Country:     "JP",
+			PostalCode:  "100-0001",
+			City:        "Chiyoda-ku",
+			Line1:       line1,
+		},
+	}
+}
+
+func TestAddressUseCase_AddAddress(t *testing.T) {
+	uc, _, user := newTestAddressUseCase()
+	ctx := context.Background()
+
+	first, err := uc.AddAddress(ctx, testAddressInput(user.ID, "home"))
+	if err != nil {
+		t.Fatalf("AddAddress() error = %v", err)
+	}
+	if !first.DefaultShipping {
+		t.Error("first address is not the default shipping address")
+	}
+
+	second, err := uc.AddAddress(ctx, testAddressInput(user.ID, "office"))
+	if err != nil {
+		t.Fatalf("AddAddress() error = %v", err)
+	}
+	if second.DefaultShipping {
+		t.Error("second address became the default shipping address")
+	}
+
+	input := testAddressInput(user.ID, "parents")
+	input.DefaultShipping = true
+	if _, err := uc.AddAddress(ctx, input); err != nil {
+		t.Fatalf("AddAddress() error = %v", err)
+	}
+	got, err := uc.DefaultShippingAddress(ctx, user.ID)
+	if err != nil || got == nil || got.Line1 != "parents" {
+		t.Errorf("DefaultShippingAddress() = %+v, %v, want parents", got, err)
+	}
+}
+
+func TestAddressUseCase_AddAddress_Errors(t *testing.T) {
+	uc, _, user := newTestAddressUseCase()
+	ctx := context.Background()
+
+	if _, err := uc.AddAddress(ctx, testAddressInput(uuid.New(), "home")); !errors.Is(err, domain.ErrUserNotFound) {
+		t.Errorf("AddAddress() for unknown user error = %v, want %v", err, domain.ErrUserNotFound)
+	}
+
+	invalid := testAddressInput(user.ID, "home")
+	invalid.PostalCode = "94105"
+	if _, err := uc.AddAddress(ctx, invalid); !errors.Is(err, domain.ErrInvalidPostalCode) {
+		t.Errorf("AddAddress() with invalid postal code error = %v, want %v", err, domain.ErrInvalidPostalCode)
+	}
+
+	for range domain.MaxAddressesPerUser {
+		if _, err := uc.AddAddress(ctx, testAddressInput(user.ID, "home")); err != nil {
+			t.Fatalf("AddAddress() error = %v", err)
+		}
+	}
+	if _, err := uc.AddAddress(ctx, testAddressInput(user.ID, "home")); !errors.Is(err, domain.ErrTooManyAddresses) {
+		t.Errorf("AddAddress() beyond the limit error = %v, want %v", err, domain.ErrTooManyAddresses)
+	}
+}
+
+func TestAddressUseCase_SetDefaultShippingAddress(t *testing.T) {
+	uc, _, user := newTestAddressUseCase()
+	ctx := context.Background()
+	home, _ := uc.AddAddress(ctx, testAddressInput(user.ID, "home"))
+	office, _ := uc.AddAddress(ctx, testAddressInput(user.ID, "office"))
+
+	got, err := uc.SetDefaultShippingAddress(ctx, user.ID, office.ID)
+	if err != nil {
+		t.Fatalf("SetDefaultShippingAddress() error = %v", err)
+	}
+	if got.ID != office.ID || !got.DefaultShipping || home.DefaultShipping {
+		t.Errorf("default = %+v, home default = %v", got, home.DefaultShipping)
+	}
+
+	if _, err := uc.SetDefaultShippingAddress(ctx, uuid.New(), home.ID); !errors.Is(err, domain.ErrAddressNotFound) {
+		t.Errorf("SetDefaultShippingAddress() of another user's address error = %v, want %v", err, domain.ErrAddressNotFound)
+	}
+}
+
+func TestAddressUseCase_DeleteAddress(t *testing.T) {
+	uc, _, user := newTestAddressUseCase()
+	ctx := context.Background()
+	home, _ := uc.AddAddress(ctx, testAddressInput(user.ID, "home"))
+
+	if err := uc.DeleteAddress(ctx, uuid.New(), home.ID); !errors.Is(err, domain.ErrAddressNotFound) {
+		t.Errorf("DeleteAddress() of another user's address error = %v, want %v", err, domain.ErrAddressNotFound)
+	}
+	if err := uc.DeleteAddress(ctx, user.ID, home.ID); err != nil {
+		t.Fatalf("DeleteAddress() error = %v", err)
+	}
+	got, err := uc.DefaultShippingAddress(ctx, user.ID)
+	if err != nil || got != nil {
+		t.Errorf("DefaultShippingAddress() after deleting it = %+v, %v, want nil", got, err)
+	}
+}
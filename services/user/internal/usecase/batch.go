@@ -0,0 +1,230 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"sync"
+
+	"github.com/google/uuid"
+
+	"github.com/daisuke8000/example-ec-platform/services/user/internal/domain"
+)
+
+// batchProgressInterval is the number of users processed between progress saves.
+const batchProgressInterval = 100
+
+type BatchUserUseCase interface {
+	BatchDeactivateUsers(ctx context.Context, target BatchTarget, requestedBy string) (*domain.BatchJob, error)
+	BatchAssignSegment(ctx context.Context, target BatchTarget, segment, requestedBy string) (*domain.BatchJob, error)
+	GetBatchJob(ctx context.Context, id uuid.UUID) (*domain.BatchJob, error)
+	GetBatchJobReport(ctx context.Context, id uuid.UUID) ([]domain.BatchJobResult, error)
+	// Wait blocks until all in-flight jobs have finished.
+	Wait()
+}
+
+// BatchTarget selects users either by explicit IDs or by a filter.
+type BatchTarget struct {
+	UserIDs []uuid.UUID
+	Filter  *domain.UserFilter
+}
+
+type batchUserUseCase struct {
+	users    domain.UserRepository
+	segments domain.SegmentRepository
+	jobs     domain.BatchJobRepository
+	logger   *slog.Logger
+	wg       sync.WaitGroup
+}
+
+// NewBatchUserUseCase creates the admin bulk action use case.
+// Jobs run in background goroutines; call Wait during shutdown.
+func NewBatchUserUseCase(
+	users domain.UserRepository,
+	segments domain.SegmentRepository,
+	jobs domain.BatchJobRepository,
+	logger *slog.Logger,
+) BatchUserUseCase {
+	return &batchUserUseCase{
+		users:    users,
+		segments: segments,
+		jobs:     jobs,
+		logger:   logger,
+	}
+}
+
+func (uc *batchUserUseCase) BatchDeactivateUsers(ctx context.Context, target BatchTarget, requestedBy string) (*domain.BatchJob, error) {
+	return uc.start(ctx, domain.BatchJobDeactivate, "", requestedBy, target)
+}
+
+func (uc *batchUserUseCase) BatchAssignSegment(ctx context.Context, target BatchTarget, segment, requestedBy string) (*domain.BatchJob, error) {
+	if err := domain.ValidateSegment(segment); err != nil {
+		return nil, err
+	}
+	return uc.start(ctx, domain.BatchJobAssignSegment, segment, requestedBy, target)
+}
+
+func (uc *batchUserUseCase) GetBatchJob(ctx context.Context, id uuid.UUID) (*domain.BatchJob, error) {
+	return uc.jobs.FindByID(ctx, id)
+}
+
+func (uc *batchUserUseCase) GetBatchJobReport(ctx context.Context, id uuid.UUID) ([]domain.BatchJobResult, error) {
+	job, err := uc.jobs.FindByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if !job.IsDone() {
+		return nil, domain.ErrBatchJobNotFinished
+	}
+	return uc.jobs.ListResults(ctx, id)
+}
+
+func (uc *batchUserUseCase) Wait() {
+	uc.wg.Wait()
+}
+
+func (uc *batchUserUseCase) start(ctx context.Context, kind domain.BatchJobKind, segment, requestedBy string, target BatchTarget) (*domain.BatchJob, error) {
+	ids, err := uc.resolveTargets(ctx, target)
+	if err != nil {
+		return nil, err
+	}
+
+	job := domain.NewBatchJob(kind, segment, requestedBy, len(ids))
+	if err := uc.jobs.Create(ctx, job); err != nil {
+		return nil, err
+	}
+
+	// The caller receives a snapshot; the running copy is owned by the worker.
+	snapshot := *job
+
+	uc.wg.Add(1)
+	go func() {
+		defer uc.wg.Done()
+		uc.run(context.WithoutCancel(ctx), job, ids)
+	}()
+
+	return &snapshot, nil
+}
+
+// resolveTargets expands the target into a de-duplicated list of user IDs.
+func (uc *batchUserUseCase) resolveTargets(ctx context.Context, target BatchTarget) ([]uuid.UUID, error) {
+	if len(target.UserIDs) > 0 {
+		if len(target.UserIDs) > domain.MaxBatchTargets {
+			return nil, domain.ErrBatchTooLarge
+		}
+		seen := make(map[uuid.UUID]struct{}, len(target.UserIDs))
+		ids := make([]uuid.UUID, 0, len(target.UserIDs))
+		for _, id := range target.UserIDs {
+			if _, ok := seen[id]; ok {
+				continue
+			}
+			seen[id] = struct{}{}
+			ids = append(ids, id)
+		}
+		return ids, nil
+	}
+
+	if target.Filter == nil {
+		return nil, domain.ErrEmptyBatchTarget
+	}
+
+	f := target.Filter
+	if f.CreatedAfter != nil && f.CreatedBefore != nil && !f.CreatedAfter.Before(*f.CreatedBefore) {
+		return nil, domain.ErrInvalidDateRange
+	}
+
+	var ids []uuid.UUID
+	page := domain.Pagination{PageSize: domain.MaxPageSize}
+	for {
+		users, next, err := uc.users.List(ctx, *f, page)
+		if err != nil {
+			return nil, err
+		}
+		for _, u := range users {
+			ids = append(ids, u.ID)
+		}
+		if len(ids) > domain.MaxBatchTargets {
+			return nil, domain.ErrBatchTooLarge
+		}
+		if next == "" {
+			break
+		}
+		page.PageToken = next
+	}
+
+	if len(ids) == 0 {
+		return nil, domain.ErrEmptyBatchTarget
+	}
+	return ids, nil
+}
+
+func (uc *batchUserUseCase) run(ctx context.Context, job *domain.BatchJob, ids []uuid.UUID) {
+	logger := uc.logger.With(
+		slog.String("job_id", job.ID.String()),
+		slog.String("kind", string(job.Kind)),
+	)
+	logger.Info("batch job started", slog.Int("total", job.Total))
+
+	job.Status = domain.BatchJobRunning
+	if err := uc.jobs.SaveProgress(ctx, job, nil); err != nil {
+		uc.fail(ctx, logger, job, err)
+		return
+	}
+
+	pending := make([]domain.BatchJobResult, 0, batchProgressInterval)
+	for _, id := range ids {
+		result := uc.apply(ctx, job, id)
+		job.Record(result)
+		pending = append(pending, result)
+
+		if len(pending) == batchProgressInterval {
+			if err := uc.jobs.SaveProgress(ctx, job, pending); err != nil {
+				uc.fail(ctx, logger, job, err)
+				return
+			}
+			pending = pending[:0]
+		}
+	}
+
+	job.Finish(domain.BatchJobCompleted)
+	if err := uc.jobs.SaveProgress(ctx, job, pending); err != nil {
+		logger.Error("failed to save batch job completion", slog.String("error", err.Error()))
+		return
+	}
+
+	logger.Info("batch job completed",
+		slog.Int("succeeded", job.Succeeded),
+		slog.Int("failed", job.Failed),
+	)
+}
+
+// apply performs the job action for a single user.
+// Missing or already-deactivated users are reported as skipped.
+func (uc *batchUserUseCase) apply(ctx context.Context, job *domain.BatchJob, id uuid.UUID) domain.BatchJobResult {
+	var err error
+	switch job.Kind {
+	case domain.BatchJobDeactivate:
+		err = uc.users.SoftDelete(ctx, id)
+	case domain.BatchJobAssignSegment:
+		if _, err = uc.users.FindByID(ctx, id); err == nil {
+			err = uc.segments.AssignSegment(ctx, id, job.Segment)
+		}
+	}
+
+	switch {
+	case err == nil:
+		return domain.BatchJobResult{UserID: id, Status: domain.BatchResultSucceeded}
+	case errors.Is(err, domain.ErrUserNotFound):
+		return domain.BatchJobResult{UserID: id, Status: domain.BatchResultSkipped, Error: err.Error()}
+	default:
+		return domain.BatchJobResult{UserID: id, Status: domain.BatchResultFailed, Error: err.Error()}
+	}
+}
+
+func (uc *batchUserUseCase) fail(ctx context.Context, logger *slog.Logger, job *domain.BatchJob, cause error) {
+	logger.Error("batch job failed", slog.String("error", cause.Error()))
+	job.Finish(domain.BatchJobFailed)
+	if err := uc.jobs.SaveProgress(ctx, job, nil); err != nil {
+		logger.Error("failed to save batch job failure", slog.String("error", err.Error()))
+	}
+}
@@ -0,0 +1,182 @@
+package usecase
+
+import (
+	"context"
+	"log/slog"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/daisuke8000/example-ec-platform/services/user/internal/domain"
+)
+
+// mockBatchJobRepository is an in-memory domain.BatchJobRepository.
+type mockBatchJobRepository struct {
+	jobs    map[uuid.UUID]domain.BatchJob
+	results map[uuid.UUID][]domain.BatchJobResult
+}
+
+func newMockBatchJobRepository() *mockBatchJobRepository {
+	return &mockBatchJobRepository{
+		jobs:    make(map[uuid.UUID]domain.BatchJob),
+		results: make(map[uuid.UUID][]domain.BatchJobResult),
+	}
+}
+
+func (m *mockBatchJobRepository) Create(ctx context.Context, job *domain.BatchJob) error {
+	m.jobs[job.ID] = *job
+	return nil
+}
+
+func (m *mockBatchJobRepository) FindByID(ctx context.Context, id uuid.UUID) (*domain.BatchJob, error) {
+	job, ok := m.jobs[id]
+	if !ok {
+		return nil, domain.ErrBatchJobNotFound
+	}
+	return &job, nil
+}
+
+func (m *mockBatchJobRepository) SaveProgress(ctx context.Context, job *domain.BatchJob, results []domain.BatchJobResult) error {
+	m.jobs[job.ID] = *job
+	m.results[job.ID] = append(m.results[job.ID], results...)
+	return nil
+}
+
+func (m *mockBatchJobRepository) ListResults(ctx context.Context, id uuid.UUID) ([]domain.BatchJobResult, error) {
+	return m.results[id], nil
+}
+
+// mockSegmentRepository records segment assignments.
+type mockSegmentRepository struct {
+	segments map[uuid.UUID]string
+}
+
+func (m *mockSegmentRepository) AssignSegment(ctx context.Context, userID uuid.UUID, segment string) error {
+	m.segments[userID] = segment
+	return nil
+}
+
+func newTestBatchUseCase(repo *mockUserRepository) (BatchUserUseCase, *mockBatchJobRepository, *mockSegmentRepository) {
+	jobs := newMockBatchJobRepository()
+	segments := &mockSegmentRepository{segments: make(map[uuid.UUID]string)}
+	logger := slog.New(slog.NewTextHandler(os.Stdout, &slog.HandlerOptions{Level: slog.LevelError}))
+	return NewBatchUserUseCase(repo, segments, jobs, logger), jobs, segments
+}
+
+func seedBatchUser(repo *mockUserRepository, email string, createdAt time.Time) *domain.User {
+	user := domain.NewUser(email, "hash", nil)
+	user.CreatedAt = createdAt
+	repo.seedUser(user)
+	return user
+}
+
+func TestBatchUserUseCase_BatchDeactivateUsers(t *testing.T) {
+	repo := newMockUserRepository()
+	base := time.Now().UTC()
+	alice := seedBatchUser(repo, "alice@example.com", base)
+	bob := seedBatchUser(repo, "bob@example.com", base.Add(time.Second))
+	missing := uuid.New()
+
+	uc, jobs, _ := newTestBatchUseCase(repo)
+
+	job, err := uc.BatchDeactivateUsers(context.Background(), BatchTarget{
+		UserIDs: []uuid.UUID{alice.ID, bob.ID, alice.ID, missing},
+	}, "admin-user")
+	if err != nil {
+		t.Fatalf("BatchDeactivateUsers() error = %v", err)
+	}
+	if job.Total != 3 {
+		t.Errorf("Total = %d, want 3 (duplicates removed)", job.Total)
+	}
+	if job.Status != domain.BatchJobPending {
+		t.Errorf("Status = %q, want %q", job.Status, domain.BatchJobPending)
+	}
+
+	uc.Wait()
+
+	done, err := uc.GetBatchJob(context.Background(), job.ID)
+	if err != nil {
+		t.Fatalf("GetBatchJob() error = %v", err)
+	}
+	if done.Status != domain.BatchJobCompleted || done.Processed != 3 || done.Succeeded != 2 || done.Failed != 0 {
+		t.Errorf("job = %+v, want completed with 3 processed, 2 succeeded", done)
+	}
+	if done.RequestedBy != "admin-user" {
+		t.Errorf("RequestedBy = %q, want %q", done.RequestedBy, "admin-user")
+	}
+	if !alice.IsDeleted || !bob.IsDeleted {
+		t.Error("targeted users should be deactivated")
+	}
+
+	report, err := uc.GetBatchJobReport(context.Background(), job.ID)
+	if err != nil {
+		t.Fatalf("GetBatchJobReport() error = %v", err)
+	}
+	if len(report) != 3 || report[2].UserID != missing || report[2].Status != domain.BatchResultSkipped {
+		t.Errorf("report = %+v, want missing user skipped last", report)
+	}
+	if len(jobs.results[job.ID]) != 3 {
+		t.Errorf("stored results = %d, want 3", len(jobs.results[job.ID]))
+	}
+}
+
+func TestBatchUserUseCase_BatchAssignSegment(t *testing.T) {
+	repo := newMockUserRepository()
+	base := time.Now().UTC()
+	alice := seedBatchUser(repo, "alice@example.com", base)
+	carol := seedBatchUser(repo, "carol@example.org", base.Add(time.Second))
+
+	t.Run("resolves filter targets", func(t *testing.T) {
+		uc, _, segments := newTestBatchUseCase(repo)
+		contains := "example.org"
+
+		job, err := uc.BatchAssignSegment(context.Background(), BatchTarget{
+			Filter: &domain.UserFilter{EmailContains: &contains},
+		}, "vip", "admin-user")
+		if err != nil {
+			t.Fatalf("BatchAssignSegment() error = %v", err)
+		}
+		uc.Wait()
+
+		if job.Total != 1 {
+			t.Errorf("Total = %d, want 1", job.Total)
+		}
+		if segments.segments[carol.ID] != "vip" {
+			t.Errorf("carol segment = %q, want %q", segments.segments[carol.ID], "vip")
+		}
+		if _, ok := segments.segments[alice.ID]; ok {
+			t.Error("alice should not be assigned a segment")
+		}
+	})
+
+	t.Run("rejects invalid segment", func(t *testing.T) {
+		uc, _, _ := newTestBatchUseCase(repo)
+		_, err := uc.BatchAssignSegment(context.Background(), BatchTarget{
+			UserIDs: []uuid.UUID{alice.ID},
+		}, "Not Valid", "admin-user")
+		if err != domain.ErrInvalidSegment {
+			t.Errorf("BatchAssignSegment() error = %v, want %v", err, domain.ErrInvalidSegment)
+		}
+	})
+
+	t.Run("rejects empty target", func(t *testing.T) {
+		uc, _, _ := newTestBatchUseCase(repo)
+		_, err := uc.BatchAssignSegment(context.Background(), BatchTarget{}, "vip", "admin-user")
+		if err != domain.ErrEmptyBatchTarget {
+			t.Errorf("BatchAssignSegment() error = %v, want %v", err, domain.ErrEmptyBatchTarget)
+		}
+	})
+}
+
+func TestBatchUserUseCase_GetBatchJobReport_NotFinished(t *testing.T) {
+	uc, jobs, _ := newTestBatchUseCase(newMockUserRepository())
+	job := domain.NewBatchJob(domain.BatchJobDeactivate, "", "admin-user", 1)
+	jobs.jobs[job.ID] = *job
+
+	_, err := uc.GetBatchJobReport(context.Background(), job.ID)
+	if err != domain.ErrBatchJobNotFinished {
+		t.Errorf("GetBatchJobReport() error = %v, want %v", err, domain.ErrBatchJobNotFinished)
+	}
+}
@@ -0,0 +1,75 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/daisuke8000/example-ec-platform/services/user/internal/domain"
+)
+
+type ConsentUseCase interface {
+	RecordConsent(ctx context.Context, input RecordConsentInput) (*domain.ConsentReceipt, error)
+	ListConsents(ctx context.Context, userID uuid.UUID, includeRevoked bool) ([]*domain.ConsentReceipt, error)
+	// RevokeConsent returns the number of receipts that were revoked.
+	RevokeConsent(ctx context.Context, userID uuid.UUID, clientID string) (int, error)
+}
+
+type RecordConsentInput struct {
+	UserID     uuid.UUID
+	ClientID   string
+	ClientName string
+	Scopes     []string
+	Remember   bool
+}
+
+// ConsentRevoker revokes consent sessions at the authorization server.
+type ConsentRevoker interface {
+	RevokeConsentSessions(ctx context.Context, subject, clientID string) error
+}
+
+type consentUseCase struct {
+	repo    domain.ConsentRepository
+	revoker ConsentRevoker
+}
+
+// NewConsentUseCase creates the consent receipt use case.
+func NewConsentUseCase(repo domain.ConsentRepository, revoker ConsentRevoker) ConsentUseCase {
+	return &consentUseCase{
+		repo:    repo,
+		revoker: revoker,
+	}
+}
+
+func (uc *consentUseCase) RecordConsent(ctx context.Context, input RecordConsentInput) (*domain.ConsentReceipt, error) {
+	receipt, err := domain.NewConsentReceipt(input.UserID, input.ClientID, input.ClientName, input.Scopes, input.Remember)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := uc.repo.Create(ctx, receipt); err != nil {
+		return nil, err
+	}
+
+	return receipt, nil
+}
+
+func (uc *consentUseCase) ListConsents(ctx context.Context, userID uuid.UUID, includeRevoked bool) ([]*domain.ConsentReceipt, error) {
+	return uc.repo.ListByUser(ctx, userID, includeRevoked)
+}
+
+func (uc *consentUseCase) RevokeConsent(ctx context.Context, userID uuid.UUID, clientID string) (int, error) {
+	if clientID == "" {
+		return 0, domain.ErrEmptyClientID
+	}
+
+	// Revoke at Hydra first: it is the source of truth for issued tokens,
+	// and grants made before receipts were recorded have no local row.
+	if err := uc.revoker.RevokeConsentSessions(ctx, userID.String(), clientID); err != nil {
+		return 0, fmt.Errorf("failed to revoke consent at authorization server: %w", err)
+	}
+
+	return uc.repo.RevokeByClient(ctx, userID, clientID, time.Now().UTC())
+}
@@ -0,0 +1,138 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/daisuke8000/example-ec-platform/services/user/internal/domain"
+)
+
+// mockConsentRepository is an in-memory domain.ConsentRepository.
+type mockConsentRepository struct {
+	receipts []*domain.ConsentReceipt
+}
+
+func (m *mockConsentRepository) Create(ctx context.Context, receipt *domain.ConsentReceipt) error {
+	m.receipts = append(m.receipts, receipt)
+	return nil
+}
+
+func (m *mockConsentRepository) ListByUser(ctx context.Context, userID uuid.UUID, includeRevoked bool) ([]*domain.ConsentReceipt, error) {
+	var out []*domain.ConsentReceipt
+	for i := len(m.receipts) - 1; i >= 0; i-- {
+		r := m.receipts[i]
+		if r.UserID == userID && (includeRevoked || !r.IsRevoked()) {
+			out = append(out, r)
+		}
+	}
+	return out, nil
+}
+
+func (m *mockConsentRepository) RevokeByClient(ctx context.Context, userID uuid.UUID, clientID string, revokedAt time.Time) (int, error) {
+	n := 0
+	for _, r := range m.receipts {
+		if r.UserID == userID && r.ClientID == clientID && !r.IsRevoked() {
+			r.RevokedAt = &revokedAt
+			n++
+		}
+	}
+	return n, nil
+}
+
+// mockConsentRevoker records revocations sent to the authorization server.
+type mockConsentRevoker struct {
+	revoked []string
+	err     error
+}
+
+func (m *mockConsentRevoker) RevokeConsentSessions(ctx context.Context, subject, clientID string) error {
+	if m.err != nil {
+		return m.err
+	}
+	m.revoked = append(m.revoked, subject+"/"+clientID)
+	return nil
+}
+
+func TestConsentUseCase_RecordAndList(t *testing.T) {
+	repo := &mockConsentRepository{}
+	uc := NewConsentUseCase(repo, &mockConsentRevoker{})
+	userID := uuid.New()
+
+	if _, err := uc.RecordConsent(context.Background(), RecordConsentInput{
+		UserID:   userID,
+		ClientID: "spa",
+		Scopes:   []string{"openid", "email"},
+		Remember: true,
+	}); err != nil {
+		t.Fatalf("RecordConsent() error = %v", err)
+	}
+
+	if _, err := uc.RecordConsent(context.Background(), RecordConsentInput{UserID: userID}); err != domain.ErrEmptyClientID {
+		t.Errorf("RecordConsent() without client error = %v, want %v", err, domain.ErrEmptyClientID)
+	}
+
+	receipts, err := uc.ListConsents(context.Background(), userID, false)
+	if err != nil {
+		t.Fatalf("ListConsents() error = %v", err)
+	}
+	if len(receipts) != 1 || receipts[0].ClientID != "spa" || !receipts[0].Remember {
+		t.Errorf("ListConsents() = %+v, want one remembered receipt for spa", receipts)
+	}
+}
+
+func TestConsentUseCase_RevokeConsent(t *testing.T) {
+	userID := uuid.New()
+
+	t.Run("revokes at hydra and marks receipts", func(t *testing.T) {
+		repo := &mockConsentRepository{}
+		revoker := &mockConsentRevoker{}
+		uc := NewConsentUseCase(repo, revoker)
+		for _, client := range []string{"spa", "spa", "mobile"} {
+			if _, err := uc.RecordConsent(context.Background(), RecordConsentInput{UserID: userID, ClientID: client}); err != nil {
+				t.Fatalf("RecordConsent() error = %v", err)
+			}
+		}
+
+		n, err := uc.RevokeConsent(context.Background(), userID, "spa")
+		if err != nil {
+			t.Fatalf("RevokeConsent() error = %v", err)
+		}
+		if n != 2 {
+			t.Errorf("RevokeConsent() = %d, want 2", n)
+		}
+		if len(revoker.revoked) != 1 || revoker.revoked[0] != userID.String()+"/spa" {
+			t.Errorf("hydra revocations = %v", revoker.revoked)
+		}
+
+		active, _ := uc.ListConsents(context.Background(), userID, false)
+		if len(active) != 1 || active[0].ClientID != "mobile" {
+			t.Errorf("active consents = %+v, want only mobile", active)
+		}
+	})
+
+	t.Run("keeps receipts when hydra fails", func(t *testing.T) {
+		repo := &mockConsentRepository{}
+		uc := NewConsentUseCase(repo, &mockConsentRevoker{err: errors.New("hydra down")})
+		if _, err := uc.RecordConsent(context.Background(), RecordConsentInput{UserID: userID, ClientID: "spa"}); err != nil {
+			t.Fatalf("RecordConsent() error = %v", err)
+		}
+
+		if _, err := uc.RevokeConsent(context.Background(), userID, "spa"); err == nil {
+			t.Fatal("RevokeConsent() expected error")
+		}
+		if repo.receipts[0].IsRevoked() {
+			t.Error("receipt should stay active when hydra revocation fails")
+		}
+	})
+
+	t.Run("requires client ID", func(t *testing.T) {
+		uc := NewConsentUseCase(&mockConsentRepository{}, &mockConsentRevoker{})
+		if _, err := uc.RevokeConsent(context.Background(), userID, ""); err != domain.ErrEmptyClientID {
+			t.Errorf("RevokeConsent() error = %v, want %v", err, domain.ErrEmptyClientID)
+		}
+	})
+}
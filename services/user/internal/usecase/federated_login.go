@@ -0,0 +1,81 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/daisuke8000/example-ec-platform/services/user/internal/domain"
+)
+
+type FederatedLoginUseCase interface {
+	// Login returns the local user of an upstream account that signed in:
+	// the linked user, else the user with the same verified email address
+	// (which gets linked), else a new user if auto-provisioning is enabled.
+	Login(ctx context.Context, profile domain.UpstreamProfile) (*domain.User, error)
+}
+
+type FederatedLoginConfig struct {
+	// AutoProvision creates a user for an upstream account whose email
+	// address has no account yet.
+	AutoProvision bool
+}
+
+type federatedLoginUseCase struct {
+	identities domain.ExternalIdentityRepository
+	users      domain.UserRepository
+	cfg        FederatedLoginConfig
+}
+
+// NewFederatedLoginUseCase creates the social login use case.
+func NewFederatedLoginUseCase(identities domain.ExternalIdentityRepository, users domain.UserRepository, cfg FederatedLoginConfig) FederatedLoginUseCase {
+	return &federatedLoginUseCase{
+		identities: identities,
+		users:      users,
+		cfg:        cfg,
+	}
+}
+
+func (uc *federatedLoginUseCase) Login(ctx context.Context, profile domain.UpstreamProfile) (*domain.User, error) {
+	identity, err := uc.identities.Find(ctx, profile.Provider, profile.Subject)
+	if err == nil {
+		return uc.users.FindByID(ctx, identity.UserID)
+	}
+	if !errors.Is(err, domain.ErrExternalIdentityNotFound) {
+		return nil, err
+	}
+
+	// Matching by email is only safe when both sides have verified it;
+	// otherwise whoever registered the address first would get the account.
+	profile.Email = strings.ToLower(strings.TrimSpace(profile.Email))
+	if !profile.EmailVerified || domain.ValidateEmail(profile.Email) != nil {
+		return nil, domain.ErrUpstreamEmailNotVerified
+	}
+
+	user, err := uc.users.FindByEmail(ctx, profile.Email)
+	switch {
+	case err == nil:
+		if !user.EmailVerified {
+			return nil, domain.ErrFederatedLoginConflict
+		}
+		if err := uc.identities.Link(ctx, domain.NewExternalIdentity(profile, user.ID)); err != nil {
+			// The user is linked to another account of the provider
+			if errors.Is(err, domain.ErrExternalIdentityAlreadyLinked) {
+				return nil, domain.ErrFederatedLoginConflict
+			}
+			return nil, err
+		}
+		return user, nil
+	case !errors.Is(err, domain.ErrUserNotFound):
+		return nil, err
+	}
+
+	if !uc.cfg.AutoProvision {
+		return nil, domain.ErrFederatedSignupDisabled
+	}
+	user = domain.NewFederatedUser(profile)
+	if err := uc.identities.Provision(ctx, user, domain.NewExternalIdentity(profile, user.ID)); err != nil {
+		return nil, err
+	}
+	return user, nil
+}
@@ -0,0 +1,181 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+
+	"github.com/daisuke8000/example-ec-platform/services/user/internal/domain"
+)
+
+// mockExternalIdentityRepository is an in-memory domain.ExternalIdentityRepository.
+type mockExternalIdentityRepository struct {
+	identities map[string]*domain.ExternalIdentity
+	users      *mockUserRepository
+}
+
+func (m *mockExternalIdentityRepository) Find(ctx context.Context, provider, subject string) (*domain.ExternalIdentity, error) {
+	identity, ok := m.identities[provider+"/"+subject]
+	if !ok {
+		return nil, domain.ErrExternalIdentityNotFound
+	}
+	return identity, nil
+}
+
+func (m *mockExternalIdentityRepository) Link(ctx context.Context, identity *domain.ExternalIdentity) error {
+	key := identity.Provider + "/" + identity.Subject
+	if _, ok := m.identities[key]; ok {
+		return domain.ErrExternalIdentityAlreadyLinked
+	}
+	for _, existing := range m.identities {
+		if existing.UserID == identity.UserID && existing.Provider == identity.Provider {
+			return domain.ErrExternalIdentityAlreadyLinked
+		}
+	}
+	m.identities[key] = identity
+	return nil
+}
+
+func (m *mockExternalIdentityRepository) Provision(ctx context.Context, user *domain.User, identity *domain.ExternalIdentity) error {
+	if err := m.users.Create(ctx, user); err != nil {
+		return err
+	}
+	return m.Link(ctx, identity)
+}
+
+func newTestFederatedLoginUseCase(cfg FederatedLoginConfig) (FederatedLoginUseCase, *mockExternalIdentityRepository, *mockUserRepository) {
+	users := newMockUserRepository()
+	identities := &mockExternalIdentityRepository{
+		identities: make(map[string]*domain.ExternalIdentity),
+		users:      users,
+	}
+	return NewFederatedLoginUseCase(identities, users, cfg), identities, users
+}
+
+func googleProfile(subject, email string) domain.UpstreamProfile {
+	return domain.UpstreamProfile{
+		Provider:      "google",
+		Subject:       subject,
+		Email:         email,
+		EmailVerified: true,
+		Name:          "Taro Yamada",
+	}
+}
+
+func TestFederatedLogin_LinkedAccount(t *testing.T) {
+	uc, identities, users := newTestFederatedLoginUseCase(FederatedLoginConfig{})
+	user := &domain.User{ID: uuid.New(), Email: "taro@example.com"}
+	users.users[user.ID] = user
+	identities.identities["google/123"] = &domain.ExternalIdentity{Provider: "google", Subject: "123", UserID: user.ID}
+
+	// The upstream email may have changed since the link was made
+	got, err := uc.Login(context.Background(), googleProfile("123", "other@example.com"))
+	if err != nil {
+		t.Fatalf("Login failed: %v", err)
+	}
+	if got.ID != user.ID {
+		t.Errorf("expected user %s, got %s", user.ID, got.ID)
+	}
+}
+
+func TestFederatedLogin_LinksVerifiedEmail(t *testing.T) {
+	uc, identities, users := newTestFederatedLoginUseCase(FederatedLoginConfig{})
+	user := &domain.User{ID: uuid.New(), Email: "taro@example.com", EmailVerified: true}
+	users.users[user.ID] = user
+	users.emailIndex[user.Email] = user.ID
+
+	got, err := uc.Login(context.Background(), googleProfile("123", "Taro@Example.com"))
+	if err != nil {
+		t.Fatalf("Login failed: %v", err)
+	}
+	if got.ID != user.ID {
+		t.Errorf("expected user %s, got %s", user.ID, got.ID)
+	}
+	if link, ok := identities.identities["google/123"]; !ok || link.UserID != user.ID {
+		t.Errorf("expected the account to be linked, got %+v", link)
+	}
+}
+
+func TestFederatedLogin_RefusesUnverifiedEmails(t *testing.T) {
+	t.Run("upstream", func(t *testing.T) {
+		uc, _, _ := newTestFederatedLoginUseCase(FederatedLoginConfig{AutoProvision: true})
+		profile := googleProfile("123", "taro@example.com")
+		profile.EmailVerified = false
+
+		_, err := uc.Login(context.Background(), profile)
+		if !errors.Is(err, domain.ErrUpstreamEmailNotVerified) {
+			t.Errorf("expected ErrUpstreamEmailNotVerified, got %v", err)
+		}
+	})
+
+	t.Run("local", func(t *testing.T) {
+		uc, identities, users := newTestFederatedLoginUseCase(FederatedLoginConfig{AutoProvision: true})
+		user := &domain.User{ID: uuid.New(), Email: "taro@example.com"}
+		users.users[user.ID] = user
+		users.emailIndex[user.Email] = user.ID
+
+		_, err := uc.Login(context.Background(), googleProfile("123", "taro@example.com"))
+		if !errors.Is(err, domain.ErrFederatedLoginConflict) {
+			t.Errorf("expected ErrFederatedLoginConflict, got %v", err)
+		}
+		if len(identities.identities) != 0 {
+			t.Error("expected no link to an unverified account")
+		}
+	})
+}
+
+func TestFederatedLogin_UserLinkedToAnotherAccount(t *testing.T) {
+	uc, identities, users := newTestFederatedLoginUseCase(FederatedLoginConfig{})
+	user := &domain.User{ID: uuid.New(), Email: "taro@example.com", EmailVerified: true}
+	users.users[user.ID] = user
+	users.emailIndex[user.Email] = user.ID
+	identities.identities["google/999"] = &domain.ExternalIdentity{Provider: "google", Subject: "999", UserID: user.ID}
+
+	_, err := uc.Login(context.Background(), googleProfile("123", "taro@example.com"))
+	if !errors.Is(err, domain.ErrFederatedLoginConflict) {
+		t.Errorf("expected ErrFederatedLoginConflict, got %v", err)
+	}
+}
+
+func TestFederatedLogin_AutoProvision(t *testing.T) {
+	t.Run("disabled", func(t *testing.T) {
+		uc, _, users := newTestFederatedLoginUseCase(FederatedLoginConfig{})
+
+		_, err := uc.Login(context.Background(), googleProfile("123", "taro@example.com"))
+		if !errors.Is(err, domain.ErrFederatedSignupDisabled) {
+			t.Errorf("expected ErrFederatedSignupDisabled, got %v", err)
+		}
+		if len(users.users) != 0 {
+			t.Error("expected no user to be created")
+		}
+	})
+
+	t.Run("enabled", func(t *testing.T) {
+		uc, identities, _ := newTestFederatedLoginUseCase(FederatedLoginConfig{AutoProvision: true})
+
+		user, err := uc.Login(context.Background(), googleProfile("123", "Taro@Example.com"))
+		if err != nil {
+			t.Fatalf("Login failed: %v", err)
+		}
+		if user.Email != "taro@example.com" || !user.EmailVerified {
+			t.Errorf("expected a verified lowercase email, got %q (verified %v)", user.Email, user.EmailVerified)
+		}
+		if user.PasswordHash != "" {
+			t.Error("expected a user without a password")
+		}
+		if user.Name == nil || *user.Name != "Taro Yamada" {
+			t.Errorf("expected the upstream name, got %v", user.Name)
+		}
+		if link, ok := identities.identities["google/123"]; !ok || link.UserID != user.ID {
+			t.Errorf("expected the account to be linked, got %+v", link)
+		}
+
+		// The next login finds the link
+		again, err := uc.Login(context.Background(), googleProfile("123", "taro@example.com"))
+		if err != nil || again.ID != user.ID {
+			t.Errorf("expected the provisioned user, got %v, %v", again, err)
+		}
+	})
+}
@@ -0,0 +1,102 @@
+package usecase
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/daisuke8000/example-ec-platform/pkg/audit"
+	"github.com/daisuke8000/example-ec-platform/services/user/internal/domain"
+)
+
+// hydraActor is the audit log actor of changes reported by Hydra.
+const hydraActor = "hydra"
+
+type HydraEventUseCase interface {
+	// HandleHydraEvent records event in the audit log and, for revocations,
+	// has the BFF reject the tokens issued before it. Events of unknown
+	// types are ignored. Handling an event twice is harmless.
+	HandleHydraEvent(ctx context.Context, event domain.HydraEvent) error
+}
+
+// AuditRecorder writes audit log entries.
+// *audit.PostgresStore is the production implementation.
+type AuditRecorder interface {
+	Record(ctx context.Context, entry *audit.Entry) error
+}
+
+// TokenRevocationNotifier tells the BFF to reject a user's tokens issued
+// before revokedAt, for one client or every client if clientID is empty.
+type TokenRevocationNotifier interface {
+	NotifyTokensRevoked(ctx context.Context, userID, clientID string, revokedAt time.Time) error
+}
+
+type hydraEventUseCase struct {
+	audit    AuditRecorder
+	notifier TokenRevocationNotifier
+	logger   *slog.Logger
+}
+
+// NewHydraEventUseCase creates the Hydra event use case. A nil notifier
+// only records revocations; tokens stay valid at the BFF until they expire.
+func NewHydraEventUseCase(recorder AuditRecorder, notifier TokenRevocationNotifier, logger *slog.Logger) HydraEventUseCase {
+	return &hydraEventUseCase{
+		audit:    recorder,
+		notifier: notifier,
+		logger:   logger,
+	}
+}
+
+func (uc *hydraEventUseCase) HandleHydraEvent(ctx context.Context, event domain.HydraEvent) error {
+	entityType, known := hydraEventEntityTypes[event.Type]
+	if !known {
+		uc.logger.DebugContext(ctx, "ignoring hydra event", slog.String("type", event.Type))
+		return nil
+	}
+	if err := event.Validate(); err != nil {
+		return err
+	}
+
+	// Notify first: if it fails, Hydra redelivers the event and the entry
+	// is recorded once the BFF has been told.
+	if event.RevokesTokens() && uc.notifier != nil {
+		if err := uc.notifier.NotifyTokensRevoked(ctx, event.Subject, event.ClientID, event.OccurredAt); err != nil {
+			return fmt.Errorf("failed to notify token revocation: %w", err)
+		}
+	}
+
+	request, err := json.Marshal(map[string]string{
+		"subject":    event.Subject,
+		"client_id":  event.ClientID,
+		"session_id": event.SessionID,
+	})
+	if err != nil {
+		return err
+	}
+	entry := &audit.Entry{
+		ID:         uuid.New(),
+		Actor:      hydraActor,
+		Method:     "hydra/" + event.Type,
+		EntityType: entityType,
+		EntityIDs:  []string{event.Subject},
+		Request:    request,
+		RequestID:  event.ID,
+		CreatedAt:  event.OccurredAt.UTC(),
+	}
+	if err := uc.audit.Record(ctx, entry); err != nil {
+		return fmt.Errorf("failed to record hydra event: %w", err)
+	}
+	return nil
+}
+
+// hydraEventEntityTypes maps the handled event types to the audit log
+// entity type; entity IDs are the user IDs, as for RevokeConsent.
+var hydraEventEntityTypes = map[string]string{
+	domain.HydraEventTokenIssued:         "token",
+	domain.HydraEventConsentRevoked:      "consent",
+	domain.HydraEventLoginSessionRevoked: "session",
+}
@@ -0,0 +1,155 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/daisuke8000/example-ec-platform/pkg/audit"
+	"github.com/daisuke8000/example-ec-platform/services/user/internal/domain"
+)
+
+// mockAuditRecorder collects recorded entries.
+type mockAuditRecorder struct {
+	entries []*audit.Entry
+	err     error
+}
+
+func (m *mockAuditRecorder) Record(ctx context.Context, entry *audit.Entry) error {
+	if m.err != nil {
+		return m.err
+	}
+	m.entries = append(m.entries, entry)
+	return nil
+}
+
+type revocation struct {
+	userID, clientID string
+	revokedAt        time.Time
+}
+
+// mockRevocationNotifier records notified revocations.
+type mockRevocationNotifier struct {
+	revocations []revocation
+	err         error
+}
+
+func (m *mockRevocationNotifier) NotifyTokensRevoked(ctx context.Context, userID, clientID string, revokedAt time.Time) error {
+	if m.err != nil {
+		return m.err
+	}
+	m.revocations = append(m.revocations, revocation{userID, clientID, revokedAt})
+	return nil
+}
+
+func newTestHydraEventUseCase(recorder *mockAuditRecorder, notifier *mockRevocationNotifier) HydraEventUseCase {
+	return NewHydraEventUseCase(recorder, notifier, slog.New(slog.NewTextHandler(io.Discard, nil)))
+}
+
+func TestHydraEventUseCase_HandleHydraEvent(t *testing.T) {
+	at := time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	tests := []struct {
+		name           string
+		event          domain.HydraEvent
+		wantEntity     string
+		wantRevocation *revocation
+	}{
+		{
+			name:       "token issued is recorded",
+			event:      domain.HydraEvent{ID: "e1", Type: domain.HydraEventTokenIssued, Subject: "user-1", ClientID: "web", OccurredAt: at},
+			wantEntity: "token",
+		},
+		{
+			name:           "consent revocation revokes the client's tokens",
+			event:          domain.HydraEvent{ID: "e2", Type: domain.HydraEventConsentRevoked, Subject: "user-1", ClientID: "web", OccurredAt: at},
+			wantEntity:     "consent",
+			wantRevocation: &revocation{"user-1", "web", at},
+		},
+		{
+			name:           "session revocation revokes every client's tokens",
+			event:          domain.HydraEvent{ID: "e3", Type: domain.HydraEventLoginSessionRevoked, Subject: "user-1", SessionID: "s1", OccurredAt: at},
+			wantEntity:     "session",
+			wantRevocation: &revocation{"user-1", "", at},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			recorder := &mockAuditRecorder{}
+			notifier := &mockRevocationNotifier{}
+			uc := newTestHydraEventUseCase(recorder, notifier)
+
+			if err := uc.HandleHydraEvent(context.Background(), tt.event); err != nil {
+				t.Fatalf("HandleHydraEvent() error = %v", err)
+			}
+
+			if len(recorder.entries) != 1 {
+				t.Fatalf("entries = %d, want 1", len(recorder.entries))
+			}
+			entry := recorder.entries[0]
+			if entry.Actor != "hydra" || entry.EntityType != tt.wantEntity || entry.Method != "hydra/"+tt.event.Type {
+				t.Errorf("entry = %+v", entry)
+			}
+			if len(entry.EntityIDs) != 1 || entry.EntityIDs[0] != "user-1" || entry.RequestID != tt.event.ID {
+				t.Errorf("entry IDs = %v, request ID = %q", entry.EntityIDs, entry.RequestID)
+			}
+
+			switch {
+			case tt.wantRevocation == nil && len(notifier.revocations) != 0:
+				t.Errorf("revocations = %v, want none", notifier.revocations)
+			case tt.wantRevocation != nil && (len(notifier.revocations) != 1 || notifier.revocations[0] != *tt.wantRevocation):
+				t.Errorf("revocations = %v, want %v", notifier.revocations, *tt.wantRevocation)
+			}
+		})
+	}
+}
+
+func TestHydraEventUseCase_IgnoresUnknownTypes(t *testing.T) {
+	recorder := &mockAuditRecorder{}
+	uc := newTestHydraEventUseCase(recorder, &mockRevocationNotifier{})
+
+	if err := uc.HandleHydraEvent(context.Background(), domain.HydraEvent{Type: "flow.expired"}); err != nil {
+		t.Errorf("HandleHydraEvent() error = %v, want nil", err)
+	}
+	if len(recorder.entries) != 0 {
+		t.Errorf("entries = %d, want 0", len(recorder.entries))
+	}
+}
+
+func TestHydraEventUseCase_Errors(t *testing.T) {
+	revoked := domain.HydraEvent{ID: "e1", Type: domain.HydraEventConsentRevoked, Subject: "user-1", ClientID: "web", OccurredAt: time.Now()}
+
+	t.Run("invalid event", func(t *testing.T) {
+		uc := newTestHydraEventUseCase(&mockAuditRecorder{}, &mockRevocationNotifier{})
+		invalid := revoked
+		invalid.ClientID = ""
+		if err := uc.HandleHydraEvent(context.Background(), invalid); !errors.Is(err, domain.ErrInvalidHydraEvent) {
+			t.Errorf("HandleHydraEvent() error = %v, want %v", err, domain.ErrInvalidHydraEvent)
+		}
+	})
+
+	t.Run("failed notification is not recorded", func(t *testing.T) {
+		recorder := &mockAuditRecorder{}
+		uc := newTestHydraEventUseCase(recorder, &mockRevocationNotifier{err: errors.New("bff down")})
+		if err := uc.HandleHydraEvent(context.Background(), revoked); err == nil {
+			t.Error("HandleHydraEvent() error = nil, want error")
+		}
+		if len(recorder.entries) != 0 {
+			t.Errorf("entries = %d, want 0", len(recorder.entries))
+		}
+	})
+
+	t.Run("without notifier revocations are only recorded", func(t *testing.T) {
+		recorder := &mockAuditRecorder{}
+		uc := NewHydraEventUseCase(recorder, nil, slog.New(slog.NewTextHandler(io.Discard, nil)))
+		if err := uc.HandleHydraEvent(context.Background(), revoked); err != nil {
+			t.Fatalf("HandleHydraEvent() error = %v", err)
+		}
+		if len(recorder.entries) != 1 {
+			t.Errorf("entries = %d, want 1", len(recorder.entries))
+		}
+	})
+}
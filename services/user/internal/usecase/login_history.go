@@ -0,0 +1,137 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/daisuke8000/example-ec-platform/services/user/internal/domain"
+)
+
+// Page sizes of GetLoginHistory.
+const (
+	DefaultLoginHistoryPageSize = 20
+	MaxLoginHistoryPageSize     = 100
+)
+
+type LoginHistoryUseCase interface {
+	// RecordLogin records a login attempt and, for a successful login from
+	// a new IP address and user agent, notifies the user. Attempts on
+	// addresses without an account are not recorded.
+	RecordLogin(ctx context.Context, attempt LoginAttempt) error
+	// GetLoginHistory returns the user's latest login events, newest first.
+	// pageSize defaults to DefaultLoginHistoryPageSize and is capped at
+	// MaxLoginHistoryPageSize.
+	GetLoginHistory(ctx context.Context, userID uuid.UUID, pageSize int) ([]*domain.LoginEvent, error)
+}
+
+// LoginAttempt is a sign-in attempt seen by the login flow.
+type LoginAttempt struct {
+	// UserID identifies the account. When it is uuid.Nil the account is
+	// looked up by Email.
+	UserID    uuid.UUID
+	Email     string
+	IPAddress string
+	UserAgent string
+	// FailureReason is one of the domain.LoginFailure constants, or empty
+	// for a successful login.
+	FailureReason string
+}
+
+// NewDeviceNotifier tells a user about a login from a new device, so they
+// can react if it was not them.
+type NewDeviceNotifier interface {
+	NotifyNewDevice(ctx context.Context, user *domain.User, event *domain.LoginEvent) error
+}
+
+// LoginHistoryConfig configures the login history.
+type LoginHistoryConfig struct {
+	Events domain.LoginEventRepository
+	Users  domain.UserRepository
+	// Notifier, if set, is told about logins from new devices.
+	Notifier NewDeviceNotifier
+	// Retention is how long events are kept.
+	Retention time.Duration
+	Logger    *slog.Logger
+}
+
+type loginHistoryUseCase struct {
+	cfg LoginHistoryConfig
+}
+
+// NewLoginHistoryUseCase creates the login history use case.
+func NewLoginHistoryUseCase(cfg LoginHistoryConfig) LoginHistoryUseCase {
+	return &loginHistoryUseCase{cfg: cfg}
+}
+
+func (uc *loginHistoryUseCase) RecordLogin(ctx context.Context, attempt LoginAttempt) error {
+	var user *domain.User
+	var err error
+	if attempt.UserID != uuid.Nil {
+		user, err = uc.cfg.Users.FindByID(ctx, attempt.UserID)
+	} else {
+		user, err = uc.cfg.Users.FindByEmail(ctx, attempt.Email)
+	}
+	if errors.Is(err, domain.ErrUserNotFound) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("find user: %w", err)
+	}
+
+	now := time.Now()
+	event := domain.NewLoginEvent(user.ID, attempt.IPAddress, attempt.UserAgent, attempt.FailureReason, now)
+	if event.Succeeded {
+		// The first login of an account is not from a new device, as there
+		// is no known one to compare with.
+		event.NewDevice, err = uc.isNewDevice(ctx, event)
+		if err != nil {
+			return err
+		}
+	}
+
+	if err := uc.cfg.Events.Create(ctx, event); err != nil {
+		return fmt.Errorf("record login event: %w", err)
+	}
+	if err := uc.cfg.Events.DeleteBefore(ctx, user.ID, now.Add(-uc.cfg.Retention)); err != nil {
+		uc.cfg.Logger.WarnContext(ctx, "failed to delete expired login events",
+			slog.String("user_id", user.ID.String()),
+			slog.String("error", err.Error()),
+		)
+	}
+
+	// A failed notification does not undo the login.
+	if event.NewDevice && uc.cfg.Notifier != nil {
+		if err := uc.cfg.Notifier.NotifyNewDevice(ctx, user, event); err != nil {
+			uc.cfg.Logger.ErrorContext(ctx, "failed to notify about a new device",
+				slog.String("user_id", user.ID.String()),
+				slog.String("error", err.Error()),
+			)
+		}
+	}
+	return nil
+}
+
+func (uc *loginHistoryUseCase) isNewDevice(ctx context.Context, event *domain.LoginEvent) (bool, error) {
+	known, err := uc.cfg.Events.HasSucceededFrom(ctx, event.UserID, event.IPAddress, event.UserAgent)
+	if err != nil || known {
+		return false, err
+	}
+	loggedInBefore, err := uc.cfg.Events.HasSucceeded(ctx, event.UserID)
+	if err != nil {
+		return false, err
+	}
+	return loggedInBefore, nil
+}
+
+func (uc *loginHistoryUseCase) GetLoginHistory(ctx context.Context, userID uuid.UUID, pageSize int) ([]*domain.LoginEvent, error) {
+	if pageSize <= 0 {
+		pageSize = DefaultLoginHistoryPageSize
+	}
+	pageSize = min(pageSize, MaxLoginHistoryPageSize)
+	return uc.cfg.Events.ListByUser(ctx, userID, pageSize)
+}
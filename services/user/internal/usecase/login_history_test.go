@@ -0,0 +1,189 @@
+package usecase
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/daisuke8000/example-ec-platform/services/user/internal/domain"
+)
+
+// mockLoginEventRepository keeps events in creation order.
+type mockLoginEventRepository struct {
+	events []*domain.LoginEvent
+}
+
+func (m *mockLoginEventRepository) Create(ctx context.Context, event *domain.LoginEvent) error {
+	m.events = append(m.events, event)
+	return nil
+}
+
+func (m *mockLoginEventRepository) ListByUser(ctx context.Context, userID uuid.UUID, limit int) ([]*domain.LoginEvent, error) {
+	var events []*domain.LoginEvent
+	for i := len(m.events) - 1; i >= 0 && len(events) < limit; i-- {
+		if m.events[i].UserID == userID {
+			events = append(events, m.events[i])
+		}
+	}
+	return events, nil
+}
+
+func (m *mockLoginEventRepository) HasSucceeded(ctx context.Context, userID uuid.UUID) (bool, error) {
+	for _, e := range m.events {
+		if e.UserID == userID && e.Succeeded {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func (m *mockLoginEventRepository) HasSucceededFrom(ctx context.Context, userID uuid.UUID, ipAddress, userAgent string) (bool, error) {
+	for _, e := range m.events {
+		if e.UserID == userID && e.Succeeded && e.IPAddress == ipAddress && e.UserAgent == userAgent {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func (m *mockLoginEventRepository) DeleteBefore(ctx context.Context, userID uuid.UUID, before time.Time) error {
+	kept := m.events[:0]
+	for _, e := range m.events {
+		if e.UserID != userID || !e.OccurredAt.Before(before) {
+			kept = append(kept, e)
+		}
+	}
+	m.events = kept
+	return nil
+}
+
+// mockNewDeviceNotifier records the notified events.
+type mockNewDeviceNotifier struct {
+	events []*domain.LoginEvent
+}
+
+func (m *mockNewDeviceNotifier) NotifyNewDevice(ctx context.Context, user *domain.User, event *domain.LoginEvent) error {
+	m.events = append(m.events, event)
+	return nil
+}
+
+func newTestLoginHistoryUseCase(events *mockLoginEventRepository, users *mockUserRepository, notifier *mockNewDeviceNotifier) LoginHistoryUseCase {
+	return NewLoginHistoryUseCase(LoginHistoryConfig{
+		Events:    events,
+		Users:     users,
+		Notifier:  notifier,
+		Retention: 90 * 24 * time.Hour,
+		Logger:    slog.New(slog.NewTextHandler(io.Discard, nil)),
+	})
+}
+
+func TestLoginHistoryUseCase_RecordLogin(t *testing.T) {
+	users := newMockUserRepository()
+	user := &domain.User{ID: uuid.New(), Email: "test@example.com"}
+	users.seedUser(user)
+	events := &mockLoginEventRepository{}
+	notifier := &mockNewDeviceNotifier{}
+	uc := newTestLoginHistoryUseCase(events, users, notifier)
+	ctx := context.Background()
+
+	laptop := LoginAttempt{UserID: user.ID, IPAddress: "203.0.113.7", UserAgent: "Firefox"}
+	phone := LoginAttempt{UserID: user.ID, IPAddress: "198.51.100.2", UserAgent: "Safari"}
+
+	steps := []struct {
+		name          string
+		attempt       LoginAttempt
+		wantNewDevice bool
+	}{
+		{"first login", laptop, false},
+		{"same device", laptop, false},
+		{"failed login from a new device", LoginAttempt{Email: user.Email, IPAddress: "192.0.2.1", UserAgent: "curl", FailureReason: domain.LoginFailureInvalidPassword}, false},
+		{"new device", phone, true},
+		{"known again", phone, false},
+	}
+	for i, step := range steps {
+		if err := uc.RecordLogin(ctx, step.attempt); err != nil {
+			t.Fatalf("%s: RecordLogin() error = %v", step.name, err)
+		}
+		if len(events.events) != i+1 {
+			t.Fatalf("%s: %d events recorded, want %d", step.name, len(events.events), i+1)
+		}
+		if got := events.events[i].NewDevice; got != step.wantNewDevice {
+			t.Errorf("%s: NewDevice = %v, want %v", step.name, got, step.wantNewDevice)
+		}
+	}
+
+	if len(notifier.events) != 1 || notifier.events[0].UserAgent != "Safari" {
+		t.Errorf("notified %d events, want the Safari login", len(notifier.events))
+	}
+	if failed := events.events[2]; failed.Succeeded || failed.UserID != user.ID {
+		t.Errorf("failed login = %+v, want a failure of the user found by email", failed)
+	}
+}
+
+func TestLoginHistoryUseCase_RecordLogin_UnknownEmail(t *testing.T) {
+	events := &mockLoginEventRepository{}
+	uc := newTestLoginHistoryUseCase(events, newMockUserRepository(), &mockNewDeviceNotifier{})
+
+	err := uc.RecordLogin(context.Background(), LoginAttempt{
+		Email:         "nobody@example.com",
+		FailureReason: domain.LoginFailureInvalidPassword,
+	})
+	if err != nil {
+		t.Fatalf("RecordLogin() error = %v", err)
+	}
+	if len(events.events) != 0 {
+		t.Errorf("%d events recorded for an unknown address, want 0", len(events.events))
+	}
+}
+
+func TestLoginHistoryUseCase_RecordLogin_DeletesExpiredEvents(t *testing.T) {
+	users := newMockUserRepository()
+	user := &domain.User{ID: uuid.New(), Email: "test@example.com"}
+	users.seedUser(user)
+	events := &mockLoginEventRepository{events: []*domain.LoginEvent{
+		{ID: uuid.New(), UserID: user.ID, Succeeded: true, OccurredAt: time.Now().Add(-100 * 24 * time.Hour)},
+	}}
+	uc := newTestLoginHistoryUseCase(events, users, &mockNewDeviceNotifier{})
+
+	if err := uc.RecordLogin(context.Background(), LoginAttempt{UserID: user.ID}); err != nil {
+		t.Fatalf("RecordLogin() error = %v", err)
+	}
+	if len(events.events) != 1 || events.events[0].OccurredAt.Before(time.Now().Add(-time.Hour)) {
+		t.Errorf("events = %+v, want only the new one", events.events)
+	}
+}
+
+func TestLoginHistoryUseCase_GetLoginHistory(t *testing.T) {
+	userID := uuid.New()
+	events := &mockLoginEventRepository{}
+	for i := 0; i < MaxLoginHistoryPageSize+5; i++ {
+		events.events = append(events.events, &domain.LoginEvent{ID: uuid.New(), UserID: userID, Succeeded: true})
+	}
+	uc := newTestLoginHistoryUseCase(events, newMockUserRepository(), &mockNewDeviceNotifier{})
+
+	tests := []struct {
+		pageSize int
+		want     int
+	}{
+		{0, DefaultLoginHistoryPageSize},
+		{5, 5},
+		{MaxLoginHistoryPageSize + 1, MaxLoginHistoryPageSize},
+	}
+	for _, tt := range tests {
+		got, err := uc.GetLoginHistory(context.Background(), userID, tt.pageSize)
+		if err != nil {
+			t.Fatalf("GetLoginHistory(%d) error = %v", tt.pageSize, err)
+		}
+		if len(got) != tt.want {
+			t.Errorf("GetLoginHistory(%d) = %d events, want %d", tt.pageSize, len(got), tt.want)
+		}
+	}
+	latest, _ := uc.GetLoginHistory(context.Background(), userID, 1)
+	if latest[0] != events.events[len(events.events)-1] {
+		t.Error("GetLoginHistory() does not return the latest event first")
+	}
+}
@@ -0,0 +1,105 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/google/uuid"
+
+	"github.com/daisuke8000/example-ec-platform/services/user/internal/domain"
+)
+
+type SessionUseCase interface {
+	// ListSessions returns the user's login sessions, most recently used first.
+	ListSessions(ctx context.Context, userID uuid.UUID) ([]*domain.Session, error)
+	// RevokeSession returns the clients whose tokens were revoked.
+	RevokeSession(ctx context.Context, userID uuid.UUID, sessionID string) ([]string, error)
+	// ListConnectedApps returns the clients the user granted access to,
+	// most recently granted first. They are revoked with
+	// ConsentUseCase.RevokeConsent.
+	ListConnectedApps(ctx context.Context, userID uuid.UUID) ([]*domain.ConnectedApp, error)
+}
+
+// SessionProvider reads and ends login sessions at the authorization server.
+type SessionProvider interface {
+	ListSessionGrants(ctx context.Context, subject string) ([]domain.SessionGrant, error)
+	RevokeLoginSession(ctx context.Context, sessionID string) error
+}
+
+type sessionUseCase struct {
+	provider SessionProvider
+	consent  ConsentUseCase
+}
+
+// NewSessionUseCase creates the session management use case. Tokens are
+// revoked through consent, which also marks the consent receipts revoked.
+func NewSessionUseCase(provider SessionProvider, consent ConsentUseCase) SessionUseCase {
+	return &sessionUseCase{
+		provider: provider,
+		consent:  consent,
+	}
+}
+
+func (uc *sessionUseCase) ListSessions(ctx context.Context, userID uuid.UUID) ([]*domain.Session, error) {
+	grants, err := uc.provider.ListSessionGrants(ctx, userID.String())
+	if err != nil {
+		return nil, fmt.Errorf("failed to list sessions at authorization server: %w", err)
+	}
+	return domain.SessionsFromGrants(grants), nil
+}
+
+func (uc *sessionUseCase) ListConnectedApps(ctx context.Context, userID uuid.UUID) ([]*domain.ConnectedApp, error) {
+	grants, err := uc.provider.ListSessionGrants(ctx, userID.String())
+	if err != nil {
+		return nil, fmt.Errorf("failed to list consents at authorization server: %w", err)
+	}
+	return domain.ConnectedAppsFromGrants(grants), nil
+}
+
+func (uc *sessionUseCase) RevokeSession(ctx context.Context, userID uuid.UUID, sessionID string) ([]string, error) {
+	if sessionID == "" {
+		return nil, domain.ErrEmptySessionID
+	}
+
+	// Listing by subject checks that the session belongs to the user before
+	// anything is revoked.
+	sessions, err := uc.ListSessions(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	i := slices.IndexFunc(sessions, func(s *domain.Session) bool { return s.ID == sessionID })
+	if i < 0 {
+		return nil, domain.ErrSessionNotFound
+	}
+
+	if err := uc.provider.RevokeLoginSession(ctx, sessionID); err != nil {
+		return nil, fmt.Errorf("failed to revoke session at authorization server: %w", err)
+	}
+
+	// Consent can only be revoked per client, so clients the user also
+	// signed in to elsewhere keep their tokens.
+	var revoked []string
+	for _, client := range sessions[i].Clients {
+		if usedElsewhere(sessions, sessionID, client.ClientID) {
+			continue
+		}
+		if _, err := uc.consent.RevokeConsent(ctx, userID, client.ClientID); err != nil {
+			return revoked, fmt.Errorf("failed to revoke tokens of client %s: %w", client.ClientID, err)
+		}
+		revoked = append(revoked, client.ClientID)
+	}
+	return revoked, nil
+}
+
+func usedElsewhere(sessions []*domain.Session, sessionID, clientID string) bool {
+	for _, s := range sessions {
+		if s.ID == sessionID {
+			continue
+		}
+		if slices.ContainsFunc(s.Clients, func(c domain.SessionClient) bool { return c.ClientID == clientID }) {
+			return true
+		}
+	}
+	return false
+}
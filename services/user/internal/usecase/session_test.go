@@ -0,0 +1,100 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/daisuke8000/example-ec-platform/services/user/internal/domain"
+)
+
+// mockSessionProvider serves fixed grants and records revoked sessions.
+type mockSessionProvider struct {
+	grants  map[string][]domain.SessionGrant
+	revoked []string
+}
+
+func (m *mockSessionProvider) ListSessionGrants(ctx context.Context, subject string) ([]domain.SessionGrant, error) {
+	return m.grants[subject], nil
+}
+
+func (m *mockSessionProvider) RevokeLoginSession(ctx context.Context, sessionID string) error {
+	m.revoked = append(m.revoked, sessionID)
+	return nil
+}
+
+func TestSessionUseCase_RevokeSession(t *testing.T) {
+	userID := uuid.New()
+	otherID := uuid.New()
+	now := time.Now()
+	provider := &mockSessionProvider{grants: map[string][]domain.SessionGrant{
+		userID.String(): {
+			{SessionID: "laptop", ClientID: "spa", GrantedAt: now.Add(-time.Hour)},
+			{SessionID: "laptop", ClientID: "admin", GrantedAt: now.Add(-time.Hour)},
+			{SessionID: "phone", ClientID: "spa", GrantedAt: now},
+		},
+		otherID.String(): {
+			{SessionID: "tablet", ClientID: "spa", GrantedAt: now},
+		},
+	}}
+	revoker := &mockConsentRevoker{}
+	uc := NewSessionUseCase(provider, NewConsentUseCase(&mockConsentRepository{}, revoker))
+
+	sessions, err := uc.ListSessions(context.Background(), userID)
+	if err != nil {
+		t.Fatalf("ListSessions() error = %v", err)
+	}
+	if len(sessions) != 2 || sessions[0].ID != "phone" {
+		t.Fatalf("ListSessions() = %d sessions starting with %q, want 2 starting with phone", len(sessions), sessions[0].ID)
+	}
+
+	if _, err := uc.RevokeSession(context.Background(), userID, "tablet"); !errors.Is(err, domain.ErrSessionNotFound) {
+		t.Errorf("RevokeSession(other user's session) error = %v, want %v", err, domain.ErrSessionNotFound)
+	}
+	if _, err := uc.RevokeSession(context.Background(), userID, ""); !errors.Is(err, domain.ErrEmptySessionID) {
+		t.Errorf("RevokeSession(\"\") error = %v, want %v", err, domain.ErrEmptySessionID)
+	}
+	if len(provider.revoked) != 0 {
+		t.Fatalf("sessions revoked after rejected requests: %v", provider.revoked)
+	}
+
+	revoked, err := uc.RevokeSession(context.Background(), userID, "laptop")
+	if err != nil {
+		t.Fatalf("RevokeSession() error = %v", err)
+	}
+	if !slices.Equal(provider.revoked, []string{"laptop"}) {
+		t.Errorf("revoked login sessions = %v, want [laptop]", provider.revoked)
+	}
+	// spa is still used on the phone and keeps its tokens.
+	if !slices.Equal(revoked, []string{"admin"}) {
+		t.Errorf("RevokeSession() revoked clients = %v, want [admin]", revoked)
+	}
+	if !slices.Equal(revoker.revoked, []string{userID.String() + "/admin"}) {
+		t.Errorf("consent revoked = %v, want only admin", revoker.revoked)
+	}
+}
+
+func TestSessionUseCase_ListConnectedApps(t *testing.T) {
+	userID := uuid.New()
+	now := time.Now()
+	provider := &mockSessionProvider{grants: map[string][]domain.SessionGrant{
+		userID.String(): {
+			{SessionID: "laptop", ClientID: "spa", GrantedAt: now.Add(-time.Hour)},
+			{SessionID: "phone", ClientID: "spa", GrantedAt: now},
+			{ClientID: "cli", GrantedAt: now.Add(-time.Minute)},
+		},
+	}}
+	uc := NewSessionUseCase(provider, NewConsentUseCase(&mockConsentRepository{}, &mockConsentRevoker{}))
+
+	apps, err := uc.ListConnectedApps(context.Background(), userID)
+	if err != nil {
+		t.Fatalf("ListConnectedApps() error = %v", err)
+	}
+	if len(apps) != 2 || apps[0].ClientID != "spa" || apps[0].Sessions != 2 || apps[1].ClientID != "cli" {
+		t.Errorf("ListConnectedApps() = %+v, want spa in 2 sessions, then cli", apps)
+	}
+}
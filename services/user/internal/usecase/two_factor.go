@@ -0,0 +1,161 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/daisuke8000/example-ec-platform/services/user/internal/domain"
+)
+
+type TwoFactorUseCase interface {
+	// EnrollTOTP starts an enrollment, replacing a pending one. It does not
+	// guard sign-in until ConfirmTOTP.
+	EnrollTOTP(ctx context.Context, userID uuid.UUID) (*TOTPEnrollment, error)
+	// ConfirmTOTP enables two-factor authentication with the first code from
+	// the authenticator app and returns the recovery codes, shown only once.
+	ConfirmTOTP(ctx context.Context, userID uuid.UUID, code string) ([]string, error)
+	// DisableTOTP removes the enrollment; it requires a current code or a
+	// recovery code.
+	DisableTOTP(ctx context.Context, userID uuid.UUID, code string) error
+	GetStatus(ctx context.Context, userID uuid.UUID) (*TwoFactorStatus, error)
+	// RequiresCode reports whether signing in needs a second factor.
+	RequiresCode(ctx context.Context, userID uuid.UUID) (bool, error)
+	// VerifyCode checks the second factor of a sign-in: a current code or a
+	// recovery code. Either can be used only once.
+	VerifyCode(ctx context.Context, userID uuid.UUID, code string) error
+}
+
+// TOTPEnrollment is what an authenticator app needs: the secret to type in
+// and the otpauth:// URI to show as a QR code.
+type TOTPEnrollment struct {
+	Secret          string
+	ProvisioningURI string
+}
+
+type TwoFactorStatus struct {
+	Enabled                bool
+	RecoveryCodesRemaining int
+}
+
+type twoFactorUseCase struct {
+	repo   domain.TwoFactorRepository
+	users  domain.UserRepository
+	issuer string
+	now    func() time.Time
+}
+
+// NewTwoFactorUseCase creates the TOTP use case. issuer names the service
+// in authenticator apps.
+func NewTwoFactorUseCase(repo domain.TwoFactorRepository, users domain.UserRepository, issuer string) TwoFactorUseCase {
+	return &twoFactorUseCase{
+		repo:   repo,
+		users:  users,
+		issuer: issuer,
+		now:    time.Now,
+	}
+}
+
+func (uc *twoFactorUseCase) EnrollTOTP(ctx context.Context, userID uuid.UUID) (*TOTPEnrollment, error) {
+	user, err := uc.users.FindByID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	tf, err := domain.NewTwoFactor(userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate TOTP secret: %w", err)
+	}
+	if err := uc.repo.SavePending(ctx, tf); err != nil {
+		return nil, err
+	}
+
+	return &TOTPEnrollment{
+		Secret:          tf.EncodedSecret(),
+		ProvisioningURI: tf.ProvisioningURI(uc.issuer, user.Email),
+	}, nil
+}
+
+func (uc *twoFactorUseCase) ConfirmTOTP(ctx context.Context, userID uuid.UUID, code string) ([]string, error) {
+	tf, err := uc.repo.Get(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if tf.Enabled() {
+		return nil, domain.ErrTwoFactorAlreadyEnabled
+	}
+	step, ok := tf.MatchCode(code, uc.now())
+	if !ok {
+		return nil, domain.ErrInvalidTOTPCode
+	}
+
+	codes, hashes, err := domain.NewRecoveryCodes()
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate recovery codes: %w", err)
+	}
+	if err := uc.repo.Enable(ctx, userID, step, hashes, uc.now().UTC()); err != nil {
+		return nil, err
+	}
+	return codes, nil
+}
+
+func (uc *twoFactorUseCase) DisableTOTP(ctx context.Context, userID uuid.UUID, code string) error {
+	tf, err := uc.repo.Get(ctx, userID)
+	if err != nil {
+		return err
+	}
+	// A pending enrollment does not guard anything yet.
+	if tf.Enabled() {
+		if err := uc.useCode(ctx, tf, code); err != nil {
+			return err
+		}
+	}
+	return uc.repo.Delete(ctx, userID)
+}
+
+func (uc *twoFactorUseCase) GetStatus(ctx context.Context, userID uuid.UUID) (*TwoFactorStatus, error) {
+	tf, err := uc.repo.Get(ctx, userID)
+	if errors.Is(err, domain.ErrTwoFactorNotEnrolled) {
+		return &TwoFactorStatus{}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &TwoFactorStatus{
+		Enabled:                tf.Enabled(),
+		RecoveryCodesRemaining: len(tf.RecoveryCodeHashes),
+	}, nil
+}
+
+func (uc *twoFactorUseCase) RequiresCode(ctx context.Context, userID uuid.UUID) (bool, error) {
+	status, err := uc.GetStatus(ctx, userID)
+	if err != nil {
+		return false, err
+	}
+	return status.Enabled, nil
+}
+
+func (uc *twoFactorUseCase) VerifyCode(ctx context.Context, userID uuid.UUID, code string) error {
+	tf, err := uc.repo.Get(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if !tf.Enabled() {
+		return domain.ErrTwoFactorNotEnrolled
+	}
+	return uc.useCode(ctx, tf, code)
+}
+
+// useCode accepts a current code or a recovery code and marks it used.
+func (uc *twoFactorUseCase) useCode(ctx context.Context, tf *domain.TwoFactor, code string) error {
+	if step, ok := tf.MatchCode(code, uc.now()); ok {
+		return uc.repo.UseStep(ctx, tf.UserID, step)
+	}
+	if tf.HasRecoveryCode(code) {
+		return uc.repo.UseRecoveryCode(ctx, tf.UserID, domain.HashRecoveryCode(code))
+	}
+	return domain.ErrInvalidTOTPCode
+}
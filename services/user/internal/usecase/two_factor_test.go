@@ -0,0 +1,151 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/daisuke8000/example-ec-platform/services/user/internal/domain"
+)
+
+// mockTwoFactorRepository is an in-memory domain.TwoFactorRepository.
+type mockTwoFactorRepository struct {
+	enrollments map[uuid.UUID]*domain.TwoFactor
+}
+
+func newMockTwoFactorRepository() *mockTwoFactorRepository {
+	return &mockTwoFactorRepository{enrollments: make(map[uuid.UUID]*domain.TwoFactor)}
+}
+
+func (m *mockTwoFactorRepository) Get(ctx context.Context, userID uuid.UUID) (*domain.TwoFactor, error) {
+	tf, ok := m.enrollments[userID]
+	if !ok {
+		return nil, domain.ErrTwoFactorNotEnrolled
+	}
+	copied := *tf
+	copied.RecoveryCodeHashes = slices.Clone(tf.RecoveryCodeHashes)
+	return &copied, nil
+}
+
+func (m *mockTwoFactorRepository) SavePending(ctx context.Context, tf *domain.TwoFactor) error {
+	if existing, ok := m.enrollments[tf.UserID]; ok && existing.Enabled() {
+		return domain.ErrTwoFactorAlreadyEnabled
+	}
+	m.enrollments[tf.UserID] = tf
+	return nil
+}
+
+func (m *mockTwoFactorRepository) Enable(ctx context.Context, userID uuid.UUID, step int64, recoveryCodeHashes []string, enabledAt time.Time) error {
+	tf, ok := m.enrollments[userID]
+	if !ok || tf.Enabled() {
+		return domain.ErrTwoFactorAlreadyEnabled
+	}
+	tf.EnabledAt = &enabledAt
+	tf.LastUsedStep = step
+	tf.RecoveryCodeHashes = recoveryCodeHashes
+	return nil
+}
+
+func (m *mockTwoFactorRepository) UseStep(ctx context.Context, userID uuid.UUID, step int64) error {
+	tf, ok := m.enrollments[userID]
+	if !ok || tf.LastUsedStep >= step {
+		return domain.ErrInvalidTOTPCode
+	}
+	tf.LastUsedStep = step
+	return nil
+}
+
+func (m *mockTwoFactorRepository) UseRecoveryCode(ctx context.Context, userID uuid.UUID, codeHash string) error {
+	tf, ok := m.enrollments[userID]
+	if !ok {
+		return domain.ErrInvalidTOTPCode
+	}
+	i := slices.Index(tf.RecoveryCodeHashes, codeHash)
+	if i < 0 {
+		return domain.ErrInvalidTOTPCode
+	}
+	tf.RecoveryCodeHashes = slices.Delete(tf.RecoveryCodeHashes, i, i+1)
+	return nil
+}
+
+func (m *mockTwoFactorRepository) Delete(ctx context.Context, userID uuid.UUID) error {
+	if _, ok := m.enrollments[userID]; !ok {
+		return domain.ErrTwoFactorNotEnrolled
+	}
+	delete(m.enrollments, userID)
+	return nil
+}
+
+func TestTwoFactorUseCase_EnrollAndVerify(t *testing.T) {
+	ctx := context.Background()
+	userRepo := newMockUserRepository()
+	user := &domain.User{ID: uuid.New(), Email: "alice@example.com"}
+	userRepo.users[user.ID] = user
+	repo := newMockTwoFactorRepository()
+	uc := NewTwoFactorUseCase(repo, userRepo, "EC Platform").(*twoFactorUseCase)
+
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	uc.now = func() time.Time { return now }
+	codeAt := func(at time.Time) string {
+		return domain.TOTPCode(repo.enrollments[user.ID].Secret, at.Unix()/30)
+	}
+
+	if _, err := uc.EnrollTOTP(ctx, user.ID); err != nil {
+		t.Fatalf("EnrollTOTP() error = %v", err)
+	}
+	if required, _ := uc.RequiresCode(ctx, user.ID); required {
+		t.Error("RequiresCode() = true before confirmation, want false")
+	}
+
+	if _, err := uc.ConfirmTOTP(ctx, user.ID, "000000"); !errors.Is(err, domain.ErrInvalidTOTPCode) {
+		t.Errorf("ConfirmTOTP(wrong code) error = %v, want %v", err, domain.ErrInvalidTOTPCode)
+	}
+	codes, err := uc.ConfirmTOTP(ctx, user.ID, codeAt(now))
+	if err != nil {
+		t.Fatalf("ConfirmTOTP() error = %v", err)
+	}
+	if len(codes) != domain.RecoveryCodeCount {
+		t.Errorf("ConfirmTOTP() = %d recovery codes, want %d", len(codes), domain.RecoveryCodeCount)
+	}
+	if required, _ := uc.RequiresCode(ctx, user.ID); !required {
+		t.Error("RequiresCode() = false after confirmation, want true")
+	}
+	if _, err := uc.EnrollTOTP(ctx, user.ID); !errors.Is(err, domain.ErrTwoFactorAlreadyEnabled) {
+		t.Errorf("EnrollTOTP(enabled) error = %v, want %v", err, domain.ErrTwoFactorAlreadyEnabled)
+	}
+
+	// The confirmation code cannot be replayed to sign in.
+	if err := uc.VerifyCode(ctx, user.ID, codeAt(now)); !errors.Is(err, domain.ErrInvalidTOTPCode) {
+		t.Errorf("VerifyCode(replayed) error = %v, want %v", err, domain.ErrInvalidTOTPCode)
+	}
+	now = now.Add(domain.TOTPPeriod)
+	if err := uc.VerifyCode(ctx, user.ID, codeAt(now)); err != nil {
+		t.Errorf("VerifyCode(next step) error = %v", err)
+	}
+
+	// Recovery codes work once.
+	if err := uc.VerifyCode(ctx, user.ID, codes[0]); err != nil {
+		t.Errorf("VerifyCode(recovery code) error = %v", err)
+	}
+	if err := uc.VerifyCode(ctx, user.ID, codes[0]); !errors.Is(err, domain.ErrInvalidTOTPCode) {
+		t.Errorf("VerifyCode(used recovery code) error = %v, want %v", err, domain.ErrInvalidTOTPCode)
+	}
+	status, err := uc.GetStatus(ctx, user.ID)
+	if err != nil || status.RecoveryCodesRemaining != domain.RecoveryCodeCount-1 {
+		t.Errorf("GetStatus() = %+v, %v; want %d recovery codes", status, err, domain.RecoveryCodeCount-1)
+	}
+
+	if err := uc.DisableTOTP(ctx, user.ID, "000000"); !errors.Is(err, domain.ErrInvalidTOTPCode) {
+		t.Errorf("DisableTOTP(wrong code) error = %v, want %v", err, domain.ErrInvalidTOTPCode)
+	}
+	if err := uc.DisableTOTP(ctx, user.ID, codes[1]); err != nil {
+		t.Fatalf("DisableTOTP() error = %v", err)
+	}
+	if status, _ := uc.GetStatus(ctx, user.ID); status.Enabled {
+		t.Error("GetStatus().Enabled = true after DisableTOTP, want false")
+	}
+}
@@ -2,12 +2,15 @@ package usecase
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"log/slog"
+	"time"
 
 	"github.com/google/uuid"
-	"golang.org/x/crypto/bcrypt"
 
 	"github.com/daisuke8000/example-ec-platform/services/user/internal/domain"
+	"github.com/daisuke8000/example-ec-platform/services/user/internal/passwordhash"
 )
 
 type UserUseCase interface {
@@ -16,6 +19,12 @@ type UserUseCase interface {
 	UpdateUser(ctx context.Context, id uuid.UUID, input UpdateUserInput) (*domain.User, error)
 	DeleteUser(ctx context.Context, id uuid.UUID) error
 	VerifyPassword(ctx context.Context, email, password string) (*domain.User, error)
+	VerifyEmail(ctx context.Context, token string) (*domain.User, error)
+	ResendVerificationEmail(ctx context.Context, id uuid.UUID) error
+	ListUsers(ctx context.Context, input ListUsersInput) (*ListUsersOutput, error)
+	GetUserRoles(ctx context.Context, id uuid.UUID) ([]*domain.Role, error)
+	UnlockUser(ctx context.Context, id uuid.UUID) error
+	ChangePassword(ctx context.Context, id uuid.UUID, currentPassword, newPassword string) error
 }
 
 type CreateUserInput struct {
@@ -29,32 +38,117 @@ type UpdateUserInput struct {
 	Name  *string
 }
 
+type ListUsersInput struct {
+	Filter    domain.UserFilter
+	PageSize  int
+	PageToken string
+}
+
+type ListUsersOutput struct {
+	Users         []*domain.User
+	NextPageToken string
+}
+
+// VerificationSender delivers email verification tokens to users.
+type VerificationSender interface {
+	SendVerification(ctx context.Context, user *domain.User, token string) error
+}
+
+// EmailVerificationConfig enables email verification for new accounts.
+// A failed delivery after the account is saved is logged rather than
+// failing the request; the user can ask for the email again with
+// ResendVerificationEmail.
+type EmailVerificationConfig struct {
+	Tokens   domain.EmailVerificationRepository
+	Sender   VerificationSender
+	TokenTTL time.Duration
+	Logger   *slog.Logger
+}
+
+// LockoutConfig locks an email address out of password login for Duration
+// after MaxAttempts consecutive failed logins.
+type LockoutConfig struct {
+	Lockouts    domain.LoginLockoutRepository
+	MaxAttempts int
+	Duration    time.Duration
+}
+
+// TxManager runs fn in a transaction that repository calls made with the
+// ctx passed to fn join.
+type TxManager interface {
+	Do(ctx context.Context, fn func(ctx context.Context) error) error
+}
+
+// BreachedPasswordChecker looks up passwords known from data breaches.
+type BreachedPasswordChecker interface {
+	IsBreached(ctx context.Context, password string) (bool, error)
+}
+
+// PasswordConfig sets the policy new passwords must meet. With Breached
+// set, passwords known from data breaches are rejected too. A failed
+// lookup is logged and the password accepted, so an outage of the lookup
+// does not block sign-ups and password changes.
+type PasswordConfig struct {
+	Policy   domain.PasswordPolicy
+	Breached BreachedPasswordChecker
+	Logger   *slog.Logger
+}
+
 type userUseCase struct {
-	repo       domain.UserRepository
-	bcryptCost int
-	dummyHash  []byte
+	repo         domain.UserRepository
+	tx           TxManager
+	hasher       passwordhash.Hasher
+	dummyHash    string
+	verification *EmailVerificationConfig
+	lockout      *LockoutConfig
+	passwords    PasswordConfig
 }
 
-func NewUserUseCase(repo domain.UserRepository, bcryptCost int) UserUseCase {
-	dummyHash, err := bcrypt.GenerateFromPassword([]byte("dummy-password-for-timing-safe"), bcryptCost)
+// NewUserUseCase creates the user use case.
+// A nil tx manager runs the steps of multi-step flows, such as creating a
+// user and its verification token, without a transaction.
+// A nil verification config creates accounts as already verified.
+// A nil lockout config leaves failed logins to the rate limiter.
+// A nil password config applies domain.DefaultPasswordPolicy.
+// New passwords are hashed with hasher; stored hashes of any algorithm of
+// package passwordhash are accepted and rehashed when hasher reports them
+// as outdated.
+func NewUserUseCase(repo domain.UserRepository, tx TxManager, hasher passwordhash.Hasher, verification *EmailVerificationConfig, lockout *LockoutConfig, passwords *PasswordConfig) UserUseCase {
+	dummyHash, err := hasher.Hash("dummy-password-for-timing-safe")
 	if err != nil {
 		panic(fmt.Sprintf("failed to generate dummy hash: %v", err))
 	}
-	return &userUseCase{
-		repo:       repo,
-		bcryptCost: bcryptCost,
-		dummyHash:  dummyHash,
+	uc := &userUseCase{
+		repo:         repo,
+		tx:           tx,
+		hasher:       hasher,
+		dummyHash:    dummyHash,
+		verification: verification,
+		lockout:      lockout,
+		passwords:    PasswordConfig{Policy: domain.DefaultPasswordPolicy()},
+	}
+	if passwords != nil {
+		uc.passwords = *passwords
+	}
+	if verification != nil && verification.Logger == nil {
+		copied := *verification
+		copied.Logger = slog.Default()
+		uc.verification = &copied
+	}
+	if uc.passwords.Logger == nil {
+		uc.passwords.Logger = slog.Default()
 	}
+	return uc
 }
 
 func (uc *userUseCase) CreateUser(ctx context.Context, input CreateUserInput) (*domain.User, error) {
 	if err := domain.ValidateEmail(input.Email); err != nil {
 		return nil, err
 	}
-	if err := domain.ValidatePassword(input.Password); err != nil {
+	if err := domain.ValidateName(input.Name); err != nil {
 		return nil, err
 	}
-	if err := domain.ValidateName(input.Name); err != nil {
+	if err := uc.validateNewPassword(ctx, input.Password); err != nil {
 		return nil, err
 	}
 
@@ -66,16 +160,37 @@ func (uc *userUseCase) CreateUser(ctx context.Context, input CreateUserInput) (*
 		return nil, err
 	}
 
-	hashedPassword, err := bcrypt.GenerateFromPassword([]byte(input.Password), uc.bcryptCost)
+	hashedPassword, err := uc.hasher.Hash(input.Password)
 	if err != nil {
 		return nil, err
 	}
 
-	user := domain.NewUser(input.Email, string(hashedPassword), input.Name)
-	if err := uc.repo.Create(ctx, user); err != nil {
+	user := domain.NewUser(input.Email, hashedPassword, input.Name)
+	if uc.verification == nil {
+		user.MarkEmailVerified(user.CreatedAt)
+	}
+	// The account and its verification token are stored together, so a
+	// failed token write does not leave an account that cannot be verified.
+	var token string
+	err = uc.inTx(ctx, func(ctx context.Context) error {
+		if err := uc.repo.Create(ctx, user); err != nil {
+			return err
+		}
+		if uc.verification != nil {
+			var err error
+			token, err = uc.storeVerificationToken(ctx, user)
+			return err
+		}
+		return nil
+	})
+	if err != nil {
 		return nil, err
 	}
 
+	if uc.verification != nil {
+		uc.sendVerification(ctx, user, token)
+	}
+
 	return user, nil
 }
 
@@ -102,6 +217,10 @@ func (uc *userUseCase) UpdateUser(ctx context.Context, id uuid.UUID, input Updat
 				return nil, err
 			}
 		}
+		if *input.Email != user.Email && uc.verification != nil {
+			user.EmailVerified = false
+			user.EmailVerifiedAt = nil
+		}
 		user.Email = *input.Email
 	}
 
@@ -112,10 +231,29 @@ func (uc *userUseCase) UpdateUser(ctx context.Context, id uuid.UUID, input Updat
 		user.Name = input.Name
 	}
 
-	if err := uc.repo.Update(ctx, user); err != nil {
+	// Tokens sent to a previous address are deleted with the change, so
+	// whoever controls that address cannot verify the new one.
+	reverify := !user.EmailVerified && uc.verification != nil
+	var token string
+	err = uc.inTx(ctx, func(ctx context.Context) error {
+		if err := uc.repo.Update(ctx, user); err != nil {
+			return err
+		}
+		if reverify {
+			var err error
+			token, err = uc.replaceVerificationToken(ctx, user)
+			return err
+		}
+		return nil
+	})
+	if err != nil {
 		return nil, err
 	}
 
+	if reverify {
+		uc.sendVerification(ctx, user, token)
+	}
+
 	return user, nil
 }
 
@@ -123,20 +261,309 @@ func (uc *userUseCase) DeleteUser(ctx context.Context, id uuid.UUID) error {
 	return uc.repo.SoftDelete(ctx, id)
 }
 
-// VerifyPassword is timing-safe: performs bcrypt comparison even for non-existent users.
+// VerifyPassword is timing-safe: performs a hash comparison even for non-existent users.
+// With a lockout config, it returns ErrAccountLocked while the address is
+// locked, whether or not it has an account and the password is correct.
+// Passwords hashed with another algorithm or a lower cost than configured
+// are rehashed on a successful login.
 func (uc *userUseCase) VerifyPassword(ctx context.Context, email, password string) (*domain.User, error) {
+	var lockout *domain.LoginLockout
+	if uc.lockout != nil {
+		var err error
+		lockout, err = uc.lockout.Lockouts.Get(ctx, domain.HashLoginEmail(email))
+		if err != nil {
+			return nil, err
+		}
+		if lockout.IsLocked(time.Now()) {
+			_ = passwordhash.Compare(uc.dummyHash, password)
+			return nil, domain.ErrAccountLocked
+		}
+	}
+
 	user, err := uc.repo.FindByEmail(ctx, email)
 	if err != nil {
 		if err == domain.ErrUserNotFound {
-			_ = bcrypt.CompareHashAndPassword(uc.dummyHash, []byte(password))
-			return nil, domain.ErrInvalidCredentials
+			_ = passwordhash.Compare(uc.dummyHash, password)
+			return nil, uc.recordLoginFailure(ctx, email)
+		}
+		return nil, err
+	}
+
+	if err := passwordhash.Compare(user.PasswordHash, password); err != nil {
+		return nil, uc.recordLoginFailure(ctx, email)
+	}
+
+	if lockout != nil && lockout.FailedAttempts > 0 {
+		if err := uc.lockout.Lockouts.Reset(ctx, lockout.EmailHash); err != nil {
+			return nil, err
+		}
+	}
+
+	if uc.hasher.NeedsRehash(user.PasswordHash) {
+		uc.upgradePasswordHash(ctx, user, password)
+	}
+
+	return user, nil
+}
+
+// upgradePasswordHash rehashes the password of user with the configured
+// hasher. It is best effort: on failure the old hash stays valid and the
+// next login tries again.
+func (uc *userUseCase) upgradePasswordHash(ctx context.Context, user *domain.User, password string) {
+	hash, err := uc.hasher.Hash(password)
+	if err == nil {
+		err = uc.repo.UpdatePasswordHash(ctx, user.ID, hash)
+	}
+	if err != nil {
+		uc.passwords.Logger.WarnContext(ctx, "failed to upgrade password hash",
+			slog.String("user_id", user.ID.String()), slog.String("error", err.Error()))
+		return
+	}
+	user.PasswordHash = hash
+}
+
+// ChangePassword replaces a user's password after checking the current
+// one. Wrong current passwords count towards the login lockout of the
+// user's address, so the endpoint cannot be used to guess passwords.
+func (uc *userUseCase) ChangePassword(ctx context.Context, id uuid.UUID, currentPassword, newPassword string) error {
+	user, err := uc.repo.FindByID(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	var lockout *domain.LoginLockout
+	if uc.lockout != nil {
+		lockout, err = uc.lockout.Lockouts.Get(ctx, domain.HashLoginEmail(user.Email))
+		if err != nil {
+			return err
+		}
+		if lockout.IsLocked(time.Now()) {
+			return domain.ErrAccountLocked
+		}
+	}
+
+	if err := passwordhash.Compare(user.PasswordHash, currentPassword); err != nil {
+		if err := uc.recordLoginFailure(ctx, user.Email); !errors.Is(err, domain.ErrInvalidCredentials) {
+			return err
+		}
+		return domain.ErrIncorrectPassword
+	}
+	if newPassword == currentPassword {
+		return domain.ErrPasswordUnchanged
+	}
+	if err := uc.validateNewPassword(ctx, newPassword); err != nil {
+		return err
+	}
+
+	hash, err := uc.hasher.Hash(newPassword)
+	if err != nil {
+		return err
+	}
+	return uc.inTx(ctx, func(ctx context.Context) error {
+		if err := uc.repo.UpdatePasswordHash(ctx, user.ID, hash); err != nil {
+			return err
 		}
+		if lockout != nil && lockout.FailedAttempts > 0 {
+			return uc.lockout.Lockouts.Reset(ctx, lockout.EmailHash)
+		}
+		return nil
+	})
+}
+
+// validateNewPassword checks a password chosen by a user against the
+// policy and, if configured, the breached password corpus.
+func (uc *userUseCase) validateNewPassword(ctx context.Context, password string) error {
+	if err := uc.passwords.Policy.Validate(password); err != nil {
+		return err
+	}
+	if uc.passwords.Breached == nil {
+		return nil
+	}
+	breached, err := uc.passwords.Breached.IsBreached(ctx, password)
+	if err != nil {
+		uc.passwords.Logger.WarnContext(ctx, "breached password lookup failed, accepting password",
+			slog.String("error", err.Error()))
+		return nil
+	}
+	if breached {
+		return domain.ErrPasswordBreached
+	}
+	return nil
+}
+
+// recordLoginFailure counts a failed login of email and returns the error
+// to report for it. The attempt that locks the address still reports
+// ErrInvalidCredentials; later ones report ErrAccountLocked.
+func (uc *userUseCase) recordLoginFailure(ctx context.Context, email string) error {
+	if uc.lockout == nil {
+		return domain.ErrInvalidCredentials
+	}
+	_, err := uc.lockout.Lockouts.RecordFailure(ctx, domain.HashLoginEmail(email),
+		uc.lockout.MaxAttempts, uc.lockout.Duration, time.Now().UTC())
+	if err != nil {
+		return fmt.Errorf("failed to record login failure: %w", err)
+	}
+	return domain.ErrInvalidCredentials
+}
+
+func (uc *userUseCase) ListUsers(ctx context.Context, input ListUsersInput) (*ListUsersOutput, error) {
+	f := input.Filter
+	if f.CreatedAfter != nil && f.CreatedBefore != nil && !f.CreatedAfter.Before(*f.CreatedBefore) {
+		return nil, domain.ErrInvalidDateRange
+	}
+	if input.PageToken != "" {
+		if _, err := domain.ParseUserCursor(input.PageToken); err != nil {
+			return nil, err
+		}
+	}
+
+	users, next, err := uc.repo.List(ctx, f, domain.Pagination{
+		PageSize:  domain.NormalizePageSize(input.PageSize),
+		PageToken: input.PageToken,
+	})
+	if err != nil {
 		return nil, err
 	}
 
-	if err := bcrypt.CompareHashAndPassword([]byte(user.PasswordHash), []byte(password)); err != nil {
-		return nil, domain.ErrInvalidCredentials
+	return &ListUsersOutput{Users: users, NextPageToken: next}, nil
+}
+
+// GetUserRoles returns the roles assigned to an active user.
+func (uc *userUseCase) GetUserRoles(ctx context.Context, id uuid.UUID) ([]*domain.Role, error) {
+	if _, err := uc.repo.FindByID(ctx, id); err != nil {
+		return nil, err
+	}
+	return uc.repo.FindRoles(ctx, id)
+}
+
+// UnlockUser lifts the lockout of a user's email address.
+func (uc *userUseCase) UnlockUser(ctx context.Context, id uuid.UUID) error {
+	if uc.lockout == nil {
+		return domain.ErrLoginLockoutDisabled
+	}
+	user, err := uc.repo.FindByID(ctx, id)
+	if err != nil {
+		return err
+	}
+	return uc.lockout.Lockouts.Reset(ctx, domain.HashLoginEmail(user.Email))
+}
+
+// VerifyEmail consumes a verification token and marks its owner as verified.
+// A token sent to an address the user has since changed is invalid.
+func (uc *userUseCase) VerifyEmail(ctx context.Context, token string) (*domain.User, error) {
+	if uc.verification == nil {
+		return nil, domain.ErrEmailVerificationDisabled
+	}
+	if token == "" {
+		return nil, domain.ErrInvalidVerificationToken
+	}
+
+	// The token is consumed only if its owner is marked verified, so a
+	// failure in between, including an expired token, leaves it unused.
+	var user *domain.User
+	err := uc.inTx(ctx, func(ctx context.Context) error {
+		stored, err := uc.verification.Tokens.Consume(ctx, domain.HashVerificationToken(token))
+		if err != nil {
+			return err
+		}
+
+		now := time.Now().UTC()
+		if stored.IsExpired(now) {
+			return domain.ErrVerificationTokenExpired
+		}
+
+		user, err = uc.repo.FindByID(ctx, stored.UserID)
+		if err != nil {
+			return err
+		}
+		if stored.Email != user.Email {
+			return domain.ErrInvalidVerificationToken
+		}
+		if user.EmailVerified {
+			return nil
+		}
+
+		if err := uc.repo.MarkEmailVerified(ctx, user.ID, now); err != nil {
+			return err
+		}
+		user.MarkEmailVerified(now)
+		return nil
+	})
+	if err != nil {
+		return nil, err
 	}
 
 	return user, nil
 }
+
+// ResendVerificationEmail sends a new verification token to an unverified
+// user, replacing any sent before.
+func (uc *userUseCase) ResendVerificationEmail(ctx context.Context, id uuid.UUID) error {
+	if uc.verification == nil {
+		return domain.ErrEmailVerificationDisabled
+	}
+
+	user, err := uc.repo.FindByID(ctx, id)
+	if err != nil {
+		return err
+	}
+	if user.EmailVerified {
+		return domain.ErrEmailAlreadyVerified
+	}
+
+	var token string
+	err = uc.inTx(ctx, func(ctx context.Context) error {
+		var err error
+		token, err = uc.replaceVerificationToken(ctx, user)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+
+	if err := uc.verification.Sender.SendVerification(ctx, user, token); err != nil {
+		return fmt.Errorf("failed to send verification email: %w", err)
+	}
+	return nil
+}
+
+// storeVerificationToken stores a new verification token for user and
+// returns its plaintext to deliver.
+func (uc *userUseCase) storeVerificationToken(ctx context.Context, user *domain.User) (string, error) {
+	token, stored, err := domain.NewEmailVerificationToken(user, uc.verification.TokenTTL)
+	if err != nil {
+		return "", fmt.Errorf("failed to generate verification token: %w", err)
+	}
+	if err := uc.verification.Tokens.Create(ctx, stored); err != nil {
+		return "", fmt.Errorf("failed to store verification token: %w", err)
+	}
+	return token, nil
+}
+
+// replaceVerificationToken deletes the user's tokens and stores a new one.
+func (uc *userUseCase) replaceVerificationToken(ctx context.Context, user *domain.User) (string, error) {
+	if err := uc.verification.Tokens.DeleteByUser(ctx, user.ID); err != nil {
+		return "", fmt.Errorf("failed to delete verification tokens: %w", err)
+	}
+	return uc.storeVerificationToken(ctx, user)
+}
+
+// sendVerification delivers a token stored with a change that is already
+// committed. A failure is logged rather than returned, since the change
+// succeeded; the user can ask for a new token.
+func (uc *userUseCase) sendVerification(ctx context.Context, user *domain.User, token string) {
+	if err := uc.verification.Sender.SendVerification(ctx, user, token); err != nil {
+		uc.verification.Logger.WarnContext(ctx, "failed to send verification email",
+			slog.String("user_id", user.ID.String()),
+			slog.String("error", err.Error()),
+		)
+	}
+}
+
+// inTx runs fn in a transaction when a tx manager is configured.
+func (uc *userUseCase) inTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	if uc.tx == nil {
+		return fn(ctx)
+	}
+	return uc.tx.Do(ctx, fn)
+}
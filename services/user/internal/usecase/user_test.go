@@ -2,12 +2,19 @@ package usecase
 
 import (
 	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"sort"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/google/uuid"
 	"golang.org/x/crypto/bcrypt"
 
 	"github.com/daisuke8000/example-ec-platform/services/user/internal/domain"
+	"github.com/daisuke8000/example-ec-platform/services/user/internal/passwordhash"
 )
 
 // mockUserRepository is a test double for domain.UserRepository.
@@ -19,12 +26,14 @@ type mockUserRepository struct {
 	findByEmailErr error
 	updateErr     error
 	softDeleteErr error
+	roles         map[uuid.UUID][]*domain.Role
 }
 
 func newMockUserRepository() *mockUserRepository {
 	return &mockUserRepository{
 		users:      make(map[uuid.UUID]*domain.User),
 		emailIndex: make(map[string]uuid.UUID),
+		roles:      make(map[uuid.UUID][]*domain.Role),
 	}
 }
 
@@ -98,6 +107,150 @@ func (m *mockUserRepository) SoftDelete(ctx context.Context, id uuid.UUID) error
 	return nil
 }
 
+func (m *mockUserRepository) MarkEmailVerified(ctx context.Context, id uuid.UUID, verifiedAt time.Time) error {
+	user, exists := m.users[id]
+	if !exists || user.IsDeleted {
+		return domain.ErrUserNotFound
+	}
+	user.MarkEmailVerified(verifiedAt)
+	return nil
+}
+
+func (m *mockUserRepository) List(ctx context.Context, filter domain.UserFilter, page domain.Pagination) ([]*domain.User, string, error) {
+	var matched []*domain.User
+	for _, u := range m.users {
+		if u.IsDeleted && !filter.IncludeDeleted {
+			continue
+		}
+		if filter.EmailContains != nil && !strings.Contains(strings.ToLower(u.Email), strings.ToLower(*filter.EmailContains)) {
+			continue
+		}
+		matched = append(matched, u)
+	}
+	sort.Slice(matched, func(i, j int) bool {
+		if matched[i].CreatedAt.Equal(matched[j].CreatedAt) {
+			return matched[i].ID.String() > matched[j].ID.String()
+		}
+		return matched[i].CreatedAt.After(matched[j].CreatedAt)
+	})
+
+	if page.PageToken != "" {
+		cursor, err := domain.ParseUserCursor(page.PageToken)
+		if err != nil {
+			return nil, "", err
+		}
+		for i, u := range matched {
+			if u.ID == cursor.ID {
+				matched = matched[i+1:]
+				break
+			}
+		}
+	}
+
+	if len(matched) <= page.PageSize {
+		return matched, "", nil
+	}
+	last := matched[page.PageSize-1]
+	return matched[:page.PageSize], domain.UserCursor{CreatedAt: last.CreatedAt, ID: last.ID}.Encode(), nil
+}
+
+func (m *mockUserRepository) UpdatePasswordHash(ctx context.Context, id uuid.UUID, passwordHash string) error {
+	user, exists := m.users[id]
+	if !exists || user.IsDeleted {
+		return domain.ErrUserNotFound
+	}
+	user.PasswordHash = passwordHash
+	return nil
+}
+
+func (m *mockUserRepository) FindRoles(ctx context.Context, userID uuid.UUID) ([]*domain.Role, error) {
+	return m.roles[userID], nil
+}
+
+// mockVerificationTokenRepository is a test double for domain.EmailVerificationRepository.
+type mockVerificationTokenRepository struct {
+	tokens map[string]*domain.EmailVerificationToken
+}
+
+func newMockVerificationTokenRepository() *mockVerificationTokenRepository {
+	return &mockVerificationTokenRepository{tokens: make(map[string]*domain.EmailVerificationToken)}
+}
+
+func (m *mockVerificationTokenRepository) Create(ctx context.Context, token *domain.EmailVerificationToken) error {
+	m.tokens[token.TokenHash] = token
+	return nil
+}
+
+func (m *mockVerificationTokenRepository) Consume(ctx context.Context, tokenHash string) (*domain.EmailVerificationToken, error) {
+	token, exists := m.tokens[tokenHash]
+	if !exists {
+		return nil, domain.ErrInvalidVerificationToken
+	}
+	delete(m.tokens, tokenHash)
+	return token, nil
+}
+
+func (m *mockVerificationTokenRepository) DeleteByUser(ctx context.Context, userID uuid.UUID) error {
+	for hash, token := range m.tokens {
+		if token.UserID == userID {
+			delete(m.tokens, hash)
+		}
+	}
+	return nil
+}
+
+// mockVerificationSender records the last token sent per user.
+type mockVerificationSender struct {
+	sent map[uuid.UUID]string
+}
+
+func (m *mockVerificationSender) SendVerification(ctx context.Context, user *domain.User, token string) error {
+	m.sent[user.ID] = token
+	return nil
+}
+
+// mockLoginLockoutRepository is a test double for domain.LoginLockoutRepository.
+type mockLoginLockoutRepository struct {
+	lockouts map[string]*domain.LoginLockout
+}
+
+func newMockLoginLockoutRepository() *mockLoginLockoutRepository {
+	return &mockLoginLockoutRepository{lockouts: make(map[string]*domain.LoginLockout)}
+}
+
+func (m *mockLoginLockoutRepository) Get(ctx context.Context, emailHash string) (*domain.LoginLockout, error) {
+	if l, ok := m.lockouts[emailHash]; ok {
+		copied := *l
+		return &copied, nil
+	}
+	return &domain.LoginLockout{EmailHash: emailHash}, nil
+}
+
+func (m *mockLoginLockoutRepository) RecordFailure(ctx context.Context, emailHash string, maxAttempts int, lockFor time.Duration, now time.Time) (*domain.LoginLockout, error) {
+	l, ok := m.lockouts[emailHash]
+	if !ok {
+		l = &domain.LoginLockout{EmailHash: emailHash}
+		m.lockouts[emailHash] = l
+	}
+	l.RecordFailure(maxAttempts, lockFor, now)
+	return l, nil
+}
+
+func (m *mockLoginLockoutRepository) Reset(ctx context.Context, emailHash string) error {
+	delete(m.lockouts, emailHash)
+	return nil
+}
+
+// mockBreachedPasswordChecker is a test double for BreachedPasswordChecker.
+type mockBreachedPasswordChecker struct {
+	breached map[string]bool
+	err      error
+}
+
+func (m *mockBreachedPasswordChecker) IsBreached(ctx context.Context, password string) (bool, error) {
+	return m.breached[password], m.err
+}
+
 // seedUser adds a user to the mock repository for testing.
 func (m *mockUserRepository) seedUser(user *domain.User) {
 	m.users[user.ID] = user
@@ -182,11 +335,11 @@ func TestUserUseCase_CreateUser(t *testing.T) {
 				tt.setup(repo)
 			}
 
-			uc := NewUserUseCase(repo, 4) // Use low cost for fast tests
+			uc := NewUserUseCase(repo, nil, passwordhash.NewBcrypt(4), nil, nil, nil) // Use low cost for fast tests
 
 			user, err := uc.CreateUser(context.Background(), tt.input)
 
-			if err != tt.wantErr {
+			if !errors.Is(err, tt.wantErr) {
 				t.Errorf("CreateUser() error = %v, wantErr %v", err, tt.wantErr)
 				return
 			}
@@ -239,7 +392,7 @@ func TestUserUseCase_GetUser(t *testing.T) {
 				tt.setup(repo)
 			}
 
-			uc := NewUserUseCase(repo, 4)
+			uc := NewUserUseCase(repo, nil, passwordhash.NewBcrypt(4), nil, nil, nil)
 
 			user, err := uc.GetUser(context.Background(), tt.id)
 
@@ -342,7 +495,7 @@ func TestUserUseCase_UpdateUser(t *testing.T) {
 				tt.setup(repo)
 			}
 
-			uc := NewUserUseCase(repo, 4)
+			uc := NewUserUseCase(repo, nil, passwordhash.NewBcrypt(4), nil, nil, nil)
 
 			user, err := uc.UpdateUser(context.Background(), tt.id, tt.input)
 
@@ -390,7 +543,7 @@ func TestUserUseCase_DeleteUser(t *testing.T) {
 				tt.setup(repo)
 			}
 
-			uc := NewUserUseCase(repo, 4)
+			uc := NewUserUseCase(repo, nil, passwordhash.NewBcrypt(4), nil, nil, nil)
 
 			err := uc.DeleteUser(context.Background(), tt.id)
 
@@ -447,7 +600,7 @@ func TestUserUseCase_VerifyPassword(t *testing.T) {
 				tt.setup(repo)
 			}
 
-			uc := NewUserUseCase(repo, 4)
+			uc := NewUserUseCase(repo, nil, passwordhash.NewBcrypt(4), nil, nil, nil)
 
 			user, err := uc.VerifyPassword(context.Background(), tt.email, tt.password)
 
@@ -463,6 +616,628 @@ func TestUserUseCase_VerifyPassword(t *testing.T) {
 	}
 }
 
+func TestUserUseCase_VerifyPassword_Lockout(t *testing.T) {
+	password := "password123"
+	hashedPassword, _ := bcrypt.GenerateFromPassword([]byte(password), 4)
+	existingUser := domain.NewUser("test@example.com", string(hashedPassword), nil)
+
+	newUseCase := func() (UserUseCase, *mockLoginLockoutRepository) {
+		repo := newMockUserRepository()
+		repo.seedUser(existingUser)
+		lockouts := newMockLoginLockoutRepository()
+		return NewUserUseCase(repo, nil, passwordhash.NewBcrypt(4), nil, &LockoutConfig{
+			Lockouts:    lockouts,
+			MaxAttempts: 3,
+			Duration:    time.Hour,
+		}, nil), lockouts
+	}
+
+	t.Run("locks after max attempts, even for the correct password", func(t *testing.T) {
+		uc, _ := newUseCase()
+		ctx := context.Background()
+		for i := 0; i < 3; i++ {
+			if _, err := uc.VerifyPassword(ctx, "test@example.com", "wrongpassword"); err != domain.ErrInvalidCredentials {
+				t.Fatalf("attempt %d: error = %v, want %v", i+1, err, domain.ErrInvalidCredentials)
+			}
+		}
+		if _, err := uc.VerifyPassword(ctx, "test@example.com", password); err != domain.ErrAccountLocked {
+			t.Errorf("VerifyPassword() error = %v, want %v", err, domain.ErrAccountLocked)
+		}
+	})
+
+	t.Run("locks addresses without an account the same way", func(t *testing.T) {
+		uc, _ := newUseCase()
+		ctx := context.Background()
+		for i := 0; i < 3; i++ {
+			_, _ = uc.VerifyPassword(ctx, "nonexistent@example.com", password)
+		}
+		if _, err := uc.VerifyPassword(ctx, "nonexistent@example.com", password); err != domain.ErrAccountLocked {
+			t.Errorf("VerifyPassword() error = %v, want %v", err, domain.ErrAccountLocked)
+		}
+	})
+
+	t.Run("successful login resets the failures", func(t *testing.T) {
+		uc, lockouts := newUseCase()
+		ctx := context.Background()
+		for i := 0; i < 2; i++ {
+			_, _ = uc.VerifyPassword(ctx, "test@example.com", "wrongpassword")
+		}
+		if _, err := uc.VerifyPassword(ctx, "test@example.com", password); err != nil {
+			t.Fatalf("VerifyPassword() error = %v", err)
+		}
+		if len(lockouts.lockouts) != 0 {
+			t.Errorf("lockouts = %d, want 0", len(lockouts.lockouts))
+		}
+	})
+
+	t.Run("unlock lifts the lock", func(t *testing.T) {
+		uc, _ := newUseCase()
+		ctx := context.Background()
+		for i := 0; i < 3; i++ {
+			_, _ = uc.VerifyPassword(ctx, "test@example.com", "wrongpassword")
+		}
+		if err := uc.UnlockUser(ctx, existingUser.ID); err != nil {
+			t.Fatalf("UnlockUser() error = %v", err)
+		}
+		if _, err := uc.VerifyPassword(ctx, "test@example.com", password); err != nil {
+			t.Errorf("VerifyPassword() after unlock error = %v", err)
+		}
+	})
+}
+
+func TestUserUseCase_UnlockUser_Disabled(t *testing.T) {
+	uc := NewUserUseCase(newMockUserRepository(), nil, passwordhash.NewBcrypt(4), nil, nil, nil)
+	if err := uc.UnlockUser(context.Background(), uuid.New()); err != domain.ErrLoginLockoutDisabled {
+		t.Errorf("UnlockUser() error = %v, want %v", err, domain.ErrLoginLockoutDisabled)
+	}
+}
+
 func stringPtr(s string) *string {
 	return &s
 }
+
+// mockTxManager runs fn directly, recording whether a transaction is open.
+type mockTxManager struct {
+	calls int
+	open  bool
+}
+
+func (m *mockTxManager) Do(ctx context.Context, fn func(ctx context.Context) error) error {
+	m.calls++
+	m.open = true
+	defer func() { m.open = false }()
+	return fn(ctx)
+}
+
+type verificationSenderFunc func(ctx context.Context, user *domain.User, token string) error
+
+func (f verificationSenderFunc) SendVerification(ctx context.Context, user *domain.User, token string) error {
+	return f(ctx, user, token)
+}
+
+func TestUserUseCase_CreateUser_EmailVerification(t *testing.T) {
+	t.Run("marks user verified when verification is disabled", func(t *testing.T) {
+		uc := NewUserUseCase(newMockUserRepository(), nil, passwordhash.NewBcrypt(4), nil, nil, nil)
+
+		user, err := uc.CreateUser(context.Background(), CreateUserInput{
+			Email:    "test@example.com",
+			Password: "password123",
+		})
+		if err != nil {
+			t.Fatalf("CreateUser() error = %v", err)
+		}
+		if !user.EmailVerified {
+			t.Error("EmailVerified = false, want true")
+		}
+	})
+
+	t.Run("sends verification token when verification is enabled", func(t *testing.T) {
+		sender := &mockVerificationSender{sent: make(map[uuid.UUID]string)}
+		uc := NewUserUseCase(newMockUserRepository(), nil, passwordhash.NewBcrypt(4), &EmailVerificationConfig{
+			Tokens:   newMockVerificationTokenRepository(),
+			Sender:   sender,
+			TokenTTL: time.Hour,
+		}, nil, nil)
+
+		user, err := uc.CreateUser(context.Background(), CreateUserInput{
+			Email:    "test@example.com",
+			Password: "password123",
+		})
+		if err != nil {
+			t.Fatalf("CreateUser() error = %v", err)
+		}
+		if user.EmailVerified {
+			t.Error("EmailVerified = true, want false")
+		}
+		if sender.sent[user.ID] == "" {
+			t.Error("verification token was not sent")
+		}
+	})
+
+	t.Run("stores user and token in one transaction and sends after commit", func(t *testing.T) {
+		tx := &mockTxManager{}
+		sent := false
+		uc := NewUserUseCase(newMockUserRepository(), tx, passwordhash.NewBcrypt(4), &EmailVerificationConfig{
+			Tokens: newMockVerificationTokenRepository(),
+			Sender: verificationSenderFunc(func(ctx context.Context, user *domain.User, token string) error {
+				if tx.open {
+					t.Error("verification email sent inside the transaction")
+				}
+				sent = true
+				return nil
+			}),
+			TokenTTL: time.Hour,
+		}, nil, nil)
+
+		if _, err := uc.CreateUser(context.Background(), CreateUserInput{
+			Email:    "test@example.com",
+			Password: "password123",
+		}); err != nil {
+			t.Fatalf("CreateUser() error = %v", err)
+		}
+		if tx.calls != 1 {
+			t.Errorf("transactions = %d, want 1", tx.calls)
+		}
+		if !sent {
+			t.Error("verification token was not sent")
+		}
+	})
+
+	t.Run("creates the user when sending fails", func(t *testing.T) {
+		repo := newMockUserRepository()
+		tokens := newMockVerificationTokenRepository()
+		uc := NewUserUseCase(repo, nil, passwordhash.NewBcrypt(4), &EmailVerificationConfig{
+			Tokens: tokens,
+			Sender: verificationSenderFunc(func(ctx context.Context, user *domain.User, token string) error {
+				return errors.New("smtp unavailable")
+			}),
+			TokenTTL: time.Hour,
+			Logger:   slog.New(slog.NewTextHandler(io.Discard, nil)),
+		}, nil, nil)
+
+		user, err := uc.CreateUser(context.Background(), CreateUserInput{
+			Email:    "test@example.com",
+			Password: "password123",
+		})
+		if err != nil {
+			t.Fatalf("CreateUser() error = %v, want nil", err)
+		}
+		if _, err := repo.FindByID(context.Background(), user.ID); err != nil {
+			t.Errorf("FindByID() error = %v, want the created user", err)
+		}
+		if len(tokens.tokens) != 1 {
+			t.Errorf("tokens = %d, want 1 to resend later", len(tokens.tokens))
+		}
+	})
+}
+
+func TestUserUseCase_UpdateUser_EmailVerification(t *testing.T) {
+	sender := &mockVerificationSender{sent: make(map[uuid.UUID]string)}
+	tokens := newMockVerificationTokenRepository()
+	uc := NewUserUseCase(newMockUserRepository(), nil, passwordhash.NewBcrypt(4), &EmailVerificationConfig{
+		Tokens:   tokens,
+		Sender:   sender,
+		TokenTTL: time.Hour,
+	}, nil, nil)
+
+	created, err := uc.CreateUser(context.Background(), CreateUserInput{
+		Email:    "old@example.com",
+		Password: "password123",
+	})
+	if err != nil {
+		t.Fatalf("CreateUser() error = %v", err)
+	}
+	oldToken := sender.sent[created.ID]
+
+	if _, err := uc.UpdateUser(context.Background(), created.ID, UpdateUserInput{Email: stringPtr("new@example.com")}); err != nil {
+		t.Fatalf("UpdateUser() error = %v", err)
+	}
+	newToken := sender.sent[created.ID]
+	if newToken == oldToken {
+		t.Fatal("no verification token was sent to the new address")
+	}
+
+	// Whoever still reads the old mailbox must not verify the new address.
+	if _, err := uc.VerifyEmail(context.Background(), oldToken); err != domain.ErrInvalidVerificationToken {
+		t.Errorf("VerifyEmail(old token) error = %v, want %v", err, domain.ErrInvalidVerificationToken)
+	}
+	user, err := uc.VerifyEmail(context.Background(), newToken)
+	if err != nil {
+		t.Fatalf("VerifyEmail(new token) error = %v", err)
+	}
+	if !user.EmailVerified || user.Email != "new@example.com" {
+		t.Errorf("user = %s verified %v, want new@example.com verified", user.Email, user.EmailVerified)
+	}
+}
+
+func TestUserUseCase_VerifyEmail(t *testing.T) {
+	tests := []struct {
+		name     string
+		tokenTTL time.Duration
+		token    func(sent string) string
+		// changeTo, if set, replaces the address behind the token's back.
+		changeTo string
+		wantErr  error
+	}{
+		{
+			name:     "verifies email with valid token",
+			tokenTTL: time.Hour,
+			token:    func(sent string) string { return sent },
+			wantErr:  nil,
+		},
+		{
+			name:     "rejects unknown token",
+			tokenTTL: time.Hour,
+			token:    func(string) string { return "unknown-token" },
+			wantErr:  domain.ErrInvalidVerificationToken,
+		},
+		{
+			name:     "rejects expired token",
+			tokenTTL: -time.Minute,
+			token:    func(sent string) string { return sent },
+			wantErr:  domain.ErrVerificationTokenExpired,
+		},
+		{
+			name:     "rejects token sent to a previous address",
+			tokenTTL: time.Hour,
+			token:    func(sent string) string { return sent },
+			changeTo: "new@example.com",
+			wantErr:  domain.ErrInvalidVerificationToken,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sender := &mockVerificationSender{sent: make(map[uuid.UUID]string)}
+			repo := newMockUserRepository()
+			uc := NewUserUseCase(repo, nil, passwordhash.NewBcrypt(4), &EmailVerificationConfig{
+				Tokens:   newMockVerificationTokenRepository(),
+				Sender:   sender,
+				TokenTTL: tt.tokenTTL,
+			}, nil, nil)
+
+			created, err := uc.CreateUser(context.Background(), CreateUserInput{
+				Email:    "test@example.com",
+				Password: "password123",
+			})
+			if err != nil {
+				t.Fatalf("CreateUser() error = %v", err)
+			}
+			if tt.changeTo != "" {
+				repo.users[created.ID].Email = tt.changeTo
+			}
+
+			user, err := uc.VerifyEmail(context.Background(), tt.token(sender.sent[created.ID]))
+			if err != tt.wantErr {
+				t.Errorf("VerifyEmail() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr == nil && !user.EmailVerified {
+				t.Error("EmailVerified = false, want true")
+			}
+			if tt.wantErr != nil && repo.users[created.ID].EmailVerified {
+				t.Error("EmailVerified = true after a rejected token")
+			}
+		})
+	}
+
+	t.Run("rejects when verification is disabled", func(t *testing.T) {
+		uc := NewUserUseCase(newMockUserRepository(), nil, passwordhash.NewBcrypt(4), nil, nil, nil)
+		if _, err := uc.VerifyEmail(context.Background(), "token"); err != domain.ErrEmailVerificationDisabled {
+			t.Errorf("VerifyEmail() error = %v, want %v", err, domain.ErrEmailVerificationDisabled)
+		}
+	})
+}
+
+func TestUserUseCase_ResendVerificationEmail(t *testing.T) {
+	sender := &mockVerificationSender{sent: make(map[uuid.UUID]string)}
+	tokens := newMockVerificationTokenRepository()
+	uc := NewUserUseCase(newMockUserRepository(), nil, passwordhash.NewBcrypt(4), &EmailVerificationConfig{
+		Tokens:   tokens,
+		Sender:   sender,
+		TokenTTL: time.Hour,
+	}, nil, nil)
+
+	created, err := uc.CreateUser(context.Background(), CreateUserInput{
+		Email:    "test@example.com",
+		Password: "password123",
+	})
+	if err != nil {
+		t.Fatalf("CreateUser() error = %v", err)
+	}
+	first := sender.sent[created.ID]
+
+	if err := uc.ResendVerificationEmail(context.Background(), created.ID); err != nil {
+		t.Fatalf("ResendVerificationEmail() error = %v", err)
+	}
+	second := sender.sent[created.ID]
+	if second == first || len(tokens.tokens) != 1 {
+		t.Fatalf("tokens = %d after resend, want only the new one", len(tokens.tokens))
+	}
+	if _, err := uc.VerifyEmail(context.Background(), first); err != domain.ErrInvalidVerificationToken {
+		t.Errorf("VerifyEmail(first token) error = %v, want %v", err, domain.ErrInvalidVerificationToken)
+	}
+	if _, err := uc.VerifyEmail(context.Background(), second); err != nil {
+		t.Fatalf("VerifyEmail(second token) error = %v", err)
+	}
+
+	if err := uc.ResendVerificationEmail(context.Background(), created.ID); err != domain.ErrEmailAlreadyVerified {
+		t.Errorf("ResendVerificationEmail(verified) error = %v, want %v", err, domain.ErrEmailAlreadyVerified)
+	}
+	if err := uc.ResendVerificationEmail(context.Background(), uuid.New()); err != domain.ErrUserNotFound {
+		t.Errorf("ResendVerificationEmail(unknown) error = %v, want %v", err, domain.ErrUserNotFound)
+	}
+
+	disabled := NewUserUseCase(newMockUserRepository(), nil, passwordhash.NewBcrypt(4), nil, nil, nil)
+	if err := disabled.ResendVerificationEmail(context.Background(), created.ID); err != domain.ErrEmailVerificationDisabled {
+		t.Errorf("ResendVerificationEmail(disabled) error = %v, want %v", err, domain.ErrEmailVerificationDisabled)
+	}
+}
+
+func TestUserUseCase_ListUsers(t *testing.T) {
+	repo := newMockUserRepository()
+	base := time.Now().UTC()
+	for i, email := range []string{"alice@example.com", "bob@example.com", "carol@example.org"} {
+		user := domain.NewUser(email, "hash", nil)
+		user.CreatedAt = base.Add(time.Duration(i) * time.Minute)
+		repo.seedUser(user)
+	}
+	deleted := domain.NewUser("dave@example.com", "hash", nil)
+	deleted.IsDeleted = true
+	repo.seedUser(deleted)
+
+	uc := NewUserUseCase(repo, nil, passwordhash.NewBcrypt(4), nil, nil, nil)
+
+	t.Run("paginates newest first", func(t *testing.T) {
+		first, err := uc.ListUsers(context.Background(), ListUsersInput{PageSize: 2})
+		if err != nil {
+			t.Fatalf("ListUsers() error = %v", err)
+		}
+		if len(first.Users) != 2 || first.Users[0].Email != "carol@example.org" {
+			t.Fatalf("first page = %v, want carol first with 2 users", first.Users)
+		}
+		if first.NextPageToken == "" {
+			t.Fatal("NextPageToken is empty, want cursor")
+		}
+
+		second, err := uc.ListUsers(context.Background(), ListUsersInput{PageSize: 2, PageToken: first.NextPageToken})
+		if err != nil {
+			t.Fatalf("ListUsers() error = %v", err)
+		}
+		if len(second.Users) != 1 || second.Users[0].Email != "alice@example.com" {
+			t.Errorf("second page = %v, want only alice", second.Users)
+		}
+		if second.NextPageToken != "" {
+			t.Errorf("NextPageToken = %q, want empty", second.NextPageToken)
+		}
+	})
+
+	t.Run("filters by email substring", func(t *testing.T) {
+		contains := "EXAMPLE.ORG"
+		out, err := uc.ListUsers(context.Background(), ListUsersInput{
+			Filter: domain.UserFilter{EmailContains: &contains},
+		})
+		if err != nil {
+			t.Fatalf("ListUsers() error = %v", err)
+		}
+		if len(out.Users) != 1 || out.Users[0].Email != "carol@example.org" {
+			t.Errorf("Users = %v, want only carol", out.Users)
+		}
+	})
+
+	t.Run("includes deleted users when requested", func(t *testing.T) {
+		out, err := uc.ListUsers(context.Background(), ListUsersInput{
+			Filter: domain.UserFilter{IncludeDeleted: true},
+		})
+		if err != nil {
+			t.Fatalf("ListUsers() error = %v", err)
+		}
+		if len(out.Users) != 4 {
+			t.Errorf("len(Users) = %d, want 4", len(out.Users))
+		}
+	})
+
+	t.Run("rejects malformed page token", func(t *testing.T) {
+		_, err := uc.ListUsers(context.Background(), ListUsersInput{PageToken: "!!!"})
+		if err != domain.ErrInvalidPageToken {
+			t.Errorf("ListUsers() error = %v, want %v", err, domain.ErrInvalidPageToken)
+		}
+	})
+
+	t.Run("rejects inverted date range", func(t *testing.T) {
+		after := base
+		before := base.Add(-time.Hour)
+		_, err := uc.ListUsers(context.Background(), ListUsersInput{
+			Filter: domain.UserFilter{CreatedAfter: &after, CreatedBefore: &before},
+		})
+		if err != domain.ErrInvalidDateRange {
+			t.Errorf("ListUsers() error = %v, want %v", err, domain.ErrInvalidDateRange)
+		}
+	})
+}
+
+func TestUserUseCase_GetUserRoles(t *testing.T) {
+	repo := newMockUserRepository()
+	user := domain.NewUser("admin@example.com", "hash", nil)
+	repo.users[user.ID] = user
+	repo.emailIndex[user.Email] = user.ID
+	repo.roles[user.ID] = []*domain.Role{
+		{Name: "admin", Permissions: []string{"users:list", "users:read"}},
+	}
+	uc := NewUserUseCase(repo, nil, passwordhash.NewBcrypt(4), nil, nil, nil)
+
+	t.Run("returns assigned roles", func(t *testing.T) {
+		roles, err := uc.GetUserRoles(context.Background(), user.ID)
+		if err != nil {
+			t.Fatalf("GetUserRoles() error = %v", err)
+		}
+		if len(roles) != 1 || roles[0].Name != "admin" {
+			t.Errorf("roles = %v, want [admin]", roles)
+		}
+	})
+
+	t.Run("unknown user", func(t *testing.T) {
+		_, err := uc.GetUserRoles(context.Background(), uuid.New())
+		if err != domain.ErrUserNotFound {
+			t.Errorf("GetUserRoles() error = %v, want %v", err, domain.ErrUserNotFound)
+		}
+	})
+}
+
+func TestUserUseCase_CreateUser_PasswordPolicy(t *testing.T) {
+	passwords := &PasswordConfig{
+		Policy:   domain.PasswordPolicy{MinLength: 10, MinCharClasses: 3},
+		Breached: &mockBreachedPasswordChecker{breached: map[string]bool{"Password123!": true}},
+	}
+
+	tests := []struct {
+		name     string
+		password string
+		wantErr  error
+	}{
+		{"meets the policy", "Correct-horse-1", nil},
+		{"shorter than the policy", "Ab1!", domain.ErrPasswordTooShort},
+		{"too few character classes", "alllowercase", domain.ErrPasswordTooWeak},
+		{"breached", "Password123!", domain.ErrPasswordBreached},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			uc := NewUserUseCase(newMockUserRepository(), nil, passwordhash.NewBcrypt(4), nil, nil, passwords)
+			_, err := uc.CreateUser(context.Background(), CreateUserInput{
+				Email:    "test@example.com",
+				Password: tt.password,
+			})
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("CreateUser() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+
+	t.Run("accepts the password when the lookup fails", func(t *testing.T) {
+		uc := NewUserUseCase(newMockUserRepository(), nil, passwordhash.NewBcrypt(4), nil, nil, &PasswordConfig{
+			Policy:   domain.DefaultPasswordPolicy(),
+			Breached: &mockBreachedPasswordChecker{err: errors.New("lookup unavailable")},
+		})
+		if _, err := uc.CreateUser(context.Background(), CreateUserInput{
+			Email:    "test@example.com",
+			Password: "password123",
+		}); err != nil {
+			t.Errorf("CreateUser() error = %v, want nil", err)
+		}
+	})
+}
+
+func TestUserUseCase_ChangePassword(t *testing.T) {
+	const current = "password123"
+	hashedPassword, _ := bcrypt.GenerateFromPassword([]byte(current), 4)
+
+	newUseCase := func() (UserUseCase, *domain.User, *mockLoginLockoutRepository) {
+		user := domain.NewUser("test@example.com", string(hashedPassword), nil)
+		repo := newMockUserRepository()
+		repo.seedUser(user)
+		lockouts := newMockLoginLockoutRepository()
+		uc := NewUserUseCase(repo, nil, passwordhash.NewBcrypt(4), nil, &LockoutConfig{
+			Lockouts:    lockouts,
+			MaxAttempts: 3,
+			Duration:    time.Hour,
+		}, &PasswordConfig{
+			Policy:   domain.DefaultPasswordPolicy(),
+			Breached: &mockBreachedPasswordChecker{breached: map[string]bool{"qwertyuiop": true}},
+		})
+		return uc, user, lockouts
+	}
+
+	t.Run("changes the password", func(t *testing.T) {
+		uc, user, _ := newUseCase()
+		ctx := context.Background()
+		if err := uc.ChangePassword(ctx, user.ID, current, "new-password-456"); err != nil {
+			t.Fatalf("ChangePassword() error = %v", err)
+		}
+		if _, err := uc.VerifyPassword(ctx, user.Email, "new-password-456"); err != nil {
+			t.Errorf("VerifyPassword(new) error = %v", err)
+		}
+		if _, err := uc.VerifyPassword(ctx, user.Email, current); err != domain.ErrInvalidCredentials {
+			t.Errorf("VerifyPassword(old) error = %v, want %v", err, domain.ErrInvalidCredentials)
+		}
+	})
+
+	tests := []struct {
+		name     string
+		current  string
+		password string
+		wantErr  error
+	}{
+		{"wrong current password", "wrongpassword", "new-password-456", domain.ErrIncorrectPassword},
+		{"unchanged", current, current, domain.ErrPasswordUnchanged},
+		{"too short", current, "short", domain.ErrPasswordTooShort},
+		{"breached", current, "qwertyuiop", domain.ErrPasswordBreached},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			uc, user, _ := newUseCase()
+			err := uc.ChangePassword(context.Background(), user.ID, tt.current, tt.password)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("ChangePassword() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+
+	t.Run("wrong current passwords lock the account", func(t *testing.T) {
+		uc, user, _ := newUseCase()
+		ctx := context.Background()
+		for i := 0; i < 3; i++ {
+			_ = uc.ChangePassword(ctx, user.ID, "wrongpassword", "new-password-456")
+		}
+		if err := uc.ChangePassword(ctx, user.ID, current, "new-password-456"); err != domain.ErrAccountLocked {
+			t.Errorf("ChangePassword() error = %v, want %v", err, domain.ErrAccountLocked)
+		}
+	})
+}
+
+func TestUserUseCase_VerifyPassword_UpgradesHashCost(t *testing.T) {
+	const password = "password123"
+	hashedPassword, _ := bcrypt.GenerateFromPassword([]byte(password), 4)
+	user := domain.NewUser("test@example.com", string(hashedPassword), nil)
+	repo := newMockUserRepository()
+	repo.seedUser(user)
+	uc := NewUserUseCase(repo, nil, passwordhash.NewBcrypt(5), nil, nil, nil)
+
+	if _, err := uc.VerifyPassword(context.Background(), user.Email, password); err != nil {
+		t.Fatalf("VerifyPassword() error = %v", err)
+	}
+	cost, err := bcrypt.Cost([]byte(repo.users[user.ID].PasswordHash))
+	if err != nil || cost != 5 {
+		t.Errorf("stored cost = %d (%v), want 5", cost, err)
+	}
+	if bcrypt.CompareHashAndPassword([]byte(repo.users[user.ID].PasswordHash), []byte(password)) != nil {
+		t.Error("upgraded hash does not match the password")
+	}
+}
+
+func TestUserUseCase_VerifyPassword_MigratesToArgon2id(t *testing.T) {
+	const password = "password123"
+	hashedPassword, _ := bcrypt.GenerateFromPassword([]byte(password), 4)
+	user := domain.NewUser("test@example.com", string(hashedPassword), nil)
+	repo := newMockUserRepository()
+	repo.seedUser(user)
+	hasher := passwordhash.NewArgon2id(passwordhash.Argon2idParams{Memory: 64, Iterations: 1, Parallelism: 1})
+	uc := NewUserUseCase(repo, nil, hasher, nil, nil, nil)
+	ctx := context.Background()
+
+	if _, err := uc.VerifyPassword(ctx, user.Email, password); err != nil {
+		t.Fatalf("VerifyPassword() with the legacy bcrypt hash error = %v", err)
+	}
+	stored := repo.users[user.ID].PasswordHash
+	if !strings.HasPrefix(stored, "$argon2id$") {
+		t.Fatalf("stored hash = %q, want an argon2id hash", stored)
+	}
+	if _, err := uc.VerifyPassword(ctx, user.Email, password); err != nil {
+		t.Errorf("VerifyPassword() with the migrated hash error = %v", err)
+	}
+	if _, err := uc.VerifyPassword(ctx, user.Email, "wrongpassword"); err != domain.ErrInvalidCredentials {
+		t.Errorf("VerifyPassword(wrong) error = %v, want %v", err, domain.ErrInvalidCredentials)
+	}
+	if repo.users[user.ID].PasswordHash != stored {
+		t.Error("an up-to-date hash was rehashed")
+	}
+}
@@ -0,0 +1,109 @@
+// Package worker provides background jobs for the user service.
+package worker
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"time"
+
+	"github.com/daisuke8000/example-ec-platform/services/user/internal/domain"
+)
+
+// UserPurgerConfig holds configuration for UserPurger.
+type UserPurgerConfig struct {
+	// Retention is how long soft-deleted users are kept before purging.
+	Retention time.Duration
+	Interval  time.Duration
+	BatchSize int
+	Mode      domain.PurgeMode
+	// DryRun logs the users that would be purged without modifying them.
+	DryRun bool
+}
+
+// UserPurger periodically anonymizes or deletes users soft-deleted longer
+// than the retention period ago.
+type UserPurger struct {
+	repo   domain.UserPurgeRepository
+	logger *slog.Logger
+	cfg    UserPurgerConfig
+	now    func() time.Time
+}
+
+func NewUserPurger(repo domain.UserPurgeRepository, logger *slog.Logger, cfg UserPurgerConfig) *UserPurger {
+	return &UserPurger{
+		repo:   repo,
+		logger: logger,
+		cfg:    cfg,
+		now:    time.Now,
+	}
+}
+
+func (w *UserPurger) Start(ctx context.Context) {
+	w.logger.Info("user purger starting",
+		"interval", w.cfg.Interval,
+		"retention", w.cfg.Retention,
+		"mode", w.cfg.Mode,
+		"dry_run", w.cfg.DryRun,
+	)
+	ticker := time.NewTicker(w.cfg.Interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			w.logger.Info("user purger shutting down")
+			return
+		case <-ticker.C:
+			w.processPurgeable(ctx)
+		}
+	}
+}
+
+func (w *UserPurger) processPurgeable(ctx context.Context) {
+	cutoff := w.now().Add(-w.cfg.Retention)
+	ids, err := w.repo.FindPurgeable(ctx, cutoff, w.cfg.BatchSize)
+	if err != nil {
+		w.logger.Error("failed to find purgeable users", "error", err)
+		return
+	}
+
+	if len(ids) == 0 {
+		return
+	}
+
+	var purged int
+	for _, id := range ids {
+		if ctx.Err() != nil {
+			w.logger.Info("context cancelled, stopping process loop")
+			return
+		}
+
+		logger := w.logger.With("user_id", id)
+
+		if w.cfg.DryRun {
+			logger.Info("dry run: would purge user", "mode", w.cfg.Mode)
+			continue
+		}
+
+		if w.cfg.Mode == domain.PurgeDelete {
+			err = w.repo.HardDelete(ctx, id)
+		} else {
+			err = w.repo.Anonymize(ctx, id)
+		}
+		if errors.Is(err, domain.ErrUserNotFound) {
+			// Restored or purged concurrently since it was listed.
+			logger.Debug("user no longer purgeable, skipping")
+			continue
+		}
+		if err != nil {
+			logger.Error("failed to purge user", "error", err)
+			continue
+		}
+		purged++
+	}
+
+	if !w.cfg.DryRun {
+		w.logger.Info("purged soft-deleted users", "count", purged, "mode", w.cfg.Mode)
+	}
+}
@@ -0,0 +1,113 @@
+package worker
+
+import (
+	"context"
+	"log/slog"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/daisuke8000/example-ec-platform/services/user/internal/domain"
+)
+
+// mockPurgeRepository is an in-memory domain.UserPurgeRepository.
+type mockPurgeRepository struct {
+	purgeable  []uuid.UUID
+	gone       map[uuid.UUID]bool
+	cutoff     time.Time
+	anonymized []uuid.UUID
+	deleted    []uuid.UUID
+}
+
+func (m *mockPurgeRepository) FindPurgeable(ctx context.Context, cutoff time.Time, limit int) ([]uuid.UUID, error) {
+	m.cutoff = cutoff
+	if len(m.purgeable) > limit {
+		return m.purgeable[:limit], nil
+	}
+	return m.purgeable, nil
+}
+
+func (m *mockPurgeRepository) Anonymize(ctx context.Context, id uuid.UUID) error {
+	if m.gone[id] {
+		return domain.ErrUserNotFound
+	}
+	m.anonymized = append(m.anonymized, id)
+	return nil
+}
+
+func (m *mockPurgeRepository) HardDelete(ctx context.Context, id uuid.UUID) error {
+	if m.gone[id] {
+		return domain.ErrUserNotFound
+	}
+	m.deleted = append(m.deleted, id)
+	return nil
+}
+
+func newTestPurger(repo *mockPurgeRepository, cfg UserPurgerConfig, now time.Time) *UserPurger {
+	logger := slog.New(slog.NewTextHandler(os.Stdout, &slog.HandlerOptions{Level: slog.LevelError}))
+	w := NewUserPurger(repo, logger, cfg)
+	w.now = func() time.Time { return now }
+	return w
+}
+
+func TestUserPurger_Anonymize(t *testing.T) {
+	now := time.Date(2026, 1, 31, 0, 0, 0, 0, time.UTC)
+	restored := uuid.New()
+	repo := &mockPurgeRepository{
+		purgeable: []uuid.UUID{uuid.New(), restored, uuid.New()},
+		gone:      map[uuid.UUID]bool{restored: true},
+	}
+
+	w := newTestPurger(repo, UserPurgerConfig{
+		Retention: 30 * 24 * time.Hour,
+		BatchSize: 10,
+		Mode:      domain.PurgeAnonymize,
+	}, now)
+	w.processPurgeable(context.Background())
+
+	if want := now.Add(-30 * 24 * time.Hour); !repo.cutoff.Equal(want) {
+		t.Errorf("cutoff = %v, want %v", repo.cutoff, want)
+	}
+	if len(repo.anonymized) != 2 {
+		t.Errorf("anonymized %d users, want 2 (restored user skipped)", len(repo.anonymized))
+	}
+	if len(repo.deleted) != 0 {
+		t.Errorf("deleted %d users in anonymize mode, want 0", len(repo.deleted))
+	}
+}
+
+func TestUserPurger_Delete(t *testing.T) {
+	repo := &mockPurgeRepository{purgeable: []uuid.UUID{uuid.New(), uuid.New(), uuid.New()}}
+
+	w := newTestPurger(repo, UserPurgerConfig{
+		Retention: time.Hour,
+		BatchSize: 2,
+		Mode:      domain.PurgeDelete,
+	}, time.Now())
+	w.processPurgeable(context.Background())
+
+	if len(repo.deleted) != 2 {
+		t.Errorf("deleted %d users, want batch size 2", len(repo.deleted))
+	}
+	if len(repo.anonymized) != 0 {
+		t.Errorf("anonymized %d users in delete mode, want 0", len(repo.anonymized))
+	}
+}
+
+func TestUserPurger_DryRun(t *testing.T) {
+	repo := &mockPurgeRepository{purgeable: []uuid.UUID{uuid.New()}}
+
+	w := newTestPurger(repo, UserPurgerConfig{
+		Retention: time.Hour,
+		BatchSize: 10,
+		Mode:      domain.PurgeDelete,
+		DryRun:    true,
+	}, time.Now())
+	w.processPurgeable(context.Background())
+
+	if len(repo.deleted) != 0 || len(repo.anonymized) != 0 {
+		t.Errorf("dry run modified users: deleted=%d anonymized=%d", len(repo.deleted), len(repo.anonymized))
+	}
+}